@@ -5,12 +5,17 @@ import (
 	"time"
 )
 
+// MaxAvailabilityWindow is the longest period of time that can be queried for
+// availabilities in a single request. Slots are expanded for every day in the
+// window so this puts an upper bound on the work done per request.
+const MaxAvailabilityWindow = 366 * 24 * time.Hour
+
 // Availability represents a slot that a resource is available with a specific
 // date and time associated with it.
 type Availability struct {
 	// The resource that the availability is for.
 	ResourceID int       `json:"resourceId"`
-	Resource   *Resource `json:"resource"`
+	Resource   *Resource `json:"resource,omitempty"`
 
 	// Information about the time of the availability.
 	StartTime time.Time `json:"startTime"`
@@ -19,24 +24,94 @@ type Availability struct {
 
 // AvailabilityService represents a service for querying resource availability.
 type AvailabilityService interface {
-	FindAvailabilties(ctx context.Context, filter AvailabilityFilter) ([]*Availability, int, error)
+	// FindAvailabilities expands the slots of a resource across a window of time
+	// and returns the periods that can still be booked. Periods covered by an
+	// unavailability or by bookings that have used up the capacity of the
+	// resource are excluded. Also returns the total number of availabilities in
+	// the window which may differ from the number returned if "Limit" is set.
+	FindAvailabilities(ctx context.Context, req FindAvailabilitiesRequest) FindAvailabilitiesResponse
 }
 
-// AvailabilityFilter represents a filter used by FindAvailabilities()
-type AvailabilityFilter struct {
+// FindAvailabilitiesRequest represents a payload used by the FindAvailabilities
+// method of an AvailabilityService.
+type FindAvailabilitiesRequest struct {
 	// Filtering fields.
-	ResourceID *int `json:"resourceId"`
-
-	StartTimeAfter time.Time `json:"startTimeAfter"`
-	EndTimeBefore  time.Time `json:"endTimeBefore"`
+	ResourceID     int       `json:"resourceId" source:"url"`
+	StartTimeAfter time.Time `json:"startTimeAfter" source:"query"`
+	EndTimeBefore  time.Time `json:"endTimeBefore" source:"query"`
 
 	// Restrict to subset of range.
-	Offset int `json:"offset"`
-	Limit  int `json:"limit"`
+	Offset int `json:"offset" source:"query"`
+	Limit  int `json:"limit" source:"query"`
 
 	// Availability property to order by.
-	OrderBy string `json:"orderBy"`
+	OrderBy *string `json:"orderBy" source:"query"`
+}
+
+// Validate a FindAvailabilitiesRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r FindAvailabilitiesRequest) Validate() []ValidationError {
+	errs := make([]ValidationError, 0)
+	if r.ResourceID < 1 {
+		errs = append(errs, ValidationError{Name: "resourceId", Reason: "Must be at least 1"})
+	}
+	if r.StartTimeAfter.IsZero() {
+		errs = append(errs, ValidationError{Name: "startTimeAfter", Reason: "Start time after is required"})
+	}
+	if r.EndTimeBefore.IsZero() {
+		errs = append(errs, ValidationError{Name: "endTimeBefore", Reason: "End time before is required"})
+	}
+	if !r.StartTimeAfter.IsZero() && !r.EndTimeBefore.IsZero() {
+		if !r.EndTimeBefore.After(r.StartTimeAfter) {
+			errs = append(errs, ValidationError{Name: "endTimeBefore", Reason: "Must be later than 'startTimeAfter'"})
+		} else if r.EndTimeBefore.Sub(r.StartTimeAfter) > MaxAvailabilityWindow {
+			errs = append(errs, ValidationError{Name: "endTimeBefore", Reason: "Must be no more than 366 days after 'startTimeAfter'"})
+		}
+	}
+	if r.Offset < 0 {
+		errs = append(errs, ValidationError{Name: "offset", Reason: "Must be greater than or equal to 0"})
+	}
+	if r.Limit < 0 {
+		errs = append(errs, ValidationError{Name: "limit", Reason: "Must be greater than or equal to 0"})
+	}
+	validOrderByValues := []string{"startTime", "endTime"}
+	if r.OrderBy != nil && !Strings(validOrderByValues).contains(*r.OrderBy) {
+		errs = append(errs, ValidationError{Name: "orderBy", Reason: "Must be a valid property name"})
+	}
+	return errs
 }
 
+// FindAvailabilitiesResponse represents a response returned by the
+// FindAvailabilities method of an AvailabilityService.
+type FindAvailabilitiesResponse struct {
+	Availabilities []*Availability `json:"availabilities,omitempty"`
+	TotalItems     int             `json:"totalItems"`
+	Err            error           `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r FindAvailabilitiesResponse) Error() error { return r.Err }
+
 // AvailabilityServiceMiddleware defines a middleware for an availability service.
 type AvailabilityServiceMiddleware func(AvailabilityService) AvailabilityService
+
+// AvailabilityValidationMiddleware returns a middleware for validating requests
+// made to an AvailabilityService.
+func AvailabilityValidationMiddleware() AvailabilityServiceMiddleware {
+	return func(next AvailabilityService) AvailabilityService {
+		return availabilityValidationMiddleware{next}
+	}
+}
+
+type availabilityValidationMiddleware struct {
+	AvailabilityService
+}
+
+// FindAvailabilities validates a FindAvailabilitiesRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw availabilityValidationMiddleware) FindAvailabilities(ctx context.Context, req FindAvailabilitiesRequest) FindAvailabilitiesResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return FindAvailabilitiesResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.AvailabilityService.FindAvailabilities(ctx, req)
+}
@@ -2,6 +2,7 @@ package booking
 
 import (
 	"context"
+	"fmt"
 	"time"
 )
 
@@ -13,6 +14,18 @@ type Booking struct {
 	ResourceID int       `json:"resourceId"`
 	Resource   *Resource `json:"resource"`
 
+	// The user that made the booking. Nil if the booking was not made by a
+	// signed in user, e.g. when using an API key.
+	UserID *int `json:"userId,omitempty"`
+
+	// The recurring series that the booking is an occurrence of. Nil if the
+	// booking does not recur.
+	SeriesID *int `json:"seriesId,omitempty"`
+
+	// The customer that the booking was made for. Nil if the booking was made
+	// without any details of the customer.
+	CustomerID *int `json:"customerId,omitempty"`
+
 	// Generic information about the booking. Can include things like the
 	// customer's personal information.
 	Metadata map[string]string `json:"metadata,omitempty"`
@@ -20,6 +33,32 @@ type Booking struct {
 	// The status of the booking.
 	Status string `json:"status"`
 
+	// The time at which a held booking is released unless it is confirmed, or
+	// at which a pending booking made through the public API is released
+	// unless its deposit is paid. Nil for other bookings.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// The price of the booking, worked out with the pricing rules of the
+	// resource when the booking was made, in the currency of the resource at
+	// that time. The price includes tax.
+	Price Money `json:"price"`
+
+	// The tax rate of the resource when the booking was made, if it had one,
+	// and Price split into the amount before tax, the tax and the amount
+	// including tax, in the currency of Price.
+	TaxRate *AppliedTax `json:"taxRate,omitempty"`
+	Taxed
+
+	// The time at which the booking was cancelled. Nil for bookings that have
+	// not been cancelled.
+	CancelledAt *time.Time `json:"cancelledAt,omitempty"`
+
+	// The amounts refunded to and charged to the customer under the
+	// cancellation policy of the resource when the booking was cancelled or
+	// the customer didn't show up, in the currency of Price.
+	RefundAmount int `json:"refundAmount"`
+	FeeAmount    int `json:"feeAmount"`
+
 	// Information about the time of the booking.
 	StartTime time.Time `json:"startTime"`
 	EndTime   time.Time `json:"endTime"`
@@ -29,6 +68,148 @@ type Booking struct {
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
+// BookingSeries represents a recurring booking. Each occurrence of the series
+// is stored as a separate Booking so that occurrences can be changed
+// individually.
+type BookingSeries struct {
+	ID int `json:"id"`
+
+	// The resource that the series has been booked for.
+	ResourceID int `json:"resourceId"`
+
+	// The RFC 5545 recurrence rule and excluded dates that the occurrences of
+	// the series were created from.
+	RRule   string      `json:"rrule"`
+	ExDates []time.Time `json:"exdates"`
+
+	// The times of the first occurrence.
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+
+	// The occurrences of the series ordered by start time.
+	Bookings []*Booking `json:"bookings,omitempty"`
+
+	// Timestamps for series creation and last update.
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// BookingOccurrence describes the time of a single occurrence of a recurring
+// booking.
+type BookingOccurrence struct {
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+}
+
+// OccurrenceUpdate describes an occurrence of a recurring booking that was
+// changed by an update to another occurrence.
+type OccurrenceUpdate struct {
+	*Booking
+
+	// The status of the occurrence before the update was applied.
+	PreviousStatus string `json:"previousStatus,omitempty"`
+}
+
+// MaxBookingSeriesOccurrences is the maximum number of occurrences that a
+// recurring booking can have.
+const MaxBookingSeriesOccurrences = 500
+
+// Series scopes determine which occurrences of a recurring booking are changed
+// when one of them is updated or deleted.
+const (
+	// Only the occurrence itself. This is the default.
+	SeriesScopeOccurrence = "occurrence"
+	// The occurrence and every later occurrence.
+	SeriesScopeFollowing = "following"
+	// Every occurrence of the series.
+	SeriesScopeSeries = "series"
+)
+
+// validateSeriesScope returns a ValidationError if scope is not a valid
+// series scope. An empty scope is valid.
+func validateSeriesScope(scope string) []ValidationError {
+	switch scope {
+	case "", SeriesScopeOccurrence, SeriesScopeFollowing, SeriesScopeSeries:
+		return nil
+	}
+	return []ValidationError{{Name: "seriesScope", Reason: "Must be one of 'occurrence', 'following' or 'series'"}}
+}
+
+// Booking statuses. A booking starts out as held, pending or confirmed and
+// moves through the lifecycle defined by bookingStatusTransitions.
+const (
+	BookingStatusHeld      = "held"
+	BookingStatusPending   = "pending"
+	BookingStatusConfirmed = "confirmed"
+	BookingStatusCheckedIn = "checked_in"
+	BookingStatusCompleted = "completed"
+	BookingStatusCancelled = "cancelled"
+	BookingStatusNoShow    = "no_show"
+	BookingStatusExpired   = "expired"
+)
+
+// BookingStatuses contains every valid booking status.
+var BookingStatuses = []string{
+	BookingStatusHeld,
+	BookingStatusPending,
+	BookingStatusConfirmed,
+	BookingStatusCheckedIn,
+	BookingStatusCompleted,
+	BookingStatusCancelled,
+	BookingStatusNoShow,
+	BookingStatusExpired,
+}
+
+// ActiveBookingStatuses contains the statuses of bookings that occupy a
+// resource. Only bookings with one of these statuses are considered when
+// checking for conflicts and calculating availability.
+var ActiveBookingStatuses = []string{
+	BookingStatusHeld,
+	BookingStatusPending,
+	BookingStatusConfirmed,
+	BookingStatusCheckedIn,
+}
+
+// bookingStatusTransitions maps each status to the statuses that a booking
+// may move to from it. Statuses without an entry are final.
+var bookingStatusTransitions = map[string][]string{
+	BookingStatusHeld:      {BookingStatusPending, BookingStatusConfirmed, BookingStatusCancelled, BookingStatusExpired},
+	BookingStatusPending:   {BookingStatusConfirmed, BookingStatusCancelled, BookingStatusExpired},
+	BookingStatusConfirmed: {BookingStatusCheckedIn, BookingStatusCancelled, BookingStatusNoShow},
+	BookingStatusCheckedIn: {BookingStatusCompleted},
+}
+
+// Hold durations. A hold that doesn't specify how long it lasts is released
+// after DefaultBookingHoldDuration.
+const (
+	DefaultBookingHoldDuration = 10 * time.Minute
+	MaxBookingHoldDuration     = time.Hour
+)
+
+// DepositPaymentDuration is how long customers booking through the public API
+// have to pay the deposit of a booking before it is released.
+const DepositPaymentDuration = 30 * time.Minute
+
+// ValidBookingStatus returns true if status is a known booking status.
+func ValidBookingStatus(status string) bool {
+	return Strings(BookingStatuses).contains(status)
+}
+
+// ActiveBookingStatus returns true if a booking with the given status occupies
+// its resource.
+func ActiveBookingStatus(status string) bool {
+	return Strings(ActiveBookingStatuses).contains(status)
+}
+
+// CanTransitionBooking returns true if a booking is allowed to move from one
+// status to another. Remaining in the same status is always allowed.
+func CanTransitionBooking(from, to string) bool {
+	if from == to {
+		return true
+	}
+	return Strings(bookingStatusTransitions[from]).contains(to)
+}
+
 // BookingService represents a service for managing bookings.
 type BookingService interface {
 	// Retrieves a single booking by ID along with the associated resource and
@@ -42,21 +223,87 @@ type BookingService interface {
 	// field is set.
 	FindBookings(ctx context.Context, req FindBookingsRequest) FindBookingsResponse
 
-	// Creates a new booking and assigns the current user as the owner.
+	// Creates a new booking and assigns the current user as the owner. The
+	// booking must fit within a single slot of the resource (EBOOKINGOUTSIDESLOTS),
+	// must not start in the past (EBOOKINGINPAST) or overlap an unavailability
+	// (EBOOKINGUNAVAILABLE), and must not exceed the quantity of its slot
+	// (ESLOTFULL) or of the resource (EBOOKINGCONFLICT).
+	//
+	// The booking is priced with the pricing rules of the resource and keeps
+	// that price if the rules change. A promo code in the request is redeemed
+	// for the booking, and a validation error for "promoCode" is returned if
+	// it doesn't exist, is outside of its validity window, doesn't apply to
+	// the resource or has reached its redemption limits.
+	//
+	// Bookings of a resource with a booking price are created as pending along
+	// with a deposit payment, and are confirmed once the deposit succeeds.
+	// Returns EPAYMENTPROVIDER if the payment provider could not create the
+	// deposit.
 	CreateBooking(ctx context.Context, req CreateBookingRequest) CreateBookingResponse
 
 	// Updates an existing booking by ID. Only the booking owner can update a
 	// booking. Returns the new booking state even if there was an error during
-	// update.
+	// update. Bookings that are moved are checked in the same way as new
+	// bookings.
 	//
 	// Returns ENOTFOUND if the booking does not exist or the user does not have
-	// permission to update it.
+	// permission to update it. Returns EPAYMENTREQUIRED if the booking is
+	// confirmed before its deposit has been paid.
+	//
+	// Bookings that are cancelled or marked as a no-show are settled under the
+	// cancellation policy of their resource: the refund is paid back out of
+	// the deposit and the refund and fee are stored on the booking. Returns
+	// EPAYMENTPROVIDER if the refund could not be made.
 	UpdateBooking(ctx context.Context, req UpdateBookingRequest) UpdateBookingResponse
 
 	// Permanently removes a booking by ID. Only the booking owner may delete a
 	// booking. Returns ENOTFOUND if the booking does not exist or the user does
-	// not have permission to delete it.
+	// not have permission to delete it. The time of the deleted booking is
+	// offered to the waitlist of the resource, as it is when a booking is
+	// cancelled.
 	DeleteBooking(ctx context.Context, req DeleteBookingRequest) DeleteBookingResponse
+
+	// Retrieves a recurring booking by ID along with its occurrences. Returns
+	// EBOOKINGSERIESNOTFOUND if the series does not exist or the user does not
+	// have permission to view it.
+	FindBookingSeriesByID(ctx context.Context, req FindBookingSeriesByIDRequest) FindBookingSeriesByIDResponse
+
+	// Creates a recurring booking and a booking for each of its occurrences.
+	// Returns EBOOKINGCONFLICT listing the conflicting occurrences if any of
+	// them can't be booked, unless conflicting occurrences are to be skipped.
+	CreateBookingSeries(ctx context.Context, req CreateBookingSeriesRequest) CreateBookingSeriesResponse
+
+	// Creates a booking for each row of an import. Every row is validated and
+	// checked for conflicts like a single booking would be. Rows that fail are
+	// reported in the response unless the import is all or nothing, in which
+	// case nothing is created and EINVALID is returned with the errors of every
+	// row.
+	ImportBookings(ctx context.Context, req ImportBookingsRequest) ImportBookingsResponse
+
+	// Creates a held booking that occupies the resource until it expires. The
+	// hold is checked in the same way as a new booking, except that required
+	// booking fields can be left out, and is released by a BookingHoldService
+	// unless it is confirmed before it expires.
+	HoldBooking(ctx context.Context, req HoldBookingRequest) HoldBookingResponse
+
+	// Confirms a held booking. Returns EBOOKINGNOTHELD if the booking is not
+	// held, EHOLDEXPIRED if the hold expired before it was confirmed and
+	// EINVALID if its metadata doesn't satisfy the booking fields of the
+	// resource. Held bookings of a resource with a booking price become
+	// pending instead and are confirmed once their deposit succeeds.
+	ConfirmBooking(ctx context.Context, req ConfirmBookingRequest) ConfirmBookingResponse
+}
+
+// BookingHoldService represents a service for releasing held bookings that
+// were not confirmed in time, and pending bookings whose deposit was not paid
+// in time. It is used by background workers and is not restricted to the
+// organization of the caller.
+type BookingHoldService interface {
+	// Marks up to limit held or pending bookings that expired at or before now
+	// as expired. Returns the expired bookings along with their resources and
+	// previous statuses, and the spots that were offered to waitlist entries
+	// in their place.
+	ExpireBookingHolds(ctx context.Context, now time.Time, limit int) ([]BookingStatusChangedPayload, []WaitlistOffer, error)
 }
 
 // BookingUpdate represents a set of fields to update on a booking.
@@ -96,21 +343,32 @@ type FindBookingsRequest struct {
 	ID             *int       `json:"id" source:"query"`
 	ResourceID     *int       `json:"resourceId" source:"query"`
 	Status         *string    `json:"status" source:"query"`
+	SeriesID       *int       `json:"seriesId" source:"query"`
+	CustomerID     *int       `json:"customerId" source:"query"`
 	StartTimeAfter *time.Time `json:"startTimeAfter" source:"query"`
 	EndTimeBefore  *time.Time `json:"endTimeBefore" source:"query"`
 
 	// Restrict to subset of range.
-	Offset int `json:"offset"`
-	Limit  int `json:"limit"`
+	Offset int `json:"offset" source:"query"`
+	Limit  int `json:"limit" source:"query"`
 
 	// Booking property to order by.
-	OrderBy string `json:"orderBy"`
+	OrderBy string `json:"orderBy" source:"query"`
 }
 
 // Validate a FindBookings. Returns a ValidationError for each requirement that fails.
 func (r FindBookingsRequest) Validate() []ValidationError {
-	// insert validation logic here
-	return nil
+	errs := make([]ValidationError, 0)
+	if r.Status != nil && !ValidBookingStatus(*r.Status) {
+		errs = append(errs, ValidationError{Name: "status", Reason: "Must be a valid booking status"})
+	}
+	if r.Offset < 0 {
+		errs = append(errs, ValidationError{Name: "offset", Reason: "Must be greater than or equal to 0"})
+	}
+	if r.Limit < 0 {
+		errs = append(errs, ValidationError{Name: "limit", Reason: "Must be greater than or equal to 0"})
+	}
+	return errs
 }
 
 // FindBookingsResponse represents a response returned by the FindBookings method of a BookingService.
@@ -138,17 +396,49 @@ type CreateBookingRequest struct {
 	// Information about the time of the booking.
 	StartTime time.Time `json:"startTime" source:"json"`
 	EndTime   time.Time `json:"endTime" source:"json"`
+
+	// The customer segment that the booking is priced for, e.g. "member".
+	Segment string `json:"segment" source:"json"`
+
+	// A promo code to redeem for the booking and an identifier of the
+	// customer, such as their email address, that per-customer redemption
+	// limits are counted against.
+	PromoCode string `json:"promoCode" source:"json"`
+	Customer  string `json:"customer" source:"json"`
+
+	// The password of the resource. Only checked for bookings made through
+	// the public API.
+	Password string `json:"password" source:"json"`
+
+	// The customer that the booking is made for. If nil, the booking is made
+	// for the customer with the email address in Metadata, who is created if
+	// they don't exist yet.
+	CustomerID *int `json:"customerId" source:"json"`
 }
 
 // Validate a CreateBooking. Returns a ValidationError for each requirement that fails.
 func (r CreateBookingRequest) Validate() []ValidationError {
-	// insert validation logic here
-	return nil
+	errs := make([]ValidationError, 0)
+	if r.ResourceID < 1 {
+		errs = append(errs, ValidationError{Name: "resourceId", Reason: "Must be at least 1"})
+	}
+	// New bookings may only start at the beginning of the lifecycle. Leaving the
+	// status empty creates a pending booking.
+	if r.Status != "" && r.Status != BookingStatusPending && r.Status != BookingStatusConfirmed {
+		errs = append(errs, ValidationError{Name: "status", Reason: "Must be one of 'pending' or 'confirmed'"})
+	}
+	errs = append(errs, validateBookingTimes(r.StartTime, r.EndTime)...)
+	return errs
 }
 
 // CreateBookingResponse represents a response returned by the CreateBooking method of a BookingService.
 type CreateBookingResponse struct {
 	*Booking
+
+	// The deposit that the customer has to pay before the booking is
+	// confirmed. Nil if the resource has no booking price.
+	Payment *Payment `json:"payment,omitempty"`
+
 	Err error `json:"err,omitempty"`
 }
 
@@ -163,17 +453,46 @@ type UpdateBookingRequest struct {
 	Status     string            `json:"status" source:"json"`
 	StartTime  time.Time         `json:"startTime" source:"json"`
 	EndTime    time.Time         `json:"endTime" source:"json"`
+
+	// Which occurrences to update if the booking is part of a series. When
+	// updating following occurrences or the whole series, other occurrences
+	// that are pending or confirmed are moved by the same amount as the
+	// booking and given its duration, resource and status.
+	SeriesScope string `json:"seriesScope" source:"json"`
 }
 
 // Validate a UpdateBooking. Returns a ValidationError for each requirement that fails.
 func (r UpdateBookingRequest) Validate() []ValidationError {
-	// insert validation logic here
-	return nil
+	errs := make([]ValidationError, 0)
+	if r.ID < 1 {
+		errs = append(errs, ValidationError{Name: "id", Reason: "Must be at least 1"})
+	}
+	if r.ResourceID < 1 {
+		errs = append(errs, ValidationError{Name: "resourceId", Reason: "Must be at least 1"})
+	}
+	if !ValidBookingStatus(r.Status) {
+		errs = append(errs, ValidationError{Name: "status", Reason: "Must be a valid booking status"})
+	}
+	errs = append(errs, validateBookingTimes(r.StartTime, r.EndTime)...)
+	errs = append(errs, validateSeriesScope(r.SeriesScope)...)
+	return errs
 }
 
 // UpdateBookingResponse represents a response returned by the UpdateBooking method of a BookingService.
 type UpdateBookingResponse struct {
 	*Booking
+
+	// The status of the booking before the update was applied.
+	PreviousStatus string `json:"previousStatus,omitempty"`
+
+	// Other occurrences of the series that were updated along with the
+	// booking, if any.
+	Occurrences []OccurrenceUpdate `json:"occurrences,omitempty"`
+
+	// Spots offered to waitlist entries because the update freed up the time
+	// of the booking.
+	Offers []WaitlistOffer `json:"offers,omitempty"`
+
 	Err error `json:"err,omitempty"`
 }
 
@@ -183,22 +502,269 @@ func (r UpdateBookingResponse) Error() error { return r.Err }
 // DeleteBookingRequest represents a payload used by the DeleteBooking method of a BookingService
 type DeleteBookingRequest struct {
 	ID int `json:"id" source:"url"`
+
+	// Which occurrences to delete if the booking is part of a series.
+	SeriesScope string `json:"seriesScope" source:"query"`
 }
 
 // Validate a DeleteBooking. Returns a ValidationError for each requirement that fails.
 func (r DeleteBookingRequest) Validate() []ValidationError {
-	// insert validation logic here
-	return nil
+	errs := make([]ValidationError, 0)
+	if r.ID < 1 {
+		errs = append(errs, ValidationError{Name: "id", Reason: "Must be at least 1"})
+	}
+	errs = append(errs, validateSeriesScope(r.SeriesScope)...)
+	return errs
+}
+
+// validateBookingTimes returns a ValidationError for each problem with the
+// start and end time of a booking.
+func validateBookingTimes(startTime, endTime time.Time) []ValidationError {
+	var errs []ValidationError
+	if startTime.IsZero() {
+		errs = append(errs, ValidationError{Name: "startTime", Reason: "Start time is required"})
+	}
+	if endTime.IsZero() {
+		errs = append(errs, ValidationError{Name: "endTime", Reason: "End time is required"})
+	}
+	if !startTime.IsZero() && !endTime.IsZero() && !endTime.After(startTime) {
+		errs = append(errs, ValidationError{Name: "endTime", Reason: "Must be later than 'startTime'"})
+	}
+	return errs
 }
 
 // DeleteBookingResponse represents a response returned by the DeleteBooking method of a BookingService.
 type DeleteBookingResponse struct {
+	// The IDs of every booking that was deleted, including other occurrences
+	// of the series.
+	DeletedIDs []int `json:"deletedIds,omitempty"`
+
+	// Spots offered to waitlist entries in place of the deleted bookings.
+	Offers []WaitlistOffer `json:"offers,omitempty"`
+
 	Err error `json:"err,omitempty"`
 }
 
 // Error implements the errorer interface. Returns property Err from the response.
 func (r DeleteBookingResponse) Error() error { return r.Err }
 
+// ImportBookingsRequest represents a payload used by the ImportBookings method of a BookingService
+type ImportBookingsRequest struct {
+	Rows []CreateBookingRequest `json:"rows" source:"json"`
+
+	// Roll back the whole import if any row fails.
+	AllOrNothing bool `json:"allOrNothing" source:"query"`
+}
+
+// Validate a ImportBookings. Returns a ValidationError for each requirement
+// that fails. Rows are validated individually as they are imported.
+func (r ImportBookingsRequest) Validate() []ValidationError {
+	return validateImportRows(len(r.Rows))
+}
+
+// BookingImportResult describes the outcome of importing a single row.
+type BookingImportResult struct {
+	// The position of the row within the import, starting from 0.
+	Row int `json:"row"`
+
+	// The created booking. Nil if the row failed.
+	Booking *Booking `json:"booking,omitempty"`
+
+	Errors []ValidationError `json:"errors,omitempty"`
+}
+
+// ImportBookingsResponse represents a response returned by the ImportBookings method of a BookingService.
+type ImportBookingsResponse struct {
+	Rows    []BookingImportResult `json:"rows"`
+	Created int                   `json:"created"`
+	Failed  int                   `json:"failed"`
+	Err     error                 `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r ImportBookingsResponse) Error() error { return r.Err }
+
+// HoldBookingRequest represents a payload used by the HoldBooking method of a BookingService
+type HoldBookingRequest struct {
+	// The resource that the booking is held for.
+	ResourceID int `json:"resourceId" source:"json"`
+
+	// Generic information about the booking.
+	Metadata map[string]string `json:"metadata" source:"json"`
+
+	// Information about the time of the booking.
+	StartTime time.Time `json:"startTime" source:"json"`
+	EndTime   time.Time `json:"endTime" source:"json"`
+
+	// How long the hold lasts in seconds. Defaults to
+	// DefaultBookingHoldDuration.
+	Duration int `json:"duration" source:"json"`
+
+	// The customer segment that the booking is priced for, e.g. "member".
+	Segment string `json:"segment" source:"json"`
+
+	// A promo code to redeem for the booking, see CreateBookingRequest. The
+	// redemption stops counting if the hold expires.
+	PromoCode string `json:"promoCode" source:"json"`
+	Customer  string `json:"customer" source:"json"`
+}
+
+// Validate a HoldBooking. Returns a ValidationError for each requirement that fails.
+func (r HoldBookingRequest) Validate() []ValidationError {
+	errs := make([]ValidationError, 0)
+	if r.ResourceID < 1 {
+		errs = append(errs, ValidationError{Name: "resourceId", Reason: "Must be at least 1"})
+	}
+	if r.Duration < 0 || time.Duration(r.Duration)*time.Second > MaxBookingHoldDuration {
+		errs = append(errs, ValidationError{
+			Name:   "duration",
+			Reason: fmt.Sprintf("Must be between 1 and %d seconds", int(MaxBookingHoldDuration.Seconds())),
+		})
+	}
+	errs = append(errs, validateBookingTimes(r.StartTime, r.EndTime)...)
+	return errs
+}
+
+// HoldDuration returns how long the hold lasts.
+func (r HoldBookingRequest) HoldDuration() time.Duration {
+	if r.Duration == 0 {
+		return DefaultBookingHoldDuration
+	}
+	return time.Duration(r.Duration) * time.Second
+}
+
+// HoldBookingResponse represents a response returned by the HoldBooking method of a BookingService.
+type HoldBookingResponse struct {
+	*Booking
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r HoldBookingResponse) Error() error { return r.Err }
+
+// ConfirmBookingRequest represents a payload used by the ConfirmBooking method of a BookingService
+type ConfirmBookingRequest struct {
+	ID int `json:"id" source:"url"`
+
+	// Metadata to add to the booking, e.g. customer details that were
+	// collected while the booking was held.
+	Metadata map[string]string `json:"metadata" source:"json"`
+}
+
+// Validate a ConfirmBooking. Returns a ValidationError for each requirement that fails.
+func (r ConfirmBookingRequest) Validate() []ValidationError {
+	if r.ID < 1 {
+		return []ValidationError{
+			{Name: "id", Reason: "Must be at least 1"},
+		}
+	}
+	return nil
+}
+
+// ConfirmBookingResponse represents a response returned by the ConfirmBooking method of a BookingService.
+type ConfirmBookingResponse struct {
+	*Booking
+
+	// The status of the booking before it was confirmed.
+	PreviousStatus string `json:"previousStatus,omitempty"`
+
+	// The deposit that the customer has to pay before the booking is
+	// confirmed. Nil if the resource has no booking price.
+	Payment *Payment `json:"payment,omitempty"`
+
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r ConfirmBookingResponse) Error() error { return r.Err }
+
+// FindBookingSeriesByIDRequest represents a payload used by the FindBookingSeriesByID method of a BookingService
+type FindBookingSeriesByIDRequest struct {
+	ID int `json:"id" source:"url"`
+}
+
+// Validate a FindBookingSeriesByID. Returns a ValidationError for each requirement that fails.
+func (r FindBookingSeriesByIDRequest) Validate() []ValidationError {
+	if r.ID < 1 {
+		return []ValidationError{
+			{Name: "id", Reason: "Must be at least 1"},
+		}
+	}
+	return nil
+}
+
+// FindBookingSeriesByIDResponse represents a response returned by the FindBookingSeriesByID method of a BookingService.
+type FindBookingSeriesByIDResponse struct {
+	*BookingSeries
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r FindBookingSeriesByIDResponse) Error() error { return r.Err }
+
+// CreateBookingSeriesRequest represents a payload used by the CreateBookingSeries method of a BookingService
+type CreateBookingSeriesRequest struct {
+	// The resource that the series is booked for.
+	ResourceID int `json:"resourceId" source:"json"`
+
+	// Generic information that is copied to every occurrence.
+	Metadata map[string]string `json:"metadata" source:"json"`
+
+	// The status of every occurrence.
+	Status string `json:"status" source:"json"`
+
+	// The time of the first occurrence. Later occurrences start at the same
+	// local time in the resource's timezone and have the same duration.
+	StartTime time.Time `json:"startTime" source:"json"`
+	EndTime   time.Time `json:"endTime" source:"json"`
+
+	// An RFC 5545 recurrence rule, e.g. "FREQ=WEEKLY;BYDAY=TU;COUNT=10". The
+	// rule must be bounded by COUNT or UNTIL. As in RFC 5545 the start time is
+	// the first occurrence even if it doesn't match the rule, and UNTIL values
+	// without a UTC time are in the resource's timezone.
+	RRule string `json:"rrule" source:"json"`
+
+	// Start times of occurrences that should not be booked.
+	ExDates []time.Time `json:"exdates" source:"json"`
+
+	// Book the occurrences that can be booked instead of failing if any of
+	// them conflict with existing bookings or fall outside the slots of the
+	// resource.
+	SkipConflicts bool `json:"skipConflicts" source:"json"`
+}
+
+// Validate a CreateBookingSeries. Returns a ValidationError for each requirement that fails.
+func (r CreateBookingSeriesRequest) Validate() []ValidationError {
+	errs := make([]ValidationError, 0)
+	if r.ResourceID < 1 {
+		errs = append(errs, ValidationError{Name: "resourceId", Reason: "Must be at least 1"})
+	}
+	if r.Status != "" && r.Status != BookingStatusPending && r.Status != BookingStatusConfirmed {
+		errs = append(errs, ValidationError{Name: "status", Reason: "Must be one of 'pending' or 'confirmed'"})
+	}
+	errs = append(errs, validateBookingTimes(r.StartTime, r.EndTime)...)
+	if rule, err := ParseRRule(r.RRule); err != nil {
+		errs = append(errs, ValidationError{Name: "rrule", Reason: "Must be a valid recurrence rule: " + err.Error()})
+	} else if !rule.Bounded() {
+		errs = append(errs, ValidationError{Name: "rrule", Reason: "Must be bounded by COUNT or UNTIL"})
+	}
+	return errs
+}
+
+// CreateBookingSeriesResponse represents a response returned by the CreateBookingSeries method of a BookingService.
+type CreateBookingSeriesResponse struct {
+	*BookingSeries
+
+	// Occurrences that were not booked because they conflicted with existing
+	// bookings. Only set if conflicts were skipped.
+	Skipped []BookingOccurrence `json:"skipped,omitempty"`
+
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r CreateBookingSeriesResponse) Error() error { return r.Err }
+
 // BookingServiceMiddleware defines a middleware for BookingService
 type BookingServiceMiddleware func(service BookingService) BookingService
 
@@ -257,3 +823,48 @@ func (mw bookingValidationMiddleware) DeleteBooking(ctx context.Context, req Del
 	}
 	return mw.BookingService.DeleteBooking(ctx, req)
 }
+
+// FindBookingSeriesByID validates a FindBookingSeriesByIDRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw bookingValidationMiddleware) FindBookingSeriesByID(ctx context.Context, req FindBookingSeriesByIDRequest) FindBookingSeriesByIDResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return FindBookingSeriesByIDResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.BookingService.FindBookingSeriesByID(ctx, req)
+}
+
+// CreateBookingSeries validates a CreateBookingSeriesRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw bookingValidationMiddleware) CreateBookingSeries(ctx context.Context, req CreateBookingSeriesRequest) CreateBookingSeriesResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return CreateBookingSeriesResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.BookingService.CreateBookingSeries(ctx, req)
+}
+
+// ImportBookings validates a ImportBookingsRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw bookingValidationMiddleware) ImportBookings(ctx context.Context, req ImportBookingsRequest) ImportBookingsResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return ImportBookingsResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.BookingService.ImportBookings(ctx, req)
+}
+
+// HoldBooking validates a HoldBookingRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw bookingValidationMiddleware) HoldBooking(ctx context.Context, req HoldBookingRequest) HoldBookingResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return HoldBookingResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.BookingService.HoldBooking(ctx, req)
+}
+
+// ConfirmBooking validates a ConfirmBookingRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw bookingValidationMiddleware) ConfirmBooking(ctx context.Context, req ConfirmBookingRequest) ConfirmBookingResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return ConfirmBookingResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.BookingService.ConfirmBooking(ctx, req)
+}
@@ -69,6 +69,22 @@ func (mw resourceCacheMiddleware) CreateResource(ctx context.Context, req bookin
 	return mw.ResourceService.CreateResource(ctx, req)
 }
 
+// ImportResources creates a resource for each row of an import. Cached lists
+// of resources are removed once any row has been imported.
+func (mw resourceCacheMiddleware) ImportResources(ctx context.Context, req booking.ImportResourcesRequest) booking.ImportResourcesResponse {
+	res := mw.ResourceService.ImportResources(ctx, req)
+	if res.Err != nil || res.Created == 0 {
+		return res
+	}
+
+	match := booking.CacheKey(ctx, "find_resources", "*")
+	err := mw.Cache.RemoveMany(ctx, match)
+	if err != nil {
+		res.Err = fmt.Errorf("could not remove cache keys: %w", err)
+	}
+	return res
+}
+
 // UpdateResource updates an existing resource by ID. Only the resource owner can update a
 // resource. Returns the new resource state even if there was an error during update.
 //
@@ -0,0 +1,207 @@
+package booking
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// CalendarFeedTokenPrefix is prepended to the secret token of every resource
+// calendar feed.
+const CalendarFeedTokenPrefix = "cal_"
+
+// CalendarFeedHistory is how far into the past a calendar feed includes
+// bookings and unavailabilities.
+const CalendarFeedHistory = 90 * 24 * time.Hour
+
+// CalendarImportHorizon is how far into the future recurring events are
+// expanded when a calendar is imported. Later occurrences are picked up by
+// importing the calendar again.
+const CalendarImportHorizon = 365 * 24 * time.Hour
+
+// Calendar event statuses as defined by RFC 5545.
+const (
+	CalendarEventStatusTentative = "TENTATIVE"
+	CalendarEventStatusConfirmed = "CONFIRMED"
+	CalendarEventStatusCancelled = "CANCELLED"
+)
+
+// Calendar represents a collection of events that can be exchanged with other
+// calendar applications, such as an iCalendar feed.
+type Calendar struct {
+	// The name of the calendar shown by calendar applications.
+	Name string `json:"name"`
+
+	Events []*CalendarEvent `json:"events"`
+}
+
+// CalendarEvent represents a single event in a calendar.
+type CalendarEvent struct {
+	// Globally unique identifier of the event. Occurrences of recurring events
+	// that have been imported each have their own UID.
+	UID string `json:"uid"`
+
+	Summary     string `json:"summary"`
+	Description string `json:"description,omitempty"`
+	Status      string `json:"status,omitempty"`
+
+	// The time that the event begins at and the time that it finishes at.
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+
+	// All day events only have a start and end date.
+	AllDay bool `json:"allDay,omitempty"`
+
+	// Timestamps for event creation and last update.
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// CalendarService represents a service for exchanging bookings and
+// unavailabilities with calendar applications.
+type CalendarService interface {
+	// FindResourceCalendar retrieves the bookings and unavailabilities of a
+	// resource as a calendar. The request is authenticated by the feed token of
+	// the resource rather than the current user so that calendar applications
+	// can subscribe to it. Returns EUNAUTHORIZED if the token is invalid.
+	FindResourceCalendar(ctx context.Context, req FindResourceCalendarRequest) FindResourceCalendarResponse
+
+	// CreateResourceFeedToken generates a new feed token for a resource. Any
+	// previous token of the resource stops working. The token is only returned
+	// here.
+	CreateResourceFeedToken(ctx context.Context, req CreateResourceFeedTokenRequest) CreateResourceFeedTokenResponse
+
+	// ImportUnavailabilities creates an unavailability for each busy event of
+	// an iCalendar file. Events that were imported before are updated instead.
+	ImportUnavailabilities(ctx context.Context, req ImportUnavailabilitiesRequest) ImportUnavailabilitiesResponse
+}
+
+// FindResourceCalendarRequest represents a payload used by the FindResourceCalendar method of a CalendarService
+type FindResourceCalendarRequest struct {
+	ResourceID int    `json:"id" source:"url"`
+	Token      string `json:"token" source:"query"`
+}
+
+// Validate a FindResourceCalendar. Returns a ValidationError for each requirement that fails.
+func (r FindResourceCalendarRequest) Validate() []ValidationError {
+	errs := make([]ValidationError, 0)
+	if r.ResourceID < 1 {
+		errs = append(errs, ValidationError{Name: "id", Reason: "Must be at least 1"})
+	}
+	if !strings.HasPrefix(r.Token, CalendarFeedTokenPrefix) {
+		errs = append(errs, ValidationError{Name: "token", Reason: "Must be a valid feed token"})
+	}
+	return errs
+}
+
+// FindResourceCalendarResponse represents a response returned by the FindResourceCalendar method of a CalendarService.
+type FindResourceCalendarResponse struct {
+	*Calendar
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r FindResourceCalendarResponse) Error() error { return r.Err }
+
+// CreateResourceFeedTokenRequest represents a payload used by the CreateResourceFeedToken method of a CalendarService
+type CreateResourceFeedTokenRequest struct {
+	ResourceID int `json:"id" source:"url"`
+}
+
+// Validate a CreateResourceFeedToken. Returns a ValidationError for each requirement that fails.
+func (r CreateResourceFeedTokenRequest) Validate() []ValidationError {
+	if r.ResourceID < 1 {
+		return []ValidationError{
+			{Name: "id", Reason: "Must be at least 1"},
+		}
+	}
+	return nil
+}
+
+// CreateResourceFeedTokenResponse represents a response returned by the CreateResourceFeedToken method of a CalendarService.
+type CreateResourceFeedTokenResponse struct {
+	// The secret token used to subscribe to the calendar feed. It is not
+	// stored and cannot be retrieved again.
+	Token string `json:"token,omitempty"`
+
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r CreateResourceFeedTokenResponse) Error() error { return r.Err }
+
+// ImportUnavailabilitiesRequest represents a payload used by the ImportUnavailabilities method of a CalendarService
+type ImportUnavailabilitiesRequest struct {
+	ResourceID int `json:"resourceId" source:"url"`
+
+	// The contents of an iCalendar (.ics) file.
+	Calendar string `json:"calendar" source:"json"`
+}
+
+// Validate a ImportUnavailabilities. Returns a ValidationError for each requirement that fails.
+func (r ImportUnavailabilitiesRequest) Validate() []ValidationError {
+	errs := make([]ValidationError, 0)
+	if r.ResourceID < 1 {
+		errs = append(errs, ValidationError{Name: "resourceId", Reason: "Must be at least 1"})
+	}
+	if strings.TrimSpace(r.Calendar) == "" {
+		errs = append(errs, ValidationError{Name: "calendar", Reason: "Calendar is required"})
+	}
+	return errs
+}
+
+// ImportUnavailabilitiesResponse represents a response returned by the ImportUnavailabilities method of a CalendarService.
+type ImportUnavailabilitiesResponse struct {
+	// Unavailabilities that were created or updated by the import.
+	Created []*Unavailability `json:"created"`
+	Updated []*Unavailability `json:"updated"`
+
+	// Events of the calendar that could not be imported and the reason why.
+	Skipped []ValidationError `json:"skipped,omitempty"`
+
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r ImportUnavailabilitiesResponse) Error() error { return r.Err }
+
+// CalendarServiceMiddleware defines a middleware for CalendarService
+type CalendarServiceMiddleware func(service CalendarService) CalendarService
+
+// CalendarValidationMiddleware returns a middleware for validating requests made to a CalendarService
+func CalendarValidationMiddleware() CalendarServiceMiddleware {
+	return func(next CalendarService) CalendarService {
+		return calendarValidationMiddleware{next}
+	}
+}
+
+type calendarValidationMiddleware struct {
+	CalendarService
+}
+
+// FindResourceCalendar validates a FindResourceCalendarRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw calendarValidationMiddleware) FindResourceCalendar(ctx context.Context, req FindResourceCalendarRequest) FindResourceCalendarResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return FindResourceCalendarResponse{Err: Errorf(EUNAUTHORIZED, "Invalid calendar feed token.")}
+	}
+	return mw.CalendarService.FindResourceCalendar(ctx, req)
+}
+
+// CreateResourceFeedToken validates a CreateResourceFeedTokenRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw calendarValidationMiddleware) CreateResourceFeedToken(ctx context.Context, req CreateResourceFeedTokenRequest) CreateResourceFeedTokenResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return CreateResourceFeedTokenResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.CalendarService.CreateResourceFeedToken(ctx, req)
+}
+
+// ImportUnavailabilities validates a ImportUnavailabilitiesRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw calendarValidationMiddleware) ImportUnavailabilities(ctx context.Context, req ImportUnavailabilitiesRequest) ImportUnavailabilitiesResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return ImportUnavailabilitiesResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.CalendarService.ImportUnavailabilities(ctx, req)
+}
@@ -0,0 +1,106 @@
+package booking
+
+import (
+	"fmt"
+	"time"
+)
+
+// CancellationPolicy describes how much of a booking is refunded or charged
+// when it is cancelled, depending on how much notice the customer gives, and
+// the fee charged when the customer doesn't show up.
+//
+// For example, a policy that gives a full refund until 48 hours before a
+// booking, half until 24 hours before and nothing after that has two tiers:
+// one with a notice of 48 hours and a refund of 100%, and one with a notice of
+// 24 hours and a refund of 50%.
+type CancellationPolicy struct {
+	// Tiers ordered from the longest notice to the shortest. The first tier
+	// whose notice is met applies. Cancelling with less notice than every
+	// tier gives no refund and charges no fee.
+	Tiers []CancellationTier `json:"tiers"`
+
+	// Percentage of the price of the booking charged when the customer
+	// doesn't show up.
+	NoShowFeePercent int `json:"noShowFeePercent"`
+}
+
+// CancellationTier is a step of a CancellationPolicy.
+type CancellationTier struct {
+	// Minimum number of seconds before the start of the booking that it must
+	// be cancelled for the tier to apply.
+	MinNotice int `json:"minNotice"`
+
+	// Percentage of the deposit paid for the booking that is refunded.
+	RefundPercent int `json:"refundPercent"`
+
+	// Percentage of the price of the booking charged as a late cancellation
+	// fee.
+	FeePercent int `json:"feePercent"`
+}
+
+// CancellationCharges are the amounts refunded and charged when a booking is
+// cancelled or its customer doesn't show up.
+type CancellationCharges struct {
+	Refund int
+	Fee    int
+}
+
+// Cancel returns the charges for cancelling a booking with notice to go
+// before it starts. paid is the amount of the deposit that has been paid and
+// not refunded, and price is the full price of the booking. A nil policy
+// refunds nothing and charges nothing.
+func (p *CancellationPolicy) Cancel(notice time.Duration, paid, price int) CancellationCharges {
+	if p == nil {
+		return CancellationCharges{}
+	}
+	for _, t := range p.Tiers {
+		if notice >= time.Duration(t.MinNotice)*time.Second {
+			return CancellationCharges{
+				Refund: paid * t.RefundPercent / 100,
+				Fee:    price * t.FeePercent / 100,
+			}
+		}
+	}
+	return CancellationCharges{}
+}
+
+// NoShow returns the charges for a customer not showing up to a booking with
+// the given price.
+func (p *CancellationPolicy) NoShow(price int) CancellationCharges {
+	if p == nil {
+		return CancellationCharges{}
+	}
+	return CancellationCharges{Fee: price * p.NoShowFeePercent / 100}
+}
+
+// Validate a CancellationPolicy. Returns a ValidationError for each
+// requirement that fails. name is the name of the field holding the policy.
+func (p *CancellationPolicy) Validate(name string) []ValidationError {
+	if p == nil {
+		return nil
+	}
+	var errs []ValidationError
+	for i, t := range p.Tiers {
+		tier := fmt.Sprintf("%s.tiers[%d]", name, i)
+		if t.MinNotice < 0 {
+			errs = append(errs, ValidationError{Name: tier + ".minNotice", Reason: "Cannot be less than 0"})
+		}
+		if i > 0 && t.MinNotice >= p.Tiers[i-1].MinNotice {
+			errs = append(errs, ValidationError{Name: tier + ".minNotice", Reason: "Must be less than the notice of the previous tier"})
+		}
+		if !validPercent(t.RefundPercent) {
+			errs = append(errs, ValidationError{Name: tier + ".refundPercent", Reason: "Must be between 0 and 100"})
+		}
+		if !validPercent(t.FeePercent) {
+			errs = append(errs, ValidationError{Name: tier + ".feePercent", Reason: "Must be between 0 and 100"})
+		}
+	}
+	if !validPercent(p.NoShowFeePercent) {
+		errs = append(errs, ValidationError{Name: name + ".noShowFeePercent", Reason: "Must be between 0 and 100"})
+	}
+	return errs
+}
+
+func validPercent(v int) bool {
+	return v >= 0 && v <= 100
+}
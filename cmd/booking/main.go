@@ -10,6 +10,9 @@ import (
 	"os/user"
 	"path/filepath"
 	"strings"
+	// Embed the IANA timezone database so that resource timezones can be
+	// loaded on hosts without one installed.
+	_ "time/tzdata"
 
 	"github.com/go-kit/kit/log"
 	goredis "github.com/go-redis/redis/v8"
@@ -17,10 +20,14 @@ import (
 	"github.com/openmesh/booking/cache"
 	"github.com/openmesh/booking/ent"
 	"github.com/openmesh/booking/ent/migrate"
+	"github.com/openmesh/booking/event"
+	"github.com/openmesh/booking/hold"
 	"github.com/openmesh/booking/http"
 	"github.com/openmesh/booking/metrics"
 	"github.com/openmesh/booking/oauth"
 	"github.com/openmesh/booking/redis"
+	"github.com/openmesh/booking/stripe"
+	"github.com/openmesh/booking/webhook"
 	"github.com/pelletier/go-toml"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/github"
@@ -165,15 +172,6 @@ func (m *Main) Run(ctx context.Context) (err error) {
 	// 	log.Printf("rollbar error tracking enabled")
 	// }
 
-	// Initialize event service for real-time events.
-	// We are using an in-memory implementation but this could be changed to
-	// a more robust service if we expanded out to multiple nodes.
-
-	// eventService := inmem.NewEventService()
-
-	// Attach our event service to the SQLite database so it can publish events.
-	// m.DB.EventService = eventService
-
 	// Expand the DSN (in case it is in the user home directory ("~")).
 	// Then open the database. This will instantiate the SQLite connection
 	// and execute any pending migration files.
@@ -189,6 +187,30 @@ func (m *Main) Run(ctx context.Context) (err error) {
 		return fmt.Errorf("failed creating schema resources: %v", err)
 	}
 
+	// Resources created before IANA timezones were supported store a fixed UTC
+	// offset. Convert them now so that they follow daylight saving time.
+	if _, err := ent.MigrateResourceTimezones(ctx, m.Client); err != nil {
+		return fmt.Errorf("failed migrating resource timezones: %v", err)
+	}
+
+	// Bookings made before prices were stored on bookings are given the price
+	// of their resource so that reports keep counting them.
+	if _, err := ent.MigrateBookingPrices(ctx, m.Client); err != nil {
+		return fmt.Errorf("failed migrating booking prices: %v", err)
+	}
+
+	// Resource passwords used to be stored in plaintext. Hash any that still
+	// are before they can be checked against.
+	if _, err := ent.MigrateResourcePasswords(ctx, m.Client); err != nil {
+		return fmt.Errorf("failed migrating resource passwords: %v", err)
+	}
+
+	// Customers used to only be recorded in booking metadata. Link those
+	// bookings to customers, creating them from the metadata as needed.
+	if _, err := ent.MigrateBookingCustomers(ctx, m.Client); err != nil {
+		return fmt.Errorf("failed migrating booking customers: %v", err)
+	}
+
 	// Create dependencies used by service middlewares
 	var logger log.Logger
 	{
@@ -223,19 +245,54 @@ func (m *Main) Run(ctx context.Context) (err error) {
 		DB:       m.Config.Redis.DB,
 	})
 
+	// Deliver events to the webhooks registered by organizations. Deliveries
+	// are stored when events are published so the dispatcher can send them in
+	// the background and retry failures.
+	webhookDispatcher := webhook.NewDispatcher(ent.NewWebhookDeliveryService(m.Client))
+	webhookDispatcher.Logger = log.With(logger, "component", "webhook")
+	go webhookDispatcher.Run(ctx)
+
+	// Initialize event service for real-time events. Events are distributed
+	// with Redis pub/sub so that subscribers receive events published by any
+	// node. inmem.NewEventService() can be used instead for a single node.
+	var eventService booking.EventService
+	{
+		eventService = redis.NewEventService(&goredis.Options{
+			Addr:     m.Config.Redis.Addr,
+			Password: m.Config.Redis.Password,
+			DB:       m.Config.Redis.DB,
+		})
+		eventService = webhook.NewEventService(eventService, webhookDispatcher)
+	}
+
+	// Release held bookings that were not confirmed in time.
+	holdExpirer := hold.NewExpirer(ent.NewBookingHoldService(m.Client), eventService)
+	holdExpirer.Logger = log.With(logger, "component", "hold")
+	go holdExpirer.Run(ctx)
+
+	// Take deposits through Stripe if it has been configured. Bookings are
+	// confirmed without payment otherwise. inmem.NewPaymentProvider() can be
+	// used instead for development.
+	var paymentProvider booking.PaymentProvider
+	if m.Config.Stripe.SecretKey != "" {
+		paymentProvider = stripe.NewPaymentProvider(m.Config.Stripe.SecretKey, m.Config.Stripe.WebhookSecret)
+	}
+
 	// Instantiate ent-backed services.
 	// authService := ent.NewAuthService(m.Client)
 	var resourceService booking.ResourceService
 	{
 		resourceService = ent.NewResourceService(m.Client)
 		resourceService = cache.ResourceCacheMiddleware(redisCache)(resourceService)
+		resourceService = event.ResourceEventMiddleware(eventService)(resourceService)
 		resourceService = booking.ResourceValidationMiddleware()(resourceService)
 		resourceService = logging.ResourceLoggingMiddleware(logger)(resourceService)
 		resourceService = metrics.ResourceMetricsMiddleware(requestCount, errorCount, requestDuration)(resourceService)
 	}
 	var bookingService booking.BookingService
 	{
-		bookingService = ent.NewBookingService(m.Client)
+		bookingService = ent.NewBookingService(m.Client, paymentProvider)
+		bookingService = event.BookingMiddleware(eventService)(bookingService)
 		bookingService = booking.BookingValidationMiddleware()(bookingService)
 		bookingService = logging.BookingLoggingMiddleware(logger)(bookingService)
 		bookingService = metrics.BookingMetricsMiddleware(requestCount, errorCount, requestDuration)(bookingService)
@@ -243,14 +300,37 @@ func (m *Main) Run(ctx context.Context) (err error) {
 	var organizationService booking.OrganizationService
 	{
 		organizationService = ent.NewOrganizationService(m.Client)
+		organizationService = event.OrganizationEventMiddleware(eventService)(organizationService)
 		organizationService = logging.OrganizationLoggingMiddleware(logger)(organizationService)
 	}
 	var unavailabilityService booking.UnavailabilityService
 	{
 		unavailabilityService = ent.NewUnavailabilityService(m.Client)
+		unavailabilityService = event.UnavailabilityEventMiddleware(eventService)(unavailabilityService)
 		unavailabilityService = logging.UnavailabilityLoggingMiddleware(logger)(unavailabilityService)
 		unavailabilityService = metrics.UnavailabilityMetricsMiddleware(requestCount, errorCount, requestDuration)(unavailabilityService)
 	}
+	var availabilityService booking.AvailabilityService
+	{
+		availabilityService = ent.NewAvailabilityService(m.Client)
+		availabilityService = booking.AvailabilityValidationMiddleware()(availabilityService)
+		availabilityService = logging.AvailabilityLoggingMiddleware(logger)(availabilityService)
+		availabilityService = metrics.AvailabilityMetricsMiddleware(requestCount, errorCount, requestDuration)(availabilityService)
+	}
+	var pricingService booking.PricingService
+	{
+		pricingService = ent.NewPricingService(m.Client)
+		pricingService = booking.PricingValidationMiddleware()(pricingService)
+		pricingService = logging.PricingLoggingMiddleware(logger)(pricingService)
+		pricingService = metrics.PricingMetricsMiddleware(requestCount, errorCount, requestDuration)(pricingService)
+	}
+	var reportService booking.ReportService
+	{
+		reportService = ent.NewReportService(m.Client)
+		reportService = booking.ReportValidationMiddleware()(reportService)
+		reportService = logging.ReportLoggingMiddleware(logger)(reportService)
+		reportService = metrics.ReportMetricsMiddleware(requestCount, errorCount, requestDuration)(reportService)
+	}
 	var userService booking.UserService
 	{
 		userService = ent.NewUserService(m.Client)
@@ -261,10 +341,72 @@ func (m *Main) Run(ctx context.Context) (err error) {
 		authService = logging.AuthLoggingMiddleware(logger)(authService)
 		authService = metrics.AuthMetricsMiddleware(requestCount, errorCount, requestDuration)(authService)
 	}
-	// var tokenService booking.TokenService
-	// {
-
-	// }
+	var tokenService booking.TokenService
+	{
+		tokenService = ent.NewTokenService(m.Client)
+		tokenService = booking.TokenValidationMiddleware()(tokenService)
+		tokenService = logging.TokenLoggingMiddleware(logger)(tokenService)
+		tokenService = metrics.TokenMetricsMiddleware(requestCount, errorCount, requestDuration)(tokenService)
+	}
+	var webhookService booking.WebhookService
+	{
+		webhookService = ent.NewWebhookService(m.Client)
+		webhookService = booking.WebhookValidationMiddleware()(webhookService)
+		webhookService = logging.WebhookLoggingMiddleware(logger)(webhookService)
+		webhookService = metrics.WebhookMetricsMiddleware(requestCount, errorCount, requestDuration)(webhookService)
+	}
+	var promoCodeService booking.PromoCodeService
+	{
+		promoCodeService = ent.NewPromoCodeService(m.Client)
+		promoCodeService = booking.PromoCodeValidationMiddleware()(promoCodeService)
+		promoCodeService = logging.PromoCodeLoggingMiddleware(logger)(promoCodeService)
+		promoCodeService = metrics.PromoCodeMetricsMiddleware(requestCount, errorCount, requestDuration)(promoCodeService)
+	}
+	var taxRateService booking.TaxRateService
+	{
+		taxRateService = ent.NewTaxRateService(m.Client)
+		taxRateService = booking.TaxRateValidationMiddleware()(taxRateService)
+		taxRateService = logging.TaxRateLoggingMiddleware(logger)(taxRateService)
+		taxRateService = metrics.TaxRateMetricsMiddleware(requestCount, errorCount, requestDuration)(taxRateService)
+	}
+	var customerService booking.CustomerService
+	{
+		customerService = ent.NewCustomerService(m.Client)
+		customerService = booking.CustomerValidationMiddleware()(customerService)
+		customerService = logging.CustomerLoggingMiddleware(logger)(customerService)
+		customerService = metrics.CustomerMetricsMiddleware(requestCount, errorCount, requestDuration)(customerService)
+	}
+	var invoiceService booking.InvoiceService
+	{
+		invoiceService = ent.NewInvoiceService(m.Client)
+		invoiceService = booking.InvoiceValidationMiddleware()(invoiceService)
+		invoiceService = logging.InvoiceLoggingMiddleware(logger)(invoiceService)
+		invoiceService = metrics.InvoiceMetricsMiddleware(requestCount, errorCount, requestDuration)(invoiceService)
+	}
+	var waitlistService booking.WaitlistService
+	{
+		waitlistService = ent.NewWaitlistService(m.Client)
+		waitlistService = event.WaitlistEventMiddleware(eventService)(waitlistService)
+		waitlistService = booking.WaitlistValidationMiddleware()(waitlistService)
+		waitlistService = logging.WaitlistLoggingMiddleware(logger)(waitlistService)
+		waitlistService = metrics.WaitlistMetricsMiddleware(requestCount, errorCount, requestDuration)(waitlistService)
+	}
+	var paymentService booking.PaymentService
+	{
+		paymentService = ent.NewPaymentService(m.Client, paymentProvider)
+		paymentService = event.PaymentEventMiddleware(eventService)(paymentService)
+		paymentService = booking.PaymentValidationMiddleware()(paymentService)
+		paymentService = logging.PaymentLoggingMiddleware(logger)(paymentService)
+		paymentService = metrics.PaymentMetricsMiddleware(requestCount, errorCount, requestDuration)(paymentService)
+	}
+	var calendarService booking.CalendarService
+	{
+		calendarService = ent.NewCalendarService(m.Client)
+		calendarService = event.CalendarEventMiddleware(eventService)(calendarService)
+		calendarService = booking.CalendarValidationMiddleware()(calendarService)
+		calendarService = logging.CalendarLoggingMiddleware(logger)(calendarService)
+		calendarService = metrics.CalendarMetricsMiddleware(requestCount, errorCount, requestDuration)(calendarService)
+	}
 	var oauthService booking.OAuthService
 	{
 		oauthService = oauth.NewOAuthService(authService, map[string]*oauth2.Config{
@@ -292,18 +434,32 @@ func (m *Main) Run(ctx context.Context) (err error) {
 	m.HTTPServer.Domain = m.Config.HTTP.Domain
 	m.HTTPServer.HashKey = m.Config.HTTP.HashKey
 	m.HTTPServer.BlockKey = m.Config.HTTP.BlockKey
+	m.HTTPServer.PublicRateLimit = m.Config.HTTP.PublicRateLimit
+	m.HTTPServer.PublicBookingRateLimit = m.Config.HTTP.PublicBookingRateLimit
 	m.HTTPServer.GitHubClientID = m.Config.GitHub.ClientID
 	m.HTTPServer.GitHubClientSecret = m.Config.GitHub.ClientSecret
 
 	// Attach underlying services to the HTTP server.
 	// m.HTTPServer.AuthService = authService
+	m.HTTPServer.AvailabilityService = availabilityService
 	m.HTTPServer.BookingService = bookingService
+	m.HTTPServer.CalendarService = calendarService
 	m.HTTPServer.OAuthService = oauthService
 	m.HTTPServer.OrganizationService = organizationService
+	m.HTTPServer.ReportService = reportService
 	m.HTTPServer.ResourceService = resourceService
+	m.HTTPServer.TokenService = tokenService
 	m.HTTPServer.UnavailabilityService = unavailabilityService
+	m.HTTPServer.PaymentService = paymentService
+	m.HTTPServer.PricingService = pricingService
+	m.HTTPServer.PromoCodeService = promoCodeService
+	m.HTTPServer.TaxRateService = taxRateService
+	m.HTTPServer.CustomerService = customerService
+	m.HTTPServer.InvoiceService = invoiceService
 	m.HTTPServer.UserService = userService
-	// m.HTTPServer.EventService = eventService
+	m.HTTPServer.WaitlistService = waitlistService
+	m.HTTPServer.WebhookService = webhookService
+	m.HTTPServer.EventService = eventService
 	// m.HTTPServer.UserService = userService
 
 	// Attach logger to server.
@@ -350,6 +506,11 @@ type Config struct {
 		Domain   string `toml:"domain"`
 		HashKey  string `toml:"hash-key"`
 		BlockKey string `toml:"block-key"`
+
+		// Requests and bookings allowed per minute for each client of the
+		// public API. Zero disables the limit.
+		PublicRateLimit        int `toml:"public-rate-limit"`
+		PublicBookingRateLimit int `toml:"public-booking-rate-limit"`
 	} `toml:"http"`
 
 	GoogleAnalytics struct {
@@ -370,12 +531,19 @@ type Config struct {
 		Password string `toml:"password"`
 		DB       int    `toml:"db"`
 	}
+
+	Stripe struct {
+		SecretKey     string `toml:"secret-key"`
+		WebhookSecret string `toml:"webhook-secret"`
+	} `toml:"stripe"`
 }
 
 // DefaultConfig returns a new instance of Config with defaults set.
 func DefaultConfig() Config {
 	var config Config
 	config.DB.DSN = DefaultDSN
+	config.HTTP.PublicRateLimit = http.DefaultPublicRateLimit
+	config.HTTP.PublicBookingRateLimit = http.DefaultPublicBookingRateLimit
 	return config
 }
 
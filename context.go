@@ -16,6 +16,12 @@ const (
 	// Stores the current organization in the context.
 	organizationContextKey
 
+	// Stores the scopes granted to the API token used to authenticate.
+	scopesContextKey
+
+	// Stores whether the request was made through the public API.
+	publicContextKey
+
 	// Stores the "flash" in the context. This is a term used in web development
 	// for a message that is passed from one request to the next for informational
 	// purposes. This could be moved into the "http" package as it is only HTTP
@@ -64,6 +70,42 @@ func OrganizationIDFromContext(ctx context.Context) int {
 	return 0
 }
 
+// NewContextWithScopes returns a new context restricted to the given scopes.
+func NewContextWithScopes(ctx context.Context, scopes []string) context.Context {
+	return context.WithValue(ctx, scopesContextKey, scopes)
+}
+
+// ScopesFromContext returns the scopes that the current request is restricted
+// to. Returns false if the request is not restricted, e.g. when authenticated
+// with a session cookie rather than an API token.
+func ScopesFromContext(ctx context.Context) ([]string, bool) {
+	scopes, ok := ctx.Value(scopesContextKey).([]string)
+	return scopes, ok
+}
+
+// HasScope returns true if the current request has been granted scope.
+// Requests that are not restricted to a set of scopes have every scope.
+func HasScope(ctx context.Context, scope string) bool {
+	scopes, ok := ScopesFromContext(ctx)
+	if !ok {
+		return true
+	}
+	return Strings(scopes).contains(scope)
+}
+
+// NewContextWithPublic returns a new context that marks whether the request
+// was made through the public API by an unauthenticated customer.
+func NewContextWithPublic(ctx context.Context, public bool) context.Context {
+	return context.WithValue(ctx, publicContextKey, public)
+}
+
+// PublicFromContext returns true if the current request was made through the
+// public API.
+func PublicFromContext(ctx context.Context) bool {
+	public, _ := ctx.Value(publicContextKey).(bool)
+	return public
+}
+
 // NewContextWithFlash returns a new context with the given flash value.
 func NewContextWithFlash(ctx context.Context, v string) context.Context {
 	return context.WithValue(ctx, flashContextKey, v)
@@ -0,0 +1,465 @@
+package booking
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+// Customer represents a person that makes bookings with an organization.
+// Customers are identified by their email address, which is unique within an
+// organization when it is set.
+type Customer struct {
+	ID int `json:"id"`
+
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	Phone string `json:"phone"`
+
+	// Notes that members of the organization keep about the customer.
+	Notes string `json:"notes"`
+
+	// Labels used to group customers, e.g. "vip".
+	Tags []string `json:"tags"`
+
+	// Timestamps for customer creation and last update.
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// CustomerLifetimeValue is the value of every booking that a customer has
+// made that counts as a sale. Revenue is totalled separately for each
+// currency.
+type CustomerLifetimeValue struct {
+	CustomerID int         `json:"customerId"`
+	Bookings   int         `json:"bookings"`
+	Revenue    TaxedTotals `json:"revenue"`
+
+	// The start times of the first and the most recent sale. Nil if the
+	// customer hasn't made any.
+	FirstBookingAt *time.Time `json:"firstBookingAt"`
+	LastBookingAt  *time.Time `json:"lastBookingAt"`
+}
+
+// NormalizeCustomerEmail returns the form of an email address that customers
+// are stored and matched under.
+func NormalizeCustomerEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// The metadata keys that the details of a customer are read from when a
+// booking is made without a customer. Keys are matched regardless of case and
+// of any punctuation, so "Email", "customer_email" and "customerEmail" all
+// give the email of the customer.
+var (
+	customerEmailMetadataKeys     = []string{"email", "emailaddress", "customeremail"}
+	customerNameMetadataKeys      = []string{"name", "fullname", "customername"}
+	customerFirstNameMetadataKeys = []string{"firstname", "givenname"}
+	customerLastNameMetadataKeys  = []string{"lastname", "surname", "familyname"}
+	customerPhoneMetadataKeys     = []string{"phone", "phonenumber", "telephone", "customerphone"}
+)
+
+// CustomerFromMetadata returns the customer described by the metadata of a
+// booking. Returns false if the metadata doesn't contain a valid email
+// address, which customers are matched by.
+func CustomerFromMetadata(metadata map[string]string) (CreateCustomerRequest, bool) {
+	values := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		if v = strings.TrimSpace(v); v != "" {
+			values[normalizeMetadataKey(k)] = v
+		}
+	}
+	lookup := func(keys []string) string {
+		for _, k := range keys {
+			if v, ok := values[k]; ok {
+				return v
+			}
+		}
+		return ""
+	}
+
+	email := NormalizeCustomerEmail(lookup(customerEmailMetadataKeys))
+	if !validEmail(email) {
+		return CreateCustomerRequest{}, false
+	}
+	name := lookup(customerNameMetadataKeys)
+	if name == "" {
+		name = strings.TrimSpace(lookup(customerFirstNameMetadataKeys) + " " + lookup(customerLastNameMetadataKeys))
+	}
+	return CreateCustomerRequest{
+		Name:  name,
+		Email: email,
+		Phone: lookup(customerPhoneMetadataKeys),
+	}, true
+}
+
+// normalizeMetadataKey returns key in lower case without any characters other
+// than letters and digits.
+func normalizeMetadataKey(key string) string {
+	var b strings.Builder
+	for _, c := range strings.ToLower(key) {
+		if (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') {
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
+// validEmail returns true if email is a bare email address such as
+// jane@example.com.
+func validEmail(email string) bool {
+	addr, err := mail.ParseAddress(email)
+	return err == nil && addr.Address == email
+}
+
+// CustomerService represents a service for managing the customers of an
+// organization.
+type CustomerService interface {
+	// FindCustomerByID retrieves a single customer by ID. Returns
+	// ECUSTOMERNOTFOUND if the customer does not exist or user does not have
+	// permission to view it.
+	FindCustomerByID(ctx context.Context, req FindCustomerByIDRequest) FindCustomerByIDResponse
+
+	// FindCustomers searches the customers of the current organization.
+	FindCustomers(ctx context.Context, req FindCustomersRequest) FindCustomersResponse
+
+	// CreateCustomer creates a new customer for the current organization.
+	// Returns ECUSTOMERCONFLICT if another customer has the same email.
+	CreateCustomer(ctx context.Context, req CreateCustomerRequest) CreateCustomerResponse
+
+	// UpdateCustomer updates an existing customer by ID. Returns
+	// ECUSTOMERCONFLICT if another customer has the same email.
+	UpdateCustomer(ctx context.Context, req UpdateCustomerRequest) UpdateCustomerResponse
+
+	// DeleteCustomer permanently removes a customer. Their bookings are kept
+	// without a customer.
+	DeleteCustomer(ctx context.Context, req DeleteCustomerRequest) DeleteCustomerResponse
+
+	// FindCustomerBookings retrieves the bookings made by a customer, most
+	// recent first.
+	FindCustomerBookings(ctx context.Context, req FindCustomerBookingsRequest) FindCustomerBookingsResponse
+
+	// GetCustomerLifetimeValue returns the value of every booking made by a
+	// customer that counts as a sale.
+	GetCustomerLifetimeValue(ctx context.Context, req GetCustomerLifetimeValueRequest) GetCustomerLifetimeValueResponse
+}
+
+// FindCustomerByIDRequest represents a payload used by the FindCustomerByID method of a CustomerService
+type FindCustomerByIDRequest struct {
+	ID int `json:"id" source:"url"`
+}
+
+// Validate a FindCustomerByIDRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r FindCustomerByIDRequest) Validate() []ValidationError {
+	if r.ID < 1 {
+		return []ValidationError{{Name: "id", Reason: "Must be at least 1"}}
+	}
+	return nil
+}
+
+// FindCustomerByIDResponse represents a response returned by the FindCustomerByID method of a CustomerService.
+type FindCustomerByIDResponse struct {
+	*Customer
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r FindCustomerByIDResponse) Error() error { return r.Err }
+
+// FindCustomersRequest represents a payload used by the FindCustomers method of a CustomerService
+type FindCustomersRequest struct {
+	// Filtering fields. Query matches customers whose name, email or phone
+	// contains it regardless of case.
+	Query *string `json:"query" source:"query"`
+	Tag   *string `json:"tag" source:"query"`
+
+	// Restrict to subset of range.
+	Offset int `json:"offset" source:"query"`
+	Limit  int `json:"limit" source:"query"`
+
+	// Customer property to order by.
+	OrderBy *string `json:"orderBy" source:"query"`
+}
+
+// Validate a FindCustomersRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r FindCustomersRequest) Validate() []ValidationError {
+	errs := make([]ValidationError, 0)
+	if r.Offset < 0 {
+		errs = append(errs, ValidationError{Name: "offset", Reason: "Must be greater than or equal to 0"})
+	}
+	if r.Limit < 0 {
+		errs = append(errs, ValidationError{Name: "limit", Reason: "Must be greater than or equal to 0"})
+	}
+	validOrderByValues := []string{"name", "email", "createdAt", "updatedAt"}
+	if r.OrderBy != nil && !Strings(validOrderByValues).contains(*r.OrderBy) {
+		errs = append(errs, ValidationError{Name: "orderBy", Reason: "Must be a valid property name"})
+	}
+	return errs
+}
+
+// FindCustomersResponse represents a response returned by the FindCustomers method of a CustomerService.
+type FindCustomersResponse struct {
+	Customers  []*Customer `json:"customers"`
+	TotalItems int         `json:"totalItems"`
+	Err        error       `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r FindCustomersResponse) Error() error { return r.Err }
+
+// CreateCustomerRequest represents a payload used by the CreateCustomer method of a CustomerService
+type CreateCustomerRequest struct {
+	Name  string   `json:"name" source:"json"`
+	Email string   `json:"email" source:"json"`
+	Phone string   `json:"phone" source:"json"`
+	Notes string   `json:"notes" source:"json"`
+	Tags  []string `json:"tags" source:"json"`
+}
+
+// Validate a CreateCustomerRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r CreateCustomerRequest) Validate() []ValidationError {
+	return validateCustomer(r.Name, r.Email, r.Phone, r.Tags)
+}
+
+// CreateCustomerResponse represents a response returned by the CreateCustomer method of a CustomerService.
+type CreateCustomerResponse struct {
+	*Customer
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r CreateCustomerResponse) Error() error { return r.Err }
+
+// UpdateCustomerRequest represents a payload used by the UpdateCustomer method of a CustomerService
+type UpdateCustomerRequest struct {
+	ID    int      `json:"id" source:"url"`
+	Name  string   `json:"name" source:"json"`
+	Email string   `json:"email" source:"json"`
+	Phone string   `json:"phone" source:"json"`
+	Notes string   `json:"notes" source:"json"`
+	Tags  []string `json:"tags" source:"json"`
+}
+
+// Validate an UpdateCustomerRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r UpdateCustomerRequest) Validate() []ValidationError {
+	errs := make([]ValidationError, 0)
+	if r.ID < 1 {
+		errs = append(errs, ValidationError{Name: "id", Reason: "Must be at least 1"})
+	}
+	return append(errs, validateCustomer(r.Name, r.Email, r.Phone, r.Tags)...)
+}
+
+// UpdateCustomerResponse represents a response returned by the UpdateCustomer method of a CustomerService.
+type UpdateCustomerResponse struct {
+	*Customer
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r UpdateCustomerResponse) Error() error { return r.Err }
+
+// DeleteCustomerRequest represents a payload used by the DeleteCustomer method of a CustomerService
+type DeleteCustomerRequest struct {
+	ID int `json:"id" source:"url"`
+}
+
+// Validate a DeleteCustomerRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r DeleteCustomerRequest) Validate() []ValidationError {
+	if r.ID < 1 {
+		return []ValidationError{{Name: "id", Reason: "Must be at least 1"}}
+	}
+	return nil
+}
+
+// DeleteCustomerResponse represents a response returned by the DeleteCustomer method of a CustomerService.
+type DeleteCustomerResponse struct {
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r DeleteCustomerResponse) Error() error { return r.Err }
+
+// FindCustomerBookingsRequest represents a payload used by the FindCustomerBookings method of a CustomerService
+type FindCustomerBookingsRequest struct {
+	ID int `json:"id" source:"url"`
+
+	// Restrict to subset of range.
+	Offset int `json:"offset" source:"query"`
+	Limit  int `json:"limit" source:"query"`
+}
+
+// Validate a FindCustomerBookingsRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r FindCustomerBookingsRequest) Validate() []ValidationError {
+	errs := make([]ValidationError, 0)
+	if r.ID < 1 {
+		errs = append(errs, ValidationError{Name: "id", Reason: "Must be at least 1"})
+	}
+	if r.Offset < 0 {
+		errs = append(errs, ValidationError{Name: "offset", Reason: "Must be greater than or equal to 0"})
+	}
+	if r.Limit < 0 {
+		errs = append(errs, ValidationError{Name: "limit", Reason: "Must be greater than or equal to 0"})
+	}
+	return errs
+}
+
+// FindCustomerBookingsResponse represents a response returned by the FindCustomerBookings method of a CustomerService.
+type FindCustomerBookingsResponse struct {
+	Bookings   []*Booking `json:"bookings"`
+	TotalItems int        `json:"totalItems"`
+	Err        error      `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r FindCustomerBookingsResponse) Error() error { return r.Err }
+
+// GetCustomerLifetimeValueRequest represents a payload used by the GetCustomerLifetimeValue method of a CustomerService
+type GetCustomerLifetimeValueRequest struct {
+	ID int `json:"id" source:"url"`
+}
+
+// Validate a GetCustomerLifetimeValueRequest. Returns a ValidationError for
+// each requirement that fails.
+func (r GetCustomerLifetimeValueRequest) Validate() []ValidationError {
+	if r.ID < 1 {
+		return []ValidationError{{Name: "id", Reason: "Must be at least 1"}}
+	}
+	return nil
+}
+
+// GetCustomerLifetimeValueResponse represents a response returned by the GetCustomerLifetimeValue method of a CustomerService.
+type GetCustomerLifetimeValueResponse struct {
+	*CustomerLifetimeValue
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r GetCustomerLifetimeValueResponse) Error() error { return r.Err }
+
+// maxCustomerTagLength is the longest tag that a customer can be given.
+const maxCustomerTagLength = 32
+
+func validateCustomer(name, email, phone string, tags []string) []ValidationError {
+	errs := make([]ValidationError, 0)
+	name, email = strings.TrimSpace(name), NormalizeCustomerEmail(email)
+	if name == "" && email == "" {
+		errs = append(errs, ValidationError{Name: "name", Reason: "Name or email is required"})
+	}
+	if len(name) > 128 {
+		errs = append(errs, ValidationError{Name: "name", Reason: "Must be no more than 128 characters"})
+	}
+	if email != "" && !validEmail(email) {
+		errs = append(errs, ValidationError{Name: "email", Reason: "Must be a valid email address"})
+	}
+	if len(strings.TrimSpace(phone)) > 32 {
+		errs = append(errs, ValidationError{Name: "phone", Reason: "Must be no more than 32 characters"})
+	}
+	for i, t := range tags {
+		t = strings.TrimSpace(t)
+		if t == "" || len(t) > maxCustomerTagLength {
+			errs = append(errs, ValidationError{
+				Name:   fmt.Sprintf("tags[%d]", i),
+				Reason: fmt.Sprintf("Must be between 1 and %d characters", maxCustomerTagLength),
+			})
+		}
+	}
+	return errs
+}
+
+// NormalizeCustomerTags returns tags without surrounding whitespace or
+// duplicates, in the order that they were first given.
+func NormalizeCustomerTags(tags []string) []string {
+	result := make([]string, 0, len(tags))
+	for _, t := range tags {
+		t = strings.TrimSpace(t)
+		if t != "" && !Strings(result).contains(t) {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+// CustomerServiceMiddleware defines a middleware for CustomerService
+type CustomerServiceMiddleware func(service CustomerService) CustomerService
+
+// CustomerValidationMiddleware returns a middleware for validating requests made to a CustomerService
+func CustomerValidationMiddleware() CustomerServiceMiddleware {
+	return func(next CustomerService) CustomerService {
+		return customerValidationMiddleware{next}
+	}
+}
+
+type customerValidationMiddleware struct {
+	CustomerService
+}
+
+// FindCustomerByID validates a FindCustomerByIDRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw customerValidationMiddleware) FindCustomerByID(ctx context.Context, req FindCustomerByIDRequest) FindCustomerByIDResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return FindCustomerByIDResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.CustomerService.FindCustomerByID(ctx, req)
+}
+
+// FindCustomers validates a FindCustomersRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw customerValidationMiddleware) FindCustomers(ctx context.Context, req FindCustomersRequest) FindCustomersResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return FindCustomersResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.CustomerService.FindCustomers(ctx, req)
+}
+
+// CreateCustomer validates a CreateCustomerRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw customerValidationMiddleware) CreateCustomer(ctx context.Context, req CreateCustomerRequest) CreateCustomerResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return CreateCustomerResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.CustomerService.CreateCustomer(ctx, req)
+}
+
+// UpdateCustomer validates an UpdateCustomerRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw customerValidationMiddleware) UpdateCustomer(ctx context.Context, req UpdateCustomerRequest) UpdateCustomerResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return UpdateCustomerResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.CustomerService.UpdateCustomer(ctx, req)
+}
+
+// DeleteCustomer validates a DeleteCustomerRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw customerValidationMiddleware) DeleteCustomer(ctx context.Context, req DeleteCustomerRequest) DeleteCustomerResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return DeleteCustomerResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.CustomerService.DeleteCustomer(ctx, req)
+}
+
+// FindCustomerBookings validates a FindCustomerBookingsRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw customerValidationMiddleware) FindCustomerBookings(ctx context.Context, req FindCustomerBookingsRequest) FindCustomerBookingsResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return FindCustomerBookingsResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.CustomerService.FindCustomerBookings(ctx, req)
+}
+
+// GetCustomerLifetimeValue validates a GetCustomerLifetimeValueRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw customerValidationMiddleware) GetCustomerLifetimeValue(ctx context.Context, req GetCustomerLifetimeValueRequest) GetCustomerLifetimeValueResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return GetCustomerLifetimeValueResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.CustomerService.GetCustomerLifetimeValue(ctx, req)
+}
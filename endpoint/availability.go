@@ -0,0 +1,30 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/openmesh/booking"
+)
+
+// AvailabilityEndpoints collects all the endpoints that compose a booking.AvailabilityService.
+// It's used as a helper struct, to collect all the endpoints into a single
+// parameter.
+type AvailabilityEndpoints struct {
+	FindAvailabilitiesEndpoint endpoint.Endpoint
+}
+
+// MakeAvailabilityEndpoints returns an AvailabilityEndpoints struct where each
+// endpoint invokes the corresponding method on the provided service.
+func MakeAvailabilityEndpoints(s booking.AvailabilityService) AvailabilityEndpoints {
+	return AvailabilityEndpoints{
+		FindAvailabilitiesEndpoint: MakeFindAvailabilitiesEndpoint(s),
+	}
+}
+
+// MakeFindAvailabilitiesEndpoint returns an endpoint via the passed service.
+func MakeFindAvailabilitiesEndpoint(s booking.AvailabilityService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.FindAvailabilities(ctx, r.(booking.FindAvailabilitiesRequest)), nil
+	}
+}
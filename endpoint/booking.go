@@ -11,22 +11,32 @@ import (
 // It's used as a helper struct, to collect all the endpoints into a single
 // parameter.
 type BookingEndpoints struct {
-	FindBookingByIDEndpoint endpoint.Endpoint
-	FindBookingsEndpoint    endpoint.Endpoint
-	CreateBookingEndpoint   endpoint.Endpoint
-	UpdateBookingEndpoint   endpoint.Endpoint
-	DeleteBookingEndpoint   endpoint.Endpoint
+	FindBookingByIDEndpoint       endpoint.Endpoint
+	FindBookingsEndpoint          endpoint.Endpoint
+	CreateBookingEndpoint         endpoint.Endpoint
+	UpdateBookingEndpoint         endpoint.Endpoint
+	DeleteBookingEndpoint         endpoint.Endpoint
+	FindBookingSeriesByIDEndpoint endpoint.Endpoint
+	CreateBookingSeriesEndpoint   endpoint.Endpoint
+	ImportBookingsEndpoint        endpoint.Endpoint
+	HoldBookingEndpoint           endpoint.Endpoint
+	ConfirmBookingEndpoint        endpoint.Endpoint
 }
 
 // MakeBookingEndpoints returns a BookingEndpoints struct where each endpoint
 // invokes the corresponding method on the provided service.
 func MakeBookingEndpoints(s booking.BookingService) BookingEndpoints {
 	return BookingEndpoints{
-		FindBookingByIDEndpoint: MakeFindBookingByIDEndpoint(s),
-		FindBookingsEndpoint:    MakeFindBookingsEndpoint(s),
-		CreateBookingEndpoint:   MakeCreateBookingEndpoint(s),
-		UpdateBookingEndpoint:   MakeUpdateBookingEndpoint(s),
-		DeleteBookingEndpoint:   MakeDeleteBookingEndpoint(s),
+		FindBookingByIDEndpoint:       MakeFindBookingByIDEndpoint(s),
+		FindBookingsEndpoint:          MakeFindBookingsEndpoint(s),
+		CreateBookingEndpoint:         MakeCreateBookingEndpoint(s),
+		UpdateBookingEndpoint:         MakeUpdateBookingEndpoint(s),
+		DeleteBookingEndpoint:         MakeDeleteBookingEndpoint(s),
+		FindBookingSeriesByIDEndpoint: MakeFindBookingSeriesByIDEndpoint(s),
+		CreateBookingSeriesEndpoint:   MakeCreateBookingSeriesEndpoint(s),
+		ImportBookingsEndpoint:        MakeImportBookingsEndpoint(s),
+		HoldBookingEndpoint:           MakeHoldBookingEndpoint(s),
+		ConfirmBookingEndpoint:        MakeConfirmBookingEndpoint(s),
 	}
 }
 
@@ -64,3 +74,38 @@ func MakeDeleteBookingEndpoint(s booking.BookingService) endpoint.Endpoint {
 		return s.DeleteBooking(ctx, r.(booking.DeleteBookingRequest)), nil
 	}
 }
+
+// MakeFindBookingSeriesByIDEndpoint returns an endpoint via the passed service.
+func MakeFindBookingSeriesByIDEndpoint(s booking.BookingService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.FindBookingSeriesByID(ctx, r.(booking.FindBookingSeriesByIDRequest)), nil
+	}
+}
+
+// MakeCreateBookingSeriesEndpoint returns an endpoint via the passed service.
+func MakeCreateBookingSeriesEndpoint(s booking.BookingService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.CreateBookingSeries(ctx, r.(booking.CreateBookingSeriesRequest)), nil
+	}
+}
+
+// MakeImportBookingsEndpoint returns an endpoint via the passed service.
+func MakeImportBookingsEndpoint(s booking.BookingService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.ImportBookings(ctx, r.(booking.ImportBookingsRequest)), nil
+	}
+}
+
+// MakeHoldBookingEndpoint returns an endpoint via the passed service.
+func MakeHoldBookingEndpoint(s booking.BookingService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.HoldBooking(ctx, r.(booking.HoldBookingRequest)), nil
+	}
+}
+
+// MakeConfirmBookingEndpoint returns an endpoint via the passed service.
+func MakeConfirmBookingEndpoint(s booking.BookingService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.ConfirmBooking(ctx, r.(booking.ConfirmBookingRequest)), nil
+	}
+}
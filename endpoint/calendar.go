@@ -0,0 +1,48 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/openmesh/booking"
+)
+
+// CalendarEndpoints collects all the endpoints that compose a booking.CalendarService.
+// It's used as a helper struct, to collect all the endpoints into a single
+// parameter.
+type CalendarEndpoints struct {
+	FindResourceCalendarEndpoint    endpoint.Endpoint
+	CreateResourceFeedTokenEndpoint endpoint.Endpoint
+	ImportUnavailabilitiesEndpoint  endpoint.Endpoint
+}
+
+// MakeCalendarEndpoints returns a CalendarEndpoints struct where each endpoint
+// invokes the corresponding method on the provided service.
+func MakeCalendarEndpoints(s booking.CalendarService) CalendarEndpoints {
+	return CalendarEndpoints{
+		FindResourceCalendarEndpoint:    MakeFindResourceCalendarEndpoint(s),
+		CreateResourceFeedTokenEndpoint: MakeCreateResourceFeedTokenEndpoint(s),
+		ImportUnavailabilitiesEndpoint:  MakeImportUnavailabilitiesEndpoint(s),
+	}
+}
+
+// MakeFindResourceCalendarEndpoint returns an endpoint via the passed service.
+func MakeFindResourceCalendarEndpoint(s booking.CalendarService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.FindResourceCalendar(ctx, r.(booking.FindResourceCalendarRequest)), nil
+	}
+}
+
+// MakeCreateResourceFeedTokenEndpoint returns an endpoint via the passed service.
+func MakeCreateResourceFeedTokenEndpoint(s booking.CalendarService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.CreateResourceFeedToken(ctx, r.(booking.CreateResourceFeedTokenRequest)), nil
+	}
+}
+
+// MakeImportUnavailabilitiesEndpoint returns an endpoint via the passed service.
+func MakeImportUnavailabilitiesEndpoint(s booking.CalendarService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.ImportUnavailabilities(ctx, r.(booking.ImportUnavailabilitiesRequest)), nil
+	}
+}
@@ -0,0 +1,84 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/openmesh/booking"
+)
+
+// CustomerEndpoints collects all the endpoints that compose a booking.CustomerService.
+// It's used as a helper struct, to collect all the endpoints into a single
+// parameter.
+type CustomerEndpoints struct {
+	FindCustomerByIDEndpoint         endpoint.Endpoint
+	FindCustomersEndpoint            endpoint.Endpoint
+	CreateCustomerEndpoint           endpoint.Endpoint
+	UpdateCustomerEndpoint           endpoint.Endpoint
+	DeleteCustomerEndpoint           endpoint.Endpoint
+	FindCustomerBookingsEndpoint     endpoint.Endpoint
+	GetCustomerLifetimeValueEndpoint endpoint.Endpoint
+}
+
+// MakeCustomerEndpoints returns a CustomerEndpoints struct where each endpoint
+// invokes the corresponding method on the provided service.
+func MakeCustomerEndpoints(s booking.CustomerService) CustomerEndpoints {
+	return CustomerEndpoints{
+		FindCustomerByIDEndpoint:         MakeFindCustomerByIDEndpoint(s),
+		FindCustomersEndpoint:            MakeFindCustomersEndpoint(s),
+		CreateCustomerEndpoint:           MakeCreateCustomerEndpoint(s),
+		UpdateCustomerEndpoint:           MakeUpdateCustomerEndpoint(s),
+		DeleteCustomerEndpoint:           MakeDeleteCustomerEndpoint(s),
+		FindCustomerBookingsEndpoint:     MakeFindCustomerBookingsEndpoint(s),
+		GetCustomerLifetimeValueEndpoint: MakeGetCustomerLifetimeValueEndpoint(s),
+	}
+}
+
+// MakeFindCustomerByIDEndpoint returns an endpoint via the passed service.
+func MakeFindCustomerByIDEndpoint(s booking.CustomerService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.FindCustomerByID(ctx, r.(booking.FindCustomerByIDRequest)), nil
+	}
+}
+
+// MakeFindCustomersEndpoint returns an endpoint via the passed service.
+func MakeFindCustomersEndpoint(s booking.CustomerService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.FindCustomers(ctx, r.(booking.FindCustomersRequest)), nil
+	}
+}
+
+// MakeCreateCustomerEndpoint returns an endpoint via the passed service.
+func MakeCreateCustomerEndpoint(s booking.CustomerService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.CreateCustomer(ctx, r.(booking.CreateCustomerRequest)), nil
+	}
+}
+
+// MakeUpdateCustomerEndpoint returns an endpoint via the passed service.
+func MakeUpdateCustomerEndpoint(s booking.CustomerService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.UpdateCustomer(ctx, r.(booking.UpdateCustomerRequest)), nil
+	}
+}
+
+// MakeDeleteCustomerEndpoint returns an endpoint via the passed service.
+func MakeDeleteCustomerEndpoint(s booking.CustomerService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.DeleteCustomer(ctx, r.(booking.DeleteCustomerRequest)), nil
+	}
+}
+
+// MakeFindCustomerBookingsEndpoint returns an endpoint via the passed service.
+func MakeFindCustomerBookingsEndpoint(s booking.CustomerService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.FindCustomerBookings(ctx, r.(booking.FindCustomerBookingsRequest)), nil
+	}
+}
+
+// MakeGetCustomerLifetimeValueEndpoint returns an endpoint via the passed service.
+func MakeGetCustomerLifetimeValueEndpoint(s booking.CustomerService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.GetCustomerLifetimeValue(ctx, r.(booking.GetCustomerLifetimeValueRequest)), nil
+	}
+}
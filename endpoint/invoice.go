@@ -0,0 +1,57 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/openmesh/booking"
+)
+
+// InvoiceEndpoints collects all the endpoints that compose a booking.InvoiceService.
+// It's used as a helper struct, to collect all the endpoints into a single
+// parameter.
+type InvoiceEndpoints struct {
+	FindInvoiceByIDEndpoint     endpoint.Endpoint
+	FindInvoicesEndpoint        endpoint.Endpoint
+	FindBookingInvoiceEndpoint  endpoint.Endpoint
+	IssueBookingInvoiceEndpoint endpoint.Endpoint
+}
+
+// MakeInvoiceEndpoints returns a InvoiceEndpoints struct where each endpoint
+// invokes the corresponding method on the provided service.
+func MakeInvoiceEndpoints(s booking.InvoiceService) InvoiceEndpoints {
+	return InvoiceEndpoints{
+		FindInvoiceByIDEndpoint:     MakeFindInvoiceByIDEndpoint(s),
+		FindInvoicesEndpoint:        MakeFindInvoicesEndpoint(s),
+		FindBookingInvoiceEndpoint:  MakeFindBookingInvoiceEndpoint(s),
+		IssueBookingInvoiceEndpoint: MakeIssueBookingInvoiceEndpoint(s),
+	}
+}
+
+// MakeFindInvoiceByIDEndpoint returns an endpoint via the passed service.
+func MakeFindInvoiceByIDEndpoint(s booking.InvoiceService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.FindInvoiceByID(ctx, r.(booking.FindInvoiceByIDRequest)), nil
+	}
+}
+
+// MakeFindInvoicesEndpoint returns an endpoint via the passed service.
+func MakeFindInvoicesEndpoint(s booking.InvoiceService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.FindInvoices(ctx, r.(booking.FindInvoicesRequest)), nil
+	}
+}
+
+// MakeFindBookingInvoiceEndpoint returns an endpoint via the passed service.
+func MakeFindBookingInvoiceEndpoint(s booking.InvoiceService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.FindBookingInvoice(ctx, r.(booking.FindBookingInvoiceRequest)), nil
+	}
+}
+
+// MakeIssueBookingInvoiceEndpoint returns an endpoint via the passed service.
+func MakeIssueBookingInvoiceEndpoint(s booking.InvoiceService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.IssueBookingInvoice(ctx, r.(booking.IssueBookingInvoiceRequest)), nil
+	}
+}
@@ -0,0 +1,57 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/openmesh/booking"
+)
+
+// PaymentEndpoints collects all the endpoints that compose a booking.PaymentService.
+// It's used as a helper struct, to collect all the endpoints into a single
+// parameter.
+type PaymentEndpoints struct {
+	FindPaymentsEndpoint         endpoint.Endpoint
+	CapturePaymentEndpoint       endpoint.Endpoint
+	RefundPaymentEndpoint        endpoint.Endpoint
+	HandlePaymentWebhookEndpoint endpoint.Endpoint
+}
+
+// MakePaymentEndpoints returns a PaymentEndpoints struct where each endpoint
+// invokes the corresponding method on the provided service.
+func MakePaymentEndpoints(s booking.PaymentService) PaymentEndpoints {
+	return PaymentEndpoints{
+		FindPaymentsEndpoint:         MakeFindPaymentsEndpoint(s),
+		CapturePaymentEndpoint:       MakeCapturePaymentEndpoint(s),
+		RefundPaymentEndpoint:        MakeRefundPaymentEndpoint(s),
+		HandlePaymentWebhookEndpoint: MakeHandlePaymentWebhookEndpoint(s),
+	}
+}
+
+// MakeFindPaymentsEndpoint returns an endpoint via the passed service.
+func MakeFindPaymentsEndpoint(s booking.PaymentService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.FindPayments(ctx, r.(booking.FindPaymentsRequest)), nil
+	}
+}
+
+// MakeCapturePaymentEndpoint returns an endpoint via the passed service.
+func MakeCapturePaymentEndpoint(s booking.PaymentService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.CapturePayment(ctx, r.(booking.CapturePaymentRequest)), nil
+	}
+}
+
+// MakeRefundPaymentEndpoint returns an endpoint via the passed service.
+func MakeRefundPaymentEndpoint(s booking.PaymentService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.RefundPayment(ctx, r.(booking.RefundPaymentRequest)), nil
+	}
+}
+
+// MakeHandlePaymentWebhookEndpoint returns an endpoint via the passed service.
+func MakeHandlePaymentWebhookEndpoint(s booking.PaymentService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.HandlePaymentWebhook(ctx, r.(booking.HandlePaymentWebhookRequest)), nil
+	}
+}
@@ -0,0 +1,30 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/openmesh/booking"
+)
+
+// PricingEndpoints collects all the endpoints that compose a booking.PricingService.
+// It's used as a helper struct, to collect all the endpoints into a single
+// parameter.
+type PricingEndpoints struct {
+	QuoteBookingEndpoint endpoint.Endpoint
+}
+
+// MakePricingEndpoints returns a PricingEndpoints struct where each endpoint
+// invokes the corresponding method on the provided service.
+func MakePricingEndpoints(s booking.PricingService) PricingEndpoints {
+	return PricingEndpoints{
+		QuoteBookingEndpoint: MakeQuoteBookingEndpoint(s),
+	}
+}
+
+// MakeQuoteBookingEndpoint returns an endpoint via the passed service.
+func MakeQuoteBookingEndpoint(s booking.PricingService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.QuoteBooking(ctx, r.(booking.QuoteBookingRequest)), nil
+	}
+}
@@ -0,0 +1,75 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/openmesh/booking"
+)
+
+// PromoCodeEndpoints collects all the endpoints that compose a booking.PromoCodeService.
+// It's used as a helper struct, to collect all the endpoints into a single
+// parameter.
+type PromoCodeEndpoints struct {
+	FindPromoCodeByIDEndpoint    endpoint.Endpoint
+	FindPromoCodesEndpoint       endpoint.Endpoint
+	CreatePromoCodeEndpoint      endpoint.Endpoint
+	UpdatePromoCodeEndpoint      endpoint.Endpoint
+	DeletePromoCodeEndpoint      endpoint.Endpoint
+	FindPromoRedemptionsEndpoint endpoint.Endpoint
+}
+
+// MakePromoCodeEndpoints returns a PromoCodeEndpoints struct where each endpoint
+// invokes the corresponding method on the provided service.
+func MakePromoCodeEndpoints(s booking.PromoCodeService) PromoCodeEndpoints {
+	return PromoCodeEndpoints{
+		FindPromoCodeByIDEndpoint:    MakeFindPromoCodeByIDEndpoint(s),
+		FindPromoCodesEndpoint:       MakeFindPromoCodesEndpoint(s),
+		CreatePromoCodeEndpoint:      MakeCreatePromoCodeEndpoint(s),
+		UpdatePromoCodeEndpoint:      MakeUpdatePromoCodeEndpoint(s),
+		DeletePromoCodeEndpoint:      MakeDeletePromoCodeEndpoint(s),
+		FindPromoRedemptionsEndpoint: MakeFindPromoRedemptionsEndpoint(s),
+	}
+}
+
+// MakeFindPromoCodeByIDEndpoint returns an endpoint via the passed service.
+func MakeFindPromoCodeByIDEndpoint(s booking.PromoCodeService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.FindPromoCodeByID(ctx, r.(booking.FindPromoCodeByIDRequest)), nil
+	}
+}
+
+// MakeFindPromoCodesEndpoint returns an endpoint via the passed service.
+func MakeFindPromoCodesEndpoint(s booking.PromoCodeService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.FindPromoCodes(ctx, r.(booking.FindPromoCodesRequest)), nil
+	}
+}
+
+// MakeCreatePromoCodeEndpoint returns an endpoint via the passed service.
+func MakeCreatePromoCodeEndpoint(s booking.PromoCodeService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.CreatePromoCode(ctx, r.(booking.CreatePromoCodeRequest)), nil
+	}
+}
+
+// MakeUpdatePromoCodeEndpoint returns an endpoint via the passed service.
+func MakeUpdatePromoCodeEndpoint(s booking.PromoCodeService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.UpdatePromoCode(ctx, r.(booking.UpdatePromoCodeRequest)), nil
+	}
+}
+
+// MakeDeletePromoCodeEndpoint returns an endpoint via the passed service.
+func MakeDeletePromoCodeEndpoint(s booking.PromoCodeService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.DeletePromoCode(ctx, r.(booking.DeletePromoCodeRequest)), nil
+	}
+}
+
+// MakeFindPromoRedemptionsEndpoint returns an endpoint via the passed service.
+func MakeFindPromoRedemptionsEndpoint(s booking.PromoCodeService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.FindPromoRedemptions(ctx, r.(booking.FindPromoRedemptionsRequest)), nil
+	}
+}
@@ -16,6 +16,7 @@ type ResourceEndpoints struct {
 	CreateResourceEndpoint   endpoint.Endpoint
 	UpdateResourceEndpoint   endpoint.Endpoint
 	DeleteResourceEndpoint   endpoint.Endpoint
+	ImportResourcesEndpoint  endpoint.Endpoint
 }
 
 // MakeResourceEndpoints returns a ResourceEndpoints struct where each endpoint
@@ -27,6 +28,7 @@ func MakeResourceEndpoints(s booking.ResourceService) ResourceEndpoints {
 		CreateResourceEndpoint:   MakeCreateResourceEndpoint(s),
 		UpdateResourceEndpoint:   MakeUpdateResourceEndpoint(s),
 		DeleteResourceEndpoint:   MakeDeleteResourceEndpoint(s),
+		ImportResourcesEndpoint:  MakeImportResourcesEndpoint(s),
 	}
 }
 
@@ -64,3 +66,10 @@ func MakeDeleteResourceEndpoint(s booking.ResourceService) endpoint.Endpoint {
 		return s.DeleteResource(ctx, r.(booking.DeleteResourceRequest)), nil
 	}
 }
+
+// MakeImportResourcesEndpoint returns an endpoint via the passed service.
+func MakeImportResourcesEndpoint(s booking.ResourceService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.ImportResources(ctx, r.(booking.ImportResourcesRequest)), nil
+	}
+}
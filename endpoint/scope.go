@@ -0,0 +1,22 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/openmesh/booking"
+)
+
+// RequireScope returns a middleware that rejects requests which have not been
+// granted scope. Requests that aren't restricted to a set of scopes, such as
+// those authenticated with a session, are always allowed through.
+func RequireScope(scope string) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			if !booking.HasScope(ctx, scope) {
+				return nil, booking.Errorf(booking.EFORBIDDEN, "This API token is missing the '%s' scope.", scope)
+			}
+			return next(ctx, request)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/openmesh/booking"
+)
+
+// TaxRateEndpoints collects all the endpoints that compose a booking.TaxRateService.
+// It's used as a helper struct, to collect all the endpoints into a single
+// parameter.
+type TaxRateEndpoints struct {
+	FindTaxRateByIDEndpoint endpoint.Endpoint
+	FindTaxRatesEndpoint    endpoint.Endpoint
+	CreateTaxRateEndpoint   endpoint.Endpoint
+	UpdateTaxRateEndpoint   endpoint.Endpoint
+	DeleteTaxRateEndpoint   endpoint.Endpoint
+}
+
+// MakeTaxRateEndpoints returns a TaxRateEndpoints struct where each endpoint
+// invokes the corresponding method on the provided service.
+func MakeTaxRateEndpoints(s booking.TaxRateService) TaxRateEndpoints {
+	return TaxRateEndpoints{
+		FindTaxRateByIDEndpoint: MakeFindTaxRateByIDEndpoint(s),
+		FindTaxRatesEndpoint:    MakeFindTaxRatesEndpoint(s),
+		CreateTaxRateEndpoint:   MakeCreateTaxRateEndpoint(s),
+		UpdateTaxRateEndpoint:   MakeUpdateTaxRateEndpoint(s),
+		DeleteTaxRateEndpoint:   MakeDeleteTaxRateEndpoint(s),
+	}
+}
+
+// MakeFindTaxRateByIDEndpoint returns an endpoint via the passed service.
+func MakeFindTaxRateByIDEndpoint(s booking.TaxRateService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.FindTaxRateByID(ctx, r.(booking.FindTaxRateByIDRequest)), nil
+	}
+}
+
+// MakeFindTaxRatesEndpoint returns an endpoint via the passed service.
+func MakeFindTaxRatesEndpoint(s booking.TaxRateService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.FindTaxRates(ctx, r.(booking.FindTaxRatesRequest)), nil
+	}
+}
+
+// MakeCreateTaxRateEndpoint returns an endpoint via the passed service.
+func MakeCreateTaxRateEndpoint(s booking.TaxRateService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.CreateTaxRate(ctx, r.(booking.CreateTaxRateRequest)), nil
+	}
+}
+
+// MakeUpdateTaxRateEndpoint returns an endpoint via the passed service.
+func MakeUpdateTaxRateEndpoint(s booking.TaxRateService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.UpdateTaxRate(ctx, r.(booking.UpdateTaxRateRequest)), nil
+	}
+}
+
+// MakeDeleteTaxRateEndpoint returns an endpoint via the passed service.
+func MakeDeleteTaxRateEndpoint(s booking.TaxRateService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.DeleteTaxRate(ctx, r.(booking.DeleteTaxRateRequest)), nil
+	}
+}
@@ -13,6 +13,7 @@ import (
 type TokenEndpoints struct {
 	CreateTokenEndpoint endpoint.Endpoint
 	FindTokensEndpoint  endpoint.Endpoint
+	RevokeTokenEndpoint endpoint.Endpoint
 }
 
 // MakeTokenEndpoints returns a TokenEndpoints struct where each endpoint
@@ -21,6 +22,7 @@ func MakeTokenEndpoints(s booking.TokenService) TokenEndpoints {
 	return TokenEndpoints{
 		CreateTokenEndpoint: MakeCreateTokenEndpoint(s),
 		FindTokensEndpoint:  MakeFindTokensEndpoint(s),
+		RevokeTokenEndpoint: MakeRevokeTokenEndpoint(s),
 	}
 }
 
@@ -37,3 +39,10 @@ func MakeFindTokensEndpoint(s booking.TokenService) endpoint.Endpoint {
 		return s.FindTokens(ctx, r.(booking.FindTokensRequest)), nil
 	}
 }
+
+// MakeRevokeTokenEndpoint returns an endpoint via the passed service.
+func MakeRevokeTokenEndpoint(s booking.TokenService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.RevokeToken(ctx, r.(booking.RevokeTokenRequest)), nil
+	}
+}
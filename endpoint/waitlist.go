@@ -0,0 +1,48 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/openmesh/booking"
+)
+
+// WaitlistEndpoints collects all the endpoints that compose a booking.WaitlistService.
+// It's used as a helper struct, to collect all the endpoints into a single
+// parameter.
+type WaitlistEndpoints struct {
+	FindWaitlistEntriesEndpoint endpoint.Endpoint
+	JoinWaitlistEndpoint        endpoint.Endpoint
+	LeaveWaitlistEndpoint       endpoint.Endpoint
+}
+
+// MakeWaitlistEndpoints returns a WaitlistEndpoints struct where each endpoint
+// invokes the corresponding method on the provided service.
+func MakeWaitlistEndpoints(s booking.WaitlistService) WaitlistEndpoints {
+	return WaitlistEndpoints{
+		FindWaitlistEntriesEndpoint: MakeFindWaitlistEntriesEndpoint(s),
+		JoinWaitlistEndpoint:        MakeJoinWaitlistEndpoint(s),
+		LeaveWaitlistEndpoint:       MakeLeaveWaitlistEndpoint(s),
+	}
+}
+
+// MakeFindWaitlistEntriesEndpoint returns an endpoint via the passed service.
+func MakeFindWaitlistEntriesEndpoint(s booking.WaitlistService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.FindWaitlistEntries(ctx, r.(booking.FindWaitlistEntriesRequest)), nil
+	}
+}
+
+// MakeJoinWaitlistEndpoint returns an endpoint via the passed service.
+func MakeJoinWaitlistEndpoint(s booking.WaitlistService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.JoinWaitlist(ctx, r.(booking.JoinWaitlistRequest)), nil
+	}
+}
+
+// MakeLeaveWaitlistEndpoint returns an endpoint via the passed service.
+func MakeLeaveWaitlistEndpoint(s booking.WaitlistService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.LeaveWaitlist(ctx, r.(booking.LeaveWaitlistRequest)), nil
+	}
+}
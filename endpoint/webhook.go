@@ -0,0 +1,84 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/openmesh/booking"
+)
+
+// WebhookEndpoints collects all the endpoints that compose a booking.WebhookService.
+// It's used as a helper struct, to collect all the endpoints into a single
+// parameter.
+type WebhookEndpoints struct {
+	FindWebhookByIDEndpoint       endpoint.Endpoint
+	FindWebhooksEndpoint          endpoint.Endpoint
+	CreateWebhookEndpoint         endpoint.Endpoint
+	UpdateWebhookEndpoint         endpoint.Endpoint
+	DeleteWebhookEndpoint         endpoint.Endpoint
+	FindWebhookDeliveriesEndpoint endpoint.Endpoint
+	RetryWebhookDeliveryEndpoint  endpoint.Endpoint
+}
+
+// MakeWebhookEndpoints returns a WebhookEndpoints struct where each endpoint
+// invokes the corresponding method on the provided service.
+func MakeWebhookEndpoints(s booking.WebhookService) WebhookEndpoints {
+	return WebhookEndpoints{
+		FindWebhookByIDEndpoint:       MakeFindWebhookByIDEndpoint(s),
+		FindWebhooksEndpoint:          MakeFindWebhooksEndpoint(s),
+		CreateWebhookEndpoint:         MakeCreateWebhookEndpoint(s),
+		UpdateWebhookEndpoint:         MakeUpdateWebhookEndpoint(s),
+		DeleteWebhookEndpoint:         MakeDeleteWebhookEndpoint(s),
+		FindWebhookDeliveriesEndpoint: MakeFindWebhookDeliveriesEndpoint(s),
+		RetryWebhookDeliveryEndpoint:  MakeRetryWebhookDeliveryEndpoint(s),
+	}
+}
+
+// MakeFindWebhookByIDEndpoint returns an endpoint via the passed service.
+func MakeFindWebhookByIDEndpoint(s booking.WebhookService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.FindWebhookByID(ctx, r.(booking.FindWebhookByIDRequest)), nil
+	}
+}
+
+// MakeFindWebhooksEndpoint returns an endpoint via the passed service.
+func MakeFindWebhooksEndpoint(s booking.WebhookService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.FindWebhooks(ctx, r.(booking.FindWebhooksRequest)), nil
+	}
+}
+
+// MakeCreateWebhookEndpoint returns an endpoint via the passed service.
+func MakeCreateWebhookEndpoint(s booking.WebhookService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.CreateWebhook(ctx, r.(booking.CreateWebhookRequest)), nil
+	}
+}
+
+// MakeUpdateWebhookEndpoint returns an endpoint via the passed service.
+func MakeUpdateWebhookEndpoint(s booking.WebhookService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.UpdateWebhook(ctx, r.(booking.UpdateWebhookRequest)), nil
+	}
+}
+
+// MakeDeleteWebhookEndpoint returns an endpoint via the passed service.
+func MakeDeleteWebhookEndpoint(s booking.WebhookService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.DeleteWebhook(ctx, r.(booking.DeleteWebhookRequest)), nil
+	}
+}
+
+// MakeFindWebhookDeliveriesEndpoint returns an endpoint via the passed service.
+func MakeFindWebhookDeliveriesEndpoint(s booking.WebhookService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.FindWebhookDeliveries(ctx, r.(booking.FindWebhookDeliveriesRequest)), nil
+	}
+}
+
+// MakeRetryWebhookDeliveryEndpoint returns an endpoint via the passed service.
+func MakeRetryWebhookDeliveryEndpoint(s booking.WebhookService) endpoint.Endpoint {
+	return func(ctx context.Context, r interface{}) (interface{}, error) {
+		return s.RetryWebhookDelivery(ctx, r.(booking.RetryWebhookDeliveryRequest)), nil
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/openmesh/booking/ent/auth"
@@ -19,6 +20,7 @@ type AuthCreate struct {
 	config
 	mutation *AuthMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "createdAt" field.
@@ -248,6 +250,7 @@ func (ac *AuthCreate) createSpec() (*Auth, *sqlgraph.CreateSpec) {
 			},
 		}
 	)
+	_spec.OnConflict = ac.conflict
 	if value, ok := ac.mutation.CreatedAt(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -327,10 +330,380 @@ func (ac *AuthCreate) createSpec() (*Auth, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Auth.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuthUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ac *AuthCreate) OnConflict(opts ...sql.ConflictOption) *AuthUpsertOne {
+	ac.conflict = opts
+	return &AuthUpsertOne{
+		create: ac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Auth.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ac *AuthCreate) OnConflictColumns(columns ...string) *AuthUpsertOne {
+	ac.conflict = append(ac.conflict, sql.ConflictColumns(columns...))
+	return &AuthUpsertOne{
+		create: ac,
+	}
+}
+
+type (
+	// AuthUpsertOne is the builder for "upsert"-ing
+	//  one Auth node.
+	AuthUpsertOne struct {
+		create *AuthCreate
+	}
+
+	// AuthUpsert is the "OnConflict" setter.
+	AuthUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *AuthUpsert) SetCreatedAt(v time.Time) *AuthUpsert {
+	u.Set(auth.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *AuthUpsert) UpdateCreatedAt() *AuthUpsert {
+	u.SetExcluded(auth.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *AuthUpsert) SetUpdatedAt(v time.Time) *AuthUpsert {
+	u.Set(auth.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *AuthUpsert) UpdateUpdatedAt() *AuthUpsert {
+	u.SetExcluded(auth.FieldUpdatedAt)
+	return u
+}
+
+// SetSource sets the "source" field.
+func (u *AuthUpsert) SetSource(v string) *AuthUpsert {
+	u.Set(auth.FieldSource, v)
+	return u
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *AuthUpsert) UpdateSource() *AuthUpsert {
+	u.SetExcluded(auth.FieldSource)
+	return u
+}
+
+// SetSourceId sets the "sourceId" field.
+func (u *AuthUpsert) SetSourceId(v string) *AuthUpsert {
+	u.Set(auth.FieldSourceId, v)
+	return u
+}
+
+// UpdateSourceId sets the "sourceId" field to the value that was provided on create.
+func (u *AuthUpsert) UpdateSourceId() *AuthUpsert {
+	u.SetExcluded(auth.FieldSourceId)
+	return u
+}
+
+// SetAccessToken sets the "accessToken" field.
+func (u *AuthUpsert) SetAccessToken(v string) *AuthUpsert {
+	u.Set(auth.FieldAccessToken, v)
+	return u
+}
+
+// UpdateAccessToken sets the "accessToken" field to the value that was provided on create.
+func (u *AuthUpsert) UpdateAccessToken() *AuthUpsert {
+	u.SetExcluded(auth.FieldAccessToken)
+	return u
+}
+
+// ClearAccessToken clears the value of the "accessToken" field.
+func (u *AuthUpsert) ClearAccessToken() *AuthUpsert {
+	u.SetNull(auth.FieldAccessToken)
+	return u
+}
+
+// SetRefreshToken sets the "refreshToken" field.
+func (u *AuthUpsert) SetRefreshToken(v string) *AuthUpsert {
+	u.Set(auth.FieldRefreshToken, v)
+	return u
+}
+
+// UpdateRefreshToken sets the "refreshToken" field to the value that was provided on create.
+func (u *AuthUpsert) UpdateRefreshToken() *AuthUpsert {
+	u.SetExcluded(auth.FieldRefreshToken)
+	return u
+}
+
+// ClearRefreshToken clears the value of the "refreshToken" field.
+func (u *AuthUpsert) ClearRefreshToken() *AuthUpsert {
+	u.SetNull(auth.FieldRefreshToken)
+	return u
+}
+
+// SetExpiry sets the "expiry" field.
+func (u *AuthUpsert) SetExpiry(v time.Time) *AuthUpsert {
+	u.Set(auth.FieldExpiry, v)
+	return u
+}
+
+// UpdateExpiry sets the "expiry" field to the value that was provided on create.
+func (u *AuthUpsert) UpdateExpiry() *AuthUpsert {
+	u.SetExcluded(auth.FieldExpiry)
+	return u
+}
+
+// ClearExpiry clears the value of the "expiry" field.
+func (u *AuthUpsert) ClearExpiry() *AuthUpsert {
+	u.SetNull(auth.FieldExpiry)
+	return u
+}
+
+// SetUserId sets the "userId" field.
+func (u *AuthUpsert) SetUserId(v int) *AuthUpsert {
+	u.Set(auth.FieldUserId, v)
+	return u
+}
+
+// UpdateUserId sets the "userId" field to the value that was provided on create.
+func (u *AuthUpsert) UpdateUserId() *AuthUpsert {
+	u.SetExcluded(auth.FieldUserId)
+	return u
+}
+
+// UpdateNewValues updates the fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Auth.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AuthUpsertOne) UpdateNewValues() *AuthUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Auth.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AuthUpsertOne) Ignore() *AuthUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuthUpsertOne) DoNothing() *AuthUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuthCreate.OnConflict
+// documentation for more info.
+func (u *AuthUpsertOne) Update(set func(*AuthUpsert)) *AuthUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuthUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *AuthUpsertOne) SetCreatedAt(v time.Time) *AuthUpsertOne {
+	return u.Update(func(s *AuthUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *AuthUpsertOne) UpdateCreatedAt() *AuthUpsertOne {
+	return u.Update(func(s *AuthUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *AuthUpsertOne) SetUpdatedAt(v time.Time) *AuthUpsertOne {
+	return u.Update(func(s *AuthUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *AuthUpsertOne) UpdateUpdatedAt() *AuthUpsertOne {
+	return u.Update(func(s *AuthUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *AuthUpsertOne) SetSource(v string) *AuthUpsertOne {
+	return u.Update(func(s *AuthUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *AuthUpsertOne) UpdateSource() *AuthUpsertOne {
+	return u.Update(func(s *AuthUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// SetSourceId sets the "sourceId" field.
+func (u *AuthUpsertOne) SetSourceId(v string) *AuthUpsertOne {
+	return u.Update(func(s *AuthUpsert) {
+		s.SetSourceId(v)
+	})
+}
+
+// UpdateSourceId sets the "sourceId" field to the value that was provided on create.
+func (u *AuthUpsertOne) UpdateSourceId() *AuthUpsertOne {
+	return u.Update(func(s *AuthUpsert) {
+		s.UpdateSourceId()
+	})
+}
+
+// SetAccessToken sets the "accessToken" field.
+func (u *AuthUpsertOne) SetAccessToken(v string) *AuthUpsertOne {
+	return u.Update(func(s *AuthUpsert) {
+		s.SetAccessToken(v)
+	})
+}
+
+// UpdateAccessToken sets the "accessToken" field to the value that was provided on create.
+func (u *AuthUpsertOne) UpdateAccessToken() *AuthUpsertOne {
+	return u.Update(func(s *AuthUpsert) {
+		s.UpdateAccessToken()
+	})
+}
+
+// ClearAccessToken clears the value of the "accessToken" field.
+func (u *AuthUpsertOne) ClearAccessToken() *AuthUpsertOne {
+	return u.Update(func(s *AuthUpsert) {
+		s.ClearAccessToken()
+	})
+}
+
+// SetRefreshToken sets the "refreshToken" field.
+func (u *AuthUpsertOne) SetRefreshToken(v string) *AuthUpsertOne {
+	return u.Update(func(s *AuthUpsert) {
+		s.SetRefreshToken(v)
+	})
+}
+
+// UpdateRefreshToken sets the "refreshToken" field to the value that was provided on create.
+func (u *AuthUpsertOne) UpdateRefreshToken() *AuthUpsertOne {
+	return u.Update(func(s *AuthUpsert) {
+		s.UpdateRefreshToken()
+	})
+}
+
+// ClearRefreshToken clears the value of the "refreshToken" field.
+func (u *AuthUpsertOne) ClearRefreshToken() *AuthUpsertOne {
+	return u.Update(func(s *AuthUpsert) {
+		s.ClearRefreshToken()
+	})
+}
+
+// SetExpiry sets the "expiry" field.
+func (u *AuthUpsertOne) SetExpiry(v time.Time) *AuthUpsertOne {
+	return u.Update(func(s *AuthUpsert) {
+		s.SetExpiry(v)
+	})
+}
+
+// UpdateExpiry sets the "expiry" field to the value that was provided on create.
+func (u *AuthUpsertOne) UpdateExpiry() *AuthUpsertOne {
+	return u.Update(func(s *AuthUpsert) {
+		s.UpdateExpiry()
+	})
+}
+
+// ClearExpiry clears the value of the "expiry" field.
+func (u *AuthUpsertOne) ClearExpiry() *AuthUpsertOne {
+	return u.Update(func(s *AuthUpsert) {
+		s.ClearExpiry()
+	})
+}
+
+// SetUserId sets the "userId" field.
+func (u *AuthUpsertOne) SetUserId(v int) *AuthUpsertOne {
+	return u.Update(func(s *AuthUpsert) {
+		s.SetUserId(v)
+	})
+}
+
+// UpdateUserId sets the "userId" field to the value that was provided on create.
+func (u *AuthUpsertOne) UpdateUserId() *AuthUpsertOne {
+	return u.Update(func(s *AuthUpsert) {
+		s.UpdateUserId()
+	})
+}
+
+// Exec executes the query.
+func (u *AuthUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuthCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuthUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AuthUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AuthUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // AuthCreateBulk is the builder for creating many Auth entities in bulk.
 type AuthCreateBulk struct {
 	config
 	builders []*AuthCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Auth entities in the database.
@@ -357,6 +730,7 @@ func (acb *AuthCreateBulk) Save(ctx context.Context) ([]*Auth, error) {
 					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = acb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -410,3 +784,237 @@ func (acb *AuthCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Auth.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuthUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (acb *AuthCreateBulk) OnConflict(opts ...sql.ConflictOption) *AuthUpsertBulk {
+	acb.conflict = opts
+	return &AuthUpsertBulk{
+		create: acb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Auth.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (acb *AuthCreateBulk) OnConflictColumns(columns ...string) *AuthUpsertBulk {
+	acb.conflict = append(acb.conflict, sql.ConflictColumns(columns...))
+	return &AuthUpsertBulk{
+		create: acb,
+	}
+}
+
+// AuthUpsertBulk is the builder for "upsert"-ing
+// a bulk of Auth nodes.
+type AuthUpsertBulk struct {
+	create *AuthCreateBulk
+}
+
+// UpdateNewValues updates the fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Auth.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AuthUpsertBulk) UpdateNewValues() *AuthUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Auth.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AuthUpsertBulk) Ignore() *AuthUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuthUpsertBulk) DoNothing() *AuthUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuthCreateBulk.OnConflict
+// documentation for more info.
+func (u *AuthUpsertBulk) Update(set func(*AuthUpsert)) *AuthUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuthUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *AuthUpsertBulk) SetCreatedAt(v time.Time) *AuthUpsertBulk {
+	return u.Update(func(s *AuthUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *AuthUpsertBulk) UpdateCreatedAt() *AuthUpsertBulk {
+	return u.Update(func(s *AuthUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *AuthUpsertBulk) SetUpdatedAt(v time.Time) *AuthUpsertBulk {
+	return u.Update(func(s *AuthUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *AuthUpsertBulk) UpdateUpdatedAt() *AuthUpsertBulk {
+	return u.Update(func(s *AuthUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *AuthUpsertBulk) SetSource(v string) *AuthUpsertBulk {
+	return u.Update(func(s *AuthUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *AuthUpsertBulk) UpdateSource() *AuthUpsertBulk {
+	return u.Update(func(s *AuthUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// SetSourceId sets the "sourceId" field.
+func (u *AuthUpsertBulk) SetSourceId(v string) *AuthUpsertBulk {
+	return u.Update(func(s *AuthUpsert) {
+		s.SetSourceId(v)
+	})
+}
+
+// UpdateSourceId sets the "sourceId" field to the value that was provided on create.
+func (u *AuthUpsertBulk) UpdateSourceId() *AuthUpsertBulk {
+	return u.Update(func(s *AuthUpsert) {
+		s.UpdateSourceId()
+	})
+}
+
+// SetAccessToken sets the "accessToken" field.
+func (u *AuthUpsertBulk) SetAccessToken(v string) *AuthUpsertBulk {
+	return u.Update(func(s *AuthUpsert) {
+		s.SetAccessToken(v)
+	})
+}
+
+// UpdateAccessToken sets the "accessToken" field to the value that was provided on create.
+func (u *AuthUpsertBulk) UpdateAccessToken() *AuthUpsertBulk {
+	return u.Update(func(s *AuthUpsert) {
+		s.UpdateAccessToken()
+	})
+}
+
+// ClearAccessToken clears the value of the "accessToken" field.
+func (u *AuthUpsertBulk) ClearAccessToken() *AuthUpsertBulk {
+	return u.Update(func(s *AuthUpsert) {
+		s.ClearAccessToken()
+	})
+}
+
+// SetRefreshToken sets the "refreshToken" field.
+func (u *AuthUpsertBulk) SetRefreshToken(v string) *AuthUpsertBulk {
+	return u.Update(func(s *AuthUpsert) {
+		s.SetRefreshToken(v)
+	})
+}
+
+// UpdateRefreshToken sets the "refreshToken" field to the value that was provided on create.
+func (u *AuthUpsertBulk) UpdateRefreshToken() *AuthUpsertBulk {
+	return u.Update(func(s *AuthUpsert) {
+		s.UpdateRefreshToken()
+	})
+}
+
+// ClearRefreshToken clears the value of the "refreshToken" field.
+func (u *AuthUpsertBulk) ClearRefreshToken() *AuthUpsertBulk {
+	return u.Update(func(s *AuthUpsert) {
+		s.ClearRefreshToken()
+	})
+}
+
+// SetExpiry sets the "expiry" field.
+func (u *AuthUpsertBulk) SetExpiry(v time.Time) *AuthUpsertBulk {
+	return u.Update(func(s *AuthUpsert) {
+		s.SetExpiry(v)
+	})
+}
+
+// UpdateExpiry sets the "expiry" field to the value that was provided on create.
+func (u *AuthUpsertBulk) UpdateExpiry() *AuthUpsertBulk {
+	return u.Update(func(s *AuthUpsert) {
+		s.UpdateExpiry()
+	})
+}
+
+// ClearExpiry clears the value of the "expiry" field.
+func (u *AuthUpsertBulk) ClearExpiry() *AuthUpsertBulk {
+	return u.Update(func(s *AuthUpsert) {
+		s.ClearExpiry()
+	})
+}
+
+// SetUserId sets the "userId" field.
+func (u *AuthUpsertBulk) SetUserId(v int) *AuthUpsertBulk {
+	return u.Update(func(s *AuthUpsert) {
+		s.SetUserId(v)
+	})
+}
+
+// UpdateUserId sets the "userId" field to the value that was provided on create.
+func (u *AuthUpsertBulk) UpdateUserId() *AuthUpsertBulk {
+	return u.Update(func(s *AuthUpsert) {
+		s.UpdateUserId()
+	})
+}
+
+// Exec executes the query.
+func (u *AuthUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AuthCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuthCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuthUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
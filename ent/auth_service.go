@@ -195,7 +195,7 @@ func findUserByEmail(ctx context.Context, tx *Tx, email string) (*User, error) {
 		First(ctx)
 	var nfe *NotFoundError
 	if errors.As(err, &nfe) {
-		return nil, booking.Errorf(booking.EUSERNOTFOUND, "Could not find user by email: email=%s, err=%w", email, err)
+		return nil, booking.Errorf(booking.EUSERNOTFOUND, "Could not find user by email: email=%s, err=%v", email, err)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to find user: %w", err)
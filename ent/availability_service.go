@@ -0,0 +1,277 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/openmesh/booking"
+	entbooking "github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/unavailability"
+)
+
+type availabilityService struct {
+	client *Client
+}
+
+func NewAvailabilityService(client *Client) *availabilityService {
+	return &availabilityService{
+		client,
+	}
+}
+
+// FindAvailabilities expands the slots of a resource over the requested window
+// in the timezone of the resource. Slot occurrences are trimmed by any
+// unavailabilities, dropped when the slot quantity has been booked out and
+// trimmed again wherever concurrent bookings use up the quantity available for
+// the resource.
+func (s *availabilityService) FindAvailabilities(
+	ctx context.Context,
+	req booking.FindAvailabilitiesRequest,
+) booking.FindAvailabilitiesResponse {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return booking.FindAvailabilitiesResponse{
+			Err: fmt.Errorf("failed to start transaction: %w", err),
+		}
+	}
+	defer tx.Rollback()
+
+	r, err := findResourceByID(ctx, tx, req.ResourceID, func(rq *ResourceQuery) *ResourceQuery {
+		return rq.WithSlots()
+	})
+	if err != nil {
+		return booking.FindAvailabilitiesResponse{Err: err}
+	}
+
+	a, err := findAvailabilities(ctx, tx, r, req.StartTimeAfter, req.EndTimeBefore)
+	if err != nil {
+		return booking.FindAvailabilitiesResponse{
+			Err: fmt.Errorf("failed to find availabilities: %w", err),
+		}
+	}
+
+	if req.OrderBy != nil && *req.OrderBy == "endTime" {
+		sort.SliceStable(a, func(i, j int) bool { return a[i].EndTime.Before(a[j].EndTime) })
+	}
+
+	totalItems := len(a)
+	if req.Offset >= len(a) {
+		a = nil
+	} else {
+		a = a[req.Offset:]
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = 10
+	}
+	if len(a) > limit {
+		a = a[:limit]
+	}
+
+	return booking.FindAvailabilitiesResponse{
+		Availabilities: a,
+		TotalItems:     totalItems,
+	}
+}
+
+// findAvailabilities returns the bookable periods of r that fall entirely
+// within [start, end), ordered by start time.
+func findAvailabilities(
+	ctx context.Context,
+	tx *Tx,
+	r *Resource,
+	start time.Time,
+	end time.Time,
+) ([]*booking.Availability, error) {
+	loc, err := r.toModel().Location()
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := tx.Unavailability.
+		Query().
+		Where(
+			unavailability.ResourceId(r.ID),
+			unavailability.StartTimeLT(end),
+			unavailability.EndTimeGT(start),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query unavailabilities: %w", err)
+	}
+	blocked := make([]period, 0, len(u))
+	for _, v := range u {
+		blocked = append(blocked, period{v.StartTime, v.EndTime})
+	}
+
+	b, err := tx.Booking.
+		Query().
+		Where(
+			entbooking.ResourceId(r.ID),
+			entbooking.StatusIn(booking.ActiveBookingStatuses...),
+			unexpiredHold(time.Now()),
+			entbooking.StartTimeLT(end),
+			entbooking.EndTimeGT(start),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query bookings: %w", err)
+	}
+	booked := make([]period, 0, len(b))
+	for _, v := range b {
+		booked = append(booked, period{v.StartTime, v.EndTime})
+	}
+
+	var result []*booking.Availability
+	for _, occ := range expandSlots(r.Edges.Slots, start.In(loc), end.In(loc)) {
+		// Slot quantities limit the number of bookings that can be made against
+		// a single occurrence of the slot.
+		if occ.slot.Quantity != nil && countOverlapping(booked, occ.period) >= *occ.slot.Quantity {
+			continue
+		}
+		free := occ.period.subtract(blocked)
+		if r.QuantityAvailable != nil {
+			free = subtractAll(free, saturated(booked, *r.QuantityAvailable))
+		}
+		for _, p := range free {
+			result = append(result, &booking.Availability{
+				ResourceID: r.ID,
+				StartTime:  p.start,
+				EndTime:    p.end,
+			})
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool { return result[i].StartTime.Before(result[j].StartTime) })
+	return result, nil
+}
+
+// period is a half-open interval of time [start, end).
+type period struct {
+	start time.Time
+	end   time.Time
+}
+
+func (p period) overlaps(o period) bool {
+	return p.start.Before(o.end) && o.start.Before(p.end)
+}
+
+// subtract removes each of the periods in others from p and returns what is
+// left over.
+func (p period) subtract(others []period) []period {
+	return subtractAll([]period{p}, others)
+}
+
+// subtractAll removes each of the periods in others from every period in ps.
+func subtractAll(ps []period, others []period) []period {
+	for _, o := range others {
+		var next []period
+		for _, p := range ps {
+			if !p.overlaps(o) {
+				next = append(next, p)
+				continue
+			}
+			if p.start.Before(o.start) {
+				next = append(next, period{p.start, o.start})
+			}
+			if o.end.Before(p.end) {
+				next = append(next, period{o.end, p.end})
+			}
+		}
+		ps = next
+	}
+	return ps
+}
+
+// countOverlapping returns the number of periods in ps that overlap p.
+func countOverlapping(ps []period, p period) int {
+	c := 0
+	for _, v := range ps {
+		if v.overlaps(p) {
+			c++
+		}
+	}
+	return c
+}
+
+// saturated returns the periods during which at least quantity of the periods
+// in ps are in progress at the same time.
+func saturated(ps []period, quantity int) []period {
+	type edge struct {
+		at    time.Time
+		delta int
+	}
+	edges := make([]edge, 0, len(ps)*2)
+	for _, p := range ps {
+		edges = append(edges, edge{p.start, 1}, edge{p.end, -1})
+	}
+	// Ends sort before starts at the same instant so that back to back
+	// bookings are not counted as concurrent.
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].at.Equal(edges[j].at) {
+			return edges[i].delta < edges[j].delta
+		}
+		return edges[i].at.Before(edges[j].at)
+	})
+
+	var result []period
+	var open time.Time
+	active := 0
+	for _, e := range edges {
+		before := active
+		active += e.delta
+		if before < quantity && active >= quantity {
+			open = e.at
+		} else if before >= quantity && active < quantity && e.at.After(open) {
+			result = append(result, period{open, e.at})
+		}
+	}
+	return result
+}
+
+// slotOccurrence is a single instance of a slot on a specific date.
+type slotOccurrence struct {
+	period
+	slot *Slot
+}
+
+// expandSlots returns every occurrence of slots that falls entirely within
+// [start, end). Dates are walked in the location of start so that slot times
+// are interpreted in the timezone of the resource. Slots that begin or end in
+// an hour skipped by a daylight saving transition are moved forward by the
+// length of the gap and slots in a repeated hour use its first occurrence.
+func expandSlots(slots []*Slot, start, end time.Time) []slotOccurrence {
+	var result []slotOccurrence
+	loc := start.Location()
+	// Dates are walked in UTC so that days are always 24 hours long. Only the
+	// date is used to place the slots in loc.
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	last := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+	for ; !day.After(last); day = day.AddDate(0, 0, 1) {
+		for _, s := range slots {
+			wd, ok := s.toModel().Weekday()
+			if !ok || wd != day.Weekday() {
+				continue
+			}
+			st, err := time.Parse("15:04", s.StartTime)
+			if err != nil {
+				continue
+			}
+			et, err := time.Parse("15:04", s.EndTime)
+			if err != nil {
+				continue
+			}
+			p := period{
+				start: booking.LocalTime(day.Year(), day.Month(), day.Day(), st.Hour(), st.Minute(), 0, 0, loc),
+				end:   booking.LocalTime(day.Year(), day.Month(), day.Day(), et.Hour(), et.Minute(), 0, 0, loc),
+			}
+			if p.start.Before(start) || p.end.After(end) {
+				continue
+			}
+			result = append(result, slotOccurrence{p, s})
+		}
+	}
+	return result
+}
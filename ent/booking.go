@@ -9,7 +9,10 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/bookingseries"
+	"github.com/openmesh/booking/ent/customer"
 	"github.com/openmesh/booking/ent/resource"
+	"github.com/openmesh/booking/ent/user"
 )
 
 // Booking is the model entity for the Booking schema.
@@ -29,6 +32,32 @@ type Booking struct {
 	EndTime time.Time `json:"endTime,omitempty"`
 	// ResourceId holds the value of the "resourceId" field.
 	ResourceId int `json:"resourceId,omitempty"`
+	// UserId holds the value of the "userId" field.
+	UserId *int `json:"userId,omitempty"`
+	// SeriesId holds the value of the "seriesId" field.
+	SeriesId *int `json:"seriesId,omitempty"`
+	// CustomerId holds the value of the "customerId" field.
+	CustomerId *int `json:"customerId,omitempty"`
+	// ExpiresAt holds the value of the "expiresAt" field.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// Price holds the value of the "price" field.
+	Price *int `json:"price,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// CancelledAt holds the value of the "cancelledAt" field.
+	CancelledAt *time.Time `json:"cancelledAt,omitempty"`
+	// RefundAmount holds the value of the "refundAmount" field.
+	RefundAmount int `json:"refundAmount,omitempty"`
+	// FeeAmount holds the value of the "feeAmount" field.
+	FeeAmount int `json:"feeAmount,omitempty"`
+	// TaxName holds the value of the "taxName" field.
+	TaxName string `json:"taxName,omitempty"`
+	// TaxRate holds the value of the "taxRate" field.
+	TaxRate int `json:"taxRate,omitempty"`
+	// TaxInclusive holds the value of the "taxInclusive" field.
+	TaxInclusive bool `json:"taxInclusive,omitempty"`
+	// TaxAmount holds the value of the "taxAmount" field.
+	TaxAmount int `json:"taxAmount,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the BookingQuery when eager-loading is set.
 	Edges BookingEdges `json:"edges"`
@@ -38,11 +67,25 @@ type Booking struct {
 type BookingEdges struct {
 	// Metadata holds the value of the metadata edge.
 	Metadata []*BookingMetadatum `json:"metadata,omitempty"`
+	// WaitlistEntries holds the value of the waitlistEntries edge.
+	WaitlistEntries []*WaitlistEntry `json:"waitlistEntries,omitempty"`
+	// Payments holds the value of the payments edge.
+	Payments []*Payment `json:"payments,omitempty"`
+	// PromoRedemptions holds the value of the promoRedemptions edge.
+	PromoRedemptions []*PromoRedemption `json:"promoRedemptions,omitempty"`
+	// Invoices holds the value of the invoices edge.
+	Invoices []*Invoice `json:"invoices,omitempty"`
 	// Resource holds the value of the resource edge.
 	Resource *Resource `json:"resource,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Series holds the value of the series edge.
+	Series *BookingSeries `json:"series,omitempty"`
+	// Customer holds the value of the customer edge.
+	Customer *Customer `json:"customer,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [9]bool
 }
 
 // MetadataOrErr returns the Metadata value or an error if the edge
@@ -54,10 +97,46 @@ func (e BookingEdges) MetadataOrErr() ([]*BookingMetadatum, error) {
 	return nil, &NotLoadedError{edge: "metadata"}
 }
 
+// WaitlistEntriesOrErr returns the WaitlistEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e BookingEdges) WaitlistEntriesOrErr() ([]*WaitlistEntry, error) {
+	if e.loadedTypes[1] {
+		return e.WaitlistEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "waitlistEntries"}
+}
+
+// PaymentsOrErr returns the Payments value or an error if the edge
+// was not loaded in eager-loading.
+func (e BookingEdges) PaymentsOrErr() ([]*Payment, error) {
+	if e.loadedTypes[2] {
+		return e.Payments, nil
+	}
+	return nil, &NotLoadedError{edge: "payments"}
+}
+
+// PromoRedemptionsOrErr returns the PromoRedemptions value or an error if the edge
+// was not loaded in eager-loading.
+func (e BookingEdges) PromoRedemptionsOrErr() ([]*PromoRedemption, error) {
+	if e.loadedTypes[3] {
+		return e.PromoRedemptions, nil
+	}
+	return nil, &NotLoadedError{edge: "promoRedemptions"}
+}
+
+// InvoicesOrErr returns the Invoices value or an error if the edge
+// was not loaded in eager-loading.
+func (e BookingEdges) InvoicesOrErr() ([]*Invoice, error) {
+	if e.loadedTypes[4] {
+		return e.Invoices, nil
+	}
+	return nil, &NotLoadedError{edge: "invoices"}
+}
+
 // ResourceOrErr returns the Resource value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e BookingEdges) ResourceOrErr() (*Resource, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[5] {
 		if e.Resource == nil {
 			// The edge resource was loaded in eager-loading,
 			// but was not found.
@@ -68,16 +147,60 @@ func (e BookingEdges) ResourceOrErr() (*Resource, error) {
 	return nil, &NotLoadedError{edge: "resource"}
 }
 
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BookingEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[6] {
+		if e.User == nil {
+			// The edge user was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// SeriesOrErr returns the Series value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BookingEdges) SeriesOrErr() (*BookingSeries, error) {
+	if e.loadedTypes[7] {
+		if e.Series == nil {
+			// The edge series was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: bookingseries.Label}
+		}
+		return e.Series, nil
+	}
+	return nil, &NotLoadedError{edge: "series"}
+}
+
+// CustomerOrErr returns the Customer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BookingEdges) CustomerOrErr() (*Customer, error) {
+	if e.loadedTypes[8] {
+		if e.Customer == nil {
+			// The edge customer was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: customer.Label}
+		}
+		return e.Customer, nil
+	}
+	return nil, &NotLoadedError{edge: "customer"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Booking) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case booking.FieldID, booking.FieldResourceId:
+		case booking.FieldTaxInclusive:
+			values[i] = new(sql.NullBool)
+		case booking.FieldID, booking.FieldResourceId, booking.FieldUserId, booking.FieldSeriesId, booking.FieldCustomerId, booking.FieldPrice, booking.FieldRefundAmount, booking.FieldFeeAmount, booking.FieldTaxRate, booking.FieldTaxAmount:
 			values[i] = new(sql.NullInt64)
-		case booking.FieldStatus:
+		case booking.FieldStatus, booking.FieldCurrency, booking.FieldTaxName:
 			values[i] = new(sql.NullString)
-		case booking.FieldCreatedAt, booking.FieldUpdatedAt, booking.FieldStartTime, booking.FieldEndTime:
+		case booking.FieldCreatedAt, booking.FieldUpdatedAt, booking.FieldStartTime, booking.FieldEndTime, booking.FieldExpiresAt, booking.FieldCancelledAt:
 			values[i] = new(sql.NullTime)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Booking", columns[i])
@@ -136,6 +259,90 @@ func (b *Booking) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				b.ResourceId = int(value.Int64)
 			}
+		case booking.FieldUserId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field userId", values[i])
+			} else if value.Valid {
+				b.UserId = new(int)
+				*b.UserId = int(value.Int64)
+			}
+		case booking.FieldSeriesId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seriesId", values[i])
+			} else if value.Valid {
+				b.SeriesId = new(int)
+				*b.SeriesId = int(value.Int64)
+			}
+		case booking.FieldCustomerId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field customerId", values[i])
+			} else if value.Valid {
+				b.CustomerId = new(int)
+				*b.CustomerId = int(value.Int64)
+			}
+		case booking.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiresAt", values[i])
+			} else if value.Valid {
+				b.ExpiresAt = new(time.Time)
+				*b.ExpiresAt = value.Time
+			}
+		case booking.FieldPrice:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value.Valid {
+				b.Price = new(int)
+				*b.Price = int(value.Int64)
+			}
+		case booking.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				b.Currency = value.String
+			}
+		case booking.FieldCancelledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelledAt", values[i])
+			} else if value.Valid {
+				b.CancelledAt = new(time.Time)
+				*b.CancelledAt = value.Time
+			}
+		case booking.FieldRefundAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field refundAmount", values[i])
+			} else if value.Valid {
+				b.RefundAmount = int(value.Int64)
+			}
+		case booking.FieldFeeAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field feeAmount", values[i])
+			} else if value.Valid {
+				b.FeeAmount = int(value.Int64)
+			}
+		case booking.FieldTaxName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field taxName", values[i])
+			} else if value.Valid {
+				b.TaxName = value.String
+			}
+		case booking.FieldTaxRate:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field taxRate", values[i])
+			} else if value.Valid {
+				b.TaxRate = int(value.Int64)
+			}
+		case booking.FieldTaxInclusive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field taxInclusive", values[i])
+			} else if value.Valid {
+				b.TaxInclusive = value.Bool
+			}
+		case booking.FieldTaxAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field taxAmount", values[i])
+			} else if value.Valid {
+				b.TaxAmount = int(value.Int64)
+			}
 		}
 	}
 	return nil
@@ -146,11 +353,46 @@ func (b *Booking) QueryMetadata() *BookingMetadatumQuery {
 	return (&BookingClient{config: b.config}).QueryMetadata(b)
 }
 
+// QueryWaitlistEntries queries the "waitlistEntries" edge of the Booking entity.
+func (b *Booking) QueryWaitlistEntries() *WaitlistEntryQuery {
+	return (&BookingClient{config: b.config}).QueryWaitlistEntries(b)
+}
+
+// QueryPayments queries the "payments" edge of the Booking entity.
+func (b *Booking) QueryPayments() *PaymentQuery {
+	return (&BookingClient{config: b.config}).QueryPayments(b)
+}
+
+// QueryPromoRedemptions queries the "promoRedemptions" edge of the Booking entity.
+func (b *Booking) QueryPromoRedemptions() *PromoRedemptionQuery {
+	return (&BookingClient{config: b.config}).QueryPromoRedemptions(b)
+}
+
+// QueryInvoices queries the "invoices" edge of the Booking entity.
+func (b *Booking) QueryInvoices() *InvoiceQuery {
+	return (&BookingClient{config: b.config}).QueryInvoices(b)
+}
+
 // QueryResource queries the "resource" edge of the Booking entity.
 func (b *Booking) QueryResource() *ResourceQuery {
 	return (&BookingClient{config: b.config}).QueryResource(b)
 }
 
+// QueryUser queries the "user" edge of the Booking entity.
+func (b *Booking) QueryUser() *UserQuery {
+	return (&BookingClient{config: b.config}).QueryUser(b)
+}
+
+// QuerySeries queries the "series" edge of the Booking entity.
+func (b *Booking) QuerySeries() *BookingSeriesQuery {
+	return (&BookingClient{config: b.config}).QuerySeries(b)
+}
+
+// QueryCustomer queries the "customer" edge of the Booking entity.
+func (b *Booking) QueryCustomer() *CustomerQuery {
+	return (&BookingClient{config: b.config}).QueryCustomer(b)
+}
+
 // Update returns a builder for updating this Booking.
 // Note that you need to call Booking.Unwrap() before calling this method if this Booking
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -186,6 +428,44 @@ func (b *Booking) String() string {
 	builder.WriteString(b.EndTime.Format(time.ANSIC))
 	builder.WriteString(", resourceId=")
 	builder.WriteString(fmt.Sprintf("%v", b.ResourceId))
+	if v := b.UserId; v != nil {
+		builder.WriteString(", userId=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	if v := b.SeriesId; v != nil {
+		builder.WriteString(", seriesId=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	if v := b.CustomerId; v != nil {
+		builder.WriteString(", customerId=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	if v := b.ExpiresAt; v != nil {
+		builder.WriteString(", expiresAt=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := b.Price; v != nil {
+		builder.WriteString(", price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", currency=")
+	builder.WriteString(b.Currency)
+	if v := b.CancelledAt; v != nil {
+		builder.WriteString(", cancelledAt=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", refundAmount=")
+	builder.WriteString(fmt.Sprintf("%v", b.RefundAmount))
+	builder.WriteString(", feeAmount=")
+	builder.WriteString(fmt.Sprintf("%v", b.FeeAmount))
+	builder.WriteString(", taxName=")
+	builder.WriteString(b.TaxName)
+	builder.WriteString(", taxRate=")
+	builder.WriteString(fmt.Sprintf("%v", b.TaxRate))
+	builder.WriteString(", taxInclusive=")
+	builder.WriteString(fmt.Sprintf("%v", b.TaxInclusive))
+	builder.WriteString(", taxAmount=")
+	builder.WriteString(fmt.Sprintf("%v", b.TaxAmount))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -25,10 +25,50 @@ const (
 	FieldEndTime = "end_time"
 	// FieldResourceId holds the string denoting the resourceid field in the database.
 	FieldResourceId = "resource_id"
+	// FieldUserId holds the string denoting the userid field in the database.
+	FieldUserId = "user_id"
+	// FieldSeriesId holds the string denoting the seriesid field in the database.
+	FieldSeriesId = "series_id"
+	// FieldCustomerId holds the string denoting the customerid field in the database.
+	FieldCustomerId = "customer_id"
+	// FieldExpiresAt holds the string denoting the expiresat field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldPrice holds the string denoting the price field in the database.
+	FieldPrice = "price"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldCancelledAt holds the string denoting the cancelledat field in the database.
+	FieldCancelledAt = "cancelled_at"
+	// FieldRefundAmount holds the string denoting the refundamount field in the database.
+	FieldRefundAmount = "refund_amount"
+	// FieldFeeAmount holds the string denoting the feeamount field in the database.
+	FieldFeeAmount = "fee_amount"
+	// FieldTaxName holds the string denoting the taxname field in the database.
+	FieldTaxName = "tax_name"
+	// FieldTaxRate holds the string denoting the taxrate field in the database.
+	FieldTaxRate = "tax_rate"
+	// FieldTaxInclusive holds the string denoting the taxinclusive field in the database.
+	FieldTaxInclusive = "tax_inclusive"
+	// FieldTaxAmount holds the string denoting the taxamount field in the database.
+	FieldTaxAmount = "tax_amount"
 	// EdgeMetadata holds the string denoting the metadata edge name in mutations.
 	EdgeMetadata = "metadata"
+	// EdgeWaitlistEntries holds the string denoting the waitlistentries edge name in mutations.
+	EdgeWaitlistEntries = "waitlistEntries"
+	// EdgePayments holds the string denoting the payments edge name in mutations.
+	EdgePayments = "payments"
+	// EdgePromoRedemptions holds the string denoting the promoredemptions edge name in mutations.
+	EdgePromoRedemptions = "promoRedemptions"
+	// EdgeInvoices holds the string denoting the invoices edge name in mutations.
+	EdgeInvoices = "invoices"
 	// EdgeResource holds the string denoting the resource edge name in mutations.
 	EdgeResource = "resource"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeSeries holds the string denoting the series edge name in mutations.
+	EdgeSeries = "series"
+	// EdgeCustomer holds the string denoting the customer edge name in mutations.
+	EdgeCustomer = "customer"
 	// Table holds the table name of the booking in the database.
 	Table = "bookings"
 	// MetadataTable is the table that holds the metadata relation/edge.
@@ -38,6 +78,34 @@ const (
 	MetadataInverseTable = "booking_metadata"
 	// MetadataColumn is the table column denoting the metadata relation/edge.
 	MetadataColumn = "booking_id"
+	// WaitlistEntriesTable is the table that holds the waitlistEntries relation/edge.
+	WaitlistEntriesTable = "waitlist_entries"
+	// WaitlistEntriesInverseTable is the table name for the WaitlistEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "waitlistentry" package.
+	WaitlistEntriesInverseTable = "waitlist_entries"
+	// WaitlistEntriesColumn is the table column denoting the waitlistEntries relation/edge.
+	WaitlistEntriesColumn = "booking_id"
+	// PaymentsTable is the table that holds the payments relation/edge.
+	PaymentsTable = "payments"
+	// PaymentsInverseTable is the table name for the Payment entity.
+	// It exists in this package in order to avoid circular dependency with the "payment" package.
+	PaymentsInverseTable = "payments"
+	// PaymentsColumn is the table column denoting the payments relation/edge.
+	PaymentsColumn = "booking_id"
+	// PromoRedemptionsTable is the table that holds the promoRedemptions relation/edge.
+	PromoRedemptionsTable = "promo_redemptions"
+	// PromoRedemptionsInverseTable is the table name for the PromoRedemption entity.
+	// It exists in this package in order to avoid circular dependency with the "promoredemption" package.
+	PromoRedemptionsInverseTable = "promo_redemptions"
+	// PromoRedemptionsColumn is the table column denoting the promoRedemptions relation/edge.
+	PromoRedemptionsColumn = "booking_id"
+	// InvoicesTable is the table that holds the invoices relation/edge.
+	InvoicesTable = "invoices"
+	// InvoicesInverseTable is the table name for the Invoice entity.
+	// It exists in this package in order to avoid circular dependency with the "invoice" package.
+	InvoicesInverseTable = "invoices"
+	// InvoicesColumn is the table column denoting the invoices relation/edge.
+	InvoicesColumn = "booking_id"
 	// ResourceTable is the table that holds the resource relation/edge.
 	ResourceTable = "bookings"
 	// ResourceInverseTable is the table name for the Resource entity.
@@ -45,6 +113,27 @@ const (
 	ResourceInverseTable = "resources"
 	// ResourceColumn is the table column denoting the resource relation/edge.
 	ResourceColumn = "resource_id"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "bookings"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+	// SeriesTable is the table that holds the series relation/edge.
+	SeriesTable = "bookings"
+	// SeriesInverseTable is the table name for the BookingSeries entity.
+	// It exists in this package in order to avoid circular dependency with the "bookingseries" package.
+	SeriesInverseTable = "booking_series"
+	// SeriesColumn is the table column denoting the series relation/edge.
+	SeriesColumn = "series_id"
+	// CustomerTable is the table that holds the customer relation/edge.
+	CustomerTable = "bookings"
+	// CustomerInverseTable is the table name for the Customer entity.
+	// It exists in this package in order to avoid circular dependency with the "customer" package.
+	CustomerInverseTable = "customers"
+	// CustomerColumn is the table column denoting the customer relation/edge.
+	CustomerColumn = "customer_id"
 )
 
 // Columns holds all SQL columns for booking fields.
@@ -56,6 +145,19 @@ var Columns = []string{
 	FieldStartTime,
 	FieldEndTime,
 	FieldResourceId,
+	FieldUserId,
+	FieldSeriesId,
+	FieldCustomerId,
+	FieldExpiresAt,
+	FieldPrice,
+	FieldCurrency,
+	FieldCancelledAt,
+	FieldRefundAmount,
+	FieldFeeAmount,
+	FieldTaxName,
+	FieldTaxRate,
+	FieldTaxInclusive,
+	FieldTaxAmount,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -83,4 +185,16 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updatedAt" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// DefaultRefundAmount holds the default value on creation for the "refundAmount" field.
+	DefaultRefundAmount int
+	// DefaultFeeAmount holds the default value on creation for the "feeAmount" field.
+	DefaultFeeAmount int
+	// DefaultTaxRate holds the default value on creation for the "taxRate" field.
+	DefaultTaxRate int
+	// DefaultTaxInclusive holds the default value on creation for the "taxInclusive" field.
+	DefaultTaxInclusive bool
+	// DefaultTaxAmount holds the default value on creation for the "taxAmount" field.
+	DefaultTaxAmount int
 )
@@ -135,6 +135,97 @@ func ResourceId(v int) predicate.Booking {
 	})
 }
 
+// UserId applies equality check predicate on the "userId" field. It's identical to UserIdEQ.
+func UserId(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserId), v))
+	})
+}
+
+// SeriesId applies equality check predicate on the "seriesId" field. It's identical to SeriesIdEQ.
+func SeriesId(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSeriesId), v))
+	})
+}
+
+// CustomerId applies equality check predicate on the "customerId" field. It's identical to CustomerIdEQ.
+func CustomerId(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCustomerId), v))
+	})
+}
+
+// ExpiresAt applies equality check predicate on the "expiresAt" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPrice), v))
+	})
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCurrency), v))
+	})
+}
+
+// CancelledAt applies equality check predicate on the "cancelledAt" field. It's identical to CancelledAtEQ.
+func CancelledAt(v time.Time) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCancelledAt), v))
+	})
+}
+
+// RefundAmount applies equality check predicate on the "refundAmount" field. It's identical to RefundAmountEQ.
+func RefundAmount(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRefundAmount), v))
+	})
+}
+
+// FeeAmount applies equality check predicate on the "feeAmount" field. It's identical to FeeAmountEQ.
+func FeeAmount(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFeeAmount), v))
+	})
+}
+
+// TaxName applies equality check predicate on the "taxName" field. It's identical to TaxNameEQ.
+func TaxName(v string) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxName), v))
+	})
+}
+
+// TaxRate applies equality check predicate on the "taxRate" field. It's identical to TaxRateEQ.
+func TaxRate(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxRate), v))
+	})
+}
+
+// TaxInclusive applies equality check predicate on the "taxInclusive" field. It's identical to TaxInclusiveEQ.
+func TaxInclusive(v bool) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxInclusive), v))
+	})
+}
+
+// TaxAmount applies equality check predicate on the "taxAmount" field. It's identical to TaxAmountEQ.
+func TaxAmount(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxAmount), v))
+	})
+}
+
 // CreatedAtEQ applies the EQ predicate on the "createdAt" field.
 func CreatedAtEQ(v time.Time) predicate.Booking {
 	return predicate.Booking(func(s *sql.Selector) {
@@ -598,53 +689,1259 @@ func ResourceIdNotIn(vs ...int) predicate.Booking {
 	})
 }
 
-// HasMetadata applies the HasEdge predicate on the "metadata" edge.
-func HasMetadata() predicate.Booking {
+// UserIdEQ applies the EQ predicate on the "userId" field.
+func UserIdEQ(v int) predicate.Booking {
 	return predicate.Booking(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.To(MetadataTable, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, MetadataTable, MetadataColumn),
-		)
-		sqlgraph.HasNeighbors(s, step)
+		s.Where(sql.EQ(s.C(FieldUserId), v))
 	})
 }
 
-// HasMetadataWith applies the HasEdge predicate on the "metadata" edge with a given conditions (other predicates).
-func HasMetadataWith(preds ...predicate.BookingMetadatum) predicate.Booking {
+// UserIdNEQ applies the NEQ predicate on the "userId" field.
+func UserIdNEQ(v int) predicate.Booking {
 	return predicate.Booking(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.To(MetadataInverseTable, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, MetadataTable, MetadataColumn),
-		)
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
-			for _, p := range preds {
-				p(s)
-			}
-		})
+		s.Where(sql.NEQ(s.C(FieldUserId), v))
 	})
 }
 
-// HasResource applies the HasEdge predicate on the "resource" edge.
-func HasResource() predicate.Booking {
+// UserIdIn applies the In predicate on the "userId" field.
+func UserIdIn(vs ...int) predicate.Booking {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
 	return predicate.Booking(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.To(ResourceTable, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, ResourceTable, ResourceColumn),
-		)
-		sqlgraph.HasNeighbors(s, step)
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUserId), v...))
 	})
 }
 
-// HasResourceWith applies the HasEdge predicate on the "resource" edge with a given conditions (other predicates).
-func HasResourceWith(preds ...predicate.Resource) predicate.Booking {
+// UserIdNotIn applies the NotIn predicate on the "userId" field.
+func UserIdNotIn(vs ...int) predicate.Booking {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
 	return predicate.Booking(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.To(ResourceInverseTable, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, ResourceTable, ResourceColumn),
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUserId), v...))
+	})
+}
+
+// UserIdIsNil applies the IsNil predicate on the "userId" field.
+func UserIdIsNil() predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldUserId)))
+	})
+}
+
+// UserIdNotNil applies the NotNil predicate on the "userId" field.
+func UserIdNotNil() predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldUserId)))
+	})
+}
+
+// SeriesIdEQ applies the EQ predicate on the "seriesId" field.
+func SeriesIdEQ(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSeriesId), v))
+	})
+}
+
+// SeriesIdNEQ applies the NEQ predicate on the "seriesId" field.
+func SeriesIdNEQ(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSeriesId), v))
+	})
+}
+
+// SeriesIdIn applies the In predicate on the "seriesId" field.
+func SeriesIdIn(vs ...int) predicate.Booking {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Booking(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSeriesId), v...))
+	})
+}
+
+// SeriesIdNotIn applies the NotIn predicate on the "seriesId" field.
+func SeriesIdNotIn(vs ...int) predicate.Booking {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Booking(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSeriesId), v...))
+	})
+}
+
+// SeriesIdIsNil applies the IsNil predicate on the "seriesId" field.
+func SeriesIdIsNil() predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSeriesId)))
+	})
+}
+
+// SeriesIdNotNil applies the NotNil predicate on the "seriesId" field.
+func SeriesIdNotNil() predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSeriesId)))
+	})
+}
+
+// CustomerIdEQ applies the EQ predicate on the "customerId" field.
+func CustomerIdEQ(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCustomerId), v))
+	})
+}
+
+// CustomerIdNEQ applies the NEQ predicate on the "customerId" field.
+func CustomerIdNEQ(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCustomerId), v))
+	})
+}
+
+// CustomerIdIn applies the In predicate on the "customerId" field.
+func CustomerIdIn(vs ...int) predicate.Booking {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Booking(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCustomerId), v...))
+	})
+}
+
+// CustomerIdNotIn applies the NotIn predicate on the "customerId" field.
+func CustomerIdNotIn(vs ...int) predicate.Booking {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Booking(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCustomerId), v...))
+	})
+}
+
+// CustomerIdIsNil applies the IsNil predicate on the "customerId" field.
+func CustomerIdIsNil() predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCustomerId)))
+	})
+}
+
+// CustomerIdNotNil applies the NotNil predicate on the "customerId" field.
+func CustomerIdNotNil() predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCustomerId)))
+	})
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expiresAt" field.
+func ExpiresAtEQ(v time.Time) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expiresAt" field.
+func ExpiresAtNEQ(v time.Time) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtIn applies the In predicate on the "expiresAt" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Booking {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Booking(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExpiresAt), v...))
+	})
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expiresAt" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Booking {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Booking(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExpiresAt), v...))
+	})
+}
+
+// ExpiresAtGT applies the GT predicate on the "expiresAt" field.
+func ExpiresAtGT(v time.Time) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expiresAt" field.
+func ExpiresAtGTE(v time.Time) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtLT applies the LT predicate on the "expiresAt" field.
+func ExpiresAtLT(v time.Time) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expiresAt" field.
+func ExpiresAtLTE(v time.Time) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expiresAt" field.
+func ExpiresAtIsNil() predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldExpiresAt)))
+	})
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expiresAt" field.
+func ExpiresAtNotNil() predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldExpiresAt)))
+	})
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPrice), v))
+	})
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPrice), v))
+	})
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...int) predicate.Booking {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Booking(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPrice), v...))
+	})
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...int) predicate.Booking {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Booking(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPrice), v...))
+	})
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPrice), v))
+	})
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPrice), v))
+	})
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPrice), v))
+	})
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPrice), v))
+	})
+}
+
+// PriceIsNil applies the IsNil predicate on the "price" field.
+func PriceIsNil() predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPrice)))
+	})
+}
+
+// PriceNotNil applies the NotNil predicate on the "price" field.
+func PriceNotNil() predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPrice)))
+	})
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Booking {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Booking(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCurrency), v...))
+	})
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Booking {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Booking(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCurrency), v...))
+	})
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCurrency), v))
+	})
+}
+
+// CancelledAtEQ applies the EQ predicate on the "cancelledAt" field.
+func CancelledAtEQ(v time.Time) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCancelledAt), v))
+	})
+}
+
+// CancelledAtNEQ applies the NEQ predicate on the "cancelledAt" field.
+func CancelledAtNEQ(v time.Time) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCancelledAt), v))
+	})
+}
+
+// CancelledAtIn applies the In predicate on the "cancelledAt" field.
+func CancelledAtIn(vs ...time.Time) predicate.Booking {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Booking(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCancelledAt), v...))
+	})
+}
+
+// CancelledAtNotIn applies the NotIn predicate on the "cancelledAt" field.
+func CancelledAtNotIn(vs ...time.Time) predicate.Booking {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Booking(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCancelledAt), v...))
+	})
+}
+
+// CancelledAtGT applies the GT predicate on the "cancelledAt" field.
+func CancelledAtGT(v time.Time) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCancelledAt), v))
+	})
+}
+
+// CancelledAtGTE applies the GTE predicate on the "cancelledAt" field.
+func CancelledAtGTE(v time.Time) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCancelledAt), v))
+	})
+}
+
+// CancelledAtLT applies the LT predicate on the "cancelledAt" field.
+func CancelledAtLT(v time.Time) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCancelledAt), v))
+	})
+}
+
+// CancelledAtLTE applies the LTE predicate on the "cancelledAt" field.
+func CancelledAtLTE(v time.Time) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCancelledAt), v))
+	})
+}
+
+// CancelledAtIsNil applies the IsNil predicate on the "cancelledAt" field.
+func CancelledAtIsNil() predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCancelledAt)))
+	})
+}
+
+// CancelledAtNotNil applies the NotNil predicate on the "cancelledAt" field.
+func CancelledAtNotNil() predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCancelledAt)))
+	})
+}
+
+// RefundAmountEQ applies the EQ predicate on the "refundAmount" field.
+func RefundAmountEQ(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRefundAmount), v))
+	})
+}
+
+// RefundAmountNEQ applies the NEQ predicate on the "refundAmount" field.
+func RefundAmountNEQ(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRefundAmount), v))
+	})
+}
+
+// RefundAmountIn applies the In predicate on the "refundAmount" field.
+func RefundAmountIn(vs ...int) predicate.Booking {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Booking(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRefundAmount), v...))
+	})
+}
+
+// RefundAmountNotIn applies the NotIn predicate on the "refundAmount" field.
+func RefundAmountNotIn(vs ...int) predicate.Booking {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Booking(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRefundAmount), v...))
+	})
+}
+
+// RefundAmountGT applies the GT predicate on the "refundAmount" field.
+func RefundAmountGT(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRefundAmount), v))
+	})
+}
+
+// RefundAmountGTE applies the GTE predicate on the "refundAmount" field.
+func RefundAmountGTE(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRefundAmount), v))
+	})
+}
+
+// RefundAmountLT applies the LT predicate on the "refundAmount" field.
+func RefundAmountLT(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRefundAmount), v))
+	})
+}
+
+// RefundAmountLTE applies the LTE predicate on the "refundAmount" field.
+func RefundAmountLTE(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRefundAmount), v))
+	})
+}
+
+// FeeAmountEQ applies the EQ predicate on the "feeAmount" field.
+func FeeAmountEQ(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFeeAmount), v))
+	})
+}
+
+// FeeAmountNEQ applies the NEQ predicate on the "feeAmount" field.
+func FeeAmountNEQ(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldFeeAmount), v))
+	})
+}
+
+// FeeAmountIn applies the In predicate on the "feeAmount" field.
+func FeeAmountIn(vs ...int) predicate.Booking {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Booking(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldFeeAmount), v...))
+	})
+}
+
+// FeeAmountNotIn applies the NotIn predicate on the "feeAmount" field.
+func FeeAmountNotIn(vs ...int) predicate.Booking {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Booking(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldFeeAmount), v...))
+	})
+}
+
+// FeeAmountGT applies the GT predicate on the "feeAmount" field.
+func FeeAmountGT(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldFeeAmount), v))
+	})
+}
+
+// FeeAmountGTE applies the GTE predicate on the "feeAmount" field.
+func FeeAmountGTE(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldFeeAmount), v))
+	})
+}
+
+// FeeAmountLT applies the LT predicate on the "feeAmount" field.
+func FeeAmountLT(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldFeeAmount), v))
+	})
+}
+
+// FeeAmountLTE applies the LTE predicate on the "feeAmount" field.
+func FeeAmountLTE(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldFeeAmount), v))
+	})
+}
+
+// TaxNameEQ applies the EQ predicate on the "taxName" field.
+func TaxNameEQ(v string) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxName), v))
+	})
+}
+
+// TaxNameNEQ applies the NEQ predicate on the "taxName" field.
+func TaxNameNEQ(v string) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTaxName), v))
+	})
+}
+
+// TaxNameIn applies the In predicate on the "taxName" field.
+func TaxNameIn(vs ...string) predicate.Booking {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Booking(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTaxName), v...))
+	})
+}
+
+// TaxNameNotIn applies the NotIn predicate on the "taxName" field.
+func TaxNameNotIn(vs ...string) predicate.Booking {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Booking(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTaxName), v...))
+	})
+}
+
+// TaxNameGT applies the GT predicate on the "taxName" field.
+func TaxNameGT(v string) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTaxName), v))
+	})
+}
+
+// TaxNameGTE applies the GTE predicate on the "taxName" field.
+func TaxNameGTE(v string) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTaxName), v))
+	})
+}
+
+// TaxNameLT applies the LT predicate on the "taxName" field.
+func TaxNameLT(v string) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTaxName), v))
+	})
+}
+
+// TaxNameLTE applies the LTE predicate on the "taxName" field.
+func TaxNameLTE(v string) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTaxName), v))
+	})
+}
+
+// TaxNameContains applies the Contains predicate on the "taxName" field.
+func TaxNameContains(v string) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTaxName), v))
+	})
+}
+
+// TaxNameHasPrefix applies the HasPrefix predicate on the "taxName" field.
+func TaxNameHasPrefix(v string) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTaxName), v))
+	})
+}
+
+// TaxNameHasSuffix applies the HasSuffix predicate on the "taxName" field.
+func TaxNameHasSuffix(v string) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTaxName), v))
+	})
+}
+
+// TaxNameIsNil applies the IsNil predicate on the "taxName" field.
+func TaxNameIsNil() predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTaxName)))
+	})
+}
+
+// TaxNameNotNil applies the NotNil predicate on the "taxName" field.
+func TaxNameNotNil() predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTaxName)))
+	})
+}
+
+// TaxNameEqualFold applies the EqualFold predicate on the "taxName" field.
+func TaxNameEqualFold(v string) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTaxName), v))
+	})
+}
+
+// TaxNameContainsFold applies the ContainsFold predicate on the "taxName" field.
+func TaxNameContainsFold(v string) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTaxName), v))
+	})
+}
+
+// TaxRateEQ applies the EQ predicate on the "taxRate" field.
+func TaxRateEQ(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxRate), v))
+	})
+}
+
+// TaxRateNEQ applies the NEQ predicate on the "taxRate" field.
+func TaxRateNEQ(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTaxRate), v))
+	})
+}
+
+// TaxRateIn applies the In predicate on the "taxRate" field.
+func TaxRateIn(vs ...int) predicate.Booking {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Booking(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTaxRate), v...))
+	})
+}
+
+// TaxRateNotIn applies the NotIn predicate on the "taxRate" field.
+func TaxRateNotIn(vs ...int) predicate.Booking {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Booking(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTaxRate), v...))
+	})
+}
+
+// TaxRateGT applies the GT predicate on the "taxRate" field.
+func TaxRateGT(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTaxRate), v))
+	})
+}
+
+// TaxRateGTE applies the GTE predicate on the "taxRate" field.
+func TaxRateGTE(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTaxRate), v))
+	})
+}
+
+// TaxRateLT applies the LT predicate on the "taxRate" field.
+func TaxRateLT(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTaxRate), v))
+	})
+}
+
+// TaxRateLTE applies the LTE predicate on the "taxRate" field.
+func TaxRateLTE(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTaxRate), v))
+	})
+}
+
+// TaxInclusiveEQ applies the EQ predicate on the "taxInclusive" field.
+func TaxInclusiveEQ(v bool) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxInclusive), v))
+	})
+}
+
+// TaxInclusiveNEQ applies the NEQ predicate on the "taxInclusive" field.
+func TaxInclusiveNEQ(v bool) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTaxInclusive), v))
+	})
+}
+
+// TaxAmountEQ applies the EQ predicate on the "taxAmount" field.
+func TaxAmountEQ(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxAmount), v))
+	})
+}
+
+// TaxAmountNEQ applies the NEQ predicate on the "taxAmount" field.
+func TaxAmountNEQ(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTaxAmount), v))
+	})
+}
+
+// TaxAmountIn applies the In predicate on the "taxAmount" field.
+func TaxAmountIn(vs ...int) predicate.Booking {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Booking(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTaxAmount), v...))
+	})
+}
+
+// TaxAmountNotIn applies the NotIn predicate on the "taxAmount" field.
+func TaxAmountNotIn(vs ...int) predicate.Booking {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Booking(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTaxAmount), v...))
+	})
+}
+
+// TaxAmountGT applies the GT predicate on the "taxAmount" field.
+func TaxAmountGT(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTaxAmount), v))
+	})
+}
+
+// TaxAmountGTE applies the GTE predicate on the "taxAmount" field.
+func TaxAmountGTE(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTaxAmount), v))
+	})
+}
+
+// TaxAmountLT applies the LT predicate on the "taxAmount" field.
+func TaxAmountLT(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTaxAmount), v))
+	})
+}
+
+// TaxAmountLTE applies the LTE predicate on the "taxAmount" field.
+func TaxAmountLTE(v int) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTaxAmount), v))
+	})
+}
+
+// HasMetadata applies the HasEdge predicate on the "metadata" edge.
+func HasMetadata() predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(MetadataTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MetadataTable, MetadataColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMetadataWith applies the HasEdge predicate on the "metadata" edge with a given conditions (other predicates).
+func HasMetadataWith(preds ...predicate.BookingMetadatum) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(MetadataInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MetadataTable, MetadataColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWaitlistEntries applies the HasEdge predicate on the "waitlistEntries" edge.
+func HasWaitlistEntries() predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WaitlistEntriesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WaitlistEntriesTable, WaitlistEntriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWaitlistEntriesWith applies the HasEdge predicate on the "waitlistEntries" edge with a given conditions (other predicates).
+func HasWaitlistEntriesWith(preds ...predicate.WaitlistEntry) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WaitlistEntriesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WaitlistEntriesTable, WaitlistEntriesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPayments applies the HasEdge predicate on the "payments" edge.
+func HasPayments() predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PaymentsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PaymentsTable, PaymentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentsWith applies the HasEdge predicate on the "payments" edge with a given conditions (other predicates).
+func HasPaymentsWith(preds ...predicate.Payment) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PaymentsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PaymentsTable, PaymentsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPromoRedemptions applies the HasEdge predicate on the "promoRedemptions" edge.
+func HasPromoRedemptions() predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PromoRedemptionsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PromoRedemptionsTable, PromoRedemptionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPromoRedemptionsWith applies the HasEdge predicate on the "promoRedemptions" edge with a given conditions (other predicates).
+func HasPromoRedemptionsWith(preds ...predicate.PromoRedemption) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PromoRedemptionsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PromoRedemptionsTable, PromoRedemptionsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvoices applies the HasEdge predicate on the "invoices" edge.
+func HasInvoices() predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(InvoicesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InvoicesTable, InvoicesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvoicesWith applies the HasEdge predicate on the "invoices" edge with a given conditions (other predicates).
+func HasInvoicesWith(preds ...predicate.Invoice) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(InvoicesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InvoicesTable, InvoicesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasResource applies the HasEdge predicate on the "resource" edge.
+func HasResource() predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ResourceTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ResourceTable, ResourceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasResourceWith applies the HasEdge predicate on the "resource" edge with a given conditions (other predicates).
+func HasResourceWith(preds ...predicate.Resource) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ResourceInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ResourceTable, ResourceColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSeries applies the HasEdge predicate on the "series" edge.
+func HasSeries() predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(SeriesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SeriesTable, SeriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSeriesWith applies the HasEdge predicate on the "series" edge with a given conditions (other predicates).
+func HasSeriesWith(preds ...predicate.BookingSeries) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(SeriesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SeriesTable, SeriesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCustomer applies the HasEdge predicate on the "customer" edge.
+func HasCustomer() predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CustomerTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CustomerTable, CustomerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCustomerWith applies the HasEdge predicate on the "customer" edge with a given conditions (other predicates).
+func HasCustomerWith(preds ...predicate.Customer) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CustomerInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CustomerTable, CustomerColumn),
 		)
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
@@ -8,11 +8,19 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/openmesh/booking/ent/booking"
 	"github.com/openmesh/booking/ent/bookingmetadatum"
+	"github.com/openmesh/booking/ent/bookingseries"
+	"github.com/openmesh/booking/ent/customer"
+	"github.com/openmesh/booking/ent/invoice"
+	"github.com/openmesh/booking/ent/payment"
+	"github.com/openmesh/booking/ent/promoredemption"
 	"github.com/openmesh/booking/ent/resource"
+	"github.com/openmesh/booking/ent/user"
+	"github.com/openmesh/booking/ent/waitlistentry"
 )
 
 // BookingCreate is the builder for creating a Booking entity.
@@ -20,6 +28,7 @@ type BookingCreate struct {
 	config
 	mutation *BookingMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "createdAt" field.
@@ -74,6 +83,188 @@ func (bc *BookingCreate) SetResourceId(i int) *BookingCreate {
 	return bc
 }
 
+// SetUserId sets the "userId" field.
+func (bc *BookingCreate) SetUserId(i int) *BookingCreate {
+	bc.mutation.SetUserId(i)
+	return bc
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (bc *BookingCreate) SetNillableUserId(i *int) *BookingCreate {
+	if i != nil {
+		bc.SetUserId(*i)
+	}
+	return bc
+}
+
+// SetSeriesId sets the "seriesId" field.
+func (bc *BookingCreate) SetSeriesId(i int) *BookingCreate {
+	bc.mutation.SetSeriesId(i)
+	return bc
+}
+
+// SetNillableSeriesId sets the "seriesId" field if the given value is not nil.
+func (bc *BookingCreate) SetNillableSeriesId(i *int) *BookingCreate {
+	if i != nil {
+		bc.SetSeriesId(*i)
+	}
+	return bc
+}
+
+// SetCustomerId sets the "customerId" field.
+func (bc *BookingCreate) SetCustomerId(i int) *BookingCreate {
+	bc.mutation.SetCustomerId(i)
+	return bc
+}
+
+// SetNillableCustomerId sets the "customerId" field if the given value is not nil.
+func (bc *BookingCreate) SetNillableCustomerId(i *int) *BookingCreate {
+	if i != nil {
+		bc.SetCustomerId(*i)
+	}
+	return bc
+}
+
+// SetExpiresAt sets the "expiresAt" field.
+func (bc *BookingCreate) SetExpiresAt(t time.Time) *BookingCreate {
+	bc.mutation.SetExpiresAt(t)
+	return bc
+}
+
+// SetNillableExpiresAt sets the "expiresAt" field if the given value is not nil.
+func (bc *BookingCreate) SetNillableExpiresAt(t *time.Time) *BookingCreate {
+	if t != nil {
+		bc.SetExpiresAt(*t)
+	}
+	return bc
+}
+
+// SetPrice sets the "price" field.
+func (bc *BookingCreate) SetPrice(i int) *BookingCreate {
+	bc.mutation.SetPrice(i)
+	return bc
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (bc *BookingCreate) SetNillablePrice(i *int) *BookingCreate {
+	if i != nil {
+		bc.SetPrice(*i)
+	}
+	return bc
+}
+
+// SetCurrency sets the "currency" field.
+func (bc *BookingCreate) SetCurrency(s string) *BookingCreate {
+	bc.mutation.SetCurrency(s)
+	return bc
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (bc *BookingCreate) SetNillableCurrency(s *string) *BookingCreate {
+	if s != nil {
+		bc.SetCurrency(*s)
+	}
+	return bc
+}
+
+// SetCancelledAt sets the "cancelledAt" field.
+func (bc *BookingCreate) SetCancelledAt(t time.Time) *BookingCreate {
+	bc.mutation.SetCancelledAt(t)
+	return bc
+}
+
+// SetNillableCancelledAt sets the "cancelledAt" field if the given value is not nil.
+func (bc *BookingCreate) SetNillableCancelledAt(t *time.Time) *BookingCreate {
+	if t != nil {
+		bc.SetCancelledAt(*t)
+	}
+	return bc
+}
+
+// SetRefundAmount sets the "refundAmount" field.
+func (bc *BookingCreate) SetRefundAmount(i int) *BookingCreate {
+	bc.mutation.SetRefundAmount(i)
+	return bc
+}
+
+// SetNillableRefundAmount sets the "refundAmount" field if the given value is not nil.
+func (bc *BookingCreate) SetNillableRefundAmount(i *int) *BookingCreate {
+	if i != nil {
+		bc.SetRefundAmount(*i)
+	}
+	return bc
+}
+
+// SetFeeAmount sets the "feeAmount" field.
+func (bc *BookingCreate) SetFeeAmount(i int) *BookingCreate {
+	bc.mutation.SetFeeAmount(i)
+	return bc
+}
+
+// SetNillableFeeAmount sets the "feeAmount" field if the given value is not nil.
+func (bc *BookingCreate) SetNillableFeeAmount(i *int) *BookingCreate {
+	if i != nil {
+		bc.SetFeeAmount(*i)
+	}
+	return bc
+}
+
+// SetTaxName sets the "taxName" field.
+func (bc *BookingCreate) SetTaxName(s string) *BookingCreate {
+	bc.mutation.SetTaxName(s)
+	return bc
+}
+
+// SetNillableTaxName sets the "taxName" field if the given value is not nil.
+func (bc *BookingCreate) SetNillableTaxName(s *string) *BookingCreate {
+	if s != nil {
+		bc.SetTaxName(*s)
+	}
+	return bc
+}
+
+// SetTaxRate sets the "taxRate" field.
+func (bc *BookingCreate) SetTaxRate(i int) *BookingCreate {
+	bc.mutation.SetTaxRate(i)
+	return bc
+}
+
+// SetNillableTaxRate sets the "taxRate" field if the given value is not nil.
+func (bc *BookingCreate) SetNillableTaxRate(i *int) *BookingCreate {
+	if i != nil {
+		bc.SetTaxRate(*i)
+	}
+	return bc
+}
+
+// SetTaxInclusive sets the "taxInclusive" field.
+func (bc *BookingCreate) SetTaxInclusive(b bool) *BookingCreate {
+	bc.mutation.SetTaxInclusive(b)
+	return bc
+}
+
+// SetNillableTaxInclusive sets the "taxInclusive" field if the given value is not nil.
+func (bc *BookingCreate) SetNillableTaxInclusive(b *bool) *BookingCreate {
+	if b != nil {
+		bc.SetTaxInclusive(*b)
+	}
+	return bc
+}
+
+// SetTaxAmount sets the "taxAmount" field.
+func (bc *BookingCreate) SetTaxAmount(i int) *BookingCreate {
+	bc.mutation.SetTaxAmount(i)
+	return bc
+}
+
+// SetNillableTaxAmount sets the "taxAmount" field if the given value is not nil.
+func (bc *BookingCreate) SetNillableTaxAmount(i *int) *BookingCreate {
+	if i != nil {
+		bc.SetTaxAmount(*i)
+	}
+	return bc
+}
+
 // AddMetadatumIDs adds the "metadata" edge to the BookingMetadatum entity by IDs.
 func (bc *BookingCreate) AddMetadatumIDs(ids ...int) *BookingCreate {
 	bc.mutation.AddMetadatumIDs(ids...)
@@ -89,6 +280,66 @@ func (bc *BookingCreate) AddMetadata(b ...*BookingMetadatum) *BookingCreate {
 	return bc.AddMetadatumIDs(ids...)
 }
 
+// AddWaitlistEntryIDs adds the "waitlistEntries" edge to the WaitlistEntry entity by IDs.
+func (bc *BookingCreate) AddWaitlistEntryIDs(ids ...int) *BookingCreate {
+	bc.mutation.AddWaitlistEntryIDs(ids...)
+	return bc
+}
+
+// AddWaitlistEntries adds the "waitlistEntries" edges to the WaitlistEntry entity.
+func (bc *BookingCreate) AddWaitlistEntries(w ...*WaitlistEntry) *BookingCreate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return bc.AddWaitlistEntryIDs(ids...)
+}
+
+// AddPaymentIDs adds the "payments" edge to the Payment entity by IDs.
+func (bc *BookingCreate) AddPaymentIDs(ids ...int) *BookingCreate {
+	bc.mutation.AddPaymentIDs(ids...)
+	return bc
+}
+
+// AddPayments adds the "payments" edges to the Payment entity.
+func (bc *BookingCreate) AddPayments(p ...*Payment) *BookingCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bc.AddPaymentIDs(ids...)
+}
+
+// AddPromoRedemptionIDs adds the "promoRedemptions" edge to the PromoRedemption entity by IDs.
+func (bc *BookingCreate) AddPromoRedemptionIDs(ids ...int) *BookingCreate {
+	bc.mutation.AddPromoRedemptionIDs(ids...)
+	return bc
+}
+
+// AddPromoRedemptions adds the "promoRedemptions" edges to the PromoRedemption entity.
+func (bc *BookingCreate) AddPromoRedemptions(p ...*PromoRedemption) *BookingCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bc.AddPromoRedemptionIDs(ids...)
+}
+
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
+func (bc *BookingCreate) AddInvoiceIDs(ids ...int) *BookingCreate {
+	bc.mutation.AddInvoiceIDs(ids...)
+	return bc
+}
+
+// AddInvoices adds the "invoices" edges to the Invoice entity.
+func (bc *BookingCreate) AddInvoices(i ...*Invoice) *BookingCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return bc.AddInvoiceIDs(ids...)
+}
+
 // SetResourceID sets the "resource" edge to the Resource entity by ID.
 func (bc *BookingCreate) SetResourceID(id int) *BookingCreate {
 	bc.mutation.SetResourceID(id)
@@ -100,6 +351,63 @@ func (bc *BookingCreate) SetResource(r *Resource) *BookingCreate {
 	return bc.SetResourceID(r.ID)
 }
 
+// SetUserID sets the "user" edge to the User entity by ID.
+func (bc *BookingCreate) SetUserID(id int) *BookingCreate {
+	bc.mutation.SetUserID(id)
+	return bc
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (bc *BookingCreate) SetNillableUserID(id *int) *BookingCreate {
+	if id != nil {
+		bc = bc.SetUserID(*id)
+	}
+	return bc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (bc *BookingCreate) SetUser(u *User) *BookingCreate {
+	return bc.SetUserID(u.ID)
+}
+
+// SetSeriesID sets the "series" edge to the BookingSeries entity by ID.
+func (bc *BookingCreate) SetSeriesID(id int) *BookingCreate {
+	bc.mutation.SetSeriesID(id)
+	return bc
+}
+
+// SetNillableSeriesID sets the "series" edge to the BookingSeries entity by ID if the given value is not nil.
+func (bc *BookingCreate) SetNillableSeriesID(id *int) *BookingCreate {
+	if id != nil {
+		bc = bc.SetSeriesID(*id)
+	}
+	return bc
+}
+
+// SetSeries sets the "series" edge to the BookingSeries entity.
+func (bc *BookingCreate) SetSeries(b *BookingSeries) *BookingCreate {
+	return bc.SetSeriesID(b.ID)
+}
+
+// SetCustomerID sets the "customer" edge to the Customer entity by ID.
+func (bc *BookingCreate) SetCustomerID(id int) *BookingCreate {
+	bc.mutation.SetCustomerID(id)
+	return bc
+}
+
+// SetNillableCustomerID sets the "customer" edge to the Customer entity by ID if the given value is not nil.
+func (bc *BookingCreate) SetNillableCustomerID(id *int) *BookingCreate {
+	if id != nil {
+		bc = bc.SetCustomerID(*id)
+	}
+	return bc
+}
+
+// SetCustomer sets the "customer" edge to the Customer entity.
+func (bc *BookingCreate) SetCustomer(c *Customer) *BookingCreate {
+	return bc.SetCustomerID(c.ID)
+}
+
 // Mutation returns the BookingMutation object of the builder.
 func (bc *BookingCreate) Mutation() *BookingMutation {
 	return bc.mutation
@@ -187,6 +495,30 @@ func (bc *BookingCreate) defaults() error {
 		v := booking.DefaultUpdatedAt()
 		bc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := bc.mutation.Currency(); !ok {
+		v := booking.DefaultCurrency
+		bc.mutation.SetCurrency(v)
+	}
+	if _, ok := bc.mutation.RefundAmount(); !ok {
+		v := booking.DefaultRefundAmount
+		bc.mutation.SetRefundAmount(v)
+	}
+	if _, ok := bc.mutation.FeeAmount(); !ok {
+		v := booking.DefaultFeeAmount
+		bc.mutation.SetFeeAmount(v)
+	}
+	if _, ok := bc.mutation.TaxRate(); !ok {
+		v := booking.DefaultTaxRate
+		bc.mutation.SetTaxRate(v)
+	}
+	if _, ok := bc.mutation.TaxInclusive(); !ok {
+		v := booking.DefaultTaxInclusive
+		bc.mutation.SetTaxInclusive(v)
+	}
+	if _, ok := bc.mutation.TaxAmount(); !ok {
+		v := booking.DefaultTaxAmount
+		bc.mutation.SetTaxAmount(v)
+	}
 	return nil
 }
 
@@ -210,6 +542,24 @@ func (bc *BookingCreate) check() error {
 	if _, ok := bc.mutation.ResourceId(); !ok {
 		return &ValidationError{Name: "resourceId", err: errors.New(`ent: missing required field "resourceId"`)}
 	}
+	if _, ok := bc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "currency"`)}
+	}
+	if _, ok := bc.mutation.RefundAmount(); !ok {
+		return &ValidationError{Name: "refundAmount", err: errors.New(`ent: missing required field "refundAmount"`)}
+	}
+	if _, ok := bc.mutation.FeeAmount(); !ok {
+		return &ValidationError{Name: "feeAmount", err: errors.New(`ent: missing required field "feeAmount"`)}
+	}
+	if _, ok := bc.mutation.TaxRate(); !ok {
+		return &ValidationError{Name: "taxRate", err: errors.New(`ent: missing required field "taxRate"`)}
+	}
+	if _, ok := bc.mutation.TaxInclusive(); !ok {
+		return &ValidationError{Name: "taxInclusive", err: errors.New(`ent: missing required field "taxInclusive"`)}
+	}
+	if _, ok := bc.mutation.TaxAmount(); !ok {
+		return &ValidationError{Name: "taxAmount", err: errors.New(`ent: missing required field "taxAmount"`)}
+	}
 	if _, ok := bc.mutation.ResourceID(); !ok {
 		return &ValidationError{Name: "resource", err: errors.New("ent: missing required edge \"resource\"")}
 	}
@@ -240,6 +590,7 @@ func (bc *BookingCreate) createSpec() (*Booking, *sqlgraph.CreateSpec) {
 			},
 		}
 	)
+	_spec.OnConflict = bc.conflict
 	if value, ok := bc.mutation.CreatedAt(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -280,6 +631,86 @@ func (bc *BookingCreate) createSpec() (*Booking, *sqlgraph.CreateSpec) {
 		})
 		_node.EndTime = value
 	}
+	if value, ok := bc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: booking.FieldExpiresAt,
+		})
+		_node.ExpiresAt = &value
+	}
+	if value, ok := bc.mutation.Price(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: booking.FieldPrice,
+		})
+		_node.Price = &value
+	}
+	if value, ok := bc.mutation.Currency(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: booking.FieldCurrency,
+		})
+		_node.Currency = value
+	}
+	if value, ok := bc.mutation.CancelledAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: booking.FieldCancelledAt,
+		})
+		_node.CancelledAt = &value
+	}
+	if value, ok := bc.mutation.RefundAmount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: booking.FieldRefundAmount,
+		})
+		_node.RefundAmount = value
+	}
+	if value, ok := bc.mutation.FeeAmount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: booking.FieldFeeAmount,
+		})
+		_node.FeeAmount = value
+	}
+	if value, ok := bc.mutation.TaxName(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: booking.FieldTaxName,
+		})
+		_node.TaxName = value
+	}
+	if value, ok := bc.mutation.TaxRate(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: booking.FieldTaxRate,
+		})
+		_node.TaxRate = value
+	}
+	if value, ok := bc.mutation.TaxInclusive(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: booking.FieldTaxInclusive,
+		})
+		_node.TaxInclusive = value
+	}
+	if value, ok := bc.mutation.TaxAmount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: booking.FieldTaxAmount,
+		})
+		_node.TaxAmount = value
+	}
 	if nodes := bc.mutation.MetadataIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -299,73 +730,918 @@ func (bc *BookingCreate) createSpec() (*Booking, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := bc.mutation.ResourceIDs(); len(nodes) > 0 {
+	if nodes := bc.mutation.WaitlistEntriesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   booking.ResourceTable,
-			Columns: []string{booking.ResourceColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.WaitlistEntriesTable,
+			Columns: []string{booking.WaitlistEntriesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
 					Type:   field.TypeInt,
-					Column: resource.FieldID,
+					Column: waitlistentry.FieldID,
 				},
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.ResourceId = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	return _node, _spec
-}
-
-// BookingCreateBulk is the builder for creating many Booking entities in bulk.
-type BookingCreateBulk struct {
-	config
-	builders []*BookingCreate
-}
-
-// Save creates the Booking entities in the database.
-func (bcb *BookingCreateBulk) Save(ctx context.Context) ([]*Booking, error) {
-	specs := make([]*sqlgraph.CreateSpec, len(bcb.builders))
-	nodes := make([]*Booking, len(bcb.builders))
-	mutators := make([]Mutator, len(bcb.builders))
-	for i := range bcb.builders {
-		func(i int, root context.Context) {
-			builder := bcb.builders[i]
-			builder.defaults()
-			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				mutation, ok := m.(*BookingMutation)
-				if !ok {
-					return nil, fmt.Errorf("unexpected mutation type %T", m)
-				}
-				if err := builder.check(); err != nil {
-					return nil, err
-				}
-				builder.mutation = mutation
-				nodes[i], specs[i] = builder.createSpec()
-				var err error
-				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
-				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
-					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
-						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{err.Error(), err}
-						}
-					}
-				}
-				if err != nil {
-					return nil, err
-				}
-				mutation.id = &nodes[i].ID
-				mutation.done = true
-				if specs[i].ID.Value != nil {
-					id := specs[i].ID.Value.(int64)
+	if nodes := bc.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.PaymentsTable,
+			Columns: []string{booking.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: payment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.PromoRedemptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.PromoRedemptionsTable,
+			Columns: []string{booking.PromoRedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: promoredemption.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.InvoicesTable,
+			Columns: []string{booking.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.ResourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booking.ResourceTable,
+			Columns: []string{booking.ResourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: resource.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ResourceId = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booking.UserTable,
+			Columns: []string{booking.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserId = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.SeriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booking.SeriesTable,
+			Columns: []string{booking.SeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: bookingseries.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SeriesId = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booking.CustomerTable,
+			Columns: []string{booking.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: customer.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CustomerId = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Booking.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BookingUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (bc *BookingCreate) OnConflict(opts ...sql.ConflictOption) *BookingUpsertOne {
+	bc.conflict = opts
+	return &BookingUpsertOne{
+		create: bc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Booking.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bc *BookingCreate) OnConflictColumns(columns ...string) *BookingUpsertOne {
+	bc.conflict = append(bc.conflict, sql.ConflictColumns(columns...))
+	return &BookingUpsertOne{
+		create: bc,
+	}
+}
+
+type (
+	// BookingUpsertOne is the builder for "upsert"-ing
+	//  one Booking node.
+	BookingUpsertOne struct {
+		create *BookingCreate
+	}
+
+	// BookingUpsert is the "OnConflict" setter.
+	BookingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *BookingUpsert) SetCreatedAt(v time.Time) *BookingUpsert {
+	u.Set(booking.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *BookingUpsert) UpdateCreatedAt() *BookingUpsert {
+	u.SetExcluded(booking.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *BookingUpsert) SetUpdatedAt(v time.Time) *BookingUpsert {
+	u.Set(booking.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *BookingUpsert) UpdateUpdatedAt() *BookingUpsert {
+	u.SetExcluded(booking.FieldUpdatedAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *BookingUpsert) SetStatus(v string) *BookingUpsert {
+	u.Set(booking.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BookingUpsert) UpdateStatus() *BookingUpsert {
+	u.SetExcluded(booking.FieldStatus)
+	return u
+}
+
+// SetStartTime sets the "startTime" field.
+func (u *BookingUpsert) SetStartTime(v time.Time) *BookingUpsert {
+	u.Set(booking.FieldStartTime, v)
+	return u
+}
+
+// UpdateStartTime sets the "startTime" field to the value that was provided on create.
+func (u *BookingUpsert) UpdateStartTime() *BookingUpsert {
+	u.SetExcluded(booking.FieldStartTime)
+	return u
+}
+
+// SetEndTime sets the "endTime" field.
+func (u *BookingUpsert) SetEndTime(v time.Time) *BookingUpsert {
+	u.Set(booking.FieldEndTime, v)
+	return u
+}
+
+// UpdateEndTime sets the "endTime" field to the value that was provided on create.
+func (u *BookingUpsert) UpdateEndTime() *BookingUpsert {
+	u.SetExcluded(booking.FieldEndTime)
+	return u
+}
+
+// SetResourceId sets the "resourceId" field.
+func (u *BookingUpsert) SetResourceId(v int) *BookingUpsert {
+	u.Set(booking.FieldResourceId, v)
+	return u
+}
+
+// UpdateResourceId sets the "resourceId" field to the value that was provided on create.
+func (u *BookingUpsert) UpdateResourceId() *BookingUpsert {
+	u.SetExcluded(booking.FieldResourceId)
+	return u
+}
+
+// SetUserId sets the "userId" field.
+func (u *BookingUpsert) SetUserId(v int) *BookingUpsert {
+	u.Set(booking.FieldUserId, v)
+	return u
+}
+
+// UpdateUserId sets the "userId" field to the value that was provided on create.
+func (u *BookingUpsert) UpdateUserId() *BookingUpsert {
+	u.SetExcluded(booking.FieldUserId)
+	return u
+}
+
+// ClearUserId clears the value of the "userId" field.
+func (u *BookingUpsert) ClearUserId() *BookingUpsert {
+	u.SetNull(booking.FieldUserId)
+	return u
+}
+
+// SetSeriesId sets the "seriesId" field.
+func (u *BookingUpsert) SetSeriesId(v int) *BookingUpsert {
+	u.Set(booking.FieldSeriesId, v)
+	return u
+}
+
+// UpdateSeriesId sets the "seriesId" field to the value that was provided on create.
+func (u *BookingUpsert) UpdateSeriesId() *BookingUpsert {
+	u.SetExcluded(booking.FieldSeriesId)
+	return u
+}
+
+// ClearSeriesId clears the value of the "seriesId" field.
+func (u *BookingUpsert) ClearSeriesId() *BookingUpsert {
+	u.SetNull(booking.FieldSeriesId)
+	return u
+}
+
+// SetCustomerId sets the "customerId" field.
+func (u *BookingUpsert) SetCustomerId(v int) *BookingUpsert {
+	u.Set(booking.FieldCustomerId, v)
+	return u
+}
+
+// UpdateCustomerId sets the "customerId" field to the value that was provided on create.
+func (u *BookingUpsert) UpdateCustomerId() *BookingUpsert {
+	u.SetExcluded(booking.FieldCustomerId)
+	return u
+}
+
+// ClearCustomerId clears the value of the "customerId" field.
+func (u *BookingUpsert) ClearCustomerId() *BookingUpsert {
+	u.SetNull(booking.FieldCustomerId)
+	return u
+}
+
+// SetExpiresAt sets the "expiresAt" field.
+func (u *BookingUpsert) SetExpiresAt(v time.Time) *BookingUpsert {
+	u.Set(booking.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expiresAt" field to the value that was provided on create.
+func (u *BookingUpsert) UpdateExpiresAt() *BookingUpsert {
+	u.SetExcluded(booking.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expiresAt" field.
+func (u *BookingUpsert) ClearExpiresAt() *BookingUpsert {
+	u.SetNull(booking.FieldExpiresAt)
+	return u
+}
+
+// SetPrice sets the "price" field.
+func (u *BookingUpsert) SetPrice(v int) *BookingUpsert {
+	u.Set(booking.FieldPrice, v)
+	return u
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *BookingUpsert) UpdatePrice() *BookingUpsert {
+	u.SetExcluded(booking.FieldPrice)
+	return u
+}
+
+// ClearPrice clears the value of the "price" field.
+func (u *BookingUpsert) ClearPrice() *BookingUpsert {
+	u.SetNull(booking.FieldPrice)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *BookingUpsert) SetCurrency(v string) *BookingUpsert {
+	u.Set(booking.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *BookingUpsert) UpdateCurrency() *BookingUpsert {
+	u.SetExcluded(booking.FieldCurrency)
+	return u
+}
+
+// SetCancelledAt sets the "cancelledAt" field.
+func (u *BookingUpsert) SetCancelledAt(v time.Time) *BookingUpsert {
+	u.Set(booking.FieldCancelledAt, v)
+	return u
+}
+
+// UpdateCancelledAt sets the "cancelledAt" field to the value that was provided on create.
+func (u *BookingUpsert) UpdateCancelledAt() *BookingUpsert {
+	u.SetExcluded(booking.FieldCancelledAt)
+	return u
+}
+
+// ClearCancelledAt clears the value of the "cancelledAt" field.
+func (u *BookingUpsert) ClearCancelledAt() *BookingUpsert {
+	u.SetNull(booking.FieldCancelledAt)
+	return u
+}
+
+// SetRefundAmount sets the "refundAmount" field.
+func (u *BookingUpsert) SetRefundAmount(v int) *BookingUpsert {
+	u.Set(booking.FieldRefundAmount, v)
+	return u
+}
+
+// UpdateRefundAmount sets the "refundAmount" field to the value that was provided on create.
+func (u *BookingUpsert) UpdateRefundAmount() *BookingUpsert {
+	u.SetExcluded(booking.FieldRefundAmount)
+	return u
+}
+
+// SetFeeAmount sets the "feeAmount" field.
+func (u *BookingUpsert) SetFeeAmount(v int) *BookingUpsert {
+	u.Set(booking.FieldFeeAmount, v)
+	return u
+}
+
+// UpdateFeeAmount sets the "feeAmount" field to the value that was provided on create.
+func (u *BookingUpsert) UpdateFeeAmount() *BookingUpsert {
+	u.SetExcluded(booking.FieldFeeAmount)
+	return u
+}
+
+// SetTaxName sets the "taxName" field.
+func (u *BookingUpsert) SetTaxName(v string) *BookingUpsert {
+	u.Set(booking.FieldTaxName, v)
+	return u
+}
+
+// UpdateTaxName sets the "taxName" field to the value that was provided on create.
+func (u *BookingUpsert) UpdateTaxName() *BookingUpsert {
+	u.SetExcluded(booking.FieldTaxName)
+	return u
+}
+
+// ClearTaxName clears the value of the "taxName" field.
+func (u *BookingUpsert) ClearTaxName() *BookingUpsert {
+	u.SetNull(booking.FieldTaxName)
+	return u
+}
+
+// SetTaxRate sets the "taxRate" field.
+func (u *BookingUpsert) SetTaxRate(v int) *BookingUpsert {
+	u.Set(booking.FieldTaxRate, v)
+	return u
+}
+
+// UpdateTaxRate sets the "taxRate" field to the value that was provided on create.
+func (u *BookingUpsert) UpdateTaxRate() *BookingUpsert {
+	u.SetExcluded(booking.FieldTaxRate)
+	return u
+}
+
+// SetTaxInclusive sets the "taxInclusive" field.
+func (u *BookingUpsert) SetTaxInclusive(v bool) *BookingUpsert {
+	u.Set(booking.FieldTaxInclusive, v)
+	return u
+}
+
+// UpdateTaxInclusive sets the "taxInclusive" field to the value that was provided on create.
+func (u *BookingUpsert) UpdateTaxInclusive() *BookingUpsert {
+	u.SetExcluded(booking.FieldTaxInclusive)
+	return u
+}
+
+// SetTaxAmount sets the "taxAmount" field.
+func (u *BookingUpsert) SetTaxAmount(v int) *BookingUpsert {
+	u.Set(booking.FieldTaxAmount, v)
+	return u
+}
+
+// UpdateTaxAmount sets the "taxAmount" field to the value that was provided on create.
+func (u *BookingUpsert) UpdateTaxAmount() *BookingUpsert {
+	u.SetExcluded(booking.FieldTaxAmount)
+	return u
+}
+
+// UpdateNewValues updates the fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Booking.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BookingUpsertOne) UpdateNewValues() *BookingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Booking.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BookingUpsertOne) Ignore() *BookingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BookingUpsertOne) DoNothing() *BookingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BookingCreate.OnConflict
+// documentation for more info.
+func (u *BookingUpsertOne) Update(set func(*BookingUpsert)) *BookingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BookingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *BookingUpsertOne) SetCreatedAt(v time.Time) *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *BookingUpsertOne) UpdateCreatedAt() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *BookingUpsertOne) SetUpdatedAt(v time.Time) *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *BookingUpsertOne) UpdateUpdatedAt() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *BookingUpsertOne) SetStatus(v string) *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BookingUpsertOne) UpdateStatus() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetStartTime sets the "startTime" field.
+func (u *BookingUpsertOne) SetStartTime(v time.Time) *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetStartTime(v)
+	})
+}
+
+// UpdateStartTime sets the "startTime" field to the value that was provided on create.
+func (u *BookingUpsertOne) UpdateStartTime() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateStartTime()
+	})
+}
+
+// SetEndTime sets the "endTime" field.
+func (u *BookingUpsertOne) SetEndTime(v time.Time) *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetEndTime(v)
+	})
+}
+
+// UpdateEndTime sets the "endTime" field to the value that was provided on create.
+func (u *BookingUpsertOne) UpdateEndTime() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateEndTime()
+	})
+}
+
+// SetResourceId sets the "resourceId" field.
+func (u *BookingUpsertOne) SetResourceId(v int) *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetResourceId(v)
+	})
+}
+
+// UpdateResourceId sets the "resourceId" field to the value that was provided on create.
+func (u *BookingUpsertOne) UpdateResourceId() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateResourceId()
+	})
+}
+
+// SetUserId sets the "userId" field.
+func (u *BookingUpsertOne) SetUserId(v int) *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetUserId(v)
+	})
+}
+
+// UpdateUserId sets the "userId" field to the value that was provided on create.
+func (u *BookingUpsertOne) UpdateUserId() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateUserId()
+	})
+}
+
+// ClearUserId clears the value of the "userId" field.
+func (u *BookingUpsertOne) ClearUserId() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.ClearUserId()
+	})
+}
+
+// SetSeriesId sets the "seriesId" field.
+func (u *BookingUpsertOne) SetSeriesId(v int) *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetSeriesId(v)
+	})
+}
+
+// UpdateSeriesId sets the "seriesId" field to the value that was provided on create.
+func (u *BookingUpsertOne) UpdateSeriesId() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateSeriesId()
+	})
+}
+
+// ClearSeriesId clears the value of the "seriesId" field.
+func (u *BookingUpsertOne) ClearSeriesId() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.ClearSeriesId()
+	})
+}
+
+// SetCustomerId sets the "customerId" field.
+func (u *BookingUpsertOne) SetCustomerId(v int) *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetCustomerId(v)
+	})
+}
+
+// UpdateCustomerId sets the "customerId" field to the value that was provided on create.
+func (u *BookingUpsertOne) UpdateCustomerId() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateCustomerId()
+	})
+}
+
+// ClearCustomerId clears the value of the "customerId" field.
+func (u *BookingUpsertOne) ClearCustomerId() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.ClearCustomerId()
+	})
+}
+
+// SetExpiresAt sets the "expiresAt" field.
+func (u *BookingUpsertOne) SetExpiresAt(v time.Time) *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expiresAt" field to the value that was provided on create.
+func (u *BookingUpsertOne) UpdateExpiresAt() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expiresAt" field.
+func (u *BookingUpsertOne) ClearExpiresAt() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *BookingUpsertOne) SetPrice(v int) *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *BookingUpsertOne) UpdatePrice() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// ClearPrice clears the value of the "price" field.
+func (u *BookingUpsertOne) ClearPrice() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.ClearPrice()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *BookingUpsertOne) SetCurrency(v string) *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *BookingUpsertOne) UpdateCurrency() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetCancelledAt sets the "cancelledAt" field.
+func (u *BookingUpsertOne) SetCancelledAt(v time.Time) *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetCancelledAt(v)
+	})
+}
+
+// UpdateCancelledAt sets the "cancelledAt" field to the value that was provided on create.
+func (u *BookingUpsertOne) UpdateCancelledAt() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateCancelledAt()
+	})
+}
+
+// ClearCancelledAt clears the value of the "cancelledAt" field.
+func (u *BookingUpsertOne) ClearCancelledAt() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.ClearCancelledAt()
+	})
+}
+
+// SetRefundAmount sets the "refundAmount" field.
+func (u *BookingUpsertOne) SetRefundAmount(v int) *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetRefundAmount(v)
+	})
+}
+
+// UpdateRefundAmount sets the "refundAmount" field to the value that was provided on create.
+func (u *BookingUpsertOne) UpdateRefundAmount() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateRefundAmount()
+	})
+}
+
+// SetFeeAmount sets the "feeAmount" field.
+func (u *BookingUpsertOne) SetFeeAmount(v int) *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetFeeAmount(v)
+	})
+}
+
+// UpdateFeeAmount sets the "feeAmount" field to the value that was provided on create.
+func (u *BookingUpsertOne) UpdateFeeAmount() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateFeeAmount()
+	})
+}
+
+// SetTaxName sets the "taxName" field.
+func (u *BookingUpsertOne) SetTaxName(v string) *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetTaxName(v)
+	})
+}
+
+// UpdateTaxName sets the "taxName" field to the value that was provided on create.
+func (u *BookingUpsertOne) UpdateTaxName() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateTaxName()
+	})
+}
+
+// ClearTaxName clears the value of the "taxName" field.
+func (u *BookingUpsertOne) ClearTaxName() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.ClearTaxName()
+	})
+}
+
+// SetTaxRate sets the "taxRate" field.
+func (u *BookingUpsertOne) SetTaxRate(v int) *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetTaxRate(v)
+	})
+}
+
+// UpdateTaxRate sets the "taxRate" field to the value that was provided on create.
+func (u *BookingUpsertOne) UpdateTaxRate() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateTaxRate()
+	})
+}
+
+// SetTaxInclusive sets the "taxInclusive" field.
+func (u *BookingUpsertOne) SetTaxInclusive(v bool) *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetTaxInclusive(v)
+	})
+}
+
+// UpdateTaxInclusive sets the "taxInclusive" field to the value that was provided on create.
+func (u *BookingUpsertOne) UpdateTaxInclusive() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateTaxInclusive()
+	})
+}
+
+// SetTaxAmount sets the "taxAmount" field.
+func (u *BookingUpsertOne) SetTaxAmount(v int) *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetTaxAmount(v)
+	})
+}
+
+// UpdateTaxAmount sets the "taxAmount" field to the value that was provided on create.
+func (u *BookingUpsertOne) UpdateTaxAmount() *BookingUpsertOne {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateTaxAmount()
+	})
+}
+
+// Exec executes the query.
+func (u *BookingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BookingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BookingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BookingUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BookingUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BookingCreateBulk is the builder for creating many Booking entities in bulk.
+type BookingCreateBulk struct {
+	config
+	builders []*BookingCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Booking entities in the database.
+func (bcb *BookingCreateBulk) Save(ctx context.Context) ([]*Booking, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(bcb.builders))
+	nodes := make([]*Booking, len(bcb.builders))
+	mutators := make([]Mutator, len(bcb.builders))
+	for i := range bcb.builders {
+		func(i int, root context.Context) {
+			builder := bcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BookingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = bcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
 					nodes[i].ID = int(id)
 				}
 				return nodes[i], nil
@@ -405,3 +1681,419 @@ func (bcb *BookingCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Booking.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BookingUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (bcb *BookingCreateBulk) OnConflict(opts ...sql.ConflictOption) *BookingUpsertBulk {
+	bcb.conflict = opts
+	return &BookingUpsertBulk{
+		create: bcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Booking.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bcb *BookingCreateBulk) OnConflictColumns(columns ...string) *BookingUpsertBulk {
+	bcb.conflict = append(bcb.conflict, sql.ConflictColumns(columns...))
+	return &BookingUpsertBulk{
+		create: bcb,
+	}
+}
+
+// BookingUpsertBulk is the builder for "upsert"-ing
+// a bulk of Booking nodes.
+type BookingUpsertBulk struct {
+	create *BookingCreateBulk
+}
+
+// UpdateNewValues updates the fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Booking.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BookingUpsertBulk) UpdateNewValues() *BookingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Booking.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BookingUpsertBulk) Ignore() *BookingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BookingUpsertBulk) DoNothing() *BookingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BookingCreateBulk.OnConflict
+// documentation for more info.
+func (u *BookingUpsertBulk) Update(set func(*BookingUpsert)) *BookingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BookingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *BookingUpsertBulk) SetCreatedAt(v time.Time) *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *BookingUpsertBulk) UpdateCreatedAt() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *BookingUpsertBulk) SetUpdatedAt(v time.Time) *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *BookingUpsertBulk) UpdateUpdatedAt() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *BookingUpsertBulk) SetStatus(v string) *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BookingUpsertBulk) UpdateStatus() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetStartTime sets the "startTime" field.
+func (u *BookingUpsertBulk) SetStartTime(v time.Time) *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetStartTime(v)
+	})
+}
+
+// UpdateStartTime sets the "startTime" field to the value that was provided on create.
+func (u *BookingUpsertBulk) UpdateStartTime() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateStartTime()
+	})
+}
+
+// SetEndTime sets the "endTime" field.
+func (u *BookingUpsertBulk) SetEndTime(v time.Time) *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetEndTime(v)
+	})
+}
+
+// UpdateEndTime sets the "endTime" field to the value that was provided on create.
+func (u *BookingUpsertBulk) UpdateEndTime() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateEndTime()
+	})
+}
+
+// SetResourceId sets the "resourceId" field.
+func (u *BookingUpsertBulk) SetResourceId(v int) *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetResourceId(v)
+	})
+}
+
+// UpdateResourceId sets the "resourceId" field to the value that was provided on create.
+func (u *BookingUpsertBulk) UpdateResourceId() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateResourceId()
+	})
+}
+
+// SetUserId sets the "userId" field.
+func (u *BookingUpsertBulk) SetUserId(v int) *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetUserId(v)
+	})
+}
+
+// UpdateUserId sets the "userId" field to the value that was provided on create.
+func (u *BookingUpsertBulk) UpdateUserId() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateUserId()
+	})
+}
+
+// ClearUserId clears the value of the "userId" field.
+func (u *BookingUpsertBulk) ClearUserId() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.ClearUserId()
+	})
+}
+
+// SetSeriesId sets the "seriesId" field.
+func (u *BookingUpsertBulk) SetSeriesId(v int) *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetSeriesId(v)
+	})
+}
+
+// UpdateSeriesId sets the "seriesId" field to the value that was provided on create.
+func (u *BookingUpsertBulk) UpdateSeriesId() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateSeriesId()
+	})
+}
+
+// ClearSeriesId clears the value of the "seriesId" field.
+func (u *BookingUpsertBulk) ClearSeriesId() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.ClearSeriesId()
+	})
+}
+
+// SetCustomerId sets the "customerId" field.
+func (u *BookingUpsertBulk) SetCustomerId(v int) *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetCustomerId(v)
+	})
+}
+
+// UpdateCustomerId sets the "customerId" field to the value that was provided on create.
+func (u *BookingUpsertBulk) UpdateCustomerId() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateCustomerId()
+	})
+}
+
+// ClearCustomerId clears the value of the "customerId" field.
+func (u *BookingUpsertBulk) ClearCustomerId() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.ClearCustomerId()
+	})
+}
+
+// SetExpiresAt sets the "expiresAt" field.
+func (u *BookingUpsertBulk) SetExpiresAt(v time.Time) *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expiresAt" field to the value that was provided on create.
+func (u *BookingUpsertBulk) UpdateExpiresAt() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expiresAt" field.
+func (u *BookingUpsertBulk) ClearExpiresAt() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *BookingUpsertBulk) SetPrice(v int) *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *BookingUpsertBulk) UpdatePrice() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// ClearPrice clears the value of the "price" field.
+func (u *BookingUpsertBulk) ClearPrice() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.ClearPrice()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *BookingUpsertBulk) SetCurrency(v string) *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *BookingUpsertBulk) UpdateCurrency() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetCancelledAt sets the "cancelledAt" field.
+func (u *BookingUpsertBulk) SetCancelledAt(v time.Time) *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetCancelledAt(v)
+	})
+}
+
+// UpdateCancelledAt sets the "cancelledAt" field to the value that was provided on create.
+func (u *BookingUpsertBulk) UpdateCancelledAt() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateCancelledAt()
+	})
+}
+
+// ClearCancelledAt clears the value of the "cancelledAt" field.
+func (u *BookingUpsertBulk) ClearCancelledAt() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.ClearCancelledAt()
+	})
+}
+
+// SetRefundAmount sets the "refundAmount" field.
+func (u *BookingUpsertBulk) SetRefundAmount(v int) *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetRefundAmount(v)
+	})
+}
+
+// UpdateRefundAmount sets the "refundAmount" field to the value that was provided on create.
+func (u *BookingUpsertBulk) UpdateRefundAmount() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateRefundAmount()
+	})
+}
+
+// SetFeeAmount sets the "feeAmount" field.
+func (u *BookingUpsertBulk) SetFeeAmount(v int) *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetFeeAmount(v)
+	})
+}
+
+// UpdateFeeAmount sets the "feeAmount" field to the value that was provided on create.
+func (u *BookingUpsertBulk) UpdateFeeAmount() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateFeeAmount()
+	})
+}
+
+// SetTaxName sets the "taxName" field.
+func (u *BookingUpsertBulk) SetTaxName(v string) *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetTaxName(v)
+	})
+}
+
+// UpdateTaxName sets the "taxName" field to the value that was provided on create.
+func (u *BookingUpsertBulk) UpdateTaxName() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateTaxName()
+	})
+}
+
+// ClearTaxName clears the value of the "taxName" field.
+func (u *BookingUpsertBulk) ClearTaxName() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.ClearTaxName()
+	})
+}
+
+// SetTaxRate sets the "taxRate" field.
+func (u *BookingUpsertBulk) SetTaxRate(v int) *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetTaxRate(v)
+	})
+}
+
+// UpdateTaxRate sets the "taxRate" field to the value that was provided on create.
+func (u *BookingUpsertBulk) UpdateTaxRate() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateTaxRate()
+	})
+}
+
+// SetTaxInclusive sets the "taxInclusive" field.
+func (u *BookingUpsertBulk) SetTaxInclusive(v bool) *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetTaxInclusive(v)
+	})
+}
+
+// UpdateTaxInclusive sets the "taxInclusive" field to the value that was provided on create.
+func (u *BookingUpsertBulk) UpdateTaxInclusive() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateTaxInclusive()
+	})
+}
+
+// SetTaxAmount sets the "taxAmount" field.
+func (u *BookingUpsertBulk) SetTaxAmount(v int) *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.SetTaxAmount(v)
+	})
+}
+
+// UpdateTaxAmount sets the "taxAmount" field to the value that was provided on create.
+func (u *BookingUpsertBulk) UpdateTaxAmount() *BookingUpsertBulk {
+	return u.Update(func(s *BookingUpsert) {
+		s.UpdateTaxAmount()
+	})
+}
+
+// Exec executes the query.
+func (u *BookingUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BookingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BookingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BookingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
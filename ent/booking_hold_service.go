@@ -0,0 +1,375 @@
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/privacy"
+	"github.com/openmesh/booking"
+	entbooking "github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/bookingmetadatum"
+)
+
+// Creates a held booking that occupies the resource until it expires. The
+// hold is checked in the same way as a new booking, except that required
+// booking fields can be left out until it is confirmed.
+func (s *bookingService) HoldBooking(
+	ctx context.Context,
+	req booking.HoldBookingRequest,
+) booking.HoldBookingResponse {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return booking.HoldBookingResponse{
+			Err: fmt.Errorf("failed to start transaction: %w", err),
+		}
+	}
+
+	err = checkBookingTimes(ctx, tx, req.ResourceID, req.StartTime, req.EndTime)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.HoldBookingResponse{
+			Err: fmt.Errorf("booking time check failed: %w", err),
+		}
+	}
+
+	err = checkForBookingTimeConflict(ctx, tx, req.ResourceID, req.StartTime, req.EndTime)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.HoldBookingResponse{
+			Err: fmt.Errorf("booking time conflict check failed: %w", err),
+		}
+	}
+
+	expiresAt := time.Now().Add(req.HoldDuration())
+	b, err := createBooking(ctx, tx, booking.CreateBookingRequest{
+		ResourceID: req.ResourceID,
+		Metadata:   req.Metadata,
+		Status:     booking.BookingStatusHeld,
+		StartTime:  req.StartTime,
+		EndTime:    req.EndTime,
+		Segment:    req.Segment,
+		PromoCode:  req.PromoCode,
+		Customer:   req.Customer,
+	}, &expiresAt, func(b *Booking) (*Booking, error) {
+		b.Edges.Metadata, err = b.QueryMetadata().All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query metadata")
+		}
+		b.Edges.Resource, err = b.QueryResource().First(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query resource")
+		}
+		return b, nil
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.HoldBookingResponse{
+			Err: fmt.Errorf("failed to hold booking: %w", err),
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return booking.HoldBookingResponse{
+			Err: fmt.Errorf("failed to commit transaction: %w", err),
+		}
+	}
+
+	return booking.HoldBookingResponse{
+		Booking: b.toModel(),
+	}
+}
+
+// Confirms a held booking. The booking is locked while it is confirmed so that
+// it can't expire at the same time. Metadata in the request is added to the
+// booking, replacing existing values with the same keys, and the result is
+// checked against the booking fields of the resource. Bookings that need a
+// deposit become pending until the deposit is paid.
+func (s *bookingService) ConfirmBooking(
+	ctx context.Context,
+	req booking.ConfirmBookingRequest,
+) booking.ConfirmBookingResponse {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return booking.ConfirmBookingResponse{
+			Err: fmt.Errorf("failed to start transaction: %w", err),
+		}
+	}
+
+	existing, err := findBookingByID(ctx, tx, req.ID, func(bq *BookingQuery) *BookingQuery {
+		return bq.ForUpdate()
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.ConfirmBookingResponse{Err: err}
+	}
+	if existing.Status != booking.BookingStatusHeld {
+		_ = tx.Rollback()
+		return booking.ConfirmBookingResponse{
+			Err: booking.Errorf(booking.EBOOKINGNOTHELD, "Booking with ID %d is not held", req.ID),
+		}
+	}
+	if holdExpired(existing, time.Now()) {
+		_ = tx.Rollback()
+		return booking.ConfirmBookingResponse{
+			Err: booking.Errorf(booking.EHOLDEXPIRED, "The hold on booking with ID %d has expired", req.ID),
+		}
+	}
+
+	r, err := existing.QueryResource().Only(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.ConfirmBookingResponse{
+			Err: fmt.Errorf("failed to query resource: %w", err),
+		}
+	}
+	// The metadata of the hold and of the request has to satisfy the booking
+	// fields of the resource together, including the required ones.
+	err = checkConfirmedMetadata(ctx, existing, r, req.Metadata)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.ConfirmBookingResponse{Err: err}
+	}
+
+	for k, v := range req.Metadata {
+		_, err = tx.BookingMetadatum.
+			Delete().
+			Where(bookingmetadatum.BookingId(req.ID), bookingmetadatum.Key(k)).
+			Exec(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return booking.ConfirmBookingResponse{
+				Err: fmt.Errorf("failed to replace metadata: %w", err),
+			}
+		}
+		_, err = tx.BookingMetadatum.
+			Create().
+			SetBookingId(req.ID).
+			SetKey(k).
+			SetValue(v).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return booking.ConfirmBookingResponse{
+				Err: fmt.Errorf("failed to add metadata: %w", err),
+			}
+		}
+	}
+
+	// Holds that need a deposit are kept as pending bookings until it is paid.
+	deposit := depositRequired(s.payments, r)
+	status := booking.BookingStatusConfirmed
+	if deposit {
+		status = booking.BookingStatusPending
+	}
+
+	b, err := tx.Booking.
+		UpdateOneID(req.ID).
+		SetStatus(status).
+		ClearExpiresAt().
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.ConfirmBookingResponse{
+			Err: fmt.Errorf("failed to confirm booking: %w", err),
+		}
+	}
+	err = settleWaitlistOffer(ctx, tx, req.ID, booking.WaitlistStatusBooked)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.ConfirmBookingResponse{Err: err}
+	}
+	b.Edges.Resource = r
+	b.Edges.Metadata, err = b.QueryMetadata().All(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.ConfirmBookingResponse{
+			Err: fmt.Errorf("failed to query metadata: %w", err),
+		}
+	}
+
+	var p *Payment
+	if deposit {
+		b, p, err = requestDeposit(ctx, tx, s.payments, b, r)
+		if err != nil {
+			_ = tx.Rollback()
+			return booking.ConfirmBookingResponse{Err: err}
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return booking.ConfirmBookingResponse{
+			Err: fmt.Errorf("failed to commit transaction: %w", err),
+		}
+	}
+
+	return booking.ConfirmBookingResponse{
+		Booking:        b.toModel(),
+		PreviousStatus: existing.Status,
+		Payment:        depositModel(p),
+	}
+}
+
+// checkConfirmedMetadata validates the metadata of held booking b merged with
+// metadata against the booking fields of its resource r. Returns EINVALID with
+// an error named after the key of each field that is missing or invalid.
+func checkConfirmedMetadata(ctx context.Context, b *Booking, r *Resource, metadata map[string]string) error {
+	fields, err := r.bookingFields()
+	if err != nil {
+		return err
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	held, err := b.QueryMetadata().All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query metadata: %w", err)
+	}
+	merged := make(map[string]string, len(held)+len(metadata))
+	for _, m := range held {
+		merged[m.Key] = m.Value
+	}
+	for k, v := range metadata {
+		merged[k] = v
+	}
+	if errs := fields.ValidateMetadata(merged); len(errs) > 0 {
+		return booking.ValidationErrorf("", errs...)
+	}
+	return nil
+}
+
+// holdExpired returns true if b is held and its hold ran out at or before now.
+func holdExpired(b *Booking, now time.Time) bool {
+	return b.Status == booking.BookingStatusHeld && b.ExpiresAt != nil && !b.ExpiresAt.After(now)
+}
+
+type bookingHoldService struct {
+	client *Client
+}
+
+// NewBookingHoldService constructs a new instance of a
+// booking.BookingHoldService using ent as its persistence layer. Holds are
+// released on behalf of every organization so privacy rules are bypassed.
+func NewBookingHoldService(client *Client) *bookingHoldService {
+	return &bookingHoldService{client}
+}
+
+// ExpireBookingHolds marks up to limit held or pending bookings that expired
+// at or before now as expired, oldest first. Pending bookings only expire if
+// they were made through the public API and their deposit wasn't paid in
+// time. Each booking is only expired if it is still held or pending so that
+// bookings confirmed in the meantime are left alone. The time of each expired
+// booking is offered to the waitlist of its resource in the same transaction.
+func (s *bookingHoldService) ExpireBookingHolds(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]booking.BookingStatusChangedPayload, []booking.WaitlistOffer, error) {
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	due, err := s.client.Booking.
+		Query().
+		Where(
+			entbooking.StatusIn(booking.BookingStatusHeld, booking.BookingStatusPending),
+			entbooking.ExpiresAtLTE(now),
+		).
+		Order(Asc(entbooking.FieldExpiresAt)).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query expired holds: %w", err)
+	}
+
+	var expired []booking.BookingStatusChangedPayload
+	var offers []booking.WaitlistOffer
+	for _, id := range due {
+		b, o, err := expireBookingHold(ctx, s.client, id, now)
+		if err != nil {
+			return expired, offers, err
+		}
+		if b != nil {
+			expired = append(expired, *b)
+			offers = append(offers, o...)
+		}
+	}
+	return expired, offers, nil
+}
+
+// expireBookingHold expires a single held or pending booking and offers its
+// time to the waitlist. Returns nil if the booking is no longer held or
+// pending.
+func expireBookingHold(
+	ctx context.Context,
+	client *Client,
+	id int,
+	now time.Time,
+) (*booking.BookingStatusChangedPayload, []booking.WaitlistOffer, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// The booking is locked so that it can't be confirmed while it expires.
+	existing, err := tx.Booking.
+		Query().
+		Where(
+			entbooking.ID(id),
+			entbooking.StatusIn(booking.BookingStatusHeld, booking.BookingStatusPending),
+			entbooking.ExpiresAtLTE(now),
+		).
+		ForUpdate().
+		Only(ctx)
+	var nfe *NotFoundError
+	if errors.As(err, &nfe) {
+		_ = tx.Rollback()
+		return nil, nil, nil
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, nil, fmt.Errorf("failed to query hold: %w", err)
+	}
+
+	err = existing.Update().
+		SetStatus(booking.BookingStatusExpired).
+		ClearExpiresAt().
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, nil, fmt.Errorf("failed to expire hold: %w", err)
+	}
+
+	err = settleWaitlistOffer(ctx, tx, id, booking.WaitlistStatusExpired)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, nil, err
+	}
+
+	b, err := tx.Booking.
+		Query().
+		Where(entbooking.ID(id)).
+		WithResource().
+		WithMetadata().
+		Only(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, nil, fmt.Errorf("failed to query expired booking: %w", err)
+	}
+
+	offers, err := offerWaitlistSpots(ctx, tx, b.ResourceId, b.StartTime, b.EndTime)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, nil, fmt.Errorf("failed to offer waitlist spots: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return &booking.BookingStatusChangedPayload{
+		Booking:        b.toModel(),
+		PreviousStatus: existing.Status,
+	}, offers, nil
+}
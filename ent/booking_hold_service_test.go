@@ -0,0 +1,83 @@
+package ent_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/openmesh/booking"
+	"github.com/openmesh/booking/ent"
+)
+
+// assertInvalidMetadata checks that err is a validation error of exactly the
+// metadata keys in want.
+func assertInvalidMetadata(t *testing.T, err error, want ...string) {
+	t.Helper()
+	var e *booking.Error
+	if !errors.As(err, &e) || e.Code != booking.EINVALID {
+		t.Fatalf("returned %v, want %s", err, booking.EINVALID)
+	}
+	var got []string
+	for _, p := range e.Params {
+		got = append(got, p.Name)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("invalid fields are %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != "metadata."+want[i] {
+			t.Errorf("invalid fields are %v, want %v", got, want)
+		}
+	}
+}
+
+func TestBookingService_ConfirmBooking_Metadata(t *testing.T) {
+	client := newTestClient(t)
+	ctx := newTestOrganization(t, client)
+	r := newTestResource(t, ctx, client, func(req *booking.CreateResourceRequest) {
+		req.BookingFields = booking.BookingFields{
+			{Key: "name", Label: "Name", Type: booking.BookingFieldText, Required: true},
+			{Key: "partySize", Label: "Party size", Type: booking.BookingFieldNumber, Required: true},
+		}
+	})
+	s := ent.NewBookingService(client, nil)
+	st := testBookingTime(10)
+	hold := booking.HoldBookingRequest{
+		ResourceID: r.ID,
+		StartTime:  st,
+		EndTime:    st.Add(time.Hour),
+	}
+
+	// Holds don't need the required fields but their values are checked.
+	hold.Metadata = map[string]string{"partySize": "several"}
+	assertInvalidMetadata(t, s.HoldBooking(ctx, hold).Err, "partySize")
+
+	hold.Metadata = map[string]string{"partySize": "4"}
+	held := s.HoldBooking(ctx, hold)
+	if held.Err != nil {
+		t.Fatalf("failed to hold booking: %v", held.Err)
+	}
+
+	// The metadata of the hold and of the confirmation are checked together.
+	res := s.ConfirmBooking(ctx, booking.ConfirmBookingRequest{ID: held.Booking.ID})
+	assertInvalidMetadata(t, res.Err, "name")
+	res = s.ConfirmBooking(ctx, booking.ConfirmBookingRequest{
+		ID:       held.Booking.ID,
+		Metadata: map[string]string{"name": "Ada", "partySize": "a few"},
+	})
+	assertInvalidMetadata(t, res.Err, "partySize")
+
+	res = s.ConfirmBooking(ctx, booking.ConfirmBookingRequest{
+		ID:       held.Booking.ID,
+		Metadata: map[string]string{"name": "Ada"},
+	})
+	if res.Err != nil {
+		t.Fatalf("failed to confirm booking: %v", res.Err)
+	}
+	if res.Booking.Status != booking.BookingStatusConfirmed {
+		t.Errorf("booking is %s, want %s", res.Booking.Status, booking.BookingStatusConfirmed)
+	}
+	if got := res.Booking.Metadata; got["name"] != "Ada" || got["partySize"] != "4" {
+		t.Errorf("metadata is %v, want name Ada and party size 4", got)
+	}
+}
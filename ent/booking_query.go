@@ -9,13 +9,21 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/openmesh/booking/ent/booking"
 	"github.com/openmesh/booking/ent/bookingmetadatum"
+	"github.com/openmesh/booking/ent/bookingseries"
+	"github.com/openmesh/booking/ent/customer"
+	"github.com/openmesh/booking/ent/invoice"
+	"github.com/openmesh/booking/ent/payment"
 	"github.com/openmesh/booking/ent/predicate"
+	"github.com/openmesh/booking/ent/promoredemption"
 	"github.com/openmesh/booking/ent/resource"
+	"github.com/openmesh/booking/ent/user"
+	"github.com/openmesh/booking/ent/waitlistentry"
 )
 
 // BookingQuery is the builder for querying Booking entities.
@@ -28,8 +36,16 @@ type BookingQuery struct {
 	fields     []string
 	predicates []predicate.Booking
 	// eager-loading edges.
-	withMetadata *BookingMetadatumQuery
-	withResource *ResourceQuery
+	withMetadata         *BookingMetadatumQuery
+	withWaitlistEntries  *WaitlistEntryQuery
+	withPayments         *PaymentQuery
+	withPromoRedemptions *PromoRedemptionQuery
+	withInvoices         *InvoiceQuery
+	withResource         *ResourceQuery
+	withUser             *UserQuery
+	withSeries           *BookingSeriesQuery
+	withCustomer         *CustomerQuery
+	modifiers            []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -88,6 +104,94 @@ func (bq *BookingQuery) QueryMetadata() *BookingMetadatumQuery {
 	return query
 }
 
+// QueryWaitlistEntries chains the current query on the "waitlistEntries" edge.
+func (bq *BookingQuery) QueryWaitlistEntries() *WaitlistEntryQuery {
+	query := &WaitlistEntryQuery{config: bq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(booking.Table, booking.FieldID, selector),
+			sqlgraph.To(waitlistentry.Table, waitlistentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, booking.WaitlistEntriesTable, booking.WaitlistEntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPayments chains the current query on the "payments" edge.
+func (bq *BookingQuery) QueryPayments() *PaymentQuery {
+	query := &PaymentQuery{config: bq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(booking.Table, booking.FieldID, selector),
+			sqlgraph.To(payment.Table, payment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, booking.PaymentsTable, booking.PaymentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPromoRedemptions chains the current query on the "promoRedemptions" edge.
+func (bq *BookingQuery) QueryPromoRedemptions() *PromoRedemptionQuery {
+	query := &PromoRedemptionQuery{config: bq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(booking.Table, booking.FieldID, selector),
+			sqlgraph.To(promoredemption.Table, promoredemption.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, booking.PromoRedemptionsTable, booking.PromoRedemptionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvoices chains the current query on the "invoices" edge.
+func (bq *BookingQuery) QueryInvoices() *InvoiceQuery {
+	query := &InvoiceQuery{config: bq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(booking.Table, booking.FieldID, selector),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, booking.InvoicesTable, booking.InvoicesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryResource chains the current query on the "resource" edge.
 func (bq *BookingQuery) QueryResource() *ResourceQuery {
 	query := &ResourceQuery{config: bq.config}
@@ -110,6 +214,72 @@ func (bq *BookingQuery) QueryResource() *ResourceQuery {
 	return query
 }
 
+// QueryUser chains the current query on the "user" edge.
+func (bq *BookingQuery) QueryUser() *UserQuery {
+	query := &UserQuery{config: bq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(booking.Table, booking.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, booking.UserTable, booking.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySeries chains the current query on the "series" edge.
+func (bq *BookingQuery) QuerySeries() *BookingSeriesQuery {
+	query := &BookingSeriesQuery{config: bq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(booking.Table, booking.FieldID, selector),
+			sqlgraph.To(bookingseries.Table, bookingseries.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, booking.SeriesTable, booking.SeriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCustomer chains the current query on the "customer" edge.
+func (bq *BookingQuery) QueryCustomer() *CustomerQuery {
+	query := &CustomerQuery{config: bq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(booking.Table, booking.FieldID, selector),
+			sqlgraph.To(customer.Table, customer.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, booking.CustomerTable, booking.CustomerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Booking entity from the query.
 // Returns a *NotFoundError when no Booking was found.
 func (bq *BookingQuery) First(ctx context.Context) (*Booking, error) {
@@ -286,13 +456,20 @@ func (bq *BookingQuery) Clone() *BookingQuery {
 		return nil
 	}
 	return &BookingQuery{
-		config:       bq.config,
-		limit:        bq.limit,
-		offset:       bq.offset,
-		order:        append([]OrderFunc{}, bq.order...),
-		predicates:   append([]predicate.Booking{}, bq.predicates...),
-		withMetadata: bq.withMetadata.Clone(),
-		withResource: bq.withResource.Clone(),
+		config:               bq.config,
+		limit:                bq.limit,
+		offset:               bq.offset,
+		order:                append([]OrderFunc{}, bq.order...),
+		predicates:           append([]predicate.Booking{}, bq.predicates...),
+		withMetadata:         bq.withMetadata.Clone(),
+		withWaitlistEntries:  bq.withWaitlistEntries.Clone(),
+		withPayments:         bq.withPayments.Clone(),
+		withPromoRedemptions: bq.withPromoRedemptions.Clone(),
+		withInvoices:         bq.withInvoices.Clone(),
+		withResource:         bq.withResource.Clone(),
+		withUser:             bq.withUser.Clone(),
+		withSeries:           bq.withSeries.Clone(),
+		withCustomer:         bq.withCustomer.Clone(),
 		// clone intermediate query.
 		sql:  bq.sql.Clone(),
 		path: bq.path,
@@ -310,6 +487,50 @@ func (bq *BookingQuery) WithMetadata(opts ...func(*BookingMetadatumQuery)) *Book
 	return bq
 }
 
+// WithWaitlistEntries tells the query-builder to eager-load the nodes that are connected to
+// the "waitlistEntries" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BookingQuery) WithWaitlistEntries(opts ...func(*WaitlistEntryQuery)) *BookingQuery {
+	query := &WaitlistEntryQuery{config: bq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withWaitlistEntries = query
+	return bq
+}
+
+// WithPayments tells the query-builder to eager-load the nodes that are connected to
+// the "payments" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BookingQuery) WithPayments(opts ...func(*PaymentQuery)) *BookingQuery {
+	query := &PaymentQuery{config: bq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withPayments = query
+	return bq
+}
+
+// WithPromoRedemptions tells the query-builder to eager-load the nodes that are connected to
+// the "promoRedemptions" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BookingQuery) WithPromoRedemptions(opts ...func(*PromoRedemptionQuery)) *BookingQuery {
+	query := &PromoRedemptionQuery{config: bq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withPromoRedemptions = query
+	return bq
+}
+
+// WithInvoices tells the query-builder to eager-load the nodes that are connected to
+// the "invoices" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BookingQuery) WithInvoices(opts ...func(*InvoiceQuery)) *BookingQuery {
+	query := &InvoiceQuery{config: bq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withInvoices = query
+	return bq
+}
+
 // WithResource tells the query-builder to eager-load the nodes that are connected to
 // the "resource" edge. The optional arguments are used to configure the query builder of the edge.
 func (bq *BookingQuery) WithResource(opts ...func(*ResourceQuery)) *BookingQuery {
@@ -321,6 +542,39 @@ func (bq *BookingQuery) WithResource(opts ...func(*ResourceQuery)) *BookingQuery
 	return bq
 }
 
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BookingQuery) WithUser(opts ...func(*UserQuery)) *BookingQuery {
+	query := &UserQuery{config: bq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withUser = query
+	return bq
+}
+
+// WithSeries tells the query-builder to eager-load the nodes that are connected to
+// the "series" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BookingQuery) WithSeries(opts ...func(*BookingSeriesQuery)) *BookingQuery {
+	query := &BookingSeriesQuery{config: bq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withSeries = query
+	return bq
+}
+
+// WithCustomer tells the query-builder to eager-load the nodes that are connected to
+// the "customer" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BookingQuery) WithCustomer(opts ...func(*CustomerQuery)) *BookingQuery {
+	query := &CustomerQuery{config: bq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withCustomer = query
+	return bq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -392,9 +646,16 @@ func (bq *BookingQuery) sqlAll(ctx context.Context) ([]*Booking, error) {
 	var (
 		nodes       = []*Booking{}
 		_spec       = bq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [9]bool{
 			bq.withMetadata != nil,
+			bq.withWaitlistEntries != nil,
+			bq.withPayments != nil,
+			bq.withPromoRedemptions != nil,
+			bq.withInvoices != nil,
 			bq.withResource != nil,
+			bq.withUser != nil,
+			bq.withSeries != nil,
+			bq.withCustomer != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
@@ -410,6 +671,9 @@ func (bq *BookingQuery) sqlAll(ctx context.Context) ([]*Booking, error) {
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(bq.modifiers) > 0 {
+		_spec.Modifiers = bq.modifiers
+	}
 	if err := sqlgraph.QueryNodes(ctx, bq.driver, _spec); err != nil {
 		return nil, err
 	}
@@ -442,6 +706,109 @@ func (bq *BookingQuery) sqlAll(ctx context.Context) ([]*Booking, error) {
 		}
 	}
 
+	if query := bq.withWaitlistEntries; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Booking)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.WaitlistEntries = []*WaitlistEntry{}
+		}
+		query.Where(predicate.WaitlistEntry(func(s *sql.Selector) {
+			s.Where(sql.InValues(booking.WaitlistEntriesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.BookingId
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "bookingId" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "bookingId" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.WaitlistEntries = append(node.Edges.WaitlistEntries, n)
+		}
+	}
+
+	if query := bq.withPayments; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Booking)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Payments = []*Payment{}
+		}
+		query.Where(predicate.Payment(func(s *sql.Selector) {
+			s.Where(sql.InValues(booking.PaymentsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.BookingId
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "bookingId" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Payments = append(node.Edges.Payments, n)
+		}
+	}
+
+	if query := bq.withPromoRedemptions; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Booking)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.PromoRedemptions = []*PromoRedemption{}
+		}
+		query.Where(predicate.PromoRedemption(func(s *sql.Selector) {
+			s.Where(sql.InValues(booking.PromoRedemptionsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.BookingId
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "bookingId" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.PromoRedemptions = append(node.Edges.PromoRedemptions, n)
+		}
+	}
+
+	if query := bq.withInvoices; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Booking)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Invoices = []*Invoice{}
+		}
+		query.Where(predicate.Invoice(func(s *sql.Selector) {
+			s.Where(sql.InValues(booking.InvoicesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.BookingId
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "bookingId" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Invoices = append(node.Edges.Invoices, n)
+		}
+	}
+
 	if query := bq.withResource; query != nil {
 		ids := make([]int, 0, len(nodes))
 		nodeids := make(map[int][]*Booking)
@@ -468,11 +835,101 @@ func (bq *BookingQuery) sqlAll(ctx context.Context) ([]*Booking, error) {
 		}
 	}
 
+	if query := bq.withUser; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*Booking)
+		for i := range nodes {
+			if nodes[i].UserId == nil {
+				continue
+			}
+			fk := *nodes[i].UserId
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(user.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "userId" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.User = n
+			}
+		}
+	}
+
+	if query := bq.withSeries; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*Booking)
+		for i := range nodes {
+			if nodes[i].SeriesId == nil {
+				continue
+			}
+			fk := *nodes[i].SeriesId
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(bookingseries.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "seriesId" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Series = n
+			}
+		}
+	}
+
+	if query := bq.withCustomer; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*Booking)
+		for i := range nodes {
+			if nodes[i].CustomerId == nil {
+				continue
+			}
+			fk := *nodes[i].CustomerId
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(customer.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "customerId" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Customer = n
+			}
+		}
+	}
+
 	return nodes, nil
 }
 
 func (bq *BookingQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := bq.querySpec()
+	if len(bq.modifiers) > 0 {
+		_spec.Modifiers = bq.modifiers
+	}
 	return sqlgraph.CountNodes(ctx, bq.driver, _spec)
 }
 
@@ -544,6 +1001,9 @@ func (bq *BookingQuery) sqlQuery(ctx context.Context) *sql.Selector {
 		selector = bq.sql
 		selector.Select(selector.Columns(columns...)...)
 	}
+	for _, m := range bq.modifiers {
+		m(selector)
+	}
 	for _, p := range bq.predicates {
 		p(selector)
 	}
@@ -561,6 +1021,32 @@ func (bq *BookingQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (bq *BookingQuery) ForUpdate(opts ...sql.LockOption) *BookingQuery {
+	if bq.driver.Dialect() == dialect.Postgres {
+		bq.Unique(false)
+	}
+	bq.modifiers = append(bq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return bq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (bq *BookingQuery) ForShare(opts ...sql.LockOption) *BookingQuery {
+	if bq.driver.Dialect() == dialect.Postgres {
+		bq.Unique(false)
+	}
+	bq.modifiers = append(bq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return bq
+}
+
 // BookingGroupBy is the group-by builder for Booking entities.
 type BookingGroupBy struct {
 	config
@@ -0,0 +1,261 @@
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openmesh/booking"
+	entbooking "github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/bookingseries"
+)
+
+// Retrieves a recurring booking by ID along with its occurrences. Returns
+// EBOOKINGSERIESNOTFOUND if the series does not exist or the user does not
+// have permission to view it.
+func (s *bookingService) FindBookingSeriesByID(
+	ctx context.Context,
+	req booking.FindBookingSeriesByIDRequest,
+) booking.FindBookingSeriesByIDResponse {
+	bs, err := s.client.BookingSeries.
+		Query().
+		Where(bookingseries.ID(req.ID)).
+		WithBookings(func(bq *BookingQuery) {
+			bq.WithMetadata().Order(Asc(entbooking.FieldStartTime))
+		}).
+		Only(ctx)
+	var nfe *NotFoundError
+	if errors.As(err, &nfe) {
+		return booking.FindBookingSeriesByIDResponse{
+			Err: booking.Errorf(booking.EBOOKINGSERIESNOTFOUND, "Could not find booking series with ID %d", req.ID),
+		}
+	}
+	if err != nil {
+		return booking.FindBookingSeriesByIDResponse{
+			Err: fmt.Errorf("failed to find booking series: %w", err),
+		}
+	}
+
+	return booking.FindBookingSeriesByIDResponse{BookingSeries: bs.toModel()}
+}
+
+// Creates a recurring booking and a booking for each of its occurrences. The
+// recurrence rule is expanded in the timezone of the resource so that
+// occurrences keep the same local time. Nothing is saved if any occurrence
+// conflicts unless conflicting occurrences are to be skipped. Occurrences of a
+// resource with a booking price are pending until their deposit is paid.
+func (s *bookingService) CreateBookingSeries(
+	ctx context.Context,
+	req booking.CreateBookingSeriesRequest,
+) booking.CreateBookingSeriesResponse {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return booking.CreateBookingSeriesResponse{
+			Err: fmt.Errorf("failed to start transaction: %w", err),
+		}
+	}
+
+	r, err := findResourceByID(ctx, tx, req.ResourceID, nil)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.CreateBookingSeriesResponse{
+			Err: fmt.Errorf("failed to find resource: %w", err),
+		}
+	}
+
+	occurrences, err := expandBookingSeries(r, req)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.CreateBookingSeriesResponse{Err: err}
+	}
+	// Each occurrence of a resource that needs a deposit waits for its own
+	// deposit to be paid before it is confirmed.
+	deposit := depositRequired(s.payments, r)
+	if deposit {
+		req.Status = booking.BookingStatusPending
+	}
+
+	bs, err := tx.BookingSeries.
+		Create().
+		SetResourceID(req.ResourceID).
+		SetRrule(req.RRule).
+		SetExdates(req.ExDates).
+		SetStartTime(req.StartTime).
+		SetEndTime(req.EndTime).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.CreateBookingSeriesResponse{
+			Err: fmt.Errorf("failed to create booking series: %w", err),
+		}
+	}
+
+	// Occurrences are created as they are checked so that occurrences of the
+	// series that overlap each other are counted too.
+	var ids []int
+	var conflicts []booking.ValidationError
+	var skipped []booking.BookingOccurrence
+	for i, o := range occurrences {
+		err = checkBookingTimes(ctx, tx, req.ResourceID, o.StartTime, o.EndTime)
+		if err == nil {
+			err = checkForBookingTimeConflict(ctx, tx, req.ResourceID, o.StartTime, o.EndTime)
+		}
+		if _, ok := bookingTimeErrorParams(err); ok {
+			conflicts = append(conflicts, booking.ValidationError{
+				Name:   fmt.Sprintf("occurrences[%d]", i),
+				Reason: fmt.Sprintf("Cannot be booked from %s to %s: %s", o.StartTime.Format(time.RFC3339), o.EndTime.Format(time.RFC3339), booking.ErrorMessage(err)),
+			})
+			skipped = append(skipped, o)
+			continue
+		}
+		if err != nil {
+			_ = tx.Rollback()
+			return booking.CreateBookingSeriesResponse{
+				Err: fmt.Errorf("booking time conflict check failed: %w", err),
+			}
+		}
+
+		b, err := createBooking(ctx, tx, booking.CreateBookingRequest{
+			ResourceID: req.ResourceID,
+			Metadata:   req.Metadata,
+			Status:     req.Status,
+			StartTime:  o.StartTime,
+			EndTime:    o.EndTime,
+		}, nil, nil)
+		if err != nil {
+			_ = tx.Rollback()
+			return booking.CreateBookingSeriesResponse{
+				Err: fmt.Errorf("failed to create occurrence: %w", err),
+			}
+		}
+		if deposit {
+			b, _, err = requestDeposit(ctx, tx, s.payments, b, r)
+			if err != nil {
+				_ = tx.Rollback()
+				return booking.CreateBookingSeriesResponse{Err: err}
+			}
+		}
+		ids = append(ids, b.ID)
+	}
+
+	if len(conflicts) > 0 && (!req.SkipConflicts || len(skipped) == len(occurrences)) {
+		_ = tx.Rollback()
+		return booking.CreateBookingSeriesResponse{
+			Err: &booking.Error{
+				Code:   booking.EBOOKINGCONFLICT,
+				Detail: fmt.Sprintf("%d of %d occurrences cannot be booked", len(conflicts), len(occurrences)),
+				Title:  "Booking conflict",
+				Params: conflicts,
+			},
+		}
+	}
+
+	err = tx.Booking.
+		Update().
+		Where(entbooking.IDIn(ids...)).
+		SetSeriesID(bs.ID).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.CreateBookingSeriesResponse{
+			Err: fmt.Errorf("failed to link occurrences to series: %w", err),
+		}
+	}
+
+	bs.Edges.Bookings, err = bs.QueryBookings().
+		WithMetadata().
+		Order(Asc(entbooking.FieldStartTime)).
+		All(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.CreateBookingSeriesResponse{
+			Err: fmt.Errorf("failed to query occurrences: %w", err),
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return booking.CreateBookingSeriesResponse{
+			Err: fmt.Errorf("failed to commit transaction: %w", err),
+		}
+	}
+
+	return booking.CreateBookingSeriesResponse{
+		BookingSeries: bs.toModel(),
+		Skipped:       skipped,
+	}
+}
+
+// expandBookingSeries returns the times of every occurrence of a recurring
+// booking for resource r, excluding the dates listed in req.ExDates. Returns a
+// validation error if the rule produces no occurrences or too many of them.
+func expandBookingSeries(r *Resource, req booking.CreateBookingSeriesRequest) ([]booking.BookingOccurrence, error) {
+	rule, err := booking.ParseRRule(req.RRule)
+	if err != nil {
+		return nil, booking.ValidationErrorf("", booking.ValidationError{
+			Name:   "rrule",
+			Reason: "Must be a valid recurrence rule: " + err.Error(),
+		})
+	}
+	loc, err := r.toModel().Location()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load resource location: %w", err)
+	}
+
+	starts, complete := rule.Occurrences(req.StartTime.In(loc), booking.MaxBookingSeriesOccurrences)
+	if !complete {
+		return nil, booking.ValidationErrorf("", booking.ValidationError{
+			Name:   "rrule",
+			Reason: fmt.Sprintf("Must not produce more than %d occurrences", booking.MaxBookingSeriesOccurrences),
+		})
+	}
+
+	duration := req.EndTime.Sub(req.StartTime)
+	var occurrences []booking.BookingOccurrence
+	for _, st := range starts {
+		if excludedOccurrence(st, req.ExDates) {
+			continue
+		}
+		occurrences = append(occurrences, booking.BookingOccurrence{
+			StartTime: st,
+			EndTime:   st.Add(duration),
+		})
+	}
+	if len(occurrences) == 0 {
+		return nil, booking.ValidationErrorf("", booking.ValidationError{
+			Name:   "rrule",
+			Reason: "Must produce at least one occurrence",
+		})
+	}
+	return occurrences, nil
+}
+
+// excludedOccurrence returns true if t is one of exdates.
+func excludedOccurrence(t time.Time, exdates []time.Time) bool {
+	for _, d := range exdates {
+		if t.Equal(d) {
+			return true
+		}
+	}
+	return false
+}
+
+func (bs *BookingSeries) toModel() *booking.BookingSeries {
+	result := &booking.BookingSeries{
+		ID:         bs.ID,
+		ResourceID: bs.ResourceId,
+		RRule:      bs.Rrule,
+		ExDates:    bs.Exdates,
+		StartTime:  bs.StartTime,
+		EndTime:    bs.EndTime,
+		CreatedAt:  bs.CreatedAt,
+		UpdatedAt:  bs.UpdatedAt,
+	}
+
+	if bs.Edges.Bookings != nil {
+		result.Bookings = Bookings(bs.Edges.Bookings).toModels()
+	}
+
+	return result
+}
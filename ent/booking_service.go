@@ -6,18 +6,25 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/privacy"
 	"github.com/openmesh/booking"
 	entbooking "github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/payment"
+	"github.com/openmesh/booking/ent/predicate"
+	"github.com/openmesh/booking/ent/resource"
+	"github.com/openmesh/booking/ent/unavailability"
 )
 
 type bookingService struct {
-	client *Client
+	client   *Client
+	payments booking.PaymentProvider
 }
 
 // NewBookingService constructs a new instance of a booking.BookingService using
-// ent as its persistence layer.
-func NewBookingService(client *Client) *bookingService {
-	return &bookingService{client}
+// ent as its persistence layer. Deposits are taken through payments, which may
+// be nil if payments are not taken.
+func NewBookingService(client *Client, payments booking.PaymentProvider) *bookingService {
+	return &bookingService{client, payments}
 }
 
 // Retrieves a single booking by ID along with the associated resource and
@@ -119,6 +126,12 @@ func findBookings(
 	if req.ResourceID != nil {
 		q.Where(entbooking.ResourceId(*req.ResourceID))
 	}
+	if req.SeriesID != nil {
+		q.Where(entbooking.SeriesId(*req.SeriesID))
+	}
+	if req.CustomerID != nil {
+		q.Where(entbooking.CustomerId(*req.CustomerID))
+	}
 	if req.Status != nil {
 		q.Where(entbooking.Status(*req.Status))
 	}
@@ -165,30 +178,67 @@ func (s *bookingService) CreateBooking(
 		}
 	}
 
+	err = checkBookingTimes(ctx, tx, req.ResourceID, req.StartTime, req.EndTime)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.CreateBookingResponse{
+			Err: fmt.Errorf("booking time check failed: %w", err),
+		}
+	}
+
 	err = checkForBookingTimeConflict(ctx, tx, req.ResourceID, req.StartTime, req.EndTime)
 	if err != nil {
+		_ = tx.Rollback()
 		return booking.CreateBookingResponse{
 			Err: fmt.Errorf("booking time conflict check failed: %w", err),
 		}
 	}
 
-	b, err := createBooking(ctx, tx, req, func(b *Booking) (*Booking, error) {
+	r, err := tx.Resource.Get(ctx, req.ResourceID)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.CreateBookingResponse{
+			Err: fmt.Errorf("failed to query resource: %w", err),
+		}
+	}
+	// Bookings that need a deposit wait for it to be paid before they are
+	// confirmed. Bookings made through the public API are released if it
+	// isn't paid in time so that unpaid bookings can't occupy the resource
+	// forever.
+	deposit := depositRequired(s.payments, r)
+	var expiresAt *time.Time
+	if deposit {
+		req.Status = booking.BookingStatusPending
+		if booking.PublicFromContext(ctx) {
+			t := time.Now().Add(booking.DepositPaymentDuration)
+			expiresAt = &t
+		}
+	}
+
+	b, err := createBooking(ctx, tx, req, expiresAt, func(b *Booking) (*Booking, error) {
 		b.Edges.Metadata, err = b.QueryMetadata().All(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to query metadata")
 		}
-		b.Edges.Resource, err = b.QueryResource().First(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("failed to query resource")
-		}
+		b.Edges.Resource = r
 		return b, nil
 	})
 	if err != nil {
+		_ = tx.Rollback()
 		return booking.CreateBookingResponse{
 			Err: fmt.Errorf("failed to create booking: %w", err),
 		}
 	}
 
+	var p *Payment
+	if deposit {
+		b, p, err = requestDeposit(ctx, tx, s.payments, b, r)
+		if err != nil {
+			_ = tx.Rollback()
+			return booking.CreateBookingResponse{Err: err}
+		}
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return booking.CreateBookingResponse{
@@ -198,9 +248,186 @@ func (s *bookingService) CreateBooking(
 
 	return booking.CreateBookingResponse{
 		Booking: b.toModel(),
+		Payment: depositModel(p),
 	}
 }
 
+// ImportBookings creates a booking for each row of an import within a single
+// transaction. Rows are created in order so that rows of the same import that
+// overlap each other are counted when checking for conflicts. Rows that fail
+// validation, conflict with other bookings or belong to a resource that can't
+// be found are reported and skipped, unless the import is all or nothing. Rows
+// of a resource with a booking price are pending until their deposit is paid.
+func (s *bookingService) ImportBookings(
+	ctx context.Context,
+	req booking.ImportBookingsRequest,
+) booking.ImportBookingsResponse {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return booking.ImportBookingsResponse{
+			Err: fmt.Errorf("failed to start transaction: %w", err),
+		}
+	}
+
+	var res booking.ImportBookingsResponse
+	var failed []booking.ValidationError
+	for i, row := range req.Rows {
+		result := booking.BookingImportResult{Row: i, Errors: row.Validate()}
+		if len(result.Errors) == 0 {
+			err = checkBookingTimes(ctx, tx, row.ResourceID, row.StartTime, row.EndTime)
+			if err == nil {
+				err = checkForBookingTimeConflict(ctx, tx, row.ResourceID, row.StartTime, row.EndTime)
+			}
+			if params, ok := bookingTimeErrorParams(err); ok {
+				result.Errors = params
+			} else if err != nil {
+				_ = tx.Rollback()
+				return booking.ImportBookingsResponse{
+					Err: fmt.Errorf("booking time check failed: %w", err),
+				}
+			}
+		}
+		if len(result.Errors) > 0 {
+			failed = append(failed, booking.ImportRowErrors(i, result.Errors)...)
+			res.Rows = append(res.Rows, result)
+			res.Failed++
+			continue
+		}
+
+		r, err := findResourceByID(ctx, tx, row.ResourceID, nil)
+		if err != nil {
+			_ = tx.Rollback()
+			return booking.ImportBookingsResponse{
+				Err: fmt.Errorf("failed to find resource of row %d: %w", i, err),
+			}
+		}
+		// Imported bookings wait for their deposit like any other booking.
+		deposit := depositRequired(s.payments, r)
+		if deposit {
+			row.Status = booking.BookingStatusPending
+		}
+
+		b, err := createBooking(ctx, tx, row, nil, func(b *Booking) (*Booking, error) {
+			b.Edges.Metadata, err = b.QueryMetadata().All(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to query metadata")
+			}
+			return b, nil
+		})
+		// Promo codes, custom fields and customers are checked before
+		// anything is written so a row that fails them can be skipped like
+		// any other invalid row.
+		if params, ok := promoCodeErrorParams(err); ok {
+			failed = append(failed, booking.ImportRowErrors(i, params)...)
+			result.Errors = params
+			res.Rows = append(res.Rows, result)
+			res.Failed++
+			continue
+		}
+		if err != nil {
+			_ = tx.Rollback()
+			return booking.ImportBookingsResponse{
+				Err: fmt.Errorf("failed to import row %d: %w", i, err),
+			}
+		}
+		if deposit {
+			b, _, err = requestDeposit(ctx, tx, s.payments, b, r)
+			if err != nil {
+				_ = tx.Rollback()
+				return booking.ImportBookingsResponse{Err: err}
+			}
+		}
+		result.Booking = b.toModel()
+		res.Rows = append(res.Rows, result)
+		res.Created++
+	}
+
+	if req.AllOrNothing && len(failed) > 0 {
+		_ = tx.Rollback()
+		return booking.ImportBookingsResponse{
+			Err: booking.ValidationErrorf("", failed...),
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return booking.ImportBookingsResponse{
+			Err: fmt.Errorf("failed to commit transaction: %w", err),
+		}
+	}
+	return res
+}
+
+// checkBookingTimes returns an error if a booking of the resource from st to et
+// would start in the past (EBOOKINGINPAST), would not fit within a single
+// occurrence of one of the slots of the resource (EBOOKINGOUTSIDESLOTS) or
+// would overlap an unavailability of the resource (EBOOKINGUNAVAILABLE). It
+// only needs to be called when the times or resource of a booking change.
+func checkBookingTimes(ctx context.Context, tx *Tx, rid int, st, et time.Time) error {
+	if st.Before(time.Now()) {
+		return bookingTimeError(booking.EBOOKINGINPAST, "Bookings cannot be made in the past", booking.ValidationError{
+			Name:   "startTime",
+			Reason: "Must not be in the past",
+		})
+	}
+
+	r, err := findResourceByID(ctx, tx, rid, func(rq *ResourceQuery) *ResourceQuery {
+		return rq.WithSlots()
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find resource: %w", err)
+	}
+	occ, err := containingSlot(r, st, et)
+	if err != nil {
+		return err
+	}
+	if occ == nil {
+		return bookingTimeError(booking.EBOOKINGOUTSIDESLOTS, "Bookings must be within one of the slots of the resource", booking.ValidationError{
+			Name:   "startTime",
+			Reason: "Must be within one of the slots of the resource",
+		}, booking.ValidationError{
+			Name:   "endTime",
+			Reason: "Must be within the same slot as startTime",
+		})
+	}
+
+	u, err := tx.Unavailability.
+		Query().
+		Where(
+			unavailability.ResourceId(rid),
+			unavailability.StartTimeLT(et),
+			unavailability.EndTimeGT(st),
+		).
+		Order(Asc(unavailability.FieldStartTime)).
+		First(ctx)
+	var nfe *NotFoundError
+	if err != nil && !errors.As(err, &nfe) {
+		return fmt.Errorf("failed to query unavailabilities: %w", err)
+	}
+	if u != nil {
+		return bookingTimeError(booking.EBOOKINGUNAVAILABLE, "The resource is unavailable at the time of the booking", booking.ValidationError{
+			Name: "startTime",
+			Reason: fmt.Sprintf(
+				"Overlaps an unavailability from %s to %s",
+				u.StartTime.Format(time.RFC3339),
+				u.EndTime.Format(time.RFC3339),
+			),
+		})
+	}
+	return nil
+}
+
+// checkForBookingTimeConflict returns an error if a booking of the resource
+// from st to et would exceed the quantity of the slot it is in (ESLOTFULL) or
+// the quantity available for the resource (EBOOKINGCONFLICT). Bookings are
+// half-open intervals so a booking that ends when another begins doesn't
+// overlap it. Bookings with IDs in allowedIDs are not counted, which allows a
+// booking to be moved without conflicting with itself.
+//
+// The resource row is locked until tx ends so that concurrent transactions
+// booking the same resource are checked one at a time. Otherwise two of them
+// could both count the bookings before either is inserted and overbook the
+// resource. The booking must be saved in tx for the check to hold.
 func checkForBookingTimeConflict(
 	ctx context.Context,
 	tx *Tx,
@@ -209,88 +436,259 @@ func checkForBookingTimeConflict(
 	et time.Time,
 	allowedIDs ...int,
 ) error {
-	r, err := findResourceByID(ctx, tx, rid, nil)
+	r, err := findResourceByID(ctx, tx, rid, func(rq *ResourceQuery) *ResourceQuery {
+		return rq.WithSlots().ForUpdate()
+	})
 	if err != nil {
 		return fmt.Errorf("failed to find resource: %w", err)
 	}
+
+	occ, err := containingSlot(r, st, et)
+	if err != nil {
+		return err
+	}
+	// Bookings that aren't within a slot are only possible if they were made
+	// before slots were enforced, in which case there's no slot to fill.
+	if occ != nil && occ.slot.Quantity != nil {
+		c, err := countActiveBookings(ctx, tx, rid, occ.start, occ.end, allowedIDs)
+		if err != nil {
+			return err
+		}
+		if c >= *occ.slot.Quantity {
+			return bookingTimeError(booking.ESLOTFULL, "The slot of the booking is fully booked", booking.ValidationError{
+				Name: "startTime",
+				Reason: fmt.Sprintf(
+					"The slot from %s to %s is fully booked",
+					occ.start.Format(time.RFC3339),
+					occ.end.Format(time.RFC3339),
+				),
+			})
+		}
+	}
+
 	// If quantity available is nil then there is no limit to the number of
 	// bookings that can made for the specified resource and we can return early.
 	if r.QuantityAvailable == nil {
 		return nil
 	}
-
-	c, err := tx.Booking.
-		Query().
-		Where(entbooking.IDNotIn(allowedIDs...)).
-		Where(
-			entbooking.ResourceId(rid),
-			entbooking.Or(
-				// New booking begins during an existing booking.
-				entbooking.And(entbooking.StartTimeLTE(st), entbooking.EndTimeGTE(st)),
-				// New booking ends during an exsting booking.
-				entbooking.And(entbooking.StartTimeLTE(et), entbooking.EndTimeGTE(et)),
-				// New booking is entirely during an existing booking.
-				entbooking.And(entbooking.StartTimeLTE(st), entbooking.EndTimeGTE(et)),
-				// Existing booking is entirely during new booking.
-				entbooking.And(entbooking.StartTimeGTE(st), entbooking.EndTimeLTE(et)),
-			),
-		).
-		Count(ctx)
-
+	c, err := countActiveBookings(ctx, tx, rid, st, et, allowedIDs)
+	if err != nil {
+		return err
+	}
 	if c >= *r.QuantityAvailable {
-		return booking.Errorf(
+		return bookingTimeError(
 			booking.EBOOKINGCONFLICT,
 			"Maximum quantity of bookings allowed for a resource at a single time exceeded",
+			booking.ValidationError{
+				Name:   "startTime",
+				Reason: fmt.Sprintf("Overlaps %d existing bookings", c),
+			},
 		)
 	}
 	return nil
 }
 
-func countOverlappingBookings(ctx context.Context, tx *Tx, resourceID int, startTime time.Time, endTime time.Time) (int, error) {
-	return tx.Booking.
+// countActiveBookings counts the bookings of a resource that occupy any part
+// of [st, et), other than those with IDs in excludedIDs.
+func countActiveBookings(ctx context.Context, tx *Tx, rid int, st, et time.Time, excludedIDs []int) (int, error) {
+	q := tx.Booking.
 		Query().
 		Where(
-			entbooking.ResourceId(resourceID),
-			entbooking.Or(
-				// New booking begins during an existing booking.
-				entbooking.And(entbooking.StartTimeLTE(startTime), entbooking.EndTimeGTE(startTime)),
-				// New booking ends during an exsting booking.
-				entbooking.And(entbooking.StartTimeLTE(endTime), entbooking.EndTimeGTE(endTime)),
-				// New booking is entirely during an existing booking.
-				entbooking.And(entbooking.StartTimeLTE(startTime), entbooking.EndTimeGTE(endTime)),
-				// Existing booking is entirely during new booking.
-				entbooking.And(entbooking.StartTimeGTE(startTime), entbooking.EndTimeLTE(endTime)),
-			),
-		).
-		Count(ctx)
+			entbooking.ResourceId(rid),
+			entbooking.StatusIn(booking.ActiveBookingStatuses...),
+			unexpiredHold(time.Now()),
+			entbooking.StartTimeLT(et),
+			entbooking.EndTimeGT(st),
+		)
+	// IDNotIn matches nothing at all when it is given no IDs.
+	if len(excludedIDs) > 0 {
+		q.Where(entbooking.IDNotIn(excludedIDs...))
+	}
+	c, err := q.Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count overlapping bookings: %w", err)
+	}
+	return c, nil
+}
+
+// unexpiredHold excludes held bookings whose hold ran out at or before now.
+// They no longer occupy their resource even if they haven't been released yet.
+func unexpiredHold(now time.Time) predicate.Booking {
+	return entbooking.Or(
+		entbooking.StatusNEQ(booking.BookingStatusHeld),
+		entbooking.ExpiresAtGT(now),
+	)
+}
+
+// containingSlot returns the occurrence of a slot of r that [st, et) fits
+// entirely within, or nil if there isn't one. r must be loaded with its slots.
+func containingSlot(r *Resource, st, et time.Time) (*slotOccurrence, error) {
+	loc, err := r.toModel().Location()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load resource location: %w", err)
+	}
+	// Slots are shorter than a day so a slot containing the booking must begin
+	// and end within a day of it.
+	for _, occ := range expandSlots(r.Edges.Slots, st.Add(-24*time.Hour).In(loc), et.Add(24*time.Hour).In(loc)) {
+		if !occ.start.After(st) && !occ.end.Before(et) {
+			return &occ, nil
+		}
+	}
+	return nil, nil
+}
+
+// bookingTimeError returns an error explaining why a booking can't be made at
+// the requested time.
+func bookingTimeError(code, detail string, params ...booking.ValidationError) error {
+	return &booking.Error{
+		Code:   code,
+		Detail: detail,
+		Title:  "Booking unavailable",
+		Params: params,
+	}
 }
 
+// bookingTimeErrorParams returns the params of an error returned by
+// checkBookingTimes or checkForBookingTimeConflict. Returns false if err is
+// any other kind of error.
+func bookingTimeErrorParams(err error) ([]booking.ValidationError, bool) {
+	var e *booking.Error
+	if !errors.As(err, &e) {
+		return nil, false
+	}
+	switch e.Code {
+	case booking.EBOOKINGINPAST,
+		booking.EBOOKINGOUTSIDESLOTS,
+		booking.EBOOKINGUNAVAILABLE,
+		booking.ESLOTFULL,
+		booking.EBOOKINGCONFLICT,
+		booking.ERESOURCENOTFOUND:
+		if len(e.Params) == 0 {
+			return []booking.ValidationError{{Name: "resourceId", Reason: e.Detail}}, true
+		}
+		return e.Params, true
+	}
+	return nil, false
+}
+
+// createBooking creates a booking from req. expiresAt is only set for held
+// bookings.
 func createBooking(
 	ctx context.Context,
 	tx *Tx,
 	req booking.CreateBookingRequest,
+	expiresAt *time.Time,
 	attachEdges func(*Booking) (*Booking, error),
 ) (*Booking, error) {
-	var m []*BookingMetadatum
-	for k, v := range req.Metadata {
-		m = append(m, &BookingMetadatum{
-			Key:   k,
-			Value: v,
-		})
+	status := req.Status
+	if status == "" {
+		status = booking.BookingStatusPending
 	}
 
-	b, err := tx.Booking.
+	r, err := findResourceByID(ctx, tx, req.ResourceID, nil)
+	if err != nil {
+		return nil, err
+	}
+	// Customers booking through the public API have to know the password of
+	// a protected resource. Members of the organization don't.
+	if booking.PublicFromContext(ctx) {
+		if err := checkResourcePassword(r, req.Password); err != nil {
+			return nil, err
+		}
+	}
+	fields, err := r.bookingFields()
+	if err != nil {
+		return nil, err
+	}
+	// Required fields of a hold are only checked when it is confirmed, once
+	// the customer has filled them in.
+	validate := fields.ValidateMetadata
+	if status == booking.BookingStatusHeld {
+		validate = fields.ValidatePartialMetadata
+	}
+	if errs := validate(req.Metadata); len(errs) > 0 {
+		return nil, booking.ValidationErrorf("", errs...)
+	}
+	quote, err := r.toModel().Quote(req.StartTime, req.EndTime, req.Segment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to price booking: %w", err)
+	}
+	tax, err := resourceTaxRate(ctx, tx.Client(), r)
+	if err != nil {
+		return nil, err
+	}
+	quote.ApplyTax(tax)
+	var promo *booking.PromoCode
+	var discount int
+	if req.PromoCode != "" {
+		promo, err = redeemablePromoCode(ctx, tx, req.PromoCode, quote, req.Customer, true)
+		if err != nil {
+			return nil, err
+		}
+		discount = quote.ApplyPromoCode(promo)
+	}
+	customerID, err := bookingCustomerID(ctx, tx, r.OrganizationId, req)
+	if err != nil {
+		return nil, err
+	}
+
+	q := tx.Booking.
 		Create().
 		SetResourceID(req.ResourceID).
-		SetStatus(req.Status).
+		SetStatus(status).
+		SetPrice(quote.Gross).
+		SetCurrency(quote.Currency).
+		SetTaxAmount(quote.Tax).
 		SetStartTime(req.StartTime).
 		SetEndTime(req.EndTime).
-		AddMetadata(m...).
-		Save(ctx)
+		SetNillableExpiresAt(expiresAt).
+		SetNillableCustomerID(customerID)
+	// Record the user that made the booking. Bookings made using an API key are
+	// not associated with a user.
+	if userID := booking.UserIDFromContext(ctx); userID != 0 {
+		q.SetUserID(userID)
+	}
+	if tax != nil {
+		q.SetTaxName(tax.Name).
+			SetTaxRate(tax.Rate).
+			SetTaxInclusive(tax.Inclusive)
+	}
+
+	b, err := q.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create booking: %w", err)
 	}
 
+	// Metadata can only be added once the booking exists.
+	if len(req.Metadata) > 0 {
+		m := make([]*BookingMetadatumCreate, 0, len(req.Metadata))
+		for k, v := range req.Metadata {
+			m = append(m, tx.BookingMetadatum.
+				Create().
+				SetBookingId(b.ID).
+				SetKey(k).
+				SetValue(v))
+		}
+		_, err = tx.BookingMetadatum.CreateBulk(m...).Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add metadata: %w", err)
+		}
+	}
+
+	if promo != nil {
+		_, err = tx.PromoRedemption.
+			Create().
+			SetPromoCodeID(promo.ID).
+			SetBookingID(b.ID).
+			SetCustomer(booking.NormalizePromoCustomer(req.Customer)).
+			SetDiscount(discount).
+			SetCurrency(quote.Currency).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to redeem promo code: %w", err)
+		}
+	}
+
 	if attachEdges != nil {
 		b, err = attachEdges(b)
 		if err != nil {
@@ -317,10 +715,48 @@ func (s *bookingService) UpdateBooking(
 		}
 	}
 
-	err = checkForBookingTimeConflict(ctx, tx, req.ResourceID, req.StartTime, req.EndTime)
+	existing, err := findBookingByID(ctx, tx, req.ID, nil)
 	if err != nil {
+		_ = tx.Rollback()
+		return booking.UpdateBookingResponse{Err: err}
+	}
+	// A hold that ran out can only be released, not kept or confirmed.
+	if holdExpired(existing, time.Now()) && booking.ActiveBookingStatus(req.Status) {
+		_ = tx.Rollback()
 		return booking.UpdateBookingResponse{
-			Err: fmt.Errorf("booking time conflict check failed: %w", err),
+			Err: booking.Errorf(booking.EHOLDEXPIRED, "The hold on booking with ID %d has expired", req.ID),
+		}
+	}
+	if !booking.CanTransitionBooking(existing.Status, req.Status) {
+		_ = tx.Rollback()
+		return booking.UpdateBookingResponse{
+			Err: booking.Errorf(
+				booking.EINVALIDTRANSITION,
+				"Cannot change status of booking from '%s' to '%s'",
+				existing.Status,
+				req.Status,
+			),
+		}
+	}
+
+	if req.Status == booking.BookingStatusConfirmed && existing.Status != booking.BookingStatusConfirmed {
+		unpaid, err := depositOutstanding(ctx, tx, s.payments, existing)
+		if err != nil {
+			_ = tx.Rollback()
+			return booking.UpdateBookingResponse{Err: err}
+		}
+		if unpaid {
+			_ = tx.Rollback()
+			return booking.UpdateBookingResponse{
+				Err: booking.Errorf(booking.EPAYMENTREQUIRED, "The deposit of booking with ID %d has not been paid", req.ID),
+			}
+		}
+	}
+
+	if seriesScoped(req.SeriesScope) && existing.SeriesId == nil {
+		_ = tx.Rollback()
+		return booking.UpdateBookingResponse{
+			Err: booking.Errorf(booking.ENOTINSERIES, "Booking with ID %d is not part of a series", req.ID),
 		}
 	}
 
@@ -335,10 +771,343 @@ func (s *bookingService) UpdateBooking(
 		}
 		return b, nil
 	})
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.UpdateBookingResponse{
+			Err: fmt.Errorf("failed to update booking: %w", err),
+		}
+	}
+
+	// Other occurrences are moved before anything is checked for conflicts so
+	// that occurrences aren't compared against the old times of each other.
+	var occurrences []booking.OccurrenceUpdate
+	var freed []freedTime
+	if seriesScoped(req.SeriesScope) {
+		occurrences, freed, err = updateSeriesOccurrences(ctx, tx, s.payments, existing, req)
+		if err != nil {
+			_ = tx.Rollback()
+			return booking.UpdateBookingResponse{
+				Err: fmt.Errorf("failed to update occurrences: %w", err),
+			}
+		}
+	}
+
+	// Bookings that no longer occupy the resource can't conflict with others.
+	// Only bookings that are being moved need to fit the slots of the resource
+	// so that bookings in the past can still be completed or cancelled.
+	moved := req.ResourceID != existing.ResourceId ||
+		!req.StartTime.Equal(existing.StartTime) ||
+		!req.EndTime.Equal(existing.EndTime)
+	if booking.ActiveBookingStatus(req.Status) && moved {
+		err = checkBookingTimes(ctx, tx, req.ResourceID, req.StartTime, req.EndTime)
+		if err != nil {
+			_ = tx.Rollback()
+			return booking.UpdateBookingResponse{
+				Err: fmt.Errorf("booking time check failed: %w", err),
+			}
+		}
+	}
+	if booking.ActiveBookingStatus(req.Status) {
+		err = checkForBookingTimeConflict(ctx, tx, req.ResourceID, req.StartTime, req.EndTime, req.ID)
+		if err != nil {
+			_ = tx.Rollback()
+			return booking.UpdateBookingResponse{
+				Err: fmt.Errorf("booking time conflict check failed: %w", err),
+			}
+		}
+	}
+
+	// Time that is no longer occupied is offered to the waitlist once the
+	// booking and its occurrences have been checked.
+	if existing.Status == booking.BookingStatusHeld && req.Status != booking.BookingStatusHeld {
+		err = settleWaitlistOffer(ctx, tx, req.ID, waitlistOfferOutcome(req.Status))
+		if err != nil {
+			_ = tx.Rollback()
+			return booking.UpdateBookingResponse{Err: err}
+		}
+	}
+	if f, ok := freedBy(existing, req.Status, req.ResourceID, req.StartTime, req.EndTime); ok {
+		freed = append([]freedTime{f}, freed...)
+	}
+	offers, err := offerFreedTimes(ctx, tx, freed)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.UpdateBookingResponse{
+			Err: fmt.Errorf("failed to offer waitlist spots: %w", err),
+		}
+	}
+
+	// Refunds are paid as late as possible so that as little as possible can
+	// roll the transaction back after the money has been moved.
+	if cancellationSettled(existing.Status, req.Status) {
+		b, err = settleCancellation(ctx, tx, s.payments, b, existing.Status, time.Now())
+		if err != nil {
+			_ = tx.Rollback()
+			return booking.UpdateBookingResponse{Err: err}
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return booking.UpdateBookingResponse{
+			Err: fmt.Errorf("failed to commit transaction: %w", err),
+		}
+	}
 
 	return booking.UpdateBookingResponse{
-		Booking: b.toModel(),
+		Booking:        b.toModel(),
+		PreviousStatus: existing.Status,
+		Occurrences:    occurrences,
+		Offers:         offers,
+	}
+}
+
+// depositOutstanding returns true if booking b needs a deposit to be paid
+// before it can be confirmed. Bookings that haven't been asked for a deposit,
+// such as held bookings, need one if their resource has a booking price.
+func depositOutstanding(ctx context.Context, tx *Tx, provider booking.PaymentProvider, b *Booking) (bool, error) {
+	r, err := b.QueryResource().Only(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to query resource: %w", err)
+	}
+	return depositUnpaid(ctx, tx, b.ID, depositRequired(provider, r))
+}
+
+// cancellationSettled returns true if a booking moving from status from to
+// status to is settled under the cancellation policy of its resource.
+func cancellationSettled(from, to string) bool {
+	return from != to && (to == booking.BookingStatusCancelled || to == booking.BookingStatusNoShow)
+}
+
+// settleCancellation applies the cancellation policy of the resource of
+// booking b, which has just been cancelled or marked as a no-show. The refund
+// is paid back through provider out of the deposits of the booking, oldest
+// first, and the refund and fee are recorded on the booking. Cancellations are
+// measured against the start of the booking at now. Held bookings haven't been
+// paid for so releasing them costs nothing. Returns the booking with its
+// loaded edges.
+func settleCancellation(
+	ctx context.Context,
+	tx *Tx,
+	provider booking.PaymentProvider,
+	b *Booking,
+	previousStatus string,
+	now time.Time,
+) (*Booking, error) {
+	var charges booking.CancellationCharges
+	if previousStatus != booking.BookingStatusHeld {
+		var err error
+		charges, err = refundCancellation(ctx, tx, provider, b, now)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	edges := b.Edges
+	q := b.Update().
+		SetRefundAmount(charges.Refund).
+		SetFeeAmount(charges.Fee)
+	if b.Status == booking.BookingStatusCancelled {
+		q.SetCancelledAt(now)
+	}
+	b, err := q.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record cancellation charges: %w", err)
+	}
+	b.Edges = edges
+	return b, nil
+}
+
+// refundCancellation computes the charges for booking b under the
+// cancellation policy of its resource and pays the refund through provider.
+func refundCancellation(
+	ctx context.Context,
+	tx *Tx,
+	provider booking.PaymentProvider,
+	b *Booking,
+	now time.Time,
+) (booking.CancellationCharges, error) {
+	var none booking.CancellationCharges
+	r := b.Edges.Resource
+	if r == nil {
+		var err error
+		r, err = b.QueryResource().Only(ctx)
+		if err != nil {
+			return none, fmt.Errorf("failed to query resource: %w", err)
+		}
+	}
+	policy, err := r.cancellationPolicy()
+	if err != nil {
+		return none, err
+	}
+
+	payments, err := tx.Payment.
+		Query().
+		Where(
+			payment.BookingId(b.ID),
+			payment.StatusIn(booking.PaymentStatusSucceeded, booking.PaymentStatusPartiallyRefunded),
+		).
+		Order(Asc(payment.FieldID)).
+		ForUpdate().
+		All(ctx)
+	if err != nil {
+		return none, fmt.Errorf("failed to query payments: %w", err)
+	}
+	paid := 0
+	for _, p := range payments {
+		paid += p.Amount - p.AmountRefunded
+	}
+
+	var charges booking.CancellationCharges
+	if b.Status == booking.BookingStatusNoShow {
+		charges = policy.NoShow(bookingPrice(b))
+	} else {
+		charges = policy.Cancel(b.StartTime.Sub(now), paid, bookingPrice(b))
+	}
+
+	remaining := charges.Refund
+	for _, p := range payments {
+		if remaining == 0 {
+			break
+		}
+		amount := p.Amount - p.AmountRefunded
+		if amount > remaining {
+			amount = remaining
+		}
+		if err := checkPaymentProvider(provider, p); err != nil {
+			return none, err
+		}
+		// Bookings are only cancelled once so the key is the same for every
+		// attempt to settle the cancellation.
+		key := fmt.Sprintf("booking-%d-cancellation-payment-%d-refund", b.ID, p.ID)
+		if _, err := refundPayment(ctx, tx, provider, p, amount, key); err != nil {
+			return none, err
+		}
+		remaining -= amount
+	}
+
+	return charges, nil
+}
+
+// seriesScoped returns true if scope includes occurrences of a series other
+// than the booking being changed.
+func seriesScoped(scope string) bool {
+	return scope == booking.SeriesScopeFollowing || scope == booking.SeriesScopeSeries
+}
+
+// updateSeriesOccurrences applies an update of booking b to the other pending
+// and confirmed occurrences of its series within req.SeriesScope. Occurrences
+// are moved by the same amount as b and are given its new duration, resource
+// and status. Occurrences that are cancelled are settled under the
+// cancellation policy of their resource with refunds paid through provider.
+// Also returns the times that the occurrences stopped occupying.
+func updateSeriesOccurrences(
+	ctx context.Context,
+	tx *Tx,
+	provider booking.PaymentProvider,
+	b *Booking,
+	req booking.UpdateBookingRequest,
+) ([]booking.OccurrenceUpdate, []freedTime, error) {
+	q := tx.Booking.
+		Query().
+		Where(
+			entbooking.SeriesId(*b.SeriesId),
+			entbooking.IDNEQ(b.ID),
+			entbooking.StatusIn(booking.BookingStatusPending, booking.BookingStatusConfirmed),
+		)
+	if req.SeriesScope == booking.SeriesScopeFollowing {
+		q.Where(entbooking.StartTimeGT(b.StartTime))
+	}
+	others, err := q.Order(Asc(entbooking.FieldStartTime)).All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query occurrences: %w", err)
 	}
+
+	now := time.Now()
+	delta := req.StartTime.Sub(b.StartTime)
+	duration := req.EndTime.Sub(req.StartTime)
+	updated := make([]booking.OccurrenceUpdate, 0, len(others))
+	var freed []freedTime
+	for _, o := range others {
+		if !booking.CanTransitionBooking(o.Status, req.Status) {
+			return nil, nil, booking.Errorf(
+				booking.EINVALIDTRANSITION,
+				"Cannot change status of booking with ID %d from '%s' to '%s'",
+				o.ID,
+				o.Status,
+				req.Status,
+			)
+		}
+		if req.Status == booking.BookingStatusConfirmed && o.Status != booking.BookingStatusConfirmed {
+			unpaid, err := depositOutstanding(ctx, tx, provider, o)
+			if err != nil {
+				return nil, nil, err
+			}
+			if unpaid {
+				return nil, nil, booking.Errorf(booking.EPAYMENTREQUIRED, "The deposit of booking with ID %d has not been paid", o.ID)
+			}
+		}
+		st := o.StartTime.Add(delta)
+		u, err := updateBooking(ctx, tx, booking.UpdateBookingRequest{
+			ID:         o.ID,
+			ResourceID: req.ResourceID,
+			Status:     req.Status,
+			StartTime:  st,
+			EndTime:    st.Add(duration),
+		}, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		if cancellationSettled(o.Status, u.Status) {
+			u, err = settleCancellation(ctx, tx, provider, u, o.Status, now)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		updated = append(updated, booking.OccurrenceUpdate{
+			Booking:        u.toModel(),
+			PreviousStatus: o.Status,
+		})
+		if f, ok := freedBy(o, u.Status, u.ResourceId, u.StartTime, u.EndTime); ok {
+			freed = append(freed, f)
+		}
+	}
+
+	if !booking.ActiveBookingStatus(req.Status) {
+		return updated, freed, nil
+	}
+	// Occurrences that have already ended are left where they were moved to
+	// without checking the slots of the resource, like a single booking in the
+	// past would be.
+	var conflicts []booking.ValidationError
+	for i, u := range updated {
+		if u.EndTime.After(now) && (delta != 0 || u.ResourceID != b.ResourceId || duration != b.EndTime.Sub(b.StartTime)) {
+			err = checkBookingTimes(ctx, tx, u.ResourceID, u.StartTime, u.EndTime)
+		}
+		if err == nil {
+			err = checkForBookingTimeConflict(ctx, tx, u.ResourceID, u.StartTime, u.EndTime, u.ID)
+		}
+		if _, ok := bookingTimeErrorParams(err); ok {
+			conflicts = append(conflicts, booking.ValidationError{
+				Name:   fmt.Sprintf("occurrences[%d]", i),
+				Reason: fmt.Sprintf("Booking with ID %d cannot be moved: %s", u.ID, booking.ErrorMessage(err)),
+			})
+			err = nil
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("booking time conflict check failed: %w", err)
+		}
+	}
+	if len(conflicts) > 0 {
+		return nil, nil, &booking.Error{
+			Code:   booking.EBOOKINGCONFLICT,
+			Detail: fmt.Sprintf("%d of %d occurrences would conflict with existing bookings or fall outside the slots of the resource", len(conflicts), len(updated)),
+			Title:  "Booking conflict",
+			Params: conflicts,
+		}
+	}
+	return updated, freed, nil
 }
 
 func updateBooking(
@@ -347,13 +1116,17 @@ func updateBooking(
 	req booking.UpdateBookingRequest,
 	attachEdges func(*Booking) (*Booking, error),
 ) (*Booking, error) {
-	b, err := tx.Booking.
+	q := tx.Booking.
 		UpdateOneID(req.ID).
 		SetStartTime(req.StartTime).
 		SetEndTime(req.EndTime).
 		SetResourceID(req.ResourceID).
-		SetStatus(req.Status).
-		Save(ctx)
+		SetStatus(req.Status)
+	// Bookings only expire while they are held.
+	if req.Status != booking.BookingStatusHeld {
+		q.ClearExpiresAt()
+	}
+	b, err := q.Save(ctx)
 
 	var nfe *NotFoundError
 	if errors.As(err, &nfe) {
@@ -367,9 +1140,11 @@ func updateBooking(
 		return nil, fmt.Errorf("failed to update booking: %w", err)
 	}
 
-	b, err = attachEdges(b)
-	if err != nil {
-		return nil, fmt.Errorf("failed to attach edges: %w", err)
+	if attachEdges != nil {
+		b, err = attachEdges(b)
+		if err != nil {
+			return nil, fmt.Errorf("failed to attach edges: %w", err)
+		}
 	}
 
 	return b, nil
@@ -388,13 +1163,83 @@ func (s *bookingService) DeleteBooking(
 			Err: fmt.Errorf("failed to start transaction: %w", err),
 		}
 	}
-	err = deleteBooking(ctx, tx, req.ID)
+
+	existing, err := findBookingByID(ctx, tx, req.ID, nil)
 	if err != nil {
+		_ = tx.Rollback()
+		return booking.DeleteBookingResponse{Err: err}
+	}
+	if seriesScoped(req.SeriesScope) && existing.SeriesId == nil {
+		_ = tx.Rollback()
 		return booking.DeleteBookingResponse{
-			Err: fmt.Errorf("failed to delete booking: %w", err),
+			Err: booking.Errorf(booking.ENOTINSERIES, "Booking with ID %d is not part of a series", req.ID),
+		}
+	}
+
+	deleted := []*Booking{existing}
+	if seriesScoped(req.SeriesScope) {
+		q := tx.Booking.
+			Query().
+			Where(entbooking.SeriesId(*existing.SeriesId), entbooking.IDNEQ(req.ID))
+		if req.SeriesScope == booking.SeriesScopeFollowing {
+			q.Where(entbooking.StartTimeGT(existing.StartTime))
+		}
+		others, err := q.All(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return booking.DeleteBookingResponse{
+				Err: fmt.Errorf("failed to query occurrences: %w", err),
+			}
 		}
+		deleted = append(deleted, others...)
 	}
-	return booking.DeleteBookingResponse{}
+
+	var ids []int
+	var freed []freedTime
+	for _, b := range deleted {
+		err = settleWaitlistOffer(ctx, tx, b.ID, booking.WaitlistStatusDeclined)
+		if err != nil {
+			_ = tx.Rollback()
+			return booking.DeleteBookingResponse{Err: err}
+		}
+		err = deleteBooking(ctx, tx, b.ID)
+		if err != nil {
+			_ = tx.Rollback()
+			return booking.DeleteBookingResponse{
+				Err: fmt.Errorf("failed to delete booking: %w", err),
+			}
+		}
+		ids = append(ids, b.ID)
+		if booking.ActiveBookingStatus(b.Status) {
+			freed = append(freed, freedTime{b.ResourceId, period{b.StartTime, b.EndTime}})
+		}
+	}
+
+	offers, err := offerFreedTimes(ctx, tx, freed)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.DeleteBookingResponse{
+			Err: fmt.Errorf("failed to offer waitlist spots: %w", err),
+		}
+	}
+
+	if req.SeriesScope == booking.SeriesScopeSeries {
+		err = tx.BookingSeries.DeleteOneID(*existing.SeriesId).Exec(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return booking.DeleteBookingResponse{
+				Err: fmt.Errorf("failed to delete booking series: %w", err),
+			}
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return booking.DeleteBookingResponse{
+			Err: fmt.Errorf("failed to commit transaction: %w", err),
+		}
+	}
+	return booking.DeleteBookingResponse{DeletedIDs: ids, Offers: offers}
 }
 
 func deleteBooking(ctx context.Context, tx *Tx, id int) error {
@@ -418,13 +1263,23 @@ func deleteBooking(ctx context.Context, tx *Tx, id int) error {
 
 func (b *Booking) toModel() *booking.Booking {
 	result := &booking.Booking{
-		ID:         b.ID,
-		ResourceID: b.ResourceId,
-		Status:     b.Status,
-		StartTime:  b.StartTime,
-		EndTime:    b.EndTime,
-		CreatedAt:  b.CreatedAt,
-		UpdatedAt:  b.UpdatedAt,
+		ID:           b.ID,
+		ResourceID:   b.ResourceId,
+		UserID:       b.UserId,
+		SeriesID:     b.SeriesId,
+		CustomerID:   b.CustomerId,
+		Status:       b.Status,
+		ExpiresAt:    b.ExpiresAt,
+		Price:        booking.NewMoney(bookingPrice(b), b.Currency),
+		TaxRate:      b.appliedTax(),
+		Taxed:        b.taxed().Taxed,
+		CancelledAt:  b.CancelledAt,
+		RefundAmount: b.RefundAmount,
+		FeeAmount:    b.FeeAmount,
+		StartTime:    b.StartTime,
+		EndTime:      b.EndTime,
+		CreatedAt:    b.CreatedAt,
+		UpdatedAt:    b.UpdatedAt,
 	}
 
 	if b.Edges.Resource != nil {
@@ -438,6 +1293,94 @@ func (b *Booking) toModel() *booking.Booking {
 	return result
 }
 
+// MigrateBookingPrices stores the price of their resource on bookings that
+// were made before the price of a booking was stored. Bookings are migrated
+// across every organization. Returns the number of bookings that were
+// migrated.
+func MigrateBookingPrices(ctx context.Context, client *Client) (int, error) {
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	ids, err := tx.Booking.
+		Query().
+		Where(entbooking.PriceIsNil()).
+		Unique(true).
+		Select(entbooking.FieldResourceId).
+		Ints(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, fmt.Errorf("failed to query resources of unpriced bookings: %w", err)
+	}
+	if len(ids) == 0 {
+		_ = tx.Rollback()
+		return 0, nil
+	}
+	resources, err := tx.Resource.
+		Query().
+		Where(resource.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, fmt.Errorf("failed to query resources: %w", err)
+	}
+
+	migrated := 0
+	for _, r := range resources {
+		n, err := tx.Booking.
+			Update().
+			Where(
+				entbooking.ResourceId(r.ID),
+				entbooking.PriceIsNil(),
+			).
+			SetPrice(r.Price).
+			SetCurrency(r.Currency).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return 0, fmt.Errorf("failed to migrate prices of bookings of resource %d: %w", r.ID, err)
+		}
+		migrated += n
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return migrated, nil
+}
+
+// bookingPrice returns the price that booking b was made for.
+func bookingPrice(b *Booking) int {
+	if b.Price == nil {
+		return 0
+	}
+	return *b.Price
+}
+
+// appliedTax returns the tax rate that was charged on b. Returns nil if b was
+// made without tax.
+func (b *Booking) appliedTax() *booking.AppliedTax {
+	return appliedTax(b.TaxName, b.TaxRate, b.TaxInclusive)
+}
+
+// appliedTax returns the tax rate stored on a booking as name, rate and
+// inclusive. Returns nil for bookings made without tax.
+func appliedTax(name string, rate int, inclusive bool) *booking.AppliedTax {
+	if name == "" && rate == 0 {
+		return nil
+	}
+	return &booking.AppliedTax{Name: name, Rate: rate, Inclusive: inclusive}
+}
+
+// taxed splits the price of b, which includes tax, into its net amount and
+// tax.
+func (b *Booking) taxed() booking.TaxedMoney {
+	return booking.NewTaxedMoney(bookingPrice(b), b.TaxAmount, b.Currency)
+}
+
 func (b Bookings) toModels() []*booking.Booking {
 	var bookings []*booking.Booking
 	for _, v := range b {
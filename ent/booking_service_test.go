@@ -0,0 +1,253 @@
+package ent_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openmesh/booking"
+	"github.com/openmesh/booking/ent"
+	entbooking "github.com/openmesh/booking/ent/booking"
+)
+
+// concurrentBookings is the number of bookings that tests make at the same
+// time.
+const concurrentBookings = 10
+
+func TestBookingService_CreateBooking_Concurrent(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(req *booking.CreateResourceRequest)
+		capacity int
+		code     string
+	}{
+		{
+			name: "QuantityAvailable",
+			modify: func(req *booking.CreateResourceRequest) {
+				req.QuantityAvailable = intPtr(1)
+			},
+			capacity: 1,
+			code:     booking.EBOOKINGCONFLICT,
+		},
+		{
+			name: "SlotQuantity",
+			modify: func(req *booking.CreateResourceRequest) {
+				for _, s := range req.Slots {
+					s.Quantity = intPtr(2)
+				}
+			},
+			capacity: 2,
+			code:     booking.ESLOTFULL,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t)
+			ctx := newTestOrganization(t, client)
+			r := newTestResource(t, ctx, client, tt.modify)
+			s := ent.NewBookingService(client, nil)
+
+			st := testBookingTime(10)
+			errs := make([]error, concurrentBookings)
+			var wg sync.WaitGroup
+			for i := range errs {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					errs[i] = s.CreateBooking(ctx, booking.CreateBookingRequest{
+						ResourceID: r.ID,
+						Status:     booking.BookingStatusConfirmed,
+						StartTime:  st,
+						EndTime:    st.Add(time.Hour),
+					}).Err
+				}(i)
+			}
+			wg.Wait()
+
+			var created int
+			for _, err := range errs {
+				if err == nil {
+					created++
+				} else if code := booking.ErrorCode(err); code != tt.code {
+					t.Errorf("unexpected error %s: %v", code, err)
+				}
+			}
+			if created != tt.capacity {
+				t.Errorf("created %d bookings, want %d", created, tt.capacity)
+			}
+			if c := countActiveBookings(t, ctx, client, r.ID); c != tt.capacity {
+				t.Errorf("%d active bookings, want %d", c, tt.capacity)
+			}
+		})
+	}
+}
+
+func TestBookingService_UpdateBooking_Concurrent(t *testing.T) {
+	client := newTestClient(t)
+	ctx := newTestOrganization(t, client)
+	r := newTestResource(t, ctx, client, func(req *booking.CreateResourceRequest) {
+		req.QuantityAvailable = intPtr(1)
+	})
+	s := ent.NewBookingService(client, nil)
+
+	// Make bookings that don't overlap and then move all of them to the same
+	// hour at once.
+	ids := make([]int, concurrentBookings)
+	for i := range ids {
+		st := testBookingTime(8).Add(time.Duration(i) * time.Hour)
+		if st.Hour() >= 10 {
+			st = st.Add(time.Hour)
+		}
+		res := s.CreateBooking(ctx, booking.CreateBookingRequest{
+			ResourceID: r.ID,
+			Status:     booking.BookingStatusConfirmed,
+			StartTime:  st,
+			EndTime:    st.Add(time.Hour),
+		})
+		if res.Err != nil {
+			t.Fatalf("failed to create booking: %v", res.Err)
+		}
+		ids[i] = res.Booking.ID
+	}
+
+	st := testBookingTime(10)
+	errs := make([]error, len(ids))
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func(i, id int) {
+			defer wg.Done()
+			errs[i] = s.UpdateBooking(ctx, booking.UpdateBookingRequest{
+				ID:         id,
+				ResourceID: r.ID,
+				Status:     booking.BookingStatusConfirmed,
+				StartTime:  st,
+				EndTime:    st.Add(time.Hour),
+			}).Err
+		}(i, id)
+	}
+	wg.Wait()
+
+	var moved int
+	for _, err := range errs {
+		if err == nil {
+			moved++
+		} else if code := booking.ErrorCode(err); code != booking.EBOOKINGCONFLICT {
+			t.Errorf("unexpected error %s: %v", code, err)
+		}
+	}
+	if moved != 1 {
+		t.Errorf("moved %d bookings, want 1", moved)
+	}
+	c, err := client.Booking.
+		Query().
+		Where(
+			entbooking.ResourceId(r.ID),
+			entbooking.StartTime(st),
+		).
+		Count(ctx)
+	if err != nil {
+		t.Fatalf("failed to count bookings: %v", err)
+	}
+	if c != 1 {
+		t.Errorf("%d bookings at %s, want 1", c, st)
+	}
+}
+
+func TestBookingService_CreateBooking_ConcurrentCustomer(t *testing.T) {
+	client := newTestClient(t)
+	ctx := newTestOrganization(t, client)
+	r := newTestResource(t, ctx, client, nil)
+	s := ent.NewBookingService(client, nil)
+
+	// Every booking is for the same new customer, who is created by whichever
+	// booking gets there first.
+	results := make([]booking.CreateBookingResponse, concurrentBookings)
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			st := testBookingTime(8 + i)
+			results[i] = s.CreateBooking(ctx, booking.CreateBookingRequest{
+				ResourceID: r.ID,
+				Status:     booking.BookingStatusConfirmed,
+				StartTime:  st,
+				EndTime:    st.Add(time.Hour),
+				Metadata:   map[string]string{"name": "Ada", "email": "Ada@example.com"},
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	customers, err := client.Customer.Query().All(ctx)
+	if err != nil {
+		t.Fatalf("failed to query customers: %v", err)
+	}
+	if len(customers) != 1 {
+		t.Fatalf("%d customers, want 1", len(customers))
+	}
+	for i, res := range results {
+		if res.Err != nil {
+			t.Errorf("booking %d returned %v", i+1, res.Err)
+			continue
+		}
+		if id := res.Booking.CustomerID; id == nil || *id != customers[0].ID {
+			t.Errorf("booking %d is for customer %v, want %d", i+1, id, customers[0].ID)
+		}
+	}
+}
+
+func TestBookingService_CreateBooking_BackToBack(t *testing.T) {
+	client := newTestClient(t)
+	ctx := newTestOrganization(t, client)
+	r := newTestResource(t, ctx, client, func(req *booking.CreateResourceRequest) {
+		req.QuantityAvailable = intPtr(1)
+	})
+	s := ent.NewBookingService(client, nil)
+
+	// A booking that ends when another begins doesn't overlap it, whichever
+	// is made first.
+	for _, hour := range []int{10, 11, 9} {
+		st := testBookingTime(hour)
+		res := s.CreateBooking(ctx, booking.CreateBookingRequest{
+			ResourceID: r.ID,
+			Status:     booking.BookingStatusConfirmed,
+			StartTime:  st,
+			EndTime:    st.Add(time.Hour),
+		})
+		if res.Err != nil {
+			t.Fatalf("failed to create booking at %s: %v", st, res.Err)
+		}
+	}
+
+	st := testBookingTime(10).Add(30 * time.Minute)
+	res := s.CreateBooking(ctx, booking.CreateBookingRequest{
+		ResourceID: r.ID,
+		Status:     booking.BookingStatusConfirmed,
+		StartTime:  st,
+		EndTime:    st.Add(time.Hour),
+	})
+	if code := booking.ErrorCode(res.Err); code != booking.EBOOKINGCONFLICT {
+		t.Errorf("overlapping booking returned %q, want %s", code, booking.EBOOKINGCONFLICT)
+	}
+}
+
+// countActiveBookings returns the number of active bookings of a resource.
+func countActiveBookings(t *testing.T, ctx context.Context, client *ent.Client, rid int) int {
+	t.Helper()
+	c, err := client.Booking.
+		Query().
+		Where(
+			entbooking.ResourceId(rid),
+			entbooking.StatusIn(booking.ActiveBookingStatuses...),
+		).
+		Count(ctx)
+	if err != nil {
+		t.Fatalf("failed to count bookings: %v", err)
+	}
+	return c
+}
+
+func intPtr(v int) *int { return &v }
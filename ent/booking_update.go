@@ -13,8 +13,15 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/openmesh/booking/ent/booking"
 	"github.com/openmesh/booking/ent/bookingmetadatum"
+	"github.com/openmesh/booking/ent/bookingseries"
+	"github.com/openmesh/booking/ent/customer"
+	"github.com/openmesh/booking/ent/invoice"
+	"github.com/openmesh/booking/ent/payment"
 	"github.com/openmesh/booking/ent/predicate"
+	"github.com/openmesh/booking/ent/promoredemption"
 	"github.com/openmesh/booking/ent/resource"
+	"github.com/openmesh/booking/ent/user"
+	"github.com/openmesh/booking/ent/waitlistentry"
 )
 
 // BookingUpdate is the builder for updating Booking entities.
@@ -60,6 +67,265 @@ func (bu *BookingUpdate) SetResourceId(i int) *BookingUpdate {
 	return bu
 }
 
+// SetUserId sets the "userId" field.
+func (bu *BookingUpdate) SetUserId(i int) *BookingUpdate {
+	bu.mutation.SetUserId(i)
+	return bu
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (bu *BookingUpdate) SetNillableUserId(i *int) *BookingUpdate {
+	if i != nil {
+		bu.SetUserId(*i)
+	}
+	return bu
+}
+
+// ClearUserId clears the value of the "userId" field.
+func (bu *BookingUpdate) ClearUserId() *BookingUpdate {
+	bu.mutation.ClearUserId()
+	return bu
+}
+
+// SetSeriesId sets the "seriesId" field.
+func (bu *BookingUpdate) SetSeriesId(i int) *BookingUpdate {
+	bu.mutation.SetSeriesId(i)
+	return bu
+}
+
+// SetNillableSeriesId sets the "seriesId" field if the given value is not nil.
+func (bu *BookingUpdate) SetNillableSeriesId(i *int) *BookingUpdate {
+	if i != nil {
+		bu.SetSeriesId(*i)
+	}
+	return bu
+}
+
+// ClearSeriesId clears the value of the "seriesId" field.
+func (bu *BookingUpdate) ClearSeriesId() *BookingUpdate {
+	bu.mutation.ClearSeriesId()
+	return bu
+}
+
+// SetCustomerId sets the "customerId" field.
+func (bu *BookingUpdate) SetCustomerId(i int) *BookingUpdate {
+	bu.mutation.SetCustomerId(i)
+	return bu
+}
+
+// SetNillableCustomerId sets the "customerId" field if the given value is not nil.
+func (bu *BookingUpdate) SetNillableCustomerId(i *int) *BookingUpdate {
+	if i != nil {
+		bu.SetCustomerId(*i)
+	}
+	return bu
+}
+
+// ClearCustomerId clears the value of the "customerId" field.
+func (bu *BookingUpdate) ClearCustomerId() *BookingUpdate {
+	bu.mutation.ClearCustomerId()
+	return bu
+}
+
+// SetExpiresAt sets the "expiresAt" field.
+func (bu *BookingUpdate) SetExpiresAt(t time.Time) *BookingUpdate {
+	bu.mutation.SetExpiresAt(t)
+	return bu
+}
+
+// SetNillableExpiresAt sets the "expiresAt" field if the given value is not nil.
+func (bu *BookingUpdate) SetNillableExpiresAt(t *time.Time) *BookingUpdate {
+	if t != nil {
+		bu.SetExpiresAt(*t)
+	}
+	return bu
+}
+
+// ClearExpiresAt clears the value of the "expiresAt" field.
+func (bu *BookingUpdate) ClearExpiresAt() *BookingUpdate {
+	bu.mutation.ClearExpiresAt()
+	return bu
+}
+
+// SetPrice sets the "price" field.
+func (bu *BookingUpdate) SetPrice(i int) *BookingUpdate {
+	bu.mutation.ResetPrice()
+	bu.mutation.SetPrice(i)
+	return bu
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (bu *BookingUpdate) SetNillablePrice(i *int) *BookingUpdate {
+	if i != nil {
+		bu.SetPrice(*i)
+	}
+	return bu
+}
+
+// AddPrice adds i to the "price" field.
+func (bu *BookingUpdate) AddPrice(i int) *BookingUpdate {
+	bu.mutation.AddPrice(i)
+	return bu
+}
+
+// ClearPrice clears the value of the "price" field.
+func (bu *BookingUpdate) ClearPrice() *BookingUpdate {
+	bu.mutation.ClearPrice()
+	return bu
+}
+
+// SetCurrency sets the "currency" field.
+func (bu *BookingUpdate) SetCurrency(s string) *BookingUpdate {
+	bu.mutation.SetCurrency(s)
+	return bu
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (bu *BookingUpdate) SetNillableCurrency(s *string) *BookingUpdate {
+	if s != nil {
+		bu.SetCurrency(*s)
+	}
+	return bu
+}
+
+// SetCancelledAt sets the "cancelledAt" field.
+func (bu *BookingUpdate) SetCancelledAt(t time.Time) *BookingUpdate {
+	bu.mutation.SetCancelledAt(t)
+	return bu
+}
+
+// SetNillableCancelledAt sets the "cancelledAt" field if the given value is not nil.
+func (bu *BookingUpdate) SetNillableCancelledAt(t *time.Time) *BookingUpdate {
+	if t != nil {
+		bu.SetCancelledAt(*t)
+	}
+	return bu
+}
+
+// ClearCancelledAt clears the value of the "cancelledAt" field.
+func (bu *BookingUpdate) ClearCancelledAt() *BookingUpdate {
+	bu.mutation.ClearCancelledAt()
+	return bu
+}
+
+// SetRefundAmount sets the "refundAmount" field.
+func (bu *BookingUpdate) SetRefundAmount(i int) *BookingUpdate {
+	bu.mutation.ResetRefundAmount()
+	bu.mutation.SetRefundAmount(i)
+	return bu
+}
+
+// SetNillableRefundAmount sets the "refundAmount" field if the given value is not nil.
+func (bu *BookingUpdate) SetNillableRefundAmount(i *int) *BookingUpdate {
+	if i != nil {
+		bu.SetRefundAmount(*i)
+	}
+	return bu
+}
+
+// AddRefundAmount adds i to the "refundAmount" field.
+func (bu *BookingUpdate) AddRefundAmount(i int) *BookingUpdate {
+	bu.mutation.AddRefundAmount(i)
+	return bu
+}
+
+// SetFeeAmount sets the "feeAmount" field.
+func (bu *BookingUpdate) SetFeeAmount(i int) *BookingUpdate {
+	bu.mutation.ResetFeeAmount()
+	bu.mutation.SetFeeAmount(i)
+	return bu
+}
+
+// SetNillableFeeAmount sets the "feeAmount" field if the given value is not nil.
+func (bu *BookingUpdate) SetNillableFeeAmount(i *int) *BookingUpdate {
+	if i != nil {
+		bu.SetFeeAmount(*i)
+	}
+	return bu
+}
+
+// AddFeeAmount adds i to the "feeAmount" field.
+func (bu *BookingUpdate) AddFeeAmount(i int) *BookingUpdate {
+	bu.mutation.AddFeeAmount(i)
+	return bu
+}
+
+// SetTaxName sets the "taxName" field.
+func (bu *BookingUpdate) SetTaxName(s string) *BookingUpdate {
+	bu.mutation.SetTaxName(s)
+	return bu
+}
+
+// SetNillableTaxName sets the "taxName" field if the given value is not nil.
+func (bu *BookingUpdate) SetNillableTaxName(s *string) *BookingUpdate {
+	if s != nil {
+		bu.SetTaxName(*s)
+	}
+	return bu
+}
+
+// ClearTaxName clears the value of the "taxName" field.
+func (bu *BookingUpdate) ClearTaxName() *BookingUpdate {
+	bu.mutation.ClearTaxName()
+	return bu
+}
+
+// SetTaxRate sets the "taxRate" field.
+func (bu *BookingUpdate) SetTaxRate(i int) *BookingUpdate {
+	bu.mutation.ResetTaxRate()
+	bu.mutation.SetTaxRate(i)
+	return bu
+}
+
+// SetNillableTaxRate sets the "taxRate" field if the given value is not nil.
+func (bu *BookingUpdate) SetNillableTaxRate(i *int) *BookingUpdate {
+	if i != nil {
+		bu.SetTaxRate(*i)
+	}
+	return bu
+}
+
+// AddTaxRate adds i to the "taxRate" field.
+func (bu *BookingUpdate) AddTaxRate(i int) *BookingUpdate {
+	bu.mutation.AddTaxRate(i)
+	return bu
+}
+
+// SetTaxInclusive sets the "taxInclusive" field.
+func (bu *BookingUpdate) SetTaxInclusive(b bool) *BookingUpdate {
+	bu.mutation.SetTaxInclusive(b)
+	return bu
+}
+
+// SetNillableTaxInclusive sets the "taxInclusive" field if the given value is not nil.
+func (bu *BookingUpdate) SetNillableTaxInclusive(b *bool) *BookingUpdate {
+	if b != nil {
+		bu.SetTaxInclusive(*b)
+	}
+	return bu
+}
+
+// SetTaxAmount sets the "taxAmount" field.
+func (bu *BookingUpdate) SetTaxAmount(i int) *BookingUpdate {
+	bu.mutation.ResetTaxAmount()
+	bu.mutation.SetTaxAmount(i)
+	return bu
+}
+
+// SetNillableTaxAmount sets the "taxAmount" field if the given value is not nil.
+func (bu *BookingUpdate) SetNillableTaxAmount(i *int) *BookingUpdate {
+	if i != nil {
+		bu.SetTaxAmount(*i)
+	}
+	return bu
+}
+
+// AddTaxAmount adds i to the "taxAmount" field.
+func (bu *BookingUpdate) AddTaxAmount(i int) *BookingUpdate {
+	bu.mutation.AddTaxAmount(i)
+	return bu
+}
+
 // AddMetadatumIDs adds the "metadata" edge to the BookingMetadatum entity by IDs.
 func (bu *BookingUpdate) AddMetadatumIDs(ids ...int) *BookingUpdate {
 	bu.mutation.AddMetadatumIDs(ids...)
@@ -75,6 +341,66 @@ func (bu *BookingUpdate) AddMetadata(b ...*BookingMetadatum) *BookingUpdate {
 	return bu.AddMetadatumIDs(ids...)
 }
 
+// AddWaitlistEntryIDs adds the "waitlistEntries" edge to the WaitlistEntry entity by IDs.
+func (bu *BookingUpdate) AddWaitlistEntryIDs(ids ...int) *BookingUpdate {
+	bu.mutation.AddWaitlistEntryIDs(ids...)
+	return bu
+}
+
+// AddWaitlistEntries adds the "waitlistEntries" edges to the WaitlistEntry entity.
+func (bu *BookingUpdate) AddWaitlistEntries(w ...*WaitlistEntry) *BookingUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return bu.AddWaitlistEntryIDs(ids...)
+}
+
+// AddPaymentIDs adds the "payments" edge to the Payment entity by IDs.
+func (bu *BookingUpdate) AddPaymentIDs(ids ...int) *BookingUpdate {
+	bu.mutation.AddPaymentIDs(ids...)
+	return bu
+}
+
+// AddPayments adds the "payments" edges to the Payment entity.
+func (bu *BookingUpdate) AddPayments(p ...*Payment) *BookingUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bu.AddPaymentIDs(ids...)
+}
+
+// AddPromoRedemptionIDs adds the "promoRedemptions" edge to the PromoRedemption entity by IDs.
+func (bu *BookingUpdate) AddPromoRedemptionIDs(ids ...int) *BookingUpdate {
+	bu.mutation.AddPromoRedemptionIDs(ids...)
+	return bu
+}
+
+// AddPromoRedemptions adds the "promoRedemptions" edges to the PromoRedemption entity.
+func (bu *BookingUpdate) AddPromoRedemptions(p ...*PromoRedemption) *BookingUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bu.AddPromoRedemptionIDs(ids...)
+}
+
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
+func (bu *BookingUpdate) AddInvoiceIDs(ids ...int) *BookingUpdate {
+	bu.mutation.AddInvoiceIDs(ids...)
+	return bu
+}
+
+// AddInvoices adds the "invoices" edges to the Invoice entity.
+func (bu *BookingUpdate) AddInvoices(i ...*Invoice) *BookingUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return bu.AddInvoiceIDs(ids...)
+}
+
 // SetResourceID sets the "resource" edge to the Resource entity by ID.
 func (bu *BookingUpdate) SetResourceID(id int) *BookingUpdate {
 	bu.mutation.SetResourceID(id)
@@ -86,6 +412,63 @@ func (bu *BookingUpdate) SetResource(r *Resource) *BookingUpdate {
 	return bu.SetResourceID(r.ID)
 }
 
+// SetUserID sets the "user" edge to the User entity by ID.
+func (bu *BookingUpdate) SetUserID(id int) *BookingUpdate {
+	bu.mutation.SetUserID(id)
+	return bu
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (bu *BookingUpdate) SetNillableUserID(id *int) *BookingUpdate {
+	if id != nil {
+		bu = bu.SetUserID(*id)
+	}
+	return bu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (bu *BookingUpdate) SetUser(u *User) *BookingUpdate {
+	return bu.SetUserID(u.ID)
+}
+
+// SetSeriesID sets the "series" edge to the BookingSeries entity by ID.
+func (bu *BookingUpdate) SetSeriesID(id int) *BookingUpdate {
+	bu.mutation.SetSeriesID(id)
+	return bu
+}
+
+// SetNillableSeriesID sets the "series" edge to the BookingSeries entity by ID if the given value is not nil.
+func (bu *BookingUpdate) SetNillableSeriesID(id *int) *BookingUpdate {
+	if id != nil {
+		bu = bu.SetSeriesID(*id)
+	}
+	return bu
+}
+
+// SetSeries sets the "series" edge to the BookingSeries entity.
+func (bu *BookingUpdate) SetSeries(b *BookingSeries) *BookingUpdate {
+	return bu.SetSeriesID(b.ID)
+}
+
+// SetCustomerID sets the "customer" edge to the Customer entity by ID.
+func (bu *BookingUpdate) SetCustomerID(id int) *BookingUpdate {
+	bu.mutation.SetCustomerID(id)
+	return bu
+}
+
+// SetNillableCustomerID sets the "customer" edge to the Customer entity by ID if the given value is not nil.
+func (bu *BookingUpdate) SetNillableCustomerID(id *int) *BookingUpdate {
+	if id != nil {
+		bu = bu.SetCustomerID(*id)
+	}
+	return bu
+}
+
+// SetCustomer sets the "customer" edge to the Customer entity.
+func (bu *BookingUpdate) SetCustomer(c *Customer) *BookingUpdate {
+	return bu.SetCustomerID(c.ID)
+}
+
 // Mutation returns the BookingMutation object of the builder.
 func (bu *BookingUpdate) Mutation() *BookingMutation {
 	return bu.mutation
@@ -112,12 +495,114 @@ func (bu *BookingUpdate) RemoveMetadata(b ...*BookingMetadatum) *BookingUpdate {
 	return bu.RemoveMetadatumIDs(ids...)
 }
 
+// ClearWaitlistEntries clears all "waitlistEntries" edges to the WaitlistEntry entity.
+func (bu *BookingUpdate) ClearWaitlistEntries() *BookingUpdate {
+	bu.mutation.ClearWaitlistEntries()
+	return bu
+}
+
+// RemoveWaitlistEntryIDs removes the "waitlistEntries" edge to WaitlistEntry entities by IDs.
+func (bu *BookingUpdate) RemoveWaitlistEntryIDs(ids ...int) *BookingUpdate {
+	bu.mutation.RemoveWaitlistEntryIDs(ids...)
+	return bu
+}
+
+// RemoveWaitlistEntries removes "waitlistEntries" edges to WaitlistEntry entities.
+func (bu *BookingUpdate) RemoveWaitlistEntries(w ...*WaitlistEntry) *BookingUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return bu.RemoveWaitlistEntryIDs(ids...)
+}
+
+// ClearPayments clears all "payments" edges to the Payment entity.
+func (bu *BookingUpdate) ClearPayments() *BookingUpdate {
+	bu.mutation.ClearPayments()
+	return bu
+}
+
+// RemovePaymentIDs removes the "payments" edge to Payment entities by IDs.
+func (bu *BookingUpdate) RemovePaymentIDs(ids ...int) *BookingUpdate {
+	bu.mutation.RemovePaymentIDs(ids...)
+	return bu
+}
+
+// RemovePayments removes "payments" edges to Payment entities.
+func (bu *BookingUpdate) RemovePayments(p ...*Payment) *BookingUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bu.RemovePaymentIDs(ids...)
+}
+
+// ClearPromoRedemptions clears all "promoRedemptions" edges to the PromoRedemption entity.
+func (bu *BookingUpdate) ClearPromoRedemptions() *BookingUpdate {
+	bu.mutation.ClearPromoRedemptions()
+	return bu
+}
+
+// RemovePromoRedemptionIDs removes the "promoRedemptions" edge to PromoRedemption entities by IDs.
+func (bu *BookingUpdate) RemovePromoRedemptionIDs(ids ...int) *BookingUpdate {
+	bu.mutation.RemovePromoRedemptionIDs(ids...)
+	return bu
+}
+
+// RemovePromoRedemptions removes "promoRedemptions" edges to PromoRedemption entities.
+func (bu *BookingUpdate) RemovePromoRedemptions(p ...*PromoRedemption) *BookingUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bu.RemovePromoRedemptionIDs(ids...)
+}
+
+// ClearInvoices clears all "invoices" edges to the Invoice entity.
+func (bu *BookingUpdate) ClearInvoices() *BookingUpdate {
+	bu.mutation.ClearInvoices()
+	return bu
+}
+
+// RemoveInvoiceIDs removes the "invoices" edge to Invoice entities by IDs.
+func (bu *BookingUpdate) RemoveInvoiceIDs(ids ...int) *BookingUpdate {
+	bu.mutation.RemoveInvoiceIDs(ids...)
+	return bu
+}
+
+// RemoveInvoices removes "invoices" edges to Invoice entities.
+func (bu *BookingUpdate) RemoveInvoices(i ...*Invoice) *BookingUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return bu.RemoveInvoiceIDs(ids...)
+}
+
 // ClearResource clears the "resource" edge to the Resource entity.
 func (bu *BookingUpdate) ClearResource() *BookingUpdate {
 	bu.mutation.ClearResource()
 	return bu
 }
 
+// ClearUser clears the "user" edge to the User entity.
+func (bu *BookingUpdate) ClearUser() *BookingUpdate {
+	bu.mutation.ClearUser()
+	return bu
+}
+
+// ClearSeries clears the "series" edge to the BookingSeries entity.
+func (bu *BookingUpdate) ClearSeries() *BookingUpdate {
+	bu.mutation.ClearSeries()
+	return bu
+}
+
+// ClearCustomer clears the "customer" edge to the Customer entity.
+func (bu *BookingUpdate) ClearCustomer() *BookingUpdate {
+	bu.mutation.ClearCustomer()
+	return bu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (bu *BookingUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -247,36 +732,165 @@ func (bu *BookingUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: booking.FieldEndTime,
 		})
 	}
-	if bu.mutation.MetadataCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   booking.MetadataTable,
-			Columns: []string{booking.MetadataColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: &sqlgraph.FieldSpec{
-					Type:   field.TypeInt,
-					Column: bookingmetadatum.FieldID,
-				},
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	if value, ok := bu.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: booking.FieldExpiresAt,
+		})
 	}
-	if nodes := bu.mutation.RemovedMetadataIDs(); len(nodes) > 0 && !bu.mutation.MetadataCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   booking.MetadataTable,
-			Columns: []string{booking.MetadataColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: &sqlgraph.FieldSpec{
-					Type:   field.TypeInt,
-					Column: bookingmetadatum.FieldID,
-				},
-			},
-		}
+	if bu.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: booking.FieldExpiresAt,
+		})
+	}
+	if value, ok := bu.mutation.Price(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: booking.FieldPrice,
+		})
+	}
+	if value, ok := bu.mutation.AddedPrice(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: booking.FieldPrice,
+		})
+	}
+	if bu.mutation.PriceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: booking.FieldPrice,
+		})
+	}
+	if value, ok := bu.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: booking.FieldCurrency,
+		})
+	}
+	if value, ok := bu.mutation.CancelledAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: booking.FieldCancelledAt,
+		})
+	}
+	if bu.mutation.CancelledAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: booking.FieldCancelledAt,
+		})
+	}
+	if value, ok := bu.mutation.RefundAmount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: booking.FieldRefundAmount,
+		})
+	}
+	if value, ok := bu.mutation.AddedRefundAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: booking.FieldRefundAmount,
+		})
+	}
+	if value, ok := bu.mutation.FeeAmount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: booking.FieldFeeAmount,
+		})
+	}
+	if value, ok := bu.mutation.AddedFeeAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: booking.FieldFeeAmount,
+		})
+	}
+	if value, ok := bu.mutation.TaxName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: booking.FieldTaxName,
+		})
+	}
+	if bu.mutation.TaxNameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: booking.FieldTaxName,
+		})
+	}
+	if value, ok := bu.mutation.TaxRate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: booking.FieldTaxRate,
+		})
+	}
+	if value, ok := bu.mutation.AddedTaxRate(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: booking.FieldTaxRate,
+		})
+	}
+	if value, ok := bu.mutation.TaxInclusive(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: booking.FieldTaxInclusive,
+		})
+	}
+	if value, ok := bu.mutation.TaxAmount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: booking.FieldTaxAmount,
+		})
+	}
+	if value, ok := bu.mutation.AddedTaxAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: booking.FieldTaxAmount,
+		})
+	}
+	if bu.mutation.MetadataCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.MetadataTable,
+			Columns: []string{booking.MetadataColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: bookingmetadatum.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedMetadataIDs(); len(nodes) > 0 && !bu.mutation.MetadataCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.MetadataTable,
+			Columns: []string{booking.MetadataColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: bookingmetadatum.FieldID,
+				},
+			},
+		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
@@ -301,6 +915,222 @@ func (bu *BookingUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if bu.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.WaitlistEntriesTable,
+			Columns: []string{booking.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: waitlistentry.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedWaitlistEntriesIDs(); len(nodes) > 0 && !bu.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.WaitlistEntriesTable,
+			Columns: []string{booking.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: waitlistentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.WaitlistEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.WaitlistEntriesTable,
+			Columns: []string{booking.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: waitlistentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.PaymentsTable,
+			Columns: []string{booking.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: payment.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedPaymentsIDs(); len(nodes) > 0 && !bu.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.PaymentsTable,
+			Columns: []string{booking.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: payment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.PaymentsTable,
+			Columns: []string{booking.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: payment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.PromoRedemptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.PromoRedemptionsTable,
+			Columns: []string{booking.PromoRedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: promoredemption.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedPromoRedemptionsIDs(); len(nodes) > 0 && !bu.mutation.PromoRedemptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.PromoRedemptionsTable,
+			Columns: []string{booking.PromoRedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: promoredemption.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.PromoRedemptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.PromoRedemptionsTable,
+			Columns: []string{booking.PromoRedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: promoredemption.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.InvoicesTable,
+			Columns: []string{booking.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedInvoicesIDs(); len(nodes) > 0 && !bu.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.InvoicesTable,
+			Columns: []string{booking.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.InvoicesTable,
+			Columns: []string{booking.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if bu.mutation.ResourceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -317,71 +1147,435 @@ func (bu *BookingUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := bu.mutation.ResourceIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   booking.ResourceTable,
-			Columns: []string{booking.ResourceColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: &sqlgraph.FieldSpec{
-					Type:   field.TypeInt,
-					Column: resource.FieldID,
-				},
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	if nodes := bu.mutation.ResourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booking.ResourceTable,
+			Columns: []string{booking.ResourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: resource.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booking.UserTable,
+			Columns: []string{booking.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booking.UserTable,
+			Columns: []string{booking.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.SeriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booking.SeriesTable,
+			Columns: []string{booking.SeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: bookingseries.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.SeriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booking.SeriesTable,
+			Columns: []string{booking.SeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: bookingseries.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.CustomerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booking.CustomerTable,
+			Columns: []string{booking.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: customer.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booking.CustomerTable,
+			Columns: []string{booking.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: customer.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{booking.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// BookingUpdateOne is the builder for updating a single Booking entity.
+type BookingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BookingMutation
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (buo *BookingUpdateOne) SetUpdatedAt(t time.Time) *BookingUpdateOne {
+	buo.mutation.SetUpdatedAt(t)
+	return buo
+}
+
+// SetStatus sets the "status" field.
+func (buo *BookingUpdateOne) SetStatus(s string) *BookingUpdateOne {
+	buo.mutation.SetStatus(s)
+	return buo
+}
+
+// SetStartTime sets the "startTime" field.
+func (buo *BookingUpdateOne) SetStartTime(t time.Time) *BookingUpdateOne {
+	buo.mutation.SetStartTime(t)
+	return buo
+}
+
+// SetEndTime sets the "endTime" field.
+func (buo *BookingUpdateOne) SetEndTime(t time.Time) *BookingUpdateOne {
+	buo.mutation.SetEndTime(t)
+	return buo
+}
+
+// SetResourceId sets the "resourceId" field.
+func (buo *BookingUpdateOne) SetResourceId(i int) *BookingUpdateOne {
+	buo.mutation.SetResourceId(i)
+	return buo
+}
+
+// SetUserId sets the "userId" field.
+func (buo *BookingUpdateOne) SetUserId(i int) *BookingUpdateOne {
+	buo.mutation.SetUserId(i)
+	return buo
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (buo *BookingUpdateOne) SetNillableUserId(i *int) *BookingUpdateOne {
+	if i != nil {
+		buo.SetUserId(*i)
+	}
+	return buo
+}
+
+// ClearUserId clears the value of the "userId" field.
+func (buo *BookingUpdateOne) ClearUserId() *BookingUpdateOne {
+	buo.mutation.ClearUserId()
+	return buo
+}
+
+// SetSeriesId sets the "seriesId" field.
+func (buo *BookingUpdateOne) SetSeriesId(i int) *BookingUpdateOne {
+	buo.mutation.SetSeriesId(i)
+	return buo
+}
+
+// SetNillableSeriesId sets the "seriesId" field if the given value is not nil.
+func (buo *BookingUpdateOne) SetNillableSeriesId(i *int) *BookingUpdateOne {
+	if i != nil {
+		buo.SetSeriesId(*i)
+	}
+	return buo
+}
+
+// ClearSeriesId clears the value of the "seriesId" field.
+func (buo *BookingUpdateOne) ClearSeriesId() *BookingUpdateOne {
+	buo.mutation.ClearSeriesId()
+	return buo
+}
+
+// SetCustomerId sets the "customerId" field.
+func (buo *BookingUpdateOne) SetCustomerId(i int) *BookingUpdateOne {
+	buo.mutation.SetCustomerId(i)
+	return buo
+}
+
+// SetNillableCustomerId sets the "customerId" field if the given value is not nil.
+func (buo *BookingUpdateOne) SetNillableCustomerId(i *int) *BookingUpdateOne {
+	if i != nil {
+		buo.SetCustomerId(*i)
+	}
+	return buo
+}
+
+// ClearCustomerId clears the value of the "customerId" field.
+func (buo *BookingUpdateOne) ClearCustomerId() *BookingUpdateOne {
+	buo.mutation.ClearCustomerId()
+	return buo
+}
+
+// SetExpiresAt sets the "expiresAt" field.
+func (buo *BookingUpdateOne) SetExpiresAt(t time.Time) *BookingUpdateOne {
+	buo.mutation.SetExpiresAt(t)
+	return buo
+}
+
+// SetNillableExpiresAt sets the "expiresAt" field if the given value is not nil.
+func (buo *BookingUpdateOne) SetNillableExpiresAt(t *time.Time) *BookingUpdateOne {
+	if t != nil {
+		buo.SetExpiresAt(*t)
+	}
+	return buo
+}
+
+// ClearExpiresAt clears the value of the "expiresAt" field.
+func (buo *BookingUpdateOne) ClearExpiresAt() *BookingUpdateOne {
+	buo.mutation.ClearExpiresAt()
+	return buo
+}
+
+// SetPrice sets the "price" field.
+func (buo *BookingUpdateOne) SetPrice(i int) *BookingUpdateOne {
+	buo.mutation.ResetPrice()
+	buo.mutation.SetPrice(i)
+	return buo
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (buo *BookingUpdateOne) SetNillablePrice(i *int) *BookingUpdateOne {
+	if i != nil {
+		buo.SetPrice(*i)
+	}
+	return buo
+}
+
+// AddPrice adds i to the "price" field.
+func (buo *BookingUpdateOne) AddPrice(i int) *BookingUpdateOne {
+	buo.mutation.AddPrice(i)
+	return buo
+}
+
+// ClearPrice clears the value of the "price" field.
+func (buo *BookingUpdateOne) ClearPrice() *BookingUpdateOne {
+	buo.mutation.ClearPrice()
+	return buo
+}
+
+// SetCurrency sets the "currency" field.
+func (buo *BookingUpdateOne) SetCurrency(s string) *BookingUpdateOne {
+	buo.mutation.SetCurrency(s)
+	return buo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (buo *BookingUpdateOne) SetNillableCurrency(s *string) *BookingUpdateOne {
+	if s != nil {
+		buo.SetCurrency(*s)
+	}
+	return buo
+}
+
+// SetCancelledAt sets the "cancelledAt" field.
+func (buo *BookingUpdateOne) SetCancelledAt(t time.Time) *BookingUpdateOne {
+	buo.mutation.SetCancelledAt(t)
+	return buo
+}
+
+// SetNillableCancelledAt sets the "cancelledAt" field if the given value is not nil.
+func (buo *BookingUpdateOne) SetNillableCancelledAt(t *time.Time) *BookingUpdateOne {
+	if t != nil {
+		buo.SetCancelledAt(*t)
+	}
+	return buo
+}
+
+// ClearCancelledAt clears the value of the "cancelledAt" field.
+func (buo *BookingUpdateOne) ClearCancelledAt() *BookingUpdateOne {
+	buo.mutation.ClearCancelledAt()
+	return buo
+}
+
+// SetRefundAmount sets the "refundAmount" field.
+func (buo *BookingUpdateOne) SetRefundAmount(i int) *BookingUpdateOne {
+	buo.mutation.ResetRefundAmount()
+	buo.mutation.SetRefundAmount(i)
+	return buo
+}
+
+// SetNillableRefundAmount sets the "refundAmount" field if the given value is not nil.
+func (buo *BookingUpdateOne) SetNillableRefundAmount(i *int) *BookingUpdateOne {
+	if i != nil {
+		buo.SetRefundAmount(*i)
+	}
+	return buo
+}
+
+// AddRefundAmount adds i to the "refundAmount" field.
+func (buo *BookingUpdateOne) AddRefundAmount(i int) *BookingUpdateOne {
+	buo.mutation.AddRefundAmount(i)
+	return buo
+}
+
+// SetFeeAmount sets the "feeAmount" field.
+func (buo *BookingUpdateOne) SetFeeAmount(i int) *BookingUpdateOne {
+	buo.mutation.ResetFeeAmount()
+	buo.mutation.SetFeeAmount(i)
+	return buo
+}
+
+// SetNillableFeeAmount sets the "feeAmount" field if the given value is not nil.
+func (buo *BookingUpdateOne) SetNillableFeeAmount(i *int) *BookingUpdateOne {
+	if i != nil {
+		buo.SetFeeAmount(*i)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{booking.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{err.Error(), err}
-		}
-		return 0, err
+	return buo
+}
+
+// AddFeeAmount adds i to the "feeAmount" field.
+func (buo *BookingUpdateOne) AddFeeAmount(i int) *BookingUpdateOne {
+	buo.mutation.AddFeeAmount(i)
+	return buo
+}
+
+// SetTaxName sets the "taxName" field.
+func (buo *BookingUpdateOne) SetTaxName(s string) *BookingUpdateOne {
+	buo.mutation.SetTaxName(s)
+	return buo
+}
+
+// SetNillableTaxName sets the "taxName" field if the given value is not nil.
+func (buo *BookingUpdateOne) SetNillableTaxName(s *string) *BookingUpdateOne {
+	if s != nil {
+		buo.SetTaxName(*s)
 	}
-	return n, nil
+	return buo
 }
 
-// BookingUpdateOne is the builder for updating a single Booking entity.
-type BookingUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *BookingMutation
+// ClearTaxName clears the value of the "taxName" field.
+func (buo *BookingUpdateOne) ClearTaxName() *BookingUpdateOne {
+	buo.mutation.ClearTaxName()
+	return buo
 }
 
-// SetUpdatedAt sets the "updatedAt" field.
-func (buo *BookingUpdateOne) SetUpdatedAt(t time.Time) *BookingUpdateOne {
-	buo.mutation.SetUpdatedAt(t)
+// SetTaxRate sets the "taxRate" field.
+func (buo *BookingUpdateOne) SetTaxRate(i int) *BookingUpdateOne {
+	buo.mutation.ResetTaxRate()
+	buo.mutation.SetTaxRate(i)
 	return buo
 }
 
-// SetStatus sets the "status" field.
-func (buo *BookingUpdateOne) SetStatus(s string) *BookingUpdateOne {
-	buo.mutation.SetStatus(s)
+// SetNillableTaxRate sets the "taxRate" field if the given value is not nil.
+func (buo *BookingUpdateOne) SetNillableTaxRate(i *int) *BookingUpdateOne {
+	if i != nil {
+		buo.SetTaxRate(*i)
+	}
 	return buo
 }
 
-// SetStartTime sets the "startTime" field.
-func (buo *BookingUpdateOne) SetStartTime(t time.Time) *BookingUpdateOne {
-	buo.mutation.SetStartTime(t)
+// AddTaxRate adds i to the "taxRate" field.
+func (buo *BookingUpdateOne) AddTaxRate(i int) *BookingUpdateOne {
+	buo.mutation.AddTaxRate(i)
 	return buo
 }
 
-// SetEndTime sets the "endTime" field.
-func (buo *BookingUpdateOne) SetEndTime(t time.Time) *BookingUpdateOne {
-	buo.mutation.SetEndTime(t)
+// SetTaxInclusive sets the "taxInclusive" field.
+func (buo *BookingUpdateOne) SetTaxInclusive(b bool) *BookingUpdateOne {
+	buo.mutation.SetTaxInclusive(b)
 	return buo
 }
 
-// SetResourceId sets the "resourceId" field.
-func (buo *BookingUpdateOne) SetResourceId(i int) *BookingUpdateOne {
-	buo.mutation.SetResourceId(i)
+// SetNillableTaxInclusive sets the "taxInclusive" field if the given value is not nil.
+func (buo *BookingUpdateOne) SetNillableTaxInclusive(b *bool) *BookingUpdateOne {
+	if b != nil {
+		buo.SetTaxInclusive(*b)
+	}
+	return buo
+}
+
+// SetTaxAmount sets the "taxAmount" field.
+func (buo *BookingUpdateOne) SetTaxAmount(i int) *BookingUpdateOne {
+	buo.mutation.ResetTaxAmount()
+	buo.mutation.SetTaxAmount(i)
+	return buo
+}
+
+// SetNillableTaxAmount sets the "taxAmount" field if the given value is not nil.
+func (buo *BookingUpdateOne) SetNillableTaxAmount(i *int) *BookingUpdateOne {
+	if i != nil {
+		buo.SetTaxAmount(*i)
+	}
+	return buo
+}
+
+// AddTaxAmount adds i to the "taxAmount" field.
+func (buo *BookingUpdateOne) AddTaxAmount(i int) *BookingUpdateOne {
+	buo.mutation.AddTaxAmount(i)
 	return buo
 }
 
@@ -400,6 +1594,66 @@ func (buo *BookingUpdateOne) AddMetadata(b ...*BookingMetadatum) *BookingUpdateO
 	return buo.AddMetadatumIDs(ids...)
 }
 
+// AddWaitlistEntryIDs adds the "waitlistEntries" edge to the WaitlistEntry entity by IDs.
+func (buo *BookingUpdateOne) AddWaitlistEntryIDs(ids ...int) *BookingUpdateOne {
+	buo.mutation.AddWaitlistEntryIDs(ids...)
+	return buo
+}
+
+// AddWaitlistEntries adds the "waitlistEntries" edges to the WaitlistEntry entity.
+func (buo *BookingUpdateOne) AddWaitlistEntries(w ...*WaitlistEntry) *BookingUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return buo.AddWaitlistEntryIDs(ids...)
+}
+
+// AddPaymentIDs adds the "payments" edge to the Payment entity by IDs.
+func (buo *BookingUpdateOne) AddPaymentIDs(ids ...int) *BookingUpdateOne {
+	buo.mutation.AddPaymentIDs(ids...)
+	return buo
+}
+
+// AddPayments adds the "payments" edges to the Payment entity.
+func (buo *BookingUpdateOne) AddPayments(p ...*Payment) *BookingUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return buo.AddPaymentIDs(ids...)
+}
+
+// AddPromoRedemptionIDs adds the "promoRedemptions" edge to the PromoRedemption entity by IDs.
+func (buo *BookingUpdateOne) AddPromoRedemptionIDs(ids ...int) *BookingUpdateOne {
+	buo.mutation.AddPromoRedemptionIDs(ids...)
+	return buo
+}
+
+// AddPromoRedemptions adds the "promoRedemptions" edges to the PromoRedemption entity.
+func (buo *BookingUpdateOne) AddPromoRedemptions(p ...*PromoRedemption) *BookingUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return buo.AddPromoRedemptionIDs(ids...)
+}
+
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
+func (buo *BookingUpdateOne) AddInvoiceIDs(ids ...int) *BookingUpdateOne {
+	buo.mutation.AddInvoiceIDs(ids...)
+	return buo
+}
+
+// AddInvoices adds the "invoices" edges to the Invoice entity.
+func (buo *BookingUpdateOne) AddInvoices(i ...*Invoice) *BookingUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return buo.AddInvoiceIDs(ids...)
+}
+
 // SetResourceID sets the "resource" edge to the Resource entity by ID.
 func (buo *BookingUpdateOne) SetResourceID(id int) *BookingUpdateOne {
 	buo.mutation.SetResourceID(id)
@@ -411,6 +1665,63 @@ func (buo *BookingUpdateOne) SetResource(r *Resource) *BookingUpdateOne {
 	return buo.SetResourceID(r.ID)
 }
 
+// SetUserID sets the "user" edge to the User entity by ID.
+func (buo *BookingUpdateOne) SetUserID(id int) *BookingUpdateOne {
+	buo.mutation.SetUserID(id)
+	return buo
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (buo *BookingUpdateOne) SetNillableUserID(id *int) *BookingUpdateOne {
+	if id != nil {
+		buo = buo.SetUserID(*id)
+	}
+	return buo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (buo *BookingUpdateOne) SetUser(u *User) *BookingUpdateOne {
+	return buo.SetUserID(u.ID)
+}
+
+// SetSeriesID sets the "series" edge to the BookingSeries entity by ID.
+func (buo *BookingUpdateOne) SetSeriesID(id int) *BookingUpdateOne {
+	buo.mutation.SetSeriesID(id)
+	return buo
+}
+
+// SetNillableSeriesID sets the "series" edge to the BookingSeries entity by ID if the given value is not nil.
+func (buo *BookingUpdateOne) SetNillableSeriesID(id *int) *BookingUpdateOne {
+	if id != nil {
+		buo = buo.SetSeriesID(*id)
+	}
+	return buo
+}
+
+// SetSeries sets the "series" edge to the BookingSeries entity.
+func (buo *BookingUpdateOne) SetSeries(b *BookingSeries) *BookingUpdateOne {
+	return buo.SetSeriesID(b.ID)
+}
+
+// SetCustomerID sets the "customer" edge to the Customer entity by ID.
+func (buo *BookingUpdateOne) SetCustomerID(id int) *BookingUpdateOne {
+	buo.mutation.SetCustomerID(id)
+	return buo
+}
+
+// SetNillableCustomerID sets the "customer" edge to the Customer entity by ID if the given value is not nil.
+func (buo *BookingUpdateOne) SetNillableCustomerID(id *int) *BookingUpdateOne {
+	if id != nil {
+		buo = buo.SetCustomerID(*id)
+	}
+	return buo
+}
+
+// SetCustomer sets the "customer" edge to the Customer entity.
+func (buo *BookingUpdateOne) SetCustomer(c *Customer) *BookingUpdateOne {
+	return buo.SetCustomerID(c.ID)
+}
+
 // Mutation returns the BookingMutation object of the builder.
 func (buo *BookingUpdateOne) Mutation() *BookingMutation {
 	return buo.mutation
@@ -437,12 +1748,114 @@ func (buo *BookingUpdateOne) RemoveMetadata(b ...*BookingMetadatum) *BookingUpda
 	return buo.RemoveMetadatumIDs(ids...)
 }
 
+// ClearWaitlistEntries clears all "waitlistEntries" edges to the WaitlistEntry entity.
+func (buo *BookingUpdateOne) ClearWaitlistEntries() *BookingUpdateOne {
+	buo.mutation.ClearWaitlistEntries()
+	return buo
+}
+
+// RemoveWaitlistEntryIDs removes the "waitlistEntries" edge to WaitlistEntry entities by IDs.
+func (buo *BookingUpdateOne) RemoveWaitlistEntryIDs(ids ...int) *BookingUpdateOne {
+	buo.mutation.RemoveWaitlistEntryIDs(ids...)
+	return buo
+}
+
+// RemoveWaitlistEntries removes "waitlistEntries" edges to WaitlistEntry entities.
+func (buo *BookingUpdateOne) RemoveWaitlistEntries(w ...*WaitlistEntry) *BookingUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return buo.RemoveWaitlistEntryIDs(ids...)
+}
+
+// ClearPayments clears all "payments" edges to the Payment entity.
+func (buo *BookingUpdateOne) ClearPayments() *BookingUpdateOne {
+	buo.mutation.ClearPayments()
+	return buo
+}
+
+// RemovePaymentIDs removes the "payments" edge to Payment entities by IDs.
+func (buo *BookingUpdateOne) RemovePaymentIDs(ids ...int) *BookingUpdateOne {
+	buo.mutation.RemovePaymentIDs(ids...)
+	return buo
+}
+
+// RemovePayments removes "payments" edges to Payment entities.
+func (buo *BookingUpdateOne) RemovePayments(p ...*Payment) *BookingUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return buo.RemovePaymentIDs(ids...)
+}
+
+// ClearPromoRedemptions clears all "promoRedemptions" edges to the PromoRedemption entity.
+func (buo *BookingUpdateOne) ClearPromoRedemptions() *BookingUpdateOne {
+	buo.mutation.ClearPromoRedemptions()
+	return buo
+}
+
+// RemovePromoRedemptionIDs removes the "promoRedemptions" edge to PromoRedemption entities by IDs.
+func (buo *BookingUpdateOne) RemovePromoRedemptionIDs(ids ...int) *BookingUpdateOne {
+	buo.mutation.RemovePromoRedemptionIDs(ids...)
+	return buo
+}
+
+// RemovePromoRedemptions removes "promoRedemptions" edges to PromoRedemption entities.
+func (buo *BookingUpdateOne) RemovePromoRedemptions(p ...*PromoRedemption) *BookingUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return buo.RemovePromoRedemptionIDs(ids...)
+}
+
+// ClearInvoices clears all "invoices" edges to the Invoice entity.
+func (buo *BookingUpdateOne) ClearInvoices() *BookingUpdateOne {
+	buo.mutation.ClearInvoices()
+	return buo
+}
+
+// RemoveInvoiceIDs removes the "invoices" edge to Invoice entities by IDs.
+func (buo *BookingUpdateOne) RemoveInvoiceIDs(ids ...int) *BookingUpdateOne {
+	buo.mutation.RemoveInvoiceIDs(ids...)
+	return buo
+}
+
+// RemoveInvoices removes "invoices" edges to Invoice entities.
+func (buo *BookingUpdateOne) RemoveInvoices(i ...*Invoice) *BookingUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return buo.RemoveInvoiceIDs(ids...)
+}
+
 // ClearResource clears the "resource" edge to the Resource entity.
 func (buo *BookingUpdateOne) ClearResource() *BookingUpdateOne {
 	buo.mutation.ClearResource()
 	return buo
 }
 
+// ClearUser clears the "user" edge to the User entity.
+func (buo *BookingUpdateOne) ClearUser() *BookingUpdateOne {
+	buo.mutation.ClearUser()
+	return buo
+}
+
+// ClearSeries clears the "series" edge to the BookingSeries entity.
+func (buo *BookingUpdateOne) ClearSeries() *BookingUpdateOne {
+	buo.mutation.ClearSeries()
+	return buo
+}
+
+// ClearCustomer clears the "customer" edge to the Customer entity.
+func (buo *BookingUpdateOne) ClearCustomer() *BookingUpdateOne {
+	buo.mutation.ClearCustomer()
+	return buo
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (buo *BookingUpdateOne) Select(field string, fields ...string) *BookingUpdateOne {
@@ -582,18 +1995,147 @@ func (buo *BookingUpdateOne) sqlSave(ctx context.Context) (_node *Booking, err e
 			Column: booking.FieldStatus,
 		})
 	}
-	if value, ok := buo.mutation.StartTime(); ok {
+	if value, ok := buo.mutation.StartTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: booking.FieldStartTime,
+		})
+	}
+	if value, ok := buo.mutation.EndTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: booking.FieldEndTime,
+		})
+	}
+	if value, ok := buo.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: booking.FieldExpiresAt,
+		})
+	}
+	if buo.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: booking.FieldExpiresAt,
+		})
+	}
+	if value, ok := buo.mutation.Price(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: booking.FieldPrice,
+		})
+	}
+	if value, ok := buo.mutation.AddedPrice(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: booking.FieldPrice,
+		})
+	}
+	if buo.mutation.PriceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: booking.FieldPrice,
+		})
+	}
+	if value, ok := buo.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: booking.FieldCurrency,
+		})
+	}
+	if value, ok := buo.mutation.CancelledAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: booking.FieldCancelledAt,
+		})
+	}
+	if buo.mutation.CancelledAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: booking.FieldCancelledAt,
+		})
+	}
+	if value, ok := buo.mutation.RefundAmount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: booking.FieldRefundAmount,
+		})
+	}
+	if value, ok := buo.mutation.AddedRefundAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: booking.FieldRefundAmount,
+		})
+	}
+	if value, ok := buo.mutation.FeeAmount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: booking.FieldFeeAmount,
+		})
+	}
+	if value, ok := buo.mutation.AddedFeeAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: booking.FieldFeeAmount,
+		})
+	}
+	if value, ok := buo.mutation.TaxName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: booking.FieldTaxName,
+		})
+	}
+	if buo.mutation.TaxNameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: booking.FieldTaxName,
+		})
+	}
+	if value, ok := buo.mutation.TaxRate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: booking.FieldTaxRate,
+		})
+	}
+	if value, ok := buo.mutation.AddedTaxRate(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: booking.FieldTaxRate,
+		})
+	}
+	if value, ok := buo.mutation.TaxInclusive(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: booking.FieldTaxInclusive,
+		})
+	}
+	if value, ok := buo.mutation.TaxAmount(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeTime,
+			Type:   field.TypeInt,
 			Value:  value,
-			Column: booking.FieldStartTime,
+			Column: booking.FieldTaxAmount,
 		})
 	}
-	if value, ok := buo.mutation.EndTime(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeTime,
+	if value, ok := buo.mutation.AddedTaxAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
 			Value:  value,
-			Column: booking.FieldEndTime,
+			Column: booking.FieldTaxAmount,
 		})
 	}
 	if buo.mutation.MetadataCleared() {
@@ -650,6 +2192,222 @@ func (buo *BookingUpdateOne) sqlSave(ctx context.Context) (_node *Booking, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if buo.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.WaitlistEntriesTable,
+			Columns: []string{booking.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: waitlistentry.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedWaitlistEntriesIDs(); len(nodes) > 0 && !buo.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.WaitlistEntriesTable,
+			Columns: []string{booking.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: waitlistentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.WaitlistEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.WaitlistEntriesTable,
+			Columns: []string{booking.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: waitlistentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.PaymentsTable,
+			Columns: []string{booking.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: payment.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedPaymentsIDs(); len(nodes) > 0 && !buo.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.PaymentsTable,
+			Columns: []string{booking.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: payment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.PaymentsTable,
+			Columns: []string{booking.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: payment.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.PromoRedemptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.PromoRedemptionsTable,
+			Columns: []string{booking.PromoRedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: promoredemption.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedPromoRedemptionsIDs(); len(nodes) > 0 && !buo.mutation.PromoRedemptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.PromoRedemptionsTable,
+			Columns: []string{booking.PromoRedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: promoredemption.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.PromoRedemptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.PromoRedemptionsTable,
+			Columns: []string{booking.PromoRedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: promoredemption.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.InvoicesTable,
+			Columns: []string{booking.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedInvoicesIDs(); len(nodes) > 0 && !buo.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.InvoicesTable,
+			Columns: []string{booking.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.InvoicesTable,
+			Columns: []string{booking.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if buo.mutation.ResourceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -685,6 +2443,111 @@ func (buo *BookingUpdateOne) sqlSave(ctx context.Context) (_node *Booking, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if buo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booking.UserTable,
+			Columns: []string{booking.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booking.UserTable,
+			Columns: []string{booking.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.SeriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booking.SeriesTable,
+			Columns: []string{booking.SeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: bookingseries.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.SeriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booking.SeriesTable,
+			Columns: []string{booking.SeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: bookingseries.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.CustomerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booking.CustomerTable,
+			Columns: []string{booking.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: customer.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booking.CustomerTable,
+			Columns: []string{booking.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: customer.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Booking{config: buo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/openmesh/booking/ent/booking"
@@ -18,6 +19,7 @@ type BookingMetadatumCreate struct {
 	config
 	mutation *BookingMetadatumMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetKey sets the "key" field.
@@ -158,6 +160,7 @@ func (bmc *BookingMetadatumCreate) createSpec() (*BookingMetadatum, *sqlgraph.Cr
 			},
 		}
 	)
+	_spec.OnConflict = bmc.conflict
 	if value, ok := bmc.mutation.Key(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -197,10 +200,211 @@ func (bmc *BookingMetadatumCreate) createSpec() (*BookingMetadatum, *sqlgraph.Cr
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BookingMetadatum.Create().
+//		SetKey(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BookingMetadatumUpsert) {
+//			SetKey(v+v).
+//		}).
+//		Exec(ctx)
+func (bmc *BookingMetadatumCreate) OnConflict(opts ...sql.ConflictOption) *BookingMetadatumUpsertOne {
+	bmc.conflict = opts
+	return &BookingMetadatumUpsertOne{
+		create: bmc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BookingMetadatum.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bmc *BookingMetadatumCreate) OnConflictColumns(columns ...string) *BookingMetadatumUpsertOne {
+	bmc.conflict = append(bmc.conflict, sql.ConflictColumns(columns...))
+	return &BookingMetadatumUpsertOne{
+		create: bmc,
+	}
+}
+
+type (
+	// BookingMetadatumUpsertOne is the builder for "upsert"-ing
+	//  one BookingMetadatum node.
+	BookingMetadatumUpsertOne struct {
+		create *BookingMetadatumCreate
+	}
+
+	// BookingMetadatumUpsert is the "OnConflict" setter.
+	BookingMetadatumUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetKey sets the "key" field.
+func (u *BookingMetadatumUpsert) SetKey(v string) *BookingMetadatumUpsert {
+	u.Set(bookingmetadatum.FieldKey, v)
+	return u
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *BookingMetadatumUpsert) UpdateKey() *BookingMetadatumUpsert {
+	u.SetExcluded(bookingmetadatum.FieldKey)
+	return u
+}
+
+// SetValue sets the "value" field.
+func (u *BookingMetadatumUpsert) SetValue(v string) *BookingMetadatumUpsert {
+	u.Set(bookingmetadatum.FieldValue, v)
+	return u
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *BookingMetadatumUpsert) UpdateValue() *BookingMetadatumUpsert {
+	u.SetExcluded(bookingmetadatum.FieldValue)
+	return u
+}
+
+// SetBookingId sets the "bookingId" field.
+func (u *BookingMetadatumUpsert) SetBookingId(v int) *BookingMetadatumUpsert {
+	u.Set(bookingmetadatum.FieldBookingId, v)
+	return u
+}
+
+// UpdateBookingId sets the "bookingId" field to the value that was provided on create.
+func (u *BookingMetadatumUpsert) UpdateBookingId() *BookingMetadatumUpsert {
+	u.SetExcluded(bookingmetadatum.FieldBookingId)
+	return u
+}
+
+// UpdateNewValues updates the fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.BookingMetadatum.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BookingMetadatumUpsertOne) UpdateNewValues() *BookingMetadatumUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BookingMetadatum.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BookingMetadatumUpsertOne) Ignore() *BookingMetadatumUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BookingMetadatumUpsertOne) DoNothing() *BookingMetadatumUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BookingMetadatumCreate.OnConflict
+// documentation for more info.
+func (u *BookingMetadatumUpsertOne) Update(set func(*BookingMetadatumUpsert)) *BookingMetadatumUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BookingMetadatumUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetKey sets the "key" field.
+func (u *BookingMetadatumUpsertOne) SetKey(v string) *BookingMetadatumUpsertOne {
+	return u.Update(func(s *BookingMetadatumUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *BookingMetadatumUpsertOne) UpdateKey() *BookingMetadatumUpsertOne {
+	return u.Update(func(s *BookingMetadatumUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetValue sets the "value" field.
+func (u *BookingMetadatumUpsertOne) SetValue(v string) *BookingMetadatumUpsertOne {
+	return u.Update(func(s *BookingMetadatumUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *BookingMetadatumUpsertOne) UpdateValue() *BookingMetadatumUpsertOne {
+	return u.Update(func(s *BookingMetadatumUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// SetBookingId sets the "bookingId" field.
+func (u *BookingMetadatumUpsertOne) SetBookingId(v int) *BookingMetadatumUpsertOne {
+	return u.Update(func(s *BookingMetadatumUpsert) {
+		s.SetBookingId(v)
+	})
+}
+
+// UpdateBookingId sets the "bookingId" field to the value that was provided on create.
+func (u *BookingMetadatumUpsertOne) UpdateBookingId() *BookingMetadatumUpsertOne {
+	return u.Update(func(s *BookingMetadatumUpsert) {
+		s.UpdateBookingId()
+	})
+}
+
+// Exec executes the query.
+func (u *BookingMetadatumUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BookingMetadatumCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BookingMetadatumUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BookingMetadatumUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BookingMetadatumUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // BookingMetadatumCreateBulk is the builder for creating many BookingMetadatum entities in bulk.
 type BookingMetadatumCreateBulk struct {
 	config
 	builders []*BookingMetadatumCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the BookingMetadatum entities in the database.
@@ -226,6 +430,7 @@ func (bmcb *BookingMetadatumCreateBulk) Save(ctx context.Context) ([]*BookingMet
 					_, err = mutators[i+1].Mutate(root, bmcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = bmcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, bmcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -279,3 +484,146 @@ func (bmcb *BookingMetadatumCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BookingMetadatum.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BookingMetadatumUpsert) {
+//			SetKey(v+v).
+//		}).
+//		Exec(ctx)
+func (bmcb *BookingMetadatumCreateBulk) OnConflict(opts ...sql.ConflictOption) *BookingMetadatumUpsertBulk {
+	bmcb.conflict = opts
+	return &BookingMetadatumUpsertBulk{
+		create: bmcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BookingMetadatum.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bmcb *BookingMetadatumCreateBulk) OnConflictColumns(columns ...string) *BookingMetadatumUpsertBulk {
+	bmcb.conflict = append(bmcb.conflict, sql.ConflictColumns(columns...))
+	return &BookingMetadatumUpsertBulk{
+		create: bmcb,
+	}
+}
+
+// BookingMetadatumUpsertBulk is the builder for "upsert"-ing
+// a bulk of BookingMetadatum nodes.
+type BookingMetadatumUpsertBulk struct {
+	create *BookingMetadatumCreateBulk
+}
+
+// UpdateNewValues updates the fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BookingMetadatum.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BookingMetadatumUpsertBulk) UpdateNewValues() *BookingMetadatumUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BookingMetadatum.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BookingMetadatumUpsertBulk) Ignore() *BookingMetadatumUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BookingMetadatumUpsertBulk) DoNothing() *BookingMetadatumUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BookingMetadatumCreateBulk.OnConflict
+// documentation for more info.
+func (u *BookingMetadatumUpsertBulk) Update(set func(*BookingMetadatumUpsert)) *BookingMetadatumUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BookingMetadatumUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetKey sets the "key" field.
+func (u *BookingMetadatumUpsertBulk) SetKey(v string) *BookingMetadatumUpsertBulk {
+	return u.Update(func(s *BookingMetadatumUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *BookingMetadatumUpsertBulk) UpdateKey() *BookingMetadatumUpsertBulk {
+	return u.Update(func(s *BookingMetadatumUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetValue sets the "value" field.
+func (u *BookingMetadatumUpsertBulk) SetValue(v string) *BookingMetadatumUpsertBulk {
+	return u.Update(func(s *BookingMetadatumUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *BookingMetadatumUpsertBulk) UpdateValue() *BookingMetadatumUpsertBulk {
+	return u.Update(func(s *BookingMetadatumUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// SetBookingId sets the "bookingId" field.
+func (u *BookingMetadatumUpsertBulk) SetBookingId(v int) *BookingMetadatumUpsertBulk {
+	return u.Update(func(s *BookingMetadatumUpsert) {
+		s.SetBookingId(v)
+	})
+}
+
+// UpdateBookingId sets the "bookingId" field to the value that was provided on create.
+func (u *BookingMetadatumUpsertBulk) UpdateBookingId() *BookingMetadatumUpsertBulk {
+	return u.Update(func(s *BookingMetadatumUpsert) {
+		s.UpdateBookingId()
+	})
+}
+
+// Exec executes the query.
+func (u *BookingMetadatumUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BookingMetadatumCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BookingMetadatumCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BookingMetadatumUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
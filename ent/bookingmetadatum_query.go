@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -27,6 +28,7 @@ type BookingMetadatumQuery struct {
 	predicates []predicate.BookingMetadatum
 	// eager-loading edges.
 	withBooking *BookingQuery
+	modifiers   []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -372,6 +374,9 @@ func (bmq *BookingMetadatumQuery) sqlAll(ctx context.Context) ([]*BookingMetadat
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(bmq.modifiers) > 0 {
+		_spec.Modifiers = bmq.modifiers
+	}
 	if err := sqlgraph.QueryNodes(ctx, bmq.driver, _spec); err != nil {
 		return nil, err
 	}
@@ -410,6 +415,9 @@ func (bmq *BookingMetadatumQuery) sqlAll(ctx context.Context) ([]*BookingMetadat
 
 func (bmq *BookingMetadatumQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := bmq.querySpec()
+	if len(bmq.modifiers) > 0 {
+		_spec.Modifiers = bmq.modifiers
+	}
 	return sqlgraph.CountNodes(ctx, bmq.driver, _spec)
 }
 
@@ -481,6 +489,9 @@ func (bmq *BookingMetadatumQuery) sqlQuery(ctx context.Context) *sql.Selector {
 		selector = bmq.sql
 		selector.Select(selector.Columns(columns...)...)
 	}
+	for _, m := range bmq.modifiers {
+		m(selector)
+	}
 	for _, p := range bmq.predicates {
 		p(selector)
 	}
@@ -498,6 +509,32 @@ func (bmq *BookingMetadatumQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (bmq *BookingMetadatumQuery) ForUpdate(opts ...sql.LockOption) *BookingMetadatumQuery {
+	if bmq.driver.Dialect() == dialect.Postgres {
+		bmq.Unique(false)
+	}
+	bmq.modifiers = append(bmq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return bmq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (bmq *BookingMetadatumQuery) ForShare(opts ...sql.LockOption) *BookingMetadatumQuery {
+	if bmq.driver.Dialect() == dialect.Postgres {
+		bmq.Unique(false)
+	}
+	bmq.modifiers = append(bmq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return bmq
+}
+
 // BookingMetadatumGroupBy is the group-by builder for BookingMetadatum entities.
 type BookingMetadatumGroupBy struct {
 	config
@@ -0,0 +1,89 @@
+// Code generated by entc, DO NOT EDIT.
+
+package bookingseries
+
+import (
+	"time"
+
+	"entgo.io/ent"
+)
+
+const (
+	// Label holds the string label denoting the bookingseries type in the database.
+	Label = "booking_series"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the createdat field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updatedat field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldRrule holds the string denoting the rrule field in the database.
+	FieldRrule = "rrule"
+	// FieldExdates holds the string denoting the exdates field in the database.
+	FieldExdates = "exdates"
+	// FieldStartTime holds the string denoting the starttime field in the database.
+	FieldStartTime = "start_time"
+	// FieldEndTime holds the string denoting the endtime field in the database.
+	FieldEndTime = "end_time"
+	// FieldResourceId holds the string denoting the resourceid field in the database.
+	FieldResourceId = "resource_id"
+	// EdgeBookings holds the string denoting the bookings edge name in mutations.
+	EdgeBookings = "bookings"
+	// EdgeResource holds the string denoting the resource edge name in mutations.
+	EdgeResource = "resource"
+	// Table holds the table name of the bookingseries in the database.
+	Table = "booking_series"
+	// BookingsTable is the table that holds the bookings relation/edge.
+	BookingsTable = "bookings"
+	// BookingsInverseTable is the table name for the Booking entity.
+	// It exists in this package in order to avoid circular dependency with the "booking" package.
+	BookingsInverseTable = "bookings"
+	// BookingsColumn is the table column denoting the bookings relation/edge.
+	BookingsColumn = "series_id"
+	// ResourceTable is the table that holds the resource relation/edge.
+	ResourceTable = "booking_series"
+	// ResourceInverseTable is the table name for the Resource entity.
+	// It exists in this package in order to avoid circular dependency with the "resource" package.
+	ResourceInverseTable = "resources"
+	// ResourceColumn is the table column denoting the resource relation/edge.
+	ResourceColumn = "resource_id"
+)
+
+// Columns holds all SQL columns for bookingseries fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldRrule,
+	FieldExdates,
+	FieldStartTime,
+	FieldEndTime,
+	FieldResourceId,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/openmesh/booking/ent/runtime"
+//
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCreatedAt holds the default value on creation for the "createdAt" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updatedAt" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updatedAt" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
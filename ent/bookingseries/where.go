@@ -0,0 +1,701 @@
+// Code generated by entc, DO NOT EDIT.
+
+package bookingseries
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/openmesh/booking/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "createdAt" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updatedAt" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// Rrule applies equality check predicate on the "rrule" field. It's identical to RruleEQ.
+func Rrule(v string) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRrule), v))
+	})
+}
+
+// StartTime applies equality check predicate on the "startTime" field. It's identical to StartTimeEQ.
+func StartTime(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartTime), v))
+	})
+}
+
+// EndTime applies equality check predicate on the "endTime" field. It's identical to EndTimeEQ.
+func EndTime(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndTime), v))
+	})
+}
+
+// ResourceId applies equality check predicate on the "resourceId" field. It's identical to ResourceIdEQ.
+func ResourceId(v int) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResourceId), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "createdAt" field.
+func CreatedAtEQ(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "createdAt" field.
+func CreatedAtNEQ(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "createdAt" field.
+func CreatedAtIn(vs ...time.Time) predicate.BookingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "createdAt" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BookingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "createdAt" field.
+func CreatedAtGT(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "createdAt" field.
+func CreatedAtGTE(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "createdAt" field.
+func CreatedAtLT(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "createdAt" field.
+func CreatedAtLTE(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updatedAt" field.
+func UpdatedAtEQ(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updatedAt" field.
+func UpdatedAtNEQ(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updatedAt" field.
+func UpdatedAtIn(vs ...time.Time) predicate.BookingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updatedAt" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.BookingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updatedAt" field.
+func UpdatedAtGT(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updatedAt" field.
+func UpdatedAtGTE(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updatedAt" field.
+func UpdatedAtLT(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updatedAt" field.
+func UpdatedAtLTE(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// RruleEQ applies the EQ predicate on the "rrule" field.
+func RruleEQ(v string) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRrule), v))
+	})
+}
+
+// RruleNEQ applies the NEQ predicate on the "rrule" field.
+func RruleNEQ(v string) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRrule), v))
+	})
+}
+
+// RruleIn applies the In predicate on the "rrule" field.
+func RruleIn(vs ...string) predicate.BookingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRrule), v...))
+	})
+}
+
+// RruleNotIn applies the NotIn predicate on the "rrule" field.
+func RruleNotIn(vs ...string) predicate.BookingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRrule), v...))
+	})
+}
+
+// RruleGT applies the GT predicate on the "rrule" field.
+func RruleGT(v string) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRrule), v))
+	})
+}
+
+// RruleGTE applies the GTE predicate on the "rrule" field.
+func RruleGTE(v string) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRrule), v))
+	})
+}
+
+// RruleLT applies the LT predicate on the "rrule" field.
+func RruleLT(v string) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRrule), v))
+	})
+}
+
+// RruleLTE applies the LTE predicate on the "rrule" field.
+func RruleLTE(v string) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRrule), v))
+	})
+}
+
+// RruleContains applies the Contains predicate on the "rrule" field.
+func RruleContains(v string) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldRrule), v))
+	})
+}
+
+// RruleHasPrefix applies the HasPrefix predicate on the "rrule" field.
+func RruleHasPrefix(v string) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldRrule), v))
+	})
+}
+
+// RruleHasSuffix applies the HasSuffix predicate on the "rrule" field.
+func RruleHasSuffix(v string) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldRrule), v))
+	})
+}
+
+// RruleEqualFold applies the EqualFold predicate on the "rrule" field.
+func RruleEqualFold(v string) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldRrule), v))
+	})
+}
+
+// RruleContainsFold applies the ContainsFold predicate on the "rrule" field.
+func RruleContainsFold(v string) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldRrule), v))
+	})
+}
+
+// ExdatesIsNil applies the IsNil predicate on the "exdates" field.
+func ExdatesIsNil() predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldExdates)))
+	})
+}
+
+// ExdatesNotNil applies the NotNil predicate on the "exdates" field.
+func ExdatesNotNil() predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldExdates)))
+	})
+}
+
+// StartTimeEQ applies the EQ predicate on the "startTime" field.
+func StartTimeEQ(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartTime), v))
+	})
+}
+
+// StartTimeNEQ applies the NEQ predicate on the "startTime" field.
+func StartTimeNEQ(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStartTime), v))
+	})
+}
+
+// StartTimeIn applies the In predicate on the "startTime" field.
+func StartTimeIn(vs ...time.Time) predicate.BookingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStartTime), v...))
+	})
+}
+
+// StartTimeNotIn applies the NotIn predicate on the "startTime" field.
+func StartTimeNotIn(vs ...time.Time) predicate.BookingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStartTime), v...))
+	})
+}
+
+// StartTimeGT applies the GT predicate on the "startTime" field.
+func StartTimeGT(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStartTime), v))
+	})
+}
+
+// StartTimeGTE applies the GTE predicate on the "startTime" field.
+func StartTimeGTE(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStartTime), v))
+	})
+}
+
+// StartTimeLT applies the LT predicate on the "startTime" field.
+func StartTimeLT(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStartTime), v))
+	})
+}
+
+// StartTimeLTE applies the LTE predicate on the "startTime" field.
+func StartTimeLTE(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStartTime), v))
+	})
+}
+
+// EndTimeEQ applies the EQ predicate on the "endTime" field.
+func EndTimeEQ(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndTime), v))
+	})
+}
+
+// EndTimeNEQ applies the NEQ predicate on the "endTime" field.
+func EndTimeNEQ(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEndTime), v))
+	})
+}
+
+// EndTimeIn applies the In predicate on the "endTime" field.
+func EndTimeIn(vs ...time.Time) predicate.BookingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEndTime), v...))
+	})
+}
+
+// EndTimeNotIn applies the NotIn predicate on the "endTime" field.
+func EndTimeNotIn(vs ...time.Time) predicate.BookingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEndTime), v...))
+	})
+}
+
+// EndTimeGT applies the GT predicate on the "endTime" field.
+func EndTimeGT(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEndTime), v))
+	})
+}
+
+// EndTimeGTE applies the GTE predicate on the "endTime" field.
+func EndTimeGTE(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEndTime), v))
+	})
+}
+
+// EndTimeLT applies the LT predicate on the "endTime" field.
+func EndTimeLT(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEndTime), v))
+	})
+}
+
+// EndTimeLTE applies the LTE predicate on the "endTime" field.
+func EndTimeLTE(v time.Time) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEndTime), v))
+	})
+}
+
+// ResourceIdEQ applies the EQ predicate on the "resourceId" field.
+func ResourceIdEQ(v int) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResourceId), v))
+	})
+}
+
+// ResourceIdNEQ applies the NEQ predicate on the "resourceId" field.
+func ResourceIdNEQ(v int) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldResourceId), v))
+	})
+}
+
+// ResourceIdIn applies the In predicate on the "resourceId" field.
+func ResourceIdIn(vs ...int) predicate.BookingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldResourceId), v...))
+	})
+}
+
+// ResourceIdNotIn applies the NotIn predicate on the "resourceId" field.
+func ResourceIdNotIn(vs ...int) predicate.BookingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldResourceId), v...))
+	})
+}
+
+// HasBookings applies the HasEdge predicate on the "bookings" edge.
+func HasBookings() predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(BookingsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BookingsTable, BookingsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBookingsWith applies the HasEdge predicate on the "bookings" edge with a given conditions (other predicates).
+func HasBookingsWith(preds ...predicate.Booking) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(BookingsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BookingsTable, BookingsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasResource applies the HasEdge predicate on the "resource" edge.
+func HasResource() predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ResourceTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ResourceTable, ResourceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasResourceWith applies the HasEdge predicate on the "resource" edge with a given conditions (other predicates).
+func HasResourceWith(preds ...predicate.Resource) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ResourceInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ResourceTable, ResourceColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BookingSeries) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BookingSeries) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BookingSeries) predicate.BookingSeries {
+	return predicate.BookingSeries(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,949 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/bookingseries"
+	"github.com/openmesh/booking/ent/resource"
+)
+
+// BookingSeriesCreate is the builder for creating a BookingSeries entity.
+type BookingSeriesCreate struct {
+	config
+	mutation *BookingSeriesMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (bsc *BookingSeriesCreate) SetCreatedAt(t time.Time) *BookingSeriesCreate {
+	bsc.mutation.SetCreatedAt(t)
+	return bsc
+}
+
+// SetNillableCreatedAt sets the "createdAt" field if the given value is not nil.
+func (bsc *BookingSeriesCreate) SetNillableCreatedAt(t *time.Time) *BookingSeriesCreate {
+	if t != nil {
+		bsc.SetCreatedAt(*t)
+	}
+	return bsc
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (bsc *BookingSeriesCreate) SetUpdatedAt(t time.Time) *BookingSeriesCreate {
+	bsc.mutation.SetUpdatedAt(t)
+	return bsc
+}
+
+// SetNillableUpdatedAt sets the "updatedAt" field if the given value is not nil.
+func (bsc *BookingSeriesCreate) SetNillableUpdatedAt(t *time.Time) *BookingSeriesCreate {
+	if t != nil {
+		bsc.SetUpdatedAt(*t)
+	}
+	return bsc
+}
+
+// SetRrule sets the "rrule" field.
+func (bsc *BookingSeriesCreate) SetRrule(s string) *BookingSeriesCreate {
+	bsc.mutation.SetRrule(s)
+	return bsc
+}
+
+// SetExdates sets the "exdates" field.
+func (bsc *BookingSeriesCreate) SetExdates(t []time.Time) *BookingSeriesCreate {
+	bsc.mutation.SetExdates(t)
+	return bsc
+}
+
+// SetStartTime sets the "startTime" field.
+func (bsc *BookingSeriesCreate) SetStartTime(t time.Time) *BookingSeriesCreate {
+	bsc.mutation.SetStartTime(t)
+	return bsc
+}
+
+// SetEndTime sets the "endTime" field.
+func (bsc *BookingSeriesCreate) SetEndTime(t time.Time) *BookingSeriesCreate {
+	bsc.mutation.SetEndTime(t)
+	return bsc
+}
+
+// SetResourceId sets the "resourceId" field.
+func (bsc *BookingSeriesCreate) SetResourceId(i int) *BookingSeriesCreate {
+	bsc.mutation.SetResourceId(i)
+	return bsc
+}
+
+// AddBookingIDs adds the "bookings" edge to the Booking entity by IDs.
+func (bsc *BookingSeriesCreate) AddBookingIDs(ids ...int) *BookingSeriesCreate {
+	bsc.mutation.AddBookingIDs(ids...)
+	return bsc
+}
+
+// AddBookings adds the "bookings" edges to the Booking entity.
+func (bsc *BookingSeriesCreate) AddBookings(b ...*Booking) *BookingSeriesCreate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bsc.AddBookingIDs(ids...)
+}
+
+// SetResourceID sets the "resource" edge to the Resource entity by ID.
+func (bsc *BookingSeriesCreate) SetResourceID(id int) *BookingSeriesCreate {
+	bsc.mutation.SetResourceID(id)
+	return bsc
+}
+
+// SetResource sets the "resource" edge to the Resource entity.
+func (bsc *BookingSeriesCreate) SetResource(r *Resource) *BookingSeriesCreate {
+	return bsc.SetResourceID(r.ID)
+}
+
+// Mutation returns the BookingSeriesMutation object of the builder.
+func (bsc *BookingSeriesCreate) Mutation() *BookingSeriesMutation {
+	return bsc.mutation
+}
+
+// Save creates the BookingSeries in the database.
+func (bsc *BookingSeriesCreate) Save(ctx context.Context) (*BookingSeries, error) {
+	var (
+		err  error
+		node *BookingSeries
+	)
+	if err := bsc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(bsc.hooks) == 0 {
+		if err = bsc.check(); err != nil {
+			return nil, err
+		}
+		node, err = bsc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BookingSeriesMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = bsc.check(); err != nil {
+				return nil, err
+			}
+			bsc.mutation = mutation
+			if node, err = bsc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(bsc.hooks) - 1; i >= 0; i-- {
+			if bsc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bsc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, bsc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bsc *BookingSeriesCreate) SaveX(ctx context.Context) *BookingSeries {
+	v, err := bsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bsc *BookingSeriesCreate) Exec(ctx context.Context) error {
+	_, err := bsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bsc *BookingSeriesCreate) ExecX(ctx context.Context) {
+	if err := bsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bsc *BookingSeriesCreate) defaults() error {
+	if _, ok := bsc.mutation.CreatedAt(); !ok {
+		if bookingseries.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized bookingseries.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := bookingseries.DefaultCreatedAt()
+		bsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := bsc.mutation.UpdatedAt(); !ok {
+		if bookingseries.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized bookingseries.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := bookingseries.DefaultUpdatedAt()
+		bsc.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bsc *BookingSeriesCreate) check() error {
+	if _, ok := bsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "createdAt", err: errors.New(`ent: missing required field "createdAt"`)}
+	}
+	if _, ok := bsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updatedAt", err: errors.New(`ent: missing required field "updatedAt"`)}
+	}
+	if _, ok := bsc.mutation.Rrule(); !ok {
+		return &ValidationError{Name: "rrule", err: errors.New(`ent: missing required field "rrule"`)}
+	}
+	if _, ok := bsc.mutation.StartTime(); !ok {
+		return &ValidationError{Name: "startTime", err: errors.New(`ent: missing required field "startTime"`)}
+	}
+	if _, ok := bsc.mutation.EndTime(); !ok {
+		return &ValidationError{Name: "endTime", err: errors.New(`ent: missing required field "endTime"`)}
+	}
+	if _, ok := bsc.mutation.ResourceId(); !ok {
+		return &ValidationError{Name: "resourceId", err: errors.New(`ent: missing required field "resourceId"`)}
+	}
+	if _, ok := bsc.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource", err: errors.New("ent: missing required edge \"resource\"")}
+	}
+	return nil
+}
+
+func (bsc *BookingSeriesCreate) sqlSave(ctx context.Context) (*BookingSeries, error) {
+	_node, _spec := bsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (bsc *BookingSeriesCreate) createSpec() (*BookingSeries, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BookingSeries{config: bsc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: bookingseries.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: bookingseries.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = bsc.conflict
+	if value, ok := bsc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: bookingseries.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := bsc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: bookingseries.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if value, ok := bsc.mutation.Rrule(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: bookingseries.FieldRrule,
+		})
+		_node.Rrule = value
+	}
+	if value, ok := bsc.mutation.Exdates(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: bookingseries.FieldExdates,
+		})
+		_node.Exdates = value
+	}
+	if value, ok := bsc.mutation.StartTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: bookingseries.FieldStartTime,
+		})
+		_node.StartTime = value
+	}
+	if value, ok := bsc.mutation.EndTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: bookingseries.FieldEndTime,
+		})
+		_node.EndTime = value
+	}
+	if nodes := bsc.mutation.BookingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bookingseries.BookingsTable,
+			Columns: []string{bookingseries.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bsc.mutation.ResourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bookingseries.ResourceTable,
+			Columns: []string{bookingseries.ResourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: resource.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ResourceId = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BookingSeries.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BookingSeriesUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (bsc *BookingSeriesCreate) OnConflict(opts ...sql.ConflictOption) *BookingSeriesUpsertOne {
+	bsc.conflict = opts
+	return &BookingSeriesUpsertOne{
+		create: bsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BookingSeries.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bsc *BookingSeriesCreate) OnConflictColumns(columns ...string) *BookingSeriesUpsertOne {
+	bsc.conflict = append(bsc.conflict, sql.ConflictColumns(columns...))
+	return &BookingSeriesUpsertOne{
+		create: bsc,
+	}
+}
+
+type (
+	// BookingSeriesUpsertOne is the builder for "upsert"-ing
+	//  one BookingSeries node.
+	BookingSeriesUpsertOne struct {
+		create *BookingSeriesCreate
+	}
+
+	// BookingSeriesUpsert is the "OnConflict" setter.
+	BookingSeriesUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *BookingSeriesUpsert) SetCreatedAt(v time.Time) *BookingSeriesUpsert {
+	u.Set(bookingseries.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *BookingSeriesUpsert) UpdateCreatedAt() *BookingSeriesUpsert {
+	u.SetExcluded(bookingseries.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *BookingSeriesUpsert) SetUpdatedAt(v time.Time) *BookingSeriesUpsert {
+	u.Set(bookingseries.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *BookingSeriesUpsert) UpdateUpdatedAt() *BookingSeriesUpsert {
+	u.SetExcluded(bookingseries.FieldUpdatedAt)
+	return u
+}
+
+// SetRrule sets the "rrule" field.
+func (u *BookingSeriesUpsert) SetRrule(v string) *BookingSeriesUpsert {
+	u.Set(bookingseries.FieldRrule, v)
+	return u
+}
+
+// UpdateRrule sets the "rrule" field to the value that was provided on create.
+func (u *BookingSeriesUpsert) UpdateRrule() *BookingSeriesUpsert {
+	u.SetExcluded(bookingseries.FieldRrule)
+	return u
+}
+
+// SetExdates sets the "exdates" field.
+func (u *BookingSeriesUpsert) SetExdates(v []time.Time) *BookingSeriesUpsert {
+	u.Set(bookingseries.FieldExdates, v)
+	return u
+}
+
+// UpdateExdates sets the "exdates" field to the value that was provided on create.
+func (u *BookingSeriesUpsert) UpdateExdates() *BookingSeriesUpsert {
+	u.SetExcluded(bookingseries.FieldExdates)
+	return u
+}
+
+// ClearExdates clears the value of the "exdates" field.
+func (u *BookingSeriesUpsert) ClearExdates() *BookingSeriesUpsert {
+	u.SetNull(bookingseries.FieldExdates)
+	return u
+}
+
+// SetStartTime sets the "startTime" field.
+func (u *BookingSeriesUpsert) SetStartTime(v time.Time) *BookingSeriesUpsert {
+	u.Set(bookingseries.FieldStartTime, v)
+	return u
+}
+
+// UpdateStartTime sets the "startTime" field to the value that was provided on create.
+func (u *BookingSeriesUpsert) UpdateStartTime() *BookingSeriesUpsert {
+	u.SetExcluded(bookingseries.FieldStartTime)
+	return u
+}
+
+// SetEndTime sets the "endTime" field.
+func (u *BookingSeriesUpsert) SetEndTime(v time.Time) *BookingSeriesUpsert {
+	u.Set(bookingseries.FieldEndTime, v)
+	return u
+}
+
+// UpdateEndTime sets the "endTime" field to the value that was provided on create.
+func (u *BookingSeriesUpsert) UpdateEndTime() *BookingSeriesUpsert {
+	u.SetExcluded(bookingseries.FieldEndTime)
+	return u
+}
+
+// SetResourceId sets the "resourceId" field.
+func (u *BookingSeriesUpsert) SetResourceId(v int) *BookingSeriesUpsert {
+	u.Set(bookingseries.FieldResourceId, v)
+	return u
+}
+
+// UpdateResourceId sets the "resourceId" field to the value that was provided on create.
+func (u *BookingSeriesUpsert) UpdateResourceId() *BookingSeriesUpsert {
+	u.SetExcluded(bookingseries.FieldResourceId)
+	return u
+}
+
+// UpdateNewValues updates the fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.BookingSeries.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BookingSeriesUpsertOne) UpdateNewValues() *BookingSeriesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BookingSeries.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BookingSeriesUpsertOne) Ignore() *BookingSeriesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BookingSeriesUpsertOne) DoNothing() *BookingSeriesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BookingSeriesCreate.OnConflict
+// documentation for more info.
+func (u *BookingSeriesUpsertOne) Update(set func(*BookingSeriesUpsert)) *BookingSeriesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BookingSeriesUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *BookingSeriesUpsertOne) SetCreatedAt(v time.Time) *BookingSeriesUpsertOne {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *BookingSeriesUpsertOne) UpdateCreatedAt() *BookingSeriesUpsertOne {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *BookingSeriesUpsertOne) SetUpdatedAt(v time.Time) *BookingSeriesUpsertOne {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *BookingSeriesUpsertOne) UpdateUpdatedAt() *BookingSeriesUpsertOne {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetRrule sets the "rrule" field.
+func (u *BookingSeriesUpsertOne) SetRrule(v string) *BookingSeriesUpsertOne {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.SetRrule(v)
+	})
+}
+
+// UpdateRrule sets the "rrule" field to the value that was provided on create.
+func (u *BookingSeriesUpsertOne) UpdateRrule() *BookingSeriesUpsertOne {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.UpdateRrule()
+	})
+}
+
+// SetExdates sets the "exdates" field.
+func (u *BookingSeriesUpsertOne) SetExdates(v []time.Time) *BookingSeriesUpsertOne {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.SetExdates(v)
+	})
+}
+
+// UpdateExdates sets the "exdates" field to the value that was provided on create.
+func (u *BookingSeriesUpsertOne) UpdateExdates() *BookingSeriesUpsertOne {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.UpdateExdates()
+	})
+}
+
+// ClearExdates clears the value of the "exdates" field.
+func (u *BookingSeriesUpsertOne) ClearExdates() *BookingSeriesUpsertOne {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.ClearExdates()
+	})
+}
+
+// SetStartTime sets the "startTime" field.
+func (u *BookingSeriesUpsertOne) SetStartTime(v time.Time) *BookingSeriesUpsertOne {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.SetStartTime(v)
+	})
+}
+
+// UpdateStartTime sets the "startTime" field to the value that was provided on create.
+func (u *BookingSeriesUpsertOne) UpdateStartTime() *BookingSeriesUpsertOne {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.UpdateStartTime()
+	})
+}
+
+// SetEndTime sets the "endTime" field.
+func (u *BookingSeriesUpsertOne) SetEndTime(v time.Time) *BookingSeriesUpsertOne {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.SetEndTime(v)
+	})
+}
+
+// UpdateEndTime sets the "endTime" field to the value that was provided on create.
+func (u *BookingSeriesUpsertOne) UpdateEndTime() *BookingSeriesUpsertOne {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.UpdateEndTime()
+	})
+}
+
+// SetResourceId sets the "resourceId" field.
+func (u *BookingSeriesUpsertOne) SetResourceId(v int) *BookingSeriesUpsertOne {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.SetResourceId(v)
+	})
+}
+
+// UpdateResourceId sets the "resourceId" field to the value that was provided on create.
+func (u *BookingSeriesUpsertOne) UpdateResourceId() *BookingSeriesUpsertOne {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.UpdateResourceId()
+	})
+}
+
+// Exec executes the query.
+func (u *BookingSeriesUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BookingSeriesCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BookingSeriesUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BookingSeriesUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BookingSeriesUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BookingSeriesCreateBulk is the builder for creating many BookingSeries entities in bulk.
+type BookingSeriesCreateBulk struct {
+	config
+	builders []*BookingSeriesCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BookingSeries entities in the database.
+func (bscb *BookingSeriesCreateBulk) Save(ctx context.Context) ([]*BookingSeries, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(bscb.builders))
+	nodes := make([]*BookingSeries, len(bscb.builders))
+	mutators := make([]Mutator, len(bscb.builders))
+	for i := range bscb.builders {
+		func(i int, root context.Context) {
+			builder := bscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BookingSeriesMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = bscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bscb *BookingSeriesCreateBulk) SaveX(ctx context.Context) []*BookingSeries {
+	v, err := bscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bscb *BookingSeriesCreateBulk) Exec(ctx context.Context) error {
+	_, err := bscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bscb *BookingSeriesCreateBulk) ExecX(ctx context.Context) {
+	if err := bscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BookingSeries.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BookingSeriesUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (bscb *BookingSeriesCreateBulk) OnConflict(opts ...sql.ConflictOption) *BookingSeriesUpsertBulk {
+	bscb.conflict = opts
+	return &BookingSeriesUpsertBulk{
+		create: bscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BookingSeries.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bscb *BookingSeriesCreateBulk) OnConflictColumns(columns ...string) *BookingSeriesUpsertBulk {
+	bscb.conflict = append(bscb.conflict, sql.ConflictColumns(columns...))
+	return &BookingSeriesUpsertBulk{
+		create: bscb,
+	}
+}
+
+// BookingSeriesUpsertBulk is the builder for "upsert"-ing
+// a bulk of BookingSeries nodes.
+type BookingSeriesUpsertBulk struct {
+	create *BookingSeriesCreateBulk
+}
+
+// UpdateNewValues updates the fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BookingSeries.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BookingSeriesUpsertBulk) UpdateNewValues() *BookingSeriesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BookingSeries.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BookingSeriesUpsertBulk) Ignore() *BookingSeriesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BookingSeriesUpsertBulk) DoNothing() *BookingSeriesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BookingSeriesCreateBulk.OnConflict
+// documentation for more info.
+func (u *BookingSeriesUpsertBulk) Update(set func(*BookingSeriesUpsert)) *BookingSeriesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BookingSeriesUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *BookingSeriesUpsertBulk) SetCreatedAt(v time.Time) *BookingSeriesUpsertBulk {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *BookingSeriesUpsertBulk) UpdateCreatedAt() *BookingSeriesUpsertBulk {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *BookingSeriesUpsertBulk) SetUpdatedAt(v time.Time) *BookingSeriesUpsertBulk {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *BookingSeriesUpsertBulk) UpdateUpdatedAt() *BookingSeriesUpsertBulk {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetRrule sets the "rrule" field.
+func (u *BookingSeriesUpsertBulk) SetRrule(v string) *BookingSeriesUpsertBulk {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.SetRrule(v)
+	})
+}
+
+// UpdateRrule sets the "rrule" field to the value that was provided on create.
+func (u *BookingSeriesUpsertBulk) UpdateRrule() *BookingSeriesUpsertBulk {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.UpdateRrule()
+	})
+}
+
+// SetExdates sets the "exdates" field.
+func (u *BookingSeriesUpsertBulk) SetExdates(v []time.Time) *BookingSeriesUpsertBulk {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.SetExdates(v)
+	})
+}
+
+// UpdateExdates sets the "exdates" field to the value that was provided on create.
+func (u *BookingSeriesUpsertBulk) UpdateExdates() *BookingSeriesUpsertBulk {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.UpdateExdates()
+	})
+}
+
+// ClearExdates clears the value of the "exdates" field.
+func (u *BookingSeriesUpsertBulk) ClearExdates() *BookingSeriesUpsertBulk {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.ClearExdates()
+	})
+}
+
+// SetStartTime sets the "startTime" field.
+func (u *BookingSeriesUpsertBulk) SetStartTime(v time.Time) *BookingSeriesUpsertBulk {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.SetStartTime(v)
+	})
+}
+
+// UpdateStartTime sets the "startTime" field to the value that was provided on create.
+func (u *BookingSeriesUpsertBulk) UpdateStartTime() *BookingSeriesUpsertBulk {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.UpdateStartTime()
+	})
+}
+
+// SetEndTime sets the "endTime" field.
+func (u *BookingSeriesUpsertBulk) SetEndTime(v time.Time) *BookingSeriesUpsertBulk {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.SetEndTime(v)
+	})
+}
+
+// UpdateEndTime sets the "endTime" field to the value that was provided on create.
+func (u *BookingSeriesUpsertBulk) UpdateEndTime() *BookingSeriesUpsertBulk {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.UpdateEndTime()
+	})
+}
+
+// SetResourceId sets the "resourceId" field.
+func (u *BookingSeriesUpsertBulk) SetResourceId(v int) *BookingSeriesUpsertBulk {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.SetResourceId(v)
+	})
+}
+
+// UpdateResourceId sets the "resourceId" field to the value that was provided on create.
+func (u *BookingSeriesUpsertBulk) UpdateResourceId() *BookingSeriesUpsertBulk {
+	return u.Update(func(s *BookingSeriesUpsert) {
+		s.UpdateResourceId()
+	})
+}
+
+// Exec executes the query.
+func (u *BookingSeriesUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BookingSeriesCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BookingSeriesCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BookingSeriesUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
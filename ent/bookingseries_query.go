@@ -0,0 +1,1092 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/bookingseries"
+	"github.com/openmesh/booking/ent/predicate"
+	"github.com/openmesh/booking/ent/resource"
+)
+
+// BookingSeriesQuery is the builder for querying BookingSeries entities.
+type BookingSeriesQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.BookingSeries
+	// eager-loading edges.
+	withBookings *BookingQuery
+	withResource *ResourceQuery
+	modifiers    []func(s *sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BookingSeriesQuery builder.
+func (bsq *BookingSeriesQuery) Where(ps ...predicate.BookingSeries) *BookingSeriesQuery {
+	bsq.predicates = append(bsq.predicates, ps...)
+	return bsq
+}
+
+// Limit adds a limit step to the query.
+func (bsq *BookingSeriesQuery) Limit(limit int) *BookingSeriesQuery {
+	bsq.limit = &limit
+	return bsq
+}
+
+// Offset adds an offset step to the query.
+func (bsq *BookingSeriesQuery) Offset(offset int) *BookingSeriesQuery {
+	bsq.offset = &offset
+	return bsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (bsq *BookingSeriesQuery) Unique(unique bool) *BookingSeriesQuery {
+	bsq.unique = &unique
+	return bsq
+}
+
+// Order adds an order step to the query.
+func (bsq *BookingSeriesQuery) Order(o ...OrderFunc) *BookingSeriesQuery {
+	bsq.order = append(bsq.order, o...)
+	return bsq
+}
+
+// QueryBookings chains the current query on the "bookings" edge.
+func (bsq *BookingSeriesQuery) QueryBookings() *BookingQuery {
+	query := &BookingQuery{config: bsq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bookingseries.Table, bookingseries.FieldID, selector),
+			sqlgraph.To(booking.Table, booking.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, bookingseries.BookingsTable, bookingseries.BookingsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryResource chains the current query on the "resource" edge.
+func (bsq *BookingSeriesQuery) QueryResource() *ResourceQuery {
+	query := &ResourceQuery{config: bsq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bookingseries.Table, bookingseries.FieldID, selector),
+			sqlgraph.To(resource.Table, resource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, bookingseries.ResourceTable, bookingseries.ResourceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first BookingSeries entity from the query.
+// Returns a *NotFoundError when no BookingSeries was found.
+func (bsq *BookingSeriesQuery) First(ctx context.Context) (*BookingSeries, error) {
+	nodes, err := bsq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{bookingseries.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (bsq *BookingSeriesQuery) FirstX(ctx context.Context) *BookingSeries {
+	node, err := bsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BookingSeries ID from the query.
+// Returns a *NotFoundError when no BookingSeries ID was found.
+func (bsq *BookingSeriesQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = bsq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{bookingseries.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (bsq *BookingSeriesQuery) FirstIDX(ctx context.Context) int {
+	id, err := bsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BookingSeries entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one BookingSeries entity is not found.
+// Returns a *NotFoundError when no BookingSeries entities are found.
+func (bsq *BookingSeriesQuery) Only(ctx context.Context) (*BookingSeries, error) {
+	nodes, err := bsq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{bookingseries.Label}
+	default:
+		return nil, &NotSingularError{bookingseries.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (bsq *BookingSeriesQuery) OnlyX(ctx context.Context) *BookingSeries {
+	node, err := bsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BookingSeries ID in the query.
+// Returns a *NotSingularError when exactly one BookingSeries ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (bsq *BookingSeriesQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = bsq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{bookingseries.Label}
+	default:
+		err = &NotSingularError{bookingseries.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (bsq *BookingSeriesQuery) OnlyIDX(ctx context.Context) int {
+	id, err := bsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BookingSeriesSlice.
+func (bsq *BookingSeriesQuery) All(ctx context.Context) ([]*BookingSeries, error) {
+	if err := bsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return bsq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (bsq *BookingSeriesQuery) AllX(ctx context.Context) []*BookingSeries {
+	nodes, err := bsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BookingSeries IDs.
+func (bsq *BookingSeriesQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := bsq.Select(bookingseries.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (bsq *BookingSeriesQuery) IDsX(ctx context.Context) []int {
+	ids, err := bsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (bsq *BookingSeriesQuery) Count(ctx context.Context) (int, error) {
+	if err := bsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return bsq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (bsq *BookingSeriesQuery) CountX(ctx context.Context) int {
+	count, err := bsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (bsq *BookingSeriesQuery) Exist(ctx context.Context) (bool, error) {
+	if err := bsq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return bsq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (bsq *BookingSeriesQuery) ExistX(ctx context.Context) bool {
+	exist, err := bsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BookingSeriesQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (bsq *BookingSeriesQuery) Clone() *BookingSeriesQuery {
+	if bsq == nil {
+		return nil
+	}
+	return &BookingSeriesQuery{
+		config:       bsq.config,
+		limit:        bsq.limit,
+		offset:       bsq.offset,
+		order:        append([]OrderFunc{}, bsq.order...),
+		predicates:   append([]predicate.BookingSeries{}, bsq.predicates...),
+		withBookings: bsq.withBookings.Clone(),
+		withResource: bsq.withResource.Clone(),
+		// clone intermediate query.
+		sql:  bsq.sql.Clone(),
+		path: bsq.path,
+	}
+}
+
+// WithBookings tells the query-builder to eager-load the nodes that are connected to
+// the "bookings" edge. The optional arguments are used to configure the query builder of the edge.
+func (bsq *BookingSeriesQuery) WithBookings(opts ...func(*BookingQuery)) *BookingSeriesQuery {
+	query := &BookingQuery{config: bsq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	bsq.withBookings = query
+	return bsq
+}
+
+// WithResource tells the query-builder to eager-load the nodes that are connected to
+// the "resource" edge. The optional arguments are used to configure the query builder of the edge.
+func (bsq *BookingSeriesQuery) WithResource(opts ...func(*ResourceQuery)) *BookingSeriesQuery {
+	query := &ResourceQuery{config: bsq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	bsq.withResource = query
+	return bsq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"createdAt,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BookingSeries.Query().
+//		GroupBy(bookingseries.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (bsq *BookingSeriesQuery) GroupBy(field string, fields ...string) *BookingSeriesGroupBy {
+	group := &BookingSeriesGroupBy{config: bsq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := bsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return bsq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"createdAt,omitempty"`
+//	}
+//
+//	client.BookingSeries.Query().
+//		Select(bookingseries.FieldCreatedAt).
+//		Scan(ctx, &v)
+//
+func (bsq *BookingSeriesQuery) Select(fields ...string) *BookingSeriesSelect {
+	bsq.fields = append(bsq.fields, fields...)
+	return &BookingSeriesSelect{BookingSeriesQuery: bsq}
+}
+
+func (bsq *BookingSeriesQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range bsq.fields {
+		if !bookingseries.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if bsq.path != nil {
+		prev, err := bsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		bsq.sql = prev
+	}
+	if bookingseries.Policy == nil {
+		return errors.New("ent: uninitialized bookingseries.Policy (forgotten import ent/runtime?)")
+	}
+	if err := bookingseries.Policy.EvalQuery(ctx, bsq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (bsq *BookingSeriesQuery) sqlAll(ctx context.Context) ([]*BookingSeries, error) {
+	var (
+		nodes       = []*BookingSeries{}
+		_spec       = bsq.querySpec()
+		loadedTypes = [2]bool{
+			bsq.withBookings != nil,
+			bsq.withResource != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &BookingSeries{config: bsq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(bsq.modifiers) > 0 {
+		_spec.Modifiers = bsq.modifiers
+	}
+	if err := sqlgraph.QueryNodes(ctx, bsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := bsq.withBookings; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*BookingSeries)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Bookings = []*Booking{}
+		}
+		query.Where(predicate.Booking(func(s *sql.Selector) {
+			s.Where(sql.InValues(bookingseries.BookingsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.SeriesId
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "seriesId" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "seriesId" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Bookings = append(node.Edges.Bookings, n)
+		}
+	}
+
+	if query := bsq.withResource; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*BookingSeries)
+		for i := range nodes {
+			fk := nodes[i].ResourceId
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(resource.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "resourceId" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Resource = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (bsq *BookingSeriesQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := bsq.querySpec()
+	if len(bsq.modifiers) > 0 {
+		_spec.Modifiers = bsq.modifiers
+	}
+	return sqlgraph.CountNodes(ctx, bsq.driver, _spec)
+}
+
+func (bsq *BookingSeriesQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := bsq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (bsq *BookingSeriesQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   bookingseries.Table,
+			Columns: bookingseries.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: bookingseries.FieldID,
+			},
+		},
+		From:   bsq.sql,
+		Unique: true,
+	}
+	if unique := bsq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := bsq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bookingseries.FieldID)
+		for i := range fields {
+			if fields[i] != bookingseries.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := bsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := bsq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := bsq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := bsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (bsq *BookingSeriesQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(bsq.driver.Dialect())
+	t1 := builder.Table(bookingseries.Table)
+	columns := bsq.fields
+	if len(columns) == 0 {
+		columns = bookingseries.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if bsq.sql != nil {
+		selector = bsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	for _, m := range bsq.modifiers {
+		m(selector)
+	}
+	for _, p := range bsq.predicates {
+		p(selector)
+	}
+	for _, p := range bsq.order {
+		p(selector)
+	}
+	if offset := bsq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := bsq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (bsq *BookingSeriesQuery) ForUpdate(opts ...sql.LockOption) *BookingSeriesQuery {
+	if bsq.driver.Dialect() == dialect.Postgres {
+		bsq.Unique(false)
+	}
+	bsq.modifiers = append(bsq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return bsq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (bsq *BookingSeriesQuery) ForShare(opts ...sql.LockOption) *BookingSeriesQuery {
+	if bsq.driver.Dialect() == dialect.Postgres {
+		bsq.Unique(false)
+	}
+	bsq.modifiers = append(bsq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return bsq
+}
+
+// BookingSeriesGroupBy is the group-by builder for BookingSeries entities.
+type BookingSeriesGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (bsgb *BookingSeriesGroupBy) Aggregate(fns ...AggregateFunc) *BookingSeriesGroupBy {
+	bsgb.fns = append(bsgb.fns, fns...)
+	return bsgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (bsgb *BookingSeriesGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := bsgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	bsgb.sql = query
+	return bsgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (bsgb *BookingSeriesGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := bsgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (bsgb *BookingSeriesGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(bsgb.fields) > 1 {
+		return nil, errors.New("ent: BookingSeriesGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := bsgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (bsgb *BookingSeriesGroupBy) StringsX(ctx context.Context) []string {
+	v, err := bsgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (bsgb *BookingSeriesGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = bsgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{bookingseries.Label}
+	default:
+		err = fmt.Errorf("ent: BookingSeriesGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (bsgb *BookingSeriesGroupBy) StringX(ctx context.Context) string {
+	v, err := bsgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (bsgb *BookingSeriesGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(bsgb.fields) > 1 {
+		return nil, errors.New("ent: BookingSeriesGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := bsgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (bsgb *BookingSeriesGroupBy) IntsX(ctx context.Context) []int {
+	v, err := bsgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (bsgb *BookingSeriesGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = bsgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{bookingseries.Label}
+	default:
+		err = fmt.Errorf("ent: BookingSeriesGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (bsgb *BookingSeriesGroupBy) IntX(ctx context.Context) int {
+	v, err := bsgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (bsgb *BookingSeriesGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(bsgb.fields) > 1 {
+		return nil, errors.New("ent: BookingSeriesGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := bsgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (bsgb *BookingSeriesGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := bsgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (bsgb *BookingSeriesGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = bsgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{bookingseries.Label}
+	default:
+		err = fmt.Errorf("ent: BookingSeriesGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (bsgb *BookingSeriesGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := bsgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (bsgb *BookingSeriesGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(bsgb.fields) > 1 {
+		return nil, errors.New("ent: BookingSeriesGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := bsgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (bsgb *BookingSeriesGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := bsgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (bsgb *BookingSeriesGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = bsgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{bookingseries.Label}
+	default:
+		err = fmt.Errorf("ent: BookingSeriesGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (bsgb *BookingSeriesGroupBy) BoolX(ctx context.Context) bool {
+	v, err := bsgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (bsgb *BookingSeriesGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range bsgb.fields {
+		if !bookingseries.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := bsgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bsgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (bsgb *BookingSeriesGroupBy) sqlQuery() *sql.Selector {
+	selector := bsgb.sql.Select()
+	aggregation := make([]string, 0, len(bsgb.fns))
+	for _, fn := range bsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(bsgb.fields)+len(bsgb.fns))
+		for _, f := range bsgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		for _, c := range aggregation {
+			columns = append(columns, c)
+		}
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(bsgb.fields...)...)
+}
+
+// BookingSeriesSelect is the builder for selecting fields of BookingSeries entities.
+type BookingSeriesSelect struct {
+	*BookingSeriesQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bss *BookingSeriesSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := bss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	bss.sql = bss.BookingSeriesQuery.sqlQuery(ctx)
+	return bss.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (bss *BookingSeriesSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := bss.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (bss *BookingSeriesSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(bss.fields) > 1 {
+		return nil, errors.New("ent: BookingSeriesSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := bss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (bss *BookingSeriesSelect) StringsX(ctx context.Context) []string {
+	v, err := bss.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (bss *BookingSeriesSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = bss.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{bookingseries.Label}
+	default:
+		err = fmt.Errorf("ent: BookingSeriesSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (bss *BookingSeriesSelect) StringX(ctx context.Context) string {
+	v, err := bss.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (bss *BookingSeriesSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(bss.fields) > 1 {
+		return nil, errors.New("ent: BookingSeriesSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := bss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (bss *BookingSeriesSelect) IntsX(ctx context.Context) []int {
+	v, err := bss.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (bss *BookingSeriesSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = bss.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{bookingseries.Label}
+	default:
+		err = fmt.Errorf("ent: BookingSeriesSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (bss *BookingSeriesSelect) IntX(ctx context.Context) int {
+	v, err := bss.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (bss *BookingSeriesSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(bss.fields) > 1 {
+		return nil, errors.New("ent: BookingSeriesSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := bss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (bss *BookingSeriesSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := bss.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (bss *BookingSeriesSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = bss.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{bookingseries.Label}
+	default:
+		err = fmt.Errorf("ent: BookingSeriesSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (bss *BookingSeriesSelect) Float64X(ctx context.Context) float64 {
+	v, err := bss.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (bss *BookingSeriesSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(bss.fields) > 1 {
+		return nil, errors.New("ent: BookingSeriesSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := bss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (bss *BookingSeriesSelect) BoolsX(ctx context.Context) []bool {
+	v, err := bss.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (bss *BookingSeriesSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = bss.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{bookingseries.Label}
+	default:
+		err = fmt.Errorf("ent: BookingSeriesSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (bss *BookingSeriesSelect) BoolX(ctx context.Context) bool {
+	v, err := bss.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (bss *BookingSeriesSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := bss.sql.Query()
+	if err := bss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
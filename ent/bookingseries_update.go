@@ -0,0 +1,750 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/bookingseries"
+	"github.com/openmesh/booking/ent/predicate"
+	"github.com/openmesh/booking/ent/resource"
+)
+
+// BookingSeriesUpdate is the builder for updating BookingSeries entities.
+type BookingSeriesUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BookingSeriesMutation
+}
+
+// Where appends a list predicates to the BookingSeriesUpdate builder.
+func (bsu *BookingSeriesUpdate) Where(ps ...predicate.BookingSeries) *BookingSeriesUpdate {
+	bsu.mutation.Where(ps...)
+	return bsu
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (bsu *BookingSeriesUpdate) SetUpdatedAt(t time.Time) *BookingSeriesUpdate {
+	bsu.mutation.SetUpdatedAt(t)
+	return bsu
+}
+
+// SetRrule sets the "rrule" field.
+func (bsu *BookingSeriesUpdate) SetRrule(s string) *BookingSeriesUpdate {
+	bsu.mutation.SetRrule(s)
+	return bsu
+}
+
+// SetExdates sets the "exdates" field.
+func (bsu *BookingSeriesUpdate) SetExdates(t []time.Time) *BookingSeriesUpdate {
+	bsu.mutation.SetExdates(t)
+	return bsu
+}
+
+// ClearExdates clears the value of the "exdates" field.
+func (bsu *BookingSeriesUpdate) ClearExdates() *BookingSeriesUpdate {
+	bsu.mutation.ClearExdates()
+	return bsu
+}
+
+// SetStartTime sets the "startTime" field.
+func (bsu *BookingSeriesUpdate) SetStartTime(t time.Time) *BookingSeriesUpdate {
+	bsu.mutation.SetStartTime(t)
+	return bsu
+}
+
+// SetEndTime sets the "endTime" field.
+func (bsu *BookingSeriesUpdate) SetEndTime(t time.Time) *BookingSeriesUpdate {
+	bsu.mutation.SetEndTime(t)
+	return bsu
+}
+
+// SetResourceId sets the "resourceId" field.
+func (bsu *BookingSeriesUpdate) SetResourceId(i int) *BookingSeriesUpdate {
+	bsu.mutation.SetResourceId(i)
+	return bsu
+}
+
+// AddBookingIDs adds the "bookings" edge to the Booking entity by IDs.
+func (bsu *BookingSeriesUpdate) AddBookingIDs(ids ...int) *BookingSeriesUpdate {
+	bsu.mutation.AddBookingIDs(ids...)
+	return bsu
+}
+
+// AddBookings adds the "bookings" edges to the Booking entity.
+func (bsu *BookingSeriesUpdate) AddBookings(b ...*Booking) *BookingSeriesUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bsu.AddBookingIDs(ids...)
+}
+
+// SetResourceID sets the "resource" edge to the Resource entity by ID.
+func (bsu *BookingSeriesUpdate) SetResourceID(id int) *BookingSeriesUpdate {
+	bsu.mutation.SetResourceID(id)
+	return bsu
+}
+
+// SetResource sets the "resource" edge to the Resource entity.
+func (bsu *BookingSeriesUpdate) SetResource(r *Resource) *BookingSeriesUpdate {
+	return bsu.SetResourceID(r.ID)
+}
+
+// Mutation returns the BookingSeriesMutation object of the builder.
+func (bsu *BookingSeriesUpdate) Mutation() *BookingSeriesMutation {
+	return bsu.mutation
+}
+
+// ClearBookings clears all "bookings" edges to the Booking entity.
+func (bsu *BookingSeriesUpdate) ClearBookings() *BookingSeriesUpdate {
+	bsu.mutation.ClearBookings()
+	return bsu
+}
+
+// RemoveBookingIDs removes the "bookings" edge to Booking entities by IDs.
+func (bsu *BookingSeriesUpdate) RemoveBookingIDs(ids ...int) *BookingSeriesUpdate {
+	bsu.mutation.RemoveBookingIDs(ids...)
+	return bsu
+}
+
+// RemoveBookings removes "bookings" edges to Booking entities.
+func (bsu *BookingSeriesUpdate) RemoveBookings(b ...*Booking) *BookingSeriesUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bsu.RemoveBookingIDs(ids...)
+}
+
+// ClearResource clears the "resource" edge to the Resource entity.
+func (bsu *BookingSeriesUpdate) ClearResource() *BookingSeriesUpdate {
+	bsu.mutation.ClearResource()
+	return bsu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bsu *BookingSeriesUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if err := bsu.defaults(); err != nil {
+		return 0, err
+	}
+	if len(bsu.hooks) == 0 {
+		if err = bsu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = bsu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BookingSeriesMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = bsu.check(); err != nil {
+				return 0, err
+			}
+			bsu.mutation = mutation
+			affected, err = bsu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(bsu.hooks) - 1; i >= 0; i-- {
+			if bsu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bsu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, bsu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bsu *BookingSeriesUpdate) SaveX(ctx context.Context) int {
+	affected, err := bsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bsu *BookingSeriesUpdate) Exec(ctx context.Context) error {
+	_, err := bsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bsu *BookingSeriesUpdate) ExecX(ctx context.Context) {
+	if err := bsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bsu *BookingSeriesUpdate) defaults() error {
+	if _, ok := bsu.mutation.UpdatedAt(); !ok {
+		if bookingseries.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized bookingseries.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := bookingseries.UpdateDefaultUpdatedAt()
+		bsu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bsu *BookingSeriesUpdate) check() error {
+	if _, ok := bsu.mutation.ResourceID(); bsu.mutation.ResourceCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"resource\"")
+	}
+	return nil
+}
+
+func (bsu *BookingSeriesUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   bookingseries.Table,
+			Columns: bookingseries.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: bookingseries.FieldID,
+			},
+		},
+	}
+	if ps := bsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bsu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: bookingseries.FieldUpdatedAt,
+		})
+	}
+	if value, ok := bsu.mutation.Rrule(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: bookingseries.FieldRrule,
+		})
+	}
+	if value, ok := bsu.mutation.Exdates(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: bookingseries.FieldExdates,
+		})
+	}
+	if bsu.mutation.ExdatesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: bookingseries.FieldExdates,
+		})
+	}
+	if value, ok := bsu.mutation.StartTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: bookingseries.FieldStartTime,
+		})
+	}
+	if value, ok := bsu.mutation.EndTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: bookingseries.FieldEndTime,
+		})
+	}
+	if bsu.mutation.BookingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bookingseries.BookingsTable,
+			Columns: []string{bookingseries.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bsu.mutation.RemovedBookingsIDs(); len(nodes) > 0 && !bsu.mutation.BookingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bookingseries.BookingsTable,
+			Columns: []string{bookingseries.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bsu.mutation.BookingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bookingseries.BookingsTable,
+			Columns: []string{bookingseries.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bsu.mutation.ResourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bookingseries.ResourceTable,
+			Columns: []string{bookingseries.ResourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: resource.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bsu.mutation.ResourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bookingseries.ResourceTable,
+			Columns: []string{bookingseries.ResourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: resource.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bookingseries.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// BookingSeriesUpdateOne is the builder for updating a single BookingSeries entity.
+type BookingSeriesUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BookingSeriesMutation
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (bsuo *BookingSeriesUpdateOne) SetUpdatedAt(t time.Time) *BookingSeriesUpdateOne {
+	bsuo.mutation.SetUpdatedAt(t)
+	return bsuo
+}
+
+// SetRrule sets the "rrule" field.
+func (bsuo *BookingSeriesUpdateOne) SetRrule(s string) *BookingSeriesUpdateOne {
+	bsuo.mutation.SetRrule(s)
+	return bsuo
+}
+
+// SetExdates sets the "exdates" field.
+func (bsuo *BookingSeriesUpdateOne) SetExdates(t []time.Time) *BookingSeriesUpdateOne {
+	bsuo.mutation.SetExdates(t)
+	return bsuo
+}
+
+// ClearExdates clears the value of the "exdates" field.
+func (bsuo *BookingSeriesUpdateOne) ClearExdates() *BookingSeriesUpdateOne {
+	bsuo.mutation.ClearExdates()
+	return bsuo
+}
+
+// SetStartTime sets the "startTime" field.
+func (bsuo *BookingSeriesUpdateOne) SetStartTime(t time.Time) *BookingSeriesUpdateOne {
+	bsuo.mutation.SetStartTime(t)
+	return bsuo
+}
+
+// SetEndTime sets the "endTime" field.
+func (bsuo *BookingSeriesUpdateOne) SetEndTime(t time.Time) *BookingSeriesUpdateOne {
+	bsuo.mutation.SetEndTime(t)
+	return bsuo
+}
+
+// SetResourceId sets the "resourceId" field.
+func (bsuo *BookingSeriesUpdateOne) SetResourceId(i int) *BookingSeriesUpdateOne {
+	bsuo.mutation.SetResourceId(i)
+	return bsuo
+}
+
+// AddBookingIDs adds the "bookings" edge to the Booking entity by IDs.
+func (bsuo *BookingSeriesUpdateOne) AddBookingIDs(ids ...int) *BookingSeriesUpdateOne {
+	bsuo.mutation.AddBookingIDs(ids...)
+	return bsuo
+}
+
+// AddBookings adds the "bookings" edges to the Booking entity.
+func (bsuo *BookingSeriesUpdateOne) AddBookings(b ...*Booking) *BookingSeriesUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bsuo.AddBookingIDs(ids...)
+}
+
+// SetResourceID sets the "resource" edge to the Resource entity by ID.
+func (bsuo *BookingSeriesUpdateOne) SetResourceID(id int) *BookingSeriesUpdateOne {
+	bsuo.mutation.SetResourceID(id)
+	return bsuo
+}
+
+// SetResource sets the "resource" edge to the Resource entity.
+func (bsuo *BookingSeriesUpdateOne) SetResource(r *Resource) *BookingSeriesUpdateOne {
+	return bsuo.SetResourceID(r.ID)
+}
+
+// Mutation returns the BookingSeriesMutation object of the builder.
+func (bsuo *BookingSeriesUpdateOne) Mutation() *BookingSeriesMutation {
+	return bsuo.mutation
+}
+
+// ClearBookings clears all "bookings" edges to the Booking entity.
+func (bsuo *BookingSeriesUpdateOne) ClearBookings() *BookingSeriesUpdateOne {
+	bsuo.mutation.ClearBookings()
+	return bsuo
+}
+
+// RemoveBookingIDs removes the "bookings" edge to Booking entities by IDs.
+func (bsuo *BookingSeriesUpdateOne) RemoveBookingIDs(ids ...int) *BookingSeriesUpdateOne {
+	bsuo.mutation.RemoveBookingIDs(ids...)
+	return bsuo
+}
+
+// RemoveBookings removes "bookings" edges to Booking entities.
+func (bsuo *BookingSeriesUpdateOne) RemoveBookings(b ...*Booking) *BookingSeriesUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bsuo.RemoveBookingIDs(ids...)
+}
+
+// ClearResource clears the "resource" edge to the Resource entity.
+func (bsuo *BookingSeriesUpdateOne) ClearResource() *BookingSeriesUpdateOne {
+	bsuo.mutation.ClearResource()
+	return bsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (bsuo *BookingSeriesUpdateOne) Select(field string, fields ...string) *BookingSeriesUpdateOne {
+	bsuo.fields = append([]string{field}, fields...)
+	return bsuo
+}
+
+// Save executes the query and returns the updated BookingSeries entity.
+func (bsuo *BookingSeriesUpdateOne) Save(ctx context.Context) (*BookingSeries, error) {
+	var (
+		err  error
+		node *BookingSeries
+	)
+	if err := bsuo.defaults(); err != nil {
+		return nil, err
+	}
+	if len(bsuo.hooks) == 0 {
+		if err = bsuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = bsuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BookingSeriesMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = bsuo.check(); err != nil {
+				return nil, err
+			}
+			bsuo.mutation = mutation
+			node, err = bsuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(bsuo.hooks) - 1; i >= 0; i-- {
+			if bsuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bsuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, bsuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bsuo *BookingSeriesUpdateOne) SaveX(ctx context.Context) *BookingSeries {
+	node, err := bsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (bsuo *BookingSeriesUpdateOne) Exec(ctx context.Context) error {
+	_, err := bsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bsuo *BookingSeriesUpdateOne) ExecX(ctx context.Context) {
+	if err := bsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bsuo *BookingSeriesUpdateOne) defaults() error {
+	if _, ok := bsuo.mutation.UpdatedAt(); !ok {
+		if bookingseries.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized bookingseries.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := bookingseries.UpdateDefaultUpdatedAt()
+		bsuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bsuo *BookingSeriesUpdateOne) check() error {
+	if _, ok := bsuo.mutation.ResourceID(); bsuo.mutation.ResourceCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"resource\"")
+	}
+	return nil
+}
+
+func (bsuo *BookingSeriesUpdateOne) sqlSave(ctx context.Context) (_node *BookingSeries, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   bookingseries.Table,
+			Columns: bookingseries.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: bookingseries.FieldID,
+			},
+		},
+	}
+	id, ok := bsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing BookingSeries.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := bsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bookingseries.FieldID)
+		for _, f := range fields {
+			if !bookingseries.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bookingseries.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := bsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bsuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: bookingseries.FieldUpdatedAt,
+		})
+	}
+	if value, ok := bsuo.mutation.Rrule(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: bookingseries.FieldRrule,
+		})
+	}
+	if value, ok := bsuo.mutation.Exdates(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: bookingseries.FieldExdates,
+		})
+	}
+	if bsuo.mutation.ExdatesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: bookingseries.FieldExdates,
+		})
+	}
+	if value, ok := bsuo.mutation.StartTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: bookingseries.FieldStartTime,
+		})
+	}
+	if value, ok := bsuo.mutation.EndTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: bookingseries.FieldEndTime,
+		})
+	}
+	if bsuo.mutation.BookingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bookingseries.BookingsTable,
+			Columns: []string{bookingseries.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bsuo.mutation.RemovedBookingsIDs(); len(nodes) > 0 && !bsuo.mutation.BookingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bookingseries.BookingsTable,
+			Columns: []string{bookingseries.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bsuo.mutation.BookingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bookingseries.BookingsTable,
+			Columns: []string{bookingseries.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bsuo.mutation.ResourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bookingseries.ResourceTable,
+			Columns: []string{bookingseries.ResourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: resource.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bsuo.mutation.ResourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bookingseries.ResourceTable,
+			Columns: []string{bookingseries.ResourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: resource.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BookingSeries{config: bsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, bsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bookingseries.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
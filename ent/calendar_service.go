@@ -0,0 +1,285 @@
+package ent
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"entgo.io/ent/privacy"
+	"github.com/openmesh/booking"
+	entbooking "github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/resource"
+	"github.com/openmesh/booking/ent/unavailability"
+	"github.com/openmesh/booking/ical"
+	"github.com/openmesh/booking/rand"
+)
+
+type calendarService struct {
+	client *Client
+}
+
+// NewCalendarService constructs a new instance of a booking.CalendarService
+// using ent as its persistence layer.
+func NewCalendarService(client *Client) *calendarService {
+	return &calendarService{client}
+}
+
+// FindResourceCalendar retrieves the bookings and unavailabilities of a
+// resource as a calendar. Nobody is signed in when a calendar application
+// fetches the feed so privacy rules are bypassed once the feed token has been
+// checked.
+func (s *calendarService) FindResourceCalendar(
+	ctx context.Context,
+	req booking.FindResourceCalendarRequest,
+) booking.FindResourceCalendarResponse {
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	r, err := s.client.Resource.
+		Query().
+		Where(resource.ID(req.ResourceID)).
+		Only(ctx)
+	var nfe *NotFoundError
+	if err != nil && !errors.As(err, &nfe) {
+		return booking.FindResourceCalendarResponse{
+			Err: fmt.Errorf("failed to find resource: %w", err),
+		}
+	}
+	// Resources that don't exist are indistinguishable from invalid tokens so
+	// that resource IDs can't be enumerated.
+	if r == nil || r.FeedTokenHash == "" ||
+		subtle.ConstantTimeCompare([]byte(r.FeedTokenHash), []byte(hashTokenSecret(req.Token))) != 1 {
+		return booking.FindResourceCalendarResponse{
+			Err: booking.Errorf(booking.EUNAUTHORIZED, "Invalid calendar feed token."),
+		}
+	}
+
+	since := time.Now().Add(-booking.CalendarFeedHistory)
+	b, err := s.client.Booking.
+		Query().
+		Where(
+			entbooking.ResourceId(r.ID),
+			entbooking.EndTimeGT(since),
+			entbooking.StatusNotIn(booking.BookingStatusCancelled, booking.BookingStatusExpired),
+		).
+		WithMetadata().
+		Order(Asc(entbooking.FieldStartTime)).
+		All(ctx)
+	if err != nil {
+		return booking.FindResourceCalendarResponse{
+			Err: fmt.Errorf("failed to query bookings: %w", err),
+		}
+	}
+
+	u, err := s.client.Unavailability.
+		Query().
+		Where(
+			unavailability.ResourceId(r.ID),
+			unavailability.EndTimeGT(since),
+		).
+		Order(Asc(unavailability.FieldStartTime)).
+		All(ctx)
+	if err != nil {
+		return booking.FindResourceCalendarResponse{
+			Err: fmt.Errorf("failed to query unavailabilities: %w", err),
+		}
+	}
+
+	cal := &booking.Calendar{Name: r.Name}
+	for _, v := range b {
+		cal.Events = append(cal.Events, v.toCalendarEvent())
+	}
+	for _, v := range u {
+		cal.Events = append(cal.Events, v.toCalendarEvent())
+	}
+	return booking.FindResourceCalendarResponse{Calendar: cal}
+}
+
+// CreateResourceFeedToken generates a new feed token for a resource. Only a
+// hash of the token is stored.
+func (s *calendarService) CreateResourceFeedToken(
+	ctx context.Context,
+	req booking.CreateResourceFeedTokenRequest,
+) booking.CreateResourceFeedTokenResponse {
+	secret := booking.CalendarFeedTokenPrefix + rand.Key()
+	err := s.client.Resource.
+		UpdateOneID(req.ResourceID).
+		SetFeedTokenHash(hashTokenSecret(secret)).
+		Exec(ctx)
+	var nfe *NotFoundError
+	if errors.As(err, &nfe) {
+		return booking.CreateResourceFeedTokenResponse{
+			Err: booking.Errorf(booking.ERESOURCENOTFOUND, "Could not find resource with ID %d", req.ResourceID),
+		}
+	}
+	if err != nil {
+		return booking.CreateResourceFeedTokenResponse{
+			Err: fmt.Errorf("failed to update resource: %w", err),
+		}
+	}
+	return booking.CreateResourceFeedTokenResponse{Token: secret}
+}
+
+// ImportUnavailabilities creates an unavailability for each upcoming busy event
+// of an iCalendar file. Unavailabilities that were imported from an event
+// before are matched by the UID of the event and updated. Unavailabilities of
+// events that have since been removed from the calendar are left alone.
+func (s *calendarService) ImportUnavailabilities(
+	ctx context.Context,
+	req booking.ImportUnavailabilitiesRequest,
+) booking.ImportUnavailabilitiesResponse {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return booking.ImportUnavailabilitiesResponse{
+			Err: fmt.Errorf("failed to start transaction: %w", err),
+		}
+	}
+
+	r, err := findResourceByID(ctx, tx, req.ResourceID, nil)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.ImportUnavailabilitiesResponse{Err: err}
+	}
+	loc, err := r.toModel().Location()
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.ImportUnavailabilitiesResponse{
+			Err: fmt.Errorf("failed to load resource location: %w", err),
+		}
+	}
+
+	now := time.Now()
+	events, eventErrs, err := ical.Decode(strings.NewReader(req.Calendar), loc, now.Add(booking.CalendarImportHorizon))
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.ImportUnavailabilitiesResponse{
+			Err: booking.ValidationErrorf("", booking.ValidationError{
+				Name:   "calendar",
+				Reason: "Must be a valid iCalendar file: " + err.Error(),
+			}),
+		}
+	}
+
+	var skipped []booking.ValidationError
+	for _, e := range eventErrs {
+		skipped = append(skipped, booking.ValidationError{
+			Name:   fmt.Sprintf("events[%d]", e.Index),
+			Reason: e.Err.Error(),
+		})
+	}
+
+	var uids []string
+	for _, ev := range events {
+		uids = append(uids, ev.UID)
+	}
+	existing, err := tx.Unavailability.
+		Query().
+		Where(
+			unavailability.ResourceId(r.ID),
+			unavailability.ExternalIdIn(uids...),
+		).
+		All(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.ImportUnavailabilitiesResponse{
+			Err: fmt.Errorf("failed to query unavailabilities: %w", err),
+		}
+	}
+	byUID := make(map[string]*Unavailability)
+	for _, u := range existing {
+		byUID[u.ExternalId] = u
+	}
+
+	var res booking.ImportUnavailabilitiesResponse
+	for _, ev := range events {
+		if u, ok := byUID[ev.UID]; ok {
+			if u.StartTime.Equal(ev.StartTime) && u.EndTime.Equal(ev.EndTime) {
+				continue
+			}
+			u, err = u.Update().
+				SetStartTime(ev.StartTime).
+				SetEndTime(ev.EndTime).
+				Save(ctx)
+			if err != nil {
+				_ = tx.Rollback()
+				return booking.ImportUnavailabilitiesResponse{
+					Err: fmt.Errorf("failed to update unavailability: %w", err),
+				}
+			}
+			res.Updated = append(res.Updated, u.toModel())
+			continue
+		}
+
+		// Past events can't block bookings so there's no need to import them.
+		if !ev.EndTime.After(now) {
+			continue
+		}
+		u, err := tx.Unavailability.
+			Create().
+			SetResourceID(r.ID).
+			SetStartTime(ev.StartTime).
+			SetEndTime(ev.EndTime).
+			SetExternalId(ev.UID).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return booking.ImportUnavailabilitiesResponse{
+				Err: fmt.Errorf("failed to create unavailability: %w", err),
+			}
+		}
+		res.Created = append(res.Created, u.toModel())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return booking.ImportUnavailabilitiesResponse{
+			Err: fmt.Errorf("failed to commit transaction: %w", err),
+		}
+	}
+
+	res.Skipped = skipped
+	return res
+}
+
+// toCalendarEvent converts a booking into an event of a resource calendar.
+// Metadata is included in the description so that it can be seen from
+// calendar applications.
+func (b *Booking) toCalendarEvent() *booking.CalendarEvent {
+	status := booking.CalendarEventStatusConfirmed
+	if b.Status == booking.BookingStatusPending || b.Status == booking.BookingStatusHeld {
+		status = booking.CalendarEventStatusTentative
+	}
+
+	var description []string
+	for _, m := range b.Edges.Metadata {
+		description = append(description, m.Key+": "+m.Value)
+	}
+	sort.Strings(description)
+
+	return &booking.CalendarEvent{
+		UID:         fmt.Sprintf("booking-%d@openmesh-booking", b.ID),
+		Summary:     fmt.Sprintf("Booking #%d", b.ID),
+		Description: strings.Join(description, "\n"),
+		Status:      status,
+		StartTime:   b.StartTime,
+		EndTime:     b.EndTime,
+		CreatedAt:   b.CreatedAt,
+		UpdatedAt:   b.UpdatedAt,
+	}
+}
+
+// toCalendarEvent converts an unavailability into an event of a resource
+// calendar.
+func (u *Unavailability) toCalendarEvent() *booking.CalendarEvent {
+	return &booking.CalendarEvent{
+		UID:       fmt.Sprintf("unavailability-%d@openmesh-booking", u.ID),
+		Summary:   "Unavailable",
+		Status:    booking.CalendarEventStatusConfirmed,
+		StartTime: u.StartTime,
+		EndTime:   u.EndTime,
+		CreatedAt: u.CreatedAt,
+		UpdatedAt: u.UpdatedAt,
+	}
+}
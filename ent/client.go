@@ -12,13 +12,23 @@ import (
 	"github.com/openmesh/booking/ent/auth"
 	"github.com/openmesh/booking/ent/booking"
 	"github.com/openmesh/booking/ent/bookingmetadatum"
+	"github.com/openmesh/booking/ent/bookingseries"
+	"github.com/openmesh/booking/ent/customer"
+	"github.com/openmesh/booking/ent/invoice"
 	"github.com/openmesh/booking/ent/organization"
 	"github.com/openmesh/booking/ent/organizationownership"
+	"github.com/openmesh/booking/ent/payment"
+	"github.com/openmesh/booking/ent/promocode"
+	"github.com/openmesh/booking/ent/promoredemption"
 	"github.com/openmesh/booking/ent/resource"
 	"github.com/openmesh/booking/ent/slot"
+	"github.com/openmesh/booking/ent/taxrate"
 	"github.com/openmesh/booking/ent/token"
 	"github.com/openmesh/booking/ent/unavailability"
 	"github.com/openmesh/booking/ent/user"
+	"github.com/openmesh/booking/ent/waitlistentry"
+	"github.com/openmesh/booking/ent/webhook"
+	"github.com/openmesh/booking/ent/webhookdelivery"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -36,20 +46,40 @@ type Client struct {
 	Booking *BookingClient
 	// BookingMetadatum is the client for interacting with the BookingMetadatum builders.
 	BookingMetadatum *BookingMetadatumClient
+	// BookingSeries is the client for interacting with the BookingSeries builders.
+	BookingSeries *BookingSeriesClient
+	// Customer is the client for interacting with the Customer builders.
+	Customer *CustomerClient
+	// Invoice is the client for interacting with the Invoice builders.
+	Invoice *InvoiceClient
 	// Organization is the client for interacting with the Organization builders.
 	Organization *OrganizationClient
 	// OrganizationOwnership is the client for interacting with the OrganizationOwnership builders.
 	OrganizationOwnership *OrganizationOwnershipClient
+	// Payment is the client for interacting with the Payment builders.
+	Payment *PaymentClient
+	// PromoCode is the client for interacting with the PromoCode builders.
+	PromoCode *PromoCodeClient
+	// PromoRedemption is the client for interacting with the PromoRedemption builders.
+	PromoRedemption *PromoRedemptionClient
 	// Resource is the client for interacting with the Resource builders.
 	Resource *ResourceClient
 	// Slot is the client for interacting with the Slot builders.
 	Slot *SlotClient
+	// TaxRate is the client for interacting with the TaxRate builders.
+	TaxRate *TaxRateClient
 	// Token is the client for interacting with the Token builders.
 	Token *TokenClient
 	// Unavailability is the client for interacting with the Unavailability builders.
 	Unavailability *UnavailabilityClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// WaitlistEntry is the client for interacting with the WaitlistEntry builders.
+	WaitlistEntry *WaitlistEntryClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -66,13 +96,23 @@ func (c *Client) init() {
 	c.Auth = NewAuthClient(c.config)
 	c.Booking = NewBookingClient(c.config)
 	c.BookingMetadatum = NewBookingMetadatumClient(c.config)
+	c.BookingSeries = NewBookingSeriesClient(c.config)
+	c.Customer = NewCustomerClient(c.config)
+	c.Invoice = NewInvoiceClient(c.config)
 	c.Organization = NewOrganizationClient(c.config)
 	c.OrganizationOwnership = NewOrganizationOwnershipClient(c.config)
+	c.Payment = NewPaymentClient(c.config)
+	c.PromoCode = NewPromoCodeClient(c.config)
+	c.PromoRedemption = NewPromoRedemptionClient(c.config)
 	c.Resource = NewResourceClient(c.config)
 	c.Slot = NewSlotClient(c.config)
+	c.TaxRate = NewTaxRateClient(c.config)
 	c.Token = NewTokenClient(c.config)
 	c.Unavailability = NewUnavailabilityClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.WaitlistEntry = NewWaitlistEntryClient(c.config)
+	c.Webhook = NewWebhookClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
 }
 
 // Open opens a database/sql.DB specified by the driver name and
@@ -109,13 +149,23 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		Auth:                  NewAuthClient(cfg),
 		Booking:               NewBookingClient(cfg),
 		BookingMetadatum:      NewBookingMetadatumClient(cfg),
+		BookingSeries:         NewBookingSeriesClient(cfg),
+		Customer:              NewCustomerClient(cfg),
+		Invoice:               NewInvoiceClient(cfg),
 		Organization:          NewOrganizationClient(cfg),
 		OrganizationOwnership: NewOrganizationOwnershipClient(cfg),
+		Payment:               NewPaymentClient(cfg),
+		PromoCode:             NewPromoCodeClient(cfg),
+		PromoRedemption:       NewPromoRedemptionClient(cfg),
 		Resource:              NewResourceClient(cfg),
 		Slot:                  NewSlotClient(cfg),
+		TaxRate:               NewTaxRateClient(cfg),
 		Token:                 NewTokenClient(cfg),
 		Unavailability:        NewUnavailabilityClient(cfg),
 		User:                  NewUserClient(cfg),
+		WaitlistEntry:         NewWaitlistEntryClient(cfg),
+		Webhook:               NewWebhookClient(cfg),
+		WebhookDelivery:       NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
@@ -137,13 +187,23 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		Auth:                  NewAuthClient(cfg),
 		Booking:               NewBookingClient(cfg),
 		BookingMetadatum:      NewBookingMetadatumClient(cfg),
+		BookingSeries:         NewBookingSeriesClient(cfg),
+		Customer:              NewCustomerClient(cfg),
+		Invoice:               NewInvoiceClient(cfg),
 		Organization:          NewOrganizationClient(cfg),
 		OrganizationOwnership: NewOrganizationOwnershipClient(cfg),
+		Payment:               NewPaymentClient(cfg),
+		PromoCode:             NewPromoCodeClient(cfg),
+		PromoRedemption:       NewPromoRedemptionClient(cfg),
 		Resource:              NewResourceClient(cfg),
 		Slot:                  NewSlotClient(cfg),
+		TaxRate:               NewTaxRateClient(cfg),
 		Token:                 NewTokenClient(cfg),
 		Unavailability:        NewUnavailabilityClient(cfg),
 		User:                  NewUserClient(cfg),
+		WaitlistEntry:         NewWaitlistEntryClient(cfg),
+		Webhook:               NewWebhookClient(cfg),
+		WebhookDelivery:       NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
@@ -176,13 +236,23 @@ func (c *Client) Use(hooks ...Hook) {
 	c.Auth.Use(hooks...)
 	c.Booking.Use(hooks...)
 	c.BookingMetadatum.Use(hooks...)
+	c.BookingSeries.Use(hooks...)
+	c.Customer.Use(hooks...)
+	c.Invoice.Use(hooks...)
 	c.Organization.Use(hooks...)
 	c.OrganizationOwnership.Use(hooks...)
+	c.Payment.Use(hooks...)
+	c.PromoCode.Use(hooks...)
+	c.PromoRedemption.Use(hooks...)
 	c.Resource.Use(hooks...)
 	c.Slot.Use(hooks...)
+	c.TaxRate.Use(hooks...)
 	c.Token.Use(hooks...)
 	c.Unavailability.Use(hooks...)
 	c.User.Use(hooks...)
+	c.WaitlistEntry.Use(hooks...)
+	c.Webhook.Use(hooks...)
+	c.WebhookDelivery.Use(hooks...)
 }
 
 // AuthClient is a client for the Auth schema.
@@ -392,6 +462,70 @@ func (c *BookingClient) QueryMetadata(b *Booking) *BookingMetadatumQuery {
 	return query
 }
 
+// QueryWaitlistEntries queries the waitlistEntries edge of a Booking.
+func (c *BookingClient) QueryWaitlistEntries(b *Booking) *WaitlistEntryQuery {
+	query := &WaitlistEntryQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := b.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(booking.Table, booking.FieldID, id),
+			sqlgraph.To(waitlistentry.Table, waitlistentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, booking.WaitlistEntriesTable, booking.WaitlistEntriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPayments queries the payments edge of a Booking.
+func (c *BookingClient) QueryPayments(b *Booking) *PaymentQuery {
+	query := &PaymentQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := b.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(booking.Table, booking.FieldID, id),
+			sqlgraph.To(payment.Table, payment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, booking.PaymentsTable, booking.PaymentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPromoRedemptions queries the promoRedemptions edge of a Booking.
+func (c *BookingClient) QueryPromoRedemptions(b *Booking) *PromoRedemptionQuery {
+	query := &PromoRedemptionQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := b.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(booking.Table, booking.FieldID, id),
+			sqlgraph.To(promoredemption.Table, promoredemption.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, booking.PromoRedemptionsTable, booking.PromoRedemptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvoices queries the invoices edge of a Booking.
+func (c *BookingClient) QueryInvoices(b *Booking) *InvoiceQuery {
+	query := &InvoiceQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := b.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(booking.Table, booking.FieldID, id),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, booking.InvoicesTable, booking.InvoicesColumn),
+		)
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryResource queries the resource edge of a Booking.
 func (c *BookingClient) QueryResource(b *Booking) *ResourceQuery {
 	query := &ResourceQuery{config: c.config}
@@ -408,6 +542,54 @@ func (c *BookingClient) QueryResource(b *Booking) *ResourceQuery {
 	return query
 }
 
+// QueryUser queries the user edge of a Booking.
+func (c *BookingClient) QueryUser(b *Booking) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := b.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(booking.Table, booking.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, booking.UserTable, booking.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySeries queries the series edge of a Booking.
+func (c *BookingClient) QuerySeries(b *Booking) *BookingSeriesQuery {
+	query := &BookingSeriesQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := b.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(booking.Table, booking.FieldID, id),
+			sqlgraph.To(bookingseries.Table, bookingseries.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, booking.SeriesTable, booking.SeriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCustomer queries the customer edge of a Booking.
+func (c *BookingClient) QueryCustomer(b *Booking) *CustomerQuery {
+	query := &CustomerQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := b.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(booking.Table, booking.FieldID, id),
+			sqlgraph.To(customer.Table, customer.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, booking.CustomerTable, booking.CustomerColumn),
+		)
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *BookingClient) Hooks() []Hook {
 	hooks := c.hooks.Booking
@@ -521,84 +703,84 @@ func (c *BookingMetadatumClient) Hooks() []Hook {
 	return append(hooks[:len(hooks):len(hooks)], bookingmetadatum.Hooks[:]...)
 }
 
-// OrganizationClient is a client for the Organization schema.
-type OrganizationClient struct {
+// BookingSeriesClient is a client for the BookingSeries schema.
+type BookingSeriesClient struct {
 	config
 }
 
-// NewOrganizationClient returns a client for the Organization from the given config.
-func NewOrganizationClient(c config) *OrganizationClient {
-	return &OrganizationClient{config: c}
+// NewBookingSeriesClient returns a client for the BookingSeries from the given config.
+func NewBookingSeriesClient(c config) *BookingSeriesClient {
+	return &BookingSeriesClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `organization.Hooks(f(g(h())))`.
-func (c *OrganizationClient) Use(hooks ...Hook) {
-	c.hooks.Organization = append(c.hooks.Organization, hooks...)
+// A call to `Use(f, g, h)` equals to `bookingseries.Hooks(f(g(h())))`.
+func (c *BookingSeriesClient) Use(hooks ...Hook) {
+	c.hooks.BookingSeries = append(c.hooks.BookingSeries, hooks...)
 }
 
-// Create returns a create builder for Organization.
-func (c *OrganizationClient) Create() *OrganizationCreate {
-	mutation := newOrganizationMutation(c.config, OpCreate)
-	return &OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a create builder for BookingSeries.
+func (c *BookingSeriesClient) Create() *BookingSeriesCreate {
+	mutation := newBookingSeriesMutation(c.config, OpCreate)
+	return &BookingSeriesCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Organization entities.
-func (c *OrganizationClient) CreateBulk(builders ...*OrganizationCreate) *OrganizationCreateBulk {
-	return &OrganizationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BookingSeries entities.
+func (c *BookingSeriesClient) CreateBulk(builders ...*BookingSeriesCreate) *BookingSeriesCreateBulk {
+	return &BookingSeriesCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Organization.
-func (c *OrganizationClient) Update() *OrganizationUpdate {
-	mutation := newOrganizationMutation(c.config, OpUpdate)
-	return &OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BookingSeries.
+func (c *BookingSeriesClient) Update() *BookingSeriesUpdate {
+	mutation := newBookingSeriesMutation(c.config, OpUpdate)
+	return &BookingSeriesUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OrganizationClient) UpdateOne(o *Organization) *OrganizationUpdateOne {
-	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganization(o))
-	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BookingSeriesClient) UpdateOne(bs *BookingSeries) *BookingSeriesUpdateOne {
+	mutation := newBookingSeriesMutation(c.config, OpUpdateOne, withBookingSeries(bs))
+	return &BookingSeriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OrganizationClient) UpdateOneID(id int) *OrganizationUpdateOne {
-	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganizationID(id))
-	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BookingSeriesClient) UpdateOneID(id int) *BookingSeriesUpdateOne {
+	mutation := newBookingSeriesMutation(c.config, OpUpdateOne, withBookingSeriesID(id))
+	return &BookingSeriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Organization.
-func (c *OrganizationClient) Delete() *OrganizationDelete {
-	mutation := newOrganizationMutation(c.config, OpDelete)
-	return &OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BookingSeries.
+func (c *BookingSeriesClient) Delete() *BookingSeriesDelete {
+	mutation := newBookingSeriesMutation(c.config, OpDelete)
+	return &BookingSeriesDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a delete builder for the given entity.
-func (c *OrganizationClient) DeleteOne(o *Organization) *OrganizationDeleteOne {
-	return c.DeleteOneID(o.ID)
+func (c *BookingSeriesClient) DeleteOne(bs *BookingSeries) *BookingSeriesDeleteOne {
+	return c.DeleteOneID(bs.ID)
 }
 
 // DeleteOneID returns a delete builder for the given id.
-func (c *OrganizationClient) DeleteOneID(id int) *OrganizationDeleteOne {
-	builder := c.Delete().Where(organization.ID(id))
+func (c *BookingSeriesClient) DeleteOneID(id int) *BookingSeriesDeleteOne {
+	builder := c.Delete().Where(bookingseries.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OrganizationDeleteOne{builder}
+	return &BookingSeriesDeleteOne{builder}
 }
 
-// Query returns a query builder for Organization.
-func (c *OrganizationClient) Query() *OrganizationQuery {
-	return &OrganizationQuery{
+// Query returns a query builder for BookingSeries.
+func (c *BookingSeriesClient) Query() *BookingSeriesQuery {
+	return &BookingSeriesQuery{
 		config: c.config,
 	}
 }
 
-// Get returns a Organization entity by its id.
-func (c *OrganizationClient) Get(ctx context.Context, id int) (*Organization, error) {
-	return c.Query().Where(organization.ID(id)).Only(ctx)
+// Get returns a BookingSeries entity by its id.
+func (c *BookingSeriesClient) Get(ctx context.Context, id int) (*BookingSeries, error) {
+	return c.Query().Where(bookingseries.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OrganizationClient) GetX(ctx context.Context, id int) *Organization {
+func (c *BookingSeriesClient) GetX(ctx context.Context, id int) *BookingSeries {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -606,137 +788,122 @@ func (c *OrganizationClient) GetX(ctx context.Context, id int) *Organization {
 	return obj
 }
 
-// QueryUsers queries the users edge of a Organization.
-func (c *OrganizationClient) QueryUsers(o *Organization) *UserQuery {
-	query := &UserQuery{config: c.config}
+// QueryBookings queries the bookings edge of a BookingSeries.
+func (c *BookingSeriesClient) QueryBookings(bs *BookingSeries) *BookingQuery {
+	query := &BookingQuery{config: c.config}
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
-		id := o.ID
+		id := bs.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, organization.UsersTable, organization.UsersColumn),
+			sqlgraph.From(bookingseries.Table, bookingseries.FieldID, id),
+			sqlgraph.To(booking.Table, booking.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, bookingseries.BookingsTable, bookingseries.BookingsColumn),
 		)
-		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(bs.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryResources queries the resources edge of a Organization.
-func (c *OrganizationClient) QueryResources(o *Organization) *ResourceQuery {
+// QueryResource queries the resource edge of a BookingSeries.
+func (c *BookingSeriesClient) QueryResource(bs *BookingSeries) *ResourceQuery {
 	query := &ResourceQuery{config: c.config}
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
-		id := o.ID
+		id := bs.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.From(bookingseries.Table, bookingseries.FieldID, id),
 			sqlgraph.To(resource.Table, resource.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, organization.ResourcesTable, organization.ResourcesColumn),
-		)
-		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryTokens queries the tokens edge of a Organization.
-func (c *OrganizationClient) QueryTokens(o *Organization) *TokenQuery {
-	query := &TokenQuery{config: c.config}
-	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
-		id := o.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
-			sqlgraph.To(token.Table, token.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, organization.TokensTable, organization.TokensColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, bookingseries.ResourceTable, bookingseries.ResourceColumn),
 		)
-		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(bs.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *OrganizationClient) Hooks() []Hook {
-	return c.hooks.Organization
+func (c *BookingSeriesClient) Hooks() []Hook {
+	hooks := c.hooks.BookingSeries
+	return append(hooks[:len(hooks):len(hooks)], bookingseries.Hooks[:]...)
 }
 
-// OrganizationOwnershipClient is a client for the OrganizationOwnership schema.
-type OrganizationOwnershipClient struct {
+// CustomerClient is a client for the Customer schema.
+type CustomerClient struct {
 	config
 }
 
-// NewOrganizationOwnershipClient returns a client for the OrganizationOwnership from the given config.
-func NewOrganizationOwnershipClient(c config) *OrganizationOwnershipClient {
-	return &OrganizationOwnershipClient{config: c}
+// NewCustomerClient returns a client for the Customer from the given config.
+func NewCustomerClient(c config) *CustomerClient {
+	return &CustomerClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `organizationownership.Hooks(f(g(h())))`.
-func (c *OrganizationOwnershipClient) Use(hooks ...Hook) {
-	c.hooks.OrganizationOwnership = append(c.hooks.OrganizationOwnership, hooks...)
+// A call to `Use(f, g, h)` equals to `customer.Hooks(f(g(h())))`.
+func (c *CustomerClient) Use(hooks ...Hook) {
+	c.hooks.Customer = append(c.hooks.Customer, hooks...)
 }
 
-// Create returns a create builder for OrganizationOwnership.
-func (c *OrganizationOwnershipClient) Create() *OrganizationOwnershipCreate {
-	mutation := newOrganizationOwnershipMutation(c.config, OpCreate)
-	return &OrganizationOwnershipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a create builder for Customer.
+func (c *CustomerClient) Create() *CustomerCreate {
+	mutation := newCustomerMutation(c.config, OpCreate)
+	return &CustomerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of OrganizationOwnership entities.
-func (c *OrganizationOwnershipClient) CreateBulk(builders ...*OrganizationOwnershipCreate) *OrganizationOwnershipCreateBulk {
-	return &OrganizationOwnershipCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Customer entities.
+func (c *CustomerClient) CreateBulk(builders ...*CustomerCreate) *CustomerCreateBulk {
+	return &CustomerCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for OrganizationOwnership.
-func (c *OrganizationOwnershipClient) Update() *OrganizationOwnershipUpdate {
-	mutation := newOrganizationOwnershipMutation(c.config, OpUpdate)
-	return &OrganizationOwnershipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Customer.
+func (c *CustomerClient) Update() *CustomerUpdate {
+	mutation := newCustomerMutation(c.config, OpUpdate)
+	return &CustomerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OrganizationOwnershipClient) UpdateOne(oo *OrganizationOwnership) *OrganizationOwnershipUpdateOne {
-	mutation := newOrganizationOwnershipMutation(c.config, OpUpdateOne, withOrganizationOwnership(oo))
-	return &OrganizationOwnershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CustomerClient) UpdateOne(cu *Customer) *CustomerUpdateOne {
+	mutation := newCustomerMutation(c.config, OpUpdateOne, withCustomer(cu))
+	return &CustomerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OrganizationOwnershipClient) UpdateOneID(id int) *OrganizationOwnershipUpdateOne {
-	mutation := newOrganizationOwnershipMutation(c.config, OpUpdateOne, withOrganizationOwnershipID(id))
-	return &OrganizationOwnershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CustomerClient) UpdateOneID(id int) *CustomerUpdateOne {
+	mutation := newCustomerMutation(c.config, OpUpdateOne, withCustomerID(id))
+	return &CustomerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for OrganizationOwnership.
-func (c *OrganizationOwnershipClient) Delete() *OrganizationOwnershipDelete {
-	mutation := newOrganizationOwnershipMutation(c.config, OpDelete)
-	return &OrganizationOwnershipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Customer.
+func (c *CustomerClient) Delete() *CustomerDelete {
+	mutation := newCustomerMutation(c.config, OpDelete)
+	return &CustomerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a delete builder for the given entity.
-func (c *OrganizationOwnershipClient) DeleteOne(oo *OrganizationOwnership) *OrganizationOwnershipDeleteOne {
-	return c.DeleteOneID(oo.ID)
+func (c *CustomerClient) DeleteOne(cu *Customer) *CustomerDeleteOne {
+	return c.DeleteOneID(cu.ID)
 }
 
 // DeleteOneID returns a delete builder for the given id.
-func (c *OrganizationOwnershipClient) DeleteOneID(id int) *OrganizationOwnershipDeleteOne {
-	builder := c.Delete().Where(organizationownership.ID(id))
+func (c *CustomerClient) DeleteOneID(id int) *CustomerDeleteOne {
+	builder := c.Delete().Where(customer.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OrganizationOwnershipDeleteOne{builder}
+	return &CustomerDeleteOne{builder}
 }
 
-// Query returns a query builder for OrganizationOwnership.
-func (c *OrganizationOwnershipClient) Query() *OrganizationOwnershipQuery {
-	return &OrganizationOwnershipQuery{
+// Query returns a query builder for Customer.
+func (c *CustomerClient) Query() *CustomerQuery {
+	return &CustomerQuery{
 		config: c.config,
 	}
 }
 
-// Get returns a OrganizationOwnership entity by its id.
-func (c *OrganizationOwnershipClient) Get(ctx context.Context, id int) (*OrganizationOwnership, error) {
-	return c.Query().Where(organizationownership.ID(id)).Only(ctx)
+// Get returns a Customer entity by its id.
+func (c *CustomerClient) Get(ctx context.Context, id int) (*Customer, error) {
+	return c.Query().Where(customer.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OrganizationOwnershipClient) GetX(ctx context.Context, id int) *OrganizationOwnership {
+func (c *CustomerClient) GetX(ctx context.Context, id int) *Customer {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -744,116 +911,965 @@ func (c *OrganizationOwnershipClient) GetX(ctx context.Context, id int) *Organiz
 	return obj
 }
 
-// QueryUser queries the user edge of a OrganizationOwnership.
-func (c *OrganizationOwnershipClient) QueryUser(oo *OrganizationOwnership) *UserQuery {
-	query := &UserQuery{config: c.config}
+// QueryOrganization queries the organization edge of a Customer.
+func (c *CustomerClient) QueryOrganization(cu *Customer) *OrganizationQuery {
+	query := &OrganizationQuery{config: c.config}
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
-		id := oo.ID
+		id := cu.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organizationownership.Table, organizationownership.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, organizationownership.UserTable, organizationownership.UserColumn),
+			sqlgraph.From(customer.Table, customer.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, customer.OrganizationTable, customer.OrganizationColumn),
 		)
-		fromV = sqlgraph.Neighbors(oo.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(cu.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryOrganization queries the organization edge of a OrganizationOwnership.
-func (c *OrganizationOwnershipClient) QueryOrganization(oo *OrganizationOwnership) *OrganizationQuery {
-	query := &OrganizationQuery{config: c.config}
+// QueryBookings queries the bookings edge of a Customer.
+func (c *CustomerClient) QueryBookings(cu *Customer) *BookingQuery {
+	query := &BookingQuery{config: c.config}
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
-		id := oo.ID
+		id := cu.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organizationownership.Table, organizationownership.FieldID, id),
-			sqlgraph.To(organization.Table, organization.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, organizationownership.OrganizationTable, organizationownership.OrganizationColumn),
+			sqlgraph.From(customer.Table, customer.FieldID, id),
+			sqlgraph.To(booking.Table, booking.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, customer.BookingsTable, customer.BookingsColumn),
 		)
-		fromV = sqlgraph.Neighbors(oo.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(cu.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *OrganizationOwnershipClient) Hooks() []Hook {
-	return c.hooks.OrganizationOwnership
+func (c *CustomerClient) Hooks() []Hook {
+	hooks := c.hooks.Customer
+	return append(hooks[:len(hooks):len(hooks)], customer.Hooks[:]...)
 }
 
-// ResourceClient is a client for the Resource schema.
-type ResourceClient struct {
+// InvoiceClient is a client for the Invoice schema.
+type InvoiceClient struct {
 	config
 }
 
-// NewResourceClient returns a client for the Resource from the given config.
-func NewResourceClient(c config) *ResourceClient {
-	return &ResourceClient{config: c}
+// NewInvoiceClient returns a client for the Invoice from the given config.
+func NewInvoiceClient(c config) *InvoiceClient {
+	return &InvoiceClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `resource.Hooks(f(g(h())))`.
-func (c *ResourceClient) Use(hooks ...Hook) {
-	c.hooks.Resource = append(c.hooks.Resource, hooks...)
+// A call to `Use(f, g, h)` equals to `invoice.Hooks(f(g(h())))`.
+func (c *InvoiceClient) Use(hooks ...Hook) {
+	c.hooks.Invoice = append(c.hooks.Invoice, hooks...)
 }
 
-// Create returns a create builder for Resource.
-func (c *ResourceClient) Create() *ResourceCreate {
-	mutation := newResourceMutation(c.config, OpCreate)
-	return &ResourceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a create builder for Invoice.
+func (c *InvoiceClient) Create() *InvoiceCreate {
+	mutation := newInvoiceMutation(c.config, OpCreate)
+	return &InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Resource entities.
-func (c *ResourceClient) CreateBulk(builders ...*ResourceCreate) *ResourceCreateBulk {
-	return &ResourceCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Invoice entities.
+func (c *InvoiceClient) CreateBulk(builders ...*InvoiceCreate) *InvoiceCreateBulk {
+	return &InvoiceCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Resource.
-func (c *ResourceClient) Update() *ResourceUpdate {
-	mutation := newResourceMutation(c.config, OpUpdate)
-	return &ResourceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Invoice.
+func (c *InvoiceClient) Update() *InvoiceUpdate {
+	mutation := newInvoiceMutation(c.config, OpUpdate)
+	return &InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ResourceClient) UpdateOne(r *Resource) *ResourceUpdateOne {
-	mutation := newResourceMutation(c.config, OpUpdateOne, withResource(r))
-	return &ResourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InvoiceClient) UpdateOne(i *Invoice) *InvoiceUpdateOne {
+	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoice(i))
+	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ResourceClient) UpdateOneID(id int) *ResourceUpdateOne {
-	mutation := newResourceMutation(c.config, OpUpdateOne, withResourceID(id))
-	return &ResourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InvoiceClient) UpdateOneID(id int) *InvoiceUpdateOne {
+	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoiceID(id))
+	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Resource.
-func (c *ResourceClient) Delete() *ResourceDelete {
-	mutation := newResourceMutation(c.config, OpDelete)
-	return &ResourceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Invoice.
+func (c *InvoiceClient) Delete() *InvoiceDelete {
+	mutation := newInvoiceMutation(c.config, OpDelete)
+	return &InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a delete builder for the given entity.
-func (c *ResourceClient) DeleteOne(r *Resource) *ResourceDeleteOne {
-	return c.DeleteOneID(r.ID)
+func (c *InvoiceClient) DeleteOne(i *Invoice) *InvoiceDeleteOne {
+	return c.DeleteOneID(i.ID)
 }
 
 // DeleteOneID returns a delete builder for the given id.
-func (c *ResourceClient) DeleteOneID(id int) *ResourceDeleteOne {
-	builder := c.Delete().Where(resource.ID(id))
+func (c *InvoiceClient) DeleteOneID(id int) *InvoiceDeleteOne {
+	builder := c.Delete().Where(invoice.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ResourceDeleteOne{builder}
+	return &InvoiceDeleteOne{builder}
 }
 
-// Query returns a query builder for Resource.
-func (c *ResourceClient) Query() *ResourceQuery {
-	return &ResourceQuery{
+// Query returns a query builder for Invoice.
+func (c *InvoiceClient) Query() *InvoiceQuery {
+	return &InvoiceQuery{
 		config: c.config,
 	}
 }
 
-// Get returns a Resource entity by its id.
-func (c *ResourceClient) Get(ctx context.Context, id int) (*Resource, error) {
+// Get returns a Invoice entity by its id.
+func (c *InvoiceClient) Get(ctx context.Context, id int) (*Invoice, error) {
+	return c.Query().Where(invoice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvoiceClient) GetX(ctx context.Context, id int) *Invoice {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrganization queries the organization edge of a Invoice.
+func (c *InvoiceClient) QueryOrganization(i *Invoice) *OrganizationQuery {
+	query := &OrganizationQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invoice.OrganizationTable, invoice.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBooking queries the booking edge of a Invoice.
+func (c *InvoiceClient) QueryBooking(i *Invoice) *BookingQuery {
+	query := &BookingQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.FieldID, id),
+			sqlgraph.To(booking.Table, booking.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invoice.BookingTable, invoice.BookingColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCreditedInvoice queries the creditedInvoice edge of a Invoice.
+func (c *InvoiceClient) QueryCreditedInvoice(i *Invoice) *InvoiceQuery {
+	query := &InvoiceQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.FieldID, id),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invoice.CreditedInvoiceTable, invoice.CreditedInvoiceColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCreditNotes queries the creditNotes edge of a Invoice.
+func (c *InvoiceClient) QueryCreditNotes(i *Invoice) *InvoiceQuery {
+	query := &InvoiceQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.FieldID, id),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, invoice.CreditNotesTable, invoice.CreditNotesColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *InvoiceClient) Hooks() []Hook {
+	hooks := c.hooks.Invoice
+	return append(hooks[:len(hooks):len(hooks)], invoice.Hooks[:]...)
+}
+
+// OrganizationClient is a client for the Organization schema.
+type OrganizationClient struct {
+	config
+}
+
+// NewOrganizationClient returns a client for the Organization from the given config.
+func NewOrganizationClient(c config) *OrganizationClient {
+	return &OrganizationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `organization.Hooks(f(g(h())))`.
+func (c *OrganizationClient) Use(hooks ...Hook) {
+	c.hooks.Organization = append(c.hooks.Organization, hooks...)
+}
+
+// Create returns a create builder for Organization.
+func (c *OrganizationClient) Create() *OrganizationCreate {
+	mutation := newOrganizationMutation(c.config, OpCreate)
+	return &OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Organization entities.
+func (c *OrganizationClient) CreateBulk(builders ...*OrganizationCreate) *OrganizationCreateBulk {
+	return &OrganizationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Organization.
+func (c *OrganizationClient) Update() *OrganizationUpdate {
+	mutation := newOrganizationMutation(c.config, OpUpdate)
+	return &OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrganizationClient) UpdateOne(o *Organization) *OrganizationUpdateOne {
+	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganization(o))
+	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrganizationClient) UpdateOneID(id int) *OrganizationUpdateOne {
+	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganizationID(id))
+	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Organization.
+func (c *OrganizationClient) Delete() *OrganizationDelete {
+	mutation := newOrganizationMutation(c.config, OpDelete)
+	return &OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *OrganizationClient) DeleteOne(o *Organization) *OrganizationDeleteOne {
+	return c.DeleteOneID(o.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *OrganizationClient) DeleteOneID(id int) *OrganizationDeleteOne {
+	builder := c.Delete().Where(organization.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrganizationDeleteOne{builder}
+}
+
+// Query returns a query builder for Organization.
+func (c *OrganizationClient) Query() *OrganizationQuery {
+	return &OrganizationQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Organization entity by its id.
+func (c *OrganizationClient) Get(ctx context.Context, id int) (*Organization, error) {
+	return c.Query().Where(organization.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrganizationClient) GetX(ctx context.Context, id int) *Organization {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUsers queries the users edge of a Organization.
+func (c *OrganizationClient) QueryUsers(o *Organization) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.UsersTable, organization.UsersColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResources queries the resources edge of a Organization.
+func (c *OrganizationClient) QueryResources(o *Organization) *ResourceQuery {
+	query := &ResourceQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(resource.Table, resource.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.ResourcesTable, organization.ResourcesColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTokens queries the tokens edge of a Organization.
+func (c *OrganizationClient) QueryTokens(o *Organization) *TokenQuery {
+	query := &TokenQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(token.Table, token.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.TokensTable, organization.TokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWebhooks queries the webhooks edge of a Organization.
+func (c *OrganizationClient) QueryWebhooks(o *Organization) *WebhookQuery {
+	query := &WebhookQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.WebhooksTable, organization.WebhooksColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPromoCodes queries the promoCodes edge of a Organization.
+func (c *OrganizationClient) QueryPromoCodes(o *Organization) *PromoCodeQuery {
+	query := &PromoCodeQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(promocode.Table, promocode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.PromoCodesTable, organization.PromoCodesColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvoices queries the invoices edge of a Organization.
+func (c *OrganizationClient) QueryInvoices(o *Organization) *InvoiceQuery {
+	query := &InvoiceQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.InvoicesTable, organization.InvoicesColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTaxRates queries the taxRates edge of a Organization.
+func (c *OrganizationClient) QueryTaxRates(o *Organization) *TaxRateQuery {
+	query := &TaxRateQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(taxrate.Table, taxrate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.TaxRatesTable, organization.TaxRatesColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCustomers queries the customers edge of a Organization.
+func (c *OrganizationClient) QueryCustomers(o *Organization) *CustomerQuery {
+	query := &CustomerQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(customer.Table, customer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.CustomersTable, organization.CustomersColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrganizationClient) Hooks() []Hook {
+	return c.hooks.Organization
+}
+
+// OrganizationOwnershipClient is a client for the OrganizationOwnership schema.
+type OrganizationOwnershipClient struct {
+	config
+}
+
+// NewOrganizationOwnershipClient returns a client for the OrganizationOwnership from the given config.
+func NewOrganizationOwnershipClient(c config) *OrganizationOwnershipClient {
+	return &OrganizationOwnershipClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `organizationownership.Hooks(f(g(h())))`.
+func (c *OrganizationOwnershipClient) Use(hooks ...Hook) {
+	c.hooks.OrganizationOwnership = append(c.hooks.OrganizationOwnership, hooks...)
+}
+
+// Create returns a create builder for OrganizationOwnership.
+func (c *OrganizationOwnershipClient) Create() *OrganizationOwnershipCreate {
+	mutation := newOrganizationOwnershipMutation(c.config, OpCreate)
+	return &OrganizationOwnershipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OrganizationOwnership entities.
+func (c *OrganizationOwnershipClient) CreateBulk(builders ...*OrganizationOwnershipCreate) *OrganizationOwnershipCreateBulk {
+	return &OrganizationOwnershipCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OrganizationOwnership.
+func (c *OrganizationOwnershipClient) Update() *OrganizationOwnershipUpdate {
+	mutation := newOrganizationOwnershipMutation(c.config, OpUpdate)
+	return &OrganizationOwnershipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrganizationOwnershipClient) UpdateOne(oo *OrganizationOwnership) *OrganizationOwnershipUpdateOne {
+	mutation := newOrganizationOwnershipMutation(c.config, OpUpdateOne, withOrganizationOwnership(oo))
+	return &OrganizationOwnershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrganizationOwnershipClient) UpdateOneID(id int) *OrganizationOwnershipUpdateOne {
+	mutation := newOrganizationOwnershipMutation(c.config, OpUpdateOne, withOrganizationOwnershipID(id))
+	return &OrganizationOwnershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OrganizationOwnership.
+func (c *OrganizationOwnershipClient) Delete() *OrganizationOwnershipDelete {
+	mutation := newOrganizationOwnershipMutation(c.config, OpDelete)
+	return &OrganizationOwnershipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *OrganizationOwnershipClient) DeleteOne(oo *OrganizationOwnership) *OrganizationOwnershipDeleteOne {
+	return c.DeleteOneID(oo.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *OrganizationOwnershipClient) DeleteOneID(id int) *OrganizationOwnershipDeleteOne {
+	builder := c.Delete().Where(organizationownership.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrganizationOwnershipDeleteOne{builder}
+}
+
+// Query returns a query builder for OrganizationOwnership.
+func (c *OrganizationOwnershipClient) Query() *OrganizationOwnershipQuery {
+	return &OrganizationOwnershipQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a OrganizationOwnership entity by its id.
+func (c *OrganizationOwnershipClient) Get(ctx context.Context, id int) (*OrganizationOwnership, error) {
+	return c.Query().Where(organizationownership.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrganizationOwnershipClient) GetX(ctx context.Context, id int) *OrganizationOwnership {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a OrganizationOwnership.
+func (c *OrganizationOwnershipClient) QueryUser(oo *OrganizationOwnership) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := oo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organizationownership.Table, organizationownership.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, organizationownership.UserTable, organizationownership.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(oo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrganization queries the organization edge of a OrganizationOwnership.
+func (c *OrganizationOwnershipClient) QueryOrganization(oo *OrganizationOwnership) *OrganizationQuery {
+	query := &OrganizationQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := oo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organizationownership.Table, organizationownership.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, organizationownership.OrganizationTable, organizationownership.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(oo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrganizationOwnershipClient) Hooks() []Hook {
+	return c.hooks.OrganizationOwnership
+}
+
+// PaymentClient is a client for the Payment schema.
+type PaymentClient struct {
+	config
+}
+
+// NewPaymentClient returns a client for the Payment from the given config.
+func NewPaymentClient(c config) *PaymentClient {
+	return &PaymentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `payment.Hooks(f(g(h())))`.
+func (c *PaymentClient) Use(hooks ...Hook) {
+	c.hooks.Payment = append(c.hooks.Payment, hooks...)
+}
+
+// Create returns a create builder for Payment.
+func (c *PaymentClient) Create() *PaymentCreate {
+	mutation := newPaymentMutation(c.config, OpCreate)
+	return &PaymentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Payment entities.
+func (c *PaymentClient) CreateBulk(builders ...*PaymentCreate) *PaymentCreateBulk {
+	return &PaymentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Payment.
+func (c *PaymentClient) Update() *PaymentUpdate {
+	mutation := newPaymentMutation(c.config, OpUpdate)
+	return &PaymentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaymentClient) UpdateOne(pa *Payment) *PaymentUpdateOne {
+	mutation := newPaymentMutation(c.config, OpUpdateOne, withPayment(pa))
+	return &PaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaymentClient) UpdateOneID(id int) *PaymentUpdateOne {
+	mutation := newPaymentMutation(c.config, OpUpdateOne, withPaymentID(id))
+	return &PaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Payment.
+func (c *PaymentClient) Delete() *PaymentDelete {
+	mutation := newPaymentMutation(c.config, OpDelete)
+	return &PaymentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *PaymentClient) DeleteOne(pa *Payment) *PaymentDeleteOne {
+	return c.DeleteOneID(pa.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *PaymentClient) DeleteOneID(id int) *PaymentDeleteOne {
+	builder := c.Delete().Where(payment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaymentDeleteOne{builder}
+}
+
+// Query returns a query builder for Payment.
+func (c *PaymentClient) Query() *PaymentQuery {
+	return &PaymentQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Payment entity by its id.
+func (c *PaymentClient) Get(ctx context.Context, id int) (*Payment, error) {
+	return c.Query().Where(payment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaymentClient) GetX(ctx context.Context, id int) *Payment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBooking queries the booking edge of a Payment.
+func (c *PaymentClient) QueryBooking(pa *Payment) *BookingQuery {
+	query := &BookingQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := pa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(payment.Table, payment.FieldID, id),
+			sqlgraph.To(booking.Table, booking.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, payment.BookingTable, payment.BookingColumn),
+		)
+		fromV = sqlgraph.Neighbors(pa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PaymentClient) Hooks() []Hook {
+	hooks := c.hooks.Payment
+	return append(hooks[:len(hooks):len(hooks)], payment.Hooks[:]...)
+}
+
+// PromoCodeClient is a client for the PromoCode schema.
+type PromoCodeClient struct {
+	config
+}
+
+// NewPromoCodeClient returns a client for the PromoCode from the given config.
+func NewPromoCodeClient(c config) *PromoCodeClient {
+	return &PromoCodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `promocode.Hooks(f(g(h())))`.
+func (c *PromoCodeClient) Use(hooks ...Hook) {
+	c.hooks.PromoCode = append(c.hooks.PromoCode, hooks...)
+}
+
+// Create returns a create builder for PromoCode.
+func (c *PromoCodeClient) Create() *PromoCodeCreate {
+	mutation := newPromoCodeMutation(c.config, OpCreate)
+	return &PromoCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PromoCode entities.
+func (c *PromoCodeClient) CreateBulk(builders ...*PromoCodeCreate) *PromoCodeCreateBulk {
+	return &PromoCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PromoCode.
+func (c *PromoCodeClient) Update() *PromoCodeUpdate {
+	mutation := newPromoCodeMutation(c.config, OpUpdate)
+	return &PromoCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PromoCodeClient) UpdateOne(pc *PromoCode) *PromoCodeUpdateOne {
+	mutation := newPromoCodeMutation(c.config, OpUpdateOne, withPromoCode(pc))
+	return &PromoCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PromoCodeClient) UpdateOneID(id int) *PromoCodeUpdateOne {
+	mutation := newPromoCodeMutation(c.config, OpUpdateOne, withPromoCodeID(id))
+	return &PromoCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PromoCode.
+func (c *PromoCodeClient) Delete() *PromoCodeDelete {
+	mutation := newPromoCodeMutation(c.config, OpDelete)
+	return &PromoCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *PromoCodeClient) DeleteOne(pc *PromoCode) *PromoCodeDeleteOne {
+	return c.DeleteOneID(pc.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *PromoCodeClient) DeleteOneID(id int) *PromoCodeDeleteOne {
+	builder := c.Delete().Where(promocode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PromoCodeDeleteOne{builder}
+}
+
+// Query returns a query builder for PromoCode.
+func (c *PromoCodeClient) Query() *PromoCodeQuery {
+	return &PromoCodeQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a PromoCode entity by its id.
+func (c *PromoCodeClient) Get(ctx context.Context, id int) (*PromoCode, error) {
+	return c.Query().Where(promocode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PromoCodeClient) GetX(ctx context.Context, id int) *PromoCode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrganization queries the organization edge of a PromoCode.
+func (c *PromoCodeClient) QueryOrganization(pc *PromoCode) *OrganizationQuery {
+	query := &OrganizationQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := pc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(promocode.Table, promocode.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, promocode.OrganizationTable, promocode.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(pc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRedemptions queries the redemptions edge of a PromoCode.
+func (c *PromoCodeClient) QueryRedemptions(pc *PromoCode) *PromoRedemptionQuery {
+	query := &PromoRedemptionQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := pc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(promocode.Table, promocode.FieldID, id),
+			sqlgraph.To(promoredemption.Table, promoredemption.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, promocode.RedemptionsTable, promocode.RedemptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PromoCodeClient) Hooks() []Hook {
+	hooks := c.hooks.PromoCode
+	return append(hooks[:len(hooks):len(hooks)], promocode.Hooks[:]...)
+}
+
+// PromoRedemptionClient is a client for the PromoRedemption schema.
+type PromoRedemptionClient struct {
+	config
+}
+
+// NewPromoRedemptionClient returns a client for the PromoRedemption from the given config.
+func NewPromoRedemptionClient(c config) *PromoRedemptionClient {
+	return &PromoRedemptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `promoredemption.Hooks(f(g(h())))`.
+func (c *PromoRedemptionClient) Use(hooks ...Hook) {
+	c.hooks.PromoRedemption = append(c.hooks.PromoRedemption, hooks...)
+}
+
+// Create returns a create builder for PromoRedemption.
+func (c *PromoRedemptionClient) Create() *PromoRedemptionCreate {
+	mutation := newPromoRedemptionMutation(c.config, OpCreate)
+	return &PromoRedemptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PromoRedemption entities.
+func (c *PromoRedemptionClient) CreateBulk(builders ...*PromoRedemptionCreate) *PromoRedemptionCreateBulk {
+	return &PromoRedemptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PromoRedemption.
+func (c *PromoRedemptionClient) Update() *PromoRedemptionUpdate {
+	mutation := newPromoRedemptionMutation(c.config, OpUpdate)
+	return &PromoRedemptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PromoRedemptionClient) UpdateOne(pr *PromoRedemption) *PromoRedemptionUpdateOne {
+	mutation := newPromoRedemptionMutation(c.config, OpUpdateOne, withPromoRedemption(pr))
+	return &PromoRedemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PromoRedemptionClient) UpdateOneID(id int) *PromoRedemptionUpdateOne {
+	mutation := newPromoRedemptionMutation(c.config, OpUpdateOne, withPromoRedemptionID(id))
+	return &PromoRedemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PromoRedemption.
+func (c *PromoRedemptionClient) Delete() *PromoRedemptionDelete {
+	mutation := newPromoRedemptionMutation(c.config, OpDelete)
+	return &PromoRedemptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *PromoRedemptionClient) DeleteOne(pr *PromoRedemption) *PromoRedemptionDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *PromoRedemptionClient) DeleteOneID(id int) *PromoRedemptionDeleteOne {
+	builder := c.Delete().Where(promoredemption.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PromoRedemptionDeleteOne{builder}
+}
+
+// Query returns a query builder for PromoRedemption.
+func (c *PromoRedemptionClient) Query() *PromoRedemptionQuery {
+	return &PromoRedemptionQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a PromoRedemption entity by its id.
+func (c *PromoRedemptionClient) Get(ctx context.Context, id int) (*PromoRedemption, error) {
+	return c.Query().Where(promoredemption.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PromoRedemptionClient) GetX(ctx context.Context, id int) *PromoRedemption {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPromoCode queries the promoCode edge of a PromoRedemption.
+func (c *PromoRedemptionClient) QueryPromoCode(pr *PromoRedemption) *PromoCodeQuery {
+	query := &PromoCodeQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(promoredemption.Table, promoredemption.FieldID, id),
+			sqlgraph.To(promocode.Table, promocode.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, promoredemption.PromoCodeTable, promoredemption.PromoCodeColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBooking queries the booking edge of a PromoRedemption.
+func (c *PromoRedemptionClient) QueryBooking(pr *PromoRedemption) *BookingQuery {
+	query := &BookingQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(promoredemption.Table, promoredemption.FieldID, id),
+			sqlgraph.To(booking.Table, booking.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, promoredemption.BookingTable, promoredemption.BookingColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PromoRedemptionClient) Hooks() []Hook {
+	hooks := c.hooks.PromoRedemption
+	return append(hooks[:len(hooks):len(hooks)], promoredemption.Hooks[:]...)
+}
+
+// ResourceClient is a client for the Resource schema.
+type ResourceClient struct {
+	config
+}
+
+// NewResourceClient returns a client for the Resource from the given config.
+func NewResourceClient(c config) *ResourceClient {
+	return &ResourceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `resource.Hooks(f(g(h())))`.
+func (c *ResourceClient) Use(hooks ...Hook) {
+	c.hooks.Resource = append(c.hooks.Resource, hooks...)
+}
+
+// Create returns a create builder for Resource.
+func (c *ResourceClient) Create() *ResourceCreate {
+	mutation := newResourceMutation(c.config, OpCreate)
+	return &ResourceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Resource entities.
+func (c *ResourceClient) CreateBulk(builders ...*ResourceCreate) *ResourceCreateBulk {
+	return &ResourceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Resource.
+func (c *ResourceClient) Update() *ResourceUpdate {
+	mutation := newResourceMutation(c.config, OpUpdate)
+	return &ResourceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ResourceClient) UpdateOne(r *Resource) *ResourceUpdateOne {
+	mutation := newResourceMutation(c.config, OpUpdateOne, withResource(r))
+	return &ResourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ResourceClient) UpdateOneID(id int) *ResourceUpdateOne {
+	mutation := newResourceMutation(c.config, OpUpdateOne, withResourceID(id))
+	return &ResourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Resource.
+func (c *ResourceClient) Delete() *ResourceDelete {
+	mutation := newResourceMutation(c.config, OpDelete)
+	return &ResourceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *ResourceClient) DeleteOne(r *Resource) *ResourceDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *ResourceClient) DeleteOneID(id int) *ResourceDeleteOne {
+	builder := c.Delete().Where(resource.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ResourceDeleteOne{builder}
+}
+
+// Query returns a query builder for Resource.
+func (c *ResourceClient) Query() *ResourceQuery {
+	return &ResourceQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Resource entity by its id.
+func (c *ResourceClient) Get(ctx context.Context, id int) (*Resource, error) {
 	return c.Query().Where(resource.ID(id)).Only(ctx)
 }
 
@@ -898,6 +1914,22 @@ func (c *ResourceClient) QueryBookings(r *Resource) *BookingQuery {
 	return query
 }
 
+// QueryBookingSeries queries the bookingSeries edge of a Resource.
+func (c *ResourceClient) QueryBookingSeries(r *Resource) *BookingSeriesQuery {
+	query := &BookingSeriesQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resource.Table, resource.FieldID, id),
+			sqlgraph.To(bookingseries.Table, bookingseries.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resource.BookingSeriesTable, resource.BookingSeriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryUnavailabilities queries the unavailabilities edge of a Resource.
 func (c *ResourceClient) QueryUnavailabilities(r *Resource) *UnavailabilityQuery {
 	query := &UnavailabilityQuery{config: c.config}
@@ -914,6 +1946,22 @@ func (c *ResourceClient) QueryUnavailabilities(r *Resource) *UnavailabilityQuery
 	return query
 }
 
+// QueryWaitlistEntries queries the waitlistEntries edge of a Resource.
+func (c *ResourceClient) QueryWaitlistEntries(r *Resource) *WaitlistEntryQuery {
+	query := &WaitlistEntryQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resource.Table, resource.FieldID, id),
+			sqlgraph.To(waitlistentry.Table, waitlistentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resource.WaitlistEntriesTable, resource.WaitlistEntriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryOrganization queries the organization edge of a Resource.
 func (c *ResourceClient) QueryOrganization(r *Resource) *OrganizationQuery {
 	query := &OrganizationQuery{config: c.config}
@@ -930,6 +1978,22 @@ func (c *ResourceClient) QueryOrganization(r *Resource) *OrganizationQuery {
 	return query
 }
 
+// QueryTaxRate queries the taxRate edge of a Resource.
+func (c *ResourceClient) QueryTaxRate(r *Resource) *TaxRateQuery {
+	query := &TaxRateQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resource.Table, resource.FieldID, id),
+			sqlgraph.To(taxrate.Table, taxrate.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resource.TaxRateTable, resource.TaxRateColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ResourceClient) Hooks() []Hook {
 	hooks := c.hooks.Resource
@@ -947,73 +2011,180 @@ func NewSlotClient(c config) *SlotClient {
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `slot.Hooks(f(g(h())))`.
-func (c *SlotClient) Use(hooks ...Hook) {
-	c.hooks.Slot = append(c.hooks.Slot, hooks...)
+// A call to `Use(f, g, h)` equals to `slot.Hooks(f(g(h())))`.
+func (c *SlotClient) Use(hooks ...Hook) {
+	c.hooks.Slot = append(c.hooks.Slot, hooks...)
+}
+
+// Create returns a create builder for Slot.
+func (c *SlotClient) Create() *SlotCreate {
+	mutation := newSlotMutation(c.config, OpCreate)
+	return &SlotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Slot entities.
+func (c *SlotClient) CreateBulk(builders ...*SlotCreate) *SlotCreateBulk {
+	return &SlotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Slot.
+func (c *SlotClient) Update() *SlotUpdate {
+	mutation := newSlotMutation(c.config, OpUpdate)
+	return &SlotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SlotClient) UpdateOne(s *Slot) *SlotUpdateOne {
+	mutation := newSlotMutation(c.config, OpUpdateOne, withSlot(s))
+	return &SlotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SlotClient) UpdateOneID(id int) *SlotUpdateOne {
+	mutation := newSlotMutation(c.config, OpUpdateOne, withSlotID(id))
+	return &SlotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Slot.
+func (c *SlotClient) Delete() *SlotDelete {
+	mutation := newSlotMutation(c.config, OpDelete)
+	return &SlotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *SlotClient) DeleteOne(s *Slot) *SlotDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *SlotClient) DeleteOneID(id int) *SlotDeleteOne {
+	builder := c.Delete().Where(slot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SlotDeleteOne{builder}
+}
+
+// Query returns a query builder for Slot.
+func (c *SlotClient) Query() *SlotQuery {
+	return &SlotQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Slot entity by its id.
+func (c *SlotClient) Get(ctx context.Context, id int) (*Slot, error) {
+	return c.Query().Where(slot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SlotClient) GetX(ctx context.Context, id int) *Slot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryResource queries the resource edge of a Slot.
+func (c *SlotClient) QueryResource(s *Slot) *ResourceQuery {
+	query := &ResourceQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(slot.Table, slot.FieldID, id),
+			sqlgraph.To(resource.Table, resource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, slot.ResourceTable, slot.ResourceColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SlotClient) Hooks() []Hook {
+	hooks := c.hooks.Slot
+	return append(hooks[:len(hooks):len(hooks)], slot.Hooks[:]...)
+}
+
+// TaxRateClient is a client for the TaxRate schema.
+type TaxRateClient struct {
+	config
+}
+
+// NewTaxRateClient returns a client for the TaxRate from the given config.
+func NewTaxRateClient(c config) *TaxRateClient {
+	return &TaxRateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taxrate.Hooks(f(g(h())))`.
+func (c *TaxRateClient) Use(hooks ...Hook) {
+	c.hooks.TaxRate = append(c.hooks.TaxRate, hooks...)
 }
 
-// Create returns a create builder for Slot.
-func (c *SlotClient) Create() *SlotCreate {
-	mutation := newSlotMutation(c.config, OpCreate)
-	return &SlotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a create builder for TaxRate.
+func (c *TaxRateClient) Create() *TaxRateCreate {
+	mutation := newTaxRateMutation(c.config, OpCreate)
+	return &TaxRateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Slot entities.
-func (c *SlotClient) CreateBulk(builders ...*SlotCreate) *SlotCreateBulk {
-	return &SlotCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of TaxRate entities.
+func (c *TaxRateClient) CreateBulk(builders ...*TaxRateCreate) *TaxRateCreateBulk {
+	return &TaxRateCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Slot.
-func (c *SlotClient) Update() *SlotUpdate {
-	mutation := newSlotMutation(c.config, OpUpdate)
-	return &SlotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for TaxRate.
+func (c *TaxRateClient) Update() *TaxRateUpdate {
+	mutation := newTaxRateMutation(c.config, OpUpdate)
+	return &TaxRateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SlotClient) UpdateOne(s *Slot) *SlotUpdateOne {
-	mutation := newSlotMutation(c.config, OpUpdateOne, withSlot(s))
-	return &SlotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TaxRateClient) UpdateOne(tr *TaxRate) *TaxRateUpdateOne {
+	mutation := newTaxRateMutation(c.config, OpUpdateOne, withTaxRate(tr))
+	return &TaxRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SlotClient) UpdateOneID(id int) *SlotUpdateOne {
-	mutation := newSlotMutation(c.config, OpUpdateOne, withSlotID(id))
-	return &SlotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TaxRateClient) UpdateOneID(id int) *TaxRateUpdateOne {
+	mutation := newTaxRateMutation(c.config, OpUpdateOne, withTaxRateID(id))
+	return &TaxRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Slot.
-func (c *SlotClient) Delete() *SlotDelete {
-	mutation := newSlotMutation(c.config, OpDelete)
-	return &SlotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for TaxRate.
+func (c *TaxRateClient) Delete() *TaxRateDelete {
+	mutation := newTaxRateMutation(c.config, OpDelete)
+	return &TaxRateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a delete builder for the given entity.
-func (c *SlotClient) DeleteOne(s *Slot) *SlotDeleteOne {
-	return c.DeleteOneID(s.ID)
+func (c *TaxRateClient) DeleteOne(tr *TaxRate) *TaxRateDeleteOne {
+	return c.DeleteOneID(tr.ID)
 }
 
 // DeleteOneID returns a delete builder for the given id.
-func (c *SlotClient) DeleteOneID(id int) *SlotDeleteOne {
-	builder := c.Delete().Where(slot.ID(id))
+func (c *TaxRateClient) DeleteOneID(id int) *TaxRateDeleteOne {
+	builder := c.Delete().Where(taxrate.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SlotDeleteOne{builder}
+	return &TaxRateDeleteOne{builder}
 }
 
-// Query returns a query builder for Slot.
-func (c *SlotClient) Query() *SlotQuery {
-	return &SlotQuery{
+// Query returns a query builder for TaxRate.
+func (c *TaxRateClient) Query() *TaxRateQuery {
+	return &TaxRateQuery{
 		config: c.config,
 	}
 }
 
-// Get returns a Slot entity by its id.
-func (c *SlotClient) Get(ctx context.Context, id int) (*Slot, error) {
-	return c.Query().Where(slot.ID(id)).Only(ctx)
+// Get returns a TaxRate entity by its id.
+func (c *TaxRateClient) Get(ctx context.Context, id int) (*TaxRate, error) {
+	return c.Query().Where(taxrate.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SlotClient) GetX(ctx context.Context, id int) *Slot {
+func (c *TaxRateClient) GetX(ctx context.Context, id int) *TaxRate {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1021,26 +2192,42 @@ func (c *SlotClient) GetX(ctx context.Context, id int) *Slot {
 	return obj
 }
 
-// QueryResource queries the resource edge of a Slot.
-func (c *SlotClient) QueryResource(s *Slot) *ResourceQuery {
+// QueryOrganization queries the organization edge of a TaxRate.
+func (c *TaxRateClient) QueryOrganization(tr *TaxRate) *OrganizationQuery {
+	query := &OrganizationQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := tr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taxrate.Table, taxrate.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taxrate.OrganizationTable, taxrate.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(tr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResources queries the resources edge of a TaxRate.
+func (c *TaxRateClient) QueryResources(tr *TaxRate) *ResourceQuery {
 	query := &ResourceQuery{config: c.config}
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
-		id := s.ID
+		id := tr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(slot.Table, slot.FieldID, id),
+			sqlgraph.From(taxrate.Table, taxrate.FieldID, id),
 			sqlgraph.To(resource.Table, resource.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, slot.ResourceTable, slot.ResourceColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, taxrate.ResourcesTable, taxrate.ResourcesColumn),
 		)
-		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(tr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *SlotClient) Hooks() []Hook {
-	hooks := c.hooks.Slot
-	return append(hooks[:len(hooks):len(hooks)], slot.Hooks[:]...)
+func (c *TaxRateClient) Hooks() []Hook {
+	hooks := c.hooks.TaxRate
+	return append(hooks[:len(hooks):len(hooks)], taxrate.Hooks[:]...)
 }
 
 // TokenClient is a client for the Token schema.
@@ -1390,6 +2577,22 @@ func (c *UserClient) QueryTokens(u *User) *TokenQuery {
 	return query
 }
 
+// QueryBookings queries the bookings edge of a User.
+func (c *UserClient) QueryBookings(u *User) *BookingQuery {
+	query := &BookingQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(booking.Table, booking.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.BookingsTable, user.BookingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryOrganization queries the organization edge of a User.
 func (c *UserClient) QueryOrganization(u *User) *OrganizationQuery {
 	query := &OrganizationQuery{config: c.config}
@@ -1410,3 +2613,356 @@ func (c *UserClient) QueryOrganization(u *User) *OrganizationQuery {
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
 }
+
+// WaitlistEntryClient is a client for the WaitlistEntry schema.
+type WaitlistEntryClient struct {
+	config
+}
+
+// NewWaitlistEntryClient returns a client for the WaitlistEntry from the given config.
+func NewWaitlistEntryClient(c config) *WaitlistEntryClient {
+	return &WaitlistEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `waitlistentry.Hooks(f(g(h())))`.
+func (c *WaitlistEntryClient) Use(hooks ...Hook) {
+	c.hooks.WaitlistEntry = append(c.hooks.WaitlistEntry, hooks...)
+}
+
+// Create returns a create builder for WaitlistEntry.
+func (c *WaitlistEntryClient) Create() *WaitlistEntryCreate {
+	mutation := newWaitlistEntryMutation(c.config, OpCreate)
+	return &WaitlistEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WaitlistEntry entities.
+func (c *WaitlistEntryClient) CreateBulk(builders ...*WaitlistEntryCreate) *WaitlistEntryCreateBulk {
+	return &WaitlistEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WaitlistEntry.
+func (c *WaitlistEntryClient) Update() *WaitlistEntryUpdate {
+	mutation := newWaitlistEntryMutation(c.config, OpUpdate)
+	return &WaitlistEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WaitlistEntryClient) UpdateOne(we *WaitlistEntry) *WaitlistEntryUpdateOne {
+	mutation := newWaitlistEntryMutation(c.config, OpUpdateOne, withWaitlistEntry(we))
+	return &WaitlistEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WaitlistEntryClient) UpdateOneID(id int) *WaitlistEntryUpdateOne {
+	mutation := newWaitlistEntryMutation(c.config, OpUpdateOne, withWaitlistEntryID(id))
+	return &WaitlistEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WaitlistEntry.
+func (c *WaitlistEntryClient) Delete() *WaitlistEntryDelete {
+	mutation := newWaitlistEntryMutation(c.config, OpDelete)
+	return &WaitlistEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *WaitlistEntryClient) DeleteOne(we *WaitlistEntry) *WaitlistEntryDeleteOne {
+	return c.DeleteOneID(we.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *WaitlistEntryClient) DeleteOneID(id int) *WaitlistEntryDeleteOne {
+	builder := c.Delete().Where(waitlistentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WaitlistEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for WaitlistEntry.
+func (c *WaitlistEntryClient) Query() *WaitlistEntryQuery {
+	return &WaitlistEntryQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a WaitlistEntry entity by its id.
+func (c *WaitlistEntryClient) Get(ctx context.Context, id int) (*WaitlistEntry, error) {
+	return c.Query().Where(waitlistentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WaitlistEntryClient) GetX(ctx context.Context, id int) *WaitlistEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryResource queries the resource edge of a WaitlistEntry.
+func (c *WaitlistEntryClient) QueryResource(we *WaitlistEntry) *ResourceQuery {
+	query := &ResourceQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := we.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(waitlistentry.Table, waitlistentry.FieldID, id),
+			sqlgraph.To(resource.Table, resource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, waitlistentry.ResourceTable, waitlistentry.ResourceColumn),
+		)
+		fromV = sqlgraph.Neighbors(we.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBooking queries the booking edge of a WaitlistEntry.
+func (c *WaitlistEntryClient) QueryBooking(we *WaitlistEntry) *BookingQuery {
+	query := &BookingQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := we.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(waitlistentry.Table, waitlistentry.FieldID, id),
+			sqlgraph.To(booking.Table, booking.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, waitlistentry.BookingTable, waitlistentry.BookingColumn),
+		)
+		fromV = sqlgraph.Neighbors(we.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WaitlistEntryClient) Hooks() []Hook {
+	hooks := c.hooks.WaitlistEntry
+	return append(hooks[:len(hooks):len(hooks)], waitlistentry.Hooks[:]...)
+}
+
+// WebhookClient is a client for the Webhook schema.
+type WebhookClient struct {
+	config
+}
+
+// NewWebhookClient returns a client for the Webhook from the given config.
+func NewWebhookClient(c config) *WebhookClient {
+	return &WebhookClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhook.Hooks(f(g(h())))`.
+func (c *WebhookClient) Use(hooks ...Hook) {
+	c.hooks.Webhook = append(c.hooks.Webhook, hooks...)
+}
+
+// Create returns a create builder for Webhook.
+func (c *WebhookClient) Create() *WebhookCreate {
+	mutation := newWebhookMutation(c.config, OpCreate)
+	return &WebhookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Webhook entities.
+func (c *WebhookClient) CreateBulk(builders ...*WebhookCreate) *WebhookCreateBulk {
+	return &WebhookCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Webhook.
+func (c *WebhookClient) Update() *WebhookUpdate {
+	mutation := newWebhookMutation(c.config, OpUpdate)
+	return &WebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookClient) UpdateOne(w *Webhook) *WebhookUpdateOne {
+	mutation := newWebhookMutation(c.config, OpUpdateOne, withWebhook(w))
+	return &WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookClient) UpdateOneID(id int) *WebhookUpdateOne {
+	mutation := newWebhookMutation(c.config, OpUpdateOne, withWebhookID(id))
+	return &WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Webhook.
+func (c *WebhookClient) Delete() *WebhookDelete {
+	mutation := newWebhookMutation(c.config, OpDelete)
+	return &WebhookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *WebhookClient) DeleteOne(w *Webhook) *WebhookDeleteOne {
+	return c.DeleteOneID(w.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *WebhookClient) DeleteOneID(id int) *WebhookDeleteOne {
+	builder := c.Delete().Where(webhook.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeleteOne{builder}
+}
+
+// Query returns a query builder for Webhook.
+func (c *WebhookClient) Query() *WebhookQuery {
+	return &WebhookQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Webhook entity by its id.
+func (c *WebhookClient) Get(ctx context.Context, id int) (*Webhook, error) {
+	return c.Query().Where(webhook.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookClient) GetX(ctx context.Context, id int) *Webhook {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrganization queries the organization edge of a Webhook.
+func (c *WebhookClient) QueryOrganization(w *Webhook) *OrganizationQuery {
+	query := &OrganizationQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhook.Table, webhook.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhook.OrganizationTable, webhook.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDeliveries queries the deliveries edge of a Webhook.
+func (c *WebhookClient) QueryDeliveries(w *Webhook) *WebhookDeliveryQuery {
+	query := &WebhookDeliveryQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhook.Table, webhook.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, webhook.DeliveriesTable, webhook.DeliveriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookClient) Hooks() []Hook {
+	hooks := c.hooks.Webhook
+	return append(hooks[:len(hooks):len(hooks)], webhook.Hooks[:]...)
+}
+
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Create returns a create builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(wd *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(wd))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id int) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(wd *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(wd.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *WebhookDeliveryClient) DeleteOneID(id int) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id int) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id int) *WebhookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWebhook queries the webhook edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QueryWebhook(wd *WebhookDelivery) *WebhookQuery {
+	query := &WebhookQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := wd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdelivery.WebhookTable, webhookdelivery.WebhookColumn),
+		)
+		fromV = sqlgraph.Neighbors(wd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	hooks := c.hooks.WebhookDelivery
+	return append(hooks[:len(hooks):len(hooks)], webhookdelivery.Hooks[:]...)
+}
@@ -27,13 +27,23 @@ type hooks struct {
 	Auth                  []ent.Hook
 	Booking               []ent.Hook
 	BookingMetadatum      []ent.Hook
+	BookingSeries         []ent.Hook
+	Customer              []ent.Hook
+	Invoice               []ent.Hook
 	Organization          []ent.Hook
 	OrganizationOwnership []ent.Hook
+	Payment               []ent.Hook
+	PromoCode             []ent.Hook
+	PromoRedemption       []ent.Hook
 	Resource              []ent.Hook
 	Slot                  []ent.Hook
+	TaxRate               []ent.Hook
 	Token                 []ent.Hook
 	Unavailability        []ent.Hook
 	User                  []ent.Hook
+	WaitlistEntry         []ent.Hook
+	Webhook               []ent.Hook
+	WebhookDelivery       []ent.Hook
 }
 
 // Options applies the options on the config object.
@@ -0,0 +1,536 @@
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/privacy"
+	"github.com/openmesh/booking"
+	entbooking "github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/bookingmetadatum"
+	"github.com/openmesh/booking/ent/customer"
+	"github.com/openmesh/booking/ent/predicate"
+)
+
+type customerService struct {
+	client *Client
+}
+
+// NewCustomerService constructs a new instance of a booking.CustomerService
+// using ent as its persistence layer.
+func NewCustomerService(client *Client) *customerService {
+	return &customerService{client}
+}
+
+// FindCustomerByID retrieves a single customer by ID. Returns
+// ECUSTOMERNOTFOUND if the customer does not exist or user does not have
+// permission to view it.
+func (s *customerService) FindCustomerByID(
+	ctx context.Context,
+	req booking.FindCustomerByIDRequest,
+) booking.FindCustomerByIDResponse {
+	c, err := findCustomerByID(ctx, s.client, req.ID)
+	if err != nil {
+		return booking.FindCustomerByIDResponse{Err: err}
+	}
+	return booking.FindCustomerByIDResponse{Customer: c.toModel()}
+}
+
+// FindCustomers searches the customers of the current organization. Customers
+// are ordered by name unless another order is requested.
+func (s *customerService) FindCustomers(
+	ctx context.Context,
+	req booking.FindCustomersRequest,
+) booking.FindCustomersResponse {
+	q := s.client.Customer.Query()
+	if req.Query != nil && strings.TrimSpace(*req.Query) != "" {
+		v := strings.TrimSpace(*req.Query)
+		q.Where(customer.Or(
+			customer.NameContainsFold(v),
+			customer.EmailContainsFold(v),
+			customer.PhoneContainsFold(v),
+		))
+	}
+	if req.Tag != nil {
+		q.Where(customerHasTag(*req.Tag))
+	}
+
+	c, err := q.Clone().Count(ctx)
+	if err != nil {
+		return booking.FindCustomersResponse{
+			Err: fmt.Errorf("failed to count customers: %w", err),
+		}
+	}
+
+	q = q.Offset(req.Offset)
+	if req.Limit == 0 {
+		q.Limit(10)
+	} else {
+		q.Limit(req.Limit)
+	}
+
+	customers, err := q.Order(customerOrder(req.OrderBy)...).All(ctx)
+	if err != nil {
+		return booking.FindCustomersResponse{
+			Err: fmt.Errorf("failed to query customers: %w", err),
+		}
+	}
+	return booking.FindCustomersResponse{
+		Customers:  Customers(customers).toModels(),
+		TotalItems: c,
+	}
+}
+
+// CreateCustomer creates a new customer for the current organization. Returns
+// ECUSTOMERCONFLICT if another customer has the same email.
+func (s *customerService) CreateCustomer(
+	ctx context.Context,
+	req booking.CreateCustomerRequest,
+) booking.CreateCustomerResponse {
+	orgID := booking.OrganizationIDFromContext(ctx)
+	if orgID == 0 {
+		return booking.CreateCustomerResponse{
+			Err: booking.Errorf(booking.EUNAUTHORIZED, "You must be signed in to create a customer."),
+		}
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return booking.CreateCustomerResponse{
+			Err: fmt.Errorf("failed to start transaction: %w", err),
+		}
+	}
+	defer tx.Rollback()
+
+	email := booking.NormalizeCustomerEmail(req.Email)
+	if err := checkForCustomerConflict(ctx, tx, email, 0); err != nil {
+		return booking.CreateCustomerResponse{Err: err}
+	}
+
+	c, err := createCustomer(ctx, tx, orgID, req)
+	if err != nil {
+		return booking.CreateCustomerResponse{Err: err}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return booking.CreateCustomerResponse{
+			Err: fmt.Errorf("failed to commit transaction: %w", err),
+		}
+	}
+	return booking.CreateCustomerResponse{Customer: c.toModel()}
+}
+
+// UpdateCustomer updates an existing customer by ID. Returns ECUSTOMERCONFLICT
+// if another customer has the same email.
+func (s *customerService) UpdateCustomer(
+	ctx context.Context,
+	req booking.UpdateCustomerRequest,
+) booking.UpdateCustomerResponse {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return booking.UpdateCustomerResponse{
+			Err: fmt.Errorf("failed to start transaction: %w", err),
+		}
+	}
+	defer tx.Rollback()
+
+	c, err := findCustomerByID(ctx, tx.Client(), req.ID)
+	if err != nil {
+		return booking.UpdateCustomerResponse{Err: err}
+	}
+
+	email := booking.NormalizeCustomerEmail(req.Email)
+	if err := checkForCustomerConflict(ctx, tx, email, c.ID); err != nil {
+		return booking.UpdateCustomerResponse{Err: err}
+	}
+
+	q := c.Update().
+		SetName(strings.TrimSpace(req.Name)).
+		SetPhone(strings.TrimSpace(req.Phone)).
+		SetNotes(req.Notes).
+		SetTags(booking.NormalizeCustomerTags(req.Tags))
+	if email != "" {
+		q.SetEmail(email)
+	} else {
+		q.ClearEmail()
+	}
+	c, err = q.Save(ctx)
+	if err != nil {
+		return booking.UpdateCustomerResponse{
+			Err: fmt.Errorf("failed to update customer: %w", err),
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return booking.UpdateCustomerResponse{
+			Err: fmt.Errorf("failed to commit transaction: %w", err),
+		}
+	}
+	return booking.UpdateCustomerResponse{Customer: c.toModel()}
+}
+
+// DeleteCustomer permanently removes a customer. Their bookings are kept
+// without a customer.
+func (s *customerService) DeleteCustomer(
+	ctx context.Context,
+	req booking.DeleteCustomerRequest,
+) booking.DeleteCustomerResponse {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return booking.DeleteCustomerResponse{
+			Err: fmt.Errorf("failed to start transaction: %w", err),
+		}
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Booking.
+		Update().
+		Where(entbooking.CustomerId(req.ID)).
+		ClearCustomerId().
+		Save(ctx); err != nil {
+		return booking.DeleteCustomerResponse{
+			Err: fmt.Errorf("failed to clear customer of bookings: %w", err),
+		}
+	}
+
+	a, err := tx.Customer.
+		Delete().
+		Where(customer.ID(req.ID)).
+		Exec(ctx)
+	if err != nil {
+		return booking.DeleteCustomerResponse{
+			Err: fmt.Errorf("failed to delete customer: %w", err),
+		}
+	}
+	if a == 0 {
+		return booking.DeleteCustomerResponse{
+			Err: booking.Errorf(booking.ECUSTOMERNOTFOUND, "Could not find customer with ID %d", req.ID),
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return booking.DeleteCustomerResponse{
+			Err: fmt.Errorf("failed to commit transaction: %w", err),
+		}
+	}
+	return booking.DeleteCustomerResponse{}
+}
+
+// FindCustomerBookings retrieves the bookings made by a customer, most recent
+// first.
+func (s *customerService) FindCustomerBookings(
+	ctx context.Context,
+	req booking.FindCustomerBookingsRequest,
+) booking.FindCustomerBookingsResponse {
+	if _, err := findCustomerByID(ctx, s.client, req.ID); err != nil {
+		return booking.FindCustomerBookingsResponse{Err: err}
+	}
+
+	q := s.client.Booking.
+		Query().
+		Where(entbooking.CustomerId(req.ID))
+
+	c, err := q.Clone().Count(ctx)
+	if err != nil {
+		return booking.FindCustomerBookingsResponse{
+			Err: fmt.Errorf("failed to count bookings: %w", err),
+		}
+	}
+
+	q = q.Offset(req.Offset)
+	if req.Limit == 0 {
+		q.Limit(10)
+	} else {
+		q.Limit(req.Limit)
+	}
+
+	b, err := q.
+		Order(Desc(entbooking.FieldStartTime), Desc(entbooking.FieldID)).
+		WithMetadata().
+		WithResource().
+		All(ctx)
+	if err != nil {
+		return booking.FindCustomerBookingsResponse{
+			Err: fmt.Errorf("failed to query bookings: %w", err),
+		}
+	}
+
+	bookings := Bookings(b).toModels()
+	if bookings == nil {
+		bookings = make([]*booking.Booking, 0)
+	}
+	return booking.FindCustomerBookingsResponse{
+		Bookings:   bookings,
+		TotalItems: c,
+	}
+}
+
+// GetCustomerLifetimeValue returns the value of every booking made by a
+// customer that counts as a sale.
+func (s *customerService) GetCustomerLifetimeValue(
+	ctx context.Context,
+	req booking.GetCustomerLifetimeValueRequest,
+) booking.GetCustomerLifetimeValueResponse {
+	if _, err := findCustomerByID(ctx, s.client, req.ID); err != nil {
+		return booking.GetCustomerLifetimeValueResponse{Err: err}
+	}
+
+	b, err := s.client.Booking.
+		Query().
+		Where(
+			entbooking.CustomerId(req.ID),
+			entbooking.StatusIn(booking.SalesBookingStatuses...),
+		).
+		Order(Asc(entbooking.FieldStartTime)).
+		All(ctx)
+	if err != nil {
+		return booking.GetCustomerLifetimeValueResponse{
+			Err: fmt.Errorf("failed to query sales: %w", err),
+		}
+	}
+
+	value := &booking.CustomerLifetimeValue{
+		CustomerID: req.ID,
+		Bookings:   len(b),
+		Revenue:    booking.TaxedTotals{},
+	}
+	for _, v := range b {
+		value.Revenue = value.Revenue.Add(v.taxed())
+	}
+	if len(b) > 0 {
+		value.FirstBookingAt = &b[0].StartTime
+		value.LastBookingAt = &b[len(b)-1].StartTime
+	}
+	return booking.GetCustomerLifetimeValueResponse{CustomerLifetimeValue: value}
+}
+
+// findCustomerByID retrieves a customer by ID. Returns ECUSTOMERNOTFOUND if
+// the customer does not exist.
+func findCustomerByID(ctx context.Context, client *Client, id int) (*Customer, error) {
+	c, err := client.Customer.
+		Query().
+		Where(customer.ID(id)).
+		Only(ctx)
+	var nfe *NotFoundError
+	if errors.As(err, &nfe) {
+		return nil, booking.Errorf(booking.ECUSTOMERNOTFOUND, "Could not find customer with ID %d", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find customer: %w", err)
+	}
+	return c, nil
+}
+
+// checkForCustomerConflict returns ECUSTOMERCONFLICT if a customer other than
+// the one with id already uses email. Customers without an email never
+// conflict.
+func checkForCustomerConflict(ctx context.Context, tx *Tx, email string, id int) error {
+	if email == "" {
+		return nil
+	}
+	exists, err := tx.Customer.
+		Query().
+		Where(customer.Email(email), customer.IDNEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check for customer conflict: %w", err)
+	}
+	if exists {
+		return booking.Errorf(booking.ECUSTOMERCONFLICT, "A customer with the email '%s' already exists", email)
+	}
+	return nil
+}
+
+// createCustomer creates a customer of the organization with orgID.
+func createCustomer(ctx context.Context, tx *Tx, orgID int, req booking.CreateCustomerRequest) (*Customer, error) {
+	c, err := newCustomerCreate(tx, orgID, req).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create customer: %w", err)
+	}
+	return c, nil
+}
+
+// newCustomerCreate returns the builder of a customer of the organization with
+// orgID.
+func newCustomerCreate(tx *Tx, orgID int, req booking.CreateCustomerRequest) *CustomerCreate {
+	q := tx.Customer.
+		Create().
+		SetName(strings.TrimSpace(req.Name)).
+		SetPhone(strings.TrimSpace(req.Phone)).
+		SetNotes(req.Notes).
+		SetTags(booking.NormalizeCustomerTags(req.Tags)).
+		SetOrganizationID(orgID)
+	if email := booking.NormalizeCustomerEmail(req.Email); email != "" {
+		q.SetEmail(email)
+	}
+	return q
+}
+
+// findOrCreateCustomer returns the customer of the organization with orgID
+// that has the email of req, creating them if they don't exist. The details
+// of existing customers are left as they are. A customer that is created by a
+// concurrent transaction after the lookup is returned instead of violating
+// the unique email of the organization's customers.
+func findOrCreateCustomer(ctx context.Context, tx *Tx, orgID int, req booking.CreateCustomerRequest) (*Customer, error) {
+	c, err := tx.Customer.
+		Query().
+		Where(
+			customer.OrganizationId(orgID),
+			customer.Email(booking.NormalizeCustomerEmail(req.Email)),
+		).
+		Only(ctx)
+	var nfe *NotFoundError
+	if errors.As(err, &nfe) {
+		id, err := newCustomerCreate(tx, orgID, req).
+			OnConflictColumns(customer.FieldOrganizationId, customer.FieldEmail).
+			Ignore().
+			ID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create customer: %w", err)
+		}
+		return findCustomerByID(ctx, tx.Client(), id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find customer: %w", err)
+	}
+	return c, nil
+}
+
+// bookingCustomerID returns the ID of the customer that a booking of a
+// resource of the organization with orgID is made for. Bookings without a
+// customer are linked to the customer with the email in their metadata.
+// Returns nil if the booking doesn't identify a customer.
+func bookingCustomerID(ctx context.Context, tx *Tx, orgID int, req booking.CreateBookingRequest) (*int, error) {
+	if req.CustomerID != nil {
+		_, err := findCustomerByID(ctx, tx.Client(), *req.CustomerID)
+		if booking.ErrorCode(err) == booking.ECUSTOMERNOTFOUND {
+			return nil, booking.ValidationErrorf("", booking.ValidationError{
+				Name:   "customerId",
+				Reason: "Customer does not exist",
+			})
+		}
+		if err != nil {
+			return nil, err
+		}
+		return req.CustomerID, nil
+	}
+	details, ok := booking.CustomerFromMetadata(req.Metadata)
+	if !ok {
+		return nil, nil
+	}
+	c, err := findOrCreateCustomer(ctx, tx, orgID, details)
+	if err != nil {
+		return nil, err
+	}
+	return &c.ID, nil
+}
+
+// MigrateBookingCustomers links bookings made before customers were stored to
+// the customer with the email in their metadata, creating customers as needed.
+// Bookings are migrated across every organization. Returns the number of
+// bookings that were migrated.
+func MigrateBookingCustomers(ctx context.Context, client *Client) (int, error) {
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Every metadata key that an email is read from contains "mail".
+	b, err := tx.Booking.
+		Query().
+		Where(
+			entbooking.CustomerIdIsNil(),
+			entbooking.HasMetadataWith(bookingmetadatum.KeyContainsFold("mail")),
+		).
+		WithMetadata().
+		WithResource().
+		Order(Asc(entbooking.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, fmt.Errorf("failed to query bookings: %w", err)
+	}
+
+	migrated := 0
+	for _, v := range b {
+		details, ok := booking.CustomerFromMetadata(BookingMetadata(v.Edges.Metadata).toMap())
+		if !ok {
+			continue
+		}
+		c, err := findOrCreateCustomer(ctx, tx, v.Edges.Resource.OrganizationId, details)
+		if err != nil {
+			_ = tx.Rollback()
+			return 0, err
+		}
+		err = tx.Booking.
+			UpdateOneID(v.ID).
+			SetCustomerID(c.ID).
+			Exec(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return 0, fmt.Errorf("failed to migrate customer of booking %d: %w", v.ID, err)
+		}
+		migrated++
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return migrated, nil
+}
+
+// customerHasTag returns a predicate matching customers tagged with tag.
+func customerHasTag(tag string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(customer.FieldTags), strings.TrimSpace(tag)))
+	})
+}
+
+// customerOrder returns the order of customers requested by orderBy.
+func customerOrder(orderBy *string) []OrderFunc {
+	field := customer.FieldName
+	if orderBy != nil {
+		switch *orderBy {
+		case "email":
+			field = customer.FieldEmail
+		case "createdAt":
+			field = customer.FieldCreatedAt
+		case "updatedAt":
+			field = customer.FieldUpdatedAt
+		}
+	}
+	return []OrderFunc{Asc(field), Asc(customer.FieldID)}
+}
+
+func (c *Customer) toModel() *booking.Customer {
+	result := &booking.Customer{
+		ID:        c.ID,
+		Name:      c.Name,
+		Phone:     c.Phone,
+		Notes:     c.Notes,
+		Tags:      c.Tags,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+	if c.Email != nil {
+		result.Email = *c.Email
+	}
+	if result.Tags == nil {
+		result.Tags = make([]string, 0)
+	}
+	return result
+}
+
+func (c Customers) toModels() []*booking.Customer {
+	customers := make([]*booking.Customer, 0, len(c))
+	for _, v := range c {
+		customers = append(customers, v.toModel())
+	}
+	return customers
+}
@@ -0,0 +1,864 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/customer"
+	"github.com/openmesh/booking/ent/organization"
+	"github.com/openmesh/booking/ent/predicate"
+)
+
+// CustomerUpdate is the builder for updating Customer entities.
+type CustomerUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CustomerMutation
+}
+
+// Where appends a list predicates to the CustomerUpdate builder.
+func (cu *CustomerUpdate) Where(ps ...predicate.Customer) *CustomerUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (cu *CustomerUpdate) SetUpdatedAt(t time.Time) *CustomerUpdate {
+	cu.mutation.SetUpdatedAt(t)
+	return cu
+}
+
+// SetName sets the "name" field.
+func (cu *CustomerUpdate) SetName(s string) *CustomerUpdate {
+	cu.mutation.SetName(s)
+	return cu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cu *CustomerUpdate) SetNillableName(s *string) *CustomerUpdate {
+	if s != nil {
+		cu.SetName(*s)
+	}
+	return cu
+}
+
+// SetEmail sets the "email" field.
+func (cu *CustomerUpdate) SetEmail(s string) *CustomerUpdate {
+	cu.mutation.SetEmail(s)
+	return cu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (cu *CustomerUpdate) SetNillableEmail(s *string) *CustomerUpdate {
+	if s != nil {
+		cu.SetEmail(*s)
+	}
+	return cu
+}
+
+// ClearEmail clears the value of the "email" field.
+func (cu *CustomerUpdate) ClearEmail() *CustomerUpdate {
+	cu.mutation.ClearEmail()
+	return cu
+}
+
+// SetPhone sets the "phone" field.
+func (cu *CustomerUpdate) SetPhone(s string) *CustomerUpdate {
+	cu.mutation.SetPhone(s)
+	return cu
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (cu *CustomerUpdate) SetNillablePhone(s *string) *CustomerUpdate {
+	if s != nil {
+		cu.SetPhone(*s)
+	}
+	return cu
+}
+
+// SetNotes sets the "notes" field.
+func (cu *CustomerUpdate) SetNotes(s string) *CustomerUpdate {
+	cu.mutation.SetNotes(s)
+	return cu
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (cu *CustomerUpdate) SetNillableNotes(s *string) *CustomerUpdate {
+	if s != nil {
+		cu.SetNotes(*s)
+	}
+	return cu
+}
+
+// SetTags sets the "tags" field.
+func (cu *CustomerUpdate) SetTags(s []string) *CustomerUpdate {
+	cu.mutation.SetTags(s)
+	return cu
+}
+
+// ClearTags clears the value of the "tags" field.
+func (cu *CustomerUpdate) ClearTags() *CustomerUpdate {
+	cu.mutation.ClearTags()
+	return cu
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (cu *CustomerUpdate) SetOrganizationId(i int) *CustomerUpdate {
+	cu.mutation.SetOrganizationId(i)
+	return cu
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (cu *CustomerUpdate) SetOrganizationID(id int) *CustomerUpdate {
+	cu.mutation.SetOrganizationID(id)
+	return cu
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (cu *CustomerUpdate) SetOrganization(o *Organization) *CustomerUpdate {
+	return cu.SetOrganizationID(o.ID)
+}
+
+// AddBookingIDs adds the "bookings" edge to the Booking entity by IDs.
+func (cu *CustomerUpdate) AddBookingIDs(ids ...int) *CustomerUpdate {
+	cu.mutation.AddBookingIDs(ids...)
+	return cu
+}
+
+// AddBookings adds the "bookings" edges to the Booking entity.
+func (cu *CustomerUpdate) AddBookings(b ...*Booking) *CustomerUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return cu.AddBookingIDs(ids...)
+}
+
+// Mutation returns the CustomerMutation object of the builder.
+func (cu *CustomerUpdate) Mutation() *CustomerMutation {
+	return cu.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (cu *CustomerUpdate) ClearOrganization() *CustomerUpdate {
+	cu.mutation.ClearOrganization()
+	return cu
+}
+
+// ClearBookings clears all "bookings" edges to the Booking entity.
+func (cu *CustomerUpdate) ClearBookings() *CustomerUpdate {
+	cu.mutation.ClearBookings()
+	return cu
+}
+
+// RemoveBookingIDs removes the "bookings" edge to Booking entities by IDs.
+func (cu *CustomerUpdate) RemoveBookingIDs(ids ...int) *CustomerUpdate {
+	cu.mutation.RemoveBookingIDs(ids...)
+	return cu
+}
+
+// RemoveBookings removes "bookings" edges to Booking entities.
+func (cu *CustomerUpdate) RemoveBookings(b ...*Booking) *CustomerUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return cu.RemoveBookingIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CustomerUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if err := cu.defaults(); err != nil {
+		return 0, err
+	}
+	if len(cu.hooks) == 0 {
+		if err = cu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = cu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CustomerMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cu.check(); err != nil {
+				return 0, err
+			}
+			cu.mutation = mutation
+			affected, err = cu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cu.hooks) - 1; i >= 0; i-- {
+			if cu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CustomerUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CustomerUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CustomerUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cu *CustomerUpdate) defaults() error {
+	if _, ok := cu.mutation.UpdatedAt(); !ok {
+		if customer.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized customer.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := customer.UpdateDefaultUpdatedAt()
+		cu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CustomerUpdate) check() error {
+	if _, ok := cu.mutation.OrganizationID(); cu.mutation.OrganizationCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"organization\"")
+	}
+	return nil
+}
+
+func (cu *CustomerUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   customer.Table,
+			Columns: customer.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: customer.FieldID,
+			},
+		},
+	}
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: customer.FieldUpdatedAt,
+		})
+	}
+	if value, ok := cu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldName,
+		})
+	}
+	if value, ok := cu.mutation.Email(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldEmail,
+		})
+	}
+	if cu.mutation.EmailCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: customer.FieldEmail,
+		})
+	}
+	if value, ok := cu.mutation.Phone(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldPhone,
+		})
+	}
+	if value, ok := cu.mutation.Notes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldNotes,
+		})
+	}
+	if value, ok := cu.mutation.Tags(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: customer.FieldTags,
+		})
+	}
+	if cu.mutation.TagsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: customer.FieldTags,
+		})
+	}
+	if cu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customer.OrganizationTable,
+			Columns: []string{customer.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customer.OrganizationTable,
+			Columns: []string{customer.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.BookingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customer.BookingsTable,
+			Columns: []string{customer.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedBookingsIDs(); len(nodes) > 0 && !cu.mutation.BookingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customer.BookingsTable,
+			Columns: []string{customer.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.BookingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customer.BookingsTable,
+			Columns: []string{customer.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// CustomerUpdateOne is the builder for updating a single Customer entity.
+type CustomerUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CustomerMutation
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (cuo *CustomerUpdateOne) SetUpdatedAt(t time.Time) *CustomerUpdateOne {
+	cuo.mutation.SetUpdatedAt(t)
+	return cuo
+}
+
+// SetName sets the "name" field.
+func (cuo *CustomerUpdateOne) SetName(s string) *CustomerUpdateOne {
+	cuo.mutation.SetName(s)
+	return cuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cuo *CustomerUpdateOne) SetNillableName(s *string) *CustomerUpdateOne {
+	if s != nil {
+		cuo.SetName(*s)
+	}
+	return cuo
+}
+
+// SetEmail sets the "email" field.
+func (cuo *CustomerUpdateOne) SetEmail(s string) *CustomerUpdateOne {
+	cuo.mutation.SetEmail(s)
+	return cuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (cuo *CustomerUpdateOne) SetNillableEmail(s *string) *CustomerUpdateOne {
+	if s != nil {
+		cuo.SetEmail(*s)
+	}
+	return cuo
+}
+
+// ClearEmail clears the value of the "email" field.
+func (cuo *CustomerUpdateOne) ClearEmail() *CustomerUpdateOne {
+	cuo.mutation.ClearEmail()
+	return cuo
+}
+
+// SetPhone sets the "phone" field.
+func (cuo *CustomerUpdateOne) SetPhone(s string) *CustomerUpdateOne {
+	cuo.mutation.SetPhone(s)
+	return cuo
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (cuo *CustomerUpdateOne) SetNillablePhone(s *string) *CustomerUpdateOne {
+	if s != nil {
+		cuo.SetPhone(*s)
+	}
+	return cuo
+}
+
+// SetNotes sets the "notes" field.
+func (cuo *CustomerUpdateOne) SetNotes(s string) *CustomerUpdateOne {
+	cuo.mutation.SetNotes(s)
+	return cuo
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (cuo *CustomerUpdateOne) SetNillableNotes(s *string) *CustomerUpdateOne {
+	if s != nil {
+		cuo.SetNotes(*s)
+	}
+	return cuo
+}
+
+// SetTags sets the "tags" field.
+func (cuo *CustomerUpdateOne) SetTags(s []string) *CustomerUpdateOne {
+	cuo.mutation.SetTags(s)
+	return cuo
+}
+
+// ClearTags clears the value of the "tags" field.
+func (cuo *CustomerUpdateOne) ClearTags() *CustomerUpdateOne {
+	cuo.mutation.ClearTags()
+	return cuo
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (cuo *CustomerUpdateOne) SetOrganizationId(i int) *CustomerUpdateOne {
+	cuo.mutation.SetOrganizationId(i)
+	return cuo
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (cuo *CustomerUpdateOne) SetOrganizationID(id int) *CustomerUpdateOne {
+	cuo.mutation.SetOrganizationID(id)
+	return cuo
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (cuo *CustomerUpdateOne) SetOrganization(o *Organization) *CustomerUpdateOne {
+	return cuo.SetOrganizationID(o.ID)
+}
+
+// AddBookingIDs adds the "bookings" edge to the Booking entity by IDs.
+func (cuo *CustomerUpdateOne) AddBookingIDs(ids ...int) *CustomerUpdateOne {
+	cuo.mutation.AddBookingIDs(ids...)
+	return cuo
+}
+
+// AddBookings adds the "bookings" edges to the Booking entity.
+func (cuo *CustomerUpdateOne) AddBookings(b ...*Booking) *CustomerUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return cuo.AddBookingIDs(ids...)
+}
+
+// Mutation returns the CustomerMutation object of the builder.
+func (cuo *CustomerUpdateOne) Mutation() *CustomerMutation {
+	return cuo.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (cuo *CustomerUpdateOne) ClearOrganization() *CustomerUpdateOne {
+	cuo.mutation.ClearOrganization()
+	return cuo
+}
+
+// ClearBookings clears all "bookings" edges to the Booking entity.
+func (cuo *CustomerUpdateOne) ClearBookings() *CustomerUpdateOne {
+	cuo.mutation.ClearBookings()
+	return cuo
+}
+
+// RemoveBookingIDs removes the "bookings" edge to Booking entities by IDs.
+func (cuo *CustomerUpdateOne) RemoveBookingIDs(ids ...int) *CustomerUpdateOne {
+	cuo.mutation.RemoveBookingIDs(ids...)
+	return cuo
+}
+
+// RemoveBookings removes "bookings" edges to Booking entities.
+func (cuo *CustomerUpdateOne) RemoveBookings(b ...*Booking) *CustomerUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return cuo.RemoveBookingIDs(ids...)
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CustomerUpdateOne) Select(field string, fields ...string) *CustomerUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Customer entity.
+func (cuo *CustomerUpdateOne) Save(ctx context.Context) (*Customer, error) {
+	var (
+		err  error
+		node *Customer
+	)
+	if err := cuo.defaults(); err != nil {
+		return nil, err
+	}
+	if len(cuo.hooks) == 0 {
+		if err = cuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = cuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CustomerMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cuo.check(); err != nil {
+				return nil, err
+			}
+			cuo.mutation = mutation
+			node, err = cuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cuo.hooks) - 1; i >= 0; i-- {
+			if cuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CustomerUpdateOne) SaveX(ctx context.Context) *Customer {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CustomerUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CustomerUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cuo *CustomerUpdateOne) defaults() error {
+	if _, ok := cuo.mutation.UpdatedAt(); !ok {
+		if customer.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized customer.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := customer.UpdateDefaultUpdatedAt()
+		cuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CustomerUpdateOne) check() error {
+	if _, ok := cuo.mutation.OrganizationID(); cuo.mutation.OrganizationCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"organization\"")
+	}
+	return nil
+}
+
+func (cuo *CustomerUpdateOne) sqlSave(ctx context.Context) (_node *Customer, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   customer.Table,
+			Columns: customer.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: customer.FieldID,
+			},
+		},
+	}
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Customer.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, customer.FieldID)
+		for _, f := range fields {
+			if !customer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != customer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: customer.FieldUpdatedAt,
+		})
+	}
+	if value, ok := cuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldName,
+		})
+	}
+	if value, ok := cuo.mutation.Email(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldEmail,
+		})
+	}
+	if cuo.mutation.EmailCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: customer.FieldEmail,
+		})
+	}
+	if value, ok := cuo.mutation.Phone(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldPhone,
+		})
+	}
+	if value, ok := cuo.mutation.Notes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldNotes,
+		})
+	}
+	if value, ok := cuo.mutation.Tags(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: customer.FieldTags,
+		})
+	}
+	if cuo.mutation.TagsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: customer.FieldTags,
+		})
+	}
+	if cuo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customer.OrganizationTable,
+			Columns: []string{customer.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customer.OrganizationTable,
+			Columns: []string{customer.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.BookingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customer.BookingsTable,
+			Columns: []string{customer.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedBookingsIDs(); len(nodes) > 0 && !cuo.mutation.BookingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customer.BookingsTable,
+			Columns: []string{customer.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.BookingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customer.BookingsTable,
+			Columns: []string{customer.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Customer{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -11,13 +11,23 @@ import (
 	"github.com/openmesh/booking/ent/auth"
 	"github.com/openmesh/booking/ent/booking"
 	"github.com/openmesh/booking/ent/bookingmetadatum"
+	"github.com/openmesh/booking/ent/bookingseries"
+	"github.com/openmesh/booking/ent/customer"
+	"github.com/openmesh/booking/ent/invoice"
 	"github.com/openmesh/booking/ent/organization"
 	"github.com/openmesh/booking/ent/organizationownership"
+	"github.com/openmesh/booking/ent/payment"
+	"github.com/openmesh/booking/ent/promocode"
+	"github.com/openmesh/booking/ent/promoredemption"
 	"github.com/openmesh/booking/ent/resource"
 	"github.com/openmesh/booking/ent/slot"
+	"github.com/openmesh/booking/ent/taxrate"
 	"github.com/openmesh/booking/ent/token"
 	"github.com/openmesh/booking/ent/unavailability"
 	"github.com/openmesh/booking/ent/user"
+	"github.com/openmesh/booking/ent/waitlistentry"
+	"github.com/openmesh/booking/ent/webhook"
+	"github.com/openmesh/booking/ent/webhookdelivery"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -41,13 +51,23 @@ func columnChecker(table string) func(string) error {
 		auth.Table:                  auth.ValidColumn,
 		booking.Table:               booking.ValidColumn,
 		bookingmetadatum.Table:      bookingmetadatum.ValidColumn,
+		bookingseries.Table:         bookingseries.ValidColumn,
+		customer.Table:              customer.ValidColumn,
+		invoice.Table:               invoice.ValidColumn,
 		organization.Table:          organization.ValidColumn,
 		organizationownership.Table: organizationownership.ValidColumn,
+		payment.Table:               payment.ValidColumn,
+		promocode.Table:             promocode.ValidColumn,
+		promoredemption.Table:       promoredemption.ValidColumn,
 		resource.Table:              resource.ValidColumn,
 		slot.Table:                  slot.ValidColumn,
+		taxrate.Table:               taxrate.ValidColumn,
 		token.Table:                 token.ValidColumn,
 		unavailability.Table:        unavailability.ValidColumn,
 		user.Table:                  user.ValidColumn,
+		waitlistentry.Table:         waitlistentry.ValidColumn,
+		webhook.Table:               webhook.ValidColumn,
+		webhookdelivery.Table:       webhookdelivery.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
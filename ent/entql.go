@@ -6,14 +6,24 @@ import (
 	"github.com/openmesh/booking/ent/auth"
 	"github.com/openmesh/booking/ent/booking"
 	"github.com/openmesh/booking/ent/bookingmetadatum"
+	"github.com/openmesh/booking/ent/bookingseries"
+	"github.com/openmesh/booking/ent/customer"
+	"github.com/openmesh/booking/ent/invoice"
 	"github.com/openmesh/booking/ent/organization"
 	"github.com/openmesh/booking/ent/organizationownership"
+	"github.com/openmesh/booking/ent/payment"
 	"github.com/openmesh/booking/ent/predicate"
+	"github.com/openmesh/booking/ent/promocode"
+	"github.com/openmesh/booking/ent/promoredemption"
 	"github.com/openmesh/booking/ent/resource"
 	"github.com/openmesh/booking/ent/slot"
+	"github.com/openmesh/booking/ent/taxrate"
 	"github.com/openmesh/booking/ent/token"
 	"github.com/openmesh/booking/ent/unavailability"
 	"github.com/openmesh/booking/ent/user"
+	"github.com/openmesh/booking/ent/waitlistentry"
+	"github.com/openmesh/booking/ent/webhook"
+	"github.com/openmesh/booking/ent/webhookdelivery"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -23,7 +33,7 @@ import (
 
 // schemaGraph holds a representation of ent/schema at runtime.
 var schemaGraph = func() *sqlgraph.Schema {
-	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 10)}
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 20)}
 	graph.Nodes[0] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   auth.Table,
@@ -56,12 +66,25 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "Booking",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			booking.FieldCreatedAt:  {Type: field.TypeTime, Column: booking.FieldCreatedAt},
-			booking.FieldUpdatedAt:  {Type: field.TypeTime, Column: booking.FieldUpdatedAt},
-			booking.FieldStatus:     {Type: field.TypeString, Column: booking.FieldStatus},
-			booking.FieldStartTime:  {Type: field.TypeTime, Column: booking.FieldStartTime},
-			booking.FieldEndTime:    {Type: field.TypeTime, Column: booking.FieldEndTime},
-			booking.FieldResourceId: {Type: field.TypeInt, Column: booking.FieldResourceId},
+			booking.FieldCreatedAt:    {Type: field.TypeTime, Column: booking.FieldCreatedAt},
+			booking.FieldUpdatedAt:    {Type: field.TypeTime, Column: booking.FieldUpdatedAt},
+			booking.FieldStatus:       {Type: field.TypeString, Column: booking.FieldStatus},
+			booking.FieldStartTime:    {Type: field.TypeTime, Column: booking.FieldStartTime},
+			booking.FieldEndTime:      {Type: field.TypeTime, Column: booking.FieldEndTime},
+			booking.FieldResourceId:   {Type: field.TypeInt, Column: booking.FieldResourceId},
+			booking.FieldUserId:       {Type: field.TypeInt, Column: booking.FieldUserId},
+			booking.FieldSeriesId:     {Type: field.TypeInt, Column: booking.FieldSeriesId},
+			booking.FieldCustomerId:   {Type: field.TypeInt, Column: booking.FieldCustomerId},
+			booking.FieldExpiresAt:    {Type: field.TypeTime, Column: booking.FieldExpiresAt},
+			booking.FieldPrice:        {Type: field.TypeInt, Column: booking.FieldPrice},
+			booking.FieldCurrency:     {Type: field.TypeString, Column: booking.FieldCurrency},
+			booking.FieldCancelledAt:  {Type: field.TypeTime, Column: booking.FieldCancelledAt},
+			booking.FieldRefundAmount: {Type: field.TypeInt, Column: booking.FieldRefundAmount},
+			booking.FieldFeeAmount:    {Type: field.TypeInt, Column: booking.FieldFeeAmount},
+			booking.FieldTaxName:      {Type: field.TypeString, Column: booking.FieldTaxName},
+			booking.FieldTaxRate:      {Type: field.TypeInt, Column: booking.FieldTaxRate},
+			booking.FieldTaxInclusive: {Type: field.TypeBool, Column: booking.FieldTaxInclusive},
+			booking.FieldTaxAmount:    {Type: field.TypeInt, Column: booking.FieldTaxAmount},
 		},
 	}
 	graph.Nodes[2] = &sqlgraph.Node{
@@ -81,6 +104,76 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 	}
 	graph.Nodes[3] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   bookingseries.Table,
+			Columns: bookingseries.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: bookingseries.FieldID,
+			},
+		},
+		Type: "BookingSeries",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			bookingseries.FieldCreatedAt:  {Type: field.TypeTime, Column: bookingseries.FieldCreatedAt},
+			bookingseries.FieldUpdatedAt:  {Type: field.TypeTime, Column: bookingseries.FieldUpdatedAt},
+			bookingseries.FieldRrule:      {Type: field.TypeString, Column: bookingseries.FieldRrule},
+			bookingseries.FieldExdates:    {Type: field.TypeJSON, Column: bookingseries.FieldExdates},
+			bookingseries.FieldStartTime:  {Type: field.TypeTime, Column: bookingseries.FieldStartTime},
+			bookingseries.FieldEndTime:    {Type: field.TypeTime, Column: bookingseries.FieldEndTime},
+			bookingseries.FieldResourceId: {Type: field.TypeInt, Column: bookingseries.FieldResourceId},
+		},
+	}
+	graph.Nodes[4] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   customer.Table,
+			Columns: customer.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: customer.FieldID,
+			},
+		},
+		Type: "Customer",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			customer.FieldCreatedAt:      {Type: field.TypeTime, Column: customer.FieldCreatedAt},
+			customer.FieldUpdatedAt:      {Type: field.TypeTime, Column: customer.FieldUpdatedAt},
+			customer.FieldName:           {Type: field.TypeString, Column: customer.FieldName},
+			customer.FieldEmail:          {Type: field.TypeString, Column: customer.FieldEmail},
+			customer.FieldPhone:          {Type: field.TypeString, Column: customer.FieldPhone},
+			customer.FieldNotes:          {Type: field.TypeString, Column: customer.FieldNotes},
+			customer.FieldTags:           {Type: field.TypeJSON, Column: customer.FieldTags},
+			customer.FieldOrganizationId: {Type: field.TypeInt, Column: customer.FieldOrganizationId},
+		},
+	}
+	graph.Nodes[5] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   invoice.Table,
+			Columns: invoice.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoice.FieldID,
+			},
+		},
+		Type: "Invoice",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			invoice.FieldCreatedAt:         {Type: field.TypeTime, Column: invoice.FieldCreatedAt},
+			invoice.FieldUpdatedAt:         {Type: field.TypeTime, Column: invoice.FieldUpdatedAt},
+			invoice.FieldKind:              {Type: field.TypeString, Column: invoice.FieldKind},
+			invoice.FieldNumber:            {Type: field.TypeInt, Column: invoice.FieldNumber},
+			invoice.FieldIssuerName:        {Type: field.TypeString, Column: invoice.FieldIssuerName},
+			invoice.FieldCurrency:          {Type: field.TypeString, Column: invoice.FieldCurrency},
+			invoice.FieldLines:             {Type: field.TypeString, Column: invoice.FieldLines},
+			invoice.FieldTaxLines:          {Type: field.TypeString, Column: invoice.FieldTaxLines},
+			invoice.FieldSubtotal:          {Type: field.TypeInt, Column: invoice.FieldSubtotal},
+			invoice.FieldTax:               {Type: field.TypeInt, Column: invoice.FieldTax},
+			invoice.FieldTaxInclusive:      {Type: field.TypeBool, Column: invoice.FieldTaxInclusive},
+			invoice.FieldTotal:             {Type: field.TypeInt, Column: invoice.FieldTotal},
+			invoice.FieldAmountPaid:        {Type: field.TypeInt, Column: invoice.FieldAmountPaid},
+			invoice.FieldBookingId:         {Type: field.TypeInt, Column: invoice.FieldBookingId},
+			invoice.FieldCreditedInvoiceId: {Type: field.TypeInt, Column: invoice.FieldCreditedInvoiceId},
+			invoice.FieldOrganizationId:    {Type: field.TypeInt, Column: invoice.FieldOrganizationId},
+		},
+	}
+	graph.Nodes[6] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   organization.Table,
 			Columns: organization.Columns,
@@ -96,9 +189,10 @@ var schemaGraph = func() *sqlgraph.Schema {
 			organization.FieldName:       {Type: field.TypeString, Column: organization.FieldName},
 			organization.FieldPublicKey:  {Type: field.TypeString, Column: organization.FieldPublicKey},
 			organization.FieldPrivateKey: {Type: field.TypeString, Column: organization.FieldPrivateKey},
+			organization.FieldCurrency:   {Type: field.TypeString, Column: organization.FieldCurrency},
 		},
 	}
-	graph.Nodes[4] = &sqlgraph.Node{
+	graph.Nodes[7] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   organizationownership.Table,
 			Columns: organizationownership.Columns,
@@ -113,7 +207,77 @@ var schemaGraph = func() *sqlgraph.Schema {
 			organizationownership.FieldOrganizationId: {Type: field.TypeInt, Column: organizationownership.FieldOrganizationId},
 		},
 	}
-	graph.Nodes[5] = &sqlgraph.Node{
+	graph.Nodes[8] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   payment.Table,
+			Columns: payment.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: payment.FieldID,
+			},
+		},
+		Type: "Payment",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			payment.FieldCreatedAt:         {Type: field.TypeTime, Column: payment.FieldCreatedAt},
+			payment.FieldUpdatedAt:         {Type: field.TypeTime, Column: payment.FieldUpdatedAt},
+			payment.FieldProvider:          {Type: field.TypeString, Column: payment.FieldProvider},
+			payment.FieldProviderPaymentId: {Type: field.TypeString, Column: payment.FieldProviderPaymentId},
+			payment.FieldStatus:            {Type: field.TypeString, Column: payment.FieldStatus},
+			payment.FieldAmount:            {Type: field.TypeInt, Column: payment.FieldAmount},
+			payment.FieldAmountRefunded:    {Type: field.TypeInt, Column: payment.FieldAmountRefunded},
+			payment.FieldCurrency:          {Type: field.TypeString, Column: payment.FieldCurrency},
+			payment.FieldClientSecret:      {Type: field.TypeString, Column: payment.FieldClientSecret},
+			payment.FieldFailureReason:     {Type: field.TypeString, Column: payment.FieldFailureReason},
+			payment.FieldBookingId:         {Type: field.TypeInt, Column: payment.FieldBookingId},
+		},
+	}
+	graph.Nodes[9] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   promocode.Table,
+			Columns: promocode.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: promocode.FieldID,
+			},
+		},
+		Type: "PromoCode",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			promocode.FieldCreatedAt:                 {Type: field.TypeTime, Column: promocode.FieldCreatedAt},
+			promocode.FieldUpdatedAt:                 {Type: field.TypeTime, Column: promocode.FieldUpdatedAt},
+			promocode.FieldCode:                      {Type: field.TypeString, Column: promocode.FieldCode},
+			promocode.FieldDescription:               {Type: field.TypeString, Column: promocode.FieldDescription},
+			promocode.FieldDiscountType:              {Type: field.TypeString, Column: promocode.FieldDiscountType},
+			promocode.FieldDiscountValue:             {Type: field.TypeInt, Column: promocode.FieldDiscountValue},
+			promocode.FieldCurrency:                  {Type: field.TypeString, Column: promocode.FieldCurrency},
+			promocode.FieldValidFrom:                 {Type: field.TypeTime, Column: promocode.FieldValidFrom},
+			promocode.FieldValidUntil:                {Type: field.TypeTime, Column: promocode.FieldValidUntil},
+			promocode.FieldMaxRedemptions:            {Type: field.TypeInt, Column: promocode.FieldMaxRedemptions},
+			promocode.FieldMaxRedemptionsPerCustomer: {Type: field.TypeInt, Column: promocode.FieldMaxRedemptionsPerCustomer},
+			promocode.FieldResourceIds:               {Type: field.TypeJSON, Column: promocode.FieldResourceIds},
+			promocode.FieldOrganizationId:            {Type: field.TypeInt, Column: promocode.FieldOrganizationId},
+		},
+	}
+	graph.Nodes[10] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   promoredemption.Table,
+			Columns: promoredemption.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: promoredemption.FieldID,
+			},
+		},
+		Type: "PromoRedemption",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			promoredemption.FieldCreatedAt:   {Type: field.TypeTime, Column: promoredemption.FieldCreatedAt},
+			promoredemption.FieldUpdatedAt:   {Type: field.TypeTime, Column: promoredemption.FieldUpdatedAt},
+			promoredemption.FieldCustomer:    {Type: field.TypeString, Column: promoredemption.FieldCustomer},
+			promoredemption.FieldDiscount:    {Type: field.TypeInt, Column: promoredemption.FieldDiscount},
+			promoredemption.FieldCurrency:    {Type: field.TypeString, Column: promoredemption.FieldCurrency},
+			promoredemption.FieldPromoCodeId: {Type: field.TypeInt, Column: promoredemption.FieldPromoCodeId},
+			promoredemption.FieldBookingId:   {Type: field.TypeInt, Column: promoredemption.FieldBookingId},
+		},
+	}
+	graph.Nodes[11] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   resource.Table,
 			Columns: resource.Columns,
@@ -124,19 +288,25 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "Resource",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			resource.FieldCreatedAt:         {Type: field.TypeTime, Column: resource.FieldCreatedAt},
-			resource.FieldUpdatedAt:         {Type: field.TypeTime, Column: resource.FieldUpdatedAt},
-			resource.FieldName:              {Type: field.TypeString, Column: resource.FieldName},
-			resource.FieldDescription:       {Type: field.TypeString, Column: resource.FieldDescription},
-			resource.FieldTimezone:          {Type: field.TypeString, Column: resource.FieldTimezone},
-			resource.FieldPassword:          {Type: field.TypeString, Column: resource.FieldPassword},
-			resource.FieldPrice:             {Type: field.TypeInt, Column: resource.FieldPrice},
-			resource.FieldBookingPrice:      {Type: field.TypeInt, Column: resource.FieldBookingPrice},
-			resource.FieldOrganizationId:    {Type: field.TypeInt, Column: resource.FieldOrganizationId},
-			resource.FieldQuantityAvailable: {Type: field.TypeInt, Column: resource.FieldQuantityAvailable},
+			resource.FieldCreatedAt:          {Type: field.TypeTime, Column: resource.FieldCreatedAt},
+			resource.FieldUpdatedAt:          {Type: field.TypeTime, Column: resource.FieldUpdatedAt},
+			resource.FieldName:               {Type: field.TypeString, Column: resource.FieldName},
+			resource.FieldDescription:        {Type: field.TypeString, Column: resource.FieldDescription},
+			resource.FieldTimezone:           {Type: field.TypeString, Column: resource.FieldTimezone},
+			resource.FieldPasswordHash:       {Type: field.TypeString, Column: resource.FieldPasswordHash},
+			resource.FieldPrice:              {Type: field.TypeInt, Column: resource.FieldPrice},
+			resource.FieldBookingPrice:       {Type: field.TypeInt, Column: resource.FieldBookingPrice},
+			resource.FieldCurrency:           {Type: field.TypeString, Column: resource.FieldCurrency},
+			resource.FieldOrganizationId:     {Type: field.TypeInt, Column: resource.FieldOrganizationId},
+			resource.FieldQuantityAvailable:  {Type: field.TypeInt, Column: resource.FieldQuantityAvailable},
+			resource.FieldCancellationPolicy: {Type: field.TypeString, Column: resource.FieldCancellationPolicy},
+			resource.FieldPricing:            {Type: field.TypeString, Column: resource.FieldPricing},
+			resource.FieldBookingFields:      {Type: field.TypeString, Column: resource.FieldBookingFields},
+			resource.FieldFeedTokenHash:      {Type: field.TypeString, Column: resource.FieldFeedTokenHash},
+			resource.FieldTaxRateId:          {Type: field.TypeInt, Column: resource.FieldTaxRateId},
 		},
 	}
-	graph.Nodes[6] = &sqlgraph.Node{
+	graph.Nodes[12] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   slot.Table,
 			Columns: slot.Columns,
@@ -154,7 +324,27 @@ var schemaGraph = func() *sqlgraph.Schema {
 			slot.FieldResourceId: {Type: field.TypeInt, Column: slot.FieldResourceId},
 		},
 	}
-	graph.Nodes[7] = &sqlgraph.Node{
+	graph.Nodes[13] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   taxrate.Table,
+			Columns: taxrate.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: taxrate.FieldID,
+			},
+		},
+		Type: "TaxRate",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			taxrate.FieldCreatedAt:      {Type: field.TypeTime, Column: taxrate.FieldCreatedAt},
+			taxrate.FieldUpdatedAt:      {Type: field.TypeTime, Column: taxrate.FieldUpdatedAt},
+			taxrate.FieldName:           {Type: field.TypeString, Column: taxrate.FieldName},
+			taxrate.FieldRate:           {Type: field.TypeInt, Column: taxrate.FieldRate},
+			taxrate.FieldInclusive:      {Type: field.TypeBool, Column: taxrate.FieldInclusive},
+			taxrate.FieldIsDefault:      {Type: field.TypeBool, Column: taxrate.FieldIsDefault},
+			taxrate.FieldOrganizationId: {Type: field.TypeInt, Column: taxrate.FieldOrganizationId},
+		},
+	}
+	graph.Nodes[14] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   token.Table,
 			Columns: token.Columns,
@@ -168,12 +358,17 @@ var schemaGraph = func() *sqlgraph.Schema {
 			token.FieldCreatedAt:      {Type: field.TypeTime, Column: token.FieldCreatedAt},
 			token.FieldUpdatedAt:      {Type: field.TypeTime, Column: token.FieldUpdatedAt},
 			token.FieldName:           {Type: field.TypeString, Column: token.FieldName},
+			token.FieldSecretHash:     {Type: field.TypeString, Column: token.FieldSecretHash},
+			token.FieldSecretPrefix:   {Type: field.TypeString, Column: token.FieldSecretPrefix},
+			token.FieldScopes:         {Type: field.TypeJSON, Column: token.FieldScopes},
 			token.FieldExpiry:         {Type: field.TypeTime, Column: token.FieldExpiry},
+			token.FieldLastUsedAt:     {Type: field.TypeTime, Column: token.FieldLastUsedAt},
+			token.FieldRevokedAt:      {Type: field.TypeTime, Column: token.FieldRevokedAt},
 			token.FieldUserId:         {Type: field.TypeInt, Column: token.FieldUserId},
 			token.FieldOrganizationId: {Type: field.TypeInt, Column: token.FieldOrganizationId},
 		},
 	}
-	graph.Nodes[8] = &sqlgraph.Node{
+	graph.Nodes[15] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   unavailability.Table,
 			Columns: unavailability.Columns,
@@ -189,9 +384,10 @@ var schemaGraph = func() *sqlgraph.Schema {
 			unavailability.FieldStartTime:  {Type: field.TypeTime, Column: unavailability.FieldStartTime},
 			unavailability.FieldEndTime:    {Type: field.TypeTime, Column: unavailability.FieldEndTime},
 			unavailability.FieldResourceId: {Type: field.TypeInt, Column: unavailability.FieldResourceId},
+			unavailability.FieldExternalId: {Type: field.TypeString, Column: unavailability.FieldExternalId},
 		},
 	}
-	graph.Nodes[9] = &sqlgraph.Node{
+	graph.Nodes[16] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   user.Table,
 			Columns: user.Columns,
@@ -209,6 +405,75 @@ var schemaGraph = func() *sqlgraph.Schema {
 			user.FieldOrganizationId: {Type: field.TypeInt, Column: user.FieldOrganizationId},
 		},
 	}
+	graph.Nodes[17] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   waitlistentry.Table,
+			Columns: waitlistentry.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: waitlistentry.FieldID,
+			},
+		},
+		Type: "WaitlistEntry",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			waitlistentry.FieldCreatedAt:  {Type: field.TypeTime, Column: waitlistentry.FieldCreatedAt},
+			waitlistentry.FieldUpdatedAt:  {Type: field.TypeTime, Column: waitlistentry.FieldUpdatedAt},
+			waitlistentry.FieldStatus:     {Type: field.TypeString, Column: waitlistentry.FieldStatus},
+			waitlistentry.FieldStartTime:  {Type: field.TypeTime, Column: waitlistentry.FieldStartTime},
+			waitlistentry.FieldEndTime:    {Type: field.TypeTime, Column: waitlistentry.FieldEndTime},
+			waitlistentry.FieldPriority:   {Type: field.TypeInt, Column: waitlistentry.FieldPriority},
+			waitlistentry.FieldMetadata:   {Type: field.TypeJSON, Column: waitlistentry.FieldMetadata},
+			waitlistentry.FieldResourceId: {Type: field.TypeInt, Column: waitlistentry.FieldResourceId},
+			waitlistentry.FieldBookingId:  {Type: field.TypeInt, Column: waitlistentry.FieldBookingId},
+			waitlistentry.FieldOfferedAt:  {Type: field.TypeTime, Column: waitlistentry.FieldOfferedAt},
+		},
+	}
+	graph.Nodes[18] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   webhook.Table,
+			Columns: webhook.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhook.FieldID,
+			},
+		},
+		Type: "Webhook",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			webhook.FieldCreatedAt:      {Type: field.TypeTime, Column: webhook.FieldCreatedAt},
+			webhook.FieldUpdatedAt:      {Type: field.TypeTime, Column: webhook.FieldUpdatedAt},
+			webhook.FieldURL:            {Type: field.TypeString, Column: webhook.FieldURL},
+			webhook.FieldDescription:    {Type: field.TypeString, Column: webhook.FieldDescription},
+			webhook.FieldEventTypes:     {Type: field.TypeJSON, Column: webhook.FieldEventTypes},
+			webhook.FieldSecret:         {Type: field.TypeString, Column: webhook.FieldSecret},
+			webhook.FieldEnabled:        {Type: field.TypeBool, Column: webhook.FieldEnabled},
+			webhook.FieldOrganizationId: {Type: field.TypeInt, Column: webhook.FieldOrganizationId},
+		},
+	}
+	graph.Nodes[19] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   webhookdelivery.Table,
+			Columns: webhookdelivery.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhookdelivery.FieldID,
+			},
+		},
+		Type: "WebhookDelivery",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			webhookdelivery.FieldCreatedAt:      {Type: field.TypeTime, Column: webhookdelivery.FieldCreatedAt},
+			webhookdelivery.FieldUpdatedAt:      {Type: field.TypeTime, Column: webhookdelivery.FieldUpdatedAt},
+			webhookdelivery.FieldEventType:      {Type: field.TypeString, Column: webhookdelivery.FieldEventType},
+			webhookdelivery.FieldPayload:        {Type: field.TypeString, Column: webhookdelivery.FieldPayload},
+			webhookdelivery.FieldStatus:         {Type: field.TypeString, Column: webhookdelivery.FieldStatus},
+			webhookdelivery.FieldAttempts:       {Type: field.TypeInt, Column: webhookdelivery.FieldAttempts},
+			webhookdelivery.FieldNextAttemptAt:  {Type: field.TypeTime, Column: webhookdelivery.FieldNextAttemptAt},
+			webhookdelivery.FieldLastAttemptAt:  {Type: field.TypeTime, Column: webhookdelivery.FieldLastAttemptAt},
+			webhookdelivery.FieldResponseStatus: {Type: field.TypeInt, Column: webhookdelivery.FieldResponseStatus},
+			webhookdelivery.FieldLastError:      {Type: field.TypeString, Column: webhookdelivery.FieldLastError},
+			webhookdelivery.FieldWebhookId:      {Type: field.TypeInt, Column: webhookdelivery.FieldWebhookId},
+			webhookdelivery.FieldOrganizationId: {Type: field.TypeInt, Column: webhookdelivery.FieldOrganizationId},
+		},
+	}
 	graph.MustAddE(
 		"user",
 		&sqlgraph.EdgeSpec{
@@ -234,317 +499,1642 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"BookingMetadatum",
 	)
 	graph.MustAddE(
-		"resource",
+		"waitlistEntries",
 		&sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   booking.ResourceTable,
-			Columns: []string{booking.ResourceColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.WaitlistEntriesTable,
+			Columns: []string{booking.WaitlistEntriesColumn},
 			Bidi:    false,
 		},
 		"Booking",
-		"Resource",
+		"WaitlistEntry",
 	)
 	graph.MustAddE(
-		"booking",
+		"payments",
 		&sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   bookingmetadatum.BookingTable,
-			Columns: []string{bookingmetadatum.BookingColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   booking.PaymentsTable,
+			Columns: []string{booking.PaymentsColumn},
 			Bidi:    false,
 		},
-		"BookingMetadatum",
 		"Booking",
+		"Payment",
 	)
 	graph.MustAddE(
-		"users",
+		"promoRedemptions",
 		&sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   organization.UsersTable,
-			Columns: []string{organization.UsersColumn},
+			Table:   booking.PromoRedemptionsTable,
+			Columns: []string{booking.PromoRedemptionsColumn},
 			Bidi:    false,
 		},
-		"Organization",
-		"User",
+		"Booking",
+		"PromoRedemption",
 	)
 	graph.MustAddE(
-		"resources",
+		"invoices",
 		&sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   organization.ResourcesTable,
-			Columns: []string{organization.ResourcesColumn},
+			Table:   booking.InvoicesTable,
+			Columns: []string{booking.InvoicesColumn},
 			Bidi:    false,
 		},
-		"Organization",
-		"Resource",
+		"Booking",
+		"Invoice",
 	)
 	graph.MustAddE(
-		"tokens",
+		"resource",
 		&sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   organization.TokensTable,
-			Columns: []string{organization.TokensColumn},
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booking.ResourceTable,
+			Columns: []string{booking.ResourceColumn},
 			Bidi:    false,
 		},
-		"Organization",
-		"Token",
+		"Booking",
+		"Resource",
 	)
 	graph.MustAddE(
 		"user",
 		&sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: false,
-			Table:   organizationownership.UserTable,
-			Columns: []string{organizationownership.UserColumn},
+			Inverse: true,
+			Table:   booking.UserTable,
+			Columns: []string{booking.UserColumn},
 			Bidi:    false,
 		},
-		"OrganizationOwnership",
+		"Booking",
 		"User",
 	)
 	graph.MustAddE(
-		"organization",
+		"series",
 		&sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: false,
-			Table:   organizationownership.OrganizationTable,
-			Columns: []string{organizationownership.OrganizationColumn},
+			Inverse: true,
+			Table:   booking.SeriesTable,
+			Columns: []string{booking.SeriesColumn},
 			Bidi:    false,
 		},
-		"OrganizationOwnership",
-		"Organization",
+		"Booking",
+		"BookingSeries",
 	)
 	graph.MustAddE(
-		"slots",
+		"customer",
 		&sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   resource.SlotsTable,
-			Columns: []string{resource.SlotsColumn},
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booking.CustomerTable,
+			Columns: []string{booking.CustomerColumn},
 			Bidi:    false,
 		},
-		"Resource",
-		"Slot",
+		"Booking",
+		"Customer",
+	)
+	graph.MustAddE(
+		"booking",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bookingmetadatum.BookingTable,
+			Columns: []string{bookingmetadatum.BookingColumn},
+			Bidi:    false,
+		},
+		"BookingMetadatum",
+		"Booking",
 	)
 	graph.MustAddE(
 		"bookings",
 		&sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   resource.BookingsTable,
-			Columns: []string{resource.BookingsColumn},
+			Table:   bookingseries.BookingsTable,
+			Columns: []string{bookingseries.BookingsColumn},
 			Bidi:    false,
 		},
-		"Resource",
+		"BookingSeries",
 		"Booking",
 	)
 	graph.MustAddE(
-		"unavailabilities",
+		"resource",
 		&sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   resource.UnavailabilitiesTable,
-			Columns: []string{resource.UnavailabilitiesColumn},
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bookingseries.ResourceTable,
+			Columns: []string{bookingseries.ResourceColumn},
 			Bidi:    false,
 		},
+		"BookingSeries",
 		"Resource",
-		"Unavailability",
 	)
 	graph.MustAddE(
 		"organization",
 		&sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
-			Table:   resource.OrganizationTable,
-			Columns: []string{resource.OrganizationColumn},
+			Table:   customer.OrganizationTable,
+			Columns: []string{customer.OrganizationColumn},
 			Bidi:    false,
 		},
-		"Resource",
+		"Customer",
 		"Organization",
 	)
 	graph.MustAddE(
-		"resource",
+		"bookings",
 		&sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   slot.ResourceTable,
-			Columns: []string{slot.ResourceColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customer.BookingsTable,
+			Columns: []string{customer.BookingsColumn},
 			Bidi:    false,
 		},
-		"Slot",
-		"Resource",
+		"Customer",
+		"Booking",
 	)
 	graph.MustAddE(
-		"user",
+		"organization",
 		&sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
-			Table:   token.UserTable,
-			Columns: []string{token.UserColumn},
+			Table:   invoice.OrganizationTable,
+			Columns: []string{invoice.OrganizationColumn},
 			Bidi:    false,
 		},
-		"Token",
-		"User",
+		"Invoice",
+		"Organization",
 	)
 	graph.MustAddE(
-		"organization",
+		"booking",
 		&sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
-			Table:   token.OrganizationTable,
-			Columns: []string{token.OrganizationColumn},
+			Table:   invoice.BookingTable,
+			Columns: []string{invoice.BookingColumn},
 			Bidi:    false,
 		},
-		"Token",
-		"Organization",
+		"Invoice",
+		"Booking",
 	)
 	graph.MustAddE(
-		"resource",
+		"creditedInvoice",
 		&sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
-			Table:   unavailability.ResourceTable,
-			Columns: []string{unavailability.ResourceColumn},
+			Table:   invoice.CreditedInvoiceTable,
+			Columns: []string{invoice.CreditedInvoiceColumn},
 			Bidi:    false,
 		},
-		"Unavailability",
-		"Resource",
+		"Invoice",
+		"Invoice",
 	)
 	graph.MustAddE(
-		"auths",
+		"creditNotes",
 		&sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.AuthsTable,
-			Columns: []string{user.AuthsColumn},
+			Table:   invoice.CreditNotesTable,
+			Columns: []string{invoice.CreditNotesColumn},
+			Bidi:    false,
+		},
+		"Invoice",
+		"Invoice",
+	)
+	graph.MustAddE(
+		"users",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.UsersTable,
+			Columns: []string{organization.UsersColumn},
 			Bidi:    false,
 		},
+		"Organization",
 		"User",
-		"Auth",
+	)
+	graph.MustAddE(
+		"resources",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ResourcesTable,
+			Columns: []string{organization.ResourcesColumn},
+			Bidi:    false,
+		},
+		"Organization",
+		"Resource",
 	)
 	graph.MustAddE(
 		"tokens",
 		&sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.TokensTable,
-			Columns: []string{user.TokensColumn},
+			Table:   organization.TokensTable,
+			Columns: []string{organization.TokensColumn},
 			Bidi:    false,
 		},
-		"User",
+		"Organization",
 		"Token",
 	)
 	graph.MustAddE(
-		"organization",
+		"webhooks",
 		&sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   user.OrganizationTable,
-			Columns: []string{user.OrganizationColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhooksTable,
+			Columns: []string{organization.WebhooksColumn},
 			Bidi:    false,
 		},
-		"User",
 		"Organization",
+		"Webhook",
+	)
+	graph.MustAddE(
+		"promoCodes",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.PromoCodesTable,
+			Columns: []string{organization.PromoCodesColumn},
+			Bidi:    false,
+		},
+		"Organization",
+		"PromoCode",
+	)
+	graph.MustAddE(
+		"invoices",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.InvoicesTable,
+			Columns: []string{organization.InvoicesColumn},
+			Bidi:    false,
+		},
+		"Organization",
+		"Invoice",
+	)
+	graph.MustAddE(
+		"taxRates",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.TaxRatesTable,
+			Columns: []string{organization.TaxRatesColumn},
+			Bidi:    false,
+		},
+		"Organization",
+		"TaxRate",
+	)
+	graph.MustAddE(
+		"customers",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.CustomersTable,
+			Columns: []string{organization.CustomersColumn},
+			Bidi:    false,
+		},
+		"Organization",
+		"Customer",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   organizationownership.UserTable,
+			Columns: []string{organizationownership.UserColumn},
+			Bidi:    false,
+		},
+		"OrganizationOwnership",
+		"User",
+	)
+	graph.MustAddE(
+		"organization",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   organizationownership.OrganizationTable,
+			Columns: []string{organizationownership.OrganizationColumn},
+			Bidi:    false,
+		},
+		"OrganizationOwnership",
+		"Organization",
+	)
+	graph.MustAddE(
+		"booking",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payment.BookingTable,
+			Columns: []string{payment.BookingColumn},
+			Bidi:    false,
+		},
+		"Payment",
+		"Booking",
+	)
+	graph.MustAddE(
+		"organization",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   promocode.OrganizationTable,
+			Columns: []string{promocode.OrganizationColumn},
+			Bidi:    false,
+		},
+		"PromoCode",
+		"Organization",
+	)
+	graph.MustAddE(
+		"redemptions",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   promocode.RedemptionsTable,
+			Columns: []string{promocode.RedemptionsColumn},
+			Bidi:    false,
+		},
+		"PromoCode",
+		"PromoRedemption",
+	)
+	graph.MustAddE(
+		"promoCode",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   promoredemption.PromoCodeTable,
+			Columns: []string{promoredemption.PromoCodeColumn},
+			Bidi:    false,
+		},
+		"PromoRedemption",
+		"PromoCode",
+	)
+	graph.MustAddE(
+		"booking",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   promoredemption.BookingTable,
+			Columns: []string{promoredemption.BookingColumn},
+			Bidi:    false,
+		},
+		"PromoRedemption",
+		"Booking",
+	)
+	graph.MustAddE(
+		"slots",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.SlotsTable,
+			Columns: []string{resource.SlotsColumn},
+			Bidi:    false,
+		},
+		"Resource",
+		"Slot",
+	)
+	graph.MustAddE(
+		"bookings",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.BookingsTable,
+			Columns: []string{resource.BookingsColumn},
+			Bidi:    false,
+		},
+		"Resource",
+		"Booking",
+	)
+	graph.MustAddE(
+		"bookingSeries",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.BookingSeriesTable,
+			Columns: []string{resource.BookingSeriesColumn},
+			Bidi:    false,
+		},
+		"Resource",
+		"BookingSeries",
+	)
+	graph.MustAddE(
+		"unavailabilities",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.UnavailabilitiesTable,
+			Columns: []string{resource.UnavailabilitiesColumn},
+			Bidi:    false,
+		},
+		"Resource",
+		"Unavailability",
+	)
+	graph.MustAddE(
+		"waitlistEntries",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.WaitlistEntriesTable,
+			Columns: []string{resource.WaitlistEntriesColumn},
+			Bidi:    false,
+		},
+		"Resource",
+		"WaitlistEntry",
+	)
+	graph.MustAddE(
+		"organization",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resource.OrganizationTable,
+			Columns: []string{resource.OrganizationColumn},
+			Bidi:    false,
+		},
+		"Resource",
+		"Organization",
+	)
+	graph.MustAddE(
+		"taxRate",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resource.TaxRateTable,
+			Columns: []string{resource.TaxRateColumn},
+			Bidi:    false,
+		},
+		"Resource",
+		"TaxRate",
+	)
+	graph.MustAddE(
+		"resource",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slot.ResourceTable,
+			Columns: []string{slot.ResourceColumn},
+			Bidi:    false,
+		},
+		"Slot",
+		"Resource",
+	)
+	graph.MustAddE(
+		"organization",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taxrate.OrganizationTable,
+			Columns: []string{taxrate.OrganizationColumn},
+			Bidi:    false,
+		},
+		"TaxRate",
+		"Organization",
+	)
+	graph.MustAddE(
+		"resources",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taxrate.ResourcesTable,
+			Columns: []string{taxrate.ResourcesColumn},
+			Bidi:    false,
+		},
+		"TaxRate",
+		"Resource",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   token.UserTable,
+			Columns: []string{token.UserColumn},
+			Bidi:    false,
+		},
+		"Token",
+		"User",
+	)
+	graph.MustAddE(
+		"organization",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   token.OrganizationTable,
+			Columns: []string{token.OrganizationColumn},
+			Bidi:    false,
+		},
+		"Token",
+		"Organization",
+	)
+	graph.MustAddE(
+		"resource",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   unavailability.ResourceTable,
+			Columns: []string{unavailability.ResourceColumn},
+			Bidi:    false,
+		},
+		"Unavailability",
+		"Resource",
+	)
+	graph.MustAddE(
+		"auths",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuthsTable,
+			Columns: []string{user.AuthsColumn},
+			Bidi:    false,
+		},
+		"User",
+		"Auth",
+	)
+	graph.MustAddE(
+		"tokens",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TokensTable,
+			Columns: []string{user.TokensColumn},
+			Bidi:    false,
+		},
+		"User",
+		"Token",
+	)
+	graph.MustAddE(
+		"bookings",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BookingsTable,
+			Columns: []string{user.BookingsColumn},
+			Bidi:    false,
+		},
+		"User",
+		"Booking",
+	)
+	graph.MustAddE(
+		"organization",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.OrganizationTable,
+			Columns: []string{user.OrganizationColumn},
+			Bidi:    false,
+		},
+		"User",
+		"Organization",
+	)
+	graph.MustAddE(
+		"resource",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.ResourceTable,
+			Columns: []string{waitlistentry.ResourceColumn},
+			Bidi:    false,
+		},
+		"WaitlistEntry",
+		"Resource",
+	)
+	graph.MustAddE(
+		"booking",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.BookingTable,
+			Columns: []string{waitlistentry.BookingColumn},
+			Bidi:    false,
+		},
+		"WaitlistEntry",
+		"Booking",
+	)
+	graph.MustAddE(
+		"organization",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhook.OrganizationTable,
+			Columns: []string{webhook.OrganizationColumn},
+			Bidi:    false,
+		},
+		"Webhook",
+		"Organization",
+	)
+	graph.MustAddE(
+		"deliveries",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+		},
+		"Webhook",
+		"WebhookDelivery",
+	)
+	graph.MustAddE(
+		"webhook",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+		},
+		"WebhookDelivery",
+		"Webhook",
 	)
 	return graph
 }()
 
-// predicateAdder wraps the addPredicate method.
-// All update, update-one and query builders implement this interface.
-type predicateAdder interface {
-	addPredicate(func(s *sql.Selector))
+// predicateAdder wraps the addPredicate method.
+// All update, update-one and query builders implement this interface.
+type predicateAdder interface {
+	addPredicate(func(s *sql.Selector))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (aq *AuthQuery) addPredicate(pred func(s *sql.Selector)) {
+	aq.predicates = append(aq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the AuthQuery builder.
+func (aq *AuthQuery) Filter() *AuthFilter {
+	return &AuthFilter{aq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *AuthMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the AuthMutation builder.
+func (m *AuthMutation) Filter() *AuthFilter {
+	return &AuthFilter{m}
+}
+
+// AuthFilter provides a generic filtering capability at runtime for AuthQuery.
+type AuthFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *AuthFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *AuthFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(auth.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the createdAt field.
+func (f *AuthFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(auth.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updatedAt field.
+func (f *AuthFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(auth.FieldUpdatedAt))
+}
+
+// WhereSource applies the entql string predicate on the source field.
+func (f *AuthFilter) WhereSource(p entql.StringP) {
+	f.Where(p.Field(auth.FieldSource))
+}
+
+// WhereSourceId applies the entql string predicate on the sourceId field.
+func (f *AuthFilter) WhereSourceId(p entql.StringP) {
+	f.Where(p.Field(auth.FieldSourceId))
+}
+
+// WhereAccessToken applies the entql string predicate on the accessToken field.
+func (f *AuthFilter) WhereAccessToken(p entql.StringP) {
+	f.Where(p.Field(auth.FieldAccessToken))
+}
+
+// WhereRefreshToken applies the entql string predicate on the refreshToken field.
+func (f *AuthFilter) WhereRefreshToken(p entql.StringP) {
+	f.Where(p.Field(auth.FieldRefreshToken))
+}
+
+// WhereExpiry applies the entql time.Time predicate on the expiry field.
+func (f *AuthFilter) WhereExpiry(p entql.TimeP) {
+	f.Where(p.Field(auth.FieldExpiry))
+}
+
+// WhereUserId applies the entql int predicate on the userId field.
+func (f *AuthFilter) WhereUserId(p entql.IntP) {
+	f.Where(p.Field(auth.FieldUserId))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *AuthFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *AuthFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (bq *BookingQuery) addPredicate(pred func(s *sql.Selector)) {
+	bq.predicates = append(bq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the BookingQuery builder.
+func (bq *BookingQuery) Filter() *BookingFilter {
+	return &BookingFilter{bq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *BookingMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the BookingMutation builder.
+func (m *BookingMutation) Filter() *BookingFilter {
+	return &BookingFilter{m}
+}
+
+// BookingFilter provides a generic filtering capability at runtime for BookingQuery.
+type BookingFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *BookingFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *BookingFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(booking.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the createdAt field.
+func (f *BookingFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(booking.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updatedAt field.
+func (f *BookingFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(booking.FieldUpdatedAt))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *BookingFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(booking.FieldStatus))
+}
+
+// WhereStartTime applies the entql time.Time predicate on the startTime field.
+func (f *BookingFilter) WhereStartTime(p entql.TimeP) {
+	f.Where(p.Field(booking.FieldStartTime))
+}
+
+// WhereEndTime applies the entql time.Time predicate on the endTime field.
+func (f *BookingFilter) WhereEndTime(p entql.TimeP) {
+	f.Where(p.Field(booking.FieldEndTime))
+}
+
+// WhereResourceId applies the entql int predicate on the resourceId field.
+func (f *BookingFilter) WhereResourceId(p entql.IntP) {
+	f.Where(p.Field(booking.FieldResourceId))
+}
+
+// WhereUserId applies the entql int predicate on the userId field.
+func (f *BookingFilter) WhereUserId(p entql.IntP) {
+	f.Where(p.Field(booking.FieldUserId))
+}
+
+// WhereSeriesId applies the entql int predicate on the seriesId field.
+func (f *BookingFilter) WhereSeriesId(p entql.IntP) {
+	f.Where(p.Field(booking.FieldSeriesId))
+}
+
+// WhereCustomerId applies the entql int predicate on the customerId field.
+func (f *BookingFilter) WhereCustomerId(p entql.IntP) {
+	f.Where(p.Field(booking.FieldCustomerId))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expiresAt field.
+func (f *BookingFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(booking.FieldExpiresAt))
+}
+
+// WherePrice applies the entql int predicate on the price field.
+func (f *BookingFilter) WherePrice(p entql.IntP) {
+	f.Where(p.Field(booking.FieldPrice))
+}
+
+// WhereCurrency applies the entql string predicate on the currency field.
+func (f *BookingFilter) WhereCurrency(p entql.StringP) {
+	f.Where(p.Field(booking.FieldCurrency))
+}
+
+// WhereCancelledAt applies the entql time.Time predicate on the cancelledAt field.
+func (f *BookingFilter) WhereCancelledAt(p entql.TimeP) {
+	f.Where(p.Field(booking.FieldCancelledAt))
+}
+
+// WhereRefundAmount applies the entql int predicate on the refundAmount field.
+func (f *BookingFilter) WhereRefundAmount(p entql.IntP) {
+	f.Where(p.Field(booking.FieldRefundAmount))
+}
+
+// WhereFeeAmount applies the entql int predicate on the feeAmount field.
+func (f *BookingFilter) WhereFeeAmount(p entql.IntP) {
+	f.Where(p.Field(booking.FieldFeeAmount))
+}
+
+// WhereTaxName applies the entql string predicate on the taxName field.
+func (f *BookingFilter) WhereTaxName(p entql.StringP) {
+	f.Where(p.Field(booking.FieldTaxName))
+}
+
+// WhereTaxRate applies the entql int predicate on the taxRate field.
+func (f *BookingFilter) WhereTaxRate(p entql.IntP) {
+	f.Where(p.Field(booking.FieldTaxRate))
+}
+
+// WhereTaxInclusive applies the entql bool predicate on the taxInclusive field.
+func (f *BookingFilter) WhereTaxInclusive(p entql.BoolP) {
+	f.Where(p.Field(booking.FieldTaxInclusive))
+}
+
+// WhereTaxAmount applies the entql int predicate on the taxAmount field.
+func (f *BookingFilter) WhereTaxAmount(p entql.IntP) {
+	f.Where(p.Field(booking.FieldTaxAmount))
+}
+
+// WhereHasMetadata applies a predicate to check if query has an edge metadata.
+func (f *BookingFilter) WhereHasMetadata() {
+	f.Where(entql.HasEdge("metadata"))
+}
+
+// WhereHasMetadataWith applies a predicate to check if query has an edge metadata with a given conditions (other predicates).
+func (f *BookingFilter) WhereHasMetadataWith(preds ...predicate.BookingMetadatum) {
+	f.Where(entql.HasEdgeWith("metadata", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasWaitlistEntries applies a predicate to check if query has an edge waitlistEntries.
+func (f *BookingFilter) WhereHasWaitlistEntries() {
+	f.Where(entql.HasEdge("waitlistEntries"))
+}
+
+// WhereHasWaitlistEntriesWith applies a predicate to check if query has an edge waitlistEntries with a given conditions (other predicates).
+func (f *BookingFilter) WhereHasWaitlistEntriesWith(preds ...predicate.WaitlistEntry) {
+	f.Where(entql.HasEdgeWith("waitlistEntries", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasPayments applies a predicate to check if query has an edge payments.
+func (f *BookingFilter) WhereHasPayments() {
+	f.Where(entql.HasEdge("payments"))
+}
+
+// WhereHasPaymentsWith applies a predicate to check if query has an edge payments with a given conditions (other predicates).
+func (f *BookingFilter) WhereHasPaymentsWith(preds ...predicate.Payment) {
+	f.Where(entql.HasEdgeWith("payments", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasPromoRedemptions applies a predicate to check if query has an edge promoRedemptions.
+func (f *BookingFilter) WhereHasPromoRedemptions() {
+	f.Where(entql.HasEdge("promoRedemptions"))
+}
+
+// WhereHasPromoRedemptionsWith applies a predicate to check if query has an edge promoRedemptions with a given conditions (other predicates).
+func (f *BookingFilter) WhereHasPromoRedemptionsWith(preds ...predicate.PromoRedemption) {
+	f.Where(entql.HasEdgeWith("promoRedemptions", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasInvoices applies a predicate to check if query has an edge invoices.
+func (f *BookingFilter) WhereHasInvoices() {
+	f.Where(entql.HasEdge("invoices"))
+}
+
+// WhereHasInvoicesWith applies a predicate to check if query has an edge invoices with a given conditions (other predicates).
+func (f *BookingFilter) WhereHasInvoicesWith(preds ...predicate.Invoice) {
+	f.Where(entql.HasEdgeWith("invoices", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasResource applies a predicate to check if query has an edge resource.
+func (f *BookingFilter) WhereHasResource() {
+	f.Where(entql.HasEdge("resource"))
+}
+
+// WhereHasResourceWith applies a predicate to check if query has an edge resource with a given conditions (other predicates).
+func (f *BookingFilter) WhereHasResourceWith(preds ...predicate.Resource) {
+	f.Where(entql.HasEdgeWith("resource", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *BookingFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *BookingFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasSeries applies a predicate to check if query has an edge series.
+func (f *BookingFilter) WhereHasSeries() {
+	f.Where(entql.HasEdge("series"))
+}
+
+// WhereHasSeriesWith applies a predicate to check if query has an edge series with a given conditions (other predicates).
+func (f *BookingFilter) WhereHasSeriesWith(preds ...predicate.BookingSeries) {
+	f.Where(entql.HasEdgeWith("series", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasCustomer applies a predicate to check if query has an edge customer.
+func (f *BookingFilter) WhereHasCustomer() {
+	f.Where(entql.HasEdge("customer"))
+}
+
+// WhereHasCustomerWith applies a predicate to check if query has an edge customer with a given conditions (other predicates).
+func (f *BookingFilter) WhereHasCustomerWith(preds ...predicate.Customer) {
+	f.Where(entql.HasEdgeWith("customer", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (bmq *BookingMetadatumQuery) addPredicate(pred func(s *sql.Selector)) {
+	bmq.predicates = append(bmq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the BookingMetadatumQuery builder.
+func (bmq *BookingMetadatumQuery) Filter() *BookingMetadatumFilter {
+	return &BookingMetadatumFilter{bmq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *BookingMetadatumMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the BookingMetadatumMutation builder.
+func (m *BookingMetadatumMutation) Filter() *BookingMetadatumFilter {
+	return &BookingMetadatumFilter{m}
+}
+
+// BookingMetadatumFilter provides a generic filtering capability at runtime for BookingMetadatumQuery.
+type BookingMetadatumFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *BookingMetadatumFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *BookingMetadatumFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(bookingmetadatum.FieldID))
+}
+
+// WhereKey applies the entql string predicate on the key field.
+func (f *BookingMetadatumFilter) WhereKey(p entql.StringP) {
+	f.Where(p.Field(bookingmetadatum.FieldKey))
+}
+
+// WhereValue applies the entql string predicate on the value field.
+func (f *BookingMetadatumFilter) WhereValue(p entql.StringP) {
+	f.Where(p.Field(bookingmetadatum.FieldValue))
+}
+
+// WhereBookingId applies the entql int predicate on the bookingId field.
+func (f *BookingMetadatumFilter) WhereBookingId(p entql.IntP) {
+	f.Where(p.Field(bookingmetadatum.FieldBookingId))
+}
+
+// WhereHasBooking applies a predicate to check if query has an edge booking.
+func (f *BookingMetadatumFilter) WhereHasBooking() {
+	f.Where(entql.HasEdge("booking"))
+}
+
+// WhereHasBookingWith applies a predicate to check if query has an edge booking with a given conditions (other predicates).
+func (f *BookingMetadatumFilter) WhereHasBookingWith(preds ...predicate.Booking) {
+	f.Where(entql.HasEdgeWith("booking", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (aq *AuthQuery) addPredicate(pred func(s *sql.Selector)) {
-	aq.predicates = append(aq.predicates, pred)
+func (bsq *BookingSeriesQuery) addPredicate(pred func(s *sql.Selector)) {
+	bsq.predicates = append(bsq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the BookingSeriesQuery builder.
+func (bsq *BookingSeriesQuery) Filter() *BookingSeriesFilter {
+	return &BookingSeriesFilter{bsq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *BookingSeriesMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the BookingSeriesMutation builder.
+func (m *BookingSeriesMutation) Filter() *BookingSeriesFilter {
+	return &BookingSeriesFilter{m}
+}
+
+// BookingSeriesFilter provides a generic filtering capability at runtime for BookingSeriesQuery.
+type BookingSeriesFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *BookingSeriesFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *BookingSeriesFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(bookingseries.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the createdAt field.
+func (f *BookingSeriesFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(bookingseries.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updatedAt field.
+func (f *BookingSeriesFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(bookingseries.FieldUpdatedAt))
+}
+
+// WhereRrule applies the entql string predicate on the rrule field.
+func (f *BookingSeriesFilter) WhereRrule(p entql.StringP) {
+	f.Where(p.Field(bookingseries.FieldRrule))
+}
+
+// WhereExdates applies the entql json.RawMessage predicate on the exdates field.
+func (f *BookingSeriesFilter) WhereExdates(p entql.BytesP) {
+	f.Where(p.Field(bookingseries.FieldExdates))
+}
+
+// WhereStartTime applies the entql time.Time predicate on the startTime field.
+func (f *BookingSeriesFilter) WhereStartTime(p entql.TimeP) {
+	f.Where(p.Field(bookingseries.FieldStartTime))
+}
+
+// WhereEndTime applies the entql time.Time predicate on the endTime field.
+func (f *BookingSeriesFilter) WhereEndTime(p entql.TimeP) {
+	f.Where(p.Field(bookingseries.FieldEndTime))
+}
+
+// WhereResourceId applies the entql int predicate on the resourceId field.
+func (f *BookingSeriesFilter) WhereResourceId(p entql.IntP) {
+	f.Where(p.Field(bookingseries.FieldResourceId))
+}
+
+// WhereHasBookings applies a predicate to check if query has an edge bookings.
+func (f *BookingSeriesFilter) WhereHasBookings() {
+	f.Where(entql.HasEdge("bookings"))
+}
+
+// WhereHasBookingsWith applies a predicate to check if query has an edge bookings with a given conditions (other predicates).
+func (f *BookingSeriesFilter) WhereHasBookingsWith(preds ...predicate.Booking) {
+	f.Where(entql.HasEdgeWith("bookings", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasResource applies a predicate to check if query has an edge resource.
+func (f *BookingSeriesFilter) WhereHasResource() {
+	f.Where(entql.HasEdge("resource"))
+}
+
+// WhereHasResourceWith applies a predicate to check if query has an edge resource with a given conditions (other predicates).
+func (f *BookingSeriesFilter) WhereHasResourceWith(preds ...predicate.Resource) {
+	f.Where(entql.HasEdgeWith("resource", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (cq *CustomerQuery) addPredicate(pred func(s *sql.Selector)) {
+	cq.predicates = append(cq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the CustomerQuery builder.
+func (cq *CustomerQuery) Filter() *CustomerFilter {
+	return &CustomerFilter{cq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *CustomerMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the CustomerMutation builder.
+func (m *CustomerMutation) Filter() *CustomerFilter {
+	return &CustomerFilter{m}
+}
+
+// CustomerFilter provides a generic filtering capability at runtime for CustomerQuery.
+type CustomerFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *CustomerFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *CustomerFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(customer.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the createdAt field.
+func (f *CustomerFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(customer.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updatedAt field.
+func (f *CustomerFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(customer.FieldUpdatedAt))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *CustomerFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(customer.FieldName))
+}
+
+// WhereEmail applies the entql string predicate on the email field.
+func (f *CustomerFilter) WhereEmail(p entql.StringP) {
+	f.Where(p.Field(customer.FieldEmail))
+}
+
+// WherePhone applies the entql string predicate on the phone field.
+func (f *CustomerFilter) WherePhone(p entql.StringP) {
+	f.Where(p.Field(customer.FieldPhone))
+}
+
+// WhereNotes applies the entql string predicate on the notes field.
+func (f *CustomerFilter) WhereNotes(p entql.StringP) {
+	f.Where(p.Field(customer.FieldNotes))
+}
+
+// WhereTags applies the entql json.RawMessage predicate on the tags field.
+func (f *CustomerFilter) WhereTags(p entql.BytesP) {
+	f.Where(p.Field(customer.FieldTags))
+}
+
+// WhereOrganizationId applies the entql int predicate on the organizationId field.
+func (f *CustomerFilter) WhereOrganizationId(p entql.IntP) {
+	f.Where(p.Field(customer.FieldOrganizationId))
+}
+
+// WhereHasOrganization applies a predicate to check if query has an edge organization.
+func (f *CustomerFilter) WhereHasOrganization() {
+	f.Where(entql.HasEdge("organization"))
+}
+
+// WhereHasOrganizationWith applies a predicate to check if query has an edge organization with a given conditions (other predicates).
+func (f *CustomerFilter) WhereHasOrganizationWith(preds ...predicate.Organization) {
+	f.Where(entql.HasEdgeWith("organization", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasBookings applies a predicate to check if query has an edge bookings.
+func (f *CustomerFilter) WhereHasBookings() {
+	f.Where(entql.HasEdge("bookings"))
+}
+
+// WhereHasBookingsWith applies a predicate to check if query has an edge bookings with a given conditions (other predicates).
+func (f *CustomerFilter) WhereHasBookingsWith(preds ...predicate.Booking) {
+	f.Where(entql.HasEdgeWith("bookings", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (iq *InvoiceQuery) addPredicate(pred func(s *sql.Selector)) {
+	iq.predicates = append(iq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the InvoiceQuery builder.
+func (iq *InvoiceQuery) Filter() *InvoiceFilter {
+	return &InvoiceFilter{iq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *InvoiceMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the InvoiceMutation builder.
+func (m *InvoiceMutation) Filter() *InvoiceFilter {
+	return &InvoiceFilter{m}
+}
+
+// InvoiceFilter provides a generic filtering capability at runtime for InvoiceQuery.
+type InvoiceFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *InvoiceFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *InvoiceFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(invoice.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the createdAt field.
+func (f *InvoiceFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(invoice.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updatedAt field.
+func (f *InvoiceFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(invoice.FieldUpdatedAt))
+}
+
+// WhereKind applies the entql string predicate on the kind field.
+func (f *InvoiceFilter) WhereKind(p entql.StringP) {
+	f.Where(p.Field(invoice.FieldKind))
+}
+
+// WhereNumber applies the entql int predicate on the number field.
+func (f *InvoiceFilter) WhereNumber(p entql.IntP) {
+	f.Where(p.Field(invoice.FieldNumber))
+}
+
+// WhereIssuerName applies the entql string predicate on the issuerName field.
+func (f *InvoiceFilter) WhereIssuerName(p entql.StringP) {
+	f.Where(p.Field(invoice.FieldIssuerName))
+}
+
+// WhereCurrency applies the entql string predicate on the currency field.
+func (f *InvoiceFilter) WhereCurrency(p entql.StringP) {
+	f.Where(p.Field(invoice.FieldCurrency))
+}
+
+// WhereLines applies the entql string predicate on the lines field.
+func (f *InvoiceFilter) WhereLines(p entql.StringP) {
+	f.Where(p.Field(invoice.FieldLines))
+}
+
+// WhereTaxLines applies the entql string predicate on the taxLines field.
+func (f *InvoiceFilter) WhereTaxLines(p entql.StringP) {
+	f.Where(p.Field(invoice.FieldTaxLines))
+}
+
+// WhereSubtotal applies the entql int predicate on the subtotal field.
+func (f *InvoiceFilter) WhereSubtotal(p entql.IntP) {
+	f.Where(p.Field(invoice.FieldSubtotal))
+}
+
+// WhereTax applies the entql int predicate on the tax field.
+func (f *InvoiceFilter) WhereTax(p entql.IntP) {
+	f.Where(p.Field(invoice.FieldTax))
+}
+
+// WhereTaxInclusive applies the entql bool predicate on the taxInclusive field.
+func (f *InvoiceFilter) WhereTaxInclusive(p entql.BoolP) {
+	f.Where(p.Field(invoice.FieldTaxInclusive))
+}
+
+// WhereTotal applies the entql int predicate on the total field.
+func (f *InvoiceFilter) WhereTotal(p entql.IntP) {
+	f.Where(p.Field(invoice.FieldTotal))
+}
+
+// WhereAmountPaid applies the entql int predicate on the amountPaid field.
+func (f *InvoiceFilter) WhereAmountPaid(p entql.IntP) {
+	f.Where(p.Field(invoice.FieldAmountPaid))
+}
+
+// WhereBookingId applies the entql int predicate on the bookingId field.
+func (f *InvoiceFilter) WhereBookingId(p entql.IntP) {
+	f.Where(p.Field(invoice.FieldBookingId))
+}
+
+// WhereCreditedInvoiceId applies the entql int predicate on the creditedInvoiceId field.
+func (f *InvoiceFilter) WhereCreditedInvoiceId(p entql.IntP) {
+	f.Where(p.Field(invoice.FieldCreditedInvoiceId))
+}
+
+// WhereOrganizationId applies the entql int predicate on the organizationId field.
+func (f *InvoiceFilter) WhereOrganizationId(p entql.IntP) {
+	f.Where(p.Field(invoice.FieldOrganizationId))
+}
+
+// WhereHasOrganization applies a predicate to check if query has an edge organization.
+func (f *InvoiceFilter) WhereHasOrganization() {
+	f.Where(entql.HasEdge("organization"))
+}
+
+// WhereHasOrganizationWith applies a predicate to check if query has an edge organization with a given conditions (other predicates).
+func (f *InvoiceFilter) WhereHasOrganizationWith(preds ...predicate.Organization) {
+	f.Where(entql.HasEdgeWith("organization", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasBooking applies a predicate to check if query has an edge booking.
+func (f *InvoiceFilter) WhereHasBooking() {
+	f.Where(entql.HasEdge("booking"))
+}
+
+// WhereHasBookingWith applies a predicate to check if query has an edge booking with a given conditions (other predicates).
+func (f *InvoiceFilter) WhereHasBookingWith(preds ...predicate.Booking) {
+	f.Where(entql.HasEdgeWith("booking", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasCreditedInvoice applies a predicate to check if query has an edge creditedInvoice.
+func (f *InvoiceFilter) WhereHasCreditedInvoice() {
+	f.Where(entql.HasEdge("creditedInvoice"))
+}
+
+// WhereHasCreditedInvoiceWith applies a predicate to check if query has an edge creditedInvoice with a given conditions (other predicates).
+func (f *InvoiceFilter) WhereHasCreditedInvoiceWith(preds ...predicate.Invoice) {
+	f.Where(entql.HasEdgeWith("creditedInvoice", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasCreditNotes applies a predicate to check if query has an edge creditNotes.
+func (f *InvoiceFilter) WhereHasCreditNotes() {
+	f.Where(entql.HasEdge("creditNotes"))
+}
+
+// WhereHasCreditNotesWith applies a predicate to check if query has an edge creditNotes with a given conditions (other predicates).
+func (f *InvoiceFilter) WhereHasCreditNotesWith(preds ...predicate.Invoice) {
+	f.Where(entql.HasEdgeWith("creditNotes", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (oq *OrganizationQuery) addPredicate(pred func(s *sql.Selector)) {
+	oq.predicates = append(oq.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the AuthQuery builder.
-func (aq *AuthQuery) Filter() *AuthFilter {
-	return &AuthFilter{aq}
+// Filter returns a Filter implementation to apply filters on the OrganizationQuery builder.
+func (oq *OrganizationQuery) Filter() *OrganizationFilter {
+	return &OrganizationFilter{oq}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *AuthMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *OrganizationMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the AuthMutation builder.
-func (m *AuthMutation) Filter() *AuthFilter {
-	return &AuthFilter{m}
+// Filter returns an entql.Where implementation to apply filters on the OrganizationMutation builder.
+func (m *OrganizationMutation) Filter() *OrganizationFilter {
+	return &OrganizationFilter{m}
 }
 
-// AuthFilter provides a generic filtering capability at runtime for AuthQuery.
-type AuthFilter struct {
+// OrganizationFilter provides a generic filtering capability at runtime for OrganizationQuery.
+type OrganizationFilter struct {
 	predicateAdder
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *AuthFilter) Where(p entql.P) {
+func (f *OrganizationFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql int predicate on the id field.
-func (f *AuthFilter) WhereID(p entql.IntP) {
-	f.Where(p.Field(auth.FieldID))
+func (f *OrganizationFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(organization.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the createdAt field.
-func (f *AuthFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(auth.FieldCreatedAt))
+func (f *OrganizationFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(organization.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updatedAt field.
-func (f *AuthFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(auth.FieldUpdatedAt))
+func (f *OrganizationFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(organization.FieldUpdatedAt))
 }
 
-// WhereSource applies the entql string predicate on the source field.
-func (f *AuthFilter) WhereSource(p entql.StringP) {
-	f.Where(p.Field(auth.FieldSource))
+// WhereName applies the entql string predicate on the name field.
+func (f *OrganizationFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(organization.FieldName))
 }
 
-// WhereSourceId applies the entql string predicate on the sourceId field.
-func (f *AuthFilter) WhereSourceId(p entql.StringP) {
-	f.Where(p.Field(auth.FieldSourceId))
+// WherePublicKey applies the entql string predicate on the publicKey field.
+func (f *OrganizationFilter) WherePublicKey(p entql.StringP) {
+	f.Where(p.Field(organization.FieldPublicKey))
 }
 
-// WhereAccessToken applies the entql string predicate on the accessToken field.
-func (f *AuthFilter) WhereAccessToken(p entql.StringP) {
-	f.Where(p.Field(auth.FieldAccessToken))
+// WherePrivateKey applies the entql string predicate on the privateKey field.
+func (f *OrganizationFilter) WherePrivateKey(p entql.StringP) {
+	f.Where(p.Field(organization.FieldPrivateKey))
 }
 
-// WhereRefreshToken applies the entql string predicate on the refreshToken field.
-func (f *AuthFilter) WhereRefreshToken(p entql.StringP) {
-	f.Where(p.Field(auth.FieldRefreshToken))
+// WhereCurrency applies the entql string predicate on the currency field.
+func (f *OrganizationFilter) WhereCurrency(p entql.StringP) {
+	f.Where(p.Field(organization.FieldCurrency))
 }
 
-// WhereExpiry applies the entql time.Time predicate on the expiry field.
-func (f *AuthFilter) WhereExpiry(p entql.TimeP) {
-	f.Where(p.Field(auth.FieldExpiry))
+// WhereHasUsers applies a predicate to check if query has an edge users.
+func (f *OrganizationFilter) WhereHasUsers() {
+	f.Where(entql.HasEdge("users"))
 }
 
-// WhereUserId applies the entql int predicate on the userId field.
-func (f *AuthFilter) WhereUserId(p entql.IntP) {
-	f.Where(p.Field(auth.FieldUserId))
+// WhereHasUsersWith applies a predicate to check if query has an edge users with a given conditions (other predicates).
+func (f *OrganizationFilter) WhereHasUsersWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("users", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
 }
 
-// WhereHasUser applies a predicate to check if query has an edge user.
-func (f *AuthFilter) WhereHasUser() {
-	f.Where(entql.HasEdge("user"))
+// WhereHasResources applies a predicate to check if query has an edge resources.
+func (f *OrganizationFilter) WhereHasResources() {
+	f.Where(entql.HasEdge("resources"))
 }
 
-// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
-func (f *AuthFilter) WhereHasUserWith(preds ...predicate.User) {
-	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+// WhereHasResourcesWith applies a predicate to check if query has an edge resources with a given conditions (other predicates).
+func (f *OrganizationFilter) WhereHasResourcesWith(preds ...predicate.Resource) {
+	f.Where(entql.HasEdgeWith("resources", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTokens applies a predicate to check if query has an edge tokens.
+func (f *OrganizationFilter) WhereHasTokens() {
+	f.Where(entql.HasEdge("tokens"))
+}
+
+// WhereHasTokensWith applies a predicate to check if query has an edge tokens with a given conditions (other predicates).
+func (f *OrganizationFilter) WhereHasTokensWith(preds ...predicate.Token) {
+	f.Where(entql.HasEdgeWith("tokens", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasWebhooks applies a predicate to check if query has an edge webhooks.
+func (f *OrganizationFilter) WhereHasWebhooks() {
+	f.Where(entql.HasEdge("webhooks"))
+}
+
+// WhereHasWebhooksWith applies a predicate to check if query has an edge webhooks with a given conditions (other predicates).
+func (f *OrganizationFilter) WhereHasWebhooksWith(preds ...predicate.Webhook) {
+	f.Where(entql.HasEdgeWith("webhooks", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasPromoCodes applies a predicate to check if query has an edge promoCodes.
+func (f *OrganizationFilter) WhereHasPromoCodes() {
+	f.Where(entql.HasEdge("promoCodes"))
+}
+
+// WhereHasPromoCodesWith applies a predicate to check if query has an edge promoCodes with a given conditions (other predicates).
+func (f *OrganizationFilter) WhereHasPromoCodesWith(preds ...predicate.PromoCode) {
+	f.Where(entql.HasEdgeWith("promoCodes", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasInvoices applies a predicate to check if query has an edge invoices.
+func (f *OrganizationFilter) WhereHasInvoices() {
+	f.Where(entql.HasEdge("invoices"))
+}
+
+// WhereHasInvoicesWith applies a predicate to check if query has an edge invoices with a given conditions (other predicates).
+func (f *OrganizationFilter) WhereHasInvoicesWith(preds ...predicate.Invoice) {
+	f.Where(entql.HasEdgeWith("invoices", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTaxRates applies a predicate to check if query has an edge taxRates.
+func (f *OrganizationFilter) WhereHasTaxRates() {
+	f.Where(entql.HasEdge("taxRates"))
+}
+
+// WhereHasTaxRatesWith applies a predicate to check if query has an edge taxRates with a given conditions (other predicates).
+func (f *OrganizationFilter) WhereHasTaxRatesWith(preds ...predicate.TaxRate) {
+	f.Where(entql.HasEdgeWith("taxRates", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasCustomers applies a predicate to check if query has an edge customers.
+func (f *OrganizationFilter) WhereHasCustomers() {
+	f.Where(entql.HasEdge("customers"))
+}
+
+// WhereHasCustomersWith applies a predicate to check if query has an edge customers with a given conditions (other predicates).
+func (f *OrganizationFilter) WhereHasCustomersWith(preds ...predicate.Customer) {
+	f.Where(entql.HasEdgeWith("customers", sqlgraph.WrapFunc(func(s *sql.Selector) {
 		for _, p := range preds {
 			p(s)
 		}
@@ -552,96 +2142,76 @@ func (f *AuthFilter) WhereHasUserWith(preds ...predicate.User) {
 }
 
 // addPredicate implements the predicateAdder interface.
-func (bq *BookingQuery) addPredicate(pred func(s *sql.Selector)) {
-	bq.predicates = append(bq.predicates, pred)
+func (ooq *OrganizationOwnershipQuery) addPredicate(pred func(s *sql.Selector)) {
+	ooq.predicates = append(ooq.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the BookingQuery builder.
-func (bq *BookingQuery) Filter() *BookingFilter {
-	return &BookingFilter{bq}
+// Filter returns a Filter implementation to apply filters on the OrganizationOwnershipQuery builder.
+func (ooq *OrganizationOwnershipQuery) Filter() *OrganizationOwnershipFilter {
+	return &OrganizationOwnershipFilter{ooq}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *BookingMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *OrganizationOwnershipMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the BookingMutation builder.
-func (m *BookingMutation) Filter() *BookingFilter {
-	return &BookingFilter{m}
+// Filter returns an entql.Where implementation to apply filters on the OrganizationOwnershipMutation builder.
+func (m *OrganizationOwnershipMutation) Filter() *OrganizationOwnershipFilter {
+	return &OrganizationOwnershipFilter{m}
 }
 
-// BookingFilter provides a generic filtering capability at runtime for BookingQuery.
-type BookingFilter struct {
+// OrganizationOwnershipFilter provides a generic filtering capability at runtime for OrganizationOwnershipQuery.
+type OrganizationOwnershipFilter struct {
 	predicateAdder
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *BookingFilter) Where(p entql.P) {
+func (f *OrganizationOwnershipFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql int predicate on the id field.
-func (f *BookingFilter) WhereID(p entql.IntP) {
-	f.Where(p.Field(booking.FieldID))
-}
-
-// WhereCreatedAt applies the entql time.Time predicate on the createdAt field.
-func (f *BookingFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(booking.FieldCreatedAt))
-}
-
-// WhereUpdatedAt applies the entql time.Time predicate on the updatedAt field.
-func (f *BookingFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(booking.FieldUpdatedAt))
-}
-
-// WhereStatus applies the entql string predicate on the status field.
-func (f *BookingFilter) WhereStatus(p entql.StringP) {
-	f.Where(p.Field(booking.FieldStatus))
-}
-
-// WhereStartTime applies the entql time.Time predicate on the startTime field.
-func (f *BookingFilter) WhereStartTime(p entql.TimeP) {
-	f.Where(p.Field(booking.FieldStartTime))
+func (f *OrganizationOwnershipFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(organizationownership.FieldID))
 }
 
-// WhereEndTime applies the entql time.Time predicate on the endTime field.
-func (f *BookingFilter) WhereEndTime(p entql.TimeP) {
-	f.Where(p.Field(booking.FieldEndTime))
+// WhereUserId applies the entql int predicate on the userId field.
+func (f *OrganizationOwnershipFilter) WhereUserId(p entql.IntP) {
+	f.Where(p.Field(organizationownership.FieldUserId))
 }
 
-// WhereResourceId applies the entql int predicate on the resourceId field.
-func (f *BookingFilter) WhereResourceId(p entql.IntP) {
-	f.Where(p.Field(booking.FieldResourceId))
+// WhereOrganizationId applies the entql int predicate on the organizationId field.
+func (f *OrganizationOwnershipFilter) WhereOrganizationId(p entql.IntP) {
+	f.Where(p.Field(organizationownership.FieldOrganizationId))
 }
 
-// WhereHasMetadata applies a predicate to check if query has an edge metadata.
-func (f *BookingFilter) WhereHasMetadata() {
-	f.Where(entql.HasEdge("metadata"))
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *OrganizationOwnershipFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
 }
 
-// WhereHasMetadataWith applies a predicate to check if query has an edge metadata with a given conditions (other predicates).
-func (f *BookingFilter) WhereHasMetadataWith(preds ...predicate.BookingMetadatum) {
-	f.Where(entql.HasEdgeWith("metadata", sqlgraph.WrapFunc(func(s *sql.Selector) {
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *OrganizationOwnershipFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
 		for _, p := range preds {
 			p(s)
 		}
 	})))
 }
 
-// WhereHasResource applies a predicate to check if query has an edge resource.
-func (f *BookingFilter) WhereHasResource() {
-	f.Where(entql.HasEdge("resource"))
+// WhereHasOrganization applies a predicate to check if query has an edge organization.
+func (f *OrganizationOwnershipFilter) WhereHasOrganization() {
+	f.Where(entql.HasEdge("organization"))
 }
 
-// WhereHasResourceWith applies a predicate to check if query has an edge resource with a given conditions (other predicates).
-func (f *BookingFilter) WhereHasResourceWith(preds ...predicate.Resource) {
-	f.Where(entql.HasEdgeWith("resource", sqlgraph.WrapFunc(func(s *sql.Selector) {
+// WhereHasOrganizationWith applies a predicate to check if query has an edge organization with a given conditions (other predicates).
+func (f *OrganizationOwnershipFilter) WhereHasOrganizationWith(preds ...predicate.Organization) {
+	f.Where(entql.HasEdgeWith("organization", sqlgraph.WrapFunc(func(s *sql.Selector) {
 		for _, p := range preds {
 			p(s)
 		}
@@ -649,66 +2219,106 @@ func (f *BookingFilter) WhereHasResourceWith(preds ...predicate.Resource) {
 }
 
 // addPredicate implements the predicateAdder interface.
-func (bmq *BookingMetadatumQuery) addPredicate(pred func(s *sql.Selector)) {
-	bmq.predicates = append(bmq.predicates, pred)
+func (pq *PaymentQuery) addPredicate(pred func(s *sql.Selector)) {
+	pq.predicates = append(pq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the PaymentQuery builder.
+func (pq *PaymentQuery) Filter() *PaymentFilter {
+	return &PaymentFilter{pq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *PaymentMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the PaymentMutation builder.
+func (m *PaymentMutation) Filter() *PaymentFilter {
+	return &PaymentFilter{m}
+}
+
+// PaymentFilter provides a generic filtering capability at runtime for PaymentQuery.
+type PaymentFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *PaymentFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *PaymentFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(payment.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the createdAt field.
+func (f *PaymentFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(payment.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updatedAt field.
+func (f *PaymentFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(payment.FieldUpdatedAt))
 }
 
-// Filter returns a Filter implementation to apply filters on the BookingMetadatumQuery builder.
-func (bmq *BookingMetadatumQuery) Filter() *BookingMetadatumFilter {
-	return &BookingMetadatumFilter{bmq}
+// WhereProvider applies the entql string predicate on the provider field.
+func (f *PaymentFilter) WhereProvider(p entql.StringP) {
+	f.Where(p.Field(payment.FieldProvider))
 }
 
-// addPredicate implements the predicateAdder interface.
-func (m *BookingMetadatumMutation) addPredicate(pred func(s *sql.Selector)) {
-	m.predicates = append(m.predicates, pred)
+// WhereProviderPaymentId applies the entql string predicate on the providerPaymentId field.
+func (f *PaymentFilter) WhereProviderPaymentId(p entql.StringP) {
+	f.Where(p.Field(payment.FieldProviderPaymentId))
 }
 
-// Filter returns an entql.Where implementation to apply filters on the BookingMetadatumMutation builder.
-func (m *BookingMetadatumMutation) Filter() *BookingMetadatumFilter {
-	return &BookingMetadatumFilter{m}
+// WhereStatus applies the entql string predicate on the status field.
+func (f *PaymentFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(payment.FieldStatus))
 }
 
-// BookingMetadatumFilter provides a generic filtering capability at runtime for BookingMetadatumQuery.
-type BookingMetadatumFilter struct {
-	predicateAdder
+// WhereAmount applies the entql int predicate on the amount field.
+func (f *PaymentFilter) WhereAmount(p entql.IntP) {
+	f.Where(p.Field(payment.FieldAmount))
 }
 
-// Where applies the entql predicate on the query filter.
-func (f *BookingMetadatumFilter) Where(p entql.P) {
-	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
-			s.AddError(err)
-		}
-	})
+// WhereAmountRefunded applies the entql int predicate on the amountRefunded field.
+func (f *PaymentFilter) WhereAmountRefunded(p entql.IntP) {
+	f.Where(p.Field(payment.FieldAmountRefunded))
 }
 
-// WhereID applies the entql int predicate on the id field.
-func (f *BookingMetadatumFilter) WhereID(p entql.IntP) {
-	f.Where(p.Field(bookingmetadatum.FieldID))
+// WhereCurrency applies the entql string predicate on the currency field.
+func (f *PaymentFilter) WhereCurrency(p entql.StringP) {
+	f.Where(p.Field(payment.FieldCurrency))
 }
 
-// WhereKey applies the entql string predicate on the key field.
-func (f *BookingMetadatumFilter) WhereKey(p entql.StringP) {
-	f.Where(p.Field(bookingmetadatum.FieldKey))
+// WhereClientSecret applies the entql string predicate on the clientSecret field.
+func (f *PaymentFilter) WhereClientSecret(p entql.StringP) {
+	f.Where(p.Field(payment.FieldClientSecret))
 }
 
-// WhereValue applies the entql string predicate on the value field.
-func (f *BookingMetadatumFilter) WhereValue(p entql.StringP) {
-	f.Where(p.Field(bookingmetadatum.FieldValue))
+// WhereFailureReason applies the entql string predicate on the failureReason field.
+func (f *PaymentFilter) WhereFailureReason(p entql.StringP) {
+	f.Where(p.Field(payment.FieldFailureReason))
 }
 
 // WhereBookingId applies the entql int predicate on the bookingId field.
-func (f *BookingMetadatumFilter) WhereBookingId(p entql.IntP) {
-	f.Where(p.Field(bookingmetadatum.FieldBookingId))
+func (f *PaymentFilter) WhereBookingId(p entql.IntP) {
+	f.Where(p.Field(payment.FieldBookingId))
 }
 
 // WhereHasBooking applies a predicate to check if query has an edge booking.
-func (f *BookingMetadatumFilter) WhereHasBooking() {
+func (f *PaymentFilter) WhereHasBooking() {
 	f.Where(entql.HasEdge("booking"))
 }
 
 // WhereHasBookingWith applies a predicate to check if query has an edge booking with a given conditions (other predicates).
-func (f *BookingMetadatumFilter) WhereHasBookingWith(preds ...predicate.Booking) {
+func (f *PaymentFilter) WhereHasBookingWith(preds ...predicate.Booking) {
 	f.Where(entql.HasEdgeWith("booking", sqlgraph.WrapFunc(func(s *sql.Selector) {
 		for _, p := range preds {
 			p(s)
@@ -717,105 +2327,131 @@ func (f *BookingMetadatumFilter) WhereHasBookingWith(preds ...predicate.Booking)
 }
 
 // addPredicate implements the predicateAdder interface.
-func (oq *OrganizationQuery) addPredicate(pred func(s *sql.Selector)) {
-	oq.predicates = append(oq.predicates, pred)
+func (pcq *PromoCodeQuery) addPredicate(pred func(s *sql.Selector)) {
+	pcq.predicates = append(pcq.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the OrganizationQuery builder.
-func (oq *OrganizationQuery) Filter() *OrganizationFilter {
-	return &OrganizationFilter{oq}
+// Filter returns a Filter implementation to apply filters on the PromoCodeQuery builder.
+func (pcq *PromoCodeQuery) Filter() *PromoCodeFilter {
+	return &PromoCodeFilter{pcq}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *OrganizationMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *PromoCodeMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the OrganizationMutation builder.
-func (m *OrganizationMutation) Filter() *OrganizationFilter {
-	return &OrganizationFilter{m}
+// Filter returns an entql.Where implementation to apply filters on the PromoCodeMutation builder.
+func (m *PromoCodeMutation) Filter() *PromoCodeFilter {
+	return &PromoCodeFilter{m}
 }
 
-// OrganizationFilter provides a generic filtering capability at runtime for OrganizationQuery.
-type OrganizationFilter struct {
+// PromoCodeFilter provides a generic filtering capability at runtime for PromoCodeQuery.
+type PromoCodeFilter struct {
 	predicateAdder
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *OrganizationFilter) Where(p entql.P) {
+func (f *PromoCodeFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql int predicate on the id field.
-func (f *OrganizationFilter) WhereID(p entql.IntP) {
-	f.Where(p.Field(organization.FieldID))
+func (f *PromoCodeFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(promocode.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the createdAt field.
-func (f *OrganizationFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(organization.FieldCreatedAt))
+func (f *PromoCodeFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(promocode.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updatedAt field.
-func (f *OrganizationFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(organization.FieldUpdatedAt))
+func (f *PromoCodeFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(promocode.FieldUpdatedAt))
 }
 
-// WhereName applies the entql string predicate on the name field.
-func (f *OrganizationFilter) WhereName(p entql.StringP) {
-	f.Where(p.Field(organization.FieldName))
+// WhereCode applies the entql string predicate on the code field.
+func (f *PromoCodeFilter) WhereCode(p entql.StringP) {
+	f.Where(p.Field(promocode.FieldCode))
 }
 
-// WherePublicKey applies the entql string predicate on the publicKey field.
-func (f *OrganizationFilter) WherePublicKey(p entql.StringP) {
-	f.Where(p.Field(organization.FieldPublicKey))
+// WhereDescription applies the entql string predicate on the description field.
+func (f *PromoCodeFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(promocode.FieldDescription))
 }
 
-// WherePrivateKey applies the entql string predicate on the privateKey field.
-func (f *OrganizationFilter) WherePrivateKey(p entql.StringP) {
-	f.Where(p.Field(organization.FieldPrivateKey))
+// WhereDiscountType applies the entql string predicate on the discountType field.
+func (f *PromoCodeFilter) WhereDiscountType(p entql.StringP) {
+	f.Where(p.Field(promocode.FieldDiscountType))
 }
 
-// WhereHasUsers applies a predicate to check if query has an edge users.
-func (f *OrganizationFilter) WhereHasUsers() {
-	f.Where(entql.HasEdge("users"))
+// WhereDiscountValue applies the entql int predicate on the discountValue field.
+func (f *PromoCodeFilter) WhereDiscountValue(p entql.IntP) {
+	f.Where(p.Field(promocode.FieldDiscountValue))
 }
 
-// WhereHasUsersWith applies a predicate to check if query has an edge users with a given conditions (other predicates).
-func (f *OrganizationFilter) WhereHasUsersWith(preds ...predicate.User) {
-	f.Where(entql.HasEdgeWith("users", sqlgraph.WrapFunc(func(s *sql.Selector) {
-		for _, p := range preds {
-			p(s)
-		}
-	})))
+// WhereCurrency applies the entql string predicate on the currency field.
+func (f *PromoCodeFilter) WhereCurrency(p entql.StringP) {
+	f.Where(p.Field(promocode.FieldCurrency))
 }
 
-// WhereHasResources applies a predicate to check if query has an edge resources.
-func (f *OrganizationFilter) WhereHasResources() {
-	f.Where(entql.HasEdge("resources"))
+// WhereValidFrom applies the entql time.Time predicate on the validFrom field.
+func (f *PromoCodeFilter) WhereValidFrom(p entql.TimeP) {
+	f.Where(p.Field(promocode.FieldValidFrom))
 }
 
-// WhereHasResourcesWith applies a predicate to check if query has an edge resources with a given conditions (other predicates).
-func (f *OrganizationFilter) WhereHasResourcesWith(preds ...predicate.Resource) {
-	f.Where(entql.HasEdgeWith("resources", sqlgraph.WrapFunc(func(s *sql.Selector) {
+// WhereValidUntil applies the entql time.Time predicate on the validUntil field.
+func (f *PromoCodeFilter) WhereValidUntil(p entql.TimeP) {
+	f.Where(p.Field(promocode.FieldValidUntil))
+}
+
+// WhereMaxRedemptions applies the entql int predicate on the maxRedemptions field.
+func (f *PromoCodeFilter) WhereMaxRedemptions(p entql.IntP) {
+	f.Where(p.Field(promocode.FieldMaxRedemptions))
+}
+
+// WhereMaxRedemptionsPerCustomer applies the entql int predicate on the maxRedemptionsPerCustomer field.
+func (f *PromoCodeFilter) WhereMaxRedemptionsPerCustomer(p entql.IntP) {
+	f.Where(p.Field(promocode.FieldMaxRedemptionsPerCustomer))
+}
+
+// WhereResourceIds applies the entql json.RawMessage predicate on the resourceIds field.
+func (f *PromoCodeFilter) WhereResourceIds(p entql.BytesP) {
+	f.Where(p.Field(promocode.FieldResourceIds))
+}
+
+// WhereOrganizationId applies the entql int predicate on the organizationId field.
+func (f *PromoCodeFilter) WhereOrganizationId(p entql.IntP) {
+	f.Where(p.Field(promocode.FieldOrganizationId))
+}
+
+// WhereHasOrganization applies a predicate to check if query has an edge organization.
+func (f *PromoCodeFilter) WhereHasOrganization() {
+	f.Where(entql.HasEdge("organization"))
+}
+
+// WhereHasOrganizationWith applies a predicate to check if query has an edge organization with a given conditions (other predicates).
+func (f *PromoCodeFilter) WhereHasOrganizationWith(preds ...predicate.Organization) {
+	f.Where(entql.HasEdgeWith("organization", sqlgraph.WrapFunc(func(s *sql.Selector) {
 		for _, p := range preds {
 			p(s)
 		}
 	})))
 }
 
-// WhereHasTokens applies a predicate to check if query has an edge tokens.
-func (f *OrganizationFilter) WhereHasTokens() {
-	f.Where(entql.HasEdge("tokens"))
+// WhereHasRedemptions applies a predicate to check if query has an edge redemptions.
+func (f *PromoCodeFilter) WhereHasRedemptions() {
+	f.Where(entql.HasEdge("redemptions"))
 }
 
-// WhereHasTokensWith applies a predicate to check if query has an edge tokens with a given conditions (other predicates).
-func (f *OrganizationFilter) WhereHasTokensWith(preds ...predicate.Token) {
-	f.Where(entql.HasEdgeWith("tokens", sqlgraph.WrapFunc(func(s *sql.Selector) {
+// WhereHasRedemptionsWith applies a predicate to check if query has an edge redemptions with a given conditions (other predicates).
+func (f *PromoCodeFilter) WhereHasRedemptionsWith(preds ...predicate.PromoRedemption) {
+	f.Where(entql.HasEdgeWith("redemptions", sqlgraph.WrapFunc(func(s *sql.Selector) {
 		for _, p := range preds {
 			p(s)
 		}
@@ -823,76 +2459,101 @@ func (f *OrganizationFilter) WhereHasTokensWith(preds ...predicate.Token) {
 }
 
 // addPredicate implements the predicateAdder interface.
-func (ooq *OrganizationOwnershipQuery) addPredicate(pred func(s *sql.Selector)) {
-	ooq.predicates = append(ooq.predicates, pred)
+func (prq *PromoRedemptionQuery) addPredicate(pred func(s *sql.Selector)) {
+	prq.predicates = append(prq.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the OrganizationOwnershipQuery builder.
-func (ooq *OrganizationOwnershipQuery) Filter() *OrganizationOwnershipFilter {
-	return &OrganizationOwnershipFilter{ooq}
+// Filter returns a Filter implementation to apply filters on the PromoRedemptionQuery builder.
+func (prq *PromoRedemptionQuery) Filter() *PromoRedemptionFilter {
+	return &PromoRedemptionFilter{prq}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *OrganizationOwnershipMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *PromoRedemptionMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the OrganizationOwnershipMutation builder.
-func (m *OrganizationOwnershipMutation) Filter() *OrganizationOwnershipFilter {
-	return &OrganizationOwnershipFilter{m}
+// Filter returns an entql.Where implementation to apply filters on the PromoRedemptionMutation builder.
+func (m *PromoRedemptionMutation) Filter() *PromoRedemptionFilter {
+	return &PromoRedemptionFilter{m}
 }
 
-// OrganizationOwnershipFilter provides a generic filtering capability at runtime for OrganizationOwnershipQuery.
-type OrganizationOwnershipFilter struct {
+// PromoRedemptionFilter provides a generic filtering capability at runtime for PromoRedemptionQuery.
+type PromoRedemptionFilter struct {
 	predicateAdder
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *OrganizationOwnershipFilter) Where(p entql.P) {
+func (f *PromoRedemptionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql int predicate on the id field.
-func (f *OrganizationOwnershipFilter) WhereID(p entql.IntP) {
-	f.Where(p.Field(organizationownership.FieldID))
+func (f *PromoRedemptionFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(promoredemption.FieldID))
 }
 
-// WhereUserId applies the entql int predicate on the userId field.
-func (f *OrganizationOwnershipFilter) WhereUserId(p entql.IntP) {
-	f.Where(p.Field(organizationownership.FieldUserId))
+// WhereCreatedAt applies the entql time.Time predicate on the createdAt field.
+func (f *PromoRedemptionFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(promoredemption.FieldCreatedAt))
 }
 
-// WhereOrganizationId applies the entql int predicate on the organizationId field.
-func (f *OrganizationOwnershipFilter) WhereOrganizationId(p entql.IntP) {
-	f.Where(p.Field(organizationownership.FieldOrganizationId))
+// WhereUpdatedAt applies the entql time.Time predicate on the updatedAt field.
+func (f *PromoRedemptionFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(promoredemption.FieldUpdatedAt))
 }
 
-// WhereHasUser applies a predicate to check if query has an edge user.
-func (f *OrganizationOwnershipFilter) WhereHasUser() {
-	f.Where(entql.HasEdge("user"))
+// WhereCustomer applies the entql string predicate on the customer field.
+func (f *PromoRedemptionFilter) WhereCustomer(p entql.StringP) {
+	f.Where(p.Field(promoredemption.FieldCustomer))
 }
 
-// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
-func (f *OrganizationOwnershipFilter) WhereHasUserWith(preds ...predicate.User) {
-	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+// WhereDiscount applies the entql int predicate on the discount field.
+func (f *PromoRedemptionFilter) WhereDiscount(p entql.IntP) {
+	f.Where(p.Field(promoredemption.FieldDiscount))
+}
+
+// WhereCurrency applies the entql string predicate on the currency field.
+func (f *PromoRedemptionFilter) WhereCurrency(p entql.StringP) {
+	f.Where(p.Field(promoredemption.FieldCurrency))
+}
+
+// WherePromoCodeId applies the entql int predicate on the promoCodeId field.
+func (f *PromoRedemptionFilter) WherePromoCodeId(p entql.IntP) {
+	f.Where(p.Field(promoredemption.FieldPromoCodeId))
+}
+
+// WhereBookingId applies the entql int predicate on the bookingId field.
+func (f *PromoRedemptionFilter) WhereBookingId(p entql.IntP) {
+	f.Where(p.Field(promoredemption.FieldBookingId))
+}
+
+// WhereHasPromoCode applies a predicate to check if query has an edge promoCode.
+func (f *PromoRedemptionFilter) WhereHasPromoCode() {
+	f.Where(entql.HasEdge("promoCode"))
+}
+
+// WhereHasPromoCodeWith applies a predicate to check if query has an edge promoCode with a given conditions (other predicates).
+func (f *PromoRedemptionFilter) WhereHasPromoCodeWith(preds ...predicate.PromoCode) {
+	f.Where(entql.HasEdgeWith("promoCode", sqlgraph.WrapFunc(func(s *sql.Selector) {
 		for _, p := range preds {
 			p(s)
 		}
 	})))
 }
 
-// WhereHasOrganization applies a predicate to check if query has an edge organization.
-func (f *OrganizationOwnershipFilter) WhereHasOrganization() {
-	f.Where(entql.HasEdge("organization"))
+// WhereHasBooking applies a predicate to check if query has an edge booking.
+func (f *PromoRedemptionFilter) WhereHasBooking() {
+	f.Where(entql.HasEdge("booking"))
 }
 
-// WhereHasOrganizationWith applies a predicate to check if query has an edge organization with a given conditions (other predicates).
-func (f *OrganizationOwnershipFilter) WhereHasOrganizationWith(preds ...predicate.Organization) {
-	f.Where(entql.HasEdgeWith("organization", sqlgraph.WrapFunc(func(s *sql.Selector) {
+// WhereHasBookingWith applies a predicate to check if query has an edge booking with a given conditions (other predicates).
+func (f *PromoRedemptionFilter) WhereHasBookingWith(preds ...predicate.Booking) {
+	f.Where(entql.HasEdgeWith("booking", sqlgraph.WrapFunc(func(s *sql.Selector) {
 		for _, p := range preds {
 			p(s)
 		}
@@ -927,7 +2588,7 @@ type ResourceFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *ResourceFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -963,9 +2624,9 @@ func (f *ResourceFilter) WhereTimezone(p entql.StringP) {
 	f.Where(p.Field(resource.FieldTimezone))
 }
 
-// WherePassword applies the entql string predicate on the password field.
-func (f *ResourceFilter) WherePassword(p entql.StringP) {
-	f.Where(p.Field(resource.FieldPassword))
+// WherePasswordHash applies the entql string predicate on the passwordHash field.
+func (f *ResourceFilter) WherePasswordHash(p entql.StringP) {
+	f.Where(p.Field(resource.FieldPasswordHash))
 }
 
 // WherePrice applies the entql int predicate on the price field.
@@ -978,6 +2639,11 @@ func (f *ResourceFilter) WhereBookingPrice(p entql.IntP) {
 	f.Where(p.Field(resource.FieldBookingPrice))
 }
 
+// WhereCurrency applies the entql string predicate on the currency field.
+func (f *ResourceFilter) WhereCurrency(p entql.StringP) {
+	f.Where(p.Field(resource.FieldCurrency))
+}
+
 // WhereOrganizationId applies the entql int predicate on the organizationId field.
 func (f *ResourceFilter) WhereOrganizationId(p entql.IntP) {
 	f.Where(p.Field(resource.FieldOrganizationId))
@@ -988,6 +2654,31 @@ func (f *ResourceFilter) WhereQuantityAvailable(p entql.IntP) {
 	f.Where(p.Field(resource.FieldQuantityAvailable))
 }
 
+// WhereCancellationPolicy applies the entql string predicate on the cancellationPolicy field.
+func (f *ResourceFilter) WhereCancellationPolicy(p entql.StringP) {
+	f.Where(p.Field(resource.FieldCancellationPolicy))
+}
+
+// WherePricing applies the entql string predicate on the pricing field.
+func (f *ResourceFilter) WherePricing(p entql.StringP) {
+	f.Where(p.Field(resource.FieldPricing))
+}
+
+// WhereBookingFields applies the entql string predicate on the bookingFields field.
+func (f *ResourceFilter) WhereBookingFields(p entql.StringP) {
+	f.Where(p.Field(resource.FieldBookingFields))
+}
+
+// WhereFeedTokenHash applies the entql string predicate on the feedTokenHash field.
+func (f *ResourceFilter) WhereFeedTokenHash(p entql.StringP) {
+	f.Where(p.Field(resource.FieldFeedTokenHash))
+}
+
+// WhereTaxRateId applies the entql int predicate on the taxRateId field.
+func (f *ResourceFilter) WhereTaxRateId(p entql.IntP) {
+	f.Where(p.Field(resource.FieldTaxRateId))
+}
+
 // WhereHasSlots applies a predicate to check if query has an edge slots.
 func (f *ResourceFilter) WhereHasSlots() {
 	f.Where(entql.HasEdge("slots"))
@@ -1016,6 +2707,20 @@ func (f *ResourceFilter) WhereHasBookingsWith(preds ...predicate.Booking) {
 	})))
 }
 
+// WhereHasBookingSeries applies a predicate to check if query has an edge bookingSeries.
+func (f *ResourceFilter) WhereHasBookingSeries() {
+	f.Where(entql.HasEdge("bookingSeries"))
+}
+
+// WhereHasBookingSeriesWith applies a predicate to check if query has an edge bookingSeries with a given conditions (other predicates).
+func (f *ResourceFilter) WhereHasBookingSeriesWith(preds ...predicate.BookingSeries) {
+	f.Where(entql.HasEdgeWith("bookingSeries", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // WhereHasUnavailabilities applies a predicate to check if query has an edge unavailabilities.
 func (f *ResourceFilter) WhereHasUnavailabilities() {
 	f.Where(entql.HasEdge("unavailabilities"))
@@ -1030,6 +2735,20 @@ func (f *ResourceFilter) WhereHasUnavailabilitiesWith(preds ...predicate.Unavail
 	})))
 }
 
+// WhereHasWaitlistEntries applies a predicate to check if query has an edge waitlistEntries.
+func (f *ResourceFilter) WhereHasWaitlistEntries() {
+	f.Where(entql.HasEdge("waitlistEntries"))
+}
+
+// WhereHasWaitlistEntriesWith applies a predicate to check if query has an edge waitlistEntries with a given conditions (other predicates).
+func (f *ResourceFilter) WhereHasWaitlistEntriesWith(preds ...predicate.WaitlistEntry) {
+	f.Where(entql.HasEdgeWith("waitlistEntries", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // WhereHasOrganization applies a predicate to check if query has an edge organization.
 func (f *ResourceFilter) WhereHasOrganization() {
 	f.Where(entql.HasEdge("organization"))
@@ -1044,6 +2763,20 @@ func (f *ResourceFilter) WhereHasOrganizationWith(preds ...predicate.Organizatio
 	})))
 }
 
+// WhereHasTaxRate applies a predicate to check if query has an edge taxRate.
+func (f *ResourceFilter) WhereHasTaxRate() {
+	f.Where(entql.HasEdge("taxRate"))
+}
+
+// WhereHasTaxRateWith applies a predicate to check if query has an edge taxRate with a given conditions (other predicates).
+func (f *ResourceFilter) WhereHasTaxRateWith(preds ...predicate.TaxRate) {
+	f.Where(entql.HasEdgeWith("taxRate", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (sq *SlotQuery) addPredicate(pred func(s *sql.Selector)) {
 	sq.predicates = append(sq.predicates, pred)
@@ -1072,7 +2805,7 @@ type SlotFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *SlotFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1093,29 +2826,131 @@ func (f *SlotFilter) WhereStartTime(p entql.StringP) {
 	f.Where(p.Field(slot.FieldStartTime))
 }
 
-// WhereEndTime applies the entql string predicate on the endTime field.
-func (f *SlotFilter) WhereEndTime(p entql.StringP) {
-	f.Where(p.Field(slot.FieldEndTime))
+// WhereEndTime applies the entql string predicate on the endTime field.
+func (f *SlotFilter) WhereEndTime(p entql.StringP) {
+	f.Where(p.Field(slot.FieldEndTime))
+}
+
+// WhereQuantity applies the entql int predicate on the quantity field.
+func (f *SlotFilter) WhereQuantity(p entql.IntP) {
+	f.Where(p.Field(slot.FieldQuantity))
+}
+
+// WhereResourceId applies the entql int predicate on the resourceId field.
+func (f *SlotFilter) WhereResourceId(p entql.IntP) {
+	f.Where(p.Field(slot.FieldResourceId))
+}
+
+// WhereHasResource applies a predicate to check if query has an edge resource.
+func (f *SlotFilter) WhereHasResource() {
+	f.Where(entql.HasEdge("resource"))
+}
+
+// WhereHasResourceWith applies a predicate to check if query has an edge resource with a given conditions (other predicates).
+func (f *SlotFilter) WhereHasResourceWith(preds ...predicate.Resource) {
+	f.Where(entql.HasEdgeWith("resource", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (trq *TaxRateQuery) addPredicate(pred func(s *sql.Selector)) {
+	trq.predicates = append(trq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the TaxRateQuery builder.
+func (trq *TaxRateQuery) Filter() *TaxRateFilter {
+	return &TaxRateFilter{trq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *TaxRateMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the TaxRateMutation builder.
+func (m *TaxRateMutation) Filter() *TaxRateFilter {
+	return &TaxRateFilter{m}
+}
+
+// TaxRateFilter provides a generic filtering capability at runtime for TaxRateQuery.
+type TaxRateFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *TaxRateFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[13].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *TaxRateFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(taxrate.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the createdAt field.
+func (f *TaxRateFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(taxrate.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updatedAt field.
+func (f *TaxRateFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(taxrate.FieldUpdatedAt))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *TaxRateFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(taxrate.FieldName))
+}
+
+// WhereRate applies the entql int predicate on the rate field.
+func (f *TaxRateFilter) WhereRate(p entql.IntP) {
+	f.Where(p.Field(taxrate.FieldRate))
+}
+
+// WhereInclusive applies the entql bool predicate on the inclusive field.
+func (f *TaxRateFilter) WhereInclusive(p entql.BoolP) {
+	f.Where(p.Field(taxrate.FieldInclusive))
+}
+
+// WhereIsDefault applies the entql bool predicate on the isDefault field.
+func (f *TaxRateFilter) WhereIsDefault(p entql.BoolP) {
+	f.Where(p.Field(taxrate.FieldIsDefault))
 }
 
-// WhereQuantity applies the entql int predicate on the quantity field.
-func (f *SlotFilter) WhereQuantity(p entql.IntP) {
-	f.Where(p.Field(slot.FieldQuantity))
+// WhereOrganizationId applies the entql int predicate on the organizationId field.
+func (f *TaxRateFilter) WhereOrganizationId(p entql.IntP) {
+	f.Where(p.Field(taxrate.FieldOrganizationId))
 }
 
-// WhereResourceId applies the entql int predicate on the resourceId field.
-func (f *SlotFilter) WhereResourceId(p entql.IntP) {
-	f.Where(p.Field(slot.FieldResourceId))
+// WhereHasOrganization applies a predicate to check if query has an edge organization.
+func (f *TaxRateFilter) WhereHasOrganization() {
+	f.Where(entql.HasEdge("organization"))
 }
 
-// WhereHasResource applies a predicate to check if query has an edge resource.
-func (f *SlotFilter) WhereHasResource() {
-	f.Where(entql.HasEdge("resource"))
+// WhereHasOrganizationWith applies a predicate to check if query has an edge organization with a given conditions (other predicates).
+func (f *TaxRateFilter) WhereHasOrganizationWith(preds ...predicate.Organization) {
+	f.Where(entql.HasEdgeWith("organization", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
 }
 
-// WhereHasResourceWith applies a predicate to check if query has an edge resource with a given conditions (other predicates).
-func (f *SlotFilter) WhereHasResourceWith(preds ...predicate.Resource) {
-	f.Where(entql.HasEdgeWith("resource", sqlgraph.WrapFunc(func(s *sql.Selector) {
+// WhereHasResources applies a predicate to check if query has an edge resources.
+func (f *TaxRateFilter) WhereHasResources() {
+	f.Where(entql.HasEdge("resources"))
+}
+
+// WhereHasResourcesWith applies a predicate to check if query has an edge resources with a given conditions (other predicates).
+func (f *TaxRateFilter) WhereHasResourcesWith(preds ...predicate.Resource) {
+	f.Where(entql.HasEdgeWith("resources", sqlgraph.WrapFunc(func(s *sql.Selector) {
 		for _, p := range preds {
 			p(s)
 		}
@@ -1150,7 +2985,7 @@ type TokenFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *TokenFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[14].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1176,11 +3011,36 @@ func (f *TokenFilter) WhereName(p entql.StringP) {
 	f.Where(p.Field(token.FieldName))
 }
 
+// WhereSecretHash applies the entql string predicate on the secretHash field.
+func (f *TokenFilter) WhereSecretHash(p entql.StringP) {
+	f.Where(p.Field(token.FieldSecretHash))
+}
+
+// WhereSecretPrefix applies the entql string predicate on the secretPrefix field.
+func (f *TokenFilter) WhereSecretPrefix(p entql.StringP) {
+	f.Where(p.Field(token.FieldSecretPrefix))
+}
+
+// WhereScopes applies the entql json.RawMessage predicate on the scopes field.
+func (f *TokenFilter) WhereScopes(p entql.BytesP) {
+	f.Where(p.Field(token.FieldScopes))
+}
+
 // WhereExpiry applies the entql time.Time predicate on the expiry field.
 func (f *TokenFilter) WhereExpiry(p entql.TimeP) {
 	f.Where(p.Field(token.FieldExpiry))
 }
 
+// WhereLastUsedAt applies the entql time.Time predicate on the lastUsedAt field.
+func (f *TokenFilter) WhereLastUsedAt(p entql.TimeP) {
+	f.Where(p.Field(token.FieldLastUsedAt))
+}
+
+// WhereRevokedAt applies the entql time.Time predicate on the revokedAt field.
+func (f *TokenFilter) WhereRevokedAt(p entql.TimeP) {
+	f.Where(p.Field(token.FieldRevokedAt))
+}
+
 // WhereUserId applies the entql int predicate on the userId field.
 func (f *TokenFilter) WhereUserId(p entql.IntP) {
 	f.Where(p.Field(token.FieldUserId))
@@ -1247,7 +3107,7 @@ type UnavailabilityFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UnavailabilityFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[15].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1283,6 +3143,11 @@ func (f *UnavailabilityFilter) WhereResourceId(p entql.IntP) {
 	f.Where(p.Field(unavailability.FieldResourceId))
 }
 
+// WhereExternalId applies the entql string predicate on the externalId field.
+func (f *UnavailabilityFilter) WhereExternalId(p entql.StringP) {
+	f.Where(p.Field(unavailability.FieldExternalId))
+}
+
 // WhereHasResource applies a predicate to check if query has an edge resource.
 func (f *UnavailabilityFilter) WhereHasResource() {
 	f.Where(entql.HasEdge("resource"))
@@ -1325,7 +3190,7 @@ type UserFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[16].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1389,6 +3254,20 @@ func (f *UserFilter) WhereHasTokensWith(preds ...predicate.Token) {
 	})))
 }
 
+// WhereHasBookings applies a predicate to check if query has an edge bookings.
+func (f *UserFilter) WhereHasBookings() {
+	f.Where(entql.HasEdge("bookings"))
+}
+
+// WhereHasBookingsWith applies a predicate to check if query has an edge bookings with a given conditions (other predicates).
+func (f *UserFilter) WhereHasBookingsWith(preds ...predicate.Booking) {
+	f.Where(entql.HasEdgeWith("bookings", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // WhereHasOrganization applies a predicate to check if query has an edge organization.
 func (f *UserFilter) WhereHasOrganization() {
 	f.Where(entql.HasEdge("organization"))
@@ -1402,3 +3281,340 @@ func (f *UserFilter) WhereHasOrganizationWith(preds ...predicate.Organization) {
 		}
 	})))
 }
+
+// addPredicate implements the predicateAdder interface.
+func (weq *WaitlistEntryQuery) addPredicate(pred func(s *sql.Selector)) {
+	weq.predicates = append(weq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the WaitlistEntryQuery builder.
+func (weq *WaitlistEntryQuery) Filter() *WaitlistEntryFilter {
+	return &WaitlistEntryFilter{weq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *WaitlistEntryMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the WaitlistEntryMutation builder.
+func (m *WaitlistEntryMutation) Filter() *WaitlistEntryFilter {
+	return &WaitlistEntryFilter{m}
+}
+
+// WaitlistEntryFilter provides a generic filtering capability at runtime for WaitlistEntryQuery.
+type WaitlistEntryFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *WaitlistEntryFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[17].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *WaitlistEntryFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(waitlistentry.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the createdAt field.
+func (f *WaitlistEntryFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(waitlistentry.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updatedAt field.
+func (f *WaitlistEntryFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(waitlistentry.FieldUpdatedAt))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *WaitlistEntryFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(waitlistentry.FieldStatus))
+}
+
+// WhereStartTime applies the entql time.Time predicate on the startTime field.
+func (f *WaitlistEntryFilter) WhereStartTime(p entql.TimeP) {
+	f.Where(p.Field(waitlistentry.FieldStartTime))
+}
+
+// WhereEndTime applies the entql time.Time predicate on the endTime field.
+func (f *WaitlistEntryFilter) WhereEndTime(p entql.TimeP) {
+	f.Where(p.Field(waitlistentry.FieldEndTime))
+}
+
+// WherePriority applies the entql int predicate on the priority field.
+func (f *WaitlistEntryFilter) WherePriority(p entql.IntP) {
+	f.Where(p.Field(waitlistentry.FieldPriority))
+}
+
+// WhereMetadata applies the entql json.RawMessage predicate on the metadata field.
+func (f *WaitlistEntryFilter) WhereMetadata(p entql.BytesP) {
+	f.Where(p.Field(waitlistentry.FieldMetadata))
+}
+
+// WhereResourceId applies the entql int predicate on the resourceId field.
+func (f *WaitlistEntryFilter) WhereResourceId(p entql.IntP) {
+	f.Where(p.Field(waitlistentry.FieldResourceId))
+}
+
+// WhereBookingId applies the entql int predicate on the bookingId field.
+func (f *WaitlistEntryFilter) WhereBookingId(p entql.IntP) {
+	f.Where(p.Field(waitlistentry.FieldBookingId))
+}
+
+// WhereOfferedAt applies the entql time.Time predicate on the offeredAt field.
+func (f *WaitlistEntryFilter) WhereOfferedAt(p entql.TimeP) {
+	f.Where(p.Field(waitlistentry.FieldOfferedAt))
+}
+
+// WhereHasResource applies a predicate to check if query has an edge resource.
+func (f *WaitlistEntryFilter) WhereHasResource() {
+	f.Where(entql.HasEdge("resource"))
+}
+
+// WhereHasResourceWith applies a predicate to check if query has an edge resource with a given conditions (other predicates).
+func (f *WaitlistEntryFilter) WhereHasResourceWith(preds ...predicate.Resource) {
+	f.Where(entql.HasEdgeWith("resource", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasBooking applies a predicate to check if query has an edge booking.
+func (f *WaitlistEntryFilter) WhereHasBooking() {
+	f.Where(entql.HasEdge("booking"))
+}
+
+// WhereHasBookingWith applies a predicate to check if query has an edge booking with a given conditions (other predicates).
+func (f *WaitlistEntryFilter) WhereHasBookingWith(preds ...predicate.Booking) {
+	f.Where(entql.HasEdgeWith("booking", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (wq *WebhookQuery) addPredicate(pred func(s *sql.Selector)) {
+	wq.predicates = append(wq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the WebhookQuery builder.
+func (wq *WebhookQuery) Filter() *WebhookFilter {
+	return &WebhookFilter{wq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *WebhookMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the WebhookMutation builder.
+func (m *WebhookMutation) Filter() *WebhookFilter {
+	return &WebhookFilter{m}
+}
+
+// WebhookFilter provides a generic filtering capability at runtime for WebhookQuery.
+type WebhookFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *WebhookFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[18].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *WebhookFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(webhook.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the createdAt field.
+func (f *WebhookFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(webhook.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updatedAt field.
+func (f *WebhookFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(webhook.FieldUpdatedAt))
+}
+
+// WhereURL applies the entql string predicate on the url field.
+func (f *WebhookFilter) WhereURL(p entql.StringP) {
+	f.Where(p.Field(webhook.FieldURL))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *WebhookFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(webhook.FieldDescription))
+}
+
+// WhereEventTypes applies the entql json.RawMessage predicate on the eventTypes field.
+func (f *WebhookFilter) WhereEventTypes(p entql.BytesP) {
+	f.Where(p.Field(webhook.FieldEventTypes))
+}
+
+// WhereSecret applies the entql string predicate on the secret field.
+func (f *WebhookFilter) WhereSecret(p entql.StringP) {
+	f.Where(p.Field(webhook.FieldSecret))
+}
+
+// WhereEnabled applies the entql bool predicate on the enabled field.
+func (f *WebhookFilter) WhereEnabled(p entql.BoolP) {
+	f.Where(p.Field(webhook.FieldEnabled))
+}
+
+// WhereOrganizationId applies the entql int predicate on the organizationId field.
+func (f *WebhookFilter) WhereOrganizationId(p entql.IntP) {
+	f.Where(p.Field(webhook.FieldOrganizationId))
+}
+
+// WhereHasOrganization applies a predicate to check if query has an edge organization.
+func (f *WebhookFilter) WhereHasOrganization() {
+	f.Where(entql.HasEdge("organization"))
+}
+
+// WhereHasOrganizationWith applies a predicate to check if query has an edge organization with a given conditions (other predicates).
+func (f *WebhookFilter) WhereHasOrganizationWith(preds ...predicate.Organization) {
+	f.Where(entql.HasEdgeWith("organization", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasDeliveries applies a predicate to check if query has an edge deliveries.
+func (f *WebhookFilter) WhereHasDeliveries() {
+	f.Where(entql.HasEdge("deliveries"))
+}
+
+// WhereHasDeliveriesWith applies a predicate to check if query has an edge deliveries with a given conditions (other predicates).
+func (f *WebhookFilter) WhereHasDeliveriesWith(preds ...predicate.WebhookDelivery) {
+	f.Where(entql.HasEdgeWith("deliveries", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (wdq *WebhookDeliveryQuery) addPredicate(pred func(s *sql.Selector)) {
+	wdq.predicates = append(wdq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the WebhookDeliveryQuery builder.
+func (wdq *WebhookDeliveryQuery) Filter() *WebhookDeliveryFilter {
+	return &WebhookDeliveryFilter{wdq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *WebhookDeliveryMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Filter() *WebhookDeliveryFilter {
+	return &WebhookDeliveryFilter{m}
+}
+
+// WebhookDeliveryFilter provides a generic filtering capability at runtime for WebhookDeliveryQuery.
+type WebhookDeliveryFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *WebhookDeliveryFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[19].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *WebhookDeliveryFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(webhookdelivery.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the createdAt field.
+func (f *WebhookDeliveryFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(webhookdelivery.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updatedAt field.
+func (f *WebhookDeliveryFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(webhookdelivery.FieldUpdatedAt))
+}
+
+// WhereEventType applies the entql string predicate on the eventType field.
+func (f *WebhookDeliveryFilter) WhereEventType(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldEventType))
+}
+
+// WherePayload applies the entql string predicate on the payload field.
+func (f *WebhookDeliveryFilter) WherePayload(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldPayload))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *WebhookDeliveryFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldStatus))
+}
+
+// WhereAttempts applies the entql int predicate on the attempts field.
+func (f *WebhookDeliveryFilter) WhereAttempts(p entql.IntP) {
+	f.Where(p.Field(webhookdelivery.FieldAttempts))
+}
+
+// WhereNextAttemptAt applies the entql time.Time predicate on the nextAttemptAt field.
+func (f *WebhookDeliveryFilter) WhereNextAttemptAt(p entql.TimeP) {
+	f.Where(p.Field(webhookdelivery.FieldNextAttemptAt))
+}
+
+// WhereLastAttemptAt applies the entql time.Time predicate on the lastAttemptAt field.
+func (f *WebhookDeliveryFilter) WhereLastAttemptAt(p entql.TimeP) {
+	f.Where(p.Field(webhookdelivery.FieldLastAttemptAt))
+}
+
+// WhereResponseStatus applies the entql int predicate on the responseStatus field.
+func (f *WebhookDeliveryFilter) WhereResponseStatus(p entql.IntP) {
+	f.Where(p.Field(webhookdelivery.FieldResponseStatus))
+}
+
+// WhereLastError applies the entql string predicate on the lastError field.
+func (f *WebhookDeliveryFilter) WhereLastError(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldLastError))
+}
+
+// WhereWebhookId applies the entql int predicate on the webhookId field.
+func (f *WebhookDeliveryFilter) WhereWebhookId(p entql.IntP) {
+	f.Where(p.Field(webhookdelivery.FieldWebhookId))
+}
+
+// WhereOrganizationId applies the entql int predicate on the organizationId field.
+func (f *WebhookDeliveryFilter) WhereOrganizationId(p entql.IntP) {
+	f.Where(p.Field(webhookdelivery.FieldOrganizationId))
+}
+
+// WhereHasWebhook applies a predicate to check if query has an edge webhook.
+func (f *WebhookDeliveryFilter) WhereHasWebhook() {
+	f.Where(entql.HasEdge("webhook"))
+}
+
+// WhereHasWebhookWith applies a predicate to check if query has an edge webhook with a given conditions (other predicates).
+func (f *WebhookDeliveryFilter) WhereHasWebhookWith(preds ...predicate.Webhook) {
+	f.Where(entql.HasEdgeWith("webhook", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
@@ -1,3 +1,3 @@
 package ent
 
-//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --feature privacy --feature entql ./schema
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --feature privacy --feature entql --feature sql/lock --feature sql/upsert ./schema
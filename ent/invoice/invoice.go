@@ -0,0 +1,130 @@
+// Code generated by entc, DO NOT EDIT.
+
+package invoice
+
+import (
+	"time"
+
+	"entgo.io/ent"
+)
+
+const (
+	// Label holds the string label denoting the invoice type in the database.
+	Label = "invoice"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the createdat field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updatedat field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldNumber holds the string denoting the number field in the database.
+	FieldNumber = "number"
+	// FieldIssuerName holds the string denoting the issuername field in the database.
+	FieldIssuerName = "issuer_name"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldLines holds the string denoting the lines field in the database.
+	FieldLines = "lines"
+	// FieldTaxLines holds the string denoting the taxlines field in the database.
+	FieldTaxLines = "tax_lines"
+	// FieldSubtotal holds the string denoting the subtotal field in the database.
+	FieldSubtotal = "subtotal"
+	// FieldTax holds the string denoting the tax field in the database.
+	FieldTax = "tax"
+	// FieldTaxInclusive holds the string denoting the taxinclusive field in the database.
+	FieldTaxInclusive = "tax_inclusive"
+	// FieldTotal holds the string denoting the total field in the database.
+	FieldTotal = "total"
+	// FieldAmountPaid holds the string denoting the amountpaid field in the database.
+	FieldAmountPaid = "amount_paid"
+	// FieldBookingId holds the string denoting the bookingid field in the database.
+	FieldBookingId = "booking_id"
+	// FieldCreditedInvoiceId holds the string denoting the creditedinvoiceid field in the database.
+	FieldCreditedInvoiceId = "credited_invoice_id"
+	// FieldOrganizationId holds the string denoting the organizationid field in the database.
+	FieldOrganizationId = "organization_id"
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgeBooking holds the string denoting the booking edge name in mutations.
+	EdgeBooking = "booking"
+	// EdgeCreditedInvoice holds the string denoting the creditedinvoice edge name in mutations.
+	EdgeCreditedInvoice = "creditedInvoice"
+	// EdgeCreditNotes holds the string denoting the creditnotes edge name in mutations.
+	EdgeCreditNotes = "creditNotes"
+	// Table holds the table name of the invoice in the database.
+	Table = "invoices"
+	// OrganizationTable is the table that holds the organization relation/edge.
+	OrganizationTable = "invoices"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+	// BookingTable is the table that holds the booking relation/edge.
+	BookingTable = "invoices"
+	// BookingInverseTable is the table name for the Booking entity.
+	// It exists in this package in order to avoid circular dependency with the "booking" package.
+	BookingInverseTable = "bookings"
+	// BookingColumn is the table column denoting the booking relation/edge.
+	BookingColumn = "booking_id"
+	// CreditedInvoiceTable is the table that holds the creditedInvoice relation/edge.
+	CreditedInvoiceTable = "invoices"
+	// CreditedInvoiceColumn is the table column denoting the creditedInvoice relation/edge.
+	CreditedInvoiceColumn = "credited_invoice_id"
+	// CreditNotesTable is the table that holds the creditNotes relation/edge.
+	CreditNotesTable = "invoices"
+	// CreditNotesColumn is the table column denoting the creditNotes relation/edge.
+	CreditNotesColumn = "credited_invoice_id"
+)
+
+// Columns holds all SQL columns for invoice fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldKind,
+	FieldNumber,
+	FieldIssuerName,
+	FieldCurrency,
+	FieldLines,
+	FieldTaxLines,
+	FieldSubtotal,
+	FieldTax,
+	FieldTaxInclusive,
+	FieldTotal,
+	FieldAmountPaid,
+	FieldBookingId,
+	FieldCreditedInvoiceId,
+	FieldOrganizationId,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/openmesh/booking/ent/runtime"
+//
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCreatedAt holds the default value on creation for the "createdAt" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updatedAt" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updatedAt" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultTaxInclusive holds the default value on creation for the "taxInclusive" field.
+	DefaultTaxInclusive bool
+)
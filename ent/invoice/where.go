@@ -0,0 +1,1609 @@
+// Code generated by entc, DO NOT EDIT.
+
+package invoice
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/openmesh/booking/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "createdAt" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updatedAt" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldKind), v))
+	})
+}
+
+// Number applies equality check predicate on the "number" field. It's identical to NumberEQ.
+func Number(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNumber), v))
+	})
+}
+
+// IssuerName applies equality check predicate on the "issuerName" field. It's identical to IssuerNameEQ.
+func IssuerName(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIssuerName), v))
+	})
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCurrency), v))
+	})
+}
+
+// Lines applies equality check predicate on the "lines" field. It's identical to LinesEQ.
+func Lines(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLines), v))
+	})
+}
+
+// TaxLines applies equality check predicate on the "taxLines" field. It's identical to TaxLinesEQ.
+func TaxLines(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxLines), v))
+	})
+}
+
+// Subtotal applies equality check predicate on the "subtotal" field. It's identical to SubtotalEQ.
+func Subtotal(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSubtotal), v))
+	})
+}
+
+// Tax applies equality check predicate on the "tax" field. It's identical to TaxEQ.
+func Tax(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTax), v))
+	})
+}
+
+// TaxInclusive applies equality check predicate on the "taxInclusive" field. It's identical to TaxInclusiveEQ.
+func TaxInclusive(v bool) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxInclusive), v))
+	})
+}
+
+// Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
+func Total(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTotal), v))
+	})
+}
+
+// AmountPaid applies equality check predicate on the "amountPaid" field. It's identical to AmountPaidEQ.
+func AmountPaid(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAmountPaid), v))
+	})
+}
+
+// BookingId applies equality check predicate on the "bookingId" field. It's identical to BookingIdEQ.
+func BookingId(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBookingId), v))
+	})
+}
+
+// CreditedInvoiceId applies equality check predicate on the "creditedInvoiceId" field. It's identical to CreditedInvoiceIdEQ.
+func CreditedInvoiceId(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreditedInvoiceId), v))
+	})
+}
+
+// OrganizationId applies equality check predicate on the "organizationId" field. It's identical to OrganizationIdEQ.
+func OrganizationId(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOrganizationId), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "createdAt" field.
+func CreatedAtEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "createdAt" field.
+func CreatedAtNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "createdAt" field.
+func CreatedAtIn(vs ...time.Time) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "createdAt" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "createdAt" field.
+func CreatedAtGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "createdAt" field.
+func CreatedAtGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "createdAt" field.
+func CreatedAtLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "createdAt" field.
+func CreatedAtLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updatedAt" field.
+func UpdatedAtEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updatedAt" field.
+func UpdatedAtNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updatedAt" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updatedAt" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updatedAt" field.
+func UpdatedAtGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updatedAt" field.
+func UpdatedAtGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updatedAt" field.
+func UpdatedAtLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updatedAt" field.
+func UpdatedAtLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldKind), v))
+	})
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldKind), v))
+	})
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldKind), v...))
+	})
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldKind), v...))
+	})
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldKind), v))
+	})
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldKind), v))
+	})
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldKind), v))
+	})
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldKind), v))
+	})
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldKind), v))
+	})
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldKind), v))
+	})
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldKind), v))
+	})
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldKind), v))
+	})
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldKind), v))
+	})
+}
+
+// NumberEQ applies the EQ predicate on the "number" field.
+func NumberEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNumber), v))
+	})
+}
+
+// NumberNEQ applies the NEQ predicate on the "number" field.
+func NumberNEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldNumber), v))
+	})
+}
+
+// NumberIn applies the In predicate on the "number" field.
+func NumberIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldNumber), v...))
+	})
+}
+
+// NumberNotIn applies the NotIn predicate on the "number" field.
+func NumberNotIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldNumber), v...))
+	})
+}
+
+// NumberGT applies the GT predicate on the "number" field.
+func NumberGT(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldNumber), v))
+	})
+}
+
+// NumberGTE applies the GTE predicate on the "number" field.
+func NumberGTE(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldNumber), v))
+	})
+}
+
+// NumberLT applies the LT predicate on the "number" field.
+func NumberLT(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldNumber), v))
+	})
+}
+
+// NumberLTE applies the LTE predicate on the "number" field.
+func NumberLTE(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldNumber), v))
+	})
+}
+
+// IssuerNameEQ applies the EQ predicate on the "issuerName" field.
+func IssuerNameEQ(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIssuerName), v))
+	})
+}
+
+// IssuerNameNEQ applies the NEQ predicate on the "issuerName" field.
+func IssuerNameNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldIssuerName), v))
+	})
+}
+
+// IssuerNameIn applies the In predicate on the "issuerName" field.
+func IssuerNameIn(vs ...string) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldIssuerName), v...))
+	})
+}
+
+// IssuerNameNotIn applies the NotIn predicate on the "issuerName" field.
+func IssuerNameNotIn(vs ...string) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldIssuerName), v...))
+	})
+}
+
+// IssuerNameGT applies the GT predicate on the "issuerName" field.
+func IssuerNameGT(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldIssuerName), v))
+	})
+}
+
+// IssuerNameGTE applies the GTE predicate on the "issuerName" field.
+func IssuerNameGTE(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldIssuerName), v))
+	})
+}
+
+// IssuerNameLT applies the LT predicate on the "issuerName" field.
+func IssuerNameLT(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldIssuerName), v))
+	})
+}
+
+// IssuerNameLTE applies the LTE predicate on the "issuerName" field.
+func IssuerNameLTE(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldIssuerName), v))
+	})
+}
+
+// IssuerNameContains applies the Contains predicate on the "issuerName" field.
+func IssuerNameContains(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldIssuerName), v))
+	})
+}
+
+// IssuerNameHasPrefix applies the HasPrefix predicate on the "issuerName" field.
+func IssuerNameHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldIssuerName), v))
+	})
+}
+
+// IssuerNameHasSuffix applies the HasSuffix predicate on the "issuerName" field.
+func IssuerNameHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldIssuerName), v))
+	})
+}
+
+// IssuerNameEqualFold applies the EqualFold predicate on the "issuerName" field.
+func IssuerNameEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldIssuerName), v))
+	})
+}
+
+// IssuerNameContainsFold applies the ContainsFold predicate on the "issuerName" field.
+func IssuerNameContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldIssuerName), v))
+	})
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCurrency), v...))
+	})
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCurrency), v...))
+	})
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCurrency), v))
+	})
+}
+
+// LinesEQ applies the EQ predicate on the "lines" field.
+func LinesEQ(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLines), v))
+	})
+}
+
+// LinesNEQ applies the NEQ predicate on the "lines" field.
+func LinesNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLines), v))
+	})
+}
+
+// LinesIn applies the In predicate on the "lines" field.
+func LinesIn(vs ...string) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLines), v...))
+	})
+}
+
+// LinesNotIn applies the NotIn predicate on the "lines" field.
+func LinesNotIn(vs ...string) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLines), v...))
+	})
+}
+
+// LinesGT applies the GT predicate on the "lines" field.
+func LinesGT(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLines), v))
+	})
+}
+
+// LinesGTE applies the GTE predicate on the "lines" field.
+func LinesGTE(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLines), v))
+	})
+}
+
+// LinesLT applies the LT predicate on the "lines" field.
+func LinesLT(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLines), v))
+	})
+}
+
+// LinesLTE applies the LTE predicate on the "lines" field.
+func LinesLTE(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLines), v))
+	})
+}
+
+// LinesContains applies the Contains predicate on the "lines" field.
+func LinesContains(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldLines), v))
+	})
+}
+
+// LinesHasPrefix applies the HasPrefix predicate on the "lines" field.
+func LinesHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldLines), v))
+	})
+}
+
+// LinesHasSuffix applies the HasSuffix predicate on the "lines" field.
+func LinesHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldLines), v))
+	})
+}
+
+// LinesEqualFold applies the EqualFold predicate on the "lines" field.
+func LinesEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldLines), v))
+	})
+}
+
+// LinesContainsFold applies the ContainsFold predicate on the "lines" field.
+func LinesContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldLines), v))
+	})
+}
+
+// TaxLinesEQ applies the EQ predicate on the "taxLines" field.
+func TaxLinesEQ(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxLines), v))
+	})
+}
+
+// TaxLinesNEQ applies the NEQ predicate on the "taxLines" field.
+func TaxLinesNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTaxLines), v))
+	})
+}
+
+// TaxLinesIn applies the In predicate on the "taxLines" field.
+func TaxLinesIn(vs ...string) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTaxLines), v...))
+	})
+}
+
+// TaxLinesNotIn applies the NotIn predicate on the "taxLines" field.
+func TaxLinesNotIn(vs ...string) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTaxLines), v...))
+	})
+}
+
+// TaxLinesGT applies the GT predicate on the "taxLines" field.
+func TaxLinesGT(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTaxLines), v))
+	})
+}
+
+// TaxLinesGTE applies the GTE predicate on the "taxLines" field.
+func TaxLinesGTE(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTaxLines), v))
+	})
+}
+
+// TaxLinesLT applies the LT predicate on the "taxLines" field.
+func TaxLinesLT(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTaxLines), v))
+	})
+}
+
+// TaxLinesLTE applies the LTE predicate on the "taxLines" field.
+func TaxLinesLTE(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTaxLines), v))
+	})
+}
+
+// TaxLinesContains applies the Contains predicate on the "taxLines" field.
+func TaxLinesContains(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTaxLines), v))
+	})
+}
+
+// TaxLinesHasPrefix applies the HasPrefix predicate on the "taxLines" field.
+func TaxLinesHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTaxLines), v))
+	})
+}
+
+// TaxLinesHasSuffix applies the HasSuffix predicate on the "taxLines" field.
+func TaxLinesHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTaxLines), v))
+	})
+}
+
+// TaxLinesEqualFold applies the EqualFold predicate on the "taxLines" field.
+func TaxLinesEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTaxLines), v))
+	})
+}
+
+// TaxLinesContainsFold applies the ContainsFold predicate on the "taxLines" field.
+func TaxLinesContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTaxLines), v))
+	})
+}
+
+// SubtotalEQ applies the EQ predicate on the "subtotal" field.
+func SubtotalEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSubtotal), v))
+	})
+}
+
+// SubtotalNEQ applies the NEQ predicate on the "subtotal" field.
+func SubtotalNEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSubtotal), v))
+	})
+}
+
+// SubtotalIn applies the In predicate on the "subtotal" field.
+func SubtotalIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSubtotal), v...))
+	})
+}
+
+// SubtotalNotIn applies the NotIn predicate on the "subtotal" field.
+func SubtotalNotIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSubtotal), v...))
+	})
+}
+
+// SubtotalGT applies the GT predicate on the "subtotal" field.
+func SubtotalGT(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSubtotal), v))
+	})
+}
+
+// SubtotalGTE applies the GTE predicate on the "subtotal" field.
+func SubtotalGTE(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSubtotal), v))
+	})
+}
+
+// SubtotalLT applies the LT predicate on the "subtotal" field.
+func SubtotalLT(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSubtotal), v))
+	})
+}
+
+// SubtotalLTE applies the LTE predicate on the "subtotal" field.
+func SubtotalLTE(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSubtotal), v))
+	})
+}
+
+// TaxEQ applies the EQ predicate on the "tax" field.
+func TaxEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTax), v))
+	})
+}
+
+// TaxNEQ applies the NEQ predicate on the "tax" field.
+func TaxNEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTax), v))
+	})
+}
+
+// TaxIn applies the In predicate on the "tax" field.
+func TaxIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTax), v...))
+	})
+}
+
+// TaxNotIn applies the NotIn predicate on the "tax" field.
+func TaxNotIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTax), v...))
+	})
+}
+
+// TaxGT applies the GT predicate on the "tax" field.
+func TaxGT(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTax), v))
+	})
+}
+
+// TaxGTE applies the GTE predicate on the "tax" field.
+func TaxGTE(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTax), v))
+	})
+}
+
+// TaxLT applies the LT predicate on the "tax" field.
+func TaxLT(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTax), v))
+	})
+}
+
+// TaxLTE applies the LTE predicate on the "tax" field.
+func TaxLTE(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTax), v))
+	})
+}
+
+// TaxInclusiveEQ applies the EQ predicate on the "taxInclusive" field.
+func TaxInclusiveEQ(v bool) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxInclusive), v))
+	})
+}
+
+// TaxInclusiveNEQ applies the NEQ predicate on the "taxInclusive" field.
+func TaxInclusiveNEQ(v bool) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTaxInclusive), v))
+	})
+}
+
+// TotalEQ applies the EQ predicate on the "total" field.
+func TotalEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTotal), v))
+	})
+}
+
+// TotalNEQ applies the NEQ predicate on the "total" field.
+func TotalNEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTotal), v))
+	})
+}
+
+// TotalIn applies the In predicate on the "total" field.
+func TotalIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTotal), v...))
+	})
+}
+
+// TotalNotIn applies the NotIn predicate on the "total" field.
+func TotalNotIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTotal), v...))
+	})
+}
+
+// TotalGT applies the GT predicate on the "total" field.
+func TotalGT(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTotal), v))
+	})
+}
+
+// TotalGTE applies the GTE predicate on the "total" field.
+func TotalGTE(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTotal), v))
+	})
+}
+
+// TotalLT applies the LT predicate on the "total" field.
+func TotalLT(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTotal), v))
+	})
+}
+
+// TotalLTE applies the LTE predicate on the "total" field.
+func TotalLTE(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTotal), v))
+	})
+}
+
+// AmountPaidEQ applies the EQ predicate on the "amountPaid" field.
+func AmountPaidEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAmountPaid), v))
+	})
+}
+
+// AmountPaidNEQ applies the NEQ predicate on the "amountPaid" field.
+func AmountPaidNEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAmountPaid), v))
+	})
+}
+
+// AmountPaidIn applies the In predicate on the "amountPaid" field.
+func AmountPaidIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAmountPaid), v...))
+	})
+}
+
+// AmountPaidNotIn applies the NotIn predicate on the "amountPaid" field.
+func AmountPaidNotIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAmountPaid), v...))
+	})
+}
+
+// AmountPaidGT applies the GT predicate on the "amountPaid" field.
+func AmountPaidGT(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAmountPaid), v))
+	})
+}
+
+// AmountPaidGTE applies the GTE predicate on the "amountPaid" field.
+func AmountPaidGTE(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAmountPaid), v))
+	})
+}
+
+// AmountPaidLT applies the LT predicate on the "amountPaid" field.
+func AmountPaidLT(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAmountPaid), v))
+	})
+}
+
+// AmountPaidLTE applies the LTE predicate on the "amountPaid" field.
+func AmountPaidLTE(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAmountPaid), v))
+	})
+}
+
+// BookingIdEQ applies the EQ predicate on the "bookingId" field.
+func BookingIdEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBookingId), v))
+	})
+}
+
+// BookingIdNEQ applies the NEQ predicate on the "bookingId" field.
+func BookingIdNEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBookingId), v))
+	})
+}
+
+// BookingIdIn applies the In predicate on the "bookingId" field.
+func BookingIdIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBookingId), v...))
+	})
+}
+
+// BookingIdNotIn applies the NotIn predicate on the "bookingId" field.
+func BookingIdNotIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBookingId), v...))
+	})
+}
+
+// CreditedInvoiceIdEQ applies the EQ predicate on the "creditedInvoiceId" field.
+func CreditedInvoiceIdEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreditedInvoiceId), v))
+	})
+}
+
+// CreditedInvoiceIdNEQ applies the NEQ predicate on the "creditedInvoiceId" field.
+func CreditedInvoiceIdNEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreditedInvoiceId), v))
+	})
+}
+
+// CreditedInvoiceIdIn applies the In predicate on the "creditedInvoiceId" field.
+func CreditedInvoiceIdIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreditedInvoiceId), v...))
+	})
+}
+
+// CreditedInvoiceIdNotIn applies the NotIn predicate on the "creditedInvoiceId" field.
+func CreditedInvoiceIdNotIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreditedInvoiceId), v...))
+	})
+}
+
+// CreditedInvoiceIdIsNil applies the IsNil predicate on the "creditedInvoiceId" field.
+func CreditedInvoiceIdIsNil() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCreditedInvoiceId)))
+	})
+}
+
+// CreditedInvoiceIdNotNil applies the NotNil predicate on the "creditedInvoiceId" field.
+func CreditedInvoiceIdNotNil() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCreditedInvoiceId)))
+	})
+}
+
+// OrganizationIdEQ applies the EQ predicate on the "organizationId" field.
+func OrganizationIdEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOrganizationId), v))
+	})
+}
+
+// OrganizationIdNEQ applies the NEQ predicate on the "organizationId" field.
+func OrganizationIdNEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOrganizationId), v))
+	})
+}
+
+// OrganizationIdIn applies the In predicate on the "organizationId" field.
+func OrganizationIdIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldOrganizationId), v...))
+	})
+}
+
+// OrganizationIdNotIn applies the NotIn predicate on the "organizationId" field.
+func OrganizationIdNotIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldOrganizationId), v...))
+	})
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(OrganizationTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(OrganizationInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBooking applies the HasEdge predicate on the "booking" edge.
+func HasBooking() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(BookingTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BookingTable, BookingColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBookingWith applies the HasEdge predicate on the "booking" edge with a given conditions (other predicates).
+func HasBookingWith(preds ...predicate.Booking) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(BookingInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BookingTable, BookingColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCreditedInvoice applies the HasEdge predicate on the "creditedInvoice" edge.
+func HasCreditedInvoice() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CreditedInvoiceTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CreditedInvoiceTable, CreditedInvoiceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCreditedInvoiceWith applies the HasEdge predicate on the "creditedInvoice" edge with a given conditions (other predicates).
+func HasCreditedInvoiceWith(preds ...predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CreditedInvoiceTable, CreditedInvoiceColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCreditNotes applies the HasEdge predicate on the "creditNotes" edge.
+func HasCreditNotes() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CreditNotesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CreditNotesTable, CreditNotesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCreditNotesWith applies the HasEdge predicate on the "creditNotes" edge with a given conditions (other predicates).
+func HasCreditNotesWith(preds ...predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CreditNotesTable, CreditNotesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
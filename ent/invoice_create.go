@@ -0,0 +1,1539 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/invoice"
+	"github.com/openmesh/booking/ent/organization"
+)
+
+// InvoiceCreate is the builder for creating a Invoice entity.
+type InvoiceCreate struct {
+	config
+	mutation *InvoiceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (ic *InvoiceCreate) SetCreatedAt(t time.Time) *InvoiceCreate {
+	ic.mutation.SetCreatedAt(t)
+	return ic
+}
+
+// SetNillableCreatedAt sets the "createdAt" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableCreatedAt(t *time.Time) *InvoiceCreate {
+	if t != nil {
+		ic.SetCreatedAt(*t)
+	}
+	return ic
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (ic *InvoiceCreate) SetUpdatedAt(t time.Time) *InvoiceCreate {
+	ic.mutation.SetUpdatedAt(t)
+	return ic
+}
+
+// SetNillableUpdatedAt sets the "updatedAt" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableUpdatedAt(t *time.Time) *InvoiceCreate {
+	if t != nil {
+		ic.SetUpdatedAt(*t)
+	}
+	return ic
+}
+
+// SetKind sets the "kind" field.
+func (ic *InvoiceCreate) SetKind(s string) *InvoiceCreate {
+	ic.mutation.SetKind(s)
+	return ic
+}
+
+// SetNumber sets the "number" field.
+func (ic *InvoiceCreate) SetNumber(i int) *InvoiceCreate {
+	ic.mutation.SetNumber(i)
+	return ic
+}
+
+// SetIssuerName sets the "issuerName" field.
+func (ic *InvoiceCreate) SetIssuerName(s string) *InvoiceCreate {
+	ic.mutation.SetIssuerName(s)
+	return ic
+}
+
+// SetCurrency sets the "currency" field.
+func (ic *InvoiceCreate) SetCurrency(s string) *InvoiceCreate {
+	ic.mutation.SetCurrency(s)
+	return ic
+}
+
+// SetLines sets the "lines" field.
+func (ic *InvoiceCreate) SetLines(s string) *InvoiceCreate {
+	ic.mutation.SetLines(s)
+	return ic
+}
+
+// SetTaxLines sets the "taxLines" field.
+func (ic *InvoiceCreate) SetTaxLines(s string) *InvoiceCreate {
+	ic.mutation.SetTaxLines(s)
+	return ic
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (ic *InvoiceCreate) SetSubtotal(i int) *InvoiceCreate {
+	ic.mutation.SetSubtotal(i)
+	return ic
+}
+
+// SetTax sets the "tax" field.
+func (ic *InvoiceCreate) SetTax(i int) *InvoiceCreate {
+	ic.mutation.SetTax(i)
+	return ic
+}
+
+// SetTaxInclusive sets the "taxInclusive" field.
+func (ic *InvoiceCreate) SetTaxInclusive(b bool) *InvoiceCreate {
+	ic.mutation.SetTaxInclusive(b)
+	return ic
+}
+
+// SetNillableTaxInclusive sets the "taxInclusive" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableTaxInclusive(b *bool) *InvoiceCreate {
+	if b != nil {
+		ic.SetTaxInclusive(*b)
+	}
+	return ic
+}
+
+// SetTotal sets the "total" field.
+func (ic *InvoiceCreate) SetTotal(i int) *InvoiceCreate {
+	ic.mutation.SetTotal(i)
+	return ic
+}
+
+// SetAmountPaid sets the "amountPaid" field.
+func (ic *InvoiceCreate) SetAmountPaid(i int) *InvoiceCreate {
+	ic.mutation.SetAmountPaid(i)
+	return ic
+}
+
+// SetBookingId sets the "bookingId" field.
+func (ic *InvoiceCreate) SetBookingId(i int) *InvoiceCreate {
+	ic.mutation.SetBookingId(i)
+	return ic
+}
+
+// SetCreditedInvoiceId sets the "creditedInvoiceId" field.
+func (ic *InvoiceCreate) SetCreditedInvoiceId(i int) *InvoiceCreate {
+	ic.mutation.SetCreditedInvoiceId(i)
+	return ic
+}
+
+// SetNillableCreditedInvoiceId sets the "creditedInvoiceId" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableCreditedInvoiceId(i *int) *InvoiceCreate {
+	if i != nil {
+		ic.SetCreditedInvoiceId(*i)
+	}
+	return ic
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (ic *InvoiceCreate) SetOrganizationId(i int) *InvoiceCreate {
+	ic.mutation.SetOrganizationId(i)
+	return ic
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (ic *InvoiceCreate) SetOrganizationID(id int) *InvoiceCreate {
+	ic.mutation.SetOrganizationID(id)
+	return ic
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (ic *InvoiceCreate) SetOrganization(o *Organization) *InvoiceCreate {
+	return ic.SetOrganizationID(o.ID)
+}
+
+// SetBookingID sets the "booking" edge to the Booking entity by ID.
+func (ic *InvoiceCreate) SetBookingID(id int) *InvoiceCreate {
+	ic.mutation.SetBookingID(id)
+	return ic
+}
+
+// SetBooking sets the "booking" edge to the Booking entity.
+func (ic *InvoiceCreate) SetBooking(b *Booking) *InvoiceCreate {
+	return ic.SetBookingID(b.ID)
+}
+
+// SetCreditedInvoiceID sets the "creditedInvoice" edge to the Invoice entity by ID.
+func (ic *InvoiceCreate) SetCreditedInvoiceID(id int) *InvoiceCreate {
+	ic.mutation.SetCreditedInvoiceID(id)
+	return ic
+}
+
+// SetNillableCreditedInvoiceID sets the "creditedInvoice" edge to the Invoice entity by ID if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableCreditedInvoiceID(id *int) *InvoiceCreate {
+	if id != nil {
+		ic = ic.SetCreditedInvoiceID(*id)
+	}
+	return ic
+}
+
+// SetCreditedInvoice sets the "creditedInvoice" edge to the Invoice entity.
+func (ic *InvoiceCreate) SetCreditedInvoice(i *Invoice) *InvoiceCreate {
+	return ic.SetCreditedInvoiceID(i.ID)
+}
+
+// AddCreditNoteIDs adds the "creditNotes" edge to the Invoice entity by IDs.
+func (ic *InvoiceCreate) AddCreditNoteIDs(ids ...int) *InvoiceCreate {
+	ic.mutation.AddCreditNoteIDs(ids...)
+	return ic
+}
+
+// AddCreditNotes adds the "creditNotes" edges to the Invoice entity.
+func (ic *InvoiceCreate) AddCreditNotes(i ...*Invoice) *InvoiceCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ic.AddCreditNoteIDs(ids...)
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (ic *InvoiceCreate) Mutation() *InvoiceMutation {
+	return ic.mutation
+}
+
+// Save creates the Invoice in the database.
+func (ic *InvoiceCreate) Save(ctx context.Context) (*Invoice, error) {
+	var (
+		err  error
+		node *Invoice
+	)
+	if err := ic.defaults(); err != nil {
+		return nil, err
+	}
+	if len(ic.hooks) == 0 {
+		if err = ic.check(); err != nil {
+			return nil, err
+		}
+		node, err = ic.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ic.check(); err != nil {
+				return nil, err
+			}
+			ic.mutation = mutation
+			if node, err = ic.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ic.hooks) - 1; i >= 0; i-- {
+			if ic.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ic.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ic.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *InvoiceCreate) SaveX(ctx context.Context) *Invoice {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ic *InvoiceCreate) Exec(ctx context.Context) error {
+	_, err := ic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ic *InvoiceCreate) ExecX(ctx context.Context) {
+	if err := ic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *InvoiceCreate) defaults() error {
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		if invoice.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized invoice.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := invoice.DefaultCreatedAt()
+		ic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ic.mutation.UpdatedAt(); !ok {
+		if invoice.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized invoice.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := invoice.DefaultUpdatedAt()
+		ic.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ic.mutation.TaxInclusive(); !ok {
+		v := invoice.DefaultTaxInclusive
+		ic.mutation.SetTaxInclusive(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *InvoiceCreate) check() error {
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "createdAt", err: errors.New(`ent: missing required field "createdAt"`)}
+	}
+	if _, ok := ic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updatedAt", err: errors.New(`ent: missing required field "updatedAt"`)}
+	}
+	if _, ok := ic.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "kind"`)}
+	}
+	if _, ok := ic.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "number"`)}
+	}
+	if _, ok := ic.mutation.IssuerName(); !ok {
+		return &ValidationError{Name: "issuerName", err: errors.New(`ent: missing required field "issuerName"`)}
+	}
+	if _, ok := ic.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "currency"`)}
+	}
+	if _, ok := ic.mutation.Lines(); !ok {
+		return &ValidationError{Name: "lines", err: errors.New(`ent: missing required field "lines"`)}
+	}
+	if _, ok := ic.mutation.TaxLines(); !ok {
+		return &ValidationError{Name: "taxLines", err: errors.New(`ent: missing required field "taxLines"`)}
+	}
+	if _, ok := ic.mutation.Subtotal(); !ok {
+		return &ValidationError{Name: "subtotal", err: errors.New(`ent: missing required field "subtotal"`)}
+	}
+	if _, ok := ic.mutation.Tax(); !ok {
+		return &ValidationError{Name: "tax", err: errors.New(`ent: missing required field "tax"`)}
+	}
+	if _, ok := ic.mutation.TaxInclusive(); !ok {
+		return &ValidationError{Name: "taxInclusive", err: errors.New(`ent: missing required field "taxInclusive"`)}
+	}
+	if _, ok := ic.mutation.Total(); !ok {
+		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "total"`)}
+	}
+	if _, ok := ic.mutation.AmountPaid(); !ok {
+		return &ValidationError{Name: "amountPaid", err: errors.New(`ent: missing required field "amountPaid"`)}
+	}
+	if _, ok := ic.mutation.BookingId(); !ok {
+		return &ValidationError{Name: "bookingId", err: errors.New(`ent: missing required field "bookingId"`)}
+	}
+	if _, ok := ic.mutation.OrganizationId(); !ok {
+		return &ValidationError{Name: "organizationId", err: errors.New(`ent: missing required field "organizationId"`)}
+	}
+	if _, ok := ic.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization", err: errors.New("ent: missing required edge \"organization\"")}
+	}
+	if _, ok := ic.mutation.BookingID(); !ok {
+		return &ValidationError{Name: "booking", err: errors.New("ent: missing required edge \"booking\"")}
+	}
+	return nil
+}
+
+func (ic *InvoiceCreate) sqlSave(ctx context.Context) (*Invoice, error) {
+	_node, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (ic *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Invoice{config: ic.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: invoice.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoice.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = ic.conflict
+	if value, ok := ic.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invoice.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := ic.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invoice.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if value, ok := ic.mutation.Kind(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldKind,
+		})
+		_node.Kind = value
+	}
+	if value, ok := ic.mutation.Number(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldNumber,
+		})
+		_node.Number = value
+	}
+	if value, ok := ic.mutation.IssuerName(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldIssuerName,
+		})
+		_node.IssuerName = value
+	}
+	if value, ok := ic.mutation.Currency(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldCurrency,
+		})
+		_node.Currency = value
+	}
+	if value, ok := ic.mutation.Lines(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldLines,
+		})
+		_node.Lines = value
+	}
+	if value, ok := ic.mutation.TaxLines(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldTaxLines,
+		})
+		_node.TaxLines = value
+	}
+	if value, ok := ic.mutation.Subtotal(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldSubtotal,
+		})
+		_node.Subtotal = value
+	}
+	if value, ok := ic.mutation.Tax(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldTax,
+		})
+		_node.Tax = value
+	}
+	if value, ok := ic.mutation.TaxInclusive(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: invoice.FieldTaxInclusive,
+		})
+		_node.TaxInclusive = value
+	}
+	if value, ok := ic.mutation.Total(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldTotal,
+		})
+		_node.Total = value
+	}
+	if value, ok := ic.mutation.AmountPaid(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldAmountPaid,
+		})
+		_node.AmountPaid = value
+	}
+	if nodes := ic.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.OrganizationTable,
+			Columns: []string{invoice.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationId = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.BookingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.BookingTable,
+			Columns: []string{invoice.BookingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BookingId = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.CreditedInvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.CreditedInvoiceTable,
+			Columns: []string{invoice.CreditedInvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CreditedInvoiceId = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.CreditNotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditNotesTable,
+			Columns: []string{invoice.CreditNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Invoice.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoiceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ic *InvoiceCreate) OnConflict(opts ...sql.ConflictOption) *InvoiceUpsertOne {
+	ic.conflict = opts
+	return &InvoiceUpsertOne{
+		create: ic,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ic *InvoiceCreate) OnConflictColumns(columns ...string) *InvoiceUpsertOne {
+	ic.conflict = append(ic.conflict, sql.ConflictColumns(columns...))
+	return &InvoiceUpsertOne{
+		create: ic,
+	}
+}
+
+type (
+	// InvoiceUpsertOne is the builder for "upsert"-ing
+	//  one Invoice node.
+	InvoiceUpsertOne struct {
+		create *InvoiceCreate
+	}
+
+	// InvoiceUpsert is the "OnConflict" setter.
+	InvoiceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *InvoiceUpsert) SetCreatedAt(v time.Time) *InvoiceUpsert {
+	u.Set(invoice.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateCreatedAt() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *InvoiceUpsert) SetUpdatedAt(v time.Time) *InvoiceUpsert {
+	u.Set(invoice.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateUpdatedAt() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldUpdatedAt)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *InvoiceUpsert) SetKind(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateKind() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldKind)
+	return u
+}
+
+// SetNumber sets the "number" field.
+func (u *InvoiceUpsert) SetNumber(v int) *InvoiceUpsert {
+	u.Set(invoice.FieldNumber, v)
+	return u
+}
+
+// UpdateNumber sets the "number" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateNumber() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldNumber)
+	return u
+}
+
+// SetIssuerName sets the "issuerName" field.
+func (u *InvoiceUpsert) SetIssuerName(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldIssuerName, v)
+	return u
+}
+
+// UpdateIssuerName sets the "issuerName" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateIssuerName() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldIssuerName)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *InvoiceUpsert) SetCurrency(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateCurrency() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldCurrency)
+	return u
+}
+
+// SetLines sets the "lines" field.
+func (u *InvoiceUpsert) SetLines(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldLines, v)
+	return u
+}
+
+// UpdateLines sets the "lines" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateLines() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldLines)
+	return u
+}
+
+// SetTaxLines sets the "taxLines" field.
+func (u *InvoiceUpsert) SetTaxLines(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldTaxLines, v)
+	return u
+}
+
+// UpdateTaxLines sets the "taxLines" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateTaxLines() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldTaxLines)
+	return u
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (u *InvoiceUpsert) SetSubtotal(v int) *InvoiceUpsert {
+	u.Set(invoice.FieldSubtotal, v)
+	return u
+}
+
+// UpdateSubtotal sets the "subtotal" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateSubtotal() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldSubtotal)
+	return u
+}
+
+// SetTax sets the "tax" field.
+func (u *InvoiceUpsert) SetTax(v int) *InvoiceUpsert {
+	u.Set(invoice.FieldTax, v)
+	return u
+}
+
+// UpdateTax sets the "tax" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateTax() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldTax)
+	return u
+}
+
+// SetTaxInclusive sets the "taxInclusive" field.
+func (u *InvoiceUpsert) SetTaxInclusive(v bool) *InvoiceUpsert {
+	u.Set(invoice.FieldTaxInclusive, v)
+	return u
+}
+
+// UpdateTaxInclusive sets the "taxInclusive" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateTaxInclusive() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldTaxInclusive)
+	return u
+}
+
+// SetTotal sets the "total" field.
+func (u *InvoiceUpsert) SetTotal(v int) *InvoiceUpsert {
+	u.Set(invoice.FieldTotal, v)
+	return u
+}
+
+// UpdateTotal sets the "total" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateTotal() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldTotal)
+	return u
+}
+
+// SetAmountPaid sets the "amountPaid" field.
+func (u *InvoiceUpsert) SetAmountPaid(v int) *InvoiceUpsert {
+	u.Set(invoice.FieldAmountPaid, v)
+	return u
+}
+
+// UpdateAmountPaid sets the "amountPaid" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateAmountPaid() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldAmountPaid)
+	return u
+}
+
+// SetBookingId sets the "bookingId" field.
+func (u *InvoiceUpsert) SetBookingId(v int) *InvoiceUpsert {
+	u.Set(invoice.FieldBookingId, v)
+	return u
+}
+
+// UpdateBookingId sets the "bookingId" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateBookingId() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldBookingId)
+	return u
+}
+
+// SetCreditedInvoiceId sets the "creditedInvoiceId" field.
+func (u *InvoiceUpsert) SetCreditedInvoiceId(v int) *InvoiceUpsert {
+	u.Set(invoice.FieldCreditedInvoiceId, v)
+	return u
+}
+
+// UpdateCreditedInvoiceId sets the "creditedInvoiceId" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateCreditedInvoiceId() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldCreditedInvoiceId)
+	return u
+}
+
+// ClearCreditedInvoiceId clears the value of the "creditedInvoiceId" field.
+func (u *InvoiceUpsert) ClearCreditedInvoiceId() *InvoiceUpsert {
+	u.SetNull(invoice.FieldCreditedInvoiceId)
+	return u
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (u *InvoiceUpsert) SetOrganizationId(v int) *InvoiceUpsert {
+	u.Set(invoice.FieldOrganizationId, v)
+	return u
+}
+
+// UpdateOrganizationId sets the "organizationId" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateOrganizationId() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldOrganizationId)
+	return u
+}
+
+// UpdateNewValues updates the fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *InvoiceUpsertOne) UpdateNewValues() *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InvoiceUpsertOne) Ignore() *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoiceUpsertOne) DoNothing() *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoiceCreate.OnConflict
+// documentation for more info.
+func (u *InvoiceUpsertOne) Update(set func(*InvoiceUpsert)) *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoiceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *InvoiceUpsertOne) SetCreatedAt(v time.Time) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateCreatedAt() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *InvoiceUpsertOne) SetUpdatedAt(v time.Time) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateUpdatedAt() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *InvoiceUpsertOne) SetKind(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateKind() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetNumber sets the "number" field.
+func (u *InvoiceUpsertOne) SetNumber(v int) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetNumber(v)
+	})
+}
+
+// UpdateNumber sets the "number" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateNumber() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateNumber()
+	})
+}
+
+// SetIssuerName sets the "issuerName" field.
+func (u *InvoiceUpsertOne) SetIssuerName(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetIssuerName(v)
+	})
+}
+
+// UpdateIssuerName sets the "issuerName" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateIssuerName() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateIssuerName()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *InvoiceUpsertOne) SetCurrency(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateCurrency() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetLines sets the "lines" field.
+func (u *InvoiceUpsertOne) SetLines(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetLines(v)
+	})
+}
+
+// UpdateLines sets the "lines" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateLines() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateLines()
+	})
+}
+
+// SetTaxLines sets the "taxLines" field.
+func (u *InvoiceUpsertOne) SetTaxLines(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetTaxLines(v)
+	})
+}
+
+// UpdateTaxLines sets the "taxLines" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateTaxLines() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateTaxLines()
+	})
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (u *InvoiceUpsertOne) SetSubtotal(v int) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetSubtotal(v)
+	})
+}
+
+// UpdateSubtotal sets the "subtotal" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateSubtotal() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateSubtotal()
+	})
+}
+
+// SetTax sets the "tax" field.
+func (u *InvoiceUpsertOne) SetTax(v int) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetTax(v)
+	})
+}
+
+// UpdateTax sets the "tax" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateTax() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateTax()
+	})
+}
+
+// SetTaxInclusive sets the "taxInclusive" field.
+func (u *InvoiceUpsertOne) SetTaxInclusive(v bool) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetTaxInclusive(v)
+	})
+}
+
+// UpdateTaxInclusive sets the "taxInclusive" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateTaxInclusive() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateTaxInclusive()
+	})
+}
+
+// SetTotal sets the "total" field.
+func (u *InvoiceUpsertOne) SetTotal(v int) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetTotal(v)
+	})
+}
+
+// UpdateTotal sets the "total" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateTotal() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateTotal()
+	})
+}
+
+// SetAmountPaid sets the "amountPaid" field.
+func (u *InvoiceUpsertOne) SetAmountPaid(v int) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetAmountPaid(v)
+	})
+}
+
+// UpdateAmountPaid sets the "amountPaid" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateAmountPaid() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateAmountPaid()
+	})
+}
+
+// SetBookingId sets the "bookingId" field.
+func (u *InvoiceUpsertOne) SetBookingId(v int) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetBookingId(v)
+	})
+}
+
+// UpdateBookingId sets the "bookingId" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateBookingId() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateBookingId()
+	})
+}
+
+// SetCreditedInvoiceId sets the "creditedInvoiceId" field.
+func (u *InvoiceUpsertOne) SetCreditedInvoiceId(v int) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetCreditedInvoiceId(v)
+	})
+}
+
+// UpdateCreditedInvoiceId sets the "creditedInvoiceId" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateCreditedInvoiceId() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateCreditedInvoiceId()
+	})
+}
+
+// ClearCreditedInvoiceId clears the value of the "creditedInvoiceId" field.
+func (u *InvoiceUpsertOne) ClearCreditedInvoiceId() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearCreditedInvoiceId()
+	})
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (u *InvoiceUpsertOne) SetOrganizationId(v int) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetOrganizationId(v)
+	})
+}
+
+// UpdateOrganizationId sets the "organizationId" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateOrganizationId() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateOrganizationId()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoiceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvoiceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoiceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InvoiceUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InvoiceUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// InvoiceCreateBulk is the builder for creating many Invoice entities in bulk.
+type InvoiceCreateBulk struct {
+	config
+	builders []*InvoiceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Invoice entities in the database.
+func (icb *InvoiceCreateBulk) Save(ctx context.Context) ([]*Invoice, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
+	nodes := make([]*Invoice, len(icb.builders))
+	mutators := make([]Mutator, len(icb.builders))
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvoiceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = icb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *InvoiceCreateBulk) SaveX(ctx context.Context) []*Invoice {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icb *InvoiceCreateBulk) Exec(ctx context.Context) error {
+	_, err := icb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icb *InvoiceCreateBulk) ExecX(ctx context.Context) {
+	if err := icb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Invoice.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoiceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (icb *InvoiceCreateBulk) OnConflict(opts ...sql.ConflictOption) *InvoiceUpsertBulk {
+	icb.conflict = opts
+	return &InvoiceUpsertBulk{
+		create: icb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (icb *InvoiceCreateBulk) OnConflictColumns(columns ...string) *InvoiceUpsertBulk {
+	icb.conflict = append(icb.conflict, sql.ConflictColumns(columns...))
+	return &InvoiceUpsertBulk{
+		create: icb,
+	}
+}
+
+// InvoiceUpsertBulk is the builder for "upsert"-ing
+// a bulk of Invoice nodes.
+type InvoiceUpsertBulk struct {
+	create *InvoiceCreateBulk
+}
+
+// UpdateNewValues updates the fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *InvoiceUpsertBulk) UpdateNewValues() *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InvoiceUpsertBulk) Ignore() *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoiceUpsertBulk) DoNothing() *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoiceCreateBulk.OnConflict
+// documentation for more info.
+func (u *InvoiceUpsertBulk) Update(set func(*InvoiceUpsert)) *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoiceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *InvoiceUpsertBulk) SetCreatedAt(v time.Time) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateCreatedAt() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *InvoiceUpsertBulk) SetUpdatedAt(v time.Time) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateUpdatedAt() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *InvoiceUpsertBulk) SetKind(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateKind() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetNumber sets the "number" field.
+func (u *InvoiceUpsertBulk) SetNumber(v int) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetNumber(v)
+	})
+}
+
+// UpdateNumber sets the "number" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateNumber() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateNumber()
+	})
+}
+
+// SetIssuerName sets the "issuerName" field.
+func (u *InvoiceUpsertBulk) SetIssuerName(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetIssuerName(v)
+	})
+}
+
+// UpdateIssuerName sets the "issuerName" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateIssuerName() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateIssuerName()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *InvoiceUpsertBulk) SetCurrency(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateCurrency() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetLines sets the "lines" field.
+func (u *InvoiceUpsertBulk) SetLines(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetLines(v)
+	})
+}
+
+// UpdateLines sets the "lines" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateLines() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateLines()
+	})
+}
+
+// SetTaxLines sets the "taxLines" field.
+func (u *InvoiceUpsertBulk) SetTaxLines(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetTaxLines(v)
+	})
+}
+
+// UpdateTaxLines sets the "taxLines" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateTaxLines() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateTaxLines()
+	})
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (u *InvoiceUpsertBulk) SetSubtotal(v int) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetSubtotal(v)
+	})
+}
+
+// UpdateSubtotal sets the "subtotal" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateSubtotal() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateSubtotal()
+	})
+}
+
+// SetTax sets the "tax" field.
+func (u *InvoiceUpsertBulk) SetTax(v int) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetTax(v)
+	})
+}
+
+// UpdateTax sets the "tax" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateTax() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateTax()
+	})
+}
+
+// SetTaxInclusive sets the "taxInclusive" field.
+func (u *InvoiceUpsertBulk) SetTaxInclusive(v bool) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetTaxInclusive(v)
+	})
+}
+
+// UpdateTaxInclusive sets the "taxInclusive" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateTaxInclusive() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateTaxInclusive()
+	})
+}
+
+// SetTotal sets the "total" field.
+func (u *InvoiceUpsertBulk) SetTotal(v int) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetTotal(v)
+	})
+}
+
+// UpdateTotal sets the "total" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateTotal() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateTotal()
+	})
+}
+
+// SetAmountPaid sets the "amountPaid" field.
+func (u *InvoiceUpsertBulk) SetAmountPaid(v int) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetAmountPaid(v)
+	})
+}
+
+// UpdateAmountPaid sets the "amountPaid" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateAmountPaid() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateAmountPaid()
+	})
+}
+
+// SetBookingId sets the "bookingId" field.
+func (u *InvoiceUpsertBulk) SetBookingId(v int) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetBookingId(v)
+	})
+}
+
+// UpdateBookingId sets the "bookingId" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateBookingId() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateBookingId()
+	})
+}
+
+// SetCreditedInvoiceId sets the "creditedInvoiceId" field.
+func (u *InvoiceUpsertBulk) SetCreditedInvoiceId(v int) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetCreditedInvoiceId(v)
+	})
+}
+
+// UpdateCreditedInvoiceId sets the "creditedInvoiceId" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateCreditedInvoiceId() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateCreditedInvoiceId()
+	})
+}
+
+// ClearCreditedInvoiceId clears the value of the "creditedInvoiceId" field.
+func (u *InvoiceUpsertBulk) ClearCreditedInvoiceId() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearCreditedInvoiceId()
+	})
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (u *InvoiceUpsertBulk) SetOrganizationId(v int) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetOrganizationId(v)
+	})
+}
+
+// UpdateOrganizationId sets the "organizationId" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateOrganizationId() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateOrganizationId()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoiceUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InvoiceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvoiceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoiceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,491 @@
+package ent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/openmesh/booking"
+	"github.com/openmesh/booking/ent/invoice"
+	"github.com/openmesh/booking/ent/organization"
+	"github.com/openmesh/booking/ent/payment"
+	"github.com/openmesh/booking/ent/promoredemption"
+)
+
+type invoiceService struct {
+	client *Client
+}
+
+// NewInvoiceService constructs a new instance of a booking.InvoiceService using
+// ent as its persistence layer.
+func NewInvoiceService(client *Client) *invoiceService {
+	return &invoiceService{client}
+}
+
+// FindInvoiceByID retrieves a single invoice or credit note by ID. Returns
+// EINVOICENOTFOUND if the invoice does not exist or user does not have
+// permission to view it.
+func (s *invoiceService) FindInvoiceByID(
+	ctx context.Context,
+	req booking.FindInvoiceByIDRequest,
+) booking.FindInvoiceByIDResponse {
+	i, err := s.client.Invoice.Get(ctx, req.ID)
+	var nfe *NotFoundError
+	if errors.As(err, &nfe) {
+		return booking.FindInvoiceByIDResponse{
+			Err: booking.Errorf(booking.EINVOICENOTFOUND, "Could not find invoice with ID %d", req.ID),
+		}
+	}
+	if err != nil {
+		return booking.FindInvoiceByIDResponse{
+			Err: fmt.Errorf("failed to find invoice: %w", err),
+		}
+	}
+
+	m, err := i.toModel()
+	if err != nil {
+		return booking.FindInvoiceByIDResponse{Err: err}
+	}
+	return booking.FindInvoiceByIDResponse{Invoice: m}
+}
+
+// FindInvoices retrieves the invoices and credit notes of the current
+// organization, most recent first.
+func (s *invoiceService) FindInvoices(
+	ctx context.Context,
+	req booking.FindInvoicesRequest,
+) booking.FindInvoicesResponse {
+	q := s.client.Invoice.Query()
+	if req.BookingID != nil {
+		q.Where(invoice.BookingId(*req.BookingID))
+	}
+	if req.Kind != nil {
+		q.Where(invoice.Kind(*req.Kind))
+	}
+	if req.From != nil {
+		q.Where(invoice.CreatedAtGTE(*req.From))
+	}
+	if req.To != nil {
+		q.Where(invoice.CreatedAtLT(*req.To))
+	}
+
+	c, err := q.Clone().Count(ctx)
+	if err != nil {
+		return booking.FindInvoicesResponse{
+			Err: fmt.Errorf("failed to count invoices: %w", err),
+		}
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = 10
+	}
+	i, err := q.
+		Order(Desc(invoice.FieldCreatedAt), Desc(invoice.FieldID)).
+		Offset(req.Offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return booking.FindInvoicesResponse{
+			Err: fmt.Errorf("failed to query invoices: %w", err),
+		}
+	}
+
+	invoices, err := Invoices(i).toModels()
+	if err != nil {
+		return booking.FindInvoicesResponse{Err: err}
+	}
+	return booking.FindInvoicesResponse{
+		Invoices:   invoices,
+		TotalItems: c,
+	}
+}
+
+// FindBookingInvoice retrieves the invoice of a booking. Returns
+// EINVOICENOTFOUND if the booking hasn't been invoiced yet.
+func (s *invoiceService) FindBookingInvoice(
+	ctx context.Context,
+	req booking.FindBookingInvoiceRequest,
+) booking.FindBookingInvoiceResponse {
+	i, err := s.client.Invoice.
+		Query().
+		Where(
+			invoice.BookingId(req.BookingID),
+			invoice.Kind(booking.InvoiceKindInvoice),
+		).
+		Only(ctx)
+	var nfe *NotFoundError
+	if errors.As(err, &nfe) {
+		return booking.FindBookingInvoiceResponse{
+			Err: booking.Errorf(
+				booking.EINVOICENOTFOUND,
+				"Could not find invoice of booking with ID %d",
+				req.BookingID,
+			),
+		}
+	}
+	if err != nil {
+		return booking.FindBookingInvoiceResponse{
+			Err: fmt.Errorf("failed to find invoice: %w", err),
+		}
+	}
+
+	m, err := i.toModel()
+	if err != nil {
+		return booking.FindBookingInvoiceResponse{Err: err}
+	}
+	return booking.FindBookingInvoiceResponse{Invoice: m}
+}
+
+// IssueBookingInvoice issues the invoice of a booking that was paid for
+// outside of the payment provider, or returns its existing invoice.
+func (s *invoiceService) IssueBookingInvoice(
+	ctx context.Context,
+	req booking.IssueBookingInvoiceRequest,
+) booking.IssueBookingInvoiceResponse {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return booking.IssueBookingInvoiceResponse{
+			Err: fmt.Errorf("failed to start transaction: %w", err),
+		}
+	}
+
+	b, err := findBookingByID(ctx, tx, req.BookingID, func(q *BookingQuery) *BookingQuery {
+		return q.WithResource()
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.IssueBookingInvoiceResponse{Err: err}
+	}
+	switch b.Status {
+	case booking.BookingStatusHeld, booking.BookingStatusPending, booking.BookingStatusExpired:
+		_ = tx.Rollback()
+		return booking.IssueBookingInvoiceResponse{
+			Err: booking.Errorf(
+				booking.EINVALIDTRANSITION,
+				"Cannot invoice booking with status '%s'",
+				b.Status,
+			),
+		}
+	}
+
+	i, err := issueBookingInvoice(ctx, tx, b)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.IssueBookingInvoiceResponse{Err: err}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return booking.IssueBookingInvoiceResponse{
+			Err: fmt.Errorf("failed to commit transaction: %w", err),
+		}
+	}
+
+	m, err := i.toModel()
+	if err != nil {
+		return booking.IssueBookingInvoiceResponse{Err: err}
+	}
+	return booking.IssueBookingInvoiceResponse{Invoice: m}
+}
+
+// invoicePaidBooking issues the invoice of the booking with the given ID once
+// its deposit has been paid.
+func invoicePaidBooking(ctx context.Context, tx *Tx, bookingID int) error {
+	b, err := findBookingByID(ctx, tx, bookingID, func(q *BookingQuery) *BookingQuery {
+		return q.WithResource()
+	})
+	if err != nil {
+		return err
+	}
+	_, err = issueBookingInvoice(ctx, tx, b)
+	return err
+}
+
+// issueBookingInvoice issues the invoice of booking b, which must have its
+// resource loaded. The invoice bills the price of the booking, shows the
+// discounts that were given on it and subtracts the deposits that have been
+// paid from the amount due. Returns the existing invoice if b has already been
+// invoiced.
+func issueBookingInvoice(ctx context.Context, tx *Tx, b *Booking) (*Invoice, error) {
+	r := b.Edges.Resource
+	org, err := lockOrganization(ctx, tx, r.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The check happens after the organization is locked so that concurrent
+	// requests can't both issue an invoice.
+	existing, err := tx.Invoice.
+		Query().
+		Where(
+			invoice.BookingId(b.ID),
+			invoice.Kind(booking.InvoiceKindInvoice),
+		).
+		Only(ctx)
+	var nfe *NotFoundError
+	if err == nil {
+		return existing, nil
+	}
+	if !errors.As(err, &nfe) {
+		return nil, fmt.Errorf("failed to query invoice: %w", err)
+	}
+
+	loc, err := booking.LoadTimezone(r.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	redemptions, err := tx.PromoRedemption.
+		Query().
+		Where(promoredemption.BookingId(b.ID)).
+		WithPromoCode().
+		Order(Asc(promoredemption.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query promo redemptions: %w", err)
+	}
+	payments, err := tx.Payment.
+		Query().
+		Where(
+			payment.BookingId(b.ID),
+			payment.StatusIn(
+				booking.PaymentStatusSucceeded,
+				booking.PaymentStatusPartiallyRefunded,
+				booking.PaymentStatusRefunded,
+			),
+		).
+		Order(Asc(payment.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query payments: %w", err)
+	}
+
+	// Discounts are taken off before tax so the booking line and discounts
+	// add up to the subtotal: the net price, or the gross price if prices
+	// include tax.
+	taxed := b.taxed()
+	tax := b.appliedTax()
+	subtotal := taxed.Net
+	taxLines := []*booking.InvoiceTaxLine{}
+	if tax != nil {
+		if tax.Inclusive {
+			subtotal = taxed.Gross
+		}
+		taxLines = append(taxLines, &booking.InvoiceTaxLine{
+			Name:   tax.Name,
+			Rate:   tax.Rate,
+			Net:    taxed.Net,
+			Amount: taxed.Tax,
+		})
+	}
+	discount := 0
+	for _, pr := range redemptions {
+		discount += pr.Discount
+	}
+	lines := []*booking.InvoiceLine{{
+		Kind: booking.InvoiceLineBooking,
+		Description: fmt.Sprintf(
+			"%s from %s to %s",
+			r.Name,
+			b.StartTime.In(loc).Format(invoiceTimeLayout),
+			b.EndTime.In(loc).Format(invoiceTimeLayout),
+		),
+		Amount: subtotal + discount,
+	}}
+	for _, pr := range redemptions {
+		code := ""
+		if pr.Edges.PromoCode != nil {
+			code = pr.Edges.PromoCode.Code
+		}
+		lines = append(lines, &booking.InvoiceLine{
+			Kind:        booking.InvoiceLineDiscount,
+			Description: fmt.Sprintf("Promo code %s", code),
+			Amount:      -pr.Discount,
+		})
+	}
+	paid := 0
+	for _, p := range payments {
+		paid += p.Amount
+		lines = append(lines, &booking.InvoiceLine{
+			Kind:        booking.InvoiceLineDeposit,
+			Description: fmt.Sprintf("Deposit paid on %s", p.CreatedAt.In(loc).Format(invoiceDateLayout)),
+			Amount:      -p.Amount,
+		})
+	}
+
+	return createInvoice(ctx, tx, org, &booking.Invoice{
+		Kind:         booking.InvoiceKindInvoice,
+		BookingID:    b.ID,
+		Currency:     b.Currency,
+		Lines:        lines,
+		TaxLines:     taxLines,
+		Subtotal:     subtotal,
+		Tax:          taxed.Tax,
+		TaxInclusive: tax != nil && tax.Inclusive,
+		Total:        taxed.Gross,
+		AmountPaid:   paid,
+	})
+}
+
+// issueCreditNote issues a credit note for amount refunded to the customer of
+// the booking with the given ID. Bookings that haven't been invoiced, e.g.
+// because they were paid for before invoices were issued, don't get a credit
+// note so nil is returned.
+func issueCreditNote(ctx context.Context, tx *Tx, bookingID int, amount int) (*Invoice, error) {
+	i, err := tx.Invoice.
+		Query().
+		Where(
+			invoice.BookingId(bookingID),
+			invoice.Kind(booking.InvoiceKindInvoice),
+		).
+		Only(ctx)
+	var nfe *NotFoundError
+	if errors.As(err, &nfe) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query invoice: %w", err)
+	}
+	org, err := lockOrganization(ctx, tx, i.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	credited, err := i.toModel()
+	if err != nil {
+		return nil, err
+	}
+	lines, taxLines := credited.CreditNote(amount)
+	subtotal, tax := 0, 0
+	for _, l := range lines {
+		subtotal += l.Amount
+	}
+	for _, t := range taxLines {
+		tax += t.Amount
+	}
+	return createInvoice(ctx, tx, org, &booking.Invoice{
+		Kind:              booking.InvoiceKindCreditNote,
+		BookingID:         bookingID,
+		CreditedInvoiceID: &i.ID,
+		Currency:          i.Currency,
+		Lines:             lines,
+		TaxLines:          taxLines,
+		Subtotal:          subtotal,
+		Tax:               tax,
+		TaxInclusive:      credited.TaxInclusive,
+		Total:             amount,
+		AmountPaid:        amount,
+	})
+}
+
+// Layouts of the times and dates shown on invoice lines.
+const (
+	invoiceTimeLayout = "2 Jan 2006 15:04"
+	invoiceDateLayout = "2 Jan 2006"
+)
+
+// createInvoice saves m as the next invoice of its kind of organization org,
+// which must be locked.
+func createInvoice(ctx context.Context, tx *Tx, org *Organization, m *booking.Invoice) (*Invoice, error) {
+	number := 1
+	last, err := tx.Invoice.
+		Query().
+		Where(
+			invoice.OrganizationId(org.ID),
+			invoice.Kind(m.Kind),
+		).
+		Order(Desc(invoice.FieldNumber)).
+		First(ctx)
+	var nfe *NotFoundError
+	if err == nil {
+		number = last.Number + 1
+	} else if !errors.As(err, &nfe) {
+		return nil, fmt.Errorf("failed to query invoice number: %w", err)
+	}
+
+	lines, err := json.Marshal(m.Lines)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode invoice lines: %w", err)
+	}
+	taxLines, err := json.Marshal(m.TaxLines)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode invoice tax lines: %w", err)
+	}
+
+	i, err := tx.Invoice.
+		Create().
+		SetKind(m.Kind).
+		SetNumber(number).
+		SetIssuerName(org.Name).
+		SetCurrency(m.Currency).
+		SetLines(string(lines)).
+		SetTaxLines(string(taxLines)).
+		SetSubtotal(m.Subtotal).
+		SetTax(m.Tax).
+		SetTaxInclusive(m.TaxInclusive).
+		SetTotal(m.Total).
+		SetAmountPaid(m.AmountPaid).
+		SetBookingId(m.BookingID).
+		SetNillableCreditedInvoiceId(m.CreditedInvoiceID).
+		SetOrganizationId(org.ID).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create invoice: %w", err)
+	}
+	return i, nil
+}
+
+// lockOrganization returns the organization with the given ID, locked for
+// update so that its invoices are numbered one at a time.
+func lockOrganization(ctx context.Context, tx *Tx, id int) (*Organization, error) {
+	org, err := tx.Organization.
+		Query().
+		Where(organization.ID(id)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock organization: %w", err)
+	}
+	return org, nil
+}
+
+func (i *Invoice) toModel() (*booking.Invoice, error) {
+	result := &booking.Invoice{
+		ID:                i.ID,
+		Number:            booking.InvoiceNumber(i.Kind, i.Number),
+		Kind:              i.Kind,
+		BookingID:         i.BookingId,
+		CreditedInvoiceID: i.CreditedInvoiceId,
+		IssuerName:        i.IssuerName,
+		Currency:          i.Currency,
+		Subtotal:          i.Subtotal,
+		Tax:               i.Tax,
+		TaxInclusive:      i.TaxInclusive,
+		Total:             i.Total,
+		AmountPaid:        i.AmountPaid,
+		IssuedAt:          i.CreatedAt,
+	}
+	if err := json.Unmarshal([]byte(i.Lines), &result.Lines); err != nil {
+		return nil, fmt.Errorf("failed to decode invoice lines: %w", err)
+	}
+	if err := json.Unmarshal([]byte(i.TaxLines), &result.TaxLines); err != nil {
+		return nil, fmt.Errorf("failed to decode invoice tax lines: %w", err)
+	}
+	if result.AmountPaid < result.Total {
+		result.AmountDue = result.Total - result.AmountPaid
+	}
+	return result, nil
+}
+
+func (i Invoices) toModels() ([]*booking.Invoice, error) {
+	var invoices []*booking.Invoice
+	for _, v := range i {
+		m, err := v.toModel()
+		if err != nil {
+			return nil, err
+		}
+		invoices = append(invoices, m)
+	}
+	return invoices, nil
+}
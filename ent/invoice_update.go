@@ -0,0 +1,1346 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/invoice"
+	"github.com/openmesh/booking/ent/organization"
+	"github.com/openmesh/booking/ent/predicate"
+)
+
+// InvoiceUpdate is the builder for updating Invoice entities.
+type InvoiceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvoiceMutation
+}
+
+// Where appends a list predicates to the InvoiceUpdate builder.
+func (iu *InvoiceUpdate) Where(ps ...predicate.Invoice) *InvoiceUpdate {
+	iu.mutation.Where(ps...)
+	return iu
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (iu *InvoiceUpdate) SetUpdatedAt(t time.Time) *InvoiceUpdate {
+	iu.mutation.SetUpdatedAt(t)
+	return iu
+}
+
+// SetKind sets the "kind" field.
+func (iu *InvoiceUpdate) SetKind(s string) *InvoiceUpdate {
+	iu.mutation.SetKind(s)
+	return iu
+}
+
+// SetNumber sets the "number" field.
+func (iu *InvoiceUpdate) SetNumber(i int) *InvoiceUpdate {
+	iu.mutation.ResetNumber()
+	iu.mutation.SetNumber(i)
+	return iu
+}
+
+// AddNumber adds i to the "number" field.
+func (iu *InvoiceUpdate) AddNumber(i int) *InvoiceUpdate {
+	iu.mutation.AddNumber(i)
+	return iu
+}
+
+// SetIssuerName sets the "issuerName" field.
+func (iu *InvoiceUpdate) SetIssuerName(s string) *InvoiceUpdate {
+	iu.mutation.SetIssuerName(s)
+	return iu
+}
+
+// SetCurrency sets the "currency" field.
+func (iu *InvoiceUpdate) SetCurrency(s string) *InvoiceUpdate {
+	iu.mutation.SetCurrency(s)
+	return iu
+}
+
+// SetLines sets the "lines" field.
+func (iu *InvoiceUpdate) SetLines(s string) *InvoiceUpdate {
+	iu.mutation.SetLines(s)
+	return iu
+}
+
+// SetTaxLines sets the "taxLines" field.
+func (iu *InvoiceUpdate) SetTaxLines(s string) *InvoiceUpdate {
+	iu.mutation.SetTaxLines(s)
+	return iu
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (iu *InvoiceUpdate) SetSubtotal(i int) *InvoiceUpdate {
+	iu.mutation.ResetSubtotal()
+	iu.mutation.SetSubtotal(i)
+	return iu
+}
+
+// AddSubtotal adds i to the "subtotal" field.
+func (iu *InvoiceUpdate) AddSubtotal(i int) *InvoiceUpdate {
+	iu.mutation.AddSubtotal(i)
+	return iu
+}
+
+// SetTax sets the "tax" field.
+func (iu *InvoiceUpdate) SetTax(i int) *InvoiceUpdate {
+	iu.mutation.ResetTax()
+	iu.mutation.SetTax(i)
+	return iu
+}
+
+// AddTax adds i to the "tax" field.
+func (iu *InvoiceUpdate) AddTax(i int) *InvoiceUpdate {
+	iu.mutation.AddTax(i)
+	return iu
+}
+
+// SetTaxInclusive sets the "taxInclusive" field.
+func (iu *InvoiceUpdate) SetTaxInclusive(b bool) *InvoiceUpdate {
+	iu.mutation.SetTaxInclusive(b)
+	return iu
+}
+
+// SetNillableTaxInclusive sets the "taxInclusive" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableTaxInclusive(b *bool) *InvoiceUpdate {
+	if b != nil {
+		iu.SetTaxInclusive(*b)
+	}
+	return iu
+}
+
+// SetTotal sets the "total" field.
+func (iu *InvoiceUpdate) SetTotal(i int) *InvoiceUpdate {
+	iu.mutation.ResetTotal()
+	iu.mutation.SetTotal(i)
+	return iu
+}
+
+// AddTotal adds i to the "total" field.
+func (iu *InvoiceUpdate) AddTotal(i int) *InvoiceUpdate {
+	iu.mutation.AddTotal(i)
+	return iu
+}
+
+// SetAmountPaid sets the "amountPaid" field.
+func (iu *InvoiceUpdate) SetAmountPaid(i int) *InvoiceUpdate {
+	iu.mutation.ResetAmountPaid()
+	iu.mutation.SetAmountPaid(i)
+	return iu
+}
+
+// AddAmountPaid adds i to the "amountPaid" field.
+func (iu *InvoiceUpdate) AddAmountPaid(i int) *InvoiceUpdate {
+	iu.mutation.AddAmountPaid(i)
+	return iu
+}
+
+// SetBookingId sets the "bookingId" field.
+func (iu *InvoiceUpdate) SetBookingId(i int) *InvoiceUpdate {
+	iu.mutation.SetBookingId(i)
+	return iu
+}
+
+// SetCreditedInvoiceId sets the "creditedInvoiceId" field.
+func (iu *InvoiceUpdate) SetCreditedInvoiceId(i int) *InvoiceUpdate {
+	iu.mutation.SetCreditedInvoiceId(i)
+	return iu
+}
+
+// SetNillableCreditedInvoiceId sets the "creditedInvoiceId" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableCreditedInvoiceId(i *int) *InvoiceUpdate {
+	if i != nil {
+		iu.SetCreditedInvoiceId(*i)
+	}
+	return iu
+}
+
+// ClearCreditedInvoiceId clears the value of the "creditedInvoiceId" field.
+func (iu *InvoiceUpdate) ClearCreditedInvoiceId() *InvoiceUpdate {
+	iu.mutation.ClearCreditedInvoiceId()
+	return iu
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (iu *InvoiceUpdate) SetOrganizationId(i int) *InvoiceUpdate {
+	iu.mutation.SetOrganizationId(i)
+	return iu
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (iu *InvoiceUpdate) SetOrganizationID(id int) *InvoiceUpdate {
+	iu.mutation.SetOrganizationID(id)
+	return iu
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (iu *InvoiceUpdate) SetOrganization(o *Organization) *InvoiceUpdate {
+	return iu.SetOrganizationID(o.ID)
+}
+
+// SetBookingID sets the "booking" edge to the Booking entity by ID.
+func (iu *InvoiceUpdate) SetBookingID(id int) *InvoiceUpdate {
+	iu.mutation.SetBookingID(id)
+	return iu
+}
+
+// SetBooking sets the "booking" edge to the Booking entity.
+func (iu *InvoiceUpdate) SetBooking(b *Booking) *InvoiceUpdate {
+	return iu.SetBookingID(b.ID)
+}
+
+// SetCreditedInvoiceID sets the "creditedInvoice" edge to the Invoice entity by ID.
+func (iu *InvoiceUpdate) SetCreditedInvoiceID(id int) *InvoiceUpdate {
+	iu.mutation.SetCreditedInvoiceID(id)
+	return iu
+}
+
+// SetNillableCreditedInvoiceID sets the "creditedInvoice" edge to the Invoice entity by ID if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableCreditedInvoiceID(id *int) *InvoiceUpdate {
+	if id != nil {
+		iu = iu.SetCreditedInvoiceID(*id)
+	}
+	return iu
+}
+
+// SetCreditedInvoice sets the "creditedInvoice" edge to the Invoice entity.
+func (iu *InvoiceUpdate) SetCreditedInvoice(i *Invoice) *InvoiceUpdate {
+	return iu.SetCreditedInvoiceID(i.ID)
+}
+
+// AddCreditNoteIDs adds the "creditNotes" edge to the Invoice entity by IDs.
+func (iu *InvoiceUpdate) AddCreditNoteIDs(ids ...int) *InvoiceUpdate {
+	iu.mutation.AddCreditNoteIDs(ids...)
+	return iu
+}
+
+// AddCreditNotes adds the "creditNotes" edges to the Invoice entity.
+func (iu *InvoiceUpdate) AddCreditNotes(i ...*Invoice) *InvoiceUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.AddCreditNoteIDs(ids...)
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (iu *InvoiceUpdate) Mutation() *InvoiceMutation {
+	return iu.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (iu *InvoiceUpdate) ClearOrganization() *InvoiceUpdate {
+	iu.mutation.ClearOrganization()
+	return iu
+}
+
+// ClearBooking clears the "booking" edge to the Booking entity.
+func (iu *InvoiceUpdate) ClearBooking() *InvoiceUpdate {
+	iu.mutation.ClearBooking()
+	return iu
+}
+
+// ClearCreditedInvoice clears the "creditedInvoice" edge to the Invoice entity.
+func (iu *InvoiceUpdate) ClearCreditedInvoice() *InvoiceUpdate {
+	iu.mutation.ClearCreditedInvoice()
+	return iu
+}
+
+// ClearCreditNotes clears all "creditNotes" edges to the Invoice entity.
+func (iu *InvoiceUpdate) ClearCreditNotes() *InvoiceUpdate {
+	iu.mutation.ClearCreditNotes()
+	return iu
+}
+
+// RemoveCreditNoteIDs removes the "creditNotes" edge to Invoice entities by IDs.
+func (iu *InvoiceUpdate) RemoveCreditNoteIDs(ids ...int) *InvoiceUpdate {
+	iu.mutation.RemoveCreditNoteIDs(ids...)
+	return iu
+}
+
+// RemoveCreditNotes removes "creditNotes" edges to Invoice entities.
+func (iu *InvoiceUpdate) RemoveCreditNotes(i ...*Invoice) *InvoiceUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.RemoveCreditNoteIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iu *InvoiceUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if err := iu.defaults(); err != nil {
+		return 0, err
+	}
+	if len(iu.hooks) == 0 {
+		if err = iu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = iu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = iu.check(); err != nil {
+				return 0, err
+			}
+			iu.mutation = mutation
+			affected, err = iu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(iu.hooks) - 1; i >= 0; i-- {
+			if iu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = iu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iu *InvoiceUpdate) SaveX(ctx context.Context) int {
+	affected, err := iu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iu *InvoiceUpdate) Exec(ctx context.Context) error {
+	_, err := iu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iu *InvoiceUpdate) ExecX(ctx context.Context) {
+	if err := iu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iu *InvoiceUpdate) defaults() error {
+	if _, ok := iu.mutation.UpdatedAt(); !ok {
+		if invoice.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized invoice.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := invoice.UpdateDefaultUpdatedAt()
+		iu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iu *InvoiceUpdate) check() error {
+	if _, ok := iu.mutation.OrganizationID(); iu.mutation.OrganizationCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"organization\"")
+	}
+	if _, ok := iu.mutation.BookingID(); iu.mutation.BookingCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"booking\"")
+	}
+	return nil
+}
+
+func (iu *InvoiceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invoice.Table,
+			Columns: invoice.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoice.FieldID,
+			},
+		},
+	}
+	if ps := iu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invoice.FieldUpdatedAt,
+		})
+	}
+	if value, ok := iu.mutation.Kind(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldKind,
+		})
+	}
+	if value, ok := iu.mutation.Number(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldNumber,
+		})
+	}
+	if value, ok := iu.mutation.AddedNumber(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldNumber,
+		})
+	}
+	if value, ok := iu.mutation.IssuerName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldIssuerName,
+		})
+	}
+	if value, ok := iu.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldCurrency,
+		})
+	}
+	if value, ok := iu.mutation.Lines(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldLines,
+		})
+	}
+	if value, ok := iu.mutation.TaxLines(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldTaxLines,
+		})
+	}
+	if value, ok := iu.mutation.Subtotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldSubtotal,
+		})
+	}
+	if value, ok := iu.mutation.AddedSubtotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldSubtotal,
+		})
+	}
+	if value, ok := iu.mutation.Tax(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldTax,
+		})
+	}
+	if value, ok := iu.mutation.AddedTax(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldTax,
+		})
+	}
+	if value, ok := iu.mutation.TaxInclusive(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: invoice.FieldTaxInclusive,
+		})
+	}
+	if value, ok := iu.mutation.Total(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldTotal,
+		})
+	}
+	if value, ok := iu.mutation.AddedTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldTotal,
+		})
+	}
+	if value, ok := iu.mutation.AmountPaid(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldAmountPaid,
+		})
+	}
+	if value, ok := iu.mutation.AddedAmountPaid(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldAmountPaid,
+		})
+	}
+	if iu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.OrganizationTable,
+			Columns: []string{invoice.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.OrganizationTable,
+			Columns: []string{invoice.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.BookingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.BookingTable,
+			Columns: []string{invoice.BookingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.BookingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.BookingTable,
+			Columns: []string{invoice.BookingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.CreditedInvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.CreditedInvoiceTable,
+			Columns: []string{invoice.CreditedInvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.CreditedInvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.CreditedInvoiceTable,
+			Columns: []string{invoice.CreditedInvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.CreditNotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditNotesTable,
+			Columns: []string{invoice.CreditNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedCreditNotesIDs(); len(nodes) > 0 && !iu.mutation.CreditNotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditNotesTable,
+			Columns: []string{invoice.CreditNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.CreditNotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditNotesTable,
+			Columns: []string{invoice.CreditNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// InvoiceUpdateOne is the builder for updating a single Invoice entity.
+type InvoiceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvoiceMutation
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (iuo *InvoiceUpdateOne) SetUpdatedAt(t time.Time) *InvoiceUpdateOne {
+	iuo.mutation.SetUpdatedAt(t)
+	return iuo
+}
+
+// SetKind sets the "kind" field.
+func (iuo *InvoiceUpdateOne) SetKind(s string) *InvoiceUpdateOne {
+	iuo.mutation.SetKind(s)
+	return iuo
+}
+
+// SetNumber sets the "number" field.
+func (iuo *InvoiceUpdateOne) SetNumber(i int) *InvoiceUpdateOne {
+	iuo.mutation.ResetNumber()
+	iuo.mutation.SetNumber(i)
+	return iuo
+}
+
+// AddNumber adds i to the "number" field.
+func (iuo *InvoiceUpdateOne) AddNumber(i int) *InvoiceUpdateOne {
+	iuo.mutation.AddNumber(i)
+	return iuo
+}
+
+// SetIssuerName sets the "issuerName" field.
+func (iuo *InvoiceUpdateOne) SetIssuerName(s string) *InvoiceUpdateOne {
+	iuo.mutation.SetIssuerName(s)
+	return iuo
+}
+
+// SetCurrency sets the "currency" field.
+func (iuo *InvoiceUpdateOne) SetCurrency(s string) *InvoiceUpdateOne {
+	iuo.mutation.SetCurrency(s)
+	return iuo
+}
+
+// SetLines sets the "lines" field.
+func (iuo *InvoiceUpdateOne) SetLines(s string) *InvoiceUpdateOne {
+	iuo.mutation.SetLines(s)
+	return iuo
+}
+
+// SetTaxLines sets the "taxLines" field.
+func (iuo *InvoiceUpdateOne) SetTaxLines(s string) *InvoiceUpdateOne {
+	iuo.mutation.SetTaxLines(s)
+	return iuo
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (iuo *InvoiceUpdateOne) SetSubtotal(i int) *InvoiceUpdateOne {
+	iuo.mutation.ResetSubtotal()
+	iuo.mutation.SetSubtotal(i)
+	return iuo
+}
+
+// AddSubtotal adds i to the "subtotal" field.
+func (iuo *InvoiceUpdateOne) AddSubtotal(i int) *InvoiceUpdateOne {
+	iuo.mutation.AddSubtotal(i)
+	return iuo
+}
+
+// SetTax sets the "tax" field.
+func (iuo *InvoiceUpdateOne) SetTax(i int) *InvoiceUpdateOne {
+	iuo.mutation.ResetTax()
+	iuo.mutation.SetTax(i)
+	return iuo
+}
+
+// AddTax adds i to the "tax" field.
+func (iuo *InvoiceUpdateOne) AddTax(i int) *InvoiceUpdateOne {
+	iuo.mutation.AddTax(i)
+	return iuo
+}
+
+// SetTaxInclusive sets the "taxInclusive" field.
+func (iuo *InvoiceUpdateOne) SetTaxInclusive(b bool) *InvoiceUpdateOne {
+	iuo.mutation.SetTaxInclusive(b)
+	return iuo
+}
+
+// SetNillableTaxInclusive sets the "taxInclusive" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableTaxInclusive(b *bool) *InvoiceUpdateOne {
+	if b != nil {
+		iuo.SetTaxInclusive(*b)
+	}
+	return iuo
+}
+
+// SetTotal sets the "total" field.
+func (iuo *InvoiceUpdateOne) SetTotal(i int) *InvoiceUpdateOne {
+	iuo.mutation.ResetTotal()
+	iuo.mutation.SetTotal(i)
+	return iuo
+}
+
+// AddTotal adds i to the "total" field.
+func (iuo *InvoiceUpdateOne) AddTotal(i int) *InvoiceUpdateOne {
+	iuo.mutation.AddTotal(i)
+	return iuo
+}
+
+// SetAmountPaid sets the "amountPaid" field.
+func (iuo *InvoiceUpdateOne) SetAmountPaid(i int) *InvoiceUpdateOne {
+	iuo.mutation.ResetAmountPaid()
+	iuo.mutation.SetAmountPaid(i)
+	return iuo
+}
+
+// AddAmountPaid adds i to the "amountPaid" field.
+func (iuo *InvoiceUpdateOne) AddAmountPaid(i int) *InvoiceUpdateOne {
+	iuo.mutation.AddAmountPaid(i)
+	return iuo
+}
+
+// SetBookingId sets the "bookingId" field.
+func (iuo *InvoiceUpdateOne) SetBookingId(i int) *InvoiceUpdateOne {
+	iuo.mutation.SetBookingId(i)
+	return iuo
+}
+
+// SetCreditedInvoiceId sets the "creditedInvoiceId" field.
+func (iuo *InvoiceUpdateOne) SetCreditedInvoiceId(i int) *InvoiceUpdateOne {
+	iuo.mutation.SetCreditedInvoiceId(i)
+	return iuo
+}
+
+// SetNillableCreditedInvoiceId sets the "creditedInvoiceId" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableCreditedInvoiceId(i *int) *InvoiceUpdateOne {
+	if i != nil {
+		iuo.SetCreditedInvoiceId(*i)
+	}
+	return iuo
+}
+
+// ClearCreditedInvoiceId clears the value of the "creditedInvoiceId" field.
+func (iuo *InvoiceUpdateOne) ClearCreditedInvoiceId() *InvoiceUpdateOne {
+	iuo.mutation.ClearCreditedInvoiceId()
+	return iuo
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (iuo *InvoiceUpdateOne) SetOrganizationId(i int) *InvoiceUpdateOne {
+	iuo.mutation.SetOrganizationId(i)
+	return iuo
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (iuo *InvoiceUpdateOne) SetOrganizationID(id int) *InvoiceUpdateOne {
+	iuo.mutation.SetOrganizationID(id)
+	return iuo
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (iuo *InvoiceUpdateOne) SetOrganization(o *Organization) *InvoiceUpdateOne {
+	return iuo.SetOrganizationID(o.ID)
+}
+
+// SetBookingID sets the "booking" edge to the Booking entity by ID.
+func (iuo *InvoiceUpdateOne) SetBookingID(id int) *InvoiceUpdateOne {
+	iuo.mutation.SetBookingID(id)
+	return iuo
+}
+
+// SetBooking sets the "booking" edge to the Booking entity.
+func (iuo *InvoiceUpdateOne) SetBooking(b *Booking) *InvoiceUpdateOne {
+	return iuo.SetBookingID(b.ID)
+}
+
+// SetCreditedInvoiceID sets the "creditedInvoice" edge to the Invoice entity by ID.
+func (iuo *InvoiceUpdateOne) SetCreditedInvoiceID(id int) *InvoiceUpdateOne {
+	iuo.mutation.SetCreditedInvoiceID(id)
+	return iuo
+}
+
+// SetNillableCreditedInvoiceID sets the "creditedInvoice" edge to the Invoice entity by ID if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableCreditedInvoiceID(id *int) *InvoiceUpdateOne {
+	if id != nil {
+		iuo = iuo.SetCreditedInvoiceID(*id)
+	}
+	return iuo
+}
+
+// SetCreditedInvoice sets the "creditedInvoice" edge to the Invoice entity.
+func (iuo *InvoiceUpdateOne) SetCreditedInvoice(i *Invoice) *InvoiceUpdateOne {
+	return iuo.SetCreditedInvoiceID(i.ID)
+}
+
+// AddCreditNoteIDs adds the "creditNotes" edge to the Invoice entity by IDs.
+func (iuo *InvoiceUpdateOne) AddCreditNoteIDs(ids ...int) *InvoiceUpdateOne {
+	iuo.mutation.AddCreditNoteIDs(ids...)
+	return iuo
+}
+
+// AddCreditNotes adds the "creditNotes" edges to the Invoice entity.
+func (iuo *InvoiceUpdateOne) AddCreditNotes(i ...*Invoice) *InvoiceUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.AddCreditNoteIDs(ids...)
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (iuo *InvoiceUpdateOne) Mutation() *InvoiceMutation {
+	return iuo.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (iuo *InvoiceUpdateOne) ClearOrganization() *InvoiceUpdateOne {
+	iuo.mutation.ClearOrganization()
+	return iuo
+}
+
+// ClearBooking clears the "booking" edge to the Booking entity.
+func (iuo *InvoiceUpdateOne) ClearBooking() *InvoiceUpdateOne {
+	iuo.mutation.ClearBooking()
+	return iuo
+}
+
+// ClearCreditedInvoice clears the "creditedInvoice" edge to the Invoice entity.
+func (iuo *InvoiceUpdateOne) ClearCreditedInvoice() *InvoiceUpdateOne {
+	iuo.mutation.ClearCreditedInvoice()
+	return iuo
+}
+
+// ClearCreditNotes clears all "creditNotes" edges to the Invoice entity.
+func (iuo *InvoiceUpdateOne) ClearCreditNotes() *InvoiceUpdateOne {
+	iuo.mutation.ClearCreditNotes()
+	return iuo
+}
+
+// RemoveCreditNoteIDs removes the "creditNotes" edge to Invoice entities by IDs.
+func (iuo *InvoiceUpdateOne) RemoveCreditNoteIDs(ids ...int) *InvoiceUpdateOne {
+	iuo.mutation.RemoveCreditNoteIDs(ids...)
+	return iuo
+}
+
+// RemoveCreditNotes removes "creditNotes" edges to Invoice entities.
+func (iuo *InvoiceUpdateOne) RemoveCreditNotes(i ...*Invoice) *InvoiceUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.RemoveCreditNoteIDs(ids...)
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iuo *InvoiceUpdateOne) Select(field string, fields ...string) *InvoiceUpdateOne {
+	iuo.fields = append([]string{field}, fields...)
+	return iuo
+}
+
+// Save executes the query and returns the updated Invoice entity.
+func (iuo *InvoiceUpdateOne) Save(ctx context.Context) (*Invoice, error) {
+	var (
+		err  error
+		node *Invoice
+	)
+	if err := iuo.defaults(); err != nil {
+		return nil, err
+	}
+	if len(iuo.hooks) == 0 {
+		if err = iuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = iuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = iuo.check(); err != nil {
+				return nil, err
+			}
+			iuo.mutation = mutation
+			node, err = iuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(iuo.hooks) - 1; i >= 0; i-- {
+			if iuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = iuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuo *InvoiceUpdateOne) SaveX(ctx context.Context) *Invoice {
+	node, err := iuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iuo *InvoiceUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuo *InvoiceUpdateOne) ExecX(ctx context.Context) {
+	if err := iuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iuo *InvoiceUpdateOne) defaults() error {
+	if _, ok := iuo.mutation.UpdatedAt(); !ok {
+		if invoice.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized invoice.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := invoice.UpdateDefaultUpdatedAt()
+		iuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iuo *InvoiceUpdateOne) check() error {
+	if _, ok := iuo.mutation.OrganizationID(); iuo.mutation.OrganizationCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"organization\"")
+	}
+	if _, ok := iuo.mutation.BookingID(); iuo.mutation.BookingCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"booking\"")
+	}
+	return nil
+}
+
+func (iuo *InvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Invoice, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invoice.Table,
+			Columns: invoice.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoice.FieldID,
+			},
+		},
+	}
+	id, ok := iuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Invoice.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoice.FieldID)
+		for _, f := range fields {
+			if !invoice.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invoice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invoice.FieldUpdatedAt,
+		})
+	}
+	if value, ok := iuo.mutation.Kind(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldKind,
+		})
+	}
+	if value, ok := iuo.mutation.Number(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldNumber,
+		})
+	}
+	if value, ok := iuo.mutation.AddedNumber(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldNumber,
+		})
+	}
+	if value, ok := iuo.mutation.IssuerName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldIssuerName,
+		})
+	}
+	if value, ok := iuo.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldCurrency,
+		})
+	}
+	if value, ok := iuo.mutation.Lines(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldLines,
+		})
+	}
+	if value, ok := iuo.mutation.TaxLines(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldTaxLines,
+		})
+	}
+	if value, ok := iuo.mutation.Subtotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldSubtotal,
+		})
+	}
+	if value, ok := iuo.mutation.AddedSubtotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldSubtotal,
+		})
+	}
+	if value, ok := iuo.mutation.Tax(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldTax,
+		})
+	}
+	if value, ok := iuo.mutation.AddedTax(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldTax,
+		})
+	}
+	if value, ok := iuo.mutation.TaxInclusive(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: invoice.FieldTaxInclusive,
+		})
+	}
+	if value, ok := iuo.mutation.Total(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldTotal,
+		})
+	}
+	if value, ok := iuo.mutation.AddedTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldTotal,
+		})
+	}
+	if value, ok := iuo.mutation.AmountPaid(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldAmountPaid,
+		})
+	}
+	if value, ok := iuo.mutation.AddedAmountPaid(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldAmountPaid,
+		})
+	}
+	if iuo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.OrganizationTable,
+			Columns: []string{invoice.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.OrganizationTable,
+			Columns: []string{invoice.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.BookingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.BookingTable,
+			Columns: []string{invoice.BookingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.BookingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.BookingTable,
+			Columns: []string{invoice.BookingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.CreditedInvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.CreditedInvoiceTable,
+			Columns: []string{invoice.CreditedInvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.CreditedInvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.CreditedInvoiceTable,
+			Columns: []string{invoice.CreditedInvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.CreditNotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditNotesTable,
+			Columns: []string{invoice.CreditNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedCreditNotesIDs(); len(nodes) > 0 && !iuo.mutation.CreditNotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditNotesTable,
+			Columns: []string{invoice.CreditNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.CreditNotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.CreditNotesTable,
+			Columns: []string{invoice.CreditNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Invoice{config: iuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -42,7 +42,20 @@ var (
 		{Name: "status", Type: field.TypeString},
 		{Name: "start_time", Type: field.TypeTime},
 		{Name: "end_time", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "price", Type: field.TypeInt, Nullable: true},
+		{Name: "currency", Type: field.TypeString, Default: "USD"},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "refund_amount", Type: field.TypeInt, Default: 0},
+		{Name: "fee_amount", Type: field.TypeInt, Default: 0},
+		{Name: "tax_name", Type: field.TypeString, Nullable: true},
+		{Name: "tax_rate", Type: field.TypeInt, Default: 0},
+		{Name: "tax_inclusive", Type: field.TypeBool, Default: false},
+		{Name: "tax_amount", Type: field.TypeInt, Default: 0},
+		{Name: "series_id", Type: field.TypeInt, Nullable: true},
+		{Name: "customer_id", Type: field.TypeInt, Nullable: true},
 		{Name: "resource_id", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
 	}
 	// BookingsTable holds the schema information for the "bookings" table.
 	BookingsTable = &schema.Table{
@@ -50,12 +63,37 @@ var (
 		Columns:    BookingsColumns,
 		PrimaryKey: []*schema.Column{BookingsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "bookings_booking_series_bookings",
+				Columns:    []*schema.Column{BookingsColumns[16]},
+				RefColumns: []*schema.Column{BookingSeriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "bookings_customers_bookings",
+				Columns:    []*schema.Column{BookingsColumns[17]},
+				RefColumns: []*schema.Column{CustomersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "bookings_resources_bookings",
-				Columns:    []*schema.Column{BookingsColumns[6]},
+				Columns:    []*schema.Column{BookingsColumns[18]},
 				RefColumns: []*schema.Column{ResourcesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "bookings_users_bookings",
+				Columns:    []*schema.Column{BookingsColumns[19]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "booking_status_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{BookingsColumns[3], BookingsColumns[6]},
+			},
 		},
 	}
 	// BookingMetadataColumns holds the columns for the "booking_metadata" table.
@@ -79,6 +117,122 @@ var (
 			},
 		},
 	}
+	// BookingSeriesColumns holds the columns for the "booking_series" table.
+	BookingSeriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "rrule", Type: field.TypeString},
+		{Name: "exdates", Type: field.TypeJSON, Nullable: true},
+		{Name: "start_time", Type: field.TypeTime},
+		{Name: "end_time", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeInt, Nullable: true},
+	}
+	// BookingSeriesTable holds the schema information for the "booking_series" table.
+	BookingSeriesTable = &schema.Table{
+		Name:       "booking_series",
+		Columns:    BookingSeriesColumns,
+		PrimaryKey: []*schema.Column{BookingSeriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "booking_series_resources_bookingSeries",
+				Columns:    []*schema.Column{BookingSeriesColumns[7]},
+				RefColumns: []*schema.Column{ResourcesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// CustomersColumns holds the columns for the "customers" table.
+	CustomersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Default: ""},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "phone", Type: field.TypeString, Default: ""},
+		{Name: "notes", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+	}
+	// CustomersTable holds the schema information for the "customers" table.
+	CustomersTable = &schema.Table{
+		Name:       "customers",
+		Columns:    CustomersColumns,
+		PrimaryKey: []*schema.Column{CustomersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "customers_organizations_customers",
+				Columns:    []*schema.Column{CustomersColumns[8]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "customer_organization_id_email",
+				Unique:  true,
+				Columns: []*schema.Column{CustomersColumns[8], CustomersColumns[4]},
+			},
+		},
+	}
+	// InvoicesColumns holds the columns for the "invoices" table.
+	InvoicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "number", Type: field.TypeInt},
+		{Name: "issuer_name", Type: field.TypeString},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "lines", Type: field.TypeString, Size: 2147483647},
+		{Name: "tax_lines", Type: field.TypeString, Size: 2147483647},
+		{Name: "subtotal", Type: field.TypeInt},
+		{Name: "tax", Type: field.TypeInt},
+		{Name: "tax_inclusive", Type: field.TypeBool, Default: false},
+		{Name: "total", Type: field.TypeInt},
+		{Name: "amount_paid", Type: field.TypeInt},
+		{Name: "booking_id", Type: field.TypeInt, Nullable: true},
+		{Name: "credited_invoice_id", Type: field.TypeInt, Nullable: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+	}
+	// InvoicesTable holds the schema information for the "invoices" table.
+	InvoicesTable = &schema.Table{
+		Name:       "invoices",
+		Columns:    InvoicesColumns,
+		PrimaryKey: []*schema.Column{InvoicesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invoices_bookings_invoices",
+				Columns:    []*schema.Column{InvoicesColumns[14]},
+				RefColumns: []*schema.Column{BookingsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "invoices_invoices_creditNotes",
+				Columns:    []*schema.Column{InvoicesColumns[15]},
+				RefColumns: []*schema.Column{InvoicesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "invoices_organizations_invoices",
+				Columns:    []*schema.Column{InvoicesColumns[16]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invoice_organization_id_kind_number",
+				Unique:  true,
+				Columns: []*schema.Column{InvoicesColumns[16], InvoicesColumns[3], InvoicesColumns[4]},
+			},
+			{
+				Name:    "invoice_booking_id_kind",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicesColumns[14], InvoicesColumns[3]},
+			},
+		},
+	}
 	// OrganizationsColumns holds the columns for the "organizations" table.
 	OrganizationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -87,6 +241,7 @@ var (
 		{Name: "name", Type: field.TypeString},
 		{Name: "public_key", Type: field.TypeString},
 		{Name: "private_key", Type: field.TypeString},
+		{Name: "currency", Type: field.TypeString, Default: "USD"},
 	}
 	// OrganizationsTable holds the schema information for the "organizations" table.
 	OrganizationsTable = &schema.Table{
@@ -139,6 +294,118 @@ var (
 			},
 		},
 	}
+	// PaymentsColumns holds the columns for the "payments" table.
+	PaymentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "provider_payment_id", Type: field.TypeString},
+		{Name: "status", Type: field.TypeString},
+		{Name: "amount", Type: field.TypeInt},
+		{Name: "amount_refunded", Type: field.TypeInt, Default: 0},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "client_secret", Type: field.TypeString, Nullable: true},
+		{Name: "failure_reason", Type: field.TypeString, Nullable: true},
+		{Name: "booking_id", Type: field.TypeInt, Nullable: true},
+	}
+	// PaymentsTable holds the schema information for the "payments" table.
+	PaymentsTable = &schema.Table{
+		Name:       "payments",
+		Columns:    PaymentsColumns,
+		PrimaryKey: []*schema.Column{PaymentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "payments_bookings_payments",
+				Columns:    []*schema.Column{PaymentsColumns[11]},
+				RefColumns: []*schema.Column{BookingsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "payment_provider_provider_payment_id",
+				Unique:  true,
+				Columns: []*schema.Column{PaymentsColumns[3], PaymentsColumns[4]},
+			},
+		},
+	}
+	// PromoCodesColumns holds the columns for the "promo_codes" table.
+	PromoCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "code", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "discount_type", Type: field.TypeString},
+		{Name: "discount_value", Type: field.TypeInt},
+		{Name: "currency", Type: field.TypeString, Nullable: true},
+		{Name: "valid_from", Type: field.TypeTime, Nullable: true},
+		{Name: "valid_until", Type: field.TypeTime, Nullable: true},
+		{Name: "max_redemptions", Type: field.TypeInt, Nullable: true},
+		{Name: "max_redemptions_per_customer", Type: field.TypeInt, Nullable: true},
+		{Name: "resource_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+	}
+	// PromoCodesTable holds the schema information for the "promo_codes" table.
+	PromoCodesTable = &schema.Table{
+		Name:       "promo_codes",
+		Columns:    PromoCodesColumns,
+		PrimaryKey: []*schema.Column{PromoCodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "promo_codes_organizations_promoCodes",
+				Columns:    []*schema.Column{PromoCodesColumns[13]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "promocode_organization_id_code",
+				Unique:  true,
+				Columns: []*schema.Column{PromoCodesColumns[13], PromoCodesColumns[3]},
+			},
+		},
+	}
+	// PromoRedemptionsColumns holds the columns for the "promo_redemptions" table.
+	PromoRedemptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "customer", Type: field.TypeString, Nullable: true},
+		{Name: "discount", Type: field.TypeInt},
+		{Name: "currency", Type: field.TypeString, Default: "USD"},
+		{Name: "booking_id", Type: field.TypeInt, Nullable: true},
+		{Name: "promo_code_id", Type: field.TypeInt, Nullable: true},
+	}
+	// PromoRedemptionsTable holds the schema information for the "promo_redemptions" table.
+	PromoRedemptionsTable = &schema.Table{
+		Name:       "promo_redemptions",
+		Columns:    PromoRedemptionsColumns,
+		PrimaryKey: []*schema.Column{PromoRedemptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "promo_redemptions_bookings_promoRedemptions",
+				Columns:    []*schema.Column{PromoRedemptionsColumns[6]},
+				RefColumns: []*schema.Column{BookingsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "promo_redemptions_promo_codes_redemptions",
+				Columns:    []*schema.Column{PromoRedemptionsColumns[7]},
+				RefColumns: []*schema.Column{PromoCodesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "promoredemption_promo_code_id_customer",
+				Unique:  false,
+				Columns: []*schema.Column{PromoRedemptionsColumns[7], PromoRedemptionsColumns[3]},
+			},
+		},
+	}
 	// ResourcesColumns holds the columns for the "resources" table.
 	ResourcesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -147,11 +414,17 @@ var (
 		{Name: "name", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString},
 		{Name: "timezone", Type: field.TypeString},
-		{Name: "password", Type: field.TypeString},
+		{Name: "password", Type: field.TypeString, Default: ""},
 		{Name: "price", Type: field.TypeInt},
 		{Name: "booking_price", Type: field.TypeInt},
+		{Name: "currency", Type: field.TypeString, Default: "USD"},
 		{Name: "quantity_available", Type: field.TypeInt, Nullable: true},
+		{Name: "cancellation_policy", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "pricing", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "booking_fields", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "feed_token_hash", Type: field.TypeString, Nullable: true},
 		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+		{Name: "tax_rate_id", Type: field.TypeInt, Nullable: true},
 	}
 	// ResourcesTable holds the schema information for the "resources" table.
 	ResourcesTable = &schema.Table{
@@ -161,10 +434,16 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "resources_organizations_resources",
-				Columns:    []*schema.Column{ResourcesColumns[10]},
+				Columns:    []*schema.Column{ResourcesColumns[15]},
 				RefColumns: []*schema.Column{OrganizationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "resources_tax_rates_resources",
+				Columns:    []*schema.Column{ResourcesColumns[16]},
+				RefColumns: []*schema.Column{TaxRatesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 	}
 	// SlotsColumns holds the columns for the "slots" table.
@@ -190,13 +469,50 @@ var (
 			},
 		},
 	}
+	// TaxRatesColumns holds the columns for the "tax_rates" table.
+	TaxRatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "rate", Type: field.TypeInt},
+		{Name: "inclusive", Type: field.TypeBool, Default: false},
+		{Name: "is_default", Type: field.TypeBool, Default: false},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+	}
+	// TaxRatesTable holds the schema information for the "tax_rates" table.
+	TaxRatesTable = &schema.Table{
+		Name:       "tax_rates",
+		Columns:    TaxRatesColumns,
+		PrimaryKey: []*schema.Column{TaxRatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tax_rates_organizations_taxRates",
+				Columns:    []*schema.Column{TaxRatesColumns[7]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taxrate_organization_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{TaxRatesColumns[7], TaxRatesColumns[3]},
+			},
+		},
+	}
 	// TokensColumns holds the columns for the "tokens" table.
 	TokensColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "name", Type: field.TypeString},
+		{Name: "secret_hash", Type: field.TypeString, Unique: true},
+		{Name: "secret_prefix", Type: field.TypeString},
+		{Name: "scopes", Type: field.TypeJSON},
 		{Name: "expiry", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
 		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
 		{Name: "user_id", Type: field.TypeInt, Nullable: true},
 	}
@@ -208,13 +524,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "tokens_organizations_tokens",
-				Columns:    []*schema.Column{TokensColumns[5]},
+				Columns:    []*schema.Column{TokensColumns[10]},
 				RefColumns: []*schema.Column{OrganizationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "tokens_users_tokens",
-				Columns:    []*schema.Column{TokensColumns[6]},
+				Columns:    []*schema.Column{TokensColumns[11]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -227,6 +543,7 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "start_time", Type: field.TypeTime},
 		{Name: "end_time", Type: field.TypeTime},
+		{Name: "external_id", Type: field.TypeString, Nullable: true},
 		{Name: "resource_id", Type: field.TypeInt, Nullable: true},
 	}
 	// UnavailabilitiesTable holds the schema information for the "unavailabilities" table.
@@ -237,11 +554,18 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "unavailabilities_resources_unavailabilities",
-				Columns:    []*schema.Column{UnavailabilitiesColumns[5]},
+				Columns:    []*schema.Column{UnavailabilitiesColumns[6]},
 				RefColumns: []*schema.Column{ResourcesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "unavailability_resource_id_external_id",
+				Unique:  false,
+				Columns: []*schema.Column{UnavailabilitiesColumns[6], UnavailabilitiesColumns[5]},
+			},
+		},
 	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
@@ -266,31 +590,163 @@ var (
 			},
 		},
 	}
+	// WaitlistEntriesColumns holds the columns for the "waitlist_entries" table.
+	WaitlistEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeString},
+		{Name: "start_time", Type: field.TypeTime},
+		{Name: "end_time", Type: field.TypeTime},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "offered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "booking_id", Type: field.TypeInt, Nullable: true},
+		{Name: "resource_id", Type: field.TypeInt, Nullable: true},
+	}
+	// WaitlistEntriesTable holds the schema information for the "waitlist_entries" table.
+	WaitlistEntriesTable = &schema.Table{
+		Name:       "waitlist_entries",
+		Columns:    WaitlistEntriesColumns,
+		PrimaryKey: []*schema.Column{WaitlistEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "waitlist_entries_bookings_waitlistEntries",
+				Columns:    []*schema.Column{WaitlistEntriesColumns[9]},
+				RefColumns: []*schema.Column{BookingsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "waitlist_entries_resources_waitlistEntries",
+				Columns:    []*schema.Column{WaitlistEntriesColumns[10]},
+				RefColumns: []*schema.Column{ResourcesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "waitlistentry_resource_id_status_start_time",
+				Unique:  false,
+				Columns: []*schema.Column{WaitlistEntriesColumns[10], WaitlistEntriesColumns[3], WaitlistEntriesColumns[4]},
+			},
+		},
+	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "url", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "event_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhooks_organizations_webhooks",
+				Columns:    []*schema.Column{WebhooksColumns[8]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeString},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "last_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "organization_id", Type: field.TypeInt},
+		{Name: "webhook_id", Type: field.TypeInt, Nullable: true},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[12]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[5], WebhookDeliveriesColumns[7]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AuthsTable,
 		BookingsTable,
 		BookingMetadataTable,
+		BookingSeriesTable,
+		CustomersTable,
+		InvoicesTable,
 		OrganizationsTable,
 		OrganizationOwnershipsTable,
+		PaymentsTable,
+		PromoCodesTable,
+		PromoRedemptionsTable,
 		ResourcesTable,
 		SlotsTable,
+		TaxRatesTable,
 		TokensTable,
 		UnavailabilitiesTable,
 		UsersTable,
+		WaitlistEntriesTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
 	}
 )
 
 func init() {
 	AuthsTable.ForeignKeys[0].RefTable = UsersTable
-	BookingsTable.ForeignKeys[0].RefTable = ResourcesTable
+	BookingsTable.ForeignKeys[0].RefTable = BookingSeriesTable
+	BookingsTable.ForeignKeys[1].RefTable = CustomersTable
+	BookingsTable.ForeignKeys[2].RefTable = ResourcesTable
+	BookingsTable.ForeignKeys[3].RefTable = UsersTable
 	BookingMetadataTable.ForeignKeys[0].RefTable = BookingsTable
+	BookingSeriesTable.ForeignKeys[0].RefTable = ResourcesTable
+	CustomersTable.ForeignKeys[0].RefTable = OrganizationsTable
+	InvoicesTable.ForeignKeys[0].RefTable = BookingsTable
+	InvoicesTable.ForeignKeys[1].RefTable = InvoicesTable
+	InvoicesTable.ForeignKeys[2].RefTable = OrganizationsTable
 	OrganizationOwnershipsTable.ForeignKeys[0].RefTable = UsersTable
 	OrganizationOwnershipsTable.ForeignKeys[1].RefTable = OrganizationsTable
+	PaymentsTable.ForeignKeys[0].RefTable = BookingsTable
+	PromoCodesTable.ForeignKeys[0].RefTable = OrganizationsTable
+	PromoRedemptionsTable.ForeignKeys[0].RefTable = BookingsTable
+	PromoRedemptionsTable.ForeignKeys[1].RefTable = PromoCodesTable
 	ResourcesTable.ForeignKeys[0].RefTable = OrganizationsTable
+	ResourcesTable.ForeignKeys[1].RefTable = TaxRatesTable
 	SlotsTable.ForeignKeys[0].RefTable = ResourcesTable
+	TaxRatesTable.ForeignKeys[0].RefTable = OrganizationsTable
 	TokensTable.ForeignKeys[0].RefTable = OrganizationsTable
 	TokensTable.ForeignKeys[1].RefTable = UsersTable
 	UnavailabilitiesTable.ForeignKeys[0].RefTable = ResourcesTable
 	UsersTable.ForeignKeys[0].RefTable = OrganizationsTable
+	WaitlistEntriesTable.ForeignKeys[0].RefTable = BookingsTable
+	WaitlistEntriesTable.ForeignKeys[1].RefTable = ResourcesTable
+	WebhooksTable.ForeignKeys[0].RefTable = OrganizationsTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
 }
@@ -11,14 +11,24 @@ import (
 	"github.com/openmesh/booking/ent/auth"
 	"github.com/openmesh/booking/ent/booking"
 	"github.com/openmesh/booking/ent/bookingmetadatum"
+	"github.com/openmesh/booking/ent/bookingseries"
+	"github.com/openmesh/booking/ent/customer"
+	"github.com/openmesh/booking/ent/invoice"
 	"github.com/openmesh/booking/ent/organization"
 	"github.com/openmesh/booking/ent/organizationownership"
+	"github.com/openmesh/booking/ent/payment"
 	"github.com/openmesh/booking/ent/predicate"
+	"github.com/openmesh/booking/ent/promocode"
+	"github.com/openmesh/booking/ent/promoredemption"
 	"github.com/openmesh/booking/ent/resource"
 	"github.com/openmesh/booking/ent/slot"
+	"github.com/openmesh/booking/ent/taxrate"
 	"github.com/openmesh/booking/ent/token"
 	"github.com/openmesh/booking/ent/unavailability"
 	"github.com/openmesh/booking/ent/user"
+	"github.com/openmesh/booking/ent/waitlistentry"
+	"github.com/openmesh/booking/ent/webhook"
+	"github.com/openmesh/booking/ent/webhookdelivery"
 
 	"entgo.io/ent"
 )
@@ -35,13 +45,23 @@ const (
 	TypeAuth                  = "Auth"
 	TypeBooking               = "Booking"
 	TypeBookingMetadatum      = "BookingMetadatum"
+	TypeBookingSeries         = "BookingSeries"
+	TypeCustomer              = "Customer"
+	TypeInvoice               = "Invoice"
 	TypeOrganization          = "Organization"
 	TypeOrganizationOwnership = "OrganizationOwnership"
+	TypePayment               = "Payment"
+	TypePromoCode             = "PromoCode"
+	TypePromoRedemption       = "PromoRedemption"
 	TypeResource              = "Resource"
 	TypeSlot                  = "Slot"
+	TypeTaxRate               = "TaxRate"
 	TypeToken                 = "Token"
 	TypeUnavailability        = "Unavailability"
 	TypeUser                  = "User"
+	TypeWaitlistEntry         = "WaitlistEntry"
+	TypeWebhook               = "Webhook"
+	TypeWebhookDelivery       = "WebhookDelivery"
 )
 
 // AuthMutation represents an operation that mutates the Auth nodes in the graph.
@@ -848,23 +868,56 @@ func (m *AuthMutation) ResetEdge(name string) error {
 // BookingMutation represents an operation that mutates the Booking nodes in the graph.
 type BookingMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	createdAt       *time.Time
-	updatedAt       *time.Time
-	status          *string
-	startTime       *time.Time
-	endTime         *time.Time
-	clearedFields   map[string]struct{}
-	metadata        map[int]struct{}
-	removedmetadata map[int]struct{}
-	clearedmetadata bool
-	resource        *int
-	clearedresource bool
-	done            bool
-	oldValue        func(context.Context) (*Booking, error)
-	predicates      []predicate.Booking
+	op                      Op
+	typ                     string
+	id                      *int
+	createdAt               *time.Time
+	updatedAt               *time.Time
+	status                  *string
+	startTime               *time.Time
+	endTime                 *time.Time
+	expiresAt               *time.Time
+	price                   *int
+	addprice                *int
+	currency                *string
+	cancelledAt             *time.Time
+	refundAmount            *int
+	addrefundAmount         *int
+	feeAmount               *int
+	addfeeAmount            *int
+	taxName                 *string
+	taxRate                 *int
+	addtaxRate              *int
+	taxInclusive            *bool
+	taxAmount               *int
+	addtaxAmount            *int
+	clearedFields           map[string]struct{}
+	metadata                map[int]struct{}
+	removedmetadata         map[int]struct{}
+	clearedmetadata         bool
+	waitlistEntries         map[int]struct{}
+	removedwaitlistEntries  map[int]struct{}
+	clearedwaitlistEntries  bool
+	payments                map[int]struct{}
+	removedpayments         map[int]struct{}
+	clearedpayments         bool
+	promoRedemptions        map[int]struct{}
+	removedpromoRedemptions map[int]struct{}
+	clearedpromoRedemptions bool
+	invoices                map[int]struct{}
+	removedinvoices         map[int]struct{}
+	clearedinvoices         bool
+	resource                *int
+	clearedresource         bool
+	user                    *int
+	cleareduser             bool
+	series                  *int
+	clearedseries           bool
+	customer                *int
+	clearedcustomer         bool
+	done                    bool
+	oldValue                func(context.Context) (*Booking, error)
+	predicates              []predicate.Booking
 }
 
 var _ ent.Mutation = (*BookingMutation)(nil)
@@ -1162,1357 +1215,1168 @@ func (m *BookingMutation) ResetResourceId() {
 	m.resource = nil
 }
 
-// AddMetadatumIDs adds the "metadata" edge to the BookingMetadatum entity by ids.
-func (m *BookingMutation) AddMetadatumIDs(ids ...int) {
-	if m.metadata == nil {
-		m.metadata = make(map[int]struct{})
+// SetUserId sets the "userId" field.
+func (m *BookingMutation) SetUserId(i int) {
+	m.user = &i
+}
+
+// UserId returns the value of the "userId" field in the mutation.
+func (m *BookingMutation) UserId() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.metadata[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldUserId returns the old "userId" field's value of the Booking entity.
+// If the Booking object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookingMutation) OldUserId(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUserId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUserId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
 	}
+	return oldValue.UserId, nil
 }
 
-// ClearMetadata clears the "metadata" edge to the BookingMetadatum entity.
-func (m *BookingMutation) ClearMetadata() {
-	m.clearedmetadata = true
+// ClearUserId clears the value of the "userId" field.
+func (m *BookingMutation) ClearUserId() {
+	m.user = nil
+	m.clearedFields[booking.FieldUserId] = struct{}{}
 }
 
-// MetadataCleared reports if the "metadata" edge to the BookingMetadatum entity was cleared.
-func (m *BookingMutation) MetadataCleared() bool {
-	return m.clearedmetadata
+// UserIdCleared returns if the "userId" field was cleared in this mutation.
+func (m *BookingMutation) UserIdCleared() bool {
+	_, ok := m.clearedFields[booking.FieldUserId]
+	return ok
 }
 
-// RemoveMetadatumIDs removes the "metadata" edge to the BookingMetadatum entity by IDs.
-func (m *BookingMutation) RemoveMetadatumIDs(ids ...int) {
-	if m.removedmetadata == nil {
-		m.removedmetadata = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.metadata, ids[i])
-		m.removedmetadata[ids[i]] = struct{}{}
-	}
+// ResetUserId resets all changes to the "userId" field.
+func (m *BookingMutation) ResetUserId() {
+	m.user = nil
+	delete(m.clearedFields, booking.FieldUserId)
 }
 
-// RemovedMetadata returns the removed IDs of the "metadata" edge to the BookingMetadatum entity.
-func (m *BookingMutation) RemovedMetadataIDs() (ids []int) {
-	for id := range m.removedmetadata {
-		ids = append(ids, id)
+// SetSeriesId sets the "seriesId" field.
+func (m *BookingMutation) SetSeriesId(i int) {
+	m.series = &i
+}
+
+// SeriesId returns the value of the "seriesId" field in the mutation.
+func (m *BookingMutation) SeriesId() (r int, exists bool) {
+	v := m.series
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// MetadataIDs returns the "metadata" edge IDs in the mutation.
-func (m *BookingMutation) MetadataIDs() (ids []int) {
-	for id := range m.metadata {
-		ids = append(ids, id)
+// OldSeriesId returns the old "seriesId" field's value of the Booking entity.
+// If the Booking object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookingMutation) OldSeriesId(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldSeriesId is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldSeriesId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeriesId: %w", err)
+	}
+	return oldValue.SeriesId, nil
 }
 
-// ResetMetadata resets all changes to the "metadata" edge.
-func (m *BookingMutation) ResetMetadata() {
-	m.metadata = nil
-	m.clearedmetadata = false
-	m.removedmetadata = nil
+// ClearSeriesId clears the value of the "seriesId" field.
+func (m *BookingMutation) ClearSeriesId() {
+	m.series = nil
+	m.clearedFields[booking.FieldSeriesId] = struct{}{}
 }
 
-// SetResourceID sets the "resource" edge to the Resource entity by id.
-func (m *BookingMutation) SetResourceID(id int) {
-	m.resource = &id
+// SeriesIdCleared returns if the "seriesId" field was cleared in this mutation.
+func (m *BookingMutation) SeriesIdCleared() bool {
+	_, ok := m.clearedFields[booking.FieldSeriesId]
+	return ok
 }
 
-// ClearResource clears the "resource" edge to the Resource entity.
-func (m *BookingMutation) ClearResource() {
-	m.clearedresource = true
+// ResetSeriesId resets all changes to the "seriesId" field.
+func (m *BookingMutation) ResetSeriesId() {
+	m.series = nil
+	delete(m.clearedFields, booking.FieldSeriesId)
 }
 
-// ResourceCleared reports if the "resource" edge to the Resource entity was cleared.
-func (m *BookingMutation) ResourceCleared() bool {
-	return m.clearedresource
+// SetCustomerId sets the "customerId" field.
+func (m *BookingMutation) SetCustomerId(i int) {
+	m.customer = &i
 }
 
-// ResourceID returns the "resource" edge ID in the mutation.
-func (m *BookingMutation) ResourceID() (id int, exists bool) {
-	if m.resource != nil {
-		return *m.resource, true
+// CustomerId returns the value of the "customerId" field in the mutation.
+func (m *BookingMutation) CustomerId() (r int, exists bool) {
+	v := m.customer
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResourceIDs returns the "resource" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ResourceID instead. It exists only for internal usage by the builders.
-func (m *BookingMutation) ResourceIDs() (ids []int) {
-	if id := m.resource; id != nil {
-		ids = append(ids, *id)
+// OldCustomerId returns the old "customerId" field's value of the Booking entity.
+// If the Booking object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookingMutation) OldCustomerId(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCustomerId is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCustomerId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCustomerId: %w", err)
+	}
+	return oldValue.CustomerId, nil
 }
 
-// ResetResource resets all changes to the "resource" edge.
-func (m *BookingMutation) ResetResource() {
-	m.resource = nil
-	m.clearedresource = false
+// ClearCustomerId clears the value of the "customerId" field.
+func (m *BookingMutation) ClearCustomerId() {
+	m.customer = nil
+	m.clearedFields[booking.FieldCustomerId] = struct{}{}
 }
 
-// Where appends a list predicates to the BookingMutation builder.
-func (m *BookingMutation) Where(ps ...predicate.Booking) {
-	m.predicates = append(m.predicates, ps...)
+// CustomerIdCleared returns if the "customerId" field was cleared in this mutation.
+func (m *BookingMutation) CustomerIdCleared() bool {
+	_, ok := m.clearedFields[booking.FieldCustomerId]
+	return ok
 }
 
-// Op returns the operation name.
-func (m *BookingMutation) Op() Op {
-	return m.op
+// ResetCustomerId resets all changes to the "customerId" field.
+func (m *BookingMutation) ResetCustomerId() {
+	m.customer = nil
+	delete(m.clearedFields, booking.FieldCustomerId)
 }
 
-// Type returns the node type of this mutation (Booking).
-func (m *BookingMutation) Type() string {
-	return m.typ
+// SetExpiresAt sets the "expiresAt" field.
+func (m *BookingMutation) SetExpiresAt(t time.Time) {
+	m.expiresAt = &t
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *BookingMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.createdAt != nil {
-		fields = append(fields, booking.FieldCreatedAt)
+// ExpiresAt returns the value of the "expiresAt" field in the mutation.
+func (m *BookingMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expiresAt
+	if v == nil {
+		return
 	}
-	if m.updatedAt != nil {
-		fields = append(fields, booking.FieldUpdatedAt)
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expiresAt" field's value of the Booking entity.
+// If the Booking object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookingMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldExpiresAt is only allowed on UpdateOne operations")
 	}
-	if m.status != nil {
-		fields = append(fields, booking.FieldStatus)
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldExpiresAt requires an ID field in the mutation")
 	}
-	if m.startTime != nil {
-		fields = append(fields, booking.FieldStartTime)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	if m.endTime != nil {
-		fields = append(fields, booking.FieldEndTime)
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expiresAt" field.
+func (m *BookingMutation) ClearExpiresAt() {
+	m.expiresAt = nil
+	m.clearedFields[booking.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expiresAt" field was cleared in this mutation.
+func (m *BookingMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[booking.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expiresAt" field.
+func (m *BookingMutation) ResetExpiresAt() {
+	m.expiresAt = nil
+	delete(m.clearedFields, booking.FieldExpiresAt)
+}
+
+// SetPrice sets the "price" field.
+func (m *BookingMutation) SetPrice(i int) {
+	m.price = &i
+	m.addprice = nil
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *BookingMutation) Price() (r int, exists bool) {
+	v := m.price
+	if v == nil {
+		return
 	}
-	if m.resource != nil {
-		fields = append(fields, booking.FieldResourceId)
+	return *v, true
+}
+
+// OldPrice returns the old "price" field's value of the Booking entity.
+// If the Booking object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookingMutation) OldPrice(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldPrice is only allowed on UpdateOne operations")
 	}
-	return fields
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *BookingMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case booking.FieldCreatedAt:
-		return m.CreatedAt()
-	case booking.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case booking.FieldStatus:
-		return m.Status()
-	case booking.FieldStartTime:
-		return m.StartTime()
-	case booking.FieldEndTime:
-		return m.EndTime()
-	case booking.FieldResourceId:
-		return m.ResourceId()
+// AddPrice adds i to the "price" field.
+func (m *BookingMutation) AddPrice(i int) {
+	if m.addprice != nil {
+		*m.addprice += i
+	} else {
+		m.addprice = &i
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *BookingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case booking.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case booking.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case booking.FieldStatus:
-		return m.OldStatus(ctx)
-	case booking.FieldStartTime:
-		return m.OldStartTime(ctx)
-	case booking.FieldEndTime:
-		return m.OldEndTime(ctx)
-	case booking.FieldResourceId:
-		return m.OldResourceId(ctx)
+// AddedPrice returns the value that was added to the "price" field in this mutation.
+func (m *BookingMutation) AddedPrice() (r int, exists bool) {
+	v := m.addprice
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Booking field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *BookingMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case booking.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case booking.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case booking.FieldStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case booking.FieldStartTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartTime(v)
-		return nil
-	case booking.FieldEndTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEndTime(v)
-		return nil
-	case booking.FieldResourceId:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetResourceId(v)
-		return nil
+// ClearPrice clears the value of the "price" field.
+func (m *BookingMutation) ClearPrice() {
+	m.price = nil
+	m.addprice = nil
+	m.clearedFields[booking.FieldPrice] = struct{}{}
+}
+
+// PriceCleared returns if the "price" field was cleared in this mutation.
+func (m *BookingMutation) PriceCleared() bool {
+	_, ok := m.clearedFields[booking.FieldPrice]
+	return ok
+}
+
+// ResetPrice resets all changes to the "price" field.
+func (m *BookingMutation) ResetPrice() {
+	m.price = nil
+	m.addprice = nil
+	delete(m.clearedFields, booking.FieldPrice)
+}
+
+// SetCurrency sets the "currency" field.
+func (m *BookingMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *BookingMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Booking field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *BookingMutation) AddedFields() []string {
-	var fields []string
-	return fields
+// OldCurrency returns the old "currency" field's value of the Booking entity.
+// If the Booking object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookingMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *BookingMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
+// ResetCurrency resets all changes to the "currency" field.
+func (m *BookingMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetCancelledAt sets the "cancelledAt" field.
+func (m *BookingMutation) SetCancelledAt(t time.Time) {
+	m.cancelledAt = &t
+}
+
+// CancelledAt returns the value of the "cancelledAt" field in the mutation.
+func (m *BookingMutation) CancelledAt() (r time.Time, exists bool) {
+	v := m.cancelledAt
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *BookingMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// OldCancelledAt returns the old "cancelledAt" field's value of the Booking entity.
+// If the Booking object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookingMutation) OldCancelledAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCancelledAt is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Booking numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCancelledAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCancelledAt: %w", err)
+	}
+	return oldValue.CancelledAt, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *BookingMutation) ClearedFields() []string {
-	return nil
+// ClearCancelledAt clears the value of the "cancelledAt" field.
+func (m *BookingMutation) ClearCancelledAt() {
+	m.cancelledAt = nil
+	m.clearedFields[booking.FieldCancelledAt] = struct{}{}
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *BookingMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// CancelledAtCleared returns if the "cancelledAt" field was cleared in this mutation.
+func (m *BookingMutation) CancelledAtCleared() bool {
+	_, ok := m.clearedFields[booking.FieldCancelledAt]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *BookingMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Booking nullable field %s", name)
+// ResetCancelledAt resets all changes to the "cancelledAt" field.
+func (m *BookingMutation) ResetCancelledAt() {
+	m.cancelledAt = nil
+	delete(m.clearedFields, booking.FieldCancelledAt)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *BookingMutation) ResetField(name string) error {
-	switch name {
-	case booking.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case booking.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case booking.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case booking.FieldStartTime:
-		m.ResetStartTime()
-		return nil
-	case booking.FieldEndTime:
-		m.ResetEndTime()
-		return nil
-	case booking.FieldResourceId:
-		m.ResetResourceId()
-		return nil
+// SetRefundAmount sets the "refundAmount" field.
+func (m *BookingMutation) SetRefundAmount(i int) {
+	m.refundAmount = &i
+	m.addrefundAmount = nil
+}
+
+// RefundAmount returns the value of the "refundAmount" field in the mutation.
+func (m *BookingMutation) RefundAmount() (r int, exists bool) {
+	v := m.refundAmount
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Booking field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *BookingMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.metadata != nil {
-		edges = append(edges, booking.EdgeMetadata)
+// OldRefundAmount returns the old "refundAmount" field's value of the Booking entity.
+// If the Booking object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookingMutation) OldRefundAmount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRefundAmount is only allowed on UpdateOne operations")
 	}
-	if m.resource != nil {
-		edges = append(edges, booking.EdgeResource)
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRefundAmount requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefundAmount: %w", err)
+	}
+	return oldValue.RefundAmount, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *BookingMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case booking.EdgeMetadata:
-		ids := make([]ent.Value, 0, len(m.metadata))
-		for id := range m.metadata {
-			ids = append(ids, id)
-		}
-		return ids
-	case booking.EdgeResource:
-		if id := m.resource; id != nil {
-			return []ent.Value{*id}
-		}
+// AddRefundAmount adds i to the "refundAmount" field.
+func (m *BookingMutation) AddRefundAmount(i int) {
+	if m.addrefundAmount != nil {
+		*m.addrefundAmount += i
+	} else {
+		m.addrefundAmount = &i
 	}
-	return nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *BookingMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedmetadata != nil {
-		edges = append(edges, booking.EdgeMetadata)
+// AddedRefundAmount returns the value that was added to the "refundAmount" field in this mutation.
+func (m *BookingMutation) AddedRefundAmount() (r int, exists bool) {
+	v := m.addrefundAmount
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *BookingMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case booking.EdgeMetadata:
-		ids := make([]ent.Value, 0, len(m.removedmetadata))
-		for id := range m.removedmetadata {
-			ids = append(ids, id)
-		}
-		return ids
+// ResetRefundAmount resets all changes to the "refundAmount" field.
+func (m *BookingMutation) ResetRefundAmount() {
+	m.refundAmount = nil
+	m.addrefundAmount = nil
+}
+
+// SetFeeAmount sets the "feeAmount" field.
+func (m *BookingMutation) SetFeeAmount(i int) {
+	m.feeAmount = &i
+	m.addfeeAmount = nil
+}
+
+// FeeAmount returns the value of the "feeAmount" field in the mutation.
+func (m *BookingMutation) FeeAmount() (r int, exists bool) {
+	v := m.feeAmount
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *BookingMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedmetadata {
-		edges = append(edges, booking.EdgeMetadata)
+// OldFeeAmount returns the old "feeAmount" field's value of the Booking entity.
+// If the Booking object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookingMutation) OldFeeAmount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldFeeAmount is only allowed on UpdateOne operations")
 	}
-	if m.clearedresource {
-		edges = append(edges, booking.EdgeResource)
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldFeeAmount requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeeAmount: %w", err)
+	}
+	return oldValue.FeeAmount, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *BookingMutation) EdgeCleared(name string) bool {
-	switch name {
-	case booking.EdgeMetadata:
-		return m.clearedmetadata
-	case booking.EdgeResource:
-		return m.clearedresource
+// AddFeeAmount adds i to the "feeAmount" field.
+func (m *BookingMutation) AddFeeAmount(i int) {
+	if m.addfeeAmount != nil {
+		*m.addfeeAmount += i
+	} else {
+		m.addfeeAmount = &i
 	}
-	return false
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *BookingMutation) ClearEdge(name string) error {
-	switch name {
-	case booking.EdgeResource:
-		m.ClearResource()
-		return nil
+// AddedFeeAmount returns the value that was added to the "feeAmount" field in this mutation.
+func (m *BookingMutation) AddedFeeAmount() (r int, exists bool) {
+	v := m.addfeeAmount
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Booking unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *BookingMutation) ResetEdge(name string) error {
-	switch name {
-	case booking.EdgeMetadata:
-		m.ResetMetadata()
-		return nil
-	case booking.EdgeResource:
-		m.ResetResource()
-		return nil
+// ResetFeeAmount resets all changes to the "feeAmount" field.
+func (m *BookingMutation) ResetFeeAmount() {
+	m.feeAmount = nil
+	m.addfeeAmount = nil
+}
+
+// SetTaxName sets the "taxName" field.
+func (m *BookingMutation) SetTaxName(s string) {
+	m.taxName = &s
+}
+
+// TaxName returns the value of the "taxName" field in the mutation.
+func (m *BookingMutation) TaxName() (r string, exists bool) {
+	v := m.taxName
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Booking edge %s", name)
+	return *v, true
 }
 
-// BookingMetadatumMutation represents an operation that mutates the BookingMetadatum nodes in the graph.
-type BookingMetadatumMutation struct {
-	config
-	op             Op
-	typ            string
-	id             *int
-	key            *string
-	value          *string
-	clearedFields  map[string]struct{}
-	booking        *int
-	clearedbooking bool
-	done           bool
-	oldValue       func(context.Context) (*BookingMetadatum, error)
-	predicates     []predicate.BookingMetadatum
-}
-
-var _ ent.Mutation = (*BookingMetadatumMutation)(nil)
-
-// bookingmetadatumOption allows management of the mutation configuration using functional options.
-type bookingmetadatumOption func(*BookingMetadatumMutation)
-
-// newBookingMetadatumMutation creates new mutation for the BookingMetadatum entity.
-func newBookingMetadatumMutation(c config, op Op, opts ...bookingmetadatumOption) *BookingMetadatumMutation {
-	m := &BookingMetadatumMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeBookingMetadatum,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// OldTaxName returns the old "taxName" field's value of the Booking entity.
+// If the Booking object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookingMutation) OldTaxName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTaxName is only allowed on UpdateOne operations")
 	}
-	return m
-}
-
-// withBookingMetadatumID sets the ID field of the mutation.
-func withBookingMetadatumID(id int) bookingmetadatumOption {
-	return func(m *BookingMetadatumMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *BookingMetadatum
-		)
-		m.oldValue = func(ctx context.Context) (*BookingMetadatum, error) {
-			once.Do(func() {
-				if m.done {
-					err = fmt.Errorf("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().BookingMetadatum.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTaxName requires an ID field in the mutation")
 	}
-}
-
-// withBookingMetadatum sets the old BookingMetadatum of the mutation.
-func withBookingMetadatum(node *BookingMetadatum) bookingmetadatumOption {
-	return func(m *BookingMetadatumMutation) {
-		m.oldValue = func(context.Context) (*BookingMetadatum, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaxName: %w", err)
 	}
+	return oldValue.TaxName, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m BookingMetadatumMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ClearTaxName clears the value of the "taxName" field.
+func (m *BookingMutation) ClearTaxName() {
+	m.taxName = nil
+	m.clearedFields[booking.FieldTaxName] = struct{}{}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m BookingMetadatumMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// TaxNameCleared returns if the "taxName" field was cleared in this mutation.
+func (m *BookingMutation) TaxNameCleared() bool {
+	_, ok := m.clearedFields[booking.FieldTaxName]
+	return ok
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *BookingMetadatumMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// ResetTaxName resets all changes to the "taxName" field.
+func (m *BookingMutation) ResetTaxName() {
+	m.taxName = nil
+	delete(m.clearedFields, booking.FieldTaxName)
 }
 
-// SetKey sets the "key" field.
-func (m *BookingMetadatumMutation) SetKey(s string) {
-	m.key = &s
+// SetTaxRate sets the "taxRate" field.
+func (m *BookingMutation) SetTaxRate(i int) {
+	m.taxRate = &i
+	m.addtaxRate = nil
 }
 
-// Key returns the value of the "key" field in the mutation.
-func (m *BookingMetadatumMutation) Key() (r string, exists bool) {
-	v := m.key
+// TaxRate returns the value of the "taxRate" field in the mutation.
+func (m *BookingMutation) TaxRate() (r int, exists bool) {
+	v := m.taxRate
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKey returns the old "key" field's value of the BookingMetadatum entity.
-// If the BookingMetadatum object wasn't provided to the builder, the object is fetched from the database.
+// OldTaxRate returns the old "taxRate" field's value of the Booking entity.
+// If the Booking object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookingMetadatumMutation) OldKey(ctx context.Context) (v string, err error) {
+func (m *BookingMutation) OldTaxRate(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldKey is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldTaxRate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldKey requires an ID field in the mutation")
+		return v, fmt.Errorf("OldTaxRate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldTaxRate: %w", err)
 	}
-	return oldValue.Key, nil
+	return oldValue.TaxRate, nil
 }
 
-// ResetKey resets all changes to the "key" field.
-func (m *BookingMetadatumMutation) ResetKey() {
-	m.key = nil
+// AddTaxRate adds i to the "taxRate" field.
+func (m *BookingMutation) AddTaxRate(i int) {
+	if m.addtaxRate != nil {
+		*m.addtaxRate += i
+	} else {
+		m.addtaxRate = &i
+	}
 }
 
-// SetValue sets the "value" field.
-func (m *BookingMetadatumMutation) SetValue(s string) {
-	m.value = &s
+// AddedTaxRate returns the value that was added to the "taxRate" field in this mutation.
+func (m *BookingMutation) AddedTaxRate() (r int, exists bool) {
+	v := m.addtaxRate
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *BookingMetadatumMutation) Value() (r string, exists bool) {
-	v := m.value
+// ResetTaxRate resets all changes to the "taxRate" field.
+func (m *BookingMutation) ResetTaxRate() {
+	m.taxRate = nil
+	m.addtaxRate = nil
+}
+
+// SetTaxInclusive sets the "taxInclusive" field.
+func (m *BookingMutation) SetTaxInclusive(b bool) {
+	m.taxInclusive = &b
+}
+
+// TaxInclusive returns the value of the "taxInclusive" field in the mutation.
+func (m *BookingMutation) TaxInclusive() (r bool, exists bool) {
+	v := m.taxInclusive
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the BookingMetadatum entity.
-// If the BookingMetadatum object wasn't provided to the builder, the object is fetched from the database.
+// OldTaxInclusive returns the old "taxInclusive" field's value of the Booking entity.
+// If the Booking object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookingMetadatumMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *BookingMutation) OldTaxInclusive(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldValue is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldTaxInclusive is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldValue requires an ID field in the mutation")
+		return v, fmt.Errorf("OldTaxInclusive requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldTaxInclusive: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.TaxInclusive, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *BookingMetadatumMutation) ResetValue() {
-	m.value = nil
+// ResetTaxInclusive resets all changes to the "taxInclusive" field.
+func (m *BookingMutation) ResetTaxInclusive() {
+	m.taxInclusive = nil
 }
 
-// SetBookingId sets the "bookingId" field.
-func (m *BookingMetadatumMutation) SetBookingId(i int) {
-	m.booking = &i
+// SetTaxAmount sets the "taxAmount" field.
+func (m *BookingMutation) SetTaxAmount(i int) {
+	m.taxAmount = &i
+	m.addtaxAmount = nil
 }
 
-// BookingId returns the value of the "bookingId" field in the mutation.
-func (m *BookingMetadatumMutation) BookingId() (r int, exists bool) {
-	v := m.booking
+// TaxAmount returns the value of the "taxAmount" field in the mutation.
+func (m *BookingMutation) TaxAmount() (r int, exists bool) {
+	v := m.taxAmount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBookingId returns the old "bookingId" field's value of the BookingMetadatum entity.
-// If the BookingMetadatum object wasn't provided to the builder, the object is fetched from the database.
+// OldTaxAmount returns the old "taxAmount" field's value of the Booking entity.
+// If the Booking object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookingMetadatumMutation) OldBookingId(ctx context.Context) (v int, err error) {
+func (m *BookingMutation) OldTaxAmount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldBookingId is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldTaxAmount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldBookingId requires an ID field in the mutation")
+		return v, fmt.Errorf("OldTaxAmount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBookingId: %w", err)
+		return v, fmt.Errorf("querying old value for OldTaxAmount: %w", err)
 	}
-	return oldValue.BookingId, nil
+	return oldValue.TaxAmount, nil
 }
 
-// ResetBookingId resets all changes to the "bookingId" field.
-func (m *BookingMetadatumMutation) ResetBookingId() {
-	m.booking = nil
+// AddTaxAmount adds i to the "taxAmount" field.
+func (m *BookingMutation) AddTaxAmount(i int) {
+	if m.addtaxAmount != nil {
+		*m.addtaxAmount += i
+	} else {
+		m.addtaxAmount = &i
+	}
 }
 
-// SetBookingID sets the "booking" edge to the Booking entity by id.
-func (m *BookingMetadatumMutation) SetBookingID(id int) {
-	m.booking = &id
+// AddedTaxAmount returns the value that was added to the "taxAmount" field in this mutation.
+func (m *BookingMutation) AddedTaxAmount() (r int, exists bool) {
+	v := m.addtaxAmount
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearBooking clears the "booking" edge to the Booking entity.
-func (m *BookingMetadatumMutation) ClearBooking() {
-	m.clearedbooking = true
+// ResetTaxAmount resets all changes to the "taxAmount" field.
+func (m *BookingMutation) ResetTaxAmount() {
+	m.taxAmount = nil
+	m.addtaxAmount = nil
 }
 
-// BookingCleared reports if the "booking" edge to the Booking entity was cleared.
-func (m *BookingMetadatumMutation) BookingCleared() bool {
-	return m.clearedbooking
+// AddMetadatumIDs adds the "metadata" edge to the BookingMetadatum entity by ids.
+func (m *BookingMutation) AddMetadatumIDs(ids ...int) {
+	if m.metadata == nil {
+		m.metadata = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.metadata[ids[i]] = struct{}{}
+	}
 }
 
-// BookingID returns the "booking" edge ID in the mutation.
-func (m *BookingMetadatumMutation) BookingID() (id int, exists bool) {
-	if m.booking != nil {
-		return *m.booking, true
+// ClearMetadata clears the "metadata" edge to the BookingMetadatum entity.
+func (m *BookingMutation) ClearMetadata() {
+	m.clearedmetadata = true
+}
+
+// MetadataCleared reports if the "metadata" edge to the BookingMetadatum entity was cleared.
+func (m *BookingMutation) MetadataCleared() bool {
+	return m.clearedmetadata
+}
+
+// RemoveMetadatumIDs removes the "metadata" edge to the BookingMetadatum entity by IDs.
+func (m *BookingMutation) RemoveMetadatumIDs(ids ...int) {
+	if m.removedmetadata == nil {
+		m.removedmetadata = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.metadata, ids[i])
+		m.removedmetadata[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMetadata returns the removed IDs of the "metadata" edge to the BookingMetadatum entity.
+func (m *BookingMutation) RemovedMetadataIDs() (ids []int) {
+	for id := range m.removedmetadata {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// BookingIDs returns the "booking" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// BookingID instead. It exists only for internal usage by the builders.
-func (m *BookingMetadatumMutation) BookingIDs() (ids []int) {
-	if id := m.booking; id != nil {
-		ids = append(ids, *id)
+// MetadataIDs returns the "metadata" edge IDs in the mutation.
+func (m *BookingMutation) MetadataIDs() (ids []int) {
+	for id := range m.metadata {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetBooking resets all changes to the "booking" edge.
-func (m *BookingMetadatumMutation) ResetBooking() {
-	m.booking = nil
-	m.clearedbooking = false
+// ResetMetadata resets all changes to the "metadata" edge.
+func (m *BookingMutation) ResetMetadata() {
+	m.metadata = nil
+	m.clearedmetadata = false
+	m.removedmetadata = nil
 }
 
-// Where appends a list predicates to the BookingMetadatumMutation builder.
-func (m *BookingMetadatumMutation) Where(ps ...predicate.BookingMetadatum) {
-	m.predicates = append(m.predicates, ps...)
+// AddWaitlistEntryIDs adds the "waitlistEntries" edge to the WaitlistEntry entity by ids.
+func (m *BookingMutation) AddWaitlistEntryIDs(ids ...int) {
+	if m.waitlistEntries == nil {
+		m.waitlistEntries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.waitlistEntries[ids[i]] = struct{}{}
+	}
 }
 
-// Op returns the operation name.
-func (m *BookingMetadatumMutation) Op() Op {
-	return m.op
+// ClearWaitlistEntries clears the "waitlistEntries" edge to the WaitlistEntry entity.
+func (m *BookingMutation) ClearWaitlistEntries() {
+	m.clearedwaitlistEntries = true
 }
 
-// Type returns the node type of this mutation (BookingMetadatum).
-func (m *BookingMetadatumMutation) Type() string {
-	return m.typ
+// WaitlistEntriesCleared reports if the "waitlistEntries" edge to the WaitlistEntry entity was cleared.
+func (m *BookingMutation) WaitlistEntriesCleared() bool {
+	return m.clearedwaitlistEntries
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *BookingMetadatumMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.key != nil {
-		fields = append(fields, bookingmetadatum.FieldKey)
+// RemoveWaitlistEntryIDs removes the "waitlistEntries" edge to the WaitlistEntry entity by IDs.
+func (m *BookingMutation) RemoveWaitlistEntryIDs(ids ...int) {
+	if m.removedwaitlistEntries == nil {
+		m.removedwaitlistEntries = make(map[int]struct{})
 	}
-	if m.value != nil {
-		fields = append(fields, bookingmetadatum.FieldValue)
+	for i := range ids {
+		delete(m.waitlistEntries, ids[i])
+		m.removedwaitlistEntries[ids[i]] = struct{}{}
 	}
-	if m.booking != nil {
-		fields = append(fields, bookingmetadatum.FieldBookingId)
+}
+
+// RemovedWaitlistEntries returns the removed IDs of the "waitlistEntries" edge to the WaitlistEntry entity.
+func (m *BookingMutation) RemovedWaitlistEntriesIDs() (ids []int) {
+	for id := range m.removedwaitlistEntries {
+		ids = append(ids, id)
 	}
-	return fields
+	return
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *BookingMetadatumMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case bookingmetadatum.FieldKey:
-		return m.Key()
-	case bookingmetadatum.FieldValue:
-		return m.Value()
-	case bookingmetadatum.FieldBookingId:
-		return m.BookingId()
+// WaitlistEntriesIDs returns the "waitlistEntries" edge IDs in the mutation.
+func (m *BookingMutation) WaitlistEntriesIDs() (ids []int) {
+	for id := range m.waitlistEntries {
+		ids = append(ids, id)
 	}
-	return nil, false
+	return
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *BookingMetadatumMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case bookingmetadatum.FieldKey:
-		return m.OldKey(ctx)
-	case bookingmetadatum.FieldValue:
-		return m.OldValue(ctx)
-	case bookingmetadatum.FieldBookingId:
-		return m.OldBookingId(ctx)
-	}
-	return nil, fmt.Errorf("unknown BookingMetadatum field %s", name)
+// ResetWaitlistEntries resets all changes to the "waitlistEntries" edge.
+func (m *BookingMutation) ResetWaitlistEntries() {
+	m.waitlistEntries = nil
+	m.clearedwaitlistEntries = false
+	m.removedwaitlistEntries = nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *BookingMetadatumMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case bookingmetadatum.FieldKey:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetKey(v)
-		return nil
-	case bookingmetadatum.FieldValue:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetValue(v)
-		return nil
-	case bookingmetadatum.FieldBookingId:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBookingId(v)
-		return nil
+// AddPaymentIDs adds the "payments" edge to the Payment entity by ids.
+func (m *BookingMutation) AddPaymentIDs(ids ...int) {
+	if m.payments == nil {
+		m.payments = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.payments[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown BookingMetadatum field %s", name)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *BookingMetadatumMutation) AddedFields() []string {
-	var fields []string
-	return fields
+// ClearPayments clears the "payments" edge to the Payment entity.
+func (m *BookingMutation) ClearPayments() {
+	m.clearedpayments = true
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *BookingMetadatumMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	}
-	return nil, false
+// PaymentsCleared reports if the "payments" edge to the Payment entity was cleared.
+func (m *BookingMutation) PaymentsCleared() bool {
+	return m.clearedpayments
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *BookingMetadatumMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// RemovePaymentIDs removes the "payments" edge to the Payment entity by IDs.
+func (m *BookingMutation) RemovePaymentIDs(ids ...int) {
+	if m.removedpayments == nil {
+		m.removedpayments = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.payments, ids[i])
+		m.removedpayments[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown BookingMetadatum numeric field %s", name)
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *BookingMetadatumMutation) ClearedFields() []string {
-	return nil
+// RemovedPayments returns the removed IDs of the "payments" edge to the Payment entity.
+func (m *BookingMutation) RemovedPaymentsIDs() (ids []int) {
+	for id := range m.removedpayments {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *BookingMetadatumMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// PaymentsIDs returns the "payments" edge IDs in the mutation.
+func (m *BookingMutation) PaymentsIDs() (ids []int) {
+	for id := range m.payments {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *BookingMetadatumMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown BookingMetadatum nullable field %s", name)
+// ResetPayments resets all changes to the "payments" edge.
+func (m *BookingMutation) ResetPayments() {
+	m.payments = nil
+	m.clearedpayments = false
+	m.removedpayments = nil
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *BookingMetadatumMutation) ResetField(name string) error {
-	switch name {
-	case bookingmetadatum.FieldKey:
-		m.ResetKey()
-		return nil
-	case bookingmetadatum.FieldValue:
-		m.ResetValue()
-		return nil
-	case bookingmetadatum.FieldBookingId:
-		m.ResetBookingId()
-		return nil
+// AddPromoRedemptionIDs adds the "promoRedemptions" edge to the PromoRedemption entity by ids.
+func (m *BookingMutation) AddPromoRedemptionIDs(ids ...int) {
+	if m.promoRedemptions == nil {
+		m.promoRedemptions = make(map[int]struct{})
 	}
-	return fmt.Errorf("unknown BookingMetadatum field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *BookingMetadatumMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.booking != nil {
-		edges = append(edges, bookingmetadatum.EdgeBooking)
+	for i := range ids {
+		m.promoRedemptions[ids[i]] = struct{}{}
 	}
-	return edges
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *BookingMetadatumMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case bookingmetadatum.EdgeBooking:
-		if id := m.booking; id != nil {
-			return []ent.Value{*id}
-		}
-	}
-	return nil
+// ClearPromoRedemptions clears the "promoRedemptions" edge to the PromoRedemption entity.
+func (m *BookingMutation) ClearPromoRedemptions() {
+	m.clearedpromoRedemptions = true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *BookingMetadatumMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// PromoRedemptionsCleared reports if the "promoRedemptions" edge to the PromoRedemption entity was cleared.
+func (m *BookingMutation) PromoRedemptionsCleared() bool {
+	return m.clearedpromoRedemptions
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *BookingMetadatumMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
+// RemovePromoRedemptionIDs removes the "promoRedemptions" edge to the PromoRedemption entity by IDs.
+func (m *BookingMutation) RemovePromoRedemptionIDs(ids ...int) {
+	if m.removedpromoRedemptions == nil {
+		m.removedpromoRedemptions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.promoRedemptions, ids[i])
+		m.removedpromoRedemptions[ids[i]] = struct{}{}
 	}
-	return nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *BookingMetadatumMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedbooking {
-		edges = append(edges, bookingmetadatum.EdgeBooking)
+// RemovedPromoRedemptions returns the removed IDs of the "promoRedemptions" edge to the PromoRedemption entity.
+func (m *BookingMutation) RemovedPromoRedemptionsIDs() (ids []int) {
+	for id := range m.removedpromoRedemptions {
+		ids = append(ids, id)
 	}
-	return edges
+	return
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *BookingMetadatumMutation) EdgeCleared(name string) bool {
-	switch name {
-	case bookingmetadatum.EdgeBooking:
-		return m.clearedbooking
+// PromoRedemptionsIDs returns the "promoRedemptions" edge IDs in the mutation.
+func (m *BookingMutation) PromoRedemptionsIDs() (ids []int) {
+	for id := range m.promoRedemptions {
+		ids = append(ids, id)
 	}
-	return false
+	return
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *BookingMetadatumMutation) ClearEdge(name string) error {
-	switch name {
-	case bookingmetadatum.EdgeBooking:
-		m.ClearBooking()
-		return nil
-	}
-	return fmt.Errorf("unknown BookingMetadatum unique edge %s", name)
+// ResetPromoRedemptions resets all changes to the "promoRedemptions" edge.
+func (m *BookingMutation) ResetPromoRedemptions() {
+	m.promoRedemptions = nil
+	m.clearedpromoRedemptions = false
+	m.removedpromoRedemptions = nil
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *BookingMetadatumMutation) ResetEdge(name string) error {
-	switch name {
-	case bookingmetadatum.EdgeBooking:
-		m.ResetBooking()
-		return nil
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by ids.
+func (m *BookingMutation) AddInvoiceIDs(ids ...int) {
+	if m.invoices == nil {
+		m.invoices = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.invoices[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown BookingMetadatum edge %s", name)
 }
 
-// OrganizationMutation represents an operation that mutates the Organization nodes in the graph.
-type OrganizationMutation struct {
-	config
-	op               Op
-	typ              string
-	id               *int
-	createdAt        *time.Time
-	updatedAt        *time.Time
-	name             *string
-	publicKey        *string
-	privateKey       *string
-	clearedFields    map[string]struct{}
-	users            map[int]struct{}
-	removedusers     map[int]struct{}
-	clearedusers     bool
-	resources        map[int]struct{}
-	removedresources map[int]struct{}
-	clearedresources bool
-	tokens           map[string]struct{}
-	removedtokens    map[string]struct{}
-	clearedtokens    bool
-	done             bool
-	oldValue         func(context.Context) (*Organization, error)
-	predicates       []predicate.Organization
+// ClearInvoices clears the "invoices" edge to the Invoice entity.
+func (m *BookingMutation) ClearInvoices() {
+	m.clearedinvoices = true
 }
 
-var _ ent.Mutation = (*OrganizationMutation)(nil)
-
-// organizationOption allows management of the mutation configuration using functional options.
-type organizationOption func(*OrganizationMutation)
+// InvoicesCleared reports if the "invoices" edge to the Invoice entity was cleared.
+func (m *BookingMutation) InvoicesCleared() bool {
+	return m.clearedinvoices
+}
 
-// newOrganizationMutation creates new mutation for the Organization entity.
-func newOrganizationMutation(c config, op Op, opts ...organizationOption) *OrganizationMutation {
-	m := &OrganizationMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeOrganization,
-		clearedFields: make(map[string]struct{}),
+// RemoveInvoiceIDs removes the "invoices" edge to the Invoice entity by IDs.
+func (m *BookingMutation) RemoveInvoiceIDs(ids ...int) {
+	if m.removedinvoices == nil {
+		m.removedinvoices = make(map[int]struct{})
 	}
-	for _, opt := range opts {
-		opt(m)
+	for i := range ids {
+		delete(m.invoices, ids[i])
+		m.removedinvoices[ids[i]] = struct{}{}
 	}
-	return m
 }
 
-// withOrganizationID sets the ID field of the mutation.
-func withOrganizationID(id int) organizationOption {
-	return func(m *OrganizationMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Organization
-		)
-		m.oldValue = func(ctx context.Context) (*Organization, error) {
-			once.Do(func() {
-				if m.done {
-					err = fmt.Errorf("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Organization.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// RemovedInvoices returns the removed IDs of the "invoices" edge to the Invoice entity.
+func (m *BookingMutation) RemovedInvoicesIDs() (ids []int) {
+	for id := range m.removedinvoices {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// withOrganization sets the old Organization of the mutation.
-func withOrganization(node *Organization) organizationOption {
-	return func(m *OrganizationMutation) {
-		m.oldValue = func(context.Context) (*Organization, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// InvoicesIDs returns the "invoices" edge IDs in the mutation.
+func (m *BookingMutation) InvoicesIDs() (ids []int) {
+	for id := range m.invoices {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m OrganizationMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetInvoices resets all changes to the "invoices" edge.
+func (m *BookingMutation) ResetInvoices() {
+	m.invoices = nil
+	m.clearedinvoices = false
+	m.removedinvoices = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m OrganizationMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// SetResourceID sets the "resource" edge to the Resource entity by id.
+func (m *BookingMutation) SetResourceID(id int) {
+	m.resource = &id
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *OrganizationMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// ClearResource clears the "resource" edge to the Resource entity.
+func (m *BookingMutation) ClearResource() {
+	m.clearedresource = true
 }
 
-// SetCreatedAt sets the "createdAt" field.
-func (m *OrganizationMutation) SetCreatedAt(t time.Time) {
-	m.createdAt = &t
+// ResourceCleared reports if the "resource" edge to the Resource entity was cleared.
+func (m *BookingMutation) ResourceCleared() bool {
+	return m.clearedresource
 }
 
-// CreatedAt returns the value of the "createdAt" field in the mutation.
-func (m *OrganizationMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.createdAt
-	if v == nil {
-		return
+// ResourceID returns the "resource" edge ID in the mutation.
+func (m *BookingMutation) ResourceID() (id int, exists bool) {
+	if m.resource != nil {
+		return *m.resource, true
 	}
-	return *v, true
+	return
 }
 
-// OldCreatedAt returns the old "createdAt" field's value of the Organization entity.
-// If the Organization object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+// ResourceIDs returns the "resource" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ResourceID instead. It exists only for internal usage by the builders.
+func (m *BookingMutation) ResourceIDs() (ids []int) {
+	if id := m.resource; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "createdAt" field.
-func (m *OrganizationMutation) ResetCreatedAt() {
-	m.createdAt = nil
-}
-
-// SetUpdatedAt sets the "updatedAt" field.
-func (m *OrganizationMutation) SetUpdatedAt(t time.Time) {
-	m.updatedAt = &t
+	return
 }
 
-// UpdatedAt returns the value of the "updatedAt" field in the mutation.
-func (m *OrganizationMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updatedAt
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetResource resets all changes to the "resource" edge.
+func (m *BookingMutation) ResetResource() {
+	m.resource = nil
+	m.clearedresource = false
 }
 
-// OldUpdatedAt returns the old "updatedAt" field's value of the Organization entity.
-// If the Organization object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *BookingMutation) SetUserID(id int) {
+	m.user = &id
 }
 
-// ResetUpdatedAt resets all changes to the "updatedAt" field.
-func (m *OrganizationMutation) ResetUpdatedAt() {
-	m.updatedAt = nil
+// ClearUser clears the "user" edge to the User entity.
+func (m *BookingMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// SetName sets the "name" field.
-func (m *OrganizationMutation) SetName(s string) {
-	m.name = &s
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *BookingMutation) UserCleared() bool {
+	return m.UserIdCleared() || m.cleareduser
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *OrganizationMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
+// UserID returns the "user" edge ID in the mutation.
+func (m *BookingMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
-	return *v, true
+	return
 }
 
-// OldName returns the old "name" field's value of the Organization entity.
-// If the Organization object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *BookingMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *OrganizationMutation) ResetName() {
-	m.name = nil
-}
-
-// SetPublicKey sets the "publicKey" field.
-func (m *OrganizationMutation) SetPublicKey(s string) {
-	m.publicKey = &s
+	return
 }
 
-// PublicKey returns the value of the "publicKey" field in the mutation.
-func (m *OrganizationMutation) PublicKey() (r string, exists bool) {
-	v := m.publicKey
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetUser resets all changes to the "user" edge.
+func (m *BookingMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// OldPublicKey returns the old "publicKey" field's value of the Organization entity.
-// If the Organization object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationMutation) OldPublicKey(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldPublicKey is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldPublicKey requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPublicKey: %w", err)
-	}
-	return oldValue.PublicKey, nil
+// SetSeriesID sets the "series" edge to the BookingSeries entity by id.
+func (m *BookingMutation) SetSeriesID(id int) {
+	m.series = &id
 }
 
-// ResetPublicKey resets all changes to the "publicKey" field.
-func (m *OrganizationMutation) ResetPublicKey() {
-	m.publicKey = nil
+// ClearSeries clears the "series" edge to the BookingSeries entity.
+func (m *BookingMutation) ClearSeries() {
+	m.clearedseries = true
 }
 
-// SetPrivateKey sets the "privateKey" field.
-func (m *OrganizationMutation) SetPrivateKey(s string) {
-	m.privateKey = &s
+// SeriesCleared reports if the "series" edge to the BookingSeries entity was cleared.
+func (m *BookingMutation) SeriesCleared() bool {
+	return m.SeriesIdCleared() || m.clearedseries
 }
 
-// PrivateKey returns the value of the "privateKey" field in the mutation.
-func (m *OrganizationMutation) PrivateKey() (r string, exists bool) {
-	v := m.privateKey
-	if v == nil {
-		return
+// SeriesID returns the "series" edge ID in the mutation.
+func (m *BookingMutation) SeriesID() (id int, exists bool) {
+	if m.series != nil {
+		return *m.series, true
 	}
-	return *v, true
+	return
 }
 
-// OldPrivateKey returns the old "privateKey" field's value of the Organization entity.
-// If the Organization object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationMutation) OldPrivateKey(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldPrivateKey is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldPrivateKey requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrivateKey: %w", err)
+// SeriesIDs returns the "series" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SeriesID instead. It exists only for internal usage by the builders.
+func (m *BookingMutation) SeriesIDs() (ids []int) {
+	if id := m.series; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.PrivateKey, nil
-}
-
-// ResetPrivateKey resets all changes to the "privateKey" field.
-func (m *OrganizationMutation) ResetPrivateKey() {
-	m.privateKey = nil
+	return
 }
 
-// AddUserIDs adds the "users" edge to the User entity by ids.
-func (m *OrganizationMutation) AddUserIDs(ids ...int) {
-	if m.users == nil {
-		m.users = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.users[ids[i]] = struct{}{}
-	}
+// ResetSeries resets all changes to the "series" edge.
+func (m *BookingMutation) ResetSeries() {
+	m.series = nil
+	m.clearedseries = false
 }
 
-// ClearUsers clears the "users" edge to the User entity.
-func (m *OrganizationMutation) ClearUsers() {
-	m.clearedusers = true
+// SetCustomerID sets the "customer" edge to the Customer entity by id.
+func (m *BookingMutation) SetCustomerID(id int) {
+	m.customer = &id
 }
 
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *OrganizationMutation) UsersCleared() bool {
-	return m.clearedusers
+// ClearCustomer clears the "customer" edge to the Customer entity.
+func (m *BookingMutation) ClearCustomer() {
+	m.clearedcustomer = true
 }
 
-// RemoveUserIDs removes the "users" edge to the User entity by IDs.
-func (m *OrganizationMutation) RemoveUserIDs(ids ...int) {
-	if m.removedusers == nil {
-		m.removedusers = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.users, ids[i])
-		m.removedusers[ids[i]] = struct{}{}
-	}
+// CustomerCleared reports if the "customer" edge to the Customer entity was cleared.
+func (m *BookingMutation) CustomerCleared() bool {
+	return m.CustomerIdCleared() || m.clearedcustomer
 }
 
-// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
-func (m *OrganizationMutation) RemovedUsersIDs() (ids []int) {
-	for id := range m.removedusers {
-		ids = append(ids, id)
+// CustomerID returns the "customer" edge ID in the mutation.
+func (m *BookingMutation) CustomerID() (id int, exists bool) {
+	if m.customer != nil {
+		return *m.customer, true
 	}
 	return
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-func (m *OrganizationMutation) UsersIDs() (ids []int) {
-	for id := range m.users {
-		ids = append(ids, id)
+// CustomerIDs returns the "customer" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CustomerID instead. It exists only for internal usage by the builders.
+func (m *BookingMutation) CustomerIDs() (ids []int) {
+	if id := m.customer; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUsers resets all changes to the "users" edge.
-func (m *OrganizationMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
-	m.removedusers = nil
+// ResetCustomer resets all changes to the "customer" edge.
+func (m *BookingMutation) ResetCustomer() {
+	m.customer = nil
+	m.clearedcustomer = false
 }
 
-// AddResourceIDs adds the "resources" edge to the Resource entity by ids.
-func (m *OrganizationMutation) AddResourceIDs(ids ...int) {
-	if m.resources == nil {
-		m.resources = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.resources[ids[i]] = struct{}{}
-	}
+// Where appends a list predicates to the BookingMutation builder.
+func (m *BookingMutation) Where(ps ...predicate.Booking) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ClearResources clears the "resources" edge to the Resource entity.
-func (m *OrganizationMutation) ClearResources() {
-	m.clearedresources = true
+// Op returns the operation name.
+func (m *BookingMutation) Op() Op {
+	return m.op
 }
 
-// ResourcesCleared reports if the "resources" edge to the Resource entity was cleared.
-func (m *OrganizationMutation) ResourcesCleared() bool {
-	return m.clearedresources
+// Type returns the node type of this mutation (Booking).
+func (m *BookingMutation) Type() string {
+	return m.typ
 }
 
-// RemoveResourceIDs removes the "resources" edge to the Resource entity by IDs.
-func (m *OrganizationMutation) RemoveResourceIDs(ids ...int) {
-	if m.removedresources == nil {
-		m.removedresources = make(map[int]struct{})
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BookingMutation) Fields() []string {
+	fields := make([]string, 0, 19)
+	if m.createdAt != nil {
+		fields = append(fields, booking.FieldCreatedAt)
 	}
-	for i := range ids {
-		delete(m.resources, ids[i])
-		m.removedresources[ids[i]] = struct{}{}
+	if m.updatedAt != nil {
+		fields = append(fields, booking.FieldUpdatedAt)
 	}
-}
-
-// RemovedResources returns the removed IDs of the "resources" edge to the Resource entity.
-func (m *OrganizationMutation) RemovedResourcesIDs() (ids []int) {
-	for id := range m.removedresources {
-		ids = append(ids, id)
+	if m.status != nil {
+		fields = append(fields, booking.FieldStatus)
 	}
-	return
-}
-
-// ResourcesIDs returns the "resources" edge IDs in the mutation.
-func (m *OrganizationMutation) ResourcesIDs() (ids []int) {
-	for id := range m.resources {
-		ids = append(ids, id)
+	if m.startTime != nil {
+		fields = append(fields, booking.FieldStartTime)
 	}
-	return
-}
-
-// ResetResources resets all changes to the "resources" edge.
-func (m *OrganizationMutation) ResetResources() {
-	m.resources = nil
-	m.clearedresources = false
-	m.removedresources = nil
-}
-
-// AddTokenIDs adds the "tokens" edge to the Token entity by ids.
-func (m *OrganizationMutation) AddTokenIDs(ids ...string) {
-	if m.tokens == nil {
-		m.tokens = make(map[string]struct{})
+	if m.endTime != nil {
+		fields = append(fields, booking.FieldEndTime)
 	}
-	for i := range ids {
-		m.tokens[ids[i]] = struct{}{}
+	if m.resource != nil {
+		fields = append(fields, booking.FieldResourceId)
 	}
-}
-
-// ClearTokens clears the "tokens" edge to the Token entity.
-func (m *OrganizationMutation) ClearTokens() {
-	m.clearedtokens = true
-}
-
-// TokensCleared reports if the "tokens" edge to the Token entity was cleared.
-func (m *OrganizationMutation) TokensCleared() bool {
-	return m.clearedtokens
-}
-
-// RemoveTokenIDs removes the "tokens" edge to the Token entity by IDs.
-func (m *OrganizationMutation) RemoveTokenIDs(ids ...string) {
-	if m.removedtokens == nil {
-		m.removedtokens = make(map[string]struct{})
+	if m.user != nil {
+		fields = append(fields, booking.FieldUserId)
 	}
-	for i := range ids {
-		delete(m.tokens, ids[i])
-		m.removedtokens[ids[i]] = struct{}{}
+	if m.series != nil {
+		fields = append(fields, booking.FieldSeriesId)
 	}
-}
-
-// RemovedTokens returns the removed IDs of the "tokens" edge to the Token entity.
-func (m *OrganizationMutation) RemovedTokensIDs() (ids []string) {
-	for id := range m.removedtokens {
-		ids = append(ids, id)
+	if m.customer != nil {
+		fields = append(fields, booking.FieldCustomerId)
 	}
-	return
-}
-
-// TokensIDs returns the "tokens" edge IDs in the mutation.
-func (m *OrganizationMutation) TokensIDs() (ids []string) {
-	for id := range m.tokens {
-		ids = append(ids, id)
+	if m.expiresAt != nil {
+		fields = append(fields, booking.FieldExpiresAt)
 	}
-	return
-}
-
-// ResetTokens resets all changes to the "tokens" edge.
-func (m *OrganizationMutation) ResetTokens() {
-	m.tokens = nil
-	m.clearedtokens = false
-	m.removedtokens = nil
-}
-
-// Where appends a list predicates to the OrganizationMutation builder.
-func (m *OrganizationMutation) Where(ps ...predicate.Organization) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// Op returns the operation name.
-func (m *OrganizationMutation) Op() Op {
-	return m.op
-}
-
-// Type returns the node type of this mutation (Organization).
-func (m *OrganizationMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *OrganizationMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.createdAt != nil {
-		fields = append(fields, organization.FieldCreatedAt)
+	if m.price != nil {
+		fields = append(fields, booking.FieldPrice)
 	}
-	if m.updatedAt != nil {
-		fields = append(fields, organization.FieldUpdatedAt)
+	if m.currency != nil {
+		fields = append(fields, booking.FieldCurrency)
 	}
-	if m.name != nil {
-		fields = append(fields, organization.FieldName)
+	if m.cancelledAt != nil {
+		fields = append(fields, booking.FieldCancelledAt)
 	}
-	if m.publicKey != nil {
-		fields = append(fields, organization.FieldPublicKey)
+	if m.refundAmount != nil {
+		fields = append(fields, booking.FieldRefundAmount)
 	}
-	if m.privateKey != nil {
-		fields = append(fields, organization.FieldPrivateKey)
+	if m.feeAmount != nil {
+		fields = append(fields, booking.FieldFeeAmount)
+	}
+	if m.taxName != nil {
+		fields = append(fields, booking.FieldTaxName)
+	}
+	if m.taxRate != nil {
+		fields = append(fields, booking.FieldTaxRate)
+	}
+	if m.taxInclusive != nil {
+		fields = append(fields, booking.FieldTaxInclusive)
+	}
+	if m.taxAmount != nil {
+		fields = append(fields, booking.FieldTaxAmount)
 	}
 	return fields
 }
@@ -2520,18 +2384,46 @@ func (m *OrganizationMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *OrganizationMutation) Field(name string) (ent.Value, bool) {
+func (m *BookingMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case organization.FieldCreatedAt:
+	case booking.FieldCreatedAt:
 		return m.CreatedAt()
-	case organization.FieldUpdatedAt:
+	case booking.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case organization.FieldName:
-		return m.Name()
-	case organization.FieldPublicKey:
-		return m.PublicKey()
-	case organization.FieldPrivateKey:
-		return m.PrivateKey()
+	case booking.FieldStatus:
+		return m.Status()
+	case booking.FieldStartTime:
+		return m.StartTime()
+	case booking.FieldEndTime:
+		return m.EndTime()
+	case booking.FieldResourceId:
+		return m.ResourceId()
+	case booking.FieldUserId:
+		return m.UserId()
+	case booking.FieldSeriesId:
+		return m.SeriesId()
+	case booking.FieldCustomerId:
+		return m.CustomerId()
+	case booking.FieldExpiresAt:
+		return m.ExpiresAt()
+	case booking.FieldPrice:
+		return m.Price()
+	case booking.FieldCurrency:
+		return m.Currency()
+	case booking.FieldCancelledAt:
+		return m.CancelledAt()
+	case booking.FieldRefundAmount:
+		return m.RefundAmount()
+	case booking.FieldFeeAmount:
+		return m.FeeAmount()
+	case booking.FieldTaxName:
+		return m.TaxName()
+	case booking.FieldTaxRate:
+		return m.TaxRate()
+	case booking.FieldTaxInclusive:
+		return m.TaxInclusive()
+	case booking.FieldTaxAmount:
+		return m.TaxAmount()
 	}
 	return nil, false
 }
@@ -2539,205 +2431,545 @@ func (m *OrganizationMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *OrganizationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BookingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case organization.FieldCreatedAt:
+	case booking.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case organization.FieldUpdatedAt:
+	case booking.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case organization.FieldName:
-		return m.OldName(ctx)
-	case organization.FieldPublicKey:
-		return m.OldPublicKey(ctx)
-	case organization.FieldPrivateKey:
-		return m.OldPrivateKey(ctx)
+	case booking.FieldStatus:
+		return m.OldStatus(ctx)
+	case booking.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case booking.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case booking.FieldResourceId:
+		return m.OldResourceId(ctx)
+	case booking.FieldUserId:
+		return m.OldUserId(ctx)
+	case booking.FieldSeriesId:
+		return m.OldSeriesId(ctx)
+	case booking.FieldCustomerId:
+		return m.OldCustomerId(ctx)
+	case booking.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case booking.FieldPrice:
+		return m.OldPrice(ctx)
+	case booking.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case booking.FieldCancelledAt:
+		return m.OldCancelledAt(ctx)
+	case booking.FieldRefundAmount:
+		return m.OldRefundAmount(ctx)
+	case booking.FieldFeeAmount:
+		return m.OldFeeAmount(ctx)
+	case booking.FieldTaxName:
+		return m.OldTaxName(ctx)
+	case booking.FieldTaxRate:
+		return m.OldTaxRate(ctx)
+	case booking.FieldTaxInclusive:
+		return m.OldTaxInclusive(ctx)
+	case booking.FieldTaxAmount:
+		return m.OldTaxAmount(ctx)
 	}
-	return nil, fmt.Errorf("unknown Organization field %s", name)
+	return nil, fmt.Errorf("unknown Booking field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OrganizationMutation) SetField(name string, value ent.Value) error {
+func (m *BookingMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case organization.FieldCreatedAt:
+	case booking.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case organization.FieldUpdatedAt:
+	case booking.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case organization.FieldName:
+	case booking.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetStatus(v)
 		return nil
-	case organization.FieldPublicKey:
+	case booking.FieldStartTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartTime(v)
+		return nil
+	case booking.FieldEndTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndTime(v)
+		return nil
+	case booking.FieldResourceId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceId(v)
+		return nil
+	case booking.FieldUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserId(v)
+		return nil
+	case booking.FieldSeriesId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeriesId(v)
+		return nil
+	case booking.FieldCustomerId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCustomerId(v)
+		return nil
+	case booking.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case booking.FieldPrice:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case booking.FieldCurrency:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPublicKey(v)
+		m.SetCurrency(v)
 		return nil
-	case organization.FieldPrivateKey:
+	case booking.FieldCancelledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCancelledAt(v)
+		return nil
+	case booking.FieldRefundAmount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefundAmount(v)
+		return nil
+	case booking.FieldFeeAmount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeeAmount(v)
+		return nil
+	case booking.FieldTaxName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPrivateKey(v)
+		m.SetTaxName(v)
+		return nil
+	case booking.FieldTaxRate:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaxRate(v)
+		return nil
+	case booking.FieldTaxInclusive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaxInclusive(v)
+		return nil
+	case booking.FieldTaxAmount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaxAmount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Organization field %s", name)
+	return fmt.Errorf("unknown Booking field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *OrganizationMutation) AddedFields() []string {
-	return nil
+func (m *BookingMutation) AddedFields() []string {
+	var fields []string
+	if m.addprice != nil {
+		fields = append(fields, booking.FieldPrice)
+	}
+	if m.addrefundAmount != nil {
+		fields = append(fields, booking.FieldRefundAmount)
+	}
+	if m.addfeeAmount != nil {
+		fields = append(fields, booking.FieldFeeAmount)
+	}
+	if m.addtaxRate != nil {
+		fields = append(fields, booking.FieldTaxRate)
+	}
+	if m.addtaxAmount != nil {
+		fields = append(fields, booking.FieldTaxAmount)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *OrganizationMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BookingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case booking.FieldPrice:
+		return m.AddedPrice()
+	case booking.FieldRefundAmount:
+		return m.AddedRefundAmount()
+	case booking.FieldFeeAmount:
+		return m.AddedFeeAmount()
+	case booking.FieldTaxRate:
+		return m.AddedTaxRate()
+	case booking.FieldTaxAmount:
+		return m.AddedTaxAmount()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OrganizationMutation) AddField(name string, value ent.Value) error {
+func (m *BookingMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case booking.FieldPrice:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrice(v)
+		return nil
+	case booking.FieldRefundAmount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRefundAmount(v)
+		return nil
+	case booking.FieldFeeAmount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFeeAmount(v)
+		return nil
+	case booking.FieldTaxRate:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTaxRate(v)
+		return nil
+	case booking.FieldTaxAmount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTaxAmount(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Organization numeric field %s", name)
+	return fmt.Errorf("unknown Booking numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *OrganizationMutation) ClearedFields() []string {
-	return nil
+func (m *BookingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(booking.FieldUserId) {
+		fields = append(fields, booking.FieldUserId)
+	}
+	if m.FieldCleared(booking.FieldSeriesId) {
+		fields = append(fields, booking.FieldSeriesId)
+	}
+	if m.FieldCleared(booking.FieldCustomerId) {
+		fields = append(fields, booking.FieldCustomerId)
+	}
+	if m.FieldCleared(booking.FieldExpiresAt) {
+		fields = append(fields, booking.FieldExpiresAt)
+	}
+	if m.FieldCleared(booking.FieldPrice) {
+		fields = append(fields, booking.FieldPrice)
+	}
+	if m.FieldCleared(booking.FieldCancelledAt) {
+		fields = append(fields, booking.FieldCancelledAt)
+	}
+	if m.FieldCleared(booking.FieldTaxName) {
+		fields = append(fields, booking.FieldTaxName)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *OrganizationMutation) FieldCleared(name string) bool {
+func (m *BookingMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *OrganizationMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Organization nullable field %s", name)
+func (m *BookingMutation) ClearField(name string) error {
+	switch name {
+	case booking.FieldUserId:
+		m.ClearUserId()
+		return nil
+	case booking.FieldSeriesId:
+		m.ClearSeriesId()
+		return nil
+	case booking.FieldCustomerId:
+		m.ClearCustomerId()
+		return nil
+	case booking.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case booking.FieldPrice:
+		m.ClearPrice()
+		return nil
+	case booking.FieldCancelledAt:
+		m.ClearCancelledAt()
+		return nil
+	case booking.FieldTaxName:
+		m.ClearTaxName()
+		return nil
+	}
+	return fmt.Errorf("unknown Booking nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *OrganizationMutation) ResetField(name string) error {
+func (m *BookingMutation) ResetField(name string) error {
 	switch name {
-	case organization.FieldCreatedAt:
+	case booking.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case organization.FieldUpdatedAt:
+	case booking.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case organization.FieldName:
-		m.ResetName()
+	case booking.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case organization.FieldPublicKey:
-		m.ResetPublicKey()
+	case booking.FieldStartTime:
+		m.ResetStartTime()
 		return nil
-	case organization.FieldPrivateKey:
-		m.ResetPrivateKey()
+	case booking.FieldEndTime:
+		m.ResetEndTime()
+		return nil
+	case booking.FieldResourceId:
+		m.ResetResourceId()
+		return nil
+	case booking.FieldUserId:
+		m.ResetUserId()
+		return nil
+	case booking.FieldSeriesId:
+		m.ResetSeriesId()
+		return nil
+	case booking.FieldCustomerId:
+		m.ResetCustomerId()
+		return nil
+	case booking.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case booking.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case booking.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case booking.FieldCancelledAt:
+		m.ResetCancelledAt()
+		return nil
+	case booking.FieldRefundAmount:
+		m.ResetRefundAmount()
+		return nil
+	case booking.FieldFeeAmount:
+		m.ResetFeeAmount()
+		return nil
+	case booking.FieldTaxName:
+		m.ResetTaxName()
+		return nil
+	case booking.FieldTaxRate:
+		m.ResetTaxRate()
+		return nil
+	case booking.FieldTaxInclusive:
+		m.ResetTaxInclusive()
+		return nil
+	case booking.FieldTaxAmount:
+		m.ResetTaxAmount()
 		return nil
 	}
-	return fmt.Errorf("unknown Organization field %s", name)
+	return fmt.Errorf("unknown Booking field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *OrganizationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.users != nil {
-		edges = append(edges, organization.EdgeUsers)
+func (m *BookingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.metadata != nil {
+		edges = append(edges, booking.EdgeMetadata)
 	}
-	if m.resources != nil {
-		edges = append(edges, organization.EdgeResources)
+	if m.waitlistEntries != nil {
+		edges = append(edges, booking.EdgeWaitlistEntries)
 	}
-	if m.tokens != nil {
-		edges = append(edges, organization.EdgeTokens)
+	if m.payments != nil {
+		edges = append(edges, booking.EdgePayments)
+	}
+	if m.promoRedemptions != nil {
+		edges = append(edges, booking.EdgePromoRedemptions)
+	}
+	if m.invoices != nil {
+		edges = append(edges, booking.EdgeInvoices)
+	}
+	if m.resource != nil {
+		edges = append(edges, booking.EdgeResource)
+	}
+	if m.user != nil {
+		edges = append(edges, booking.EdgeUser)
+	}
+	if m.series != nil {
+		edges = append(edges, booking.EdgeSeries)
+	}
+	if m.customer != nil {
+		edges = append(edges, booking.EdgeCustomer)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *OrganizationMutation) AddedIDs(name string) []ent.Value {
+func (m *BookingMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case organization.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.users))
-		for id := range m.users {
+	case booking.EdgeMetadata:
+		ids := make([]ent.Value, 0, len(m.metadata))
+		for id := range m.metadata {
 			ids = append(ids, id)
 		}
 		return ids
-	case organization.EdgeResources:
-		ids := make([]ent.Value, 0, len(m.resources))
-		for id := range m.resources {
+	case booking.EdgeWaitlistEntries:
+		ids := make([]ent.Value, 0, len(m.waitlistEntries))
+		for id := range m.waitlistEntries {
 			ids = append(ids, id)
 		}
 		return ids
-	case organization.EdgeTokens:
-		ids := make([]ent.Value, 0, len(m.tokens))
-		for id := range m.tokens {
+	case booking.EdgePayments:
+		ids := make([]ent.Value, 0, len(m.payments))
+		for id := range m.payments {
 			ids = append(ids, id)
 		}
 		return ids
+	case booking.EdgePromoRedemptions:
+		ids := make([]ent.Value, 0, len(m.promoRedemptions))
+		for id := range m.promoRedemptions {
+			ids = append(ids, id)
+		}
+		return ids
+	case booking.EdgeInvoices:
+		ids := make([]ent.Value, 0, len(m.invoices))
+		for id := range m.invoices {
+			ids = append(ids, id)
+		}
+		return ids
+	case booking.EdgeResource:
+		if id := m.resource; id != nil {
+			return []ent.Value{*id}
+		}
+	case booking.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case booking.EdgeSeries:
+		if id := m.series; id != nil {
+			return []ent.Value{*id}
+		}
+	case booking.EdgeCustomer:
+		if id := m.customer; id != nil {
+			return []ent.Value{*id}
+		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *OrganizationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedusers != nil {
-		edges = append(edges, organization.EdgeUsers)
+func (m *BookingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.removedmetadata != nil {
+		edges = append(edges, booking.EdgeMetadata)
 	}
-	if m.removedresources != nil {
-		edges = append(edges, organization.EdgeResources)
+	if m.removedwaitlistEntries != nil {
+		edges = append(edges, booking.EdgeWaitlistEntries)
 	}
-	if m.removedtokens != nil {
-		edges = append(edges, organization.EdgeTokens)
+	if m.removedpayments != nil {
+		edges = append(edges, booking.EdgePayments)
+	}
+	if m.removedpromoRedemptions != nil {
+		edges = append(edges, booking.EdgePromoRedemptions)
+	}
+	if m.removedinvoices != nil {
+		edges = append(edges, booking.EdgeInvoices)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *OrganizationMutation) RemovedIDs(name string) []ent.Value {
+func (m *BookingMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case organization.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.removedusers))
-		for id := range m.removedusers {
+	case booking.EdgeMetadata:
+		ids := make([]ent.Value, 0, len(m.removedmetadata))
+		for id := range m.removedmetadata {
 			ids = append(ids, id)
 		}
 		return ids
-	case organization.EdgeResources:
-		ids := make([]ent.Value, 0, len(m.removedresources))
-		for id := range m.removedresources {
+	case booking.EdgeWaitlistEntries:
+		ids := make([]ent.Value, 0, len(m.removedwaitlistEntries))
+		for id := range m.removedwaitlistEntries {
 			ids = append(ids, id)
 		}
 		return ids
-	case organization.EdgeTokens:
-		ids := make([]ent.Value, 0, len(m.removedtokens))
-		for id := range m.removedtokens {
+	case booking.EdgePayments:
+		ids := make([]ent.Value, 0, len(m.removedpayments))
+		for id := range m.removedpayments {
+			ids = append(ids, id)
+		}
+		return ids
+	case booking.EdgePromoRedemptions:
+		ids := make([]ent.Value, 0, len(m.removedpromoRedemptions))
+		for id := range m.removedpromoRedemptions {
+			ids = append(ids, id)
+		}
+		return ids
+	case booking.EdgeInvoices:
+		ids := make([]ent.Value, 0, len(m.removedinvoices))
+		for id := range m.removedinvoices {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2746,86 +2978,146 @@ func (m *OrganizationMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *OrganizationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedusers {
-		edges = append(edges, organization.EdgeUsers)
+func (m *BookingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.clearedmetadata {
+		edges = append(edges, booking.EdgeMetadata)
 	}
-	if m.clearedresources {
-		edges = append(edges, organization.EdgeResources)
+	if m.clearedwaitlistEntries {
+		edges = append(edges, booking.EdgeWaitlistEntries)
 	}
-	if m.clearedtokens {
-		edges = append(edges, organization.EdgeTokens)
+	if m.clearedpayments {
+		edges = append(edges, booking.EdgePayments)
+	}
+	if m.clearedpromoRedemptions {
+		edges = append(edges, booking.EdgePromoRedemptions)
+	}
+	if m.clearedinvoices {
+		edges = append(edges, booking.EdgeInvoices)
+	}
+	if m.clearedresource {
+		edges = append(edges, booking.EdgeResource)
+	}
+	if m.cleareduser {
+		edges = append(edges, booking.EdgeUser)
+	}
+	if m.clearedseries {
+		edges = append(edges, booking.EdgeSeries)
+	}
+	if m.clearedcustomer {
+		edges = append(edges, booking.EdgeCustomer)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *OrganizationMutation) EdgeCleared(name string) bool {
+func (m *BookingMutation) EdgeCleared(name string) bool {
 	switch name {
-	case organization.EdgeUsers:
-		return m.clearedusers
-	case organization.EdgeResources:
-		return m.clearedresources
-	case organization.EdgeTokens:
-		return m.clearedtokens
+	case booking.EdgeMetadata:
+		return m.clearedmetadata
+	case booking.EdgeWaitlistEntries:
+		return m.clearedwaitlistEntries
+	case booking.EdgePayments:
+		return m.clearedpayments
+	case booking.EdgePromoRedemptions:
+		return m.clearedpromoRedemptions
+	case booking.EdgeInvoices:
+		return m.clearedinvoices
+	case booking.EdgeResource:
+		return m.clearedresource
+	case booking.EdgeUser:
+		return m.cleareduser
+	case booking.EdgeSeries:
+		return m.clearedseries
+	case booking.EdgeCustomer:
+		return m.clearedcustomer
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *OrganizationMutation) ClearEdge(name string) error {
+func (m *BookingMutation) ClearEdge(name string) error {
 	switch name {
+	case booking.EdgeResource:
+		m.ClearResource()
+		return nil
+	case booking.EdgeUser:
+		m.ClearUser()
+		return nil
+	case booking.EdgeSeries:
+		m.ClearSeries()
+		return nil
+	case booking.EdgeCustomer:
+		m.ClearCustomer()
+		return nil
 	}
-	return fmt.Errorf("unknown Organization unique edge %s", name)
+	return fmt.Errorf("unknown Booking unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *OrganizationMutation) ResetEdge(name string) error {
+func (m *BookingMutation) ResetEdge(name string) error {
 	switch name {
-	case organization.EdgeUsers:
-		m.ResetUsers()
+	case booking.EdgeMetadata:
+		m.ResetMetadata()
 		return nil
-	case organization.EdgeResources:
-		m.ResetResources()
+	case booking.EdgeWaitlistEntries:
+		m.ResetWaitlistEntries()
 		return nil
-	case organization.EdgeTokens:
-		m.ResetTokens()
+	case booking.EdgePayments:
+		m.ResetPayments()
+		return nil
+	case booking.EdgePromoRedemptions:
+		m.ResetPromoRedemptions()
+		return nil
+	case booking.EdgeInvoices:
+		m.ResetInvoices()
+		return nil
+	case booking.EdgeResource:
+		m.ResetResource()
+		return nil
+	case booking.EdgeUser:
+		m.ResetUser()
+		return nil
+	case booking.EdgeSeries:
+		m.ResetSeries()
+		return nil
+	case booking.EdgeCustomer:
+		m.ResetCustomer()
 		return nil
 	}
-	return fmt.Errorf("unknown Organization edge %s", name)
+	return fmt.Errorf("unknown Booking edge %s", name)
 }
 
-// OrganizationOwnershipMutation represents an operation that mutates the OrganizationOwnership nodes in the graph.
-type OrganizationOwnershipMutation struct {
+// BookingMetadatumMutation represents an operation that mutates the BookingMetadatum nodes in the graph.
+type BookingMetadatumMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *int
-	clearedFields       map[string]struct{}
-	user                *int
-	cleareduser         bool
-	organization        *int
-	clearedorganization bool
-	done                bool
-	oldValue            func(context.Context) (*OrganizationOwnership, error)
-	predicates          []predicate.OrganizationOwnership
+	op             Op
+	typ            string
+	id             *int
+	key            *string
+	value          *string
+	clearedFields  map[string]struct{}
+	booking        *int
+	clearedbooking bool
+	done           bool
+	oldValue       func(context.Context) (*BookingMetadatum, error)
+	predicates     []predicate.BookingMetadatum
 }
 
-var _ ent.Mutation = (*OrganizationOwnershipMutation)(nil)
+var _ ent.Mutation = (*BookingMetadatumMutation)(nil)
 
-// organizationownershipOption allows management of the mutation configuration using functional options.
-type organizationownershipOption func(*OrganizationOwnershipMutation)
+// bookingmetadatumOption allows management of the mutation configuration using functional options.
+type bookingmetadatumOption func(*BookingMetadatumMutation)
 
-// newOrganizationOwnershipMutation creates new mutation for the OrganizationOwnership entity.
-func newOrganizationOwnershipMutation(c config, op Op, opts ...organizationownershipOption) *OrganizationOwnershipMutation {
-	m := &OrganizationOwnershipMutation{
+// newBookingMetadatumMutation creates new mutation for the BookingMetadatum entity.
+func newBookingMetadatumMutation(c config, op Op, opts ...bookingmetadatumOption) *BookingMetadatumMutation {
+	m := &BookingMetadatumMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeOrganizationOwnership,
+		typ:           TypeBookingMetadatum,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2834,20 +3126,20 @@ func newOrganizationOwnershipMutation(c config, op Op, opts ...organizationowner
 	return m
 }
 
-// withOrganizationOwnershipID sets the ID field of the mutation.
-func withOrganizationOwnershipID(id int) organizationownershipOption {
-	return func(m *OrganizationOwnershipMutation) {
+// withBookingMetadatumID sets the ID field of the mutation.
+func withBookingMetadatumID(id int) bookingmetadatumOption {
+	return func(m *BookingMetadatumMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *OrganizationOwnership
+			value *BookingMetadatum
 		)
-		m.oldValue = func(ctx context.Context) (*OrganizationOwnership, error) {
+		m.oldValue = func(ctx context.Context) (*BookingMetadatum, error) {
 			once.Do(func() {
 				if m.done {
 					err = fmt.Errorf("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().OrganizationOwnership.Get(ctx, id)
+					value, err = m.Client().BookingMetadatum.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2856,10 +3148,10 @@ func withOrganizationOwnershipID(id int) organizationownershipOption {
 	}
 }
 
-// withOrganizationOwnership sets the old OrganizationOwnership of the mutation.
-func withOrganizationOwnership(node *OrganizationOwnership) organizationownershipOption {
-	return func(m *OrganizationOwnershipMutation) {
-		m.oldValue = func(context.Context) (*OrganizationOwnership, error) {
+// withBookingMetadatum sets the old BookingMetadatum of the mutation.
+func withBookingMetadatum(node *BookingMetadatum) bookingmetadatumOption {
+	return func(m *BookingMetadatumMutation) {
+		m.oldValue = func(context.Context) (*BookingMetadatum, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2868,7 +3160,7 @@ func withOrganizationOwnership(node *OrganizationOwnership) organizationownershi
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m OrganizationOwnershipMutation) Client() *Client {
+func (m BookingMetadatumMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2876,7 +3168,7 @@ func (m OrganizationOwnershipMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m OrganizationOwnershipMutation) Tx() (*Tx, error) {
+func (m BookingMetadatumMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
 	}
@@ -2887,188 +3179,188 @@ func (m OrganizationOwnershipMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *OrganizationOwnershipMutation) ID() (id int, exists bool) {
+func (m *BookingMetadatumMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
 	return *m.id, true
 }
 
-// SetUserId sets the "userId" field.
-func (m *OrganizationOwnershipMutation) SetUserId(i int) {
-	m.user = &i
+// SetKey sets the "key" field.
+func (m *BookingMetadatumMutation) SetKey(s string) {
+	m.key = &s
 }
 
-// UserId returns the value of the "userId" field in the mutation.
-func (m *OrganizationOwnershipMutation) UserId() (r int, exists bool) {
-	v := m.user
+// Key returns the value of the "key" field in the mutation.
+func (m *BookingMetadatumMutation) Key() (r string, exists bool) {
+	v := m.key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserId returns the old "userId" field's value of the OrganizationOwnership entity.
-// If the OrganizationOwnership object wasn't provided to the builder, the object is fetched from the database.
+// OldKey returns the old "key" field's value of the BookingMetadatum entity.
+// If the BookingMetadatum object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationOwnershipMutation) OldUserId(ctx context.Context) (v int, err error) {
+func (m *BookingMetadatumMutation) OldKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldUserId is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldUserId requires an ID field in the mutation")
+		return v, fmt.Errorf("OldKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
 	}
-	return oldValue.UserId, nil
+	return oldValue.Key, nil
 }
 
-// ResetUserId resets all changes to the "userId" field.
-func (m *OrganizationOwnershipMutation) ResetUserId() {
-	m.user = nil
+// ResetKey resets all changes to the "key" field.
+func (m *BookingMetadatumMutation) ResetKey() {
+	m.key = nil
 }
 
-// SetOrganizationId sets the "organizationId" field.
-func (m *OrganizationOwnershipMutation) SetOrganizationId(i int) {
-	m.organization = &i
+// SetValue sets the "value" field.
+func (m *BookingMetadatumMutation) SetValue(s string) {
+	m.value = &s
 }
 
-// OrganizationId returns the value of the "organizationId" field in the mutation.
-func (m *OrganizationOwnershipMutation) OrganizationId() (r int, exists bool) {
-	v := m.organization
+// Value returns the value of the "value" field in the mutation.
+func (m *BookingMetadatumMutation) Value() (r string, exists bool) {
+	v := m.value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOrganizationId returns the old "organizationId" field's value of the OrganizationOwnership entity.
-// If the OrganizationOwnership object wasn't provided to the builder, the object is fetched from the database.
+// OldValue returns the old "value" field's value of the BookingMetadatum entity.
+// If the BookingMetadatum object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationOwnershipMutation) OldOrganizationId(ctx context.Context) (v int, err error) {
+func (m *BookingMetadatumMutation) OldValue(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldOrganizationId is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldOrganizationId requires an ID field in the mutation")
+		return v, fmt.Errorf("OldValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOrganizationId: %w", err)
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
-	return oldValue.OrganizationId, nil
-}
-
-// ResetOrganizationId resets all changes to the "organizationId" field.
-func (m *OrganizationOwnershipMutation) ResetOrganizationId() {
-	m.organization = nil
-}
-
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *OrganizationOwnershipMutation) SetUserID(id int) {
-	m.user = &id
+	return oldValue.Value, nil
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *OrganizationOwnershipMutation) ClearUser() {
-	m.cleareduser = true
+// ResetValue resets all changes to the "value" field.
+func (m *BookingMetadatumMutation) ResetValue() {
+	m.value = nil
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *OrganizationOwnershipMutation) UserCleared() bool {
-	return m.cleareduser
+// SetBookingId sets the "bookingId" field.
+func (m *BookingMetadatumMutation) SetBookingId(i int) {
+	m.booking = &i
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *OrganizationOwnershipMutation) UserID() (id int, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// BookingId returns the value of the "bookingId" field in the mutation.
+func (m *BookingMetadatumMutation) BookingId() (r int, exists bool) {
+	v := m.booking
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *OrganizationOwnershipMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// OldBookingId returns the old "bookingId" field's value of the BookingMetadatum entity.
+// If the BookingMetadatum object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookingMetadatumMutation) OldBookingId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldBookingId is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldBookingId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBookingId: %w", err)
+	}
+	return oldValue.BookingId, nil
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *OrganizationOwnershipMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetBookingId resets all changes to the "bookingId" field.
+func (m *BookingMetadatumMutation) ResetBookingId() {
+	m.booking = nil
 }
 
-// SetOrganizationID sets the "organization" edge to the Organization entity by id.
-func (m *OrganizationOwnershipMutation) SetOrganizationID(id int) {
-	m.organization = &id
+// SetBookingID sets the "booking" edge to the Booking entity by id.
+func (m *BookingMetadatumMutation) SetBookingID(id int) {
+	m.booking = &id
 }
 
-// ClearOrganization clears the "organization" edge to the Organization entity.
-func (m *OrganizationOwnershipMutation) ClearOrganization() {
-	m.clearedorganization = true
+// ClearBooking clears the "booking" edge to the Booking entity.
+func (m *BookingMetadatumMutation) ClearBooking() {
+	m.clearedbooking = true
 }
 
-// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
-func (m *OrganizationOwnershipMutation) OrganizationCleared() bool {
-	return m.clearedorganization
+// BookingCleared reports if the "booking" edge to the Booking entity was cleared.
+func (m *BookingMetadatumMutation) BookingCleared() bool {
+	return m.clearedbooking
 }
 
-// OrganizationID returns the "organization" edge ID in the mutation.
-func (m *OrganizationOwnershipMutation) OrganizationID() (id int, exists bool) {
-	if m.organization != nil {
-		return *m.organization, true
+// BookingID returns the "booking" edge ID in the mutation.
+func (m *BookingMetadatumMutation) BookingID() (id int, exists bool) {
+	if m.booking != nil {
+		return *m.booking, true
 	}
 	return
 }
 
-// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// BookingIDs returns the "booking" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OrganizationID instead. It exists only for internal usage by the builders.
-func (m *OrganizationOwnershipMutation) OrganizationIDs() (ids []int) {
-	if id := m.organization; id != nil {
+// BookingID instead. It exists only for internal usage by the builders.
+func (m *BookingMetadatumMutation) BookingIDs() (ids []int) {
+	if id := m.booking; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetOrganization resets all changes to the "organization" edge.
-func (m *OrganizationOwnershipMutation) ResetOrganization() {
-	m.organization = nil
-	m.clearedorganization = false
+// ResetBooking resets all changes to the "booking" edge.
+func (m *BookingMetadatumMutation) ResetBooking() {
+	m.booking = nil
+	m.clearedbooking = false
 }
 
-// Where appends a list predicates to the OrganizationOwnershipMutation builder.
-func (m *OrganizationOwnershipMutation) Where(ps ...predicate.OrganizationOwnership) {
+// Where appends a list predicates to the BookingMetadatumMutation builder.
+func (m *BookingMetadatumMutation) Where(ps ...predicate.BookingMetadatum) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *OrganizationOwnershipMutation) Op() Op {
+func (m *BookingMetadatumMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (OrganizationOwnership).
-func (m *OrganizationOwnershipMutation) Type() string {
+// Type returns the node type of this mutation (BookingMetadatum).
+func (m *BookingMetadatumMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *OrganizationOwnershipMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.user != nil {
-		fields = append(fields, organizationownership.FieldUserId)
+func (m *BookingMetadatumMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.key != nil {
+		fields = append(fields, bookingmetadatum.FieldKey)
 	}
-	if m.organization != nil {
-		fields = append(fields, organizationownership.FieldOrganizationId)
+	if m.value != nil {
+		fields = append(fields, bookingmetadatum.FieldValue)
+	}
+	if m.booking != nil {
+		fields = append(fields, bookingmetadatum.FieldBookingId)
 	}
 	return fields
 }
@@ -3076,12 +3368,14 @@ func (m *OrganizationOwnershipMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *OrganizationOwnershipMutation) Field(name string) (ent.Value, bool) {
+func (m *BookingMetadatumMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case organizationownership.FieldUserId:
-		return m.UserId()
-	case organizationownership.FieldOrganizationId:
-		return m.OrganizationId()
+	case bookingmetadatum.FieldKey:
+		return m.Key()
+	case bookingmetadatum.FieldValue:
+		return m.Value()
+	case bookingmetadatum.FieldBookingId:
+		return m.BookingId()
 	}
 	return nil, false
 }
@@ -3089,42 +3383,51 @@ func (m *OrganizationOwnershipMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *OrganizationOwnershipMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BookingMetadatumMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case organizationownership.FieldUserId:
-		return m.OldUserId(ctx)
-	case organizationownership.FieldOrganizationId:
-		return m.OldOrganizationId(ctx)
+	case bookingmetadatum.FieldKey:
+		return m.OldKey(ctx)
+	case bookingmetadatum.FieldValue:
+		return m.OldValue(ctx)
+	case bookingmetadatum.FieldBookingId:
+		return m.OldBookingId(ctx)
 	}
-	return nil, fmt.Errorf("unknown OrganizationOwnership field %s", name)
+	return nil, fmt.Errorf("unknown BookingMetadatum field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OrganizationOwnershipMutation) SetField(name string, value ent.Value) error {
+func (m *BookingMetadatumMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case organizationownership.FieldUserId:
-		v, ok := value.(int)
+	case bookingmetadatum.FieldKey:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserId(v)
+		m.SetKey(v)
 		return nil
-	case organizationownership.FieldOrganizationId:
+	case bookingmetadatum.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case bookingmetadatum.FieldBookingId:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOrganizationId(v)
+		m.SetBookingId(v)
 		return nil
 	}
-	return fmt.Errorf("unknown OrganizationOwnership field %s", name)
+	return fmt.Errorf("unknown BookingMetadatum field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *OrganizationOwnershipMutation) AddedFields() []string {
+func (m *BookingMetadatumMutation) AddedFields() []string {
 	var fields []string
 	return fields
 }
@@ -3132,7 +3435,7 @@ func (m *OrganizationOwnershipMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *OrganizationOwnershipMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BookingMetadatumMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	}
 	return nil, false
@@ -3141,67 +3444,63 @@ func (m *OrganizationOwnershipMutation) AddedField(name string) (ent.Value, bool
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OrganizationOwnershipMutation) AddField(name string, value ent.Value) error {
+func (m *BookingMetadatumMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown OrganizationOwnership numeric field %s", name)
+	return fmt.Errorf("unknown BookingMetadatum numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *OrganizationOwnershipMutation) ClearedFields() []string {
+func (m *BookingMetadatumMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *OrganizationOwnershipMutation) FieldCleared(name string) bool {
+func (m *BookingMetadatumMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *OrganizationOwnershipMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown OrganizationOwnership nullable field %s", name)
+func (m *BookingMetadatumMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown BookingMetadatum nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *OrganizationOwnershipMutation) ResetField(name string) error {
+func (m *BookingMetadatumMutation) ResetField(name string) error {
 	switch name {
-	case organizationownership.FieldUserId:
-		m.ResetUserId()
+	case bookingmetadatum.FieldKey:
+		m.ResetKey()
 		return nil
-	case organizationownership.FieldOrganizationId:
-		m.ResetOrganizationId()
+	case bookingmetadatum.FieldValue:
+		m.ResetValue()
+		return nil
+	case bookingmetadatum.FieldBookingId:
+		m.ResetBookingId()
 		return nil
 	}
-	return fmt.Errorf("unknown OrganizationOwnership field %s", name)
+	return fmt.Errorf("unknown BookingMetadatum field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *OrganizationOwnershipMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, organizationownership.EdgeUser)
-	}
-	if m.organization != nil {
-		edges = append(edges, organizationownership.EdgeOrganization)
+func (m *BookingMetadatumMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.booking != nil {
+		edges = append(edges, bookingmetadatum.EdgeBooking)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *OrganizationOwnershipMutation) AddedIDs(name string) []ent.Value {
+func (m *BookingMetadatumMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case organizationownership.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case organizationownership.EdgeOrganization:
-		if id := m.organization; id != nil {
+	case bookingmetadatum.EdgeBooking:
+		if id := m.booking; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -3209,117 +3508,94 @@ func (m *OrganizationOwnershipMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *OrganizationOwnershipMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *BookingMetadatumMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *OrganizationOwnershipMutation) RemovedIDs(name string) []ent.Value {
+func (m *BookingMetadatumMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *OrganizationOwnershipMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, organizationownership.EdgeUser)
-	}
-	if m.clearedorganization {
-		edges = append(edges, organizationownership.EdgeOrganization)
+func (m *BookingMetadatumMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedbooking {
+		edges = append(edges, bookingmetadatum.EdgeBooking)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *OrganizationOwnershipMutation) EdgeCleared(name string) bool {
+func (m *BookingMetadatumMutation) EdgeCleared(name string) bool {
 	switch name {
-	case organizationownership.EdgeUser:
-		return m.cleareduser
-	case organizationownership.EdgeOrganization:
-		return m.clearedorganization
+	case bookingmetadatum.EdgeBooking:
+		return m.clearedbooking
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *OrganizationOwnershipMutation) ClearEdge(name string) error {
+func (m *BookingMetadatumMutation) ClearEdge(name string) error {
 	switch name {
-	case organizationownership.EdgeUser:
-		m.ClearUser()
-		return nil
-	case organizationownership.EdgeOrganization:
-		m.ClearOrganization()
+	case bookingmetadatum.EdgeBooking:
+		m.ClearBooking()
 		return nil
 	}
-	return fmt.Errorf("unknown OrganizationOwnership unique edge %s", name)
+	return fmt.Errorf("unknown BookingMetadatum unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *OrganizationOwnershipMutation) ResetEdge(name string) error {
+func (m *BookingMetadatumMutation) ResetEdge(name string) error {
 	switch name {
-	case organizationownership.EdgeUser:
-		m.ResetUser()
-		return nil
-	case organizationownership.EdgeOrganization:
-		m.ResetOrganization()
+	case bookingmetadatum.EdgeBooking:
+		m.ResetBooking()
 		return nil
 	}
-	return fmt.Errorf("unknown OrganizationOwnership edge %s", name)
+	return fmt.Errorf("unknown BookingMetadatum edge %s", name)
 }
 
-// ResourceMutation represents an operation that mutates the Resource nodes in the graph.
-type ResourceMutation struct {
+// BookingSeriesMutation represents an operation that mutates the BookingSeries nodes in the graph.
+type BookingSeriesMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *int
-	createdAt               *time.Time
-	updatedAt               *time.Time
-	name                    *string
-	description             *string
-	timezone                *string
-	password                *string
-	price                   *int
-	addprice                *int
-	bookingPrice            *int
-	addbookingPrice         *int
-	quantityAvailable       *int
-	addquantityAvailable    *int
-	clearedFields           map[string]struct{}
-	slots                   map[int]struct{}
-	removedslots            map[int]struct{}
-	clearedslots            bool
-	bookings                map[int]struct{}
-	removedbookings         map[int]struct{}
-	clearedbookings         bool
-	unavailabilities        map[int]struct{}
-	removedunavailabilities map[int]struct{}
-	clearedunavailabilities bool
-	organization            *int
-	clearedorganization     bool
-	done                    bool
-	oldValue                func(context.Context) (*Resource, error)
-	predicates              []predicate.Resource
+	op              Op
+	typ             string
+	id              *int
+	createdAt       *time.Time
+	updatedAt       *time.Time
+	rrule           *string
+	exdates         *[]time.Time
+	startTime       *time.Time
+	endTime         *time.Time
+	clearedFields   map[string]struct{}
+	bookings        map[int]struct{}
+	removedbookings map[int]struct{}
+	clearedbookings bool
+	resource        *int
+	clearedresource bool
+	done            bool
+	oldValue        func(context.Context) (*BookingSeries, error)
+	predicates      []predicate.BookingSeries
 }
 
-var _ ent.Mutation = (*ResourceMutation)(nil)
+var _ ent.Mutation = (*BookingSeriesMutation)(nil)
 
-// resourceOption allows management of the mutation configuration using functional options.
-type resourceOption func(*ResourceMutation)
+// bookingseriesOption allows management of the mutation configuration using functional options.
+type bookingseriesOption func(*BookingSeriesMutation)
 
-// newResourceMutation creates new mutation for the Resource entity.
-func newResourceMutation(c config, op Op, opts ...resourceOption) *ResourceMutation {
-	m := &ResourceMutation{
+// newBookingSeriesMutation creates new mutation for the BookingSeries entity.
+func newBookingSeriesMutation(c config, op Op, opts ...bookingseriesOption) *BookingSeriesMutation {
+	m := &BookingSeriesMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeResource,
+		typ:           TypeBookingSeries,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3328,20 +3604,20 @@ func newResourceMutation(c config, op Op, opts ...resourceOption) *ResourceMutat
 	return m
 }
 
-// withResourceID sets the ID field of the mutation.
-func withResourceID(id int) resourceOption {
-	return func(m *ResourceMutation) {
+// withBookingSeriesID sets the ID field of the mutation.
+func withBookingSeriesID(id int) bookingseriesOption {
+	return func(m *BookingSeriesMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Resource
+			value *BookingSeries
 		)
-		m.oldValue = func(ctx context.Context) (*Resource, error) {
+		m.oldValue = func(ctx context.Context) (*BookingSeries, error) {
 			once.Do(func() {
 				if m.done {
 					err = fmt.Errorf("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Resource.Get(ctx, id)
+					value, err = m.Client().BookingSeries.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3350,10 +3626,10 @@ func withResourceID(id int) resourceOption {
 	}
 }
 
-// withResource sets the old Resource of the mutation.
-func withResource(node *Resource) resourceOption {
-	return func(m *ResourceMutation) {
-		m.oldValue = func(context.Context) (*Resource, error) {
+// withBookingSeries sets the old BookingSeries of the mutation.
+func withBookingSeries(node *BookingSeries) bookingseriesOption {
+	return func(m *BookingSeriesMutation) {
+		m.oldValue = func(context.Context) (*BookingSeries, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3362,7 +3638,7 @@ func withResource(node *Resource) resourceOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ResourceMutation) Client() *Client {
+func (m BookingSeriesMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3370,7 +3646,7 @@ func (m ResourceMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ResourceMutation) Tx() (*Tx, error) {
+func (m BookingSeriesMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
 	}
@@ -3381,7 +3657,7 @@ func (m ResourceMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ResourceMutation) ID() (id int, exists bool) {
+func (m *BookingSeriesMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3389,12 +3665,12 @@ func (m *ResourceMutation) ID() (id int, exists bool) {
 }
 
 // SetCreatedAt sets the "createdAt" field.
-func (m *ResourceMutation) SetCreatedAt(t time.Time) {
+func (m *BookingSeriesMutation) SetCreatedAt(t time.Time) {
 	m.createdAt = &t
 }
 
 // CreatedAt returns the value of the "createdAt" field in the mutation.
-func (m *ResourceMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *BookingSeriesMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.createdAt
 	if v == nil {
 		return
@@ -3402,10 +3678,10 @@ func (m *ResourceMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "createdAt" field's value of the Resource entity.
-// If the Resource object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "createdAt" field's value of the BookingSeries entity.
+// If the BookingSeries object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ResourceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BookingSeriesMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, fmt.Errorf("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3420,17 +3696,17 @@ func (m *ResourceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreatedAt resets all changes to the "createdAt" field.
-func (m *ResourceMutation) ResetCreatedAt() {
+func (m *BookingSeriesMutation) ResetCreatedAt() {
 	m.createdAt = nil
 }
 
 // SetUpdatedAt sets the "updatedAt" field.
-func (m *ResourceMutation) SetUpdatedAt(t time.Time) {
+func (m *BookingSeriesMutation) SetUpdatedAt(t time.Time) {
 	m.updatedAt = &t
 }
 
 // UpdatedAt returns the value of the "updatedAt" field in the mutation.
-func (m *ResourceMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *BookingSeriesMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updatedAt
 	if v == nil {
 		return
@@ -3438,10 +3714,10 @@ func (m *ResourceMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updatedAt" field's value of the Resource entity.
-// If the Resource object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updatedAt" field's value of the BookingSeries entity.
+// If the BookingSeries object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ResourceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BookingSeriesMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, fmt.Errorf("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -3456,622 +3732,12635 @@ func (m *ResourceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdatedAt resets all changes to the "updatedAt" field.
-func (m *ResourceMutation) ResetUpdatedAt() {
+func (m *BookingSeriesMutation) ResetUpdatedAt() {
 	m.updatedAt = nil
 }
 
-// SetName sets the "name" field.
-func (m *ResourceMutation) SetName(s string) {
-	m.name = &s
+// SetRrule sets the "rrule" field.
+func (m *BookingSeriesMutation) SetRrule(s string) {
+	m.rrule = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ResourceMutation) Name() (r string, exists bool) {
-	v := m.name
+// Rrule returns the value of the "rrule" field in the mutation.
+func (m *BookingSeriesMutation) Rrule() (r string, exists bool) {
+	v := m.rrule
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Resource entity.
-// If the Resource object wasn't provided to the builder, the object is fetched from the database.
+// OldRrule returns the old "rrule" field's value of the BookingSeries entity.
+// If the BookingSeries object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ResourceMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *BookingSeriesMutation) OldRrule(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldName is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldRrule is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+		return v, fmt.Errorf("OldRrule requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldRrule: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Rrule, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ResourceMutation) ResetName() {
-	m.name = nil
+// ResetRrule resets all changes to the "rrule" field.
+func (m *BookingSeriesMutation) ResetRrule() {
+	m.rrule = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *ResourceMutation) SetDescription(s string) {
-	m.description = &s
+// SetExdates sets the "exdates" field.
+func (m *BookingSeriesMutation) SetExdates(t []time.Time) {
+	m.exdates = &t
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ResourceMutation) Description() (r string, exists bool) {
-	v := m.description
+// Exdates returns the value of the "exdates" field in the mutation.
+func (m *BookingSeriesMutation) Exdates() (r []time.Time, exists bool) {
+	v := m.exdates
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Resource entity.
-// If the Resource object wasn't provided to the builder, the object is fetched from the database.
+// OldExdates returns the old "exdates" field's value of the BookingSeries entity.
+// If the BookingSeries object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ResourceMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *BookingSeriesMutation) OldExdates(ctx context.Context) (v []time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldDescription is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldExdates is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldDescription requires an ID field in the mutation")
+		return v, fmt.Errorf("OldExdates requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldExdates: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.Exdates, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *ResourceMutation) ResetDescription() {
-	m.description = nil
+// ClearExdates clears the value of the "exdates" field.
+func (m *BookingSeriesMutation) ClearExdates() {
+	m.exdates = nil
+	m.clearedFields[bookingseries.FieldExdates] = struct{}{}
 }
 
-// SetTimezone sets the "timezone" field.
-func (m *ResourceMutation) SetTimezone(s string) {
-	m.timezone = &s
+// ExdatesCleared returns if the "exdates" field was cleared in this mutation.
+func (m *BookingSeriesMutation) ExdatesCleared() bool {
+	_, ok := m.clearedFields[bookingseries.FieldExdates]
+	return ok
 }
 
-// Timezone returns the value of the "timezone" field in the mutation.
-func (m *ResourceMutation) Timezone() (r string, exists bool) {
-	v := m.timezone
+// ResetExdates resets all changes to the "exdates" field.
+func (m *BookingSeriesMutation) ResetExdates() {
+	m.exdates = nil
+	delete(m.clearedFields, bookingseries.FieldExdates)
+}
+
+// SetStartTime sets the "startTime" field.
+func (m *BookingSeriesMutation) SetStartTime(t time.Time) {
+	m.startTime = &t
+}
+
+// StartTime returns the value of the "startTime" field in the mutation.
+func (m *BookingSeriesMutation) StartTime() (r time.Time, exists bool) {
+	v := m.startTime
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTimezone returns the old "timezone" field's value of the Resource entity.
-// If the Resource object wasn't provided to the builder, the object is fetched from the database.
+// OldStartTime returns the old "startTime" field's value of the BookingSeries entity.
+// If the BookingSeries object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ResourceMutation) OldTimezone(ctx context.Context) (v string, err error) {
+func (m *BookingSeriesMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldTimezone is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldStartTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldTimezone requires an ID field in the mutation")
+		return v, fmt.Errorf("OldStartTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimezone: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
 	}
-	return oldValue.Timezone, nil
+	return oldValue.StartTime, nil
 }
 
-// ResetTimezone resets all changes to the "timezone" field.
-func (m *ResourceMutation) ResetTimezone() {
-	m.timezone = nil
+// ResetStartTime resets all changes to the "startTime" field.
+func (m *BookingSeriesMutation) ResetStartTime() {
+	m.startTime = nil
 }
 
-// SetPassword sets the "password" field.
-func (m *ResourceMutation) SetPassword(s string) {
-	m.password = &s
+// SetEndTime sets the "endTime" field.
+func (m *BookingSeriesMutation) SetEndTime(t time.Time) {
+	m.endTime = &t
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *ResourceMutation) Password() (r string, exists bool) {
-	v := m.password
+// EndTime returns the value of the "endTime" field in the mutation.
+func (m *BookingSeriesMutation) EndTime() (r time.Time, exists bool) {
+	v := m.endTime
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPassword returns the old "password" field's value of the Resource entity.
-// If the Resource object wasn't provided to the builder, the object is fetched from the database.
+// OldEndTime returns the old "endTime" field's value of the BookingSeries entity.
+// If the BookingSeries object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ResourceMutation) OldPassword(ctx context.Context) (v string, err error) {
+func (m *BookingSeriesMutation) OldEndTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldPassword is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldEndTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldPassword requires an ID field in the mutation")
+		return v, fmt.Errorf("OldEndTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
 	}
-	return oldValue.Password, nil
+	return oldValue.EndTime, nil
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *ResourceMutation) ResetPassword() {
-	m.password = nil
+// ResetEndTime resets all changes to the "endTime" field.
+func (m *BookingSeriesMutation) ResetEndTime() {
+	m.endTime = nil
 }
 
-// SetPrice sets the "price" field.
-func (m *ResourceMutation) SetPrice(i int) {
-	m.price = &i
-	m.addprice = nil
+// SetResourceId sets the "resourceId" field.
+func (m *BookingSeriesMutation) SetResourceId(i int) {
+	m.resource = &i
 }
 
-// Price returns the value of the "price" field in the mutation.
-func (m *ResourceMutation) Price() (r int, exists bool) {
-	v := m.price
+// ResourceId returns the value of the "resourceId" field in the mutation.
+func (m *BookingSeriesMutation) ResourceId() (r int, exists bool) {
+	v := m.resource
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrice returns the old "price" field's value of the Resource entity.
-// If the Resource object wasn't provided to the builder, the object is fetched from the database.
+// OldResourceId returns the old "resourceId" field's value of the BookingSeries entity.
+// If the BookingSeries object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ResourceMutation) OldPrice(ctx context.Context) (v int, err error) {
+func (m *BookingSeriesMutation) OldResourceId(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldPrice is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldResourceId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldPrice requires an ID field in the mutation")
+		return v, fmt.Errorf("OldResourceId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+		return v, fmt.Errorf("querying old value for OldResourceId: %w", err)
 	}
-	return oldValue.Price, nil
+	return oldValue.ResourceId, nil
 }
 
-// AddPrice adds i to the "price" field.
-func (m *ResourceMutation) AddPrice(i int) {
-	if m.addprice != nil {
-		*m.addprice += i
-	} else {
-		m.addprice = &i
+// ResetResourceId resets all changes to the "resourceId" field.
+func (m *BookingSeriesMutation) ResetResourceId() {
+	m.resource = nil
+}
+
+// AddBookingIDs adds the "bookings" edge to the Booking entity by ids.
+func (m *BookingSeriesMutation) AddBookingIDs(ids ...int) {
+	if m.bookings == nil {
+		m.bookings = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.bookings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBookings clears the "bookings" edge to the Booking entity.
+func (m *BookingSeriesMutation) ClearBookings() {
+	m.clearedbookings = true
+}
+
+// BookingsCleared reports if the "bookings" edge to the Booking entity was cleared.
+func (m *BookingSeriesMutation) BookingsCleared() bool {
+	return m.clearedbookings
+}
+
+// RemoveBookingIDs removes the "bookings" edge to the Booking entity by IDs.
+func (m *BookingSeriesMutation) RemoveBookingIDs(ids ...int) {
+	if m.removedbookings == nil {
+		m.removedbookings = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.bookings, ids[i])
+		m.removedbookings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBookings returns the removed IDs of the "bookings" edge to the Booking entity.
+func (m *BookingSeriesMutation) RemovedBookingsIDs() (ids []int) {
+	for id := range m.removedbookings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BookingsIDs returns the "bookings" edge IDs in the mutation.
+func (m *BookingSeriesMutation) BookingsIDs() (ids []int) {
+	for id := range m.bookings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBookings resets all changes to the "bookings" edge.
+func (m *BookingSeriesMutation) ResetBookings() {
+	m.bookings = nil
+	m.clearedbookings = false
+	m.removedbookings = nil
+}
+
+// SetResourceID sets the "resource" edge to the Resource entity by id.
+func (m *BookingSeriesMutation) SetResourceID(id int) {
+	m.resource = &id
+}
+
+// ClearResource clears the "resource" edge to the Resource entity.
+func (m *BookingSeriesMutation) ClearResource() {
+	m.clearedresource = true
+}
+
+// ResourceCleared reports if the "resource" edge to the Resource entity was cleared.
+func (m *BookingSeriesMutation) ResourceCleared() bool {
+	return m.clearedresource
+}
+
+// ResourceID returns the "resource" edge ID in the mutation.
+func (m *BookingSeriesMutation) ResourceID() (id int, exists bool) {
+	if m.resource != nil {
+		return *m.resource, true
+	}
+	return
+}
+
+// ResourceIDs returns the "resource" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ResourceID instead. It exists only for internal usage by the builders.
+func (m *BookingSeriesMutation) ResourceIDs() (ids []int) {
+	if id := m.resource; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetResource resets all changes to the "resource" edge.
+func (m *BookingSeriesMutation) ResetResource() {
+	m.resource = nil
+	m.clearedresource = false
+}
+
+// Where appends a list predicates to the BookingSeriesMutation builder.
+func (m *BookingSeriesMutation) Where(ps ...predicate.BookingSeries) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *BookingSeriesMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (BookingSeries).
+func (m *BookingSeriesMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BookingSeriesMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.createdAt != nil {
+		fields = append(fields, bookingseries.FieldCreatedAt)
+	}
+	if m.updatedAt != nil {
+		fields = append(fields, bookingseries.FieldUpdatedAt)
+	}
+	if m.rrule != nil {
+		fields = append(fields, bookingseries.FieldRrule)
+	}
+	if m.exdates != nil {
+		fields = append(fields, bookingseries.FieldExdates)
+	}
+	if m.startTime != nil {
+		fields = append(fields, bookingseries.FieldStartTime)
+	}
+	if m.endTime != nil {
+		fields = append(fields, bookingseries.FieldEndTime)
+	}
+	if m.resource != nil {
+		fields = append(fields, bookingseries.FieldResourceId)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BookingSeriesMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bookingseries.FieldCreatedAt:
+		return m.CreatedAt()
+	case bookingseries.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case bookingseries.FieldRrule:
+		return m.Rrule()
+	case bookingseries.FieldExdates:
+		return m.Exdates()
+	case bookingseries.FieldStartTime:
+		return m.StartTime()
+	case bookingseries.FieldEndTime:
+		return m.EndTime()
+	case bookingseries.FieldResourceId:
+		return m.ResourceId()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BookingSeriesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bookingseries.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case bookingseries.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case bookingseries.FieldRrule:
+		return m.OldRrule(ctx)
+	case bookingseries.FieldExdates:
+		return m.OldExdates(ctx)
+	case bookingseries.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case bookingseries.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case bookingseries.FieldResourceId:
+		return m.OldResourceId(ctx)
+	}
+	return nil, fmt.Errorf("unknown BookingSeries field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BookingSeriesMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bookingseries.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case bookingseries.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case bookingseries.FieldRrule:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRrule(v)
+		return nil
+	case bookingseries.FieldExdates:
+		v, ok := value.([]time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExdates(v)
+		return nil
+	case bookingseries.FieldStartTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartTime(v)
+		return nil
+	case bookingseries.FieldEndTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndTime(v)
+		return nil
+	case bookingseries.FieldResourceId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BookingSeries field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BookingSeriesMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BookingSeriesMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BookingSeriesMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BookingSeries numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BookingSeriesMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bookingseries.FieldExdates) {
+		fields = append(fields, bookingseries.FieldExdates)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BookingSeriesMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BookingSeriesMutation) ClearField(name string) error {
+	switch name {
+	case bookingseries.FieldExdates:
+		m.ClearExdates()
+		return nil
+	}
+	return fmt.Errorf("unknown BookingSeries nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BookingSeriesMutation) ResetField(name string) error {
+	switch name {
+	case bookingseries.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case bookingseries.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case bookingseries.FieldRrule:
+		m.ResetRrule()
+		return nil
+	case bookingseries.FieldExdates:
+		m.ResetExdates()
+		return nil
+	case bookingseries.FieldStartTime:
+		m.ResetStartTime()
+		return nil
+	case bookingseries.FieldEndTime:
+		m.ResetEndTime()
+		return nil
+	case bookingseries.FieldResourceId:
+		m.ResetResourceId()
+		return nil
+	}
+	return fmt.Errorf("unknown BookingSeries field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BookingSeriesMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.bookings != nil {
+		edges = append(edges, bookingseries.EdgeBookings)
+	}
+	if m.resource != nil {
+		edges = append(edges, bookingseries.EdgeResource)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BookingSeriesMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case bookingseries.EdgeBookings:
+		ids := make([]ent.Value, 0, len(m.bookings))
+		for id := range m.bookings {
+			ids = append(ids, id)
+		}
+		return ids
+	case bookingseries.EdgeResource:
+		if id := m.resource; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BookingSeriesMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedbookings != nil {
+		edges = append(edges, bookingseries.EdgeBookings)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BookingSeriesMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case bookingseries.EdgeBookings:
+		ids := make([]ent.Value, 0, len(m.removedbookings))
+		for id := range m.removedbookings {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BookingSeriesMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedbookings {
+		edges = append(edges, bookingseries.EdgeBookings)
+	}
+	if m.clearedresource {
+		edges = append(edges, bookingseries.EdgeResource)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BookingSeriesMutation) EdgeCleared(name string) bool {
+	switch name {
+	case bookingseries.EdgeBookings:
+		return m.clearedbookings
+	case bookingseries.EdgeResource:
+		return m.clearedresource
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BookingSeriesMutation) ClearEdge(name string) error {
+	switch name {
+	case bookingseries.EdgeResource:
+		m.ClearResource()
+		return nil
+	}
+	return fmt.Errorf("unknown BookingSeries unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BookingSeriesMutation) ResetEdge(name string) error {
+	switch name {
+	case bookingseries.EdgeBookings:
+		m.ResetBookings()
+		return nil
+	case bookingseries.EdgeResource:
+		m.ResetResource()
+		return nil
+	}
+	return fmt.Errorf("unknown BookingSeries edge %s", name)
+}
+
+// CustomerMutation represents an operation that mutates the Customer nodes in the graph.
+type CustomerMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	createdAt           *time.Time
+	updatedAt           *time.Time
+	name                *string
+	email               *string
+	phone               *string
+	notes               *string
+	tags                *[]string
+	clearedFields       map[string]struct{}
+	organization        *int
+	clearedorganization bool
+	bookings            map[int]struct{}
+	removedbookings     map[int]struct{}
+	clearedbookings     bool
+	done                bool
+	oldValue            func(context.Context) (*Customer, error)
+	predicates          []predicate.Customer
+}
+
+var _ ent.Mutation = (*CustomerMutation)(nil)
+
+// customerOption allows management of the mutation configuration using functional options.
+type customerOption func(*CustomerMutation)
+
+// newCustomerMutation creates new mutation for the Customer entity.
+func newCustomerMutation(c config, op Op, opts ...customerOption) *CustomerMutation {
+	m := &CustomerMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCustomer,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCustomerID sets the ID field of the mutation.
+func withCustomerID(id int) customerOption {
+	return func(m *CustomerMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Customer
+		)
+		m.oldValue = func(ctx context.Context) (*Customer, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Customer.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCustomer sets the old Customer of the mutation.
+func withCustomer(node *Customer) customerOption {
+	return func(m *CustomerMutation) {
+		m.oldValue = func(context.Context) (*Customer, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CustomerMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CustomerMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CustomerMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (m *CustomerMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
+}
+
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *CustomerMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "createdAt" field's value of the Customer entity.
+// If the Customer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CustomerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *CustomerMutation) ResetCreatedAt() {
+	m.createdAt = nil
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (m *CustomerMutation) SetUpdatedAt(t time.Time) {
+	m.updatedAt = &t
+}
+
+// UpdatedAt returns the value of the "updatedAt" field in the mutation.
+func (m *CustomerMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updatedAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updatedAt" field's value of the Customer entity.
+// If the Customer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CustomerMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updatedAt" field.
+func (m *CustomerMutation) ResetUpdatedAt() {
+	m.updatedAt = nil
+}
+
+// SetName sets the "name" field.
+func (m *CustomerMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *CustomerMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Customer entity.
+// If the Customer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CustomerMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *CustomerMutation) ResetName() {
+	m.name = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *CustomerMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *CustomerMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the Customer entity.
+// If the Customer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CustomerMutation) OldEmail(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ClearEmail clears the value of the "email" field.
+func (m *CustomerMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[customer.FieldEmail] = struct{}{}
+}
+
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *CustomerMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[customer.FieldEmail]
+	return ok
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *CustomerMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, customer.FieldEmail)
+}
+
+// SetPhone sets the "phone" field.
+func (m *CustomerMutation) SetPhone(s string) {
+	m.phone = &s
+}
+
+// Phone returns the value of the "phone" field in the mutation.
+func (m *CustomerMutation) Phone() (r string, exists bool) {
+	v := m.phone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhone returns the old "phone" field's value of the Customer entity.
+// If the Customer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CustomerMutation) OldPhone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldPhone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldPhone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhone: %w", err)
+	}
+	return oldValue.Phone, nil
+}
+
+// ResetPhone resets all changes to the "phone" field.
+func (m *CustomerMutation) ResetPhone() {
+	m.phone = nil
+}
+
+// SetNotes sets the "notes" field.
+func (m *CustomerMutation) SetNotes(s string) {
+	m.notes = &s
+}
+
+// Notes returns the value of the "notes" field in the mutation.
+func (m *CustomerMutation) Notes() (r string, exists bool) {
+	v := m.notes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotes returns the old "notes" field's value of the Customer entity.
+// If the Customer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CustomerMutation) OldNotes(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldNotes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldNotes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotes: %w", err)
+	}
+	return oldValue.Notes, nil
+}
+
+// ResetNotes resets all changes to the "notes" field.
+func (m *CustomerMutation) ResetNotes() {
+	m.notes = nil
+}
+
+// SetTags sets the "tags" field.
+func (m *CustomerMutation) SetTags(s []string) {
+	m.tags = &s
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *CustomerMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old "tags" field's value of the Customer entity.
+// If the Customer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CustomerMutation) OldTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// ClearTags clears the value of the "tags" field.
+func (m *CustomerMutation) ClearTags() {
+	m.tags = nil
+	m.clearedFields[customer.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *CustomerMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[customer.FieldTags]
+	return ok
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *CustomerMutation) ResetTags() {
+	m.tags = nil
+	delete(m.clearedFields, customer.FieldTags)
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (m *CustomerMutation) SetOrganizationId(i int) {
+	m.organization = &i
+}
+
+// OrganizationId returns the value of the "organizationId" field in the mutation.
+func (m *CustomerMutation) OrganizationId() (r int, exists bool) {
+	v := m.organization
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationId returns the old "organizationId" field's value of the Customer entity.
+// If the Customer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CustomerMutation) OldOrganizationId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldOrganizationId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldOrganizationId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationId: %w", err)
+	}
+	return oldValue.OrganizationId, nil
+}
+
+// ResetOrganizationId resets all changes to the "organizationId" field.
+func (m *CustomerMutation) ResetOrganizationId() {
+	m.organization = nil
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by id.
+func (m *CustomerMutation) SetOrganizationID(id int) {
+	m.organization = &id
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *CustomerMutation) ClearOrganization() {
+	m.clearedorganization = true
+}
+
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *CustomerMutation) OrganizationCleared() bool {
+	return m.clearedorganization
+}
+
+// OrganizationID returns the "organization" edge ID in the mutation.
+func (m *CustomerMutation) OrganizationID() (id int, exists bool) {
+	if m.organization != nil {
+		return *m.organization, true
+	}
+	return
+}
+
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *CustomerMutation) OrganizationIDs() (ids []int) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *CustomerMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
+}
+
+// AddBookingIDs adds the "bookings" edge to the Booking entity by ids.
+func (m *CustomerMutation) AddBookingIDs(ids ...int) {
+	if m.bookings == nil {
+		m.bookings = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.bookings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBookings clears the "bookings" edge to the Booking entity.
+func (m *CustomerMutation) ClearBookings() {
+	m.clearedbookings = true
+}
+
+// BookingsCleared reports if the "bookings" edge to the Booking entity was cleared.
+func (m *CustomerMutation) BookingsCleared() bool {
+	return m.clearedbookings
+}
+
+// RemoveBookingIDs removes the "bookings" edge to the Booking entity by IDs.
+func (m *CustomerMutation) RemoveBookingIDs(ids ...int) {
+	if m.removedbookings == nil {
+		m.removedbookings = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.bookings, ids[i])
+		m.removedbookings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBookings returns the removed IDs of the "bookings" edge to the Booking entity.
+func (m *CustomerMutation) RemovedBookingsIDs() (ids []int) {
+	for id := range m.removedbookings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BookingsIDs returns the "bookings" edge IDs in the mutation.
+func (m *CustomerMutation) BookingsIDs() (ids []int) {
+	for id := range m.bookings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBookings resets all changes to the "bookings" edge.
+func (m *CustomerMutation) ResetBookings() {
+	m.bookings = nil
+	m.clearedbookings = false
+	m.removedbookings = nil
+}
+
+// Where appends a list predicates to the CustomerMutation builder.
+func (m *CustomerMutation) Where(ps ...predicate.Customer) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *CustomerMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Customer).
+func (m *CustomerMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CustomerMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.createdAt != nil {
+		fields = append(fields, customer.FieldCreatedAt)
+	}
+	if m.updatedAt != nil {
+		fields = append(fields, customer.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, customer.FieldName)
+	}
+	if m.email != nil {
+		fields = append(fields, customer.FieldEmail)
+	}
+	if m.phone != nil {
+		fields = append(fields, customer.FieldPhone)
+	}
+	if m.notes != nil {
+		fields = append(fields, customer.FieldNotes)
+	}
+	if m.tags != nil {
+		fields = append(fields, customer.FieldTags)
+	}
+	if m.organization != nil {
+		fields = append(fields, customer.FieldOrganizationId)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CustomerMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case customer.FieldCreatedAt:
+		return m.CreatedAt()
+	case customer.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case customer.FieldName:
+		return m.Name()
+	case customer.FieldEmail:
+		return m.Email()
+	case customer.FieldPhone:
+		return m.Phone()
+	case customer.FieldNotes:
+		return m.Notes()
+	case customer.FieldTags:
+		return m.Tags()
+	case customer.FieldOrganizationId:
+		return m.OrganizationId()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CustomerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case customer.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case customer.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case customer.FieldName:
+		return m.OldName(ctx)
+	case customer.FieldEmail:
+		return m.OldEmail(ctx)
+	case customer.FieldPhone:
+		return m.OldPhone(ctx)
+	case customer.FieldNotes:
+		return m.OldNotes(ctx)
+	case customer.FieldTags:
+		return m.OldTags(ctx)
+	case customer.FieldOrganizationId:
+		return m.OldOrganizationId(ctx)
+	}
+	return nil, fmt.Errorf("unknown Customer field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CustomerMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case customer.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case customer.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case customer.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case customer.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case customer.FieldPhone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhone(v)
+		return nil
+	case customer.FieldNotes:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotes(v)
+		return nil
+	case customer.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case customer.FieldOrganizationId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Customer field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CustomerMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CustomerMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CustomerMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Customer numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CustomerMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(customer.FieldEmail) {
+		fields = append(fields, customer.FieldEmail)
+	}
+	if m.FieldCleared(customer.FieldTags) {
+		fields = append(fields, customer.FieldTags)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CustomerMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CustomerMutation) ClearField(name string) error {
+	switch name {
+	case customer.FieldEmail:
+		m.ClearEmail()
+		return nil
+	case customer.FieldTags:
+		m.ClearTags()
+		return nil
+	}
+	return fmt.Errorf("unknown Customer nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CustomerMutation) ResetField(name string) error {
+	switch name {
+	case customer.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case customer.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case customer.FieldName:
+		m.ResetName()
+		return nil
+	case customer.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case customer.FieldPhone:
+		m.ResetPhone()
+		return nil
+	case customer.FieldNotes:
+		m.ResetNotes()
+		return nil
+	case customer.FieldTags:
+		m.ResetTags()
+		return nil
+	case customer.FieldOrganizationId:
+		m.ResetOrganizationId()
+		return nil
+	}
+	return fmt.Errorf("unknown Customer field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CustomerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.organization != nil {
+		edges = append(edges, customer.EdgeOrganization)
+	}
+	if m.bookings != nil {
+		edges = append(edges, customer.EdgeBookings)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CustomerMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case customer.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
+		}
+	case customer.EdgeBookings:
+		ids := make([]ent.Value, 0, len(m.bookings))
+		for id := range m.bookings {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CustomerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedbookings != nil {
+		edges = append(edges, customer.EdgeBookings)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CustomerMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case customer.EdgeBookings:
+		ids := make([]ent.Value, 0, len(m.removedbookings))
+		for id := range m.removedbookings {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CustomerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedorganization {
+		edges = append(edges, customer.EdgeOrganization)
+	}
+	if m.clearedbookings {
+		edges = append(edges, customer.EdgeBookings)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CustomerMutation) EdgeCleared(name string) bool {
+	switch name {
+	case customer.EdgeOrganization:
+		return m.clearedorganization
+	case customer.EdgeBookings:
+		return m.clearedbookings
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CustomerMutation) ClearEdge(name string) error {
+	switch name {
+	case customer.EdgeOrganization:
+		m.ClearOrganization()
+		return nil
+	}
+	return fmt.Errorf("unknown Customer unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CustomerMutation) ResetEdge(name string) error {
+	switch name {
+	case customer.EdgeOrganization:
+		m.ResetOrganization()
+		return nil
+	case customer.EdgeBookings:
+		m.ResetBookings()
+		return nil
+	}
+	return fmt.Errorf("unknown Customer edge %s", name)
+}
+
+// InvoiceMutation represents an operation that mutates the Invoice nodes in the graph.
+type InvoiceMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int
+	createdAt              *time.Time
+	updatedAt              *time.Time
+	kind                   *string
+	number                 *int
+	addnumber              *int
+	issuerName             *string
+	currency               *string
+	lines                  *string
+	taxLines               *string
+	subtotal               *int
+	addsubtotal            *int
+	tax                    *int
+	addtax                 *int
+	taxInclusive           *bool
+	total                  *int
+	addtotal               *int
+	amountPaid             *int
+	addamountPaid          *int
+	clearedFields          map[string]struct{}
+	organization           *int
+	clearedorganization    bool
+	booking                *int
+	clearedbooking         bool
+	creditedInvoice        *int
+	clearedcreditedInvoice bool
+	creditNotes            map[int]struct{}
+	removedcreditNotes     map[int]struct{}
+	clearedcreditNotes     bool
+	done                   bool
+	oldValue               func(context.Context) (*Invoice, error)
+	predicates             []predicate.Invoice
+}
+
+var _ ent.Mutation = (*InvoiceMutation)(nil)
+
+// invoiceOption allows management of the mutation configuration using functional options.
+type invoiceOption func(*InvoiceMutation)
+
+// newInvoiceMutation creates new mutation for the Invoice entity.
+func newInvoiceMutation(c config, op Op, opts ...invoiceOption) *InvoiceMutation {
+	m := &InvoiceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeInvoice,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withInvoiceID sets the ID field of the mutation.
+func withInvoiceID(id int) invoiceOption {
+	return func(m *InvoiceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Invoice
+		)
+		m.oldValue = func(ctx context.Context) (*Invoice, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Invoice.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withInvoice sets the old Invoice of the mutation.
+func withInvoice(node *Invoice) invoiceOption {
+	return func(m *InvoiceMutation) {
+		m.oldValue = func(context.Context) (*Invoice, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m InvoiceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m InvoiceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *InvoiceMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (m *InvoiceMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
+}
+
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *InvoiceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "createdAt" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *InvoiceMutation) ResetCreatedAt() {
+	m.createdAt = nil
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (m *InvoiceMutation) SetUpdatedAt(t time.Time) {
+	m.updatedAt = &t
+}
+
+// UpdatedAt returns the value of the "updatedAt" field in the mutation.
+func (m *InvoiceMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updatedAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updatedAt" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updatedAt" field.
+func (m *InvoiceMutation) ResetUpdatedAt() {
+	m.updatedAt = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *InvoiceMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *InvoiceMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *InvoiceMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetNumber sets the "number" field.
+func (m *InvoiceMutation) SetNumber(i int) {
+	m.number = &i
+	m.addnumber = nil
+}
+
+// Number returns the value of the "number" field in the mutation.
+func (m *InvoiceMutation) Number() (r int, exists bool) {
+	v := m.number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNumber returns the old "number" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldNumber(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNumber: %w", err)
+	}
+	return oldValue.Number, nil
+}
+
+// AddNumber adds i to the "number" field.
+func (m *InvoiceMutation) AddNumber(i int) {
+	if m.addnumber != nil {
+		*m.addnumber += i
+	} else {
+		m.addnumber = &i
+	}
+}
+
+// AddedNumber returns the value that was added to the "number" field in this mutation.
+func (m *InvoiceMutation) AddedNumber() (r int, exists bool) {
+	v := m.addnumber
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNumber resets all changes to the "number" field.
+func (m *InvoiceMutation) ResetNumber() {
+	m.number = nil
+	m.addnumber = nil
+}
+
+// SetIssuerName sets the "issuerName" field.
+func (m *InvoiceMutation) SetIssuerName(s string) {
+	m.issuerName = &s
+}
+
+// IssuerName returns the value of the "issuerName" field in the mutation.
+func (m *InvoiceMutation) IssuerName() (r string, exists bool) {
+	v := m.issuerName
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssuerName returns the old "issuerName" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldIssuerName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldIssuerName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldIssuerName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssuerName: %w", err)
+	}
+	return oldValue.IssuerName, nil
+}
+
+// ResetIssuerName resets all changes to the "issuerName" field.
+func (m *InvoiceMutation) ResetIssuerName() {
+	m.issuerName = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *InvoiceMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *InvoiceMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *InvoiceMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetLines sets the "lines" field.
+func (m *InvoiceMutation) SetLines(s string) {
+	m.lines = &s
+}
+
+// Lines returns the value of the "lines" field in the mutation.
+func (m *InvoiceMutation) Lines() (r string, exists bool) {
+	v := m.lines
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLines returns the old "lines" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldLines(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldLines is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldLines requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLines: %w", err)
+	}
+	return oldValue.Lines, nil
+}
+
+// ResetLines resets all changes to the "lines" field.
+func (m *InvoiceMutation) ResetLines() {
+	m.lines = nil
+}
+
+// SetTaxLines sets the "taxLines" field.
+func (m *InvoiceMutation) SetTaxLines(s string) {
+	m.taxLines = &s
+}
+
+// TaxLines returns the value of the "taxLines" field in the mutation.
+func (m *InvoiceMutation) TaxLines() (r string, exists bool) {
+	v := m.taxLines
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaxLines returns the old "taxLines" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldTaxLines(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTaxLines is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTaxLines requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaxLines: %w", err)
+	}
+	return oldValue.TaxLines, nil
+}
+
+// ResetTaxLines resets all changes to the "taxLines" field.
+func (m *InvoiceMutation) ResetTaxLines() {
+	m.taxLines = nil
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (m *InvoiceMutation) SetSubtotal(i int) {
+	m.subtotal = &i
+	m.addsubtotal = nil
+}
+
+// Subtotal returns the value of the "subtotal" field in the mutation.
+func (m *InvoiceMutation) Subtotal() (r int, exists bool) {
+	v := m.subtotal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubtotal returns the old "subtotal" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldSubtotal(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldSubtotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldSubtotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubtotal: %w", err)
+	}
+	return oldValue.Subtotal, nil
+}
+
+// AddSubtotal adds i to the "subtotal" field.
+func (m *InvoiceMutation) AddSubtotal(i int) {
+	if m.addsubtotal != nil {
+		*m.addsubtotal += i
+	} else {
+		m.addsubtotal = &i
+	}
+}
+
+// AddedSubtotal returns the value that was added to the "subtotal" field in this mutation.
+func (m *InvoiceMutation) AddedSubtotal() (r int, exists bool) {
+	v := m.addsubtotal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSubtotal resets all changes to the "subtotal" field.
+func (m *InvoiceMutation) ResetSubtotal() {
+	m.subtotal = nil
+	m.addsubtotal = nil
+}
+
+// SetTax sets the "tax" field.
+func (m *InvoiceMutation) SetTax(i int) {
+	m.tax = &i
+	m.addtax = nil
+}
+
+// Tax returns the value of the "tax" field in the mutation.
+func (m *InvoiceMutation) Tax() (r int, exists bool) {
+	v := m.tax
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTax returns the old "tax" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldTax(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTax is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTax requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTax: %w", err)
+	}
+	return oldValue.Tax, nil
+}
+
+// AddTax adds i to the "tax" field.
+func (m *InvoiceMutation) AddTax(i int) {
+	if m.addtax != nil {
+		*m.addtax += i
+	} else {
+		m.addtax = &i
+	}
+}
+
+// AddedTax returns the value that was added to the "tax" field in this mutation.
+func (m *InvoiceMutation) AddedTax() (r int, exists bool) {
+	v := m.addtax
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTax resets all changes to the "tax" field.
+func (m *InvoiceMutation) ResetTax() {
+	m.tax = nil
+	m.addtax = nil
+}
+
+// SetTaxInclusive sets the "taxInclusive" field.
+func (m *InvoiceMutation) SetTaxInclusive(b bool) {
+	m.taxInclusive = &b
+}
+
+// TaxInclusive returns the value of the "taxInclusive" field in the mutation.
+func (m *InvoiceMutation) TaxInclusive() (r bool, exists bool) {
+	v := m.taxInclusive
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaxInclusive returns the old "taxInclusive" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldTaxInclusive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTaxInclusive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTaxInclusive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaxInclusive: %w", err)
+	}
+	return oldValue.TaxInclusive, nil
+}
+
+// ResetTaxInclusive resets all changes to the "taxInclusive" field.
+func (m *InvoiceMutation) ResetTaxInclusive() {
+	m.taxInclusive = nil
+}
+
+// SetTotal sets the "total" field.
+func (m *InvoiceMutation) SetTotal(i int) {
+	m.total = &i
+	m.addtotal = nil
+}
+
+// Total returns the value of the "total" field in the mutation.
+func (m *InvoiceMutation) Total() (r int, exists bool) {
+	v := m.total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotal returns the old "total" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldTotal(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotal: %w", err)
+	}
+	return oldValue.Total, nil
+}
+
+// AddTotal adds i to the "total" field.
+func (m *InvoiceMutation) AddTotal(i int) {
+	if m.addtotal != nil {
+		*m.addtotal += i
+	} else {
+		m.addtotal = &i
+	}
+}
+
+// AddedTotal returns the value that was added to the "total" field in this mutation.
+func (m *InvoiceMutation) AddedTotal() (r int, exists bool) {
+	v := m.addtotal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotal resets all changes to the "total" field.
+func (m *InvoiceMutation) ResetTotal() {
+	m.total = nil
+	m.addtotal = nil
+}
+
+// SetAmountPaid sets the "amountPaid" field.
+func (m *InvoiceMutation) SetAmountPaid(i int) {
+	m.amountPaid = &i
+	m.addamountPaid = nil
+}
+
+// AmountPaid returns the value of the "amountPaid" field in the mutation.
+func (m *InvoiceMutation) AmountPaid() (r int, exists bool) {
+	v := m.amountPaid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmountPaid returns the old "amountPaid" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldAmountPaid(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldAmountPaid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldAmountPaid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmountPaid: %w", err)
+	}
+	return oldValue.AmountPaid, nil
+}
+
+// AddAmountPaid adds i to the "amountPaid" field.
+func (m *InvoiceMutation) AddAmountPaid(i int) {
+	if m.addamountPaid != nil {
+		*m.addamountPaid += i
+	} else {
+		m.addamountPaid = &i
+	}
+}
+
+// AddedAmountPaid returns the value that was added to the "amountPaid" field in this mutation.
+func (m *InvoiceMutation) AddedAmountPaid() (r int, exists bool) {
+	v := m.addamountPaid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmountPaid resets all changes to the "amountPaid" field.
+func (m *InvoiceMutation) ResetAmountPaid() {
+	m.amountPaid = nil
+	m.addamountPaid = nil
+}
+
+// SetBookingId sets the "bookingId" field.
+func (m *InvoiceMutation) SetBookingId(i int) {
+	m.booking = &i
+}
+
+// BookingId returns the value of the "bookingId" field in the mutation.
+func (m *InvoiceMutation) BookingId() (r int, exists bool) {
+	v := m.booking
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBookingId returns the old "bookingId" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldBookingId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldBookingId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldBookingId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBookingId: %w", err)
+	}
+	return oldValue.BookingId, nil
+}
+
+// ResetBookingId resets all changes to the "bookingId" field.
+func (m *InvoiceMutation) ResetBookingId() {
+	m.booking = nil
+}
+
+// SetCreditedInvoiceId sets the "creditedInvoiceId" field.
+func (m *InvoiceMutation) SetCreditedInvoiceId(i int) {
+	m.creditedInvoice = &i
+}
+
+// CreditedInvoiceId returns the value of the "creditedInvoiceId" field in the mutation.
+func (m *InvoiceMutation) CreditedInvoiceId() (r int, exists bool) {
+	v := m.creditedInvoice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreditedInvoiceId returns the old "creditedInvoiceId" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldCreditedInvoiceId(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreditedInvoiceId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreditedInvoiceId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreditedInvoiceId: %w", err)
+	}
+	return oldValue.CreditedInvoiceId, nil
+}
+
+// ClearCreditedInvoiceId clears the value of the "creditedInvoiceId" field.
+func (m *InvoiceMutation) ClearCreditedInvoiceId() {
+	m.creditedInvoice = nil
+	m.clearedFields[invoice.FieldCreditedInvoiceId] = struct{}{}
+}
+
+// CreditedInvoiceIdCleared returns if the "creditedInvoiceId" field was cleared in this mutation.
+func (m *InvoiceMutation) CreditedInvoiceIdCleared() bool {
+	_, ok := m.clearedFields[invoice.FieldCreditedInvoiceId]
+	return ok
+}
+
+// ResetCreditedInvoiceId resets all changes to the "creditedInvoiceId" field.
+func (m *InvoiceMutation) ResetCreditedInvoiceId() {
+	m.creditedInvoice = nil
+	delete(m.clearedFields, invoice.FieldCreditedInvoiceId)
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (m *InvoiceMutation) SetOrganizationId(i int) {
+	m.organization = &i
+}
+
+// OrganizationId returns the value of the "organizationId" field in the mutation.
+func (m *InvoiceMutation) OrganizationId() (r int, exists bool) {
+	v := m.organization
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationId returns the old "organizationId" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldOrganizationId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldOrganizationId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldOrganizationId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationId: %w", err)
+	}
+	return oldValue.OrganizationId, nil
+}
+
+// ResetOrganizationId resets all changes to the "organizationId" field.
+func (m *InvoiceMutation) ResetOrganizationId() {
+	m.organization = nil
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by id.
+func (m *InvoiceMutation) SetOrganizationID(id int) {
+	m.organization = &id
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *InvoiceMutation) ClearOrganization() {
+	m.clearedorganization = true
+}
+
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *InvoiceMutation) OrganizationCleared() bool {
+	return m.clearedorganization
+}
+
+// OrganizationID returns the "organization" edge ID in the mutation.
+func (m *InvoiceMutation) OrganizationID() (id int, exists bool) {
+	if m.organization != nil {
+		return *m.organization, true
+	}
+	return
+}
+
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *InvoiceMutation) OrganizationIDs() (ids []int) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *InvoiceMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
+}
+
+// SetBookingID sets the "booking" edge to the Booking entity by id.
+func (m *InvoiceMutation) SetBookingID(id int) {
+	m.booking = &id
+}
+
+// ClearBooking clears the "booking" edge to the Booking entity.
+func (m *InvoiceMutation) ClearBooking() {
+	m.clearedbooking = true
+}
+
+// BookingCleared reports if the "booking" edge to the Booking entity was cleared.
+func (m *InvoiceMutation) BookingCleared() bool {
+	return m.clearedbooking
+}
+
+// BookingID returns the "booking" edge ID in the mutation.
+func (m *InvoiceMutation) BookingID() (id int, exists bool) {
+	if m.booking != nil {
+		return *m.booking, true
+	}
+	return
+}
+
+// BookingIDs returns the "booking" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BookingID instead. It exists only for internal usage by the builders.
+func (m *InvoiceMutation) BookingIDs() (ids []int) {
+	if id := m.booking; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBooking resets all changes to the "booking" edge.
+func (m *InvoiceMutation) ResetBooking() {
+	m.booking = nil
+	m.clearedbooking = false
+}
+
+// SetCreditedInvoiceID sets the "creditedInvoice" edge to the Invoice entity by id.
+func (m *InvoiceMutation) SetCreditedInvoiceID(id int) {
+	m.creditedInvoice = &id
+}
+
+// ClearCreditedInvoice clears the "creditedInvoice" edge to the Invoice entity.
+func (m *InvoiceMutation) ClearCreditedInvoice() {
+	m.clearedcreditedInvoice = true
+}
+
+// CreditedInvoiceCleared reports if the "creditedInvoice" edge to the Invoice entity was cleared.
+func (m *InvoiceMutation) CreditedInvoiceCleared() bool {
+	return m.CreditedInvoiceIdCleared() || m.clearedcreditedInvoice
+}
+
+// CreditedInvoiceID returns the "creditedInvoice" edge ID in the mutation.
+func (m *InvoiceMutation) CreditedInvoiceID() (id int, exists bool) {
+	if m.creditedInvoice != nil {
+		return *m.creditedInvoice, true
+	}
+	return
+}
+
+// CreditedInvoiceIDs returns the "creditedInvoice" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreditedInvoiceID instead. It exists only for internal usage by the builders.
+func (m *InvoiceMutation) CreditedInvoiceIDs() (ids []int) {
+	if id := m.creditedInvoice; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCreditedInvoice resets all changes to the "creditedInvoice" edge.
+func (m *InvoiceMutation) ResetCreditedInvoice() {
+	m.creditedInvoice = nil
+	m.clearedcreditedInvoice = false
+}
+
+// AddCreditNoteIDs adds the "creditNotes" edge to the Invoice entity by ids.
+func (m *InvoiceMutation) AddCreditNoteIDs(ids ...int) {
+	if m.creditNotes == nil {
+		m.creditNotes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.creditNotes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCreditNotes clears the "creditNotes" edge to the Invoice entity.
+func (m *InvoiceMutation) ClearCreditNotes() {
+	m.clearedcreditNotes = true
+}
+
+// CreditNotesCleared reports if the "creditNotes" edge to the Invoice entity was cleared.
+func (m *InvoiceMutation) CreditNotesCleared() bool {
+	return m.clearedcreditNotes
+}
+
+// RemoveCreditNoteIDs removes the "creditNotes" edge to the Invoice entity by IDs.
+func (m *InvoiceMutation) RemoveCreditNoteIDs(ids ...int) {
+	if m.removedcreditNotes == nil {
+		m.removedcreditNotes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.creditNotes, ids[i])
+		m.removedcreditNotes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCreditNotes returns the removed IDs of the "creditNotes" edge to the Invoice entity.
+func (m *InvoiceMutation) RemovedCreditNotesIDs() (ids []int) {
+	for id := range m.removedcreditNotes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CreditNotesIDs returns the "creditNotes" edge IDs in the mutation.
+func (m *InvoiceMutation) CreditNotesIDs() (ids []int) {
+	for id := range m.creditNotes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCreditNotes resets all changes to the "creditNotes" edge.
+func (m *InvoiceMutation) ResetCreditNotes() {
+	m.creditNotes = nil
+	m.clearedcreditNotes = false
+	m.removedcreditNotes = nil
+}
+
+// Where appends a list predicates to the InvoiceMutation builder.
+func (m *InvoiceMutation) Where(ps ...predicate.Invoice) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *InvoiceMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Invoice).
+func (m *InvoiceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *InvoiceMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.createdAt != nil {
+		fields = append(fields, invoice.FieldCreatedAt)
+	}
+	if m.updatedAt != nil {
+		fields = append(fields, invoice.FieldUpdatedAt)
+	}
+	if m.kind != nil {
+		fields = append(fields, invoice.FieldKind)
+	}
+	if m.number != nil {
+		fields = append(fields, invoice.FieldNumber)
+	}
+	if m.issuerName != nil {
+		fields = append(fields, invoice.FieldIssuerName)
+	}
+	if m.currency != nil {
+		fields = append(fields, invoice.FieldCurrency)
+	}
+	if m.lines != nil {
+		fields = append(fields, invoice.FieldLines)
+	}
+	if m.taxLines != nil {
+		fields = append(fields, invoice.FieldTaxLines)
+	}
+	if m.subtotal != nil {
+		fields = append(fields, invoice.FieldSubtotal)
+	}
+	if m.tax != nil {
+		fields = append(fields, invoice.FieldTax)
+	}
+	if m.taxInclusive != nil {
+		fields = append(fields, invoice.FieldTaxInclusive)
+	}
+	if m.total != nil {
+		fields = append(fields, invoice.FieldTotal)
+	}
+	if m.amountPaid != nil {
+		fields = append(fields, invoice.FieldAmountPaid)
+	}
+	if m.booking != nil {
+		fields = append(fields, invoice.FieldBookingId)
+	}
+	if m.creditedInvoice != nil {
+		fields = append(fields, invoice.FieldCreditedInvoiceId)
+	}
+	if m.organization != nil {
+		fields = append(fields, invoice.FieldOrganizationId)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *InvoiceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case invoice.FieldCreatedAt:
+		return m.CreatedAt()
+	case invoice.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case invoice.FieldKind:
+		return m.Kind()
+	case invoice.FieldNumber:
+		return m.Number()
+	case invoice.FieldIssuerName:
+		return m.IssuerName()
+	case invoice.FieldCurrency:
+		return m.Currency()
+	case invoice.FieldLines:
+		return m.Lines()
+	case invoice.FieldTaxLines:
+		return m.TaxLines()
+	case invoice.FieldSubtotal:
+		return m.Subtotal()
+	case invoice.FieldTax:
+		return m.Tax()
+	case invoice.FieldTaxInclusive:
+		return m.TaxInclusive()
+	case invoice.FieldTotal:
+		return m.Total()
+	case invoice.FieldAmountPaid:
+		return m.AmountPaid()
+	case invoice.FieldBookingId:
+		return m.BookingId()
+	case invoice.FieldCreditedInvoiceId:
+		return m.CreditedInvoiceId()
+	case invoice.FieldOrganizationId:
+		return m.OrganizationId()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *InvoiceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case invoice.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case invoice.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case invoice.FieldKind:
+		return m.OldKind(ctx)
+	case invoice.FieldNumber:
+		return m.OldNumber(ctx)
+	case invoice.FieldIssuerName:
+		return m.OldIssuerName(ctx)
+	case invoice.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case invoice.FieldLines:
+		return m.OldLines(ctx)
+	case invoice.FieldTaxLines:
+		return m.OldTaxLines(ctx)
+	case invoice.FieldSubtotal:
+		return m.OldSubtotal(ctx)
+	case invoice.FieldTax:
+		return m.OldTax(ctx)
+	case invoice.FieldTaxInclusive:
+		return m.OldTaxInclusive(ctx)
+	case invoice.FieldTotal:
+		return m.OldTotal(ctx)
+	case invoice.FieldAmountPaid:
+		return m.OldAmountPaid(ctx)
+	case invoice.FieldBookingId:
+		return m.OldBookingId(ctx)
+	case invoice.FieldCreditedInvoiceId:
+		return m.OldCreditedInvoiceId(ctx)
+	case invoice.FieldOrganizationId:
+		return m.OldOrganizationId(ctx)
+	}
+	return nil, fmt.Errorf("unknown Invoice field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InvoiceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case invoice.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case invoice.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case invoice.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case invoice.FieldNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumber(v)
+		return nil
+	case invoice.FieldIssuerName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssuerName(v)
+		return nil
+	case invoice.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case invoice.FieldLines:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLines(v)
+		return nil
+	case invoice.FieldTaxLines:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaxLines(v)
+		return nil
+	case invoice.FieldSubtotal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubtotal(v)
+		return nil
+	case invoice.FieldTax:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTax(v)
+		return nil
+	case invoice.FieldTaxInclusive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaxInclusive(v)
+		return nil
+	case invoice.FieldTotal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotal(v)
+		return nil
+	case invoice.FieldAmountPaid:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmountPaid(v)
+		return nil
+	case invoice.FieldBookingId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBookingId(v)
+		return nil
+	case invoice.FieldCreditedInvoiceId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreditedInvoiceId(v)
+		return nil
+	case invoice.FieldOrganizationId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Invoice field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *InvoiceMutation) AddedFields() []string {
+	var fields []string
+	if m.addnumber != nil {
+		fields = append(fields, invoice.FieldNumber)
+	}
+	if m.addsubtotal != nil {
+		fields = append(fields, invoice.FieldSubtotal)
+	}
+	if m.addtax != nil {
+		fields = append(fields, invoice.FieldTax)
+	}
+	if m.addtotal != nil {
+		fields = append(fields, invoice.FieldTotal)
+	}
+	if m.addamountPaid != nil {
+		fields = append(fields, invoice.FieldAmountPaid)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *InvoiceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case invoice.FieldNumber:
+		return m.AddedNumber()
+	case invoice.FieldSubtotal:
+		return m.AddedSubtotal()
+	case invoice.FieldTax:
+		return m.AddedTax()
+	case invoice.FieldTotal:
+		return m.AddedTotal()
+	case invoice.FieldAmountPaid:
+		return m.AddedAmountPaid()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InvoiceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case invoice.FieldNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNumber(v)
+		return nil
+	case invoice.FieldSubtotal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSubtotal(v)
+		return nil
+	case invoice.FieldTax:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTax(v)
+		return nil
+	case invoice.FieldTotal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotal(v)
+		return nil
+	case invoice.FieldAmountPaid:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmountPaid(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Invoice numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *InvoiceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(invoice.FieldCreditedInvoiceId) {
+		fields = append(fields, invoice.FieldCreditedInvoiceId)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *InvoiceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *InvoiceMutation) ClearField(name string) error {
+	switch name {
+	case invoice.FieldCreditedInvoiceId:
+		m.ClearCreditedInvoiceId()
+		return nil
+	}
+	return fmt.Errorf("unknown Invoice nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *InvoiceMutation) ResetField(name string) error {
+	switch name {
+	case invoice.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case invoice.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case invoice.FieldKind:
+		m.ResetKind()
+		return nil
+	case invoice.FieldNumber:
+		m.ResetNumber()
+		return nil
+	case invoice.FieldIssuerName:
+		m.ResetIssuerName()
+		return nil
+	case invoice.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case invoice.FieldLines:
+		m.ResetLines()
+		return nil
+	case invoice.FieldTaxLines:
+		m.ResetTaxLines()
+		return nil
+	case invoice.FieldSubtotal:
+		m.ResetSubtotal()
+		return nil
+	case invoice.FieldTax:
+		m.ResetTax()
+		return nil
+	case invoice.FieldTaxInclusive:
+		m.ResetTaxInclusive()
+		return nil
+	case invoice.FieldTotal:
+		m.ResetTotal()
+		return nil
+	case invoice.FieldAmountPaid:
+		m.ResetAmountPaid()
+		return nil
+	case invoice.FieldBookingId:
+		m.ResetBookingId()
+		return nil
+	case invoice.FieldCreditedInvoiceId:
+		m.ResetCreditedInvoiceId()
+		return nil
+	case invoice.FieldOrganizationId:
+		m.ResetOrganizationId()
+		return nil
+	}
+	return fmt.Errorf("unknown Invoice field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *InvoiceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.organization != nil {
+		edges = append(edges, invoice.EdgeOrganization)
+	}
+	if m.booking != nil {
+		edges = append(edges, invoice.EdgeBooking)
+	}
+	if m.creditedInvoice != nil {
+		edges = append(edges, invoice.EdgeCreditedInvoice)
+	}
+	if m.creditNotes != nil {
+		edges = append(edges, invoice.EdgeCreditNotes)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *InvoiceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case invoice.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
+		}
+	case invoice.EdgeBooking:
+		if id := m.booking; id != nil {
+			return []ent.Value{*id}
+		}
+	case invoice.EdgeCreditedInvoice:
+		if id := m.creditedInvoice; id != nil {
+			return []ent.Value{*id}
+		}
+	case invoice.EdgeCreditNotes:
+		ids := make([]ent.Value, 0, len(m.creditNotes))
+		for id := range m.creditNotes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *InvoiceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedcreditNotes != nil {
+		edges = append(edges, invoice.EdgeCreditNotes)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *InvoiceMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case invoice.EdgeCreditNotes:
+		ids := make([]ent.Value, 0, len(m.removedcreditNotes))
+		for id := range m.removedcreditNotes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *InvoiceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedorganization {
+		edges = append(edges, invoice.EdgeOrganization)
+	}
+	if m.clearedbooking {
+		edges = append(edges, invoice.EdgeBooking)
+	}
+	if m.clearedcreditedInvoice {
+		edges = append(edges, invoice.EdgeCreditedInvoice)
+	}
+	if m.clearedcreditNotes {
+		edges = append(edges, invoice.EdgeCreditNotes)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *InvoiceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case invoice.EdgeOrganization:
+		return m.clearedorganization
+	case invoice.EdgeBooking:
+		return m.clearedbooking
+	case invoice.EdgeCreditedInvoice:
+		return m.clearedcreditedInvoice
+	case invoice.EdgeCreditNotes:
+		return m.clearedcreditNotes
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *InvoiceMutation) ClearEdge(name string) error {
+	switch name {
+	case invoice.EdgeOrganization:
+		m.ClearOrganization()
+		return nil
+	case invoice.EdgeBooking:
+		m.ClearBooking()
+		return nil
+	case invoice.EdgeCreditedInvoice:
+		m.ClearCreditedInvoice()
+		return nil
+	}
+	return fmt.Errorf("unknown Invoice unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *InvoiceMutation) ResetEdge(name string) error {
+	switch name {
+	case invoice.EdgeOrganization:
+		m.ResetOrganization()
+		return nil
+	case invoice.EdgeBooking:
+		m.ResetBooking()
+		return nil
+	case invoice.EdgeCreditedInvoice:
+		m.ResetCreditedInvoice()
+		return nil
+	case invoice.EdgeCreditNotes:
+		m.ResetCreditNotes()
+		return nil
+	}
+	return fmt.Errorf("unknown Invoice edge %s", name)
+}
+
+// OrganizationMutation represents an operation that mutates the Organization nodes in the graph.
+type OrganizationMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	createdAt         *time.Time
+	updatedAt         *time.Time
+	name              *string
+	publicKey         *string
+	privateKey        *string
+	currency          *string
+	clearedFields     map[string]struct{}
+	users             map[int]struct{}
+	removedusers      map[int]struct{}
+	clearedusers      bool
+	resources         map[int]struct{}
+	removedresources  map[int]struct{}
+	clearedresources  bool
+	tokens            map[string]struct{}
+	removedtokens     map[string]struct{}
+	clearedtokens     bool
+	webhooks          map[int]struct{}
+	removedwebhooks   map[int]struct{}
+	clearedwebhooks   bool
+	promoCodes        map[int]struct{}
+	removedpromoCodes map[int]struct{}
+	clearedpromoCodes bool
+	invoices          map[int]struct{}
+	removedinvoices   map[int]struct{}
+	clearedinvoices   bool
+	taxRates          map[int]struct{}
+	removedtaxRates   map[int]struct{}
+	clearedtaxRates   bool
+	customers         map[int]struct{}
+	removedcustomers  map[int]struct{}
+	clearedcustomers  bool
+	done              bool
+	oldValue          func(context.Context) (*Organization, error)
+	predicates        []predicate.Organization
+}
+
+var _ ent.Mutation = (*OrganizationMutation)(nil)
+
+// organizationOption allows management of the mutation configuration using functional options.
+type organizationOption func(*OrganizationMutation)
+
+// newOrganizationMutation creates new mutation for the Organization entity.
+func newOrganizationMutation(c config, op Op, opts ...organizationOption) *OrganizationMutation {
+	m := &OrganizationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOrganization,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOrganizationID sets the ID field of the mutation.
+func withOrganizationID(id int) organizationOption {
+	return func(m *OrganizationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Organization
+		)
+		m.oldValue = func(ctx context.Context) (*Organization, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Organization.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOrganization sets the old Organization of the mutation.
+func withOrganization(node *Organization) organizationOption {
+	return func(m *OrganizationMutation) {
+		m.oldValue = func(context.Context) (*Organization, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OrganizationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OrganizationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OrganizationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (m *OrganizationMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
+}
+
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *OrganizationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "createdAt" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *OrganizationMutation) ResetCreatedAt() {
+	m.createdAt = nil
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (m *OrganizationMutation) SetUpdatedAt(t time.Time) {
+	m.updatedAt = &t
+}
+
+// UpdatedAt returns the value of the "updatedAt" field in the mutation.
+func (m *OrganizationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updatedAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updatedAt" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updatedAt" field.
+func (m *OrganizationMutation) ResetUpdatedAt() {
+	m.updatedAt = nil
+}
+
+// SetName sets the "name" field.
+func (m *OrganizationMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *OrganizationMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *OrganizationMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPublicKey sets the "publicKey" field.
+func (m *OrganizationMutation) SetPublicKey(s string) {
+	m.publicKey = &s
+}
+
+// PublicKey returns the value of the "publicKey" field in the mutation.
+func (m *OrganizationMutation) PublicKey() (r string, exists bool) {
+	v := m.publicKey
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublicKey returns the old "publicKey" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldPublicKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldPublicKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldPublicKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublicKey: %w", err)
+	}
+	return oldValue.PublicKey, nil
+}
+
+// ResetPublicKey resets all changes to the "publicKey" field.
+func (m *OrganizationMutation) ResetPublicKey() {
+	m.publicKey = nil
+}
+
+// SetPrivateKey sets the "privateKey" field.
+func (m *OrganizationMutation) SetPrivateKey(s string) {
+	m.privateKey = &s
+}
+
+// PrivateKey returns the value of the "privateKey" field in the mutation.
+func (m *OrganizationMutation) PrivateKey() (r string, exists bool) {
+	v := m.privateKey
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrivateKey returns the old "privateKey" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldPrivateKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldPrivateKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldPrivateKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrivateKey: %w", err)
+	}
+	return oldValue.PrivateKey, nil
+}
+
+// ResetPrivateKey resets all changes to the "privateKey" field.
+func (m *OrganizationMutation) ResetPrivateKey() {
+	m.privateKey = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *OrganizationMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *OrganizationMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *OrganizationMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *OrganizationMutation) AddUserIDs(ids ...int) {
+	if m.users == nil {
+		m.users = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.users[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsers clears the "users" edge to the User entity.
+func (m *OrganizationMutation) ClearUsers() {
+	m.clearedusers = true
+}
+
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *OrganizationMutation) UsersCleared() bool {
+	return m.clearedusers
+}
+
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *OrganizationMutation) RemoveUserIDs(ids ...int) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *OrganizationMutation) RemovedUsersIDs() (ids []int) {
+	for id := range m.removedusers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *OrganizationMutation) UsersIDs() (ids []int) {
+	for id := range m.users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUsers resets all changes to the "users" edge.
+func (m *OrganizationMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
+}
+
+// AddResourceIDs adds the "resources" edge to the Resource entity by ids.
+func (m *OrganizationMutation) AddResourceIDs(ids ...int) {
+	if m.resources == nil {
+		m.resources = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.resources[ids[i]] = struct{}{}
+	}
+}
+
+// ClearResources clears the "resources" edge to the Resource entity.
+func (m *OrganizationMutation) ClearResources() {
+	m.clearedresources = true
+}
+
+// ResourcesCleared reports if the "resources" edge to the Resource entity was cleared.
+func (m *OrganizationMutation) ResourcesCleared() bool {
+	return m.clearedresources
+}
+
+// RemoveResourceIDs removes the "resources" edge to the Resource entity by IDs.
+func (m *OrganizationMutation) RemoveResourceIDs(ids ...int) {
+	if m.removedresources == nil {
+		m.removedresources = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.resources, ids[i])
+		m.removedresources[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedResources returns the removed IDs of the "resources" edge to the Resource entity.
+func (m *OrganizationMutation) RemovedResourcesIDs() (ids []int) {
+	for id := range m.removedresources {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResourcesIDs returns the "resources" edge IDs in the mutation.
+func (m *OrganizationMutation) ResourcesIDs() (ids []int) {
+	for id := range m.resources {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetResources resets all changes to the "resources" edge.
+func (m *OrganizationMutation) ResetResources() {
+	m.resources = nil
+	m.clearedresources = false
+	m.removedresources = nil
+}
+
+// AddTokenIDs adds the "tokens" edge to the Token entity by ids.
+func (m *OrganizationMutation) AddTokenIDs(ids ...string) {
+	if m.tokens == nil {
+		m.tokens = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.tokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTokens clears the "tokens" edge to the Token entity.
+func (m *OrganizationMutation) ClearTokens() {
+	m.clearedtokens = true
+}
+
+// TokensCleared reports if the "tokens" edge to the Token entity was cleared.
+func (m *OrganizationMutation) TokensCleared() bool {
+	return m.clearedtokens
+}
+
+// RemoveTokenIDs removes the "tokens" edge to the Token entity by IDs.
+func (m *OrganizationMutation) RemoveTokenIDs(ids ...string) {
+	if m.removedtokens == nil {
+		m.removedtokens = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.tokens, ids[i])
+		m.removedtokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTokens returns the removed IDs of the "tokens" edge to the Token entity.
+func (m *OrganizationMutation) RemovedTokensIDs() (ids []string) {
+	for id := range m.removedtokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TokensIDs returns the "tokens" edge IDs in the mutation.
+func (m *OrganizationMutation) TokensIDs() (ids []string) {
+	for id := range m.tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTokens resets all changes to the "tokens" edge.
+func (m *OrganizationMutation) ResetTokens() {
+	m.tokens = nil
+	m.clearedtokens = false
+	m.removedtokens = nil
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by ids.
+func (m *OrganizationMutation) AddWebhookIDs(ids ...int) {
+	if m.webhooks == nil {
+		m.webhooks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.webhooks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWebhooks clears the "webhooks" edge to the Webhook entity.
+func (m *OrganizationMutation) ClearWebhooks() {
+	m.clearedwebhooks = true
+}
+
+// WebhooksCleared reports if the "webhooks" edge to the Webhook entity was cleared.
+func (m *OrganizationMutation) WebhooksCleared() bool {
+	return m.clearedwebhooks
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to the Webhook entity by IDs.
+func (m *OrganizationMutation) RemoveWebhookIDs(ids ...int) {
+	if m.removedwebhooks == nil {
+		m.removedwebhooks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.webhooks, ids[i])
+		m.removedwebhooks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWebhooks returns the removed IDs of the "webhooks" edge to the Webhook entity.
+func (m *OrganizationMutation) RemovedWebhooksIDs() (ids []int) {
+	for id := range m.removedwebhooks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WebhooksIDs returns the "webhooks" edge IDs in the mutation.
+func (m *OrganizationMutation) WebhooksIDs() (ids []int) {
+	for id := range m.webhooks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWebhooks resets all changes to the "webhooks" edge.
+func (m *OrganizationMutation) ResetWebhooks() {
+	m.webhooks = nil
+	m.clearedwebhooks = false
+	m.removedwebhooks = nil
+}
+
+// AddPromoCodeIDs adds the "promoCodes" edge to the PromoCode entity by ids.
+func (m *OrganizationMutation) AddPromoCodeIDs(ids ...int) {
+	if m.promoCodes == nil {
+		m.promoCodes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.promoCodes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPromoCodes clears the "promoCodes" edge to the PromoCode entity.
+func (m *OrganizationMutation) ClearPromoCodes() {
+	m.clearedpromoCodes = true
+}
+
+// PromoCodesCleared reports if the "promoCodes" edge to the PromoCode entity was cleared.
+func (m *OrganizationMutation) PromoCodesCleared() bool {
+	return m.clearedpromoCodes
+}
+
+// RemovePromoCodeIDs removes the "promoCodes" edge to the PromoCode entity by IDs.
+func (m *OrganizationMutation) RemovePromoCodeIDs(ids ...int) {
+	if m.removedpromoCodes == nil {
+		m.removedpromoCodes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.promoCodes, ids[i])
+		m.removedpromoCodes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPromoCodes returns the removed IDs of the "promoCodes" edge to the PromoCode entity.
+func (m *OrganizationMutation) RemovedPromoCodesIDs() (ids []int) {
+	for id := range m.removedpromoCodes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PromoCodesIDs returns the "promoCodes" edge IDs in the mutation.
+func (m *OrganizationMutation) PromoCodesIDs() (ids []int) {
+	for id := range m.promoCodes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPromoCodes resets all changes to the "promoCodes" edge.
+func (m *OrganizationMutation) ResetPromoCodes() {
+	m.promoCodes = nil
+	m.clearedpromoCodes = false
+	m.removedpromoCodes = nil
+}
+
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by ids.
+func (m *OrganizationMutation) AddInvoiceIDs(ids ...int) {
+	if m.invoices == nil {
+		m.invoices = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.invoices[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInvoices clears the "invoices" edge to the Invoice entity.
+func (m *OrganizationMutation) ClearInvoices() {
+	m.clearedinvoices = true
+}
+
+// InvoicesCleared reports if the "invoices" edge to the Invoice entity was cleared.
+func (m *OrganizationMutation) InvoicesCleared() bool {
+	return m.clearedinvoices
+}
+
+// RemoveInvoiceIDs removes the "invoices" edge to the Invoice entity by IDs.
+func (m *OrganizationMutation) RemoveInvoiceIDs(ids ...int) {
+	if m.removedinvoices == nil {
+		m.removedinvoices = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.invoices, ids[i])
+		m.removedinvoices[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInvoices returns the removed IDs of the "invoices" edge to the Invoice entity.
+func (m *OrganizationMutation) RemovedInvoicesIDs() (ids []int) {
+	for id := range m.removedinvoices {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InvoicesIDs returns the "invoices" edge IDs in the mutation.
+func (m *OrganizationMutation) InvoicesIDs() (ids []int) {
+	for id := range m.invoices {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInvoices resets all changes to the "invoices" edge.
+func (m *OrganizationMutation) ResetInvoices() {
+	m.invoices = nil
+	m.clearedinvoices = false
+	m.removedinvoices = nil
+}
+
+// AddTaxRateIDs adds the "taxRates" edge to the TaxRate entity by ids.
+func (m *OrganizationMutation) AddTaxRateIDs(ids ...int) {
+	if m.taxRates == nil {
+		m.taxRates = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.taxRates[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTaxRates clears the "taxRates" edge to the TaxRate entity.
+func (m *OrganizationMutation) ClearTaxRates() {
+	m.clearedtaxRates = true
+}
+
+// TaxRatesCleared reports if the "taxRates" edge to the TaxRate entity was cleared.
+func (m *OrganizationMutation) TaxRatesCleared() bool {
+	return m.clearedtaxRates
+}
+
+// RemoveTaxRateIDs removes the "taxRates" edge to the TaxRate entity by IDs.
+func (m *OrganizationMutation) RemoveTaxRateIDs(ids ...int) {
+	if m.removedtaxRates == nil {
+		m.removedtaxRates = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.taxRates, ids[i])
+		m.removedtaxRates[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTaxRates returns the removed IDs of the "taxRates" edge to the TaxRate entity.
+func (m *OrganizationMutation) RemovedTaxRatesIDs() (ids []int) {
+	for id := range m.removedtaxRates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TaxRatesIDs returns the "taxRates" edge IDs in the mutation.
+func (m *OrganizationMutation) TaxRatesIDs() (ids []int) {
+	for id := range m.taxRates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTaxRates resets all changes to the "taxRates" edge.
+func (m *OrganizationMutation) ResetTaxRates() {
+	m.taxRates = nil
+	m.clearedtaxRates = false
+	m.removedtaxRates = nil
+}
+
+// AddCustomerIDs adds the "customers" edge to the Customer entity by ids.
+func (m *OrganizationMutation) AddCustomerIDs(ids ...int) {
+	if m.customers == nil {
+		m.customers = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.customers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCustomers clears the "customers" edge to the Customer entity.
+func (m *OrganizationMutation) ClearCustomers() {
+	m.clearedcustomers = true
+}
+
+// CustomersCleared reports if the "customers" edge to the Customer entity was cleared.
+func (m *OrganizationMutation) CustomersCleared() bool {
+	return m.clearedcustomers
+}
+
+// RemoveCustomerIDs removes the "customers" edge to the Customer entity by IDs.
+func (m *OrganizationMutation) RemoveCustomerIDs(ids ...int) {
+	if m.removedcustomers == nil {
+		m.removedcustomers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.customers, ids[i])
+		m.removedcustomers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCustomers returns the removed IDs of the "customers" edge to the Customer entity.
+func (m *OrganizationMutation) RemovedCustomersIDs() (ids []int) {
+	for id := range m.removedcustomers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CustomersIDs returns the "customers" edge IDs in the mutation.
+func (m *OrganizationMutation) CustomersIDs() (ids []int) {
+	for id := range m.customers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCustomers resets all changes to the "customers" edge.
+func (m *OrganizationMutation) ResetCustomers() {
+	m.customers = nil
+	m.clearedcustomers = false
+	m.removedcustomers = nil
+}
+
+// Where appends a list predicates to the OrganizationMutation builder.
+func (m *OrganizationMutation) Where(ps ...predicate.Organization) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *OrganizationMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Organization).
+func (m *OrganizationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OrganizationMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.createdAt != nil {
+		fields = append(fields, organization.FieldCreatedAt)
+	}
+	if m.updatedAt != nil {
+		fields = append(fields, organization.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, organization.FieldName)
+	}
+	if m.publicKey != nil {
+		fields = append(fields, organization.FieldPublicKey)
+	}
+	if m.privateKey != nil {
+		fields = append(fields, organization.FieldPrivateKey)
+	}
+	if m.currency != nil {
+		fields = append(fields, organization.FieldCurrency)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OrganizationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case organization.FieldCreatedAt:
+		return m.CreatedAt()
+	case organization.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case organization.FieldName:
+		return m.Name()
+	case organization.FieldPublicKey:
+		return m.PublicKey()
+	case organization.FieldPrivateKey:
+		return m.PrivateKey()
+	case organization.FieldCurrency:
+		return m.Currency()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OrganizationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case organization.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case organization.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case organization.FieldName:
+		return m.OldName(ctx)
+	case organization.FieldPublicKey:
+		return m.OldPublicKey(ctx)
+	case organization.FieldPrivateKey:
+		return m.OldPrivateKey(ctx)
+	case organization.FieldCurrency:
+		return m.OldCurrency(ctx)
+	}
+	return nil, fmt.Errorf("unknown Organization field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrganizationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case organization.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case organization.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case organization.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case organization.FieldPublicKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublicKey(v)
+		return nil
+	case organization.FieldPrivateKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrivateKey(v)
+		return nil
+	case organization.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Organization field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OrganizationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OrganizationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrganizationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Organization numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OrganizationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OrganizationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OrganizationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Organization nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OrganizationMutation) ResetField(name string) error {
+	switch name {
+	case organization.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case organization.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case organization.FieldName:
+		m.ResetName()
+		return nil
+	case organization.FieldPublicKey:
+		m.ResetPublicKey()
+		return nil
+	case organization.FieldPrivateKey:
+		m.ResetPrivateKey()
+		return nil
+	case organization.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	}
+	return fmt.Errorf("unknown Organization field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OrganizationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.users != nil {
+		edges = append(edges, organization.EdgeUsers)
+	}
+	if m.resources != nil {
+		edges = append(edges, organization.EdgeResources)
+	}
+	if m.tokens != nil {
+		edges = append(edges, organization.EdgeTokens)
+	}
+	if m.webhooks != nil {
+		edges = append(edges, organization.EdgeWebhooks)
+	}
+	if m.promoCodes != nil {
+		edges = append(edges, organization.EdgePromoCodes)
+	}
+	if m.invoices != nil {
+		edges = append(edges, organization.EdgeInvoices)
+	}
+	if m.taxRates != nil {
+		edges = append(edges, organization.EdgeTaxRates)
+	}
+	if m.customers != nil {
+		edges = append(edges, organization.EdgeCustomers)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OrganizationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case organization.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeResources:
+		ids := make([]ent.Value, 0, len(m.resources))
+		for id := range m.resources {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeTokens:
+		ids := make([]ent.Value, 0, len(m.tokens))
+		for id := range m.tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeWebhooks:
+		ids := make([]ent.Value, 0, len(m.webhooks))
+		for id := range m.webhooks {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgePromoCodes:
+		ids := make([]ent.Value, 0, len(m.promoCodes))
+		for id := range m.promoCodes {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeInvoices:
+		ids := make([]ent.Value, 0, len(m.invoices))
+		for id := range m.invoices {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeTaxRates:
+		ids := make([]ent.Value, 0, len(m.taxRates))
+		for id := range m.taxRates {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeCustomers:
+		ids := make([]ent.Value, 0, len(m.customers))
+		for id := range m.customers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OrganizationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.removedusers != nil {
+		edges = append(edges, organization.EdgeUsers)
+	}
+	if m.removedresources != nil {
+		edges = append(edges, organization.EdgeResources)
+	}
+	if m.removedtokens != nil {
+		edges = append(edges, organization.EdgeTokens)
+	}
+	if m.removedwebhooks != nil {
+		edges = append(edges, organization.EdgeWebhooks)
+	}
+	if m.removedpromoCodes != nil {
+		edges = append(edges, organization.EdgePromoCodes)
+	}
+	if m.removedinvoices != nil {
+		edges = append(edges, organization.EdgeInvoices)
+	}
+	if m.removedtaxRates != nil {
+		edges = append(edges, organization.EdgeTaxRates)
+	}
+	if m.removedcustomers != nil {
+		edges = append(edges, organization.EdgeCustomers)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OrganizationMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case organization.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeResources:
+		ids := make([]ent.Value, 0, len(m.removedresources))
+		for id := range m.removedresources {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeTokens:
+		ids := make([]ent.Value, 0, len(m.removedtokens))
+		for id := range m.removedtokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeWebhooks:
+		ids := make([]ent.Value, 0, len(m.removedwebhooks))
+		for id := range m.removedwebhooks {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgePromoCodes:
+		ids := make([]ent.Value, 0, len(m.removedpromoCodes))
+		for id := range m.removedpromoCodes {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeInvoices:
+		ids := make([]ent.Value, 0, len(m.removedinvoices))
+		for id := range m.removedinvoices {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeTaxRates:
+		ids := make([]ent.Value, 0, len(m.removedtaxRates))
+		for id := range m.removedtaxRates {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeCustomers:
+		ids := make([]ent.Value, 0, len(m.removedcustomers))
+		for id := range m.removedcustomers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OrganizationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.clearedusers {
+		edges = append(edges, organization.EdgeUsers)
+	}
+	if m.clearedresources {
+		edges = append(edges, organization.EdgeResources)
+	}
+	if m.clearedtokens {
+		edges = append(edges, organization.EdgeTokens)
+	}
+	if m.clearedwebhooks {
+		edges = append(edges, organization.EdgeWebhooks)
+	}
+	if m.clearedpromoCodes {
+		edges = append(edges, organization.EdgePromoCodes)
+	}
+	if m.clearedinvoices {
+		edges = append(edges, organization.EdgeInvoices)
+	}
+	if m.clearedtaxRates {
+		edges = append(edges, organization.EdgeTaxRates)
+	}
+	if m.clearedcustomers {
+		edges = append(edges, organization.EdgeCustomers)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OrganizationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case organization.EdgeUsers:
+		return m.clearedusers
+	case organization.EdgeResources:
+		return m.clearedresources
+	case organization.EdgeTokens:
+		return m.clearedtokens
+	case organization.EdgeWebhooks:
+		return m.clearedwebhooks
+	case organization.EdgePromoCodes:
+		return m.clearedpromoCodes
+	case organization.EdgeInvoices:
+		return m.clearedinvoices
+	case organization.EdgeTaxRates:
+		return m.clearedtaxRates
+	case organization.EdgeCustomers:
+		return m.clearedcustomers
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OrganizationMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Organization unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OrganizationMutation) ResetEdge(name string) error {
+	switch name {
+	case organization.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	case organization.EdgeResources:
+		m.ResetResources()
+		return nil
+	case organization.EdgeTokens:
+		m.ResetTokens()
+		return nil
+	case organization.EdgeWebhooks:
+		m.ResetWebhooks()
+		return nil
+	case organization.EdgePromoCodes:
+		m.ResetPromoCodes()
+		return nil
+	case organization.EdgeInvoices:
+		m.ResetInvoices()
+		return nil
+	case organization.EdgeTaxRates:
+		m.ResetTaxRates()
+		return nil
+	case organization.EdgeCustomers:
+		m.ResetCustomers()
+		return nil
+	}
+	return fmt.Errorf("unknown Organization edge %s", name)
+}
+
+// OrganizationOwnershipMutation represents an operation that mutates the OrganizationOwnership nodes in the graph.
+type OrganizationOwnershipMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	clearedFields       map[string]struct{}
+	user                *int
+	cleareduser         bool
+	organization        *int
+	clearedorganization bool
+	done                bool
+	oldValue            func(context.Context) (*OrganizationOwnership, error)
+	predicates          []predicate.OrganizationOwnership
+}
+
+var _ ent.Mutation = (*OrganizationOwnershipMutation)(nil)
+
+// organizationownershipOption allows management of the mutation configuration using functional options.
+type organizationownershipOption func(*OrganizationOwnershipMutation)
+
+// newOrganizationOwnershipMutation creates new mutation for the OrganizationOwnership entity.
+func newOrganizationOwnershipMutation(c config, op Op, opts ...organizationownershipOption) *OrganizationOwnershipMutation {
+	m := &OrganizationOwnershipMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOrganizationOwnership,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOrganizationOwnershipID sets the ID field of the mutation.
+func withOrganizationOwnershipID(id int) organizationownershipOption {
+	return func(m *OrganizationOwnershipMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OrganizationOwnership
+		)
+		m.oldValue = func(ctx context.Context) (*OrganizationOwnership, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OrganizationOwnership.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOrganizationOwnership sets the old OrganizationOwnership of the mutation.
+func withOrganizationOwnership(node *OrganizationOwnership) organizationownershipOption {
+	return func(m *OrganizationOwnershipMutation) {
+		m.oldValue = func(context.Context) (*OrganizationOwnership, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OrganizationOwnershipMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OrganizationOwnershipMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OrganizationOwnershipMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetUserId sets the "userId" field.
+func (m *OrganizationOwnershipMutation) SetUserId(i int) {
+	m.user = &i
+}
+
+// UserId returns the value of the "userId" field in the mutation.
+func (m *OrganizationOwnershipMutation) UserId() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserId returns the old "userId" field's value of the OrganizationOwnership entity.
+// If the OrganizationOwnership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationOwnershipMutation) OldUserId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUserId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUserId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
+	}
+	return oldValue.UserId, nil
+}
+
+// ResetUserId resets all changes to the "userId" field.
+func (m *OrganizationOwnershipMutation) ResetUserId() {
+	m.user = nil
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (m *OrganizationOwnershipMutation) SetOrganizationId(i int) {
+	m.organization = &i
+}
+
+// OrganizationId returns the value of the "organizationId" field in the mutation.
+func (m *OrganizationOwnershipMutation) OrganizationId() (r int, exists bool) {
+	v := m.organization
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationId returns the old "organizationId" field's value of the OrganizationOwnership entity.
+// If the OrganizationOwnership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationOwnershipMutation) OldOrganizationId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldOrganizationId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldOrganizationId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationId: %w", err)
+	}
+	return oldValue.OrganizationId, nil
+}
+
+// ResetOrganizationId resets all changes to the "organizationId" field.
+func (m *OrganizationOwnershipMutation) ResetOrganizationId() {
+	m.organization = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *OrganizationOwnershipMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *OrganizationOwnershipMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *OrganizationOwnershipMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *OrganizationOwnershipMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *OrganizationOwnershipMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *OrganizationOwnershipMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by id.
+func (m *OrganizationOwnershipMutation) SetOrganizationID(id int) {
+	m.organization = &id
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *OrganizationOwnershipMutation) ClearOrganization() {
+	m.clearedorganization = true
+}
+
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *OrganizationOwnershipMutation) OrganizationCleared() bool {
+	return m.clearedorganization
+}
+
+// OrganizationID returns the "organization" edge ID in the mutation.
+func (m *OrganizationOwnershipMutation) OrganizationID() (id int, exists bool) {
+	if m.organization != nil {
+		return *m.organization, true
+	}
+	return
+}
+
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *OrganizationOwnershipMutation) OrganizationIDs() (ids []int) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *OrganizationOwnershipMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
+}
+
+// Where appends a list predicates to the OrganizationOwnershipMutation builder.
+func (m *OrganizationOwnershipMutation) Where(ps ...predicate.OrganizationOwnership) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *OrganizationOwnershipMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (OrganizationOwnership).
+func (m *OrganizationOwnershipMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OrganizationOwnershipMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.user != nil {
+		fields = append(fields, organizationownership.FieldUserId)
+	}
+	if m.organization != nil {
+		fields = append(fields, organizationownership.FieldOrganizationId)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OrganizationOwnershipMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case organizationownership.FieldUserId:
+		return m.UserId()
+	case organizationownership.FieldOrganizationId:
+		return m.OrganizationId()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OrganizationOwnershipMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case organizationownership.FieldUserId:
+		return m.OldUserId(ctx)
+	case organizationownership.FieldOrganizationId:
+		return m.OldOrganizationId(ctx)
+	}
+	return nil, fmt.Errorf("unknown OrganizationOwnership field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrganizationOwnershipMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case organizationownership.FieldUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserId(v)
+		return nil
+	case organizationownership.FieldOrganizationId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OrganizationOwnership field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OrganizationOwnershipMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OrganizationOwnershipMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrganizationOwnershipMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown OrganizationOwnership numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OrganizationOwnershipMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OrganizationOwnershipMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OrganizationOwnershipMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown OrganizationOwnership nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OrganizationOwnershipMutation) ResetField(name string) error {
+	switch name {
+	case organizationownership.FieldUserId:
+		m.ResetUserId()
+		return nil
+	case organizationownership.FieldOrganizationId:
+		m.ResetOrganizationId()
+		return nil
+	}
+	return fmt.Errorf("unknown OrganizationOwnership field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OrganizationOwnershipMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, organizationownership.EdgeUser)
+	}
+	if m.organization != nil {
+		edges = append(edges, organizationownership.EdgeOrganization)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OrganizationOwnershipMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case organizationownership.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case organizationownership.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OrganizationOwnershipMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OrganizationOwnershipMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OrganizationOwnershipMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, organizationownership.EdgeUser)
+	}
+	if m.clearedorganization {
+		edges = append(edges, organizationownership.EdgeOrganization)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OrganizationOwnershipMutation) EdgeCleared(name string) bool {
+	switch name {
+	case organizationownership.EdgeUser:
+		return m.cleareduser
+	case organizationownership.EdgeOrganization:
+		return m.clearedorganization
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OrganizationOwnershipMutation) ClearEdge(name string) error {
+	switch name {
+	case organizationownership.EdgeUser:
+		m.ClearUser()
+		return nil
+	case organizationownership.EdgeOrganization:
+		m.ClearOrganization()
+		return nil
+	}
+	return fmt.Errorf("unknown OrganizationOwnership unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OrganizationOwnershipMutation) ResetEdge(name string) error {
+	switch name {
+	case organizationownership.EdgeUser:
+		m.ResetUser()
+		return nil
+	case organizationownership.EdgeOrganization:
+		m.ResetOrganization()
+		return nil
+	}
+	return fmt.Errorf("unknown OrganizationOwnership edge %s", name)
+}
+
+// PaymentMutation represents an operation that mutates the Payment nodes in the graph.
+type PaymentMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	createdAt         *time.Time
+	updatedAt         *time.Time
+	provider          *string
+	providerPaymentId *string
+	status            *string
+	amount            *int
+	addamount         *int
+	amountRefunded    *int
+	addamountRefunded *int
+	currency          *string
+	clientSecret      *string
+	failureReason     *string
+	clearedFields     map[string]struct{}
+	booking           *int
+	clearedbooking    bool
+	done              bool
+	oldValue          func(context.Context) (*Payment, error)
+	predicates        []predicate.Payment
+}
+
+var _ ent.Mutation = (*PaymentMutation)(nil)
+
+// paymentOption allows management of the mutation configuration using functional options.
+type paymentOption func(*PaymentMutation)
+
+// newPaymentMutation creates new mutation for the Payment entity.
+func newPaymentMutation(c config, op Op, opts ...paymentOption) *PaymentMutation {
+	m := &PaymentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePayment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPaymentID sets the ID field of the mutation.
+func withPaymentID(id int) paymentOption {
+	return func(m *PaymentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Payment
+		)
+		m.oldValue = func(ctx context.Context) (*Payment, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Payment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPayment sets the old Payment of the mutation.
+func withPayment(node *Payment) paymentOption {
+	return func(m *PaymentMutation) {
+		m.oldValue = func(context.Context) (*Payment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PaymentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PaymentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PaymentMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (m *PaymentMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
+}
+
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *PaymentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "createdAt" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *PaymentMutation) ResetCreatedAt() {
+	m.createdAt = nil
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (m *PaymentMutation) SetUpdatedAt(t time.Time) {
+	m.updatedAt = &t
+}
+
+// UpdatedAt returns the value of the "updatedAt" field in the mutation.
+func (m *PaymentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updatedAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updatedAt" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updatedAt" field.
+func (m *PaymentMutation) ResetUpdatedAt() {
+	m.updatedAt = nil
+}
+
+// SetProvider sets the "provider" field.
+func (m *PaymentMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *PaymentMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *PaymentMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetProviderPaymentId sets the "providerPaymentId" field.
+func (m *PaymentMutation) SetProviderPaymentId(s string) {
+	m.providerPaymentId = &s
+}
+
+// ProviderPaymentId returns the value of the "providerPaymentId" field in the mutation.
+func (m *PaymentMutation) ProviderPaymentId() (r string, exists bool) {
+	v := m.providerPaymentId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProviderPaymentId returns the old "providerPaymentId" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldProviderPaymentId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldProviderPaymentId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldProviderPaymentId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProviderPaymentId: %w", err)
+	}
+	return oldValue.ProviderPaymentId, nil
+}
+
+// ResetProviderPaymentId resets all changes to the "providerPaymentId" field.
+func (m *PaymentMutation) ResetProviderPaymentId() {
+	m.providerPaymentId = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *PaymentMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *PaymentMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *PaymentMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *PaymentMutation) SetAmount(i int) {
+	m.amount = &i
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *PaymentMutation) Amount() (r int, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldAmount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds i to the "amount" field.
+func (m *PaymentMutation) AddAmount(i int) {
+	if m.addamount != nil {
+		*m.addamount += i
+	} else {
+		m.addamount = &i
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *PaymentMutation) AddedAmount() (r int, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *PaymentMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetAmountRefunded sets the "amountRefunded" field.
+func (m *PaymentMutation) SetAmountRefunded(i int) {
+	m.amountRefunded = &i
+	m.addamountRefunded = nil
+}
+
+// AmountRefunded returns the value of the "amountRefunded" field in the mutation.
+func (m *PaymentMutation) AmountRefunded() (r int, exists bool) {
+	v := m.amountRefunded
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmountRefunded returns the old "amountRefunded" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldAmountRefunded(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldAmountRefunded is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldAmountRefunded requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmountRefunded: %w", err)
+	}
+	return oldValue.AmountRefunded, nil
+}
+
+// AddAmountRefunded adds i to the "amountRefunded" field.
+func (m *PaymentMutation) AddAmountRefunded(i int) {
+	if m.addamountRefunded != nil {
+		*m.addamountRefunded += i
+	} else {
+		m.addamountRefunded = &i
+	}
+}
+
+// AddedAmountRefunded returns the value that was added to the "amountRefunded" field in this mutation.
+func (m *PaymentMutation) AddedAmountRefunded() (r int, exists bool) {
+	v := m.addamountRefunded
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmountRefunded resets all changes to the "amountRefunded" field.
+func (m *PaymentMutation) ResetAmountRefunded() {
+	m.amountRefunded = nil
+	m.addamountRefunded = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *PaymentMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *PaymentMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *PaymentMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetClientSecret sets the "clientSecret" field.
+func (m *PaymentMutation) SetClientSecret(s string) {
+	m.clientSecret = &s
+}
+
+// ClientSecret returns the value of the "clientSecret" field in the mutation.
+func (m *PaymentMutation) ClientSecret() (r string, exists bool) {
+	v := m.clientSecret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientSecret returns the old "clientSecret" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldClientSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldClientSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldClientSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientSecret: %w", err)
+	}
+	return oldValue.ClientSecret, nil
+}
+
+// ClearClientSecret clears the value of the "clientSecret" field.
+func (m *PaymentMutation) ClearClientSecret() {
+	m.clientSecret = nil
+	m.clearedFields[payment.FieldClientSecret] = struct{}{}
+}
+
+// ClientSecretCleared returns if the "clientSecret" field was cleared in this mutation.
+func (m *PaymentMutation) ClientSecretCleared() bool {
+	_, ok := m.clearedFields[payment.FieldClientSecret]
+	return ok
+}
+
+// ResetClientSecret resets all changes to the "clientSecret" field.
+func (m *PaymentMutation) ResetClientSecret() {
+	m.clientSecret = nil
+	delete(m.clearedFields, payment.FieldClientSecret)
+}
+
+// SetFailureReason sets the "failureReason" field.
+func (m *PaymentMutation) SetFailureReason(s string) {
+	m.failureReason = &s
+}
+
+// FailureReason returns the value of the "failureReason" field in the mutation.
+func (m *PaymentMutation) FailureReason() (r string, exists bool) {
+	v := m.failureReason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailureReason returns the old "failureReason" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldFailureReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldFailureReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldFailureReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailureReason: %w", err)
+	}
+	return oldValue.FailureReason, nil
+}
+
+// ClearFailureReason clears the value of the "failureReason" field.
+func (m *PaymentMutation) ClearFailureReason() {
+	m.failureReason = nil
+	m.clearedFields[payment.FieldFailureReason] = struct{}{}
+}
+
+// FailureReasonCleared returns if the "failureReason" field was cleared in this mutation.
+func (m *PaymentMutation) FailureReasonCleared() bool {
+	_, ok := m.clearedFields[payment.FieldFailureReason]
+	return ok
+}
+
+// ResetFailureReason resets all changes to the "failureReason" field.
+func (m *PaymentMutation) ResetFailureReason() {
+	m.failureReason = nil
+	delete(m.clearedFields, payment.FieldFailureReason)
+}
+
+// SetBookingId sets the "bookingId" field.
+func (m *PaymentMutation) SetBookingId(i int) {
+	m.booking = &i
+}
+
+// BookingId returns the value of the "bookingId" field in the mutation.
+func (m *PaymentMutation) BookingId() (r int, exists bool) {
+	v := m.booking
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBookingId returns the old "bookingId" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldBookingId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldBookingId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldBookingId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBookingId: %w", err)
+	}
+	return oldValue.BookingId, nil
+}
+
+// ResetBookingId resets all changes to the "bookingId" field.
+func (m *PaymentMutation) ResetBookingId() {
+	m.booking = nil
+}
+
+// SetBookingID sets the "booking" edge to the Booking entity by id.
+func (m *PaymentMutation) SetBookingID(id int) {
+	m.booking = &id
+}
+
+// ClearBooking clears the "booking" edge to the Booking entity.
+func (m *PaymentMutation) ClearBooking() {
+	m.clearedbooking = true
+}
+
+// BookingCleared reports if the "booking" edge to the Booking entity was cleared.
+func (m *PaymentMutation) BookingCleared() bool {
+	return m.clearedbooking
+}
+
+// BookingID returns the "booking" edge ID in the mutation.
+func (m *PaymentMutation) BookingID() (id int, exists bool) {
+	if m.booking != nil {
+		return *m.booking, true
+	}
+	return
+}
+
+// BookingIDs returns the "booking" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BookingID instead. It exists only for internal usage by the builders.
+func (m *PaymentMutation) BookingIDs() (ids []int) {
+	if id := m.booking; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBooking resets all changes to the "booking" edge.
+func (m *PaymentMutation) ResetBooking() {
+	m.booking = nil
+	m.clearedbooking = false
+}
+
+// Where appends a list predicates to the PaymentMutation builder.
+func (m *PaymentMutation) Where(ps ...predicate.Payment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *PaymentMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Payment).
+func (m *PaymentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PaymentMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.createdAt != nil {
+		fields = append(fields, payment.FieldCreatedAt)
+	}
+	if m.updatedAt != nil {
+		fields = append(fields, payment.FieldUpdatedAt)
+	}
+	if m.provider != nil {
+		fields = append(fields, payment.FieldProvider)
+	}
+	if m.providerPaymentId != nil {
+		fields = append(fields, payment.FieldProviderPaymentId)
+	}
+	if m.status != nil {
+		fields = append(fields, payment.FieldStatus)
+	}
+	if m.amount != nil {
+		fields = append(fields, payment.FieldAmount)
+	}
+	if m.amountRefunded != nil {
+		fields = append(fields, payment.FieldAmountRefunded)
+	}
+	if m.currency != nil {
+		fields = append(fields, payment.FieldCurrency)
+	}
+	if m.clientSecret != nil {
+		fields = append(fields, payment.FieldClientSecret)
+	}
+	if m.failureReason != nil {
+		fields = append(fields, payment.FieldFailureReason)
+	}
+	if m.booking != nil {
+		fields = append(fields, payment.FieldBookingId)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PaymentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case payment.FieldCreatedAt:
+		return m.CreatedAt()
+	case payment.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case payment.FieldProvider:
+		return m.Provider()
+	case payment.FieldProviderPaymentId:
+		return m.ProviderPaymentId()
+	case payment.FieldStatus:
+		return m.Status()
+	case payment.FieldAmount:
+		return m.Amount()
+	case payment.FieldAmountRefunded:
+		return m.AmountRefunded()
+	case payment.FieldCurrency:
+		return m.Currency()
+	case payment.FieldClientSecret:
+		return m.ClientSecret()
+	case payment.FieldFailureReason:
+		return m.FailureReason()
+	case payment.FieldBookingId:
+		return m.BookingId()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PaymentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case payment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case payment.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case payment.FieldProvider:
+		return m.OldProvider(ctx)
+	case payment.FieldProviderPaymentId:
+		return m.OldProviderPaymentId(ctx)
+	case payment.FieldStatus:
+		return m.OldStatus(ctx)
+	case payment.FieldAmount:
+		return m.OldAmount(ctx)
+	case payment.FieldAmountRefunded:
+		return m.OldAmountRefunded(ctx)
+	case payment.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case payment.FieldClientSecret:
+		return m.OldClientSecret(ctx)
+	case payment.FieldFailureReason:
+		return m.OldFailureReason(ctx)
+	case payment.FieldBookingId:
+		return m.OldBookingId(ctx)
+	}
+	return nil, fmt.Errorf("unknown Payment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PaymentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case payment.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case payment.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case payment.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case payment.FieldProviderPaymentId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProviderPaymentId(v)
+		return nil
+	case payment.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case payment.FieldAmount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case payment.FieldAmountRefunded:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmountRefunded(v)
+		return nil
+	case payment.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case payment.FieldClientSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientSecret(v)
+		return nil
+	case payment.FieldFailureReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailureReason(v)
+		return nil
+	case payment.FieldBookingId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBookingId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Payment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PaymentMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, payment.FieldAmount)
+	}
+	if m.addamountRefunded != nil {
+		fields = append(fields, payment.FieldAmountRefunded)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PaymentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case payment.FieldAmount:
+		return m.AddedAmount()
+	case payment.FieldAmountRefunded:
+		return m.AddedAmountRefunded()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PaymentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case payment.FieldAmount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	case payment.FieldAmountRefunded:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmountRefunded(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Payment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PaymentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(payment.FieldClientSecret) {
+		fields = append(fields, payment.FieldClientSecret)
+	}
+	if m.FieldCleared(payment.FieldFailureReason) {
+		fields = append(fields, payment.FieldFailureReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PaymentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PaymentMutation) ClearField(name string) error {
+	switch name {
+	case payment.FieldClientSecret:
+		m.ClearClientSecret()
+		return nil
+	case payment.FieldFailureReason:
+		m.ClearFailureReason()
+		return nil
+	}
+	return fmt.Errorf("unknown Payment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PaymentMutation) ResetField(name string) error {
+	switch name {
+	case payment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case payment.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case payment.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case payment.FieldProviderPaymentId:
+		m.ResetProviderPaymentId()
+		return nil
+	case payment.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case payment.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case payment.FieldAmountRefunded:
+		m.ResetAmountRefunded()
+		return nil
+	case payment.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case payment.FieldClientSecret:
+		m.ResetClientSecret()
+		return nil
+	case payment.FieldFailureReason:
+		m.ResetFailureReason()
+		return nil
+	case payment.FieldBookingId:
+		m.ResetBookingId()
+		return nil
+	}
+	return fmt.Errorf("unknown Payment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PaymentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.booking != nil {
+		edges = append(edges, payment.EdgeBooking)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PaymentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case payment.EdgeBooking:
+		if id := m.booking; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PaymentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PaymentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PaymentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedbooking {
+		edges = append(edges, payment.EdgeBooking)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PaymentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case payment.EdgeBooking:
+		return m.clearedbooking
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PaymentMutation) ClearEdge(name string) error {
+	switch name {
+	case payment.EdgeBooking:
+		m.ClearBooking()
+		return nil
+	}
+	return fmt.Errorf("unknown Payment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PaymentMutation) ResetEdge(name string) error {
+	switch name {
+	case payment.EdgeBooking:
+		m.ResetBooking()
+		return nil
+	}
+	return fmt.Errorf("unknown Payment edge %s", name)
+}
+
+// PromoCodeMutation represents an operation that mutates the PromoCode nodes in the graph.
+type PromoCodeMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *int
+	createdAt                    *time.Time
+	updatedAt                    *time.Time
+	code                         *string
+	description                  *string
+	discountType                 *string
+	discountValue                *int
+	adddiscountValue             *int
+	currency                     *string
+	validFrom                    *time.Time
+	validUntil                   *time.Time
+	maxRedemptions               *int
+	addmaxRedemptions            *int
+	maxRedemptionsPerCustomer    *int
+	addmaxRedemptionsPerCustomer *int
+	resourceIds                  *[]int
+	clearedFields                map[string]struct{}
+	organization                 *int
+	clearedorganization          bool
+	redemptions                  map[int]struct{}
+	removedredemptions           map[int]struct{}
+	clearedredemptions           bool
+	done                         bool
+	oldValue                     func(context.Context) (*PromoCode, error)
+	predicates                   []predicate.PromoCode
+}
+
+var _ ent.Mutation = (*PromoCodeMutation)(nil)
+
+// promocodeOption allows management of the mutation configuration using functional options.
+type promocodeOption func(*PromoCodeMutation)
+
+// newPromoCodeMutation creates new mutation for the PromoCode entity.
+func newPromoCodeMutation(c config, op Op, opts ...promocodeOption) *PromoCodeMutation {
+	m := &PromoCodeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePromoCode,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPromoCodeID sets the ID field of the mutation.
+func withPromoCodeID(id int) promocodeOption {
+	return func(m *PromoCodeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PromoCode
+		)
+		m.oldValue = func(ctx context.Context) (*PromoCode, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PromoCode.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPromoCode sets the old PromoCode of the mutation.
+func withPromoCode(node *PromoCode) promocodeOption {
+	return func(m *PromoCodeMutation) {
+		m.oldValue = func(context.Context) (*PromoCode, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PromoCodeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PromoCodeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PromoCodeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (m *PromoCodeMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
+}
+
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *PromoCodeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "createdAt" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *PromoCodeMutation) ResetCreatedAt() {
+	m.createdAt = nil
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (m *PromoCodeMutation) SetUpdatedAt(t time.Time) {
+	m.updatedAt = &t
+}
+
+// UpdatedAt returns the value of the "updatedAt" field in the mutation.
+func (m *PromoCodeMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updatedAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updatedAt" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updatedAt" field.
+func (m *PromoCodeMutation) ResetUpdatedAt() {
+	m.updatedAt = nil
+}
+
+// SetCode sets the "code" field.
+func (m *PromoCodeMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *PromoCodeMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *PromoCodeMutation) ResetCode() {
+	m.code = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *PromoCodeMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *PromoCodeMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *PromoCodeMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[promocode.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *PromoCodeMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[promocode.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *PromoCodeMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, promocode.FieldDescription)
+}
+
+// SetDiscountType sets the "discountType" field.
+func (m *PromoCodeMutation) SetDiscountType(s string) {
+	m.discountType = &s
+}
+
+// DiscountType returns the value of the "discountType" field in the mutation.
+func (m *PromoCodeMutation) DiscountType() (r string, exists bool) {
+	v := m.discountType
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiscountType returns the old "discountType" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldDiscountType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldDiscountType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldDiscountType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiscountType: %w", err)
+	}
+	return oldValue.DiscountType, nil
+}
+
+// ResetDiscountType resets all changes to the "discountType" field.
+func (m *PromoCodeMutation) ResetDiscountType() {
+	m.discountType = nil
+}
+
+// SetDiscountValue sets the "discountValue" field.
+func (m *PromoCodeMutation) SetDiscountValue(i int) {
+	m.discountValue = &i
+	m.adddiscountValue = nil
+}
+
+// DiscountValue returns the value of the "discountValue" field in the mutation.
+func (m *PromoCodeMutation) DiscountValue() (r int, exists bool) {
+	v := m.discountValue
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiscountValue returns the old "discountValue" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldDiscountValue(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldDiscountValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldDiscountValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiscountValue: %w", err)
+	}
+	return oldValue.DiscountValue, nil
+}
+
+// AddDiscountValue adds i to the "discountValue" field.
+func (m *PromoCodeMutation) AddDiscountValue(i int) {
+	if m.adddiscountValue != nil {
+		*m.adddiscountValue += i
+	} else {
+		m.adddiscountValue = &i
+	}
+}
+
+// AddedDiscountValue returns the value that was added to the "discountValue" field in this mutation.
+func (m *PromoCodeMutation) AddedDiscountValue() (r int, exists bool) {
+	v := m.adddiscountValue
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDiscountValue resets all changes to the "discountValue" field.
+func (m *PromoCodeMutation) ResetDiscountValue() {
+	m.discountValue = nil
+	m.adddiscountValue = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *PromoCodeMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *PromoCodeMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (m *PromoCodeMutation) ClearCurrency() {
+	m.currency = nil
+	m.clearedFields[promocode.FieldCurrency] = struct{}{}
+}
+
+// CurrencyCleared returns if the "currency" field was cleared in this mutation.
+func (m *PromoCodeMutation) CurrencyCleared() bool {
+	_, ok := m.clearedFields[promocode.FieldCurrency]
+	return ok
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *PromoCodeMutation) ResetCurrency() {
+	m.currency = nil
+	delete(m.clearedFields, promocode.FieldCurrency)
+}
+
+// SetValidFrom sets the "validFrom" field.
+func (m *PromoCodeMutation) SetValidFrom(t time.Time) {
+	m.validFrom = &t
+}
+
+// ValidFrom returns the value of the "validFrom" field in the mutation.
+func (m *PromoCodeMutation) ValidFrom() (r time.Time, exists bool) {
+	v := m.validFrom
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidFrom returns the old "validFrom" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldValidFrom(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldValidFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldValidFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidFrom: %w", err)
+	}
+	return oldValue.ValidFrom, nil
+}
+
+// ClearValidFrom clears the value of the "validFrom" field.
+func (m *PromoCodeMutation) ClearValidFrom() {
+	m.validFrom = nil
+	m.clearedFields[promocode.FieldValidFrom] = struct{}{}
+}
+
+// ValidFromCleared returns if the "validFrom" field was cleared in this mutation.
+func (m *PromoCodeMutation) ValidFromCleared() bool {
+	_, ok := m.clearedFields[promocode.FieldValidFrom]
+	return ok
+}
+
+// ResetValidFrom resets all changes to the "validFrom" field.
+func (m *PromoCodeMutation) ResetValidFrom() {
+	m.validFrom = nil
+	delete(m.clearedFields, promocode.FieldValidFrom)
+}
+
+// SetValidUntil sets the "validUntil" field.
+func (m *PromoCodeMutation) SetValidUntil(t time.Time) {
+	m.validUntil = &t
+}
+
+// ValidUntil returns the value of the "validUntil" field in the mutation.
+func (m *PromoCodeMutation) ValidUntil() (r time.Time, exists bool) {
+	v := m.validUntil
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidUntil returns the old "validUntil" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldValidUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldValidUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldValidUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidUntil: %w", err)
+	}
+	return oldValue.ValidUntil, nil
+}
+
+// ClearValidUntil clears the value of the "validUntil" field.
+func (m *PromoCodeMutation) ClearValidUntil() {
+	m.validUntil = nil
+	m.clearedFields[promocode.FieldValidUntil] = struct{}{}
+}
+
+// ValidUntilCleared returns if the "validUntil" field was cleared in this mutation.
+func (m *PromoCodeMutation) ValidUntilCleared() bool {
+	_, ok := m.clearedFields[promocode.FieldValidUntil]
+	return ok
+}
+
+// ResetValidUntil resets all changes to the "validUntil" field.
+func (m *PromoCodeMutation) ResetValidUntil() {
+	m.validUntil = nil
+	delete(m.clearedFields, promocode.FieldValidUntil)
+}
+
+// SetMaxRedemptions sets the "maxRedemptions" field.
+func (m *PromoCodeMutation) SetMaxRedemptions(i int) {
+	m.maxRedemptions = &i
+	m.addmaxRedemptions = nil
+}
+
+// MaxRedemptions returns the value of the "maxRedemptions" field in the mutation.
+func (m *PromoCodeMutation) MaxRedemptions() (r int, exists bool) {
+	v := m.maxRedemptions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxRedemptions returns the old "maxRedemptions" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldMaxRedemptions(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldMaxRedemptions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldMaxRedemptions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxRedemptions: %w", err)
+	}
+	return oldValue.MaxRedemptions, nil
+}
+
+// AddMaxRedemptions adds i to the "maxRedemptions" field.
+func (m *PromoCodeMutation) AddMaxRedemptions(i int) {
+	if m.addmaxRedemptions != nil {
+		*m.addmaxRedemptions += i
+	} else {
+		m.addmaxRedemptions = &i
+	}
+}
+
+// AddedMaxRedemptions returns the value that was added to the "maxRedemptions" field in this mutation.
+func (m *PromoCodeMutation) AddedMaxRedemptions() (r int, exists bool) {
+	v := m.addmaxRedemptions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxRedemptions clears the value of the "maxRedemptions" field.
+func (m *PromoCodeMutation) ClearMaxRedemptions() {
+	m.maxRedemptions = nil
+	m.addmaxRedemptions = nil
+	m.clearedFields[promocode.FieldMaxRedemptions] = struct{}{}
+}
+
+// MaxRedemptionsCleared returns if the "maxRedemptions" field was cleared in this mutation.
+func (m *PromoCodeMutation) MaxRedemptionsCleared() bool {
+	_, ok := m.clearedFields[promocode.FieldMaxRedemptions]
+	return ok
+}
+
+// ResetMaxRedemptions resets all changes to the "maxRedemptions" field.
+func (m *PromoCodeMutation) ResetMaxRedemptions() {
+	m.maxRedemptions = nil
+	m.addmaxRedemptions = nil
+	delete(m.clearedFields, promocode.FieldMaxRedemptions)
+}
+
+// SetMaxRedemptionsPerCustomer sets the "maxRedemptionsPerCustomer" field.
+func (m *PromoCodeMutation) SetMaxRedemptionsPerCustomer(i int) {
+	m.maxRedemptionsPerCustomer = &i
+	m.addmaxRedemptionsPerCustomer = nil
+}
+
+// MaxRedemptionsPerCustomer returns the value of the "maxRedemptionsPerCustomer" field in the mutation.
+func (m *PromoCodeMutation) MaxRedemptionsPerCustomer() (r int, exists bool) {
+	v := m.maxRedemptionsPerCustomer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxRedemptionsPerCustomer returns the old "maxRedemptionsPerCustomer" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldMaxRedemptionsPerCustomer(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldMaxRedemptionsPerCustomer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldMaxRedemptionsPerCustomer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxRedemptionsPerCustomer: %w", err)
+	}
+	return oldValue.MaxRedemptionsPerCustomer, nil
+}
+
+// AddMaxRedemptionsPerCustomer adds i to the "maxRedemptionsPerCustomer" field.
+func (m *PromoCodeMutation) AddMaxRedemptionsPerCustomer(i int) {
+	if m.addmaxRedemptionsPerCustomer != nil {
+		*m.addmaxRedemptionsPerCustomer += i
+	} else {
+		m.addmaxRedemptionsPerCustomer = &i
+	}
+}
+
+// AddedMaxRedemptionsPerCustomer returns the value that was added to the "maxRedemptionsPerCustomer" field in this mutation.
+func (m *PromoCodeMutation) AddedMaxRedemptionsPerCustomer() (r int, exists bool) {
+	v := m.addmaxRedemptionsPerCustomer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxRedemptionsPerCustomer clears the value of the "maxRedemptionsPerCustomer" field.
+func (m *PromoCodeMutation) ClearMaxRedemptionsPerCustomer() {
+	m.maxRedemptionsPerCustomer = nil
+	m.addmaxRedemptionsPerCustomer = nil
+	m.clearedFields[promocode.FieldMaxRedemptionsPerCustomer] = struct{}{}
+}
+
+// MaxRedemptionsPerCustomerCleared returns if the "maxRedemptionsPerCustomer" field was cleared in this mutation.
+func (m *PromoCodeMutation) MaxRedemptionsPerCustomerCleared() bool {
+	_, ok := m.clearedFields[promocode.FieldMaxRedemptionsPerCustomer]
+	return ok
+}
+
+// ResetMaxRedemptionsPerCustomer resets all changes to the "maxRedemptionsPerCustomer" field.
+func (m *PromoCodeMutation) ResetMaxRedemptionsPerCustomer() {
+	m.maxRedemptionsPerCustomer = nil
+	m.addmaxRedemptionsPerCustomer = nil
+	delete(m.clearedFields, promocode.FieldMaxRedemptionsPerCustomer)
+}
+
+// SetResourceIds sets the "resourceIds" field.
+func (m *PromoCodeMutation) SetResourceIds(i []int) {
+	m.resourceIds = &i
+}
+
+// ResourceIds returns the value of the "resourceIds" field in the mutation.
+func (m *PromoCodeMutation) ResourceIds() (r []int, exists bool) {
+	v := m.resourceIds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceIds returns the old "resourceIds" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldResourceIds(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldResourceIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldResourceIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceIds: %w", err)
+	}
+	return oldValue.ResourceIds, nil
+}
+
+// ClearResourceIds clears the value of the "resourceIds" field.
+func (m *PromoCodeMutation) ClearResourceIds() {
+	m.resourceIds = nil
+	m.clearedFields[promocode.FieldResourceIds] = struct{}{}
+}
+
+// ResourceIdsCleared returns if the "resourceIds" field was cleared in this mutation.
+func (m *PromoCodeMutation) ResourceIdsCleared() bool {
+	_, ok := m.clearedFields[promocode.FieldResourceIds]
+	return ok
+}
+
+// ResetResourceIds resets all changes to the "resourceIds" field.
+func (m *PromoCodeMutation) ResetResourceIds() {
+	m.resourceIds = nil
+	delete(m.clearedFields, promocode.FieldResourceIds)
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (m *PromoCodeMutation) SetOrganizationId(i int) {
+	m.organization = &i
+}
+
+// OrganizationId returns the value of the "organizationId" field in the mutation.
+func (m *PromoCodeMutation) OrganizationId() (r int, exists bool) {
+	v := m.organization
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationId returns the old "organizationId" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldOrganizationId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldOrganizationId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldOrganizationId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationId: %w", err)
+	}
+	return oldValue.OrganizationId, nil
+}
+
+// ResetOrganizationId resets all changes to the "organizationId" field.
+func (m *PromoCodeMutation) ResetOrganizationId() {
+	m.organization = nil
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by id.
+func (m *PromoCodeMutation) SetOrganizationID(id int) {
+	m.organization = &id
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *PromoCodeMutation) ClearOrganization() {
+	m.clearedorganization = true
+}
+
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *PromoCodeMutation) OrganizationCleared() bool {
+	return m.clearedorganization
+}
+
+// OrganizationID returns the "organization" edge ID in the mutation.
+func (m *PromoCodeMutation) OrganizationID() (id int, exists bool) {
+	if m.organization != nil {
+		return *m.organization, true
+	}
+	return
+}
+
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *PromoCodeMutation) OrganizationIDs() (ids []int) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *PromoCodeMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
+}
+
+// AddRedemptionIDs adds the "redemptions" edge to the PromoRedemption entity by ids.
+func (m *PromoCodeMutation) AddRedemptionIDs(ids ...int) {
+	if m.redemptions == nil {
+		m.redemptions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.redemptions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRedemptions clears the "redemptions" edge to the PromoRedemption entity.
+func (m *PromoCodeMutation) ClearRedemptions() {
+	m.clearedredemptions = true
+}
+
+// RedemptionsCleared reports if the "redemptions" edge to the PromoRedemption entity was cleared.
+func (m *PromoCodeMutation) RedemptionsCleared() bool {
+	return m.clearedredemptions
+}
+
+// RemoveRedemptionIDs removes the "redemptions" edge to the PromoRedemption entity by IDs.
+func (m *PromoCodeMutation) RemoveRedemptionIDs(ids ...int) {
+	if m.removedredemptions == nil {
+		m.removedredemptions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.redemptions, ids[i])
+		m.removedredemptions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRedemptions returns the removed IDs of the "redemptions" edge to the PromoRedemption entity.
+func (m *PromoCodeMutation) RemovedRedemptionsIDs() (ids []int) {
+	for id := range m.removedredemptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RedemptionsIDs returns the "redemptions" edge IDs in the mutation.
+func (m *PromoCodeMutation) RedemptionsIDs() (ids []int) {
+	for id := range m.redemptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRedemptions resets all changes to the "redemptions" edge.
+func (m *PromoCodeMutation) ResetRedemptions() {
+	m.redemptions = nil
+	m.clearedredemptions = false
+	m.removedredemptions = nil
+}
+
+// Where appends a list predicates to the PromoCodeMutation builder.
+func (m *PromoCodeMutation) Where(ps ...predicate.PromoCode) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *PromoCodeMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (PromoCode).
+func (m *PromoCodeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PromoCodeMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.createdAt != nil {
+		fields = append(fields, promocode.FieldCreatedAt)
+	}
+	if m.updatedAt != nil {
+		fields = append(fields, promocode.FieldUpdatedAt)
+	}
+	if m.code != nil {
+		fields = append(fields, promocode.FieldCode)
+	}
+	if m.description != nil {
+		fields = append(fields, promocode.FieldDescription)
+	}
+	if m.discountType != nil {
+		fields = append(fields, promocode.FieldDiscountType)
+	}
+	if m.discountValue != nil {
+		fields = append(fields, promocode.FieldDiscountValue)
+	}
+	if m.currency != nil {
+		fields = append(fields, promocode.FieldCurrency)
+	}
+	if m.validFrom != nil {
+		fields = append(fields, promocode.FieldValidFrom)
+	}
+	if m.validUntil != nil {
+		fields = append(fields, promocode.FieldValidUntil)
+	}
+	if m.maxRedemptions != nil {
+		fields = append(fields, promocode.FieldMaxRedemptions)
+	}
+	if m.maxRedemptionsPerCustomer != nil {
+		fields = append(fields, promocode.FieldMaxRedemptionsPerCustomer)
+	}
+	if m.resourceIds != nil {
+		fields = append(fields, promocode.FieldResourceIds)
+	}
+	if m.organization != nil {
+		fields = append(fields, promocode.FieldOrganizationId)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PromoCodeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case promocode.FieldCreatedAt:
+		return m.CreatedAt()
+	case promocode.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case promocode.FieldCode:
+		return m.Code()
+	case promocode.FieldDescription:
+		return m.Description()
+	case promocode.FieldDiscountType:
+		return m.DiscountType()
+	case promocode.FieldDiscountValue:
+		return m.DiscountValue()
+	case promocode.FieldCurrency:
+		return m.Currency()
+	case promocode.FieldValidFrom:
+		return m.ValidFrom()
+	case promocode.FieldValidUntil:
+		return m.ValidUntil()
+	case promocode.FieldMaxRedemptions:
+		return m.MaxRedemptions()
+	case promocode.FieldMaxRedemptionsPerCustomer:
+		return m.MaxRedemptionsPerCustomer()
+	case promocode.FieldResourceIds:
+		return m.ResourceIds()
+	case promocode.FieldOrganizationId:
+		return m.OrganizationId()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PromoCodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case promocode.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case promocode.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case promocode.FieldCode:
+		return m.OldCode(ctx)
+	case promocode.FieldDescription:
+		return m.OldDescription(ctx)
+	case promocode.FieldDiscountType:
+		return m.OldDiscountType(ctx)
+	case promocode.FieldDiscountValue:
+		return m.OldDiscountValue(ctx)
+	case promocode.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case promocode.FieldValidFrom:
+		return m.OldValidFrom(ctx)
+	case promocode.FieldValidUntil:
+		return m.OldValidUntil(ctx)
+	case promocode.FieldMaxRedemptions:
+		return m.OldMaxRedemptions(ctx)
+	case promocode.FieldMaxRedemptionsPerCustomer:
+		return m.OldMaxRedemptionsPerCustomer(ctx)
+	case promocode.FieldResourceIds:
+		return m.OldResourceIds(ctx)
+	case promocode.FieldOrganizationId:
+		return m.OldOrganizationId(ctx)
+	}
+	return nil, fmt.Errorf("unknown PromoCode field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PromoCodeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case promocode.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case promocode.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case promocode.FieldCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCode(v)
+		return nil
+	case promocode.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case promocode.FieldDiscountType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiscountType(v)
+		return nil
+	case promocode.FieldDiscountValue:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiscountValue(v)
+		return nil
+	case promocode.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case promocode.FieldValidFrom:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidFrom(v)
+		return nil
+	case promocode.FieldValidUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidUntil(v)
+		return nil
+	case promocode.FieldMaxRedemptions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxRedemptions(v)
+		return nil
+	case promocode.FieldMaxRedemptionsPerCustomer:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxRedemptionsPerCustomer(v)
+		return nil
+	case promocode.FieldResourceIds:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceIds(v)
+		return nil
+	case promocode.FieldOrganizationId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PromoCode field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PromoCodeMutation) AddedFields() []string {
+	var fields []string
+	if m.adddiscountValue != nil {
+		fields = append(fields, promocode.FieldDiscountValue)
+	}
+	if m.addmaxRedemptions != nil {
+		fields = append(fields, promocode.FieldMaxRedemptions)
+	}
+	if m.addmaxRedemptionsPerCustomer != nil {
+		fields = append(fields, promocode.FieldMaxRedemptionsPerCustomer)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PromoCodeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case promocode.FieldDiscountValue:
+		return m.AddedDiscountValue()
+	case promocode.FieldMaxRedemptions:
+		return m.AddedMaxRedemptions()
+	case promocode.FieldMaxRedemptionsPerCustomer:
+		return m.AddedMaxRedemptionsPerCustomer()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PromoCodeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case promocode.FieldDiscountValue:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDiscountValue(v)
+		return nil
+	case promocode.FieldMaxRedemptions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxRedemptions(v)
+		return nil
+	case promocode.FieldMaxRedemptionsPerCustomer:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxRedemptionsPerCustomer(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PromoCode numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PromoCodeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(promocode.FieldDescription) {
+		fields = append(fields, promocode.FieldDescription)
+	}
+	if m.FieldCleared(promocode.FieldCurrency) {
+		fields = append(fields, promocode.FieldCurrency)
+	}
+	if m.FieldCleared(promocode.FieldValidFrom) {
+		fields = append(fields, promocode.FieldValidFrom)
+	}
+	if m.FieldCleared(promocode.FieldValidUntil) {
+		fields = append(fields, promocode.FieldValidUntil)
+	}
+	if m.FieldCleared(promocode.FieldMaxRedemptions) {
+		fields = append(fields, promocode.FieldMaxRedemptions)
+	}
+	if m.FieldCleared(promocode.FieldMaxRedemptionsPerCustomer) {
+		fields = append(fields, promocode.FieldMaxRedemptionsPerCustomer)
+	}
+	if m.FieldCleared(promocode.FieldResourceIds) {
+		fields = append(fields, promocode.FieldResourceIds)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PromoCodeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PromoCodeMutation) ClearField(name string) error {
+	switch name {
+	case promocode.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case promocode.FieldCurrency:
+		m.ClearCurrency()
+		return nil
+	case promocode.FieldValidFrom:
+		m.ClearValidFrom()
+		return nil
+	case promocode.FieldValidUntil:
+		m.ClearValidUntil()
+		return nil
+	case promocode.FieldMaxRedemptions:
+		m.ClearMaxRedemptions()
+		return nil
+	case promocode.FieldMaxRedemptionsPerCustomer:
+		m.ClearMaxRedemptionsPerCustomer()
+		return nil
+	case promocode.FieldResourceIds:
+		m.ClearResourceIds()
+		return nil
+	}
+	return fmt.Errorf("unknown PromoCode nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PromoCodeMutation) ResetField(name string) error {
+	switch name {
+	case promocode.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case promocode.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case promocode.FieldCode:
+		m.ResetCode()
+		return nil
+	case promocode.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case promocode.FieldDiscountType:
+		m.ResetDiscountType()
+		return nil
+	case promocode.FieldDiscountValue:
+		m.ResetDiscountValue()
+		return nil
+	case promocode.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case promocode.FieldValidFrom:
+		m.ResetValidFrom()
+		return nil
+	case promocode.FieldValidUntil:
+		m.ResetValidUntil()
+		return nil
+	case promocode.FieldMaxRedemptions:
+		m.ResetMaxRedemptions()
+		return nil
+	case promocode.FieldMaxRedemptionsPerCustomer:
+		m.ResetMaxRedemptionsPerCustomer()
+		return nil
+	case promocode.FieldResourceIds:
+		m.ResetResourceIds()
+		return nil
+	case promocode.FieldOrganizationId:
+		m.ResetOrganizationId()
+		return nil
+	}
+	return fmt.Errorf("unknown PromoCode field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PromoCodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.organization != nil {
+		edges = append(edges, promocode.EdgeOrganization)
+	}
+	if m.redemptions != nil {
+		edges = append(edges, promocode.EdgeRedemptions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PromoCodeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case promocode.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
+		}
+	case promocode.EdgeRedemptions:
+		ids := make([]ent.Value, 0, len(m.redemptions))
+		for id := range m.redemptions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PromoCodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedredemptions != nil {
+		edges = append(edges, promocode.EdgeRedemptions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PromoCodeMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case promocode.EdgeRedemptions:
+		ids := make([]ent.Value, 0, len(m.removedredemptions))
+		for id := range m.removedredemptions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PromoCodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedorganization {
+		edges = append(edges, promocode.EdgeOrganization)
+	}
+	if m.clearedredemptions {
+		edges = append(edges, promocode.EdgeRedemptions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PromoCodeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case promocode.EdgeOrganization:
+		return m.clearedorganization
+	case promocode.EdgeRedemptions:
+		return m.clearedredemptions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PromoCodeMutation) ClearEdge(name string) error {
+	switch name {
+	case promocode.EdgeOrganization:
+		m.ClearOrganization()
+		return nil
+	}
+	return fmt.Errorf("unknown PromoCode unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PromoCodeMutation) ResetEdge(name string) error {
+	switch name {
+	case promocode.EdgeOrganization:
+		m.ResetOrganization()
+		return nil
+	case promocode.EdgeRedemptions:
+		m.ResetRedemptions()
+		return nil
+	}
+	return fmt.Errorf("unknown PromoCode edge %s", name)
+}
+
+// PromoRedemptionMutation represents an operation that mutates the PromoRedemption nodes in the graph.
+type PromoRedemptionMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	createdAt        *time.Time
+	updatedAt        *time.Time
+	customer         *string
+	discount         *int
+	adddiscount      *int
+	currency         *string
+	clearedFields    map[string]struct{}
+	promoCode        *int
+	clearedpromoCode bool
+	booking          *int
+	clearedbooking   bool
+	done             bool
+	oldValue         func(context.Context) (*PromoRedemption, error)
+	predicates       []predicate.PromoRedemption
+}
+
+var _ ent.Mutation = (*PromoRedemptionMutation)(nil)
+
+// promoredemptionOption allows management of the mutation configuration using functional options.
+type promoredemptionOption func(*PromoRedemptionMutation)
+
+// newPromoRedemptionMutation creates new mutation for the PromoRedemption entity.
+func newPromoRedemptionMutation(c config, op Op, opts ...promoredemptionOption) *PromoRedemptionMutation {
+	m := &PromoRedemptionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePromoRedemption,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPromoRedemptionID sets the ID field of the mutation.
+func withPromoRedemptionID(id int) promoredemptionOption {
+	return func(m *PromoRedemptionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PromoRedemption
+		)
+		m.oldValue = func(ctx context.Context) (*PromoRedemption, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PromoRedemption.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPromoRedemption sets the old PromoRedemption of the mutation.
+func withPromoRedemption(node *PromoRedemption) promoredemptionOption {
+	return func(m *PromoRedemptionMutation) {
+		m.oldValue = func(context.Context) (*PromoRedemption, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PromoRedemptionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PromoRedemptionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PromoRedemptionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (m *PromoRedemptionMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
+}
+
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *PromoRedemptionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "createdAt" field's value of the PromoRedemption entity.
+// If the PromoRedemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoRedemptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *PromoRedemptionMutation) ResetCreatedAt() {
+	m.createdAt = nil
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (m *PromoRedemptionMutation) SetUpdatedAt(t time.Time) {
+	m.updatedAt = &t
+}
+
+// UpdatedAt returns the value of the "updatedAt" field in the mutation.
+func (m *PromoRedemptionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updatedAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updatedAt" field's value of the PromoRedemption entity.
+// If the PromoRedemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoRedemptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updatedAt" field.
+func (m *PromoRedemptionMutation) ResetUpdatedAt() {
+	m.updatedAt = nil
+}
+
+// SetCustomer sets the "customer" field.
+func (m *PromoRedemptionMutation) SetCustomer(s string) {
+	m.customer = &s
+}
+
+// Customer returns the value of the "customer" field in the mutation.
+func (m *PromoRedemptionMutation) Customer() (r string, exists bool) {
+	v := m.customer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCustomer returns the old "customer" field's value of the PromoRedemption entity.
+// If the PromoRedemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoRedemptionMutation) OldCustomer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCustomer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCustomer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCustomer: %w", err)
+	}
+	return oldValue.Customer, nil
+}
+
+// ClearCustomer clears the value of the "customer" field.
+func (m *PromoRedemptionMutation) ClearCustomer() {
+	m.customer = nil
+	m.clearedFields[promoredemption.FieldCustomer] = struct{}{}
+}
+
+// CustomerCleared returns if the "customer" field was cleared in this mutation.
+func (m *PromoRedemptionMutation) CustomerCleared() bool {
+	_, ok := m.clearedFields[promoredemption.FieldCustomer]
+	return ok
+}
+
+// ResetCustomer resets all changes to the "customer" field.
+func (m *PromoRedemptionMutation) ResetCustomer() {
+	m.customer = nil
+	delete(m.clearedFields, promoredemption.FieldCustomer)
+}
+
+// SetDiscount sets the "discount" field.
+func (m *PromoRedemptionMutation) SetDiscount(i int) {
+	m.discount = &i
+	m.adddiscount = nil
+}
+
+// Discount returns the value of the "discount" field in the mutation.
+func (m *PromoRedemptionMutation) Discount() (r int, exists bool) {
+	v := m.discount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiscount returns the old "discount" field's value of the PromoRedemption entity.
+// If the PromoRedemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoRedemptionMutation) OldDiscount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldDiscount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldDiscount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiscount: %w", err)
+	}
+	return oldValue.Discount, nil
+}
+
+// AddDiscount adds i to the "discount" field.
+func (m *PromoRedemptionMutation) AddDiscount(i int) {
+	if m.adddiscount != nil {
+		*m.adddiscount += i
+	} else {
+		m.adddiscount = &i
+	}
+}
+
+// AddedDiscount returns the value that was added to the "discount" field in this mutation.
+func (m *PromoRedemptionMutation) AddedDiscount() (r int, exists bool) {
+	v := m.adddiscount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDiscount resets all changes to the "discount" field.
+func (m *PromoRedemptionMutation) ResetDiscount() {
+	m.discount = nil
+	m.adddiscount = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *PromoRedemptionMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *PromoRedemptionMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the PromoRedemption entity.
+// If the PromoRedemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoRedemptionMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *PromoRedemptionMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetPromoCodeId sets the "promoCodeId" field.
+func (m *PromoRedemptionMutation) SetPromoCodeId(i int) {
+	m.promoCode = &i
+}
+
+// PromoCodeId returns the value of the "promoCodeId" field in the mutation.
+func (m *PromoRedemptionMutation) PromoCodeId() (r int, exists bool) {
+	v := m.promoCode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromoCodeId returns the old "promoCodeId" field's value of the PromoRedemption entity.
+// If the PromoRedemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoRedemptionMutation) OldPromoCodeId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldPromoCodeId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldPromoCodeId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromoCodeId: %w", err)
+	}
+	return oldValue.PromoCodeId, nil
+}
+
+// ResetPromoCodeId resets all changes to the "promoCodeId" field.
+func (m *PromoRedemptionMutation) ResetPromoCodeId() {
+	m.promoCode = nil
+}
+
+// SetBookingId sets the "bookingId" field.
+func (m *PromoRedemptionMutation) SetBookingId(i int) {
+	m.booking = &i
+}
+
+// BookingId returns the value of the "bookingId" field in the mutation.
+func (m *PromoRedemptionMutation) BookingId() (r int, exists bool) {
+	v := m.booking
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBookingId returns the old "bookingId" field's value of the PromoRedemption entity.
+// If the PromoRedemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoRedemptionMutation) OldBookingId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldBookingId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldBookingId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBookingId: %w", err)
+	}
+	return oldValue.BookingId, nil
+}
+
+// ResetBookingId resets all changes to the "bookingId" field.
+func (m *PromoRedemptionMutation) ResetBookingId() {
+	m.booking = nil
+}
+
+// SetPromoCodeID sets the "promoCode" edge to the PromoCode entity by id.
+func (m *PromoRedemptionMutation) SetPromoCodeID(id int) {
+	m.promoCode = &id
+}
+
+// ClearPromoCode clears the "promoCode" edge to the PromoCode entity.
+func (m *PromoRedemptionMutation) ClearPromoCode() {
+	m.clearedpromoCode = true
+}
+
+// PromoCodeCleared reports if the "promoCode" edge to the PromoCode entity was cleared.
+func (m *PromoRedemptionMutation) PromoCodeCleared() bool {
+	return m.clearedpromoCode
+}
+
+// PromoCodeID returns the "promoCode" edge ID in the mutation.
+func (m *PromoRedemptionMutation) PromoCodeID() (id int, exists bool) {
+	if m.promoCode != nil {
+		return *m.promoCode, true
+	}
+	return
+}
+
+// PromoCodeIDs returns the "promoCode" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PromoCodeID instead. It exists only for internal usage by the builders.
+func (m *PromoRedemptionMutation) PromoCodeIDs() (ids []int) {
+	if id := m.promoCode; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPromoCode resets all changes to the "promoCode" edge.
+func (m *PromoRedemptionMutation) ResetPromoCode() {
+	m.promoCode = nil
+	m.clearedpromoCode = false
+}
+
+// SetBookingID sets the "booking" edge to the Booking entity by id.
+func (m *PromoRedemptionMutation) SetBookingID(id int) {
+	m.booking = &id
+}
+
+// ClearBooking clears the "booking" edge to the Booking entity.
+func (m *PromoRedemptionMutation) ClearBooking() {
+	m.clearedbooking = true
+}
+
+// BookingCleared reports if the "booking" edge to the Booking entity was cleared.
+func (m *PromoRedemptionMutation) BookingCleared() bool {
+	return m.clearedbooking
+}
+
+// BookingID returns the "booking" edge ID in the mutation.
+func (m *PromoRedemptionMutation) BookingID() (id int, exists bool) {
+	if m.booking != nil {
+		return *m.booking, true
+	}
+	return
+}
+
+// BookingIDs returns the "booking" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BookingID instead. It exists only for internal usage by the builders.
+func (m *PromoRedemptionMutation) BookingIDs() (ids []int) {
+	if id := m.booking; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBooking resets all changes to the "booking" edge.
+func (m *PromoRedemptionMutation) ResetBooking() {
+	m.booking = nil
+	m.clearedbooking = false
+}
+
+// Where appends a list predicates to the PromoRedemptionMutation builder.
+func (m *PromoRedemptionMutation) Where(ps ...predicate.PromoRedemption) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *PromoRedemptionMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (PromoRedemption).
+func (m *PromoRedemptionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PromoRedemptionMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.createdAt != nil {
+		fields = append(fields, promoredemption.FieldCreatedAt)
+	}
+	if m.updatedAt != nil {
+		fields = append(fields, promoredemption.FieldUpdatedAt)
+	}
+	if m.customer != nil {
+		fields = append(fields, promoredemption.FieldCustomer)
+	}
+	if m.discount != nil {
+		fields = append(fields, promoredemption.FieldDiscount)
+	}
+	if m.currency != nil {
+		fields = append(fields, promoredemption.FieldCurrency)
+	}
+	if m.promoCode != nil {
+		fields = append(fields, promoredemption.FieldPromoCodeId)
+	}
+	if m.booking != nil {
+		fields = append(fields, promoredemption.FieldBookingId)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PromoRedemptionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case promoredemption.FieldCreatedAt:
+		return m.CreatedAt()
+	case promoredemption.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case promoredemption.FieldCustomer:
+		return m.Customer()
+	case promoredemption.FieldDiscount:
+		return m.Discount()
+	case promoredemption.FieldCurrency:
+		return m.Currency()
+	case promoredemption.FieldPromoCodeId:
+		return m.PromoCodeId()
+	case promoredemption.FieldBookingId:
+		return m.BookingId()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PromoRedemptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case promoredemption.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case promoredemption.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case promoredemption.FieldCustomer:
+		return m.OldCustomer(ctx)
+	case promoredemption.FieldDiscount:
+		return m.OldDiscount(ctx)
+	case promoredemption.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case promoredemption.FieldPromoCodeId:
+		return m.OldPromoCodeId(ctx)
+	case promoredemption.FieldBookingId:
+		return m.OldBookingId(ctx)
+	}
+	return nil, fmt.Errorf("unknown PromoRedemption field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PromoRedemptionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case promoredemption.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case promoredemption.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case promoredemption.FieldCustomer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCustomer(v)
+		return nil
+	case promoredemption.FieldDiscount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiscount(v)
+		return nil
+	case promoredemption.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case promoredemption.FieldPromoCodeId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromoCodeId(v)
+		return nil
+	case promoredemption.FieldBookingId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBookingId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PromoRedemption field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PromoRedemptionMutation) AddedFields() []string {
+	var fields []string
+	if m.adddiscount != nil {
+		fields = append(fields, promoredemption.FieldDiscount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PromoRedemptionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case promoredemption.FieldDiscount:
+		return m.AddedDiscount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PromoRedemptionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case promoredemption.FieldDiscount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDiscount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PromoRedemption numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PromoRedemptionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(promoredemption.FieldCustomer) {
+		fields = append(fields, promoredemption.FieldCustomer)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PromoRedemptionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PromoRedemptionMutation) ClearField(name string) error {
+	switch name {
+	case promoredemption.FieldCustomer:
+		m.ClearCustomer()
+		return nil
+	}
+	return fmt.Errorf("unknown PromoRedemption nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PromoRedemptionMutation) ResetField(name string) error {
+	switch name {
+	case promoredemption.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case promoredemption.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case promoredemption.FieldCustomer:
+		m.ResetCustomer()
+		return nil
+	case promoredemption.FieldDiscount:
+		m.ResetDiscount()
+		return nil
+	case promoredemption.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case promoredemption.FieldPromoCodeId:
+		m.ResetPromoCodeId()
+		return nil
+	case promoredemption.FieldBookingId:
+		m.ResetBookingId()
+		return nil
+	}
+	return fmt.Errorf("unknown PromoRedemption field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PromoRedemptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.promoCode != nil {
+		edges = append(edges, promoredemption.EdgePromoCode)
+	}
+	if m.booking != nil {
+		edges = append(edges, promoredemption.EdgeBooking)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PromoRedemptionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case promoredemption.EdgePromoCode:
+		if id := m.promoCode; id != nil {
+			return []ent.Value{*id}
+		}
+	case promoredemption.EdgeBooking:
+		if id := m.booking; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PromoRedemptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PromoRedemptionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PromoRedemptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedpromoCode {
+		edges = append(edges, promoredemption.EdgePromoCode)
+	}
+	if m.clearedbooking {
+		edges = append(edges, promoredemption.EdgeBooking)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PromoRedemptionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case promoredemption.EdgePromoCode:
+		return m.clearedpromoCode
+	case promoredemption.EdgeBooking:
+		return m.clearedbooking
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PromoRedemptionMutation) ClearEdge(name string) error {
+	switch name {
+	case promoredemption.EdgePromoCode:
+		m.ClearPromoCode()
+		return nil
+	case promoredemption.EdgeBooking:
+		m.ClearBooking()
+		return nil
+	}
+	return fmt.Errorf("unknown PromoRedemption unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PromoRedemptionMutation) ResetEdge(name string) error {
+	switch name {
+	case promoredemption.EdgePromoCode:
+		m.ResetPromoCode()
+		return nil
+	case promoredemption.EdgeBooking:
+		m.ResetBooking()
+		return nil
+	}
+	return fmt.Errorf("unknown PromoRedemption edge %s", name)
+}
+
+// ResourceMutation represents an operation that mutates the Resource nodes in the graph.
+type ResourceMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	createdAt               *time.Time
+	updatedAt               *time.Time
+	name                    *string
+	description             *string
+	timezone                *string
+	passwordHash            *string
+	price                   *int
+	addprice                *int
+	bookingPrice            *int
+	addbookingPrice         *int
+	currency                *string
+	quantityAvailable       *int
+	addquantityAvailable    *int
+	cancellationPolicy      *string
+	pricing                 *string
+	bookingFields           *string
+	feedTokenHash           *string
+	clearedFields           map[string]struct{}
+	slots                   map[int]struct{}
+	removedslots            map[int]struct{}
+	clearedslots            bool
+	bookings                map[int]struct{}
+	removedbookings         map[int]struct{}
+	clearedbookings         bool
+	bookingSeries           map[int]struct{}
+	removedbookingSeries    map[int]struct{}
+	clearedbookingSeries    bool
+	unavailabilities        map[int]struct{}
+	removedunavailabilities map[int]struct{}
+	clearedunavailabilities bool
+	waitlistEntries         map[int]struct{}
+	removedwaitlistEntries  map[int]struct{}
+	clearedwaitlistEntries  bool
+	organization            *int
+	clearedorganization     bool
+	taxRate                 *int
+	clearedtaxRate          bool
+	done                    bool
+	oldValue                func(context.Context) (*Resource, error)
+	predicates              []predicate.Resource
+}
+
+var _ ent.Mutation = (*ResourceMutation)(nil)
+
+// resourceOption allows management of the mutation configuration using functional options.
+type resourceOption func(*ResourceMutation)
+
+// newResourceMutation creates new mutation for the Resource entity.
+func newResourceMutation(c config, op Op, opts ...resourceOption) *ResourceMutation {
+	m := &ResourceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeResource,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withResourceID sets the ID field of the mutation.
+func withResourceID(id int) resourceOption {
+	return func(m *ResourceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Resource
+		)
+		m.oldValue = func(ctx context.Context) (*Resource, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Resource.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withResource sets the old Resource of the mutation.
+func withResource(node *Resource) resourceOption {
+	return func(m *ResourceMutation) {
+		m.oldValue = func(context.Context) (*Resource, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ResourceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ResourceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ResourceMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (m *ResourceMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
+}
+
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *ResourceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "createdAt" field's value of the Resource entity.
+// If the Resource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResourceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *ResourceMutation) ResetCreatedAt() {
+	m.createdAt = nil
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (m *ResourceMutation) SetUpdatedAt(t time.Time) {
+	m.updatedAt = &t
+}
+
+// UpdatedAt returns the value of the "updatedAt" field in the mutation.
+func (m *ResourceMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updatedAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updatedAt" field's value of the Resource entity.
+// If the Resource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResourceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updatedAt" field.
+func (m *ResourceMutation) ResetUpdatedAt() {
+	m.updatedAt = nil
+}
+
+// SetName sets the "name" field.
+func (m *ResourceMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ResourceMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Resource entity.
+// If the Resource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResourceMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ResourceMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ResourceMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ResourceMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Resource entity.
+// If the Resource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResourceMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ResourceMutation) ResetDescription() {
+	m.description = nil
+}
+
+// SetTimezone sets the "timezone" field.
+func (m *ResourceMutation) SetTimezone(s string) {
+	m.timezone = &s
+}
+
+// Timezone returns the value of the "timezone" field in the mutation.
+func (m *ResourceMutation) Timezone() (r string, exists bool) {
+	v := m.timezone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimezone returns the old "timezone" field's value of the Resource entity.
+// If the Resource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResourceMutation) OldTimezone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTimezone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTimezone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimezone: %w", err)
+	}
+	return oldValue.Timezone, nil
+}
+
+// ResetTimezone resets all changes to the "timezone" field.
+func (m *ResourceMutation) ResetTimezone() {
+	m.timezone = nil
+}
+
+// SetPasswordHash sets the "passwordHash" field.
+func (m *ResourceMutation) SetPasswordHash(s string) {
+	m.passwordHash = &s
+}
+
+// PasswordHash returns the value of the "passwordHash" field in the mutation.
+func (m *ResourceMutation) PasswordHash() (r string, exists bool) {
+	v := m.passwordHash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordHash returns the old "passwordHash" field's value of the Resource entity.
+// If the Resource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResourceMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldPasswordHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldPasswordHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+	}
+	return oldValue.PasswordHash, nil
+}
+
+// ResetPasswordHash resets all changes to the "passwordHash" field.
+func (m *ResourceMutation) ResetPasswordHash() {
+	m.passwordHash = nil
+}
+
+// SetPrice sets the "price" field.
+func (m *ResourceMutation) SetPrice(i int) {
+	m.price = &i
+	m.addprice = nil
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *ResourceMutation) Price() (r int, exists bool) {
+	v := m.price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrice returns the old "price" field's value of the Resource entity.
+// If the Resource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResourceMutation) OldPrice(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
+}
+
+// AddPrice adds i to the "price" field.
+func (m *ResourceMutation) AddPrice(i int) {
+	if m.addprice != nil {
+		*m.addprice += i
+	} else {
+		m.addprice = &i
+	}
+}
+
+// AddedPrice returns the value that was added to the "price" field in this mutation.
+func (m *ResourceMutation) AddedPrice() (r int, exists bool) {
+	v := m.addprice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPrice resets all changes to the "price" field.
+func (m *ResourceMutation) ResetPrice() {
+	m.price = nil
+	m.addprice = nil
+}
+
+// SetBookingPrice sets the "bookingPrice" field.
+func (m *ResourceMutation) SetBookingPrice(i int) {
+	m.bookingPrice = &i
+	m.addbookingPrice = nil
+}
+
+// BookingPrice returns the value of the "bookingPrice" field in the mutation.
+func (m *ResourceMutation) BookingPrice() (r int, exists bool) {
+	v := m.bookingPrice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBookingPrice returns the old "bookingPrice" field's value of the Resource entity.
+// If the Resource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResourceMutation) OldBookingPrice(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldBookingPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldBookingPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBookingPrice: %w", err)
+	}
+	return oldValue.BookingPrice, nil
+}
+
+// AddBookingPrice adds i to the "bookingPrice" field.
+func (m *ResourceMutation) AddBookingPrice(i int) {
+	if m.addbookingPrice != nil {
+		*m.addbookingPrice += i
+	} else {
+		m.addbookingPrice = &i
+	}
+}
+
+// AddedBookingPrice returns the value that was added to the "bookingPrice" field in this mutation.
+func (m *ResourceMutation) AddedBookingPrice() (r int, exists bool) {
+	v := m.addbookingPrice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBookingPrice resets all changes to the "bookingPrice" field.
+func (m *ResourceMutation) ResetBookingPrice() {
+	m.bookingPrice = nil
+	m.addbookingPrice = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *ResourceMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *ResourceMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the Resource entity.
+// If the Resource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResourceMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *ResourceMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (m *ResourceMutation) SetOrganizationId(i int) {
+	m.organization = &i
+}
+
+// OrganizationId returns the value of the "organizationId" field in the mutation.
+func (m *ResourceMutation) OrganizationId() (r int, exists bool) {
+	v := m.organization
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationId returns the old "organizationId" field's value of the Resource entity.
+// If the Resource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResourceMutation) OldOrganizationId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldOrganizationId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldOrganizationId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationId: %w", err)
+	}
+	return oldValue.OrganizationId, nil
+}
+
+// ResetOrganizationId resets all changes to the "organizationId" field.
+func (m *ResourceMutation) ResetOrganizationId() {
+	m.organization = nil
+}
+
+// SetQuantityAvailable sets the "quantityAvailable" field.
+func (m *ResourceMutation) SetQuantityAvailable(i int) {
+	m.quantityAvailable = &i
+	m.addquantityAvailable = nil
+}
+
+// QuantityAvailable returns the value of the "quantityAvailable" field in the mutation.
+func (m *ResourceMutation) QuantityAvailable() (r int, exists bool) {
+	v := m.quantityAvailable
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantityAvailable returns the old "quantityAvailable" field's value of the Resource entity.
+// If the Resource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResourceMutation) OldQuantityAvailable(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldQuantityAvailable is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldQuantityAvailable requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantityAvailable: %w", err)
+	}
+	return oldValue.QuantityAvailable, nil
+}
+
+// AddQuantityAvailable adds i to the "quantityAvailable" field.
+func (m *ResourceMutation) AddQuantityAvailable(i int) {
+	if m.addquantityAvailable != nil {
+		*m.addquantityAvailable += i
+	} else {
+		m.addquantityAvailable = &i
+	}
+}
+
+// AddedQuantityAvailable returns the value that was added to the "quantityAvailable" field in this mutation.
+func (m *ResourceMutation) AddedQuantityAvailable() (r int, exists bool) {
+	v := m.addquantityAvailable
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearQuantityAvailable clears the value of the "quantityAvailable" field.
+func (m *ResourceMutation) ClearQuantityAvailable() {
+	m.quantityAvailable = nil
+	m.addquantityAvailable = nil
+	m.clearedFields[resource.FieldQuantityAvailable] = struct{}{}
+}
+
+// QuantityAvailableCleared returns if the "quantityAvailable" field was cleared in this mutation.
+func (m *ResourceMutation) QuantityAvailableCleared() bool {
+	_, ok := m.clearedFields[resource.FieldQuantityAvailable]
+	return ok
+}
+
+// ResetQuantityAvailable resets all changes to the "quantityAvailable" field.
+func (m *ResourceMutation) ResetQuantityAvailable() {
+	m.quantityAvailable = nil
+	m.addquantityAvailable = nil
+	delete(m.clearedFields, resource.FieldQuantityAvailable)
+}
+
+// SetCancellationPolicy sets the "cancellationPolicy" field.
+func (m *ResourceMutation) SetCancellationPolicy(s string) {
+	m.cancellationPolicy = &s
+}
+
+// CancellationPolicy returns the value of the "cancellationPolicy" field in the mutation.
+func (m *ResourceMutation) CancellationPolicy() (r string, exists bool) {
+	v := m.cancellationPolicy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCancellationPolicy returns the old "cancellationPolicy" field's value of the Resource entity.
+// If the Resource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResourceMutation) OldCancellationPolicy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCancellationPolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCancellationPolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCancellationPolicy: %w", err)
+	}
+	return oldValue.CancellationPolicy, nil
+}
+
+// ClearCancellationPolicy clears the value of the "cancellationPolicy" field.
+func (m *ResourceMutation) ClearCancellationPolicy() {
+	m.cancellationPolicy = nil
+	m.clearedFields[resource.FieldCancellationPolicy] = struct{}{}
+}
+
+// CancellationPolicyCleared returns if the "cancellationPolicy" field was cleared in this mutation.
+func (m *ResourceMutation) CancellationPolicyCleared() bool {
+	_, ok := m.clearedFields[resource.FieldCancellationPolicy]
+	return ok
+}
+
+// ResetCancellationPolicy resets all changes to the "cancellationPolicy" field.
+func (m *ResourceMutation) ResetCancellationPolicy() {
+	m.cancellationPolicy = nil
+	delete(m.clearedFields, resource.FieldCancellationPolicy)
+}
+
+// SetPricing sets the "pricing" field.
+func (m *ResourceMutation) SetPricing(s string) {
+	m.pricing = &s
+}
+
+// Pricing returns the value of the "pricing" field in the mutation.
+func (m *ResourceMutation) Pricing() (r string, exists bool) {
+	v := m.pricing
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPricing returns the old "pricing" field's value of the Resource entity.
+// If the Resource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResourceMutation) OldPricing(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldPricing is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldPricing requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPricing: %w", err)
+	}
+	return oldValue.Pricing, nil
+}
+
+// ClearPricing clears the value of the "pricing" field.
+func (m *ResourceMutation) ClearPricing() {
+	m.pricing = nil
+	m.clearedFields[resource.FieldPricing] = struct{}{}
+}
+
+// PricingCleared returns if the "pricing" field was cleared in this mutation.
+func (m *ResourceMutation) PricingCleared() bool {
+	_, ok := m.clearedFields[resource.FieldPricing]
+	return ok
+}
+
+// ResetPricing resets all changes to the "pricing" field.
+func (m *ResourceMutation) ResetPricing() {
+	m.pricing = nil
+	delete(m.clearedFields, resource.FieldPricing)
+}
+
+// SetBookingFields sets the "bookingFields" field.
+func (m *ResourceMutation) SetBookingFields(s string) {
+	m.bookingFields = &s
+}
+
+// BookingFields returns the value of the "bookingFields" field in the mutation.
+func (m *ResourceMutation) BookingFields() (r string, exists bool) {
+	v := m.bookingFields
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBookingFields returns the old "bookingFields" field's value of the Resource entity.
+// If the Resource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResourceMutation) OldBookingFields(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldBookingFields is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldBookingFields requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBookingFields: %w", err)
+	}
+	return oldValue.BookingFields, nil
+}
+
+// ClearBookingFields clears the value of the "bookingFields" field.
+func (m *ResourceMutation) ClearBookingFields() {
+	m.bookingFields = nil
+	m.clearedFields[resource.FieldBookingFields] = struct{}{}
+}
+
+// BookingFieldsCleared returns if the "bookingFields" field was cleared in this mutation.
+func (m *ResourceMutation) BookingFieldsCleared() bool {
+	_, ok := m.clearedFields[resource.FieldBookingFields]
+	return ok
+}
+
+// ResetBookingFields resets all changes to the "bookingFields" field.
+func (m *ResourceMutation) ResetBookingFields() {
+	m.bookingFields = nil
+	delete(m.clearedFields, resource.FieldBookingFields)
+}
+
+// SetFeedTokenHash sets the "feedTokenHash" field.
+func (m *ResourceMutation) SetFeedTokenHash(s string) {
+	m.feedTokenHash = &s
+}
+
+// FeedTokenHash returns the value of the "feedTokenHash" field in the mutation.
+func (m *ResourceMutation) FeedTokenHash() (r string, exists bool) {
+	v := m.feedTokenHash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeedTokenHash returns the old "feedTokenHash" field's value of the Resource entity.
+// If the Resource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResourceMutation) OldFeedTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldFeedTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldFeedTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeedTokenHash: %w", err)
+	}
+	return oldValue.FeedTokenHash, nil
+}
+
+// ClearFeedTokenHash clears the value of the "feedTokenHash" field.
+func (m *ResourceMutation) ClearFeedTokenHash() {
+	m.feedTokenHash = nil
+	m.clearedFields[resource.FieldFeedTokenHash] = struct{}{}
+}
+
+// FeedTokenHashCleared returns if the "feedTokenHash" field was cleared in this mutation.
+func (m *ResourceMutation) FeedTokenHashCleared() bool {
+	_, ok := m.clearedFields[resource.FieldFeedTokenHash]
+	return ok
+}
+
+// ResetFeedTokenHash resets all changes to the "feedTokenHash" field.
+func (m *ResourceMutation) ResetFeedTokenHash() {
+	m.feedTokenHash = nil
+	delete(m.clearedFields, resource.FieldFeedTokenHash)
+}
+
+// SetTaxRateId sets the "taxRateId" field.
+func (m *ResourceMutation) SetTaxRateId(i int) {
+	m.taxRate = &i
+}
+
+// TaxRateId returns the value of the "taxRateId" field in the mutation.
+func (m *ResourceMutation) TaxRateId() (r int, exists bool) {
+	v := m.taxRate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaxRateId returns the old "taxRateId" field's value of the Resource entity.
+// If the Resource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResourceMutation) OldTaxRateId(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTaxRateId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTaxRateId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaxRateId: %w", err)
+	}
+	return oldValue.TaxRateId, nil
+}
+
+// ClearTaxRateId clears the value of the "taxRateId" field.
+func (m *ResourceMutation) ClearTaxRateId() {
+	m.taxRate = nil
+	m.clearedFields[resource.FieldTaxRateId] = struct{}{}
+}
+
+// TaxRateIdCleared returns if the "taxRateId" field was cleared in this mutation.
+func (m *ResourceMutation) TaxRateIdCleared() bool {
+	_, ok := m.clearedFields[resource.FieldTaxRateId]
+	return ok
+}
+
+// ResetTaxRateId resets all changes to the "taxRateId" field.
+func (m *ResourceMutation) ResetTaxRateId() {
+	m.taxRate = nil
+	delete(m.clearedFields, resource.FieldTaxRateId)
+}
+
+// AddSlotIDs adds the "slots" edge to the Slot entity by ids.
+func (m *ResourceMutation) AddSlotIDs(ids ...int) {
+	if m.slots == nil {
+		m.slots = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.slots[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSlots clears the "slots" edge to the Slot entity.
+func (m *ResourceMutation) ClearSlots() {
+	m.clearedslots = true
+}
+
+// SlotsCleared reports if the "slots" edge to the Slot entity was cleared.
+func (m *ResourceMutation) SlotsCleared() bool {
+	return m.clearedslots
+}
+
+// RemoveSlotIDs removes the "slots" edge to the Slot entity by IDs.
+func (m *ResourceMutation) RemoveSlotIDs(ids ...int) {
+	if m.removedslots == nil {
+		m.removedslots = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.slots, ids[i])
+		m.removedslots[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSlots returns the removed IDs of the "slots" edge to the Slot entity.
+func (m *ResourceMutation) RemovedSlotsIDs() (ids []int) {
+	for id := range m.removedslots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SlotsIDs returns the "slots" edge IDs in the mutation.
+func (m *ResourceMutation) SlotsIDs() (ids []int) {
+	for id := range m.slots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSlots resets all changes to the "slots" edge.
+func (m *ResourceMutation) ResetSlots() {
+	m.slots = nil
+	m.clearedslots = false
+	m.removedslots = nil
+}
+
+// AddBookingIDs adds the "bookings" edge to the Booking entity by ids.
+func (m *ResourceMutation) AddBookingIDs(ids ...int) {
+	if m.bookings == nil {
+		m.bookings = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.bookings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBookings clears the "bookings" edge to the Booking entity.
+func (m *ResourceMutation) ClearBookings() {
+	m.clearedbookings = true
+}
+
+// BookingsCleared reports if the "bookings" edge to the Booking entity was cleared.
+func (m *ResourceMutation) BookingsCleared() bool {
+	return m.clearedbookings
+}
+
+// RemoveBookingIDs removes the "bookings" edge to the Booking entity by IDs.
+func (m *ResourceMutation) RemoveBookingIDs(ids ...int) {
+	if m.removedbookings == nil {
+		m.removedbookings = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.bookings, ids[i])
+		m.removedbookings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBookings returns the removed IDs of the "bookings" edge to the Booking entity.
+func (m *ResourceMutation) RemovedBookingsIDs() (ids []int) {
+	for id := range m.removedbookings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BookingsIDs returns the "bookings" edge IDs in the mutation.
+func (m *ResourceMutation) BookingsIDs() (ids []int) {
+	for id := range m.bookings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBookings resets all changes to the "bookings" edge.
+func (m *ResourceMutation) ResetBookings() {
+	m.bookings = nil
+	m.clearedbookings = false
+	m.removedbookings = nil
+}
+
+// AddBookingSeryIDs adds the "bookingSeries" edge to the BookingSeries entity by ids.
+func (m *ResourceMutation) AddBookingSeryIDs(ids ...int) {
+	if m.bookingSeries == nil {
+		m.bookingSeries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.bookingSeries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBookingSeries clears the "bookingSeries" edge to the BookingSeries entity.
+func (m *ResourceMutation) ClearBookingSeries() {
+	m.clearedbookingSeries = true
+}
+
+// BookingSeriesCleared reports if the "bookingSeries" edge to the BookingSeries entity was cleared.
+func (m *ResourceMutation) BookingSeriesCleared() bool {
+	return m.clearedbookingSeries
+}
+
+// RemoveBookingSeryIDs removes the "bookingSeries" edge to the BookingSeries entity by IDs.
+func (m *ResourceMutation) RemoveBookingSeryIDs(ids ...int) {
+	if m.removedbookingSeries == nil {
+		m.removedbookingSeries = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.bookingSeries, ids[i])
+		m.removedbookingSeries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBookingSeries returns the removed IDs of the "bookingSeries" edge to the BookingSeries entity.
+func (m *ResourceMutation) RemovedBookingSeriesIDs() (ids []int) {
+	for id := range m.removedbookingSeries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BookingSeriesIDs returns the "bookingSeries" edge IDs in the mutation.
+func (m *ResourceMutation) BookingSeriesIDs() (ids []int) {
+	for id := range m.bookingSeries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBookingSeries resets all changes to the "bookingSeries" edge.
+func (m *ResourceMutation) ResetBookingSeries() {
+	m.bookingSeries = nil
+	m.clearedbookingSeries = false
+	m.removedbookingSeries = nil
+}
+
+// AddUnavailabilityIDs adds the "unavailabilities" edge to the Unavailability entity by ids.
+func (m *ResourceMutation) AddUnavailabilityIDs(ids ...int) {
+	if m.unavailabilities == nil {
+		m.unavailabilities = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.unavailabilities[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUnavailabilities clears the "unavailabilities" edge to the Unavailability entity.
+func (m *ResourceMutation) ClearUnavailabilities() {
+	m.clearedunavailabilities = true
+}
+
+// UnavailabilitiesCleared reports if the "unavailabilities" edge to the Unavailability entity was cleared.
+func (m *ResourceMutation) UnavailabilitiesCleared() bool {
+	return m.clearedunavailabilities
+}
+
+// RemoveUnavailabilityIDs removes the "unavailabilities" edge to the Unavailability entity by IDs.
+func (m *ResourceMutation) RemoveUnavailabilityIDs(ids ...int) {
+	if m.removedunavailabilities == nil {
+		m.removedunavailabilities = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.unavailabilities, ids[i])
+		m.removedunavailabilities[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUnavailabilities returns the removed IDs of the "unavailabilities" edge to the Unavailability entity.
+func (m *ResourceMutation) RemovedUnavailabilitiesIDs() (ids []int) {
+	for id := range m.removedunavailabilities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UnavailabilitiesIDs returns the "unavailabilities" edge IDs in the mutation.
+func (m *ResourceMutation) UnavailabilitiesIDs() (ids []int) {
+	for id := range m.unavailabilities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUnavailabilities resets all changes to the "unavailabilities" edge.
+func (m *ResourceMutation) ResetUnavailabilities() {
+	m.unavailabilities = nil
+	m.clearedunavailabilities = false
+	m.removedunavailabilities = nil
+}
+
+// AddWaitlistEntryIDs adds the "waitlistEntries" edge to the WaitlistEntry entity by ids.
+func (m *ResourceMutation) AddWaitlistEntryIDs(ids ...int) {
+	if m.waitlistEntries == nil {
+		m.waitlistEntries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.waitlistEntries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWaitlistEntries clears the "waitlistEntries" edge to the WaitlistEntry entity.
+func (m *ResourceMutation) ClearWaitlistEntries() {
+	m.clearedwaitlistEntries = true
+}
+
+// WaitlistEntriesCleared reports if the "waitlistEntries" edge to the WaitlistEntry entity was cleared.
+func (m *ResourceMutation) WaitlistEntriesCleared() bool {
+	return m.clearedwaitlistEntries
+}
+
+// RemoveWaitlistEntryIDs removes the "waitlistEntries" edge to the WaitlistEntry entity by IDs.
+func (m *ResourceMutation) RemoveWaitlistEntryIDs(ids ...int) {
+	if m.removedwaitlistEntries == nil {
+		m.removedwaitlistEntries = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.waitlistEntries, ids[i])
+		m.removedwaitlistEntries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWaitlistEntries returns the removed IDs of the "waitlistEntries" edge to the WaitlistEntry entity.
+func (m *ResourceMutation) RemovedWaitlistEntriesIDs() (ids []int) {
+	for id := range m.removedwaitlistEntries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WaitlistEntriesIDs returns the "waitlistEntries" edge IDs in the mutation.
+func (m *ResourceMutation) WaitlistEntriesIDs() (ids []int) {
+	for id := range m.waitlistEntries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWaitlistEntries resets all changes to the "waitlistEntries" edge.
+func (m *ResourceMutation) ResetWaitlistEntries() {
+	m.waitlistEntries = nil
+	m.clearedwaitlistEntries = false
+	m.removedwaitlistEntries = nil
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by id.
+func (m *ResourceMutation) SetOrganizationID(id int) {
+	m.organization = &id
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *ResourceMutation) ClearOrganization() {
+	m.clearedorganization = true
+}
+
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *ResourceMutation) OrganizationCleared() bool {
+	return m.clearedorganization
+}
+
+// OrganizationID returns the "organization" edge ID in the mutation.
+func (m *ResourceMutation) OrganizationID() (id int, exists bool) {
+	if m.organization != nil {
+		return *m.organization, true
+	}
+	return
+}
+
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *ResourceMutation) OrganizationIDs() (ids []int) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *ResourceMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
+}
+
+// SetTaxRateID sets the "taxRate" edge to the TaxRate entity by id.
+func (m *ResourceMutation) SetTaxRateID(id int) {
+	m.taxRate = &id
+}
+
+// ClearTaxRate clears the "taxRate" edge to the TaxRate entity.
+func (m *ResourceMutation) ClearTaxRate() {
+	m.clearedtaxRate = true
+}
+
+// TaxRateCleared reports if the "taxRate" edge to the TaxRate entity was cleared.
+func (m *ResourceMutation) TaxRateCleared() bool {
+	return m.TaxRateIdCleared() || m.clearedtaxRate
+}
+
+// TaxRateID returns the "taxRate" edge ID in the mutation.
+func (m *ResourceMutation) TaxRateID() (id int, exists bool) {
+	if m.taxRate != nil {
+		return *m.taxRate, true
+	}
+	return
+}
+
+// TaxRateIDs returns the "taxRate" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaxRateID instead. It exists only for internal usage by the builders.
+func (m *ResourceMutation) TaxRateIDs() (ids []int) {
+	if id := m.taxRate; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTaxRate resets all changes to the "taxRate" edge.
+func (m *ResourceMutation) ResetTaxRate() {
+	m.taxRate = nil
+	m.clearedtaxRate = false
+}
+
+// Where appends a list predicates to the ResourceMutation builder.
+func (m *ResourceMutation) Where(ps ...predicate.Resource) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *ResourceMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Resource).
+func (m *ResourceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ResourceMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.createdAt != nil {
+		fields = append(fields, resource.FieldCreatedAt)
+	}
+	if m.updatedAt != nil {
+		fields = append(fields, resource.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, resource.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, resource.FieldDescription)
+	}
+	if m.timezone != nil {
+		fields = append(fields, resource.FieldTimezone)
+	}
+	if m.passwordHash != nil {
+		fields = append(fields, resource.FieldPasswordHash)
+	}
+	if m.price != nil {
+		fields = append(fields, resource.FieldPrice)
+	}
+	if m.bookingPrice != nil {
+		fields = append(fields, resource.FieldBookingPrice)
+	}
+	if m.currency != nil {
+		fields = append(fields, resource.FieldCurrency)
+	}
+	if m.organization != nil {
+		fields = append(fields, resource.FieldOrganizationId)
+	}
+	if m.quantityAvailable != nil {
+		fields = append(fields, resource.FieldQuantityAvailable)
+	}
+	if m.cancellationPolicy != nil {
+		fields = append(fields, resource.FieldCancellationPolicy)
+	}
+	if m.pricing != nil {
+		fields = append(fields, resource.FieldPricing)
+	}
+	if m.bookingFields != nil {
+		fields = append(fields, resource.FieldBookingFields)
+	}
+	if m.feedTokenHash != nil {
+		fields = append(fields, resource.FieldFeedTokenHash)
+	}
+	if m.taxRate != nil {
+		fields = append(fields, resource.FieldTaxRateId)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ResourceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case resource.FieldCreatedAt:
+		return m.CreatedAt()
+	case resource.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case resource.FieldName:
+		return m.Name()
+	case resource.FieldDescription:
+		return m.Description()
+	case resource.FieldTimezone:
+		return m.Timezone()
+	case resource.FieldPasswordHash:
+		return m.PasswordHash()
+	case resource.FieldPrice:
+		return m.Price()
+	case resource.FieldBookingPrice:
+		return m.BookingPrice()
+	case resource.FieldCurrency:
+		return m.Currency()
+	case resource.FieldOrganizationId:
+		return m.OrganizationId()
+	case resource.FieldQuantityAvailable:
+		return m.QuantityAvailable()
+	case resource.FieldCancellationPolicy:
+		return m.CancellationPolicy()
+	case resource.FieldPricing:
+		return m.Pricing()
+	case resource.FieldBookingFields:
+		return m.BookingFields()
+	case resource.FieldFeedTokenHash:
+		return m.FeedTokenHash()
+	case resource.FieldTaxRateId:
+		return m.TaxRateId()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ResourceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case resource.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case resource.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case resource.FieldName:
+		return m.OldName(ctx)
+	case resource.FieldDescription:
+		return m.OldDescription(ctx)
+	case resource.FieldTimezone:
+		return m.OldTimezone(ctx)
+	case resource.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case resource.FieldPrice:
+		return m.OldPrice(ctx)
+	case resource.FieldBookingPrice:
+		return m.OldBookingPrice(ctx)
+	case resource.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case resource.FieldOrganizationId:
+		return m.OldOrganizationId(ctx)
+	case resource.FieldQuantityAvailable:
+		return m.OldQuantityAvailable(ctx)
+	case resource.FieldCancellationPolicy:
+		return m.OldCancellationPolicy(ctx)
+	case resource.FieldPricing:
+		return m.OldPricing(ctx)
+	case resource.FieldBookingFields:
+		return m.OldBookingFields(ctx)
+	case resource.FieldFeedTokenHash:
+		return m.OldFeedTokenHash(ctx)
+	case resource.FieldTaxRateId:
+		return m.OldTaxRateId(ctx)
+	}
+	return nil, fmt.Errorf("unknown Resource field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ResourceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case resource.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case resource.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case resource.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case resource.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case resource.FieldTimezone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimezone(v)
+		return nil
+	case resource.FieldPasswordHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordHash(v)
+		return nil
+	case resource.FieldPrice:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case resource.FieldBookingPrice:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBookingPrice(v)
+		return nil
+	case resource.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case resource.FieldOrganizationId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationId(v)
+		return nil
+	case resource.FieldQuantityAvailable:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantityAvailable(v)
+		return nil
+	case resource.FieldCancellationPolicy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCancellationPolicy(v)
+		return nil
+	case resource.FieldPricing:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPricing(v)
+		return nil
+	case resource.FieldBookingFields:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBookingFields(v)
+		return nil
+	case resource.FieldFeedTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeedTokenHash(v)
+		return nil
+	case resource.FieldTaxRateId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaxRateId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Resource field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ResourceMutation) AddedFields() []string {
+	var fields []string
+	if m.addprice != nil {
+		fields = append(fields, resource.FieldPrice)
+	}
+	if m.addbookingPrice != nil {
+		fields = append(fields, resource.FieldBookingPrice)
+	}
+	if m.addquantityAvailable != nil {
+		fields = append(fields, resource.FieldQuantityAvailable)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ResourceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case resource.FieldPrice:
+		return m.AddedPrice()
+	case resource.FieldBookingPrice:
+		return m.AddedBookingPrice()
+	case resource.FieldQuantityAvailable:
+		return m.AddedQuantityAvailable()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ResourceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case resource.FieldPrice:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrice(v)
+		return nil
+	case resource.FieldBookingPrice:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBookingPrice(v)
+		return nil
+	case resource.FieldQuantityAvailable:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantityAvailable(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Resource numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ResourceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(resource.FieldQuantityAvailable) {
+		fields = append(fields, resource.FieldQuantityAvailable)
+	}
+	if m.FieldCleared(resource.FieldCancellationPolicy) {
+		fields = append(fields, resource.FieldCancellationPolicy)
+	}
+	if m.FieldCleared(resource.FieldPricing) {
+		fields = append(fields, resource.FieldPricing)
+	}
+	if m.FieldCleared(resource.FieldBookingFields) {
+		fields = append(fields, resource.FieldBookingFields)
+	}
+	if m.FieldCleared(resource.FieldFeedTokenHash) {
+		fields = append(fields, resource.FieldFeedTokenHash)
+	}
+	if m.FieldCleared(resource.FieldTaxRateId) {
+		fields = append(fields, resource.FieldTaxRateId)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ResourceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ResourceMutation) ClearField(name string) error {
+	switch name {
+	case resource.FieldQuantityAvailable:
+		m.ClearQuantityAvailable()
+		return nil
+	case resource.FieldCancellationPolicy:
+		m.ClearCancellationPolicy()
+		return nil
+	case resource.FieldPricing:
+		m.ClearPricing()
+		return nil
+	case resource.FieldBookingFields:
+		m.ClearBookingFields()
+		return nil
+	case resource.FieldFeedTokenHash:
+		m.ClearFeedTokenHash()
+		return nil
+	case resource.FieldTaxRateId:
+		m.ClearTaxRateId()
+		return nil
+	}
+	return fmt.Errorf("unknown Resource nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ResourceMutation) ResetField(name string) error {
+	switch name {
+	case resource.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case resource.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case resource.FieldName:
+		m.ResetName()
+		return nil
+	case resource.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case resource.FieldTimezone:
+		m.ResetTimezone()
+		return nil
+	case resource.FieldPasswordHash:
+		m.ResetPasswordHash()
+		return nil
+	case resource.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case resource.FieldBookingPrice:
+		m.ResetBookingPrice()
+		return nil
+	case resource.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case resource.FieldOrganizationId:
+		m.ResetOrganizationId()
+		return nil
+	case resource.FieldQuantityAvailable:
+		m.ResetQuantityAvailable()
+		return nil
+	case resource.FieldCancellationPolicy:
+		m.ResetCancellationPolicy()
+		return nil
+	case resource.FieldPricing:
+		m.ResetPricing()
+		return nil
+	case resource.FieldBookingFields:
+		m.ResetBookingFields()
+		return nil
+	case resource.FieldFeedTokenHash:
+		m.ResetFeedTokenHash()
+		return nil
+	case resource.FieldTaxRateId:
+		m.ResetTaxRateId()
+		return nil
+	}
+	return fmt.Errorf("unknown Resource field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ResourceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.slots != nil {
+		edges = append(edges, resource.EdgeSlots)
+	}
+	if m.bookings != nil {
+		edges = append(edges, resource.EdgeBookings)
+	}
+	if m.bookingSeries != nil {
+		edges = append(edges, resource.EdgeBookingSeries)
+	}
+	if m.unavailabilities != nil {
+		edges = append(edges, resource.EdgeUnavailabilities)
+	}
+	if m.waitlistEntries != nil {
+		edges = append(edges, resource.EdgeWaitlistEntries)
+	}
+	if m.organization != nil {
+		edges = append(edges, resource.EdgeOrganization)
+	}
+	if m.taxRate != nil {
+		edges = append(edges, resource.EdgeTaxRate)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ResourceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case resource.EdgeSlots:
+		ids := make([]ent.Value, 0, len(m.slots))
+		for id := range m.slots {
+			ids = append(ids, id)
+		}
+		return ids
+	case resource.EdgeBookings:
+		ids := make([]ent.Value, 0, len(m.bookings))
+		for id := range m.bookings {
+			ids = append(ids, id)
+		}
+		return ids
+	case resource.EdgeBookingSeries:
+		ids := make([]ent.Value, 0, len(m.bookingSeries))
+		for id := range m.bookingSeries {
+			ids = append(ids, id)
+		}
+		return ids
+	case resource.EdgeUnavailabilities:
+		ids := make([]ent.Value, 0, len(m.unavailabilities))
+		for id := range m.unavailabilities {
+			ids = append(ids, id)
+		}
+		return ids
+	case resource.EdgeWaitlistEntries:
+		ids := make([]ent.Value, 0, len(m.waitlistEntries))
+		for id := range m.waitlistEntries {
+			ids = append(ids, id)
+		}
+		return ids
+	case resource.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
+		}
+	case resource.EdgeTaxRate:
+		if id := m.taxRate; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ResourceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removedslots != nil {
+		edges = append(edges, resource.EdgeSlots)
+	}
+	if m.removedbookings != nil {
+		edges = append(edges, resource.EdgeBookings)
+	}
+	if m.removedbookingSeries != nil {
+		edges = append(edges, resource.EdgeBookingSeries)
+	}
+	if m.removedunavailabilities != nil {
+		edges = append(edges, resource.EdgeUnavailabilities)
+	}
+	if m.removedwaitlistEntries != nil {
+		edges = append(edges, resource.EdgeWaitlistEntries)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ResourceMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case resource.EdgeSlots:
+		ids := make([]ent.Value, 0, len(m.removedslots))
+		for id := range m.removedslots {
+			ids = append(ids, id)
+		}
+		return ids
+	case resource.EdgeBookings:
+		ids := make([]ent.Value, 0, len(m.removedbookings))
+		for id := range m.removedbookings {
+			ids = append(ids, id)
+		}
+		return ids
+	case resource.EdgeBookingSeries:
+		ids := make([]ent.Value, 0, len(m.removedbookingSeries))
+		for id := range m.removedbookingSeries {
+			ids = append(ids, id)
+		}
+		return ids
+	case resource.EdgeUnavailabilities:
+		ids := make([]ent.Value, 0, len(m.removedunavailabilities))
+		for id := range m.removedunavailabilities {
+			ids = append(ids, id)
+		}
+		return ids
+	case resource.EdgeWaitlistEntries:
+		ids := make([]ent.Value, 0, len(m.removedwaitlistEntries))
+		for id := range m.removedwaitlistEntries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ResourceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.clearedslots {
+		edges = append(edges, resource.EdgeSlots)
+	}
+	if m.clearedbookings {
+		edges = append(edges, resource.EdgeBookings)
+	}
+	if m.clearedbookingSeries {
+		edges = append(edges, resource.EdgeBookingSeries)
+	}
+	if m.clearedunavailabilities {
+		edges = append(edges, resource.EdgeUnavailabilities)
+	}
+	if m.clearedwaitlistEntries {
+		edges = append(edges, resource.EdgeWaitlistEntries)
+	}
+	if m.clearedorganization {
+		edges = append(edges, resource.EdgeOrganization)
+	}
+	if m.clearedtaxRate {
+		edges = append(edges, resource.EdgeTaxRate)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ResourceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case resource.EdgeSlots:
+		return m.clearedslots
+	case resource.EdgeBookings:
+		return m.clearedbookings
+	case resource.EdgeBookingSeries:
+		return m.clearedbookingSeries
+	case resource.EdgeUnavailabilities:
+		return m.clearedunavailabilities
+	case resource.EdgeWaitlistEntries:
+		return m.clearedwaitlistEntries
+	case resource.EdgeOrganization:
+		return m.clearedorganization
+	case resource.EdgeTaxRate:
+		return m.clearedtaxRate
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ResourceMutation) ClearEdge(name string) error {
+	switch name {
+	case resource.EdgeOrganization:
+		m.ClearOrganization()
+		return nil
+	case resource.EdgeTaxRate:
+		m.ClearTaxRate()
+		return nil
+	}
+	return fmt.Errorf("unknown Resource unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ResourceMutation) ResetEdge(name string) error {
+	switch name {
+	case resource.EdgeSlots:
+		m.ResetSlots()
+		return nil
+	case resource.EdgeBookings:
+		m.ResetBookings()
+		return nil
+	case resource.EdgeBookingSeries:
+		m.ResetBookingSeries()
+		return nil
+	case resource.EdgeUnavailabilities:
+		m.ResetUnavailabilities()
+		return nil
+	case resource.EdgeWaitlistEntries:
+		m.ResetWaitlistEntries()
+		return nil
+	case resource.EdgeOrganization:
+		m.ResetOrganization()
+		return nil
+	case resource.EdgeTaxRate:
+		m.ResetTaxRate()
+		return nil
+	}
+	return fmt.Errorf("unknown Resource edge %s", name)
+}
+
+// SlotMutation represents an operation that mutates the Slot nodes in the graph.
+type SlotMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	day             *string
+	startTime       *string
+	endTime         *string
+	quantity        *int
+	addquantity     *int
+	clearedFields   map[string]struct{}
+	resource        *int
+	clearedresource bool
+	done            bool
+	oldValue        func(context.Context) (*Slot, error)
+	predicates      []predicate.Slot
+}
+
+var _ ent.Mutation = (*SlotMutation)(nil)
+
+// slotOption allows management of the mutation configuration using functional options.
+type slotOption func(*SlotMutation)
+
+// newSlotMutation creates new mutation for the Slot entity.
+func newSlotMutation(c config, op Op, opts ...slotOption) *SlotMutation {
+	m := &SlotMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSlot,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSlotID sets the ID field of the mutation.
+func withSlotID(id int) slotOption {
+	return func(m *SlotMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Slot
+		)
+		m.oldValue = func(ctx context.Context) (*Slot, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Slot.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSlot sets the old Slot of the mutation.
+func withSlot(node *Slot) slotOption {
+	return func(m *SlotMutation) {
+		m.oldValue = func(context.Context) (*Slot, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SlotMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SlotMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SlotMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetDay sets the "day" field.
+func (m *SlotMutation) SetDay(s string) {
+	m.day = &s
+}
+
+// Day returns the value of the "day" field in the mutation.
+func (m *SlotMutation) Day() (r string, exists bool) {
+	v := m.day
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDay returns the old "day" field's value of the Slot entity.
+// If the Slot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlotMutation) OldDay(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldDay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldDay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDay: %w", err)
+	}
+	return oldValue.Day, nil
+}
+
+// ResetDay resets all changes to the "day" field.
+func (m *SlotMutation) ResetDay() {
+	m.day = nil
+}
+
+// SetStartTime sets the "startTime" field.
+func (m *SlotMutation) SetStartTime(s string) {
+	m.startTime = &s
+}
+
+// StartTime returns the value of the "startTime" field in the mutation.
+func (m *SlotMutation) StartTime() (r string, exists bool) {
+	v := m.startTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartTime returns the old "startTime" field's value of the Slot entity.
+// If the Slot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlotMutation) OldStartTime(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldStartTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldStartTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+	}
+	return oldValue.StartTime, nil
+}
+
+// ResetStartTime resets all changes to the "startTime" field.
+func (m *SlotMutation) ResetStartTime() {
+	m.startTime = nil
+}
+
+// SetEndTime sets the "endTime" field.
+func (m *SlotMutation) SetEndTime(s string) {
+	m.endTime = &s
+}
+
+// EndTime returns the value of the "endTime" field in the mutation.
+func (m *SlotMutation) EndTime() (r string, exists bool) {
+	v := m.endTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndTime returns the old "endTime" field's value of the Slot entity.
+// If the Slot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlotMutation) OldEndTime(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldEndTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldEndTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+	}
+	return oldValue.EndTime, nil
+}
+
+// ResetEndTime resets all changes to the "endTime" field.
+func (m *SlotMutation) ResetEndTime() {
+	m.endTime = nil
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *SlotMutation) SetQuantity(i int) {
+	m.quantity = &i
+	m.addquantity = nil
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *SlotMutation) Quantity() (r int, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantity returns the old "quantity" field's value of the Slot entity.
+// If the Slot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlotMutation) OldQuantity(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (m *SlotMutation) AddQuantity(i int) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
+	}
+}
+
+// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
+func (m *SlotMutation) AddedQuantity() (r int, exists bool) {
+	v := m.addquantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearQuantity clears the value of the "quantity" field.
+func (m *SlotMutation) ClearQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
+	m.clearedFields[slot.FieldQuantity] = struct{}{}
+}
+
+// QuantityCleared returns if the "quantity" field was cleared in this mutation.
+func (m *SlotMutation) QuantityCleared() bool {
+	_, ok := m.clearedFields[slot.FieldQuantity]
+	return ok
+}
+
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *SlotMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
+	delete(m.clearedFields, slot.FieldQuantity)
+}
+
+// SetResourceId sets the "resourceId" field.
+func (m *SlotMutation) SetResourceId(i int) {
+	m.resource = &i
+}
+
+// ResourceId returns the value of the "resourceId" field in the mutation.
+func (m *SlotMutation) ResourceId() (r int, exists bool) {
+	v := m.resource
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceId returns the old "resourceId" field's value of the Slot entity.
+// If the Slot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlotMutation) OldResourceId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldResourceId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldResourceId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceId: %w", err)
+	}
+	return oldValue.ResourceId, nil
+}
+
+// ResetResourceId resets all changes to the "resourceId" field.
+func (m *SlotMutation) ResetResourceId() {
+	m.resource = nil
+}
+
+// SetResourceID sets the "resource" edge to the Resource entity by id.
+func (m *SlotMutation) SetResourceID(id int) {
+	m.resource = &id
+}
+
+// ClearResource clears the "resource" edge to the Resource entity.
+func (m *SlotMutation) ClearResource() {
+	m.clearedresource = true
+}
+
+// ResourceCleared reports if the "resource" edge to the Resource entity was cleared.
+func (m *SlotMutation) ResourceCleared() bool {
+	return m.clearedresource
+}
+
+// ResourceID returns the "resource" edge ID in the mutation.
+func (m *SlotMutation) ResourceID() (id int, exists bool) {
+	if m.resource != nil {
+		return *m.resource, true
+	}
+	return
+}
+
+// ResourceIDs returns the "resource" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ResourceID instead. It exists only for internal usage by the builders.
+func (m *SlotMutation) ResourceIDs() (ids []int) {
+	if id := m.resource; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetResource resets all changes to the "resource" edge.
+func (m *SlotMutation) ResetResource() {
+	m.resource = nil
+	m.clearedresource = false
+}
+
+// Where appends a list predicates to the SlotMutation builder.
+func (m *SlotMutation) Where(ps ...predicate.Slot) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *SlotMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Slot).
+func (m *SlotMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SlotMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.day != nil {
+		fields = append(fields, slot.FieldDay)
+	}
+	if m.startTime != nil {
+		fields = append(fields, slot.FieldStartTime)
+	}
+	if m.endTime != nil {
+		fields = append(fields, slot.FieldEndTime)
+	}
+	if m.quantity != nil {
+		fields = append(fields, slot.FieldQuantity)
+	}
+	if m.resource != nil {
+		fields = append(fields, slot.FieldResourceId)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SlotMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case slot.FieldDay:
+		return m.Day()
+	case slot.FieldStartTime:
+		return m.StartTime()
+	case slot.FieldEndTime:
+		return m.EndTime()
+	case slot.FieldQuantity:
+		return m.Quantity()
+	case slot.FieldResourceId:
+		return m.ResourceId()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SlotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case slot.FieldDay:
+		return m.OldDay(ctx)
+	case slot.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case slot.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case slot.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case slot.FieldResourceId:
+		return m.OldResourceId(ctx)
+	}
+	return nil, fmt.Errorf("unknown Slot field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SlotMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case slot.FieldDay:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDay(v)
+		return nil
+	case slot.FieldStartTime:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartTime(v)
+		return nil
+	case slot.FieldEndTime:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndTime(v)
+		return nil
+	case slot.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case slot.FieldResourceId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Slot field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SlotMutation) AddedFields() []string {
+	var fields []string
+	if m.addquantity != nil {
+		fields = append(fields, slot.FieldQuantity)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SlotMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case slot.FieldQuantity:
+		return m.AddedQuantity()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SlotMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case slot.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantity(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Slot numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SlotMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(slot.FieldQuantity) {
+		fields = append(fields, slot.FieldQuantity)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SlotMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SlotMutation) ClearField(name string) error {
+	switch name {
+	case slot.FieldQuantity:
+		m.ClearQuantity()
+		return nil
+	}
+	return fmt.Errorf("unknown Slot nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SlotMutation) ResetField(name string) error {
+	switch name {
+	case slot.FieldDay:
+		m.ResetDay()
+		return nil
+	case slot.FieldStartTime:
+		m.ResetStartTime()
+		return nil
+	case slot.FieldEndTime:
+		m.ResetEndTime()
+		return nil
+	case slot.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case slot.FieldResourceId:
+		m.ResetResourceId()
+		return nil
+	}
+	return fmt.Errorf("unknown Slot field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SlotMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.resource != nil {
+		edges = append(edges, slot.EdgeResource)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SlotMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case slot.EdgeResource:
+		if id := m.resource; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SlotMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SlotMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SlotMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedresource {
+		edges = append(edges, slot.EdgeResource)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SlotMutation) EdgeCleared(name string) bool {
+	switch name {
+	case slot.EdgeResource:
+		return m.clearedresource
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SlotMutation) ClearEdge(name string) error {
+	switch name {
+	case slot.EdgeResource:
+		m.ClearResource()
+		return nil
+	}
+	return fmt.Errorf("unknown Slot unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SlotMutation) ResetEdge(name string) error {
+	switch name {
+	case slot.EdgeResource:
+		m.ResetResource()
+		return nil
+	}
+	return fmt.Errorf("unknown Slot edge %s", name)
+}
+
+// TaxRateMutation represents an operation that mutates the TaxRate nodes in the graph.
+type TaxRateMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	createdAt           *time.Time
+	updatedAt           *time.Time
+	name                *string
+	rate                *int
+	addrate             *int
+	inclusive           *bool
+	isDefault           *bool
+	clearedFields       map[string]struct{}
+	organization        *int
+	clearedorganization bool
+	resources           map[int]struct{}
+	removedresources    map[int]struct{}
+	clearedresources    bool
+	done                bool
+	oldValue            func(context.Context) (*TaxRate, error)
+	predicates          []predicate.TaxRate
+}
+
+var _ ent.Mutation = (*TaxRateMutation)(nil)
+
+// taxrateOption allows management of the mutation configuration using functional options.
+type taxrateOption func(*TaxRateMutation)
+
+// newTaxRateMutation creates new mutation for the TaxRate entity.
+func newTaxRateMutation(c config, op Op, opts ...taxrateOption) *TaxRateMutation {
+	m := &TaxRateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTaxRate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTaxRateID sets the ID field of the mutation.
+func withTaxRateID(id int) taxrateOption {
+	return func(m *TaxRateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TaxRate
+		)
+		m.oldValue = func(ctx context.Context) (*TaxRate, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TaxRate.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTaxRate sets the old TaxRate of the mutation.
+func withTaxRate(node *TaxRate) taxrateOption {
+	return func(m *TaxRateMutation) {
+		m.oldValue = func(context.Context) (*TaxRate, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaxRateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaxRateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaxRateMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (m *TaxRateMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
+}
+
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *TaxRateMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "createdAt" field's value of the TaxRate entity.
+// If the TaxRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaxRateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *TaxRateMutation) ResetCreatedAt() {
+	m.createdAt = nil
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (m *TaxRateMutation) SetUpdatedAt(t time.Time) {
+	m.updatedAt = &t
+}
+
+// UpdatedAt returns the value of the "updatedAt" field in the mutation.
+func (m *TaxRateMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updatedAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updatedAt" field's value of the TaxRate entity.
+// If the TaxRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaxRateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updatedAt" field.
+func (m *TaxRateMutation) ResetUpdatedAt() {
+	m.updatedAt = nil
+}
+
+// SetName sets the "name" field.
+func (m *TaxRateMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TaxRateMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the TaxRate entity.
+// If the TaxRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaxRateMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TaxRateMutation) ResetName() {
+	m.name = nil
+}
+
+// SetRate sets the "rate" field.
+func (m *TaxRateMutation) SetRate(i int) {
+	m.rate = &i
+	m.addrate = nil
+}
+
+// Rate returns the value of the "rate" field in the mutation.
+func (m *TaxRateMutation) Rate() (r int, exists bool) {
+	v := m.rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRate returns the old "rate" field's value of the TaxRate entity.
+// If the TaxRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaxRateMutation) OldRate(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRate: %w", err)
+	}
+	return oldValue.Rate, nil
+}
+
+// AddRate adds i to the "rate" field.
+func (m *TaxRateMutation) AddRate(i int) {
+	if m.addrate != nil {
+		*m.addrate += i
+	} else {
+		m.addrate = &i
+	}
+}
+
+// AddedRate returns the value that was added to the "rate" field in this mutation.
+func (m *TaxRateMutation) AddedRate() (r int, exists bool) {
+	v := m.addrate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRate resets all changes to the "rate" field.
+func (m *TaxRateMutation) ResetRate() {
+	m.rate = nil
+	m.addrate = nil
+}
+
+// SetInclusive sets the "inclusive" field.
+func (m *TaxRateMutation) SetInclusive(b bool) {
+	m.inclusive = &b
+}
+
+// Inclusive returns the value of the "inclusive" field in the mutation.
+func (m *TaxRateMutation) Inclusive() (r bool, exists bool) {
+	v := m.inclusive
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInclusive returns the old "inclusive" field's value of the TaxRate entity.
+// If the TaxRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaxRateMutation) OldInclusive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldInclusive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldInclusive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInclusive: %w", err)
+	}
+	return oldValue.Inclusive, nil
+}
+
+// ResetInclusive resets all changes to the "inclusive" field.
+func (m *TaxRateMutation) ResetInclusive() {
+	m.inclusive = nil
+}
+
+// SetIsDefault sets the "isDefault" field.
+func (m *TaxRateMutation) SetIsDefault(b bool) {
+	m.isDefault = &b
+}
+
+// IsDefault returns the value of the "isDefault" field in the mutation.
+func (m *TaxRateMutation) IsDefault() (r bool, exists bool) {
+	v := m.isDefault
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsDefault returns the old "isDefault" field's value of the TaxRate entity.
+// If the TaxRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaxRateMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldIsDefault is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldIsDefault requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
+	}
+	return oldValue.IsDefault, nil
+}
+
+// ResetIsDefault resets all changes to the "isDefault" field.
+func (m *TaxRateMutation) ResetIsDefault() {
+	m.isDefault = nil
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (m *TaxRateMutation) SetOrganizationId(i int) {
+	m.organization = &i
+}
+
+// OrganizationId returns the value of the "organizationId" field in the mutation.
+func (m *TaxRateMutation) OrganizationId() (r int, exists bool) {
+	v := m.organization
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationId returns the old "organizationId" field's value of the TaxRate entity.
+// If the TaxRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaxRateMutation) OldOrganizationId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldOrganizationId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldOrganizationId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationId: %w", err)
+	}
+	return oldValue.OrganizationId, nil
+}
+
+// ResetOrganizationId resets all changes to the "organizationId" field.
+func (m *TaxRateMutation) ResetOrganizationId() {
+	m.organization = nil
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by id.
+func (m *TaxRateMutation) SetOrganizationID(id int) {
+	m.organization = &id
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *TaxRateMutation) ClearOrganization() {
+	m.clearedorganization = true
+}
+
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *TaxRateMutation) OrganizationCleared() bool {
+	return m.clearedorganization
+}
+
+// OrganizationID returns the "organization" edge ID in the mutation.
+func (m *TaxRateMutation) OrganizationID() (id int, exists bool) {
+	if m.organization != nil {
+		return *m.organization, true
+	}
+	return
+}
+
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *TaxRateMutation) OrganizationIDs() (ids []int) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *TaxRateMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
+}
+
+// AddResourceIDs adds the "resources" edge to the Resource entity by ids.
+func (m *TaxRateMutation) AddResourceIDs(ids ...int) {
+	if m.resources == nil {
+		m.resources = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.resources[ids[i]] = struct{}{}
+	}
+}
+
+// ClearResources clears the "resources" edge to the Resource entity.
+func (m *TaxRateMutation) ClearResources() {
+	m.clearedresources = true
+}
+
+// ResourcesCleared reports if the "resources" edge to the Resource entity was cleared.
+func (m *TaxRateMutation) ResourcesCleared() bool {
+	return m.clearedresources
+}
+
+// RemoveResourceIDs removes the "resources" edge to the Resource entity by IDs.
+func (m *TaxRateMutation) RemoveResourceIDs(ids ...int) {
+	if m.removedresources == nil {
+		m.removedresources = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.resources, ids[i])
+		m.removedresources[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedResources returns the removed IDs of the "resources" edge to the Resource entity.
+func (m *TaxRateMutation) RemovedResourcesIDs() (ids []int) {
+	for id := range m.removedresources {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResourcesIDs returns the "resources" edge IDs in the mutation.
+func (m *TaxRateMutation) ResourcesIDs() (ids []int) {
+	for id := range m.resources {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetResources resets all changes to the "resources" edge.
+func (m *TaxRateMutation) ResetResources() {
+	m.resources = nil
+	m.clearedresources = false
+	m.removedresources = nil
+}
+
+// Where appends a list predicates to the TaxRateMutation builder.
+func (m *TaxRateMutation) Where(ps ...predicate.TaxRate) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *TaxRateMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (TaxRate).
+func (m *TaxRateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TaxRateMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.createdAt != nil {
+		fields = append(fields, taxrate.FieldCreatedAt)
+	}
+	if m.updatedAt != nil {
+		fields = append(fields, taxrate.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, taxrate.FieldName)
+	}
+	if m.rate != nil {
+		fields = append(fields, taxrate.FieldRate)
+	}
+	if m.inclusive != nil {
+		fields = append(fields, taxrate.FieldInclusive)
+	}
+	if m.isDefault != nil {
+		fields = append(fields, taxrate.FieldIsDefault)
+	}
+	if m.organization != nil {
+		fields = append(fields, taxrate.FieldOrganizationId)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TaxRateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case taxrate.FieldCreatedAt:
+		return m.CreatedAt()
+	case taxrate.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case taxrate.FieldName:
+		return m.Name()
+	case taxrate.FieldRate:
+		return m.Rate()
+	case taxrate.FieldInclusive:
+		return m.Inclusive()
+	case taxrate.FieldIsDefault:
+		return m.IsDefault()
+	case taxrate.FieldOrganizationId:
+		return m.OrganizationId()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TaxRateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case taxrate.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case taxrate.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case taxrate.FieldName:
+		return m.OldName(ctx)
+	case taxrate.FieldRate:
+		return m.OldRate(ctx)
+	case taxrate.FieldInclusive:
+		return m.OldInclusive(ctx)
+	case taxrate.FieldIsDefault:
+		return m.OldIsDefault(ctx)
+	case taxrate.FieldOrganizationId:
+		return m.OldOrganizationId(ctx)
+	}
+	return nil, fmt.Errorf("unknown TaxRate field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaxRateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case taxrate.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case taxrate.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case taxrate.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case taxrate.FieldRate:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRate(v)
+		return nil
+	case taxrate.FieldInclusive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInclusive(v)
+		return nil
+	case taxrate.FieldIsDefault:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDefault(v)
+		return nil
+	case taxrate.FieldOrganizationId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TaxRate field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TaxRateMutation) AddedFields() []string {
+	var fields []string
+	if m.addrate != nil {
+		fields = append(fields, taxrate.FieldRate)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TaxRateMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case taxrate.FieldRate:
+		return m.AddedRate()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaxRateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case taxrate.FieldRate:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRate(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TaxRate numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TaxRateMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TaxRateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TaxRateMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TaxRate nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TaxRateMutation) ResetField(name string) error {
+	switch name {
+	case taxrate.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case taxrate.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case taxrate.FieldName:
+		m.ResetName()
+		return nil
+	case taxrate.FieldRate:
+		m.ResetRate()
+		return nil
+	case taxrate.FieldInclusive:
+		m.ResetInclusive()
+		return nil
+	case taxrate.FieldIsDefault:
+		m.ResetIsDefault()
+		return nil
+	case taxrate.FieldOrganizationId:
+		m.ResetOrganizationId()
+		return nil
+	}
+	return fmt.Errorf("unknown TaxRate field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TaxRateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.organization != nil {
+		edges = append(edges, taxrate.EdgeOrganization)
+	}
+	if m.resources != nil {
+		edges = append(edges, taxrate.EdgeResources)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TaxRateMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case taxrate.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
+		}
+	case taxrate.EdgeResources:
+		ids := make([]ent.Value, 0, len(m.resources))
+		for id := range m.resources {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TaxRateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedresources != nil {
+		edges = append(edges, taxrate.EdgeResources)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TaxRateMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case taxrate.EdgeResources:
+		ids := make([]ent.Value, 0, len(m.removedresources))
+		for id := range m.removedresources {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TaxRateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedorganization {
+		edges = append(edges, taxrate.EdgeOrganization)
+	}
+	if m.clearedresources {
+		edges = append(edges, taxrate.EdgeResources)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TaxRateMutation) EdgeCleared(name string) bool {
+	switch name {
+	case taxrate.EdgeOrganization:
+		return m.clearedorganization
+	case taxrate.EdgeResources:
+		return m.clearedresources
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TaxRateMutation) ClearEdge(name string) error {
+	switch name {
+	case taxrate.EdgeOrganization:
+		m.ClearOrganization()
+		return nil
+	}
+	return fmt.Errorf("unknown TaxRate unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TaxRateMutation) ResetEdge(name string) error {
+	switch name {
+	case taxrate.EdgeOrganization:
+		m.ResetOrganization()
+		return nil
+	case taxrate.EdgeResources:
+		m.ResetResources()
+		return nil
+	}
+	return fmt.Errorf("unknown TaxRate edge %s", name)
+}
+
+// TokenMutation represents an operation that mutates the Token nodes in the graph.
+type TokenMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *string
+	createdAt           *time.Time
+	updatedAt           *time.Time
+	name                *string
+	secretHash          *string
+	secretPrefix        *string
+	scopes              *[]string
+	expiry              *time.Time
+	lastUsedAt          *time.Time
+	revokedAt           *time.Time
+	clearedFields       map[string]struct{}
+	user                *int
+	cleareduser         bool
+	organization        *int
+	clearedorganization bool
+	done                bool
+	oldValue            func(context.Context) (*Token, error)
+	predicates          []predicate.Token
+}
+
+var _ ent.Mutation = (*TokenMutation)(nil)
+
+// tokenOption allows management of the mutation configuration using functional options.
+type tokenOption func(*TokenMutation)
+
+// newTokenMutation creates new mutation for the Token entity.
+func newTokenMutation(c config, op Op, opts ...tokenOption) *TokenMutation {
+	m := &TokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTokenID sets the ID field of the mutation.
+func withTokenID(id string) tokenOption {
+	return func(m *TokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Token
+		)
+		m.oldValue = func(ctx context.Context) (*Token, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Token.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withToken sets the old Token of the mutation.
+func withToken(node *Token) tokenOption {
+	return func(m *TokenMutation) {
+		m.oldValue = func(context.Context) (*Token, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Token entities.
+func (m *TokenMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TokenMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (m *TokenMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
+}
+
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *TokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "createdAt" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *TokenMutation) ResetCreatedAt() {
+	m.createdAt = nil
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (m *TokenMutation) SetUpdatedAt(t time.Time) {
+	m.updatedAt = &t
+}
+
+// UpdatedAt returns the value of the "updatedAt" field in the mutation.
+func (m *TokenMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updatedAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updatedAt" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updatedAt" field.
+func (m *TokenMutation) ResetUpdatedAt() {
+	m.updatedAt = nil
+}
+
+// SetName sets the "name" field.
+func (m *TokenMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TokenMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TokenMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSecretHash sets the "secretHash" field.
+func (m *TokenMutation) SetSecretHash(s string) {
+	m.secretHash = &s
+}
+
+// SecretHash returns the value of the "secretHash" field in the mutation.
+func (m *TokenMutation) SecretHash() (r string, exists bool) {
+	v := m.secretHash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecretHash returns the old "secretHash" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldSecretHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldSecretHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldSecretHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecretHash: %w", err)
+	}
+	return oldValue.SecretHash, nil
+}
+
+// ResetSecretHash resets all changes to the "secretHash" field.
+func (m *TokenMutation) ResetSecretHash() {
+	m.secretHash = nil
+}
+
+// SetSecretPrefix sets the "secretPrefix" field.
+func (m *TokenMutation) SetSecretPrefix(s string) {
+	m.secretPrefix = &s
+}
+
+// SecretPrefix returns the value of the "secretPrefix" field in the mutation.
+func (m *TokenMutation) SecretPrefix() (r string, exists bool) {
+	v := m.secretPrefix
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecretPrefix returns the old "secretPrefix" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldSecretPrefix(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldSecretPrefix is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldSecretPrefix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecretPrefix: %w", err)
+	}
+	return oldValue.SecretPrefix, nil
+}
+
+// ResetSecretPrefix resets all changes to the "secretPrefix" field.
+func (m *TokenMutation) ResetSecretPrefix() {
+	m.secretPrefix = nil
+}
+
+// SetScopes sets the "scopes" field.
+func (m *TokenMutation) SetScopes(s []string) {
+	m.scopes = &s
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *TokenMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopes returns the old "scopes" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *TokenMutation) ResetScopes() {
+	m.scopes = nil
+}
+
+// SetExpiry sets the "expiry" field.
+func (m *TokenMutation) SetExpiry(t time.Time) {
+	m.expiry = &t
+}
+
+// Expiry returns the value of the "expiry" field in the mutation.
+func (m *TokenMutation) Expiry() (r time.Time, exists bool) {
+	v := m.expiry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiry returns the old "expiry" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldExpiry(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldExpiry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldExpiry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
+	}
+	return oldValue.Expiry, nil
+}
+
+// ClearExpiry clears the value of the "expiry" field.
+func (m *TokenMutation) ClearExpiry() {
+	m.expiry = nil
+	m.clearedFields[token.FieldExpiry] = struct{}{}
+}
+
+// ExpiryCleared returns if the "expiry" field was cleared in this mutation.
+func (m *TokenMutation) ExpiryCleared() bool {
+	_, ok := m.clearedFields[token.FieldExpiry]
+	return ok
+}
+
+// ResetExpiry resets all changes to the "expiry" field.
+func (m *TokenMutation) ResetExpiry() {
+	m.expiry = nil
+	delete(m.clearedFields, token.FieldExpiry)
+}
+
+// SetLastUsedAt sets the "lastUsedAt" field.
+func (m *TokenMutation) SetLastUsedAt(t time.Time) {
+	m.lastUsedAt = &t
+}
+
+// LastUsedAt returns the value of the "lastUsedAt" field in the mutation.
+func (m *TokenMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.lastUsedAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedAt returns the old "lastUsedAt" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldLastUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
+}
+
+// ClearLastUsedAt clears the value of the "lastUsedAt" field.
+func (m *TokenMutation) ClearLastUsedAt() {
+	m.lastUsedAt = nil
+	m.clearedFields[token.FieldLastUsedAt] = struct{}{}
+}
+
+// LastUsedAtCleared returns if the "lastUsedAt" field was cleared in this mutation.
+func (m *TokenMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[token.FieldLastUsedAt]
+	return ok
+}
+
+// ResetLastUsedAt resets all changes to the "lastUsedAt" field.
+func (m *TokenMutation) ResetLastUsedAt() {
+	m.lastUsedAt = nil
+	delete(m.clearedFields, token.FieldLastUsedAt)
+}
+
+// SetRevokedAt sets the "revokedAt" field.
+func (m *TokenMutation) SetRevokedAt(t time.Time) {
+	m.revokedAt = &t
+}
+
+// RevokedAt returns the value of the "revokedAt" field in the mutation.
+func (m *TokenMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revokedAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revokedAt" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revokedAt" field.
+func (m *TokenMutation) ClearRevokedAt() {
+	m.revokedAt = nil
+	m.clearedFields[token.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revokedAt" field was cleared in this mutation.
+func (m *TokenMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[token.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revokedAt" field.
+func (m *TokenMutation) ResetRevokedAt() {
+	m.revokedAt = nil
+	delete(m.clearedFields, token.FieldRevokedAt)
+}
+
+// SetUserId sets the "userId" field.
+func (m *TokenMutation) SetUserId(i int) {
+	m.user = &i
+}
+
+// UserId returns the value of the "userId" field in the mutation.
+func (m *TokenMutation) UserId() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserId returns the old "userId" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldUserId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUserId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUserId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
+	}
+	return oldValue.UserId, nil
+}
+
+// ResetUserId resets all changes to the "userId" field.
+func (m *TokenMutation) ResetUserId() {
+	m.user = nil
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (m *TokenMutation) SetOrganizationId(i int) {
+	m.organization = &i
+}
+
+// OrganizationId returns the value of the "organizationId" field in the mutation.
+func (m *TokenMutation) OrganizationId() (r int, exists bool) {
+	v := m.organization
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationId returns the old "organizationId" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldOrganizationId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldOrganizationId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldOrganizationId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationId: %w", err)
+	}
+	return oldValue.OrganizationId, nil
+}
+
+// ResetOrganizationId resets all changes to the "organizationId" field.
+func (m *TokenMutation) ResetOrganizationId() {
+	m.organization = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *TokenMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *TokenMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *TokenMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *TokenMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *TokenMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *TokenMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by id.
+func (m *TokenMutation) SetOrganizationID(id int) {
+	m.organization = &id
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *TokenMutation) ClearOrganization() {
+	m.clearedorganization = true
+}
+
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *TokenMutation) OrganizationCleared() bool {
+	return m.clearedorganization
+}
+
+// OrganizationID returns the "organization" edge ID in the mutation.
+func (m *TokenMutation) OrganizationID() (id int, exists bool) {
+	if m.organization != nil {
+		return *m.organization, true
+	}
+	return
+}
+
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *TokenMutation) OrganizationIDs() (ids []int) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *TokenMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
+}
+
+// Where appends a list predicates to the TokenMutation builder.
+func (m *TokenMutation) Where(ps ...predicate.Token) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *TokenMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Token).
+func (m *TokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TokenMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.createdAt != nil {
+		fields = append(fields, token.FieldCreatedAt)
+	}
+	if m.updatedAt != nil {
+		fields = append(fields, token.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, token.FieldName)
+	}
+	if m.secretHash != nil {
+		fields = append(fields, token.FieldSecretHash)
+	}
+	if m.secretPrefix != nil {
+		fields = append(fields, token.FieldSecretPrefix)
+	}
+	if m.scopes != nil {
+		fields = append(fields, token.FieldScopes)
+	}
+	if m.expiry != nil {
+		fields = append(fields, token.FieldExpiry)
+	}
+	if m.lastUsedAt != nil {
+		fields = append(fields, token.FieldLastUsedAt)
+	}
+	if m.revokedAt != nil {
+		fields = append(fields, token.FieldRevokedAt)
+	}
+	if m.user != nil {
+		fields = append(fields, token.FieldUserId)
+	}
+	if m.organization != nil {
+		fields = append(fields, token.FieldOrganizationId)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case token.FieldCreatedAt:
+		return m.CreatedAt()
+	case token.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case token.FieldName:
+		return m.Name()
+	case token.FieldSecretHash:
+		return m.SecretHash()
+	case token.FieldSecretPrefix:
+		return m.SecretPrefix()
+	case token.FieldScopes:
+		return m.Scopes()
+	case token.FieldExpiry:
+		return m.Expiry()
+	case token.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case token.FieldRevokedAt:
+		return m.RevokedAt()
+	case token.FieldUserId:
+		return m.UserId()
+	case token.FieldOrganizationId:
+		return m.OrganizationId()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case token.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case token.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case token.FieldName:
+		return m.OldName(ctx)
+	case token.FieldSecretHash:
+		return m.OldSecretHash(ctx)
+	case token.FieldSecretPrefix:
+		return m.OldSecretPrefix(ctx)
+	case token.FieldScopes:
+		return m.OldScopes(ctx)
+	case token.FieldExpiry:
+		return m.OldExpiry(ctx)
+	case token.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case token.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case token.FieldUserId:
+		return m.OldUserId(ctx)
+	case token.FieldOrganizationId:
+		return m.OldOrganizationId(ctx)
+	}
+	return nil, fmt.Errorf("unknown Token field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case token.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case token.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case token.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case token.FieldSecretHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecretHash(v)
+		return nil
+	case token.FieldSecretPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecretPrefix(v)
+		return nil
+	case token.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case token.FieldExpiry:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiry(v)
+		return nil
+	case token.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case token.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case token.FieldUserId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserId(v)
+		return nil
+	case token.FieldOrganizationId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Token field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TokenMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TokenMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Token numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(token.FieldExpiry) {
+		fields = append(fields, token.FieldExpiry)
+	}
+	if m.FieldCleared(token.FieldLastUsedAt) {
+		fields = append(fields, token.FieldLastUsedAt)
+	}
+	if m.FieldCleared(token.FieldRevokedAt) {
+		fields = append(fields, token.FieldRevokedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TokenMutation) ClearField(name string) error {
+	switch name {
+	case token.FieldExpiry:
+		m.ClearExpiry()
+		return nil
+	case token.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	case token.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Token nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TokenMutation) ResetField(name string) error {
+	switch name {
+	case token.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case token.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case token.FieldName:
+		m.ResetName()
+		return nil
+	case token.FieldSecretHash:
+		m.ResetSecretHash()
+		return nil
+	case token.FieldSecretPrefix:
+		m.ResetSecretPrefix()
+		return nil
+	case token.FieldScopes:
+		m.ResetScopes()
+		return nil
+	case token.FieldExpiry:
+		m.ResetExpiry()
+		return nil
+	case token.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case token.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case token.FieldUserId:
+		m.ResetUserId()
+		return nil
+	case token.FieldOrganizationId:
+		m.ResetOrganizationId()
+		return nil
+	}
+	return fmt.Errorf("unknown Token field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, token.EdgeUser)
+	}
+	if m.organization != nil {
+		edges = append(edges, token.EdgeOrganization)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case token.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case token.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TokenMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, token.EdgeUser)
+	}
+	if m.clearedorganization {
+		edges = append(edges, token.EdgeOrganization)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case token.EdgeUser:
+		return m.cleareduser
+	case token.EdgeOrganization:
+		return m.clearedorganization
 	}
+	return false
 }
 
-// AddedPrice returns the value that was added to the "price" field in this mutation.
-func (m *ResourceMutation) AddedPrice() (r int, exists bool) {
-	v := m.addprice
-	if v == nil {
-		return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TokenMutation) ClearEdge(name string) error {
+	switch name {
+	case token.EdgeUser:
+		m.ClearUser()
+		return nil
+	case token.EdgeOrganization:
+		m.ClearOrganization()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Token unique edge %s", name)
 }
 
-// ResetPrice resets all changes to the "price" field.
-func (m *ResourceMutation) ResetPrice() {
-	m.price = nil
-	m.addprice = nil
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TokenMutation) ResetEdge(name string) error {
+	switch name {
+	case token.EdgeUser:
+		m.ResetUser()
+		return nil
+	case token.EdgeOrganization:
+		m.ResetOrganization()
+		return nil
+	}
+	return fmt.Errorf("unknown Token edge %s", name)
 }
 
-// SetBookingPrice sets the "bookingPrice" field.
-func (m *ResourceMutation) SetBookingPrice(i int) {
-	m.bookingPrice = &i
-	m.addbookingPrice = nil
+// UnavailabilityMutation represents an operation that mutates the Unavailability nodes in the graph.
+type UnavailabilityMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	createdAt       *time.Time
+	updatedAt       *time.Time
+	startTime       *time.Time
+	endTime         *time.Time
+	externalId      *string
+	clearedFields   map[string]struct{}
+	resource        *int
+	clearedresource bool
+	done            bool
+	oldValue        func(context.Context) (*Unavailability, error)
+	predicates      []predicate.Unavailability
 }
 
-// BookingPrice returns the value of the "bookingPrice" field in the mutation.
-func (m *ResourceMutation) BookingPrice() (r int, exists bool) {
-	v := m.bookingPrice
-	if v == nil {
-		return
-	}
-	return *v, true
-}
+var _ ent.Mutation = (*UnavailabilityMutation)(nil)
 
-// OldBookingPrice returns the old "bookingPrice" field's value of the Resource entity.
-// If the Resource object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ResourceMutation) OldBookingPrice(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldBookingPrice is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldBookingPrice requires an ID field in the mutation")
+// unavailabilityOption allows management of the mutation configuration using functional options.
+type unavailabilityOption func(*UnavailabilityMutation)
+
+// newUnavailabilityMutation creates new mutation for the Unavailability entity.
+func newUnavailabilityMutation(c config, op Op, opts ...unavailabilityOption) *UnavailabilityMutation {
+	m := &UnavailabilityMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUnavailability,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBookingPrice: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.BookingPrice, nil
+	return m
 }
 
-// AddBookingPrice adds i to the "bookingPrice" field.
-func (m *ResourceMutation) AddBookingPrice(i int) {
-	if m.addbookingPrice != nil {
-		*m.addbookingPrice += i
-	} else {
-		m.addbookingPrice = &i
+// withUnavailabilityID sets the ID field of the mutation.
+func withUnavailabilityID(id int) unavailabilityOption {
+	return func(m *UnavailabilityMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Unavailability
+		)
+		m.oldValue = func(ctx context.Context) (*Unavailability, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Unavailability.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
 }
 
-// AddedBookingPrice returns the value that was added to the "bookingPrice" field in this mutation.
-func (m *ResourceMutation) AddedBookingPrice() (r int, exists bool) {
-	v := m.addbookingPrice
-	if v == nil {
-		return
+// withUnavailability sets the old Unavailability of the mutation.
+func withUnavailability(node *Unavailability) unavailabilityOption {
+	return func(m *UnavailabilityMutation) {
+		m.oldValue = func(context.Context) (*Unavailability, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return *v, true
 }
 
-// ResetBookingPrice resets all changes to the "bookingPrice" field.
-func (m *ResourceMutation) ResetBookingPrice() {
-	m.bookingPrice = nil
-	m.addbookingPrice = nil
-}
-
-// SetOrganizationId sets the "organizationId" field.
-func (m *ResourceMutation) SetOrganizationId(i int) {
-	m.organization = &i
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UnavailabilityMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// OrganizationId returns the value of the "organizationId" field in the mutation.
-func (m *ResourceMutation) OrganizationId() (r int, exists bool) {
-	v := m.organization
-	if v == nil {
-		return
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UnavailabilityMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
 	}
-	return *v, true
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// OldOrganizationId returns the old "organizationId" field's value of the Resource entity.
-// If the Resource object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ResourceMutation) OldOrganizationId(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldOrganizationId is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldOrganizationId requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOrganizationId: %w", err)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UnavailabilityMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return oldValue.OrganizationId, nil
-}
-
-// ResetOrganizationId resets all changes to the "organizationId" field.
-func (m *ResourceMutation) ResetOrganizationId() {
-	m.organization = nil
+	return *m.id, true
 }
 
-// SetQuantityAvailable sets the "quantityAvailable" field.
-func (m *ResourceMutation) SetQuantityAvailable(i int) {
-	m.quantityAvailable = &i
-	m.addquantityAvailable = nil
+// SetCreatedAt sets the "createdAt" field.
+func (m *UnavailabilityMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
 }
 
-// QuantityAvailable returns the value of the "quantityAvailable" field in the mutation.
-func (m *ResourceMutation) QuantityAvailable() (r int, exists bool) {
-	v := m.quantityAvailable
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *UnavailabilityMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuantityAvailable returns the old "quantityAvailable" field's value of the Resource entity.
-// If the Resource object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "createdAt" field's value of the Unavailability entity.
+// If the Unavailability object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ResourceMutation) OldQuantityAvailable(ctx context.Context) (v *int, err error) {
+func (m *UnavailabilityMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldQuantityAvailable is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldQuantityAvailable requires an ID field in the mutation")
+		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuantityAvailable: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.QuantityAvailable, nil
+	return oldValue.CreatedAt, nil
 }
 
-// AddQuantityAvailable adds i to the "quantityAvailable" field.
-func (m *ResourceMutation) AddQuantityAvailable(i int) {
-	if m.addquantityAvailable != nil {
-		*m.addquantityAvailable += i
-	} else {
-		m.addquantityAvailable = &i
-	}
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *UnavailabilityMutation) ResetCreatedAt() {
+	m.createdAt = nil
 }
 
-// AddedQuantityAvailable returns the value that was added to the "quantityAvailable" field in this mutation.
-func (m *ResourceMutation) AddedQuantityAvailable() (r int, exists bool) {
-	v := m.addquantityAvailable
+// SetUpdatedAt sets the "updatedAt" field.
+func (m *UnavailabilityMutation) SetUpdatedAt(t time.Time) {
+	m.updatedAt = &t
+}
+
+// UpdatedAt returns the value of the "updatedAt" field in the mutation.
+func (m *UnavailabilityMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updatedAt
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearQuantityAvailable clears the value of the "quantityAvailable" field.
-func (m *ResourceMutation) ClearQuantityAvailable() {
-	m.quantityAvailable = nil
-	m.addquantityAvailable = nil
-	m.clearedFields[resource.FieldQuantityAvailable] = struct{}{}
-}
-
-// QuantityAvailableCleared returns if the "quantityAvailable" field was cleared in this mutation.
-func (m *ResourceMutation) QuantityAvailableCleared() bool {
-	_, ok := m.clearedFields[resource.FieldQuantityAvailable]
-	return ok
-}
-
-// ResetQuantityAvailable resets all changes to the "quantityAvailable" field.
-func (m *ResourceMutation) ResetQuantityAvailable() {
-	m.quantityAvailable = nil
-	m.addquantityAvailable = nil
-	delete(m.clearedFields, resource.FieldQuantityAvailable)
-}
-
-// AddSlotIDs adds the "slots" edge to the Slot entity by ids.
-func (m *ResourceMutation) AddSlotIDs(ids ...int) {
-	if m.slots == nil {
-		m.slots = make(map[int]struct{})
+// OldUpdatedAt returns the old "updatedAt" field's value of the Unavailability entity.
+// If the Unavailability object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UnavailabilityMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.slots[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUpdatedAt requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearSlots clears the "slots" edge to the Slot entity.
-func (m *ResourceMutation) ClearSlots() {
-	m.clearedslots = true
+// ResetUpdatedAt resets all changes to the "updatedAt" field.
+func (m *UnavailabilityMutation) ResetUpdatedAt() {
+	m.updatedAt = nil
 }
 
-// SlotsCleared reports if the "slots" edge to the Slot entity was cleared.
-func (m *ResourceMutation) SlotsCleared() bool {
-	return m.clearedslots
+// SetStartTime sets the "startTime" field.
+func (m *UnavailabilityMutation) SetStartTime(t time.Time) {
+	m.startTime = &t
 }
 
-// RemoveSlotIDs removes the "slots" edge to the Slot entity by IDs.
-func (m *ResourceMutation) RemoveSlotIDs(ids ...int) {
-	if m.removedslots == nil {
-		m.removedslots = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.slots, ids[i])
-		m.removedslots[ids[i]] = struct{}{}
+// StartTime returns the value of the "startTime" field in the mutation.
+func (m *UnavailabilityMutation) StartTime() (r time.Time, exists bool) {
+	v := m.startTime
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedSlots returns the removed IDs of the "slots" edge to the Slot entity.
-func (m *ResourceMutation) RemovedSlotsIDs() (ids []int) {
-	for id := range m.removedslots {
-		ids = append(ids, id)
+// OldStartTime returns the old "startTime" field's value of the Unavailability entity.
+// If the Unavailability object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UnavailabilityMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldStartTime is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// SlotsIDs returns the "slots" edge IDs in the mutation.
-func (m *ResourceMutation) SlotsIDs() (ids []int) {
-	for id := range m.slots {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldStartTime requires an ID field in the mutation")
 	}
-	return
-}
-
-// ResetSlots resets all changes to the "slots" edge.
-func (m *ResourceMutation) ResetSlots() {
-	m.slots = nil
-	m.clearedslots = false
-	m.removedslots = nil
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+	}
+	return oldValue.StartTime, nil
 }
 
-// AddBookingIDs adds the "bookings" edge to the Booking entity by ids.
-func (m *ResourceMutation) AddBookingIDs(ids ...int) {
-	if m.bookings == nil {
-		m.bookings = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.bookings[ids[i]] = struct{}{}
-	}
+// ResetStartTime resets all changes to the "startTime" field.
+func (m *UnavailabilityMutation) ResetStartTime() {
+	m.startTime = nil
 }
 
-// ClearBookings clears the "bookings" edge to the Booking entity.
-func (m *ResourceMutation) ClearBookings() {
-	m.clearedbookings = true
+// SetEndTime sets the "endTime" field.
+func (m *UnavailabilityMutation) SetEndTime(t time.Time) {
+	m.endTime = &t
 }
 
-// BookingsCleared reports if the "bookings" edge to the Booking entity was cleared.
-func (m *ResourceMutation) BookingsCleared() bool {
-	return m.clearedbookings
+// EndTime returns the value of the "endTime" field in the mutation.
+func (m *UnavailabilityMutation) EndTime() (r time.Time, exists bool) {
+	v := m.endTime
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveBookingIDs removes the "bookings" edge to the Booking entity by IDs.
-func (m *ResourceMutation) RemoveBookingIDs(ids ...int) {
-	if m.removedbookings == nil {
-		m.removedbookings = make(map[int]struct{})
+// OldEndTime returns the old "endTime" field's value of the Unavailability entity.
+// If the Unavailability object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UnavailabilityMutation) OldEndTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldEndTime is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.bookings, ids[i])
-		m.removedbookings[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldEndTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
 	}
+	return oldValue.EndTime, nil
 }
 
-// RemovedBookings returns the removed IDs of the "bookings" edge to the Booking entity.
-func (m *ResourceMutation) RemovedBookingsIDs() (ids []int) {
-	for id := range m.removedbookings {
-		ids = append(ids, id)
-	}
-	return
+// ResetEndTime resets all changes to the "endTime" field.
+func (m *UnavailabilityMutation) ResetEndTime() {
+	m.endTime = nil
 }
 
-// BookingsIDs returns the "bookings" edge IDs in the mutation.
-func (m *ResourceMutation) BookingsIDs() (ids []int) {
-	for id := range m.bookings {
-		ids = append(ids, id)
-	}
-	return
+// SetResourceId sets the "resourceId" field.
+func (m *UnavailabilityMutation) SetResourceId(i int) {
+	m.resource = &i
 }
 
-// ResetBookings resets all changes to the "bookings" edge.
-func (m *ResourceMutation) ResetBookings() {
-	m.bookings = nil
-	m.clearedbookings = false
-	m.removedbookings = nil
+// ResourceId returns the value of the "resourceId" field in the mutation.
+func (m *UnavailabilityMutation) ResourceId() (r int, exists bool) {
+	v := m.resource
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddUnavailabilityIDs adds the "unavailabilities" edge to the Unavailability entity by ids.
-func (m *ResourceMutation) AddUnavailabilityIDs(ids ...int) {
-	if m.unavailabilities == nil {
-		m.unavailabilities = make(map[int]struct{})
+// OldResourceId returns the old "resourceId" field's value of the Unavailability entity.
+// If the Unavailability object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UnavailabilityMutation) OldResourceId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldResourceId is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.unavailabilities[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldResourceId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceId: %w", err)
 	}
+	return oldValue.ResourceId, nil
 }
 
-// ClearUnavailabilities clears the "unavailabilities" edge to the Unavailability entity.
-func (m *ResourceMutation) ClearUnavailabilities() {
-	m.clearedunavailabilities = true
+// ResetResourceId resets all changes to the "resourceId" field.
+func (m *UnavailabilityMutation) ResetResourceId() {
+	m.resource = nil
 }
 
-// UnavailabilitiesCleared reports if the "unavailabilities" edge to the Unavailability entity was cleared.
-func (m *ResourceMutation) UnavailabilitiesCleared() bool {
-	return m.clearedunavailabilities
+// SetExternalId sets the "externalId" field.
+func (m *UnavailabilityMutation) SetExternalId(s string) {
+	m.externalId = &s
 }
 
-// RemoveUnavailabilityIDs removes the "unavailabilities" edge to the Unavailability entity by IDs.
-func (m *ResourceMutation) RemoveUnavailabilityIDs(ids ...int) {
-	if m.removedunavailabilities == nil {
-		m.removedunavailabilities = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.unavailabilities, ids[i])
-		m.removedunavailabilities[ids[i]] = struct{}{}
+// ExternalId returns the value of the "externalId" field in the mutation.
+func (m *UnavailabilityMutation) ExternalId() (r string, exists bool) {
+	v := m.externalId
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedUnavailabilities returns the removed IDs of the "unavailabilities" edge to the Unavailability entity.
-func (m *ResourceMutation) RemovedUnavailabilitiesIDs() (ids []int) {
-	for id := range m.removedunavailabilities {
-		ids = append(ids, id)
+// OldExternalId returns the old "externalId" field's value of the Unavailability entity.
+// If the Unavailability object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UnavailabilityMutation) OldExternalId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldExternalId is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldExternalId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalId: %w", err)
+	}
+	return oldValue.ExternalId, nil
 }
 
-// UnavailabilitiesIDs returns the "unavailabilities" edge IDs in the mutation.
-func (m *ResourceMutation) UnavailabilitiesIDs() (ids []int) {
-	for id := range m.unavailabilities {
-		ids = append(ids, id)
-	}
-	return
+// ClearExternalId clears the value of the "externalId" field.
+func (m *UnavailabilityMutation) ClearExternalId() {
+	m.externalId = nil
+	m.clearedFields[unavailability.FieldExternalId] = struct{}{}
 }
 
-// ResetUnavailabilities resets all changes to the "unavailabilities" edge.
-func (m *ResourceMutation) ResetUnavailabilities() {
-	m.unavailabilities = nil
-	m.clearedunavailabilities = false
-	m.removedunavailabilities = nil
+// ExternalIdCleared returns if the "externalId" field was cleared in this mutation.
+func (m *UnavailabilityMutation) ExternalIdCleared() bool {
+	_, ok := m.clearedFields[unavailability.FieldExternalId]
+	return ok
 }
 
-// SetOrganizationID sets the "organization" edge to the Organization entity by id.
-func (m *ResourceMutation) SetOrganizationID(id int) {
-	m.organization = &id
+// ResetExternalId resets all changes to the "externalId" field.
+func (m *UnavailabilityMutation) ResetExternalId() {
+	m.externalId = nil
+	delete(m.clearedFields, unavailability.FieldExternalId)
 }
 
-// ClearOrganization clears the "organization" edge to the Organization entity.
-func (m *ResourceMutation) ClearOrganization() {
-	m.clearedorganization = true
+// SetResourceID sets the "resource" edge to the Resource entity by id.
+func (m *UnavailabilityMutation) SetResourceID(id int) {
+	m.resource = &id
 }
 
-// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
-func (m *ResourceMutation) OrganizationCleared() bool {
-	return m.clearedorganization
+// ClearResource clears the "resource" edge to the Resource entity.
+func (m *UnavailabilityMutation) ClearResource() {
+	m.clearedresource = true
 }
 
-// OrganizationID returns the "organization" edge ID in the mutation.
-func (m *ResourceMutation) OrganizationID() (id int, exists bool) {
-	if m.organization != nil {
-		return *m.organization, true
+// ResourceCleared reports if the "resource" edge to the Resource entity was cleared.
+func (m *UnavailabilityMutation) ResourceCleared() bool {
+	return m.clearedresource
+}
+
+// ResourceID returns the "resource" edge ID in the mutation.
+func (m *UnavailabilityMutation) ResourceID() (id int, exists bool) {
+	if m.resource != nil {
+		return *m.resource, true
 	}
 	return
 }
 
-// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// ResourceIDs returns the "resource" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OrganizationID instead. It exists only for internal usage by the builders.
-func (m *ResourceMutation) OrganizationIDs() (ids []int) {
-	if id := m.organization; id != nil {
+// ResourceID instead. It exists only for internal usage by the builders.
+func (m *UnavailabilityMutation) ResourceIDs() (ids []int) {
+	if id := m.resource; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetOrganization resets all changes to the "organization" edge.
-func (m *ResourceMutation) ResetOrganization() {
-	m.organization = nil
-	m.clearedorganization = false
+// ResetResource resets all changes to the "resource" edge.
+func (m *UnavailabilityMutation) ResetResource() {
+	m.resource = nil
+	m.clearedresource = false
 }
 
-// Where appends a list predicates to the ResourceMutation builder.
-func (m *ResourceMutation) Where(ps ...predicate.Resource) {
+// Where appends a list predicates to the UnavailabilityMutation builder.
+func (m *UnavailabilityMutation) Where(ps ...predicate.Unavailability) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *ResourceMutation) Op() Op {
+func (m *UnavailabilityMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Resource).
-func (m *ResourceMutation) Type() string {
+// Type returns the node type of this mutation (Unavailability).
+func (m *UnavailabilityMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ResourceMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+func (m *UnavailabilityMutation) Fields() []string {
+	fields := make([]string, 0, 6)
 	if m.createdAt != nil {
-		fields = append(fields, resource.FieldCreatedAt)
+		fields = append(fields, unavailability.FieldCreatedAt)
 	}
 	if m.updatedAt != nil {
-		fields = append(fields, resource.FieldUpdatedAt)
-	}
-	if m.name != nil {
-		fields = append(fields, resource.FieldName)
-	}
-	if m.description != nil {
-		fields = append(fields, resource.FieldDescription)
-	}
-	if m.timezone != nil {
-		fields = append(fields, resource.FieldTimezone)
-	}
-	if m.password != nil {
-		fields = append(fields, resource.FieldPassword)
+		fields = append(fields, unavailability.FieldUpdatedAt)
 	}
-	if m.price != nil {
-		fields = append(fields, resource.FieldPrice)
+	if m.startTime != nil {
+		fields = append(fields, unavailability.FieldStartTime)
 	}
-	if m.bookingPrice != nil {
-		fields = append(fields, resource.FieldBookingPrice)
+	if m.endTime != nil {
+		fields = append(fields, unavailability.FieldEndTime)
 	}
-	if m.organization != nil {
-		fields = append(fields, resource.FieldOrganizationId)
+	if m.resource != nil {
+		fields = append(fields, unavailability.FieldResourceId)
 	}
-	if m.quantityAvailable != nil {
-		fields = append(fields, resource.FieldQuantityAvailable)
+	if m.externalId != nil {
+		fields = append(fields, unavailability.FieldExternalId)
 	}
 	return fields
 }
@@ -4079,28 +16368,20 @@ func (m *ResourceMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ResourceMutation) Field(name string) (ent.Value, bool) {
+func (m *UnavailabilityMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case resource.FieldCreatedAt:
+	case unavailability.FieldCreatedAt:
 		return m.CreatedAt()
-	case resource.FieldUpdatedAt:
+	case unavailability.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case resource.FieldName:
-		return m.Name()
-	case resource.FieldDescription:
-		return m.Description()
-	case resource.FieldTimezone:
-		return m.Timezone()
-	case resource.FieldPassword:
-		return m.Password()
-	case resource.FieldPrice:
-		return m.Price()
-	case resource.FieldBookingPrice:
-		return m.BookingPrice()
-	case resource.FieldOrganizationId:
-		return m.OrganizationId()
-	case resource.FieldQuantityAvailable:
-		return m.QuantityAvailable()
+	case unavailability.FieldStartTime:
+		return m.StartTime()
+	case unavailability.FieldEndTime:
+		return m.EndTime()
+	case unavailability.FieldResourceId:
+		return m.ResourceId()
+	case unavailability.FieldExternalId:
+		return m.ExternalId()
 	}
 	return nil, false
 }
@@ -4108,138 +16389,87 @@ func (m *ResourceMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ResourceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UnavailabilityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case resource.FieldCreatedAt:
+	case unavailability.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case resource.FieldUpdatedAt:
+	case unavailability.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case resource.FieldName:
-		return m.OldName(ctx)
-	case resource.FieldDescription:
-		return m.OldDescription(ctx)
-	case resource.FieldTimezone:
-		return m.OldTimezone(ctx)
-	case resource.FieldPassword:
-		return m.OldPassword(ctx)
-	case resource.FieldPrice:
-		return m.OldPrice(ctx)
-	case resource.FieldBookingPrice:
-		return m.OldBookingPrice(ctx)
-	case resource.FieldOrganizationId:
-		return m.OldOrganizationId(ctx)
-	case resource.FieldQuantityAvailable:
-		return m.OldQuantityAvailable(ctx)
+	case unavailability.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case unavailability.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case unavailability.FieldResourceId:
+		return m.OldResourceId(ctx)
+	case unavailability.FieldExternalId:
+		return m.OldExternalId(ctx)
 	}
-	return nil, fmt.Errorf("unknown Resource field %s", name)
+	return nil, fmt.Errorf("unknown Unavailability field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ResourceMutation) SetField(name string, value ent.Value) error {
+func (m *UnavailabilityMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case resource.FieldCreatedAt:
+	case unavailability.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case resource.FieldUpdatedAt:
+	case unavailability.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case resource.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case resource.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case resource.FieldTimezone:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTimezone(v)
-		return nil
-	case resource.FieldPassword:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPassword(v)
-		return nil
-	case resource.FieldPrice:
-		v, ok := value.(int)
+	case unavailability.FieldStartTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPrice(v)
+		m.SetStartTime(v)
 		return nil
-	case resource.FieldBookingPrice:
-		v, ok := value.(int)
+	case unavailability.FieldEndTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBookingPrice(v)
+		m.SetEndTime(v)
 		return nil
-	case resource.FieldOrganizationId:
+	case unavailability.FieldResourceId:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOrganizationId(v)
+		m.SetResourceId(v)
 		return nil
-	case resource.FieldQuantityAvailable:
-		v, ok := value.(int)
+	case unavailability.FieldExternalId:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuantityAvailable(v)
+		m.SetExternalId(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Resource field %s", name)
+	return fmt.Errorf("unknown Unavailability field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ResourceMutation) AddedFields() []string {
+func (m *UnavailabilityMutation) AddedFields() []string {
 	var fields []string
-	if m.addprice != nil {
-		fields = append(fields, resource.FieldPrice)
-	}
-	if m.addbookingPrice != nil {
-		fields = append(fields, resource.FieldBookingPrice)
-	}
-	if m.addquantityAvailable != nil {
-		fields = append(fields, resource.FieldQuantityAvailable)
-	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ResourceMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UnavailabilityMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case resource.FieldPrice:
-		return m.AddedPrice()
-	case resource.FieldBookingPrice:
-		return m.AddedBookingPrice()
-	case resource.FieldQuantityAvailable:
-		return m.AddedQuantityAvailable()
 	}
 	return nil, false
 }
@@ -4247,141 +16477,81 @@ func (m *ResourceMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ResourceMutation) AddField(name string, value ent.Value) error {
+func (m *UnavailabilityMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case resource.FieldPrice:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPrice(v)
-		return nil
-	case resource.FieldBookingPrice:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddBookingPrice(v)
-		return nil
-	case resource.FieldQuantityAvailable:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddQuantityAvailable(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Resource numeric field %s", name)
+	return fmt.Errorf("unknown Unavailability numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ResourceMutation) ClearedFields() []string {
+func (m *UnavailabilityMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(resource.FieldQuantityAvailable) {
-		fields = append(fields, resource.FieldQuantityAvailable)
+	if m.FieldCleared(unavailability.FieldExternalId) {
+		fields = append(fields, unavailability.FieldExternalId)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ResourceMutation) FieldCleared(name string) bool {
+func (m *UnavailabilityMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ResourceMutation) ClearField(name string) error {
+func (m *UnavailabilityMutation) ClearField(name string) error {
 	switch name {
-	case resource.FieldQuantityAvailable:
-		m.ClearQuantityAvailable()
+	case unavailability.FieldExternalId:
+		m.ClearExternalId()
 		return nil
 	}
-	return fmt.Errorf("unknown Resource nullable field %s", name)
+	return fmt.Errorf("unknown Unavailability nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ResourceMutation) ResetField(name string) error {
+func (m *UnavailabilityMutation) ResetField(name string) error {
 	switch name {
-	case resource.FieldCreatedAt:
+	case unavailability.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case resource.FieldUpdatedAt:
+	case unavailability.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case resource.FieldName:
-		m.ResetName()
-		return nil
-	case resource.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case resource.FieldTimezone:
-		m.ResetTimezone()
-		return nil
-	case resource.FieldPassword:
-		m.ResetPassword()
-		return nil
-	case resource.FieldPrice:
-		m.ResetPrice()
+	case unavailability.FieldStartTime:
+		m.ResetStartTime()
 		return nil
-	case resource.FieldBookingPrice:
-		m.ResetBookingPrice()
+	case unavailability.FieldEndTime:
+		m.ResetEndTime()
 		return nil
-	case resource.FieldOrganizationId:
-		m.ResetOrganizationId()
+	case unavailability.FieldResourceId:
+		m.ResetResourceId()
 		return nil
-	case resource.FieldQuantityAvailable:
-		m.ResetQuantityAvailable()
+	case unavailability.FieldExternalId:
+		m.ResetExternalId()
 		return nil
 	}
-	return fmt.Errorf("unknown Resource field %s", name)
+	return fmt.Errorf("unknown Unavailability field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ResourceMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.slots != nil {
-		edges = append(edges, resource.EdgeSlots)
-	}
-	if m.bookings != nil {
-		edges = append(edges, resource.EdgeBookings)
-	}
-	if m.unavailabilities != nil {
-		edges = append(edges, resource.EdgeUnavailabilities)
-	}
-	if m.organization != nil {
-		edges = append(edges, resource.EdgeOrganization)
+func (m *UnavailabilityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.resource != nil {
+		edges = append(edges, unavailability.EdgeResource)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ResourceMutation) AddedIDs(name string) []ent.Value {
+func (m *UnavailabilityMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case resource.EdgeSlots:
-		ids := make([]ent.Value, 0, len(m.slots))
-		for id := range m.slots {
-			ids = append(ids, id)
-		}
-		return ids
-	case resource.EdgeBookings:
-		ids := make([]ent.Value, 0, len(m.bookings))
-		for id := range m.bookings {
-			ids = append(ids, id)
-		}
-		return ids
-	case resource.EdgeUnavailabilities:
-		ids := make([]ent.Value, 0, len(m.unavailabilities))
-		for id := range m.unavailabilities {
-			ids = append(ids, id)
-		}
-		return ids
-	case resource.EdgeOrganization:
-		if id := m.organization; id != nil {
+	case unavailability.EdgeResource:
+		if id := m.resource; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -4389,141 +16559,98 @@ func (m *ResourceMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ResourceMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedslots != nil {
-		edges = append(edges, resource.EdgeSlots)
-	}
-	if m.removedbookings != nil {
-		edges = append(edges, resource.EdgeBookings)
-	}
-	if m.removedunavailabilities != nil {
-		edges = append(edges, resource.EdgeUnavailabilities)
-	}
+func (m *UnavailabilityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ResourceMutation) RemovedIDs(name string) []ent.Value {
+func (m *UnavailabilityMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case resource.EdgeSlots:
-		ids := make([]ent.Value, 0, len(m.removedslots))
-		for id := range m.removedslots {
-			ids = append(ids, id)
-		}
-		return ids
-	case resource.EdgeBookings:
-		ids := make([]ent.Value, 0, len(m.removedbookings))
-		for id := range m.removedbookings {
-			ids = append(ids, id)
-		}
-		return ids
-	case resource.EdgeUnavailabilities:
-		ids := make([]ent.Value, 0, len(m.removedunavailabilities))
-		for id := range m.removedunavailabilities {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ResourceMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedslots {
-		edges = append(edges, resource.EdgeSlots)
-	}
-	if m.clearedbookings {
-		edges = append(edges, resource.EdgeBookings)
-	}
-	if m.clearedunavailabilities {
-		edges = append(edges, resource.EdgeUnavailabilities)
-	}
-	if m.clearedorganization {
-		edges = append(edges, resource.EdgeOrganization)
+func (m *UnavailabilityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedresource {
+		edges = append(edges, unavailability.EdgeResource)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ResourceMutation) EdgeCleared(name string) bool {
+func (m *UnavailabilityMutation) EdgeCleared(name string) bool {
 	switch name {
-	case resource.EdgeSlots:
-		return m.clearedslots
-	case resource.EdgeBookings:
-		return m.clearedbookings
-	case resource.EdgeUnavailabilities:
-		return m.clearedunavailabilities
-	case resource.EdgeOrganization:
-		return m.clearedorganization
+	case unavailability.EdgeResource:
+		return m.clearedresource
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ResourceMutation) ClearEdge(name string) error {
+func (m *UnavailabilityMutation) ClearEdge(name string) error {
 	switch name {
-	case resource.EdgeOrganization:
-		m.ClearOrganization()
+	case unavailability.EdgeResource:
+		m.ClearResource()
 		return nil
 	}
-	return fmt.Errorf("unknown Resource unique edge %s", name)
+	return fmt.Errorf("unknown Unavailability unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ResourceMutation) ResetEdge(name string) error {
+func (m *UnavailabilityMutation) ResetEdge(name string) error {
 	switch name {
-	case resource.EdgeSlots:
-		m.ResetSlots()
-		return nil
-	case resource.EdgeBookings:
-		m.ResetBookings()
-		return nil
-	case resource.EdgeUnavailabilities:
-		m.ResetUnavailabilities()
-		return nil
-	case resource.EdgeOrganization:
-		m.ResetOrganization()
+	case unavailability.EdgeResource:
+		m.ResetResource()
 		return nil
 	}
-	return fmt.Errorf("unknown Resource edge %s", name)
+	return fmt.Errorf("unknown Unavailability edge %s", name)
 }
 
-// SlotMutation represents an operation that mutates the Slot nodes in the graph.
-type SlotMutation struct {
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	day             *string
-	startTime       *string
-	endTime         *string
-	quantity        *int
-	addquantity     *int
-	clearedFields   map[string]struct{}
-	resource        *int
-	clearedresource bool
-	done            bool
-	oldValue        func(context.Context) (*Slot, error)
-	predicates      []predicate.Slot
+	op                  Op
+	typ                 string
+	id                  *int
+	createdAt           *time.Time
+	updatedAt           *time.Time
+	name                *string
+	email               *string
+	clearedFields       map[string]struct{}
+	auths               map[int]struct{}
+	removedauths        map[int]struct{}
+	clearedauths        bool
+	tokens              map[string]struct{}
+	removedtokens       map[string]struct{}
+	clearedtokens       bool
+	bookings            map[int]struct{}
+	removedbookings     map[int]struct{}
+	clearedbookings     bool
+	organization        *int
+	clearedorganization bool
+	done                bool
+	oldValue            func(context.Context) (*User, error)
+	predicates          []predicate.User
 }
 
-var _ ent.Mutation = (*SlotMutation)(nil)
+var _ ent.Mutation = (*UserMutation)(nil)
 
-// slotOption allows management of the mutation configuration using functional options.
-type slotOption func(*SlotMutation)
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
 
-// newSlotMutation creates new mutation for the Slot entity.
-func newSlotMutation(c config, op Op, opts ...slotOption) *SlotMutation {
-	m := &SlotMutation{
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSlot,
+		typ:           TypeUser,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4532,20 +16659,20 @@ func newSlotMutation(c config, op Op, opts ...slotOption) *SlotMutation {
 	return m
 }
 
-// withSlotID sets the ID field of the mutation.
-func withSlotID(id int) slotOption {
-	return func(m *SlotMutation) {
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Slot
+			value *User
 		)
-		m.oldValue = func(ctx context.Context) (*Slot, error) {
+		m.oldValue = func(ctx context.Context) (*User, error) {
 			once.Do(func() {
 				if m.done {
 					err = fmt.Errorf("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Slot.Get(ctx, id)
+					value, err = m.Client().User.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4554,10 +16681,10 @@ func withSlotID(id int) slotOption {
 	}
 }
 
-// withSlot sets the old Slot of the mutation.
-func withSlot(node *Slot) slotOption {
-	return func(m *SlotMutation) {
-		m.oldValue = func(context.Context) (*Slot, error) {
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4566,7 +16693,7 @@ func withSlot(node *Slot) slotOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SlotMutation) Client() *Client {
+func (m UserMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4574,7 +16701,7 @@ func (m SlotMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SlotMutation) Tx() (*Tx, error) {
+func (m UserMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
 	}
@@ -4585,300 +16712,441 @@ func (m SlotMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SlotMutation) ID() (id int, exists bool) {
+func (m *UserMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
 	return *m.id, true
 }
 
-// SetDay sets the "day" field.
-func (m *SlotMutation) SetDay(s string) {
-	m.day = &s
+// SetCreatedAt sets the "createdAt" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
+}
+
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "createdAt" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.createdAt = nil
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updatedAt = &t
+}
+
+// UpdatedAt returns the value of the "updatedAt" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updatedAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updatedAt" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updatedAt" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updatedAt = nil
+}
+
+// SetName sets the "name" field.
+func (m *UserMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Day returns the value of the "day" field in the mutation.
-func (m *SlotMutation) Day() (r string, exists bool) {
-	v := m.day
+// Name returns the value of the "name" field in the mutation.
+func (m *UserMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDay returns the old "day" field's value of the Slot entity.
-// If the Slot object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SlotMutation) OldDay(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldDay is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldDay requires an ID field in the mutation")
+		return v, fmt.Errorf("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDay: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Day, nil
+	return oldValue.Name, nil
 }
 
-// ResetDay resets all changes to the "day" field.
-func (m *SlotMutation) ResetDay() {
-	m.day = nil
+// ResetName resets all changes to the "name" field.
+func (m *UserMutation) ResetName() {
+	m.name = nil
 }
 
-// SetStartTime sets the "startTime" field.
-func (m *SlotMutation) SetStartTime(s string) {
-	m.startTime = &s
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
 }
 
-// StartTime returns the value of the "startTime" field in the mutation.
-func (m *SlotMutation) StartTime() (r string, exists bool) {
-	v := m.startTime
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartTime returns the old "startTime" field's value of the Slot entity.
-// If the Slot object wasn't provided to the builder, the object is fetched from the database.
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SlotMutation) OldStartTime(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldStartTime is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldStartTime requires an ID field in the mutation")
+		return v, fmt.Errorf("OldEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
-	return oldValue.StartTime, nil
+	return oldValue.Email, nil
 }
 
-// ResetStartTime resets all changes to the "startTime" field.
-func (m *SlotMutation) ResetStartTime() {
-	m.startTime = nil
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
 }
 
-// SetEndTime sets the "endTime" field.
-func (m *SlotMutation) SetEndTime(s string) {
-	m.endTime = &s
+// SetOrganizationId sets the "organizationId" field.
+func (m *UserMutation) SetOrganizationId(i int) {
+	m.organization = &i
 }
 
-// EndTime returns the value of the "endTime" field in the mutation.
-func (m *SlotMutation) EndTime() (r string, exists bool) {
-	v := m.endTime
+// OrganizationId returns the value of the "organizationId" field in the mutation.
+func (m *UserMutation) OrganizationId() (r int, exists bool) {
+	v := m.organization
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEndTime returns the old "endTime" field's value of the Slot entity.
-// If the Slot object wasn't provided to the builder, the object is fetched from the database.
+// OldOrganizationId returns the old "organizationId" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SlotMutation) OldEndTime(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldOrganizationId(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldEndTime is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldOrganizationId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldEndTime requires an ID field in the mutation")
+		return v, fmt.Errorf("OldOrganizationId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldOrganizationId: %w", err)
 	}
-	return oldValue.EndTime, nil
+	return oldValue.OrganizationId, nil
 }
 
-// ResetEndTime resets all changes to the "endTime" field.
-func (m *SlotMutation) ResetEndTime() {
-	m.endTime = nil
+// ClearOrganizationId clears the value of the "organizationId" field.
+func (m *UserMutation) ClearOrganizationId() {
+	m.organization = nil
+	m.clearedFields[user.FieldOrganizationId] = struct{}{}
 }
 
-// SetQuantity sets the "quantity" field.
-func (m *SlotMutation) SetQuantity(i int) {
-	m.quantity = &i
-	m.addquantity = nil
+// OrganizationIdCleared returns if the "organizationId" field was cleared in this mutation.
+func (m *UserMutation) OrganizationIdCleared() bool {
+	_, ok := m.clearedFields[user.FieldOrganizationId]
+	return ok
 }
 
-// Quantity returns the value of the "quantity" field in the mutation.
-func (m *SlotMutation) Quantity() (r int, exists bool) {
-	v := m.quantity
-	if v == nil {
-		return
+// ResetOrganizationId resets all changes to the "organizationId" field.
+func (m *UserMutation) ResetOrganizationId() {
+	m.organization = nil
+	delete(m.clearedFields, user.FieldOrganizationId)
+}
+
+// AddAuthIDs adds the "auths" edge to the Auth entity by ids.
+func (m *UserMutation) AddAuthIDs(ids ...int) {
+	if m.auths == nil {
+		m.auths = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.auths[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldQuantity returns the old "quantity" field's value of the Slot entity.
-// If the Slot object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SlotMutation) OldQuantity(ctx context.Context) (v *int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldQuantity is only allowed on UpdateOne operations")
+// ClearAuths clears the "auths" edge to the Auth entity.
+func (m *UserMutation) ClearAuths() {
+	m.clearedauths = true
+}
+
+// AuthsCleared reports if the "auths" edge to the Auth entity was cleared.
+func (m *UserMutation) AuthsCleared() bool {
+	return m.clearedauths
+}
+
+// RemoveAuthIDs removes the "auths" edge to the Auth entity by IDs.
+func (m *UserMutation) RemoveAuthIDs(ids ...int) {
+	if m.removedauths == nil {
+		m.removedauths = make(map[int]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldQuantity requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.auths, ids[i])
+		m.removedauths[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+}
+
+// RemovedAuths returns the removed IDs of the "auths" edge to the Auth entity.
+func (m *UserMutation) RemovedAuthsIDs() (ids []int) {
+	for id := range m.removedauths {
+		ids = append(ids, id)
 	}
-	return oldValue.Quantity, nil
+	return
 }
 
-// AddQuantity adds i to the "quantity" field.
-func (m *SlotMutation) AddQuantity(i int) {
-	if m.addquantity != nil {
-		*m.addquantity += i
-	} else {
-		m.addquantity = &i
+// AuthsIDs returns the "auths" edge IDs in the mutation.
+func (m *UserMutation) AuthsIDs() (ids []int) {
+	for id := range m.auths {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
-func (m *SlotMutation) AddedQuantity() (r int, exists bool) {
-	v := m.addquantity
-	if v == nil {
-		return
+// ResetAuths resets all changes to the "auths" edge.
+func (m *UserMutation) ResetAuths() {
+	m.auths = nil
+	m.clearedauths = false
+	m.removedauths = nil
+}
+
+// AddTokenIDs adds the "tokens" edge to the Token entity by ids.
+func (m *UserMutation) AddTokenIDs(ids ...string) {
+	if m.tokens == nil {
+		m.tokens = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.tokens[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// ClearQuantity clears the value of the "quantity" field.
-func (m *SlotMutation) ClearQuantity() {
-	m.quantity = nil
-	m.addquantity = nil
-	m.clearedFields[slot.FieldQuantity] = struct{}{}
+// ClearTokens clears the "tokens" edge to the Token entity.
+func (m *UserMutation) ClearTokens() {
+	m.clearedtokens = true
 }
 
-// QuantityCleared returns if the "quantity" field was cleared in this mutation.
-func (m *SlotMutation) QuantityCleared() bool {
-	_, ok := m.clearedFields[slot.FieldQuantity]
-	return ok
+// TokensCleared reports if the "tokens" edge to the Token entity was cleared.
+func (m *UserMutation) TokensCleared() bool {
+	return m.clearedtokens
+}
+
+// RemoveTokenIDs removes the "tokens" edge to the Token entity by IDs.
+func (m *UserMutation) RemoveTokenIDs(ids ...string) {
+	if m.removedtokens == nil {
+		m.removedtokens = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.tokens, ids[i])
+		m.removedtokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTokens returns the removed IDs of the "tokens" edge to the Token entity.
+func (m *UserMutation) RemovedTokensIDs() (ids []string) {
+	for id := range m.removedtokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TokensIDs returns the "tokens" edge IDs in the mutation.
+func (m *UserMutation) TokensIDs() (ids []string) {
+	for id := range m.tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTokens resets all changes to the "tokens" edge.
+func (m *UserMutation) ResetTokens() {
+	m.tokens = nil
+	m.clearedtokens = false
+	m.removedtokens = nil
+}
+
+// AddBookingIDs adds the "bookings" edge to the Booking entity by ids.
+func (m *UserMutation) AddBookingIDs(ids ...int) {
+	if m.bookings == nil {
+		m.bookings = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.bookings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBookings clears the "bookings" edge to the Booking entity.
+func (m *UserMutation) ClearBookings() {
+	m.clearedbookings = true
 }
 
-// ResetQuantity resets all changes to the "quantity" field.
-func (m *SlotMutation) ResetQuantity() {
-	m.quantity = nil
-	m.addquantity = nil
-	delete(m.clearedFields, slot.FieldQuantity)
+// BookingsCleared reports if the "bookings" edge to the Booking entity was cleared.
+func (m *UserMutation) BookingsCleared() bool {
+	return m.clearedbookings
 }
 
-// SetResourceId sets the "resourceId" field.
-func (m *SlotMutation) SetResourceId(i int) {
-	m.resource = &i
+// RemoveBookingIDs removes the "bookings" edge to the Booking entity by IDs.
+func (m *UserMutation) RemoveBookingIDs(ids ...int) {
+	if m.removedbookings == nil {
+		m.removedbookings = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.bookings, ids[i])
+		m.removedbookings[ids[i]] = struct{}{}
+	}
 }
 
-// ResourceId returns the value of the "resourceId" field in the mutation.
-func (m *SlotMutation) ResourceId() (r int, exists bool) {
-	v := m.resource
-	if v == nil {
-		return
+// RemovedBookings returns the removed IDs of the "bookings" edge to the Booking entity.
+func (m *UserMutation) RemovedBookingsIDs() (ids []int) {
+	for id := range m.removedbookings {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldResourceId returns the old "resourceId" field's value of the Slot entity.
-// If the Slot object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SlotMutation) OldResourceId(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldResourceId is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldResourceId requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResourceId: %w", err)
+// BookingsIDs returns the "bookings" edge IDs in the mutation.
+func (m *UserMutation) BookingsIDs() (ids []int) {
+	for id := range m.bookings {
+		ids = append(ids, id)
 	}
-	return oldValue.ResourceId, nil
+	return
 }
 
-// ResetResourceId resets all changes to the "resourceId" field.
-func (m *SlotMutation) ResetResourceId() {
-	m.resource = nil
+// ResetBookings resets all changes to the "bookings" edge.
+func (m *UserMutation) ResetBookings() {
+	m.bookings = nil
+	m.clearedbookings = false
+	m.removedbookings = nil
 }
 
-// SetResourceID sets the "resource" edge to the Resource entity by id.
-func (m *SlotMutation) SetResourceID(id int) {
-	m.resource = &id
+// SetOrganizationID sets the "organization" edge to the Organization entity by id.
+func (m *UserMutation) SetOrganizationID(id int) {
+	m.organization = &id
 }
 
-// ClearResource clears the "resource" edge to the Resource entity.
-func (m *SlotMutation) ClearResource() {
-	m.clearedresource = true
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *UserMutation) ClearOrganization() {
+	m.clearedorganization = true
 }
 
-// ResourceCleared reports if the "resource" edge to the Resource entity was cleared.
-func (m *SlotMutation) ResourceCleared() bool {
-	return m.clearedresource
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *UserMutation) OrganizationCleared() bool {
+	return m.OrganizationIdCleared() || m.clearedorganization
 }
 
-// ResourceID returns the "resource" edge ID in the mutation.
-func (m *SlotMutation) ResourceID() (id int, exists bool) {
-	if m.resource != nil {
-		return *m.resource, true
+// OrganizationID returns the "organization" edge ID in the mutation.
+func (m *UserMutation) OrganizationID() (id int, exists bool) {
+	if m.organization != nil {
+		return *m.organization, true
 	}
 	return
 }
 
-// ResourceIDs returns the "resource" edge IDs in the mutation.
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ResourceID instead. It exists only for internal usage by the builders.
-func (m *SlotMutation) ResourceIDs() (ids []int) {
-	if id := m.resource; id != nil {
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) OrganizationIDs() (ids []int) {
+	if id := m.organization; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetResource resets all changes to the "resource" edge.
-func (m *SlotMutation) ResetResource() {
-	m.resource = nil
-	m.clearedresource = false
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *UserMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
 }
 
-// Where appends a list predicates to the SlotMutation builder.
-func (m *SlotMutation) Where(ps ...predicate.Slot) {
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *SlotMutation) Op() Op {
+func (m *UserMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Slot).
-func (m *SlotMutation) Type() string {
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SlotMutation) Fields() []string {
+func (m *UserMutation) Fields() []string {
 	fields := make([]string, 0, 5)
-	if m.day != nil {
-		fields = append(fields, slot.FieldDay)
+	if m.createdAt != nil {
+		fields = append(fields, user.FieldCreatedAt)
 	}
-	if m.startTime != nil {
-		fields = append(fields, slot.FieldStartTime)
+	if m.updatedAt != nil {
+		fields = append(fields, user.FieldUpdatedAt)
 	}
-	if m.endTime != nil {
-		fields = append(fields, slot.FieldEndTime)
+	if m.name != nil {
+		fields = append(fields, user.FieldName)
 	}
-	if m.quantity != nil {
-		fields = append(fields, slot.FieldQuantity)
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
 	}
-	if m.resource != nil {
-		fields = append(fields, slot.FieldResourceId)
+	if m.organization != nil {
+		fields = append(fields, user.FieldOrganizationId)
 	}
 	return fields
 }
@@ -4886,18 +17154,18 @@ func (m *SlotMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SlotMutation) Field(name string) (ent.Value, bool) {
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case slot.FieldDay:
-		return m.Day()
-	case slot.FieldStartTime:
-		return m.StartTime()
-	case slot.FieldEndTime:
-		return m.EndTime()
-	case slot.FieldQuantity:
-		return m.Quantity()
-	case slot.FieldResourceId:
-		return m.ResourceId()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	case user.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case user.FieldName:
+		return m.Name()
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldOrganizationId:
+		return m.OrganizationId()
 	}
 	return nil, false
 }
@@ -4905,83 +17173,78 @@ func (m *SlotMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SlotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case slot.FieldDay:
-		return m.OldDay(ctx)
-	case slot.FieldStartTime:
-		return m.OldStartTime(ctx)
-	case slot.FieldEndTime:
-		return m.OldEndTime(ctx)
-	case slot.FieldQuantity:
-		return m.OldQuantity(ctx)
-	case slot.FieldResourceId:
-		return m.OldResourceId(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case user.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case user.FieldName:
+		return m.OldName(ctx)
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldOrganizationId:
+		return m.OldOrganizationId(ctx)
 	}
-	return nil, fmt.Errorf("unknown Slot field %s", name)
+	return nil, fmt.Errorf("unknown User field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SlotMutation) SetField(name string, value ent.Value) error {
+func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case slot.FieldDay:
-		v, ok := value.(string)
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDay(v)
+		m.SetCreatedAt(v)
 		return nil
-	case slot.FieldStartTime:
-		v, ok := value.(string)
+	case user.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStartTime(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case slot.FieldEndTime:
+	case user.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEndTime(v)
+		m.SetName(v)
 		return nil
-	case slot.FieldQuantity:
-		v, ok := value.(int)
+	case user.FieldEmail:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuantity(v)
+		m.SetEmail(v)
 		return nil
-	case slot.FieldResourceId:
+	case user.FieldOrganizationId:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResourceId(v)
+		m.SetOrganizationId(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Slot field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SlotMutation) AddedFields() []string {
+func (m *UserMutation) AddedFields() []string {
 	var fields []string
-	if m.addquantity != nil {
-		fields = append(fields, slot.FieldQuantity)
-	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SlotMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case slot.FieldQuantity:
-		return m.AddedQuantity()
 	}
 	return nil, false
 }
@@ -4989,85 +17252,105 @@ func (m *SlotMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SlotMutation) AddField(name string, value ent.Value) error {
+func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case slot.FieldQuantity:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddQuantity(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Slot numeric field %s", name)
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SlotMutation) ClearedFields() []string {
+func (m *UserMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(slot.FieldQuantity) {
-		fields = append(fields, slot.FieldQuantity)
+	if m.FieldCleared(user.FieldOrganizationId) {
+		fields = append(fields, user.FieldOrganizationId)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SlotMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SlotMutation) ClearField(name string) error {
+func (m *UserMutation) ClearField(name string) error {
 	switch name {
-	case slot.FieldQuantity:
-		m.ClearQuantity()
+	case user.FieldOrganizationId:
+		m.ClearOrganizationId()
 		return nil
 	}
-	return fmt.Errorf("unknown Slot nullable field %s", name)
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SlotMutation) ResetField(name string) error {
+func (m *UserMutation) ResetField(name string) error {
 	switch name {
-	case slot.FieldDay:
-		m.ResetDay()
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case slot.FieldStartTime:
-		m.ResetStartTime()
+	case user.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
-	case slot.FieldEndTime:
-		m.ResetEndTime()
+	case user.FieldName:
+		m.ResetName()
 		return nil
-	case slot.FieldQuantity:
-		m.ResetQuantity()
+	case user.FieldEmail:
+		m.ResetEmail()
 		return nil
-	case slot.FieldResourceId:
-		m.ResetResourceId()
+	case user.FieldOrganizationId:
+		m.ResetOrganizationId()
 		return nil
 	}
-	return fmt.Errorf("unknown Slot field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SlotMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.resource != nil {
-		edges = append(edges, slot.EdgeResource)
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.auths != nil {
+		edges = append(edges, user.EdgeAuths)
+	}
+	if m.tokens != nil {
+		edges = append(edges, user.EdgeTokens)
+	}
+	if m.bookings != nil {
+		edges = append(edges, user.EdgeBookings)
+	}
+	if m.organization != nil {
+		edges = append(edges, user.EdgeOrganization)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SlotMutation) AddedIDs(name string) []ent.Value {
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case slot.EdgeResource:
-		if id := m.resource; id != nil {
+	case user.EdgeAuths:
+		ids := make([]ent.Value, 0, len(m.auths))
+		for id := range m.auths {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTokens:
+		ids := make([]ent.Value, 0, len(m.tokens))
+		for id := range m.tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBookings:
+		ids := make([]ent.Value, 0, len(m.bookings))
+		for id := range m.bookings {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeOrganization:
+		if id := m.organization; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -5075,91 +17358,147 @@ func (m *SlotMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SlotMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedauths != nil {
+		edges = append(edges, user.EdgeAuths)
+	}
+	if m.removedtokens != nil {
+		edges = append(edges, user.EdgeTokens)
+	}
+	if m.removedbookings != nil {
+		edges = append(edges, user.EdgeBookings)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SlotMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
+	case user.EdgeAuths:
+		ids := make([]ent.Value, 0, len(m.removedauths))
+		for id := range m.removedauths {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTokens:
+		ids := make([]ent.Value, 0, len(m.removedtokens))
+		for id := range m.removedtokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBookings:
+		ids := make([]ent.Value, 0, len(m.removedbookings))
+		for id := range m.removedbookings {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SlotMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedresource {
-		edges = append(edges, slot.EdgeResource)
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedauths {
+		edges = append(edges, user.EdgeAuths)
+	}
+	if m.clearedtokens {
+		edges = append(edges, user.EdgeTokens)
+	}
+	if m.clearedbookings {
+		edges = append(edges, user.EdgeBookings)
+	}
+	if m.clearedorganization {
+		edges = append(edges, user.EdgeOrganization)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SlotMutation) EdgeCleared(name string) bool {
+func (m *UserMutation) EdgeCleared(name string) bool {
 	switch name {
-	case slot.EdgeResource:
-		return m.clearedresource
+	case user.EdgeAuths:
+		return m.clearedauths
+	case user.EdgeTokens:
+		return m.clearedtokens
+	case user.EdgeBookings:
+		return m.clearedbookings
+	case user.EdgeOrganization:
+		return m.clearedorganization
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SlotMutation) ClearEdge(name string) error {
+func (m *UserMutation) ClearEdge(name string) error {
 	switch name {
-	case slot.EdgeResource:
-		m.ClearResource()
+	case user.EdgeOrganization:
+		m.ClearOrganization()
 		return nil
 	}
-	return fmt.Errorf("unknown Slot unique edge %s", name)
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SlotMutation) ResetEdge(name string) error {
+func (m *UserMutation) ResetEdge(name string) error {
 	switch name {
-	case slot.EdgeResource:
-		m.ResetResource()
+	case user.EdgeAuths:
+		m.ResetAuths()
+		return nil
+	case user.EdgeTokens:
+		m.ResetTokens()
+		return nil
+	case user.EdgeBookings:
+		m.ResetBookings()
+		return nil
+	case user.EdgeOrganization:
+		m.ResetOrganization()
 		return nil
 	}
-	return fmt.Errorf("unknown Slot edge %s", name)
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// TokenMutation represents an operation that mutates the Token nodes in the graph.
-type TokenMutation struct {
+// WaitlistEntryMutation represents an operation that mutates the WaitlistEntry nodes in the graph.
+type WaitlistEntryMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *string
-	createdAt           *time.Time
-	updatedAt           *time.Time
-	name                *string
-	expiry              *time.Time
-	clearedFields       map[string]struct{}
-	user                *int
-	cleareduser         bool
-	organization        *int
-	clearedorganization bool
-	done                bool
-	oldValue            func(context.Context) (*Token, error)
-	predicates          []predicate.Token
+	op              Op
+	typ             string
+	id              *int
+	createdAt       *time.Time
+	updatedAt       *time.Time
+	status          *string
+	startTime       *time.Time
+	endTime         *time.Time
+	priority        *int
+	addpriority     *int
+	metadata        *map[string]string
+	offeredAt       *time.Time
+	clearedFields   map[string]struct{}
+	resource        *int
+	clearedresource bool
+	booking         *int
+	clearedbooking  bool
+	done            bool
+	oldValue        func(context.Context) (*WaitlistEntry, error)
+	predicates      []predicate.WaitlistEntry
 }
 
-var _ ent.Mutation = (*TokenMutation)(nil)
+var _ ent.Mutation = (*WaitlistEntryMutation)(nil)
 
-// tokenOption allows management of the mutation configuration using functional options.
-type tokenOption func(*TokenMutation)
+// waitlistentryOption allows management of the mutation configuration using functional options.
+type waitlistentryOption func(*WaitlistEntryMutation)
 
-// newTokenMutation creates new mutation for the Token entity.
-func newTokenMutation(c config, op Op, opts ...tokenOption) *TokenMutation {
-	m := &TokenMutation{
+// newWaitlistEntryMutation creates new mutation for the WaitlistEntry entity.
+func newWaitlistEntryMutation(c config, op Op, opts ...waitlistentryOption) *WaitlistEntryMutation {
+	m := &WaitlistEntryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeToken,
+		typ:           TypeWaitlistEntry,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5168,20 +17507,20 @@ func newTokenMutation(c config, op Op, opts ...tokenOption) *TokenMutation {
 	return m
 }
 
-// withTokenID sets the ID field of the mutation.
-func withTokenID(id string) tokenOption {
-	return func(m *TokenMutation) {
+// withWaitlistEntryID sets the ID field of the mutation.
+func withWaitlistEntryID(id int) waitlistentryOption {
+	return func(m *WaitlistEntryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Token
+			value *WaitlistEntry
 		)
-		m.oldValue = func(ctx context.Context) (*Token, error) {
+		m.oldValue = func(ctx context.Context) (*WaitlistEntry, error) {
 			once.Do(func() {
 				if m.done {
 					err = fmt.Errorf("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Token.Get(ctx, id)
+					value, err = m.Client().WaitlistEntry.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5190,10 +17529,10 @@ func withTokenID(id string) tokenOption {
 	}
 }
 
-// withToken sets the old Token of the mutation.
-func withToken(node *Token) tokenOption {
-	return func(m *TokenMutation) {
-		m.oldValue = func(context.Context) (*Token, error) {
+// withWaitlistEntry sets the old WaitlistEntry of the mutation.
+func withWaitlistEntry(node *WaitlistEntry) waitlistentryOption {
+	return func(m *WaitlistEntryMutation) {
+		m.oldValue = func(context.Context) (*WaitlistEntry, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5202,7 +17541,7 @@ func withToken(node *Token) tokenOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TokenMutation) Client() *Client {
+func (m WaitlistEntryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5210,7 +17549,7 @@ func (m TokenMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TokenMutation) Tx() (*Tx, error) {
+func (m WaitlistEntryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
 	}
@@ -5219,365 +17558,561 @@ func (m TokenMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Token entities.
-func (m *TokenMutation) SetID(id string) {
-	m.id = &id
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WaitlistEntryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (m *WaitlistEntryMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
+}
+
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *WaitlistEntryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "createdAt" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaitlistEntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *WaitlistEntryMutation) ResetCreatedAt() {
+	m.createdAt = nil
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (m *WaitlistEntryMutation) SetUpdatedAt(t time.Time) {
+	m.updatedAt = &t
+}
+
+// UpdatedAt returns the value of the "updatedAt" field in the mutation.
+func (m *WaitlistEntryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updatedAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updatedAt" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaitlistEntryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updatedAt" field.
+func (m *WaitlistEntryMutation) ResetUpdatedAt() {
+	m.updatedAt = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *WaitlistEntryMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *WaitlistEntryMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *TokenMutation) ID() (id string, exists bool) {
-	if m.id == nil {
-		return
+// OldStatus returns the old "status" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaitlistEntryMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldStatus is only allowed on UpdateOne operations")
 	}
-	return *m.id, true
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// SetCreatedAt sets the "createdAt" field.
-func (m *TokenMutation) SetCreatedAt(t time.Time) {
-	m.createdAt = &t
+// ResetStatus resets all changes to the "status" field.
+func (m *WaitlistEntryMutation) ResetStatus() {
+	m.status = nil
 }
 
-// CreatedAt returns the value of the "createdAt" field in the mutation.
-func (m *TokenMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.createdAt
+// SetStartTime sets the "startTime" field.
+func (m *WaitlistEntryMutation) SetStartTime(t time.Time) {
+	m.startTime = &t
+}
+
+// StartTime returns the value of the "startTime" field in the mutation.
+func (m *WaitlistEntryMutation) StartTime() (r time.Time, exists bool) {
+	v := m.startTime
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "createdAt" field's value of the Token entity.
-// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// OldStartTime returns the old "startTime" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WaitlistEntryMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldStartTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
+		return v, fmt.Errorf("OldStartTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.StartTime, nil
 }
 
-// ResetCreatedAt resets all changes to the "createdAt" field.
-func (m *TokenMutation) ResetCreatedAt() {
-	m.createdAt = nil
+// ResetStartTime resets all changes to the "startTime" field.
+func (m *WaitlistEntryMutation) ResetStartTime() {
+	m.startTime = nil
 }
 
-// SetUpdatedAt sets the "updatedAt" field.
-func (m *TokenMutation) SetUpdatedAt(t time.Time) {
-	m.updatedAt = &t
+// SetEndTime sets the "endTime" field.
+func (m *WaitlistEntryMutation) SetEndTime(t time.Time) {
+	m.endTime = &t
 }
 
-// UpdatedAt returns the value of the "updatedAt" field in the mutation.
-func (m *TokenMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updatedAt
+// EndTime returns the value of the "endTime" field in the mutation.
+func (m *WaitlistEntryMutation) EndTime() (r time.Time, exists bool) {
+	v := m.endTime
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updatedAt" field's value of the Token entity.
-// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// OldEndTime returns the old "endTime" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WaitlistEntryMutation) OldEndTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldEndTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldUpdatedAt requires an ID field in the mutation")
+		return v, fmt.Errorf("OldEndTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.EndTime, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updatedAt" field.
-func (m *TokenMutation) ResetUpdatedAt() {
-	m.updatedAt = nil
+// ResetEndTime resets all changes to the "endTime" field.
+func (m *WaitlistEntryMutation) ResetEndTime() {
+	m.endTime = nil
 }
 
-// SetName sets the "name" field.
-func (m *TokenMutation) SetName(s string) {
-	m.name = &s
+// SetPriority sets the "priority" field.
+func (m *WaitlistEntryMutation) SetPriority(i int) {
+	m.priority = &i
+	m.addpriority = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *TokenMutation) Name() (r string, exists bool) {
-	v := m.name
+// Priority returns the value of the "priority" field in the mutation.
+func (m *WaitlistEntryMutation) Priority() (r int, exists bool) {
+	v := m.priority
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Token entity.
-// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// OldPriority returns the old "priority" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *WaitlistEntryMutation) OldPriority(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldName is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldPriority is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+		return v, fmt.Errorf("OldPriority requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Priority, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *TokenMutation) ResetName() {
-	m.name = nil
+// AddPriority adds i to the "priority" field.
+func (m *WaitlistEntryMutation) AddPriority(i int) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
 }
 
-// SetExpiry sets the "expiry" field.
-func (m *TokenMutation) SetExpiry(t time.Time) {
-	m.expiry = &t
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *WaitlistEntryMutation) AddedPriority() (r int, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Expiry returns the value of the "expiry" field in the mutation.
-func (m *TokenMutation) Expiry() (r time.Time, exists bool) {
-	v := m.expiry
+// ResetPriority resets all changes to the "priority" field.
+func (m *WaitlistEntryMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *WaitlistEntryMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *WaitlistEntryMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiry returns the old "expiry" field's value of the Token entity.
-// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// OldMetadata returns the old "metadata" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMutation) OldExpiry(ctx context.Context) (v *time.Time, err error) {
+func (m *WaitlistEntryMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldExpiry is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldMetadata is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldExpiry requires an ID field in the mutation")
+		return v, fmt.Errorf("OldMetadata requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
 	}
-	return oldValue.Expiry, nil
+	return oldValue.Metadata, nil
 }
 
-// ClearExpiry clears the value of the "expiry" field.
-func (m *TokenMutation) ClearExpiry() {
-	m.expiry = nil
-	m.clearedFields[token.FieldExpiry] = struct{}{}
+// ClearMetadata clears the value of the "metadata" field.
+func (m *WaitlistEntryMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[waitlistentry.FieldMetadata] = struct{}{}
 }
 
-// ExpiryCleared returns if the "expiry" field was cleared in this mutation.
-func (m *TokenMutation) ExpiryCleared() bool {
-	_, ok := m.clearedFields[token.FieldExpiry]
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *WaitlistEntryMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[waitlistentry.FieldMetadata]
 	return ok
 }
 
-// ResetExpiry resets all changes to the "expiry" field.
-func (m *TokenMutation) ResetExpiry() {
-	m.expiry = nil
-	delete(m.clearedFields, token.FieldExpiry)
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *WaitlistEntryMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, waitlistentry.FieldMetadata)
 }
 
-// SetUserId sets the "userId" field.
-func (m *TokenMutation) SetUserId(i int) {
-	m.user = &i
+// SetResourceId sets the "resourceId" field.
+func (m *WaitlistEntryMutation) SetResourceId(i int) {
+	m.resource = &i
 }
 
-// UserId returns the value of the "userId" field in the mutation.
-func (m *TokenMutation) UserId() (r int, exists bool) {
-	v := m.user
+// ResourceId returns the value of the "resourceId" field in the mutation.
+func (m *WaitlistEntryMutation) ResourceId() (r int, exists bool) {
+	v := m.resource
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserId returns the old "userId" field's value of the Token entity.
-// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// OldResourceId returns the old "resourceId" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMutation) OldUserId(ctx context.Context) (v int, err error) {
+func (m *WaitlistEntryMutation) OldResourceId(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldUserId is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldResourceId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldUserId requires an ID field in the mutation")
+		return v, fmt.Errorf("OldResourceId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserId: %w", err)
+		return v, fmt.Errorf("querying old value for OldResourceId: %w", err)
 	}
-	return oldValue.UserId, nil
+	return oldValue.ResourceId, nil
 }
 
-// ResetUserId resets all changes to the "userId" field.
-func (m *TokenMutation) ResetUserId() {
-	m.user = nil
+// ResetResourceId resets all changes to the "resourceId" field.
+func (m *WaitlistEntryMutation) ResetResourceId() {
+	m.resource = nil
 }
 
-// SetOrganizationId sets the "organizationId" field.
-func (m *TokenMutation) SetOrganizationId(i int) {
-	m.organization = &i
+// SetBookingId sets the "bookingId" field.
+func (m *WaitlistEntryMutation) SetBookingId(i int) {
+	m.booking = &i
 }
 
-// OrganizationId returns the value of the "organizationId" field in the mutation.
-func (m *TokenMutation) OrganizationId() (r int, exists bool) {
-	v := m.organization
+// BookingId returns the value of the "bookingId" field in the mutation.
+func (m *WaitlistEntryMutation) BookingId() (r int, exists bool) {
+	v := m.booking
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOrganizationId returns the old "organizationId" field's value of the Token entity.
-// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// OldBookingId returns the old "bookingId" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMutation) OldOrganizationId(ctx context.Context) (v int, err error) {
+func (m *WaitlistEntryMutation) OldBookingId(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldOrganizationId is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldBookingId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldOrganizationId requires an ID field in the mutation")
+		return v, fmt.Errorf("OldBookingId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOrganizationId: %w", err)
+		return v, fmt.Errorf("querying old value for OldBookingId: %w", err)
 	}
-	return oldValue.OrganizationId, nil
+	return oldValue.BookingId, nil
 }
 
-// ResetOrganizationId resets all changes to the "organizationId" field.
-func (m *TokenMutation) ResetOrganizationId() {
-	m.organization = nil
+// ClearBookingId clears the value of the "bookingId" field.
+func (m *WaitlistEntryMutation) ClearBookingId() {
+	m.booking = nil
+	m.clearedFields[waitlistentry.FieldBookingId] = struct{}{}
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *TokenMutation) SetUserID(id int) {
-	m.user = &id
+// BookingIdCleared returns if the "bookingId" field was cleared in this mutation.
+func (m *WaitlistEntryMutation) BookingIdCleared() bool {
+	_, ok := m.clearedFields[waitlistentry.FieldBookingId]
+	return ok
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *TokenMutation) ClearUser() {
-	m.cleareduser = true
+// ResetBookingId resets all changes to the "bookingId" field.
+func (m *WaitlistEntryMutation) ResetBookingId() {
+	m.booking = nil
+	delete(m.clearedFields, waitlistentry.FieldBookingId)
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *TokenMutation) UserCleared() bool {
-	return m.cleareduser
+// SetOfferedAt sets the "offeredAt" field.
+func (m *WaitlistEntryMutation) SetOfferedAt(t time.Time) {
+	m.offeredAt = &t
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *TokenMutation) UserID() (id int, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// OfferedAt returns the value of the "offeredAt" field in the mutation.
+func (m *WaitlistEntryMutation) OfferedAt() (r time.Time, exists bool) {
+	v := m.offeredAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOfferedAt returns the old "offeredAt" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaitlistEntryMutation) OldOfferedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldOfferedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldOfferedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOfferedAt: %w", err)
+	}
+	return oldValue.OfferedAt, nil
+}
+
+// ClearOfferedAt clears the value of the "offeredAt" field.
+func (m *WaitlistEntryMutation) ClearOfferedAt() {
+	m.offeredAt = nil
+	m.clearedFields[waitlistentry.FieldOfferedAt] = struct{}{}
+}
+
+// OfferedAtCleared returns if the "offeredAt" field was cleared in this mutation.
+func (m *WaitlistEntryMutation) OfferedAtCleared() bool {
+	_, ok := m.clearedFields[waitlistentry.FieldOfferedAt]
+	return ok
+}
+
+// ResetOfferedAt resets all changes to the "offeredAt" field.
+func (m *WaitlistEntryMutation) ResetOfferedAt() {
+	m.offeredAt = nil
+	delete(m.clearedFields, waitlistentry.FieldOfferedAt)
+}
+
+// SetResourceID sets the "resource" edge to the Resource entity by id.
+func (m *WaitlistEntryMutation) SetResourceID(id int) {
+	m.resource = &id
+}
+
+// ClearResource clears the "resource" edge to the Resource entity.
+func (m *WaitlistEntryMutation) ClearResource() {
+	m.clearedresource = true
+}
+
+// ResourceCleared reports if the "resource" edge to the Resource entity was cleared.
+func (m *WaitlistEntryMutation) ResourceCleared() bool {
+	return m.clearedresource
+}
+
+// ResourceID returns the "resource" edge ID in the mutation.
+func (m *WaitlistEntryMutation) ResourceID() (id int, exists bool) {
+	if m.resource != nil {
+		return *m.resource, true
 	}
 	return
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
+// ResourceIDs returns the "resource" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *TokenMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
+// ResourceID instead. It exists only for internal usage by the builders.
+func (m *WaitlistEntryMutation) ResourceIDs() (ids []int) {
+	if id := m.resource; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *TokenMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetResource resets all changes to the "resource" edge.
+func (m *WaitlistEntryMutation) ResetResource() {
+	m.resource = nil
+	m.clearedresource = false
 }
 
-// SetOrganizationID sets the "organization" edge to the Organization entity by id.
-func (m *TokenMutation) SetOrganizationID(id int) {
-	m.organization = &id
+// SetBookingID sets the "booking" edge to the Booking entity by id.
+func (m *WaitlistEntryMutation) SetBookingID(id int) {
+	m.booking = &id
 }
 
-// ClearOrganization clears the "organization" edge to the Organization entity.
-func (m *TokenMutation) ClearOrganization() {
-	m.clearedorganization = true
+// ClearBooking clears the "booking" edge to the Booking entity.
+func (m *WaitlistEntryMutation) ClearBooking() {
+	m.clearedbooking = true
 }
 
-// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
-func (m *TokenMutation) OrganizationCleared() bool {
-	return m.clearedorganization
+// BookingCleared reports if the "booking" edge to the Booking entity was cleared.
+func (m *WaitlistEntryMutation) BookingCleared() bool {
+	return m.BookingIdCleared() || m.clearedbooking
 }
 
-// OrganizationID returns the "organization" edge ID in the mutation.
-func (m *TokenMutation) OrganizationID() (id int, exists bool) {
-	if m.organization != nil {
-		return *m.organization, true
+// BookingID returns the "booking" edge ID in the mutation.
+func (m *WaitlistEntryMutation) BookingID() (id int, exists bool) {
+	if m.booking != nil {
+		return *m.booking, true
 	}
 	return
 }
 
-// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// BookingIDs returns the "booking" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OrganizationID instead. It exists only for internal usage by the builders.
-func (m *TokenMutation) OrganizationIDs() (ids []int) {
-	if id := m.organization; id != nil {
+// BookingID instead. It exists only for internal usage by the builders.
+func (m *WaitlistEntryMutation) BookingIDs() (ids []int) {
+	if id := m.booking; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetOrganization resets all changes to the "organization" edge.
-func (m *TokenMutation) ResetOrganization() {
-	m.organization = nil
-	m.clearedorganization = false
+// ResetBooking resets all changes to the "booking" edge.
+func (m *WaitlistEntryMutation) ResetBooking() {
+	m.booking = nil
+	m.clearedbooking = false
 }
 
-// Where appends a list predicates to the TokenMutation builder.
-func (m *TokenMutation) Where(ps ...predicate.Token) {
+// Where appends a list predicates to the WaitlistEntryMutation builder.
+func (m *WaitlistEntryMutation) Where(ps ...predicate.WaitlistEntry) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *TokenMutation) Op() Op {
+func (m *WaitlistEntryMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Token).
-func (m *TokenMutation) Type() string {
+// Type returns the node type of this mutation (WaitlistEntry).
+func (m *WaitlistEntryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TokenMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *WaitlistEntryMutation) Fields() []string {
+	fields := make([]string, 0, 10)
 	if m.createdAt != nil {
-		fields = append(fields, token.FieldCreatedAt)
+		fields = append(fields, waitlistentry.FieldCreatedAt)
 	}
 	if m.updatedAt != nil {
-		fields = append(fields, token.FieldUpdatedAt)
+		fields = append(fields, waitlistentry.FieldUpdatedAt)
 	}
-	if m.name != nil {
-		fields = append(fields, token.FieldName)
+	if m.status != nil {
+		fields = append(fields, waitlistentry.FieldStatus)
 	}
-	if m.expiry != nil {
-		fields = append(fields, token.FieldExpiry)
+	if m.startTime != nil {
+		fields = append(fields, waitlistentry.FieldStartTime)
 	}
-	if m.user != nil {
-		fields = append(fields, token.FieldUserId)
+	if m.endTime != nil {
+		fields = append(fields, waitlistentry.FieldEndTime)
 	}
-	if m.organization != nil {
-		fields = append(fields, token.FieldOrganizationId)
+	if m.priority != nil {
+		fields = append(fields, waitlistentry.FieldPriority)
+	}
+	if m.metadata != nil {
+		fields = append(fields, waitlistentry.FieldMetadata)
+	}
+	if m.resource != nil {
+		fields = append(fields, waitlistentry.FieldResourceId)
+	}
+	if m.booking != nil {
+		fields = append(fields, waitlistentry.FieldBookingId)
+	}
+	if m.offeredAt != nil {
+		fields = append(fields, waitlistentry.FieldOfferedAt)
 	}
 	return fields
 }
@@ -5585,20 +18120,28 @@ func (m *TokenMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TokenMutation) Field(name string) (ent.Value, bool) {
+func (m *WaitlistEntryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case token.FieldCreatedAt:
+	case waitlistentry.FieldCreatedAt:
 		return m.CreatedAt()
-	case token.FieldUpdatedAt:
+	case waitlistentry.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case token.FieldName:
-		return m.Name()
-	case token.FieldExpiry:
-		return m.Expiry()
-	case token.FieldUserId:
-		return m.UserId()
-	case token.FieldOrganizationId:
-		return m.OrganizationId()
+	case waitlistentry.FieldStatus:
+		return m.Status()
+	case waitlistentry.FieldStartTime:
+		return m.StartTime()
+	case waitlistentry.FieldEndTime:
+		return m.EndTime()
+	case waitlistentry.FieldPriority:
+		return m.Priority()
+	case waitlistentry.FieldMetadata:
+		return m.Metadata()
+	case waitlistentry.FieldResourceId:
+		return m.ResourceId()
+	case waitlistentry.FieldBookingId:
+		return m.BookingId()
+	case waitlistentry.FieldOfferedAt:
+		return m.OfferedAt()
 	}
 	return nil, false
 }
@@ -5606,87 +18149,128 @@ func (m *TokenMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WaitlistEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case token.FieldCreatedAt:
+	case waitlistentry.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case token.FieldUpdatedAt:
+	case waitlistentry.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case token.FieldName:
-		return m.OldName(ctx)
-	case token.FieldExpiry:
-		return m.OldExpiry(ctx)
-	case token.FieldUserId:
-		return m.OldUserId(ctx)
-	case token.FieldOrganizationId:
-		return m.OldOrganizationId(ctx)
+	case waitlistentry.FieldStatus:
+		return m.OldStatus(ctx)
+	case waitlistentry.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case waitlistentry.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case waitlistentry.FieldPriority:
+		return m.OldPriority(ctx)
+	case waitlistentry.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case waitlistentry.FieldResourceId:
+		return m.OldResourceId(ctx)
+	case waitlistentry.FieldBookingId:
+		return m.OldBookingId(ctx)
+	case waitlistentry.FieldOfferedAt:
+		return m.OldOfferedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Token field %s", name)
+	return nil, fmt.Errorf("unknown WaitlistEntry field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TokenMutation) SetField(name string, value ent.Value) error {
+func (m *WaitlistEntryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case token.FieldCreatedAt:
+	case waitlistentry.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case token.FieldUpdatedAt:
+	case waitlistentry.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case token.FieldName:
+	case waitlistentry.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetStatus(v)
 		return nil
-	case token.FieldExpiry:
+	case waitlistentry.FieldStartTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiry(v)
+		m.SetStartTime(v)
 		return nil
-	case token.FieldUserId:
+	case waitlistentry.FieldEndTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndTime(v)
+		return nil
+	case waitlistentry.FieldPriority:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserId(v)
+		m.SetPriority(v)
 		return nil
-	case token.FieldOrganizationId:
+	case waitlistentry.FieldMetadata:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case waitlistentry.FieldResourceId:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOrganizationId(v)
+		m.SetResourceId(v)
+		return nil
+	case waitlistentry.FieldBookingId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBookingId(v)
+		return nil
+	case waitlistentry.FieldOfferedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOfferedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Token field %s", name)
+	return fmt.Errorf("unknown WaitlistEntry field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TokenMutation) AddedFields() []string {
+func (m *WaitlistEntryMutation) AddedFields() []string {
 	var fields []string
+	if m.addpriority != nil {
+		fields = append(fields, waitlistentry.FieldPriority)
+	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TokenMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WaitlistEntryMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case waitlistentry.FieldPriority:
+		return m.AddedPriority()
 	}
 	return nil, false
 }
@@ -5694,88 +18278,119 @@ func (m *TokenMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TokenMutation) AddField(name string, value ent.Value) error {
+func (m *WaitlistEntryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case waitlistentry.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Token numeric field %s", name)
+	return fmt.Errorf("unknown WaitlistEntry numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TokenMutation) ClearedFields() []string {
+func (m *WaitlistEntryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(token.FieldExpiry) {
-		fields = append(fields, token.FieldExpiry)
+	if m.FieldCleared(waitlistentry.FieldMetadata) {
+		fields = append(fields, waitlistentry.FieldMetadata)
+	}
+	if m.FieldCleared(waitlistentry.FieldBookingId) {
+		fields = append(fields, waitlistentry.FieldBookingId)
+	}
+	if m.FieldCleared(waitlistentry.FieldOfferedAt) {
+		fields = append(fields, waitlistentry.FieldOfferedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TokenMutation) FieldCleared(name string) bool {
+func (m *WaitlistEntryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TokenMutation) ClearField(name string) error {
+func (m *WaitlistEntryMutation) ClearField(name string) error {
 	switch name {
-	case token.FieldExpiry:
-		m.ClearExpiry()
+	case waitlistentry.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case waitlistentry.FieldBookingId:
+		m.ClearBookingId()
+		return nil
+	case waitlistentry.FieldOfferedAt:
+		m.ClearOfferedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Token nullable field %s", name)
+	return fmt.Errorf("unknown WaitlistEntry nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TokenMutation) ResetField(name string) error {
+func (m *WaitlistEntryMutation) ResetField(name string) error {
 	switch name {
-	case token.FieldCreatedAt:
+	case waitlistentry.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case token.FieldUpdatedAt:
+	case waitlistentry.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case token.FieldName:
-		m.ResetName()
+	case waitlistentry.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case token.FieldExpiry:
-		m.ResetExpiry()
+	case waitlistentry.FieldStartTime:
+		m.ResetStartTime()
 		return nil
-	case token.FieldUserId:
-		m.ResetUserId()
+	case waitlistentry.FieldEndTime:
+		m.ResetEndTime()
 		return nil
-	case token.FieldOrganizationId:
-		m.ResetOrganizationId()
+	case waitlistentry.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case waitlistentry.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case waitlistentry.FieldResourceId:
+		m.ResetResourceId()
+		return nil
+	case waitlistentry.FieldBookingId:
+		m.ResetBookingId()
+		return nil
+	case waitlistentry.FieldOfferedAt:
+		m.ResetOfferedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Token field %s", name)
+	return fmt.Errorf("unknown WaitlistEntry field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TokenMutation) AddedEdges() []string {
+func (m *WaitlistEntryMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, token.EdgeUser)
+	if m.resource != nil {
+		edges = append(edges, waitlistentry.EdgeResource)
 	}
-	if m.organization != nil {
-		edges = append(edges, token.EdgeOrganization)
+	if m.booking != nil {
+		edges = append(edges, waitlistentry.EdgeBooking)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TokenMutation) AddedIDs(name string) []ent.Value {
+func (m *WaitlistEntryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case token.EdgeUser:
-		if id := m.user; id != nil {
+	case waitlistentry.EdgeResource:
+		if id := m.resource; id != nil {
 			return []ent.Value{*id}
 		}
-	case token.EdgeOrganization:
-		if id := m.organization; id != nil {
+	case waitlistentry.EdgeBooking:
+		if id := m.booking; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -5783,100 +18398,106 @@ func (m *TokenMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TokenMutation) RemovedEdges() []string {
+func (m *WaitlistEntryMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TokenMutation) RemovedIDs(name string) []ent.Value {
+func (m *WaitlistEntryMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TokenMutation) ClearedEdges() []string {
+func (m *WaitlistEntryMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, token.EdgeUser)
+	if m.clearedresource {
+		edges = append(edges, waitlistentry.EdgeResource)
 	}
-	if m.clearedorganization {
-		edges = append(edges, token.EdgeOrganization)
+	if m.clearedbooking {
+		edges = append(edges, waitlistentry.EdgeBooking)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TokenMutation) EdgeCleared(name string) bool {
+func (m *WaitlistEntryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case token.EdgeUser:
-		return m.cleareduser
-	case token.EdgeOrganization:
-		return m.clearedorganization
+	case waitlistentry.EdgeResource:
+		return m.clearedresource
+	case waitlistentry.EdgeBooking:
+		return m.clearedbooking
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TokenMutation) ClearEdge(name string) error {
+func (m *WaitlistEntryMutation) ClearEdge(name string) error {
 	switch name {
-	case token.EdgeUser:
-		m.ClearUser()
+	case waitlistentry.EdgeResource:
+		m.ClearResource()
 		return nil
-	case token.EdgeOrganization:
-		m.ClearOrganization()
+	case waitlistentry.EdgeBooking:
+		m.ClearBooking()
 		return nil
 	}
-	return fmt.Errorf("unknown Token unique edge %s", name)
+	return fmt.Errorf("unknown WaitlistEntry unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TokenMutation) ResetEdge(name string) error {
+func (m *WaitlistEntryMutation) ResetEdge(name string) error {
 	switch name {
-	case token.EdgeUser:
-		m.ResetUser()
+	case waitlistentry.EdgeResource:
+		m.ResetResource()
 		return nil
-	case token.EdgeOrganization:
-		m.ResetOrganization()
+	case waitlistentry.EdgeBooking:
+		m.ResetBooking()
 		return nil
 	}
-	return fmt.Errorf("unknown Token edge %s", name)
+	return fmt.Errorf("unknown WaitlistEntry edge %s", name)
 }
 
-// UnavailabilityMutation represents an operation that mutates the Unavailability nodes in the graph.
-type UnavailabilityMutation struct {
+// WebhookMutation represents an operation that mutates the Webhook nodes in the graph.
+type WebhookMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	createdAt       *time.Time
-	updatedAt       *time.Time
-	startTime       *time.Time
-	endTime         *time.Time
-	clearedFields   map[string]struct{}
-	resource        *int
-	clearedresource bool
-	done            bool
-	oldValue        func(context.Context) (*Unavailability, error)
-	predicates      []predicate.Unavailability
+	op                  Op
+	typ                 string
+	id                  *int
+	createdAt           *time.Time
+	updatedAt           *time.Time
+	url                 *string
+	description         *string
+	eventTypes          *[]string
+	secret              *string
+	enabled             *bool
+	clearedFields       map[string]struct{}
+	organization        *int
+	clearedorganization bool
+	deliveries          map[int]struct{}
+	removeddeliveries   map[int]struct{}
+	cleareddeliveries   bool
+	done                bool
+	oldValue            func(context.Context) (*Webhook, error)
+	predicates          []predicate.Webhook
 }
 
-var _ ent.Mutation = (*UnavailabilityMutation)(nil)
+var _ ent.Mutation = (*WebhookMutation)(nil)
 
-// unavailabilityOption allows management of the mutation configuration using functional options.
-type unavailabilityOption func(*UnavailabilityMutation)
+// webhookOption allows management of the mutation configuration using functional options.
+type webhookOption func(*WebhookMutation)
 
-// newUnavailabilityMutation creates new mutation for the Unavailability entity.
-func newUnavailabilityMutation(c config, op Op, opts ...unavailabilityOption) *UnavailabilityMutation {
-	m := &UnavailabilityMutation{
+// newWebhookMutation creates new mutation for the Webhook entity.
+func newWebhookMutation(c config, op Op, opts ...webhookOption) *WebhookMutation {
+	m := &WebhookMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUnavailability,
+		typ:           TypeWebhook,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5885,20 +18506,20 @@ func newUnavailabilityMutation(c config, op Op, opts ...unavailabilityOption) *U
 	return m
 }
 
-// withUnavailabilityID sets the ID field of the mutation.
-func withUnavailabilityID(id int) unavailabilityOption {
-	return func(m *UnavailabilityMutation) {
+// withWebhookID sets the ID field of the mutation.
+func withWebhookID(id int) webhookOption {
+	return func(m *WebhookMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Unavailability
+			value *Webhook
 		)
-		m.oldValue = func(ctx context.Context) (*Unavailability, error) {
+		m.oldValue = func(ctx context.Context) (*Webhook, error) {
 			once.Do(func() {
 				if m.done {
 					err = fmt.Errorf("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Unavailability.Get(ctx, id)
+					value, err = m.Client().Webhook.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5907,10 +18528,10 @@ func withUnavailabilityID(id int) unavailabilityOption {
 	}
 }
 
-// withUnavailability sets the old Unavailability of the mutation.
-func withUnavailability(node *Unavailability) unavailabilityOption {
-	return func(m *UnavailabilityMutation) {
-		m.oldValue = func(context.Context) (*Unavailability, error) {
+// withWebhook sets the old Webhook of the mutation.
+func withWebhook(node *Webhook) webhookOption {
+	return func(m *WebhookMutation) {
+		m.oldValue = func(context.Context) (*Webhook, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5919,7 +18540,7 @@ func withUnavailability(node *Unavailability) unavailabilityOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UnavailabilityMutation) Client() *Client {
+func (m WebhookMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5927,7 +18548,7 @@ func (m UnavailabilityMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UnavailabilityMutation) Tx() (*Tx, error) {
+func (m WebhookMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
 	}
@@ -5938,7 +18559,7 @@ func (m UnavailabilityMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UnavailabilityMutation) ID() (id int, exists bool) {
+func (m *WebhookMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5946,12 +18567,12 @@ func (m *UnavailabilityMutation) ID() (id int, exists bool) {
 }
 
 // SetCreatedAt sets the "createdAt" field.
-func (m *UnavailabilityMutation) SetCreatedAt(t time.Time) {
+func (m *WebhookMutation) SetCreatedAt(t time.Time) {
 	m.createdAt = &t
 }
 
 // CreatedAt returns the value of the "createdAt" field in the mutation.
-func (m *UnavailabilityMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *WebhookMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.createdAt
 	if v == nil {
 		return
@@ -5959,10 +18580,10 @@ func (m *UnavailabilityMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "createdAt" field's value of the Unavailability entity.
-// If the Unavailability object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "createdAt" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UnavailabilityMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, fmt.Errorf("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -5977,17 +18598,17 @@ func (m *UnavailabilityMutation) OldCreatedAt(ctx context.Context) (v time.Time,
 }
 
 // ResetCreatedAt resets all changes to the "createdAt" field.
-func (m *UnavailabilityMutation) ResetCreatedAt() {
+func (m *WebhookMutation) ResetCreatedAt() {
 	m.createdAt = nil
 }
 
 // SetUpdatedAt sets the "updatedAt" field.
-func (m *UnavailabilityMutation) SetUpdatedAt(t time.Time) {
+func (m *WebhookMutation) SetUpdatedAt(t time.Time) {
 	m.updatedAt = &t
 }
 
 // UpdatedAt returns the value of the "updatedAt" field in the mutation.
-func (m *UnavailabilityMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *WebhookMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updatedAt
 	if v == nil {
 		return
@@ -5995,10 +18616,10 @@ func (m *UnavailabilityMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updatedAt" field's value of the Unavailability entity.
-// If the Unavailability object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updatedAt" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UnavailabilityMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, fmt.Errorf("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -6013,191 +18634,388 @@ func (m *UnavailabilityMutation) OldUpdatedAt(ctx context.Context) (v time.Time,
 }
 
 // ResetUpdatedAt resets all changes to the "updatedAt" field.
-func (m *UnavailabilityMutation) ResetUpdatedAt() {
+func (m *WebhookMutation) ResetUpdatedAt() {
 	m.updatedAt = nil
 }
 
-// SetStartTime sets the "startTime" field.
-func (m *UnavailabilityMutation) SetStartTime(t time.Time) {
-	m.startTime = &t
+// SetURL sets the "url" field.
+func (m *WebhookMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// StartTime returns the value of the "startTime" field in the mutation.
-func (m *UnavailabilityMutation) StartTime() (r time.Time, exists bool) {
-	v := m.startTime
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartTime returns the old "startTime" field's value of the Unavailability entity.
-// If the Unavailability object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UnavailabilityMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldStartTime is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldStartTime requires an ID field in the mutation")
+		return v, fmt.Errorf("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.StartTime, nil
+	return oldValue.URL, nil
 }
 
-// ResetStartTime resets all changes to the "startTime" field.
-func (m *UnavailabilityMutation) ResetStartTime() {
-	m.startTime = nil
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookMutation) ResetURL() {
+	m.url = nil
 }
 
-// SetEndTime sets the "endTime" field.
-func (m *UnavailabilityMutation) SetEndTime(t time.Time) {
-	m.endTime = &t
+// SetDescription sets the "description" field.
+func (m *WebhookMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// EndTime returns the value of the "endTime" field in the mutation.
-func (m *UnavailabilityMutation) EndTime() (r time.Time, exists bool) {
-	v := m.endTime
+// Description returns the value of the "description" field in the mutation.
+func (m *WebhookMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEndTime returns the old "endTime" field's value of the Unavailability entity.
-// If the Unavailability object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UnavailabilityMutation) OldEndTime(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldEndTime is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldEndTime requires an ID field in the mutation")
+		return v, fmt.Errorf("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.EndTime, nil
+	return oldValue.Description, nil
 }
 
-// ResetEndTime resets all changes to the "endTime" field.
-func (m *UnavailabilityMutation) ResetEndTime() {
-	m.endTime = nil
+// ClearDescription clears the value of the "description" field.
+func (m *WebhookMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[webhook.FieldDescription] = struct{}{}
 }
 
-// SetResourceId sets the "resourceId" field.
-func (m *UnavailabilityMutation) SetResourceId(i int) {
-	m.resource = &i
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *WebhookMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldDescription]
+	return ok
 }
 
-// ResourceId returns the value of the "resourceId" field in the mutation.
-func (m *UnavailabilityMutation) ResourceId() (r int, exists bool) {
-	v := m.resource
+// ResetDescription resets all changes to the "description" field.
+func (m *WebhookMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, webhook.FieldDescription)
+}
+
+// SetEventTypes sets the "eventTypes" field.
+func (m *WebhookMutation) SetEventTypes(s []string) {
+	m.eventTypes = &s
+}
+
+// EventTypes returns the value of the "eventTypes" field in the mutation.
+func (m *WebhookMutation) EventTypes() (r []string, exists bool) {
+	v := m.eventTypes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResourceId returns the old "resourceId" field's value of the Unavailability entity.
-// If the Unavailability object wasn't provided to the builder, the object is fetched from the database.
+// OldEventTypes returns the old "eventTypes" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UnavailabilityMutation) OldResourceId(ctx context.Context) (v int, err error) {
+func (m *WebhookMutation) OldEventTypes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldResourceId is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldEventTypes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldResourceId requires an ID field in the mutation")
+		return v, fmt.Errorf("OldEventTypes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResourceId: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventTypes: %w", err)
 	}
-	return oldValue.ResourceId, nil
+	return oldValue.EventTypes, nil
 }
 
-// ResetResourceId resets all changes to the "resourceId" field.
-func (m *UnavailabilityMutation) ResetResourceId() {
-	m.resource = nil
+// ClearEventTypes clears the value of the "eventTypes" field.
+func (m *WebhookMutation) ClearEventTypes() {
+	m.eventTypes = nil
+	m.clearedFields[webhook.FieldEventTypes] = struct{}{}
 }
 
-// SetResourceID sets the "resource" edge to the Resource entity by id.
-func (m *UnavailabilityMutation) SetResourceID(id int) {
-	m.resource = &id
+// EventTypesCleared returns if the "eventTypes" field was cleared in this mutation.
+func (m *WebhookMutation) EventTypesCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldEventTypes]
+	return ok
 }
 
-// ClearResource clears the "resource" edge to the Resource entity.
-func (m *UnavailabilityMutation) ClearResource() {
-	m.clearedresource = true
+// ResetEventTypes resets all changes to the "eventTypes" field.
+func (m *WebhookMutation) ResetEventTypes() {
+	m.eventTypes = nil
+	delete(m.clearedFields, webhook.FieldEventTypes)
 }
 
-// ResourceCleared reports if the "resource" edge to the Resource entity was cleared.
-func (m *UnavailabilityMutation) ResourceCleared() bool {
-	return m.clearedresource
+// SetSecret sets the "secret" field.
+func (m *WebhookMutation) SetSecret(s string) {
+	m.secret = &s
 }
 
-// ResourceID returns the "resource" edge ID in the mutation.
-func (m *UnavailabilityMutation) ResourceID() (id int, exists bool) {
-	if m.resource != nil {
-		return *m.resource, true
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *WebhookMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *WebhookMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *WebhookMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (m *WebhookMutation) SetOrganizationId(i int) {
+	m.organization = &i
+}
+
+// OrganizationId returns the value of the "organizationId" field in the mutation.
+func (m *WebhookMutation) OrganizationId() (r int, exists bool) {
+	v := m.organization
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationId returns the old "organizationId" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldOrganizationId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldOrganizationId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldOrganizationId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationId: %w", err)
+	}
+	return oldValue.OrganizationId, nil
+}
+
+// ResetOrganizationId resets all changes to the "organizationId" field.
+func (m *WebhookMutation) ResetOrganizationId() {
+	m.organization = nil
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by id.
+func (m *WebhookMutation) SetOrganizationID(id int) {
+	m.organization = &id
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *WebhookMutation) ClearOrganization() {
+	m.clearedorganization = true
+}
+
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *WebhookMutation) OrganizationCleared() bool {
+	return m.clearedorganization
+}
+
+// OrganizationID returns the "organization" edge ID in the mutation.
+func (m *WebhookMutation) OrganizationID() (id int, exists bool) {
+	if m.organization != nil {
+		return *m.organization, true
 	}
 	return
 }
 
-// ResourceIDs returns the "resource" edge IDs in the mutation.
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ResourceID instead. It exists only for internal usage by the builders.
-func (m *UnavailabilityMutation) ResourceIDs() (ids []int) {
-	if id := m.resource; id != nil {
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *WebhookMutation) OrganizationIDs() (ids []int) {
+	if id := m.organization; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetResource resets all changes to the "resource" edge.
-func (m *UnavailabilityMutation) ResetResource() {
-	m.resource = nil
-	m.clearedresource = false
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *WebhookMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
 }
 
-// Where appends a list predicates to the UnavailabilityMutation builder.
-func (m *UnavailabilityMutation) Where(ps ...predicate.Unavailability) {
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by ids.
+func (m *WebhookMutation) AddDeliveryIDs(ids ...int) {
+	if m.deliveries == nil {
+		m.deliveries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.deliveries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDeliveries clears the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookMutation) ClearDeliveries() {
+	m.cleareddeliveries = true
+}
+
+// DeliveriesCleared reports if the "deliveries" edge to the WebhookDelivery entity was cleared.
+func (m *WebhookMutation) DeliveriesCleared() bool {
+	return m.cleareddeliveries
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (m *WebhookMutation) RemoveDeliveryIDs(ids ...int) {
+	if m.removeddeliveries == nil {
+		m.removeddeliveries = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.deliveries, ids[i])
+		m.removeddeliveries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDeliveries returns the removed IDs of the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookMutation) RemovedDeliveriesIDs() (ids []int) {
+	for id := range m.removeddeliveries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DeliveriesIDs returns the "deliveries" edge IDs in the mutation.
+func (m *WebhookMutation) DeliveriesIDs() (ids []int) {
+	for id := range m.deliveries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDeliveries resets all changes to the "deliveries" edge.
+func (m *WebhookMutation) ResetDeliveries() {
+	m.deliveries = nil
+	m.cleareddeliveries = false
+	m.removeddeliveries = nil
+}
+
+// Where appends a list predicates to the WebhookMutation builder.
+func (m *WebhookMutation) Where(ps ...predicate.Webhook) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *UnavailabilityMutation) Op() Op {
+func (m *WebhookMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Unavailability).
-func (m *UnavailabilityMutation) Type() string {
+// Type returns the node type of this mutation (Webhook).
+func (m *WebhookMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UnavailabilityMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+func (m *WebhookMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.createdAt != nil {
-		fields = append(fields, unavailability.FieldCreatedAt)
+		fields = append(fields, webhook.FieldCreatedAt)
 	}
 	if m.updatedAt != nil {
-		fields = append(fields, unavailability.FieldUpdatedAt)
+		fields = append(fields, webhook.FieldUpdatedAt)
 	}
-	if m.startTime != nil {
-		fields = append(fields, unavailability.FieldStartTime)
+	if m.url != nil {
+		fields = append(fields, webhook.FieldURL)
 	}
-	if m.endTime != nil {
-		fields = append(fields, unavailability.FieldEndTime)
+	if m.description != nil {
+		fields = append(fields, webhook.FieldDescription)
 	}
-	if m.resource != nil {
-		fields = append(fields, unavailability.FieldResourceId)
+	if m.eventTypes != nil {
+		fields = append(fields, webhook.FieldEventTypes)
+	}
+	if m.secret != nil {
+		fields = append(fields, webhook.FieldSecret)
+	}
+	if m.enabled != nil {
+		fields = append(fields, webhook.FieldEnabled)
+	}
+	if m.organization != nil {
+		fields = append(fields, webhook.FieldOrganizationId)
 	}
 	return fields
 }
@@ -6205,18 +19023,24 @@ func (m *UnavailabilityMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UnavailabilityMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case unavailability.FieldCreatedAt:
+	case webhook.FieldCreatedAt:
 		return m.CreatedAt()
-	case unavailability.FieldUpdatedAt:
+	case webhook.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case unavailability.FieldStartTime:
-		return m.StartTime()
-	case unavailability.FieldEndTime:
-		return m.EndTime()
-	case unavailability.FieldResourceId:
-		return m.ResourceId()
+	case webhook.FieldURL:
+		return m.URL()
+	case webhook.FieldDescription:
+		return m.Description()
+	case webhook.FieldEventTypes:
+		return m.EventTypes()
+	case webhook.FieldSecret:
+		return m.Secret()
+	case webhook.FieldEnabled:
+		return m.Enabled()
+	case webhook.FieldOrganizationId:
+		return m.OrganizationId()
 	}
 	return nil, false
 }
@@ -6224,69 +19048,96 @@ func (m *UnavailabilityMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UnavailabilityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case unavailability.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case unavailability.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case unavailability.FieldStartTime:
-		return m.OldStartTime(ctx)
-	case unavailability.FieldEndTime:
-		return m.OldEndTime(ctx)
-	case unavailability.FieldResourceId:
-		return m.OldResourceId(ctx)
+	case webhook.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case webhook.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case webhook.FieldURL:
+		return m.OldURL(ctx)
+	case webhook.FieldDescription:
+		return m.OldDescription(ctx)
+	case webhook.FieldEventTypes:
+		return m.OldEventTypes(ctx)
+	case webhook.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhook.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case webhook.FieldOrganizationId:
+		return m.OldOrganizationId(ctx)
 	}
-	return nil, fmt.Errorf("unknown Unavailability field %s", name)
+	return nil, fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UnavailabilityMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case unavailability.FieldCreatedAt:
+	case webhook.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case unavailability.FieldUpdatedAt:
+	case webhook.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case unavailability.FieldStartTime:
-		v, ok := value.(time.Time)
+	case webhook.FieldURL:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStartTime(v)
+		m.SetURL(v)
 		return nil
-	case unavailability.FieldEndTime:
-		v, ok := value.(time.Time)
+	case webhook.FieldDescription:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEndTime(v)
+		m.SetDescription(v)
 		return nil
-	case unavailability.FieldResourceId:
+	case webhook.FieldEventTypes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventTypes(v)
+		return nil
+	case webhook.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case webhook.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case webhook.FieldOrganizationId:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResourceId(v)
+		m.SetOrganizationId(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Unavailability field %s", name)
+	return fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UnavailabilityMutation) AddedFields() []string {
+func (m *WebhookMutation) AddedFields() []string {
 	var fields []string
 	return fields
 }
@@ -6294,7 +19145,7 @@ func (m *UnavailabilityMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UnavailabilityMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	}
 	return nil, false
@@ -6303,165 +19154,219 @@ func (m *UnavailabilityMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UnavailabilityMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Unavailability numeric field %s", name)
+	return fmt.Errorf("unknown Webhook numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UnavailabilityMutation) ClearedFields() []string {
-	return nil
+func (m *WebhookMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(webhook.FieldDescription) {
+		fields = append(fields, webhook.FieldDescription)
+	}
+	if m.FieldCleared(webhook.FieldEventTypes) {
+		fields = append(fields, webhook.FieldEventTypes)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UnavailabilityMutation) FieldCleared(name string) bool {
+func (m *WebhookMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UnavailabilityMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Unavailability nullable field %s", name)
+func (m *WebhookMutation) ClearField(name string) error {
+	switch name {
+	case webhook.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case webhook.FieldEventTypes:
+		m.ClearEventTypes()
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UnavailabilityMutation) ResetField(name string) error {
+func (m *WebhookMutation) ResetField(name string) error {
 	switch name {
-	case unavailability.FieldCreatedAt:
+	case webhook.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case unavailability.FieldUpdatedAt:
+	case webhook.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case unavailability.FieldStartTime:
-		m.ResetStartTime()
+	case webhook.FieldURL:
+		m.ResetURL()
 		return nil
-	case unavailability.FieldEndTime:
-		m.ResetEndTime()
+	case webhook.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case unavailability.FieldResourceId:
-		m.ResetResourceId()
+	case webhook.FieldEventTypes:
+		m.ResetEventTypes()
+		return nil
+	case webhook.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case webhook.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case webhook.FieldOrganizationId:
+		m.ResetOrganizationId()
 		return nil
 	}
-	return fmt.Errorf("unknown Unavailability field %s", name)
+	return fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UnavailabilityMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.resource != nil {
-		edges = append(edges, unavailability.EdgeResource)
+func (m *WebhookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.organization != nil {
+		edges = append(edges, webhook.EdgeOrganization)
+	}
+	if m.deliveries != nil {
+		edges = append(edges, webhook.EdgeDeliveries)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UnavailabilityMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case unavailability.EdgeResource:
-		if id := m.resource; id != nil {
+	case webhook.EdgeOrganization:
+		if id := m.organization; id != nil {
 			return []ent.Value{*id}
 		}
+	case webhook.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.deliveries))
+		for id := range m.deliveries {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UnavailabilityMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *WebhookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeddeliveries != nil {
+		edges = append(edges, webhook.EdgeDeliveries)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UnavailabilityMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
+	case webhook.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.removeddeliveries))
+		for id := range m.removeddeliveries {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UnavailabilityMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedresource {
-		edges = append(edges, unavailability.EdgeResource)
+func (m *WebhookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedorganization {
+		edges = append(edges, webhook.EdgeOrganization)
+	}
+	if m.cleareddeliveries {
+		edges = append(edges, webhook.EdgeDeliveries)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UnavailabilityMutation) EdgeCleared(name string) bool {
+func (m *WebhookMutation) EdgeCleared(name string) bool {
 	switch name {
-	case unavailability.EdgeResource:
-		return m.clearedresource
+	case webhook.EdgeOrganization:
+		return m.clearedorganization
+	case webhook.EdgeDeliveries:
+		return m.cleareddeliveries
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UnavailabilityMutation) ClearEdge(name string) error {
+func (m *WebhookMutation) ClearEdge(name string) error {
 	switch name {
-	case unavailability.EdgeResource:
-		m.ClearResource()
+	case webhook.EdgeOrganization:
+		m.ClearOrganization()
 		return nil
 	}
-	return fmt.Errorf("unknown Unavailability unique edge %s", name)
+	return fmt.Errorf("unknown Webhook unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UnavailabilityMutation) ResetEdge(name string) error {
+func (m *WebhookMutation) ResetEdge(name string) error {
 	switch name {
-	case unavailability.EdgeResource:
-		m.ResetResource()
+	case webhook.EdgeOrganization:
+		m.ResetOrganization()
+		return nil
+	case webhook.EdgeDeliveries:
+		m.ResetDeliveries()
 		return nil
 	}
-	return fmt.Errorf("unknown Unavailability edge %s", name)
+	return fmt.Errorf("unknown Webhook edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *int
-	createdAt           *time.Time
-	updatedAt           *time.Time
-	name                *string
-	email               *string
-	clearedFields       map[string]struct{}
-	auths               map[int]struct{}
-	removedauths        map[int]struct{}
-	clearedauths        bool
-	tokens              map[string]struct{}
-	removedtokens       map[string]struct{}
-	clearedtokens       bool
-	organization        *int
-	clearedorganization bool
-	done                bool
-	oldValue            func(context.Context) (*User, error)
-	predicates          []predicate.User
-}
-
-var _ ent.Mutation = (*UserMutation)(nil)
-
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
-
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+	op                Op
+	typ               string
+	id                *int
+	createdAt         *time.Time
+	updatedAt         *time.Time
+	eventType         *string
+	payload           *string
+	status            *string
+	attempts          *int
+	addattempts       *int
+	nextAttemptAt     *time.Time
+	lastAttemptAt     *time.Time
+	responseStatus    *int
+	addresponseStatus *int
+	lastError         *string
+	organizationId    *int
+	addorganizationId *int
+	clearedFields     map[string]struct{}
+	webhook           *int
+	clearedwebhook    bool
+	done              bool
+	oldValue          func(context.Context) (*WebhookDelivery, error)
+	predicates        []predicate.WebhookDelivery
+}
+
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
+
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
+
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeWebhookDelivery,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6470,20 +19375,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id int) userOption {
-	return func(m *UserMutation) {
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id int) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *WebhookDelivery
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
 			once.Do(func() {
 				if m.done {
 					err = fmt.Errorf("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6492,418 +19397,670 @@ func withUserID(id int) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
 			return node, nil
 		}
 		m.id = &node.ID
 	}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebhookDeliveryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookDeliveryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (m *WebhookDeliveryMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
+}
+
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *WebhookDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "createdAt" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *WebhookDeliveryMutation) ResetCreatedAt() {
+	m.createdAt = nil
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (m *WebhookDeliveryMutation) SetUpdatedAt(t time.Time) {
+	m.updatedAt = &t
+}
+
+// UpdatedAt returns the value of the "updatedAt" field in the mutation.
+func (m *WebhookDeliveryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updatedAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updatedAt" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updatedAt" field.
+func (m *WebhookDeliveryMutation) ResetUpdatedAt() {
+	m.updatedAt = nil
+}
+
+// SetEventType sets the "eventType" field.
+func (m *WebhookDeliveryMutation) SetEventType(s string) {
+	m.eventType = &s
+}
+
+// EventType returns the value of the "eventType" field in the mutation.
+func (m *WebhookDeliveryMutation) EventType() (r string, exists bool) {
+	v := m.eventType
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventType returns the old "eventType" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldEventType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldEventType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldEventType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+	}
+	return oldValue.EventType, nil
+}
+
+// ResetEventType resets all changes to the "eventType" field.
+func (m *WebhookDeliveryMutation) ResetEventType() {
+	m.eventType = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *WebhookDeliveryMutation) SetPayload(s string) {
+	m.payload = &s
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeliveryMutation) Payload() (r string, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return *v, true
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
+// OldPayload returns the old "payload" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldPayload(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldPayload is only allowed on UpdateOne operations")
 	}
-	return *m.id, true
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
 }
 
-// SetCreatedAt sets the "createdAt" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
-	m.createdAt = &t
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeliveryMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// CreatedAt returns the value of the "createdAt" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.createdAt
+// SetStatus sets the "status" field.
+func (m *WebhookDeliveryMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *WebhookDeliveryMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "createdAt" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
+		return v, fmt.Errorf("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Status, nil
 }
 
-// ResetCreatedAt resets all changes to the "createdAt" field.
-func (m *UserMutation) ResetCreatedAt() {
-	m.createdAt = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *WebhookDeliveryMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetUpdatedAt sets the "updatedAt" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
-	m.updatedAt = &t
+// SetAttempts sets the "attempts" field.
+func (m *WebhookDeliveryMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// UpdatedAt returns the value of the "updatedAt" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updatedAt
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updatedAt" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempts returns the old "attempts" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldAttempts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldUpdatedAt requires an ID field in the mutation")
+		return v, fmt.Errorf("OldAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Attempts, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updatedAt" field.
-func (m *UserMutation) ResetUpdatedAt() {
-	m.updatedAt = nil
+// AddAttempts adds i to the "attempts" field.
+func (m *WebhookDeliveryMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
 }
 
-// SetName sets the "name" field.
-func (m *UserMutation) SetName(s string) {
-	m.name = &s
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *UserMutation) Name() (r string, exists bool) {
-	v := m.name
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WebhookDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetNextAttemptAt sets the "nextAttemptAt" field.
+func (m *WebhookDeliveryMutation) SetNextAttemptAt(t time.Time) {
+	m.nextAttemptAt = &t
+}
+
+// NextAttemptAt returns the value of the "nextAttemptAt" field in the mutation.
+func (m *WebhookDeliveryMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.nextAttemptAt
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldNextAttemptAt returns the old "nextAttemptAt" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldNextAttemptAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldName is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldNextAttemptAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+		return v, fmt.Errorf("OldNextAttemptAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.NextAttemptAt, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *UserMutation) ResetName() {
-	m.name = nil
+// ResetNextAttemptAt resets all changes to the "nextAttemptAt" field.
+func (m *WebhookDeliveryMutation) ResetNextAttemptAt() {
+	m.nextAttemptAt = nil
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// SetLastAttemptAt sets the "lastAttemptAt" field.
+func (m *WebhookDeliveryMutation) SetLastAttemptAt(t time.Time) {
+	m.lastAttemptAt = &t
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
+// LastAttemptAt returns the value of the "lastAttemptAt" field in the mutation.
+func (m *WebhookDeliveryMutation) LastAttemptAt() (r time.Time, exists bool) {
+	v := m.lastAttemptAt
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldLastAttemptAt returns the old "lastAttemptAt" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldLastAttemptAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldEmail is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldLastAttemptAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldEmail requires an ID field in the mutation")
+		return v, fmt.Errorf("OldLastAttemptAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastAttemptAt: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.LastAttemptAt, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+// ClearLastAttemptAt clears the value of the "lastAttemptAt" field.
+func (m *WebhookDeliveryMutation) ClearLastAttemptAt() {
+	m.lastAttemptAt = nil
+	m.clearedFields[webhookdelivery.FieldLastAttemptAt] = struct{}{}
 }
 
-// SetOrganizationId sets the "organizationId" field.
-func (m *UserMutation) SetOrganizationId(i int) {
-	m.organization = &i
+// LastAttemptAtCleared returns if the "lastAttemptAt" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) LastAttemptAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldLastAttemptAt]
+	return ok
 }
 
-// OrganizationId returns the value of the "organizationId" field in the mutation.
-func (m *UserMutation) OrganizationId() (r int, exists bool) {
-	v := m.organization
+// ResetLastAttemptAt resets all changes to the "lastAttemptAt" field.
+func (m *WebhookDeliveryMutation) ResetLastAttemptAt() {
+	m.lastAttemptAt = nil
+	delete(m.clearedFields, webhookdelivery.FieldLastAttemptAt)
+}
+
+// SetResponseStatus sets the "responseStatus" field.
+func (m *WebhookDeliveryMutation) SetResponseStatus(i int) {
+	m.responseStatus = &i
+	m.addresponseStatus = nil
+}
+
+// ResponseStatus returns the value of the "responseStatus" field in the mutation.
+func (m *WebhookDeliveryMutation) ResponseStatus() (r int, exists bool) {
+	v := m.responseStatus
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOrganizationId returns the old "organizationId" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldResponseStatus returns the old "responseStatus" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldOrganizationId(ctx context.Context) (v *int, err error) {
+func (m *WebhookDeliveryMutation) OldResponseStatus(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldOrganizationId is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldResponseStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldOrganizationId requires an ID field in the mutation")
+		return v, fmt.Errorf("OldResponseStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOrganizationId: %w", err)
+		return v, fmt.Errorf("querying old value for OldResponseStatus: %w", err)
 	}
-	return oldValue.OrganizationId, nil
+	return oldValue.ResponseStatus, nil
 }
 
-// ClearOrganizationId clears the value of the "organizationId" field.
-func (m *UserMutation) ClearOrganizationId() {
-	m.organization = nil
-	m.clearedFields[user.FieldOrganizationId] = struct{}{}
+// AddResponseStatus adds i to the "responseStatus" field.
+func (m *WebhookDeliveryMutation) AddResponseStatus(i int) {
+	if m.addresponseStatus != nil {
+		*m.addresponseStatus += i
+	} else {
+		m.addresponseStatus = &i
+	}
 }
 
-// OrganizationIdCleared returns if the "organizationId" field was cleared in this mutation.
-func (m *UserMutation) OrganizationIdCleared() bool {
-	_, ok := m.clearedFields[user.FieldOrganizationId]
-	return ok
+// AddedResponseStatus returns the value that was added to the "responseStatus" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedResponseStatus() (r int, exists bool) {
+	v := m.addresponseStatus
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetOrganizationId resets all changes to the "organizationId" field.
-func (m *UserMutation) ResetOrganizationId() {
-	m.organization = nil
-	delete(m.clearedFields, user.FieldOrganizationId)
+// ClearResponseStatus clears the value of the "responseStatus" field.
+func (m *WebhookDeliveryMutation) ClearResponseStatus() {
+	m.responseStatus = nil
+	m.addresponseStatus = nil
+	m.clearedFields[webhookdelivery.FieldResponseStatus] = struct{}{}
 }
 
-// AddAuthIDs adds the "auths" edge to the Auth entity by ids.
-func (m *UserMutation) AddAuthIDs(ids ...int) {
-	if m.auths == nil {
-		m.auths = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.auths[ids[i]] = struct{}{}
-	}
+// ResponseStatusCleared returns if the "responseStatus" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ResponseStatusCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldResponseStatus]
+	return ok
 }
 
-// ClearAuths clears the "auths" edge to the Auth entity.
-func (m *UserMutation) ClearAuths() {
-	m.clearedauths = true
+// ResetResponseStatus resets all changes to the "responseStatus" field.
+func (m *WebhookDeliveryMutation) ResetResponseStatus() {
+	m.responseStatus = nil
+	m.addresponseStatus = nil
+	delete(m.clearedFields, webhookdelivery.FieldResponseStatus)
 }
 
-// AuthsCleared reports if the "auths" edge to the Auth entity was cleared.
-func (m *UserMutation) AuthsCleared() bool {
-	return m.clearedauths
+// SetLastError sets the "lastError" field.
+func (m *WebhookDeliveryMutation) SetLastError(s string) {
+	m.lastError = &s
 }
 
-// RemoveAuthIDs removes the "auths" edge to the Auth entity by IDs.
-func (m *UserMutation) RemoveAuthIDs(ids ...int) {
-	if m.removedauths == nil {
-		m.removedauths = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.auths, ids[i])
-		m.removedauths[ids[i]] = struct{}{}
+// LastError returns the value of the "lastError" field in the mutation.
+func (m *WebhookDeliveryMutation) LastError() (r string, exists bool) {
+	v := m.lastError
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedAuths returns the removed IDs of the "auths" edge to the Auth entity.
-func (m *UserMutation) RemovedAuthsIDs() (ids []int) {
-	for id := range m.removedauths {
-		ids = append(ids, id)
+// OldLastError returns the old "lastError" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldLastError is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
 }
 
-// AuthsIDs returns the "auths" edge IDs in the mutation.
-func (m *UserMutation) AuthsIDs() (ids []int) {
-	for id := range m.auths {
-		ids = append(ids, id)
-	}
-	return
+// ClearLastError clears the value of the "lastError" field.
+func (m *WebhookDeliveryMutation) ClearLastError() {
+	m.lastError = nil
+	m.clearedFields[webhookdelivery.FieldLastError] = struct{}{}
 }
 
-// ResetAuths resets all changes to the "auths" edge.
-func (m *UserMutation) ResetAuths() {
-	m.auths = nil
-	m.clearedauths = false
-	m.removedauths = nil
+// LastErrorCleared returns if the "lastError" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldLastError]
+	return ok
 }
 
-// AddTokenIDs adds the "tokens" edge to the Token entity by ids.
-func (m *UserMutation) AddTokenIDs(ids ...string) {
-	if m.tokens == nil {
-		m.tokens = make(map[string]struct{})
+// ResetLastError resets all changes to the "lastError" field.
+func (m *WebhookDeliveryMutation) ResetLastError() {
+	m.lastError = nil
+	delete(m.clearedFields, webhookdelivery.FieldLastError)
+}
+
+// SetWebhookId sets the "webhookId" field.
+func (m *WebhookDeliveryMutation) SetWebhookId(i int) {
+	m.webhook = &i
+}
+
+// WebhookId returns the value of the "webhookId" field in the mutation.
+func (m *WebhookDeliveryMutation) WebhookId() (r int, exists bool) {
+	v := m.webhook
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.tokens[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldWebhookId returns the old "webhookId" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldWebhookId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldWebhookId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldWebhookId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWebhookId: %w", err)
 	}
+	return oldValue.WebhookId, nil
 }
 
-// ClearTokens clears the "tokens" edge to the Token entity.
-func (m *UserMutation) ClearTokens() {
-	m.clearedtokens = true
+// ResetWebhookId resets all changes to the "webhookId" field.
+func (m *WebhookDeliveryMutation) ResetWebhookId() {
+	m.webhook = nil
 }
 
-// TokensCleared reports if the "tokens" edge to the Token entity was cleared.
-func (m *UserMutation) TokensCleared() bool {
-	return m.clearedtokens
+// SetOrganizationId sets the "organizationId" field.
+func (m *WebhookDeliveryMutation) SetOrganizationId(i int) {
+	m.organizationId = &i
+	m.addorganizationId = nil
 }
 
-// RemoveTokenIDs removes the "tokens" edge to the Token entity by IDs.
-func (m *UserMutation) RemoveTokenIDs(ids ...string) {
-	if m.removedtokens == nil {
-		m.removedtokens = make(map[string]struct{})
+// OrganizationId returns the value of the "organizationId" field in the mutation.
+func (m *WebhookDeliveryMutation) OrganizationId() (r int, exists bool) {
+	v := m.organizationId
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.tokens, ids[i])
-		m.removedtokens[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldOrganizationId returns the old "organizationId" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldOrganizationId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldOrganizationId is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldOrganizationId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationId: %w", err)
+	}
+	return oldValue.OrganizationId, nil
 }
 
-// RemovedTokens returns the removed IDs of the "tokens" edge to the Token entity.
-func (m *UserMutation) RemovedTokensIDs() (ids []string) {
-	for id := range m.removedtokens {
-		ids = append(ids, id)
+// AddOrganizationId adds i to the "organizationId" field.
+func (m *WebhookDeliveryMutation) AddOrganizationId(i int) {
+	if m.addorganizationId != nil {
+		*m.addorganizationId += i
+	} else {
+		m.addorganizationId = &i
 	}
-	return
 }
 
-// TokensIDs returns the "tokens" edge IDs in the mutation.
-func (m *UserMutation) TokensIDs() (ids []string) {
-	for id := range m.tokens {
-		ids = append(ids, id)
+// AddedOrganizationId returns the value that was added to the "organizationId" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedOrganizationId() (r int, exists bool) {
+	v := m.addorganizationId
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetTokens resets all changes to the "tokens" edge.
-func (m *UserMutation) ResetTokens() {
-	m.tokens = nil
-	m.clearedtokens = false
-	m.removedtokens = nil
+// ResetOrganizationId resets all changes to the "organizationId" field.
+func (m *WebhookDeliveryMutation) ResetOrganizationId() {
+	m.organizationId = nil
+	m.addorganizationId = nil
 }
 
-// SetOrganizationID sets the "organization" edge to the Organization entity by id.
-func (m *UserMutation) SetOrganizationID(id int) {
-	m.organization = &id
+// SetWebhookID sets the "webhook" edge to the Webhook entity by id.
+func (m *WebhookDeliveryMutation) SetWebhookID(id int) {
+	m.webhook = &id
 }
 
-// ClearOrganization clears the "organization" edge to the Organization entity.
-func (m *UserMutation) ClearOrganization() {
-	m.clearedorganization = true
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (m *WebhookDeliveryMutation) ClearWebhook() {
+	m.clearedwebhook = true
 }
 
-// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
-func (m *UserMutation) OrganizationCleared() bool {
-	return m.OrganizationIdCleared() || m.clearedorganization
+// WebhookCleared reports if the "webhook" edge to the Webhook entity was cleared.
+func (m *WebhookDeliveryMutation) WebhookCleared() bool {
+	return m.clearedwebhook
 }
 
-// OrganizationID returns the "organization" edge ID in the mutation.
-func (m *UserMutation) OrganizationID() (id int, exists bool) {
-	if m.organization != nil {
-		return *m.organization, true
+// WebhookID returns the "webhook" edge ID in the mutation.
+func (m *WebhookDeliveryMutation) WebhookID() (id int, exists bool) {
+	if m.webhook != nil {
+		return *m.webhook, true
 	}
 	return
 }
 
-// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// WebhookIDs returns the "webhook" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OrganizationID instead. It exists only for internal usage by the builders.
-func (m *UserMutation) OrganizationIDs() (ids []int) {
-	if id := m.organization; id != nil {
+// WebhookID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeliveryMutation) WebhookIDs() (ids []int) {
+	if id := m.webhook; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetOrganization resets all changes to the "organization" edge.
-func (m *UserMutation) ResetOrganization() {
-	m.organization = nil
-	m.clearedorganization = false
+// ResetWebhook resets all changes to the "webhook" edge.
+func (m *WebhookDeliveryMutation) ResetWebhook() {
+	m.webhook = nil
+	m.clearedwebhook = false
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 12)
 	if m.createdAt != nil {
-		fields = append(fields, user.FieldCreatedAt)
+		fields = append(fields, webhookdelivery.FieldCreatedAt)
 	}
 	if m.updatedAt != nil {
-		fields = append(fields, user.FieldUpdatedAt)
+		fields = append(fields, webhookdelivery.FieldUpdatedAt)
 	}
-	if m.name != nil {
-		fields = append(fields, user.FieldName)
+	if m.eventType != nil {
+		fields = append(fields, webhookdelivery.FieldEventType)
 	}
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
+	if m.payload != nil {
+		fields = append(fields, webhookdelivery.FieldPayload)
 	}
-	if m.organization != nil {
-		fields = append(fields, user.FieldOrganizationId)
+	if m.status != nil {
+		fields = append(fields, webhookdelivery.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.nextAttemptAt != nil {
+		fields = append(fields, webhookdelivery.FieldNextAttemptAt)
+	}
+	if m.lastAttemptAt != nil {
+		fields = append(fields, webhookdelivery.FieldLastAttemptAt)
+	}
+	if m.responseStatus != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.lastError != nil {
+		fields = append(fields, webhookdelivery.FieldLastError)
+	}
+	if m.webhook != nil {
+		fields = append(fields, webhookdelivery.FieldWebhookId)
+	}
+	if m.organizationId != nil {
+		fields = append(fields, webhookdelivery.FieldOrganizationId)
 	}
 	return fields
 }
@@ -6911,17 +20068,31 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldCreatedAt:
+	case webhookdelivery.FieldCreatedAt:
 		return m.CreatedAt()
-	case user.FieldUpdatedAt:
+	case webhookdelivery.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case user.FieldName:
-		return m.Name()
-	case user.FieldEmail:
-		return m.Email()
-	case user.FieldOrganizationId:
+	case webhookdelivery.FieldEventType:
+		return m.EventType()
+	case webhookdelivery.FieldPayload:
+		return m.Payload()
+	case webhookdelivery.FieldStatus:
+		return m.Status()
+	case webhookdelivery.FieldAttempts:
+		return m.Attempts()
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case webhookdelivery.FieldLastAttemptAt:
+		return m.LastAttemptAt()
+	case webhookdelivery.FieldResponseStatus:
+		return m.ResponseStatus()
+	case webhookdelivery.FieldLastError:
+		return m.LastError()
+	case webhookdelivery.FieldWebhookId:
+		return m.WebhookId()
+	case webhookdelivery.FieldOrganizationId:
 		return m.OrganizationId()
 	}
 	return nil, false
@@ -6930,56 +20101,119 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldCreatedAt:
+	case webhookdelivery.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case user.FieldUpdatedAt:
+	case webhookdelivery.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case user.FieldName:
-		return m.OldName(ctx)
-	case user.FieldEmail:
-		return m.OldEmail(ctx)
-	case user.FieldOrganizationId:
+	case webhookdelivery.FieldEventType:
+		return m.OldEventType(ctx)
+	case webhookdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdelivery.FieldStatus:
+		return m.OldStatus(ctx)
+	case webhookdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case webhookdelivery.FieldLastAttemptAt:
+		return m.OldLastAttemptAt(ctx)
+	case webhookdelivery.FieldResponseStatus:
+		return m.OldResponseStatus(ctx)
+	case webhookdelivery.FieldLastError:
+		return m.OldLastError(ctx)
+	case webhookdelivery.FieldWebhookId:
+		return m.OldWebhookId(ctx)
+	case webhookdelivery.FieldOrganizationId:
 		return m.OldOrganizationId(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldCreatedAt:
+	case webhookdelivery.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case user.FieldUpdatedAt:
+	case webhookdelivery.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case user.FieldName:
+	case webhookdelivery.FieldEventType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetEventType(v)
 		return nil
-	case user.FieldEmail:
+	case webhookdelivery.FieldPayload:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetPayload(v)
 		return nil
-	case user.FieldOrganizationId:
+	case webhookdelivery.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	case webhookdelivery.FieldLastAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastAttemptAt(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseStatus(v)
+		return nil
+	case webhookdelivery.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case webhookdelivery.FieldWebhookId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebhookId(v)
+		return nil
+	case webhookdelivery.FieldOrganizationId:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -6987,21 +20221,36 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		m.SetOrganizationId(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
+func (m *WebhookDeliveryMutation) AddedFields() []string {
 	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.addresponseStatus != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.addorganizationId != nil {
+		fields = append(fields, webhookdelivery.FieldOrganizationId)
+	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case webhookdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	case webhookdelivery.FieldResponseStatus:
+		return m.AddedResponseStatus()
+	case webhookdelivery.FieldOrganizationId:
+		return m.AddedOrganizationId()
 	}
 	return nil, false
 }
@@ -7009,96 +20258,132 @@ func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResponseStatus(v)
+		return nil
+	case webhookdelivery.FieldOrganizationId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOrganizationId(v)
+		return nil
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(user.FieldOrganizationId) {
-		fields = append(fields, user.FieldOrganizationId)
+	if m.FieldCleared(webhookdelivery.FieldLastAttemptAt) {
+		fields = append(fields, webhookdelivery.FieldLastAttemptAt)
+	}
+	if m.FieldCleared(webhookdelivery.FieldResponseStatus) {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.FieldCleared(webhookdelivery.FieldLastError) {
+		fields = append(fields, webhookdelivery.FieldLastError)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
 	switch name {
-	case user.FieldOrganizationId:
-		m.ClearOrganizationId()
+	case webhookdelivery.FieldLastAttemptAt:
+		m.ClearLastAttemptAt()
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		m.ClearResponseStatus()
+		return nil
+	case webhookdelivery.FieldLastError:
+		m.ClearLastError()
 		return nil
 	}
-	return fmt.Errorf("unknown User nullable field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldCreatedAt:
+	case webhookdelivery.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case user.FieldUpdatedAt:
+	case webhookdelivery.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case user.FieldName:
-		m.ResetName()
+	case webhookdelivery.FieldEventType:
+		m.ResetEventType()
 		return nil
-	case user.FieldEmail:
-		m.ResetEmail()
+	case webhookdelivery.FieldPayload:
+		m.ResetPayload()
 		return nil
-	case user.FieldOrganizationId:
+	case webhookdelivery.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case webhookdelivery.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case webhookdelivery.FieldLastAttemptAt:
+		m.ResetLastAttemptAt()
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		m.ResetResponseStatus()
+		return nil
+	case webhookdelivery.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case webhookdelivery.FieldWebhookId:
+		m.ResetWebhookId()
+		return nil
+	case webhookdelivery.FieldOrganizationId:
 		m.ResetOrganizationId()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.auths != nil {
-		edges = append(edges, user.EdgeAuths)
-	}
-	if m.tokens != nil {
-		edges = append(edges, user.EdgeTokens)
-	}
-	if m.organization != nil {
-		edges = append(edges, user.EdgeOrganization)
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.webhook != nil {
+		edges = append(edges, webhookdelivery.EdgeWebhook)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeAuths:
-		ids := make([]ent.Value, 0, len(m.auths))
-		for id := range m.auths {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeTokens:
-		ids := make([]ent.Value, 0, len(m.tokens))
-		for id := range m.tokens {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeOrganization:
-		if id := m.organization; id != nil {
+	case webhookdelivery.EdgeWebhook:
+		if id := m.webhook; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -7106,90 +20391,56 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedauths != nil {
-		edges = append(edges, user.EdgeAuths)
-	}
-	if m.removedtokens != nil {
-		edges = append(edges, user.EdgeTokens)
-	}
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeAuths:
-		ids := make([]ent.Value, 0, len(m.removedauths))
-		for id := range m.removedauths {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeTokens:
-		ids := make([]ent.Value, 0, len(m.removedtokens))
-		for id := range m.removedtokens {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedauths {
-		edges = append(edges, user.EdgeAuths)
-	}
-	if m.clearedtokens {
-		edges = append(edges, user.EdgeTokens)
-	}
-	if m.clearedorganization {
-		edges = append(edges, user.EdgeOrganization)
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedwebhook {
+		edges = append(edges, webhookdelivery.EdgeWebhook)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.EdgeAuths:
-		return m.clearedauths
-	case user.EdgeTokens:
-		return m.clearedtokens
-	case user.EdgeOrganization:
-		return m.clearedorganization
+	case webhookdelivery.EdgeWebhook:
+		return m.clearedwebhook
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
 	switch name {
-	case user.EdgeOrganization:
-		m.ClearOrganization()
+	case webhookdelivery.EdgeWebhook:
+		m.ClearWebhook()
 		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgeAuths:
-		m.ResetAuths()
-		return nil
-	case user.EdgeTokens:
-		m.ResetTokens()
-		return nil
-	case user.EdgeOrganization:
-		m.ResetOrganization()
+	case webhookdelivery.EdgeWebhook:
+		m.ResetWebhook()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
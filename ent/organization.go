@@ -26,6 +26,8 @@ type Organization struct {
 	PublicKey string `json:"publicKey,omitempty"`
 	// PrivateKey holds the value of the "privateKey" field.
 	PrivateKey string `json:"privateKey,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the OrganizationQuery when eager-loading is set.
 	Edges OrganizationEdges `json:"edges"`
@@ -39,9 +41,19 @@ type OrganizationEdges struct {
 	Resources []*Resource `json:"resources,omitempty"`
 	// Tokens holds the value of the tokens edge.
 	Tokens []*Token `json:"tokens,omitempty"`
+	// Webhooks holds the value of the webhooks edge.
+	Webhooks []*Webhook `json:"webhooks,omitempty"`
+	// PromoCodes holds the value of the promoCodes edge.
+	PromoCodes []*PromoCode `json:"promoCodes,omitempty"`
+	// Invoices holds the value of the invoices edge.
+	Invoices []*Invoice `json:"invoices,omitempty"`
+	// TaxRates holds the value of the taxRates edge.
+	TaxRates []*TaxRate `json:"taxRates,omitempty"`
+	// Customers holds the value of the customers edge.
+	Customers []*Customer `json:"customers,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [8]bool
 }
 
 // UsersOrErr returns the Users value or an error if the edge
@@ -71,6 +83,51 @@ func (e OrganizationEdges) TokensOrErr() ([]*Token, error) {
 	return nil, &NotLoadedError{edge: "tokens"}
 }
 
+// WebhooksOrErr returns the Webhooks value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) WebhooksOrErr() ([]*Webhook, error) {
+	if e.loadedTypes[3] {
+		return e.Webhooks, nil
+	}
+	return nil, &NotLoadedError{edge: "webhooks"}
+}
+
+// PromoCodesOrErr returns the PromoCodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) PromoCodesOrErr() ([]*PromoCode, error) {
+	if e.loadedTypes[4] {
+		return e.PromoCodes, nil
+	}
+	return nil, &NotLoadedError{edge: "promoCodes"}
+}
+
+// InvoicesOrErr returns the Invoices value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) InvoicesOrErr() ([]*Invoice, error) {
+	if e.loadedTypes[5] {
+		return e.Invoices, nil
+	}
+	return nil, &NotLoadedError{edge: "invoices"}
+}
+
+// TaxRatesOrErr returns the TaxRates value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) TaxRatesOrErr() ([]*TaxRate, error) {
+	if e.loadedTypes[6] {
+		return e.TaxRates, nil
+	}
+	return nil, &NotLoadedError{edge: "taxRates"}
+}
+
+// CustomersOrErr returns the Customers value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) CustomersOrErr() ([]*Customer, error) {
+	if e.loadedTypes[7] {
+		return e.Customers, nil
+	}
+	return nil, &NotLoadedError{edge: "customers"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Organization) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
@@ -78,7 +135,7 @@ func (*Organization) scanValues(columns []string) ([]interface{}, error) {
 		switch columns[i] {
 		case organization.FieldID:
 			values[i] = new(sql.NullInt64)
-		case organization.FieldName, organization.FieldPublicKey, organization.FieldPrivateKey:
+		case organization.FieldName, organization.FieldPublicKey, organization.FieldPrivateKey, organization.FieldCurrency:
 			values[i] = new(sql.NullString)
 		case organization.FieldCreatedAt, organization.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -133,6 +190,12 @@ func (o *Organization) assignValues(columns []string, values []interface{}) erro
 			} else if value.Valid {
 				o.PrivateKey = value.String
 			}
+		case organization.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				o.Currency = value.String
+			}
 		}
 	}
 	return nil
@@ -153,6 +216,31 @@ func (o *Organization) QueryTokens() *TokenQuery {
 	return (&OrganizationClient{config: o.config}).QueryTokens(o)
 }
 
+// QueryWebhooks queries the "webhooks" edge of the Organization entity.
+func (o *Organization) QueryWebhooks() *WebhookQuery {
+	return (&OrganizationClient{config: o.config}).QueryWebhooks(o)
+}
+
+// QueryPromoCodes queries the "promoCodes" edge of the Organization entity.
+func (o *Organization) QueryPromoCodes() *PromoCodeQuery {
+	return (&OrganizationClient{config: o.config}).QueryPromoCodes(o)
+}
+
+// QueryInvoices queries the "invoices" edge of the Organization entity.
+func (o *Organization) QueryInvoices() *InvoiceQuery {
+	return (&OrganizationClient{config: o.config}).QueryInvoices(o)
+}
+
+// QueryTaxRates queries the "taxRates" edge of the Organization entity.
+func (o *Organization) QueryTaxRates() *TaxRateQuery {
+	return (&OrganizationClient{config: o.config}).QueryTaxRates(o)
+}
+
+// QueryCustomers queries the "customers" edge of the Organization entity.
+func (o *Organization) QueryCustomers() *CustomerQuery {
+	return (&OrganizationClient{config: o.config}).QueryCustomers(o)
+}
+
 // Update returns a builder for updating this Organization.
 // Note that you need to call Organization.Unwrap() before calling this method if this Organization
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -186,6 +274,8 @@ func (o *Organization) String() string {
 	builder.WriteString(o.PublicKey)
 	builder.WriteString(", privateKey=")
 	builder.WriteString(o.PrivateKey)
+	builder.WriteString(", currency=")
+	builder.WriteString(o.Currency)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -21,12 +21,24 @@ const (
 	FieldPublicKey = "public_key"
 	// FieldPrivateKey holds the string denoting the privatekey field in the database.
 	FieldPrivateKey = "private_key"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
 	// EdgeUsers holds the string denoting the users edge name in mutations.
 	EdgeUsers = "users"
 	// EdgeResources holds the string denoting the resources edge name in mutations.
 	EdgeResources = "resources"
 	// EdgeTokens holds the string denoting the tokens edge name in mutations.
 	EdgeTokens = "tokens"
+	// EdgeWebhooks holds the string denoting the webhooks edge name in mutations.
+	EdgeWebhooks = "webhooks"
+	// EdgePromoCodes holds the string denoting the promocodes edge name in mutations.
+	EdgePromoCodes = "promoCodes"
+	// EdgeInvoices holds the string denoting the invoices edge name in mutations.
+	EdgeInvoices = "invoices"
+	// EdgeTaxRates holds the string denoting the taxrates edge name in mutations.
+	EdgeTaxRates = "taxRates"
+	// EdgeCustomers holds the string denoting the customers edge name in mutations.
+	EdgeCustomers = "customers"
 	// Table holds the table name of the organization in the database.
 	Table = "organizations"
 	// UsersTable is the table that holds the users relation/edge.
@@ -50,6 +62,41 @@ const (
 	TokensInverseTable = "tokens"
 	// TokensColumn is the table column denoting the tokens relation/edge.
 	TokensColumn = "organization_id"
+	// WebhooksTable is the table that holds the webhooks relation/edge.
+	WebhooksTable = "webhooks"
+	// WebhooksInverseTable is the table name for the Webhook entity.
+	// It exists in this package in order to avoid circular dependency with the "webhook" package.
+	WebhooksInverseTable = "webhooks"
+	// WebhooksColumn is the table column denoting the webhooks relation/edge.
+	WebhooksColumn = "organization_id"
+	// PromoCodesTable is the table that holds the promoCodes relation/edge.
+	PromoCodesTable = "promo_codes"
+	// PromoCodesInverseTable is the table name for the PromoCode entity.
+	// It exists in this package in order to avoid circular dependency with the "promocode" package.
+	PromoCodesInverseTable = "promo_codes"
+	// PromoCodesColumn is the table column denoting the promoCodes relation/edge.
+	PromoCodesColumn = "organization_id"
+	// InvoicesTable is the table that holds the invoices relation/edge.
+	InvoicesTable = "invoices"
+	// InvoicesInverseTable is the table name for the Invoice entity.
+	// It exists in this package in order to avoid circular dependency with the "invoice" package.
+	InvoicesInverseTable = "invoices"
+	// InvoicesColumn is the table column denoting the invoices relation/edge.
+	InvoicesColumn = "organization_id"
+	// TaxRatesTable is the table that holds the taxRates relation/edge.
+	TaxRatesTable = "tax_rates"
+	// TaxRatesInverseTable is the table name for the TaxRate entity.
+	// It exists in this package in order to avoid circular dependency with the "taxrate" package.
+	TaxRatesInverseTable = "tax_rates"
+	// TaxRatesColumn is the table column denoting the taxRates relation/edge.
+	TaxRatesColumn = "organization_id"
+	// CustomersTable is the table that holds the customers relation/edge.
+	CustomersTable = "customers"
+	// CustomersInverseTable is the table name for the Customer entity.
+	// It exists in this package in order to avoid circular dependency with the "customer" package.
+	CustomersInverseTable = "customers"
+	// CustomersColumn is the table column denoting the customers relation/edge.
+	CustomersColumn = "organization_id"
 )
 
 // Columns holds all SQL columns for organization fields.
@@ -60,6 +107,7 @@ var Columns = []string{
 	FieldName,
 	FieldPublicKey,
 	FieldPrivateKey,
+	FieldCurrency,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -79,4 +127,6 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updatedAt" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
 )
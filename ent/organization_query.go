@@ -9,14 +9,20 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/openmesh/booking/ent/customer"
+	"github.com/openmesh/booking/ent/invoice"
 	"github.com/openmesh/booking/ent/organization"
 	"github.com/openmesh/booking/ent/predicate"
+	"github.com/openmesh/booking/ent/promocode"
 	"github.com/openmesh/booking/ent/resource"
+	"github.com/openmesh/booking/ent/taxrate"
 	"github.com/openmesh/booking/ent/token"
 	"github.com/openmesh/booking/ent/user"
+	"github.com/openmesh/booking/ent/webhook"
 )
 
 // OrganizationQuery is the builder for querying Organization entities.
@@ -29,9 +35,15 @@ type OrganizationQuery struct {
 	fields     []string
 	predicates []predicate.Organization
 	// eager-loading edges.
-	withUsers     *UserQuery
-	withResources *ResourceQuery
-	withTokens    *TokenQuery
+	withUsers      *UserQuery
+	withResources  *ResourceQuery
+	withTokens     *TokenQuery
+	withWebhooks   *WebhookQuery
+	withPromoCodes *PromoCodeQuery
+	withInvoices   *InvoiceQuery
+	withTaxRates   *TaxRateQuery
+	withCustomers  *CustomerQuery
+	modifiers      []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -134,6 +146,116 @@ func (oq *OrganizationQuery) QueryTokens() *TokenQuery {
 	return query
 }
 
+// QueryWebhooks chains the current query on the "webhooks" edge.
+func (oq *OrganizationQuery) QueryWebhooks() *WebhookQuery {
+	query := &WebhookQuery{config: oq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.WebhooksTable, organization.WebhooksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPromoCodes chains the current query on the "promoCodes" edge.
+func (oq *OrganizationQuery) QueryPromoCodes() *PromoCodeQuery {
+	query := &PromoCodeQuery{config: oq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(promocode.Table, promocode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.PromoCodesTable, organization.PromoCodesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvoices chains the current query on the "invoices" edge.
+func (oq *OrganizationQuery) QueryInvoices() *InvoiceQuery {
+	query := &InvoiceQuery{config: oq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.InvoicesTable, organization.InvoicesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTaxRates chains the current query on the "taxRates" edge.
+func (oq *OrganizationQuery) QueryTaxRates() *TaxRateQuery {
+	query := &TaxRateQuery{config: oq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(taxrate.Table, taxrate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.TaxRatesTable, organization.TaxRatesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCustomers chains the current query on the "customers" edge.
+func (oq *OrganizationQuery) QueryCustomers() *CustomerQuery {
+	query := &CustomerQuery{config: oq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(customer.Table, customer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.CustomersTable, organization.CustomersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Organization entity from the query.
 // Returns a *NotFoundError when no Organization was found.
 func (oq *OrganizationQuery) First(ctx context.Context) (*Organization, error) {
@@ -310,14 +432,19 @@ func (oq *OrganizationQuery) Clone() *OrganizationQuery {
 		return nil
 	}
 	return &OrganizationQuery{
-		config:        oq.config,
-		limit:         oq.limit,
-		offset:        oq.offset,
-		order:         append([]OrderFunc{}, oq.order...),
-		predicates:    append([]predicate.Organization{}, oq.predicates...),
-		withUsers:     oq.withUsers.Clone(),
-		withResources: oq.withResources.Clone(),
-		withTokens:    oq.withTokens.Clone(),
+		config:         oq.config,
+		limit:          oq.limit,
+		offset:         oq.offset,
+		order:          append([]OrderFunc{}, oq.order...),
+		predicates:     append([]predicate.Organization{}, oq.predicates...),
+		withUsers:      oq.withUsers.Clone(),
+		withResources:  oq.withResources.Clone(),
+		withTokens:     oq.withTokens.Clone(),
+		withWebhooks:   oq.withWebhooks.Clone(),
+		withPromoCodes: oq.withPromoCodes.Clone(),
+		withInvoices:   oq.withInvoices.Clone(),
+		withTaxRates:   oq.withTaxRates.Clone(),
+		withCustomers:  oq.withCustomers.Clone(),
 		// clone intermediate query.
 		sql:  oq.sql.Clone(),
 		path: oq.path,
@@ -357,6 +484,61 @@ func (oq *OrganizationQuery) WithTokens(opts ...func(*TokenQuery)) *Organization
 	return oq
 }
 
+// WithWebhooks tells the query-builder to eager-load the nodes that are connected to
+// the "webhooks" edge. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrganizationQuery) WithWebhooks(opts ...func(*WebhookQuery)) *OrganizationQuery {
+	query := &WebhookQuery{config: oq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	oq.withWebhooks = query
+	return oq
+}
+
+// WithPromoCodes tells the query-builder to eager-load the nodes that are connected to
+// the "promoCodes" edge. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrganizationQuery) WithPromoCodes(opts ...func(*PromoCodeQuery)) *OrganizationQuery {
+	query := &PromoCodeQuery{config: oq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	oq.withPromoCodes = query
+	return oq
+}
+
+// WithInvoices tells the query-builder to eager-load the nodes that are connected to
+// the "invoices" edge. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrganizationQuery) WithInvoices(opts ...func(*InvoiceQuery)) *OrganizationQuery {
+	query := &InvoiceQuery{config: oq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	oq.withInvoices = query
+	return oq
+}
+
+// WithTaxRates tells the query-builder to eager-load the nodes that are connected to
+// the "taxRates" edge. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrganizationQuery) WithTaxRates(opts ...func(*TaxRateQuery)) *OrganizationQuery {
+	query := &TaxRateQuery{config: oq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	oq.withTaxRates = query
+	return oq
+}
+
+// WithCustomers tells the query-builder to eager-load the nodes that are connected to
+// the "customers" edge. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrganizationQuery) WithCustomers(opts ...func(*CustomerQuery)) *OrganizationQuery {
+	query := &CustomerQuery{config: oq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	oq.withCustomers = query
+	return oq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -422,10 +604,15 @@ func (oq *OrganizationQuery) sqlAll(ctx context.Context) ([]*Organization, error
 	var (
 		nodes       = []*Organization{}
 		_spec       = oq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [8]bool{
 			oq.withUsers != nil,
 			oq.withResources != nil,
 			oq.withTokens != nil,
+			oq.withWebhooks != nil,
+			oq.withPromoCodes != nil,
+			oq.withInvoices != nil,
+			oq.withTaxRates != nil,
+			oq.withCustomers != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
@@ -441,6 +628,9 @@ func (oq *OrganizationQuery) sqlAll(ctx context.Context) ([]*Organization, error
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(oq.modifiers) > 0 {
+		_spec.Modifiers = oq.modifiers
+	}
 	if err := sqlgraph.QueryNodes(ctx, oq.driver, _spec); err != nil {
 		return nil, err
 	}
@@ -526,11 +716,139 @@ func (oq *OrganizationQuery) sqlAll(ctx context.Context) ([]*Organization, error
 		}
 	}
 
+	if query := oq.withWebhooks; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Organization)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Webhooks = []*Webhook{}
+		}
+		query.Where(predicate.Webhook(func(s *sql.Selector) {
+			s.Where(sql.InValues(organization.WebhooksColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.OrganizationId
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "organizationId" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Webhooks = append(node.Edges.Webhooks, n)
+		}
+	}
+
+	if query := oq.withPromoCodes; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Organization)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.PromoCodes = []*PromoCode{}
+		}
+		query.Where(predicate.PromoCode(func(s *sql.Selector) {
+			s.Where(sql.InValues(organization.PromoCodesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.OrganizationId
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "organizationId" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.PromoCodes = append(node.Edges.PromoCodes, n)
+		}
+	}
+
+	if query := oq.withInvoices; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Organization)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Invoices = []*Invoice{}
+		}
+		query.Where(predicate.Invoice(func(s *sql.Selector) {
+			s.Where(sql.InValues(organization.InvoicesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.OrganizationId
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "organizationId" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Invoices = append(node.Edges.Invoices, n)
+		}
+	}
+
+	if query := oq.withTaxRates; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Organization)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.TaxRates = []*TaxRate{}
+		}
+		query.Where(predicate.TaxRate(func(s *sql.Selector) {
+			s.Where(sql.InValues(organization.TaxRatesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.OrganizationId
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "organizationId" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.TaxRates = append(node.Edges.TaxRates, n)
+		}
+	}
+
+	if query := oq.withCustomers; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Organization)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Customers = []*Customer{}
+		}
+		query.Where(predicate.Customer(func(s *sql.Selector) {
+			s.Where(sql.InValues(organization.CustomersColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.OrganizationId
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "organizationId" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Customers = append(node.Edges.Customers, n)
+		}
+	}
+
 	return nodes, nil
 }
 
 func (oq *OrganizationQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := oq.querySpec()
+	if len(oq.modifiers) > 0 {
+		_spec.Modifiers = oq.modifiers
+	}
 	return sqlgraph.CountNodes(ctx, oq.driver, _spec)
 }
 
@@ -602,6 +920,9 @@ func (oq *OrganizationQuery) sqlQuery(ctx context.Context) *sql.Selector {
 		selector = oq.sql
 		selector.Select(selector.Columns(columns...)...)
 	}
+	for _, m := range oq.modifiers {
+		m(selector)
+	}
 	for _, p := range oq.predicates {
 		p(selector)
 	}
@@ -619,6 +940,32 @@ func (oq *OrganizationQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (oq *OrganizationQuery) ForUpdate(opts ...sql.LockOption) *OrganizationQuery {
+	if oq.driver.Dialect() == dialect.Postgres {
+		oq.Unique(false)
+	}
+	oq.modifiers = append(oq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return oq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (oq *OrganizationQuery) ForShare(opts ...sql.LockOption) *OrganizationQuery {
+	if oq.driver.Dialect() == dialect.Postgres {
+		oq.Unique(false)
+	}
+	oq.modifiers = append(oq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return oq
+}
+
 // OrganizationGroupBy is the group-by builder for Organization entities.
 type OrganizationGroupBy struct {
 	config
@@ -2,6 +2,8 @@ package ent
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math/rand"
 
 	"github.com/openmesh/booking/ent/organization"
@@ -39,13 +41,38 @@ func (s *organizationService) FindOrganizationByPrivateKey(ctx context.Context,
 	return org.toModel(), err
 }
 
+// FindOrganizationByPublicKey retrieves an organization by PublicKey. Returns
+// ENOTFOUND if organization does not exist.
+func (s *organizationService) FindOrganizationByPublicKey(ctx context.Context, key string) (*booking.Organization, error) {
+	org, err := s.client.Organization.
+		Query().
+		Where(organization.PublicKey(key)).
+		Only(ctx)
+	var nfe *NotFoundError
+	if errors.As(err, &nfe) {
+		return nil, booking.Errorf(booking.ENOTFOUND, "Could not find organization with public key '%s'", key)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find organization: %w", err)
+	}
+	return org.toModel(), nil
+}
+
 func (s *organizationService) UpdateOrganization(ctx context.Context, upd booking.OrganizationUpdate) (*booking.Organization, error) {
+	if errs := upd.Validate(); len(errs) > 0 {
+		return nil, booking.WrapValidationErrors(errs)
+	}
+
 	organizationID := booking.OrganizationIDFromContext(ctx)
 	updateBuilder := s.client.Organization.UpdateOneID(organizationID)
 
 	if upd.Name != nil {
 		updateBuilder.SetName(*upd.Name)
 	}
+	// Existing resources and bookings keep their currency.
+	if upd.Currency != nil {
+		updateBuilder.SetCurrency(*upd.Currency)
+	}
 
 	org, err := updateBuilder.Save(ctx)
 	if err != nil {
@@ -62,6 +89,7 @@ func (s *organizationService) CreateOrganization(ctx context.Context, organizati
 		SetPublicKey(randStringBytes(16)).
 		SetPrivateKey(randStringBytes(16)).
 		SetName(organization.Name).
+		SetCurrency(organizationDefaultCurrency(organization.Currency)).
 		Save(ctx)
 
 	if err != nil {
@@ -73,6 +101,15 @@ func (s *organizationService) CreateOrganization(ctx context.Context, organizati
 	return nil
 }
 
+// organizationDefaultCurrency returns currency if it is supported and
+// booking.DefaultCurrency otherwise.
+func organizationDefaultCurrency(currency string) string {
+	if booking.ValidCurrency(currency) {
+		return currency
+	}
+	return booking.DefaultCurrency
+}
+
 const letterBytes = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
 
 func randStringBytes(n int) string {
@@ -88,7 +125,18 @@ func (o *Organization) toModel() *booking.Organization {
 		ID:        o.ID,
 		Name:      o.Name,
 		PublicKey: o.PublicKey,
+		Currency:  o.Currency,
 		CreatedAt: o.CreatedAt,
 		UpdatedAt: o.UpdatedAt,
 	}
 }
+
+// organizationCurrency returns the default currency of the organization of the
+// current user.
+func organizationCurrency(ctx context.Context, client *Client) (string, error) {
+	o, err := client.Organization.Get(ctx, booking.OrganizationIDFromContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("failed to find organization: %w", err)
+	}
+	return o.Currency, nil
+}
@@ -10,11 +10,16 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/openmesh/booking/ent/customer"
+	"github.com/openmesh/booking/ent/invoice"
 	"github.com/openmesh/booking/ent/organization"
 	"github.com/openmesh/booking/ent/predicate"
+	"github.com/openmesh/booking/ent/promocode"
 	"github.com/openmesh/booking/ent/resource"
+	"github.com/openmesh/booking/ent/taxrate"
 	"github.com/openmesh/booking/ent/token"
 	"github.com/openmesh/booking/ent/user"
+	"github.com/openmesh/booking/ent/webhook"
 )
 
 // OrganizationUpdate is the builder for updating Organization entities.
@@ -54,6 +59,20 @@ func (ou *OrganizationUpdate) SetPrivateKey(s string) *OrganizationUpdate {
 	return ou
 }
 
+// SetCurrency sets the "currency" field.
+func (ou *OrganizationUpdate) SetCurrency(s string) *OrganizationUpdate {
+	ou.mutation.SetCurrency(s)
+	return ou
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (ou *OrganizationUpdate) SetNillableCurrency(s *string) *OrganizationUpdate {
+	if s != nil {
+		ou.SetCurrency(*s)
+	}
+	return ou
+}
+
 // AddUserIDs adds the "users" edge to the User entity by IDs.
 func (ou *OrganizationUpdate) AddUserIDs(ids ...int) *OrganizationUpdate {
 	ou.mutation.AddUserIDs(ids...)
@@ -99,6 +118,81 @@ func (ou *OrganizationUpdate) AddTokens(t ...*Token) *OrganizationUpdate {
 	return ou.AddTokenIDs(ids...)
 }
 
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (ou *OrganizationUpdate) AddWebhookIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.AddWebhookIDs(ids...)
+	return ou
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (ou *OrganizationUpdate) AddWebhooks(w ...*Webhook) *OrganizationUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return ou.AddWebhookIDs(ids...)
+}
+
+// AddPromoCodeIDs adds the "promoCodes" edge to the PromoCode entity by IDs.
+func (ou *OrganizationUpdate) AddPromoCodeIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.AddPromoCodeIDs(ids...)
+	return ou
+}
+
+// AddPromoCodes adds the "promoCodes" edges to the PromoCode entity.
+func (ou *OrganizationUpdate) AddPromoCodes(p ...*PromoCode) *OrganizationUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ou.AddPromoCodeIDs(ids...)
+}
+
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
+func (ou *OrganizationUpdate) AddInvoiceIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.AddInvoiceIDs(ids...)
+	return ou
+}
+
+// AddInvoices adds the "invoices" edges to the Invoice entity.
+func (ou *OrganizationUpdate) AddInvoices(i ...*Invoice) *OrganizationUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ou.AddInvoiceIDs(ids...)
+}
+
+// AddTaxRateIDs adds the "taxRates" edge to the TaxRate entity by IDs.
+func (ou *OrganizationUpdate) AddTaxRateIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.AddTaxRateIDs(ids...)
+	return ou
+}
+
+// AddTaxRates adds the "taxRates" edges to the TaxRate entity.
+func (ou *OrganizationUpdate) AddTaxRates(t ...*TaxRate) *OrganizationUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ou.AddTaxRateIDs(ids...)
+}
+
+// AddCustomerIDs adds the "customers" edge to the Customer entity by IDs.
+func (ou *OrganizationUpdate) AddCustomerIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.AddCustomerIDs(ids...)
+	return ou
+}
+
+// AddCustomers adds the "customers" edges to the Customer entity.
+func (ou *OrganizationUpdate) AddCustomers(c ...*Customer) *OrganizationUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ou.AddCustomerIDs(ids...)
+}
+
 // Mutation returns the OrganizationMutation object of the builder.
 func (ou *OrganizationUpdate) Mutation() *OrganizationMutation {
 	return ou.mutation
@@ -167,6 +261,111 @@ func (ou *OrganizationUpdate) RemoveTokens(t ...*Token) *OrganizationUpdate {
 	return ou.RemoveTokenIDs(ids...)
 }
 
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (ou *OrganizationUpdate) ClearWebhooks() *OrganizationUpdate {
+	ou.mutation.ClearWebhooks()
+	return ou
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (ou *OrganizationUpdate) RemoveWebhookIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.RemoveWebhookIDs(ids...)
+	return ou
+}
+
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (ou *OrganizationUpdate) RemoveWebhooks(w ...*Webhook) *OrganizationUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return ou.RemoveWebhookIDs(ids...)
+}
+
+// ClearPromoCodes clears all "promoCodes" edges to the PromoCode entity.
+func (ou *OrganizationUpdate) ClearPromoCodes() *OrganizationUpdate {
+	ou.mutation.ClearPromoCodes()
+	return ou
+}
+
+// RemovePromoCodeIDs removes the "promoCodes" edge to PromoCode entities by IDs.
+func (ou *OrganizationUpdate) RemovePromoCodeIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.RemovePromoCodeIDs(ids...)
+	return ou
+}
+
+// RemovePromoCodes removes "promoCodes" edges to PromoCode entities.
+func (ou *OrganizationUpdate) RemovePromoCodes(p ...*PromoCode) *OrganizationUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ou.RemovePromoCodeIDs(ids...)
+}
+
+// ClearInvoices clears all "invoices" edges to the Invoice entity.
+func (ou *OrganizationUpdate) ClearInvoices() *OrganizationUpdate {
+	ou.mutation.ClearInvoices()
+	return ou
+}
+
+// RemoveInvoiceIDs removes the "invoices" edge to Invoice entities by IDs.
+func (ou *OrganizationUpdate) RemoveInvoiceIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.RemoveInvoiceIDs(ids...)
+	return ou
+}
+
+// RemoveInvoices removes "invoices" edges to Invoice entities.
+func (ou *OrganizationUpdate) RemoveInvoices(i ...*Invoice) *OrganizationUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ou.RemoveInvoiceIDs(ids...)
+}
+
+// ClearTaxRates clears all "taxRates" edges to the TaxRate entity.
+func (ou *OrganizationUpdate) ClearTaxRates() *OrganizationUpdate {
+	ou.mutation.ClearTaxRates()
+	return ou
+}
+
+// RemoveTaxRateIDs removes the "taxRates" edge to TaxRate entities by IDs.
+func (ou *OrganizationUpdate) RemoveTaxRateIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.RemoveTaxRateIDs(ids...)
+	return ou
+}
+
+// RemoveTaxRates removes "taxRates" edges to TaxRate entities.
+func (ou *OrganizationUpdate) RemoveTaxRates(t ...*TaxRate) *OrganizationUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ou.RemoveTaxRateIDs(ids...)
+}
+
+// ClearCustomers clears all "customers" edges to the Customer entity.
+func (ou *OrganizationUpdate) ClearCustomers() *OrganizationUpdate {
+	ou.mutation.ClearCustomers()
+	return ou
+}
+
+// RemoveCustomerIDs removes the "customers" edge to Customer entities by IDs.
+func (ou *OrganizationUpdate) RemoveCustomerIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.RemoveCustomerIDs(ids...)
+	return ou
+}
+
+// RemoveCustomers removes "customers" edges to Customer entities.
+func (ou *OrganizationUpdate) RemoveCustomers(c ...*Customer) *OrganizationUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ou.RemoveCustomerIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ou *OrganizationUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -276,6 +475,13 @@ func (ou *OrganizationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: organization.FieldPrivateKey,
 		})
 	}
+	if value, ok := ou.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: organization.FieldCurrency,
+		})
+	}
 	if ou.mutation.UsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -438,160 +644,624 @@ func (ou *OrganizationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, ou.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{organization.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{err.Error(), err}
+	if ou.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhooksTable,
+			Columns: []string{organization.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: webhook.FieldID,
+				},
+			},
 		}
-		return 0, err
-	}
-	return n, nil
-}
-
-// OrganizationUpdateOne is the builder for updating a single Organization entity.
-type OrganizationUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *OrganizationMutation
-}
-
-// SetUpdatedAt sets the "updatedAt" field.
-func (ouo *OrganizationUpdateOne) SetUpdatedAt(t time.Time) *OrganizationUpdateOne {
-	ouo.mutation.SetUpdatedAt(t)
-	return ouo
-}
-
-// SetName sets the "name" field.
-func (ouo *OrganizationUpdateOne) SetName(s string) *OrganizationUpdateOne {
-	ouo.mutation.SetName(s)
-	return ouo
-}
-
-// SetPublicKey sets the "publicKey" field.
-func (ouo *OrganizationUpdateOne) SetPublicKey(s string) *OrganizationUpdateOne {
-	ouo.mutation.SetPublicKey(s)
-	return ouo
-}
-
-// SetPrivateKey sets the "privateKey" field.
-func (ouo *OrganizationUpdateOne) SetPrivateKey(s string) *OrganizationUpdateOne {
-	ouo.mutation.SetPrivateKey(s)
-	return ouo
-}
-
-// AddUserIDs adds the "users" edge to the User entity by IDs.
-func (ouo *OrganizationUpdateOne) AddUserIDs(ids ...int) *OrganizationUpdateOne {
-	ouo.mutation.AddUserIDs(ids...)
-	return ouo
-}
-
-// AddUsers adds the "users" edges to the User entity.
-func (ouo *OrganizationUpdateOne) AddUsers(u ...*User) *OrganizationUpdateOne {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return ouo.AddUserIDs(ids...)
-}
-
-// AddResourceIDs adds the "resources" edge to the Resource entity by IDs.
-func (ouo *OrganizationUpdateOne) AddResourceIDs(ids ...int) *OrganizationUpdateOne {
-	ouo.mutation.AddResourceIDs(ids...)
-	return ouo
-}
-
-// AddResources adds the "resources" edges to the Resource entity.
-func (ouo *OrganizationUpdateOne) AddResources(r ...*Resource) *OrganizationUpdateOne {
-	ids := make([]int, len(r))
-	for i := range r {
-		ids[i] = r[i].ID
+	if nodes := ou.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !ou.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhooksTable,
+			Columns: []string{organization.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return ouo.AddResourceIDs(ids...)
-}
-
-// AddTokenIDs adds the "tokens" edge to the Token entity by IDs.
-func (ouo *OrganizationUpdateOne) AddTokenIDs(ids ...string) *OrganizationUpdateOne {
-	ouo.mutation.AddTokenIDs(ids...)
-	return ouo
-}
-
-// AddTokens adds the "tokens" edges to the Token entity.
-func (ouo *OrganizationUpdateOne) AddTokens(t ...*Token) *OrganizationUpdateOne {
-	ids := make([]string, len(t))
-	for i := range t {
-		ids[i] = t[i].ID
+	if nodes := ou.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhooksTable,
+			Columns: []string{organization.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return ouo.AddTokenIDs(ids...)
-}
-
-// Mutation returns the OrganizationMutation object of the builder.
-func (ouo *OrganizationUpdateOne) Mutation() *OrganizationMutation {
-	return ouo.mutation
-}
-
-// ClearUsers clears all "users" edges to the User entity.
-func (ouo *OrganizationUpdateOne) ClearUsers() *OrganizationUpdateOne {
-	ouo.mutation.ClearUsers()
-	return ouo
-}
-
-// RemoveUserIDs removes the "users" edge to User entities by IDs.
-func (ouo *OrganizationUpdateOne) RemoveUserIDs(ids ...int) *OrganizationUpdateOne {
-	ouo.mutation.RemoveUserIDs(ids...)
-	return ouo
-}
-
-// RemoveUsers removes "users" edges to User entities.
-func (ouo *OrganizationUpdateOne) RemoveUsers(u ...*User) *OrganizationUpdateOne {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
+	if ou.mutation.PromoCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.PromoCodesTable,
+			Columns: []string{organization.PromoCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: promocode.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return ouo.RemoveUserIDs(ids...)
-}
-
-// ClearResources clears all "resources" edges to the Resource entity.
-func (ouo *OrganizationUpdateOne) ClearResources() *OrganizationUpdateOne {
-	ouo.mutation.ClearResources()
-	return ouo
-}
-
-// RemoveResourceIDs removes the "resources" edge to Resource entities by IDs.
+	if nodes := ou.mutation.RemovedPromoCodesIDs(); len(nodes) > 0 && !ou.mutation.PromoCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.PromoCodesTable,
+			Columns: []string{organization.PromoCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: promocode.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.PromoCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.PromoCodesTable,
+			Columns: []string{organization.PromoCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: promocode.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.InvoicesTable,
+			Columns: []string{organization.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedInvoicesIDs(); len(nodes) > 0 && !ou.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.InvoicesTable,
+			Columns: []string{organization.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.InvoicesTable,
+			Columns: []string{organization.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.TaxRatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.TaxRatesTable,
+			Columns: []string{organization.TaxRatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: taxrate.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedTaxRatesIDs(); len(nodes) > 0 && !ou.mutation.TaxRatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.TaxRatesTable,
+			Columns: []string{organization.TaxRatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: taxrate.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.TaxRatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.TaxRatesTable,
+			Columns: []string{organization.TaxRatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: taxrate.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.CustomersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.CustomersTable,
+			Columns: []string{organization.CustomersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: customer.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedCustomersIDs(); len(nodes) > 0 && !ou.mutation.CustomersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.CustomersTable,
+			Columns: []string{organization.CustomersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: customer.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.CustomersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.CustomersTable,
+			Columns: []string{organization.CustomersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: customer.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ou.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{organization.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// OrganizationUpdateOne is the builder for updating a single Organization entity.
+type OrganizationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OrganizationMutation
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (ouo *OrganizationUpdateOne) SetUpdatedAt(t time.Time) *OrganizationUpdateOne {
+	ouo.mutation.SetUpdatedAt(t)
+	return ouo
+}
+
+// SetName sets the "name" field.
+func (ouo *OrganizationUpdateOne) SetName(s string) *OrganizationUpdateOne {
+	ouo.mutation.SetName(s)
+	return ouo
+}
+
+// SetPublicKey sets the "publicKey" field.
+func (ouo *OrganizationUpdateOne) SetPublicKey(s string) *OrganizationUpdateOne {
+	ouo.mutation.SetPublicKey(s)
+	return ouo
+}
+
+// SetPrivateKey sets the "privateKey" field.
+func (ouo *OrganizationUpdateOne) SetPrivateKey(s string) *OrganizationUpdateOne {
+	ouo.mutation.SetPrivateKey(s)
+	return ouo
+}
+
+// SetCurrency sets the "currency" field.
+func (ouo *OrganizationUpdateOne) SetCurrency(s string) *OrganizationUpdateOne {
+	ouo.mutation.SetCurrency(s)
+	return ouo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (ouo *OrganizationUpdateOne) SetNillableCurrency(s *string) *OrganizationUpdateOne {
+	if s != nil {
+		ouo.SetCurrency(*s)
+	}
+	return ouo
+}
+
+// AddUserIDs adds the "users" edge to the User entity by IDs.
+func (ouo *OrganizationUpdateOne) AddUserIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.AddUserIDs(ids...)
+	return ouo
+}
+
+// AddUsers adds the "users" edges to the User entity.
+func (ouo *OrganizationUpdateOne) AddUsers(u ...*User) *OrganizationUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return ouo.AddUserIDs(ids...)
+}
+
+// AddResourceIDs adds the "resources" edge to the Resource entity by IDs.
+func (ouo *OrganizationUpdateOne) AddResourceIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.AddResourceIDs(ids...)
+	return ouo
+}
+
+// AddResources adds the "resources" edges to the Resource entity.
+func (ouo *OrganizationUpdateOne) AddResources(r ...*Resource) *OrganizationUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ouo.AddResourceIDs(ids...)
+}
+
+// AddTokenIDs adds the "tokens" edge to the Token entity by IDs.
+func (ouo *OrganizationUpdateOne) AddTokenIDs(ids ...string) *OrganizationUpdateOne {
+	ouo.mutation.AddTokenIDs(ids...)
+	return ouo
+}
+
+// AddTokens adds the "tokens" edges to the Token entity.
+func (ouo *OrganizationUpdateOne) AddTokens(t ...*Token) *OrganizationUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ouo.AddTokenIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (ouo *OrganizationUpdateOne) AddWebhookIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.AddWebhookIDs(ids...)
+	return ouo
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (ouo *OrganizationUpdateOne) AddWebhooks(w ...*Webhook) *OrganizationUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return ouo.AddWebhookIDs(ids...)
+}
+
+// AddPromoCodeIDs adds the "promoCodes" edge to the PromoCode entity by IDs.
+func (ouo *OrganizationUpdateOne) AddPromoCodeIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.AddPromoCodeIDs(ids...)
+	return ouo
+}
+
+// AddPromoCodes adds the "promoCodes" edges to the PromoCode entity.
+func (ouo *OrganizationUpdateOne) AddPromoCodes(p ...*PromoCode) *OrganizationUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ouo.AddPromoCodeIDs(ids...)
+}
+
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
+func (ouo *OrganizationUpdateOne) AddInvoiceIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.AddInvoiceIDs(ids...)
+	return ouo
+}
+
+// AddInvoices adds the "invoices" edges to the Invoice entity.
+func (ouo *OrganizationUpdateOne) AddInvoices(i ...*Invoice) *OrganizationUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ouo.AddInvoiceIDs(ids...)
+}
+
+// AddTaxRateIDs adds the "taxRates" edge to the TaxRate entity by IDs.
+func (ouo *OrganizationUpdateOne) AddTaxRateIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.AddTaxRateIDs(ids...)
+	return ouo
+}
+
+// AddTaxRates adds the "taxRates" edges to the TaxRate entity.
+func (ouo *OrganizationUpdateOne) AddTaxRates(t ...*TaxRate) *OrganizationUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ouo.AddTaxRateIDs(ids...)
+}
+
+// AddCustomerIDs adds the "customers" edge to the Customer entity by IDs.
+func (ouo *OrganizationUpdateOne) AddCustomerIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.AddCustomerIDs(ids...)
+	return ouo
+}
+
+// AddCustomers adds the "customers" edges to the Customer entity.
+func (ouo *OrganizationUpdateOne) AddCustomers(c ...*Customer) *OrganizationUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ouo.AddCustomerIDs(ids...)
+}
+
+// Mutation returns the OrganizationMutation object of the builder.
+func (ouo *OrganizationUpdateOne) Mutation() *OrganizationMutation {
+	return ouo.mutation
+}
+
+// ClearUsers clears all "users" edges to the User entity.
+func (ouo *OrganizationUpdateOne) ClearUsers() *OrganizationUpdateOne {
+	ouo.mutation.ClearUsers()
+	return ouo
+}
+
+// RemoveUserIDs removes the "users" edge to User entities by IDs.
+func (ouo *OrganizationUpdateOne) RemoveUserIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.RemoveUserIDs(ids...)
+	return ouo
+}
+
+// RemoveUsers removes "users" edges to User entities.
+func (ouo *OrganizationUpdateOne) RemoveUsers(u ...*User) *OrganizationUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return ouo.RemoveUserIDs(ids...)
+}
+
+// ClearResources clears all "resources" edges to the Resource entity.
+func (ouo *OrganizationUpdateOne) ClearResources() *OrganizationUpdateOne {
+	ouo.mutation.ClearResources()
+	return ouo
+}
+
+// RemoveResourceIDs removes the "resources" edge to Resource entities by IDs.
 func (ouo *OrganizationUpdateOne) RemoveResourceIDs(ids ...int) *OrganizationUpdateOne {
 	ouo.mutation.RemoveResourceIDs(ids...)
 	return ouo
 }
 
-// RemoveResources removes "resources" edges to Resource entities.
-func (ouo *OrganizationUpdateOne) RemoveResources(r ...*Resource) *OrganizationUpdateOne {
-	ids := make([]int, len(r))
-	for i := range r {
-		ids[i] = r[i].ID
+// RemoveResources removes "resources" edges to Resource entities.
+func (ouo *OrganizationUpdateOne) RemoveResources(r ...*Resource) *OrganizationUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ouo.RemoveResourceIDs(ids...)
+}
+
+// ClearTokens clears all "tokens" edges to the Token entity.
+func (ouo *OrganizationUpdateOne) ClearTokens() *OrganizationUpdateOne {
+	ouo.mutation.ClearTokens()
+	return ouo
+}
+
+// RemoveTokenIDs removes the "tokens" edge to Token entities by IDs.
+func (ouo *OrganizationUpdateOne) RemoveTokenIDs(ids ...string) *OrganizationUpdateOne {
+	ouo.mutation.RemoveTokenIDs(ids...)
+	return ouo
+}
+
+// RemoveTokens removes "tokens" edges to Token entities.
+func (ouo *OrganizationUpdateOne) RemoveTokens(t ...*Token) *OrganizationUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ouo.RemoveTokenIDs(ids...)
+}
+
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (ouo *OrganizationUpdateOne) ClearWebhooks() *OrganizationUpdateOne {
+	ouo.mutation.ClearWebhooks()
+	return ouo
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (ouo *OrganizationUpdateOne) RemoveWebhookIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.RemoveWebhookIDs(ids...)
+	return ouo
+}
+
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (ouo *OrganizationUpdateOne) RemoveWebhooks(w ...*Webhook) *OrganizationUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return ouo.RemoveWebhookIDs(ids...)
+}
+
+// ClearPromoCodes clears all "promoCodes" edges to the PromoCode entity.
+func (ouo *OrganizationUpdateOne) ClearPromoCodes() *OrganizationUpdateOne {
+	ouo.mutation.ClearPromoCodes()
+	return ouo
+}
+
+// RemovePromoCodeIDs removes the "promoCodes" edge to PromoCode entities by IDs.
+func (ouo *OrganizationUpdateOne) RemovePromoCodeIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.RemovePromoCodeIDs(ids...)
+	return ouo
+}
+
+// RemovePromoCodes removes "promoCodes" edges to PromoCode entities.
+func (ouo *OrganizationUpdateOne) RemovePromoCodes(p ...*PromoCode) *OrganizationUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	return ouo.RemoveResourceIDs(ids...)
+	return ouo.RemovePromoCodeIDs(ids...)
 }
 
-// ClearTokens clears all "tokens" edges to the Token entity.
-func (ouo *OrganizationUpdateOne) ClearTokens() *OrganizationUpdateOne {
-	ouo.mutation.ClearTokens()
+// ClearInvoices clears all "invoices" edges to the Invoice entity.
+func (ouo *OrganizationUpdateOne) ClearInvoices() *OrganizationUpdateOne {
+	ouo.mutation.ClearInvoices()
 	return ouo
 }
 
-// RemoveTokenIDs removes the "tokens" edge to Token entities by IDs.
-func (ouo *OrganizationUpdateOne) RemoveTokenIDs(ids ...string) *OrganizationUpdateOne {
-	ouo.mutation.RemoveTokenIDs(ids...)
+// RemoveInvoiceIDs removes the "invoices" edge to Invoice entities by IDs.
+func (ouo *OrganizationUpdateOne) RemoveInvoiceIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.RemoveInvoiceIDs(ids...)
 	return ouo
 }
 
-// RemoveTokens removes "tokens" edges to Token entities.
-func (ouo *OrganizationUpdateOne) RemoveTokens(t ...*Token) *OrganizationUpdateOne {
-	ids := make([]string, len(t))
+// RemoveInvoices removes "invoices" edges to Invoice entities.
+func (ouo *OrganizationUpdateOne) RemoveInvoices(i ...*Invoice) *OrganizationUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ouo.RemoveInvoiceIDs(ids...)
+}
+
+// ClearTaxRates clears all "taxRates" edges to the TaxRate entity.
+func (ouo *OrganizationUpdateOne) ClearTaxRates() *OrganizationUpdateOne {
+	ouo.mutation.ClearTaxRates()
+	return ouo
+}
+
+// RemoveTaxRateIDs removes the "taxRates" edge to TaxRate entities by IDs.
+func (ouo *OrganizationUpdateOne) RemoveTaxRateIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.RemoveTaxRateIDs(ids...)
+	return ouo
+}
+
+// RemoveTaxRates removes "taxRates" edges to TaxRate entities.
+func (ouo *OrganizationUpdateOne) RemoveTaxRates(t ...*TaxRate) *OrganizationUpdateOne {
+	ids := make([]int, len(t))
 	for i := range t {
 		ids[i] = t[i].ID
 	}
-	return ouo.RemoveTokenIDs(ids...)
+	return ouo.RemoveTaxRateIDs(ids...)
+}
+
+// ClearCustomers clears all "customers" edges to the Customer entity.
+func (ouo *OrganizationUpdateOne) ClearCustomers() *OrganizationUpdateOne {
+	ouo.mutation.ClearCustomers()
+	return ouo
+}
+
+// RemoveCustomerIDs removes the "customers" edge to Customer entities by IDs.
+func (ouo *OrganizationUpdateOne) RemoveCustomerIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.RemoveCustomerIDs(ids...)
+	return ouo
+}
+
+// RemoveCustomers removes "customers" edges to Customer entities.
+func (ouo *OrganizationUpdateOne) RemoveCustomers(c ...*Customer) *OrganizationUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ouo.RemoveCustomerIDs(ids...)
 }
 
 // Select allows selecting one or more fields (columns) of the returned entity.
@@ -727,6 +1397,13 @@ func (ouo *OrganizationUpdateOne) sqlSave(ctx context.Context) (_node *Organizat
 			Column: organization.FieldPrivateKey,
 		})
 	}
+	if value, ok := ouo.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: organization.FieldCurrency,
+		})
+	}
 	if ouo.mutation.UsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -889,6 +1566,276 @@ func (ouo *OrganizationUpdateOne) sqlSave(ctx context.Context) (_node *Organizat
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ouo.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhooksTable,
+			Columns: []string{organization.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !ouo.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhooksTable,
+			Columns: []string{organization.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhooksTable,
+			Columns: []string{organization.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.PromoCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.PromoCodesTable,
+			Columns: []string{organization.PromoCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: promocode.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedPromoCodesIDs(); len(nodes) > 0 && !ouo.mutation.PromoCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.PromoCodesTable,
+			Columns: []string{organization.PromoCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: promocode.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.PromoCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.PromoCodesTable,
+			Columns: []string{organization.PromoCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: promocode.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.InvoicesTable,
+			Columns: []string{organization.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedInvoicesIDs(); len(nodes) > 0 && !ouo.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.InvoicesTable,
+			Columns: []string{organization.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.InvoicesTable,
+			Columns: []string{organization.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.TaxRatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.TaxRatesTable,
+			Columns: []string{organization.TaxRatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: taxrate.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedTaxRatesIDs(); len(nodes) > 0 && !ouo.mutation.TaxRatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.TaxRatesTable,
+			Columns: []string{organization.TaxRatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: taxrate.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.TaxRatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.TaxRatesTable,
+			Columns: []string{organization.TaxRatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: taxrate.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.CustomersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.CustomersTable,
+			Columns: []string{organization.CustomersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: customer.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedCustomersIDs(); len(nodes) > 0 && !ouo.mutation.CustomersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.CustomersTable,
+			Columns: []string{organization.CustomersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: customer.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.CustomersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.CustomersTable,
+			Columns: []string{organization.CustomersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: customer.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Organization{config: ouo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
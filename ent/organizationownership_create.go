@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/openmesh/booking/ent/organization"
@@ -19,6 +20,7 @@ type OrganizationOwnershipCreate struct {
 	config
 	mutation *OrganizationOwnershipMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUserId sets the "userId" field.
@@ -164,6 +166,7 @@ func (ooc *OrganizationOwnershipCreate) createSpec() (*OrganizationOwnership, *s
 			},
 		}
 	)
+	_spec.OnConflict = ooc.conflict
 	if nodes := ooc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -207,10 +210,185 @@ func (ooc *OrganizationOwnershipCreate) createSpec() (*OrganizationOwnership, *s
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OrganizationOwnership.Create().
+//		SetUserId(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OrganizationOwnershipUpsert) {
+//			SetUserId(v+v).
+//		}).
+//		Exec(ctx)
+func (ooc *OrganizationOwnershipCreate) OnConflict(opts ...sql.ConflictOption) *OrganizationOwnershipUpsertOne {
+	ooc.conflict = opts
+	return &OrganizationOwnershipUpsertOne{
+		create: ooc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OrganizationOwnership.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ooc *OrganizationOwnershipCreate) OnConflictColumns(columns ...string) *OrganizationOwnershipUpsertOne {
+	ooc.conflict = append(ooc.conflict, sql.ConflictColumns(columns...))
+	return &OrganizationOwnershipUpsertOne{
+		create: ooc,
+	}
+}
+
+type (
+	// OrganizationOwnershipUpsertOne is the builder for "upsert"-ing
+	//  one OrganizationOwnership node.
+	OrganizationOwnershipUpsertOne struct {
+		create *OrganizationOwnershipCreate
+	}
+
+	// OrganizationOwnershipUpsert is the "OnConflict" setter.
+	OrganizationOwnershipUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserId sets the "userId" field.
+func (u *OrganizationOwnershipUpsert) SetUserId(v int) *OrganizationOwnershipUpsert {
+	u.Set(organizationownership.FieldUserId, v)
+	return u
+}
+
+// UpdateUserId sets the "userId" field to the value that was provided on create.
+func (u *OrganizationOwnershipUpsert) UpdateUserId() *OrganizationOwnershipUpsert {
+	u.SetExcluded(organizationownership.FieldUserId)
+	return u
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (u *OrganizationOwnershipUpsert) SetOrganizationId(v int) *OrganizationOwnershipUpsert {
+	u.Set(organizationownership.FieldOrganizationId, v)
+	return u
+}
+
+// UpdateOrganizationId sets the "organizationId" field to the value that was provided on create.
+func (u *OrganizationOwnershipUpsert) UpdateOrganizationId() *OrganizationOwnershipUpsert {
+	u.SetExcluded(organizationownership.FieldOrganizationId)
+	return u
+}
+
+// UpdateNewValues updates the fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.OrganizationOwnership.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *OrganizationOwnershipUpsertOne) UpdateNewValues() *OrganizationOwnershipUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OrganizationOwnership.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *OrganizationOwnershipUpsertOne) Ignore() *OrganizationOwnershipUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OrganizationOwnershipUpsertOne) DoNothing() *OrganizationOwnershipUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OrganizationOwnershipCreate.OnConflict
+// documentation for more info.
+func (u *OrganizationOwnershipUpsertOne) Update(set func(*OrganizationOwnershipUpsert)) *OrganizationOwnershipUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OrganizationOwnershipUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserId sets the "userId" field.
+func (u *OrganizationOwnershipUpsertOne) SetUserId(v int) *OrganizationOwnershipUpsertOne {
+	return u.Update(func(s *OrganizationOwnershipUpsert) {
+		s.SetUserId(v)
+	})
+}
+
+// UpdateUserId sets the "userId" field to the value that was provided on create.
+func (u *OrganizationOwnershipUpsertOne) UpdateUserId() *OrganizationOwnershipUpsertOne {
+	return u.Update(func(s *OrganizationOwnershipUpsert) {
+		s.UpdateUserId()
+	})
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (u *OrganizationOwnershipUpsertOne) SetOrganizationId(v int) *OrganizationOwnershipUpsertOne {
+	return u.Update(func(s *OrganizationOwnershipUpsert) {
+		s.SetOrganizationId(v)
+	})
+}
+
+// UpdateOrganizationId sets the "organizationId" field to the value that was provided on create.
+func (u *OrganizationOwnershipUpsertOne) UpdateOrganizationId() *OrganizationOwnershipUpsertOne {
+	return u.Update(func(s *OrganizationOwnershipUpsert) {
+		s.UpdateOrganizationId()
+	})
+}
+
+// Exec executes the query.
+func (u *OrganizationOwnershipUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OrganizationOwnershipCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OrganizationOwnershipUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *OrganizationOwnershipUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *OrganizationOwnershipUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // OrganizationOwnershipCreateBulk is the builder for creating many OrganizationOwnership entities in bulk.
 type OrganizationOwnershipCreateBulk struct {
 	config
 	builders []*OrganizationOwnershipCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the OrganizationOwnership entities in the database.
@@ -236,6 +414,7 @@ func (oocb *OrganizationOwnershipCreateBulk) Save(ctx context.Context) ([]*Organ
 					_, err = mutators[i+1].Mutate(root, oocb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = oocb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, oocb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -289,3 +468,132 @@ func (oocb *OrganizationOwnershipCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OrganizationOwnership.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OrganizationOwnershipUpsert) {
+//			SetUserId(v+v).
+//		}).
+//		Exec(ctx)
+func (oocb *OrganizationOwnershipCreateBulk) OnConflict(opts ...sql.ConflictOption) *OrganizationOwnershipUpsertBulk {
+	oocb.conflict = opts
+	return &OrganizationOwnershipUpsertBulk{
+		create: oocb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OrganizationOwnership.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (oocb *OrganizationOwnershipCreateBulk) OnConflictColumns(columns ...string) *OrganizationOwnershipUpsertBulk {
+	oocb.conflict = append(oocb.conflict, sql.ConflictColumns(columns...))
+	return &OrganizationOwnershipUpsertBulk{
+		create: oocb,
+	}
+}
+
+// OrganizationOwnershipUpsertBulk is the builder for "upsert"-ing
+// a bulk of OrganizationOwnership nodes.
+type OrganizationOwnershipUpsertBulk struct {
+	create *OrganizationOwnershipCreateBulk
+}
+
+// UpdateNewValues updates the fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.OrganizationOwnership.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *OrganizationOwnershipUpsertBulk) UpdateNewValues() *OrganizationOwnershipUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OrganizationOwnership.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *OrganizationOwnershipUpsertBulk) Ignore() *OrganizationOwnershipUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OrganizationOwnershipUpsertBulk) DoNothing() *OrganizationOwnershipUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OrganizationOwnershipCreateBulk.OnConflict
+// documentation for more info.
+func (u *OrganizationOwnershipUpsertBulk) Update(set func(*OrganizationOwnershipUpsert)) *OrganizationOwnershipUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OrganizationOwnershipUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserId sets the "userId" field.
+func (u *OrganizationOwnershipUpsertBulk) SetUserId(v int) *OrganizationOwnershipUpsertBulk {
+	return u.Update(func(s *OrganizationOwnershipUpsert) {
+		s.SetUserId(v)
+	})
+}
+
+// UpdateUserId sets the "userId" field to the value that was provided on create.
+func (u *OrganizationOwnershipUpsertBulk) UpdateUserId() *OrganizationOwnershipUpsertBulk {
+	return u.Update(func(s *OrganizationOwnershipUpsert) {
+		s.UpdateUserId()
+	})
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (u *OrganizationOwnershipUpsertBulk) SetOrganizationId(v int) *OrganizationOwnershipUpsertBulk {
+	return u.Update(func(s *OrganizationOwnershipUpsert) {
+		s.SetOrganizationId(v)
+	})
+}
+
+// UpdateOrganizationId sets the "organizationId" field to the value that was provided on create.
+func (u *OrganizationOwnershipUpsertBulk) UpdateOrganizationId() *OrganizationOwnershipUpsertBulk {
+	return u.Update(func(s *OrganizationOwnershipUpsert) {
+		s.UpdateOrganizationId()
+	})
+}
+
+// Exec executes the query.
+func (u *OrganizationOwnershipUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the OrganizationOwnershipCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OrganizationOwnershipCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OrganizationOwnershipUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
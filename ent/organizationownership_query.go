@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -29,6 +30,7 @@ type OrganizationOwnershipQuery struct {
 	// eager-loading edges.
 	withUser         *UserQuery
 	withOrganization *OrganizationQuery
+	modifiers        []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -403,6 +405,9 @@ func (ooq *OrganizationOwnershipQuery) sqlAll(ctx context.Context) ([]*Organizat
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(ooq.modifiers) > 0 {
+		_spec.Modifiers = ooq.modifiers
+	}
 	if err := sqlgraph.QueryNodes(ctx, ooq.driver, _spec); err != nil {
 		return nil, err
 	}
@@ -467,6 +472,9 @@ func (ooq *OrganizationOwnershipQuery) sqlAll(ctx context.Context) ([]*Organizat
 
 func (ooq *OrganizationOwnershipQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ooq.querySpec()
+	if len(ooq.modifiers) > 0 {
+		_spec.Modifiers = ooq.modifiers
+	}
 	return sqlgraph.CountNodes(ctx, ooq.driver, _spec)
 }
 
@@ -538,6 +546,9 @@ func (ooq *OrganizationOwnershipQuery) sqlQuery(ctx context.Context) *sql.Select
 		selector = ooq.sql
 		selector.Select(selector.Columns(columns...)...)
 	}
+	for _, m := range ooq.modifiers {
+		m(selector)
+	}
 	for _, p := range ooq.predicates {
 		p(selector)
 	}
@@ -555,6 +566,32 @@ func (ooq *OrganizationOwnershipQuery) sqlQuery(ctx context.Context) *sql.Select
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ooq *OrganizationOwnershipQuery) ForUpdate(opts ...sql.LockOption) *OrganizationOwnershipQuery {
+	if ooq.driver.Dialect() == dialect.Postgres {
+		ooq.Unique(false)
+	}
+	ooq.modifiers = append(ooq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ooq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ooq *OrganizationOwnershipQuery) ForShare(opts ...sql.LockOption) *OrganizationOwnershipQuery {
+	if ooq.driver.Dialect() == dialect.Postgres {
+		ooq.Unique(false)
+	}
+	ooq.modifiers = append(ooq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ooq
+}
+
 // OrganizationOwnershipGroupBy is the group-by builder for OrganizationOwnership entities.
 type OrganizationOwnershipGroupBy struct {
 	config
@@ -0,0 +1,94 @@
+// Code generated by entc, DO NOT EDIT.
+
+package payment
+
+import (
+	"time"
+
+	"entgo.io/ent"
+)
+
+const (
+	// Label holds the string label denoting the payment type in the database.
+	Label = "payment"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the createdat field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updatedat field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldProviderPaymentId holds the string denoting the providerpaymentid field in the database.
+	FieldProviderPaymentId = "provider_payment_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldAmountRefunded holds the string denoting the amountrefunded field in the database.
+	FieldAmountRefunded = "amount_refunded"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldClientSecret holds the string denoting the clientsecret field in the database.
+	FieldClientSecret = "client_secret"
+	// FieldFailureReason holds the string denoting the failurereason field in the database.
+	FieldFailureReason = "failure_reason"
+	// FieldBookingId holds the string denoting the bookingid field in the database.
+	FieldBookingId = "booking_id"
+	// EdgeBooking holds the string denoting the booking edge name in mutations.
+	EdgeBooking = "booking"
+	// Table holds the table name of the payment in the database.
+	Table = "payments"
+	// BookingTable is the table that holds the booking relation/edge.
+	BookingTable = "payments"
+	// BookingInverseTable is the table name for the Booking entity.
+	// It exists in this package in order to avoid circular dependency with the "booking" package.
+	BookingInverseTable = "bookings"
+	// BookingColumn is the table column denoting the booking relation/edge.
+	BookingColumn = "booking_id"
+)
+
+// Columns holds all SQL columns for payment fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldProvider,
+	FieldProviderPaymentId,
+	FieldStatus,
+	FieldAmount,
+	FieldAmountRefunded,
+	FieldCurrency,
+	FieldClientSecret,
+	FieldFailureReason,
+	FieldBookingId,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/openmesh/booking/ent/runtime"
+//
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCreatedAt holds the default value on creation for the "createdAt" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updatedAt" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updatedAt" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultAmountRefunded holds the default value on creation for the "amountRefunded" field.
+	DefaultAmountRefunded int
+)
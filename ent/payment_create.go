@@ -0,0 +1,1187 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/payment"
+)
+
+// PaymentCreate is the builder for creating a Payment entity.
+type PaymentCreate struct {
+	config
+	mutation *PaymentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (pc *PaymentCreate) SetCreatedAt(t time.Time) *PaymentCreate {
+	pc.mutation.SetCreatedAt(t)
+	return pc
+}
+
+// SetNillableCreatedAt sets the "createdAt" field if the given value is not nil.
+func (pc *PaymentCreate) SetNillableCreatedAt(t *time.Time) *PaymentCreate {
+	if t != nil {
+		pc.SetCreatedAt(*t)
+	}
+	return pc
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (pc *PaymentCreate) SetUpdatedAt(t time.Time) *PaymentCreate {
+	pc.mutation.SetUpdatedAt(t)
+	return pc
+}
+
+// SetNillableUpdatedAt sets the "updatedAt" field if the given value is not nil.
+func (pc *PaymentCreate) SetNillableUpdatedAt(t *time.Time) *PaymentCreate {
+	if t != nil {
+		pc.SetUpdatedAt(*t)
+	}
+	return pc
+}
+
+// SetProvider sets the "provider" field.
+func (pc *PaymentCreate) SetProvider(s string) *PaymentCreate {
+	pc.mutation.SetProvider(s)
+	return pc
+}
+
+// SetProviderPaymentId sets the "providerPaymentId" field.
+func (pc *PaymentCreate) SetProviderPaymentId(s string) *PaymentCreate {
+	pc.mutation.SetProviderPaymentId(s)
+	return pc
+}
+
+// SetStatus sets the "status" field.
+func (pc *PaymentCreate) SetStatus(s string) *PaymentCreate {
+	pc.mutation.SetStatus(s)
+	return pc
+}
+
+// SetAmount sets the "amount" field.
+func (pc *PaymentCreate) SetAmount(i int) *PaymentCreate {
+	pc.mutation.SetAmount(i)
+	return pc
+}
+
+// SetAmountRefunded sets the "amountRefunded" field.
+func (pc *PaymentCreate) SetAmountRefunded(i int) *PaymentCreate {
+	pc.mutation.SetAmountRefunded(i)
+	return pc
+}
+
+// SetNillableAmountRefunded sets the "amountRefunded" field if the given value is not nil.
+func (pc *PaymentCreate) SetNillableAmountRefunded(i *int) *PaymentCreate {
+	if i != nil {
+		pc.SetAmountRefunded(*i)
+	}
+	return pc
+}
+
+// SetCurrency sets the "currency" field.
+func (pc *PaymentCreate) SetCurrency(s string) *PaymentCreate {
+	pc.mutation.SetCurrency(s)
+	return pc
+}
+
+// SetClientSecret sets the "clientSecret" field.
+func (pc *PaymentCreate) SetClientSecret(s string) *PaymentCreate {
+	pc.mutation.SetClientSecret(s)
+	return pc
+}
+
+// SetNillableClientSecret sets the "clientSecret" field if the given value is not nil.
+func (pc *PaymentCreate) SetNillableClientSecret(s *string) *PaymentCreate {
+	if s != nil {
+		pc.SetClientSecret(*s)
+	}
+	return pc
+}
+
+// SetFailureReason sets the "failureReason" field.
+func (pc *PaymentCreate) SetFailureReason(s string) *PaymentCreate {
+	pc.mutation.SetFailureReason(s)
+	return pc
+}
+
+// SetNillableFailureReason sets the "failureReason" field if the given value is not nil.
+func (pc *PaymentCreate) SetNillableFailureReason(s *string) *PaymentCreate {
+	if s != nil {
+		pc.SetFailureReason(*s)
+	}
+	return pc
+}
+
+// SetBookingId sets the "bookingId" field.
+func (pc *PaymentCreate) SetBookingId(i int) *PaymentCreate {
+	pc.mutation.SetBookingId(i)
+	return pc
+}
+
+// SetBookingID sets the "booking" edge to the Booking entity by ID.
+func (pc *PaymentCreate) SetBookingID(id int) *PaymentCreate {
+	pc.mutation.SetBookingID(id)
+	return pc
+}
+
+// SetBooking sets the "booking" edge to the Booking entity.
+func (pc *PaymentCreate) SetBooking(b *Booking) *PaymentCreate {
+	return pc.SetBookingID(b.ID)
+}
+
+// Mutation returns the PaymentMutation object of the builder.
+func (pc *PaymentCreate) Mutation() *PaymentMutation {
+	return pc.mutation
+}
+
+// Save creates the Payment in the database.
+func (pc *PaymentCreate) Save(ctx context.Context) (*Payment, error) {
+	var (
+		err  error
+		node *Payment
+	)
+	if err := pc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(pc.hooks) == 0 {
+		if err = pc.check(); err != nil {
+			return nil, err
+		}
+		node, err = pc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PaymentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pc.check(); err != nil {
+				return nil, err
+			}
+			pc.mutation = mutation
+			if node, err = pc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pc.hooks) - 1; i >= 0; i-- {
+			if pc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *PaymentCreate) SaveX(ctx context.Context) *Payment {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *PaymentCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *PaymentCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *PaymentCreate) defaults() error {
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		if payment.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized payment.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := payment.DefaultCreatedAt()
+		pc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		if payment.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized payment.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := payment.DefaultUpdatedAt()
+		pc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := pc.mutation.AmountRefunded(); !ok {
+		v := payment.DefaultAmountRefunded
+		pc.mutation.SetAmountRefunded(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *PaymentCreate) check() error {
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "createdAt", err: errors.New(`ent: missing required field "createdAt"`)}
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updatedAt", err: errors.New(`ent: missing required field "updatedAt"`)}
+	}
+	if _, ok := pc.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "provider"`)}
+	}
+	if _, ok := pc.mutation.ProviderPaymentId(); !ok {
+		return &ValidationError{Name: "providerPaymentId", err: errors.New(`ent: missing required field "providerPaymentId"`)}
+	}
+	if _, ok := pc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "status"`)}
+	}
+	if _, ok := pc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "amount"`)}
+	}
+	if _, ok := pc.mutation.AmountRefunded(); !ok {
+		return &ValidationError{Name: "amountRefunded", err: errors.New(`ent: missing required field "amountRefunded"`)}
+	}
+	if _, ok := pc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "currency"`)}
+	}
+	if _, ok := pc.mutation.BookingId(); !ok {
+		return &ValidationError{Name: "bookingId", err: errors.New(`ent: missing required field "bookingId"`)}
+	}
+	if _, ok := pc.mutation.BookingID(); !ok {
+		return &ValidationError{Name: "booking", err: errors.New("ent: missing required edge \"booking\"")}
+	}
+	return nil
+}
+
+func (pc *PaymentCreate) sqlSave(ctx context.Context) (*Payment, error) {
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (pc *PaymentCreate) createSpec() (*Payment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Payment{config: pc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: payment.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: payment.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = pc.conflict
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: payment.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := pc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: payment.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if value, ok := pc.mutation.Provider(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: payment.FieldProvider,
+		})
+		_node.Provider = value
+	}
+	if value, ok := pc.mutation.ProviderPaymentId(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: payment.FieldProviderPaymentId,
+		})
+		_node.ProviderPaymentId = value
+	}
+	if value, ok := pc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: payment.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := pc.mutation.Amount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: payment.FieldAmount,
+		})
+		_node.Amount = value
+	}
+	if value, ok := pc.mutation.AmountRefunded(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: payment.FieldAmountRefunded,
+		})
+		_node.AmountRefunded = value
+	}
+	if value, ok := pc.mutation.Currency(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: payment.FieldCurrency,
+		})
+		_node.Currency = value
+	}
+	if value, ok := pc.mutation.ClientSecret(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: payment.FieldClientSecret,
+		})
+		_node.ClientSecret = value
+	}
+	if value, ok := pc.mutation.FailureReason(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: payment.FieldFailureReason,
+		})
+		_node.FailureReason = value
+	}
+	if nodes := pc.mutation.BookingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payment.BookingTable,
+			Columns: []string{payment.BookingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BookingId = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Payment.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pc *PaymentCreate) OnConflict(opts ...sql.ConflictOption) *PaymentUpsertOne {
+	pc.conflict = opts
+	return &PaymentUpsertOne{
+		create: pc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Payment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pc *PaymentCreate) OnConflictColumns(columns ...string) *PaymentUpsertOne {
+	pc.conflict = append(pc.conflict, sql.ConflictColumns(columns...))
+	return &PaymentUpsertOne{
+		create: pc,
+	}
+}
+
+type (
+	// PaymentUpsertOne is the builder for "upsert"-ing
+	//  one Payment node.
+	PaymentUpsertOne struct {
+		create *PaymentCreate
+	}
+
+	// PaymentUpsert is the "OnConflict" setter.
+	PaymentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *PaymentUpsert) SetCreatedAt(v time.Time) *PaymentUpsert {
+	u.Set(payment.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *PaymentUpsert) UpdateCreatedAt() *PaymentUpsert {
+	u.SetExcluded(payment.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *PaymentUpsert) SetUpdatedAt(v time.Time) *PaymentUpsert {
+	u.Set(payment.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *PaymentUpsert) UpdateUpdatedAt() *PaymentUpsert {
+	u.SetExcluded(payment.FieldUpdatedAt)
+	return u
+}
+
+// SetProvider sets the "provider" field.
+func (u *PaymentUpsert) SetProvider(v string) *PaymentUpsert {
+	u.Set(payment.FieldProvider, v)
+	return u
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *PaymentUpsert) UpdateProvider() *PaymentUpsert {
+	u.SetExcluded(payment.FieldProvider)
+	return u
+}
+
+// SetProviderPaymentId sets the "providerPaymentId" field.
+func (u *PaymentUpsert) SetProviderPaymentId(v string) *PaymentUpsert {
+	u.Set(payment.FieldProviderPaymentId, v)
+	return u
+}
+
+// UpdateProviderPaymentId sets the "providerPaymentId" field to the value that was provided on create.
+func (u *PaymentUpsert) UpdateProviderPaymentId() *PaymentUpsert {
+	u.SetExcluded(payment.FieldProviderPaymentId)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PaymentUpsert) SetStatus(v string) *PaymentUpsert {
+	u.Set(payment.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PaymentUpsert) UpdateStatus() *PaymentUpsert {
+	u.SetExcluded(payment.FieldStatus)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *PaymentUpsert) SetAmount(v int) *PaymentUpsert {
+	u.Set(payment.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *PaymentUpsert) UpdateAmount() *PaymentUpsert {
+	u.SetExcluded(payment.FieldAmount)
+	return u
+}
+
+// SetAmountRefunded sets the "amountRefunded" field.
+func (u *PaymentUpsert) SetAmountRefunded(v int) *PaymentUpsert {
+	u.Set(payment.FieldAmountRefunded, v)
+	return u
+}
+
+// UpdateAmountRefunded sets the "amountRefunded" field to the value that was provided on create.
+func (u *PaymentUpsert) UpdateAmountRefunded() *PaymentUpsert {
+	u.SetExcluded(payment.FieldAmountRefunded)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *PaymentUpsert) SetCurrency(v string) *PaymentUpsert {
+	u.Set(payment.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *PaymentUpsert) UpdateCurrency() *PaymentUpsert {
+	u.SetExcluded(payment.FieldCurrency)
+	return u
+}
+
+// SetClientSecret sets the "clientSecret" field.
+func (u *PaymentUpsert) SetClientSecret(v string) *PaymentUpsert {
+	u.Set(payment.FieldClientSecret, v)
+	return u
+}
+
+// UpdateClientSecret sets the "clientSecret" field to the value that was provided on create.
+func (u *PaymentUpsert) UpdateClientSecret() *PaymentUpsert {
+	u.SetExcluded(payment.FieldClientSecret)
+	return u
+}
+
+// ClearClientSecret clears the value of the "clientSecret" field.
+func (u *PaymentUpsert) ClearClientSecret() *PaymentUpsert {
+	u.SetNull(payment.FieldClientSecret)
+	return u
+}
+
+// SetFailureReason sets the "failureReason" field.
+func (u *PaymentUpsert) SetFailureReason(v string) *PaymentUpsert {
+	u.Set(payment.FieldFailureReason, v)
+	return u
+}
+
+// UpdateFailureReason sets the "failureReason" field to the value that was provided on create.
+func (u *PaymentUpsert) UpdateFailureReason() *PaymentUpsert {
+	u.SetExcluded(payment.FieldFailureReason)
+	return u
+}
+
+// ClearFailureReason clears the value of the "failureReason" field.
+func (u *PaymentUpsert) ClearFailureReason() *PaymentUpsert {
+	u.SetNull(payment.FieldFailureReason)
+	return u
+}
+
+// SetBookingId sets the "bookingId" field.
+func (u *PaymentUpsert) SetBookingId(v int) *PaymentUpsert {
+	u.Set(payment.FieldBookingId, v)
+	return u
+}
+
+// UpdateBookingId sets the "bookingId" field to the value that was provided on create.
+func (u *PaymentUpsert) UpdateBookingId() *PaymentUpsert {
+	u.SetExcluded(payment.FieldBookingId)
+	return u
+}
+
+// UpdateNewValues updates the fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Payment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PaymentUpsertOne) UpdateNewValues() *PaymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Payment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PaymentUpsertOne) Ignore() *PaymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentUpsertOne) DoNothing() *PaymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentCreate.OnConflict
+// documentation for more info.
+func (u *PaymentUpsertOne) Update(set func(*PaymentUpsert)) *PaymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *PaymentUpsertOne) SetCreatedAt(v time.Time) *PaymentUpsertOne {
+	return u.Update(func(s *PaymentUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *PaymentUpsertOne) UpdateCreatedAt() *PaymentUpsertOne {
+	return u.Update(func(s *PaymentUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *PaymentUpsertOne) SetUpdatedAt(v time.Time) *PaymentUpsertOne {
+	return u.Update(func(s *PaymentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *PaymentUpsertOne) UpdateUpdatedAt() *PaymentUpsertOne {
+	return u.Update(func(s *PaymentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *PaymentUpsertOne) SetProvider(v string) *PaymentUpsertOne {
+	return u.Update(func(s *PaymentUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *PaymentUpsertOne) UpdateProvider() *PaymentUpsertOne {
+	return u.Update(func(s *PaymentUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetProviderPaymentId sets the "providerPaymentId" field.
+func (u *PaymentUpsertOne) SetProviderPaymentId(v string) *PaymentUpsertOne {
+	return u.Update(func(s *PaymentUpsert) {
+		s.SetProviderPaymentId(v)
+	})
+}
+
+// UpdateProviderPaymentId sets the "providerPaymentId" field to the value that was provided on create.
+func (u *PaymentUpsertOne) UpdateProviderPaymentId() *PaymentUpsertOne {
+	return u.Update(func(s *PaymentUpsert) {
+		s.UpdateProviderPaymentId()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PaymentUpsertOne) SetStatus(v string) *PaymentUpsertOne {
+	return u.Update(func(s *PaymentUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PaymentUpsertOne) UpdateStatus() *PaymentUpsertOne {
+	return u.Update(func(s *PaymentUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *PaymentUpsertOne) SetAmount(v int) *PaymentUpsertOne {
+	return u.Update(func(s *PaymentUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *PaymentUpsertOne) UpdateAmount() *PaymentUpsertOne {
+	return u.Update(func(s *PaymentUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetAmountRefunded sets the "amountRefunded" field.
+func (u *PaymentUpsertOne) SetAmountRefunded(v int) *PaymentUpsertOne {
+	return u.Update(func(s *PaymentUpsert) {
+		s.SetAmountRefunded(v)
+	})
+}
+
+// UpdateAmountRefunded sets the "amountRefunded" field to the value that was provided on create.
+func (u *PaymentUpsertOne) UpdateAmountRefunded() *PaymentUpsertOne {
+	return u.Update(func(s *PaymentUpsert) {
+		s.UpdateAmountRefunded()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *PaymentUpsertOne) SetCurrency(v string) *PaymentUpsertOne {
+	return u.Update(func(s *PaymentUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *PaymentUpsertOne) UpdateCurrency() *PaymentUpsertOne {
+	return u.Update(func(s *PaymentUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetClientSecret sets the "clientSecret" field.
+func (u *PaymentUpsertOne) SetClientSecret(v string) *PaymentUpsertOne {
+	return u.Update(func(s *PaymentUpsert) {
+		s.SetClientSecret(v)
+	})
+}
+
+// UpdateClientSecret sets the "clientSecret" field to the value that was provided on create.
+func (u *PaymentUpsertOne) UpdateClientSecret() *PaymentUpsertOne {
+	return u.Update(func(s *PaymentUpsert) {
+		s.UpdateClientSecret()
+	})
+}
+
+// ClearClientSecret clears the value of the "clientSecret" field.
+func (u *PaymentUpsertOne) ClearClientSecret() *PaymentUpsertOne {
+	return u.Update(func(s *PaymentUpsert) {
+		s.ClearClientSecret()
+	})
+}
+
+// SetFailureReason sets the "failureReason" field.
+func (u *PaymentUpsertOne) SetFailureReason(v string) *PaymentUpsertOne {
+	return u.Update(func(s *PaymentUpsert) {
+		s.SetFailureReason(v)
+	})
+}
+
+// UpdateFailureReason sets the "failureReason" field to the value that was provided on create.
+func (u *PaymentUpsertOne) UpdateFailureReason() *PaymentUpsertOne {
+	return u.Update(func(s *PaymentUpsert) {
+		s.UpdateFailureReason()
+	})
+}
+
+// ClearFailureReason clears the value of the "failureReason" field.
+func (u *PaymentUpsertOne) ClearFailureReason() *PaymentUpsertOne {
+	return u.Update(func(s *PaymentUpsert) {
+		s.ClearFailureReason()
+	})
+}
+
+// SetBookingId sets the "bookingId" field.
+func (u *PaymentUpsertOne) SetBookingId(v int) *PaymentUpsertOne {
+	return u.Update(func(s *PaymentUpsert) {
+		s.SetBookingId(v)
+	})
+}
+
+// UpdateBookingId sets the "bookingId" field to the value that was provided on create.
+func (u *PaymentUpsertOne) UpdateBookingId() *PaymentUpsertOne {
+	return u.Update(func(s *PaymentUpsert) {
+		s.UpdateBookingId()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PaymentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PaymentUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PaymentUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PaymentCreateBulk is the builder for creating many Payment entities in bulk.
+type PaymentCreateBulk struct {
+	config
+	builders []*PaymentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Payment entities in the database.
+func (pcb *PaymentCreateBulk) Save(ctx context.Context) ([]*Payment, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Payment, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PaymentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *PaymentCreateBulk) SaveX(ctx context.Context) []*Payment {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *PaymentCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *PaymentCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Payment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pcb *PaymentCreateBulk) OnConflict(opts ...sql.ConflictOption) *PaymentUpsertBulk {
+	pcb.conflict = opts
+	return &PaymentUpsertBulk{
+		create: pcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Payment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pcb *PaymentCreateBulk) OnConflictColumns(columns ...string) *PaymentUpsertBulk {
+	pcb.conflict = append(pcb.conflict, sql.ConflictColumns(columns...))
+	return &PaymentUpsertBulk{
+		create: pcb,
+	}
+}
+
+// PaymentUpsertBulk is the builder for "upsert"-ing
+// a bulk of Payment nodes.
+type PaymentUpsertBulk struct {
+	create *PaymentCreateBulk
+}
+
+// UpdateNewValues updates the fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Payment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PaymentUpsertBulk) UpdateNewValues() *PaymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Payment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PaymentUpsertBulk) Ignore() *PaymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentUpsertBulk) DoNothing() *PaymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentCreateBulk.OnConflict
+// documentation for more info.
+func (u *PaymentUpsertBulk) Update(set func(*PaymentUpsert)) *PaymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *PaymentUpsertBulk) SetCreatedAt(v time.Time) *PaymentUpsertBulk {
+	return u.Update(func(s *PaymentUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *PaymentUpsertBulk) UpdateCreatedAt() *PaymentUpsertBulk {
+	return u.Update(func(s *PaymentUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *PaymentUpsertBulk) SetUpdatedAt(v time.Time) *PaymentUpsertBulk {
+	return u.Update(func(s *PaymentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *PaymentUpsertBulk) UpdateUpdatedAt() *PaymentUpsertBulk {
+	return u.Update(func(s *PaymentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *PaymentUpsertBulk) SetProvider(v string) *PaymentUpsertBulk {
+	return u.Update(func(s *PaymentUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *PaymentUpsertBulk) UpdateProvider() *PaymentUpsertBulk {
+	return u.Update(func(s *PaymentUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetProviderPaymentId sets the "providerPaymentId" field.
+func (u *PaymentUpsertBulk) SetProviderPaymentId(v string) *PaymentUpsertBulk {
+	return u.Update(func(s *PaymentUpsert) {
+		s.SetProviderPaymentId(v)
+	})
+}
+
+// UpdateProviderPaymentId sets the "providerPaymentId" field to the value that was provided on create.
+func (u *PaymentUpsertBulk) UpdateProviderPaymentId() *PaymentUpsertBulk {
+	return u.Update(func(s *PaymentUpsert) {
+		s.UpdateProviderPaymentId()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PaymentUpsertBulk) SetStatus(v string) *PaymentUpsertBulk {
+	return u.Update(func(s *PaymentUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PaymentUpsertBulk) UpdateStatus() *PaymentUpsertBulk {
+	return u.Update(func(s *PaymentUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *PaymentUpsertBulk) SetAmount(v int) *PaymentUpsertBulk {
+	return u.Update(func(s *PaymentUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *PaymentUpsertBulk) UpdateAmount() *PaymentUpsertBulk {
+	return u.Update(func(s *PaymentUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetAmountRefunded sets the "amountRefunded" field.
+func (u *PaymentUpsertBulk) SetAmountRefunded(v int) *PaymentUpsertBulk {
+	return u.Update(func(s *PaymentUpsert) {
+		s.SetAmountRefunded(v)
+	})
+}
+
+// UpdateAmountRefunded sets the "amountRefunded" field to the value that was provided on create.
+func (u *PaymentUpsertBulk) UpdateAmountRefunded() *PaymentUpsertBulk {
+	return u.Update(func(s *PaymentUpsert) {
+		s.UpdateAmountRefunded()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *PaymentUpsertBulk) SetCurrency(v string) *PaymentUpsertBulk {
+	return u.Update(func(s *PaymentUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *PaymentUpsertBulk) UpdateCurrency() *PaymentUpsertBulk {
+	return u.Update(func(s *PaymentUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetClientSecret sets the "clientSecret" field.
+func (u *PaymentUpsertBulk) SetClientSecret(v string) *PaymentUpsertBulk {
+	return u.Update(func(s *PaymentUpsert) {
+		s.SetClientSecret(v)
+	})
+}
+
+// UpdateClientSecret sets the "clientSecret" field to the value that was provided on create.
+func (u *PaymentUpsertBulk) UpdateClientSecret() *PaymentUpsertBulk {
+	return u.Update(func(s *PaymentUpsert) {
+		s.UpdateClientSecret()
+	})
+}
+
+// ClearClientSecret clears the value of the "clientSecret" field.
+func (u *PaymentUpsertBulk) ClearClientSecret() *PaymentUpsertBulk {
+	return u.Update(func(s *PaymentUpsert) {
+		s.ClearClientSecret()
+	})
+}
+
+// SetFailureReason sets the "failureReason" field.
+func (u *PaymentUpsertBulk) SetFailureReason(v string) *PaymentUpsertBulk {
+	return u.Update(func(s *PaymentUpsert) {
+		s.SetFailureReason(v)
+	})
+}
+
+// UpdateFailureReason sets the "failureReason" field to the value that was provided on create.
+func (u *PaymentUpsertBulk) UpdateFailureReason() *PaymentUpsertBulk {
+	return u.Update(func(s *PaymentUpsert) {
+		s.UpdateFailureReason()
+	})
+}
+
+// ClearFailureReason clears the value of the "failureReason" field.
+func (u *PaymentUpsertBulk) ClearFailureReason() *PaymentUpsertBulk {
+	return u.Update(func(s *PaymentUpsert) {
+		s.ClearFailureReason()
+	})
+}
+
+// SetBookingId sets the "bookingId" field.
+func (u *PaymentUpsertBulk) SetBookingId(v int) *PaymentUpsertBulk {
+	return u.Update(func(s *PaymentUpsert) {
+		s.SetBookingId(v)
+	})
+}
+
+// UpdateBookingId sets the "bookingId" field to the value that was provided on create.
+func (u *PaymentUpsertBulk) UpdateBookingId() *PaymentUpsertBulk {
+	return u.Update(func(s *PaymentUpsert) {
+		s.UpdateBookingId()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PaymentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PaymentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,602 @@
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"entgo.io/ent/privacy"
+	"github.com/openmesh/booking"
+	entbooking "github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/payment"
+)
+
+type paymentService struct {
+	client   *Client
+	provider booking.PaymentProvider
+}
+
+// NewPaymentService constructs a new instance of a booking.PaymentService using
+// ent as its persistence layer. Payments are captured and refunded through
+// provider, which may be nil if payments are not taken.
+func NewPaymentService(client *Client, provider booking.PaymentProvider) *paymentService {
+	return &paymentService{client, provider}
+}
+
+// FindPayments retrieves a list of payments, most recent first.
+func (s *paymentService) FindPayments(
+	ctx context.Context,
+	req booking.FindPaymentsRequest,
+) booking.FindPaymentsResponse {
+	q := s.client.Payment.Query()
+	if req.BookingID != nil {
+		q.Where(payment.BookingId(*req.BookingID))
+	}
+	if req.Status != nil {
+		q.Where(payment.Status(*req.Status))
+	}
+
+	c, err := q.Clone().Count(ctx)
+	if err != nil {
+		return booking.FindPaymentsResponse{
+			Err: fmt.Errorf("failed to count payments: %w", err),
+		}
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = 10
+	}
+	p, err := q.
+		Order(Desc(payment.FieldCreatedAt), Desc(payment.FieldID)).
+		Offset(req.Offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return booking.FindPaymentsResponse{
+			Err: fmt.Errorf("failed to query payments: %w", err),
+		}
+	}
+
+	return booking.FindPaymentsResponse{
+		Payments:   Payments(p).toModels(),
+		TotalItems: c,
+	}
+}
+
+// CapturePayment captures an authorized payment and confirms its booking if
+// the booking is waiting for it.
+func (s *paymentService) CapturePayment(
+	ctx context.Context,
+	req booking.CapturePaymentRequest,
+) booking.CapturePaymentResponse {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return booking.CapturePaymentResponse{
+			Err: fmt.Errorf("failed to start transaction: %w", err),
+		}
+	}
+
+	p, err := findPaymentByID(ctx, tx, req.ID)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.CapturePaymentResponse{Err: err}
+	}
+	if p.Status != booking.PaymentStatusAuthorized {
+		_ = tx.Rollback()
+		return booking.CapturePaymentResponse{
+			Err: booking.Errorf(
+				booking.EINVALIDTRANSITION,
+				"Cannot capture payment with status '%s'",
+				p.Status,
+			),
+		}
+	}
+	err = s.checkProvider(p)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.CapturePaymentResponse{Err: err}
+	}
+	amount := req.Amount
+	if amount == 0 {
+		amount = p.Amount
+	}
+	if amount > p.Amount {
+		_ = tx.Rollback()
+		return booking.CapturePaymentResponse{
+			Err: booking.ValidationErrorf("", booking.ValidationError{
+				Name:   "amount",
+				Reason: fmt.Sprintf("Must be at most %d", p.Amount),
+			}),
+		}
+	}
+
+	intent, err := s.provider.CapturePaymentIntent(ctx, p.ProviderPaymentId, amount)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.CapturePaymentResponse{
+			Err: paymentProviderError("capture the payment", err),
+		}
+	}
+
+	p, b, err := updatePayment(ctx, tx, p, booking.PaymentWebhookEvent{Status: intent.Status})
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.CapturePaymentResponse{Err: err}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return booking.CapturePaymentResponse{
+			Err: fmt.Errorf("failed to commit transaction: %w", err),
+		}
+	}
+
+	res := booking.CapturePaymentResponse{Payment: p.toModel()}
+	if b != nil {
+		res.Booking = b.toModel()
+		res.PreviousBookingStatus = booking.BookingStatusPending
+	}
+	return res
+}
+
+// RefundPayment refunds part or all of a paid payment through its provider.
+func (s *paymentService) RefundPayment(
+	ctx context.Context,
+	req booking.RefundPaymentRequest,
+) booking.RefundPaymentResponse {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return booking.RefundPaymentResponse{
+			Err: fmt.Errorf("failed to start transaction: %w", err),
+		}
+	}
+
+	p, err := findPaymentByID(ctx, tx, req.ID)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.RefundPaymentResponse{Err: err}
+	}
+	remaining := p.Amount - p.AmountRefunded
+	if !booking.PaymentPaid(p.Status) || remaining <= 0 {
+		_ = tx.Rollback()
+		return booking.RefundPaymentResponse{
+			Err: booking.Errorf(
+				booking.EINVALIDTRANSITION,
+				"Cannot refund payment with status '%s'",
+				p.Status,
+			),
+		}
+	}
+	err = s.checkProvider(p)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.RefundPaymentResponse{Err: err}
+	}
+	amount := req.Amount
+	if amount == 0 {
+		amount = remaining
+	}
+	if amount > remaining {
+		_ = tx.Rollback()
+		return booking.RefundPaymentResponse{
+			Err: booking.ValidationErrorf("", booking.ValidationError{
+				Name:   "amount",
+				Reason: fmt.Sprintf("Must be at most %d", remaining),
+			}),
+		}
+	}
+
+	// The key only changes once a refund has been recorded so that retrying a
+	// request whose transaction failed doesn't refund the payment twice.
+	previous := p.Status
+	key := fmt.Sprintf("payment-%d-refund-%d", p.ID, p.AmountRefunded)
+	p, err = refundPayment(ctx, tx, s.provider, p, amount, key)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.RefundPaymentResponse{Err: err}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return booking.RefundPaymentResponse{
+			Err: fmt.Errorf("failed to commit transaction: %w", err),
+		}
+	}
+	return booking.RefundPaymentResponse{
+		Payment:        p.toModel(),
+		PreviousStatus: previous,
+	}
+}
+
+// HandlePaymentWebhook applies a notification from the payment provider.
+// Notifications are not sent on behalf of an organization so privacy rules are
+// bypassed once the notification has been verified.
+func (s *paymentService) HandlePaymentWebhook(
+	ctx context.Context,
+	req booking.HandlePaymentWebhookRequest,
+) booking.HandlePaymentWebhookResponse {
+	if s.provider == nil || s.provider.Name() != req.Provider {
+		return booking.HandlePaymentWebhookResponse{
+			Err: booking.Errorf(booking.ENOTFOUND, "Payment provider '%s' is not configured", req.Provider),
+		}
+	}
+	ev, err := s.provider.VerifyPaymentWebhook(req.Payload, req.Headers)
+	if err != nil {
+		return booking.HandlePaymentWebhookResponse{Err: err}
+	}
+	if ev == nil {
+		return booking.HandlePaymentWebhookResponse{}
+	}
+
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return booking.HandlePaymentWebhookResponse{
+			Err: fmt.Errorf("failed to start transaction: %w", err),
+		}
+	}
+
+	p, err := tx.Payment.
+		Query().
+		Where(
+			payment.Provider(req.Provider),
+			payment.ProviderPaymentId(ev.ProviderPaymentID),
+		).
+		ForUpdate().
+		Only(ctx)
+	var nfe *NotFoundError
+	if errors.As(err, &nfe) {
+		_ = tx.Rollback()
+		return booking.HandlePaymentWebhookResponse{}
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.HandlePaymentWebhookResponse{
+			Err: fmt.Errorf("failed to find payment: %w", err),
+		}
+	}
+
+	previous := p.Status
+	p, b, err := updatePayment(ctx, tx, p, *ev)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.HandlePaymentWebhookResponse{Err: err}
+	}
+	previousBooking := ""
+	if b != nil {
+		previousBooking = booking.BookingStatusPending
+	} else {
+		// The booking is returned either way so that events can be published
+		// to its organization.
+		b, err = p.QueryBooking().WithResource().Only(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return booking.HandlePaymentWebhookResponse{
+				Err: fmt.Errorf("failed to query booking: %w", err),
+			}
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return booking.HandlePaymentWebhookResponse{
+			Err: fmt.Errorf("failed to commit transaction: %w", err),
+		}
+	}
+
+	return booking.HandlePaymentWebhookResponse{
+		Payment:               p.toModel(),
+		PreviousStatus:        previous,
+		Booking:               b.toModel(),
+		PreviousBookingStatus: previousBooking,
+	}
+}
+
+// checkProvider returns an error if p was not made with the configured
+// provider.
+func (s *paymentService) checkProvider(p *Payment) error {
+	return checkPaymentProvider(s.provider, p)
+}
+
+// checkPaymentProvider returns an error if p was not made with provider.
+func checkPaymentProvider(provider booking.PaymentProvider, p *Payment) error {
+	if provider == nil || provider.Name() != p.Provider {
+		return booking.Errorf(
+			booking.EPAYMENTPROVIDER,
+			"Payment provider '%s' of payment with ID %d is not configured",
+			p.Provider,
+			p.ID,
+		)
+	}
+	return nil
+}
+
+// findPaymentByID returns the payment with the given ID, locked for update.
+func findPaymentByID(ctx context.Context, tx *Tx, id int) (*Payment, error) {
+	p, err := tx.Payment.
+		Query().
+		Where(payment.ID(id)).
+		ForUpdate().
+		Only(ctx)
+	var nfe *NotFoundError
+	if errors.As(err, &nfe) {
+		return nil, booking.Errorf(booking.EPAYMENTNOTFOUND, "Could not find payment with ID %d", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find payment: %w", err)
+	}
+	return p, nil
+}
+
+// updatePayment applies a change reported by the provider to payment p. A
+// payment that has been paid never goes back to being unpaid, nor to being
+// less refunded, as notifications may arrive out of order. If the payment has
+// just been paid the booking is invoiced, and if the booking is waiting for it
+// the booking is confirmed and returned along with its resource and metadata.
+// Payments that are already fully refunded by the time they are heard to be
+// paid confirm nothing. Refunds are credited to the invoice of the booking.
+func updatePayment(
+	ctx context.Context,
+	tx *Tx,
+	p *Payment,
+	ev booking.PaymentWebhookEvent,
+) (*Payment, *Booking, error) {
+	paid := booking.PaymentPaid(p.Status)
+	if paid && (!booking.PaymentPaid(ev.Status) || refundProgress(ev.Status) < refundProgress(p.Status)) {
+		return p, nil, nil
+	}
+
+	// Refunds made through the provider's dashboard are only heard about
+	// here so they are credited as well.
+	refunded := ev.AmountRefunded - p.AmountRefunded
+	q := p.Update().SetStatus(ev.Status)
+	if refunded > 0 {
+		q.SetAmountRefunded(ev.AmountRefunded)
+	}
+	if ev.Status == booking.PaymentStatusFailed {
+		q.SetFailureReason(ev.FailureReason)
+	}
+	p, err := q.Save(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to update payment: %w", err)
+	}
+
+	// The booking is invoiced before any refund reported along with the
+	// payment is credited so that there is an invoice to credit.
+	var b *Booking
+	if !paid && booking.PaymentPaid(p.Status) && p.Status != booking.PaymentStatusRefunded {
+		if err := invoicePaidBooking(ctx, tx, p.BookingId); err != nil {
+			return nil, nil, err
+		}
+		b, err = confirmPaidBooking(ctx, tx, p.BookingId)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if refunded > 0 {
+		if _, err := issueCreditNote(ctx, tx, p.BookingId, refunded); err != nil {
+			return nil, nil, err
+		}
+	}
+	return p, b, nil
+}
+
+// refundProgress orders the statuses of paid payments by how much of the
+// payment has been refunded.
+func refundProgress(status string) int {
+	switch status {
+	case booking.PaymentStatusPartiallyRefunded:
+		return 1
+	case booking.PaymentStatusRefunded:
+		return 2
+	}
+	return 0
+}
+
+// confirmPaidBooking confirms the booking with the given ID if it is pending,
+// so that it no longer expires. Returns nil if the booking was not pending, e.g. because it was cancelled
+// before it was paid for.
+func confirmPaidBooking(ctx context.Context, tx *Tx, id int) (*Booking, error) {
+	n, err := tx.Booking.
+		Update().
+		Where(
+			entbooking.ID(id),
+			entbooking.Status(booking.BookingStatusPending),
+		).
+		SetStatus(booking.BookingStatusConfirmed).
+		ClearExpiresAt().
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to confirm booking: %w", err)
+	}
+	if n == 0 {
+		return nil, nil
+	}
+
+	b, err := tx.Booking.
+		Query().
+		Where(entbooking.ID(id)).
+		WithResource().
+		WithMetadata().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query confirmed booking: %w", err)
+	}
+	return b, nil
+}
+
+// refundPayment refunds amount of payment p through provider, records the
+// refund and issues a credit note for it. The provider moves the money before
+// tx is committed, so callers pass an idempotency key that stays the same when
+// the refund is retried after tx fails.
+func refundPayment(
+	ctx context.Context,
+	tx *Tx,
+	provider booking.PaymentProvider,
+	p *Payment,
+	amount int,
+	idempotencyKey string,
+) (*Payment, error) {
+	r, err := provider.RefundPayment(ctx, p.ProviderPaymentId, amount, idempotencyKey)
+	if err != nil {
+		return nil, paymentProviderError("refund the payment", err)
+	}
+
+	refunded := p.AmountRefunded + r.Amount
+	status := booking.PaymentStatusPartiallyRefunded
+	if refunded >= p.Amount {
+		status = booking.PaymentStatusRefunded
+	}
+	p, err = p.Update().
+		SetAmountRefunded(refunded).
+		SetStatus(status).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update payment: %w", err)
+	}
+	if _, err := issueCreditNote(ctx, tx, p.BookingId, r.Amount); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// depositRequired returns true if bookings of resource r can only be
+// confirmed once a deposit has been paid.
+func depositRequired(provider booking.PaymentProvider, r *Resource) bool {
+	return provider != nil && r.BookingPrice > 0
+}
+
+// requestDeposit creates the deposit payment of pending booking b of resource
+// r. Providers may report that the payment succeeded straight away, in which
+// case the booking is invoiced and confirmed. Returns the booking with its
+// loaded edges.
+func requestDeposit(
+	ctx context.Context,
+	tx *Tx,
+	provider booking.PaymentProvider,
+	b *Booking,
+	r *Resource,
+) (*Booking, *Payment, error) {
+	intent, err := provider.CreatePaymentIntent(ctx, booking.PaymentIntentParams{
+		Amount:      r.BookingPrice,
+		Currency:    r.Currency,
+		Description: fmt.Sprintf("Deposit for %s", r.Name),
+		Metadata: map[string]string{
+			"bookingId":  strconv.Itoa(b.ID),
+			"resourceId": strconv.Itoa(r.ID),
+		},
+		// The booking ID is only used once so retries of the same request
+		// can't create a second deposit.
+		IdempotencyKey: fmt.Sprintf("booking-%d-deposit", b.ID),
+	})
+	if err != nil {
+		return nil, nil, paymentProviderError("create the deposit", err)
+	}
+
+	p, err := tx.Payment.
+		Create().
+		SetBookingId(b.ID).
+		SetProvider(provider.Name()).
+		SetProviderPaymentId(intent.ID).
+		SetStatus(intent.Status).
+		SetAmount(intent.Amount).
+		SetCurrency(r.Currency).
+		SetClientSecret(intent.ClientSecret).
+		Save(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create payment: %w", err)
+	}
+
+	if booking.PaymentPaid(p.Status) {
+		if err := invoicePaidBooking(ctx, tx, b.ID); err != nil {
+			return nil, nil, err
+		}
+	}
+	if booking.PaymentPaid(p.Status) && b.Status == booking.BookingStatusPending {
+		edges := b.Edges
+		b, err = b.Update().
+			SetStatus(booking.BookingStatusConfirmed).
+			ClearExpiresAt().
+			Save(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to confirm booking: %w", err)
+		}
+		b.Edges = edges
+	}
+	return b, p, nil
+}
+
+// depositUnpaid returns true if the booking with the given ID has a deposit
+// that has not been paid. Bookings without any payments are unpaid if required
+// is true, i.e. their resource needs a deposit. Cancelled payments don't count
+// so that a deposit can be waived by cancelling it.
+func depositUnpaid(ctx context.Context, tx *Tx, id int, required bool) (bool, error) {
+	statuses, err := tx.Payment.
+		Query().
+		Where(payment.BookingId(id)).
+		Select(payment.FieldStatus).
+		Strings(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to query payments: %w", err)
+	}
+	if len(statuses) == 0 {
+		return required, nil
+	}
+	unpaid := false
+	for _, s := range statuses {
+		if booking.PaymentPaid(s) {
+			return false, nil
+		}
+		if s != booking.PaymentStatusCancelled {
+			unpaid = true
+		}
+	}
+	return unpaid, nil
+}
+
+// paymentProviderError wraps an error returned by a payment provider while
+// trying to carry out action. Application errors are returned as they are.
+func paymentProviderError(action string, err error) error {
+	var e *booking.Error
+	if errors.As(err, &e) {
+		return err
+	}
+	return booking.Errorf(booking.EPAYMENTPROVIDER, "The payment provider failed to %s: %v", action, err)
+}
+
+func (p *Payment) toModel() *booking.Payment {
+	return &booking.Payment{
+		ID:                p.ID,
+		BookingID:         p.BookingId,
+		Provider:          p.Provider,
+		ProviderPaymentID: p.ProviderPaymentId,
+		Amount:            p.Amount,
+		AmountRefunded:    p.AmountRefunded,
+		Currency:          p.Currency,
+		Status:            p.Status,
+		FailureReason:     p.FailureReason,
+		CreatedAt:         p.CreatedAt,
+		UpdatedAt:         p.UpdatedAt,
+	}
+}
+
+// depositModel returns the model of a deposit that was just created, including
+// the client secret that the customer needs to pay it. Returns nil if p is nil.
+func depositModel(p *Payment) *booking.Payment {
+	if p == nil {
+		return nil
+	}
+	m := p.toModel()
+	m.ClientSecret = p.ClientSecret
+	return m
+}
+
+func (p Payments) toModels() []*booking.Payment {
+	var payments []*booking.Payment
+	for _, v := range p {
+		payments = append(payments, v.toModel())
+	}
+	return payments
+}
@@ -0,0 +1,508 @@
+package ent_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/openmesh/booking"
+	"github.com/openmesh/booking/ent"
+	"github.com/openmesh/booking/inmem"
+)
+
+const testPaymentWebhookSecret = "whsec_test"
+
+// paymentTest holds the services used to take the deposit of a booking.
+type paymentTest struct {
+	client   *ent.Client
+	ctx      context.Context
+	provider *inmem.PaymentProvider
+	bookings booking.BookingService
+	payments booking.PaymentService
+	resource *booking.Resource
+}
+
+// newPaymentTest sets up a resource with a deposit of 200 that is paid
+// through provider, or through an inmem.PaymentProvider if nil.
+func newPaymentTest(t *testing.T, provider booking.PaymentProvider) *paymentTest {
+	t.Helper()
+	client := newTestClient(t)
+	ctx := newTestOrganization(t, client)
+	var fake *inmem.PaymentProvider
+	switch p := provider.(type) {
+	case nil:
+		fake = inmem.NewPaymentProvider(testPaymentWebhookSecret)
+		provider = fake
+	case *inmem.PaymentProvider:
+		fake = p
+	case manualCaptureProvider:
+		fake = p.PaymentProvider
+	case *lostRefundProvider:
+		fake = p.PaymentProvider
+	}
+	return &paymentTest{
+		client:   client,
+		ctx:      ctx,
+		provider: fake,
+		bookings: ent.NewBookingService(client, provider),
+		payments: ent.NewPaymentService(client, provider),
+		resource: newTestResource(t, ctx, client, func(req *booking.CreateResourceRequest) {
+			req.Price = booking.NewMoney(1000, "USD")
+			req.BookingPrice = booking.NewMoney(200, "USD")
+		}),
+	}
+}
+
+// createBooking makes a booking of the resource, which waits for its
+// deposit.
+func (pt *paymentTest) createBooking(t *testing.T) booking.CreateBookingResponse {
+	t.Helper()
+	st := testBookingTime(10)
+	res := pt.bookings.CreateBooking(pt.ctx, booking.CreateBookingRequest{
+		ResourceID: pt.resource.ID,
+		Status:     booking.BookingStatusConfirmed,
+		StartTime:  st,
+		EndTime:    st.Add(time.Hour),
+	})
+	if res.Err != nil {
+		t.Fatalf("failed to create booking: %v", res.Err)
+	}
+	return res
+}
+
+// notify sends a notification from the provider to the webhook endpoint.
+// Notifications aren't sent on behalf of an organization.
+func (pt *paymentTest) notify(t *testing.T, payload []byte, headers map[string][]string, err error) booking.HandlePaymentWebhookResponse {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("failed to create notification: %v", err)
+	}
+	res := pt.payments.HandlePaymentWebhook(context.Background(), booking.HandlePaymentWebhookRequest{
+		Provider: inmem.PaymentProviderName,
+		Payload:  payload,
+		Headers:  headers,
+	})
+	if res.Err != nil {
+		t.Fatalf("failed to handle notification: %v", res.Err)
+	}
+	return res
+}
+
+// assertStatuses checks the status of a booking and of its deposit.
+func (pt *paymentTest) assertStatuses(t *testing.T, bookingID int, bookingStatus, paymentStatus string) {
+	t.Helper()
+	res := pt.payments.FindPayments(pt.ctx, booking.FindPaymentsRequest{BookingID: &bookingID})
+	if res.Err != nil {
+		t.Fatalf("failed to find payments: %v", res.Err)
+	}
+	if len(res.Payments) != 1 {
+		t.Fatalf("booking has %d payments, want 1", len(res.Payments))
+	}
+	if got := res.Payments[0].Status; got != paymentStatus {
+		t.Errorf("payment is %s, want %s", got, paymentStatus)
+	}
+	b, err := pt.client.Booking.Get(pt.ctx, bookingID)
+	if err != nil {
+		t.Fatalf("failed to get booking: %v", err)
+	}
+	if b.Status != bookingStatus {
+		t.Errorf("booking is %s, want %s", b.Status, bookingStatus)
+	}
+}
+
+func TestBookingService_CreateBooking_Deposit(t *testing.T) {
+	pt := newPaymentTest(t, nil)
+	res := pt.createBooking(t)
+
+	if res.Booking.Status != booking.BookingStatusPending {
+		t.Errorf("booking is %s, want %s", res.Booking.Status, booking.BookingStatusPending)
+	}
+	if res.Payment == nil {
+		t.Fatal("no deposit was requested")
+	}
+	if res.Payment.Amount != 200 || res.Payment.Currency != "USD" {
+		t.Errorf("deposit is %d %s, want 200 USD", res.Payment.Amount, res.Payment.Currency)
+	}
+	if res.Payment.ClientSecret == "" {
+		t.Error("deposit has no client secret")
+	}
+	pt.assertStatuses(t, res.Booking.ID, booking.BookingStatusPending, booking.PaymentStatusPending)
+}
+
+func TestBookingService_CreateBooking_DepositPaidImmediately(t *testing.T) {
+	provider := inmem.NewPaymentProvider(testPaymentWebhookSecret)
+	provider.AutoSucceed = true
+	pt := newPaymentTest(t, provider)
+	res := pt.createBooking(t)
+
+	if res.Booking.Status != booking.BookingStatusConfirmed {
+		t.Errorf("booking is %s, want %s", res.Booking.Status, booking.BookingStatusConfirmed)
+	}
+	pt.assertStatuses(t, res.Booking.ID, booking.BookingStatusConfirmed, booking.PaymentStatusSucceeded)
+}
+
+func TestPaymentService_HandlePaymentWebhook_Paid(t *testing.T) {
+	pt := newPaymentTest(t, nil)
+	created := pt.createBooking(t)
+
+	payload, headers, err := pt.provider.Pay(created.Payment.ProviderPaymentID)
+	res := pt.notify(t, payload, headers, err)
+	if res.PreviousBookingStatus != booking.BookingStatusPending {
+		t.Errorf("previous booking status is %q, want %s", res.PreviousBookingStatus, booking.BookingStatusPending)
+	}
+	pt.assertStatuses(t, created.Booking.ID, booking.BookingStatusConfirmed, booking.PaymentStatusSucceeded)
+
+	// Providers may send the same notification more than once.
+	res = pt.notify(t, payload, headers, nil)
+	if res.PreviousBookingStatus != "" {
+		t.Errorf("repeated notification changed the booking from %s", res.PreviousBookingStatus)
+	}
+	pt.assertStatuses(t, created.Booking.ID, booking.BookingStatusConfirmed, booking.PaymentStatusSucceeded)
+}
+
+func TestPaymentService_HandlePaymentWebhook_Declined(t *testing.T) {
+	pt := newPaymentTest(t, nil)
+	created := pt.createBooking(t)
+	id := created.Payment.ProviderPaymentID
+
+	payload, headers, err := pt.provider.Decline(id, "card_declined")
+	res := pt.notify(t, payload, headers, err)
+	if res.PreviousBookingStatus != "" {
+		t.Errorf("failed deposit changed the booking from %s", res.PreviousBookingStatus)
+	}
+	if res.Payment.FailureReason != "card_declined" {
+		t.Errorf("failure reason is %q, want card_declined", res.Payment.FailureReason)
+	}
+	pt.assertStatuses(t, created.Booking.ID, booking.BookingStatusPending, booking.PaymentStatusFailed)
+
+	// The customer can try again with another payment method.
+	payload, headers, err = pt.provider.Pay(id)
+	pt.notify(t, payload, headers, err)
+	pt.assertStatuses(t, created.Booking.ID, booking.BookingStatusConfirmed, booking.PaymentStatusSucceeded)
+}
+
+func TestPaymentService_HandlePaymentWebhook_Refunded(t *testing.T) {
+	pt := newPaymentTest(t, nil)
+	created := pt.createBooking(t)
+	id := created.Payment.ProviderPaymentID
+
+	// The deposit is paid and refunded through the provider's dashboard, and
+	// the notification of the refund arrives before the one of the payment.
+	paid, paidHeaders, err := pt.provider.Pay(id)
+	if err != nil {
+		t.Fatalf("failed to pay: %v", err)
+	}
+	payload, headers, err := pt.provider.Refund(id, 200)
+	res := pt.notify(t, payload, headers, err)
+	if res.PreviousBookingStatus != "" {
+		t.Errorf("refunded deposit changed the booking from %s", res.PreviousBookingStatus)
+	}
+	pt.assertStatuses(t, created.Booking.ID, booking.BookingStatusPending, booking.PaymentStatusRefunded)
+
+	res = pt.notify(t, paid, paidHeaders, nil)
+	if res.PreviousBookingStatus != "" {
+		t.Errorf("late notification changed the booking from %s", res.PreviousBookingStatus)
+	}
+	pt.assertStatuses(t, created.Booking.ID, booking.BookingStatusPending, booking.PaymentStatusRefunded)
+}
+
+func TestPaymentService_HandlePaymentWebhook_InvalidSignature(t *testing.T) {
+	pt := newPaymentTest(t, nil)
+	created := pt.createBooking(t)
+
+	payload, _, err := pt.provider.Pay(created.Payment.ProviderPaymentID)
+	if err != nil {
+		t.Fatalf("failed to pay: %v", err)
+	}
+	res := pt.payments.HandlePaymentWebhook(context.Background(), booking.HandlePaymentWebhookRequest{
+		Provider: inmem.PaymentProviderName,
+		Payload:  payload,
+		Headers:  map[string][]string{inmem.PaymentSignatureHeader: {"forged"}},
+	})
+	if code := booking.ErrorCode(res.Err); code != booking.EUNAUTHORIZED {
+		t.Errorf("returned %q, want %s", code, booking.EUNAUTHORIZED)
+	}
+	pt.assertStatuses(t, created.Booking.ID, booking.BookingStatusPending, booking.PaymentStatusPending)
+}
+
+func TestPaymentService_CapturePayment(t *testing.T) {
+	pt := newPaymentTest(t, manualCaptureProvider{inmem.NewPaymentProvider(testPaymentWebhookSecret)})
+	created := pt.createBooking(t)
+
+	// Authorized deposits don't confirm the booking until they are captured.
+	payload, headers, err := pt.provider.Pay(created.Payment.ProviderPaymentID)
+	res := pt.notify(t, payload, headers, err)
+	if res.PreviousBookingStatus != "" {
+		t.Errorf("authorized deposit changed the booking from %s", res.PreviousBookingStatus)
+	}
+	pt.assertStatuses(t, created.Booking.ID, booking.BookingStatusPending, booking.PaymentStatusAuthorized)
+
+	captured := pt.payments.CapturePayment(pt.ctx, booking.CapturePaymentRequest{ID: created.Payment.ID})
+	if captured.Err != nil {
+		t.Fatalf("failed to capture payment: %v", captured.Err)
+	}
+	if captured.Booking == nil || captured.Booking.Status != booking.BookingStatusConfirmed {
+		t.Errorf("capture returned booking %+v, want it confirmed", captured.Booking)
+	}
+	pt.assertStatuses(t, created.Booking.ID, booking.BookingStatusConfirmed, booking.PaymentStatusSucceeded)
+
+	captured = pt.payments.CapturePayment(pt.ctx, booking.CapturePaymentRequest{ID: created.Payment.ID})
+	if code := booking.ErrorCode(captured.Err); code != booking.EINVALIDTRANSITION {
+		t.Errorf("second capture returned %q, want %s", code, booking.EINVALIDTRANSITION)
+	}
+}
+
+// manualCaptureProvider only authorizes payments so that they have to be
+// captured.
+type manualCaptureProvider struct {
+	*inmem.PaymentProvider
+}
+
+func (p manualCaptureProvider) CreatePaymentIntent(ctx context.Context, params booking.PaymentIntentParams) (*booking.PaymentIntent, error) {
+	params.CaptureManually = true
+	return p.PaymentProvider.CreatePaymentIntent(ctx, params)
+}
+
+func TestBookingService_CreateBookingSeries_Deposit(t *testing.T) {
+	pt := newPaymentTest(t, nil)
+	st := testBookingTime(10)
+	res := pt.bookings.CreateBookingSeries(pt.ctx, booking.CreateBookingSeriesRequest{
+		ResourceID: pt.resource.ID,
+		Status:     booking.BookingStatusConfirmed,
+		StartTime:  st,
+		EndTime:    st.Add(time.Hour),
+		RRule:      "FREQ=DAILY;COUNT=3",
+	})
+	if res.Err != nil {
+		t.Fatalf("failed to create booking series: %v", res.Err)
+	}
+	if len(res.Bookings) != 3 {
+		t.Fatalf("series has %d occurrences, want 3", len(res.Bookings))
+	}
+	// Every occurrence waits for a deposit of its own.
+	for _, b := range res.Bookings {
+		pt.assertStatuses(t, b.ID, booking.BookingStatusPending, booking.PaymentStatusPending)
+	}
+
+	// Paying the deposit of one occurrence doesn't confirm the others.
+	first := res.Bookings[0]
+	payments := pt.payments.FindPayments(pt.ctx, booking.FindPaymentsRequest{BookingID: &first.ID})
+	if payments.Err != nil || len(payments.Payments) != 1 {
+		t.Fatalf("failed to find deposit: %v", payments.Err)
+	}
+	payload, headers, err := pt.provider.Pay(payments.Payments[0].ProviderPaymentID)
+	pt.notify(t, payload, headers, err)
+	pt.assertStatuses(t, first.ID, booking.BookingStatusConfirmed, booking.PaymentStatusSucceeded)
+
+	updated := pt.bookings.UpdateBooking(pt.ctx, booking.UpdateBookingRequest{
+		ID:          first.ID,
+		ResourceID:  first.ResourceID,
+		Status:      booking.BookingStatusConfirmed,
+		StartTime:   first.StartTime,
+		EndTime:     first.EndTime,
+		SeriesScope: booking.SeriesScopeSeries,
+	})
+	if code := booking.ErrorCode(updated.Err); code != booking.EPAYMENTREQUIRED {
+		t.Errorf("confirming the series returned %q, want %s", code, booking.EPAYMENTREQUIRED)
+	}
+	for _, b := range res.Bookings[1:] {
+		pt.assertStatuses(t, b.ID, booking.BookingStatusPending, booking.PaymentStatusPending)
+	}
+}
+
+func TestBookingService_ImportBookings_Deposit(t *testing.T) {
+	pt := newPaymentTest(t, nil)
+	st := testBookingTime(10)
+	res := pt.bookings.ImportBookings(pt.ctx, booking.ImportBookingsRequest{
+		Rows: []booking.CreateBookingRequest{{
+			ResourceID: pt.resource.ID,
+			Status:     booking.BookingStatusConfirmed,
+			StartTime:  st,
+			EndTime:    st.Add(time.Hour),
+		}},
+	})
+	if res.Err != nil {
+		t.Fatalf("failed to import bookings: %v", res.Err)
+	}
+	if res.Created != 1 {
+		t.Fatalf("imported %d bookings, want 1", res.Created)
+	}
+	pt.assertStatuses(t, res.Rows[0].Booking.ID, booking.BookingStatusPending, booking.PaymentStatusPending)
+}
+
+func TestBookingService_UpdateBooking_DepositRequired(t *testing.T) {
+	pt := newPaymentTest(t, nil)
+
+	// Bookings made before the organization took payments have no deposit
+	// but still need one once the resource has a booking price.
+	st := testBookingTime(10)
+	created := ent.NewBookingService(pt.client, nil).CreateBooking(pt.ctx, booking.CreateBookingRequest{
+		ResourceID: pt.resource.ID,
+		StartTime:  st,
+		EndTime:    st.Add(time.Hour),
+	})
+	if created.Err != nil {
+		t.Fatalf("failed to create booking: %v", created.Err)
+	}
+	if created.Payment != nil {
+		t.Fatal("deposit was requested without a payment provider")
+	}
+
+	res := pt.bookings.UpdateBooking(pt.ctx, booking.UpdateBookingRequest{
+		ID:         created.Booking.ID,
+		ResourceID: pt.resource.ID,
+		Status:     booking.BookingStatusConfirmed,
+		StartTime:  st,
+		EndTime:    st.Add(time.Hour),
+	})
+	if code := booking.ErrorCode(res.Err); code != booking.EPAYMENTREQUIRED {
+		t.Errorf("returned %q, want %s", code, booking.EPAYMENTREQUIRED)
+	}
+}
+
+func TestBookingService_UpdateBooking_CancellationRetried(t *testing.T) {
+	pt := newPaymentTest(t, &lostRefundProvider{PaymentProvider: inmem.NewPaymentProvider(testPaymentWebhookSecret)})
+	pt.resource = newTestResource(t, pt.ctx, pt.client, func(req *booking.CreateResourceRequest) {
+		req.Price = booking.NewMoney(1000, "USD")
+		req.BookingPrice = booking.NewMoney(200, "USD")
+		req.CancellationPolicy = &booking.CancellationPolicy{
+			Tiers: []booking.CancellationTier{{MinNotice: 0, RefundPercent: 100}},
+		}
+	})
+	created := pt.createBooking(t)
+	payload, headers, err := pt.provider.Pay(created.Payment.ProviderPaymentID)
+	pt.notify(t, payload, headers, err)
+
+	cancel := booking.UpdateBookingRequest{
+		ID:         created.Booking.ID,
+		ResourceID: pt.resource.ID,
+		Status:     booking.BookingStatusCancelled,
+		StartTime:  created.Booking.StartTime,
+		EndTime:    created.Booking.EndTime,
+	}
+	// The deposit is refunded but the provider's response is lost, so the
+	// cancellation is rolled back.
+	res := pt.bookings.UpdateBooking(pt.ctx, cancel)
+	if code := booking.ErrorCode(res.Err); code != booking.EPAYMENTPROVIDER {
+		t.Fatalf("returned %q, want %s", code, booking.EPAYMENTPROVIDER)
+	}
+	pt.assertStatuses(t, created.Booking.ID, booking.BookingStatusConfirmed, booking.PaymentStatusSucceeded)
+
+	// Retrying the cancellation records the refund without paying it twice.
+	res = pt.bookings.UpdateBooking(pt.ctx, cancel)
+	if res.Err != nil {
+		t.Fatalf("failed to cancel booking: %v", res.Err)
+	}
+	if res.Booking.RefundAmount != 200 {
+		t.Errorf("refunded %d, want 200", res.Booking.RefundAmount)
+	}
+	pt.assertStatuses(t, created.Booking.ID, booking.BookingStatusCancelled, booking.PaymentStatusRefunded)
+}
+
+// lostRefundProvider refunds payments but reports the first refund as failed,
+// as if the response of the provider had been lost.
+type lostRefundProvider struct {
+	*inmem.PaymentProvider
+	lost bool
+}
+
+func (p *lostRefundProvider) RefundPayment(ctx context.Context, id string, amount int, idempotencyKey string) (*booking.PaymentRefund, error) {
+	r, err := p.PaymentProvider.RefundPayment(ctx, id, amount, idempotencyKey)
+	if err == nil && !p.lost {
+		p.lost = true
+		return nil, errors.New("connection reset by peer")
+	}
+	return r, err
+}
+
+func TestBookingHoldService_ExpireBookingHolds_UnpaidDeposit(t *testing.T) {
+	pt := newPaymentTest(t, nil)
+	ctx := booking.NewContextWithPublic(booking.NewContextWithUser(pt.ctx, nil), true)
+	st := testBookingTime(10)
+	book := func(st time.Time) booking.CreateBookingResponse {
+		t.Helper()
+		res := pt.bookings.CreateBooking(ctx, booking.CreateBookingRequest{
+			ResourceID: pt.resource.ID,
+			StartTime:  st,
+			EndTime:    st.Add(time.Hour),
+		})
+		if res.Err != nil {
+			t.Fatalf("failed to create booking: %v", res.Err)
+		}
+		return res
+	}
+
+	// Bookings made through the public API have to be paid for in time.
+	unpaid := book(st)
+	if unpaid.Booking.ExpiresAt == nil {
+		t.Fatal("public booking waiting for its deposit doesn't expire")
+	}
+	paid := book(st.Add(2 * time.Hour))
+	payload, headers, err := pt.provider.Pay(paid.Payment.ProviderPaymentID)
+	pt.notify(t, payload, headers, err)
+
+	holds := ent.NewBookingHoldService(pt.client)
+	expired, _, err := holds.ExpireBookingHolds(context.Background(), time.Now().Add(booking.DepositPaymentDuration), 10)
+	if err != nil {
+		t.Fatalf("failed to expire holds: %v", err)
+	}
+	if len(expired) != 1 || expired[0].Booking.ID != unpaid.Booking.ID {
+		t.Fatalf("expired %+v, want booking %d", expired, unpaid.Booking.ID)
+	}
+	if expired[0].PreviousStatus != booking.BookingStatusPending {
+		t.Errorf("previous status is %s, want %s", expired[0].PreviousStatus, booking.BookingStatusPending)
+	}
+	pt.assertStatuses(t, unpaid.Booking.ID, booking.BookingStatusExpired, booking.PaymentStatusPending)
+	pt.assertStatuses(t, paid.Booking.ID, booking.BookingStatusConfirmed, booking.PaymentStatusSucceeded)
+
+	// Bookings made by members of the organization wait for their deposit
+	// for as long as it takes.
+	if res := pt.createBooking(t); res.Booking.ExpiresAt != nil {
+		t.Error("booking made by a member expires")
+	}
+}
+
+func TestReportService_GetRecentSalesReport_Deposits(t *testing.T) {
+	provider := inmem.NewPaymentProvider(testPaymentWebhookSecret)
+	provider.AutoSucceed = true
+	pt := newPaymentTest(t, provider)
+	paid := pt.createBooking(t)
+	if res := pt.payments.RefundPayment(pt.ctx, booking.RefundPaymentRequest{ID: paid.Payment.ID, Amount: 50}); res.Err != nil {
+		t.Fatalf("failed to refund deposit: %v", res.Err)
+	}
+
+	// A booking made before the organization took payments has no deposit.
+	st := testBookingTime(12)
+	unpaid := ent.NewBookingService(pt.client, nil).CreateBooking(pt.ctx, booking.CreateBookingRequest{
+		ResourceID: pt.resource.ID,
+		Status:     booking.BookingStatusConfirmed,
+		StartTime:  st,
+		EndTime:    st.Add(time.Hour),
+	})
+	if unpaid.Err != nil {
+		t.Fatalf("failed to create booking: %v", unpaid.Err)
+	}
+
+	// Deposits are what was paid, not the resource's current booking price.
+	if _, err := pt.client.Resource.UpdateOneID(pt.resource.ID).SetBookingPrice(500).Save(pt.ctx); err != nil {
+		t.Fatalf("failed to update resource: %v", err)
+	}
+
+	res := ent.NewReportService(pt.client).GetRecentSalesReport(pt.ctx, booking.GetRecentSalesReportRequest{})
+	if res.Err != nil {
+		t.Fatalf("failed to get report: %v", res.Err)
+	}
+	if got := res.TotalDeposits.Amount("USD"); got != 150 {
+		t.Errorf("total deposits are %d USD, want 150", got)
+	}
+	want := map[int]int{paid.Booking.ID: 150, unpaid.Booking.ID: 0}
+	if len(res.Sales) != len(want) {
+		t.Fatalf("%d sales, want %d", len(res.Sales), len(want))
+	}
+	for _, row := range res.Sales {
+		if got := row.BookingPrice; got.Amount != want[row.BookingID] || got.Currency != "USD" {
+			t.Errorf("deposit of booking %d is %d %s, want %d USD", row.BookingID, got.Amount, got.Currency, want[row.BookingID])
+		}
+	}
+}
@@ -0,0 +1,801 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/payment"
+	"github.com/openmesh/booking/ent/predicate"
+)
+
+// PaymentUpdate is the builder for updating Payment entities.
+type PaymentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PaymentMutation
+}
+
+// Where appends a list predicates to the PaymentUpdate builder.
+func (pu *PaymentUpdate) Where(ps ...predicate.Payment) *PaymentUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (pu *PaymentUpdate) SetUpdatedAt(t time.Time) *PaymentUpdate {
+	pu.mutation.SetUpdatedAt(t)
+	return pu
+}
+
+// SetProvider sets the "provider" field.
+func (pu *PaymentUpdate) SetProvider(s string) *PaymentUpdate {
+	pu.mutation.SetProvider(s)
+	return pu
+}
+
+// SetProviderPaymentId sets the "providerPaymentId" field.
+func (pu *PaymentUpdate) SetProviderPaymentId(s string) *PaymentUpdate {
+	pu.mutation.SetProviderPaymentId(s)
+	return pu
+}
+
+// SetStatus sets the "status" field.
+func (pu *PaymentUpdate) SetStatus(s string) *PaymentUpdate {
+	pu.mutation.SetStatus(s)
+	return pu
+}
+
+// SetAmount sets the "amount" field.
+func (pu *PaymentUpdate) SetAmount(i int) *PaymentUpdate {
+	pu.mutation.ResetAmount()
+	pu.mutation.SetAmount(i)
+	return pu
+}
+
+// AddAmount adds i to the "amount" field.
+func (pu *PaymentUpdate) AddAmount(i int) *PaymentUpdate {
+	pu.mutation.AddAmount(i)
+	return pu
+}
+
+// SetAmountRefunded sets the "amountRefunded" field.
+func (pu *PaymentUpdate) SetAmountRefunded(i int) *PaymentUpdate {
+	pu.mutation.ResetAmountRefunded()
+	pu.mutation.SetAmountRefunded(i)
+	return pu
+}
+
+// SetNillableAmountRefunded sets the "amountRefunded" field if the given value is not nil.
+func (pu *PaymentUpdate) SetNillableAmountRefunded(i *int) *PaymentUpdate {
+	if i != nil {
+		pu.SetAmountRefunded(*i)
+	}
+	return pu
+}
+
+// AddAmountRefunded adds i to the "amountRefunded" field.
+func (pu *PaymentUpdate) AddAmountRefunded(i int) *PaymentUpdate {
+	pu.mutation.AddAmountRefunded(i)
+	return pu
+}
+
+// SetCurrency sets the "currency" field.
+func (pu *PaymentUpdate) SetCurrency(s string) *PaymentUpdate {
+	pu.mutation.SetCurrency(s)
+	return pu
+}
+
+// SetClientSecret sets the "clientSecret" field.
+func (pu *PaymentUpdate) SetClientSecret(s string) *PaymentUpdate {
+	pu.mutation.SetClientSecret(s)
+	return pu
+}
+
+// SetNillableClientSecret sets the "clientSecret" field if the given value is not nil.
+func (pu *PaymentUpdate) SetNillableClientSecret(s *string) *PaymentUpdate {
+	if s != nil {
+		pu.SetClientSecret(*s)
+	}
+	return pu
+}
+
+// ClearClientSecret clears the value of the "clientSecret" field.
+func (pu *PaymentUpdate) ClearClientSecret() *PaymentUpdate {
+	pu.mutation.ClearClientSecret()
+	return pu
+}
+
+// SetFailureReason sets the "failureReason" field.
+func (pu *PaymentUpdate) SetFailureReason(s string) *PaymentUpdate {
+	pu.mutation.SetFailureReason(s)
+	return pu
+}
+
+// SetNillableFailureReason sets the "failureReason" field if the given value is not nil.
+func (pu *PaymentUpdate) SetNillableFailureReason(s *string) *PaymentUpdate {
+	if s != nil {
+		pu.SetFailureReason(*s)
+	}
+	return pu
+}
+
+// ClearFailureReason clears the value of the "failureReason" field.
+func (pu *PaymentUpdate) ClearFailureReason() *PaymentUpdate {
+	pu.mutation.ClearFailureReason()
+	return pu
+}
+
+// SetBookingId sets the "bookingId" field.
+func (pu *PaymentUpdate) SetBookingId(i int) *PaymentUpdate {
+	pu.mutation.SetBookingId(i)
+	return pu
+}
+
+// SetBookingID sets the "booking" edge to the Booking entity by ID.
+func (pu *PaymentUpdate) SetBookingID(id int) *PaymentUpdate {
+	pu.mutation.SetBookingID(id)
+	return pu
+}
+
+// SetBooking sets the "booking" edge to the Booking entity.
+func (pu *PaymentUpdate) SetBooking(b *Booking) *PaymentUpdate {
+	return pu.SetBookingID(b.ID)
+}
+
+// Mutation returns the PaymentMutation object of the builder.
+func (pu *PaymentUpdate) Mutation() *PaymentMutation {
+	return pu.mutation
+}
+
+// ClearBooking clears the "booking" edge to the Booking entity.
+func (pu *PaymentUpdate) ClearBooking() *PaymentUpdate {
+	pu.mutation.ClearBooking()
+	return pu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PaymentUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if err := pu.defaults(); err != nil {
+		return 0, err
+	}
+	if len(pu.hooks) == 0 {
+		if err = pu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = pu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PaymentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pu.check(); err != nil {
+				return 0, err
+			}
+			pu.mutation = mutation
+			affected, err = pu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(pu.hooks) - 1; i >= 0; i-- {
+			if pu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PaymentUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PaymentUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PaymentUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pu *PaymentUpdate) defaults() error {
+	if _, ok := pu.mutation.UpdatedAt(); !ok {
+		if payment.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized payment.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := payment.UpdateDefaultUpdatedAt()
+		pu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *PaymentUpdate) check() error {
+	if _, ok := pu.mutation.BookingID(); pu.mutation.BookingCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"booking\"")
+	}
+	return nil
+}
+
+func (pu *PaymentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   payment.Table,
+			Columns: payment.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: payment.FieldID,
+			},
+		},
+	}
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: payment.FieldUpdatedAt,
+		})
+	}
+	if value, ok := pu.mutation.Provider(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: payment.FieldProvider,
+		})
+	}
+	if value, ok := pu.mutation.ProviderPaymentId(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: payment.FieldProviderPaymentId,
+		})
+	}
+	if value, ok := pu.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: payment.FieldStatus,
+		})
+	}
+	if value, ok := pu.mutation.Amount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: payment.FieldAmount,
+		})
+	}
+	if value, ok := pu.mutation.AddedAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: payment.FieldAmount,
+		})
+	}
+	if value, ok := pu.mutation.AmountRefunded(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: payment.FieldAmountRefunded,
+		})
+	}
+	if value, ok := pu.mutation.AddedAmountRefunded(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: payment.FieldAmountRefunded,
+		})
+	}
+	if value, ok := pu.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: payment.FieldCurrency,
+		})
+	}
+	if value, ok := pu.mutation.ClientSecret(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: payment.FieldClientSecret,
+		})
+	}
+	if pu.mutation.ClientSecretCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: payment.FieldClientSecret,
+		})
+	}
+	if value, ok := pu.mutation.FailureReason(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: payment.FieldFailureReason,
+		})
+	}
+	if pu.mutation.FailureReasonCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: payment.FieldFailureReason,
+		})
+	}
+	if pu.mutation.BookingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payment.BookingTable,
+			Columns: []string{payment.BookingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.BookingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payment.BookingTable,
+			Columns: []string{payment.BookingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// PaymentUpdateOne is the builder for updating a single Payment entity.
+type PaymentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PaymentMutation
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (puo *PaymentUpdateOne) SetUpdatedAt(t time.Time) *PaymentUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// SetProvider sets the "provider" field.
+func (puo *PaymentUpdateOne) SetProvider(s string) *PaymentUpdateOne {
+	puo.mutation.SetProvider(s)
+	return puo
+}
+
+// SetProviderPaymentId sets the "providerPaymentId" field.
+func (puo *PaymentUpdateOne) SetProviderPaymentId(s string) *PaymentUpdateOne {
+	puo.mutation.SetProviderPaymentId(s)
+	return puo
+}
+
+// SetStatus sets the "status" field.
+func (puo *PaymentUpdateOne) SetStatus(s string) *PaymentUpdateOne {
+	puo.mutation.SetStatus(s)
+	return puo
+}
+
+// SetAmount sets the "amount" field.
+func (puo *PaymentUpdateOne) SetAmount(i int) *PaymentUpdateOne {
+	puo.mutation.ResetAmount()
+	puo.mutation.SetAmount(i)
+	return puo
+}
+
+// AddAmount adds i to the "amount" field.
+func (puo *PaymentUpdateOne) AddAmount(i int) *PaymentUpdateOne {
+	puo.mutation.AddAmount(i)
+	return puo
+}
+
+// SetAmountRefunded sets the "amountRefunded" field.
+func (puo *PaymentUpdateOne) SetAmountRefunded(i int) *PaymentUpdateOne {
+	puo.mutation.ResetAmountRefunded()
+	puo.mutation.SetAmountRefunded(i)
+	return puo
+}
+
+// SetNillableAmountRefunded sets the "amountRefunded" field if the given value is not nil.
+func (puo *PaymentUpdateOne) SetNillableAmountRefunded(i *int) *PaymentUpdateOne {
+	if i != nil {
+		puo.SetAmountRefunded(*i)
+	}
+	return puo
+}
+
+// AddAmountRefunded adds i to the "amountRefunded" field.
+func (puo *PaymentUpdateOne) AddAmountRefunded(i int) *PaymentUpdateOne {
+	puo.mutation.AddAmountRefunded(i)
+	return puo
+}
+
+// SetCurrency sets the "currency" field.
+func (puo *PaymentUpdateOne) SetCurrency(s string) *PaymentUpdateOne {
+	puo.mutation.SetCurrency(s)
+	return puo
+}
+
+// SetClientSecret sets the "clientSecret" field.
+func (puo *PaymentUpdateOne) SetClientSecret(s string) *PaymentUpdateOne {
+	puo.mutation.SetClientSecret(s)
+	return puo
+}
+
+// SetNillableClientSecret sets the "clientSecret" field if the given value is not nil.
+func (puo *PaymentUpdateOne) SetNillableClientSecret(s *string) *PaymentUpdateOne {
+	if s != nil {
+		puo.SetClientSecret(*s)
+	}
+	return puo
+}
+
+// ClearClientSecret clears the value of the "clientSecret" field.
+func (puo *PaymentUpdateOne) ClearClientSecret() *PaymentUpdateOne {
+	puo.mutation.ClearClientSecret()
+	return puo
+}
+
+// SetFailureReason sets the "failureReason" field.
+func (puo *PaymentUpdateOne) SetFailureReason(s string) *PaymentUpdateOne {
+	puo.mutation.SetFailureReason(s)
+	return puo
+}
+
+// SetNillableFailureReason sets the "failureReason" field if the given value is not nil.
+func (puo *PaymentUpdateOne) SetNillableFailureReason(s *string) *PaymentUpdateOne {
+	if s != nil {
+		puo.SetFailureReason(*s)
+	}
+	return puo
+}
+
+// ClearFailureReason clears the value of the "failureReason" field.
+func (puo *PaymentUpdateOne) ClearFailureReason() *PaymentUpdateOne {
+	puo.mutation.ClearFailureReason()
+	return puo
+}
+
+// SetBookingId sets the "bookingId" field.
+func (puo *PaymentUpdateOne) SetBookingId(i int) *PaymentUpdateOne {
+	puo.mutation.SetBookingId(i)
+	return puo
+}
+
+// SetBookingID sets the "booking" edge to the Booking entity by ID.
+func (puo *PaymentUpdateOne) SetBookingID(id int) *PaymentUpdateOne {
+	puo.mutation.SetBookingID(id)
+	return puo
+}
+
+// SetBooking sets the "booking" edge to the Booking entity.
+func (puo *PaymentUpdateOne) SetBooking(b *Booking) *PaymentUpdateOne {
+	return puo.SetBookingID(b.ID)
+}
+
+// Mutation returns the PaymentMutation object of the builder.
+func (puo *PaymentUpdateOne) Mutation() *PaymentMutation {
+	return puo.mutation
+}
+
+// ClearBooking clears the "booking" edge to the Booking entity.
+func (puo *PaymentUpdateOne) ClearBooking() *PaymentUpdateOne {
+	puo.mutation.ClearBooking()
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PaymentUpdateOne) Select(field string, fields ...string) *PaymentUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Payment entity.
+func (puo *PaymentUpdateOne) Save(ctx context.Context) (*Payment, error) {
+	var (
+		err  error
+		node *Payment
+	)
+	if err := puo.defaults(); err != nil {
+		return nil, err
+	}
+	if len(puo.hooks) == 0 {
+		if err = puo.check(); err != nil {
+			return nil, err
+		}
+		node, err = puo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PaymentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = puo.check(); err != nil {
+				return nil, err
+			}
+			puo.mutation = mutation
+			node, err = puo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(puo.hooks) - 1; i >= 0; i-- {
+			if puo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = puo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, puo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PaymentUpdateOne) SaveX(ctx context.Context) *Payment {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PaymentUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PaymentUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (puo *PaymentUpdateOne) defaults() error {
+	if _, ok := puo.mutation.UpdatedAt(); !ok {
+		if payment.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized payment.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := payment.UpdateDefaultUpdatedAt()
+		puo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *PaymentUpdateOne) check() error {
+	if _, ok := puo.mutation.BookingID(); puo.mutation.BookingCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"booking\"")
+	}
+	return nil
+}
+
+func (puo *PaymentUpdateOne) sqlSave(ctx context.Context) (_node *Payment, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   payment.Table,
+			Columns: payment.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: payment.FieldID,
+			},
+		},
+	}
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Payment.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, payment.FieldID)
+		for _, f := range fields {
+			if !payment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != payment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: payment.FieldUpdatedAt,
+		})
+	}
+	if value, ok := puo.mutation.Provider(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: payment.FieldProvider,
+		})
+	}
+	if value, ok := puo.mutation.ProviderPaymentId(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: payment.FieldProviderPaymentId,
+		})
+	}
+	if value, ok := puo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: payment.FieldStatus,
+		})
+	}
+	if value, ok := puo.mutation.Amount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: payment.FieldAmount,
+		})
+	}
+	if value, ok := puo.mutation.AddedAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: payment.FieldAmount,
+		})
+	}
+	if value, ok := puo.mutation.AmountRefunded(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: payment.FieldAmountRefunded,
+		})
+	}
+	if value, ok := puo.mutation.AddedAmountRefunded(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: payment.FieldAmountRefunded,
+		})
+	}
+	if value, ok := puo.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: payment.FieldCurrency,
+		})
+	}
+	if value, ok := puo.mutation.ClientSecret(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: payment.FieldClientSecret,
+		})
+	}
+	if puo.mutation.ClientSecretCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: payment.FieldClientSecret,
+		})
+	}
+	if value, ok := puo.mutation.FailureReason(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: payment.FieldFailureReason,
+		})
+	}
+	if puo.mutation.FailureReasonCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: payment.FieldFailureReason,
+		})
+	}
+	if puo.mutation.BookingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payment.BookingTable,
+			Columns: []string{payment.BookingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.BookingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payment.BookingTable,
+			Columns: []string{payment.BookingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Payment{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,59 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openmesh/booking"
+)
+
+type pricingService struct {
+	client *Client
+}
+
+// NewPricingService constructs a new instance of a booking.PricingService
+// using ent as its persistence layer.
+func NewPricingService(client *Client) *pricingService {
+	return &pricingService{client}
+}
+
+// QuoteBooking prices a prospective booking with the pricing rules, tax rate
+// and promo code of its resource in the same way that the booking would be priced
+// when it is made.
+func (s *pricingService) QuoteBooking(
+	ctx context.Context,
+	req booking.QuoteBookingRequest,
+) booking.QuoteBookingResponse {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return booking.QuoteBookingResponse{
+			Err: fmt.Errorf("failed to start transaction: %w", err),
+		}
+	}
+	defer tx.Rollback()
+
+	r, err := findResourceByID(ctx, tx, req.ResourceID, nil)
+	if err != nil {
+		return booking.QuoteBookingResponse{Err: err}
+	}
+
+	q, err := r.toModel().Quote(req.StartTime, req.EndTime, req.Segment)
+	if err != nil {
+		return booking.QuoteBookingResponse{
+			Err: fmt.Errorf("failed to price booking: %w", err),
+		}
+	}
+	tax, err := resourceTaxRate(ctx, tx.Client(), r)
+	if err != nil {
+		return booking.QuoteBookingResponse{Err: err}
+	}
+	q.ApplyTax(tax)
+	if req.PromoCode != "" {
+		promo, err := redeemablePromoCode(ctx, tx, req.PromoCode, q, req.Customer, false)
+		if err != nil {
+			return booking.QuoteBookingResponse{Err: err}
+		}
+		q.ApplyPromoCode(promo)
+	}
+	return booking.QuoteBookingResponse{Quote: q}
+}
@@ -0,0 +1,543 @@
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openmesh/booking"
+	entbooking "github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/promocode"
+	"github.com/openmesh/booking/ent/promoredemption"
+)
+
+type promoCodeService struct {
+	client *Client
+}
+
+// NewPromoCodeService constructs a new instance of a booking.PromoCodeService
+// using ent as its persistence layer.
+func NewPromoCodeService(client *Client) *promoCodeService {
+	return &promoCodeService{client}
+}
+
+// FindPromoCodeByID retrieves a single promo code by ID along with its
+// redemption totals. Returns EPROMOCODENOTFOUND if the promo code does not
+// exist or user does not have permission to view it.
+func (s *promoCodeService) FindPromoCodeByID(
+	ctx context.Context,
+	req booking.FindPromoCodeByIDRequest,
+) booking.FindPromoCodeByIDResponse {
+	c, err := findPromoCodeByID(ctx, s.client, req.ID)
+	if err != nil {
+		return booking.FindPromoCodeByIDResponse{Err: err}
+	}
+	codes, err := promoCodeModels(ctx, s.client, []*PromoCode{c})
+	if err != nil {
+		return booking.FindPromoCodeByIDResponse{Err: err}
+	}
+	return booking.FindPromoCodeByIDResponse{PromoCode: codes[0]}
+}
+
+// FindPromoCodes retrieves the promo codes of the current organization along
+// with their redemption totals.
+func (s *promoCodeService) FindPromoCodes(
+	ctx context.Context,
+	req booking.FindPromoCodesRequest,
+) booking.FindPromoCodesResponse {
+	q := s.client.PromoCode.Query()
+	if req.Code != nil {
+		q.Where(promocode.CodeContainsFold(*req.Code))
+	}
+
+	c, err := q.Clone().Count(ctx)
+	if err != nil {
+		return booking.FindPromoCodesResponse{
+			Err: fmt.Errorf("failed to count promo codes: %w", err),
+		}
+	}
+
+	q = q.Offset(req.Offset)
+	if req.Limit == 0 {
+		q.Limit(10)
+	} else {
+		q.Limit(req.Limit)
+	}
+
+	p, err := q.Order(Asc(promocode.FieldID)).All(ctx)
+	if err != nil {
+		return booking.FindPromoCodesResponse{
+			Err: fmt.Errorf("failed to query promo codes: %w", err),
+		}
+	}
+
+	codes, err := promoCodeModels(ctx, s.client, p)
+	if err != nil {
+		return booking.FindPromoCodesResponse{Err: err}
+	}
+	return booking.FindPromoCodesResponse{
+		PromoCodes: codes,
+		TotalItems: c,
+	}
+}
+
+// CreatePromoCode creates a new promo code for the current organization.
+// Returns EPROMOCODECONFLICT if the organization already has the code.
+func (s *promoCodeService) CreatePromoCode(
+	ctx context.Context,
+	req booking.CreatePromoCodeRequest,
+) booking.CreatePromoCodeResponse {
+	orgID := booking.OrganizationIDFromContext(ctx)
+	if orgID == 0 {
+		return booking.CreatePromoCodeResponse{
+			Err: booking.Errorf(booking.EUNAUTHORIZED, "You must be signed in to create a promo code."),
+		}
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return booking.CreatePromoCodeResponse{
+			Err: fmt.Errorf("failed to start transaction: %w", err),
+		}
+	}
+	defer tx.Rollback()
+
+	code := booking.NormalizePromoCode(req.Code)
+	if err := checkForPromoCodeConflict(ctx, tx, code, 0); err != nil {
+		return booking.CreatePromoCodeResponse{Err: err}
+	}
+	currency, err := promoCodeCurrency(ctx, tx, req.DiscountType, req.Currency)
+	if err != nil {
+		return booking.CreatePromoCodeResponse{Err: err}
+	}
+
+	p, err := tx.PromoCode.
+		Create().
+		SetCode(code).
+		SetDescription(req.Description).
+		SetDiscountType(req.DiscountType).
+		SetDiscountValue(req.DiscountValue).
+		SetCurrency(currency).
+		SetNillableValidFrom(req.ValidFrom).
+		SetNillableValidUntil(req.ValidUntil).
+		SetNillableMaxRedemptions(req.MaxRedemptions).
+		SetNillableMaxRedemptionsPerCustomer(req.MaxRedemptionsPerCustomer).
+		SetResourceIds(req.ResourceIDs).
+		SetOrganizationID(orgID).
+		Save(ctx)
+	if err != nil {
+		return booking.CreatePromoCodeResponse{
+			Err: fmt.Errorf("failed to create promo code: %w", err),
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return booking.CreatePromoCodeResponse{
+			Err: fmt.Errorf("failed to commit transaction: %w", err),
+		}
+	}
+	return booking.CreatePromoCodeResponse{PromoCode: p.toModel()}
+}
+
+// UpdatePromoCode updates an existing promo code by ID. Existing redemptions
+// are unaffected.
+func (s *promoCodeService) UpdatePromoCode(
+	ctx context.Context,
+	req booking.UpdatePromoCodeRequest,
+) booking.UpdatePromoCodeResponse {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return booking.UpdatePromoCodeResponse{
+			Err: fmt.Errorf("failed to start transaction: %w", err),
+		}
+	}
+	defer tx.Rollback()
+
+	p, err := findPromoCodeByID(ctx, tx.Client(), req.ID)
+	if err != nil {
+		return booking.UpdatePromoCodeResponse{Err: err}
+	}
+
+	code := booking.NormalizePromoCode(req.Code)
+	if err := checkForPromoCodeConflict(ctx, tx, code, p.ID); err != nil {
+		return booking.UpdatePromoCodeResponse{Err: err}
+	}
+	currency, err := promoCodeCurrency(ctx, tx, req.DiscountType, req.Currency)
+	if err != nil {
+		return booking.UpdatePromoCodeResponse{Err: err}
+	}
+
+	q := p.Update().
+		SetCode(code).
+		SetDescription(req.Description).
+		SetDiscountType(req.DiscountType).
+		SetDiscountValue(req.DiscountValue).
+		SetCurrency(currency).
+		SetResourceIds(req.ResourceIDs)
+	if req.ValidFrom != nil {
+		q.SetValidFrom(*req.ValidFrom)
+	} else {
+		q.ClearValidFrom()
+	}
+	if req.ValidUntil != nil {
+		q.SetValidUntil(*req.ValidUntil)
+	} else {
+		q.ClearValidUntil()
+	}
+	if req.MaxRedemptions != nil {
+		q.SetMaxRedemptions(*req.MaxRedemptions)
+	} else {
+		q.ClearMaxRedemptions()
+	}
+	if req.MaxRedemptionsPerCustomer != nil {
+		q.SetMaxRedemptionsPerCustomer(*req.MaxRedemptionsPerCustomer)
+	} else {
+		q.ClearMaxRedemptionsPerCustomer()
+	}
+
+	p, err = q.Save(ctx)
+	if err != nil {
+		return booking.UpdatePromoCodeResponse{
+			Err: fmt.Errorf("failed to update promo code: %w", err),
+		}
+	}
+
+	codes, err := promoCodeModels(ctx, tx.Client(), []*PromoCode{p})
+	if err != nil {
+		return booking.UpdatePromoCodeResponse{Err: err}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return booking.UpdatePromoCodeResponse{
+			Err: fmt.Errorf("failed to commit transaction: %w", err),
+		}
+	}
+	return booking.UpdatePromoCodeResponse{PromoCode: codes[0]}
+}
+
+// DeletePromoCode permanently removes a promo code along with its
+// redemptions.
+func (s *promoCodeService) DeletePromoCode(
+	ctx context.Context,
+	req booking.DeletePromoCodeRequest,
+) booking.DeletePromoCodeResponse {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return booking.DeletePromoCodeResponse{
+			Err: fmt.Errorf("failed to start transaction: %w", err),
+		}
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.PromoRedemption.
+		Delete().
+		Where(promoredemption.PromoCodeId(req.ID)).
+		Exec(ctx); err != nil {
+		return booking.DeletePromoCodeResponse{
+			Err: fmt.Errorf("failed to delete promo code redemptions: %w", err),
+		}
+	}
+
+	a, err := tx.PromoCode.
+		Delete().
+		Where(promocode.ID(req.ID)).
+		Exec(ctx)
+	if err != nil {
+		return booking.DeletePromoCodeResponse{
+			Err: fmt.Errorf("failed to delete promo code: %w", err),
+		}
+	}
+	if a == 0 {
+		return booking.DeletePromoCodeResponse{
+			Err: booking.Errorf(booking.EPROMOCODENOTFOUND, "Could not find promo code with ID %d", req.ID),
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return booking.DeletePromoCodeResponse{
+			Err: fmt.Errorf("failed to commit transaction: %w", err),
+		}
+	}
+	return booking.DeletePromoCodeResponse{}
+}
+
+// FindPromoRedemptions retrieves the redemptions of a promo code, most recent
+// first, along with the total discount of the ones that still count.
+func (s *promoCodeService) FindPromoRedemptions(
+	ctx context.Context,
+	req booking.FindPromoRedemptionsRequest,
+) booking.FindPromoRedemptionsResponse {
+	// Make sure that the promo code exists so that a missing promo code isn't
+	// mistaken for one that hasn't been redeemed.
+	p, err := findPromoCodeByID(ctx, s.client, req.PromoCodeID)
+	if err != nil {
+		return booking.FindPromoRedemptionsResponse{Err: err}
+	}
+
+	q := s.client.PromoRedemption.
+		Query().
+		Where(promoredemption.PromoCodeId(p.ID))
+	if req.Customer != nil {
+		q.Where(promoredemption.Customer(booking.NormalizePromoCustomer(*req.Customer)))
+	}
+	if req.From != nil {
+		q.Where(promoredemption.CreatedAtGTE(*req.From))
+	}
+	if req.To != nil {
+		q.Where(promoredemption.CreatedAtLT(*req.To))
+	}
+
+	c, err := q.Clone().Count(ctx)
+	if err != nil {
+		return booking.FindPromoRedemptionsResponse{
+			Err: fmt.Errorf("failed to count promo code redemptions: %w", err),
+		}
+	}
+
+	var totals []struct {
+		Currency string `json:"currency"`
+		Sum      int    `json:"sum"`
+	}
+	err = q.Clone().
+		Where(promoredemption.HasBookingWith(entbooking.StatusNotIn(booking.VoidPromoRedemptionStatuses...))).
+		GroupBy(promoredemption.FieldCurrency).
+		Aggregate(Sum(promoredemption.FieldDiscount)).
+		Scan(ctx, &totals)
+	if err != nil {
+		return booking.FindPromoRedemptionsResponse{
+			Err: fmt.Errorf("failed to sum promo code discounts: %w", err),
+		}
+	}
+	total := booking.MoneyTotals{}
+	for _, t := range totals {
+		total = total.Add(booking.NewMoney(t.Sum, t.Currency))
+	}
+
+	q = q.Offset(req.Offset)
+	if req.Limit == 0 {
+		q.Limit(10)
+	} else {
+		q.Limit(req.Limit)
+	}
+
+	r, err := q.
+		WithBooking().
+		Order(Desc(promoredemption.FieldCreatedAt), Desc(promoredemption.FieldID)).
+		All(ctx)
+	if err != nil {
+		return booking.FindPromoRedemptionsResponse{
+			Err: fmt.Errorf("failed to query promo code redemptions: %w", err),
+		}
+	}
+
+	redemptions := make([]*booking.PromoRedemption, 0, len(r))
+	for _, v := range r {
+		m := v.toModel()
+		m.Code = p.Code
+		redemptions = append(redemptions, m)
+	}
+	return booking.FindPromoRedemptionsResponse{
+		Redemptions:   redemptions,
+		TotalItems:    c,
+		TotalDiscount: total,
+	}
+}
+
+// findPromoCodeByID retrieves a promo code by ID. Returns EPROMOCODENOTFOUND
+// if the promo code does not exist.
+func findPromoCodeByID(ctx context.Context, client *Client, id int) (*PromoCode, error) {
+	p, err := client.PromoCode.
+		Query().
+		Where(promocode.ID(id)).
+		Only(ctx)
+	var nfe *NotFoundError
+	if errors.As(err, &nfe) {
+		return nil, booking.Errorf(booking.EPROMOCODENOTFOUND, "Could not find promo code with ID %d", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find promo code: %w", err)
+	}
+	return p, nil
+}
+
+// checkForPromoCodeConflict returns EPROMOCODECONFLICT if a promo code other
+// than the one with id already uses code.
+func checkForPromoCodeConflict(ctx context.Context, tx *Tx, code string, id int) error {
+	exists, err := tx.PromoCode.
+		Query().
+		Where(promocode.Code(code), promocode.IDNEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check for promo code conflict: %w", err)
+	}
+	if exists {
+		return booking.Errorf(booking.EPROMOCODECONFLICT, "A promo code with code '%s' already exists", code)
+	}
+	return nil
+}
+
+// promoCodeCurrency returns the currency stored for a promo code. Fixed
+// discounts are in the organization's default currency unless currency is
+// given and percent discounts don't have a currency.
+func promoCodeCurrency(ctx context.Context, tx *Tx, discountType, currency string) (string, error) {
+	if discountType != booking.PromoDiscountFixed {
+		return "", nil
+	}
+	if currency != "" {
+		return currency, nil
+	}
+	return organizationCurrency(ctx, tx.Client())
+}
+
+// redeemablePromoCode returns the promo code matching code if it can be
+// redeemed now by customer for the booking priced by quote.
+// Returns a validation error for "promoCode" if the code doesn't exist or
+// can't be redeemed. The promo code is locked when lock is true so that
+// concurrent bookings can't redeem it past its limits.
+func redeemablePromoCode(
+	ctx context.Context,
+	tx *Tx,
+	code string,
+	quote *booking.Quote,
+	customer string,
+	lock bool,
+) (*booking.PromoCode, error) {
+	q := tx.PromoCode.
+		Query().
+		Where(promocode.Code(booking.NormalizePromoCode(code)))
+	if lock {
+		q.ForUpdate()
+	}
+	p, err := q.Only(ctx)
+	var nfe *NotFoundError
+	if errors.As(err, &nfe) {
+		return nil, booking.ValidationErrorf("", booking.ValidationError{
+			Name:   "promoCode",
+			Reason: "Promo code does not exist",
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find promo code: %w", err)
+	}
+
+	counted := promoredemption.And(
+		promoredemption.PromoCodeId(p.ID),
+		promoredemption.HasBookingWith(entbooking.StatusNotIn(booking.VoidPromoRedemptionStatuses...)),
+	)
+	var redemptions, customerRedemptions int
+	if p.MaxRedemptions != nil {
+		redemptions, err = tx.PromoRedemption.Query().Where(counted).Count(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count promo code redemptions: %w", err)
+		}
+	}
+	customer = booking.NormalizePromoCustomer(customer)
+	if p.MaxRedemptionsPerCustomer != nil && customer != "" {
+		customerRedemptions, err = tx.PromoRedemption.
+			Query().
+			Where(counted, promoredemption.Customer(customer)).
+			Count(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count promo code redemptions: %w", err)
+		}
+	}
+
+	m := p.toModel()
+	if errs := m.Check(time.Now(), quote, customer, redemptions, customerRedemptions); len(errs) > 0 {
+		return nil, booking.ValidationErrorf("", errs...)
+	}
+	return m, nil
+}
+
+// promoCodeModels converts promo codes to their models along with the number
+// of redemptions that count towards their limits and their total discount.
+func promoCodeModels(ctx context.Context, client *Client, p []*PromoCode) ([]*booking.PromoCode, error) {
+	ids := make([]int, 0, len(p))
+	for _, v := range p {
+		ids = append(ids, v.ID)
+	}
+
+	var totals []struct {
+		PromoCodeID int    `json:"promo_code_id"`
+		Currency    string `json:"currency"`
+		Count       int    `json:"count"`
+		Sum         int    `json:"sum"`
+	}
+	err := client.PromoRedemption.
+		Query().
+		Where(
+			promoredemption.PromoCodeIdIn(ids...),
+			promoredemption.HasBookingWith(entbooking.StatusNotIn(booking.VoidPromoRedemptionStatuses...)),
+		).
+		GroupBy(promoredemption.FieldPromoCodeId, promoredemption.FieldCurrency).
+		Aggregate(Count(), Sum(promoredemption.FieldDiscount)).
+		Scan(ctx, &totals)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count promo code redemptions: %w", err)
+	}
+
+	codes := PromoCodes(p).toModels()
+	for _, c := range codes {
+		for _, t := range totals {
+			if t.PromoCodeID == c.ID {
+				c.Redemptions += t.Count
+				c.TotalDiscount = c.TotalDiscount.Add(booking.NewMoney(t.Sum, t.Currency))
+			}
+		}
+	}
+	return codes, nil
+}
+
+// promoCodeErrorParams returns the params of a validation error returned by
+// redeemablePromoCode. Returns false if err is any other kind of error.
+func promoCodeErrorParams(err error) ([]booking.ValidationError, bool) {
+	var e *booking.Error
+	if !errors.As(err, &e) || e.Code != booking.EINVALID {
+		return nil, false
+	}
+	return e.Params, true
+}
+
+func (p *PromoCode) toModel() *booking.PromoCode {
+	return &booking.PromoCode{
+		ID:                        p.ID,
+		Code:                      p.Code,
+		Description:               p.Description,
+		DiscountType:              p.DiscountType,
+		DiscountValue:             p.DiscountValue,
+		Currency:                  p.Currency,
+		ValidFrom:                 p.ValidFrom,
+		ValidUntil:                p.ValidUntil,
+		MaxRedemptions:            p.MaxRedemptions,
+		MaxRedemptionsPerCustomer: p.MaxRedemptionsPerCustomer,
+		ResourceIDs:               p.ResourceIds,
+		TotalDiscount:             booking.MoneyTotals{},
+		OrganizationID:            p.OrganizationId,
+		CreatedAt:                 p.CreatedAt,
+		UpdatedAt:                 p.UpdatedAt,
+	}
+}
+
+func (p PromoCodes) toModels() []*booking.PromoCode {
+	var codes []*booking.PromoCode
+	for _, v := range p {
+		codes = append(codes, v.toModel())
+	}
+	return codes
+}
+
+func (r *PromoRedemption) toModel() *booking.PromoRedemption {
+	result := &booking.PromoRedemption{
+		ID:          r.ID,
+		PromoCodeID: r.PromoCodeId,
+		BookingID:   r.BookingId,
+		Customer:    r.Customer,
+		Discount:    booking.NewMoney(r.Discount, r.Currency),
+		CreatedAt:   r.CreatedAt,
+	}
+	if r.Edges.Booking != nil {
+		result.BookingStatus = r.Edges.Booking.Status
+	}
+	return result
+}
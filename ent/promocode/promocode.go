@@ -0,0 +1,107 @@
+// Code generated by entc, DO NOT EDIT.
+
+package promocode
+
+import (
+	"time"
+
+	"entgo.io/ent"
+)
+
+const (
+	// Label holds the string label denoting the promocode type in the database.
+	Label = "promo_code"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the createdat field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updatedat field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldDiscountType holds the string denoting the discounttype field in the database.
+	FieldDiscountType = "discount_type"
+	// FieldDiscountValue holds the string denoting the discountvalue field in the database.
+	FieldDiscountValue = "discount_value"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldValidFrom holds the string denoting the validfrom field in the database.
+	FieldValidFrom = "valid_from"
+	// FieldValidUntil holds the string denoting the validuntil field in the database.
+	FieldValidUntil = "valid_until"
+	// FieldMaxRedemptions holds the string denoting the maxredemptions field in the database.
+	FieldMaxRedemptions = "max_redemptions"
+	// FieldMaxRedemptionsPerCustomer holds the string denoting the maxredemptionspercustomer field in the database.
+	FieldMaxRedemptionsPerCustomer = "max_redemptions_per_customer"
+	// FieldResourceIds holds the string denoting the resourceids field in the database.
+	FieldResourceIds = "resource_ids"
+	// FieldOrganizationId holds the string denoting the organizationid field in the database.
+	FieldOrganizationId = "organization_id"
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgeRedemptions holds the string denoting the redemptions edge name in mutations.
+	EdgeRedemptions = "redemptions"
+	// Table holds the table name of the promocode in the database.
+	Table = "promo_codes"
+	// OrganizationTable is the table that holds the organization relation/edge.
+	OrganizationTable = "promo_codes"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+	// RedemptionsTable is the table that holds the redemptions relation/edge.
+	RedemptionsTable = "promo_redemptions"
+	// RedemptionsInverseTable is the table name for the PromoRedemption entity.
+	// It exists in this package in order to avoid circular dependency with the "promoredemption" package.
+	RedemptionsInverseTable = "promo_redemptions"
+	// RedemptionsColumn is the table column denoting the redemptions relation/edge.
+	RedemptionsColumn = "promo_code_id"
+)
+
+// Columns holds all SQL columns for promocode fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldCode,
+	FieldDescription,
+	FieldDiscountType,
+	FieldDiscountValue,
+	FieldCurrency,
+	FieldValidFrom,
+	FieldValidUntil,
+	FieldMaxRedemptions,
+	FieldMaxRedemptionsPerCustomer,
+	FieldResourceIds,
+	FieldOrganizationId,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/openmesh/booking/ent/runtime"
+//
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCreatedAt holds the default value on creation for the "createdAt" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updatedAt" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updatedAt" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
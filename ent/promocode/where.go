@@ -0,0 +1,1388 @@
+// Code generated by entc, DO NOT EDIT.
+
+package promocode
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/openmesh/booking/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "createdAt" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updatedAt" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCode), v))
+	})
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDescription), v))
+	})
+}
+
+// DiscountType applies equality check predicate on the "discountType" field. It's identical to DiscountTypeEQ.
+func DiscountType(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDiscountType), v))
+	})
+}
+
+// DiscountValue applies equality check predicate on the "discountValue" field. It's identical to DiscountValueEQ.
+func DiscountValue(v int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDiscountValue), v))
+	})
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCurrency), v))
+	})
+}
+
+// ValidFrom applies equality check predicate on the "validFrom" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldValidFrom), v))
+	})
+}
+
+// ValidUntil applies equality check predicate on the "validUntil" field. It's identical to ValidUntilEQ.
+func ValidUntil(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldValidUntil), v))
+	})
+}
+
+// MaxRedemptions applies equality check predicate on the "maxRedemptions" field. It's identical to MaxRedemptionsEQ.
+func MaxRedemptions(v int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMaxRedemptions), v))
+	})
+}
+
+// MaxRedemptionsPerCustomer applies equality check predicate on the "maxRedemptionsPerCustomer" field. It's identical to MaxRedemptionsPerCustomerEQ.
+func MaxRedemptionsPerCustomer(v int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMaxRedemptionsPerCustomer), v))
+	})
+}
+
+// OrganizationId applies equality check predicate on the "organizationId" field. It's identical to OrganizationIdEQ.
+func OrganizationId(v int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOrganizationId), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "createdAt" field.
+func CreatedAtEQ(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "createdAt" field.
+func CreatedAtNEQ(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "createdAt" field.
+func CreatedAtIn(vs ...time.Time) predicate.PromoCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "createdAt" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PromoCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "createdAt" field.
+func CreatedAtGT(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "createdAt" field.
+func CreatedAtGTE(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "createdAt" field.
+func CreatedAtLT(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "createdAt" field.
+func CreatedAtLTE(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updatedAt" field.
+func UpdatedAtEQ(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updatedAt" field.
+func UpdatedAtNEQ(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updatedAt" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PromoCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updatedAt" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PromoCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updatedAt" field.
+func UpdatedAtGT(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updatedAt" field.
+func UpdatedAtGTE(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updatedAt" field.
+func UpdatedAtLT(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updatedAt" field.
+func UpdatedAtLTE(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCode), v))
+	})
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCode), v))
+	})
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.PromoCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCode), v...))
+	})
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.PromoCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCode), v...))
+	})
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCode), v))
+	})
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCode), v))
+	})
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCode), v))
+	})
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCode), v))
+	})
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCode), v))
+	})
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCode), v))
+	})
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCode), v))
+	})
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCode), v))
+	})
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCode), v))
+	})
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.PromoCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDescription), v...))
+	})
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.PromoCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDescription), v...))
+	})
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDescription)))
+	})
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDescription)))
+	})
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDescription), v))
+	})
+}
+
+// DiscountTypeEQ applies the EQ predicate on the "discountType" field.
+func DiscountTypeEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDiscountType), v))
+	})
+}
+
+// DiscountTypeNEQ applies the NEQ predicate on the "discountType" field.
+func DiscountTypeNEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDiscountType), v))
+	})
+}
+
+// DiscountTypeIn applies the In predicate on the "discountType" field.
+func DiscountTypeIn(vs ...string) predicate.PromoCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDiscountType), v...))
+	})
+}
+
+// DiscountTypeNotIn applies the NotIn predicate on the "discountType" field.
+func DiscountTypeNotIn(vs ...string) predicate.PromoCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDiscountType), v...))
+	})
+}
+
+// DiscountTypeGT applies the GT predicate on the "discountType" field.
+func DiscountTypeGT(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDiscountType), v))
+	})
+}
+
+// DiscountTypeGTE applies the GTE predicate on the "discountType" field.
+func DiscountTypeGTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDiscountType), v))
+	})
+}
+
+// DiscountTypeLT applies the LT predicate on the "discountType" field.
+func DiscountTypeLT(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDiscountType), v))
+	})
+}
+
+// DiscountTypeLTE applies the LTE predicate on the "discountType" field.
+func DiscountTypeLTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDiscountType), v))
+	})
+}
+
+// DiscountTypeContains applies the Contains predicate on the "discountType" field.
+func DiscountTypeContains(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDiscountType), v))
+	})
+}
+
+// DiscountTypeHasPrefix applies the HasPrefix predicate on the "discountType" field.
+func DiscountTypeHasPrefix(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDiscountType), v))
+	})
+}
+
+// DiscountTypeHasSuffix applies the HasSuffix predicate on the "discountType" field.
+func DiscountTypeHasSuffix(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDiscountType), v))
+	})
+}
+
+// DiscountTypeEqualFold applies the EqualFold predicate on the "discountType" field.
+func DiscountTypeEqualFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDiscountType), v))
+	})
+}
+
+// DiscountTypeContainsFold applies the ContainsFold predicate on the "discountType" field.
+func DiscountTypeContainsFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDiscountType), v))
+	})
+}
+
+// DiscountValueEQ applies the EQ predicate on the "discountValue" field.
+func DiscountValueEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDiscountValue), v))
+	})
+}
+
+// DiscountValueNEQ applies the NEQ predicate on the "discountValue" field.
+func DiscountValueNEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDiscountValue), v))
+	})
+}
+
+// DiscountValueIn applies the In predicate on the "discountValue" field.
+func DiscountValueIn(vs ...int) predicate.PromoCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDiscountValue), v...))
+	})
+}
+
+// DiscountValueNotIn applies the NotIn predicate on the "discountValue" field.
+func DiscountValueNotIn(vs ...int) predicate.PromoCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDiscountValue), v...))
+	})
+}
+
+// DiscountValueGT applies the GT predicate on the "discountValue" field.
+func DiscountValueGT(v int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDiscountValue), v))
+	})
+}
+
+// DiscountValueGTE applies the GTE predicate on the "discountValue" field.
+func DiscountValueGTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDiscountValue), v))
+	})
+}
+
+// DiscountValueLT applies the LT predicate on the "discountValue" field.
+func DiscountValueLT(v int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDiscountValue), v))
+	})
+}
+
+// DiscountValueLTE applies the LTE predicate on the "discountValue" field.
+func DiscountValueLTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDiscountValue), v))
+	})
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.PromoCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCurrency), v...))
+	})
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.PromoCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCurrency), v...))
+	})
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyIsNil applies the IsNil predicate on the "currency" field.
+func CurrencyIsNil() predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCurrency)))
+	})
+}
+
+// CurrencyNotNil applies the NotNil predicate on the "currency" field.
+func CurrencyNotNil() predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCurrency)))
+	})
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCurrency), v))
+	})
+}
+
+// ValidFromEQ applies the EQ predicate on the "validFrom" field.
+func ValidFromEQ(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldValidFrom), v))
+	})
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "validFrom" field.
+func ValidFromNEQ(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldValidFrom), v))
+	})
+}
+
+// ValidFromIn applies the In predicate on the "validFrom" field.
+func ValidFromIn(vs ...time.Time) predicate.PromoCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldValidFrom), v...))
+	})
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "validFrom" field.
+func ValidFromNotIn(vs ...time.Time) predicate.PromoCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldValidFrom), v...))
+	})
+}
+
+// ValidFromGT applies the GT predicate on the "validFrom" field.
+func ValidFromGT(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldValidFrom), v))
+	})
+}
+
+// ValidFromGTE applies the GTE predicate on the "validFrom" field.
+func ValidFromGTE(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldValidFrom), v))
+	})
+}
+
+// ValidFromLT applies the LT predicate on the "validFrom" field.
+func ValidFromLT(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldValidFrom), v))
+	})
+}
+
+// ValidFromLTE applies the LTE predicate on the "validFrom" field.
+func ValidFromLTE(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldValidFrom), v))
+	})
+}
+
+// ValidFromIsNil applies the IsNil predicate on the "validFrom" field.
+func ValidFromIsNil() predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldValidFrom)))
+	})
+}
+
+// ValidFromNotNil applies the NotNil predicate on the "validFrom" field.
+func ValidFromNotNil() predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldValidFrom)))
+	})
+}
+
+// ValidUntilEQ applies the EQ predicate on the "validUntil" field.
+func ValidUntilEQ(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldValidUntil), v))
+	})
+}
+
+// ValidUntilNEQ applies the NEQ predicate on the "validUntil" field.
+func ValidUntilNEQ(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldValidUntil), v))
+	})
+}
+
+// ValidUntilIn applies the In predicate on the "validUntil" field.
+func ValidUntilIn(vs ...time.Time) predicate.PromoCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldValidUntil), v...))
+	})
+}
+
+// ValidUntilNotIn applies the NotIn predicate on the "validUntil" field.
+func ValidUntilNotIn(vs ...time.Time) predicate.PromoCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldValidUntil), v...))
+	})
+}
+
+// ValidUntilGT applies the GT predicate on the "validUntil" field.
+func ValidUntilGT(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldValidUntil), v))
+	})
+}
+
+// ValidUntilGTE applies the GTE predicate on the "validUntil" field.
+func ValidUntilGTE(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldValidUntil), v))
+	})
+}
+
+// ValidUntilLT applies the LT predicate on the "validUntil" field.
+func ValidUntilLT(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldValidUntil), v))
+	})
+}
+
+// ValidUntilLTE applies the LTE predicate on the "validUntil" field.
+func ValidUntilLTE(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldValidUntil), v))
+	})
+}
+
+// ValidUntilIsNil applies the IsNil predicate on the "validUntil" field.
+func ValidUntilIsNil() predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldValidUntil)))
+	})
+}
+
+// ValidUntilNotNil applies the NotNil predicate on the "validUntil" field.
+func ValidUntilNotNil() predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldValidUntil)))
+	})
+}
+
+// MaxRedemptionsEQ applies the EQ predicate on the "maxRedemptions" field.
+func MaxRedemptionsEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMaxRedemptions), v))
+	})
+}
+
+// MaxRedemptionsNEQ applies the NEQ predicate on the "maxRedemptions" field.
+func MaxRedemptionsNEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMaxRedemptions), v))
+	})
+}
+
+// MaxRedemptionsIn applies the In predicate on the "maxRedemptions" field.
+func MaxRedemptionsIn(vs ...int) predicate.PromoCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMaxRedemptions), v...))
+	})
+}
+
+// MaxRedemptionsNotIn applies the NotIn predicate on the "maxRedemptions" field.
+func MaxRedemptionsNotIn(vs ...int) predicate.PromoCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMaxRedemptions), v...))
+	})
+}
+
+// MaxRedemptionsGT applies the GT predicate on the "maxRedemptions" field.
+func MaxRedemptionsGT(v int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMaxRedemptions), v))
+	})
+}
+
+// MaxRedemptionsGTE applies the GTE predicate on the "maxRedemptions" field.
+func MaxRedemptionsGTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMaxRedemptions), v))
+	})
+}
+
+// MaxRedemptionsLT applies the LT predicate on the "maxRedemptions" field.
+func MaxRedemptionsLT(v int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMaxRedemptions), v))
+	})
+}
+
+// MaxRedemptionsLTE applies the LTE predicate on the "maxRedemptions" field.
+func MaxRedemptionsLTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMaxRedemptions), v))
+	})
+}
+
+// MaxRedemptionsIsNil applies the IsNil predicate on the "maxRedemptions" field.
+func MaxRedemptionsIsNil() predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldMaxRedemptions)))
+	})
+}
+
+// MaxRedemptionsNotNil applies the NotNil predicate on the "maxRedemptions" field.
+func MaxRedemptionsNotNil() predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldMaxRedemptions)))
+	})
+}
+
+// MaxRedemptionsPerCustomerEQ applies the EQ predicate on the "maxRedemptionsPerCustomer" field.
+func MaxRedemptionsPerCustomerEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMaxRedemptionsPerCustomer), v))
+	})
+}
+
+// MaxRedemptionsPerCustomerNEQ applies the NEQ predicate on the "maxRedemptionsPerCustomer" field.
+func MaxRedemptionsPerCustomerNEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMaxRedemptionsPerCustomer), v))
+	})
+}
+
+// MaxRedemptionsPerCustomerIn applies the In predicate on the "maxRedemptionsPerCustomer" field.
+func MaxRedemptionsPerCustomerIn(vs ...int) predicate.PromoCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMaxRedemptionsPerCustomer), v...))
+	})
+}
+
+// MaxRedemptionsPerCustomerNotIn applies the NotIn predicate on the "maxRedemptionsPerCustomer" field.
+func MaxRedemptionsPerCustomerNotIn(vs ...int) predicate.PromoCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMaxRedemptionsPerCustomer), v...))
+	})
+}
+
+// MaxRedemptionsPerCustomerGT applies the GT predicate on the "maxRedemptionsPerCustomer" field.
+func MaxRedemptionsPerCustomerGT(v int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMaxRedemptionsPerCustomer), v))
+	})
+}
+
+// MaxRedemptionsPerCustomerGTE applies the GTE predicate on the "maxRedemptionsPerCustomer" field.
+func MaxRedemptionsPerCustomerGTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMaxRedemptionsPerCustomer), v))
+	})
+}
+
+// MaxRedemptionsPerCustomerLT applies the LT predicate on the "maxRedemptionsPerCustomer" field.
+func MaxRedemptionsPerCustomerLT(v int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMaxRedemptionsPerCustomer), v))
+	})
+}
+
+// MaxRedemptionsPerCustomerLTE applies the LTE predicate on the "maxRedemptionsPerCustomer" field.
+func MaxRedemptionsPerCustomerLTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMaxRedemptionsPerCustomer), v))
+	})
+}
+
+// MaxRedemptionsPerCustomerIsNil applies the IsNil predicate on the "maxRedemptionsPerCustomer" field.
+func MaxRedemptionsPerCustomerIsNil() predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldMaxRedemptionsPerCustomer)))
+	})
+}
+
+// MaxRedemptionsPerCustomerNotNil applies the NotNil predicate on the "maxRedemptionsPerCustomer" field.
+func MaxRedemptionsPerCustomerNotNil() predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldMaxRedemptionsPerCustomer)))
+	})
+}
+
+// ResourceIdsIsNil applies the IsNil predicate on the "resourceIds" field.
+func ResourceIdsIsNil() predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldResourceIds)))
+	})
+}
+
+// ResourceIdsNotNil applies the NotNil predicate on the "resourceIds" field.
+func ResourceIdsNotNil() predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldResourceIds)))
+	})
+}
+
+// OrganizationIdEQ applies the EQ predicate on the "organizationId" field.
+func OrganizationIdEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOrganizationId), v))
+	})
+}
+
+// OrganizationIdNEQ applies the NEQ predicate on the "organizationId" field.
+func OrganizationIdNEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOrganizationId), v))
+	})
+}
+
+// OrganizationIdIn applies the In predicate on the "organizationId" field.
+func OrganizationIdIn(vs ...int) predicate.PromoCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldOrganizationId), v...))
+	})
+}
+
+// OrganizationIdNotIn applies the NotIn predicate on the "organizationId" field.
+func OrganizationIdNotIn(vs ...int) predicate.PromoCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldOrganizationId), v...))
+	})
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(OrganizationTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(OrganizationInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRedemptions applies the HasEdge predicate on the "redemptions" edge.
+func HasRedemptions() predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(RedemptionsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RedemptionsTable, RedemptionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRedemptionsWith applies the HasEdge predicate on the "redemptions" edge with a given conditions (other predicates).
+func HasRedemptionsWith(preds ...predicate.PromoRedemption) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(RedemptionsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RedemptionsTable, RedemptionsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PromoCode) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PromoCode) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PromoCode) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
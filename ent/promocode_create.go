@@ -0,0 +1,1441 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmesh/booking/ent/organization"
+	"github.com/openmesh/booking/ent/promocode"
+	"github.com/openmesh/booking/ent/promoredemption"
+)
+
+// PromoCodeCreate is the builder for creating a PromoCode entity.
+type PromoCodeCreate struct {
+	config
+	mutation *PromoCodeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (pcc *PromoCodeCreate) SetCreatedAt(t time.Time) *PromoCodeCreate {
+	pcc.mutation.SetCreatedAt(t)
+	return pcc
+}
+
+// SetNillableCreatedAt sets the "createdAt" field if the given value is not nil.
+func (pcc *PromoCodeCreate) SetNillableCreatedAt(t *time.Time) *PromoCodeCreate {
+	if t != nil {
+		pcc.SetCreatedAt(*t)
+	}
+	return pcc
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (pcc *PromoCodeCreate) SetUpdatedAt(t time.Time) *PromoCodeCreate {
+	pcc.mutation.SetUpdatedAt(t)
+	return pcc
+}
+
+// SetNillableUpdatedAt sets the "updatedAt" field if the given value is not nil.
+func (pcc *PromoCodeCreate) SetNillableUpdatedAt(t *time.Time) *PromoCodeCreate {
+	if t != nil {
+		pcc.SetUpdatedAt(*t)
+	}
+	return pcc
+}
+
+// SetCode sets the "code" field.
+func (pcc *PromoCodeCreate) SetCode(s string) *PromoCodeCreate {
+	pcc.mutation.SetCode(s)
+	return pcc
+}
+
+// SetDescription sets the "description" field.
+func (pcc *PromoCodeCreate) SetDescription(s string) *PromoCodeCreate {
+	pcc.mutation.SetDescription(s)
+	return pcc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (pcc *PromoCodeCreate) SetNillableDescription(s *string) *PromoCodeCreate {
+	if s != nil {
+		pcc.SetDescription(*s)
+	}
+	return pcc
+}
+
+// SetDiscountType sets the "discountType" field.
+func (pcc *PromoCodeCreate) SetDiscountType(s string) *PromoCodeCreate {
+	pcc.mutation.SetDiscountType(s)
+	return pcc
+}
+
+// SetDiscountValue sets the "discountValue" field.
+func (pcc *PromoCodeCreate) SetDiscountValue(i int) *PromoCodeCreate {
+	pcc.mutation.SetDiscountValue(i)
+	return pcc
+}
+
+// SetCurrency sets the "currency" field.
+func (pcc *PromoCodeCreate) SetCurrency(s string) *PromoCodeCreate {
+	pcc.mutation.SetCurrency(s)
+	return pcc
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (pcc *PromoCodeCreate) SetNillableCurrency(s *string) *PromoCodeCreate {
+	if s != nil {
+		pcc.SetCurrency(*s)
+	}
+	return pcc
+}
+
+// SetValidFrom sets the "validFrom" field.
+func (pcc *PromoCodeCreate) SetValidFrom(t time.Time) *PromoCodeCreate {
+	pcc.mutation.SetValidFrom(t)
+	return pcc
+}
+
+// SetNillableValidFrom sets the "validFrom" field if the given value is not nil.
+func (pcc *PromoCodeCreate) SetNillableValidFrom(t *time.Time) *PromoCodeCreate {
+	if t != nil {
+		pcc.SetValidFrom(*t)
+	}
+	return pcc
+}
+
+// SetValidUntil sets the "validUntil" field.
+func (pcc *PromoCodeCreate) SetValidUntil(t time.Time) *PromoCodeCreate {
+	pcc.mutation.SetValidUntil(t)
+	return pcc
+}
+
+// SetNillableValidUntil sets the "validUntil" field if the given value is not nil.
+func (pcc *PromoCodeCreate) SetNillableValidUntil(t *time.Time) *PromoCodeCreate {
+	if t != nil {
+		pcc.SetValidUntil(*t)
+	}
+	return pcc
+}
+
+// SetMaxRedemptions sets the "maxRedemptions" field.
+func (pcc *PromoCodeCreate) SetMaxRedemptions(i int) *PromoCodeCreate {
+	pcc.mutation.SetMaxRedemptions(i)
+	return pcc
+}
+
+// SetNillableMaxRedemptions sets the "maxRedemptions" field if the given value is not nil.
+func (pcc *PromoCodeCreate) SetNillableMaxRedemptions(i *int) *PromoCodeCreate {
+	if i != nil {
+		pcc.SetMaxRedemptions(*i)
+	}
+	return pcc
+}
+
+// SetMaxRedemptionsPerCustomer sets the "maxRedemptionsPerCustomer" field.
+func (pcc *PromoCodeCreate) SetMaxRedemptionsPerCustomer(i int) *PromoCodeCreate {
+	pcc.mutation.SetMaxRedemptionsPerCustomer(i)
+	return pcc
+}
+
+// SetNillableMaxRedemptionsPerCustomer sets the "maxRedemptionsPerCustomer" field if the given value is not nil.
+func (pcc *PromoCodeCreate) SetNillableMaxRedemptionsPerCustomer(i *int) *PromoCodeCreate {
+	if i != nil {
+		pcc.SetMaxRedemptionsPerCustomer(*i)
+	}
+	return pcc
+}
+
+// SetResourceIds sets the "resourceIds" field.
+func (pcc *PromoCodeCreate) SetResourceIds(i []int) *PromoCodeCreate {
+	pcc.mutation.SetResourceIds(i)
+	return pcc
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (pcc *PromoCodeCreate) SetOrganizationId(i int) *PromoCodeCreate {
+	pcc.mutation.SetOrganizationId(i)
+	return pcc
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (pcc *PromoCodeCreate) SetOrganizationID(id int) *PromoCodeCreate {
+	pcc.mutation.SetOrganizationID(id)
+	return pcc
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (pcc *PromoCodeCreate) SetOrganization(o *Organization) *PromoCodeCreate {
+	return pcc.SetOrganizationID(o.ID)
+}
+
+// AddRedemptionIDs adds the "redemptions" edge to the PromoRedemption entity by IDs.
+func (pcc *PromoCodeCreate) AddRedemptionIDs(ids ...int) *PromoCodeCreate {
+	pcc.mutation.AddRedemptionIDs(ids...)
+	return pcc
+}
+
+// AddRedemptions adds the "redemptions" edges to the PromoRedemption entity.
+func (pcc *PromoCodeCreate) AddRedemptions(p ...*PromoRedemption) *PromoCodeCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pcc.AddRedemptionIDs(ids...)
+}
+
+// Mutation returns the PromoCodeMutation object of the builder.
+func (pcc *PromoCodeCreate) Mutation() *PromoCodeMutation {
+	return pcc.mutation
+}
+
+// Save creates the PromoCode in the database.
+func (pcc *PromoCodeCreate) Save(ctx context.Context) (*PromoCode, error) {
+	var (
+		err  error
+		node *PromoCode
+	)
+	if err := pcc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(pcc.hooks) == 0 {
+		if err = pcc.check(); err != nil {
+			return nil, err
+		}
+		node, err = pcc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PromoCodeMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pcc.check(); err != nil {
+				return nil, err
+			}
+			pcc.mutation = mutation
+			if node, err = pcc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pcc.hooks) - 1; i >= 0; i-- {
+			if pcc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pcc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pcc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pcc *PromoCodeCreate) SaveX(ctx context.Context) *PromoCode {
+	v, err := pcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcc *PromoCodeCreate) Exec(ctx context.Context) error {
+	_, err := pcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcc *PromoCodeCreate) ExecX(ctx context.Context) {
+	if err := pcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcc *PromoCodeCreate) defaults() error {
+	if _, ok := pcc.mutation.CreatedAt(); !ok {
+		if promocode.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized promocode.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := promocode.DefaultCreatedAt()
+		pcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pcc.mutation.UpdatedAt(); !ok {
+		if promocode.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized promocode.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := promocode.DefaultUpdatedAt()
+		pcc.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcc *PromoCodeCreate) check() error {
+	if _, ok := pcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "createdAt", err: errors.New(`ent: missing required field "createdAt"`)}
+	}
+	if _, ok := pcc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updatedAt", err: errors.New(`ent: missing required field "updatedAt"`)}
+	}
+	if _, ok := pcc.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "code"`)}
+	}
+	if _, ok := pcc.mutation.DiscountType(); !ok {
+		return &ValidationError{Name: "discountType", err: errors.New(`ent: missing required field "discountType"`)}
+	}
+	if _, ok := pcc.mutation.DiscountValue(); !ok {
+		return &ValidationError{Name: "discountValue", err: errors.New(`ent: missing required field "discountValue"`)}
+	}
+	if _, ok := pcc.mutation.OrganizationId(); !ok {
+		return &ValidationError{Name: "organizationId", err: errors.New(`ent: missing required field "organizationId"`)}
+	}
+	if _, ok := pcc.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization", err: errors.New("ent: missing required edge \"organization\"")}
+	}
+	return nil
+}
+
+func (pcc *PromoCodeCreate) sqlSave(ctx context.Context) (*PromoCode, error) {
+	_node, _spec := pcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (pcc *PromoCodeCreate) createSpec() (*PromoCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PromoCode{config: pcc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: promocode.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: promocode.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = pcc.conflict
+	if value, ok := pcc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: promocode.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := pcc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: promocode.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if value, ok := pcc.mutation.Code(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promocode.FieldCode,
+		})
+		_node.Code = value
+	}
+	if value, ok := pcc.mutation.Description(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promocode.FieldDescription,
+		})
+		_node.Description = value
+	}
+	if value, ok := pcc.mutation.DiscountType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promocode.FieldDiscountType,
+		})
+		_node.DiscountType = value
+	}
+	if value, ok := pcc.mutation.DiscountValue(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promocode.FieldDiscountValue,
+		})
+		_node.DiscountValue = value
+	}
+	if value, ok := pcc.mutation.Currency(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promocode.FieldCurrency,
+		})
+		_node.Currency = value
+	}
+	if value, ok := pcc.mutation.ValidFrom(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: promocode.FieldValidFrom,
+		})
+		_node.ValidFrom = &value
+	}
+	if value, ok := pcc.mutation.ValidUntil(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: promocode.FieldValidUntil,
+		})
+		_node.ValidUntil = &value
+	}
+	if value, ok := pcc.mutation.MaxRedemptions(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promocode.FieldMaxRedemptions,
+		})
+		_node.MaxRedemptions = &value
+	}
+	if value, ok := pcc.mutation.MaxRedemptionsPerCustomer(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promocode.FieldMaxRedemptionsPerCustomer,
+		})
+		_node.MaxRedemptionsPerCustomer = &value
+	}
+	if value, ok := pcc.mutation.ResourceIds(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: promocode.FieldResourceIds,
+		})
+		_node.ResourceIds = value
+	}
+	if nodes := pcc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   promocode.OrganizationTable,
+			Columns: []string{promocode.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationId = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pcc.mutation.RedemptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   promocode.RedemptionsTable,
+			Columns: []string{promocode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: promoredemption.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PromoCode.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PromoCodeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pcc *PromoCodeCreate) OnConflict(opts ...sql.ConflictOption) *PromoCodeUpsertOne {
+	pcc.conflict = opts
+	return &PromoCodeUpsertOne{
+		create: pcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PromoCode.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pcc *PromoCodeCreate) OnConflictColumns(columns ...string) *PromoCodeUpsertOne {
+	pcc.conflict = append(pcc.conflict, sql.ConflictColumns(columns...))
+	return &PromoCodeUpsertOne{
+		create: pcc,
+	}
+}
+
+type (
+	// PromoCodeUpsertOne is the builder for "upsert"-ing
+	//  one PromoCode node.
+	PromoCodeUpsertOne struct {
+		create *PromoCodeCreate
+	}
+
+	// PromoCodeUpsert is the "OnConflict" setter.
+	PromoCodeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *PromoCodeUpsert) SetCreatedAt(v time.Time) *PromoCodeUpsert {
+	u.Set(promocode.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateCreatedAt() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *PromoCodeUpsert) SetUpdatedAt(v time.Time) *PromoCodeUpsert {
+	u.Set(promocode.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateUpdatedAt() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldUpdatedAt)
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *PromoCodeUpsert) SetCode(v string) *PromoCodeUpsert {
+	u.Set(promocode.FieldCode, v)
+	return u
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateCode() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldCode)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *PromoCodeUpsert) SetDescription(v string) *PromoCodeUpsert {
+	u.Set(promocode.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateDescription() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PromoCodeUpsert) ClearDescription() *PromoCodeUpsert {
+	u.SetNull(promocode.FieldDescription)
+	return u
+}
+
+// SetDiscountType sets the "discountType" field.
+func (u *PromoCodeUpsert) SetDiscountType(v string) *PromoCodeUpsert {
+	u.Set(promocode.FieldDiscountType, v)
+	return u
+}
+
+// UpdateDiscountType sets the "discountType" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateDiscountType() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldDiscountType)
+	return u
+}
+
+// SetDiscountValue sets the "discountValue" field.
+func (u *PromoCodeUpsert) SetDiscountValue(v int) *PromoCodeUpsert {
+	u.Set(promocode.FieldDiscountValue, v)
+	return u
+}
+
+// UpdateDiscountValue sets the "discountValue" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateDiscountValue() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldDiscountValue)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *PromoCodeUpsert) SetCurrency(v string) *PromoCodeUpsert {
+	u.Set(promocode.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateCurrency() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldCurrency)
+	return u
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (u *PromoCodeUpsert) ClearCurrency() *PromoCodeUpsert {
+	u.SetNull(promocode.FieldCurrency)
+	return u
+}
+
+// SetValidFrom sets the "validFrom" field.
+func (u *PromoCodeUpsert) SetValidFrom(v time.Time) *PromoCodeUpsert {
+	u.Set(promocode.FieldValidFrom, v)
+	return u
+}
+
+// UpdateValidFrom sets the "validFrom" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateValidFrom() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldValidFrom)
+	return u
+}
+
+// ClearValidFrom clears the value of the "validFrom" field.
+func (u *PromoCodeUpsert) ClearValidFrom() *PromoCodeUpsert {
+	u.SetNull(promocode.FieldValidFrom)
+	return u
+}
+
+// SetValidUntil sets the "validUntil" field.
+func (u *PromoCodeUpsert) SetValidUntil(v time.Time) *PromoCodeUpsert {
+	u.Set(promocode.FieldValidUntil, v)
+	return u
+}
+
+// UpdateValidUntil sets the "validUntil" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateValidUntil() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldValidUntil)
+	return u
+}
+
+// ClearValidUntil clears the value of the "validUntil" field.
+func (u *PromoCodeUpsert) ClearValidUntil() *PromoCodeUpsert {
+	u.SetNull(promocode.FieldValidUntil)
+	return u
+}
+
+// SetMaxRedemptions sets the "maxRedemptions" field.
+func (u *PromoCodeUpsert) SetMaxRedemptions(v int) *PromoCodeUpsert {
+	u.Set(promocode.FieldMaxRedemptions, v)
+	return u
+}
+
+// UpdateMaxRedemptions sets the "maxRedemptions" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateMaxRedemptions() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldMaxRedemptions)
+	return u
+}
+
+// ClearMaxRedemptions clears the value of the "maxRedemptions" field.
+func (u *PromoCodeUpsert) ClearMaxRedemptions() *PromoCodeUpsert {
+	u.SetNull(promocode.FieldMaxRedemptions)
+	return u
+}
+
+// SetMaxRedemptionsPerCustomer sets the "maxRedemptionsPerCustomer" field.
+func (u *PromoCodeUpsert) SetMaxRedemptionsPerCustomer(v int) *PromoCodeUpsert {
+	u.Set(promocode.FieldMaxRedemptionsPerCustomer, v)
+	return u
+}
+
+// UpdateMaxRedemptionsPerCustomer sets the "maxRedemptionsPerCustomer" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateMaxRedemptionsPerCustomer() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldMaxRedemptionsPerCustomer)
+	return u
+}
+
+// ClearMaxRedemptionsPerCustomer clears the value of the "maxRedemptionsPerCustomer" field.
+func (u *PromoCodeUpsert) ClearMaxRedemptionsPerCustomer() *PromoCodeUpsert {
+	u.SetNull(promocode.FieldMaxRedemptionsPerCustomer)
+	return u
+}
+
+// SetResourceIds sets the "resourceIds" field.
+func (u *PromoCodeUpsert) SetResourceIds(v []int) *PromoCodeUpsert {
+	u.Set(promocode.FieldResourceIds, v)
+	return u
+}
+
+// UpdateResourceIds sets the "resourceIds" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateResourceIds() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldResourceIds)
+	return u
+}
+
+// ClearResourceIds clears the value of the "resourceIds" field.
+func (u *PromoCodeUpsert) ClearResourceIds() *PromoCodeUpsert {
+	u.SetNull(promocode.FieldResourceIds)
+	return u
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (u *PromoCodeUpsert) SetOrganizationId(v int) *PromoCodeUpsert {
+	u.Set(promocode.FieldOrganizationId, v)
+	return u
+}
+
+// UpdateOrganizationId sets the "organizationId" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateOrganizationId() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldOrganizationId)
+	return u
+}
+
+// UpdateNewValues updates the fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.PromoCode.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PromoCodeUpsertOne) UpdateNewValues() *PromoCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PromoCode.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PromoCodeUpsertOne) Ignore() *PromoCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PromoCodeUpsertOne) DoNothing() *PromoCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PromoCodeCreate.OnConflict
+// documentation for more info.
+func (u *PromoCodeUpsertOne) Update(set func(*PromoCodeUpsert)) *PromoCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PromoCodeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *PromoCodeUpsertOne) SetCreatedAt(v time.Time) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateCreatedAt() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *PromoCodeUpsertOne) SetUpdatedAt(v time.Time) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateUpdatedAt() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *PromoCodeUpsertOne) SetCode(v string) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateCode() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *PromoCodeUpsertOne) SetDescription(v string) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateDescription() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PromoCodeUpsertOne) ClearDescription() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetDiscountType sets the "discountType" field.
+func (u *PromoCodeUpsertOne) SetDiscountType(v string) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetDiscountType(v)
+	})
+}
+
+// UpdateDiscountType sets the "discountType" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateDiscountType() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateDiscountType()
+	})
+}
+
+// SetDiscountValue sets the "discountValue" field.
+func (u *PromoCodeUpsertOne) SetDiscountValue(v int) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetDiscountValue(v)
+	})
+}
+
+// UpdateDiscountValue sets the "discountValue" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateDiscountValue() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateDiscountValue()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *PromoCodeUpsertOne) SetCurrency(v string) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateCurrency() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (u *PromoCodeUpsertOne) ClearCurrency() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearCurrency()
+	})
+}
+
+// SetValidFrom sets the "validFrom" field.
+func (u *PromoCodeUpsertOne) SetValidFrom(v time.Time) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetValidFrom(v)
+	})
+}
+
+// UpdateValidFrom sets the "validFrom" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateValidFrom() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateValidFrom()
+	})
+}
+
+// ClearValidFrom clears the value of the "validFrom" field.
+func (u *PromoCodeUpsertOne) ClearValidFrom() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearValidFrom()
+	})
+}
+
+// SetValidUntil sets the "validUntil" field.
+func (u *PromoCodeUpsertOne) SetValidUntil(v time.Time) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetValidUntil(v)
+	})
+}
+
+// UpdateValidUntil sets the "validUntil" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateValidUntil() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateValidUntil()
+	})
+}
+
+// ClearValidUntil clears the value of the "validUntil" field.
+func (u *PromoCodeUpsertOne) ClearValidUntil() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearValidUntil()
+	})
+}
+
+// SetMaxRedemptions sets the "maxRedemptions" field.
+func (u *PromoCodeUpsertOne) SetMaxRedemptions(v int) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetMaxRedemptions(v)
+	})
+}
+
+// UpdateMaxRedemptions sets the "maxRedemptions" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateMaxRedemptions() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateMaxRedemptions()
+	})
+}
+
+// ClearMaxRedemptions clears the value of the "maxRedemptions" field.
+func (u *PromoCodeUpsertOne) ClearMaxRedemptions() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearMaxRedemptions()
+	})
+}
+
+// SetMaxRedemptionsPerCustomer sets the "maxRedemptionsPerCustomer" field.
+func (u *PromoCodeUpsertOne) SetMaxRedemptionsPerCustomer(v int) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetMaxRedemptionsPerCustomer(v)
+	})
+}
+
+// UpdateMaxRedemptionsPerCustomer sets the "maxRedemptionsPerCustomer" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateMaxRedemptionsPerCustomer() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateMaxRedemptionsPerCustomer()
+	})
+}
+
+// ClearMaxRedemptionsPerCustomer clears the value of the "maxRedemptionsPerCustomer" field.
+func (u *PromoCodeUpsertOne) ClearMaxRedemptionsPerCustomer() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearMaxRedemptionsPerCustomer()
+	})
+}
+
+// SetResourceIds sets the "resourceIds" field.
+func (u *PromoCodeUpsertOne) SetResourceIds(v []int) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetResourceIds(v)
+	})
+}
+
+// UpdateResourceIds sets the "resourceIds" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateResourceIds() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateResourceIds()
+	})
+}
+
+// ClearResourceIds clears the value of the "resourceIds" field.
+func (u *PromoCodeUpsertOne) ClearResourceIds() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearResourceIds()
+	})
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (u *PromoCodeUpsertOne) SetOrganizationId(v int) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetOrganizationId(v)
+	})
+}
+
+// UpdateOrganizationId sets the "organizationId" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateOrganizationId() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateOrganizationId()
+	})
+}
+
+// Exec executes the query.
+func (u *PromoCodeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PromoCodeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PromoCodeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PromoCodeUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PromoCodeUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PromoCodeCreateBulk is the builder for creating many PromoCode entities in bulk.
+type PromoCodeCreateBulk struct {
+	config
+	builders []*PromoCodeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PromoCode entities in the database.
+func (pccb *PromoCodeCreateBulk) Save(ctx context.Context) ([]*PromoCode, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(pccb.builders))
+	nodes := make([]*PromoCode, len(pccb.builders))
+	mutators := make([]Mutator, len(pccb.builders))
+	for i := range pccb.builders {
+		func(i int, root context.Context) {
+			builder := pccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PromoCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pccb *PromoCodeCreateBulk) SaveX(ctx context.Context) []*PromoCode {
+	v, err := pccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pccb *PromoCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := pccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pccb *PromoCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := pccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PromoCode.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PromoCodeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pccb *PromoCodeCreateBulk) OnConflict(opts ...sql.ConflictOption) *PromoCodeUpsertBulk {
+	pccb.conflict = opts
+	return &PromoCodeUpsertBulk{
+		create: pccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PromoCode.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pccb *PromoCodeCreateBulk) OnConflictColumns(columns ...string) *PromoCodeUpsertBulk {
+	pccb.conflict = append(pccb.conflict, sql.ConflictColumns(columns...))
+	return &PromoCodeUpsertBulk{
+		create: pccb,
+	}
+}
+
+// PromoCodeUpsertBulk is the builder for "upsert"-ing
+// a bulk of PromoCode nodes.
+type PromoCodeUpsertBulk struct {
+	create *PromoCodeCreateBulk
+}
+
+// UpdateNewValues updates the fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PromoCode.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PromoCodeUpsertBulk) UpdateNewValues() *PromoCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PromoCode.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PromoCodeUpsertBulk) Ignore() *PromoCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PromoCodeUpsertBulk) DoNothing() *PromoCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PromoCodeCreateBulk.OnConflict
+// documentation for more info.
+func (u *PromoCodeUpsertBulk) Update(set func(*PromoCodeUpsert)) *PromoCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PromoCodeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *PromoCodeUpsertBulk) SetCreatedAt(v time.Time) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateCreatedAt() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *PromoCodeUpsertBulk) SetUpdatedAt(v time.Time) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateUpdatedAt() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *PromoCodeUpsertBulk) SetCode(v string) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateCode() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *PromoCodeUpsertBulk) SetDescription(v string) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateDescription() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PromoCodeUpsertBulk) ClearDescription() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetDiscountType sets the "discountType" field.
+func (u *PromoCodeUpsertBulk) SetDiscountType(v string) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetDiscountType(v)
+	})
+}
+
+// UpdateDiscountType sets the "discountType" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateDiscountType() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateDiscountType()
+	})
+}
+
+// SetDiscountValue sets the "discountValue" field.
+func (u *PromoCodeUpsertBulk) SetDiscountValue(v int) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetDiscountValue(v)
+	})
+}
+
+// UpdateDiscountValue sets the "discountValue" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateDiscountValue() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateDiscountValue()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *PromoCodeUpsertBulk) SetCurrency(v string) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateCurrency() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (u *PromoCodeUpsertBulk) ClearCurrency() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearCurrency()
+	})
+}
+
+// SetValidFrom sets the "validFrom" field.
+func (u *PromoCodeUpsertBulk) SetValidFrom(v time.Time) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetValidFrom(v)
+	})
+}
+
+// UpdateValidFrom sets the "validFrom" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateValidFrom() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateValidFrom()
+	})
+}
+
+// ClearValidFrom clears the value of the "validFrom" field.
+func (u *PromoCodeUpsertBulk) ClearValidFrom() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearValidFrom()
+	})
+}
+
+// SetValidUntil sets the "validUntil" field.
+func (u *PromoCodeUpsertBulk) SetValidUntil(v time.Time) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetValidUntil(v)
+	})
+}
+
+// UpdateValidUntil sets the "validUntil" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateValidUntil() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateValidUntil()
+	})
+}
+
+// ClearValidUntil clears the value of the "validUntil" field.
+func (u *PromoCodeUpsertBulk) ClearValidUntil() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearValidUntil()
+	})
+}
+
+// SetMaxRedemptions sets the "maxRedemptions" field.
+func (u *PromoCodeUpsertBulk) SetMaxRedemptions(v int) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetMaxRedemptions(v)
+	})
+}
+
+// UpdateMaxRedemptions sets the "maxRedemptions" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateMaxRedemptions() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateMaxRedemptions()
+	})
+}
+
+// ClearMaxRedemptions clears the value of the "maxRedemptions" field.
+func (u *PromoCodeUpsertBulk) ClearMaxRedemptions() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearMaxRedemptions()
+	})
+}
+
+// SetMaxRedemptionsPerCustomer sets the "maxRedemptionsPerCustomer" field.
+func (u *PromoCodeUpsertBulk) SetMaxRedemptionsPerCustomer(v int) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetMaxRedemptionsPerCustomer(v)
+	})
+}
+
+// UpdateMaxRedemptionsPerCustomer sets the "maxRedemptionsPerCustomer" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateMaxRedemptionsPerCustomer() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateMaxRedemptionsPerCustomer()
+	})
+}
+
+// ClearMaxRedemptionsPerCustomer clears the value of the "maxRedemptionsPerCustomer" field.
+func (u *PromoCodeUpsertBulk) ClearMaxRedemptionsPerCustomer() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearMaxRedemptionsPerCustomer()
+	})
+}
+
+// SetResourceIds sets the "resourceIds" field.
+func (u *PromoCodeUpsertBulk) SetResourceIds(v []int) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetResourceIds(v)
+	})
+}
+
+// UpdateResourceIds sets the "resourceIds" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateResourceIds() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateResourceIds()
+	})
+}
+
+// ClearResourceIds clears the value of the "resourceIds" field.
+func (u *PromoCodeUpsertBulk) ClearResourceIds() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearResourceIds()
+	})
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (u *PromoCodeUpsertBulk) SetOrganizationId(v int) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetOrganizationId(v)
+	})
+}
+
+// UpdateOrganizationId sets the "organizationId" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateOrganizationId() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateOrganizationId()
+	})
+}
+
+// Exec executes the query.
+func (u *PromoCodeUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PromoCodeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PromoCodeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PromoCodeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
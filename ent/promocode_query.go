@@ -0,0 +1,1089 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmesh/booking/ent/organization"
+	"github.com/openmesh/booking/ent/predicate"
+	"github.com/openmesh/booking/ent/promocode"
+	"github.com/openmesh/booking/ent/promoredemption"
+)
+
+// PromoCodeQuery is the builder for querying PromoCode entities.
+type PromoCodeQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.PromoCode
+	// eager-loading edges.
+	withOrganization *OrganizationQuery
+	withRedemptions  *PromoRedemptionQuery
+	modifiers        []func(s *sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PromoCodeQuery builder.
+func (pcq *PromoCodeQuery) Where(ps ...predicate.PromoCode) *PromoCodeQuery {
+	pcq.predicates = append(pcq.predicates, ps...)
+	return pcq
+}
+
+// Limit adds a limit step to the query.
+func (pcq *PromoCodeQuery) Limit(limit int) *PromoCodeQuery {
+	pcq.limit = &limit
+	return pcq
+}
+
+// Offset adds an offset step to the query.
+func (pcq *PromoCodeQuery) Offset(offset int) *PromoCodeQuery {
+	pcq.offset = &offset
+	return pcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pcq *PromoCodeQuery) Unique(unique bool) *PromoCodeQuery {
+	pcq.unique = &unique
+	return pcq
+}
+
+// Order adds an order step to the query.
+func (pcq *PromoCodeQuery) Order(o ...OrderFunc) *PromoCodeQuery {
+	pcq.order = append(pcq.order, o...)
+	return pcq
+}
+
+// QueryOrganization chains the current query on the "organization" edge.
+func (pcq *PromoCodeQuery) QueryOrganization() *OrganizationQuery {
+	query := &OrganizationQuery{config: pcq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(promocode.Table, promocode.FieldID, selector),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, promocode.OrganizationTable, promocode.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRedemptions chains the current query on the "redemptions" edge.
+func (pcq *PromoCodeQuery) QueryRedemptions() *PromoRedemptionQuery {
+	query := &PromoRedemptionQuery{config: pcq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(promocode.Table, promocode.FieldID, selector),
+			sqlgraph.To(promoredemption.Table, promoredemption.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, promocode.RedemptionsTable, promocode.RedemptionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PromoCode entity from the query.
+// Returns a *NotFoundError when no PromoCode was found.
+func (pcq *PromoCodeQuery) First(ctx context.Context) (*PromoCode, error) {
+	nodes, err := pcq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{promocode.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pcq *PromoCodeQuery) FirstX(ctx context.Context) *PromoCode {
+	node, err := pcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PromoCode ID from the query.
+// Returns a *NotFoundError when no PromoCode ID was found.
+func (pcq *PromoCodeQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pcq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{promocode.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pcq *PromoCodeQuery) FirstIDX(ctx context.Context) int {
+	id, err := pcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PromoCode entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one PromoCode entity is not found.
+// Returns a *NotFoundError when no PromoCode entities are found.
+func (pcq *PromoCodeQuery) Only(ctx context.Context) (*PromoCode, error) {
+	nodes, err := pcq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{promocode.Label}
+	default:
+		return nil, &NotSingularError{promocode.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pcq *PromoCodeQuery) OnlyX(ctx context.Context) *PromoCode {
+	node, err := pcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PromoCode ID in the query.
+// Returns a *NotSingularError when exactly one PromoCode ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (pcq *PromoCodeQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pcq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{promocode.Label}
+	default:
+		err = &NotSingularError{promocode.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pcq *PromoCodeQuery) OnlyIDX(ctx context.Context) int {
+	id, err := pcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PromoCodes.
+func (pcq *PromoCodeQuery) All(ctx context.Context) ([]*PromoCode, error) {
+	if err := pcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return pcq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pcq *PromoCodeQuery) AllX(ctx context.Context) []*PromoCode {
+	nodes, err := pcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PromoCode IDs.
+func (pcq *PromoCodeQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := pcq.Select(promocode.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pcq *PromoCodeQuery) IDsX(ctx context.Context) []int {
+	ids, err := pcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pcq *PromoCodeQuery) Count(ctx context.Context) (int, error) {
+	if err := pcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return pcq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pcq *PromoCodeQuery) CountX(ctx context.Context) int {
+	count, err := pcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pcq *PromoCodeQuery) Exist(ctx context.Context) (bool, error) {
+	if err := pcq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return pcq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pcq *PromoCodeQuery) ExistX(ctx context.Context) bool {
+	exist, err := pcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PromoCodeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pcq *PromoCodeQuery) Clone() *PromoCodeQuery {
+	if pcq == nil {
+		return nil
+	}
+	return &PromoCodeQuery{
+		config:           pcq.config,
+		limit:            pcq.limit,
+		offset:           pcq.offset,
+		order:            append([]OrderFunc{}, pcq.order...),
+		predicates:       append([]predicate.PromoCode{}, pcq.predicates...),
+		withOrganization: pcq.withOrganization.Clone(),
+		withRedemptions:  pcq.withRedemptions.Clone(),
+		// clone intermediate query.
+		sql:  pcq.sql.Clone(),
+		path: pcq.path,
+	}
+}
+
+// WithOrganization tells the query-builder to eager-load the nodes that are connected to
+// the "organization" edge. The optional arguments are used to configure the query builder of the edge.
+func (pcq *PromoCodeQuery) WithOrganization(opts ...func(*OrganizationQuery)) *PromoCodeQuery {
+	query := &OrganizationQuery{config: pcq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	pcq.withOrganization = query
+	return pcq
+}
+
+// WithRedemptions tells the query-builder to eager-load the nodes that are connected to
+// the "redemptions" edge. The optional arguments are used to configure the query builder of the edge.
+func (pcq *PromoCodeQuery) WithRedemptions(opts ...func(*PromoRedemptionQuery)) *PromoCodeQuery {
+	query := &PromoRedemptionQuery{config: pcq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	pcq.withRedemptions = query
+	return pcq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"createdAt,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PromoCode.Query().
+//		GroupBy(promocode.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (pcq *PromoCodeQuery) GroupBy(field string, fields ...string) *PromoCodeGroupBy {
+	group := &PromoCodeGroupBy{config: pcq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := pcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return pcq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"createdAt,omitempty"`
+//	}
+//
+//	client.PromoCode.Query().
+//		Select(promocode.FieldCreatedAt).
+//		Scan(ctx, &v)
+//
+func (pcq *PromoCodeQuery) Select(fields ...string) *PromoCodeSelect {
+	pcq.fields = append(pcq.fields, fields...)
+	return &PromoCodeSelect{PromoCodeQuery: pcq}
+}
+
+func (pcq *PromoCodeQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range pcq.fields {
+		if !promocode.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pcq.path != nil {
+		prev, err := pcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pcq.sql = prev
+	}
+	if promocode.Policy == nil {
+		return errors.New("ent: uninitialized promocode.Policy (forgotten import ent/runtime?)")
+	}
+	if err := promocode.Policy.EvalQuery(ctx, pcq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (pcq *PromoCodeQuery) sqlAll(ctx context.Context) ([]*PromoCode, error) {
+	var (
+		nodes       = []*PromoCode{}
+		_spec       = pcq.querySpec()
+		loadedTypes = [2]bool{
+			pcq.withOrganization != nil,
+			pcq.withRedemptions != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &PromoCode{config: pcq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(pcq.modifiers) > 0 {
+		_spec.Modifiers = pcq.modifiers
+	}
+	if err := sqlgraph.QueryNodes(ctx, pcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := pcq.withOrganization; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*PromoCode)
+		for i := range nodes {
+			fk := nodes[i].OrganizationId
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(organization.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "organizationId" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Organization = n
+			}
+		}
+	}
+
+	if query := pcq.withRedemptions; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*PromoCode)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Redemptions = []*PromoRedemption{}
+		}
+		query.Where(predicate.PromoRedemption(func(s *sql.Selector) {
+			s.Where(sql.InValues(promocode.RedemptionsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.PromoCodeId
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "promoCodeId" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Redemptions = append(node.Edges.Redemptions, n)
+		}
+	}
+
+	return nodes, nil
+}
+
+func (pcq *PromoCodeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pcq.querySpec()
+	if len(pcq.modifiers) > 0 {
+		_spec.Modifiers = pcq.modifiers
+	}
+	return sqlgraph.CountNodes(ctx, pcq.driver, _spec)
+}
+
+func (pcq *PromoCodeQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := pcq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (pcq *PromoCodeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   promocode.Table,
+			Columns: promocode.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: promocode.FieldID,
+			},
+		},
+		From:   pcq.sql,
+		Unique: true,
+	}
+	if unique := pcq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := pcq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, promocode.FieldID)
+		for i := range fields {
+			if fields[i] != promocode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pcq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pcq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pcq *PromoCodeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pcq.driver.Dialect())
+	t1 := builder.Table(promocode.Table)
+	columns := pcq.fields
+	if len(columns) == 0 {
+		columns = promocode.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pcq.sql != nil {
+		selector = pcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	for _, m := range pcq.modifiers {
+		m(selector)
+	}
+	for _, p := range pcq.predicates {
+		p(selector)
+	}
+	for _, p := range pcq.order {
+		p(selector)
+	}
+	if offset := pcq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pcq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (pcq *PromoCodeQuery) ForUpdate(opts ...sql.LockOption) *PromoCodeQuery {
+	if pcq.driver.Dialect() == dialect.Postgres {
+		pcq.Unique(false)
+	}
+	pcq.modifiers = append(pcq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return pcq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (pcq *PromoCodeQuery) ForShare(opts ...sql.LockOption) *PromoCodeQuery {
+	if pcq.driver.Dialect() == dialect.Postgres {
+		pcq.Unique(false)
+	}
+	pcq.modifiers = append(pcq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return pcq
+}
+
+// PromoCodeGroupBy is the group-by builder for PromoCode entities.
+type PromoCodeGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pcgb *PromoCodeGroupBy) Aggregate(fns ...AggregateFunc) *PromoCodeGroupBy {
+	pcgb.fns = append(pcgb.fns, fns...)
+	return pcgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (pcgb *PromoCodeGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := pcgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	pcgb.sql = query
+	return pcgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (pcgb *PromoCodeGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := pcgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (pcgb *PromoCodeGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(pcgb.fields) > 1 {
+		return nil, errors.New("ent: PromoCodeGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := pcgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (pcgb *PromoCodeGroupBy) StringsX(ctx context.Context) []string {
+	v, err := pcgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (pcgb *PromoCodeGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = pcgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{promocode.Label}
+	default:
+		err = fmt.Errorf("ent: PromoCodeGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (pcgb *PromoCodeGroupBy) StringX(ctx context.Context) string {
+	v, err := pcgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (pcgb *PromoCodeGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(pcgb.fields) > 1 {
+		return nil, errors.New("ent: PromoCodeGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := pcgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (pcgb *PromoCodeGroupBy) IntsX(ctx context.Context) []int {
+	v, err := pcgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (pcgb *PromoCodeGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = pcgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{promocode.Label}
+	default:
+		err = fmt.Errorf("ent: PromoCodeGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (pcgb *PromoCodeGroupBy) IntX(ctx context.Context) int {
+	v, err := pcgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (pcgb *PromoCodeGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(pcgb.fields) > 1 {
+		return nil, errors.New("ent: PromoCodeGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := pcgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (pcgb *PromoCodeGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := pcgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (pcgb *PromoCodeGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = pcgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{promocode.Label}
+	default:
+		err = fmt.Errorf("ent: PromoCodeGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (pcgb *PromoCodeGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := pcgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (pcgb *PromoCodeGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(pcgb.fields) > 1 {
+		return nil, errors.New("ent: PromoCodeGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := pcgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (pcgb *PromoCodeGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := pcgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (pcgb *PromoCodeGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = pcgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{promocode.Label}
+	default:
+		err = fmt.Errorf("ent: PromoCodeGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (pcgb *PromoCodeGroupBy) BoolX(ctx context.Context) bool {
+	v, err := pcgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (pcgb *PromoCodeGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range pcgb.fields {
+		if !promocode.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := pcgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pcgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (pcgb *PromoCodeGroupBy) sqlQuery() *sql.Selector {
+	selector := pcgb.sql.Select()
+	aggregation := make([]string, 0, len(pcgb.fns))
+	for _, fn := range pcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(pcgb.fields)+len(pcgb.fns))
+		for _, f := range pcgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		for _, c := range aggregation {
+			columns = append(columns, c)
+		}
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(pcgb.fields...)...)
+}
+
+// PromoCodeSelect is the builder for selecting fields of PromoCode entities.
+type PromoCodeSelect struct {
+	*PromoCodeQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pcs *PromoCodeSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := pcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	pcs.sql = pcs.PromoCodeQuery.sqlQuery(ctx)
+	return pcs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (pcs *PromoCodeSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := pcs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (pcs *PromoCodeSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(pcs.fields) > 1 {
+		return nil, errors.New("ent: PromoCodeSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := pcs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (pcs *PromoCodeSelect) StringsX(ctx context.Context) []string {
+	v, err := pcs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (pcs *PromoCodeSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = pcs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{promocode.Label}
+	default:
+		err = fmt.Errorf("ent: PromoCodeSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (pcs *PromoCodeSelect) StringX(ctx context.Context) string {
+	v, err := pcs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (pcs *PromoCodeSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(pcs.fields) > 1 {
+		return nil, errors.New("ent: PromoCodeSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := pcs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (pcs *PromoCodeSelect) IntsX(ctx context.Context) []int {
+	v, err := pcs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (pcs *PromoCodeSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = pcs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{promocode.Label}
+	default:
+		err = fmt.Errorf("ent: PromoCodeSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (pcs *PromoCodeSelect) IntX(ctx context.Context) int {
+	v, err := pcs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (pcs *PromoCodeSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(pcs.fields) > 1 {
+		return nil, errors.New("ent: PromoCodeSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := pcs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (pcs *PromoCodeSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := pcs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (pcs *PromoCodeSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = pcs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{promocode.Label}
+	default:
+		err = fmt.Errorf("ent: PromoCodeSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (pcs *PromoCodeSelect) Float64X(ctx context.Context) float64 {
+	v, err := pcs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (pcs *PromoCodeSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(pcs.fields) > 1 {
+		return nil, errors.New("ent: PromoCodeSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := pcs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (pcs *PromoCodeSelect) BoolsX(ctx context.Context) []bool {
+	v, err := pcs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (pcs *PromoCodeSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = pcs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{promocode.Label}
+	default:
+		err = fmt.Errorf("ent: PromoCodeSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (pcs *PromoCodeSelect) BoolX(ctx context.Context) bool {
+	v, err := pcs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (pcs *PromoCodeSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := pcs.sql.Query()
+	if err := pcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,1230 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmesh/booking/ent/organization"
+	"github.com/openmesh/booking/ent/predicate"
+	"github.com/openmesh/booking/ent/promocode"
+	"github.com/openmesh/booking/ent/promoredemption"
+)
+
+// PromoCodeUpdate is the builder for updating PromoCode entities.
+type PromoCodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PromoCodeMutation
+}
+
+// Where appends a list predicates to the PromoCodeUpdate builder.
+func (pcu *PromoCodeUpdate) Where(ps ...predicate.PromoCode) *PromoCodeUpdate {
+	pcu.mutation.Where(ps...)
+	return pcu
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (pcu *PromoCodeUpdate) SetUpdatedAt(t time.Time) *PromoCodeUpdate {
+	pcu.mutation.SetUpdatedAt(t)
+	return pcu
+}
+
+// SetCode sets the "code" field.
+func (pcu *PromoCodeUpdate) SetCode(s string) *PromoCodeUpdate {
+	pcu.mutation.SetCode(s)
+	return pcu
+}
+
+// SetDescription sets the "description" field.
+func (pcu *PromoCodeUpdate) SetDescription(s string) *PromoCodeUpdate {
+	pcu.mutation.SetDescription(s)
+	return pcu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (pcu *PromoCodeUpdate) SetNillableDescription(s *string) *PromoCodeUpdate {
+	if s != nil {
+		pcu.SetDescription(*s)
+	}
+	return pcu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (pcu *PromoCodeUpdate) ClearDescription() *PromoCodeUpdate {
+	pcu.mutation.ClearDescription()
+	return pcu
+}
+
+// SetDiscountType sets the "discountType" field.
+func (pcu *PromoCodeUpdate) SetDiscountType(s string) *PromoCodeUpdate {
+	pcu.mutation.SetDiscountType(s)
+	return pcu
+}
+
+// SetDiscountValue sets the "discountValue" field.
+func (pcu *PromoCodeUpdate) SetDiscountValue(i int) *PromoCodeUpdate {
+	pcu.mutation.ResetDiscountValue()
+	pcu.mutation.SetDiscountValue(i)
+	return pcu
+}
+
+// AddDiscountValue adds i to the "discountValue" field.
+func (pcu *PromoCodeUpdate) AddDiscountValue(i int) *PromoCodeUpdate {
+	pcu.mutation.AddDiscountValue(i)
+	return pcu
+}
+
+// SetCurrency sets the "currency" field.
+func (pcu *PromoCodeUpdate) SetCurrency(s string) *PromoCodeUpdate {
+	pcu.mutation.SetCurrency(s)
+	return pcu
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (pcu *PromoCodeUpdate) SetNillableCurrency(s *string) *PromoCodeUpdate {
+	if s != nil {
+		pcu.SetCurrency(*s)
+	}
+	return pcu
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (pcu *PromoCodeUpdate) ClearCurrency() *PromoCodeUpdate {
+	pcu.mutation.ClearCurrency()
+	return pcu
+}
+
+// SetValidFrom sets the "validFrom" field.
+func (pcu *PromoCodeUpdate) SetValidFrom(t time.Time) *PromoCodeUpdate {
+	pcu.mutation.SetValidFrom(t)
+	return pcu
+}
+
+// SetNillableValidFrom sets the "validFrom" field if the given value is not nil.
+func (pcu *PromoCodeUpdate) SetNillableValidFrom(t *time.Time) *PromoCodeUpdate {
+	if t != nil {
+		pcu.SetValidFrom(*t)
+	}
+	return pcu
+}
+
+// ClearValidFrom clears the value of the "validFrom" field.
+func (pcu *PromoCodeUpdate) ClearValidFrom() *PromoCodeUpdate {
+	pcu.mutation.ClearValidFrom()
+	return pcu
+}
+
+// SetValidUntil sets the "validUntil" field.
+func (pcu *PromoCodeUpdate) SetValidUntil(t time.Time) *PromoCodeUpdate {
+	pcu.mutation.SetValidUntil(t)
+	return pcu
+}
+
+// SetNillableValidUntil sets the "validUntil" field if the given value is not nil.
+func (pcu *PromoCodeUpdate) SetNillableValidUntil(t *time.Time) *PromoCodeUpdate {
+	if t != nil {
+		pcu.SetValidUntil(*t)
+	}
+	return pcu
+}
+
+// ClearValidUntil clears the value of the "validUntil" field.
+func (pcu *PromoCodeUpdate) ClearValidUntil() *PromoCodeUpdate {
+	pcu.mutation.ClearValidUntil()
+	return pcu
+}
+
+// SetMaxRedemptions sets the "maxRedemptions" field.
+func (pcu *PromoCodeUpdate) SetMaxRedemptions(i int) *PromoCodeUpdate {
+	pcu.mutation.ResetMaxRedemptions()
+	pcu.mutation.SetMaxRedemptions(i)
+	return pcu
+}
+
+// SetNillableMaxRedemptions sets the "maxRedemptions" field if the given value is not nil.
+func (pcu *PromoCodeUpdate) SetNillableMaxRedemptions(i *int) *PromoCodeUpdate {
+	if i != nil {
+		pcu.SetMaxRedemptions(*i)
+	}
+	return pcu
+}
+
+// AddMaxRedemptions adds i to the "maxRedemptions" field.
+func (pcu *PromoCodeUpdate) AddMaxRedemptions(i int) *PromoCodeUpdate {
+	pcu.mutation.AddMaxRedemptions(i)
+	return pcu
+}
+
+// ClearMaxRedemptions clears the value of the "maxRedemptions" field.
+func (pcu *PromoCodeUpdate) ClearMaxRedemptions() *PromoCodeUpdate {
+	pcu.mutation.ClearMaxRedemptions()
+	return pcu
+}
+
+// SetMaxRedemptionsPerCustomer sets the "maxRedemptionsPerCustomer" field.
+func (pcu *PromoCodeUpdate) SetMaxRedemptionsPerCustomer(i int) *PromoCodeUpdate {
+	pcu.mutation.ResetMaxRedemptionsPerCustomer()
+	pcu.mutation.SetMaxRedemptionsPerCustomer(i)
+	return pcu
+}
+
+// SetNillableMaxRedemptionsPerCustomer sets the "maxRedemptionsPerCustomer" field if the given value is not nil.
+func (pcu *PromoCodeUpdate) SetNillableMaxRedemptionsPerCustomer(i *int) *PromoCodeUpdate {
+	if i != nil {
+		pcu.SetMaxRedemptionsPerCustomer(*i)
+	}
+	return pcu
+}
+
+// AddMaxRedemptionsPerCustomer adds i to the "maxRedemptionsPerCustomer" field.
+func (pcu *PromoCodeUpdate) AddMaxRedemptionsPerCustomer(i int) *PromoCodeUpdate {
+	pcu.mutation.AddMaxRedemptionsPerCustomer(i)
+	return pcu
+}
+
+// ClearMaxRedemptionsPerCustomer clears the value of the "maxRedemptionsPerCustomer" field.
+func (pcu *PromoCodeUpdate) ClearMaxRedemptionsPerCustomer() *PromoCodeUpdate {
+	pcu.mutation.ClearMaxRedemptionsPerCustomer()
+	return pcu
+}
+
+// SetResourceIds sets the "resourceIds" field.
+func (pcu *PromoCodeUpdate) SetResourceIds(i []int) *PromoCodeUpdate {
+	pcu.mutation.SetResourceIds(i)
+	return pcu
+}
+
+// ClearResourceIds clears the value of the "resourceIds" field.
+func (pcu *PromoCodeUpdate) ClearResourceIds() *PromoCodeUpdate {
+	pcu.mutation.ClearResourceIds()
+	return pcu
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (pcu *PromoCodeUpdate) SetOrganizationId(i int) *PromoCodeUpdate {
+	pcu.mutation.SetOrganizationId(i)
+	return pcu
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (pcu *PromoCodeUpdate) SetOrganizationID(id int) *PromoCodeUpdate {
+	pcu.mutation.SetOrganizationID(id)
+	return pcu
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (pcu *PromoCodeUpdate) SetOrganization(o *Organization) *PromoCodeUpdate {
+	return pcu.SetOrganizationID(o.ID)
+}
+
+// AddRedemptionIDs adds the "redemptions" edge to the PromoRedemption entity by IDs.
+func (pcu *PromoCodeUpdate) AddRedemptionIDs(ids ...int) *PromoCodeUpdate {
+	pcu.mutation.AddRedemptionIDs(ids...)
+	return pcu
+}
+
+// AddRedemptions adds the "redemptions" edges to the PromoRedemption entity.
+func (pcu *PromoCodeUpdate) AddRedemptions(p ...*PromoRedemption) *PromoCodeUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pcu.AddRedemptionIDs(ids...)
+}
+
+// Mutation returns the PromoCodeMutation object of the builder.
+func (pcu *PromoCodeUpdate) Mutation() *PromoCodeMutation {
+	return pcu.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (pcu *PromoCodeUpdate) ClearOrganization() *PromoCodeUpdate {
+	pcu.mutation.ClearOrganization()
+	return pcu
+}
+
+// ClearRedemptions clears all "redemptions" edges to the PromoRedemption entity.
+func (pcu *PromoCodeUpdate) ClearRedemptions() *PromoCodeUpdate {
+	pcu.mutation.ClearRedemptions()
+	return pcu
+}
+
+// RemoveRedemptionIDs removes the "redemptions" edge to PromoRedemption entities by IDs.
+func (pcu *PromoCodeUpdate) RemoveRedemptionIDs(ids ...int) *PromoCodeUpdate {
+	pcu.mutation.RemoveRedemptionIDs(ids...)
+	return pcu
+}
+
+// RemoveRedemptions removes "redemptions" edges to PromoRedemption entities.
+func (pcu *PromoCodeUpdate) RemoveRedemptions(p ...*PromoRedemption) *PromoCodeUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pcu.RemoveRedemptionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pcu *PromoCodeUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if err := pcu.defaults(); err != nil {
+		return 0, err
+	}
+	if len(pcu.hooks) == 0 {
+		if err = pcu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = pcu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PromoCodeMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pcu.check(); err != nil {
+				return 0, err
+			}
+			pcu.mutation = mutation
+			affected, err = pcu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(pcu.hooks) - 1; i >= 0; i-- {
+			if pcu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pcu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pcu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcu *PromoCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := pcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pcu *PromoCodeUpdate) Exec(ctx context.Context) error {
+	_, err := pcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcu *PromoCodeUpdate) ExecX(ctx context.Context) {
+	if err := pcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcu *PromoCodeUpdate) defaults() error {
+	if _, ok := pcu.mutation.UpdatedAt(); !ok {
+		if promocode.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized promocode.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := promocode.UpdateDefaultUpdatedAt()
+		pcu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcu *PromoCodeUpdate) check() error {
+	if _, ok := pcu.mutation.OrganizationID(); pcu.mutation.OrganizationCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"organization\"")
+	}
+	return nil
+}
+
+func (pcu *PromoCodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   promocode.Table,
+			Columns: promocode.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: promocode.FieldID,
+			},
+		},
+	}
+	if ps := pcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pcu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: promocode.FieldUpdatedAt,
+		})
+	}
+	if value, ok := pcu.mutation.Code(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promocode.FieldCode,
+		})
+	}
+	if value, ok := pcu.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promocode.FieldDescription,
+		})
+	}
+	if pcu.mutation.DescriptionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: promocode.FieldDescription,
+		})
+	}
+	if value, ok := pcu.mutation.DiscountType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promocode.FieldDiscountType,
+		})
+	}
+	if value, ok := pcu.mutation.DiscountValue(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promocode.FieldDiscountValue,
+		})
+	}
+	if value, ok := pcu.mutation.AddedDiscountValue(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promocode.FieldDiscountValue,
+		})
+	}
+	if value, ok := pcu.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promocode.FieldCurrency,
+		})
+	}
+	if pcu.mutation.CurrencyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: promocode.FieldCurrency,
+		})
+	}
+	if value, ok := pcu.mutation.ValidFrom(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: promocode.FieldValidFrom,
+		})
+	}
+	if pcu.mutation.ValidFromCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: promocode.FieldValidFrom,
+		})
+	}
+	if value, ok := pcu.mutation.ValidUntil(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: promocode.FieldValidUntil,
+		})
+	}
+	if pcu.mutation.ValidUntilCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: promocode.FieldValidUntil,
+		})
+	}
+	if value, ok := pcu.mutation.MaxRedemptions(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promocode.FieldMaxRedemptions,
+		})
+	}
+	if value, ok := pcu.mutation.AddedMaxRedemptions(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promocode.FieldMaxRedemptions,
+		})
+	}
+	if pcu.mutation.MaxRedemptionsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: promocode.FieldMaxRedemptions,
+		})
+	}
+	if value, ok := pcu.mutation.MaxRedemptionsPerCustomer(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promocode.FieldMaxRedemptionsPerCustomer,
+		})
+	}
+	if value, ok := pcu.mutation.AddedMaxRedemptionsPerCustomer(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promocode.FieldMaxRedemptionsPerCustomer,
+		})
+	}
+	if pcu.mutation.MaxRedemptionsPerCustomerCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: promocode.FieldMaxRedemptionsPerCustomer,
+		})
+	}
+	if value, ok := pcu.mutation.ResourceIds(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: promocode.FieldResourceIds,
+		})
+	}
+	if pcu.mutation.ResourceIdsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: promocode.FieldResourceIds,
+		})
+	}
+	if pcu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   promocode.OrganizationTable,
+			Columns: []string{promocode.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   promocode.OrganizationTable,
+			Columns: []string{promocode.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pcu.mutation.RedemptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   promocode.RedemptionsTable,
+			Columns: []string{promocode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: promoredemption.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcu.mutation.RemovedRedemptionsIDs(); len(nodes) > 0 && !pcu.mutation.RedemptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   promocode.RedemptionsTable,
+			Columns: []string{promocode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: promoredemption.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcu.mutation.RedemptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   promocode.RedemptionsTable,
+			Columns: []string{promocode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: promoredemption.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promocode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// PromoCodeUpdateOne is the builder for updating a single PromoCode entity.
+type PromoCodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PromoCodeMutation
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (pcuo *PromoCodeUpdateOne) SetUpdatedAt(t time.Time) *PromoCodeUpdateOne {
+	pcuo.mutation.SetUpdatedAt(t)
+	return pcuo
+}
+
+// SetCode sets the "code" field.
+func (pcuo *PromoCodeUpdateOne) SetCode(s string) *PromoCodeUpdateOne {
+	pcuo.mutation.SetCode(s)
+	return pcuo
+}
+
+// SetDescription sets the "description" field.
+func (pcuo *PromoCodeUpdateOne) SetDescription(s string) *PromoCodeUpdateOne {
+	pcuo.mutation.SetDescription(s)
+	return pcuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (pcuo *PromoCodeUpdateOne) SetNillableDescription(s *string) *PromoCodeUpdateOne {
+	if s != nil {
+		pcuo.SetDescription(*s)
+	}
+	return pcuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (pcuo *PromoCodeUpdateOne) ClearDescription() *PromoCodeUpdateOne {
+	pcuo.mutation.ClearDescription()
+	return pcuo
+}
+
+// SetDiscountType sets the "discountType" field.
+func (pcuo *PromoCodeUpdateOne) SetDiscountType(s string) *PromoCodeUpdateOne {
+	pcuo.mutation.SetDiscountType(s)
+	return pcuo
+}
+
+// SetDiscountValue sets the "discountValue" field.
+func (pcuo *PromoCodeUpdateOne) SetDiscountValue(i int) *PromoCodeUpdateOne {
+	pcuo.mutation.ResetDiscountValue()
+	pcuo.mutation.SetDiscountValue(i)
+	return pcuo
+}
+
+// AddDiscountValue adds i to the "discountValue" field.
+func (pcuo *PromoCodeUpdateOne) AddDiscountValue(i int) *PromoCodeUpdateOne {
+	pcuo.mutation.AddDiscountValue(i)
+	return pcuo
+}
+
+// SetCurrency sets the "currency" field.
+func (pcuo *PromoCodeUpdateOne) SetCurrency(s string) *PromoCodeUpdateOne {
+	pcuo.mutation.SetCurrency(s)
+	return pcuo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (pcuo *PromoCodeUpdateOne) SetNillableCurrency(s *string) *PromoCodeUpdateOne {
+	if s != nil {
+		pcuo.SetCurrency(*s)
+	}
+	return pcuo
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (pcuo *PromoCodeUpdateOne) ClearCurrency() *PromoCodeUpdateOne {
+	pcuo.mutation.ClearCurrency()
+	return pcuo
+}
+
+// SetValidFrom sets the "validFrom" field.
+func (pcuo *PromoCodeUpdateOne) SetValidFrom(t time.Time) *PromoCodeUpdateOne {
+	pcuo.mutation.SetValidFrom(t)
+	return pcuo
+}
+
+// SetNillableValidFrom sets the "validFrom" field if the given value is not nil.
+func (pcuo *PromoCodeUpdateOne) SetNillableValidFrom(t *time.Time) *PromoCodeUpdateOne {
+	if t != nil {
+		pcuo.SetValidFrom(*t)
+	}
+	return pcuo
+}
+
+// ClearValidFrom clears the value of the "validFrom" field.
+func (pcuo *PromoCodeUpdateOne) ClearValidFrom() *PromoCodeUpdateOne {
+	pcuo.mutation.ClearValidFrom()
+	return pcuo
+}
+
+// SetValidUntil sets the "validUntil" field.
+func (pcuo *PromoCodeUpdateOne) SetValidUntil(t time.Time) *PromoCodeUpdateOne {
+	pcuo.mutation.SetValidUntil(t)
+	return pcuo
+}
+
+// SetNillableValidUntil sets the "validUntil" field if the given value is not nil.
+func (pcuo *PromoCodeUpdateOne) SetNillableValidUntil(t *time.Time) *PromoCodeUpdateOne {
+	if t != nil {
+		pcuo.SetValidUntil(*t)
+	}
+	return pcuo
+}
+
+// ClearValidUntil clears the value of the "validUntil" field.
+func (pcuo *PromoCodeUpdateOne) ClearValidUntil() *PromoCodeUpdateOne {
+	pcuo.mutation.ClearValidUntil()
+	return pcuo
+}
+
+// SetMaxRedemptions sets the "maxRedemptions" field.
+func (pcuo *PromoCodeUpdateOne) SetMaxRedemptions(i int) *PromoCodeUpdateOne {
+	pcuo.mutation.ResetMaxRedemptions()
+	pcuo.mutation.SetMaxRedemptions(i)
+	return pcuo
+}
+
+// SetNillableMaxRedemptions sets the "maxRedemptions" field if the given value is not nil.
+func (pcuo *PromoCodeUpdateOne) SetNillableMaxRedemptions(i *int) *PromoCodeUpdateOne {
+	if i != nil {
+		pcuo.SetMaxRedemptions(*i)
+	}
+	return pcuo
+}
+
+// AddMaxRedemptions adds i to the "maxRedemptions" field.
+func (pcuo *PromoCodeUpdateOne) AddMaxRedemptions(i int) *PromoCodeUpdateOne {
+	pcuo.mutation.AddMaxRedemptions(i)
+	return pcuo
+}
+
+// ClearMaxRedemptions clears the value of the "maxRedemptions" field.
+func (pcuo *PromoCodeUpdateOne) ClearMaxRedemptions() *PromoCodeUpdateOne {
+	pcuo.mutation.ClearMaxRedemptions()
+	return pcuo
+}
+
+// SetMaxRedemptionsPerCustomer sets the "maxRedemptionsPerCustomer" field.
+func (pcuo *PromoCodeUpdateOne) SetMaxRedemptionsPerCustomer(i int) *PromoCodeUpdateOne {
+	pcuo.mutation.ResetMaxRedemptionsPerCustomer()
+	pcuo.mutation.SetMaxRedemptionsPerCustomer(i)
+	return pcuo
+}
+
+// SetNillableMaxRedemptionsPerCustomer sets the "maxRedemptionsPerCustomer" field if the given value is not nil.
+func (pcuo *PromoCodeUpdateOne) SetNillableMaxRedemptionsPerCustomer(i *int) *PromoCodeUpdateOne {
+	if i != nil {
+		pcuo.SetMaxRedemptionsPerCustomer(*i)
+	}
+	return pcuo
+}
+
+// AddMaxRedemptionsPerCustomer adds i to the "maxRedemptionsPerCustomer" field.
+func (pcuo *PromoCodeUpdateOne) AddMaxRedemptionsPerCustomer(i int) *PromoCodeUpdateOne {
+	pcuo.mutation.AddMaxRedemptionsPerCustomer(i)
+	return pcuo
+}
+
+// ClearMaxRedemptionsPerCustomer clears the value of the "maxRedemptionsPerCustomer" field.
+func (pcuo *PromoCodeUpdateOne) ClearMaxRedemptionsPerCustomer() *PromoCodeUpdateOne {
+	pcuo.mutation.ClearMaxRedemptionsPerCustomer()
+	return pcuo
+}
+
+// SetResourceIds sets the "resourceIds" field.
+func (pcuo *PromoCodeUpdateOne) SetResourceIds(i []int) *PromoCodeUpdateOne {
+	pcuo.mutation.SetResourceIds(i)
+	return pcuo
+}
+
+// ClearResourceIds clears the value of the "resourceIds" field.
+func (pcuo *PromoCodeUpdateOne) ClearResourceIds() *PromoCodeUpdateOne {
+	pcuo.mutation.ClearResourceIds()
+	return pcuo
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (pcuo *PromoCodeUpdateOne) SetOrganizationId(i int) *PromoCodeUpdateOne {
+	pcuo.mutation.SetOrganizationId(i)
+	return pcuo
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (pcuo *PromoCodeUpdateOne) SetOrganizationID(id int) *PromoCodeUpdateOne {
+	pcuo.mutation.SetOrganizationID(id)
+	return pcuo
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (pcuo *PromoCodeUpdateOne) SetOrganization(o *Organization) *PromoCodeUpdateOne {
+	return pcuo.SetOrganizationID(o.ID)
+}
+
+// AddRedemptionIDs adds the "redemptions" edge to the PromoRedemption entity by IDs.
+func (pcuo *PromoCodeUpdateOne) AddRedemptionIDs(ids ...int) *PromoCodeUpdateOne {
+	pcuo.mutation.AddRedemptionIDs(ids...)
+	return pcuo
+}
+
+// AddRedemptions adds the "redemptions" edges to the PromoRedemption entity.
+func (pcuo *PromoCodeUpdateOne) AddRedemptions(p ...*PromoRedemption) *PromoCodeUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pcuo.AddRedemptionIDs(ids...)
+}
+
+// Mutation returns the PromoCodeMutation object of the builder.
+func (pcuo *PromoCodeUpdateOne) Mutation() *PromoCodeMutation {
+	return pcuo.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (pcuo *PromoCodeUpdateOne) ClearOrganization() *PromoCodeUpdateOne {
+	pcuo.mutation.ClearOrganization()
+	return pcuo
+}
+
+// ClearRedemptions clears all "redemptions" edges to the PromoRedemption entity.
+func (pcuo *PromoCodeUpdateOne) ClearRedemptions() *PromoCodeUpdateOne {
+	pcuo.mutation.ClearRedemptions()
+	return pcuo
+}
+
+// RemoveRedemptionIDs removes the "redemptions" edge to PromoRedemption entities by IDs.
+func (pcuo *PromoCodeUpdateOne) RemoveRedemptionIDs(ids ...int) *PromoCodeUpdateOne {
+	pcuo.mutation.RemoveRedemptionIDs(ids...)
+	return pcuo
+}
+
+// RemoveRedemptions removes "redemptions" edges to PromoRedemption entities.
+func (pcuo *PromoCodeUpdateOne) RemoveRedemptions(p ...*PromoRedemption) *PromoCodeUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pcuo.RemoveRedemptionIDs(ids...)
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pcuo *PromoCodeUpdateOne) Select(field string, fields ...string) *PromoCodeUpdateOne {
+	pcuo.fields = append([]string{field}, fields...)
+	return pcuo
+}
+
+// Save executes the query and returns the updated PromoCode entity.
+func (pcuo *PromoCodeUpdateOne) Save(ctx context.Context) (*PromoCode, error) {
+	var (
+		err  error
+		node *PromoCode
+	)
+	if err := pcuo.defaults(); err != nil {
+		return nil, err
+	}
+	if len(pcuo.hooks) == 0 {
+		if err = pcuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = pcuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PromoCodeMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pcuo.check(); err != nil {
+				return nil, err
+			}
+			pcuo.mutation = mutation
+			node, err = pcuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pcuo.hooks) - 1; i >= 0; i-- {
+			if pcuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pcuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pcuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcuo *PromoCodeUpdateOne) SaveX(ctx context.Context) *PromoCode {
+	node, err := pcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pcuo *PromoCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := pcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcuo *PromoCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := pcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcuo *PromoCodeUpdateOne) defaults() error {
+	if _, ok := pcuo.mutation.UpdatedAt(); !ok {
+		if promocode.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized promocode.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := promocode.UpdateDefaultUpdatedAt()
+		pcuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcuo *PromoCodeUpdateOne) check() error {
+	if _, ok := pcuo.mutation.OrganizationID(); pcuo.mutation.OrganizationCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"organization\"")
+	}
+	return nil
+}
+
+func (pcuo *PromoCodeUpdateOne) sqlSave(ctx context.Context) (_node *PromoCode, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   promocode.Table,
+			Columns: promocode.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: promocode.FieldID,
+			},
+		},
+	}
+	id, ok := pcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing PromoCode.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, promocode.FieldID)
+		for _, f := range fields {
+			if !promocode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != promocode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pcuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: promocode.FieldUpdatedAt,
+		})
+	}
+	if value, ok := pcuo.mutation.Code(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promocode.FieldCode,
+		})
+	}
+	if value, ok := pcuo.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promocode.FieldDescription,
+		})
+	}
+	if pcuo.mutation.DescriptionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: promocode.FieldDescription,
+		})
+	}
+	if value, ok := pcuo.mutation.DiscountType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promocode.FieldDiscountType,
+		})
+	}
+	if value, ok := pcuo.mutation.DiscountValue(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promocode.FieldDiscountValue,
+		})
+	}
+	if value, ok := pcuo.mutation.AddedDiscountValue(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promocode.FieldDiscountValue,
+		})
+	}
+	if value, ok := pcuo.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promocode.FieldCurrency,
+		})
+	}
+	if pcuo.mutation.CurrencyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: promocode.FieldCurrency,
+		})
+	}
+	if value, ok := pcuo.mutation.ValidFrom(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: promocode.FieldValidFrom,
+		})
+	}
+	if pcuo.mutation.ValidFromCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: promocode.FieldValidFrom,
+		})
+	}
+	if value, ok := pcuo.mutation.ValidUntil(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: promocode.FieldValidUntil,
+		})
+	}
+	if pcuo.mutation.ValidUntilCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: promocode.FieldValidUntil,
+		})
+	}
+	if value, ok := pcuo.mutation.MaxRedemptions(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promocode.FieldMaxRedemptions,
+		})
+	}
+	if value, ok := pcuo.mutation.AddedMaxRedemptions(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promocode.FieldMaxRedemptions,
+		})
+	}
+	if pcuo.mutation.MaxRedemptionsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: promocode.FieldMaxRedemptions,
+		})
+	}
+	if value, ok := pcuo.mutation.MaxRedemptionsPerCustomer(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promocode.FieldMaxRedemptionsPerCustomer,
+		})
+	}
+	if value, ok := pcuo.mutation.AddedMaxRedemptionsPerCustomer(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promocode.FieldMaxRedemptionsPerCustomer,
+		})
+	}
+	if pcuo.mutation.MaxRedemptionsPerCustomerCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: promocode.FieldMaxRedemptionsPerCustomer,
+		})
+	}
+	if value, ok := pcuo.mutation.ResourceIds(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: promocode.FieldResourceIds,
+		})
+	}
+	if pcuo.mutation.ResourceIdsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: promocode.FieldResourceIds,
+		})
+	}
+	if pcuo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   promocode.OrganizationTable,
+			Columns: []string{promocode.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcuo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   promocode.OrganizationTable,
+			Columns: []string{promocode.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pcuo.mutation.RedemptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   promocode.RedemptionsTable,
+			Columns: []string{promocode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: promoredemption.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcuo.mutation.RemovedRedemptionsIDs(); len(nodes) > 0 && !pcuo.mutation.RedemptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   promocode.RedemptionsTable,
+			Columns: []string{promocode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: promoredemption.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcuo.mutation.RedemptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   promocode.RedemptionsTable,
+			Columns: []string{promocode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: promoredemption.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PromoCode{config: pcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promocode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
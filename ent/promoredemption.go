@@ -0,0 +1,216 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/promocode"
+	"github.com/openmesh/booking/ent/promoredemption"
+)
+
+// PromoRedemption is the model entity for the PromoRedemption schema.
+type PromoRedemption struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "createdAt" field.
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+	// UpdatedAt holds the value of the "updatedAt" field.
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+	// Customer holds the value of the "customer" field.
+	Customer string `json:"customer,omitempty"`
+	// Discount holds the value of the "discount" field.
+	Discount int `json:"discount,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// PromoCodeId holds the value of the "promoCodeId" field.
+	PromoCodeId int `json:"promoCodeId,omitempty"`
+	// BookingId holds the value of the "bookingId" field.
+	BookingId int `json:"bookingId,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PromoRedemptionQuery when eager-loading is set.
+	Edges PromoRedemptionEdges `json:"edges"`
+}
+
+// PromoRedemptionEdges holds the relations/edges for other nodes in the graph.
+type PromoRedemptionEdges struct {
+	// PromoCode holds the value of the promoCode edge.
+	PromoCode *PromoCode `json:"promoCode,omitempty"`
+	// Booking holds the value of the booking edge.
+	Booking *Booking `json:"booking,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PromoCodeOrErr returns the PromoCode value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PromoRedemptionEdges) PromoCodeOrErr() (*PromoCode, error) {
+	if e.loadedTypes[0] {
+		if e.PromoCode == nil {
+			// The edge promoCode was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: promocode.Label}
+		}
+		return e.PromoCode, nil
+	}
+	return nil, &NotLoadedError{edge: "promoCode"}
+}
+
+// BookingOrErr returns the Booking value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PromoRedemptionEdges) BookingOrErr() (*Booking, error) {
+	if e.loadedTypes[1] {
+		if e.Booking == nil {
+			// The edge booking was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: booking.Label}
+		}
+		return e.Booking, nil
+	}
+	return nil, &NotLoadedError{edge: "booking"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PromoRedemption) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case promoredemption.FieldID, promoredemption.FieldDiscount, promoredemption.FieldPromoCodeId, promoredemption.FieldBookingId:
+			values[i] = new(sql.NullInt64)
+		case promoredemption.FieldCustomer, promoredemption.FieldCurrency:
+			values[i] = new(sql.NullString)
+		case promoredemption.FieldCreatedAt, promoredemption.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type PromoRedemption", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PromoRedemption fields.
+func (pr *PromoRedemption) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case promoredemption.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pr.ID = int(value.Int64)
+		case promoredemption.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field createdAt", values[i])
+			} else if value.Valid {
+				pr.CreatedAt = value.Time
+			}
+		case promoredemption.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updatedAt", values[i])
+			} else if value.Valid {
+				pr.UpdatedAt = value.Time
+			}
+		case promoredemption.FieldCustomer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field customer", values[i])
+			} else if value.Valid {
+				pr.Customer = value.String
+			}
+		case promoredemption.FieldDiscount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field discount", values[i])
+			} else if value.Valid {
+				pr.Discount = int(value.Int64)
+			}
+		case promoredemption.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				pr.Currency = value.String
+			}
+		case promoredemption.FieldPromoCodeId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field promoCodeId", values[i])
+			} else if value.Valid {
+				pr.PromoCodeId = int(value.Int64)
+			}
+		case promoredemption.FieldBookingId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bookingId", values[i])
+			} else if value.Valid {
+				pr.BookingId = int(value.Int64)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryPromoCode queries the "promoCode" edge of the PromoRedemption entity.
+func (pr *PromoRedemption) QueryPromoCode() *PromoCodeQuery {
+	return (&PromoRedemptionClient{config: pr.config}).QueryPromoCode(pr)
+}
+
+// QueryBooking queries the "booking" edge of the PromoRedemption entity.
+func (pr *PromoRedemption) QueryBooking() *BookingQuery {
+	return (&PromoRedemptionClient{config: pr.config}).QueryBooking(pr)
+}
+
+// Update returns a builder for updating this PromoRedemption.
+// Note that you need to call PromoRedemption.Unwrap() before calling this method if this PromoRedemption
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *PromoRedemption) Update() *PromoRedemptionUpdateOne {
+	return (&PromoRedemptionClient{config: pr.config}).UpdateOne(pr)
+}
+
+// Unwrap unwraps the PromoRedemption entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *PromoRedemption) Unwrap() *PromoRedemption {
+	tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PromoRedemption is not a transactional entity")
+	}
+	pr.config.driver = tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *PromoRedemption) String() string {
+	var builder strings.Builder
+	builder.WriteString("PromoRedemption(")
+	builder.WriteString(fmt.Sprintf("id=%v", pr.ID))
+	builder.WriteString(", createdAt=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", updatedAt=")
+	builder.WriteString(pr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", customer=")
+	builder.WriteString(pr.Customer)
+	builder.WriteString(", discount=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Discount))
+	builder.WriteString(", currency=")
+	builder.WriteString(pr.Currency)
+	builder.WriteString(", promoCodeId=")
+	builder.WriteString(fmt.Sprintf("%v", pr.PromoCodeId))
+	builder.WriteString(", bookingId=")
+	builder.WriteString(fmt.Sprintf("%v", pr.BookingId))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PromoRedemptions is a parsable slice of PromoRedemption.
+type PromoRedemptions []*PromoRedemption
+
+func (pr PromoRedemptions) config(cfg config) {
+	for _i := range pr {
+		pr[_i].config = cfg
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by entc, DO NOT EDIT.
+
+package promoredemption
+
+import (
+	"time"
+
+	"entgo.io/ent"
+)
+
+const (
+	// Label holds the string label denoting the promoredemption type in the database.
+	Label = "promo_redemption"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the createdat field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updatedat field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCustomer holds the string denoting the customer field in the database.
+	FieldCustomer = "customer"
+	// FieldDiscount holds the string denoting the discount field in the database.
+	FieldDiscount = "discount"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldPromoCodeId holds the string denoting the promocodeid field in the database.
+	FieldPromoCodeId = "promo_code_id"
+	// FieldBookingId holds the string denoting the bookingid field in the database.
+	FieldBookingId = "booking_id"
+	// EdgePromoCode holds the string denoting the promocode edge name in mutations.
+	EdgePromoCode = "promoCode"
+	// EdgeBooking holds the string denoting the booking edge name in mutations.
+	EdgeBooking = "booking"
+	// Table holds the table name of the promoredemption in the database.
+	Table = "promo_redemptions"
+	// PromoCodeTable is the table that holds the promoCode relation/edge.
+	PromoCodeTable = "promo_redemptions"
+	// PromoCodeInverseTable is the table name for the PromoCode entity.
+	// It exists in this package in order to avoid circular dependency with the "promocode" package.
+	PromoCodeInverseTable = "promo_codes"
+	// PromoCodeColumn is the table column denoting the promoCode relation/edge.
+	PromoCodeColumn = "promo_code_id"
+	// BookingTable is the table that holds the booking relation/edge.
+	BookingTable = "promo_redemptions"
+	// BookingInverseTable is the table name for the Booking entity.
+	// It exists in this package in order to avoid circular dependency with the "booking" package.
+	BookingInverseTable = "bookings"
+	// BookingColumn is the table column denoting the booking relation/edge.
+	BookingColumn = "booking_id"
+)
+
+// Columns holds all SQL columns for promoredemption fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldCustomer,
+	FieldDiscount,
+	FieldCurrency,
+	FieldPromoCodeId,
+	FieldBookingId,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/openmesh/booking/ent/runtime"
+//
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCreatedAt holds the default value on creation for the "createdAt" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updatedAt" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updatedAt" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+)
@@ -0,0 +1,791 @@
+// Code generated by entc, DO NOT EDIT.
+
+package promoredemption
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/openmesh/booking/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "createdAt" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updatedAt" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// Customer applies equality check predicate on the "customer" field. It's identical to CustomerEQ.
+func Customer(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCustomer), v))
+	})
+}
+
+// Discount applies equality check predicate on the "discount" field. It's identical to DiscountEQ.
+func Discount(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDiscount), v))
+	})
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCurrency), v))
+	})
+}
+
+// PromoCodeId applies equality check predicate on the "promoCodeId" field. It's identical to PromoCodeIdEQ.
+func PromoCodeId(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPromoCodeId), v))
+	})
+}
+
+// BookingId applies equality check predicate on the "bookingId" field. It's identical to BookingIdEQ.
+func BookingId(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBookingId), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "createdAt" field.
+func CreatedAtEQ(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "createdAt" field.
+func CreatedAtNEQ(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "createdAt" field.
+func CreatedAtIn(vs ...time.Time) predicate.PromoRedemption {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "createdAt" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PromoRedemption {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "createdAt" field.
+func CreatedAtGT(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "createdAt" field.
+func CreatedAtGTE(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "createdAt" field.
+func CreatedAtLT(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "createdAt" field.
+func CreatedAtLTE(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updatedAt" field.
+func UpdatedAtEQ(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updatedAt" field.
+func UpdatedAtNEQ(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updatedAt" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PromoRedemption {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updatedAt" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PromoRedemption {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updatedAt" field.
+func UpdatedAtGT(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updatedAt" field.
+func UpdatedAtGTE(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updatedAt" field.
+func UpdatedAtLT(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updatedAt" field.
+func UpdatedAtLTE(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// CustomerEQ applies the EQ predicate on the "customer" field.
+func CustomerEQ(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCustomer), v))
+	})
+}
+
+// CustomerNEQ applies the NEQ predicate on the "customer" field.
+func CustomerNEQ(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCustomer), v))
+	})
+}
+
+// CustomerIn applies the In predicate on the "customer" field.
+func CustomerIn(vs ...string) predicate.PromoRedemption {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCustomer), v...))
+	})
+}
+
+// CustomerNotIn applies the NotIn predicate on the "customer" field.
+func CustomerNotIn(vs ...string) predicate.PromoRedemption {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCustomer), v...))
+	})
+}
+
+// CustomerGT applies the GT predicate on the "customer" field.
+func CustomerGT(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCustomer), v))
+	})
+}
+
+// CustomerGTE applies the GTE predicate on the "customer" field.
+func CustomerGTE(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCustomer), v))
+	})
+}
+
+// CustomerLT applies the LT predicate on the "customer" field.
+func CustomerLT(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCustomer), v))
+	})
+}
+
+// CustomerLTE applies the LTE predicate on the "customer" field.
+func CustomerLTE(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCustomer), v))
+	})
+}
+
+// CustomerContains applies the Contains predicate on the "customer" field.
+func CustomerContains(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCustomer), v))
+	})
+}
+
+// CustomerHasPrefix applies the HasPrefix predicate on the "customer" field.
+func CustomerHasPrefix(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCustomer), v))
+	})
+}
+
+// CustomerHasSuffix applies the HasSuffix predicate on the "customer" field.
+func CustomerHasSuffix(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCustomer), v))
+	})
+}
+
+// CustomerIsNil applies the IsNil predicate on the "customer" field.
+func CustomerIsNil() predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCustomer)))
+	})
+}
+
+// CustomerNotNil applies the NotNil predicate on the "customer" field.
+func CustomerNotNil() predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCustomer)))
+	})
+}
+
+// CustomerEqualFold applies the EqualFold predicate on the "customer" field.
+func CustomerEqualFold(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCustomer), v))
+	})
+}
+
+// CustomerContainsFold applies the ContainsFold predicate on the "customer" field.
+func CustomerContainsFold(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCustomer), v))
+	})
+}
+
+// DiscountEQ applies the EQ predicate on the "discount" field.
+func DiscountEQ(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDiscount), v))
+	})
+}
+
+// DiscountNEQ applies the NEQ predicate on the "discount" field.
+func DiscountNEQ(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDiscount), v))
+	})
+}
+
+// DiscountIn applies the In predicate on the "discount" field.
+func DiscountIn(vs ...int) predicate.PromoRedemption {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDiscount), v...))
+	})
+}
+
+// DiscountNotIn applies the NotIn predicate on the "discount" field.
+func DiscountNotIn(vs ...int) predicate.PromoRedemption {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDiscount), v...))
+	})
+}
+
+// DiscountGT applies the GT predicate on the "discount" field.
+func DiscountGT(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDiscount), v))
+	})
+}
+
+// DiscountGTE applies the GTE predicate on the "discount" field.
+func DiscountGTE(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDiscount), v))
+	})
+}
+
+// DiscountLT applies the LT predicate on the "discount" field.
+func DiscountLT(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDiscount), v))
+	})
+}
+
+// DiscountLTE applies the LTE predicate on the "discount" field.
+func DiscountLTE(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDiscount), v))
+	})
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.PromoRedemption {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCurrency), v...))
+	})
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.PromoRedemption {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCurrency), v...))
+	})
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCurrency), v))
+	})
+}
+
+// PromoCodeIdEQ applies the EQ predicate on the "promoCodeId" field.
+func PromoCodeIdEQ(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPromoCodeId), v))
+	})
+}
+
+// PromoCodeIdNEQ applies the NEQ predicate on the "promoCodeId" field.
+func PromoCodeIdNEQ(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPromoCodeId), v))
+	})
+}
+
+// PromoCodeIdIn applies the In predicate on the "promoCodeId" field.
+func PromoCodeIdIn(vs ...int) predicate.PromoRedemption {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPromoCodeId), v...))
+	})
+}
+
+// PromoCodeIdNotIn applies the NotIn predicate on the "promoCodeId" field.
+func PromoCodeIdNotIn(vs ...int) predicate.PromoRedemption {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPromoCodeId), v...))
+	})
+}
+
+// BookingIdEQ applies the EQ predicate on the "bookingId" field.
+func BookingIdEQ(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBookingId), v))
+	})
+}
+
+// BookingIdNEQ applies the NEQ predicate on the "bookingId" field.
+func BookingIdNEQ(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBookingId), v))
+	})
+}
+
+// BookingIdIn applies the In predicate on the "bookingId" field.
+func BookingIdIn(vs ...int) predicate.PromoRedemption {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBookingId), v...))
+	})
+}
+
+// BookingIdNotIn applies the NotIn predicate on the "bookingId" field.
+func BookingIdNotIn(vs ...int) predicate.PromoRedemption {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBookingId), v...))
+	})
+}
+
+// HasPromoCode applies the HasEdge predicate on the "promoCode" edge.
+func HasPromoCode() predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PromoCodeTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PromoCodeTable, PromoCodeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPromoCodeWith applies the HasEdge predicate on the "promoCode" edge with a given conditions (other predicates).
+func HasPromoCodeWith(preds ...predicate.PromoCode) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PromoCodeInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PromoCodeTable, PromoCodeColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBooking applies the HasEdge predicate on the "booking" edge.
+func HasBooking() predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(BookingTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BookingTable, BookingColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBookingWith applies the HasEdge predicate on the "booking" edge with a given conditions (other predicates).
+func HasBookingWith(preds ...predicate.Booking) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(BookingInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BookingTable, BookingColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PromoRedemption) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PromoRedemption) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PromoRedemption) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
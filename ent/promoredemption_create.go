@@ -0,0 +1,961 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/promocode"
+	"github.com/openmesh/booking/ent/promoredemption"
+)
+
+// PromoRedemptionCreate is the builder for creating a PromoRedemption entity.
+type PromoRedemptionCreate struct {
+	config
+	mutation *PromoRedemptionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (prc *PromoRedemptionCreate) SetCreatedAt(t time.Time) *PromoRedemptionCreate {
+	prc.mutation.SetCreatedAt(t)
+	return prc
+}
+
+// SetNillableCreatedAt sets the "createdAt" field if the given value is not nil.
+func (prc *PromoRedemptionCreate) SetNillableCreatedAt(t *time.Time) *PromoRedemptionCreate {
+	if t != nil {
+		prc.SetCreatedAt(*t)
+	}
+	return prc
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (prc *PromoRedemptionCreate) SetUpdatedAt(t time.Time) *PromoRedemptionCreate {
+	prc.mutation.SetUpdatedAt(t)
+	return prc
+}
+
+// SetNillableUpdatedAt sets the "updatedAt" field if the given value is not nil.
+func (prc *PromoRedemptionCreate) SetNillableUpdatedAt(t *time.Time) *PromoRedemptionCreate {
+	if t != nil {
+		prc.SetUpdatedAt(*t)
+	}
+	return prc
+}
+
+// SetCustomer sets the "customer" field.
+func (prc *PromoRedemptionCreate) SetCustomer(s string) *PromoRedemptionCreate {
+	prc.mutation.SetCustomer(s)
+	return prc
+}
+
+// SetNillableCustomer sets the "customer" field if the given value is not nil.
+func (prc *PromoRedemptionCreate) SetNillableCustomer(s *string) *PromoRedemptionCreate {
+	if s != nil {
+		prc.SetCustomer(*s)
+	}
+	return prc
+}
+
+// SetDiscount sets the "discount" field.
+func (prc *PromoRedemptionCreate) SetDiscount(i int) *PromoRedemptionCreate {
+	prc.mutation.SetDiscount(i)
+	return prc
+}
+
+// SetCurrency sets the "currency" field.
+func (prc *PromoRedemptionCreate) SetCurrency(s string) *PromoRedemptionCreate {
+	prc.mutation.SetCurrency(s)
+	return prc
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (prc *PromoRedemptionCreate) SetNillableCurrency(s *string) *PromoRedemptionCreate {
+	if s != nil {
+		prc.SetCurrency(*s)
+	}
+	return prc
+}
+
+// SetPromoCodeId sets the "promoCodeId" field.
+func (prc *PromoRedemptionCreate) SetPromoCodeId(i int) *PromoRedemptionCreate {
+	prc.mutation.SetPromoCodeId(i)
+	return prc
+}
+
+// SetBookingId sets the "bookingId" field.
+func (prc *PromoRedemptionCreate) SetBookingId(i int) *PromoRedemptionCreate {
+	prc.mutation.SetBookingId(i)
+	return prc
+}
+
+// SetPromoCodeID sets the "promoCode" edge to the PromoCode entity by ID.
+func (prc *PromoRedemptionCreate) SetPromoCodeID(id int) *PromoRedemptionCreate {
+	prc.mutation.SetPromoCodeID(id)
+	return prc
+}
+
+// SetPromoCode sets the "promoCode" edge to the PromoCode entity.
+func (prc *PromoRedemptionCreate) SetPromoCode(p *PromoCode) *PromoRedemptionCreate {
+	return prc.SetPromoCodeID(p.ID)
+}
+
+// SetBookingID sets the "booking" edge to the Booking entity by ID.
+func (prc *PromoRedemptionCreate) SetBookingID(id int) *PromoRedemptionCreate {
+	prc.mutation.SetBookingID(id)
+	return prc
+}
+
+// SetBooking sets the "booking" edge to the Booking entity.
+func (prc *PromoRedemptionCreate) SetBooking(b *Booking) *PromoRedemptionCreate {
+	return prc.SetBookingID(b.ID)
+}
+
+// Mutation returns the PromoRedemptionMutation object of the builder.
+func (prc *PromoRedemptionCreate) Mutation() *PromoRedemptionMutation {
+	return prc.mutation
+}
+
+// Save creates the PromoRedemption in the database.
+func (prc *PromoRedemptionCreate) Save(ctx context.Context) (*PromoRedemption, error) {
+	var (
+		err  error
+		node *PromoRedemption
+	)
+	if err := prc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(prc.hooks) == 0 {
+		if err = prc.check(); err != nil {
+			return nil, err
+		}
+		node, err = prc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PromoRedemptionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = prc.check(); err != nil {
+				return nil, err
+			}
+			prc.mutation = mutation
+			if node, err = prc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(prc.hooks) - 1; i >= 0; i-- {
+			if prc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = prc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, prc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (prc *PromoRedemptionCreate) SaveX(ctx context.Context) *PromoRedemption {
+	v, err := prc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prc *PromoRedemptionCreate) Exec(ctx context.Context) error {
+	_, err := prc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prc *PromoRedemptionCreate) ExecX(ctx context.Context) {
+	if err := prc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (prc *PromoRedemptionCreate) defaults() error {
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		if promoredemption.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized promoredemption.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := promoredemption.DefaultCreatedAt()
+		prc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := prc.mutation.UpdatedAt(); !ok {
+		if promoredemption.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized promoredemption.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := promoredemption.DefaultUpdatedAt()
+		prc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := prc.mutation.Currency(); !ok {
+		v := promoredemption.DefaultCurrency
+		prc.mutation.SetCurrency(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (prc *PromoRedemptionCreate) check() error {
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "createdAt", err: errors.New(`ent: missing required field "createdAt"`)}
+	}
+	if _, ok := prc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updatedAt", err: errors.New(`ent: missing required field "updatedAt"`)}
+	}
+	if _, ok := prc.mutation.Discount(); !ok {
+		return &ValidationError{Name: "discount", err: errors.New(`ent: missing required field "discount"`)}
+	}
+	if _, ok := prc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "currency"`)}
+	}
+	if _, ok := prc.mutation.PromoCodeId(); !ok {
+		return &ValidationError{Name: "promoCodeId", err: errors.New(`ent: missing required field "promoCodeId"`)}
+	}
+	if _, ok := prc.mutation.BookingId(); !ok {
+		return &ValidationError{Name: "bookingId", err: errors.New(`ent: missing required field "bookingId"`)}
+	}
+	if _, ok := prc.mutation.PromoCodeID(); !ok {
+		return &ValidationError{Name: "promoCode", err: errors.New("ent: missing required edge \"promoCode\"")}
+	}
+	if _, ok := prc.mutation.BookingID(); !ok {
+		return &ValidationError{Name: "booking", err: errors.New("ent: missing required edge \"booking\"")}
+	}
+	return nil
+}
+
+func (prc *PromoRedemptionCreate) sqlSave(ctx context.Context) (*PromoRedemption, error) {
+	_node, _spec := prc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, prc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (prc *PromoRedemptionCreate) createSpec() (*PromoRedemption, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PromoRedemption{config: prc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: promoredemption.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: promoredemption.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = prc.conflict
+	if value, ok := prc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: promoredemption.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := prc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: promoredemption.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if value, ok := prc.mutation.Customer(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promoredemption.FieldCustomer,
+		})
+		_node.Customer = value
+	}
+	if value, ok := prc.mutation.Discount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promoredemption.FieldDiscount,
+		})
+		_node.Discount = value
+	}
+	if value, ok := prc.mutation.Currency(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promoredemption.FieldCurrency,
+		})
+		_node.Currency = value
+	}
+	if nodes := prc.mutation.PromoCodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   promoredemption.PromoCodeTable,
+			Columns: []string{promoredemption.PromoCodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: promocode.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PromoCodeId = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := prc.mutation.BookingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   promoredemption.BookingTable,
+			Columns: []string{promoredemption.BookingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BookingId = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PromoRedemption.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PromoRedemptionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (prc *PromoRedemptionCreate) OnConflict(opts ...sql.ConflictOption) *PromoRedemptionUpsertOne {
+	prc.conflict = opts
+	return &PromoRedemptionUpsertOne{
+		create: prc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PromoRedemption.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (prc *PromoRedemptionCreate) OnConflictColumns(columns ...string) *PromoRedemptionUpsertOne {
+	prc.conflict = append(prc.conflict, sql.ConflictColumns(columns...))
+	return &PromoRedemptionUpsertOne{
+		create: prc,
+	}
+}
+
+type (
+	// PromoRedemptionUpsertOne is the builder for "upsert"-ing
+	//  one PromoRedemption node.
+	PromoRedemptionUpsertOne struct {
+		create *PromoRedemptionCreate
+	}
+
+	// PromoRedemptionUpsert is the "OnConflict" setter.
+	PromoRedemptionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *PromoRedemptionUpsert) SetCreatedAt(v time.Time) *PromoRedemptionUpsert {
+	u.Set(promoredemption.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *PromoRedemptionUpsert) UpdateCreatedAt() *PromoRedemptionUpsert {
+	u.SetExcluded(promoredemption.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *PromoRedemptionUpsert) SetUpdatedAt(v time.Time) *PromoRedemptionUpsert {
+	u.Set(promoredemption.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *PromoRedemptionUpsert) UpdateUpdatedAt() *PromoRedemptionUpsert {
+	u.SetExcluded(promoredemption.FieldUpdatedAt)
+	return u
+}
+
+// SetCustomer sets the "customer" field.
+func (u *PromoRedemptionUpsert) SetCustomer(v string) *PromoRedemptionUpsert {
+	u.Set(promoredemption.FieldCustomer, v)
+	return u
+}
+
+// UpdateCustomer sets the "customer" field to the value that was provided on create.
+func (u *PromoRedemptionUpsert) UpdateCustomer() *PromoRedemptionUpsert {
+	u.SetExcluded(promoredemption.FieldCustomer)
+	return u
+}
+
+// ClearCustomer clears the value of the "customer" field.
+func (u *PromoRedemptionUpsert) ClearCustomer() *PromoRedemptionUpsert {
+	u.SetNull(promoredemption.FieldCustomer)
+	return u
+}
+
+// SetDiscount sets the "discount" field.
+func (u *PromoRedemptionUpsert) SetDiscount(v int) *PromoRedemptionUpsert {
+	u.Set(promoredemption.FieldDiscount, v)
+	return u
+}
+
+// UpdateDiscount sets the "discount" field to the value that was provided on create.
+func (u *PromoRedemptionUpsert) UpdateDiscount() *PromoRedemptionUpsert {
+	u.SetExcluded(promoredemption.FieldDiscount)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *PromoRedemptionUpsert) SetCurrency(v string) *PromoRedemptionUpsert {
+	u.Set(promoredemption.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *PromoRedemptionUpsert) UpdateCurrency() *PromoRedemptionUpsert {
+	u.SetExcluded(promoredemption.FieldCurrency)
+	return u
+}
+
+// SetPromoCodeId sets the "promoCodeId" field.
+func (u *PromoRedemptionUpsert) SetPromoCodeId(v int) *PromoRedemptionUpsert {
+	u.Set(promoredemption.FieldPromoCodeId, v)
+	return u
+}
+
+// UpdatePromoCodeId sets the "promoCodeId" field to the value that was provided on create.
+func (u *PromoRedemptionUpsert) UpdatePromoCodeId() *PromoRedemptionUpsert {
+	u.SetExcluded(promoredemption.FieldPromoCodeId)
+	return u
+}
+
+// SetBookingId sets the "bookingId" field.
+func (u *PromoRedemptionUpsert) SetBookingId(v int) *PromoRedemptionUpsert {
+	u.Set(promoredemption.FieldBookingId, v)
+	return u
+}
+
+// UpdateBookingId sets the "bookingId" field to the value that was provided on create.
+func (u *PromoRedemptionUpsert) UpdateBookingId() *PromoRedemptionUpsert {
+	u.SetExcluded(promoredemption.FieldBookingId)
+	return u
+}
+
+// UpdateNewValues updates the fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.PromoRedemption.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PromoRedemptionUpsertOne) UpdateNewValues() *PromoRedemptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PromoRedemption.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PromoRedemptionUpsertOne) Ignore() *PromoRedemptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PromoRedemptionUpsertOne) DoNothing() *PromoRedemptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PromoRedemptionCreate.OnConflict
+// documentation for more info.
+func (u *PromoRedemptionUpsertOne) Update(set func(*PromoRedemptionUpsert)) *PromoRedemptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PromoRedemptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *PromoRedemptionUpsertOne) SetCreatedAt(v time.Time) *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *PromoRedemptionUpsertOne) UpdateCreatedAt() *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *PromoRedemptionUpsertOne) SetUpdatedAt(v time.Time) *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *PromoRedemptionUpsertOne) UpdateUpdatedAt() *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetCustomer sets the "customer" field.
+func (u *PromoRedemptionUpsertOne) SetCustomer(v string) *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.SetCustomer(v)
+	})
+}
+
+// UpdateCustomer sets the "customer" field to the value that was provided on create.
+func (u *PromoRedemptionUpsertOne) UpdateCustomer() *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.UpdateCustomer()
+	})
+}
+
+// ClearCustomer clears the value of the "customer" field.
+func (u *PromoRedemptionUpsertOne) ClearCustomer() *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.ClearCustomer()
+	})
+}
+
+// SetDiscount sets the "discount" field.
+func (u *PromoRedemptionUpsertOne) SetDiscount(v int) *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.SetDiscount(v)
+	})
+}
+
+// UpdateDiscount sets the "discount" field to the value that was provided on create.
+func (u *PromoRedemptionUpsertOne) UpdateDiscount() *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.UpdateDiscount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *PromoRedemptionUpsertOne) SetCurrency(v string) *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *PromoRedemptionUpsertOne) UpdateCurrency() *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetPromoCodeId sets the "promoCodeId" field.
+func (u *PromoRedemptionUpsertOne) SetPromoCodeId(v int) *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.SetPromoCodeId(v)
+	})
+}
+
+// UpdatePromoCodeId sets the "promoCodeId" field to the value that was provided on create.
+func (u *PromoRedemptionUpsertOne) UpdatePromoCodeId() *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.UpdatePromoCodeId()
+	})
+}
+
+// SetBookingId sets the "bookingId" field.
+func (u *PromoRedemptionUpsertOne) SetBookingId(v int) *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.SetBookingId(v)
+	})
+}
+
+// UpdateBookingId sets the "bookingId" field to the value that was provided on create.
+func (u *PromoRedemptionUpsertOne) UpdateBookingId() *PromoRedemptionUpsertOne {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.UpdateBookingId()
+	})
+}
+
+// Exec executes the query.
+func (u *PromoRedemptionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PromoRedemptionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PromoRedemptionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PromoRedemptionUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PromoRedemptionUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PromoRedemptionCreateBulk is the builder for creating many PromoRedemption entities in bulk.
+type PromoRedemptionCreateBulk struct {
+	config
+	builders []*PromoRedemptionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PromoRedemption entities in the database.
+func (prcb *PromoRedemptionCreateBulk) Save(ctx context.Context) ([]*PromoRedemption, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(prcb.builders))
+	nodes := make([]*PromoRedemption, len(prcb.builders))
+	mutators := make([]Mutator, len(prcb.builders))
+	for i := range prcb.builders {
+		func(i int, root context.Context) {
+			builder := prcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PromoRedemptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, prcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = prcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, prcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, prcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (prcb *PromoRedemptionCreateBulk) SaveX(ctx context.Context) []*PromoRedemption {
+	v, err := prcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prcb *PromoRedemptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := prcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prcb *PromoRedemptionCreateBulk) ExecX(ctx context.Context) {
+	if err := prcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PromoRedemption.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PromoRedemptionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (prcb *PromoRedemptionCreateBulk) OnConflict(opts ...sql.ConflictOption) *PromoRedemptionUpsertBulk {
+	prcb.conflict = opts
+	return &PromoRedemptionUpsertBulk{
+		create: prcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PromoRedemption.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (prcb *PromoRedemptionCreateBulk) OnConflictColumns(columns ...string) *PromoRedemptionUpsertBulk {
+	prcb.conflict = append(prcb.conflict, sql.ConflictColumns(columns...))
+	return &PromoRedemptionUpsertBulk{
+		create: prcb,
+	}
+}
+
+// PromoRedemptionUpsertBulk is the builder for "upsert"-ing
+// a bulk of PromoRedemption nodes.
+type PromoRedemptionUpsertBulk struct {
+	create *PromoRedemptionCreateBulk
+}
+
+// UpdateNewValues updates the fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PromoRedemption.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PromoRedemptionUpsertBulk) UpdateNewValues() *PromoRedemptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PromoRedemption.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PromoRedemptionUpsertBulk) Ignore() *PromoRedemptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PromoRedemptionUpsertBulk) DoNothing() *PromoRedemptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PromoRedemptionCreateBulk.OnConflict
+// documentation for more info.
+func (u *PromoRedemptionUpsertBulk) Update(set func(*PromoRedemptionUpsert)) *PromoRedemptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PromoRedemptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *PromoRedemptionUpsertBulk) SetCreatedAt(v time.Time) *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *PromoRedemptionUpsertBulk) UpdateCreatedAt() *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *PromoRedemptionUpsertBulk) SetUpdatedAt(v time.Time) *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *PromoRedemptionUpsertBulk) UpdateUpdatedAt() *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetCustomer sets the "customer" field.
+func (u *PromoRedemptionUpsertBulk) SetCustomer(v string) *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.SetCustomer(v)
+	})
+}
+
+// UpdateCustomer sets the "customer" field to the value that was provided on create.
+func (u *PromoRedemptionUpsertBulk) UpdateCustomer() *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.UpdateCustomer()
+	})
+}
+
+// ClearCustomer clears the value of the "customer" field.
+func (u *PromoRedemptionUpsertBulk) ClearCustomer() *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.ClearCustomer()
+	})
+}
+
+// SetDiscount sets the "discount" field.
+func (u *PromoRedemptionUpsertBulk) SetDiscount(v int) *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.SetDiscount(v)
+	})
+}
+
+// UpdateDiscount sets the "discount" field to the value that was provided on create.
+func (u *PromoRedemptionUpsertBulk) UpdateDiscount() *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.UpdateDiscount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *PromoRedemptionUpsertBulk) SetCurrency(v string) *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *PromoRedemptionUpsertBulk) UpdateCurrency() *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetPromoCodeId sets the "promoCodeId" field.
+func (u *PromoRedemptionUpsertBulk) SetPromoCodeId(v int) *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.SetPromoCodeId(v)
+	})
+}
+
+// UpdatePromoCodeId sets the "promoCodeId" field to the value that was provided on create.
+func (u *PromoRedemptionUpsertBulk) UpdatePromoCodeId() *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.UpdatePromoCodeId()
+	})
+}
+
+// SetBookingId sets the "bookingId" field.
+func (u *PromoRedemptionUpsertBulk) SetBookingId(v int) *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.SetBookingId(v)
+	})
+}
+
+// UpdateBookingId sets the "bookingId" field to the value that was provided on create.
+func (u *PromoRedemptionUpsertBulk) UpdateBookingId() *PromoRedemptionUpsertBulk {
+	return u.Update(func(s *PromoRedemptionUpsert) {
+		s.UpdateBookingId()
+	})
+}
+
+// Exec executes the query.
+func (u *PromoRedemptionUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PromoRedemptionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PromoRedemptionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PromoRedemptionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
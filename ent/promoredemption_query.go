@@ -0,0 +1,1089 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/predicate"
+	"github.com/openmesh/booking/ent/promocode"
+	"github.com/openmesh/booking/ent/promoredemption"
+)
+
+// PromoRedemptionQuery is the builder for querying PromoRedemption entities.
+type PromoRedemptionQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.PromoRedemption
+	// eager-loading edges.
+	withPromoCode *PromoCodeQuery
+	withBooking   *BookingQuery
+	modifiers     []func(s *sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PromoRedemptionQuery builder.
+func (prq *PromoRedemptionQuery) Where(ps ...predicate.PromoRedemption) *PromoRedemptionQuery {
+	prq.predicates = append(prq.predicates, ps...)
+	return prq
+}
+
+// Limit adds a limit step to the query.
+func (prq *PromoRedemptionQuery) Limit(limit int) *PromoRedemptionQuery {
+	prq.limit = &limit
+	return prq
+}
+
+// Offset adds an offset step to the query.
+func (prq *PromoRedemptionQuery) Offset(offset int) *PromoRedemptionQuery {
+	prq.offset = &offset
+	return prq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (prq *PromoRedemptionQuery) Unique(unique bool) *PromoRedemptionQuery {
+	prq.unique = &unique
+	return prq
+}
+
+// Order adds an order step to the query.
+func (prq *PromoRedemptionQuery) Order(o ...OrderFunc) *PromoRedemptionQuery {
+	prq.order = append(prq.order, o...)
+	return prq
+}
+
+// QueryPromoCode chains the current query on the "promoCode" edge.
+func (prq *PromoRedemptionQuery) QueryPromoCode() *PromoCodeQuery {
+	query := &PromoCodeQuery{config: prq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := prq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := prq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(promoredemption.Table, promoredemption.FieldID, selector),
+			sqlgraph.To(promocode.Table, promocode.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, promoredemption.PromoCodeTable, promoredemption.PromoCodeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(prq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBooking chains the current query on the "booking" edge.
+func (prq *PromoRedemptionQuery) QueryBooking() *BookingQuery {
+	query := &BookingQuery{config: prq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := prq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := prq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(promoredemption.Table, promoredemption.FieldID, selector),
+			sqlgraph.To(booking.Table, booking.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, promoredemption.BookingTable, promoredemption.BookingColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(prq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PromoRedemption entity from the query.
+// Returns a *NotFoundError when no PromoRedemption was found.
+func (prq *PromoRedemptionQuery) First(ctx context.Context) (*PromoRedemption, error) {
+	nodes, err := prq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{promoredemption.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (prq *PromoRedemptionQuery) FirstX(ctx context.Context) *PromoRedemption {
+	node, err := prq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PromoRedemption ID from the query.
+// Returns a *NotFoundError when no PromoRedemption ID was found.
+func (prq *PromoRedemptionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = prq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{promoredemption.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (prq *PromoRedemptionQuery) FirstIDX(ctx context.Context) int {
+	id, err := prq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PromoRedemption entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one PromoRedemption entity is not found.
+// Returns a *NotFoundError when no PromoRedemption entities are found.
+func (prq *PromoRedemptionQuery) Only(ctx context.Context) (*PromoRedemption, error) {
+	nodes, err := prq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{promoredemption.Label}
+	default:
+		return nil, &NotSingularError{promoredemption.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (prq *PromoRedemptionQuery) OnlyX(ctx context.Context) *PromoRedemption {
+	node, err := prq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PromoRedemption ID in the query.
+// Returns a *NotSingularError when exactly one PromoRedemption ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (prq *PromoRedemptionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = prq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{promoredemption.Label}
+	default:
+		err = &NotSingularError{promoredemption.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (prq *PromoRedemptionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := prq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PromoRedemptions.
+func (prq *PromoRedemptionQuery) All(ctx context.Context) ([]*PromoRedemption, error) {
+	if err := prq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return prq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (prq *PromoRedemptionQuery) AllX(ctx context.Context) []*PromoRedemption {
+	nodes, err := prq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PromoRedemption IDs.
+func (prq *PromoRedemptionQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := prq.Select(promoredemption.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (prq *PromoRedemptionQuery) IDsX(ctx context.Context) []int {
+	ids, err := prq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (prq *PromoRedemptionQuery) Count(ctx context.Context) (int, error) {
+	if err := prq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return prq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (prq *PromoRedemptionQuery) CountX(ctx context.Context) int {
+	count, err := prq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (prq *PromoRedemptionQuery) Exist(ctx context.Context) (bool, error) {
+	if err := prq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return prq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (prq *PromoRedemptionQuery) ExistX(ctx context.Context) bool {
+	exist, err := prq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PromoRedemptionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (prq *PromoRedemptionQuery) Clone() *PromoRedemptionQuery {
+	if prq == nil {
+		return nil
+	}
+	return &PromoRedemptionQuery{
+		config:        prq.config,
+		limit:         prq.limit,
+		offset:        prq.offset,
+		order:         append([]OrderFunc{}, prq.order...),
+		predicates:    append([]predicate.PromoRedemption{}, prq.predicates...),
+		withPromoCode: prq.withPromoCode.Clone(),
+		withBooking:   prq.withBooking.Clone(),
+		// clone intermediate query.
+		sql:  prq.sql.Clone(),
+		path: prq.path,
+	}
+}
+
+// WithPromoCode tells the query-builder to eager-load the nodes that are connected to
+// the "promoCode" edge. The optional arguments are used to configure the query builder of the edge.
+func (prq *PromoRedemptionQuery) WithPromoCode(opts ...func(*PromoCodeQuery)) *PromoRedemptionQuery {
+	query := &PromoCodeQuery{config: prq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	prq.withPromoCode = query
+	return prq
+}
+
+// WithBooking tells the query-builder to eager-load the nodes that are connected to
+// the "booking" edge. The optional arguments are used to configure the query builder of the edge.
+func (prq *PromoRedemptionQuery) WithBooking(opts ...func(*BookingQuery)) *PromoRedemptionQuery {
+	query := &BookingQuery{config: prq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	prq.withBooking = query
+	return prq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"createdAt,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PromoRedemption.Query().
+//		GroupBy(promoredemption.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (prq *PromoRedemptionQuery) GroupBy(field string, fields ...string) *PromoRedemptionGroupBy {
+	group := &PromoRedemptionGroupBy{config: prq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := prq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return prq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"createdAt,omitempty"`
+//	}
+//
+//	client.PromoRedemption.Query().
+//		Select(promoredemption.FieldCreatedAt).
+//		Scan(ctx, &v)
+//
+func (prq *PromoRedemptionQuery) Select(fields ...string) *PromoRedemptionSelect {
+	prq.fields = append(prq.fields, fields...)
+	return &PromoRedemptionSelect{PromoRedemptionQuery: prq}
+}
+
+func (prq *PromoRedemptionQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range prq.fields {
+		if !promoredemption.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if prq.path != nil {
+		prev, err := prq.path(ctx)
+		if err != nil {
+			return err
+		}
+		prq.sql = prev
+	}
+	if promoredemption.Policy == nil {
+		return errors.New("ent: uninitialized promoredemption.Policy (forgotten import ent/runtime?)")
+	}
+	if err := promoredemption.Policy.EvalQuery(ctx, prq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (prq *PromoRedemptionQuery) sqlAll(ctx context.Context) ([]*PromoRedemption, error) {
+	var (
+		nodes       = []*PromoRedemption{}
+		_spec       = prq.querySpec()
+		loadedTypes = [2]bool{
+			prq.withPromoCode != nil,
+			prq.withBooking != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &PromoRedemption{config: prq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(prq.modifiers) > 0 {
+		_spec.Modifiers = prq.modifiers
+	}
+	if err := sqlgraph.QueryNodes(ctx, prq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := prq.withPromoCode; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*PromoRedemption)
+		for i := range nodes {
+			fk := nodes[i].PromoCodeId
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(promocode.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "promoCodeId" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.PromoCode = n
+			}
+		}
+	}
+
+	if query := prq.withBooking; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*PromoRedemption)
+		for i := range nodes {
+			fk := nodes[i].BookingId
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(booking.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "bookingId" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Booking = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (prq *PromoRedemptionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := prq.querySpec()
+	if len(prq.modifiers) > 0 {
+		_spec.Modifiers = prq.modifiers
+	}
+	return sqlgraph.CountNodes(ctx, prq.driver, _spec)
+}
+
+func (prq *PromoRedemptionQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := prq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (prq *PromoRedemptionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   promoredemption.Table,
+			Columns: promoredemption.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: promoredemption.FieldID,
+			},
+		},
+		From:   prq.sql,
+		Unique: true,
+	}
+	if unique := prq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := prq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, promoredemption.FieldID)
+		for i := range fields {
+			if fields[i] != promoredemption.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := prq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := prq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := prq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := prq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (prq *PromoRedemptionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(prq.driver.Dialect())
+	t1 := builder.Table(promoredemption.Table)
+	columns := prq.fields
+	if len(columns) == 0 {
+		columns = promoredemption.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if prq.sql != nil {
+		selector = prq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	for _, m := range prq.modifiers {
+		m(selector)
+	}
+	for _, p := range prq.predicates {
+		p(selector)
+	}
+	for _, p := range prq.order {
+		p(selector)
+	}
+	if offset := prq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := prq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (prq *PromoRedemptionQuery) ForUpdate(opts ...sql.LockOption) *PromoRedemptionQuery {
+	if prq.driver.Dialect() == dialect.Postgres {
+		prq.Unique(false)
+	}
+	prq.modifiers = append(prq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return prq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (prq *PromoRedemptionQuery) ForShare(opts ...sql.LockOption) *PromoRedemptionQuery {
+	if prq.driver.Dialect() == dialect.Postgres {
+		prq.Unique(false)
+	}
+	prq.modifiers = append(prq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return prq
+}
+
+// PromoRedemptionGroupBy is the group-by builder for PromoRedemption entities.
+type PromoRedemptionGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (prgb *PromoRedemptionGroupBy) Aggregate(fns ...AggregateFunc) *PromoRedemptionGroupBy {
+	prgb.fns = append(prgb.fns, fns...)
+	return prgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (prgb *PromoRedemptionGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := prgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	prgb.sql = query
+	return prgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (prgb *PromoRedemptionGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := prgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (prgb *PromoRedemptionGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(prgb.fields) > 1 {
+		return nil, errors.New("ent: PromoRedemptionGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := prgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (prgb *PromoRedemptionGroupBy) StringsX(ctx context.Context) []string {
+	v, err := prgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (prgb *PromoRedemptionGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = prgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{promoredemption.Label}
+	default:
+		err = fmt.Errorf("ent: PromoRedemptionGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (prgb *PromoRedemptionGroupBy) StringX(ctx context.Context) string {
+	v, err := prgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (prgb *PromoRedemptionGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(prgb.fields) > 1 {
+		return nil, errors.New("ent: PromoRedemptionGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := prgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (prgb *PromoRedemptionGroupBy) IntsX(ctx context.Context) []int {
+	v, err := prgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (prgb *PromoRedemptionGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = prgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{promoredemption.Label}
+	default:
+		err = fmt.Errorf("ent: PromoRedemptionGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (prgb *PromoRedemptionGroupBy) IntX(ctx context.Context) int {
+	v, err := prgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (prgb *PromoRedemptionGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(prgb.fields) > 1 {
+		return nil, errors.New("ent: PromoRedemptionGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := prgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (prgb *PromoRedemptionGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := prgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (prgb *PromoRedemptionGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = prgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{promoredemption.Label}
+	default:
+		err = fmt.Errorf("ent: PromoRedemptionGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (prgb *PromoRedemptionGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := prgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (prgb *PromoRedemptionGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(prgb.fields) > 1 {
+		return nil, errors.New("ent: PromoRedemptionGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := prgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (prgb *PromoRedemptionGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := prgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (prgb *PromoRedemptionGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = prgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{promoredemption.Label}
+	default:
+		err = fmt.Errorf("ent: PromoRedemptionGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (prgb *PromoRedemptionGroupBy) BoolX(ctx context.Context) bool {
+	v, err := prgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (prgb *PromoRedemptionGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range prgb.fields {
+		if !promoredemption.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := prgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := prgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (prgb *PromoRedemptionGroupBy) sqlQuery() *sql.Selector {
+	selector := prgb.sql.Select()
+	aggregation := make([]string, 0, len(prgb.fns))
+	for _, fn := range prgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(prgb.fields)+len(prgb.fns))
+		for _, f := range prgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		for _, c := range aggregation {
+			columns = append(columns, c)
+		}
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(prgb.fields...)...)
+}
+
+// PromoRedemptionSelect is the builder for selecting fields of PromoRedemption entities.
+type PromoRedemptionSelect struct {
+	*PromoRedemptionQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (prs *PromoRedemptionSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := prs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	prs.sql = prs.PromoRedemptionQuery.sqlQuery(ctx)
+	return prs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (prs *PromoRedemptionSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := prs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (prs *PromoRedemptionSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(prs.fields) > 1 {
+		return nil, errors.New("ent: PromoRedemptionSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := prs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (prs *PromoRedemptionSelect) StringsX(ctx context.Context) []string {
+	v, err := prs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (prs *PromoRedemptionSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = prs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{promoredemption.Label}
+	default:
+		err = fmt.Errorf("ent: PromoRedemptionSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (prs *PromoRedemptionSelect) StringX(ctx context.Context) string {
+	v, err := prs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (prs *PromoRedemptionSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(prs.fields) > 1 {
+		return nil, errors.New("ent: PromoRedemptionSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := prs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (prs *PromoRedemptionSelect) IntsX(ctx context.Context) []int {
+	v, err := prs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (prs *PromoRedemptionSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = prs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{promoredemption.Label}
+	default:
+		err = fmt.Errorf("ent: PromoRedemptionSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (prs *PromoRedemptionSelect) IntX(ctx context.Context) int {
+	v, err := prs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (prs *PromoRedemptionSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(prs.fields) > 1 {
+		return nil, errors.New("ent: PromoRedemptionSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := prs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (prs *PromoRedemptionSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := prs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (prs *PromoRedemptionSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = prs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{promoredemption.Label}
+	default:
+		err = fmt.Errorf("ent: PromoRedemptionSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (prs *PromoRedemptionSelect) Float64X(ctx context.Context) float64 {
+	v, err := prs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (prs *PromoRedemptionSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(prs.fields) > 1 {
+		return nil, errors.New("ent: PromoRedemptionSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := prs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (prs *PromoRedemptionSelect) BoolsX(ctx context.Context) []bool {
+	v, err := prs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (prs *PromoRedemptionSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = prs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{promoredemption.Label}
+	default:
+		err = fmt.Errorf("ent: PromoRedemptionSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (prs *PromoRedemptionSelect) BoolX(ctx context.Context) bool {
+	v, err := prs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (prs *PromoRedemptionSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := prs.sql.Query()
+	if err := prs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
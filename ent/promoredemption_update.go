@@ -0,0 +1,726 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/predicate"
+	"github.com/openmesh/booking/ent/promocode"
+	"github.com/openmesh/booking/ent/promoredemption"
+)
+
+// PromoRedemptionUpdate is the builder for updating PromoRedemption entities.
+type PromoRedemptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PromoRedemptionMutation
+}
+
+// Where appends a list predicates to the PromoRedemptionUpdate builder.
+func (pru *PromoRedemptionUpdate) Where(ps ...predicate.PromoRedemption) *PromoRedemptionUpdate {
+	pru.mutation.Where(ps...)
+	return pru
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (pru *PromoRedemptionUpdate) SetUpdatedAt(t time.Time) *PromoRedemptionUpdate {
+	pru.mutation.SetUpdatedAt(t)
+	return pru
+}
+
+// SetCustomer sets the "customer" field.
+func (pru *PromoRedemptionUpdate) SetCustomer(s string) *PromoRedemptionUpdate {
+	pru.mutation.SetCustomer(s)
+	return pru
+}
+
+// SetNillableCustomer sets the "customer" field if the given value is not nil.
+func (pru *PromoRedemptionUpdate) SetNillableCustomer(s *string) *PromoRedemptionUpdate {
+	if s != nil {
+		pru.SetCustomer(*s)
+	}
+	return pru
+}
+
+// ClearCustomer clears the value of the "customer" field.
+func (pru *PromoRedemptionUpdate) ClearCustomer() *PromoRedemptionUpdate {
+	pru.mutation.ClearCustomer()
+	return pru
+}
+
+// SetDiscount sets the "discount" field.
+func (pru *PromoRedemptionUpdate) SetDiscount(i int) *PromoRedemptionUpdate {
+	pru.mutation.ResetDiscount()
+	pru.mutation.SetDiscount(i)
+	return pru
+}
+
+// AddDiscount adds i to the "discount" field.
+func (pru *PromoRedemptionUpdate) AddDiscount(i int) *PromoRedemptionUpdate {
+	pru.mutation.AddDiscount(i)
+	return pru
+}
+
+// SetCurrency sets the "currency" field.
+func (pru *PromoRedemptionUpdate) SetCurrency(s string) *PromoRedemptionUpdate {
+	pru.mutation.SetCurrency(s)
+	return pru
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (pru *PromoRedemptionUpdate) SetNillableCurrency(s *string) *PromoRedemptionUpdate {
+	if s != nil {
+		pru.SetCurrency(*s)
+	}
+	return pru
+}
+
+// SetPromoCodeId sets the "promoCodeId" field.
+func (pru *PromoRedemptionUpdate) SetPromoCodeId(i int) *PromoRedemptionUpdate {
+	pru.mutation.SetPromoCodeId(i)
+	return pru
+}
+
+// SetBookingId sets the "bookingId" field.
+func (pru *PromoRedemptionUpdate) SetBookingId(i int) *PromoRedemptionUpdate {
+	pru.mutation.SetBookingId(i)
+	return pru
+}
+
+// SetPromoCodeID sets the "promoCode" edge to the PromoCode entity by ID.
+func (pru *PromoRedemptionUpdate) SetPromoCodeID(id int) *PromoRedemptionUpdate {
+	pru.mutation.SetPromoCodeID(id)
+	return pru
+}
+
+// SetPromoCode sets the "promoCode" edge to the PromoCode entity.
+func (pru *PromoRedemptionUpdate) SetPromoCode(p *PromoCode) *PromoRedemptionUpdate {
+	return pru.SetPromoCodeID(p.ID)
+}
+
+// SetBookingID sets the "booking" edge to the Booking entity by ID.
+func (pru *PromoRedemptionUpdate) SetBookingID(id int) *PromoRedemptionUpdate {
+	pru.mutation.SetBookingID(id)
+	return pru
+}
+
+// SetBooking sets the "booking" edge to the Booking entity.
+func (pru *PromoRedemptionUpdate) SetBooking(b *Booking) *PromoRedemptionUpdate {
+	return pru.SetBookingID(b.ID)
+}
+
+// Mutation returns the PromoRedemptionMutation object of the builder.
+func (pru *PromoRedemptionUpdate) Mutation() *PromoRedemptionMutation {
+	return pru.mutation
+}
+
+// ClearPromoCode clears the "promoCode" edge to the PromoCode entity.
+func (pru *PromoRedemptionUpdate) ClearPromoCode() *PromoRedemptionUpdate {
+	pru.mutation.ClearPromoCode()
+	return pru
+}
+
+// ClearBooking clears the "booking" edge to the Booking entity.
+func (pru *PromoRedemptionUpdate) ClearBooking() *PromoRedemptionUpdate {
+	pru.mutation.ClearBooking()
+	return pru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pru *PromoRedemptionUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if err := pru.defaults(); err != nil {
+		return 0, err
+	}
+	if len(pru.hooks) == 0 {
+		if err = pru.check(); err != nil {
+			return 0, err
+		}
+		affected, err = pru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PromoRedemptionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pru.check(); err != nil {
+				return 0, err
+			}
+			pru.mutation = mutation
+			affected, err = pru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(pru.hooks) - 1; i >= 0; i-- {
+			if pru.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pru *PromoRedemptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := pru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pru *PromoRedemptionUpdate) Exec(ctx context.Context) error {
+	_, err := pru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pru *PromoRedemptionUpdate) ExecX(ctx context.Context) {
+	if err := pru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pru *PromoRedemptionUpdate) defaults() error {
+	if _, ok := pru.mutation.UpdatedAt(); !ok {
+		if promoredemption.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized promoredemption.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := promoredemption.UpdateDefaultUpdatedAt()
+		pru.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pru *PromoRedemptionUpdate) check() error {
+	if _, ok := pru.mutation.PromoCodeID(); pru.mutation.PromoCodeCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"promoCode\"")
+	}
+	if _, ok := pru.mutation.BookingID(); pru.mutation.BookingCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"booking\"")
+	}
+	return nil
+}
+
+func (pru *PromoRedemptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   promoredemption.Table,
+			Columns: promoredemption.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: promoredemption.FieldID,
+			},
+		},
+	}
+	if ps := pru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pru.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: promoredemption.FieldUpdatedAt,
+		})
+	}
+	if value, ok := pru.mutation.Customer(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promoredemption.FieldCustomer,
+		})
+	}
+	if pru.mutation.CustomerCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: promoredemption.FieldCustomer,
+		})
+	}
+	if value, ok := pru.mutation.Discount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promoredemption.FieldDiscount,
+		})
+	}
+	if value, ok := pru.mutation.AddedDiscount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promoredemption.FieldDiscount,
+		})
+	}
+	if value, ok := pru.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promoredemption.FieldCurrency,
+		})
+	}
+	if pru.mutation.PromoCodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   promoredemption.PromoCodeTable,
+			Columns: []string{promoredemption.PromoCodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: promocode.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pru.mutation.PromoCodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   promoredemption.PromoCodeTable,
+			Columns: []string{promoredemption.PromoCodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: promocode.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pru.mutation.BookingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   promoredemption.BookingTable,
+			Columns: []string{promoredemption.BookingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pru.mutation.BookingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   promoredemption.BookingTable,
+			Columns: []string{promoredemption.BookingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promoredemption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// PromoRedemptionUpdateOne is the builder for updating a single PromoRedemption entity.
+type PromoRedemptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PromoRedemptionMutation
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (pruo *PromoRedemptionUpdateOne) SetUpdatedAt(t time.Time) *PromoRedemptionUpdateOne {
+	pruo.mutation.SetUpdatedAt(t)
+	return pruo
+}
+
+// SetCustomer sets the "customer" field.
+func (pruo *PromoRedemptionUpdateOne) SetCustomer(s string) *PromoRedemptionUpdateOne {
+	pruo.mutation.SetCustomer(s)
+	return pruo
+}
+
+// SetNillableCustomer sets the "customer" field if the given value is not nil.
+func (pruo *PromoRedemptionUpdateOne) SetNillableCustomer(s *string) *PromoRedemptionUpdateOne {
+	if s != nil {
+		pruo.SetCustomer(*s)
+	}
+	return pruo
+}
+
+// ClearCustomer clears the value of the "customer" field.
+func (pruo *PromoRedemptionUpdateOne) ClearCustomer() *PromoRedemptionUpdateOne {
+	pruo.mutation.ClearCustomer()
+	return pruo
+}
+
+// SetDiscount sets the "discount" field.
+func (pruo *PromoRedemptionUpdateOne) SetDiscount(i int) *PromoRedemptionUpdateOne {
+	pruo.mutation.ResetDiscount()
+	pruo.mutation.SetDiscount(i)
+	return pruo
+}
+
+// AddDiscount adds i to the "discount" field.
+func (pruo *PromoRedemptionUpdateOne) AddDiscount(i int) *PromoRedemptionUpdateOne {
+	pruo.mutation.AddDiscount(i)
+	return pruo
+}
+
+// SetCurrency sets the "currency" field.
+func (pruo *PromoRedemptionUpdateOne) SetCurrency(s string) *PromoRedemptionUpdateOne {
+	pruo.mutation.SetCurrency(s)
+	return pruo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (pruo *PromoRedemptionUpdateOne) SetNillableCurrency(s *string) *PromoRedemptionUpdateOne {
+	if s != nil {
+		pruo.SetCurrency(*s)
+	}
+	return pruo
+}
+
+// SetPromoCodeId sets the "promoCodeId" field.
+func (pruo *PromoRedemptionUpdateOne) SetPromoCodeId(i int) *PromoRedemptionUpdateOne {
+	pruo.mutation.SetPromoCodeId(i)
+	return pruo
+}
+
+// SetBookingId sets the "bookingId" field.
+func (pruo *PromoRedemptionUpdateOne) SetBookingId(i int) *PromoRedemptionUpdateOne {
+	pruo.mutation.SetBookingId(i)
+	return pruo
+}
+
+// SetPromoCodeID sets the "promoCode" edge to the PromoCode entity by ID.
+func (pruo *PromoRedemptionUpdateOne) SetPromoCodeID(id int) *PromoRedemptionUpdateOne {
+	pruo.mutation.SetPromoCodeID(id)
+	return pruo
+}
+
+// SetPromoCode sets the "promoCode" edge to the PromoCode entity.
+func (pruo *PromoRedemptionUpdateOne) SetPromoCode(p *PromoCode) *PromoRedemptionUpdateOne {
+	return pruo.SetPromoCodeID(p.ID)
+}
+
+// SetBookingID sets the "booking" edge to the Booking entity by ID.
+func (pruo *PromoRedemptionUpdateOne) SetBookingID(id int) *PromoRedemptionUpdateOne {
+	pruo.mutation.SetBookingID(id)
+	return pruo
+}
+
+// SetBooking sets the "booking" edge to the Booking entity.
+func (pruo *PromoRedemptionUpdateOne) SetBooking(b *Booking) *PromoRedemptionUpdateOne {
+	return pruo.SetBookingID(b.ID)
+}
+
+// Mutation returns the PromoRedemptionMutation object of the builder.
+func (pruo *PromoRedemptionUpdateOne) Mutation() *PromoRedemptionMutation {
+	return pruo.mutation
+}
+
+// ClearPromoCode clears the "promoCode" edge to the PromoCode entity.
+func (pruo *PromoRedemptionUpdateOne) ClearPromoCode() *PromoRedemptionUpdateOne {
+	pruo.mutation.ClearPromoCode()
+	return pruo
+}
+
+// ClearBooking clears the "booking" edge to the Booking entity.
+func (pruo *PromoRedemptionUpdateOne) ClearBooking() *PromoRedemptionUpdateOne {
+	pruo.mutation.ClearBooking()
+	return pruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pruo *PromoRedemptionUpdateOne) Select(field string, fields ...string) *PromoRedemptionUpdateOne {
+	pruo.fields = append([]string{field}, fields...)
+	return pruo
+}
+
+// Save executes the query and returns the updated PromoRedemption entity.
+func (pruo *PromoRedemptionUpdateOne) Save(ctx context.Context) (*PromoRedemption, error) {
+	var (
+		err  error
+		node *PromoRedemption
+	)
+	if err := pruo.defaults(); err != nil {
+		return nil, err
+	}
+	if len(pruo.hooks) == 0 {
+		if err = pruo.check(); err != nil {
+			return nil, err
+		}
+		node, err = pruo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PromoRedemptionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pruo.check(); err != nil {
+				return nil, err
+			}
+			pruo.mutation = mutation
+			node, err = pruo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pruo.hooks) - 1; i >= 0; i-- {
+			if pruo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pruo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pruo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pruo *PromoRedemptionUpdateOne) SaveX(ctx context.Context) *PromoRedemption {
+	node, err := pruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pruo *PromoRedemptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := pruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pruo *PromoRedemptionUpdateOne) ExecX(ctx context.Context) {
+	if err := pruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pruo *PromoRedemptionUpdateOne) defaults() error {
+	if _, ok := pruo.mutation.UpdatedAt(); !ok {
+		if promoredemption.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized promoredemption.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := promoredemption.UpdateDefaultUpdatedAt()
+		pruo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pruo *PromoRedemptionUpdateOne) check() error {
+	if _, ok := pruo.mutation.PromoCodeID(); pruo.mutation.PromoCodeCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"promoCode\"")
+	}
+	if _, ok := pruo.mutation.BookingID(); pruo.mutation.BookingCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"booking\"")
+	}
+	return nil
+}
+
+func (pruo *PromoRedemptionUpdateOne) sqlSave(ctx context.Context) (_node *PromoRedemption, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   promoredemption.Table,
+			Columns: promoredemption.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: promoredemption.FieldID,
+			},
+		},
+	}
+	id, ok := pruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing PromoRedemption.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, promoredemption.FieldID)
+		for _, f := range fields {
+			if !promoredemption.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != promoredemption.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pruo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: promoredemption.FieldUpdatedAt,
+		})
+	}
+	if value, ok := pruo.mutation.Customer(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promoredemption.FieldCustomer,
+		})
+	}
+	if pruo.mutation.CustomerCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: promoredemption.FieldCustomer,
+		})
+	}
+	if value, ok := pruo.mutation.Discount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promoredemption.FieldDiscount,
+		})
+	}
+	if value, ok := pruo.mutation.AddedDiscount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promoredemption.FieldDiscount,
+		})
+	}
+	if value, ok := pruo.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promoredemption.FieldCurrency,
+		})
+	}
+	if pruo.mutation.PromoCodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   promoredemption.PromoCodeTable,
+			Columns: []string{promoredemption.PromoCodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: promocode.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pruo.mutation.PromoCodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   promoredemption.PromoCodeTable,
+			Columns: []string{promoredemption.PromoCodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: promocode.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pruo.mutation.BookingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   promoredemption.BookingTable,
+			Columns: []string{promoredemption.BookingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pruo.mutation.BookingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   promoredemption.BookingTable,
+			Columns: []string{promoredemption.BookingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PromoRedemption{config: pruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promoredemption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,556 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/openmesh/booking"
+	entbooking "github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/payment"
+	"github.com/openmesh/booking/ent/predicate"
+	"github.com/openmesh/booking/ent/resource"
+	"github.com/openmesh/booking/ent/user"
+)
+
+type reportService struct {
+	client *Client
+}
+
+// NewReportService constructs a new instance of a booking.ReportService using
+// ent as its persistence layer.
+func NewReportService(client *Client) *reportService {
+	return &reportService{client}
+}
+
+// GetRecentSalesReport returns the most recently made bookings that count as
+// sales along with the total value of all sales in the window, broken down by
+// the tax rate that they were charged.
+func (s *reportService) GetRecentSalesReport(
+	ctx context.Context,
+	req booking.GetRecentSalesReportRequest,
+) booking.GetRecentSalesReportResponse {
+	now := time.Now()
+	from, to := reportWindow(req.From, req.To, now.AddDate(0, 0, -30), now)
+
+	preds := []predicate.Booking{
+		entbooking.StatusIn(booking.SalesBookingStatuses...),
+		entbooking.CreatedAtGTE(from),
+		entbooking.CreatedAtLT(to),
+	}
+	if req.ResourceID != nil {
+		preds = append(preds, entbooking.ResourceId(*req.ResourceID))
+	}
+	q := s.client.Booking.
+		Query().
+		Where(preds...)
+
+	var totals []struct {
+		Currency     string `json:"currency"`
+		TaxName      string `json:"tax_name"`
+		TaxRate      int    `json:"tax_rate"`
+		TaxInclusive bool   `json:"tax_inclusive"`
+		Count        int    `json:"count"`
+		Sum          int    `json:"sum"`
+		Tax          int    `json:"tax"`
+	}
+	err := q.Clone().
+		GroupBy(entbooking.FieldCurrency, entbooking.FieldTaxName, entbooking.FieldTaxRate, entbooking.FieldTaxInclusive).
+		Aggregate(Count(), Sum(entbooking.FieldPrice), sumAs(entbooking.FieldTaxAmount, "tax")).
+		Scan(ctx, &totals)
+	if err != nil {
+		return booking.GetRecentSalesReportResponse{
+			Err: fmt.Errorf("failed to total sales: %w", err),
+		}
+	}
+
+	res := booking.GetRecentSalesReportResponse{
+		Sales:         make([]*booking.SalesReportRow, 0),
+		TotalRevenue:  booking.TaxedTotals{},
+		TotalDeposits: booking.MoneyTotals{},
+		TaxBreakdown:  make([]*booking.TaxReportRow, 0, len(totals)),
+	}
+	for _, t := range totals {
+		revenue := booking.NewTaxedMoney(t.Sum, t.Tax, t.Currency)
+		res.TotalSales += t.Count
+		res.TotalRevenue = res.TotalRevenue.Add(revenue)
+		res.TaxBreakdown = append(res.TaxBreakdown, &booking.TaxReportRow{
+			TaxRate:  appliedTax(t.TaxName, t.TaxRate, t.TaxInclusive),
+			Bookings: t.Count,
+			Revenue:  revenue,
+		})
+	}
+	sortTaxBreakdown(res.TaxBreakdown)
+
+	// Deposits are what was paid for the sales, less what has been refunded.
+	var deposits []struct {
+		Currency string `json:"currency"`
+		Sum      int    `json:"sum"`
+		Refunded int    `json:"refunded"`
+	}
+	err = s.client.Payment.
+		Query().
+		Where(
+			payment.HasBookingWith(preds...),
+			payment.StatusIn(paidPaymentStatuses...),
+		).
+		GroupBy(payment.FieldCurrency).
+		Aggregate(Sum(payment.FieldAmount), sumAs(payment.FieldAmountRefunded, "refunded")).
+		Scan(ctx, &deposits)
+	if err != nil {
+		return booking.GetRecentSalesReportResponse{
+			Err: fmt.Errorf("failed to total deposits: %w", err),
+		}
+	}
+	for _, d := range deposits {
+		res.TotalDeposits = res.TotalDeposits.Add(booking.NewMoney(d.Sum-d.Refunded, d.Currency))
+	}
+
+	b, err := q.
+		WithResource().
+		Order(Desc(entbooking.FieldCreatedAt)).
+		Limit(reportLimit(req.Limit)).
+		All(ctx)
+	if err != nil {
+		return booking.GetRecentSalesReportResponse{
+			Err: fmt.Errorf("failed to query sales: %w", err),
+		}
+	}
+	ids := make([]int, 0, len(b))
+	for _, v := range b {
+		ids = append(ids, v.ID)
+	}
+	paid, err := bookingDeposits(ctx, s.client, ids)
+	if err != nil {
+		return booking.GetRecentSalesReportResponse{Err: err}
+	}
+	for _, v := range b {
+		deposit, ok := paid[v.ID]
+		if !ok {
+			deposit = booking.NewMoney(0, v.Currency)
+		}
+		res.Sales = append(res.Sales, &booking.SalesReportRow{
+			BookingReportRow: v.toReportRow(),
+			Price:            v.taxed(),
+			BookingPrice:     deposit,
+			TaxRate:          v.appliedTax(),
+		})
+	}
+	return res
+}
+
+// GetUpcomingBookingsReport returns active bookings that have not started yet
+// ordered by start time.
+func (s *reportService) GetUpcomingBookingsReport(
+	ctx context.Context,
+	req booking.GetUpcomingBookingsReportRequest,
+) booking.GetUpcomingBookingsReportResponse {
+	now := time.Now()
+	from, to := reportWindow(req.From, req.To, now, now.AddDate(0, 0, 7))
+	if from.Before(now) {
+		from = now
+	}
+
+	q := s.client.Booking.
+		Query().
+		Where(bookingReportPredicates(booking.ActiveBookingStatuses, from, to, req.ResourceID)...)
+
+	c, err := q.Clone().Count(ctx)
+	if err != nil {
+		return booking.GetUpcomingBookingsReportResponse{
+			Err: fmt.Errorf("failed to count upcoming bookings: %w", err),
+		}
+	}
+
+	b, err := q.
+		WithResource().
+		Order(Asc(entbooking.FieldStartTime)).
+		Limit(reportLimit(req.Limit)).
+		All(ctx)
+	if err != nil {
+		return booking.GetUpcomingBookingsReportResponse{
+			Err: fmt.Errorf("failed to query upcoming bookings: %w", err),
+		}
+	}
+
+	return booking.GetUpcomingBookingsReportResponse{
+		Bookings:   Bookings(b).toReportRows(),
+		TotalItems: c,
+	}
+}
+
+// GetBookingsActivityReport returns the number of bookings and the revenue
+// from them for each day in the window. Days are in UTC.
+func (s *reportService) GetBookingsActivityReport(
+	ctx context.Context,
+	req booking.GetBookingsActivityReportRequest,
+) booking.GetBookingsActivityReportResponse {
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	from, to := reportWindow(req.From, req.To, today.AddDate(0, 0, -29), today.AddDate(0, 0, 1))
+
+	// Include every booking that took place or is still going to take place.
+	var statuses []string
+	statuses = append(statuses, booking.SalesBookingStatuses...)
+	statuses = append(statuses, booking.ActiveBookingStatuses...)
+	b, err := s.client.Booking.
+		Query().
+		Where(bookingReportPredicates(statuses, from, to, req.ResourceID)...).
+		WithResource().
+		All(ctx)
+	if err != nil {
+		return booking.GetBookingsActivityReportResponse{
+			Err: fmt.Errorf("failed to query bookings: %w", err),
+		}
+	}
+
+	// Start with an empty row for every day so that days without any bookings
+	// are still included in the report.
+	var days []*booking.ActivityReportRow
+	rows := make(map[string]*booking.ActivityReportRow)
+	from = from.UTC()
+	for d := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC); d.Before(to); d = d.AddDate(0, 0, 1) {
+		row := &booking.ActivityReportRow{Date: d.Format("2006-01-02"), Revenue: booking.TaxedTotals{}}
+		rows[row.Date] = row
+		days = append(days, row)
+	}
+	for _, v := range b {
+		row, ok := rows[v.StartTime.UTC().Format("2006-01-02")]
+		if !ok {
+			continue
+		}
+		row.Bookings++
+		if booking.SaleBookingStatus(v.Status) {
+			row.Revenue = row.Revenue.Add(v.taxed())
+		}
+	}
+
+	return booking.GetBookingsActivityReportResponse{Days: days}
+}
+
+// GetTodaysBookingsReport returns the active bookings that start today in UTC
+// ordered by start time.
+func (s *reportService) GetTodaysBookingsReport(
+	ctx context.Context,
+	req booking.GetTodaysBookingsReportRequest,
+) booking.GetTodaysBookingsReportResponse {
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	from, to := reportWindow(req.From, req.To, today, today.AddDate(0, 0, 1))
+
+	b, err := s.client.Booking.
+		Query().
+		Where(bookingReportPredicates(booking.ActiveBookingStatuses, from, to, req.ResourceID)...).
+		WithResource().
+		Order(Asc(entbooking.FieldStartTime)).
+		All(ctx)
+	if err != nil {
+		return booking.GetTodaysBookingsReportResponse{
+			Err: fmt.Errorf("failed to query bookings: %w", err),
+		}
+	}
+
+	return booking.GetTodaysBookingsReportResponse{
+		Bookings: Bookings(b).toReportRows(),
+	}
+}
+
+// GetTopResourcesReport returns the resources that generated the most revenue.
+func (s *reportService) GetTopResourcesReport(
+	ctx context.Context,
+	req booking.GetTopResourcesReportRequest,
+) booking.GetTopResourcesReportResponse {
+	now := time.Now()
+	from, to := reportWindow(req.From, req.To, now.AddDate(0, 0, -30), now)
+
+	currency, err := organizationCurrency(ctx, s.client)
+	if err != nil {
+		return booking.GetTopResourcesReportResponse{Err: err}
+	}
+
+	var counts []struct {
+		ResourceID int    `json:"resource_id"`
+		Currency   string `json:"currency"`
+		Count      int    `json:"count"`
+		Sum        int    `json:"sum"`
+		Tax        int    `json:"tax"`
+	}
+	err = s.client.Booking.
+		Query().
+		Where(bookingReportPredicates(booking.SalesBookingStatuses, from, to, req.ResourceID)...).
+		GroupBy(entbooking.FieldResourceId, entbooking.FieldCurrency).
+		Aggregate(Count(), Sum(entbooking.FieldPrice), sumAs(entbooking.FieldTaxAmount, "tax")).
+		Scan(ctx, &counts)
+	if err != nil {
+		return booking.GetTopResourcesReportResponse{
+			Err: fmt.Errorf("failed to count bookings by resource: %w", err),
+		}
+	}
+
+	ids := make([]int, 0, len(counts))
+	for _, c := range counts {
+		ids = append(ids, c.ResourceID)
+	}
+	resources, err := findResourcesByIDs(ctx, s.client, ids)
+	if err != nil {
+		return booking.GetTopResourcesReportResponse{Err: err}
+	}
+
+	// Bookings of a resource are counted once for each currency that they
+	// were made in.
+	byResource := make(map[int]*booking.TopResourceReportRow)
+	rows := make([]*booking.TopResourceReportRow, 0, len(counts))
+	for _, c := range counts {
+		r, ok := resources[c.ResourceID]
+		if !ok {
+			continue
+		}
+		row, ok := byResource[r.ID]
+		if !ok {
+			row = &booking.TopResourceReportRow{
+				ResourceID:   r.ID,
+				ResourceName: r.Name,
+				Revenue:      booking.TaxedTotals{},
+			}
+			byResource[r.ID] = row
+			rows = append(rows, row)
+		}
+		row.Bookings += c.Count
+		row.Revenue = row.Revenue.Add(booking.NewTaxedMoney(c.Sum, c.Tax, c.Currency))
+	}
+	// Revenue in different currencies can't be compared so resources are
+	// ranked by their gross revenue in the default currency.
+	sort.SliceStable(rows, func(i, j int) bool {
+		ri, rj := rows[i].Revenue.Gross(currency), rows[j].Revenue.Gross(currency)
+		if ri == rj {
+			if rows[i].Bookings == rows[j].Bookings {
+				return rows[i].ResourceID < rows[j].ResourceID
+			}
+			return rows[i].Bookings > rows[j].Bookings
+		}
+		return ri > rj
+	})
+	if limit := reportLimit(req.Limit); len(rows) > limit {
+		rows = rows[:limit]
+	}
+
+	return booking.GetTopResourcesReportResponse{Resources: rows}
+}
+
+// GetTopEmployeesReport returns the users that made the bookings which
+// generated the most revenue. Bookings that were not made by a user are not
+// included.
+func (s *reportService) GetTopEmployeesReport(
+	ctx context.Context,
+	req booking.GetTopEmployeesReportRequest,
+) booking.GetTopEmployeesReportResponse {
+	now := time.Now()
+	from, to := reportWindow(req.From, req.To, now.AddDate(0, 0, -30), now)
+
+	currency, err := organizationCurrency(ctx, s.client)
+	if err != nil {
+		return booking.GetTopEmployeesReportResponse{Err: err}
+	}
+
+	var counts []struct {
+		UserID   int    `json:"user_id"`
+		Currency string `json:"currency"`
+		Count    int    `json:"count"`
+		Sum      int    `json:"sum"`
+		Tax      int    `json:"tax"`
+	}
+	err = s.client.Booking.
+		Query().
+		Where(bookingReportPredicates(booking.SalesBookingStatuses, from, to, req.ResourceID)...).
+		Where(entbooking.UserIdNotNil()).
+		GroupBy(entbooking.FieldUserId, entbooking.FieldCurrency).
+		Aggregate(Count(), Sum(entbooking.FieldPrice), sumAs(entbooking.FieldTaxAmount, "tax")).
+		Scan(ctx, &counts)
+	if err != nil {
+		return booking.GetTopEmployeesReportResponse{
+			Err: fmt.Errorf("failed to count bookings by user: %w", err),
+		}
+	}
+
+	userIDs := make([]int, 0, len(counts))
+	for _, c := range counts {
+		userIDs = append(userIDs, c.UserID)
+	}
+	users, err := s.client.User.
+		Query().
+		Where(user.IDIn(userIDs...)).
+		All(ctx)
+	if err != nil {
+		return booking.GetTopEmployeesReportResponse{
+			Err: fmt.Errorf("failed to query users: %w", err),
+		}
+	}
+
+	rows := make(map[int]*booking.TopEmployeeReportRow)
+	for _, u := range users {
+		rows[u.ID] = &booking.TopEmployeeReportRow{UserID: u.ID, Name: u.Name, Revenue: booking.TaxedTotals{}}
+	}
+	for _, c := range counts {
+		row, ok := rows[c.UserID]
+		if !ok {
+			continue
+		}
+		row.Bookings += c.Count
+		row.Revenue = row.Revenue.Add(booking.NewTaxedMoney(c.Sum, c.Tax, c.Currency))
+	}
+
+	result := make([]*booking.TopEmployeeReportRow, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, row)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		ri, rj := result[i].Revenue.Gross(currency), result[j].Revenue.Gross(currency)
+		if ri == rj {
+			return result[i].UserID < result[j].UserID
+		}
+		return ri > rj
+	})
+	if limit := reportLimit(req.Limit); len(result) > limit {
+		result = result[:limit]
+	}
+
+	return booking.GetTopEmployeesReportResponse{Employees: result}
+}
+
+// bookingReportPredicates returns the predicates shared by reports that only
+// include bookings with one of the given statuses which start within
+// [from, to).
+func bookingReportPredicates(statuses []string, from, to time.Time, resourceID *int) []predicate.Booking {
+	ps := []predicate.Booking{
+		entbooking.StatusIn(statuses...),
+		entbooking.StartTimeGTE(from),
+		entbooking.StartTimeLT(to),
+	}
+	if resourceID != nil {
+		ps = append(ps, entbooking.ResourceId(*resourceID))
+	}
+	return ps
+}
+
+// sumAs applies the "sum" aggregation function on field and names the result
+// so that it can be scanned alongside another sum.
+func sumAs(field, name string) AggregateFunc {
+	return func(s *sql.Selector) string {
+		return sql.As(Sum(field)(s), name)
+	}
+}
+
+// sortTaxBreakdown orders the rows of a tax breakdown by currency and then by
+// tax rate, with sales made without tax first.
+func sortTaxBreakdown(rows []*booking.TaxReportRow) {
+	key := func(row *booking.TaxReportRow) booking.AppliedTax {
+		if row.TaxRate == nil {
+			return booking.AppliedTax{}
+		}
+		return *row.TaxRate
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		ki, kj := key(rows[i]), key(rows[j])
+		switch {
+		case rows[i].Revenue.Currency != rows[j].Revenue.Currency:
+			return rows[i].Revenue.Currency < rows[j].Revenue.Currency
+		case ki.Rate != kj.Rate:
+			return ki.Rate < kj.Rate
+		case ki.Name != kj.Name:
+			return ki.Name < kj.Name
+		}
+		return !ki.Inclusive && kj.Inclusive
+	})
+}
+
+// findResourcesByIDs returns the resources with the given IDs keyed by ID.
+func findResourcesByIDs(ctx context.Context, client *Client, ids []int) (map[int]*Resource, error) {
+	r, err := client.Resource.
+		Query().
+		Where(resource.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query resources: %w", err)
+	}
+	result := make(map[int]*Resource, len(r))
+	for _, v := range r {
+		result[v.ID] = v
+	}
+	return result, nil
+}
+
+// reportWindow returns the window of time that a report covers, falling back
+// to the provided defaults for any bound that was not requested.
+func reportWindow(from, to *time.Time, defaultFrom, defaultTo time.Time) (time.Time, time.Time) {
+	if from != nil {
+		defaultFrom = *from
+	}
+	if to != nil {
+		defaultTo = *to
+	}
+	return defaultFrom, defaultTo
+}
+
+// reportLimit returns the maximum number of rows to include in a report.
+func reportLimit(limit int) int {
+	if limit == 0 {
+		return 10
+	}
+	return limit
+}
+
+func (b *Booking) toReportRow() booking.BookingReportRow {
+	row := booking.BookingReportRow{
+		BookingID:  b.ID,
+		ResourceID: b.ResourceId,
+		Status:     b.Status,
+		StartTime:  b.StartTime,
+		EndTime:    b.EndTime,
+		CreatedAt:  b.CreatedAt,
+	}
+	if b.Edges.Resource != nil {
+		row.ResourceName = b.Edges.Resource.Name
+	}
+	return row
+}
+
+func (b Bookings) toReportRows() []*booking.BookingReportRow {
+	rows := make([]*booking.BookingReportRow, 0, len(b))
+	for _, v := range b {
+		row := v.toReportRow()
+		rows = append(rows, &row)
+	}
+	return rows
+}
+
+// paidPaymentStatuses are the statuses of payments that have been paid,
+// including those that have since been refunded.
+var paidPaymentStatuses = []string{
+	booking.PaymentStatusSucceeded,
+	booking.PaymentStatusPartiallyRefunded,
+	booking.PaymentStatusRefunded,
+}
+
+// bookingDeposits returns what was paid for each of the bookings with the
+// given IDs, less what has been refunded, keyed by booking ID. Bookings that
+// weren't paid for are left out.
+func bookingDeposits(ctx context.Context, client *Client, ids []int) (map[int]booking.Money, error) {
+	payments, err := client.Payment.
+		Query().
+		Where(
+			payment.BookingIdIn(ids...),
+			payment.StatusIn(paidPaymentStatuses...),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query deposits: %w", err)
+	}
+	deposits := make(map[int]booking.Money)
+	for _, p := range payments {
+		d := deposits[p.BookingId]
+		deposits[p.BookingId] = booking.NewMoney(d.Amount+p.Amount-p.AmountRefunded, p.Currency)
+	}
+	return deposits, nil
+}
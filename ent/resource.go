@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/openmesh/booking/ent/organization"
 	"github.com/openmesh/booking/ent/resource"
+	"github.com/openmesh/booking/ent/taxrate"
 )
 
 // Resource is the model entity for the Resource schema.
@@ -27,16 +28,28 @@ type Resource struct {
 	Description string `json:"description,omitempty"`
 	// Timezone holds the value of the "timezone" field.
 	Timezone string `json:"timezone,omitempty"`
-	// Password holds the value of the "password" field.
-	Password string `json:"password,omitempty"`
+	// PasswordHash holds the value of the "passwordHash" field.
+	PasswordHash string `json:"-"`
 	// Price holds the value of the "price" field.
 	Price int `json:"price,omitempty"`
 	// BookingPrice holds the value of the "bookingPrice" field.
 	BookingPrice int `json:"bookingPrice,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
 	// OrganizationId holds the value of the "organizationId" field.
 	OrganizationId int `json:"organizationId,omitempty"`
 	// QuantityAvailable holds the value of the "quantityAvailable" field.
 	QuantityAvailable *int `json:"quantityAvailable,omitempty"`
+	// CancellationPolicy holds the value of the "cancellationPolicy" field.
+	CancellationPolicy string `json:"cancellationPolicy,omitempty"`
+	// Pricing holds the value of the "pricing" field.
+	Pricing string `json:"pricing,omitempty"`
+	// BookingFields holds the value of the "bookingFields" field.
+	BookingFields string `json:"bookingFields,omitempty"`
+	// FeedTokenHash holds the value of the "feedTokenHash" field.
+	FeedTokenHash string `json:"-"`
+	// TaxRateId holds the value of the "taxRateId" field.
+	TaxRateId *int `json:"taxRateId,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ResourceQuery when eager-loading is set.
 	Edges ResourceEdges `json:"edges"`
@@ -48,13 +61,19 @@ type ResourceEdges struct {
 	Slots []*Slot `json:"slots,omitempty"`
 	// Bookings holds the value of the bookings edge.
 	Bookings []*Booking `json:"bookings,omitempty"`
+	// BookingSeries holds the value of the bookingSeries edge.
+	BookingSeries []*BookingSeries `json:"bookingSeries,omitempty"`
 	// Unavailabilities holds the value of the unavailabilities edge.
 	Unavailabilities []*Unavailability `json:"unavailabilities,omitempty"`
+	// WaitlistEntries holds the value of the waitlistEntries edge.
+	WaitlistEntries []*WaitlistEntry `json:"waitlistEntries,omitempty"`
 	// Organization holds the value of the organization edge.
 	Organization *Organization `json:"organization,omitempty"`
+	// TaxRate holds the value of the taxRate edge.
+	TaxRate *TaxRate `json:"taxRate,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [7]bool
 }
 
 // SlotsOrErr returns the Slots value or an error if the edge
@@ -75,19 +94,37 @@ func (e ResourceEdges) BookingsOrErr() ([]*Booking, error) {
 	return nil, &NotLoadedError{edge: "bookings"}
 }
 
+// BookingSeriesOrErr returns the BookingSeries value or an error if the edge
+// was not loaded in eager-loading.
+func (e ResourceEdges) BookingSeriesOrErr() ([]*BookingSeries, error) {
+	if e.loadedTypes[2] {
+		return e.BookingSeries, nil
+	}
+	return nil, &NotLoadedError{edge: "bookingSeries"}
+}
+
 // UnavailabilitiesOrErr returns the Unavailabilities value or an error if the edge
 // was not loaded in eager-loading.
 func (e ResourceEdges) UnavailabilitiesOrErr() ([]*Unavailability, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.Unavailabilities, nil
 	}
 	return nil, &NotLoadedError{edge: "unavailabilities"}
 }
 
+// WaitlistEntriesOrErr returns the WaitlistEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e ResourceEdges) WaitlistEntriesOrErr() ([]*WaitlistEntry, error) {
+	if e.loadedTypes[4] {
+		return e.WaitlistEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "waitlistEntries"}
+}
+
 // OrganizationOrErr returns the Organization value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e ResourceEdges) OrganizationOrErr() (*Organization, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[5] {
 		if e.Organization == nil {
 			// The edge organization was loaded in eager-loading,
 			// but was not found.
@@ -98,14 +135,28 @@ func (e ResourceEdges) OrganizationOrErr() (*Organization, error) {
 	return nil, &NotLoadedError{edge: "organization"}
 }
 
+// TaxRateOrErr returns the TaxRate value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ResourceEdges) TaxRateOrErr() (*TaxRate, error) {
+	if e.loadedTypes[6] {
+		if e.TaxRate == nil {
+			// The edge taxRate was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: taxrate.Label}
+		}
+		return e.TaxRate, nil
+	}
+	return nil, &NotLoadedError{edge: "taxRate"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Resource) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case resource.FieldID, resource.FieldPrice, resource.FieldBookingPrice, resource.FieldOrganizationId, resource.FieldQuantityAvailable:
+		case resource.FieldID, resource.FieldPrice, resource.FieldBookingPrice, resource.FieldOrganizationId, resource.FieldQuantityAvailable, resource.FieldTaxRateId:
 			values[i] = new(sql.NullInt64)
-		case resource.FieldName, resource.FieldDescription, resource.FieldTimezone, resource.FieldPassword:
+		case resource.FieldName, resource.FieldDescription, resource.FieldTimezone, resource.FieldPasswordHash, resource.FieldCurrency, resource.FieldCancellationPolicy, resource.FieldPricing, resource.FieldBookingFields, resource.FieldFeedTokenHash:
 			values[i] = new(sql.NullString)
 		case resource.FieldCreatedAt, resource.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -160,11 +211,11 @@ func (r *Resource) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				r.Timezone = value.String
 			}
-		case resource.FieldPassword:
+		case resource.FieldPasswordHash:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field password", values[i])
+				return fmt.Errorf("unexpected type %T for field passwordHash", values[i])
 			} else if value.Valid {
-				r.Password = value.String
+				r.PasswordHash = value.String
 			}
 		case resource.FieldPrice:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
@@ -178,6 +229,12 @@ func (r *Resource) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				r.BookingPrice = int(value.Int64)
 			}
+		case resource.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				r.Currency = value.String
+			}
 		case resource.FieldOrganizationId:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field organizationId", values[i])
@@ -191,6 +248,37 @@ func (r *Resource) assignValues(columns []string, values []interface{}) error {
 				r.QuantityAvailable = new(int)
 				*r.QuantityAvailable = int(value.Int64)
 			}
+		case resource.FieldCancellationPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cancellationPolicy", values[i])
+			} else if value.Valid {
+				r.CancellationPolicy = value.String
+			}
+		case resource.FieldPricing:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pricing", values[i])
+			} else if value.Valid {
+				r.Pricing = value.String
+			}
+		case resource.FieldBookingFields:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bookingFields", values[i])
+			} else if value.Valid {
+				r.BookingFields = value.String
+			}
+		case resource.FieldFeedTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field feedTokenHash", values[i])
+			} else if value.Valid {
+				r.FeedTokenHash = value.String
+			}
+		case resource.FieldTaxRateId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field taxRateId", values[i])
+			} else if value.Valid {
+				r.TaxRateId = new(int)
+				*r.TaxRateId = int(value.Int64)
+			}
 		}
 	}
 	return nil
@@ -206,16 +294,31 @@ func (r *Resource) QueryBookings() *BookingQuery {
 	return (&ResourceClient{config: r.config}).QueryBookings(r)
 }
 
+// QueryBookingSeries queries the "bookingSeries" edge of the Resource entity.
+func (r *Resource) QueryBookingSeries() *BookingSeriesQuery {
+	return (&ResourceClient{config: r.config}).QueryBookingSeries(r)
+}
+
 // QueryUnavailabilities queries the "unavailabilities" edge of the Resource entity.
 func (r *Resource) QueryUnavailabilities() *UnavailabilityQuery {
 	return (&ResourceClient{config: r.config}).QueryUnavailabilities(r)
 }
 
+// QueryWaitlistEntries queries the "waitlistEntries" edge of the Resource entity.
+func (r *Resource) QueryWaitlistEntries() *WaitlistEntryQuery {
+	return (&ResourceClient{config: r.config}).QueryWaitlistEntries(r)
+}
+
 // QueryOrganization queries the "organization" edge of the Resource entity.
 func (r *Resource) QueryOrganization() *OrganizationQuery {
 	return (&ResourceClient{config: r.config}).QueryOrganization(r)
 }
 
+// QueryTaxRate queries the "taxRate" edge of the Resource entity.
+func (r *Resource) QueryTaxRate() *TaxRateQuery {
+	return (&ResourceClient{config: r.config}).QueryTaxRate(r)
+}
+
 // Update returns a builder for updating this Resource.
 // Note that you need to call Resource.Unwrap() before calling this method if this Resource
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -249,18 +352,30 @@ func (r *Resource) String() string {
 	builder.WriteString(r.Description)
 	builder.WriteString(", timezone=")
 	builder.WriteString(r.Timezone)
-	builder.WriteString(", password=")
-	builder.WriteString(r.Password)
+	builder.WriteString(", passwordHash=<sensitive>")
 	builder.WriteString(", price=")
 	builder.WriteString(fmt.Sprintf("%v", r.Price))
 	builder.WriteString(", bookingPrice=")
 	builder.WriteString(fmt.Sprintf("%v", r.BookingPrice))
+	builder.WriteString(", currency=")
+	builder.WriteString(r.Currency)
 	builder.WriteString(", organizationId=")
 	builder.WriteString(fmt.Sprintf("%v", r.OrganizationId))
 	if v := r.QuantityAvailable; v != nil {
 		builder.WriteString(", quantityAvailable=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", cancellationPolicy=")
+	builder.WriteString(r.CancellationPolicy)
+	builder.WriteString(", pricing=")
+	builder.WriteString(r.Pricing)
+	builder.WriteString(", bookingFields=")
+	builder.WriteString(r.BookingFields)
+	builder.WriteString(", feedTokenHash=<sensitive>")
+	if v := r.TaxRateId; v != nil {
+		builder.WriteString(", taxRateId=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
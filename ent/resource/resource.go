@@ -23,24 +23,42 @@ const (
 	FieldDescription = "description"
 	// FieldTimezone holds the string denoting the timezone field in the database.
 	FieldTimezone = "timezone"
-	// FieldPassword holds the string denoting the password field in the database.
-	FieldPassword = "password"
+	// FieldPasswordHash holds the string denoting the passwordhash field in the database.
+	FieldPasswordHash = "password"
 	// FieldPrice holds the string denoting the price field in the database.
 	FieldPrice = "price"
 	// FieldBookingPrice holds the string denoting the bookingprice field in the database.
 	FieldBookingPrice = "booking_price"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
 	// FieldOrganizationId holds the string denoting the organizationid field in the database.
 	FieldOrganizationId = "organization_id"
 	// FieldQuantityAvailable holds the string denoting the quantityavailable field in the database.
 	FieldQuantityAvailable = "quantity_available"
+	// FieldCancellationPolicy holds the string denoting the cancellationpolicy field in the database.
+	FieldCancellationPolicy = "cancellation_policy"
+	// FieldPricing holds the string denoting the pricing field in the database.
+	FieldPricing = "pricing"
+	// FieldBookingFields holds the string denoting the bookingfields field in the database.
+	FieldBookingFields = "booking_fields"
+	// FieldFeedTokenHash holds the string denoting the feedtokenhash field in the database.
+	FieldFeedTokenHash = "feed_token_hash"
+	// FieldTaxRateId holds the string denoting the taxrateid field in the database.
+	FieldTaxRateId = "tax_rate_id"
 	// EdgeSlots holds the string denoting the slots edge name in mutations.
 	EdgeSlots = "slots"
 	// EdgeBookings holds the string denoting the bookings edge name in mutations.
 	EdgeBookings = "bookings"
+	// EdgeBookingSeries holds the string denoting the bookingseries edge name in mutations.
+	EdgeBookingSeries = "bookingSeries"
 	// EdgeUnavailabilities holds the string denoting the unavailabilities edge name in mutations.
 	EdgeUnavailabilities = "unavailabilities"
+	// EdgeWaitlistEntries holds the string denoting the waitlistentries edge name in mutations.
+	EdgeWaitlistEntries = "waitlistEntries"
 	// EdgeOrganization holds the string denoting the organization edge name in mutations.
 	EdgeOrganization = "organization"
+	// EdgeTaxRate holds the string denoting the taxrate edge name in mutations.
+	EdgeTaxRate = "taxRate"
 	// Table holds the table name of the resource in the database.
 	Table = "resources"
 	// SlotsTable is the table that holds the slots relation/edge.
@@ -57,6 +75,13 @@ const (
 	BookingsInverseTable = "bookings"
 	// BookingsColumn is the table column denoting the bookings relation/edge.
 	BookingsColumn = "resource_id"
+	// BookingSeriesTable is the table that holds the bookingSeries relation/edge.
+	BookingSeriesTable = "booking_series"
+	// BookingSeriesInverseTable is the table name for the BookingSeries entity.
+	// It exists in this package in order to avoid circular dependency with the "bookingseries" package.
+	BookingSeriesInverseTable = "booking_series"
+	// BookingSeriesColumn is the table column denoting the bookingSeries relation/edge.
+	BookingSeriesColumn = "resource_id"
 	// UnavailabilitiesTable is the table that holds the unavailabilities relation/edge.
 	UnavailabilitiesTable = "unavailabilities"
 	// UnavailabilitiesInverseTable is the table name for the Unavailability entity.
@@ -64,6 +89,13 @@ const (
 	UnavailabilitiesInverseTable = "unavailabilities"
 	// UnavailabilitiesColumn is the table column denoting the unavailabilities relation/edge.
 	UnavailabilitiesColumn = "resource_id"
+	// WaitlistEntriesTable is the table that holds the waitlistEntries relation/edge.
+	WaitlistEntriesTable = "waitlist_entries"
+	// WaitlistEntriesInverseTable is the table name for the WaitlistEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "waitlistentry" package.
+	WaitlistEntriesInverseTable = "waitlist_entries"
+	// WaitlistEntriesColumn is the table column denoting the waitlistEntries relation/edge.
+	WaitlistEntriesColumn = "resource_id"
 	// OrganizationTable is the table that holds the organization relation/edge.
 	OrganizationTable = "resources"
 	// OrganizationInverseTable is the table name for the Organization entity.
@@ -71,6 +103,13 @@ const (
 	OrganizationInverseTable = "organizations"
 	// OrganizationColumn is the table column denoting the organization relation/edge.
 	OrganizationColumn = "organization_id"
+	// TaxRateTable is the table that holds the taxRate relation/edge.
+	TaxRateTable = "resources"
+	// TaxRateInverseTable is the table name for the TaxRate entity.
+	// It exists in this package in order to avoid circular dependency with the "taxrate" package.
+	TaxRateInverseTable = "tax_rates"
+	// TaxRateColumn is the table column denoting the taxRate relation/edge.
+	TaxRateColumn = "tax_rate_id"
 )
 
 // Columns holds all SQL columns for resource fields.
@@ -81,11 +120,17 @@ var Columns = []string{
 	FieldName,
 	FieldDescription,
 	FieldTimezone,
-	FieldPassword,
+	FieldPasswordHash,
 	FieldPrice,
 	FieldBookingPrice,
+	FieldCurrency,
 	FieldOrganizationId,
 	FieldQuantityAvailable,
+	FieldCancellationPolicy,
+	FieldPricing,
+	FieldBookingFields,
+	FieldFeedTokenHash,
+	FieldTaxRateId,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -113,4 +158,8 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updatedAt" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultPasswordHash holds the default value on creation for the "passwordHash" field.
+	DefaultPasswordHash string
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
 )
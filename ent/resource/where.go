@@ -128,10 +128,10 @@ func Timezone(v string) predicate.Resource {
 	})
 }
 
-// Password applies equality check predicate on the "password" field. It's identical to PasswordEQ.
-func Password(v string) predicate.Resource {
+// PasswordHash applies equality check predicate on the "passwordHash" field. It's identical to PasswordHashEQ.
+func PasswordHash(v string) predicate.Resource {
 	return predicate.Resource(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldPassword), v))
+		s.Where(sql.EQ(s.C(FieldPasswordHash), v))
 	})
 }
 
@@ -149,6 +149,13 @@ func BookingPrice(v int) predicate.Resource {
 	})
 }
 
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCurrency), v))
+	})
+}
+
 // OrganizationId applies equality check predicate on the "organizationId" field. It's identical to OrganizationIdEQ.
 func OrganizationId(v int) predicate.Resource {
 	return predicate.Resource(func(s *sql.Selector) {
@@ -163,6 +170,41 @@ func QuantityAvailable(v int) predicate.Resource {
 	})
 }
 
+// CancellationPolicy applies equality check predicate on the "cancellationPolicy" field. It's identical to CancellationPolicyEQ.
+func CancellationPolicy(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCancellationPolicy), v))
+	})
+}
+
+// Pricing applies equality check predicate on the "pricing" field. It's identical to PricingEQ.
+func Pricing(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPricing), v))
+	})
+}
+
+// BookingFields applies equality check predicate on the "bookingFields" field. It's identical to BookingFieldsEQ.
+func BookingFields(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBookingFields), v))
+	})
+}
+
+// FeedTokenHash applies equality check predicate on the "feedTokenHash" field. It's identical to FeedTokenHashEQ.
+func FeedTokenHash(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFeedTokenHash), v))
+	})
+}
+
+// TaxRateId applies equality check predicate on the "taxRateId" field. It's identical to TaxRateIdEQ.
+func TaxRateId(v int) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxRateId), v))
+	})
+}
+
 // CreatedAtEQ applies the EQ predicate on the "createdAt" field.
 func CreatedAtEQ(v time.Time) predicate.Resource {
 	return predicate.Resource(func(s *sql.Selector) {
@@ -648,22 +690,22 @@ func TimezoneContainsFold(v string) predicate.Resource {
 	})
 }
 
-// PasswordEQ applies the EQ predicate on the "password" field.
-func PasswordEQ(v string) predicate.Resource {
+// PasswordHashEQ applies the EQ predicate on the "passwordHash" field.
+func PasswordHashEQ(v string) predicate.Resource {
 	return predicate.Resource(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldPassword), v))
+		s.Where(sql.EQ(s.C(FieldPasswordHash), v))
 	})
 }
 
-// PasswordNEQ applies the NEQ predicate on the "password" field.
-func PasswordNEQ(v string) predicate.Resource {
+// PasswordHashNEQ applies the NEQ predicate on the "passwordHash" field.
+func PasswordHashNEQ(v string) predicate.Resource {
 	return predicate.Resource(func(s *sql.Selector) {
-		s.Where(sql.NEQ(s.C(FieldPassword), v))
+		s.Where(sql.NEQ(s.C(FieldPasswordHash), v))
 	})
 }
 
-// PasswordIn applies the In predicate on the "password" field.
-func PasswordIn(vs ...string) predicate.Resource {
+// PasswordHashIn applies the In predicate on the "passwordHash" field.
+func PasswordHashIn(vs ...string) predicate.Resource {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -675,12 +717,12 @@ func PasswordIn(vs ...string) predicate.Resource {
 			s.Where(sql.False())
 			return
 		}
-		s.Where(sql.In(s.C(FieldPassword), v...))
+		s.Where(sql.In(s.C(FieldPasswordHash), v...))
 	})
 }
 
-// PasswordNotIn applies the NotIn predicate on the "password" field.
-func PasswordNotIn(vs ...string) predicate.Resource {
+// PasswordHashNotIn applies the NotIn predicate on the "passwordHash" field.
+func PasswordHashNotIn(vs ...string) predicate.Resource {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -692,70 +734,70 @@ func PasswordNotIn(vs ...string) predicate.Resource {
 			s.Where(sql.False())
 			return
 		}
-		s.Where(sql.NotIn(s.C(FieldPassword), v...))
+		s.Where(sql.NotIn(s.C(FieldPasswordHash), v...))
 	})
 }
 
-// PasswordGT applies the GT predicate on the "password" field.
-func PasswordGT(v string) predicate.Resource {
+// PasswordHashGT applies the GT predicate on the "passwordHash" field.
+func PasswordHashGT(v string) predicate.Resource {
 	return predicate.Resource(func(s *sql.Selector) {
-		s.Where(sql.GT(s.C(FieldPassword), v))
+		s.Where(sql.GT(s.C(FieldPasswordHash), v))
 	})
 }
 
-// PasswordGTE applies the GTE predicate on the "password" field.
-func PasswordGTE(v string) predicate.Resource {
+// PasswordHashGTE applies the GTE predicate on the "passwordHash" field.
+func PasswordHashGTE(v string) predicate.Resource {
 	return predicate.Resource(func(s *sql.Selector) {
-		s.Where(sql.GTE(s.C(FieldPassword), v))
+		s.Where(sql.GTE(s.C(FieldPasswordHash), v))
 	})
 }
 
-// PasswordLT applies the LT predicate on the "password" field.
-func PasswordLT(v string) predicate.Resource {
+// PasswordHashLT applies the LT predicate on the "passwordHash" field.
+func PasswordHashLT(v string) predicate.Resource {
 	return predicate.Resource(func(s *sql.Selector) {
-		s.Where(sql.LT(s.C(FieldPassword), v))
+		s.Where(sql.LT(s.C(FieldPasswordHash), v))
 	})
 }
 
-// PasswordLTE applies the LTE predicate on the "password" field.
-func PasswordLTE(v string) predicate.Resource {
+// PasswordHashLTE applies the LTE predicate on the "passwordHash" field.
+func PasswordHashLTE(v string) predicate.Resource {
 	return predicate.Resource(func(s *sql.Selector) {
-		s.Where(sql.LTE(s.C(FieldPassword), v))
+		s.Where(sql.LTE(s.C(FieldPasswordHash), v))
 	})
 }
 
-// PasswordContains applies the Contains predicate on the "password" field.
-func PasswordContains(v string) predicate.Resource {
+// PasswordHashContains applies the Contains predicate on the "passwordHash" field.
+func PasswordHashContains(v string) predicate.Resource {
 	return predicate.Resource(func(s *sql.Selector) {
-		s.Where(sql.Contains(s.C(FieldPassword), v))
+		s.Where(sql.Contains(s.C(FieldPasswordHash), v))
 	})
 }
 
-// PasswordHasPrefix applies the HasPrefix predicate on the "password" field.
-func PasswordHasPrefix(v string) predicate.Resource {
+// PasswordHashHasPrefix applies the HasPrefix predicate on the "passwordHash" field.
+func PasswordHashHasPrefix(v string) predicate.Resource {
 	return predicate.Resource(func(s *sql.Selector) {
-		s.Where(sql.HasPrefix(s.C(FieldPassword), v))
+		s.Where(sql.HasPrefix(s.C(FieldPasswordHash), v))
 	})
 }
 
-// PasswordHasSuffix applies the HasSuffix predicate on the "password" field.
-func PasswordHasSuffix(v string) predicate.Resource {
+// PasswordHashHasSuffix applies the HasSuffix predicate on the "passwordHash" field.
+func PasswordHashHasSuffix(v string) predicate.Resource {
 	return predicate.Resource(func(s *sql.Selector) {
-		s.Where(sql.HasSuffix(s.C(FieldPassword), v))
+		s.Where(sql.HasSuffix(s.C(FieldPasswordHash), v))
 	})
 }
 
-// PasswordEqualFold applies the EqualFold predicate on the "password" field.
-func PasswordEqualFold(v string) predicate.Resource {
+// PasswordHashEqualFold applies the EqualFold predicate on the "passwordHash" field.
+func PasswordHashEqualFold(v string) predicate.Resource {
 	return predicate.Resource(func(s *sql.Selector) {
-		s.Where(sql.EqualFold(s.C(FieldPassword), v))
+		s.Where(sql.EqualFold(s.C(FieldPasswordHash), v))
 	})
 }
 
-// PasswordContainsFold applies the ContainsFold predicate on the "password" field.
-func PasswordContainsFold(v string) predicate.Resource {
+// PasswordHashContainsFold applies the ContainsFold predicate on the "passwordHash" field.
+func PasswordHashContainsFold(v string) predicate.Resource {
 	return predicate.Resource(func(s *sql.Selector) {
-		s.Where(sql.ContainsFold(s.C(FieldPassword), v))
+		s.Where(sql.ContainsFold(s.C(FieldPasswordHash), v))
 	})
 }
 
@@ -911,6 +953,117 @@ func BookingPriceLTE(v int) predicate.Resource {
 	})
 }
 
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Resource {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Resource(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCurrency), v...))
+	})
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Resource {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Resource(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCurrency), v...))
+	})
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCurrency), v))
+	})
+}
+
 // OrganizationIdEQ applies the EQ predicate on the "organizationId" field.
 func OrganizationIdEQ(v int) predicate.Resource {
 	return predicate.Resource(func(s *sql.Selector) {
@@ -1049,109 +1202,755 @@ func QuantityAvailableNotNil() predicate.Resource {
 	})
 }
 
-// HasSlots applies the HasEdge predicate on the "slots" edge.
-func HasSlots() predicate.Resource {
+// CancellationPolicyEQ applies the EQ predicate on the "cancellationPolicy" field.
+func CancellationPolicyEQ(v string) predicate.Resource {
 	return predicate.Resource(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.To(SlotsTable, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, SlotsTable, SlotsColumn),
-		)
-		sqlgraph.HasNeighbors(s, step)
+		s.Where(sql.EQ(s.C(FieldCancellationPolicy), v))
 	})
 }
 
-// HasSlotsWith applies the HasEdge predicate on the "slots" edge with a given conditions (other predicates).
-func HasSlotsWith(preds ...predicate.Slot) predicate.Resource {
+// CancellationPolicyNEQ applies the NEQ predicate on the "cancellationPolicy" field.
+func CancellationPolicyNEQ(v string) predicate.Resource {
 	return predicate.Resource(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.To(SlotsInverseTable, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, SlotsTable, SlotsColumn),
-		)
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
-			for _, p := range preds {
-				p(s)
-			}
-		})
+		s.Where(sql.NEQ(s.C(FieldCancellationPolicy), v))
 	})
 }
 
-// HasBookings applies the HasEdge predicate on the "bookings" edge.
-func HasBookings() predicate.Resource {
+// CancellationPolicyIn applies the In predicate on the "cancellationPolicy" field.
+func CancellationPolicyIn(vs ...string) predicate.Resource {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
 	return predicate.Resource(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.To(BookingsTable, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, BookingsTable, BookingsColumn),
-		)
-		sqlgraph.HasNeighbors(s, step)
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCancellationPolicy), v...))
 	})
 }
 
-// HasBookingsWith applies the HasEdge predicate on the "bookings" edge with a given conditions (other predicates).
-func HasBookingsWith(preds ...predicate.Booking) predicate.Resource {
+// CancellationPolicyNotIn applies the NotIn predicate on the "cancellationPolicy" field.
+func CancellationPolicyNotIn(vs ...string) predicate.Resource {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
 	return predicate.Resource(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.To(BookingsInverseTable, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, BookingsTable, BookingsColumn),
-		)
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
-			for _, p := range preds {
-				p(s)
-			}
-		})
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCancellationPolicy), v...))
 	})
 }
 
-// HasUnavailabilities applies the HasEdge predicate on the "unavailabilities" edge.
-func HasUnavailabilities() predicate.Resource {
+// CancellationPolicyGT applies the GT predicate on the "cancellationPolicy" field.
+func CancellationPolicyGT(v string) predicate.Resource {
 	return predicate.Resource(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.To(UnavailabilitiesTable, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, UnavailabilitiesTable, UnavailabilitiesColumn),
-		)
-		sqlgraph.HasNeighbors(s, step)
+		s.Where(sql.GT(s.C(FieldCancellationPolicy), v))
 	})
 }
 
-// HasUnavailabilitiesWith applies the HasEdge predicate on the "unavailabilities" edge with a given conditions (other predicates).
-func HasUnavailabilitiesWith(preds ...predicate.Unavailability) predicate.Resource {
+// CancellationPolicyGTE applies the GTE predicate on the "cancellationPolicy" field.
+func CancellationPolicyGTE(v string) predicate.Resource {
 	return predicate.Resource(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.To(UnavailabilitiesInverseTable, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, UnavailabilitiesTable, UnavailabilitiesColumn),
-		)
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
-			for _, p := range preds {
-				p(s)
-			}
-		})
+		s.Where(sql.GTE(s.C(FieldCancellationPolicy), v))
 	})
 }
 
-// HasOrganization applies the HasEdge predicate on the "organization" edge.
-func HasOrganization() predicate.Resource {
+// CancellationPolicyLT applies the LT predicate on the "cancellationPolicy" field.
+func CancellationPolicyLT(v string) predicate.Resource {
 	return predicate.Resource(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.To(OrganizationTable, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
-		)
-		sqlgraph.HasNeighbors(s, step)
+		s.Where(sql.LT(s.C(FieldCancellationPolicy), v))
 	})
 }
 
-// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
-func HasOrganizationWith(preds ...predicate.Organization) predicate.Resource {
+// CancellationPolicyLTE applies the LTE predicate on the "cancellationPolicy" field.
+func CancellationPolicyLTE(v string) predicate.Resource {
 	return predicate.Resource(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.To(OrganizationInverseTable, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		s.Where(sql.LTE(s.C(FieldCancellationPolicy), v))
+	})
+}
+
+// CancellationPolicyContains applies the Contains predicate on the "cancellationPolicy" field.
+func CancellationPolicyContains(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCancellationPolicy), v))
+	})
+}
+
+// CancellationPolicyHasPrefix applies the HasPrefix predicate on the "cancellationPolicy" field.
+func CancellationPolicyHasPrefix(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCancellationPolicy), v))
+	})
+}
+
+// CancellationPolicyHasSuffix applies the HasSuffix predicate on the "cancellationPolicy" field.
+func CancellationPolicyHasSuffix(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCancellationPolicy), v))
+	})
+}
+
+// CancellationPolicyIsNil applies the IsNil predicate on the "cancellationPolicy" field.
+func CancellationPolicyIsNil() predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCancellationPolicy)))
+	})
+}
+
+// CancellationPolicyNotNil applies the NotNil predicate on the "cancellationPolicy" field.
+func CancellationPolicyNotNil() predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCancellationPolicy)))
+	})
+}
+
+// CancellationPolicyEqualFold applies the EqualFold predicate on the "cancellationPolicy" field.
+func CancellationPolicyEqualFold(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCancellationPolicy), v))
+	})
+}
+
+// CancellationPolicyContainsFold applies the ContainsFold predicate on the "cancellationPolicy" field.
+func CancellationPolicyContainsFold(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCancellationPolicy), v))
+	})
+}
+
+// PricingEQ applies the EQ predicate on the "pricing" field.
+func PricingEQ(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPricing), v))
+	})
+}
+
+// PricingNEQ applies the NEQ predicate on the "pricing" field.
+func PricingNEQ(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPricing), v))
+	})
+}
+
+// PricingIn applies the In predicate on the "pricing" field.
+func PricingIn(vs ...string) predicate.Resource {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Resource(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPricing), v...))
+	})
+}
+
+// PricingNotIn applies the NotIn predicate on the "pricing" field.
+func PricingNotIn(vs ...string) predicate.Resource {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Resource(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPricing), v...))
+	})
+}
+
+// PricingGT applies the GT predicate on the "pricing" field.
+func PricingGT(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPricing), v))
+	})
+}
+
+// PricingGTE applies the GTE predicate on the "pricing" field.
+func PricingGTE(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPricing), v))
+	})
+}
+
+// PricingLT applies the LT predicate on the "pricing" field.
+func PricingLT(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPricing), v))
+	})
+}
+
+// PricingLTE applies the LTE predicate on the "pricing" field.
+func PricingLTE(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPricing), v))
+	})
+}
+
+// PricingContains applies the Contains predicate on the "pricing" field.
+func PricingContains(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldPricing), v))
+	})
+}
+
+// PricingHasPrefix applies the HasPrefix predicate on the "pricing" field.
+func PricingHasPrefix(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldPricing), v))
+	})
+}
+
+// PricingHasSuffix applies the HasSuffix predicate on the "pricing" field.
+func PricingHasSuffix(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldPricing), v))
+	})
+}
+
+// PricingIsNil applies the IsNil predicate on the "pricing" field.
+func PricingIsNil() predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPricing)))
+	})
+}
+
+// PricingNotNil applies the NotNil predicate on the "pricing" field.
+func PricingNotNil() predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPricing)))
+	})
+}
+
+// PricingEqualFold applies the EqualFold predicate on the "pricing" field.
+func PricingEqualFold(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldPricing), v))
+	})
+}
+
+// PricingContainsFold applies the ContainsFold predicate on the "pricing" field.
+func PricingContainsFold(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldPricing), v))
+	})
+}
+
+// BookingFieldsEQ applies the EQ predicate on the "bookingFields" field.
+func BookingFieldsEQ(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBookingFields), v))
+	})
+}
+
+// BookingFieldsNEQ applies the NEQ predicate on the "bookingFields" field.
+func BookingFieldsNEQ(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBookingFields), v))
+	})
+}
+
+// BookingFieldsIn applies the In predicate on the "bookingFields" field.
+func BookingFieldsIn(vs ...string) predicate.Resource {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Resource(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBookingFields), v...))
+	})
+}
+
+// BookingFieldsNotIn applies the NotIn predicate on the "bookingFields" field.
+func BookingFieldsNotIn(vs ...string) predicate.Resource {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Resource(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBookingFields), v...))
+	})
+}
+
+// BookingFieldsGT applies the GT predicate on the "bookingFields" field.
+func BookingFieldsGT(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBookingFields), v))
+	})
+}
+
+// BookingFieldsGTE applies the GTE predicate on the "bookingFields" field.
+func BookingFieldsGTE(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBookingFields), v))
+	})
+}
+
+// BookingFieldsLT applies the LT predicate on the "bookingFields" field.
+func BookingFieldsLT(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBookingFields), v))
+	})
+}
+
+// BookingFieldsLTE applies the LTE predicate on the "bookingFields" field.
+func BookingFieldsLTE(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBookingFields), v))
+	})
+}
+
+// BookingFieldsContains applies the Contains predicate on the "bookingFields" field.
+func BookingFieldsContains(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldBookingFields), v))
+	})
+}
+
+// BookingFieldsHasPrefix applies the HasPrefix predicate on the "bookingFields" field.
+func BookingFieldsHasPrefix(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldBookingFields), v))
+	})
+}
+
+// BookingFieldsHasSuffix applies the HasSuffix predicate on the "bookingFields" field.
+func BookingFieldsHasSuffix(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldBookingFields), v))
+	})
+}
+
+// BookingFieldsIsNil applies the IsNil predicate on the "bookingFields" field.
+func BookingFieldsIsNil() predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldBookingFields)))
+	})
+}
+
+// BookingFieldsNotNil applies the NotNil predicate on the "bookingFields" field.
+func BookingFieldsNotNil() predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldBookingFields)))
+	})
+}
+
+// BookingFieldsEqualFold applies the EqualFold predicate on the "bookingFields" field.
+func BookingFieldsEqualFold(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldBookingFields), v))
+	})
+}
+
+// BookingFieldsContainsFold applies the ContainsFold predicate on the "bookingFields" field.
+func BookingFieldsContainsFold(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldBookingFields), v))
+	})
+}
+
+// FeedTokenHashEQ applies the EQ predicate on the "feedTokenHash" field.
+func FeedTokenHashEQ(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFeedTokenHash), v))
+	})
+}
+
+// FeedTokenHashNEQ applies the NEQ predicate on the "feedTokenHash" field.
+func FeedTokenHashNEQ(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldFeedTokenHash), v))
+	})
+}
+
+// FeedTokenHashIn applies the In predicate on the "feedTokenHash" field.
+func FeedTokenHashIn(vs ...string) predicate.Resource {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Resource(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldFeedTokenHash), v...))
+	})
+}
+
+// FeedTokenHashNotIn applies the NotIn predicate on the "feedTokenHash" field.
+func FeedTokenHashNotIn(vs ...string) predicate.Resource {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Resource(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldFeedTokenHash), v...))
+	})
+}
+
+// FeedTokenHashGT applies the GT predicate on the "feedTokenHash" field.
+func FeedTokenHashGT(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldFeedTokenHash), v))
+	})
+}
+
+// FeedTokenHashGTE applies the GTE predicate on the "feedTokenHash" field.
+func FeedTokenHashGTE(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldFeedTokenHash), v))
+	})
+}
+
+// FeedTokenHashLT applies the LT predicate on the "feedTokenHash" field.
+func FeedTokenHashLT(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldFeedTokenHash), v))
+	})
+}
+
+// FeedTokenHashLTE applies the LTE predicate on the "feedTokenHash" field.
+func FeedTokenHashLTE(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldFeedTokenHash), v))
+	})
+}
+
+// FeedTokenHashContains applies the Contains predicate on the "feedTokenHash" field.
+func FeedTokenHashContains(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldFeedTokenHash), v))
+	})
+}
+
+// FeedTokenHashHasPrefix applies the HasPrefix predicate on the "feedTokenHash" field.
+func FeedTokenHashHasPrefix(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldFeedTokenHash), v))
+	})
+}
+
+// FeedTokenHashHasSuffix applies the HasSuffix predicate on the "feedTokenHash" field.
+func FeedTokenHashHasSuffix(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldFeedTokenHash), v))
+	})
+}
+
+// FeedTokenHashIsNil applies the IsNil predicate on the "feedTokenHash" field.
+func FeedTokenHashIsNil() predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldFeedTokenHash)))
+	})
+}
+
+// FeedTokenHashNotNil applies the NotNil predicate on the "feedTokenHash" field.
+func FeedTokenHashNotNil() predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldFeedTokenHash)))
+	})
+}
+
+// FeedTokenHashEqualFold applies the EqualFold predicate on the "feedTokenHash" field.
+func FeedTokenHashEqualFold(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldFeedTokenHash), v))
+	})
+}
+
+// FeedTokenHashContainsFold applies the ContainsFold predicate on the "feedTokenHash" field.
+func FeedTokenHashContainsFold(v string) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldFeedTokenHash), v))
+	})
+}
+
+// TaxRateIdEQ applies the EQ predicate on the "taxRateId" field.
+func TaxRateIdEQ(v int) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxRateId), v))
+	})
+}
+
+// TaxRateIdNEQ applies the NEQ predicate on the "taxRateId" field.
+func TaxRateIdNEQ(v int) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTaxRateId), v))
+	})
+}
+
+// TaxRateIdIn applies the In predicate on the "taxRateId" field.
+func TaxRateIdIn(vs ...int) predicate.Resource {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Resource(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTaxRateId), v...))
+	})
+}
+
+// TaxRateIdNotIn applies the NotIn predicate on the "taxRateId" field.
+func TaxRateIdNotIn(vs ...int) predicate.Resource {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Resource(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTaxRateId), v...))
+	})
+}
+
+// TaxRateIdIsNil applies the IsNil predicate on the "taxRateId" field.
+func TaxRateIdIsNil() predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTaxRateId)))
+	})
+}
+
+// TaxRateIdNotNil applies the NotNil predicate on the "taxRateId" field.
+func TaxRateIdNotNil() predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTaxRateId)))
+	})
+}
+
+// HasSlots applies the HasEdge predicate on the "slots" edge.
+func HasSlots() predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(SlotsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SlotsTable, SlotsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSlotsWith applies the HasEdge predicate on the "slots" edge with a given conditions (other predicates).
+func HasSlotsWith(preds ...predicate.Slot) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(SlotsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SlotsTable, SlotsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBookings applies the HasEdge predicate on the "bookings" edge.
+func HasBookings() predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(BookingsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BookingsTable, BookingsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBookingsWith applies the HasEdge predicate on the "bookings" edge with a given conditions (other predicates).
+func HasBookingsWith(preds ...predicate.Booking) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(BookingsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BookingsTable, BookingsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBookingSeries applies the HasEdge predicate on the "bookingSeries" edge.
+func HasBookingSeries() predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(BookingSeriesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BookingSeriesTable, BookingSeriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBookingSeriesWith applies the HasEdge predicate on the "bookingSeries" edge with a given conditions (other predicates).
+func HasBookingSeriesWith(preds ...predicate.BookingSeries) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(BookingSeriesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BookingSeriesTable, BookingSeriesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUnavailabilities applies the HasEdge predicate on the "unavailabilities" edge.
+func HasUnavailabilities() predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UnavailabilitiesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UnavailabilitiesTable, UnavailabilitiesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUnavailabilitiesWith applies the HasEdge predicate on the "unavailabilities" edge with a given conditions (other predicates).
+func HasUnavailabilitiesWith(preds ...predicate.Unavailability) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UnavailabilitiesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UnavailabilitiesTable, UnavailabilitiesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWaitlistEntries applies the HasEdge predicate on the "waitlistEntries" edge.
+func HasWaitlistEntries() predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WaitlistEntriesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WaitlistEntriesTable, WaitlistEntriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWaitlistEntriesWith applies the HasEdge predicate on the "waitlistEntries" edge with a given conditions (other predicates).
+func HasWaitlistEntriesWith(preds ...predicate.WaitlistEntry) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WaitlistEntriesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WaitlistEntriesTable, WaitlistEntriesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(OrganizationTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(OrganizationInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTaxRate applies the HasEdge predicate on the "taxRate" edge.
+func HasTaxRate() predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TaxRateTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TaxRateTable, TaxRateColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTaxRateWith applies the HasEdge predicate on the "taxRate" edge with a given conditions (other predicates).
+func HasTaxRateWith(preds ...predicate.TaxRate) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TaxRateInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TaxRateTable, TaxRateColumn),
 		)
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
@@ -8,13 +8,17 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/bookingseries"
 	"github.com/openmesh/booking/ent/organization"
 	"github.com/openmesh/booking/ent/resource"
 	"github.com/openmesh/booking/ent/slot"
+	"github.com/openmesh/booking/ent/taxrate"
 	"github.com/openmesh/booking/ent/unavailability"
+	"github.com/openmesh/booking/ent/waitlistentry"
 )
 
 // ResourceCreate is the builder for creating a Resource entity.
@@ -22,6 +26,7 @@ type ResourceCreate struct {
 	config
 	mutation *ResourceMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "createdAt" field.
@@ -70,9 +75,17 @@ func (rc *ResourceCreate) SetTimezone(s string) *ResourceCreate {
 	return rc
 }
 
-// SetPassword sets the "password" field.
-func (rc *ResourceCreate) SetPassword(s string) *ResourceCreate {
-	rc.mutation.SetPassword(s)
+// SetPasswordHash sets the "passwordHash" field.
+func (rc *ResourceCreate) SetPasswordHash(s string) *ResourceCreate {
+	rc.mutation.SetPasswordHash(s)
+	return rc
+}
+
+// SetNillablePasswordHash sets the "passwordHash" field if the given value is not nil.
+func (rc *ResourceCreate) SetNillablePasswordHash(s *string) *ResourceCreate {
+	if s != nil {
+		rc.SetPasswordHash(*s)
+	}
 	return rc
 }
 
@@ -88,6 +101,20 @@ func (rc *ResourceCreate) SetBookingPrice(i int) *ResourceCreate {
 	return rc
 }
 
+// SetCurrency sets the "currency" field.
+func (rc *ResourceCreate) SetCurrency(s string) *ResourceCreate {
+	rc.mutation.SetCurrency(s)
+	return rc
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (rc *ResourceCreate) SetNillableCurrency(s *string) *ResourceCreate {
+	if s != nil {
+		rc.SetCurrency(*s)
+	}
+	return rc
+}
+
 // SetOrganizationId sets the "organizationId" field.
 func (rc *ResourceCreate) SetOrganizationId(i int) *ResourceCreate {
 	rc.mutation.SetOrganizationId(i)
@@ -108,6 +135,76 @@ func (rc *ResourceCreate) SetNillableQuantityAvailable(i *int) *ResourceCreate {
 	return rc
 }
 
+// SetCancellationPolicy sets the "cancellationPolicy" field.
+func (rc *ResourceCreate) SetCancellationPolicy(s string) *ResourceCreate {
+	rc.mutation.SetCancellationPolicy(s)
+	return rc
+}
+
+// SetNillableCancellationPolicy sets the "cancellationPolicy" field if the given value is not nil.
+func (rc *ResourceCreate) SetNillableCancellationPolicy(s *string) *ResourceCreate {
+	if s != nil {
+		rc.SetCancellationPolicy(*s)
+	}
+	return rc
+}
+
+// SetPricing sets the "pricing" field.
+func (rc *ResourceCreate) SetPricing(s string) *ResourceCreate {
+	rc.mutation.SetPricing(s)
+	return rc
+}
+
+// SetNillablePricing sets the "pricing" field if the given value is not nil.
+func (rc *ResourceCreate) SetNillablePricing(s *string) *ResourceCreate {
+	if s != nil {
+		rc.SetPricing(*s)
+	}
+	return rc
+}
+
+// SetBookingFields sets the "bookingFields" field.
+func (rc *ResourceCreate) SetBookingFields(s string) *ResourceCreate {
+	rc.mutation.SetBookingFields(s)
+	return rc
+}
+
+// SetNillableBookingFields sets the "bookingFields" field if the given value is not nil.
+func (rc *ResourceCreate) SetNillableBookingFields(s *string) *ResourceCreate {
+	if s != nil {
+		rc.SetBookingFields(*s)
+	}
+	return rc
+}
+
+// SetFeedTokenHash sets the "feedTokenHash" field.
+func (rc *ResourceCreate) SetFeedTokenHash(s string) *ResourceCreate {
+	rc.mutation.SetFeedTokenHash(s)
+	return rc
+}
+
+// SetNillableFeedTokenHash sets the "feedTokenHash" field if the given value is not nil.
+func (rc *ResourceCreate) SetNillableFeedTokenHash(s *string) *ResourceCreate {
+	if s != nil {
+		rc.SetFeedTokenHash(*s)
+	}
+	return rc
+}
+
+// SetTaxRateId sets the "taxRateId" field.
+func (rc *ResourceCreate) SetTaxRateId(i int) *ResourceCreate {
+	rc.mutation.SetTaxRateId(i)
+	return rc
+}
+
+// SetNillableTaxRateId sets the "taxRateId" field if the given value is not nil.
+func (rc *ResourceCreate) SetNillableTaxRateId(i *int) *ResourceCreate {
+	if i != nil {
+		rc.SetTaxRateId(*i)
+	}
+	return rc
+}
+
 // AddSlotIDs adds the "slots" edge to the Slot entity by IDs.
 func (rc *ResourceCreate) AddSlotIDs(ids ...int) *ResourceCreate {
 	rc.mutation.AddSlotIDs(ids...)
@@ -138,6 +235,21 @@ func (rc *ResourceCreate) AddBookings(b ...*Booking) *ResourceCreate {
 	return rc.AddBookingIDs(ids...)
 }
 
+// AddBookingSeryIDs adds the "bookingSeries" edge to the BookingSeries entity by IDs.
+func (rc *ResourceCreate) AddBookingSeryIDs(ids ...int) *ResourceCreate {
+	rc.mutation.AddBookingSeryIDs(ids...)
+	return rc
+}
+
+// AddBookingSeries adds the "bookingSeries" edges to the BookingSeries entity.
+func (rc *ResourceCreate) AddBookingSeries(b ...*BookingSeries) *ResourceCreate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return rc.AddBookingSeryIDs(ids...)
+}
+
 // AddUnavailabilityIDs adds the "unavailabilities" edge to the Unavailability entity by IDs.
 func (rc *ResourceCreate) AddUnavailabilityIDs(ids ...int) *ResourceCreate {
 	rc.mutation.AddUnavailabilityIDs(ids...)
@@ -153,6 +265,21 @@ func (rc *ResourceCreate) AddUnavailabilities(u ...*Unavailability) *ResourceCre
 	return rc.AddUnavailabilityIDs(ids...)
 }
 
+// AddWaitlistEntryIDs adds the "waitlistEntries" edge to the WaitlistEntry entity by IDs.
+func (rc *ResourceCreate) AddWaitlistEntryIDs(ids ...int) *ResourceCreate {
+	rc.mutation.AddWaitlistEntryIDs(ids...)
+	return rc
+}
+
+// AddWaitlistEntries adds the "waitlistEntries" edges to the WaitlistEntry entity.
+func (rc *ResourceCreate) AddWaitlistEntries(w ...*WaitlistEntry) *ResourceCreate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return rc.AddWaitlistEntryIDs(ids...)
+}
+
 // SetOrganizationID sets the "organization" edge to the Organization entity by ID.
 func (rc *ResourceCreate) SetOrganizationID(id int) *ResourceCreate {
 	rc.mutation.SetOrganizationID(id)
@@ -164,6 +291,25 @@ func (rc *ResourceCreate) SetOrganization(o *Organization) *ResourceCreate {
 	return rc.SetOrganizationID(o.ID)
 }
 
+// SetTaxRateID sets the "taxRate" edge to the TaxRate entity by ID.
+func (rc *ResourceCreate) SetTaxRateID(id int) *ResourceCreate {
+	rc.mutation.SetTaxRateID(id)
+	return rc
+}
+
+// SetNillableTaxRateID sets the "taxRate" edge to the TaxRate entity by ID if the given value is not nil.
+func (rc *ResourceCreate) SetNillableTaxRateID(id *int) *ResourceCreate {
+	if id != nil {
+		rc = rc.SetTaxRateID(*id)
+	}
+	return rc
+}
+
+// SetTaxRate sets the "taxRate" edge to the TaxRate entity.
+func (rc *ResourceCreate) SetTaxRate(t *TaxRate) *ResourceCreate {
+	return rc.SetTaxRateID(t.ID)
+}
+
 // Mutation returns the ResourceMutation object of the builder.
 func (rc *ResourceCreate) Mutation() *ResourceMutation {
 	return rc.mutation
@@ -251,6 +397,14 @@ func (rc *ResourceCreate) defaults() error {
 		v := resource.DefaultUpdatedAt()
 		rc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := rc.mutation.PasswordHash(); !ok {
+		v := resource.DefaultPasswordHash
+		rc.mutation.SetPasswordHash(v)
+	}
+	if _, ok := rc.mutation.Currency(); !ok {
+		v := resource.DefaultCurrency
+		rc.mutation.SetCurrency(v)
+	}
 	return nil
 }
 
@@ -271,8 +425,8 @@ func (rc *ResourceCreate) check() error {
 	if _, ok := rc.mutation.Timezone(); !ok {
 		return &ValidationError{Name: "timezone", err: errors.New(`ent: missing required field "timezone"`)}
 	}
-	if _, ok := rc.mutation.Password(); !ok {
-		return &ValidationError{Name: "password", err: errors.New(`ent: missing required field "password"`)}
+	if _, ok := rc.mutation.PasswordHash(); !ok {
+		return &ValidationError{Name: "passwordHash", err: errors.New(`ent: missing required field "passwordHash"`)}
 	}
 	if _, ok := rc.mutation.Price(); !ok {
 		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "price"`)}
@@ -280,6 +434,9 @@ func (rc *ResourceCreate) check() error {
 	if _, ok := rc.mutation.BookingPrice(); !ok {
 		return &ValidationError{Name: "bookingPrice", err: errors.New(`ent: missing required field "bookingPrice"`)}
 	}
+	if _, ok := rc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "currency"`)}
+	}
 	if _, ok := rc.mutation.OrganizationId(); !ok {
 		return &ValidationError{Name: "organizationId", err: errors.New(`ent: missing required field "organizationId"`)}
 	}
@@ -313,6 +470,7 @@ func (rc *ResourceCreate) createSpec() (*Resource, *sqlgraph.CreateSpec) {
 			},
 		}
 	)
+	_spec.OnConflict = rc.conflict
 	if value, ok := rc.mutation.CreatedAt(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -353,13 +511,13 @@ func (rc *ResourceCreate) createSpec() (*Resource, *sqlgraph.CreateSpec) {
 		})
 		_node.Timezone = value
 	}
-	if value, ok := rc.mutation.Password(); ok {
+	if value, ok := rc.mutation.PasswordHash(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
 			Value:  value,
-			Column: resource.FieldPassword,
+			Column: resource.FieldPasswordHash,
 		})
-		_node.Password = value
+		_node.PasswordHash = value
 	}
 	if value, ok := rc.mutation.Price(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
@@ -377,6 +535,14 @@ func (rc *ResourceCreate) createSpec() (*Resource, *sqlgraph.CreateSpec) {
 		})
 		_node.BookingPrice = value
 	}
+	if value, ok := rc.mutation.Currency(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: resource.FieldCurrency,
+		})
+		_node.Currency = value
+	}
 	if value, ok := rc.mutation.QuantityAvailable(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -385,6 +551,38 @@ func (rc *ResourceCreate) createSpec() (*Resource, *sqlgraph.CreateSpec) {
 		})
 		_node.QuantityAvailable = &value
 	}
+	if value, ok := rc.mutation.CancellationPolicy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: resource.FieldCancellationPolicy,
+		})
+		_node.CancellationPolicy = value
+	}
+	if value, ok := rc.mutation.Pricing(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: resource.FieldPricing,
+		})
+		_node.Pricing = value
+	}
+	if value, ok := rc.mutation.BookingFields(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: resource.FieldBookingFields,
+		})
+		_node.BookingFields = value
+	}
+	if value, ok := rc.mutation.FeedTokenHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: resource.FieldFeedTokenHash,
+		})
+		_node.FeedTokenHash = value
+	}
 	if nodes := rc.mutation.SlotsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -423,6 +621,25 @@ func (rc *ResourceCreate) createSpec() (*Resource, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := rc.mutation.BookingSeriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.BookingSeriesTable,
+			Columns: []string{resource.BookingSeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: bookingseries.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := rc.mutation.UnavailabilitiesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -442,6 +659,25 @@ func (rc *ResourceCreate) createSpec() (*Resource, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := rc.mutation.WaitlistEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.WaitlistEntriesTable,
+			Columns: []string{resource.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: waitlistentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := rc.mutation.OrganizationIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -462,13 +698,650 @@ func (rc *ResourceCreate) createSpec() (*Resource, *sqlgraph.CreateSpec) {
 		_node.OrganizationId = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := rc.mutation.TaxRateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resource.TaxRateTable,
+			Columns: []string{resource.TaxRateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: taxrate.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TaxRateId = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Resource.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResourceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rc *ResourceCreate) OnConflict(opts ...sql.ConflictOption) *ResourceUpsertOne {
+	rc.conflict = opts
+	return &ResourceUpsertOne{
+		create: rc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Resource.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rc *ResourceCreate) OnConflictColumns(columns ...string) *ResourceUpsertOne {
+	rc.conflict = append(rc.conflict, sql.ConflictColumns(columns...))
+	return &ResourceUpsertOne{
+		create: rc,
+	}
+}
+
+type (
+	// ResourceUpsertOne is the builder for "upsert"-ing
+	//  one Resource node.
+	ResourceUpsertOne struct {
+		create *ResourceCreate
+	}
+
+	// ResourceUpsert is the "OnConflict" setter.
+	ResourceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *ResourceUpsert) SetCreatedAt(v time.Time) *ResourceUpsert {
+	u.Set(resource.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *ResourceUpsert) UpdateCreatedAt() *ResourceUpsert {
+	u.SetExcluded(resource.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *ResourceUpsert) SetUpdatedAt(v time.Time) *ResourceUpsert {
+	u.Set(resource.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *ResourceUpsert) UpdateUpdatedAt() *ResourceUpsert {
+	u.SetExcluded(resource.FieldUpdatedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ResourceUpsert) SetName(v string) *ResourceUpsert {
+	u.Set(resource.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ResourceUpsert) UpdateName() *ResourceUpsert {
+	u.SetExcluded(resource.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *ResourceUpsert) SetDescription(v string) *ResourceUpsert {
+	u.Set(resource.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ResourceUpsert) UpdateDescription() *ResourceUpsert {
+	u.SetExcluded(resource.FieldDescription)
+	return u
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *ResourceUpsert) SetTimezone(v string) *ResourceUpsert {
+	u.Set(resource.FieldTimezone, v)
+	return u
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *ResourceUpsert) UpdateTimezone() *ResourceUpsert {
+	u.SetExcluded(resource.FieldTimezone)
+	return u
+}
+
+// SetPasswordHash sets the "passwordHash" field.
+func (u *ResourceUpsert) SetPasswordHash(v string) *ResourceUpsert {
+	u.Set(resource.FieldPasswordHash, v)
+	return u
+}
+
+// UpdatePasswordHash sets the "passwordHash" field to the value that was provided on create.
+func (u *ResourceUpsert) UpdatePasswordHash() *ResourceUpsert {
+	u.SetExcluded(resource.FieldPasswordHash)
+	return u
+}
+
+// SetPrice sets the "price" field.
+func (u *ResourceUpsert) SetPrice(v int) *ResourceUpsert {
+	u.Set(resource.FieldPrice, v)
+	return u
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *ResourceUpsert) UpdatePrice() *ResourceUpsert {
+	u.SetExcluded(resource.FieldPrice)
+	return u
+}
+
+// SetBookingPrice sets the "bookingPrice" field.
+func (u *ResourceUpsert) SetBookingPrice(v int) *ResourceUpsert {
+	u.Set(resource.FieldBookingPrice, v)
+	return u
+}
+
+// UpdateBookingPrice sets the "bookingPrice" field to the value that was provided on create.
+func (u *ResourceUpsert) UpdateBookingPrice() *ResourceUpsert {
+	u.SetExcluded(resource.FieldBookingPrice)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *ResourceUpsert) SetCurrency(v string) *ResourceUpsert {
+	u.Set(resource.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *ResourceUpsert) UpdateCurrency() *ResourceUpsert {
+	u.SetExcluded(resource.FieldCurrency)
+	return u
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (u *ResourceUpsert) SetOrganizationId(v int) *ResourceUpsert {
+	u.Set(resource.FieldOrganizationId, v)
+	return u
+}
+
+// UpdateOrganizationId sets the "organizationId" field to the value that was provided on create.
+func (u *ResourceUpsert) UpdateOrganizationId() *ResourceUpsert {
+	u.SetExcluded(resource.FieldOrganizationId)
+	return u
+}
+
+// SetQuantityAvailable sets the "quantityAvailable" field.
+func (u *ResourceUpsert) SetQuantityAvailable(v int) *ResourceUpsert {
+	u.Set(resource.FieldQuantityAvailable, v)
+	return u
+}
+
+// UpdateQuantityAvailable sets the "quantityAvailable" field to the value that was provided on create.
+func (u *ResourceUpsert) UpdateQuantityAvailable() *ResourceUpsert {
+	u.SetExcluded(resource.FieldQuantityAvailable)
+	return u
+}
+
+// ClearQuantityAvailable clears the value of the "quantityAvailable" field.
+func (u *ResourceUpsert) ClearQuantityAvailable() *ResourceUpsert {
+	u.SetNull(resource.FieldQuantityAvailable)
+	return u
+}
+
+// SetCancellationPolicy sets the "cancellationPolicy" field.
+func (u *ResourceUpsert) SetCancellationPolicy(v string) *ResourceUpsert {
+	u.Set(resource.FieldCancellationPolicy, v)
+	return u
+}
+
+// UpdateCancellationPolicy sets the "cancellationPolicy" field to the value that was provided on create.
+func (u *ResourceUpsert) UpdateCancellationPolicy() *ResourceUpsert {
+	u.SetExcluded(resource.FieldCancellationPolicy)
+	return u
+}
+
+// ClearCancellationPolicy clears the value of the "cancellationPolicy" field.
+func (u *ResourceUpsert) ClearCancellationPolicy() *ResourceUpsert {
+	u.SetNull(resource.FieldCancellationPolicy)
+	return u
+}
+
+// SetPricing sets the "pricing" field.
+func (u *ResourceUpsert) SetPricing(v string) *ResourceUpsert {
+	u.Set(resource.FieldPricing, v)
+	return u
+}
+
+// UpdatePricing sets the "pricing" field to the value that was provided on create.
+func (u *ResourceUpsert) UpdatePricing() *ResourceUpsert {
+	u.SetExcluded(resource.FieldPricing)
+	return u
+}
+
+// ClearPricing clears the value of the "pricing" field.
+func (u *ResourceUpsert) ClearPricing() *ResourceUpsert {
+	u.SetNull(resource.FieldPricing)
+	return u
+}
+
+// SetBookingFields sets the "bookingFields" field.
+func (u *ResourceUpsert) SetBookingFields(v string) *ResourceUpsert {
+	u.Set(resource.FieldBookingFields, v)
+	return u
+}
+
+// UpdateBookingFields sets the "bookingFields" field to the value that was provided on create.
+func (u *ResourceUpsert) UpdateBookingFields() *ResourceUpsert {
+	u.SetExcluded(resource.FieldBookingFields)
+	return u
+}
+
+// ClearBookingFields clears the value of the "bookingFields" field.
+func (u *ResourceUpsert) ClearBookingFields() *ResourceUpsert {
+	u.SetNull(resource.FieldBookingFields)
+	return u
+}
+
+// SetFeedTokenHash sets the "feedTokenHash" field.
+func (u *ResourceUpsert) SetFeedTokenHash(v string) *ResourceUpsert {
+	u.Set(resource.FieldFeedTokenHash, v)
+	return u
+}
+
+// UpdateFeedTokenHash sets the "feedTokenHash" field to the value that was provided on create.
+func (u *ResourceUpsert) UpdateFeedTokenHash() *ResourceUpsert {
+	u.SetExcluded(resource.FieldFeedTokenHash)
+	return u
+}
+
+// ClearFeedTokenHash clears the value of the "feedTokenHash" field.
+func (u *ResourceUpsert) ClearFeedTokenHash() *ResourceUpsert {
+	u.SetNull(resource.FieldFeedTokenHash)
+	return u
+}
+
+// SetTaxRateId sets the "taxRateId" field.
+func (u *ResourceUpsert) SetTaxRateId(v int) *ResourceUpsert {
+	u.Set(resource.FieldTaxRateId, v)
+	return u
+}
+
+// UpdateTaxRateId sets the "taxRateId" field to the value that was provided on create.
+func (u *ResourceUpsert) UpdateTaxRateId() *ResourceUpsert {
+	u.SetExcluded(resource.FieldTaxRateId)
+	return u
+}
+
+// ClearTaxRateId clears the value of the "taxRateId" field.
+func (u *ResourceUpsert) ClearTaxRateId() *ResourceUpsert {
+	u.SetNull(resource.FieldTaxRateId)
+	return u
+}
+
+// UpdateNewValues updates the fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Resource.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ResourceUpsertOne) UpdateNewValues() *ResourceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Resource.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ResourceUpsertOne) Ignore() *ResourceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResourceUpsertOne) DoNothing() *ResourceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResourceCreate.OnConflict
+// documentation for more info.
+func (u *ResourceUpsertOne) Update(set func(*ResourceUpsert)) *ResourceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResourceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *ResourceUpsertOne) SetCreatedAt(v time.Time) *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *ResourceUpsertOne) UpdateCreatedAt() *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *ResourceUpsertOne) SetUpdatedAt(v time.Time) *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *ResourceUpsertOne) UpdateUpdatedAt() *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ResourceUpsertOne) SetName(v string) *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ResourceUpsertOne) UpdateName() *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ResourceUpsertOne) SetDescription(v string) *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ResourceUpsertOne) UpdateDescription() *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *ResourceUpsertOne) SetTimezone(v string) *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *ResourceUpsertOne) UpdateTimezone() *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetPasswordHash sets the "passwordHash" field.
+func (u *ResourceUpsertOne) SetPasswordHash(v string) *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetPasswordHash(v)
+	})
+}
+
+// UpdatePasswordHash sets the "passwordHash" field to the value that was provided on create.
+func (u *ResourceUpsertOne) UpdatePasswordHash() *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdatePasswordHash()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *ResourceUpsertOne) SetPrice(v int) *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *ResourceUpsertOne) UpdatePrice() *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetBookingPrice sets the "bookingPrice" field.
+func (u *ResourceUpsertOne) SetBookingPrice(v int) *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetBookingPrice(v)
+	})
+}
+
+// UpdateBookingPrice sets the "bookingPrice" field to the value that was provided on create.
+func (u *ResourceUpsertOne) UpdateBookingPrice() *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateBookingPrice()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *ResourceUpsertOne) SetCurrency(v string) *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *ResourceUpsertOne) UpdateCurrency() *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (u *ResourceUpsertOne) SetOrganizationId(v int) *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetOrganizationId(v)
+	})
+}
+
+// UpdateOrganizationId sets the "organizationId" field to the value that was provided on create.
+func (u *ResourceUpsertOne) UpdateOrganizationId() *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateOrganizationId()
+	})
+}
+
+// SetQuantityAvailable sets the "quantityAvailable" field.
+func (u *ResourceUpsertOne) SetQuantityAvailable(v int) *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetQuantityAvailable(v)
+	})
+}
+
+// UpdateQuantityAvailable sets the "quantityAvailable" field to the value that was provided on create.
+func (u *ResourceUpsertOne) UpdateQuantityAvailable() *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateQuantityAvailable()
+	})
+}
+
+// ClearQuantityAvailable clears the value of the "quantityAvailable" field.
+func (u *ResourceUpsertOne) ClearQuantityAvailable() *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.ClearQuantityAvailable()
+	})
+}
+
+// SetCancellationPolicy sets the "cancellationPolicy" field.
+func (u *ResourceUpsertOne) SetCancellationPolicy(v string) *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetCancellationPolicy(v)
+	})
+}
+
+// UpdateCancellationPolicy sets the "cancellationPolicy" field to the value that was provided on create.
+func (u *ResourceUpsertOne) UpdateCancellationPolicy() *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateCancellationPolicy()
+	})
+}
+
+// ClearCancellationPolicy clears the value of the "cancellationPolicy" field.
+func (u *ResourceUpsertOne) ClearCancellationPolicy() *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.ClearCancellationPolicy()
+	})
+}
+
+// SetPricing sets the "pricing" field.
+func (u *ResourceUpsertOne) SetPricing(v string) *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetPricing(v)
+	})
+}
+
+// UpdatePricing sets the "pricing" field to the value that was provided on create.
+func (u *ResourceUpsertOne) UpdatePricing() *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdatePricing()
+	})
+}
+
+// ClearPricing clears the value of the "pricing" field.
+func (u *ResourceUpsertOne) ClearPricing() *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.ClearPricing()
+	})
+}
+
+// SetBookingFields sets the "bookingFields" field.
+func (u *ResourceUpsertOne) SetBookingFields(v string) *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetBookingFields(v)
+	})
+}
+
+// UpdateBookingFields sets the "bookingFields" field to the value that was provided on create.
+func (u *ResourceUpsertOne) UpdateBookingFields() *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateBookingFields()
+	})
+}
+
+// ClearBookingFields clears the value of the "bookingFields" field.
+func (u *ResourceUpsertOne) ClearBookingFields() *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.ClearBookingFields()
+	})
+}
+
+// SetFeedTokenHash sets the "feedTokenHash" field.
+func (u *ResourceUpsertOne) SetFeedTokenHash(v string) *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetFeedTokenHash(v)
+	})
+}
+
+// UpdateFeedTokenHash sets the "feedTokenHash" field to the value that was provided on create.
+func (u *ResourceUpsertOne) UpdateFeedTokenHash() *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateFeedTokenHash()
+	})
+}
+
+// ClearFeedTokenHash clears the value of the "feedTokenHash" field.
+func (u *ResourceUpsertOne) ClearFeedTokenHash() *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.ClearFeedTokenHash()
+	})
+}
+
+// SetTaxRateId sets the "taxRateId" field.
+func (u *ResourceUpsertOne) SetTaxRateId(v int) *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetTaxRateId(v)
+	})
+}
+
+// UpdateTaxRateId sets the "taxRateId" field to the value that was provided on create.
+func (u *ResourceUpsertOne) UpdateTaxRateId() *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateTaxRateId()
+	})
+}
+
+// ClearTaxRateId clears the value of the "taxRateId" field.
+func (u *ResourceUpsertOne) ClearTaxRateId() *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.ClearTaxRateId()
+	})
+}
+
+// Exec executes the query.
+func (u *ResourceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ResourceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResourceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ResourceUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ResourceUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // ResourceCreateBulk is the builder for creating many Resource entities in bulk.
 type ResourceCreateBulk struct {
 	config
 	builders []*ResourceCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Resource entities in the database.
@@ -495,6 +1368,7 @@ func (rcb *ResourceCreateBulk) Save(ctx context.Context) ([]*Resource, error) {
 					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -548,3 +1422,370 @@ func (rcb *ResourceCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Resource.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResourceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rcb *ResourceCreateBulk) OnConflict(opts ...sql.ConflictOption) *ResourceUpsertBulk {
+	rcb.conflict = opts
+	return &ResourceUpsertBulk{
+		create: rcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Resource.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rcb *ResourceCreateBulk) OnConflictColumns(columns ...string) *ResourceUpsertBulk {
+	rcb.conflict = append(rcb.conflict, sql.ConflictColumns(columns...))
+	return &ResourceUpsertBulk{
+		create: rcb,
+	}
+}
+
+// ResourceUpsertBulk is the builder for "upsert"-ing
+// a bulk of Resource nodes.
+type ResourceUpsertBulk struct {
+	create *ResourceCreateBulk
+}
+
+// UpdateNewValues updates the fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Resource.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ResourceUpsertBulk) UpdateNewValues() *ResourceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Resource.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ResourceUpsertBulk) Ignore() *ResourceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResourceUpsertBulk) DoNothing() *ResourceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResourceCreateBulk.OnConflict
+// documentation for more info.
+func (u *ResourceUpsertBulk) Update(set func(*ResourceUpsert)) *ResourceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResourceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *ResourceUpsertBulk) SetCreatedAt(v time.Time) *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *ResourceUpsertBulk) UpdateCreatedAt() *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *ResourceUpsertBulk) SetUpdatedAt(v time.Time) *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *ResourceUpsertBulk) UpdateUpdatedAt() *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ResourceUpsertBulk) SetName(v string) *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ResourceUpsertBulk) UpdateName() *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ResourceUpsertBulk) SetDescription(v string) *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ResourceUpsertBulk) UpdateDescription() *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *ResourceUpsertBulk) SetTimezone(v string) *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *ResourceUpsertBulk) UpdateTimezone() *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetPasswordHash sets the "passwordHash" field.
+func (u *ResourceUpsertBulk) SetPasswordHash(v string) *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetPasswordHash(v)
+	})
+}
+
+// UpdatePasswordHash sets the "passwordHash" field to the value that was provided on create.
+func (u *ResourceUpsertBulk) UpdatePasswordHash() *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdatePasswordHash()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *ResourceUpsertBulk) SetPrice(v int) *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *ResourceUpsertBulk) UpdatePrice() *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetBookingPrice sets the "bookingPrice" field.
+func (u *ResourceUpsertBulk) SetBookingPrice(v int) *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetBookingPrice(v)
+	})
+}
+
+// UpdateBookingPrice sets the "bookingPrice" field to the value that was provided on create.
+func (u *ResourceUpsertBulk) UpdateBookingPrice() *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateBookingPrice()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *ResourceUpsertBulk) SetCurrency(v string) *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *ResourceUpsertBulk) UpdateCurrency() *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (u *ResourceUpsertBulk) SetOrganizationId(v int) *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetOrganizationId(v)
+	})
+}
+
+// UpdateOrganizationId sets the "organizationId" field to the value that was provided on create.
+func (u *ResourceUpsertBulk) UpdateOrganizationId() *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateOrganizationId()
+	})
+}
+
+// SetQuantityAvailable sets the "quantityAvailable" field.
+func (u *ResourceUpsertBulk) SetQuantityAvailable(v int) *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetQuantityAvailable(v)
+	})
+}
+
+// UpdateQuantityAvailable sets the "quantityAvailable" field to the value that was provided on create.
+func (u *ResourceUpsertBulk) UpdateQuantityAvailable() *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateQuantityAvailable()
+	})
+}
+
+// ClearQuantityAvailable clears the value of the "quantityAvailable" field.
+func (u *ResourceUpsertBulk) ClearQuantityAvailable() *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.ClearQuantityAvailable()
+	})
+}
+
+// SetCancellationPolicy sets the "cancellationPolicy" field.
+func (u *ResourceUpsertBulk) SetCancellationPolicy(v string) *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetCancellationPolicy(v)
+	})
+}
+
+// UpdateCancellationPolicy sets the "cancellationPolicy" field to the value that was provided on create.
+func (u *ResourceUpsertBulk) UpdateCancellationPolicy() *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateCancellationPolicy()
+	})
+}
+
+// ClearCancellationPolicy clears the value of the "cancellationPolicy" field.
+func (u *ResourceUpsertBulk) ClearCancellationPolicy() *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.ClearCancellationPolicy()
+	})
+}
+
+// SetPricing sets the "pricing" field.
+func (u *ResourceUpsertBulk) SetPricing(v string) *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetPricing(v)
+	})
+}
+
+// UpdatePricing sets the "pricing" field to the value that was provided on create.
+func (u *ResourceUpsertBulk) UpdatePricing() *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdatePricing()
+	})
+}
+
+// ClearPricing clears the value of the "pricing" field.
+func (u *ResourceUpsertBulk) ClearPricing() *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.ClearPricing()
+	})
+}
+
+// SetBookingFields sets the "bookingFields" field.
+func (u *ResourceUpsertBulk) SetBookingFields(v string) *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetBookingFields(v)
+	})
+}
+
+// UpdateBookingFields sets the "bookingFields" field to the value that was provided on create.
+func (u *ResourceUpsertBulk) UpdateBookingFields() *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateBookingFields()
+	})
+}
+
+// ClearBookingFields clears the value of the "bookingFields" field.
+func (u *ResourceUpsertBulk) ClearBookingFields() *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.ClearBookingFields()
+	})
+}
+
+// SetFeedTokenHash sets the "feedTokenHash" field.
+func (u *ResourceUpsertBulk) SetFeedTokenHash(v string) *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetFeedTokenHash(v)
+	})
+}
+
+// UpdateFeedTokenHash sets the "feedTokenHash" field to the value that was provided on create.
+func (u *ResourceUpsertBulk) UpdateFeedTokenHash() *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateFeedTokenHash()
+	})
+}
+
+// ClearFeedTokenHash clears the value of the "feedTokenHash" field.
+func (u *ResourceUpsertBulk) ClearFeedTokenHash() *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.ClearFeedTokenHash()
+	})
+}
+
+// SetTaxRateId sets the "taxRateId" field.
+func (u *ResourceUpsertBulk) SetTaxRateId(v int) *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetTaxRateId(v)
+	})
+}
+
+// UpdateTaxRateId sets the "taxRateId" field to the value that was provided on create.
+func (u *ResourceUpsertBulk) UpdateTaxRateId() *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateTaxRateId()
+	})
+}
+
+// ClearTaxRateId clears the value of the "taxRateId" field.
+func (u *ResourceUpsertBulk) ClearTaxRateId() *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.ClearTaxRateId()
+	})
+}
+
+// Exec executes the query.
+func (u *ResourceUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ResourceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ResourceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResourceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -9,15 +9,19 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/bookingseries"
 	"github.com/openmesh/booking/ent/organization"
 	"github.com/openmesh/booking/ent/predicate"
 	"github.com/openmesh/booking/ent/resource"
 	"github.com/openmesh/booking/ent/slot"
+	"github.com/openmesh/booking/ent/taxrate"
 	"github.com/openmesh/booking/ent/unavailability"
+	"github.com/openmesh/booking/ent/waitlistentry"
 )
 
 // ResourceQuery is the builder for querying Resource entities.
@@ -32,8 +36,12 @@ type ResourceQuery struct {
 	// eager-loading edges.
 	withSlots            *SlotQuery
 	withBookings         *BookingQuery
+	withBookingSeries    *BookingSeriesQuery
 	withUnavailabilities *UnavailabilityQuery
+	withWaitlistEntries  *WaitlistEntryQuery
 	withOrganization     *OrganizationQuery
+	withTaxRate          *TaxRateQuery
+	modifiers            []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -114,6 +122,28 @@ func (rq *ResourceQuery) QueryBookings() *BookingQuery {
 	return query
 }
 
+// QueryBookingSeries chains the current query on the "bookingSeries" edge.
+func (rq *ResourceQuery) QueryBookingSeries() *BookingSeriesQuery {
+	query := &BookingSeriesQuery{config: rq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resource.Table, resource.FieldID, selector),
+			sqlgraph.To(bookingseries.Table, bookingseries.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resource.BookingSeriesTable, resource.BookingSeriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryUnavailabilities chains the current query on the "unavailabilities" edge.
 func (rq *ResourceQuery) QueryUnavailabilities() *UnavailabilityQuery {
 	query := &UnavailabilityQuery{config: rq.config}
@@ -136,6 +166,28 @@ func (rq *ResourceQuery) QueryUnavailabilities() *UnavailabilityQuery {
 	return query
 }
 
+// QueryWaitlistEntries chains the current query on the "waitlistEntries" edge.
+func (rq *ResourceQuery) QueryWaitlistEntries() *WaitlistEntryQuery {
+	query := &WaitlistEntryQuery{config: rq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resource.Table, resource.FieldID, selector),
+			sqlgraph.To(waitlistentry.Table, waitlistentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resource.WaitlistEntriesTable, resource.WaitlistEntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryOrganization chains the current query on the "organization" edge.
 func (rq *ResourceQuery) QueryOrganization() *OrganizationQuery {
 	query := &OrganizationQuery{config: rq.config}
@@ -158,6 +210,28 @@ func (rq *ResourceQuery) QueryOrganization() *OrganizationQuery {
 	return query
 }
 
+// QueryTaxRate chains the current query on the "taxRate" edge.
+func (rq *ResourceQuery) QueryTaxRate() *TaxRateQuery {
+	query := &TaxRateQuery{config: rq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resource.Table, resource.FieldID, selector),
+			sqlgraph.To(taxrate.Table, taxrate.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resource.TaxRateTable, resource.TaxRateColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Resource entity from the query.
 // Returns a *NotFoundError when no Resource was found.
 func (rq *ResourceQuery) First(ctx context.Context) (*Resource, error) {
@@ -341,8 +415,11 @@ func (rq *ResourceQuery) Clone() *ResourceQuery {
 		predicates:           append([]predicate.Resource{}, rq.predicates...),
 		withSlots:            rq.withSlots.Clone(),
 		withBookings:         rq.withBookings.Clone(),
+		withBookingSeries:    rq.withBookingSeries.Clone(),
 		withUnavailabilities: rq.withUnavailabilities.Clone(),
+		withWaitlistEntries:  rq.withWaitlistEntries.Clone(),
 		withOrganization:     rq.withOrganization.Clone(),
+		withTaxRate:          rq.withTaxRate.Clone(),
 		// clone intermediate query.
 		sql:  rq.sql.Clone(),
 		path: rq.path,
@@ -371,6 +448,17 @@ func (rq *ResourceQuery) WithBookings(opts ...func(*BookingQuery)) *ResourceQuer
 	return rq
 }
 
+// WithBookingSeries tells the query-builder to eager-load the nodes that are connected to
+// the "bookingSeries" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *ResourceQuery) WithBookingSeries(opts ...func(*BookingSeriesQuery)) *ResourceQuery {
+	query := &BookingSeriesQuery{config: rq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withBookingSeries = query
+	return rq
+}
+
 // WithUnavailabilities tells the query-builder to eager-load the nodes that are connected to
 // the "unavailabilities" edge. The optional arguments are used to configure the query builder of the edge.
 func (rq *ResourceQuery) WithUnavailabilities(opts ...func(*UnavailabilityQuery)) *ResourceQuery {
@@ -382,6 +470,17 @@ func (rq *ResourceQuery) WithUnavailabilities(opts ...func(*UnavailabilityQuery)
 	return rq
 }
 
+// WithWaitlistEntries tells the query-builder to eager-load the nodes that are connected to
+// the "waitlistEntries" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *ResourceQuery) WithWaitlistEntries(opts ...func(*WaitlistEntryQuery)) *ResourceQuery {
+	query := &WaitlistEntryQuery{config: rq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withWaitlistEntries = query
+	return rq
+}
+
 // WithOrganization tells the query-builder to eager-load the nodes that are connected to
 // the "organization" edge. The optional arguments are used to configure the query builder of the edge.
 func (rq *ResourceQuery) WithOrganization(opts ...func(*OrganizationQuery)) *ResourceQuery {
@@ -393,6 +492,17 @@ func (rq *ResourceQuery) WithOrganization(opts ...func(*OrganizationQuery)) *Res
 	return rq
 }
 
+// WithTaxRate tells the query-builder to eager-load the nodes that are connected to
+// the "taxRate" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *ResourceQuery) WithTaxRate(opts ...func(*TaxRateQuery)) *ResourceQuery {
+	query := &TaxRateQuery{config: rq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withTaxRate = query
+	return rq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -464,11 +574,14 @@ func (rq *ResourceQuery) sqlAll(ctx context.Context) ([]*Resource, error) {
 	var (
 		nodes       = []*Resource{}
 		_spec       = rq.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [7]bool{
 			rq.withSlots != nil,
 			rq.withBookings != nil,
+			rq.withBookingSeries != nil,
 			rq.withUnavailabilities != nil,
+			rq.withWaitlistEntries != nil,
 			rq.withOrganization != nil,
+			rq.withTaxRate != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
@@ -484,6 +597,9 @@ func (rq *ResourceQuery) sqlAll(ctx context.Context) ([]*Resource, error) {
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(rq.modifiers) > 0 {
+		_spec.Modifiers = rq.modifiers
+	}
 	if err := sqlgraph.QueryNodes(ctx, rq.driver, _spec); err != nil {
 		return nil, err
 	}
@@ -541,6 +657,31 @@ func (rq *ResourceQuery) sqlAll(ctx context.Context) ([]*Resource, error) {
 		}
 	}
 
+	if query := rq.withBookingSeries; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Resource)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.BookingSeries = []*BookingSeries{}
+		}
+		query.Where(predicate.BookingSeries(func(s *sql.Selector) {
+			s.Where(sql.InValues(resource.BookingSeriesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.ResourceId
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "resourceId" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.BookingSeries = append(node.Edges.BookingSeries, n)
+		}
+	}
+
 	if query := rq.withUnavailabilities; query != nil {
 		fks := make([]driver.Value, 0, len(nodes))
 		nodeids := make(map[int]*Resource)
@@ -566,6 +707,31 @@ func (rq *ResourceQuery) sqlAll(ctx context.Context) ([]*Resource, error) {
 		}
 	}
 
+	if query := rq.withWaitlistEntries; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Resource)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.WaitlistEntries = []*WaitlistEntry{}
+		}
+		query.Where(predicate.WaitlistEntry(func(s *sql.Selector) {
+			s.Where(sql.InValues(resource.WaitlistEntriesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.ResourceId
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "resourceId" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.WaitlistEntries = append(node.Edges.WaitlistEntries, n)
+		}
+	}
+
 	if query := rq.withOrganization; query != nil {
 		ids := make([]int, 0, len(nodes))
 		nodeids := make(map[int][]*Resource)
@@ -592,11 +758,43 @@ func (rq *ResourceQuery) sqlAll(ctx context.Context) ([]*Resource, error) {
 		}
 	}
 
+	if query := rq.withTaxRate; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*Resource)
+		for i := range nodes {
+			if nodes[i].TaxRateId == nil {
+				continue
+			}
+			fk := *nodes[i].TaxRateId
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(taxrate.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "taxRateId" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.TaxRate = n
+			}
+		}
+	}
+
 	return nodes, nil
 }
 
 func (rq *ResourceQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := rq.querySpec()
+	if len(rq.modifiers) > 0 {
+		_spec.Modifiers = rq.modifiers
+	}
 	return sqlgraph.CountNodes(ctx, rq.driver, _spec)
 }
 
@@ -668,6 +866,9 @@ func (rq *ResourceQuery) sqlQuery(ctx context.Context) *sql.Selector {
 		selector = rq.sql
 		selector.Select(selector.Columns(columns...)...)
 	}
+	for _, m := range rq.modifiers {
+		m(selector)
+	}
 	for _, p := range rq.predicates {
 		p(selector)
 	}
@@ -685,6 +886,32 @@ func (rq *ResourceQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (rq *ResourceQuery) ForUpdate(opts ...sql.LockOption) *ResourceQuery {
+	if rq.driver.Dialect() == dialect.Postgres {
+		rq.Unique(false)
+	}
+	rq.modifiers = append(rq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return rq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (rq *ResourceQuery) ForShare(opts ...sql.LockOption) *ResourceQuery {
+	if rq.driver.Dialect() == dialect.Postgres {
+		rq.Unique(false)
+	}
+	rq.modifiers = append(rq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return rq
+}
+
 // ResourceGroupBy is the group-by builder for Resource entities.
 type ResourceGroupBy struct {
 	config
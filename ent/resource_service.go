@@ -2,12 +2,15 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
+	"entgo.io/ent/privacy"
 	"github.com/openmesh/booking"
 	"github.com/openmesh/booking/ent/resource"
 	"github.com/openmesh/booking/ent/slot"
+	"golang.org/x/crypto/bcrypt"
 )
 
 type resourceService struct {
@@ -153,6 +156,61 @@ func (s *resourceService) DeleteResource(ctx context.Context, req booking.Delete
 	return booking.DeleteResourceResponse{}
 }
 
+// ImportResources creates a resource for each row of an import within a single
+// transaction. Rows that fail validation or whose name is already taken,
+// including by an earlier row of the same import, are reported and skipped
+// unless the import is all or nothing.
+func (s *resourceService) ImportResources(
+	ctx context.Context,
+	req booking.ImportResourcesRequest,
+) booking.ImportResourcesResponse {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return booking.ImportResourcesResponse{Err: fmt.Errorf("failed to start transaction: %w", err)}
+	}
+
+	var res booking.ImportResourcesResponse
+	var failed []booking.ValidationError
+	for i, row := range req.Rows {
+		result := booking.ResourceImportResult{Row: i, Errors: row.Validate()}
+		if len(result.Errors) == 0 {
+			err = checkForResourceNameConflict(ctx, tx, row.Name)
+			if booking.ErrorCode(err) == booking.ERESOURCENAMECONFLICT {
+				result.Errors = []booking.ValidationError{{Name: "name", Reason: booking.ErrorMessage(err)}}
+			} else if err != nil {
+				_ = tx.Rollback()
+				return booking.ImportResourcesResponse{Err: fmt.Errorf("failed resource name check: %w", err)}
+			}
+		}
+		if len(result.Errors) > 0 {
+			failed = append(failed, booking.ImportRowErrors(i, result.Errors)...)
+			res.Rows = append(res.Rows, result)
+			res.Failed++
+			continue
+		}
+
+		r, err := createResource(ctx, tx, row)
+		if err != nil {
+			_ = tx.Rollback()
+			return booking.ImportResourcesResponse{Err: fmt.Errorf("failed to import row %d: %w", i, err)}
+		}
+		result.Resource = r.toModel()
+		res.Rows = append(res.Rows, result)
+		res.Created++
+	}
+
+	if req.AllOrNothing && len(failed) > 0 {
+		_ = tx.Rollback()
+		return booking.ImportResourcesResponse{Err: booking.ValidationErrorf("", failed...)}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return booking.ImportResourcesResponse{Err: fmt.Errorf("failed to commit transaction: %w", err)}
+	}
+	return res
+}
+
 // findResourceByID retrieves a single resource by ID from the database.
 func findResourceByID(
 	ctx context.Context,
@@ -244,15 +302,47 @@ func checkForResourceNameConflict(ctx context.Context, tx *Tx, name string) erro
 // in the Slots parameter of the struct.
 func createResource(ctx context.Context, tx *Tx, req booking.CreateResourceRequest) (*Resource, error) {
 	orgID := booking.OrganizationIDFromContext(ctx)
+	policy, err := encodeCancellationPolicy(req.CancellationPolicy)
+	if err != nil {
+		return nil, err
+	}
+	pricing, err := encodePricingRules(req.Pricing)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := encodeBookingFields(req.BookingFields)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResourceTaxRate(ctx, tx.Client(), req.TaxRateID); err != nil {
+		return nil, err
+	}
+	currency := resourceCurrency(req.Price, req.BookingPrice)
+	if currency == "" {
+		currency, err = organizationCurrency(ctx, tx.Client())
+		if err != nil {
+			return nil, err
+		}
+	}
+	passwordHash, err := hashResourcePassword(req.Password)
+	if err != nil {
+		return nil, err
+	}
 	r, err := tx.Resource.
 		Create().
-		SetBookingPrice(req.BookingPrice).
+		SetBookingPrice(req.BookingPrice.Amount).
 		SetDescription(req.Description).
 		SetName(req.Name).
 		SetOrganizationID(orgID).
-		SetPassword(req.Password).
-		SetPrice(req.Price).
-		SetTimezone(req.Timezone).
+		SetPasswordHash(passwordHash).
+		SetPrice(req.Price.Amount).
+		SetCurrency(currency).
+		SetTimezone(normalizeTimezone(req.Timezone)).
+		SetNillableQuantityAvailable(req.QuantityAvailable).
+		SetCancellationPolicy(policy).
+		SetPricing(pricing).
+		SetBookingFields(fields).
+		SetNillableTaxRateId(req.TaxRateID).
 		Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create resource: %w", err)
@@ -269,6 +359,150 @@ func createResource(ctx context.Context, tx *Tx, req booking.CreateResourceReque
 	return r, nil
 }
 
+// normalizeTimezone stores legacy UTC offsets as the equivalent IANA timezone
+// where there is one.
+func normalizeTimezone(tz string) string {
+	if name, ok := booking.NormalizeTimezone(tz); ok {
+		return name
+	}
+	return tz
+}
+
+// MigrateResourceTimezones converts the timezones of resources that still use
+// a legacy UTC offset to the equivalent IANA timezone. Resources are migrated
+// across every organization. Offsets without an equivalent are left as they
+// are. Returns the number of resources that were migrated.
+func MigrateResourceTimezones(ctx context.Context, client *Client) (int, error) {
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	timezones, err := tx.Resource.
+		Query().
+		Where(resource.TimezoneHasPrefix("UTC")).
+		Unique(true).
+		Select(resource.FieldTimezone).
+		Strings(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, fmt.Errorf("failed to query timezones: %w", err)
+	}
+
+	migrated := 0
+	for _, tz := range timezones {
+		name, ok := booking.NormalizeTimezone(tz)
+		if !ok || name == tz {
+			continue
+		}
+		n, err := tx.Resource.
+			Update().
+			Where(resource.Timezone(tz)).
+			SetTimezone(name).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return 0, fmt.Errorf("failed to migrate timezone %q: %w", tz, err)
+		}
+		migrated += n
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return migrated, nil
+}
+
+// hashResourcePassword returns the bcrypt hash that password is stored as.
+// Returns an empty string if password is empty so that the resource isn't
+// protected.
+func hashResourcePassword(password string) (string, error) {
+	if password == "" {
+		return "", nil
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+	return string(hash), nil
+}
+
+// isPasswordHash reports whether s is already a bcrypt hash rather than a
+// password stored before passwords were hashed.
+func isPasswordHash(s string) bool {
+	_, err := bcrypt.Cost([]byte(s))
+	return err == nil
+}
+
+// checkResourcePassword returns ERESOURCEPASSWORDINVALID unless password
+// matches the password of resource r. Resources without a password accept any
+// password.
+func checkResourcePassword(r *Resource, password string) error {
+	if r.PasswordHash == "" {
+		return nil
+	}
+	if password == "" {
+		return booking.Errorf(booking.ERESOURCEPASSWORDINVALID, "A password is required to book this resource.")
+	}
+	err := bcrypt.CompareHashAndPassword([]byte(r.PasswordHash), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return booking.Errorf(booking.ERESOURCEPASSWORDINVALID, "Incorrect password.")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check password: %w", err)
+	}
+	return nil
+}
+
+// MigrateResourcePasswords hashes the passwords of resources that were stored
+// in plaintext. Resources are migrated across every organization. Returns the
+// number of resources that were migrated.
+func MigrateResourcePasswords(ctx context.Context, client *Client) (int, error) {
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	resources, err := tx.Resource.
+		Query().
+		Where(resource.PasswordHashNEQ("")).
+		All(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, fmt.Errorf("failed to query resources: %w", err)
+	}
+
+	migrated := 0
+	for _, r := range resources {
+		if isPasswordHash(r.PasswordHash) {
+			continue
+		}
+		hash, err := hashResourcePassword(r.PasswordHash)
+		if err != nil {
+			_ = tx.Rollback()
+			return 0, err
+		}
+		err = tx.Resource.
+			UpdateOneID(r.ID).
+			SetPasswordHash(hash).
+			Exec(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return 0, fmt.Errorf("failed to migrate password of resource %d: %w", r.ID, err)
+		}
+		migrated++
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return migrated, nil
+}
+
 // createSlot creates a slot in the database.
 func createSlot(ctx context.Context, tx *Tx, s *booking.Slot, r *Resource) (*Slot, error) {
 	ns, err := tx.Slot.
@@ -285,18 +519,67 @@ func createSlot(ctx context.Context, tx *Tx, s *booking.Slot, r *Resource) (*Slo
 	return ns, nil
 }
 
+// resourceCurrency returns the currency given for the prices of a resource.
+// Returns an empty string if neither price has a currency.
+func resourceCurrency(price, bookingPrice booking.Money) string {
+	if price.Currency != "" {
+		return price.Currency
+	}
+	return bookingPrice.Currency
+}
+
 // updateResource updates a resource in the database. Also updates the slots
 // associated with the resource. It achieves this by deleting the existing slot
 // records and inserting new ones.
 func updateResource(ctx context.Context, tx *Tx, req booking.UpdateResourceRequest) (*Resource, error) {
-	r, err := tx.Resource.
-		UpdateOneID(req.ID).
+	q := tx.Resource.
+		UpdateOneID(req.ID)
+	if req.QuantityAvailable != nil {
+		q.SetQuantityAvailable(*req.QuantityAvailable)
+	} else {
+		q.ClearQuantityAvailable()
+	}
+	if err := checkResourceTaxRate(ctx, tx.Client(), req.TaxRateID); err != nil {
+		return nil, err
+	}
+	if req.TaxRateID != nil {
+		q.SetTaxRateId(*req.TaxRateID)
+	} else {
+		q.ClearTaxRateId()
+	}
+	policy, err := encodeCancellationPolicy(req.CancellationPolicy)
+	if err != nil {
+		return nil, err
+	}
+	pricing, err := encodePricingRules(req.Pricing)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := encodeBookingFields(req.BookingFields)
+	if err != nil {
+		return nil, err
+	}
+	// Resources keep their currency unless another one is given.
+	if currency := resourceCurrency(req.Price, req.BookingPrice); currency != "" {
+		q.SetCurrency(currency)
+	}
+	// Resources keep their password unless another one is given.
+	if req.Password != nil {
+		passwordHash, err := hashResourcePassword(*req.Password)
+		if err != nil {
+			return nil, err
+		}
+		q.SetPasswordHash(passwordHash)
+	}
+	r, err := q.
 		SetName(req.Name).
 		SetDescription(req.Description).
-		SetTimezone(req.Timezone).
-		SetPassword(req.Password).
-		SetPrice(req.Price).
-		SetBookingPrice(req.BookingPrice).
+		SetTimezone(normalizeTimezone(req.Timezone)).
+		SetPrice(req.Price.Amount).
+		SetBookingPrice(req.BookingPrice.Amount).
+		SetCancellationPolicy(policy).
+		SetPricing(pricing).
+		SetBookingFields(fields).
 		Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update resource: %w", err)
@@ -343,18 +626,107 @@ func deleteResource(ctx context.Context, tx *Tx, id int) error {
 	return nil
 }
 
+// encodeCancellationPolicy returns the JSON encoding of a cancellation policy
+// as it is stored with a resource. Resources without a policy store an empty
+// string.
+func encodeCancellationPolicy(p *booking.CancellationPolicy) (string, error) {
+	if p == nil {
+		return "", nil
+	}
+	buf, err := json.Marshal(p)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cancellation policy: %w", err)
+	}
+	return string(buf), nil
+}
+
+// cancellationPolicy decodes the cancellation policy of r. Returns nil if r
+// has no policy.
+func (r *Resource) cancellationPolicy() (*booking.CancellationPolicy, error) {
+	if r.CancellationPolicy == "" {
+		return nil, nil
+	}
+	var p booking.CancellationPolicy
+	if err := json.Unmarshal([]byte(r.CancellationPolicy), &p); err != nil {
+		return nil, fmt.Errorf("failed to decode cancellation policy of resource %d: %w", r.ID, err)
+	}
+	return &p, nil
+}
+
+// encodePricingRules returns the JSON encoding of pricing rules as they are
+// stored with a resource. Resources without rules store an empty string.
+func encodePricingRules(p *booking.PricingRules) (string, error) {
+	if p == nil {
+		return "", nil
+	}
+	buf, err := json.Marshal(p)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode pricing rules: %w", err)
+	}
+	return string(buf), nil
+}
+
+// pricingRules decodes the pricing rules of r. Returns nil if r has no rules.
+func (r *Resource) pricingRules() (*booking.PricingRules, error) {
+	if r.Pricing == "" {
+		return nil, nil
+	}
+	var p booking.PricingRules
+	if err := json.Unmarshal([]byte(r.Pricing), &p); err != nil {
+		return nil, fmt.Errorf("failed to decode pricing rules of resource %d: %w", r.ID, err)
+	}
+	return &p, nil
+}
+
+// encodeBookingFields returns the JSON encoding of booking fields as they are
+// stored with a resource. Resources without fields store an empty string.
+func encodeBookingFields(f booking.BookingFields) (string, error) {
+	if len(f) == 0 {
+		return "", nil
+	}
+	buf, err := json.Marshal(f)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode booking fields: %w", err)
+	}
+	return string(buf), nil
+}
+
+// bookingFields decodes the booking fields of r. Returns an empty list if r
+// has no fields.
+func (r *Resource) bookingFields() (booking.BookingFields, error) {
+	f := make(booking.BookingFields, 0)
+	if r.BookingFields == "" {
+		return f, nil
+	}
+	if err := json.Unmarshal([]byte(r.BookingFields), &f); err != nil {
+		return nil, fmt.Errorf("failed to decode booking fields of resource %d: %w", r.ID, err)
+	}
+	return f, nil
+}
+
 func (r *Resource) toModel() *booking.Resource {
+	// Policies, rules and fields are only ever stored by
+	// encodeCancellationPolicy, encodePricingRules and encodeBookingFields so
+	// they always decode.
+	policy, _ := r.cancellationPolicy()
+	pricing, _ := r.pricingRules()
+	fields, _ := r.bookingFields()
 	result := &booking.Resource{
-		ID:             r.ID,
-		OrganizationID: r.OrganizationId,
-		Name:           r.Name,
-		Description:    r.Description,
-		Timezone:       r.Timezone,
-		Password:       r.Password,
-		Price:          r.Price,
-		BookingPrice:   r.BookingPrice,
-		CreatedAt:      r.CreatedAt,
-		UpdatedAt:      r.UpdatedAt,
+		ID:                 r.ID,
+		OrganizationID:     r.OrganizationId,
+		Name:               r.Name,
+		Description:        r.Description,
+		Timezone:           r.Timezone,
+		PasswordProtected:  r.PasswordHash != "",
+		Price:              booking.NewMoney(r.Price, r.Currency),
+		BookingPrice:       booking.NewMoney(r.BookingPrice, r.Currency),
+		QuantityAvailable:  r.QuantityAvailable,
+		CancellationPolicy: policy,
+		Pricing:            pricing,
+		BookingFields:      fields,
+		TaxRateID:          r.TaxRateId,
+		CreatedAt:          r.CreatedAt,
+		UpdatedAt:          r.UpdatedAt,
 	}
 
 	if r.Edges.Organization != nil {
@@ -12,11 +12,14 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/bookingseries"
 	"github.com/openmesh/booking/ent/organization"
 	"github.com/openmesh/booking/ent/predicate"
 	"github.com/openmesh/booking/ent/resource"
 	"github.com/openmesh/booking/ent/slot"
+	"github.com/openmesh/booking/ent/taxrate"
 	"github.com/openmesh/booking/ent/unavailability"
+	"github.com/openmesh/booking/ent/waitlistentry"
 )
 
 // ResourceUpdate is the builder for updating Resource entities.
@@ -56,9 +59,17 @@ func (ru *ResourceUpdate) SetTimezone(s string) *ResourceUpdate {
 	return ru
 }
 
-// SetPassword sets the "password" field.
-func (ru *ResourceUpdate) SetPassword(s string) *ResourceUpdate {
-	ru.mutation.SetPassword(s)
+// SetPasswordHash sets the "passwordHash" field.
+func (ru *ResourceUpdate) SetPasswordHash(s string) *ResourceUpdate {
+	ru.mutation.SetPasswordHash(s)
+	return ru
+}
+
+// SetNillablePasswordHash sets the "passwordHash" field if the given value is not nil.
+func (ru *ResourceUpdate) SetNillablePasswordHash(s *string) *ResourceUpdate {
+	if s != nil {
+		ru.SetPasswordHash(*s)
+	}
 	return ru
 }
 
@@ -88,6 +99,20 @@ func (ru *ResourceUpdate) AddBookingPrice(i int) *ResourceUpdate {
 	return ru
 }
 
+// SetCurrency sets the "currency" field.
+func (ru *ResourceUpdate) SetCurrency(s string) *ResourceUpdate {
+	ru.mutation.SetCurrency(s)
+	return ru
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (ru *ResourceUpdate) SetNillableCurrency(s *string) *ResourceUpdate {
+	if s != nil {
+		ru.SetCurrency(*s)
+	}
+	return ru
+}
+
 // SetOrganizationId sets the "organizationId" field.
 func (ru *ResourceUpdate) SetOrganizationId(i int) *ResourceUpdate {
 	ru.mutation.SetOrganizationId(i)
@@ -121,6 +146,106 @@ func (ru *ResourceUpdate) ClearQuantityAvailable() *ResourceUpdate {
 	return ru
 }
 
+// SetCancellationPolicy sets the "cancellationPolicy" field.
+func (ru *ResourceUpdate) SetCancellationPolicy(s string) *ResourceUpdate {
+	ru.mutation.SetCancellationPolicy(s)
+	return ru
+}
+
+// SetNillableCancellationPolicy sets the "cancellationPolicy" field if the given value is not nil.
+func (ru *ResourceUpdate) SetNillableCancellationPolicy(s *string) *ResourceUpdate {
+	if s != nil {
+		ru.SetCancellationPolicy(*s)
+	}
+	return ru
+}
+
+// ClearCancellationPolicy clears the value of the "cancellationPolicy" field.
+func (ru *ResourceUpdate) ClearCancellationPolicy() *ResourceUpdate {
+	ru.mutation.ClearCancellationPolicy()
+	return ru
+}
+
+// SetPricing sets the "pricing" field.
+func (ru *ResourceUpdate) SetPricing(s string) *ResourceUpdate {
+	ru.mutation.SetPricing(s)
+	return ru
+}
+
+// SetNillablePricing sets the "pricing" field if the given value is not nil.
+func (ru *ResourceUpdate) SetNillablePricing(s *string) *ResourceUpdate {
+	if s != nil {
+		ru.SetPricing(*s)
+	}
+	return ru
+}
+
+// ClearPricing clears the value of the "pricing" field.
+func (ru *ResourceUpdate) ClearPricing() *ResourceUpdate {
+	ru.mutation.ClearPricing()
+	return ru
+}
+
+// SetBookingFields sets the "bookingFields" field.
+func (ru *ResourceUpdate) SetBookingFields(s string) *ResourceUpdate {
+	ru.mutation.SetBookingFields(s)
+	return ru
+}
+
+// SetNillableBookingFields sets the "bookingFields" field if the given value is not nil.
+func (ru *ResourceUpdate) SetNillableBookingFields(s *string) *ResourceUpdate {
+	if s != nil {
+		ru.SetBookingFields(*s)
+	}
+	return ru
+}
+
+// ClearBookingFields clears the value of the "bookingFields" field.
+func (ru *ResourceUpdate) ClearBookingFields() *ResourceUpdate {
+	ru.mutation.ClearBookingFields()
+	return ru
+}
+
+// SetFeedTokenHash sets the "feedTokenHash" field.
+func (ru *ResourceUpdate) SetFeedTokenHash(s string) *ResourceUpdate {
+	ru.mutation.SetFeedTokenHash(s)
+	return ru
+}
+
+// SetNillableFeedTokenHash sets the "feedTokenHash" field if the given value is not nil.
+func (ru *ResourceUpdate) SetNillableFeedTokenHash(s *string) *ResourceUpdate {
+	if s != nil {
+		ru.SetFeedTokenHash(*s)
+	}
+	return ru
+}
+
+// ClearFeedTokenHash clears the value of the "feedTokenHash" field.
+func (ru *ResourceUpdate) ClearFeedTokenHash() *ResourceUpdate {
+	ru.mutation.ClearFeedTokenHash()
+	return ru
+}
+
+// SetTaxRateId sets the "taxRateId" field.
+func (ru *ResourceUpdate) SetTaxRateId(i int) *ResourceUpdate {
+	ru.mutation.SetTaxRateId(i)
+	return ru
+}
+
+// SetNillableTaxRateId sets the "taxRateId" field if the given value is not nil.
+func (ru *ResourceUpdate) SetNillableTaxRateId(i *int) *ResourceUpdate {
+	if i != nil {
+		ru.SetTaxRateId(*i)
+	}
+	return ru
+}
+
+// ClearTaxRateId clears the value of the "taxRateId" field.
+func (ru *ResourceUpdate) ClearTaxRateId() *ResourceUpdate {
+	ru.mutation.ClearTaxRateId()
+	return ru
+}
+
 // AddSlotIDs adds the "slots" edge to the Slot entity by IDs.
 func (ru *ResourceUpdate) AddSlotIDs(ids ...int) *ResourceUpdate {
 	ru.mutation.AddSlotIDs(ids...)
@@ -151,6 +276,21 @@ func (ru *ResourceUpdate) AddBookings(b ...*Booking) *ResourceUpdate {
 	return ru.AddBookingIDs(ids...)
 }
 
+// AddBookingSeryIDs adds the "bookingSeries" edge to the BookingSeries entity by IDs.
+func (ru *ResourceUpdate) AddBookingSeryIDs(ids ...int) *ResourceUpdate {
+	ru.mutation.AddBookingSeryIDs(ids...)
+	return ru
+}
+
+// AddBookingSeries adds the "bookingSeries" edges to the BookingSeries entity.
+func (ru *ResourceUpdate) AddBookingSeries(b ...*BookingSeries) *ResourceUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return ru.AddBookingSeryIDs(ids...)
+}
+
 // AddUnavailabilityIDs adds the "unavailabilities" edge to the Unavailability entity by IDs.
 func (ru *ResourceUpdate) AddUnavailabilityIDs(ids ...int) *ResourceUpdate {
 	ru.mutation.AddUnavailabilityIDs(ids...)
@@ -166,6 +306,21 @@ func (ru *ResourceUpdate) AddUnavailabilities(u ...*Unavailability) *ResourceUpd
 	return ru.AddUnavailabilityIDs(ids...)
 }
 
+// AddWaitlistEntryIDs adds the "waitlistEntries" edge to the WaitlistEntry entity by IDs.
+func (ru *ResourceUpdate) AddWaitlistEntryIDs(ids ...int) *ResourceUpdate {
+	ru.mutation.AddWaitlistEntryIDs(ids...)
+	return ru
+}
+
+// AddWaitlistEntries adds the "waitlistEntries" edges to the WaitlistEntry entity.
+func (ru *ResourceUpdate) AddWaitlistEntries(w ...*WaitlistEntry) *ResourceUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return ru.AddWaitlistEntryIDs(ids...)
+}
+
 // SetOrganizationID sets the "organization" edge to the Organization entity by ID.
 func (ru *ResourceUpdate) SetOrganizationID(id int) *ResourceUpdate {
 	ru.mutation.SetOrganizationID(id)
@@ -177,6 +332,25 @@ func (ru *ResourceUpdate) SetOrganization(o *Organization) *ResourceUpdate {
 	return ru.SetOrganizationID(o.ID)
 }
 
+// SetTaxRateID sets the "taxRate" edge to the TaxRate entity by ID.
+func (ru *ResourceUpdate) SetTaxRateID(id int) *ResourceUpdate {
+	ru.mutation.SetTaxRateID(id)
+	return ru
+}
+
+// SetNillableTaxRateID sets the "taxRate" edge to the TaxRate entity by ID if the given value is not nil.
+func (ru *ResourceUpdate) SetNillableTaxRateID(id *int) *ResourceUpdate {
+	if id != nil {
+		ru = ru.SetTaxRateID(*id)
+	}
+	return ru
+}
+
+// SetTaxRate sets the "taxRate" edge to the TaxRate entity.
+func (ru *ResourceUpdate) SetTaxRate(t *TaxRate) *ResourceUpdate {
+	return ru.SetTaxRateID(t.ID)
+}
+
 // Mutation returns the ResourceMutation object of the builder.
 func (ru *ResourceUpdate) Mutation() *ResourceMutation {
 	return ru.mutation
@@ -224,6 +398,27 @@ func (ru *ResourceUpdate) RemoveBookings(b ...*Booking) *ResourceUpdate {
 	return ru.RemoveBookingIDs(ids...)
 }
 
+// ClearBookingSeries clears all "bookingSeries" edges to the BookingSeries entity.
+func (ru *ResourceUpdate) ClearBookingSeries() *ResourceUpdate {
+	ru.mutation.ClearBookingSeries()
+	return ru
+}
+
+// RemoveBookingSeryIDs removes the "bookingSeries" edge to BookingSeries entities by IDs.
+func (ru *ResourceUpdate) RemoveBookingSeryIDs(ids ...int) *ResourceUpdate {
+	ru.mutation.RemoveBookingSeryIDs(ids...)
+	return ru
+}
+
+// RemoveBookingSeries removes "bookingSeries" edges to BookingSeries entities.
+func (ru *ResourceUpdate) RemoveBookingSeries(b ...*BookingSeries) *ResourceUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return ru.RemoveBookingSeryIDs(ids...)
+}
+
 // ClearUnavailabilities clears all "unavailabilities" edges to the Unavailability entity.
 func (ru *ResourceUpdate) ClearUnavailabilities() *ResourceUpdate {
 	ru.mutation.ClearUnavailabilities()
@@ -245,12 +440,39 @@ func (ru *ResourceUpdate) RemoveUnavailabilities(u ...*Unavailability) *Resource
 	return ru.RemoveUnavailabilityIDs(ids...)
 }
 
+// ClearWaitlistEntries clears all "waitlistEntries" edges to the WaitlistEntry entity.
+func (ru *ResourceUpdate) ClearWaitlistEntries() *ResourceUpdate {
+	ru.mutation.ClearWaitlistEntries()
+	return ru
+}
+
+// RemoveWaitlistEntryIDs removes the "waitlistEntries" edge to WaitlistEntry entities by IDs.
+func (ru *ResourceUpdate) RemoveWaitlistEntryIDs(ids ...int) *ResourceUpdate {
+	ru.mutation.RemoveWaitlistEntryIDs(ids...)
+	return ru
+}
+
+// RemoveWaitlistEntries removes "waitlistEntries" edges to WaitlistEntry entities.
+func (ru *ResourceUpdate) RemoveWaitlistEntries(w ...*WaitlistEntry) *ResourceUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return ru.RemoveWaitlistEntryIDs(ids...)
+}
+
 // ClearOrganization clears the "organization" edge to the Organization entity.
 func (ru *ResourceUpdate) ClearOrganization() *ResourceUpdate {
 	ru.mutation.ClearOrganization()
 	return ru
 }
 
+// ClearTaxRate clears the "taxRate" edge to the TaxRate entity.
+func (ru *ResourceUpdate) ClearTaxRate() *ResourceUpdate {
+	ru.mutation.ClearTaxRate()
+	return ru
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ru *ResourceUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -380,11 +602,11 @@ func (ru *ResourceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: resource.FieldTimezone,
 		})
 	}
-	if value, ok := ru.mutation.Password(); ok {
+	if value, ok := ru.mutation.PasswordHash(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
 			Value:  value,
-			Column: resource.FieldPassword,
+			Column: resource.FieldPasswordHash,
 		})
 	}
 	if value, ok := ru.mutation.Price(); ok {
@@ -415,6 +637,13 @@ func (ru *ResourceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: resource.FieldBookingPrice,
 		})
 	}
+	if value, ok := ru.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: resource.FieldCurrency,
+		})
+	}
 	if value, ok := ru.mutation.QuantityAvailable(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -435,6 +664,58 @@ func (ru *ResourceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: resource.FieldQuantityAvailable,
 		})
 	}
+	if value, ok := ru.mutation.CancellationPolicy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: resource.FieldCancellationPolicy,
+		})
+	}
+	if ru.mutation.CancellationPolicyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: resource.FieldCancellationPolicy,
+		})
+	}
+	if value, ok := ru.mutation.Pricing(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: resource.FieldPricing,
+		})
+	}
+	if ru.mutation.PricingCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: resource.FieldPricing,
+		})
+	}
+	if value, ok := ru.mutation.BookingFields(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: resource.FieldBookingFields,
+		})
+	}
+	if ru.mutation.BookingFieldsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: resource.FieldBookingFields,
+		})
+	}
+	if value, ok := ru.mutation.FeedTokenHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: resource.FieldFeedTokenHash,
+		})
+	}
+	if ru.mutation.FeedTokenHashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: resource.FieldFeedTokenHash,
+		})
+	}
 	if ru.mutation.SlotsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -543,6 +824,60 @@ func (ru *ResourceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ru.mutation.BookingSeriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.BookingSeriesTable,
+			Columns: []string{resource.BookingSeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: bookingseries.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedBookingSeriesIDs(); len(nodes) > 0 && !ru.mutation.BookingSeriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.BookingSeriesTable,
+			Columns: []string{resource.BookingSeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: bookingseries.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.BookingSeriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.BookingSeriesTable,
+			Columns: []string{resource.BookingSeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: bookingseries.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if ru.mutation.UnavailabilitiesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -597,6 +932,60 @@ func (ru *ResourceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ru.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.WaitlistEntriesTable,
+			Columns: []string{resource.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: waitlistentry.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedWaitlistEntriesIDs(); len(nodes) > 0 && !ru.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.WaitlistEntriesTable,
+			Columns: []string{resource.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: waitlistentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.WaitlistEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.WaitlistEntriesTable,
+			Columns: []string{resource.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: waitlistentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if ru.mutation.OrganizationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -632,6 +1021,41 @@ func (ru *ResourceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ru.mutation.TaxRateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resource.TaxRateTable,
+			Columns: []string{resource.TaxRateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: taxrate.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.TaxRateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resource.TaxRateTable,
+			Columns: []string{resource.TaxRateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: taxrate.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{resource.Label}
@@ -643,100 +1067,222 @@ func (ru *ResourceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
-// ResourceUpdateOne is the builder for updating a single Resource entity.
-type ResourceUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *ResourceMutation
+// ResourceUpdateOne is the builder for updating a single Resource entity.
+type ResourceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ResourceMutation
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (ruo *ResourceUpdateOne) SetUpdatedAt(t time.Time) *ResourceUpdateOne {
+	ruo.mutation.SetUpdatedAt(t)
+	return ruo
+}
+
+// SetName sets the "name" field.
+func (ruo *ResourceUpdateOne) SetName(s string) *ResourceUpdateOne {
+	ruo.mutation.SetName(s)
+	return ruo
+}
+
+// SetDescription sets the "description" field.
+func (ruo *ResourceUpdateOne) SetDescription(s string) *ResourceUpdateOne {
+	ruo.mutation.SetDescription(s)
+	return ruo
+}
+
+// SetTimezone sets the "timezone" field.
+func (ruo *ResourceUpdateOne) SetTimezone(s string) *ResourceUpdateOne {
+	ruo.mutation.SetTimezone(s)
+	return ruo
+}
+
+// SetPasswordHash sets the "passwordHash" field.
+func (ruo *ResourceUpdateOne) SetPasswordHash(s string) *ResourceUpdateOne {
+	ruo.mutation.SetPasswordHash(s)
+	return ruo
+}
+
+// SetNillablePasswordHash sets the "passwordHash" field if the given value is not nil.
+func (ruo *ResourceUpdateOne) SetNillablePasswordHash(s *string) *ResourceUpdateOne {
+	if s != nil {
+		ruo.SetPasswordHash(*s)
+	}
+	return ruo
+}
+
+// SetPrice sets the "price" field.
+func (ruo *ResourceUpdateOne) SetPrice(i int) *ResourceUpdateOne {
+	ruo.mutation.ResetPrice()
+	ruo.mutation.SetPrice(i)
+	return ruo
+}
+
+// AddPrice adds i to the "price" field.
+func (ruo *ResourceUpdateOne) AddPrice(i int) *ResourceUpdateOne {
+	ruo.mutation.AddPrice(i)
+	return ruo
+}
+
+// SetBookingPrice sets the "bookingPrice" field.
+func (ruo *ResourceUpdateOne) SetBookingPrice(i int) *ResourceUpdateOne {
+	ruo.mutation.ResetBookingPrice()
+	ruo.mutation.SetBookingPrice(i)
+	return ruo
+}
+
+// AddBookingPrice adds i to the "bookingPrice" field.
+func (ruo *ResourceUpdateOne) AddBookingPrice(i int) *ResourceUpdateOne {
+	ruo.mutation.AddBookingPrice(i)
+	return ruo
+}
+
+// SetCurrency sets the "currency" field.
+func (ruo *ResourceUpdateOne) SetCurrency(s string) *ResourceUpdateOne {
+	ruo.mutation.SetCurrency(s)
+	return ruo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (ruo *ResourceUpdateOne) SetNillableCurrency(s *string) *ResourceUpdateOne {
+	if s != nil {
+		ruo.SetCurrency(*s)
+	}
+	return ruo
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (ruo *ResourceUpdateOne) SetOrganizationId(i int) *ResourceUpdateOne {
+	ruo.mutation.SetOrganizationId(i)
+	return ruo
+}
+
+// SetQuantityAvailable sets the "quantityAvailable" field.
+func (ruo *ResourceUpdateOne) SetQuantityAvailable(i int) *ResourceUpdateOne {
+	ruo.mutation.ResetQuantityAvailable()
+	ruo.mutation.SetQuantityAvailable(i)
+	return ruo
+}
+
+// SetNillableQuantityAvailable sets the "quantityAvailable" field if the given value is not nil.
+func (ruo *ResourceUpdateOne) SetNillableQuantityAvailable(i *int) *ResourceUpdateOne {
+	if i != nil {
+		ruo.SetQuantityAvailable(*i)
+	}
+	return ruo
+}
+
+// AddQuantityAvailable adds i to the "quantityAvailable" field.
+func (ruo *ResourceUpdateOne) AddQuantityAvailable(i int) *ResourceUpdateOne {
+	ruo.mutation.AddQuantityAvailable(i)
+	return ruo
+}
+
+// ClearQuantityAvailable clears the value of the "quantityAvailable" field.
+func (ruo *ResourceUpdateOne) ClearQuantityAvailable() *ResourceUpdateOne {
+	ruo.mutation.ClearQuantityAvailable()
+	return ruo
+}
+
+// SetCancellationPolicy sets the "cancellationPolicy" field.
+func (ruo *ResourceUpdateOne) SetCancellationPolicy(s string) *ResourceUpdateOne {
+	ruo.mutation.SetCancellationPolicy(s)
+	return ruo
+}
+
+// SetNillableCancellationPolicy sets the "cancellationPolicy" field if the given value is not nil.
+func (ruo *ResourceUpdateOne) SetNillableCancellationPolicy(s *string) *ResourceUpdateOne {
+	if s != nil {
+		ruo.SetCancellationPolicy(*s)
+	}
+	return ruo
 }
 
-// SetUpdatedAt sets the "updatedAt" field.
-func (ruo *ResourceUpdateOne) SetUpdatedAt(t time.Time) *ResourceUpdateOne {
-	ruo.mutation.SetUpdatedAt(t)
+// ClearCancellationPolicy clears the value of the "cancellationPolicy" field.
+func (ruo *ResourceUpdateOne) ClearCancellationPolicy() *ResourceUpdateOne {
+	ruo.mutation.ClearCancellationPolicy()
 	return ruo
 }
 
-// SetName sets the "name" field.
-func (ruo *ResourceUpdateOne) SetName(s string) *ResourceUpdateOne {
-	ruo.mutation.SetName(s)
+// SetPricing sets the "pricing" field.
+func (ruo *ResourceUpdateOne) SetPricing(s string) *ResourceUpdateOne {
+	ruo.mutation.SetPricing(s)
 	return ruo
 }
 
-// SetDescription sets the "description" field.
-func (ruo *ResourceUpdateOne) SetDescription(s string) *ResourceUpdateOne {
-	ruo.mutation.SetDescription(s)
+// SetNillablePricing sets the "pricing" field if the given value is not nil.
+func (ruo *ResourceUpdateOne) SetNillablePricing(s *string) *ResourceUpdateOne {
+	if s != nil {
+		ruo.SetPricing(*s)
+	}
 	return ruo
 }
 
-// SetTimezone sets the "timezone" field.
-func (ruo *ResourceUpdateOne) SetTimezone(s string) *ResourceUpdateOne {
-	ruo.mutation.SetTimezone(s)
+// ClearPricing clears the value of the "pricing" field.
+func (ruo *ResourceUpdateOne) ClearPricing() *ResourceUpdateOne {
+	ruo.mutation.ClearPricing()
 	return ruo
 }
 
-// SetPassword sets the "password" field.
-func (ruo *ResourceUpdateOne) SetPassword(s string) *ResourceUpdateOne {
-	ruo.mutation.SetPassword(s)
+// SetBookingFields sets the "bookingFields" field.
+func (ruo *ResourceUpdateOne) SetBookingFields(s string) *ResourceUpdateOne {
+	ruo.mutation.SetBookingFields(s)
 	return ruo
 }
 
-// SetPrice sets the "price" field.
-func (ruo *ResourceUpdateOne) SetPrice(i int) *ResourceUpdateOne {
-	ruo.mutation.ResetPrice()
-	ruo.mutation.SetPrice(i)
+// SetNillableBookingFields sets the "bookingFields" field if the given value is not nil.
+func (ruo *ResourceUpdateOne) SetNillableBookingFields(s *string) *ResourceUpdateOne {
+	if s != nil {
+		ruo.SetBookingFields(*s)
+	}
 	return ruo
 }
 
-// AddPrice adds i to the "price" field.
-func (ruo *ResourceUpdateOne) AddPrice(i int) *ResourceUpdateOne {
-	ruo.mutation.AddPrice(i)
+// ClearBookingFields clears the value of the "bookingFields" field.
+func (ruo *ResourceUpdateOne) ClearBookingFields() *ResourceUpdateOne {
+	ruo.mutation.ClearBookingFields()
 	return ruo
 }
 
-// SetBookingPrice sets the "bookingPrice" field.
-func (ruo *ResourceUpdateOne) SetBookingPrice(i int) *ResourceUpdateOne {
-	ruo.mutation.ResetBookingPrice()
-	ruo.mutation.SetBookingPrice(i)
+// SetFeedTokenHash sets the "feedTokenHash" field.
+func (ruo *ResourceUpdateOne) SetFeedTokenHash(s string) *ResourceUpdateOne {
+	ruo.mutation.SetFeedTokenHash(s)
 	return ruo
 }
 
-// AddBookingPrice adds i to the "bookingPrice" field.
-func (ruo *ResourceUpdateOne) AddBookingPrice(i int) *ResourceUpdateOne {
-	ruo.mutation.AddBookingPrice(i)
+// SetNillableFeedTokenHash sets the "feedTokenHash" field if the given value is not nil.
+func (ruo *ResourceUpdateOne) SetNillableFeedTokenHash(s *string) *ResourceUpdateOne {
+	if s != nil {
+		ruo.SetFeedTokenHash(*s)
+	}
 	return ruo
 }
 
-// SetOrganizationId sets the "organizationId" field.
-func (ruo *ResourceUpdateOne) SetOrganizationId(i int) *ResourceUpdateOne {
-	ruo.mutation.SetOrganizationId(i)
+// ClearFeedTokenHash clears the value of the "feedTokenHash" field.
+func (ruo *ResourceUpdateOne) ClearFeedTokenHash() *ResourceUpdateOne {
+	ruo.mutation.ClearFeedTokenHash()
 	return ruo
 }
 
-// SetQuantityAvailable sets the "quantityAvailable" field.
-func (ruo *ResourceUpdateOne) SetQuantityAvailable(i int) *ResourceUpdateOne {
-	ruo.mutation.ResetQuantityAvailable()
-	ruo.mutation.SetQuantityAvailable(i)
+// SetTaxRateId sets the "taxRateId" field.
+func (ruo *ResourceUpdateOne) SetTaxRateId(i int) *ResourceUpdateOne {
+	ruo.mutation.SetTaxRateId(i)
 	return ruo
 }
 
-// SetNillableQuantityAvailable sets the "quantityAvailable" field if the given value is not nil.
-func (ruo *ResourceUpdateOne) SetNillableQuantityAvailable(i *int) *ResourceUpdateOne {
+// SetNillableTaxRateId sets the "taxRateId" field if the given value is not nil.
+func (ruo *ResourceUpdateOne) SetNillableTaxRateId(i *int) *ResourceUpdateOne {
 	if i != nil {
-		ruo.SetQuantityAvailable(*i)
+		ruo.SetTaxRateId(*i)
 	}
 	return ruo
 }
 
-// AddQuantityAvailable adds i to the "quantityAvailable" field.
-func (ruo *ResourceUpdateOne) AddQuantityAvailable(i int) *ResourceUpdateOne {
-	ruo.mutation.AddQuantityAvailable(i)
-	return ruo
-}
-
-// ClearQuantityAvailable clears the value of the "quantityAvailable" field.
-func (ruo *ResourceUpdateOne) ClearQuantityAvailable() *ResourceUpdateOne {
-	ruo.mutation.ClearQuantityAvailable()
+// ClearTaxRateId clears the value of the "taxRateId" field.
+func (ruo *ResourceUpdateOne) ClearTaxRateId() *ResourceUpdateOne {
+	ruo.mutation.ClearTaxRateId()
 	return ruo
 }
 
@@ -770,6 +1316,21 @@ func (ruo *ResourceUpdateOne) AddBookings(b ...*Booking) *ResourceUpdateOne {
 	return ruo.AddBookingIDs(ids...)
 }
 
+// AddBookingSeryIDs adds the "bookingSeries" edge to the BookingSeries entity by IDs.
+func (ruo *ResourceUpdateOne) AddBookingSeryIDs(ids ...int) *ResourceUpdateOne {
+	ruo.mutation.AddBookingSeryIDs(ids...)
+	return ruo
+}
+
+// AddBookingSeries adds the "bookingSeries" edges to the BookingSeries entity.
+func (ruo *ResourceUpdateOne) AddBookingSeries(b ...*BookingSeries) *ResourceUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return ruo.AddBookingSeryIDs(ids...)
+}
+
 // AddUnavailabilityIDs adds the "unavailabilities" edge to the Unavailability entity by IDs.
 func (ruo *ResourceUpdateOne) AddUnavailabilityIDs(ids ...int) *ResourceUpdateOne {
 	ruo.mutation.AddUnavailabilityIDs(ids...)
@@ -785,6 +1346,21 @@ func (ruo *ResourceUpdateOne) AddUnavailabilities(u ...*Unavailability) *Resourc
 	return ruo.AddUnavailabilityIDs(ids...)
 }
 
+// AddWaitlistEntryIDs adds the "waitlistEntries" edge to the WaitlistEntry entity by IDs.
+func (ruo *ResourceUpdateOne) AddWaitlistEntryIDs(ids ...int) *ResourceUpdateOne {
+	ruo.mutation.AddWaitlistEntryIDs(ids...)
+	return ruo
+}
+
+// AddWaitlistEntries adds the "waitlistEntries" edges to the WaitlistEntry entity.
+func (ruo *ResourceUpdateOne) AddWaitlistEntries(w ...*WaitlistEntry) *ResourceUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return ruo.AddWaitlistEntryIDs(ids...)
+}
+
 // SetOrganizationID sets the "organization" edge to the Organization entity by ID.
 func (ruo *ResourceUpdateOne) SetOrganizationID(id int) *ResourceUpdateOne {
 	ruo.mutation.SetOrganizationID(id)
@@ -796,6 +1372,25 @@ func (ruo *ResourceUpdateOne) SetOrganization(o *Organization) *ResourceUpdateOn
 	return ruo.SetOrganizationID(o.ID)
 }
 
+// SetTaxRateID sets the "taxRate" edge to the TaxRate entity by ID.
+func (ruo *ResourceUpdateOne) SetTaxRateID(id int) *ResourceUpdateOne {
+	ruo.mutation.SetTaxRateID(id)
+	return ruo
+}
+
+// SetNillableTaxRateID sets the "taxRate" edge to the TaxRate entity by ID if the given value is not nil.
+func (ruo *ResourceUpdateOne) SetNillableTaxRateID(id *int) *ResourceUpdateOne {
+	if id != nil {
+		ruo = ruo.SetTaxRateID(*id)
+	}
+	return ruo
+}
+
+// SetTaxRate sets the "taxRate" edge to the TaxRate entity.
+func (ruo *ResourceUpdateOne) SetTaxRate(t *TaxRate) *ResourceUpdateOne {
+	return ruo.SetTaxRateID(t.ID)
+}
+
 // Mutation returns the ResourceMutation object of the builder.
 func (ruo *ResourceUpdateOne) Mutation() *ResourceMutation {
 	return ruo.mutation
@@ -843,6 +1438,27 @@ func (ruo *ResourceUpdateOne) RemoveBookings(b ...*Booking) *ResourceUpdateOne {
 	return ruo.RemoveBookingIDs(ids...)
 }
 
+// ClearBookingSeries clears all "bookingSeries" edges to the BookingSeries entity.
+func (ruo *ResourceUpdateOne) ClearBookingSeries() *ResourceUpdateOne {
+	ruo.mutation.ClearBookingSeries()
+	return ruo
+}
+
+// RemoveBookingSeryIDs removes the "bookingSeries" edge to BookingSeries entities by IDs.
+func (ruo *ResourceUpdateOne) RemoveBookingSeryIDs(ids ...int) *ResourceUpdateOne {
+	ruo.mutation.RemoveBookingSeryIDs(ids...)
+	return ruo
+}
+
+// RemoveBookingSeries removes "bookingSeries" edges to BookingSeries entities.
+func (ruo *ResourceUpdateOne) RemoveBookingSeries(b ...*BookingSeries) *ResourceUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return ruo.RemoveBookingSeryIDs(ids...)
+}
+
 // ClearUnavailabilities clears all "unavailabilities" edges to the Unavailability entity.
 func (ruo *ResourceUpdateOne) ClearUnavailabilities() *ResourceUpdateOne {
 	ruo.mutation.ClearUnavailabilities()
@@ -864,12 +1480,39 @@ func (ruo *ResourceUpdateOne) RemoveUnavailabilities(u ...*Unavailability) *Reso
 	return ruo.RemoveUnavailabilityIDs(ids...)
 }
 
+// ClearWaitlistEntries clears all "waitlistEntries" edges to the WaitlistEntry entity.
+func (ruo *ResourceUpdateOne) ClearWaitlistEntries() *ResourceUpdateOne {
+	ruo.mutation.ClearWaitlistEntries()
+	return ruo
+}
+
+// RemoveWaitlistEntryIDs removes the "waitlistEntries" edge to WaitlistEntry entities by IDs.
+func (ruo *ResourceUpdateOne) RemoveWaitlistEntryIDs(ids ...int) *ResourceUpdateOne {
+	ruo.mutation.RemoveWaitlistEntryIDs(ids...)
+	return ruo
+}
+
+// RemoveWaitlistEntries removes "waitlistEntries" edges to WaitlistEntry entities.
+func (ruo *ResourceUpdateOne) RemoveWaitlistEntries(w ...*WaitlistEntry) *ResourceUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return ruo.RemoveWaitlistEntryIDs(ids...)
+}
+
 // ClearOrganization clears the "organization" edge to the Organization entity.
 func (ruo *ResourceUpdateOne) ClearOrganization() *ResourceUpdateOne {
 	ruo.mutation.ClearOrganization()
 	return ruo
 }
 
+// ClearTaxRate clears the "taxRate" edge to the TaxRate entity.
+func (ruo *ResourceUpdateOne) ClearTaxRate() *ResourceUpdateOne {
+	ruo.mutation.ClearTaxRate()
+	return ruo
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (ruo *ResourceUpdateOne) Select(field string, fields ...string) *ResourceUpdateOne {
@@ -1023,11 +1666,11 @@ func (ruo *ResourceUpdateOne) sqlSave(ctx context.Context) (_node *Resource, err
 			Column: resource.FieldTimezone,
 		})
 	}
-	if value, ok := ruo.mutation.Password(); ok {
+	if value, ok := ruo.mutation.PasswordHash(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
 			Value:  value,
-			Column: resource.FieldPassword,
+			Column: resource.FieldPasswordHash,
 		})
 	}
 	if value, ok := ruo.mutation.Price(); ok {
@@ -1058,6 +1701,13 @@ func (ruo *ResourceUpdateOne) sqlSave(ctx context.Context) (_node *Resource, err
 			Column: resource.FieldBookingPrice,
 		})
 	}
+	if value, ok := ruo.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: resource.FieldCurrency,
+		})
+	}
 	if value, ok := ruo.mutation.QuantityAvailable(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -1078,6 +1728,58 @@ func (ruo *ResourceUpdateOne) sqlSave(ctx context.Context) (_node *Resource, err
 			Column: resource.FieldQuantityAvailable,
 		})
 	}
+	if value, ok := ruo.mutation.CancellationPolicy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: resource.FieldCancellationPolicy,
+		})
+	}
+	if ruo.mutation.CancellationPolicyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: resource.FieldCancellationPolicy,
+		})
+	}
+	if value, ok := ruo.mutation.Pricing(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: resource.FieldPricing,
+		})
+	}
+	if ruo.mutation.PricingCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: resource.FieldPricing,
+		})
+	}
+	if value, ok := ruo.mutation.BookingFields(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: resource.FieldBookingFields,
+		})
+	}
+	if ruo.mutation.BookingFieldsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: resource.FieldBookingFields,
+		})
+	}
+	if value, ok := ruo.mutation.FeedTokenHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: resource.FieldFeedTokenHash,
+		})
+	}
+	if ruo.mutation.FeedTokenHashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: resource.FieldFeedTokenHash,
+		})
+	}
 	if ruo.mutation.SlotsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1186,6 +1888,60 @@ func (ruo *ResourceUpdateOne) sqlSave(ctx context.Context) (_node *Resource, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ruo.mutation.BookingSeriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.BookingSeriesTable,
+			Columns: []string{resource.BookingSeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: bookingseries.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedBookingSeriesIDs(); len(nodes) > 0 && !ruo.mutation.BookingSeriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.BookingSeriesTable,
+			Columns: []string{resource.BookingSeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: bookingseries.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.BookingSeriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.BookingSeriesTable,
+			Columns: []string{resource.BookingSeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: bookingseries.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if ruo.mutation.UnavailabilitiesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1240,6 +1996,60 @@ func (ruo *ResourceUpdateOne) sqlSave(ctx context.Context) (_node *Resource, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ruo.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.WaitlistEntriesTable,
+			Columns: []string{resource.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: waitlistentry.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedWaitlistEntriesIDs(); len(nodes) > 0 && !ruo.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.WaitlistEntriesTable,
+			Columns: []string{resource.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: waitlistentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.WaitlistEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.WaitlistEntriesTable,
+			Columns: []string{resource.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: waitlistentry.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if ruo.mutation.OrganizationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1275,6 +2085,41 @@ func (ruo *ResourceUpdateOne) sqlSave(ctx context.Context) (_node *Resource, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ruo.mutation.TaxRateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resource.TaxRateTable,
+			Columns: []string{resource.TaxRateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: taxrate.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.TaxRateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resource.TaxRateTable,
+			Columns: []string{resource.TaxRateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: taxrate.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Resource{config: ruo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
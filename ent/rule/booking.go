@@ -6,6 +6,7 @@ import (
 	"github.com/openmesh/booking"
 	"github.com/openmesh/booking/ent"
 	entbooking "github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/bookingseries"
 	"github.com/openmesh/booking/ent/privacy"
 	"github.com/openmesh/booking/ent/resource"
 )
@@ -39,3 +40,33 @@ func FilterBookingOrganizationMutationRule() privacy.MutationRule {
 		return privacy.Skip
 	})
 }
+
+func FilterBookingSeriesOrganizationQueryRule() privacy.QueryRule {
+	return privacy.BookingSeriesQueryRuleFunc(func(ctx context.Context, bq *ent.BookingSeriesQuery) error {
+		orgID := booking.OrganizationIDFromContext(ctx)
+		if orgID == 0 {
+			return privacy.Denyf("missing organization from context")
+		}
+		bq.Where(
+			bookingseries.HasResourceWith(
+				resource.OrganizationId(orgID),
+			),
+		)
+		return privacy.Skip
+	})
+}
+
+func FilterBookingSeriesOrganizationMutationRule() privacy.MutationRule {
+	return privacy.BookingSeriesMutationRuleFunc(func(ctx context.Context, bm *ent.BookingSeriesMutation) error {
+		orgID := booking.OrganizationIDFromContext(ctx)
+		if orgID == 0 {
+			return privacy.Denyf("missing organization from context")
+		}
+		bm.Where(
+			bookingseries.HasResourceWith(
+				resource.OrganizationId(orgID),
+			),
+		)
+		return privacy.Skip
+	})
+}
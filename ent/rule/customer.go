@@ -0,0 +1,32 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/openmesh/booking"
+	"github.com/openmesh/booking/ent"
+	"github.com/openmesh/booking/ent/customer"
+	"github.com/openmesh/booking/ent/privacy"
+)
+
+func FilterCustomerOrganizationQueryRule() privacy.QueryRule {
+	return privacy.CustomerQueryRuleFunc(func(ctx context.Context, cq *ent.CustomerQuery) error {
+		orgID := booking.OrganizationIDFromContext(ctx)
+		if orgID == 0 {
+			return privacy.Denyf("missing organization from context")
+		}
+		cq.Where(customer.OrganizationId(orgID))
+		return privacy.Skip
+	})
+}
+
+func FilterCustomerOrganizationMutationRule() privacy.MutationRule {
+	return privacy.CustomerMutationRuleFunc(func(ctx context.Context, cm *ent.CustomerMutation) error {
+		orgID := booking.OrganizationIDFromContext(ctx)
+		if orgID == 0 {
+			return privacy.Denyf("missing organization from context")
+		}
+		cm.Where(customer.OrganizationId(orgID))
+		return privacy.Skip
+	})
+}
@@ -0,0 +1,32 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/openmesh/booking"
+	"github.com/openmesh/booking/ent"
+	"github.com/openmesh/booking/ent/invoice"
+	"github.com/openmesh/booking/ent/privacy"
+)
+
+func FilterInvoiceOrganizationQueryRule() privacy.QueryRule {
+	return privacy.InvoiceQueryRuleFunc(func(ctx context.Context, iq *ent.InvoiceQuery) error {
+		orgID := booking.OrganizationIDFromContext(ctx)
+		if orgID == 0 {
+			return privacy.Denyf("missing organization from context")
+		}
+		iq.Where(invoice.OrganizationId(orgID))
+		return privacy.Skip
+	})
+}
+
+func FilterInvoiceOrganizationMutationRule() privacy.MutationRule {
+	return privacy.InvoiceMutationRuleFunc(func(ctx context.Context, im *ent.InvoiceMutation) error {
+		orgID := booking.OrganizationIDFromContext(ctx)
+		if orgID == 0 {
+			return privacy.Denyf("missing organization from context")
+		}
+		im.Where(invoice.OrganizationId(orgID))
+		return privacy.Skip
+	})
+}
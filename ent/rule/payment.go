@@ -0,0 +1,34 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/openmesh/booking"
+	"github.com/openmesh/booking/ent"
+	entbooking "github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/payment"
+	"github.com/openmesh/booking/ent/privacy"
+	"github.com/openmesh/booking/ent/resource"
+)
+
+func FilterPaymentOrganizationQueryRule() privacy.QueryRule {
+	return privacy.PaymentQueryRuleFunc(func(ctx context.Context, pq *ent.PaymentQuery) error {
+		orgID := booking.OrganizationIDFromContext(ctx)
+		if orgID == 0 {
+			return privacy.Denyf("missing organization from context")
+		}
+		pq.Where(payment.HasBookingWith(entbooking.HasResourceWith(resource.OrganizationId(orgID))))
+		return privacy.Skip
+	})
+}
+
+func FilterPaymentOrganizationMutationRule() privacy.MutationRule {
+	return privacy.PaymentMutationRuleFunc(func(ctx context.Context, pm *ent.PaymentMutation) error {
+		orgID := booking.OrganizationIDFromContext(ctx)
+		if orgID == 0 {
+			return privacy.Denyf("missing organization from context")
+		}
+		pm.Where(payment.HasBookingWith(entbooking.HasResourceWith(resource.OrganizationId(orgID))))
+		return privacy.Skip
+	})
+}
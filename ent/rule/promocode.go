@@ -0,0 +1,55 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/openmesh/booking"
+	"github.com/openmesh/booking/ent"
+	"github.com/openmesh/booking/ent/privacy"
+	"github.com/openmesh/booking/ent/promocode"
+	"github.com/openmesh/booking/ent/promoredemption"
+)
+
+func FilterPromoCodeOrganizationQueryRule() privacy.QueryRule {
+	return privacy.PromoCodeQueryRuleFunc(func(ctx context.Context, pq *ent.PromoCodeQuery) error {
+		orgID := booking.OrganizationIDFromContext(ctx)
+		if orgID == 0 {
+			return privacy.Denyf("missing organization from context")
+		}
+		pq.Where(promocode.OrganizationId(orgID))
+		return privacy.Skip
+	})
+}
+
+func FilterPromoCodeOrganizationMutationRule() privacy.MutationRule {
+	return privacy.PromoCodeMutationRuleFunc(func(ctx context.Context, pm *ent.PromoCodeMutation) error {
+		orgID := booking.OrganizationIDFromContext(ctx)
+		if orgID == 0 {
+			return privacy.Denyf("missing organization from context")
+		}
+		pm.Where(promocode.OrganizationId(orgID))
+		return privacy.Skip
+	})
+}
+
+func FilterPromoRedemptionOrganizationQueryRule() privacy.QueryRule {
+	return privacy.PromoRedemptionQueryRuleFunc(func(ctx context.Context, pq *ent.PromoRedemptionQuery) error {
+		orgID := booking.OrganizationIDFromContext(ctx)
+		if orgID == 0 {
+			return privacy.Denyf("missing organization from context")
+		}
+		pq.Where(promoredemption.HasPromoCodeWith(promocode.OrganizationId(orgID)))
+		return privacy.Skip
+	})
+}
+
+func FilterPromoRedemptionOrganizationMutationRule() privacy.MutationRule {
+	return privacy.PromoRedemptionMutationRuleFunc(func(ctx context.Context, pm *ent.PromoRedemptionMutation) error {
+		orgID := booking.OrganizationIDFromContext(ctx)
+		if orgID == 0 {
+			return privacy.Denyf("missing organization from context")
+		}
+		pm.Where(promoredemption.HasPromoCodeWith(promocode.OrganizationId(orgID)))
+		return privacy.Skip
+	})
+}
@@ -0,0 +1,32 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/openmesh/booking"
+	"github.com/openmesh/booking/ent"
+	"github.com/openmesh/booking/ent/privacy"
+	"github.com/openmesh/booking/ent/taxrate"
+)
+
+func FilterTaxRateOrganizationQueryRule() privacy.QueryRule {
+	return privacy.TaxRateQueryRuleFunc(func(ctx context.Context, tq *ent.TaxRateQuery) error {
+		orgID := booking.OrganizationIDFromContext(ctx)
+		if orgID == 0 {
+			return privacy.Denyf("missing organization from context")
+		}
+		tq.Where(taxrate.OrganizationId(orgID))
+		return privacy.Skip
+	})
+}
+
+func FilterTaxRateOrganizationMutationRule() privacy.MutationRule {
+	return privacy.TaxRateMutationRuleFunc(func(ctx context.Context, tm *ent.TaxRateMutation) error {
+		orgID := booking.OrganizationIDFromContext(ctx)
+		if orgID == 0 {
+			return privacy.Denyf("missing organization from context")
+		}
+		tm.Where(taxrate.OrganizationId(orgID))
+		return privacy.Skip
+	})
+}
@@ -9,6 +9,28 @@ import (
 	"github.com/openmesh/booking/ent/token"
 )
 
+func FilterTokenUserQueryRule() privacy.QueryRule {
+	return privacy.TokenQueryRuleFunc(func(ctx context.Context, tq *ent.TokenQuery) error {
+		userID := booking.UserIDFromContext(ctx)
+		if userID == 0 {
+			return privacy.Denyf("missing user from context")
+		}
+		tq.Where(token.UserId(userID))
+		return privacy.Skip
+	})
+}
+
+func FilterTokenOrganizationQueryRule() privacy.QueryRule {
+	return privacy.TokenQueryRuleFunc(func(ctx context.Context, tq *ent.TokenQuery) error {
+		orgID := booking.OrganizationIDFromContext(ctx)
+		if orgID == 0 {
+			return privacy.Denyf("missing organization from context")
+		}
+		tq.Where(token.OrganizationId(orgID))
+		return privacy.Skip
+	})
+}
+
 func FilterTokenUserMutationRule() privacy.MutationRule {
 	return privacy.TokenMutationRuleFunc(func(ctx context.Context, tm *ent.TokenMutation) error {
 		userID := booking.UserIDFromContext(ctx)
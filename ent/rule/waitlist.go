@@ -0,0 +1,33 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/openmesh/booking"
+	"github.com/openmesh/booking/ent"
+	"github.com/openmesh/booking/ent/privacy"
+	"github.com/openmesh/booking/ent/resource"
+	"github.com/openmesh/booking/ent/waitlistentry"
+)
+
+func FilterWaitlistEntryOrganizationQueryRule() privacy.QueryRule {
+	return privacy.WaitlistEntryQueryRuleFunc(func(ctx context.Context, wq *ent.WaitlistEntryQuery) error {
+		orgID := booking.OrganizationIDFromContext(ctx)
+		if orgID == 0 {
+			return privacy.Denyf("missing organization from context")
+		}
+		wq.Where(waitlistentry.HasResourceWith(resource.OrganizationId(orgID)))
+		return privacy.Skip
+	})
+}
+
+func FilterWaitlistEntryOrganizationMutationRule() privacy.MutationRule {
+	return privacy.WaitlistEntryMutationRuleFunc(func(ctx context.Context, wm *ent.WaitlistEntryMutation) error {
+		orgID := booking.OrganizationIDFromContext(ctx)
+		if orgID == 0 {
+			return privacy.Denyf("missing organization from context")
+		}
+		wm.Where(waitlistentry.HasResourceWith(resource.OrganizationId(orgID)))
+		return privacy.Skip
+	})
+}
@@ -0,0 +1,55 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/openmesh/booking"
+	"github.com/openmesh/booking/ent"
+	"github.com/openmesh/booking/ent/privacy"
+	"github.com/openmesh/booking/ent/webhook"
+	"github.com/openmesh/booking/ent/webhookdelivery"
+)
+
+func FilterWebhookOrganizationQueryRule() privacy.QueryRule {
+	return privacy.WebhookQueryRuleFunc(func(ctx context.Context, wq *ent.WebhookQuery) error {
+		orgID := booking.OrganizationIDFromContext(ctx)
+		if orgID == 0 {
+			return privacy.Denyf("missing organization from context")
+		}
+		wq.Where(webhook.OrganizationId(orgID))
+		return privacy.Skip
+	})
+}
+
+func FilterWebhookOrganizationMutationRule() privacy.MutationRule {
+	return privacy.WebhookMutationRuleFunc(func(ctx context.Context, wm *ent.WebhookMutation) error {
+		orgID := booking.OrganizationIDFromContext(ctx)
+		if orgID == 0 {
+			return privacy.Denyf("missing organization from context")
+		}
+		wm.Where(webhook.OrganizationId(orgID))
+		return privacy.Skip
+	})
+}
+
+func FilterWebhookDeliveryOrganizationQueryRule() privacy.QueryRule {
+	return privacy.WebhookDeliveryQueryRuleFunc(func(ctx context.Context, wq *ent.WebhookDeliveryQuery) error {
+		orgID := booking.OrganizationIDFromContext(ctx)
+		if orgID == 0 {
+			return privacy.Denyf("missing organization from context")
+		}
+		wq.Where(webhookdelivery.OrganizationId(orgID))
+		return privacy.Skip
+	})
+}
+
+func FilterWebhookDeliveryOrganizationMutationRule() privacy.MutationRule {
+	return privacy.WebhookDeliveryMutationRuleFunc(func(ctx context.Context, wm *ent.WebhookDeliveryMutation) error {
+		orgID := booking.OrganizationIDFromContext(ctx)
+		if orgID == 0 {
+			return privacy.Denyf("missing organization from context")
+		}
+		wm.Where(webhookdelivery.OrganizationId(orgID))
+		return privacy.Skip
+	})
+}
@@ -9,13 +9,23 @@ import (
 	"github.com/openmesh/booking/ent/auth"
 	"github.com/openmesh/booking/ent/booking"
 	"github.com/openmesh/booking/ent/bookingmetadatum"
+	"github.com/openmesh/booking/ent/bookingseries"
+	"github.com/openmesh/booking/ent/customer"
+	"github.com/openmesh/booking/ent/invoice"
 	"github.com/openmesh/booking/ent/organization"
+	"github.com/openmesh/booking/ent/payment"
+	"github.com/openmesh/booking/ent/promocode"
+	"github.com/openmesh/booking/ent/promoredemption"
 	"github.com/openmesh/booking/ent/resource"
 	"github.com/openmesh/booking/ent/schema"
 	"github.com/openmesh/booking/ent/slot"
+	"github.com/openmesh/booking/ent/taxrate"
 	"github.com/openmesh/booking/ent/token"
 	"github.com/openmesh/booking/ent/unavailability"
 	"github.com/openmesh/booking/ent/user"
+	"github.com/openmesh/booking/ent/waitlistentry"
+	"github.com/openmesh/booking/ent/webhook"
+	"github.com/openmesh/booking/ent/webhookdelivery"
 
 	"entgo.io/ent"
 	"entgo.io/ent/privacy"
@@ -64,6 +74,30 @@ func init() {
 	booking.DefaultUpdatedAt = bookingDescUpdatedAt.Default.(func() time.Time)
 	// booking.UpdateDefaultUpdatedAt holds the default value on update for the updatedAt field.
 	booking.UpdateDefaultUpdatedAt = bookingDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// bookingDescCurrency is the schema descriptor for currency field.
+	bookingDescCurrency := bookingFields[9].Descriptor()
+	// booking.DefaultCurrency holds the default value on creation for the currency field.
+	booking.DefaultCurrency = bookingDescCurrency.Default.(string)
+	// bookingDescRefundAmount is the schema descriptor for refundAmount field.
+	bookingDescRefundAmount := bookingFields[11].Descriptor()
+	// booking.DefaultRefundAmount holds the default value on creation for the refundAmount field.
+	booking.DefaultRefundAmount = bookingDescRefundAmount.Default.(int)
+	// bookingDescFeeAmount is the schema descriptor for feeAmount field.
+	bookingDescFeeAmount := bookingFields[12].Descriptor()
+	// booking.DefaultFeeAmount holds the default value on creation for the feeAmount field.
+	booking.DefaultFeeAmount = bookingDescFeeAmount.Default.(int)
+	// bookingDescTaxRate is the schema descriptor for taxRate field.
+	bookingDescTaxRate := bookingFields[14].Descriptor()
+	// booking.DefaultTaxRate holds the default value on creation for the taxRate field.
+	booking.DefaultTaxRate = bookingDescTaxRate.Default.(int)
+	// bookingDescTaxInclusive is the schema descriptor for taxInclusive field.
+	bookingDescTaxInclusive := bookingFields[15].Descriptor()
+	// booking.DefaultTaxInclusive holds the default value on creation for the taxInclusive field.
+	booking.DefaultTaxInclusive = bookingDescTaxInclusive.Default.(bool)
+	// bookingDescTaxAmount is the schema descriptor for taxAmount field.
+	bookingDescTaxAmount := bookingFields[16].Descriptor()
+	// booking.DefaultTaxAmount holds the default value on creation for the taxAmount field.
+	booking.DefaultTaxAmount = bookingDescTaxAmount.Default.(int)
 	bookingmetadatum.Policy = privacy.NewPolicies(schema.BookingMetadatum{})
 	bookingmetadatum.Hooks[0] = func(next ent.Mutator) ent.Mutator {
 		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
@@ -73,6 +107,94 @@ func init() {
 			return next.Mutate(ctx, m)
 		})
 	}
+	bookingseriesMixin := schema.BookingSeries{}.Mixin()
+	bookingseries.Policy = privacy.NewPolicies(schema.BookingSeries{})
+	bookingseries.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := bookingseries.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	bookingseriesMixinFields0 := bookingseriesMixin[0].Fields()
+	_ = bookingseriesMixinFields0
+	bookingseriesFields := schema.BookingSeries{}.Fields()
+	_ = bookingseriesFields
+	// bookingseriesDescCreatedAt is the schema descriptor for createdAt field.
+	bookingseriesDescCreatedAt := bookingseriesMixinFields0[0].Descriptor()
+	// bookingseries.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	bookingseries.DefaultCreatedAt = bookingseriesDescCreatedAt.Default.(func() time.Time)
+	// bookingseriesDescUpdatedAt is the schema descriptor for updatedAt field.
+	bookingseriesDescUpdatedAt := bookingseriesMixinFields0[1].Descriptor()
+	// bookingseries.DefaultUpdatedAt holds the default value on creation for the updatedAt field.
+	bookingseries.DefaultUpdatedAt = bookingseriesDescUpdatedAt.Default.(func() time.Time)
+	// bookingseries.UpdateDefaultUpdatedAt holds the default value on update for the updatedAt field.
+	bookingseries.UpdateDefaultUpdatedAt = bookingseriesDescUpdatedAt.UpdateDefault.(func() time.Time)
+	customerMixin := schema.Customer{}.Mixin()
+	customer.Policy = privacy.NewPolicies(schema.Customer{})
+	customer.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := customer.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	customerMixinFields0 := customerMixin[0].Fields()
+	_ = customerMixinFields0
+	customerFields := schema.Customer{}.Fields()
+	_ = customerFields
+	// customerDescCreatedAt is the schema descriptor for createdAt field.
+	customerDescCreatedAt := customerMixinFields0[0].Descriptor()
+	// customer.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	customer.DefaultCreatedAt = customerDescCreatedAt.Default.(func() time.Time)
+	// customerDescUpdatedAt is the schema descriptor for updatedAt field.
+	customerDescUpdatedAt := customerMixinFields0[1].Descriptor()
+	// customer.DefaultUpdatedAt holds the default value on creation for the updatedAt field.
+	customer.DefaultUpdatedAt = customerDescUpdatedAt.Default.(func() time.Time)
+	// customer.UpdateDefaultUpdatedAt holds the default value on update for the updatedAt field.
+	customer.UpdateDefaultUpdatedAt = customerDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// customerDescName is the schema descriptor for name field.
+	customerDescName := customerFields[0].Descriptor()
+	// customer.DefaultName holds the default value on creation for the name field.
+	customer.DefaultName = customerDescName.Default.(string)
+	// customerDescPhone is the schema descriptor for phone field.
+	customerDescPhone := customerFields[2].Descriptor()
+	// customer.DefaultPhone holds the default value on creation for the phone field.
+	customer.DefaultPhone = customerDescPhone.Default.(string)
+	// customerDescNotes is the schema descriptor for notes field.
+	customerDescNotes := customerFields[3].Descriptor()
+	// customer.DefaultNotes holds the default value on creation for the notes field.
+	customer.DefaultNotes = customerDescNotes.Default.(string)
+	invoiceMixin := schema.Invoice{}.Mixin()
+	invoice.Policy = privacy.NewPolicies(schema.Invoice{})
+	invoice.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := invoice.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	invoiceMixinFields0 := invoiceMixin[0].Fields()
+	_ = invoiceMixinFields0
+	invoiceFields := schema.Invoice{}.Fields()
+	_ = invoiceFields
+	// invoiceDescCreatedAt is the schema descriptor for createdAt field.
+	invoiceDescCreatedAt := invoiceMixinFields0[0].Descriptor()
+	// invoice.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	invoice.DefaultCreatedAt = invoiceDescCreatedAt.Default.(func() time.Time)
+	// invoiceDescUpdatedAt is the schema descriptor for updatedAt field.
+	invoiceDescUpdatedAt := invoiceMixinFields0[1].Descriptor()
+	// invoice.DefaultUpdatedAt holds the default value on creation for the updatedAt field.
+	invoice.DefaultUpdatedAt = invoiceDescUpdatedAt.Default.(func() time.Time)
+	// invoice.UpdateDefaultUpdatedAt holds the default value on update for the updatedAt field.
+	invoice.UpdateDefaultUpdatedAt = invoiceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// invoiceDescTaxInclusive is the schema descriptor for taxInclusive field.
+	invoiceDescTaxInclusive := invoiceFields[8].Descriptor()
+	// invoice.DefaultTaxInclusive holds the default value on creation for the taxInclusive field.
+	invoice.DefaultTaxInclusive = invoiceDescTaxInclusive.Default.(bool)
 	organizationMixin := schema.Organization{}.Mixin()
 	organizationMixinFields0 := organizationMixin[0].Fields()
 	_ = organizationMixinFields0
@@ -88,6 +210,90 @@ func init() {
 	organization.DefaultUpdatedAt = organizationDescUpdatedAt.Default.(func() time.Time)
 	// organization.UpdateDefaultUpdatedAt holds the default value on update for the updatedAt field.
 	organization.UpdateDefaultUpdatedAt = organizationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// organizationDescCurrency is the schema descriptor for currency field.
+	organizationDescCurrency := organizationFields[3].Descriptor()
+	// organization.DefaultCurrency holds the default value on creation for the currency field.
+	organization.DefaultCurrency = organizationDescCurrency.Default.(string)
+	paymentMixin := schema.Payment{}.Mixin()
+	payment.Policy = privacy.NewPolicies(schema.Payment{})
+	payment.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := payment.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	paymentMixinFields0 := paymentMixin[0].Fields()
+	_ = paymentMixinFields0
+	paymentFields := schema.Payment{}.Fields()
+	_ = paymentFields
+	// paymentDescCreatedAt is the schema descriptor for createdAt field.
+	paymentDescCreatedAt := paymentMixinFields0[0].Descriptor()
+	// payment.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	payment.DefaultCreatedAt = paymentDescCreatedAt.Default.(func() time.Time)
+	// paymentDescUpdatedAt is the schema descriptor for updatedAt field.
+	paymentDescUpdatedAt := paymentMixinFields0[1].Descriptor()
+	// payment.DefaultUpdatedAt holds the default value on creation for the updatedAt field.
+	payment.DefaultUpdatedAt = paymentDescUpdatedAt.Default.(func() time.Time)
+	// payment.UpdateDefaultUpdatedAt holds the default value on update for the updatedAt field.
+	payment.UpdateDefaultUpdatedAt = paymentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// paymentDescAmountRefunded is the schema descriptor for amountRefunded field.
+	paymentDescAmountRefunded := paymentFields[4].Descriptor()
+	// payment.DefaultAmountRefunded holds the default value on creation for the amountRefunded field.
+	payment.DefaultAmountRefunded = paymentDescAmountRefunded.Default.(int)
+	promocodeMixin := schema.PromoCode{}.Mixin()
+	promocode.Policy = privacy.NewPolicies(schema.PromoCode{})
+	promocode.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := promocode.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	promocodeMixinFields0 := promocodeMixin[0].Fields()
+	_ = promocodeMixinFields0
+	promocodeFields := schema.PromoCode{}.Fields()
+	_ = promocodeFields
+	// promocodeDescCreatedAt is the schema descriptor for createdAt field.
+	promocodeDescCreatedAt := promocodeMixinFields0[0].Descriptor()
+	// promocode.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	promocode.DefaultCreatedAt = promocodeDescCreatedAt.Default.(func() time.Time)
+	// promocodeDescUpdatedAt is the schema descriptor for updatedAt field.
+	promocodeDescUpdatedAt := promocodeMixinFields0[1].Descriptor()
+	// promocode.DefaultUpdatedAt holds the default value on creation for the updatedAt field.
+	promocode.DefaultUpdatedAt = promocodeDescUpdatedAt.Default.(func() time.Time)
+	// promocode.UpdateDefaultUpdatedAt holds the default value on update for the updatedAt field.
+	promocode.UpdateDefaultUpdatedAt = promocodeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	promoredemptionMixin := schema.PromoRedemption{}.Mixin()
+	promoredemption.Policy = privacy.NewPolicies(schema.PromoRedemption{})
+	promoredemption.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := promoredemption.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	promoredemptionMixinFields0 := promoredemptionMixin[0].Fields()
+	_ = promoredemptionMixinFields0
+	promoredemptionFields := schema.PromoRedemption{}.Fields()
+	_ = promoredemptionFields
+	// promoredemptionDescCreatedAt is the schema descriptor for createdAt field.
+	promoredemptionDescCreatedAt := promoredemptionMixinFields0[0].Descriptor()
+	// promoredemption.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	promoredemption.DefaultCreatedAt = promoredemptionDescCreatedAt.Default.(func() time.Time)
+	// promoredemptionDescUpdatedAt is the schema descriptor for updatedAt field.
+	promoredemptionDescUpdatedAt := promoredemptionMixinFields0[1].Descriptor()
+	// promoredemption.DefaultUpdatedAt holds the default value on creation for the updatedAt field.
+	promoredemption.DefaultUpdatedAt = promoredemptionDescUpdatedAt.Default.(func() time.Time)
+	// promoredemption.UpdateDefaultUpdatedAt holds the default value on update for the updatedAt field.
+	promoredemption.UpdateDefaultUpdatedAt = promoredemptionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// promoredemptionDescCurrency is the schema descriptor for currency field.
+	promoredemptionDescCurrency := promoredemptionFields[2].Descriptor()
+	// promoredemption.DefaultCurrency holds the default value on creation for the currency field.
+	promoredemption.DefaultCurrency = promoredemptionDescCurrency.Default.(string)
 	resourceMixin := schema.Resource{}.Mixin()
 	resource.Policy = privacy.NewPolicies(schema.Resource{})
 	resource.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -112,6 +318,14 @@ func init() {
 	resource.DefaultUpdatedAt = resourceDescUpdatedAt.Default.(func() time.Time)
 	// resource.UpdateDefaultUpdatedAt holds the default value on update for the updatedAt field.
 	resource.UpdateDefaultUpdatedAt = resourceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// resourceDescPasswordHash is the schema descriptor for passwordHash field.
+	resourceDescPasswordHash := resourceFields[3].Descriptor()
+	// resource.DefaultPasswordHash holds the default value on creation for the passwordHash field.
+	resource.DefaultPasswordHash = resourceDescPasswordHash.Default.(string)
+	// resourceDescCurrency is the schema descriptor for currency field.
+	resourceDescCurrency := resourceFields[6].Descriptor()
+	// resource.DefaultCurrency holds the default value on creation for the currency field.
+	resource.DefaultCurrency = resourceDescCurrency.Default.(string)
 	slot.Policy = privacy.NewPolicies(schema.Slot{})
 	slot.Hooks[0] = func(next ent.Mutator) ent.Mutator {
 		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
@@ -121,6 +335,38 @@ func init() {
 			return next.Mutate(ctx, m)
 		})
 	}
+	taxrateMixin := schema.TaxRate{}.Mixin()
+	taxrate.Policy = privacy.NewPolicies(schema.TaxRate{})
+	taxrate.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := taxrate.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	taxrateMixinFields0 := taxrateMixin[0].Fields()
+	_ = taxrateMixinFields0
+	taxrateFields := schema.TaxRate{}.Fields()
+	_ = taxrateFields
+	// taxrateDescCreatedAt is the schema descriptor for createdAt field.
+	taxrateDescCreatedAt := taxrateMixinFields0[0].Descriptor()
+	// taxrate.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	taxrate.DefaultCreatedAt = taxrateDescCreatedAt.Default.(func() time.Time)
+	// taxrateDescUpdatedAt is the schema descriptor for updatedAt field.
+	taxrateDescUpdatedAt := taxrateMixinFields0[1].Descriptor()
+	// taxrate.DefaultUpdatedAt holds the default value on creation for the updatedAt field.
+	taxrate.DefaultUpdatedAt = taxrateDescUpdatedAt.Default.(func() time.Time)
+	// taxrate.UpdateDefaultUpdatedAt holds the default value on update for the updatedAt field.
+	taxrate.UpdateDefaultUpdatedAt = taxrateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// taxrateDescInclusive is the schema descriptor for inclusive field.
+	taxrateDescInclusive := taxrateFields[2].Descriptor()
+	// taxrate.DefaultInclusive holds the default value on creation for the inclusive field.
+	taxrate.DefaultInclusive = taxrateDescInclusive.Default.(bool)
+	// taxrateDescIsDefault is the schema descriptor for isDefault field.
+	taxrateDescIsDefault := taxrateFields[3].Descriptor()
+	// taxrate.DefaultIsDefault holds the default value on creation for the isDefault field.
+	taxrate.DefaultIsDefault = taxrateDescIsDefault.Default.(bool)
 	tokenMixin := schema.Token{}.Mixin()
 	token.Policy = privacy.NewPolicies(schema.Token{})
 	token.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -148,7 +394,7 @@ func init() {
 	// tokenDescID is the schema descriptor for id field.
 	tokenDescID := tokenFields[0].Descriptor()
 	// token.DefaultID holds the default value on creation for the id field.
-	token.DefaultID = tokenDescID.Default.(string)
+	token.DefaultID = tokenDescID.Default.(func() string)
 	unavailabilityMixin := schema.Unavailability{}.Mixin()
 	unavailability.Policy = privacy.NewPolicies(schema.Unavailability{})
 	unavailability.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -188,6 +434,90 @@ func init() {
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updatedAt field.
 	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	waitlistentryMixin := schema.WaitlistEntry{}.Mixin()
+	waitlistentry.Policy = privacy.NewPolicies(schema.WaitlistEntry{})
+	waitlistentry.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := waitlistentry.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	waitlistentryMixinFields0 := waitlistentryMixin[0].Fields()
+	_ = waitlistentryMixinFields0
+	waitlistentryFields := schema.WaitlistEntry{}.Fields()
+	_ = waitlistentryFields
+	// waitlistentryDescCreatedAt is the schema descriptor for createdAt field.
+	waitlistentryDescCreatedAt := waitlistentryMixinFields0[0].Descriptor()
+	// waitlistentry.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	waitlistentry.DefaultCreatedAt = waitlistentryDescCreatedAt.Default.(func() time.Time)
+	// waitlistentryDescUpdatedAt is the schema descriptor for updatedAt field.
+	waitlistentryDescUpdatedAt := waitlistentryMixinFields0[1].Descriptor()
+	// waitlistentry.DefaultUpdatedAt holds the default value on creation for the updatedAt field.
+	waitlistentry.DefaultUpdatedAt = waitlistentryDescUpdatedAt.Default.(func() time.Time)
+	// waitlistentry.UpdateDefaultUpdatedAt holds the default value on update for the updatedAt field.
+	waitlistentry.UpdateDefaultUpdatedAt = waitlistentryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// waitlistentryDescPriority is the schema descriptor for priority field.
+	waitlistentryDescPriority := waitlistentryFields[3].Descriptor()
+	// waitlistentry.DefaultPriority holds the default value on creation for the priority field.
+	waitlistentry.DefaultPriority = waitlistentryDescPriority.Default.(int)
+	webhookMixin := schema.Webhook{}.Mixin()
+	webhook.Policy = privacy.NewPolicies(schema.Webhook{})
+	webhook.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := webhook.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	webhookMixinFields0 := webhookMixin[0].Fields()
+	_ = webhookMixinFields0
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescCreatedAt is the schema descriptor for createdAt field.
+	webhookDescCreatedAt := webhookMixinFields0[0].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	// webhookDescUpdatedAt is the schema descriptor for updatedAt field.
+	webhookDescUpdatedAt := webhookMixinFields0[1].Descriptor()
+	// webhook.DefaultUpdatedAt holds the default value on creation for the updatedAt field.
+	webhook.DefaultUpdatedAt = webhookDescUpdatedAt.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdatedAt holds the default value on update for the updatedAt field.
+	webhook.UpdateDefaultUpdatedAt = webhookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookDescEnabled is the schema descriptor for enabled field.
+	webhookDescEnabled := webhookFields[4].Descriptor()
+	// webhook.DefaultEnabled holds the default value on creation for the enabled field.
+	webhook.DefaultEnabled = webhookDescEnabled.Default.(bool)
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdelivery.Policy = privacy.NewPolicies(schema.WebhookDelivery{})
+	webhookdelivery.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := webhookdelivery.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	webhookdeliveryMixinFields0 := webhookdeliveryMixin[0].Fields()
+	_ = webhookdeliveryMixinFields0
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescCreatedAt is the schema descriptor for createdAt field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryMixinFields0[0].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescUpdatedAt is the schema descriptor for updatedAt field.
+	webhookdeliveryDescUpdatedAt := webhookdeliveryMixinFields0[1].Descriptor()
+	// webhookdelivery.DefaultUpdatedAt holds the default value on creation for the updatedAt field.
+	webhookdelivery.DefaultUpdatedAt = webhookdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updatedAt field.
+	webhookdelivery.UpdateDefaultUpdatedAt = webhookdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[3].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
 }
 
 const (
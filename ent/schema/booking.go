@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/openmesh/booking/ent/privacy"
 	"github.com/openmesh/booking/ent/rule"
 )
@@ -20,6 +21,45 @@ func (Booking) Fields() []ent.Field {
 		field.Time("startTime"),
 		field.Time("endTime"),
 		field.Int("resourceId"),
+		field.Int("userId").
+			Optional().
+			Nillable(),
+		field.Int("seriesId").
+			Optional().
+			Nillable(),
+		field.Int("customerId").
+			Optional().
+			Nillable(),
+		field.Time("expiresAt").
+			Optional().
+			Nillable(),
+		// Nil for bookings made before prices were stored, until they are
+		// migrated with the price of their resource.
+		field.Int("price").
+			Optional().
+			Nillable(),
+		// ISO 4217 code of the currency of the price. Every price was in US
+		// dollars before currencies were supported.
+		field.String("currency").
+			Default("USD"),
+		field.Time("cancelledAt").
+			Optional().
+			Nillable(),
+		field.Int("refundAmount").
+			Default(0),
+		field.Int("feeAmount").
+			Default(0),
+		// The tax rate that applied when the booking was made and the tax
+		// included in its price, which is the gross amount. Bookings made
+		// before taxes were supported have no tax.
+		field.String("taxName").
+			Optional(),
+		field.Int("taxRate").
+			Default(0),
+		field.Bool("taxInclusive").
+			Default(false),
+		field.Int("taxAmount").
+			Default(0),
 	}
 }
 
@@ -27,11 +67,34 @@ func (Booking) Fields() []ent.Field {
 func (Booking) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("metadata", BookingMetadatum.Type),
+		edge.To("waitlistEntries", WaitlistEntry.Type),
+		edge.To("payments", Payment.Type),
+		edge.To("promoRedemptions", PromoRedemption.Type),
+		edge.To("invoices", Invoice.Type),
 		edge.From("resource", Resource.Type).
 			Ref("bookings").
 			Field("resourceId").
 			Unique().
 			Required(),
+		edge.From("user", User.Type).
+			Ref("bookings").
+			Field("userId").
+			Unique(),
+		edge.From("series", BookingSeries.Type).
+			Ref("bookings").
+			Field("seriesId").
+			Unique(),
+		edge.From("customer", Customer.Type).
+			Ref("bookings").
+			Field("customerId").
+			Unique(),
+	}
+}
+
+// Indexes of the Booking.
+func (Booking) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "expiresAt"),
 	}
 }
 
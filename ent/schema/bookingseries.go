@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/openmesh/booking/ent/privacy"
+	"github.com/openmesh/booking/ent/rule"
+)
+
+// BookingSeries holds the schema definition for the BookingSeries entity.
+type BookingSeries struct {
+	ent.Schema
+}
+
+// Fields of the BookingSeries.
+func (BookingSeries) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("rrule"),
+		field.JSON("exdates", []time.Time{}).
+			Optional(),
+		// The times of the first occurrence of the series.
+		field.Time("startTime"),
+		field.Time("endTime"),
+		field.Int("resourceId"),
+	}
+}
+
+// Edges of the BookingSeries.
+func (BookingSeries) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("bookings", Booking.Type),
+		edge.From("resource", Resource.Type).
+			Ref("bookingSeries").
+			Field("resourceId").
+			Unique().
+			Required(),
+	}
+}
+
+// Mixins of the BookingSeries.
+func (BookingSeries) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Timestamp{},
+	}
+}
+
+func (BookingSeries) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.FilterBookingSeriesOrganizationQueryRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.FilterBookingSeriesOrganizationMutationRule(),
+		},
+	}
+}
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/openmesh/booking/ent/privacy"
+	"github.com/openmesh/booking/ent/rule"
+)
+
+// Customer holds the schema definition for the Customer entity.
+type Customer struct {
+	ent.Schema
+}
+
+// Fields of the Customer.
+func (Customer) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Default(""),
+		// Stored in lower case. Nil for customers without an email so that
+		// they don't conflict with each other.
+		field.String("email").
+			Optional().
+			Nillable(),
+		field.String("phone").
+			Default(""),
+		field.Text("notes").
+			Default(""),
+		field.Strings("tags").
+			Optional(),
+		field.Int("organizationId"),
+	}
+}
+
+// Edges of the Customer.
+func (Customer) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("customers").
+			Field("organizationId").
+			Unique().
+			Required(),
+		edge.To("bookings", Booking.Type),
+	}
+}
+
+// Indexes of the Customer.
+func (Customer) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organizationId", "email").
+			Unique(),
+	}
+}
+
+// Mixins of the Customer.
+func (Customer) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Timestamp{},
+	}
+}
+
+func (Customer) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.FilterCustomerOrganizationQueryRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.FilterCustomerOrganizationMutationRule(),
+		},
+	}
+}
@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/openmesh/booking/ent/privacy"
+	"github.com/openmesh/booking/ent/rule"
+)
+
+// Invoice holds the schema definition for the Invoice entity.
+type Invoice struct {
+	ent.Schema
+}
+
+// Fields of the Invoice.
+func (Invoice) Fields() []ent.Field {
+	return []ent.Field{
+		// Invoices and credit notes are numbered in separate sequences within
+		// each organization.
+		field.String("kind"),
+		field.Int("number"),
+		// The name of the organization when the invoice was issued so that
+		// invoices don't change if it is renamed.
+		field.String("issuerName"),
+		field.String("currency"),
+		// JSON encoded booking.InvoiceLine and booking.InvoiceTaxLine lists.
+		field.Text("lines"),
+		field.Text("taxLines"),
+		field.Int("subtotal"),
+		field.Int("tax"),
+		// Whether the subtotal already includes the tax.
+		field.Bool("taxInclusive").
+			Default(false),
+		field.Int("total"),
+		field.Int("amountPaid"),
+		field.Int("bookingId"),
+		// The invoice that a credit note credits.
+		field.Int("creditedInvoiceId").
+			Optional().
+			Nillable(),
+		field.Int("organizationId"),
+	}
+}
+
+// Edges of the Invoice.
+func (Invoice) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("invoices").
+			Field("organizationId").
+			Unique().
+			Required(),
+		edge.From("booking", Booking.Type).
+			Ref("invoices").
+			Field("bookingId").
+			Unique().
+			Required(),
+		edge.To("creditNotes", Invoice.Type).
+			From("creditedInvoice").
+			Field("creditedInvoiceId").
+			Unique(),
+	}
+}
+
+// Indexes of the Invoice.
+func (Invoice) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organizationId", "kind", "number").
+			Unique(),
+		index.Fields("bookingId", "kind"),
+	}
+}
+
+// Mixins of the Invoice.
+func (Invoice) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Timestamp{},
+	}
+}
+
+func (Invoice) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.FilterInvoiceOrganizationQueryRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.FilterInvoiceOrganizationMutationRule(),
+		},
+	}
+}
@@ -18,6 +18,10 @@ func (Organization) Fields() []ent.Field {
 		field.String("name"),
 		field.String("publicKey"),
 		field.String("privateKey"),
+		// ISO 4217 code of the currency that new resources are priced in
+		// unless another currency is given.
+		field.String("currency").
+			Default("USD"),
 	}
 }
 
@@ -27,6 +31,11 @@ func (Organization) Edges() []ent.Edge {
 		edge.To("users", User.Type),
 		edge.To("resources", Resource.Type),
 		edge.To("tokens", Token.Type),
+		edge.To("webhooks", Webhook.Type),
+		edge.To("promoCodes", PromoCode.Type),
+		edge.To("invoices", Invoice.Type),
+		edge.To("taxRates", TaxRate.Type),
+		edge.To("customers", Customer.Type),
 	}
 }
 
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/openmesh/booking/ent/privacy"
+	"github.com/openmesh/booking/ent/rule"
+)
+
+// Payment holds the schema definition for the Payment entity.
+type Payment struct {
+	ent.Schema
+}
+
+// Fields of the Payment.
+func (Payment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("provider"),
+		field.String("providerPaymentId"),
+		field.String("status"),
+		field.Int("amount"),
+		field.Int("amountRefunded").
+			Default(0),
+		field.String("currency"),
+		field.String("clientSecret").
+			Optional().
+			Sensitive(),
+		field.String("failureReason").
+			Optional(),
+		field.Int("bookingId"),
+	}
+}
+
+// Edges of the Payment.
+func (Payment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("booking", Booking.Type).
+			Ref("payments").
+			Field("bookingId").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Payment.
+func (Payment) Indexes() []ent.Index {
+	return []ent.Index{
+		// Notifications from providers are matched to payments by their ID
+		// within the provider.
+		index.Fields("provider", "providerPaymentId").
+			Unique(),
+	}
+}
+
+// Mixins of the Payment.
+func (Payment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Timestamp{},
+	}
+}
+
+func (Payment) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.FilterPaymentOrganizationQueryRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.FilterPaymentOrganizationMutationRule(),
+		},
+	}
+}
@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/openmesh/booking/ent/privacy"
+	"github.com/openmesh/booking/ent/rule"
+)
+
+// PromoCode holds the schema definition for the PromoCode entity.
+type PromoCode struct {
+	ent.Schema
+}
+
+// Fields of the PromoCode.
+func (PromoCode) Fields() []ent.Field {
+	return []ent.Field{
+		// Codes are stored in upper case so that they can be entered in any
+		// case.
+		field.String("code"),
+		field.String("description").
+			Optional(),
+		field.String("discountType"),
+		field.Int("discountValue"),
+		// ISO 4217 code of the currency of fixed discounts. Fixed discounts
+		// only apply to bookings in the same currency.
+		field.String("currency").
+			Optional(),
+		field.Time("validFrom").
+			Optional().
+			Nillable(),
+		field.Time("validUntil").
+			Optional().
+			Nillable(),
+		field.Int("maxRedemptions").
+			Optional().
+			Nillable(),
+		field.Int("maxRedemptionsPerCustomer").
+			Optional().
+			Nillable(),
+		// The resources that the code can be used for. The code can be used
+		// for every resource if empty.
+		field.Ints("resourceIds").
+			Optional(),
+		field.Int("organizationId"),
+	}
+}
+
+// Edges of the PromoCode.
+func (PromoCode) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("promoCodes").
+			Field("organizationId").
+			Unique().
+			Required(),
+		edge.To("redemptions", PromoRedemption.Type),
+	}
+}
+
+// Indexes of the PromoCode.
+func (PromoCode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organizationId", "code").
+			Unique(),
+	}
+}
+
+// Mixins of the PromoCode.
+func (PromoCode) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Timestamp{},
+	}
+}
+
+func (PromoCode) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.FilterPromoCodeOrganizationQueryRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.FilterPromoCodeOrganizationMutationRule(),
+		},
+	}
+}
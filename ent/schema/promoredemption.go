@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/openmesh/booking/ent/privacy"
+	"github.com/openmesh/booking/ent/rule"
+)
+
+// PromoRedemption holds the schema definition for the PromoRedemption entity.
+type PromoRedemption struct {
+	ent.Schema
+}
+
+// Fields of the PromoRedemption.
+func (PromoRedemption) Fields() []ent.Field {
+	return []ent.Field{
+		// Identifies the customer that redeemed the code so that redemptions
+		// can be limited per customer.
+		field.String("customer").
+			Optional(),
+		field.Int("discount"),
+		// ISO 4217 code of the currency of the discount, which is the
+		// currency of the booking.
+		field.String("currency").
+			Default("USD"),
+		field.Int("promoCodeId"),
+		field.Int("bookingId"),
+	}
+}
+
+// Edges of the PromoRedemption.
+func (PromoRedemption) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("promoCode", PromoCode.Type).
+			Ref("redemptions").
+			Field("promoCodeId").
+			Unique().
+			Required(),
+		edge.From("booking", Booking.Type).
+			Ref("promoRedemptions").
+			Field("bookingId").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the PromoRedemption.
+func (PromoRedemption) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("promoCodeId", "customer"),
+	}
+}
+
+// Mixins of the PromoRedemption.
+func (PromoRedemption) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Timestamp{},
+	}
+}
+
+func (PromoRedemption) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.FilterPromoRedemptionOrganizationQueryRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.FilterPromoRedemptionOrganizationMutationRule(),
+		},
+	}
+}
@@ -19,13 +19,43 @@ func (Resource) Fields() []ent.Field {
 		field.String("name"),
 		field.String("description"),
 		field.String("timezone"),
-		field.String("password"),
+		// bcrypt hash of the password that customers need to book the resource
+		// through the public API. Empty if the resource isn't protected.
+		field.String("passwordHash").
+			StorageKey("password").
+			Default("").
+			Sensitive(),
 		field.Int("price"),
 		field.Int("bookingPrice"),
+		// ISO 4217 code of the currency of price and bookingPrice.
+		field.String("currency").
+			Default("USD"),
 		field.Int("organizationId"),
 		field.Int("quantityAvailable").
 			Optional().
 			Nillable(),
+		// JSON encoded booking.CancellationPolicy. The policy is encoded by
+		// the resource service as generated code can't import the booking
+		// package alongside the booking entity.
+		field.Text("cancellationPolicy").
+			Optional(),
+		// JSON encoded booking.PricingRules, stored like the cancellation
+		// policy.
+		field.Text("pricing").
+			Optional(),
+		// JSON encoded booking.BookingFields, stored like the cancellation
+		// policy.
+		field.Text("bookingFields").
+			Optional(),
+		// SHA-256 hash of the secret token used to subscribe to the calendar
+		// feed of the resource.
+		field.String("feedTokenHash").
+			Optional().
+			Sensitive(),
+		// Overrides the default tax rate of the organization.
+		field.Int("taxRateId").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -34,12 +64,18 @@ func (Resource) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("slots", Slot.Type),
 		edge.To("bookings", Booking.Type),
+		edge.To("bookingSeries", BookingSeries.Type),
 		edge.To("unavailabilities", Unavailability.Type),
+		edge.To("waitlistEntries", WaitlistEntry.Type),
 		edge.From("organization", Organization.Type).
 			Ref("resources").
 			Field("organizationId").
 			Unique().
 			Required(),
+		edge.From("taxRate", TaxRate.Type).
+			Ref("resources").
+			Field("taxRateId").
+			Unique(),
 	}
 }
 
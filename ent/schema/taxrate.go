@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/openmesh/booking/ent/privacy"
+	"github.com/openmesh/booking/ent/rule"
+)
+
+// TaxRate holds the schema definition for the TaxRate entity.
+type TaxRate struct {
+	ent.Schema
+}
+
+// Fields of the TaxRate.
+func (TaxRate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		// Hundredths of a percent, e.g. 2000 for 20%.
+		field.Int("rate"),
+		// Whether prices already include the tax.
+		field.Bool("inclusive").
+			Default(false),
+		// The rate that applies to resources without a rate of their own.
+		// Each organization has at most one default rate.
+		field.Bool("isDefault").
+			Default(false),
+		field.Int("organizationId"),
+	}
+}
+
+// Edges of the TaxRate.
+func (TaxRate) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("taxRates").
+			Field("organizationId").
+			Unique().
+			Required(),
+		edge.To("resources", Resource.Type),
+	}
+}
+
+// Indexes of the TaxRate.
+func (TaxRate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organizationId", "name").
+			Unique(),
+	}
+}
+
+// Mixins of the TaxRate.
+func (TaxRate) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Timestamp{},
+	}
+}
+
+func (TaxRate) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.FilterTaxRateOrganizationQueryRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.FilterTaxRateOrganizationMutationRule(),
+		},
+	}
+}
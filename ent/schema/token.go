@@ -18,14 +18,32 @@ type Token struct {
 func (Token) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("id").
-			Default(fmt.Sprintf("omb_%s", rand.Key())).
+			DefaultFunc(func() string {
+				return fmt.Sprintf("tok_%s", rand.Key()[:16])
+			}).
 			Immutable(),
 		field.String("name").
 			Immutable(),
+		// Only a hash of the secret is stored. The secret itself is returned
+		// once when the token is created.
+		field.String("secretHash").
+			Unique().
+			Sensitive().
+			Immutable(),
+		field.String("secretPrefix").
+			Immutable(),
+		field.Strings("scopes").
+			Immutable(),
 		field.Time("expiry").
 			Immutable().
 			Nillable().
 			Optional(),
+		field.Time("lastUsedAt").
+			Nillable().
+			Optional(),
+		field.Time("revokedAt").
+			Nillable().
+			Optional(),
 		field.Int("userId"),
 		field.Int("organizationId"),
 	}
@@ -54,6 +72,10 @@ func (Token) Mixin() []ent.Mixin {
 
 func (Token) Policy() ent.Policy {
 	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.FilterTokenUserQueryRule(),
+			rule.FilterTokenOrganizationQueryRule(),
+		},
 		Mutation: privacy.MutationPolicy{
 			rule.FilterTokenUserMutationRule(),
 			rule.FilterTokenOrganizationMutationRule(),
@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/openmesh/booking/ent/privacy"
 	"github.com/openmesh/booking/ent/rule"
 )
@@ -19,6 +20,10 @@ func (Unavailability) Fields() []ent.Field {
 		field.Time("startTime"),
 		field.Time("endTime"),
 		field.Int("resourceId"),
+		// Identifies the calendar event that the unavailability was imported
+		// from so that importing the same calendar again updates it.
+		field.String("externalId").
+			Optional(),
 	}
 }
 
@@ -33,6 +38,12 @@ func (Unavailability) Edges() []ent.Edge {
 	}
 }
 
+func (Unavailability) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resourceId", "externalId"),
+	}
+}
+
 // Mixins of the Unavailability.
 func (Unavailability) Mixin() []ent.Mixin {
 	return []ent.Mixin{
@@ -27,6 +27,7 @@ func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("auths", Auth.Type),
 		edge.To("tokens", Token.Type),
+		edge.To("bookings", Booking.Type),
 		edge.From("organization", Organization.Type).
 			Ref("users").
 			Field("organizationId").
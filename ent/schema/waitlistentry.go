@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/openmesh/booking/ent/privacy"
+	"github.com/openmesh/booking/ent/rule"
+)
+
+// WaitlistEntry holds the schema definition for the WaitlistEntry entity.
+type WaitlistEntry struct {
+	ent.Schema
+}
+
+// Fields of the WaitlistEntry.
+func (WaitlistEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("status"),
+		field.Time("startTime"),
+		field.Time("endTime"),
+		field.Int("priority").
+			Default(0),
+		field.JSON("metadata", map[string]string{}).
+			Optional(),
+		field.Int("resourceId"),
+		// The held booking that the entry was offered.
+		field.Int("bookingId").
+			Optional().
+			Nillable(),
+		field.Time("offeredAt").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the WaitlistEntry.
+func (WaitlistEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("resource", Resource.Type).
+			Ref("waitlistEntries").
+			Field("resourceId").
+			Unique().
+			Required(),
+		edge.From("booking", Booking.Type).
+			Ref("waitlistEntries").
+			Field("bookingId").
+			Unique(),
+	}
+}
+
+// Indexes of the WaitlistEntry.
+func (WaitlistEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resourceId", "status", "startTime"),
+	}
+}
+
+// Mixins of the WaitlistEntry.
+func (WaitlistEntry) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Timestamp{},
+	}
+}
+
+func (WaitlistEntry) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.FilterWaitlistEntryOrganizationQueryRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.FilterWaitlistEntryOrganizationMutationRule(),
+		},
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/openmesh/booking/ent/privacy"
+	"github.com/openmesh/booking/ent/rule"
+)
+
+// Webhook holds the schema definition for the Webhook entity.
+type Webhook struct {
+	ent.Schema
+}
+
+// Fields of the Webhook.
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("url"),
+		field.String("description").
+			Optional(),
+		// The event types that are delivered to the webhook. All event types
+		// are delivered if empty.
+		field.Strings("eventTypes").
+			Optional(),
+		// The secret is needed to sign payloads so unlike token secrets it has
+		// to be stored as is.
+		field.String("secret").
+			Sensitive(),
+		field.Bool("enabled").
+			Default(true),
+		field.Int("organizationId"),
+	}
+}
+
+// Edges of the Webhook.
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("webhooks").
+			Field("organizationId").
+			Unique().
+			Required(),
+		edge.To("deliveries", WebhookDelivery.Type),
+	}
+}
+
+// Mixin of the Webhook.
+func (Webhook) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Timestamp{},
+	}
+}
+
+func (Webhook) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.FilterWebhookOrganizationQueryRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.FilterWebhookOrganizationMutationRule(),
+		},
+	}
+}
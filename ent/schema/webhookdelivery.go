@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/openmesh/booking/ent/privacy"
+	"github.com/openmesh/booking/ent/rule"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("eventType").
+			Immutable(),
+		// The exact body that is sent with every attempt so that retries can
+		// be verified with the same signature scheme.
+		field.Text("payload").
+			Immutable(),
+		field.String("status"),
+		field.Int("attempts").
+			Default(0),
+		field.Time("nextAttemptAt"),
+		field.Time("lastAttemptAt").
+			Nillable().
+			Optional(),
+		field.Int("responseStatus").
+			Nillable().
+			Optional(),
+		field.String("lastError").
+			Optional(),
+		field.Int("webhookId"),
+		field.Int("organizationId"),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).
+			Ref("deliveries").
+			Field("webhookId").
+			Unique().
+			Required(),
+	}
+}
+
+// Mixin of the WebhookDelivery.
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Timestamp{},
+	}
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "nextAttemptAt"),
+	}
+}
+
+func (WebhookDelivery) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.FilterWebhookDeliveryOrganizationQueryRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.FilterWebhookDeliveryOrganizationMutationRule(),
+		},
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/openmesh/booking/ent/resource"
@@ -18,6 +19,7 @@ type SlotCreate struct {
 	config
 	mutation *SlotMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetDay sets the "day" field.
@@ -181,6 +183,7 @@ func (sc *SlotCreate) createSpec() (*Slot, *sqlgraph.CreateSpec) {
 			},
 		}
 	)
+	_spec.OnConflict = sc.conflict
 	if value, ok := sc.mutation.Day(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -236,10 +239,276 @@ func (sc *SlotCreate) createSpec() (*Slot, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Slot.Create().
+//		SetDay(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SlotUpsert) {
+//			SetDay(v+v).
+//		}).
+//		Exec(ctx)
+func (sc *SlotCreate) OnConflict(opts ...sql.ConflictOption) *SlotUpsertOne {
+	sc.conflict = opts
+	return &SlotUpsertOne{
+		create: sc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Slot.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sc *SlotCreate) OnConflictColumns(columns ...string) *SlotUpsertOne {
+	sc.conflict = append(sc.conflict, sql.ConflictColumns(columns...))
+	return &SlotUpsertOne{
+		create: sc,
+	}
+}
+
+type (
+	// SlotUpsertOne is the builder for "upsert"-ing
+	//  one Slot node.
+	SlotUpsertOne struct {
+		create *SlotCreate
+	}
+
+	// SlotUpsert is the "OnConflict" setter.
+	SlotUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDay sets the "day" field.
+func (u *SlotUpsert) SetDay(v string) *SlotUpsert {
+	u.Set(slot.FieldDay, v)
+	return u
+}
+
+// UpdateDay sets the "day" field to the value that was provided on create.
+func (u *SlotUpsert) UpdateDay() *SlotUpsert {
+	u.SetExcluded(slot.FieldDay)
+	return u
+}
+
+// SetStartTime sets the "startTime" field.
+func (u *SlotUpsert) SetStartTime(v string) *SlotUpsert {
+	u.Set(slot.FieldStartTime, v)
+	return u
+}
+
+// UpdateStartTime sets the "startTime" field to the value that was provided on create.
+func (u *SlotUpsert) UpdateStartTime() *SlotUpsert {
+	u.SetExcluded(slot.FieldStartTime)
+	return u
+}
+
+// SetEndTime sets the "endTime" field.
+func (u *SlotUpsert) SetEndTime(v string) *SlotUpsert {
+	u.Set(slot.FieldEndTime, v)
+	return u
+}
+
+// UpdateEndTime sets the "endTime" field to the value that was provided on create.
+func (u *SlotUpsert) UpdateEndTime() *SlotUpsert {
+	u.SetExcluded(slot.FieldEndTime)
+	return u
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *SlotUpsert) SetQuantity(v int) *SlotUpsert {
+	u.Set(slot.FieldQuantity, v)
+	return u
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *SlotUpsert) UpdateQuantity() *SlotUpsert {
+	u.SetExcluded(slot.FieldQuantity)
+	return u
+}
+
+// ClearQuantity clears the value of the "quantity" field.
+func (u *SlotUpsert) ClearQuantity() *SlotUpsert {
+	u.SetNull(slot.FieldQuantity)
+	return u
+}
+
+// SetResourceId sets the "resourceId" field.
+func (u *SlotUpsert) SetResourceId(v int) *SlotUpsert {
+	u.Set(slot.FieldResourceId, v)
+	return u
+}
+
+// UpdateResourceId sets the "resourceId" field to the value that was provided on create.
+func (u *SlotUpsert) UpdateResourceId() *SlotUpsert {
+	u.SetExcluded(slot.FieldResourceId)
+	return u
+}
+
+// UpdateNewValues updates the fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Slot.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SlotUpsertOne) UpdateNewValues() *SlotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Slot.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SlotUpsertOne) Ignore() *SlotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SlotUpsertOne) DoNothing() *SlotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SlotCreate.OnConflict
+// documentation for more info.
+func (u *SlotUpsertOne) Update(set func(*SlotUpsert)) *SlotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SlotUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDay sets the "day" field.
+func (u *SlotUpsertOne) SetDay(v string) *SlotUpsertOne {
+	return u.Update(func(s *SlotUpsert) {
+		s.SetDay(v)
+	})
+}
+
+// UpdateDay sets the "day" field to the value that was provided on create.
+func (u *SlotUpsertOne) UpdateDay() *SlotUpsertOne {
+	return u.Update(func(s *SlotUpsert) {
+		s.UpdateDay()
+	})
+}
+
+// SetStartTime sets the "startTime" field.
+func (u *SlotUpsertOne) SetStartTime(v string) *SlotUpsertOne {
+	return u.Update(func(s *SlotUpsert) {
+		s.SetStartTime(v)
+	})
+}
+
+// UpdateStartTime sets the "startTime" field to the value that was provided on create.
+func (u *SlotUpsertOne) UpdateStartTime() *SlotUpsertOne {
+	return u.Update(func(s *SlotUpsert) {
+		s.UpdateStartTime()
+	})
+}
+
+// SetEndTime sets the "endTime" field.
+func (u *SlotUpsertOne) SetEndTime(v string) *SlotUpsertOne {
+	return u.Update(func(s *SlotUpsert) {
+		s.SetEndTime(v)
+	})
+}
+
+// UpdateEndTime sets the "endTime" field to the value that was provided on create.
+func (u *SlotUpsertOne) UpdateEndTime() *SlotUpsertOne {
+	return u.Update(func(s *SlotUpsert) {
+		s.UpdateEndTime()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *SlotUpsertOne) SetQuantity(v int) *SlotUpsertOne {
+	return u.Update(func(s *SlotUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *SlotUpsertOne) UpdateQuantity() *SlotUpsertOne {
+	return u.Update(func(s *SlotUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// ClearQuantity clears the value of the "quantity" field.
+func (u *SlotUpsertOne) ClearQuantity() *SlotUpsertOne {
+	return u.Update(func(s *SlotUpsert) {
+		s.ClearQuantity()
+	})
+}
+
+// SetResourceId sets the "resourceId" field.
+func (u *SlotUpsertOne) SetResourceId(v int) *SlotUpsertOne {
+	return u.Update(func(s *SlotUpsert) {
+		s.SetResourceId(v)
+	})
+}
+
+// UpdateResourceId sets the "resourceId" field to the value that was provided on create.
+func (u *SlotUpsertOne) UpdateResourceId() *SlotUpsertOne {
+	return u.Update(func(s *SlotUpsert) {
+		s.UpdateResourceId()
+	})
+}
+
+// Exec executes the query.
+func (u *SlotUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SlotCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SlotUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SlotUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SlotUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // SlotCreateBulk is the builder for creating many Slot entities in bulk.
 type SlotCreateBulk struct {
 	config
 	builders []*SlotCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Slot entities in the database.
@@ -265,6 +534,7 @@ func (scb *SlotCreateBulk) Save(ctx context.Context) ([]*Slot, error) {
 					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = scb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -318,3 +588,181 @@ func (scb *SlotCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Slot.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SlotUpsert) {
+//			SetDay(v+v).
+//		}).
+//		Exec(ctx)
+func (scb *SlotCreateBulk) OnConflict(opts ...sql.ConflictOption) *SlotUpsertBulk {
+	scb.conflict = opts
+	return &SlotUpsertBulk{
+		create: scb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Slot.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (scb *SlotCreateBulk) OnConflictColumns(columns ...string) *SlotUpsertBulk {
+	scb.conflict = append(scb.conflict, sql.ConflictColumns(columns...))
+	return &SlotUpsertBulk{
+		create: scb,
+	}
+}
+
+// SlotUpsertBulk is the builder for "upsert"-ing
+// a bulk of Slot nodes.
+type SlotUpsertBulk struct {
+	create *SlotCreateBulk
+}
+
+// UpdateNewValues updates the fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Slot.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SlotUpsertBulk) UpdateNewValues() *SlotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Slot.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SlotUpsertBulk) Ignore() *SlotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SlotUpsertBulk) DoNothing() *SlotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SlotCreateBulk.OnConflict
+// documentation for more info.
+func (u *SlotUpsertBulk) Update(set func(*SlotUpsert)) *SlotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SlotUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDay sets the "day" field.
+func (u *SlotUpsertBulk) SetDay(v string) *SlotUpsertBulk {
+	return u.Update(func(s *SlotUpsert) {
+		s.SetDay(v)
+	})
+}
+
+// UpdateDay sets the "day" field to the value that was provided on create.
+func (u *SlotUpsertBulk) UpdateDay() *SlotUpsertBulk {
+	return u.Update(func(s *SlotUpsert) {
+		s.UpdateDay()
+	})
+}
+
+// SetStartTime sets the "startTime" field.
+func (u *SlotUpsertBulk) SetStartTime(v string) *SlotUpsertBulk {
+	return u.Update(func(s *SlotUpsert) {
+		s.SetStartTime(v)
+	})
+}
+
+// UpdateStartTime sets the "startTime" field to the value that was provided on create.
+func (u *SlotUpsertBulk) UpdateStartTime() *SlotUpsertBulk {
+	return u.Update(func(s *SlotUpsert) {
+		s.UpdateStartTime()
+	})
+}
+
+// SetEndTime sets the "endTime" field.
+func (u *SlotUpsertBulk) SetEndTime(v string) *SlotUpsertBulk {
+	return u.Update(func(s *SlotUpsert) {
+		s.SetEndTime(v)
+	})
+}
+
+// UpdateEndTime sets the "endTime" field to the value that was provided on create.
+func (u *SlotUpsertBulk) UpdateEndTime() *SlotUpsertBulk {
+	return u.Update(func(s *SlotUpsert) {
+		s.UpdateEndTime()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *SlotUpsertBulk) SetQuantity(v int) *SlotUpsertBulk {
+	return u.Update(func(s *SlotUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *SlotUpsertBulk) UpdateQuantity() *SlotUpsertBulk {
+	return u.Update(func(s *SlotUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// ClearQuantity clears the value of the "quantity" field.
+func (u *SlotUpsertBulk) ClearQuantity() *SlotUpsertBulk {
+	return u.Update(func(s *SlotUpsert) {
+		s.ClearQuantity()
+	})
+}
+
+// SetResourceId sets the "resourceId" field.
+func (u *SlotUpsertBulk) SetResourceId(v int) *SlotUpsertBulk {
+	return u.Update(func(s *SlotUpsert) {
+		s.SetResourceId(v)
+	})
+}
+
+// UpdateResourceId sets the "resourceId" field to the value that was provided on create.
+func (u *SlotUpsertBulk) UpdateResourceId() *SlotUpsertBulk {
+	return u.Update(func(s *SlotUpsert) {
+		s.UpdateResourceId()
+	})
+}
+
+// Exec executes the query.
+func (u *SlotUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SlotCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SlotCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SlotUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
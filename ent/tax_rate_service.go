@@ -0,0 +1,309 @@
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/openmesh/booking"
+	"github.com/openmesh/booking/ent/resource"
+	"github.com/openmesh/booking/ent/taxrate"
+)
+
+type taxRateService struct {
+	client *Client
+}
+
+// NewTaxRateService constructs a new instance of a booking.TaxRateService using
+// ent as its persistence layer.
+func NewTaxRateService(client *Client) *taxRateService {
+	return &taxRateService{client}
+}
+
+// FindTaxRateByID retrieves a single tax rate by ID. Returns ETAXRATENOTFOUND
+// if the tax rate does not exist or user does not have permission to view it.
+func (s *taxRateService) FindTaxRateByID(
+	ctx context.Context,
+	req booking.FindTaxRateByIDRequest,
+) booking.FindTaxRateByIDResponse {
+	t, err := findTaxRateByID(ctx, s.client, req.ID)
+	if err != nil {
+		return booking.FindTaxRateByIDResponse{Err: err}
+	}
+	return booking.FindTaxRateByIDResponse{TaxRate: t.toModel()}
+}
+
+// FindTaxRates retrieves every tax rate of the current organization ordered by
+// name.
+func (s *taxRateService) FindTaxRates(
+	ctx context.Context,
+	req booking.FindTaxRatesRequest,
+) booking.FindTaxRatesResponse {
+	t, err := s.client.TaxRate.
+		Query().
+		Order(Asc(taxrate.FieldName)).
+		All(ctx)
+	if err != nil {
+		return booking.FindTaxRatesResponse{
+			Err: fmt.Errorf("failed to query tax rates: %w", err),
+		}
+	}
+	return booking.FindTaxRatesResponse{TaxRates: TaxRates(t).toModels()}
+}
+
+// CreateTaxRate creates a new tax rate for the current organization. A new
+// default rate replaces the existing default.
+func (s *taxRateService) CreateTaxRate(
+	ctx context.Context,
+	req booking.CreateTaxRateRequest,
+) booking.CreateTaxRateResponse {
+	orgID := booking.OrganizationIDFromContext(ctx)
+	if orgID == 0 {
+		return booking.CreateTaxRateResponse{
+			Err: booking.Errorf(booking.EUNAUTHORIZED, "You must be signed in to create a tax rate."),
+		}
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return booking.CreateTaxRateResponse{
+			Err: fmt.Errorf("failed to start transaction: %w", err),
+		}
+	}
+	defer tx.Rollback()
+
+	name := strings.TrimSpace(req.Name)
+	if err := checkForTaxRateConflict(ctx, tx, name, 0); err != nil {
+		return booking.CreateTaxRateResponse{Err: err}
+	}
+
+	t, err := tx.TaxRate.
+		Create().
+		SetName(name).
+		SetRate(req.Rate).
+		SetInclusive(req.Inclusive).
+		SetIsDefault(req.Default).
+		SetOrganizationID(orgID).
+		Save(ctx)
+	if err != nil {
+		return booking.CreateTaxRateResponse{
+			Err: fmt.Errorf("failed to create tax rate: %w", err),
+		}
+	}
+	if t.IsDefault {
+		if err := clearDefaultTaxRate(ctx, tx, t.ID); err != nil {
+			return booking.CreateTaxRateResponse{Err: err}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return booking.CreateTaxRateResponse{
+			Err: fmt.Errorf("failed to commit transaction: %w", err),
+		}
+	}
+	return booking.CreateTaxRateResponse{TaxRate: t.toModel()}
+}
+
+// UpdateTaxRate updates an existing tax rate by ID. Bookings that have already
+// been made keep the rate that they were made with.
+func (s *taxRateService) UpdateTaxRate(
+	ctx context.Context,
+	req booking.UpdateTaxRateRequest,
+) booking.UpdateTaxRateResponse {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return booking.UpdateTaxRateResponse{
+			Err: fmt.Errorf("failed to start transaction: %w", err),
+		}
+	}
+	defer tx.Rollback()
+
+	t, err := findTaxRateByID(ctx, tx.Client(), req.ID)
+	if err != nil {
+		return booking.UpdateTaxRateResponse{Err: err}
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if err := checkForTaxRateConflict(ctx, tx, name, t.ID); err != nil {
+		return booking.UpdateTaxRateResponse{Err: err}
+	}
+
+	t, err = t.Update().
+		SetName(name).
+		SetRate(req.Rate).
+		SetInclusive(req.Inclusive).
+		SetIsDefault(req.Default).
+		Save(ctx)
+	if err != nil {
+		return booking.UpdateTaxRateResponse{
+			Err: fmt.Errorf("failed to update tax rate: %w", err),
+		}
+	}
+	if t.IsDefault {
+		if err := clearDefaultTaxRate(ctx, tx, t.ID); err != nil {
+			return booking.UpdateTaxRateResponse{Err: err}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return booking.UpdateTaxRateResponse{
+			Err: fmt.Errorf("failed to commit transaction: %w", err),
+		}
+	}
+	return booking.UpdateTaxRateResponse{TaxRate: t.toModel()}
+}
+
+// DeleteTaxRate permanently removes a tax rate. Resources that used it fall
+// back to the default rate of the organization.
+func (s *taxRateService) DeleteTaxRate(
+	ctx context.Context,
+	req booking.DeleteTaxRateRequest,
+) booking.DeleteTaxRateResponse {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return booking.DeleteTaxRateResponse{
+			Err: fmt.Errorf("failed to start transaction: %w", err),
+		}
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Resource.
+		Update().
+		Where(resource.TaxRateId(req.ID)).
+		ClearTaxRateId().
+		Save(ctx); err != nil {
+		return booking.DeleteTaxRateResponse{
+			Err: fmt.Errorf("failed to clear tax rate of resources: %w", err),
+		}
+	}
+
+	a, err := tx.TaxRate.
+		Delete().
+		Where(taxrate.ID(req.ID)).
+		Exec(ctx)
+	if err != nil {
+		return booking.DeleteTaxRateResponse{
+			Err: fmt.Errorf("failed to delete tax rate: %w", err),
+		}
+	}
+	if a == 0 {
+		return booking.DeleteTaxRateResponse{
+			Err: booking.Errorf(booking.ETAXRATENOTFOUND, "Could not find tax rate with ID %d", req.ID),
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return booking.DeleteTaxRateResponse{
+			Err: fmt.Errorf("failed to commit transaction: %w", err),
+		}
+	}
+	return booking.DeleteTaxRateResponse{}
+}
+
+// findTaxRateByID retrieves a tax rate by ID. Returns ETAXRATENOTFOUND if the
+// tax rate does not exist.
+func findTaxRateByID(ctx context.Context, client *Client, id int) (*TaxRate, error) {
+	t, err := client.TaxRate.
+		Query().
+		Where(taxrate.ID(id)).
+		Only(ctx)
+	var nfe *NotFoundError
+	if errors.As(err, &nfe) {
+		return nil, booking.Errorf(booking.ETAXRATENOTFOUND, "Could not find tax rate with ID %d", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tax rate: %w", err)
+	}
+	return t, nil
+}
+
+// checkForTaxRateConflict returns ETAXRATECONFLICT if a tax rate other than
+// the one with id already uses name.
+func checkForTaxRateConflict(ctx context.Context, tx *Tx, name string, id int) error {
+	exists, err := tx.TaxRate.
+		Query().
+		Where(taxrate.Name(name), taxrate.IDNEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check for tax rate conflict: %w", err)
+	}
+	if exists {
+		return booking.Errorf(booking.ETAXRATECONFLICT, "A tax rate named '%s' already exists", name)
+	}
+	return nil
+}
+
+// clearDefaultTaxRate makes sure that the tax rate with id is the only default
+// rate of the organization.
+func clearDefaultTaxRate(ctx context.Context, tx *Tx, id int) error {
+	_, err := tx.TaxRate.
+		Update().
+		Where(taxrate.IsDefault(true), taxrate.IDNEQ(id)).
+		SetIsDefault(false).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to clear default tax rate: %w", err)
+	}
+	return nil
+}
+
+// checkResourceTaxRate returns a validation error for "taxRateId" if id is set
+// but the tax rate doesn't exist.
+func checkResourceTaxRate(ctx context.Context, client *Client, id *int) error {
+	if id == nil {
+		return nil
+	}
+	_, err := findTaxRateByID(ctx, client, *id)
+	if booking.ErrorCode(err) == booking.ETAXRATENOTFOUND {
+		return booking.ValidationErrorf("", booking.ValidationError{
+			Name:   "taxRateId",
+			Reason: "Tax rate does not exist",
+		})
+	}
+	return err
+}
+
+// resourceTaxRate returns the tax rate charged on bookings of resource r: the
+// rate of the resource, or the default rate of its organization if it doesn't
+// have one. Returns nil if neither is set.
+func resourceTaxRate(ctx context.Context, client *Client, r *Resource) (*booking.AppliedTax, error) {
+	q := client.TaxRate.Query()
+	if r.TaxRateId != nil {
+		q.Where(taxrate.ID(*r.TaxRateId))
+	} else {
+		q.Where(
+			taxrate.OrganizationId(r.OrganizationId),
+			taxrate.IsDefault(true),
+		)
+	}
+	t, err := q.First(ctx)
+	var nfe *NotFoundError
+	if errors.As(err, &nfe) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tax rate: %w", err)
+	}
+	return t.toModel().Applied(), nil
+}
+
+func (t *TaxRate) toModel() *booking.TaxRate {
+	return &booking.TaxRate{
+		ID:        t.ID,
+		Name:      t.Name,
+		Rate:      t.Rate,
+		Inclusive: t.Inclusive,
+		Default:   t.IsDefault,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
+
+func (t TaxRates) toModels() []*booking.TaxRate {
+	rates := make([]*booking.TaxRate, 0, len(t))
+	for _, v := range t {
+		rates = append(rates, v.toModel())
+	}
+	return rates
+}
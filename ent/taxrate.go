@@ -0,0 +1,212 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/openmesh/booking/ent/organization"
+	"github.com/openmesh/booking/ent/taxrate"
+)
+
+// TaxRate is the model entity for the TaxRate schema.
+type TaxRate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "createdAt" field.
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+	// UpdatedAt holds the value of the "updatedAt" field.
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Rate holds the value of the "rate" field.
+	Rate int `json:"rate,omitempty"`
+	// Inclusive holds the value of the "inclusive" field.
+	Inclusive bool `json:"inclusive,omitempty"`
+	// IsDefault holds the value of the "isDefault" field.
+	IsDefault bool `json:"isDefault,omitempty"`
+	// OrganizationId holds the value of the "organizationId" field.
+	OrganizationId int `json:"organizationId,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaxRateQuery when eager-loading is set.
+	Edges TaxRateEdges `json:"edges"`
+}
+
+// TaxRateEdges holds the relations/edges for other nodes in the graph.
+type TaxRateEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
+	// Resources holds the value of the resources edge.
+	Resources []*Resource `json:"resources,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaxRateEdges) OrganizationOrErr() (*Organization, error) {
+	if e.loadedTypes[0] {
+		if e.Organization == nil {
+			// The edge organization was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: organization.Label}
+		}
+		return e.Organization, nil
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// ResourcesOrErr returns the Resources value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaxRateEdges) ResourcesOrErr() ([]*Resource, error) {
+	if e.loadedTypes[1] {
+		return e.Resources, nil
+	}
+	return nil, &NotLoadedError{edge: "resources"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaxRate) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taxrate.FieldInclusive, taxrate.FieldIsDefault:
+			values[i] = new(sql.NullBool)
+		case taxrate.FieldID, taxrate.FieldRate, taxrate.FieldOrganizationId:
+			values[i] = new(sql.NullInt64)
+		case taxrate.FieldName:
+			values[i] = new(sql.NullString)
+		case taxrate.FieldCreatedAt, taxrate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type TaxRate", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaxRate fields.
+func (tr *TaxRate) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taxrate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tr.ID = int(value.Int64)
+		case taxrate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field createdAt", values[i])
+			} else if value.Valid {
+				tr.CreatedAt = value.Time
+			}
+		case taxrate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updatedAt", values[i])
+			} else if value.Valid {
+				tr.UpdatedAt = value.Time
+			}
+		case taxrate.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				tr.Name = value.String
+			}
+		case taxrate.FieldRate:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rate", values[i])
+			} else if value.Valid {
+				tr.Rate = int(value.Int64)
+			}
+		case taxrate.FieldInclusive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field inclusive", values[i])
+			} else if value.Valid {
+				tr.Inclusive = value.Bool
+			}
+		case taxrate.FieldIsDefault:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field isDefault", values[i])
+			} else if value.Valid {
+				tr.IsDefault = value.Bool
+			}
+		case taxrate.FieldOrganizationId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organizationId", values[i])
+			} else if value.Valid {
+				tr.OrganizationId = int(value.Int64)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryOrganization queries the "organization" edge of the TaxRate entity.
+func (tr *TaxRate) QueryOrganization() *OrganizationQuery {
+	return (&TaxRateClient{config: tr.config}).QueryOrganization(tr)
+}
+
+// QueryResources queries the "resources" edge of the TaxRate entity.
+func (tr *TaxRate) QueryResources() *ResourceQuery {
+	return (&TaxRateClient{config: tr.config}).QueryResources(tr)
+}
+
+// Update returns a builder for updating this TaxRate.
+// Note that you need to call TaxRate.Unwrap() before calling this method if this TaxRate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tr *TaxRate) Update() *TaxRateUpdateOne {
+	return (&TaxRateClient{config: tr.config}).UpdateOne(tr)
+}
+
+// Unwrap unwraps the TaxRate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tr *TaxRate) Unwrap() *TaxRate {
+	tx, ok := tr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaxRate is not a transactional entity")
+	}
+	tr.config.driver = tx.drv
+	return tr
+}
+
+// String implements the fmt.Stringer.
+func (tr *TaxRate) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaxRate(")
+	builder.WriteString(fmt.Sprintf("id=%v", tr.ID))
+	builder.WriteString(", createdAt=")
+	builder.WriteString(tr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", updatedAt=")
+	builder.WriteString(tr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", name=")
+	builder.WriteString(tr.Name)
+	builder.WriteString(", rate=")
+	builder.WriteString(fmt.Sprintf("%v", tr.Rate))
+	builder.WriteString(", inclusive=")
+	builder.WriteString(fmt.Sprintf("%v", tr.Inclusive))
+	builder.WriteString(", isDefault=")
+	builder.WriteString(fmt.Sprintf("%v", tr.IsDefault))
+	builder.WriteString(", organizationId=")
+	builder.WriteString(fmt.Sprintf("%v", tr.OrganizationId))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaxRates is a parsable slice of TaxRate.
+type TaxRates []*TaxRate
+
+func (tr TaxRates) config(cfg config) {
+	for _i := range tr {
+		tr[_i].config = cfg
+	}
+}
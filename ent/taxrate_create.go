@@ -0,0 +1,956 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmesh/booking/ent/organization"
+	"github.com/openmesh/booking/ent/resource"
+	"github.com/openmesh/booking/ent/taxrate"
+)
+
+// TaxRateCreate is the builder for creating a TaxRate entity.
+type TaxRateCreate struct {
+	config
+	mutation *TaxRateMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (trc *TaxRateCreate) SetCreatedAt(t time.Time) *TaxRateCreate {
+	trc.mutation.SetCreatedAt(t)
+	return trc
+}
+
+// SetNillableCreatedAt sets the "createdAt" field if the given value is not nil.
+func (trc *TaxRateCreate) SetNillableCreatedAt(t *time.Time) *TaxRateCreate {
+	if t != nil {
+		trc.SetCreatedAt(*t)
+	}
+	return trc
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (trc *TaxRateCreate) SetUpdatedAt(t time.Time) *TaxRateCreate {
+	trc.mutation.SetUpdatedAt(t)
+	return trc
+}
+
+// SetNillableUpdatedAt sets the "updatedAt" field if the given value is not nil.
+func (trc *TaxRateCreate) SetNillableUpdatedAt(t *time.Time) *TaxRateCreate {
+	if t != nil {
+		trc.SetUpdatedAt(*t)
+	}
+	return trc
+}
+
+// SetName sets the "name" field.
+func (trc *TaxRateCreate) SetName(s string) *TaxRateCreate {
+	trc.mutation.SetName(s)
+	return trc
+}
+
+// SetRate sets the "rate" field.
+func (trc *TaxRateCreate) SetRate(i int) *TaxRateCreate {
+	trc.mutation.SetRate(i)
+	return trc
+}
+
+// SetInclusive sets the "inclusive" field.
+func (trc *TaxRateCreate) SetInclusive(b bool) *TaxRateCreate {
+	trc.mutation.SetInclusive(b)
+	return trc
+}
+
+// SetNillableInclusive sets the "inclusive" field if the given value is not nil.
+func (trc *TaxRateCreate) SetNillableInclusive(b *bool) *TaxRateCreate {
+	if b != nil {
+		trc.SetInclusive(*b)
+	}
+	return trc
+}
+
+// SetIsDefault sets the "isDefault" field.
+func (trc *TaxRateCreate) SetIsDefault(b bool) *TaxRateCreate {
+	trc.mutation.SetIsDefault(b)
+	return trc
+}
+
+// SetNillableIsDefault sets the "isDefault" field if the given value is not nil.
+func (trc *TaxRateCreate) SetNillableIsDefault(b *bool) *TaxRateCreate {
+	if b != nil {
+		trc.SetIsDefault(*b)
+	}
+	return trc
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (trc *TaxRateCreate) SetOrganizationId(i int) *TaxRateCreate {
+	trc.mutation.SetOrganizationId(i)
+	return trc
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (trc *TaxRateCreate) SetOrganizationID(id int) *TaxRateCreate {
+	trc.mutation.SetOrganizationID(id)
+	return trc
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (trc *TaxRateCreate) SetOrganization(o *Organization) *TaxRateCreate {
+	return trc.SetOrganizationID(o.ID)
+}
+
+// AddResourceIDs adds the "resources" edge to the Resource entity by IDs.
+func (trc *TaxRateCreate) AddResourceIDs(ids ...int) *TaxRateCreate {
+	trc.mutation.AddResourceIDs(ids...)
+	return trc
+}
+
+// AddResources adds the "resources" edges to the Resource entity.
+func (trc *TaxRateCreate) AddResources(r ...*Resource) *TaxRateCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return trc.AddResourceIDs(ids...)
+}
+
+// Mutation returns the TaxRateMutation object of the builder.
+func (trc *TaxRateCreate) Mutation() *TaxRateMutation {
+	return trc.mutation
+}
+
+// Save creates the TaxRate in the database.
+func (trc *TaxRateCreate) Save(ctx context.Context) (*TaxRate, error) {
+	var (
+		err  error
+		node *TaxRate
+	)
+	if err := trc.defaults(); err != nil {
+		return nil, err
+	}
+	if len(trc.hooks) == 0 {
+		if err = trc.check(); err != nil {
+			return nil, err
+		}
+		node, err = trc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TaxRateMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = trc.check(); err != nil {
+				return nil, err
+			}
+			trc.mutation = mutation
+			if node, err = trc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(trc.hooks) - 1; i >= 0; i-- {
+			if trc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = trc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, trc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (trc *TaxRateCreate) SaveX(ctx context.Context) *TaxRate {
+	v, err := trc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trc *TaxRateCreate) Exec(ctx context.Context) error {
+	_, err := trc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trc *TaxRateCreate) ExecX(ctx context.Context) {
+	if err := trc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (trc *TaxRateCreate) defaults() error {
+	if _, ok := trc.mutation.CreatedAt(); !ok {
+		if taxrate.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized taxrate.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := taxrate.DefaultCreatedAt()
+		trc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := trc.mutation.UpdatedAt(); !ok {
+		if taxrate.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized taxrate.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := taxrate.DefaultUpdatedAt()
+		trc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := trc.mutation.Inclusive(); !ok {
+		v := taxrate.DefaultInclusive
+		trc.mutation.SetInclusive(v)
+	}
+	if _, ok := trc.mutation.IsDefault(); !ok {
+		v := taxrate.DefaultIsDefault
+		trc.mutation.SetIsDefault(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (trc *TaxRateCreate) check() error {
+	if _, ok := trc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "createdAt", err: errors.New(`ent: missing required field "createdAt"`)}
+	}
+	if _, ok := trc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updatedAt", err: errors.New(`ent: missing required field "updatedAt"`)}
+	}
+	if _, ok := trc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "name"`)}
+	}
+	if _, ok := trc.mutation.Rate(); !ok {
+		return &ValidationError{Name: "rate", err: errors.New(`ent: missing required field "rate"`)}
+	}
+	if _, ok := trc.mutation.Inclusive(); !ok {
+		return &ValidationError{Name: "inclusive", err: errors.New(`ent: missing required field "inclusive"`)}
+	}
+	if _, ok := trc.mutation.IsDefault(); !ok {
+		return &ValidationError{Name: "isDefault", err: errors.New(`ent: missing required field "isDefault"`)}
+	}
+	if _, ok := trc.mutation.OrganizationId(); !ok {
+		return &ValidationError{Name: "organizationId", err: errors.New(`ent: missing required field "organizationId"`)}
+	}
+	if _, ok := trc.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization", err: errors.New("ent: missing required edge \"organization\"")}
+	}
+	return nil
+}
+
+func (trc *TaxRateCreate) sqlSave(ctx context.Context) (*TaxRate, error) {
+	_node, _spec := trc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, trc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (trc *TaxRateCreate) createSpec() (*TaxRate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaxRate{config: trc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: taxrate.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: taxrate.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = trc.conflict
+	if value, ok := trc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: taxrate.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := trc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: taxrate.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if value, ok := trc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: taxrate.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := trc.mutation.Rate(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: taxrate.FieldRate,
+		})
+		_node.Rate = value
+	}
+	if value, ok := trc.mutation.Inclusive(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: taxrate.FieldInclusive,
+		})
+		_node.Inclusive = value
+	}
+	if value, ok := trc.mutation.IsDefault(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: taxrate.FieldIsDefault,
+		})
+		_node.IsDefault = value
+	}
+	if nodes := trc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taxrate.OrganizationTable,
+			Columns: []string{taxrate.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationId = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := trc.mutation.ResourcesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taxrate.ResourcesTable,
+			Columns: []string{taxrate.ResourcesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: resource.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaxRate.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaxRateUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (trc *TaxRateCreate) OnConflict(opts ...sql.ConflictOption) *TaxRateUpsertOne {
+	trc.conflict = opts
+	return &TaxRateUpsertOne{
+		create: trc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaxRate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (trc *TaxRateCreate) OnConflictColumns(columns ...string) *TaxRateUpsertOne {
+	trc.conflict = append(trc.conflict, sql.ConflictColumns(columns...))
+	return &TaxRateUpsertOne{
+		create: trc,
+	}
+}
+
+type (
+	// TaxRateUpsertOne is the builder for "upsert"-ing
+	//  one TaxRate node.
+	TaxRateUpsertOne struct {
+		create *TaxRateCreate
+	}
+
+	// TaxRateUpsert is the "OnConflict" setter.
+	TaxRateUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *TaxRateUpsert) SetCreatedAt(v time.Time) *TaxRateUpsert {
+	u.Set(taxrate.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *TaxRateUpsert) UpdateCreatedAt() *TaxRateUpsert {
+	u.SetExcluded(taxrate.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *TaxRateUpsert) SetUpdatedAt(v time.Time) *TaxRateUpsert {
+	u.Set(taxrate.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *TaxRateUpsert) UpdateUpdatedAt() *TaxRateUpsert {
+	u.SetExcluded(taxrate.FieldUpdatedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TaxRateUpsert) SetName(v string) *TaxRateUpsert {
+	u.Set(taxrate.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TaxRateUpsert) UpdateName() *TaxRateUpsert {
+	u.SetExcluded(taxrate.FieldName)
+	return u
+}
+
+// SetRate sets the "rate" field.
+func (u *TaxRateUpsert) SetRate(v int) *TaxRateUpsert {
+	u.Set(taxrate.FieldRate, v)
+	return u
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *TaxRateUpsert) UpdateRate() *TaxRateUpsert {
+	u.SetExcluded(taxrate.FieldRate)
+	return u
+}
+
+// SetInclusive sets the "inclusive" field.
+func (u *TaxRateUpsert) SetInclusive(v bool) *TaxRateUpsert {
+	u.Set(taxrate.FieldInclusive, v)
+	return u
+}
+
+// UpdateInclusive sets the "inclusive" field to the value that was provided on create.
+func (u *TaxRateUpsert) UpdateInclusive() *TaxRateUpsert {
+	u.SetExcluded(taxrate.FieldInclusive)
+	return u
+}
+
+// SetIsDefault sets the "isDefault" field.
+func (u *TaxRateUpsert) SetIsDefault(v bool) *TaxRateUpsert {
+	u.Set(taxrate.FieldIsDefault, v)
+	return u
+}
+
+// UpdateIsDefault sets the "isDefault" field to the value that was provided on create.
+func (u *TaxRateUpsert) UpdateIsDefault() *TaxRateUpsert {
+	u.SetExcluded(taxrate.FieldIsDefault)
+	return u
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (u *TaxRateUpsert) SetOrganizationId(v int) *TaxRateUpsert {
+	u.Set(taxrate.FieldOrganizationId, v)
+	return u
+}
+
+// UpdateOrganizationId sets the "organizationId" field to the value that was provided on create.
+func (u *TaxRateUpsert) UpdateOrganizationId() *TaxRateUpsert {
+	u.SetExcluded(taxrate.FieldOrganizationId)
+	return u
+}
+
+// UpdateNewValues updates the fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TaxRate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TaxRateUpsertOne) UpdateNewValues() *TaxRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaxRate.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TaxRateUpsertOne) Ignore() *TaxRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaxRateUpsertOne) DoNothing() *TaxRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaxRateCreate.OnConflict
+// documentation for more info.
+func (u *TaxRateUpsertOne) Update(set func(*TaxRateUpsert)) *TaxRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaxRateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *TaxRateUpsertOne) SetCreatedAt(v time.Time) *TaxRateUpsertOne {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *TaxRateUpsertOne) UpdateCreatedAt() *TaxRateUpsertOne {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *TaxRateUpsertOne) SetUpdatedAt(v time.Time) *TaxRateUpsertOne {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *TaxRateUpsertOne) UpdateUpdatedAt() *TaxRateUpsertOne {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *TaxRateUpsertOne) SetName(v string) *TaxRateUpsertOne {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TaxRateUpsertOne) UpdateName() *TaxRateUpsertOne {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetRate sets the "rate" field.
+func (u *TaxRateUpsertOne) SetRate(v int) *TaxRateUpsertOne {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.SetRate(v)
+	})
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *TaxRateUpsertOne) UpdateRate() *TaxRateUpsertOne {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.UpdateRate()
+	})
+}
+
+// SetInclusive sets the "inclusive" field.
+func (u *TaxRateUpsertOne) SetInclusive(v bool) *TaxRateUpsertOne {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.SetInclusive(v)
+	})
+}
+
+// UpdateInclusive sets the "inclusive" field to the value that was provided on create.
+func (u *TaxRateUpsertOne) UpdateInclusive() *TaxRateUpsertOne {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.UpdateInclusive()
+	})
+}
+
+// SetIsDefault sets the "isDefault" field.
+func (u *TaxRateUpsertOne) SetIsDefault(v bool) *TaxRateUpsertOne {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.SetIsDefault(v)
+	})
+}
+
+// UpdateIsDefault sets the "isDefault" field to the value that was provided on create.
+func (u *TaxRateUpsertOne) UpdateIsDefault() *TaxRateUpsertOne {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.UpdateIsDefault()
+	})
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (u *TaxRateUpsertOne) SetOrganizationId(v int) *TaxRateUpsertOne {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.SetOrganizationId(v)
+	})
+}
+
+// UpdateOrganizationId sets the "organizationId" field to the value that was provided on create.
+func (u *TaxRateUpsertOne) UpdateOrganizationId() *TaxRateUpsertOne {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.UpdateOrganizationId()
+	})
+}
+
+// Exec executes the query.
+func (u *TaxRateUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaxRateCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaxRateUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TaxRateUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TaxRateUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TaxRateCreateBulk is the builder for creating many TaxRate entities in bulk.
+type TaxRateCreateBulk struct {
+	config
+	builders []*TaxRateCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TaxRate entities in the database.
+func (trcb *TaxRateCreateBulk) Save(ctx context.Context) ([]*TaxRate, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(trcb.builders))
+	nodes := make([]*TaxRate, len(trcb.builders))
+	mutators := make([]Mutator, len(trcb.builders))
+	for i := range trcb.builders {
+		func(i int, root context.Context) {
+			builder := trcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaxRateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, trcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = trcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, trcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, trcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (trcb *TaxRateCreateBulk) SaveX(ctx context.Context) []*TaxRate {
+	v, err := trcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trcb *TaxRateCreateBulk) Exec(ctx context.Context) error {
+	_, err := trcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trcb *TaxRateCreateBulk) ExecX(ctx context.Context) {
+	if err := trcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaxRate.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaxRateUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (trcb *TaxRateCreateBulk) OnConflict(opts ...sql.ConflictOption) *TaxRateUpsertBulk {
+	trcb.conflict = opts
+	return &TaxRateUpsertBulk{
+		create: trcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaxRate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (trcb *TaxRateCreateBulk) OnConflictColumns(columns ...string) *TaxRateUpsertBulk {
+	trcb.conflict = append(trcb.conflict, sql.ConflictColumns(columns...))
+	return &TaxRateUpsertBulk{
+		create: trcb,
+	}
+}
+
+// TaxRateUpsertBulk is the builder for "upsert"-ing
+// a bulk of TaxRate nodes.
+type TaxRateUpsertBulk struct {
+	create *TaxRateCreateBulk
+}
+
+// UpdateNewValues updates the fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TaxRate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TaxRateUpsertBulk) UpdateNewValues() *TaxRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaxRate.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TaxRateUpsertBulk) Ignore() *TaxRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaxRateUpsertBulk) DoNothing() *TaxRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaxRateCreateBulk.OnConflict
+// documentation for more info.
+func (u *TaxRateUpsertBulk) Update(set func(*TaxRateUpsert)) *TaxRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaxRateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *TaxRateUpsertBulk) SetCreatedAt(v time.Time) *TaxRateUpsertBulk {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *TaxRateUpsertBulk) UpdateCreatedAt() *TaxRateUpsertBulk {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *TaxRateUpsertBulk) SetUpdatedAt(v time.Time) *TaxRateUpsertBulk {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *TaxRateUpsertBulk) UpdateUpdatedAt() *TaxRateUpsertBulk {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *TaxRateUpsertBulk) SetName(v string) *TaxRateUpsertBulk {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TaxRateUpsertBulk) UpdateName() *TaxRateUpsertBulk {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetRate sets the "rate" field.
+func (u *TaxRateUpsertBulk) SetRate(v int) *TaxRateUpsertBulk {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.SetRate(v)
+	})
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *TaxRateUpsertBulk) UpdateRate() *TaxRateUpsertBulk {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.UpdateRate()
+	})
+}
+
+// SetInclusive sets the "inclusive" field.
+func (u *TaxRateUpsertBulk) SetInclusive(v bool) *TaxRateUpsertBulk {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.SetInclusive(v)
+	})
+}
+
+// UpdateInclusive sets the "inclusive" field to the value that was provided on create.
+func (u *TaxRateUpsertBulk) UpdateInclusive() *TaxRateUpsertBulk {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.UpdateInclusive()
+	})
+}
+
+// SetIsDefault sets the "isDefault" field.
+func (u *TaxRateUpsertBulk) SetIsDefault(v bool) *TaxRateUpsertBulk {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.SetIsDefault(v)
+	})
+}
+
+// UpdateIsDefault sets the "isDefault" field to the value that was provided on create.
+func (u *TaxRateUpsertBulk) UpdateIsDefault() *TaxRateUpsertBulk {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.UpdateIsDefault()
+	})
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (u *TaxRateUpsertBulk) SetOrganizationId(v int) *TaxRateUpsertBulk {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.SetOrganizationId(v)
+	})
+}
+
+// UpdateOrganizationId sets the "organizationId" field to the value that was provided on create.
+func (u *TaxRateUpsertBulk) UpdateOrganizationId() *TaxRateUpsertBulk {
+	return u.Update(func(s *TaxRateUpsert) {
+		s.UpdateOrganizationId()
+	})
+}
+
+// Exec executes the query.
+func (u *TaxRateUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TaxRateCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaxRateCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaxRateUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
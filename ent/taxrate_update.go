@@ -0,0 +1,786 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmesh/booking/ent/organization"
+	"github.com/openmesh/booking/ent/predicate"
+	"github.com/openmesh/booking/ent/resource"
+	"github.com/openmesh/booking/ent/taxrate"
+)
+
+// TaxRateUpdate is the builder for updating TaxRate entities.
+type TaxRateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaxRateMutation
+}
+
+// Where appends a list predicates to the TaxRateUpdate builder.
+func (tru *TaxRateUpdate) Where(ps ...predicate.TaxRate) *TaxRateUpdate {
+	tru.mutation.Where(ps...)
+	return tru
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (tru *TaxRateUpdate) SetUpdatedAt(t time.Time) *TaxRateUpdate {
+	tru.mutation.SetUpdatedAt(t)
+	return tru
+}
+
+// SetName sets the "name" field.
+func (tru *TaxRateUpdate) SetName(s string) *TaxRateUpdate {
+	tru.mutation.SetName(s)
+	return tru
+}
+
+// SetRate sets the "rate" field.
+func (tru *TaxRateUpdate) SetRate(i int) *TaxRateUpdate {
+	tru.mutation.ResetRate()
+	tru.mutation.SetRate(i)
+	return tru
+}
+
+// AddRate adds i to the "rate" field.
+func (tru *TaxRateUpdate) AddRate(i int) *TaxRateUpdate {
+	tru.mutation.AddRate(i)
+	return tru
+}
+
+// SetInclusive sets the "inclusive" field.
+func (tru *TaxRateUpdate) SetInclusive(b bool) *TaxRateUpdate {
+	tru.mutation.SetInclusive(b)
+	return tru
+}
+
+// SetNillableInclusive sets the "inclusive" field if the given value is not nil.
+func (tru *TaxRateUpdate) SetNillableInclusive(b *bool) *TaxRateUpdate {
+	if b != nil {
+		tru.SetInclusive(*b)
+	}
+	return tru
+}
+
+// SetIsDefault sets the "isDefault" field.
+func (tru *TaxRateUpdate) SetIsDefault(b bool) *TaxRateUpdate {
+	tru.mutation.SetIsDefault(b)
+	return tru
+}
+
+// SetNillableIsDefault sets the "isDefault" field if the given value is not nil.
+func (tru *TaxRateUpdate) SetNillableIsDefault(b *bool) *TaxRateUpdate {
+	if b != nil {
+		tru.SetIsDefault(*b)
+	}
+	return tru
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (tru *TaxRateUpdate) SetOrganizationId(i int) *TaxRateUpdate {
+	tru.mutation.SetOrganizationId(i)
+	return tru
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (tru *TaxRateUpdate) SetOrganizationID(id int) *TaxRateUpdate {
+	tru.mutation.SetOrganizationID(id)
+	return tru
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (tru *TaxRateUpdate) SetOrganization(o *Organization) *TaxRateUpdate {
+	return tru.SetOrganizationID(o.ID)
+}
+
+// AddResourceIDs adds the "resources" edge to the Resource entity by IDs.
+func (tru *TaxRateUpdate) AddResourceIDs(ids ...int) *TaxRateUpdate {
+	tru.mutation.AddResourceIDs(ids...)
+	return tru
+}
+
+// AddResources adds the "resources" edges to the Resource entity.
+func (tru *TaxRateUpdate) AddResources(r ...*Resource) *TaxRateUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return tru.AddResourceIDs(ids...)
+}
+
+// Mutation returns the TaxRateMutation object of the builder.
+func (tru *TaxRateUpdate) Mutation() *TaxRateMutation {
+	return tru.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (tru *TaxRateUpdate) ClearOrganization() *TaxRateUpdate {
+	tru.mutation.ClearOrganization()
+	return tru
+}
+
+// ClearResources clears all "resources" edges to the Resource entity.
+func (tru *TaxRateUpdate) ClearResources() *TaxRateUpdate {
+	tru.mutation.ClearResources()
+	return tru
+}
+
+// RemoveResourceIDs removes the "resources" edge to Resource entities by IDs.
+func (tru *TaxRateUpdate) RemoveResourceIDs(ids ...int) *TaxRateUpdate {
+	tru.mutation.RemoveResourceIDs(ids...)
+	return tru
+}
+
+// RemoveResources removes "resources" edges to Resource entities.
+func (tru *TaxRateUpdate) RemoveResources(r ...*Resource) *TaxRateUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return tru.RemoveResourceIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tru *TaxRateUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if err := tru.defaults(); err != nil {
+		return 0, err
+	}
+	if len(tru.hooks) == 0 {
+		if err = tru.check(); err != nil {
+			return 0, err
+		}
+		affected, err = tru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TaxRateMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = tru.check(); err != nil {
+				return 0, err
+			}
+			tru.mutation = mutation
+			affected, err = tru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(tru.hooks) - 1; i >= 0; i-- {
+			if tru.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tru *TaxRateUpdate) SaveX(ctx context.Context) int {
+	affected, err := tru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tru *TaxRateUpdate) Exec(ctx context.Context) error {
+	_, err := tru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tru *TaxRateUpdate) ExecX(ctx context.Context) {
+	if err := tru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tru *TaxRateUpdate) defaults() error {
+	if _, ok := tru.mutation.UpdatedAt(); !ok {
+		if taxrate.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized taxrate.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := taxrate.UpdateDefaultUpdatedAt()
+		tru.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tru *TaxRateUpdate) check() error {
+	if _, ok := tru.mutation.OrganizationID(); tru.mutation.OrganizationCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"organization\"")
+	}
+	return nil
+}
+
+func (tru *TaxRateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   taxrate.Table,
+			Columns: taxrate.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: taxrate.FieldID,
+			},
+		},
+	}
+	if ps := tru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tru.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: taxrate.FieldUpdatedAt,
+		})
+	}
+	if value, ok := tru.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: taxrate.FieldName,
+		})
+	}
+	if value, ok := tru.mutation.Rate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: taxrate.FieldRate,
+		})
+	}
+	if value, ok := tru.mutation.AddedRate(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: taxrate.FieldRate,
+		})
+	}
+	if value, ok := tru.mutation.Inclusive(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: taxrate.FieldInclusive,
+		})
+	}
+	if value, ok := tru.mutation.IsDefault(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: taxrate.FieldIsDefault,
+		})
+	}
+	if tru.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taxrate.OrganizationTable,
+			Columns: []string{taxrate.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tru.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taxrate.OrganizationTable,
+			Columns: []string{taxrate.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tru.mutation.ResourcesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taxrate.ResourcesTable,
+			Columns: []string{taxrate.ResourcesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: resource.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tru.mutation.RemovedResourcesIDs(); len(nodes) > 0 && !tru.mutation.ResourcesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taxrate.ResourcesTable,
+			Columns: []string{taxrate.ResourcesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: resource.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tru.mutation.ResourcesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taxrate.ResourcesTable,
+			Columns: []string{taxrate.ResourcesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: resource.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taxrate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// TaxRateUpdateOne is the builder for updating a single TaxRate entity.
+type TaxRateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaxRateMutation
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (truo *TaxRateUpdateOne) SetUpdatedAt(t time.Time) *TaxRateUpdateOne {
+	truo.mutation.SetUpdatedAt(t)
+	return truo
+}
+
+// SetName sets the "name" field.
+func (truo *TaxRateUpdateOne) SetName(s string) *TaxRateUpdateOne {
+	truo.mutation.SetName(s)
+	return truo
+}
+
+// SetRate sets the "rate" field.
+func (truo *TaxRateUpdateOne) SetRate(i int) *TaxRateUpdateOne {
+	truo.mutation.ResetRate()
+	truo.mutation.SetRate(i)
+	return truo
+}
+
+// AddRate adds i to the "rate" field.
+func (truo *TaxRateUpdateOne) AddRate(i int) *TaxRateUpdateOne {
+	truo.mutation.AddRate(i)
+	return truo
+}
+
+// SetInclusive sets the "inclusive" field.
+func (truo *TaxRateUpdateOne) SetInclusive(b bool) *TaxRateUpdateOne {
+	truo.mutation.SetInclusive(b)
+	return truo
+}
+
+// SetNillableInclusive sets the "inclusive" field if the given value is not nil.
+func (truo *TaxRateUpdateOne) SetNillableInclusive(b *bool) *TaxRateUpdateOne {
+	if b != nil {
+		truo.SetInclusive(*b)
+	}
+	return truo
+}
+
+// SetIsDefault sets the "isDefault" field.
+func (truo *TaxRateUpdateOne) SetIsDefault(b bool) *TaxRateUpdateOne {
+	truo.mutation.SetIsDefault(b)
+	return truo
+}
+
+// SetNillableIsDefault sets the "isDefault" field if the given value is not nil.
+func (truo *TaxRateUpdateOne) SetNillableIsDefault(b *bool) *TaxRateUpdateOne {
+	if b != nil {
+		truo.SetIsDefault(*b)
+	}
+	return truo
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (truo *TaxRateUpdateOne) SetOrganizationId(i int) *TaxRateUpdateOne {
+	truo.mutation.SetOrganizationId(i)
+	return truo
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (truo *TaxRateUpdateOne) SetOrganizationID(id int) *TaxRateUpdateOne {
+	truo.mutation.SetOrganizationID(id)
+	return truo
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (truo *TaxRateUpdateOne) SetOrganization(o *Organization) *TaxRateUpdateOne {
+	return truo.SetOrganizationID(o.ID)
+}
+
+// AddResourceIDs adds the "resources" edge to the Resource entity by IDs.
+func (truo *TaxRateUpdateOne) AddResourceIDs(ids ...int) *TaxRateUpdateOne {
+	truo.mutation.AddResourceIDs(ids...)
+	return truo
+}
+
+// AddResources adds the "resources" edges to the Resource entity.
+func (truo *TaxRateUpdateOne) AddResources(r ...*Resource) *TaxRateUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return truo.AddResourceIDs(ids...)
+}
+
+// Mutation returns the TaxRateMutation object of the builder.
+func (truo *TaxRateUpdateOne) Mutation() *TaxRateMutation {
+	return truo.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (truo *TaxRateUpdateOne) ClearOrganization() *TaxRateUpdateOne {
+	truo.mutation.ClearOrganization()
+	return truo
+}
+
+// ClearResources clears all "resources" edges to the Resource entity.
+func (truo *TaxRateUpdateOne) ClearResources() *TaxRateUpdateOne {
+	truo.mutation.ClearResources()
+	return truo
+}
+
+// RemoveResourceIDs removes the "resources" edge to Resource entities by IDs.
+func (truo *TaxRateUpdateOne) RemoveResourceIDs(ids ...int) *TaxRateUpdateOne {
+	truo.mutation.RemoveResourceIDs(ids...)
+	return truo
+}
+
+// RemoveResources removes "resources" edges to Resource entities.
+func (truo *TaxRateUpdateOne) RemoveResources(r ...*Resource) *TaxRateUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return truo.RemoveResourceIDs(ids...)
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (truo *TaxRateUpdateOne) Select(field string, fields ...string) *TaxRateUpdateOne {
+	truo.fields = append([]string{field}, fields...)
+	return truo
+}
+
+// Save executes the query and returns the updated TaxRate entity.
+func (truo *TaxRateUpdateOne) Save(ctx context.Context) (*TaxRate, error) {
+	var (
+		err  error
+		node *TaxRate
+	)
+	if err := truo.defaults(); err != nil {
+		return nil, err
+	}
+	if len(truo.hooks) == 0 {
+		if err = truo.check(); err != nil {
+			return nil, err
+		}
+		node, err = truo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TaxRateMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = truo.check(); err != nil {
+				return nil, err
+			}
+			truo.mutation = mutation
+			node, err = truo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(truo.hooks) - 1; i >= 0; i-- {
+			if truo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = truo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, truo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (truo *TaxRateUpdateOne) SaveX(ctx context.Context) *TaxRate {
+	node, err := truo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (truo *TaxRateUpdateOne) Exec(ctx context.Context) error {
+	_, err := truo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (truo *TaxRateUpdateOne) ExecX(ctx context.Context) {
+	if err := truo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (truo *TaxRateUpdateOne) defaults() error {
+	if _, ok := truo.mutation.UpdatedAt(); !ok {
+		if taxrate.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized taxrate.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := taxrate.UpdateDefaultUpdatedAt()
+		truo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (truo *TaxRateUpdateOne) check() error {
+	if _, ok := truo.mutation.OrganizationID(); truo.mutation.OrganizationCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"organization\"")
+	}
+	return nil
+}
+
+func (truo *TaxRateUpdateOne) sqlSave(ctx context.Context) (_node *TaxRate, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   taxrate.Table,
+			Columns: taxrate.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: taxrate.FieldID,
+			},
+		},
+	}
+	id, ok := truo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing TaxRate.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := truo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taxrate.FieldID)
+		for _, f := range fields {
+			if !taxrate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taxrate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := truo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := truo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: taxrate.FieldUpdatedAt,
+		})
+	}
+	if value, ok := truo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: taxrate.FieldName,
+		})
+	}
+	if value, ok := truo.mutation.Rate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: taxrate.FieldRate,
+		})
+	}
+	if value, ok := truo.mutation.AddedRate(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: taxrate.FieldRate,
+		})
+	}
+	if value, ok := truo.mutation.Inclusive(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: taxrate.FieldInclusive,
+		})
+	}
+	if value, ok := truo.mutation.IsDefault(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: taxrate.FieldIsDefault,
+		})
+	}
+	if truo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taxrate.OrganizationTable,
+			Columns: []string{taxrate.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := truo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taxrate.OrganizationTable,
+			Columns: []string{taxrate.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: organization.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if truo.mutation.ResourcesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taxrate.ResourcesTable,
+			Columns: []string{taxrate.ResourcesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: resource.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := truo.mutation.RemovedResourcesIDs(); len(nodes) > 0 && !truo.mutation.ResourcesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taxrate.ResourcesTable,
+			Columns: []string{taxrate.ResourcesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: resource.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := truo.mutation.ResourcesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taxrate.ResourcesTable,
+			Columns: []string{taxrate.ResourcesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: resource.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TaxRate{config: truo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, truo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taxrate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
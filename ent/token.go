@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -24,8 +25,18 @@ type Token struct {
 	UpdatedAt time.Time `json:"updatedAt,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// SecretHash holds the value of the "secretHash" field.
+	SecretHash string `json:"-"`
+	// SecretPrefix holds the value of the "secretPrefix" field.
+	SecretPrefix string `json:"secretPrefix,omitempty"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes []string `json:"scopes,omitempty"`
 	// Expiry holds the value of the "expiry" field.
 	Expiry *time.Time `json:"expiry,omitempty"`
+	// LastUsedAt holds the value of the "lastUsedAt" field.
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	// RevokedAt holds the value of the "revokedAt" field.
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
 	// UserId holds the value of the "userId" field.
 	UserId int `json:"userId,omitempty"`
 	// OrganizationId holds the value of the "organizationId" field.
@@ -79,11 +90,13 @@ func (*Token) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case token.FieldScopes:
+			values[i] = new([]byte)
 		case token.FieldUserId, token.FieldOrganizationId:
 			values[i] = new(sql.NullInt64)
-		case token.FieldID, token.FieldName:
+		case token.FieldID, token.FieldName, token.FieldSecretHash, token.FieldSecretPrefix:
 			values[i] = new(sql.NullString)
-		case token.FieldCreatedAt, token.FieldUpdatedAt, token.FieldExpiry:
+		case token.FieldCreatedAt, token.FieldUpdatedAt, token.FieldExpiry, token.FieldLastUsedAt, token.FieldRevokedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Token", columns[i])
@@ -124,6 +137,26 @@ func (t *Token) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				t.Name = value.String
 			}
+		case token.FieldSecretHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secretHash", values[i])
+			} else if value.Valid {
+				t.SecretHash = value.String
+			}
+		case token.FieldSecretPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secretPrefix", values[i])
+			} else if value.Valid {
+				t.SecretPrefix = value.String
+			}
+		case token.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &t.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
 		case token.FieldExpiry:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field expiry", values[i])
@@ -131,6 +164,20 @@ func (t *Token) assignValues(columns []string, values []interface{}) error {
 				t.Expiry = new(time.Time)
 				*t.Expiry = value.Time
 			}
+		case token.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field lastUsedAt", values[i])
+			} else if value.Valid {
+				t.LastUsedAt = new(time.Time)
+				*t.LastUsedAt = value.Time
+			}
+		case token.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revokedAt", values[i])
+			} else if value.Valid {
+				t.RevokedAt = new(time.Time)
+				*t.RevokedAt = value.Time
+			}
 		case token.FieldUserId:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field userId", values[i])
@@ -187,10 +234,23 @@ func (t *Token) String() string {
 	builder.WriteString(t.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", name=")
 	builder.WriteString(t.Name)
+	builder.WriteString(", secretHash=<sensitive>")
+	builder.WriteString(", secretPrefix=")
+	builder.WriteString(t.SecretPrefix)
+	builder.WriteString(", scopes=")
+	builder.WriteString(fmt.Sprintf("%v", t.Scopes))
 	if v := t.Expiry; v != nil {
 		builder.WriteString(", expiry=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	if v := t.LastUsedAt; v != nil {
+		builder.WriteString(", lastUsedAt=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := t.RevokedAt; v != nil {
+		builder.WriteString(", revokedAt=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteString(", userId=")
 	builder.WriteString(fmt.Sprintf("%v", t.UserId))
 	builder.WriteString(", organizationId=")
@@ -19,8 +19,18 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldSecretHash holds the string denoting the secrethash field in the database.
+	FieldSecretHash = "secret_hash"
+	// FieldSecretPrefix holds the string denoting the secretprefix field in the database.
+	FieldSecretPrefix = "secret_prefix"
+	// FieldScopes holds the string denoting the scopes field in the database.
+	FieldScopes = "scopes"
 	// FieldExpiry holds the string denoting the expiry field in the database.
 	FieldExpiry = "expiry"
+	// FieldLastUsedAt holds the string denoting the lastusedat field in the database.
+	FieldLastUsedAt = "last_used_at"
+	// FieldRevokedAt holds the string denoting the revokedat field in the database.
+	FieldRevokedAt = "revoked_at"
 	// FieldUserId holds the string denoting the userid field in the database.
 	FieldUserId = "user_id"
 	// FieldOrganizationId holds the string denoting the organizationid field in the database.
@@ -53,7 +63,12 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldName,
+	FieldSecretHash,
+	FieldSecretPrefix,
+	FieldScopes,
 	FieldExpiry,
+	FieldLastUsedAt,
+	FieldRevokedAt,
 	FieldUserId,
 	FieldOrganizationId,
 }
@@ -84,5 +99,5 @@ var (
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updatedAt" field.
 	UpdateDefaultUpdatedAt func() time.Time
 	// DefaultID holds the default value on creation for the "id" field.
-	DefaultID string
+	DefaultID func() string
 )
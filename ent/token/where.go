@@ -114,6 +114,20 @@ func Name(v string) predicate.Token {
 	})
 }
 
+// SecretHash applies equality check predicate on the "secretHash" field. It's identical to SecretHashEQ.
+func SecretHash(v string) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSecretHash), v))
+	})
+}
+
+// SecretPrefix applies equality check predicate on the "secretPrefix" field. It's identical to SecretPrefixEQ.
+func SecretPrefix(v string) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSecretPrefix), v))
+	})
+}
+
 // Expiry applies equality check predicate on the "expiry" field. It's identical to ExpiryEQ.
 func Expiry(v time.Time) predicate.Token {
 	return predicate.Token(func(s *sql.Selector) {
@@ -121,6 +135,20 @@ func Expiry(v time.Time) predicate.Token {
 	})
 }
 
+// LastUsedAt applies equality check predicate on the "lastUsedAt" field. It's identical to LastUsedAtEQ.
+func LastUsedAt(v time.Time) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// RevokedAt applies equality check predicate on the "revokedAt" field. It's identical to RevokedAtEQ.
+func RevokedAt(v time.Time) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRevokedAt), v))
+	})
+}
+
 // UserId applies equality check predicate on the "userId" field. It's identical to UserIdEQ.
 func UserId(v int) predicate.Token {
 	return predicate.Token(func(s *sql.Selector) {
@@ -398,6 +426,228 @@ func NameContainsFold(v string) predicate.Token {
 	})
 }
 
+// SecretHashEQ applies the EQ predicate on the "secretHash" field.
+func SecretHashEQ(v string) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSecretHash), v))
+	})
+}
+
+// SecretHashNEQ applies the NEQ predicate on the "secretHash" field.
+func SecretHashNEQ(v string) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSecretHash), v))
+	})
+}
+
+// SecretHashIn applies the In predicate on the "secretHash" field.
+func SecretHashIn(vs ...string) predicate.Token {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Token(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSecretHash), v...))
+	})
+}
+
+// SecretHashNotIn applies the NotIn predicate on the "secretHash" field.
+func SecretHashNotIn(vs ...string) predicate.Token {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Token(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSecretHash), v...))
+	})
+}
+
+// SecretHashGT applies the GT predicate on the "secretHash" field.
+func SecretHashGT(v string) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSecretHash), v))
+	})
+}
+
+// SecretHashGTE applies the GTE predicate on the "secretHash" field.
+func SecretHashGTE(v string) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSecretHash), v))
+	})
+}
+
+// SecretHashLT applies the LT predicate on the "secretHash" field.
+func SecretHashLT(v string) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSecretHash), v))
+	})
+}
+
+// SecretHashLTE applies the LTE predicate on the "secretHash" field.
+func SecretHashLTE(v string) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSecretHash), v))
+	})
+}
+
+// SecretHashContains applies the Contains predicate on the "secretHash" field.
+func SecretHashContains(v string) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSecretHash), v))
+	})
+}
+
+// SecretHashHasPrefix applies the HasPrefix predicate on the "secretHash" field.
+func SecretHashHasPrefix(v string) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSecretHash), v))
+	})
+}
+
+// SecretHashHasSuffix applies the HasSuffix predicate on the "secretHash" field.
+func SecretHashHasSuffix(v string) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSecretHash), v))
+	})
+}
+
+// SecretHashEqualFold applies the EqualFold predicate on the "secretHash" field.
+func SecretHashEqualFold(v string) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSecretHash), v))
+	})
+}
+
+// SecretHashContainsFold applies the ContainsFold predicate on the "secretHash" field.
+func SecretHashContainsFold(v string) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSecretHash), v))
+	})
+}
+
+// SecretPrefixEQ applies the EQ predicate on the "secretPrefix" field.
+func SecretPrefixEQ(v string) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSecretPrefix), v))
+	})
+}
+
+// SecretPrefixNEQ applies the NEQ predicate on the "secretPrefix" field.
+func SecretPrefixNEQ(v string) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSecretPrefix), v))
+	})
+}
+
+// SecretPrefixIn applies the In predicate on the "secretPrefix" field.
+func SecretPrefixIn(vs ...string) predicate.Token {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Token(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSecretPrefix), v...))
+	})
+}
+
+// SecretPrefixNotIn applies the NotIn predicate on the "secretPrefix" field.
+func SecretPrefixNotIn(vs ...string) predicate.Token {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Token(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSecretPrefix), v...))
+	})
+}
+
+// SecretPrefixGT applies the GT predicate on the "secretPrefix" field.
+func SecretPrefixGT(v string) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSecretPrefix), v))
+	})
+}
+
+// SecretPrefixGTE applies the GTE predicate on the "secretPrefix" field.
+func SecretPrefixGTE(v string) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSecretPrefix), v))
+	})
+}
+
+// SecretPrefixLT applies the LT predicate on the "secretPrefix" field.
+func SecretPrefixLT(v string) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSecretPrefix), v))
+	})
+}
+
+// SecretPrefixLTE applies the LTE predicate on the "secretPrefix" field.
+func SecretPrefixLTE(v string) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSecretPrefix), v))
+	})
+}
+
+// SecretPrefixContains applies the Contains predicate on the "secretPrefix" field.
+func SecretPrefixContains(v string) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSecretPrefix), v))
+	})
+}
+
+// SecretPrefixHasPrefix applies the HasPrefix predicate on the "secretPrefix" field.
+func SecretPrefixHasPrefix(v string) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSecretPrefix), v))
+	})
+}
+
+// SecretPrefixHasSuffix applies the HasSuffix predicate on the "secretPrefix" field.
+func SecretPrefixHasSuffix(v string) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSecretPrefix), v))
+	})
+}
+
+// SecretPrefixEqualFold applies the EqualFold predicate on the "secretPrefix" field.
+func SecretPrefixEqualFold(v string) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSecretPrefix), v))
+	})
+}
+
+// SecretPrefixContainsFold applies the ContainsFold predicate on the "secretPrefix" field.
+func SecretPrefixContainsFold(v string) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSecretPrefix), v))
+	})
+}
+
 // ExpiryEQ applies the EQ predicate on the "expiry" field.
 func ExpiryEQ(v time.Time) predicate.Token {
 	return predicate.Token(func(s *sql.Selector) {
@@ -488,6 +738,186 @@ func ExpiryNotNil() predicate.Token {
 	})
 }
 
+// LastUsedAtEQ applies the EQ predicate on the "lastUsedAt" field.
+func LastUsedAtEQ(v time.Time) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtNEQ applies the NEQ predicate on the "lastUsedAt" field.
+func LastUsedAtNEQ(v time.Time) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtIn applies the In predicate on the "lastUsedAt" field.
+func LastUsedAtIn(vs ...time.Time) predicate.Token {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Token(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLastUsedAt), v...))
+	})
+}
+
+// LastUsedAtNotIn applies the NotIn predicate on the "lastUsedAt" field.
+func LastUsedAtNotIn(vs ...time.Time) predicate.Token {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Token(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLastUsedAt), v...))
+	})
+}
+
+// LastUsedAtGT applies the GT predicate on the "lastUsedAt" field.
+func LastUsedAtGT(v time.Time) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtGTE applies the GTE predicate on the "lastUsedAt" field.
+func LastUsedAtGTE(v time.Time) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtLT applies the LT predicate on the "lastUsedAt" field.
+func LastUsedAtLT(v time.Time) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtLTE applies the LTE predicate on the "lastUsedAt" field.
+func LastUsedAtLTE(v time.Time) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtIsNil applies the IsNil predicate on the "lastUsedAt" field.
+func LastUsedAtIsNil() predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLastUsedAt)))
+	})
+}
+
+// LastUsedAtNotNil applies the NotNil predicate on the "lastUsedAt" field.
+func LastUsedAtNotNil() predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLastUsedAt)))
+	})
+}
+
+// RevokedAtEQ applies the EQ predicate on the "revokedAt" field.
+func RevokedAtEQ(v time.Time) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRevokedAt), v))
+	})
+}
+
+// RevokedAtNEQ applies the NEQ predicate on the "revokedAt" field.
+func RevokedAtNEQ(v time.Time) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRevokedAt), v))
+	})
+}
+
+// RevokedAtIn applies the In predicate on the "revokedAt" field.
+func RevokedAtIn(vs ...time.Time) predicate.Token {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Token(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRevokedAt), v...))
+	})
+}
+
+// RevokedAtNotIn applies the NotIn predicate on the "revokedAt" field.
+func RevokedAtNotIn(vs ...time.Time) predicate.Token {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Token(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRevokedAt), v...))
+	})
+}
+
+// RevokedAtGT applies the GT predicate on the "revokedAt" field.
+func RevokedAtGT(v time.Time) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRevokedAt), v))
+	})
+}
+
+// RevokedAtGTE applies the GTE predicate on the "revokedAt" field.
+func RevokedAtGTE(v time.Time) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRevokedAt), v))
+	})
+}
+
+// RevokedAtLT applies the LT predicate on the "revokedAt" field.
+func RevokedAtLT(v time.Time) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRevokedAt), v))
+	})
+}
+
+// RevokedAtLTE applies the LTE predicate on the "revokedAt" field.
+func RevokedAtLTE(v time.Time) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRevokedAt), v))
+	})
+}
+
+// RevokedAtIsNil applies the IsNil predicate on the "revokedAt" field.
+func RevokedAtIsNil() predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRevokedAt)))
+	})
+}
+
+// RevokedAtNotNil applies the NotNil predicate on the "revokedAt" field.
+func RevokedAtNotNil() predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRevokedAt)))
+	})
+}
+
 // UserIdEQ applies the EQ predicate on the "userId" field.
 func UserIdEQ(v int) predicate.Token {
 	return predicate.Token(func(s *sql.Selector) {
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/openmesh/booking/ent/organization"
@@ -20,6 +22,7 @@ type TokenCreate struct {
 	config
 	mutation *TokenMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "createdAt" field.
@@ -56,6 +59,24 @@ func (tc *TokenCreate) SetName(s string) *TokenCreate {
 	return tc
 }
 
+// SetSecretHash sets the "secretHash" field.
+func (tc *TokenCreate) SetSecretHash(s string) *TokenCreate {
+	tc.mutation.SetSecretHash(s)
+	return tc
+}
+
+// SetSecretPrefix sets the "secretPrefix" field.
+func (tc *TokenCreate) SetSecretPrefix(s string) *TokenCreate {
+	tc.mutation.SetSecretPrefix(s)
+	return tc
+}
+
+// SetScopes sets the "scopes" field.
+func (tc *TokenCreate) SetScopes(s []string) *TokenCreate {
+	tc.mutation.SetScopes(s)
+	return tc
+}
+
 // SetExpiry sets the "expiry" field.
 func (tc *TokenCreate) SetExpiry(t time.Time) *TokenCreate {
 	tc.mutation.SetExpiry(t)
@@ -70,6 +91,34 @@ func (tc *TokenCreate) SetNillableExpiry(t *time.Time) *TokenCreate {
 	return tc
 }
 
+// SetLastUsedAt sets the "lastUsedAt" field.
+func (tc *TokenCreate) SetLastUsedAt(t time.Time) *TokenCreate {
+	tc.mutation.SetLastUsedAt(t)
+	return tc
+}
+
+// SetNillableLastUsedAt sets the "lastUsedAt" field if the given value is not nil.
+func (tc *TokenCreate) SetNillableLastUsedAt(t *time.Time) *TokenCreate {
+	if t != nil {
+		tc.SetLastUsedAt(*t)
+	}
+	return tc
+}
+
+// SetRevokedAt sets the "revokedAt" field.
+func (tc *TokenCreate) SetRevokedAt(t time.Time) *TokenCreate {
+	tc.mutation.SetRevokedAt(t)
+	return tc
+}
+
+// SetNillableRevokedAt sets the "revokedAt" field if the given value is not nil.
+func (tc *TokenCreate) SetNillableRevokedAt(t *time.Time) *TokenCreate {
+	if t != nil {
+		tc.SetRevokedAt(*t)
+	}
+	return tc
+}
+
 // SetUserId sets the "userId" field.
 func (tc *TokenCreate) SetUserId(i int) *TokenCreate {
 	tc.mutation.SetUserId(i)
@@ -206,7 +255,10 @@ func (tc *TokenCreate) defaults() error {
 		tc.mutation.SetUpdatedAt(v)
 	}
 	if _, ok := tc.mutation.ID(); !ok {
-		v := token.DefaultID
+		if token.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized token.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := token.DefaultID()
 		tc.mutation.SetID(v)
 	}
 	return nil
@@ -223,6 +275,15 @@ func (tc *TokenCreate) check() error {
 	if _, ok := tc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "name"`)}
 	}
+	if _, ok := tc.mutation.SecretHash(); !ok {
+		return &ValidationError{Name: "secretHash", err: errors.New(`ent: missing required field "secretHash"`)}
+	}
+	if _, ok := tc.mutation.SecretPrefix(); !ok {
+		return &ValidationError{Name: "secretPrefix", err: errors.New(`ent: missing required field "secretPrefix"`)}
+	}
+	if _, ok := tc.mutation.Scopes(); !ok {
+		return &ValidationError{Name: "scopes", err: errors.New(`ent: missing required field "scopes"`)}
+	}
 	if _, ok := tc.mutation.UserId(); !ok {
 		return &ValidationError{Name: "userId", err: errors.New(`ent: missing required field "userId"`)}
 	}
@@ -263,6 +324,7 @@ func (tc *TokenCreate) createSpec() (*Token, *sqlgraph.CreateSpec) {
 			},
 		}
 	)
+	_spec.OnConflict = tc.conflict
 	if id, ok := tc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -291,6 +353,30 @@ func (tc *TokenCreate) createSpec() (*Token, *sqlgraph.CreateSpec) {
 		})
 		_node.Name = value
 	}
+	if value, ok := tc.mutation.SecretHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: token.FieldSecretHash,
+		})
+		_node.SecretHash = value
+	}
+	if value, ok := tc.mutation.SecretPrefix(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: token.FieldSecretPrefix,
+		})
+		_node.SecretPrefix = value
+	}
+	if value, ok := tc.mutation.Scopes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: token.FieldScopes,
+		})
+		_node.Scopes = value
+	}
 	if value, ok := tc.mutation.Expiry(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -299,6 +385,22 @@ func (tc *TokenCreate) createSpec() (*Token, *sqlgraph.CreateSpec) {
 		})
 		_node.Expiry = &value
 	}
+	if value, ok := tc.mutation.LastUsedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: token.FieldLastUsedAt,
+		})
+		_node.LastUsedAt = &value
+	}
+	if value, ok := tc.mutation.RevokedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: token.FieldRevokedAt,
+		})
+		_node.RevokedAt = &value
+	}
 	if nodes := tc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -342,10 +444,471 @@ func (tc *TokenCreate) createSpec() (*Token, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Token.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TokenUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (tc *TokenCreate) OnConflict(opts ...sql.ConflictOption) *TokenUpsertOne {
+	tc.conflict = opts
+	return &TokenUpsertOne{
+		create: tc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Token.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tc *TokenCreate) OnConflictColumns(columns ...string) *TokenUpsertOne {
+	tc.conflict = append(tc.conflict, sql.ConflictColumns(columns...))
+	return &TokenUpsertOne{
+		create: tc,
+	}
+}
+
+type (
+	// TokenUpsertOne is the builder for "upsert"-ing
+	//  one Token node.
+	TokenUpsertOne struct {
+		create *TokenCreate
+	}
+
+	// TokenUpsert is the "OnConflict" setter.
+	TokenUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *TokenUpsert) SetCreatedAt(v time.Time) *TokenUpsert {
+	u.Set(token.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *TokenUpsert) UpdateCreatedAt() *TokenUpsert {
+	u.SetExcluded(token.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *TokenUpsert) SetUpdatedAt(v time.Time) *TokenUpsert {
+	u.Set(token.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *TokenUpsert) UpdateUpdatedAt() *TokenUpsert {
+	u.SetExcluded(token.FieldUpdatedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TokenUpsert) SetName(v string) *TokenUpsert {
+	u.Set(token.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TokenUpsert) UpdateName() *TokenUpsert {
+	u.SetExcluded(token.FieldName)
+	return u
+}
+
+// SetSecretHash sets the "secretHash" field.
+func (u *TokenUpsert) SetSecretHash(v string) *TokenUpsert {
+	u.Set(token.FieldSecretHash, v)
+	return u
+}
+
+// UpdateSecretHash sets the "secretHash" field to the value that was provided on create.
+func (u *TokenUpsert) UpdateSecretHash() *TokenUpsert {
+	u.SetExcluded(token.FieldSecretHash)
+	return u
+}
+
+// SetSecretPrefix sets the "secretPrefix" field.
+func (u *TokenUpsert) SetSecretPrefix(v string) *TokenUpsert {
+	u.Set(token.FieldSecretPrefix, v)
+	return u
+}
+
+// UpdateSecretPrefix sets the "secretPrefix" field to the value that was provided on create.
+func (u *TokenUpsert) UpdateSecretPrefix() *TokenUpsert {
+	u.SetExcluded(token.FieldSecretPrefix)
+	return u
+}
+
+// SetScopes sets the "scopes" field.
+func (u *TokenUpsert) SetScopes(v []string) *TokenUpsert {
+	u.Set(token.FieldScopes, v)
+	return u
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *TokenUpsert) UpdateScopes() *TokenUpsert {
+	u.SetExcluded(token.FieldScopes)
+	return u
+}
+
+// SetExpiry sets the "expiry" field.
+func (u *TokenUpsert) SetExpiry(v time.Time) *TokenUpsert {
+	u.Set(token.FieldExpiry, v)
+	return u
+}
+
+// UpdateExpiry sets the "expiry" field to the value that was provided on create.
+func (u *TokenUpsert) UpdateExpiry() *TokenUpsert {
+	u.SetExcluded(token.FieldExpiry)
+	return u
+}
+
+// ClearExpiry clears the value of the "expiry" field.
+func (u *TokenUpsert) ClearExpiry() *TokenUpsert {
+	u.SetNull(token.FieldExpiry)
+	return u
+}
+
+// SetLastUsedAt sets the "lastUsedAt" field.
+func (u *TokenUpsert) SetLastUsedAt(v time.Time) *TokenUpsert {
+	u.Set(token.FieldLastUsedAt, v)
+	return u
+}
+
+// UpdateLastUsedAt sets the "lastUsedAt" field to the value that was provided on create.
+func (u *TokenUpsert) UpdateLastUsedAt() *TokenUpsert {
+	u.SetExcluded(token.FieldLastUsedAt)
+	return u
+}
+
+// ClearLastUsedAt clears the value of the "lastUsedAt" field.
+func (u *TokenUpsert) ClearLastUsedAt() *TokenUpsert {
+	u.SetNull(token.FieldLastUsedAt)
+	return u
+}
+
+// SetRevokedAt sets the "revokedAt" field.
+func (u *TokenUpsert) SetRevokedAt(v time.Time) *TokenUpsert {
+	u.Set(token.FieldRevokedAt, v)
+	return u
+}
+
+// UpdateRevokedAt sets the "revokedAt" field to the value that was provided on create.
+func (u *TokenUpsert) UpdateRevokedAt() *TokenUpsert {
+	u.SetExcluded(token.FieldRevokedAt)
+	return u
+}
+
+// ClearRevokedAt clears the value of the "revokedAt" field.
+func (u *TokenUpsert) ClearRevokedAt() *TokenUpsert {
+	u.SetNull(token.FieldRevokedAt)
+	return u
+}
+
+// SetUserId sets the "userId" field.
+func (u *TokenUpsert) SetUserId(v int) *TokenUpsert {
+	u.Set(token.FieldUserId, v)
+	return u
+}
+
+// UpdateUserId sets the "userId" field to the value that was provided on create.
+func (u *TokenUpsert) UpdateUserId() *TokenUpsert {
+	u.SetExcluded(token.FieldUserId)
+	return u
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (u *TokenUpsert) SetOrganizationId(v int) *TokenUpsert {
+	u.Set(token.FieldOrganizationId, v)
+	return u
+}
+
+// UpdateOrganizationId sets the "organizationId" field to the value that was provided on create.
+func (u *TokenUpsert) UpdateOrganizationId() *TokenUpsert {
+	u.SetExcluded(token.FieldOrganizationId)
+	return u
+}
+
+// UpdateNewValues updates the fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Token.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(token.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TokenUpsertOne) UpdateNewValues() *TokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(token.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Token.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TokenUpsertOne) Ignore() *TokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TokenUpsertOne) DoNothing() *TokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TokenCreate.OnConflict
+// documentation for more info.
+func (u *TokenUpsertOne) Update(set func(*TokenUpsert)) *TokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *TokenUpsertOne) SetCreatedAt(v time.Time) *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *TokenUpsertOne) UpdateCreatedAt() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *TokenUpsertOne) SetUpdatedAt(v time.Time) *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *TokenUpsertOne) UpdateUpdatedAt() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *TokenUpsertOne) SetName(v string) *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TokenUpsertOne) UpdateName() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetSecretHash sets the "secretHash" field.
+func (u *TokenUpsertOne) SetSecretHash(v string) *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetSecretHash(v)
+	})
+}
+
+// UpdateSecretHash sets the "secretHash" field to the value that was provided on create.
+func (u *TokenUpsertOne) UpdateSecretHash() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateSecretHash()
+	})
+}
+
+// SetSecretPrefix sets the "secretPrefix" field.
+func (u *TokenUpsertOne) SetSecretPrefix(v string) *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetSecretPrefix(v)
+	})
+}
+
+// UpdateSecretPrefix sets the "secretPrefix" field to the value that was provided on create.
+func (u *TokenUpsertOne) UpdateSecretPrefix() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateSecretPrefix()
+	})
+}
+
+// SetScopes sets the "scopes" field.
+func (u *TokenUpsertOne) SetScopes(v []string) *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *TokenUpsertOne) UpdateScopes() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// SetExpiry sets the "expiry" field.
+func (u *TokenUpsertOne) SetExpiry(v time.Time) *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetExpiry(v)
+	})
+}
+
+// UpdateExpiry sets the "expiry" field to the value that was provided on create.
+func (u *TokenUpsertOne) UpdateExpiry() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateExpiry()
+	})
+}
+
+// ClearExpiry clears the value of the "expiry" field.
+func (u *TokenUpsertOne) ClearExpiry() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.ClearExpiry()
+	})
+}
+
+// SetLastUsedAt sets the "lastUsedAt" field.
+func (u *TokenUpsertOne) SetLastUsedAt(v time.Time) *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "lastUsedAt" field to the value that was provided on create.
+func (u *TokenUpsertOne) UpdateLastUsedAt() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "lastUsedAt" field.
+func (u *TokenUpsertOne) ClearLastUsedAt() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// SetRevokedAt sets the "revokedAt" field.
+func (u *TokenUpsertOne) SetRevokedAt(v time.Time) *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revokedAt" field to the value that was provided on create.
+func (u *TokenUpsertOne) UpdateRevokedAt() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revokedAt" field.
+func (u *TokenUpsertOne) ClearRevokedAt() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// SetUserId sets the "userId" field.
+func (u *TokenUpsertOne) SetUserId(v int) *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetUserId(v)
+	})
+}
+
+// UpdateUserId sets the "userId" field to the value that was provided on create.
+func (u *TokenUpsertOne) UpdateUserId() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateUserId()
+	})
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (u *TokenUpsertOne) SetOrganizationId(v int) *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetOrganizationId(v)
+	})
+}
+
+// UpdateOrganizationId sets the "organizationId" field to the value that was provided on create.
+func (u *TokenUpsertOne) UpdateOrganizationId() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateOrganizationId()
+	})
+}
+
+// Exec executes the query.
+func (u *TokenUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TokenCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TokenUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TokenUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TokenUpsertOne.ID is not supported by MySQL driver. Use TokenUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TokenUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // TokenCreateBulk is the builder for creating many Token entities in bulk.
 type TokenCreateBulk struct {
 	config
 	builders []*TokenCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Token entities in the database.
@@ -372,6 +935,7 @@ func (tcb *TokenCreateBulk) Save(ctx context.Context) ([]*Token, error) {
 					_, err = mutators[i+1].Mutate(root, tcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -421,3 +985,290 @@ func (tcb *TokenCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Token.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TokenUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (tcb *TokenCreateBulk) OnConflict(opts ...sql.ConflictOption) *TokenUpsertBulk {
+	tcb.conflict = opts
+	return &TokenUpsertBulk{
+		create: tcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Token.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tcb *TokenCreateBulk) OnConflictColumns(columns ...string) *TokenUpsertBulk {
+	tcb.conflict = append(tcb.conflict, sql.ConflictColumns(columns...))
+	return &TokenUpsertBulk{
+		create: tcb,
+	}
+}
+
+// TokenUpsertBulk is the builder for "upsert"-ing
+// a bulk of Token nodes.
+type TokenUpsertBulk struct {
+	create *TokenCreateBulk
+}
+
+// UpdateNewValues updates the fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Token.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(token.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TokenUpsertBulk) UpdateNewValues() *TokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(token.FieldID)
+				return
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Token.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TokenUpsertBulk) Ignore() *TokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TokenUpsertBulk) DoNothing() *TokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TokenCreateBulk.OnConflict
+// documentation for more info.
+func (u *TokenUpsertBulk) Update(set func(*TokenUpsert)) *TokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *TokenUpsertBulk) SetCreatedAt(v time.Time) *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *TokenUpsertBulk) UpdateCreatedAt() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *TokenUpsertBulk) SetUpdatedAt(v time.Time) *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *TokenUpsertBulk) UpdateUpdatedAt() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *TokenUpsertBulk) SetName(v string) *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TokenUpsertBulk) UpdateName() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetSecretHash sets the "secretHash" field.
+func (u *TokenUpsertBulk) SetSecretHash(v string) *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetSecretHash(v)
+	})
+}
+
+// UpdateSecretHash sets the "secretHash" field to the value that was provided on create.
+func (u *TokenUpsertBulk) UpdateSecretHash() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateSecretHash()
+	})
+}
+
+// SetSecretPrefix sets the "secretPrefix" field.
+func (u *TokenUpsertBulk) SetSecretPrefix(v string) *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetSecretPrefix(v)
+	})
+}
+
+// UpdateSecretPrefix sets the "secretPrefix" field to the value that was provided on create.
+func (u *TokenUpsertBulk) UpdateSecretPrefix() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateSecretPrefix()
+	})
+}
+
+// SetScopes sets the "scopes" field.
+func (u *TokenUpsertBulk) SetScopes(v []string) *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *TokenUpsertBulk) UpdateScopes() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// SetExpiry sets the "expiry" field.
+func (u *TokenUpsertBulk) SetExpiry(v time.Time) *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetExpiry(v)
+	})
+}
+
+// UpdateExpiry sets the "expiry" field to the value that was provided on create.
+func (u *TokenUpsertBulk) UpdateExpiry() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateExpiry()
+	})
+}
+
+// ClearExpiry clears the value of the "expiry" field.
+func (u *TokenUpsertBulk) ClearExpiry() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.ClearExpiry()
+	})
+}
+
+// SetLastUsedAt sets the "lastUsedAt" field.
+func (u *TokenUpsertBulk) SetLastUsedAt(v time.Time) *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "lastUsedAt" field to the value that was provided on create.
+func (u *TokenUpsertBulk) UpdateLastUsedAt() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "lastUsedAt" field.
+func (u *TokenUpsertBulk) ClearLastUsedAt() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// SetRevokedAt sets the "revokedAt" field.
+func (u *TokenUpsertBulk) SetRevokedAt(v time.Time) *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revokedAt" field to the value that was provided on create.
+func (u *TokenUpsertBulk) UpdateRevokedAt() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revokedAt" field.
+func (u *TokenUpsertBulk) ClearRevokedAt() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// SetUserId sets the "userId" field.
+func (u *TokenUpsertBulk) SetUserId(v int) *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetUserId(v)
+	})
+}
+
+// UpdateUserId sets the "userId" field to the value that was provided on create.
+func (u *TokenUpsertBulk) UpdateUserId() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateUserId()
+	})
+}
+
+// SetOrganizationId sets the "organizationId" field.
+func (u *TokenUpsertBulk) SetOrganizationId(v int) *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetOrganizationId(v)
+	})
+}
+
+// UpdateOrganizationId sets the "organizationId" field to the value that was provided on create.
+func (u *TokenUpsertBulk) UpdateOrganizationId() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateOrganizationId()
+	})
+}
+
+// Exec executes the query.
+func (u *TokenUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TokenCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TokenCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TokenUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,239 @@
+package ent
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/privacy"
+	"github.com/openmesh/booking"
+	"github.com/openmesh/booking/ent/token"
+	"github.com/openmesh/booking/rand"
+)
+
+// tokenLastUsedPrecision is how often the last used time of a token is
+// updated. Recording every use would mean a write for every API request.
+const tokenLastUsedPrecision = time.Minute
+
+type tokenService struct {
+	client *Client
+}
+
+// NewTokenService constructs a new instance of a booking.TokenService using
+// ent as its persistence layer.
+func NewTokenService(client *Client) *tokenService {
+	return &tokenService{client}
+}
+
+// CreateToken creates a new token for the current user and organization. A
+// token can't be granted scopes that the requester doesn't have themselves.
+func (s *tokenService) CreateToken(
+	ctx context.Context,
+	req booking.CreateTokenRequest,
+) booking.CreateTokenResponse {
+	userID := booking.UserIDFromContext(ctx)
+	orgID := booking.OrganizationIDFromContext(ctx)
+	if userID == 0 || orgID == 0 {
+		return booking.CreateTokenResponse{
+			Err: booking.Errorf(booking.EUNAUTHORIZED, "You must be signed in to create a token."),
+		}
+	}
+	for _, scope := range req.Scopes {
+		if !booking.HasScope(ctx, scope) {
+			return booking.CreateTokenResponse{
+				Err: booking.Errorf(booking.EFORBIDDEN, "Cannot grant scope '%s' that you have not been granted.", scope),
+			}
+		}
+	}
+
+	secret := booking.TokenSecretPrefix + rand.Key()
+	q := s.client.Token.
+		Create().
+		SetName(req.Name).
+		SetSecretHash(hashTokenSecret(secret)).
+		SetSecretPrefix(secret[:len(booking.TokenSecretPrefix)+4]).
+		SetScopes(req.Scopes).
+		SetUserID(userID).
+		SetOrganizationID(orgID)
+	if req.Expiry != nil {
+		q.SetExpiry(*req.Expiry)
+	}
+
+	t, err := q.Save(ctx)
+	if err != nil {
+		return booking.CreateTokenResponse{
+			Err: fmt.Errorf("failed to create token: %w", err),
+		}
+	}
+
+	return booking.CreateTokenResponse{
+		Token:  t.toModel(),
+		Secret: secret,
+	}
+}
+
+// FindTokens retrieves the tokens of the current user within the current
+// organization.
+func (s *tokenService) FindTokens(
+	ctx context.Context,
+	req booking.FindTokensRequest,
+) booking.FindTokensResponse {
+	q := s.client.Token.Query()
+	if !req.IncludeRevoked {
+		q.Where(token.RevokedAtIsNil())
+	}
+
+	c, err := q.Clone().Count(ctx)
+	if err != nil {
+		return booking.FindTokensResponse{
+			Err: fmt.Errorf("failed to count tokens: %w", err),
+		}
+	}
+
+	q = q.Offset(req.Offset)
+	if req.Limit == 0 {
+		q.Limit(10)
+	} else {
+		q.Limit(req.Limit)
+	}
+
+	t, err := q.Order(Desc(token.FieldCreatedAt)).All(ctx)
+	if err != nil {
+		return booking.FindTokensResponse{
+			Err: fmt.Errorf("failed to query tokens: %w", err),
+		}
+	}
+
+	return booking.FindTokensResponse{
+		Tokens:     Tokens(t).toModels(),
+		TotalItems: c,
+	}
+}
+
+// RevokeToken prevents a token from being used again. Revoking a token that
+// has already been revoked has no effect.
+func (s *tokenService) RevokeToken(
+	ctx context.Context,
+	req booking.RevokeTokenRequest,
+) booking.RevokeTokenResponse {
+	a, err := s.client.Token.
+		Update().
+		Where(token.ID(req.ID), token.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return booking.RevokeTokenResponse{
+			Err: fmt.Errorf("failed to revoke token: %w", err),
+		}
+	}
+	if a == 0 {
+		// Distinguish between tokens that don't exist and ones that have
+		// already been revoked.
+		exists, err := s.client.Token.Query().Where(token.ID(req.ID)).Exist(ctx)
+		if err != nil {
+			return booking.RevokeTokenResponse{
+				Err: fmt.Errorf("failed to find token: %w", err),
+			}
+		}
+		if !exists {
+			return booking.RevokeTokenResponse{
+				Err: booking.Errorf(booking.ENOTFOUND, "Could not find token with ID %s", req.ID),
+			}
+		}
+	}
+	return booking.RevokeTokenResponse{}
+}
+
+// AuthenticateToken looks up the token that a secret belongs to. Nobody is
+// authenticated at this point so privacy rules are bypassed.
+func (s *tokenService) AuthenticateToken(
+	ctx context.Context,
+	req booking.AuthenticateTokenRequest,
+) booking.AuthenticateTokenResponse {
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	t, err := s.client.Token.
+		Query().
+		Where(token.SecretHash(hashTokenSecret(req.Secret))).
+		WithUser(func(uq *UserQuery) {
+			uq.WithOrganization()
+		}).
+		WithOrganization().
+		Only(ctx)
+	var nfe *NotFoundError
+	if errors.As(err, &nfe) {
+		return booking.AuthenticateTokenResponse{
+			Err: booking.Errorf(booking.EUNAUTHORIZED, "Invalid API token."),
+		}
+	}
+	if err != nil {
+		return booking.AuthenticateTokenResponse{
+			Err: fmt.Errorf("failed to find token: %w", err),
+		}
+	}
+
+	now := time.Now()
+	if t.RevokedAt != nil {
+		return booking.AuthenticateTokenResponse{
+			Err: booking.Errorf(booking.EUNAUTHORIZED, "API token has been revoked."),
+		}
+	}
+	if t.Expiry != nil && !t.Expiry.After(now) {
+		return booking.AuthenticateTokenResponse{
+			Err: booking.Errorf(booking.EUNAUTHORIZED, "API token has expired."),
+		}
+	}
+
+	if t.LastUsedAt == nil || now.Sub(*t.LastUsedAt) >= tokenLastUsedPrecision {
+		edges := t.Edges
+		t, err = t.Update().SetLastUsedAt(now).Save(ctx)
+		if err != nil {
+			return booking.AuthenticateTokenResponse{
+				Err: fmt.Errorf("failed to record token use: %w", err),
+			}
+		}
+		t.Edges = edges
+	}
+
+	return booking.AuthenticateTokenResponse{Token: t.toModel()}
+}
+
+// hashTokenSecret returns the hex encoded SHA-256 hash of a token secret.
+// Secrets are long and random so a fast hash is sufficient.
+func hashTokenSecret(secret string) string {
+	h := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(h[:])
+}
+
+func (t *Token) toModel() *booking.Token {
+	result := &booking.Token{
+		ID:             t.ID,
+		Name:           t.Name,
+		SecretPrefix:   t.SecretPrefix,
+		Scopes:         t.Scopes,
+		Expiry:         t.Expiry,
+		LastUsedAt:     t.LastUsedAt,
+		RevokedAt:      t.RevokedAt,
+		UserID:         t.UserId,
+		OrganizationID: t.OrganizationId,
+		CreatedAt:      t.CreatedAt,
+		UpdatedAt:      t.UpdatedAt,
+	}
+	if t.Edges.User != nil {
+		result.User = t.Edges.User.toModel()
+	}
+	if t.Edges.Organization != nil {
+		result.Organization = t.Edges.Organization.toModel()
+	}
+	return result
+}
+
+func (t Tokens) toModels() []*booking.Token {
+	var tokens []*booking.Token
+	for _, v := range t {
+		tokens = append(tokens, v.toModel())
+	}
+	return tokens
+}
@@ -36,6 +36,46 @@ func (tu *TokenUpdate) SetUpdatedAt(t time.Time) *TokenUpdate {
 	return tu
 }
 
+// SetLastUsedAt sets the "lastUsedAt" field.
+func (tu *TokenUpdate) SetLastUsedAt(t time.Time) *TokenUpdate {
+	tu.mutation.SetLastUsedAt(t)
+	return tu
+}
+
+// SetNillableLastUsedAt sets the "lastUsedAt" field if the given value is not nil.
+func (tu *TokenUpdate) SetNillableLastUsedAt(t *time.Time) *TokenUpdate {
+	if t != nil {
+		tu.SetLastUsedAt(*t)
+	}
+	return tu
+}
+
+// ClearLastUsedAt clears the value of the "lastUsedAt" field.
+func (tu *TokenUpdate) ClearLastUsedAt() *TokenUpdate {
+	tu.mutation.ClearLastUsedAt()
+	return tu
+}
+
+// SetRevokedAt sets the "revokedAt" field.
+func (tu *TokenUpdate) SetRevokedAt(t time.Time) *TokenUpdate {
+	tu.mutation.SetRevokedAt(t)
+	return tu
+}
+
+// SetNillableRevokedAt sets the "revokedAt" field if the given value is not nil.
+func (tu *TokenUpdate) SetNillableRevokedAt(t *time.Time) *TokenUpdate {
+	if t != nil {
+		tu.SetRevokedAt(*t)
+	}
+	return tu
+}
+
+// ClearRevokedAt clears the value of the "revokedAt" field.
+func (tu *TokenUpdate) ClearRevokedAt() *TokenUpdate {
+	tu.mutation.ClearRevokedAt()
+	return tu
+}
+
 // SetUserId sets the "userId" field.
 func (tu *TokenUpdate) SetUserId(i int) *TokenUpdate {
 	tu.mutation.SetUserId(i)
@@ -204,6 +244,32 @@ func (tu *TokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: token.FieldExpiry,
 		})
 	}
+	if value, ok := tu.mutation.LastUsedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: token.FieldLastUsedAt,
+		})
+	}
+	if tu.mutation.LastUsedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: token.FieldLastUsedAt,
+		})
+	}
+	if value, ok := tu.mutation.RevokedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: token.FieldRevokedAt,
+		})
+	}
+	if tu.mutation.RevokedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: token.FieldRevokedAt,
+		})
+	}
 	if tu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -299,6 +365,46 @@ func (tuo *TokenUpdateOne) SetUpdatedAt(t time.Time) *TokenUpdateOne {
 	return tuo
 }
 
+// SetLastUsedAt sets the "lastUsedAt" field.
+func (tuo *TokenUpdateOne) SetLastUsedAt(t time.Time) *TokenUpdateOne {
+	tuo.mutation.SetLastUsedAt(t)
+	return tuo
+}
+
+// SetNillableLastUsedAt sets the "lastUsedAt" field if the given value is not nil.
+func (tuo *TokenUpdateOne) SetNillableLastUsedAt(t *time.Time) *TokenUpdateOne {
+	if t != nil {
+		tuo.SetLastUsedAt(*t)
+	}
+	return tuo
+}
+
+// ClearLastUsedAt clears the value of the "lastUsedAt" field.
+func (tuo *TokenUpdateOne) ClearLastUsedAt() *TokenUpdateOne {
+	tuo.mutation.ClearLastUsedAt()
+	return tuo
+}
+
+// SetRevokedAt sets the "revokedAt" field.
+func (tuo *TokenUpdateOne) SetRevokedAt(t time.Time) *TokenUpdateOne {
+	tuo.mutation.SetRevokedAt(t)
+	return tuo
+}
+
+// SetNillableRevokedAt sets the "revokedAt" field if the given value is not nil.
+func (tuo *TokenUpdateOne) SetNillableRevokedAt(t *time.Time) *TokenUpdateOne {
+	if t != nil {
+		tuo.SetRevokedAt(*t)
+	}
+	return tuo
+}
+
+// ClearRevokedAt clears the value of the "revokedAt" field.
+func (tuo *TokenUpdateOne) ClearRevokedAt() *TokenUpdateOne {
+	tuo.mutation.ClearRevokedAt()
+	return tuo
+}
+
 // SetUserId sets the "userId" field.
 func (tuo *TokenUpdateOne) SetUserId(i int) *TokenUpdateOne {
 	tuo.mutation.SetUserId(i)
@@ -491,6 +597,32 @@ func (tuo *TokenUpdateOne) sqlSave(ctx context.Context) (_node *Token, err error
 			Column: token.FieldExpiry,
 		})
 	}
+	if value, ok := tuo.mutation.LastUsedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: token.FieldLastUsedAt,
+		})
+	}
+	if tuo.mutation.LastUsedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: token.FieldLastUsedAt,
+		})
+	}
+	if value, ok := tuo.mutation.RevokedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: token.FieldRevokedAt,
+		})
+	}
+	if tuo.mutation.RevokedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: token.FieldRevokedAt,
+		})
+	}
 	if tuo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -18,20 +18,40 @@ type Tx struct {
 	Booking *BookingClient
 	// BookingMetadatum is the client for interacting with the BookingMetadatum builders.
 	BookingMetadatum *BookingMetadatumClient
+	// BookingSeries is the client for interacting with the BookingSeries builders.
+	BookingSeries *BookingSeriesClient
+	// Customer is the client for interacting with the Customer builders.
+	Customer *CustomerClient
+	// Invoice is the client for interacting with the Invoice builders.
+	Invoice *InvoiceClient
 	// Organization is the client for interacting with the Organization builders.
 	Organization *OrganizationClient
 	// OrganizationOwnership is the client for interacting with the OrganizationOwnership builders.
 	OrganizationOwnership *OrganizationOwnershipClient
+	// Payment is the client for interacting with the Payment builders.
+	Payment *PaymentClient
+	// PromoCode is the client for interacting with the PromoCode builders.
+	PromoCode *PromoCodeClient
+	// PromoRedemption is the client for interacting with the PromoRedemption builders.
+	PromoRedemption *PromoRedemptionClient
 	// Resource is the client for interacting with the Resource builders.
 	Resource *ResourceClient
 	// Slot is the client for interacting with the Slot builders.
 	Slot *SlotClient
+	// TaxRate is the client for interacting with the TaxRate builders.
+	TaxRate *TaxRateClient
 	// Token is the client for interacting with the Token builders.
 	Token *TokenClient
 	// Unavailability is the client for interacting with the Unavailability builders.
 	Unavailability *UnavailabilityClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// WaitlistEntry is the client for interacting with the WaitlistEntry builders.
+	WaitlistEntry *WaitlistEntryClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -170,13 +190,23 @@ func (tx *Tx) init() {
 	tx.Auth = NewAuthClient(tx.config)
 	tx.Booking = NewBookingClient(tx.config)
 	tx.BookingMetadatum = NewBookingMetadatumClient(tx.config)
+	tx.BookingSeries = NewBookingSeriesClient(tx.config)
+	tx.Customer = NewCustomerClient(tx.config)
+	tx.Invoice = NewInvoiceClient(tx.config)
 	tx.Organization = NewOrganizationClient(tx.config)
 	tx.OrganizationOwnership = NewOrganizationOwnershipClient(tx.config)
+	tx.Payment = NewPaymentClient(tx.config)
+	tx.PromoCode = NewPromoCodeClient(tx.config)
+	tx.PromoRedemption = NewPromoRedemptionClient(tx.config)
 	tx.Resource = NewResourceClient(tx.config)
 	tx.Slot = NewSlotClient(tx.config)
+	tx.TaxRate = NewTaxRateClient(tx.config)
 	tx.Token = NewTokenClient(tx.config)
 	tx.Unavailability = NewUnavailabilityClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.WaitlistEntry = NewWaitlistEntryClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -27,6 +27,8 @@ type Unavailability struct {
 	EndTime time.Time `json:"endTime,omitempty"`
 	// ResourceId holds the value of the "resourceId" field.
 	ResourceId int `json:"resourceId,omitempty"`
+	// ExternalId holds the value of the "externalId" field.
+	ExternalId string `json:"externalId,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UnavailabilityQuery when eager-loading is set.
 	Edges UnavailabilityEdges `json:"edges"`
@@ -62,6 +64,8 @@ func (*Unavailability) scanValues(columns []string) ([]interface{}, error) {
 		switch columns[i] {
 		case unavailability.FieldID, unavailability.FieldResourceId:
 			values[i] = new(sql.NullInt64)
+		case unavailability.FieldExternalId:
+			values[i] = new(sql.NullString)
 		case unavailability.FieldCreatedAt, unavailability.FieldUpdatedAt, unavailability.FieldStartTime, unavailability.FieldEndTime:
 			values[i] = new(sql.NullTime)
 		default:
@@ -115,6 +119,12 @@ func (u *Unavailability) assignValues(columns []string, values []interface{}) er
 			} else if value.Valid {
 				u.ResourceId = int(value.Int64)
 			}
+		case unavailability.FieldExternalId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field externalId", values[i])
+			} else if value.Valid {
+				u.ExternalId = value.String
+			}
 		}
 	}
 	return nil
@@ -158,6 +168,8 @@ func (u *Unavailability) String() string {
 	builder.WriteString(u.EndTime.Format(time.ANSIC))
 	builder.WriteString(", resourceId=")
 	builder.WriteString(fmt.Sprintf("%v", u.ResourceId))
+	builder.WriteString(", externalId=")
+	builder.WriteString(u.ExternalId)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -23,6 +23,8 @@ const (
 	FieldEndTime = "end_time"
 	// FieldResourceId holds the string denoting the resourceid field in the database.
 	FieldResourceId = "resource_id"
+	// FieldExternalId holds the string denoting the externalid field in the database.
+	FieldExternalId = "external_id"
 	// EdgeResource holds the string denoting the resource edge name in mutations.
 	EdgeResource = "resource"
 	// Table holds the table name of the unavailability in the database.
@@ -44,6 +46,7 @@ var Columns = []string{
 	FieldStartTime,
 	FieldEndTime,
 	FieldResourceId,
+	FieldExternalId,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -128,6 +128,13 @@ func ResourceId(v int) predicate.Unavailability {
 	})
 }
 
+// ExternalId applies equality check predicate on the "externalId" field. It's identical to ExternalIdEQ.
+func ExternalId(v string) predicate.Unavailability {
+	return predicate.Unavailability(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExternalId), v))
+	})
+}
+
 // CreatedAtEQ applies the EQ predicate on the "createdAt" field.
 func CreatedAtEQ(v time.Time) predicate.Unavailability {
 	return predicate.Unavailability(func(s *sql.Selector) {
@@ -480,6 +487,131 @@ func ResourceIdNotIn(vs ...int) predicate.Unavailability {
 	})
 }
 
+// ExternalIdEQ applies the EQ predicate on the "externalId" field.
+func ExternalIdEQ(v string) predicate.Unavailability {
+	return predicate.Unavailability(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExternalId), v))
+	})
+}
+
+// ExternalIdNEQ applies the NEQ predicate on the "externalId" field.
+func ExternalIdNEQ(v string) predicate.Unavailability {
+	return predicate.Unavailability(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExternalId), v))
+	})
+}
+
+// ExternalIdIn applies the In predicate on the "externalId" field.
+func ExternalIdIn(vs ...string) predicate.Unavailability {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Unavailability(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExternalId), v...))
+	})
+}
+
+// ExternalIdNotIn applies the NotIn predicate on the "externalId" field.
+func ExternalIdNotIn(vs ...string) predicate.Unavailability {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Unavailability(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExternalId), v...))
+	})
+}
+
+// ExternalIdGT applies the GT predicate on the "externalId" field.
+func ExternalIdGT(v string) predicate.Unavailability {
+	return predicate.Unavailability(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExternalId), v))
+	})
+}
+
+// ExternalIdGTE applies the GTE predicate on the "externalId" field.
+func ExternalIdGTE(v string) predicate.Unavailability {
+	return predicate.Unavailability(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExternalId), v))
+	})
+}
+
+// ExternalIdLT applies the LT predicate on the "externalId" field.
+func ExternalIdLT(v string) predicate.Unavailability {
+	return predicate.Unavailability(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExternalId), v))
+	})
+}
+
+// ExternalIdLTE applies the LTE predicate on the "externalId" field.
+func ExternalIdLTE(v string) predicate.Unavailability {
+	return predicate.Unavailability(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExternalId), v))
+	})
+}
+
+// ExternalIdContains applies the Contains predicate on the "externalId" field.
+func ExternalIdContains(v string) predicate.Unavailability {
+	return predicate.Unavailability(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldExternalId), v))
+	})
+}
+
+// ExternalIdHasPrefix applies the HasPrefix predicate on the "externalId" field.
+func ExternalIdHasPrefix(v string) predicate.Unavailability {
+	return predicate.Unavailability(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldExternalId), v))
+	})
+}
+
+// ExternalIdHasSuffix applies the HasSuffix predicate on the "externalId" field.
+func ExternalIdHasSuffix(v string) predicate.Unavailability {
+	return predicate.Unavailability(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldExternalId), v))
+	})
+}
+
+// ExternalIdIsNil applies the IsNil predicate on the "externalId" field.
+func ExternalIdIsNil() predicate.Unavailability {
+	return predicate.Unavailability(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldExternalId)))
+	})
+}
+
+// ExternalIdNotNil applies the NotNil predicate on the "externalId" field.
+func ExternalIdNotNil() predicate.Unavailability {
+	return predicate.Unavailability(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldExternalId)))
+	})
+}
+
+// ExternalIdEqualFold applies the EqualFold predicate on the "externalId" field.
+func ExternalIdEqualFold(v string) predicate.Unavailability {
+	return predicate.Unavailability(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldExternalId), v))
+	})
+}
+
+// ExternalIdContainsFold applies the ContainsFold predicate on the "externalId" field.
+func ExternalIdContainsFold(v string) predicate.Unavailability {
+	return predicate.Unavailability(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldExternalId), v))
+	})
+}
+
 // HasResource applies the HasEdge predicate on the "resource" edge.
 func HasResource() predicate.Unavailability {
 	return predicate.Unavailability(func(s *sql.Selector) {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/openmesh/booking/ent/resource"
@@ -19,6 +20,7 @@ type UnavailabilityCreate struct {
 	config
 	mutation *UnavailabilityMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "createdAt" field.
@@ -67,6 +69,20 @@ func (uc *UnavailabilityCreate) SetResourceId(i int) *UnavailabilityCreate {
 	return uc
 }
 
+// SetExternalId sets the "externalId" field.
+func (uc *UnavailabilityCreate) SetExternalId(s string) *UnavailabilityCreate {
+	uc.mutation.SetExternalId(s)
+	return uc
+}
+
+// SetNillableExternalId sets the "externalId" field if the given value is not nil.
+func (uc *UnavailabilityCreate) SetNillableExternalId(s *string) *UnavailabilityCreate {
+	if s != nil {
+		uc.SetExternalId(*s)
+	}
+	return uc
+}
+
 // SetResourceID sets the "resource" edge to the Resource entity by ID.
 func (uc *UnavailabilityCreate) SetResourceID(id int) *UnavailabilityCreate {
 	uc.mutation.SetResourceID(id)
@@ -215,6 +231,7 @@ func (uc *UnavailabilityCreate) createSpec() (*Unavailability, *sqlgraph.CreateS
 			},
 		}
 	)
+	_spec.OnConflict = uc.conflict
 	if value, ok := uc.mutation.CreatedAt(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -247,6 +264,14 @@ func (uc *UnavailabilityCreate) createSpec() (*Unavailability, *sqlgraph.CreateS
 		})
 		_node.EndTime = value
 	}
+	if value, ok := uc.mutation.ExternalId(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: unavailability.FieldExternalId,
+		})
+		_node.ExternalId = value
+	}
 	if nodes := uc.mutation.ResourceIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -270,10 +295,302 @@ func (uc *UnavailabilityCreate) createSpec() (*Unavailability, *sqlgraph.CreateS
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Unavailability.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UnavailabilityUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (uc *UnavailabilityCreate) OnConflict(opts ...sql.ConflictOption) *UnavailabilityUpsertOne {
+	uc.conflict = opts
+	return &UnavailabilityUpsertOne{
+		create: uc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Unavailability.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (uc *UnavailabilityCreate) OnConflictColumns(columns ...string) *UnavailabilityUpsertOne {
+	uc.conflict = append(uc.conflict, sql.ConflictColumns(columns...))
+	return &UnavailabilityUpsertOne{
+		create: uc,
+	}
+}
+
+type (
+	// UnavailabilityUpsertOne is the builder for "upsert"-ing
+	//  one Unavailability node.
+	UnavailabilityUpsertOne struct {
+		create *UnavailabilityCreate
+	}
+
+	// UnavailabilityUpsert is the "OnConflict" setter.
+	UnavailabilityUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *UnavailabilityUpsert) SetCreatedAt(v time.Time) *UnavailabilityUpsert {
+	u.Set(unavailability.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *UnavailabilityUpsert) UpdateCreatedAt() *UnavailabilityUpsert {
+	u.SetExcluded(unavailability.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *UnavailabilityUpsert) SetUpdatedAt(v time.Time) *UnavailabilityUpsert {
+	u.Set(unavailability.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *UnavailabilityUpsert) UpdateUpdatedAt() *UnavailabilityUpsert {
+	u.SetExcluded(unavailability.FieldUpdatedAt)
+	return u
+}
+
+// SetStartTime sets the "startTime" field.
+func (u *UnavailabilityUpsert) SetStartTime(v time.Time) *UnavailabilityUpsert {
+	u.Set(unavailability.FieldStartTime, v)
+	return u
+}
+
+// UpdateStartTime sets the "startTime" field to the value that was provided on create.
+func (u *UnavailabilityUpsert) UpdateStartTime() *UnavailabilityUpsert {
+	u.SetExcluded(unavailability.FieldStartTime)
+	return u
+}
+
+// SetEndTime sets the "endTime" field.
+func (u *UnavailabilityUpsert) SetEndTime(v time.Time) *UnavailabilityUpsert {
+	u.Set(unavailability.FieldEndTime, v)
+	return u
+}
+
+// UpdateEndTime sets the "endTime" field to the value that was provided on create.
+func (u *UnavailabilityUpsert) UpdateEndTime() *UnavailabilityUpsert {
+	u.SetExcluded(unavailability.FieldEndTime)
+	return u
+}
+
+// SetResourceId sets the "resourceId" field.
+func (u *UnavailabilityUpsert) SetResourceId(v int) *UnavailabilityUpsert {
+	u.Set(unavailability.FieldResourceId, v)
+	return u
+}
+
+// UpdateResourceId sets the "resourceId" field to the value that was provided on create.
+func (u *UnavailabilityUpsert) UpdateResourceId() *UnavailabilityUpsert {
+	u.SetExcluded(unavailability.FieldResourceId)
+	return u
+}
+
+// SetExternalId sets the "externalId" field.
+func (u *UnavailabilityUpsert) SetExternalId(v string) *UnavailabilityUpsert {
+	u.Set(unavailability.FieldExternalId, v)
+	return u
+}
+
+// UpdateExternalId sets the "externalId" field to the value that was provided on create.
+func (u *UnavailabilityUpsert) UpdateExternalId() *UnavailabilityUpsert {
+	u.SetExcluded(unavailability.FieldExternalId)
+	return u
+}
+
+// ClearExternalId clears the value of the "externalId" field.
+func (u *UnavailabilityUpsert) ClearExternalId() *UnavailabilityUpsert {
+	u.SetNull(unavailability.FieldExternalId)
+	return u
+}
+
+// UpdateNewValues updates the fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Unavailability.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UnavailabilityUpsertOne) UpdateNewValues() *UnavailabilityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Unavailability.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UnavailabilityUpsertOne) Ignore() *UnavailabilityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UnavailabilityUpsertOne) DoNothing() *UnavailabilityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UnavailabilityCreate.OnConflict
+// documentation for more info.
+func (u *UnavailabilityUpsertOne) Update(set func(*UnavailabilityUpsert)) *UnavailabilityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UnavailabilityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *UnavailabilityUpsertOne) SetCreatedAt(v time.Time) *UnavailabilityUpsertOne {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *UnavailabilityUpsertOne) UpdateCreatedAt() *UnavailabilityUpsertOne {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *UnavailabilityUpsertOne) SetUpdatedAt(v time.Time) *UnavailabilityUpsertOne {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *UnavailabilityUpsertOne) UpdateUpdatedAt() *UnavailabilityUpsertOne {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStartTime sets the "startTime" field.
+func (u *UnavailabilityUpsertOne) SetStartTime(v time.Time) *UnavailabilityUpsertOne {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.SetStartTime(v)
+	})
+}
+
+// UpdateStartTime sets the "startTime" field to the value that was provided on create.
+func (u *UnavailabilityUpsertOne) UpdateStartTime() *UnavailabilityUpsertOne {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.UpdateStartTime()
+	})
+}
+
+// SetEndTime sets the "endTime" field.
+func (u *UnavailabilityUpsertOne) SetEndTime(v time.Time) *UnavailabilityUpsertOne {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.SetEndTime(v)
+	})
+}
+
+// UpdateEndTime sets the "endTime" field to the value that was provided on create.
+func (u *UnavailabilityUpsertOne) UpdateEndTime() *UnavailabilityUpsertOne {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.UpdateEndTime()
+	})
+}
+
+// SetResourceId sets the "resourceId" field.
+func (u *UnavailabilityUpsertOne) SetResourceId(v int) *UnavailabilityUpsertOne {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.SetResourceId(v)
+	})
+}
+
+// UpdateResourceId sets the "resourceId" field to the value that was provided on create.
+func (u *UnavailabilityUpsertOne) UpdateResourceId() *UnavailabilityUpsertOne {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.UpdateResourceId()
+	})
+}
+
+// SetExternalId sets the "externalId" field.
+func (u *UnavailabilityUpsertOne) SetExternalId(v string) *UnavailabilityUpsertOne {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.SetExternalId(v)
+	})
+}
+
+// UpdateExternalId sets the "externalId" field to the value that was provided on create.
+func (u *UnavailabilityUpsertOne) UpdateExternalId() *UnavailabilityUpsertOne {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.UpdateExternalId()
+	})
+}
+
+// ClearExternalId clears the value of the "externalId" field.
+func (u *UnavailabilityUpsertOne) ClearExternalId() *UnavailabilityUpsertOne {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.ClearExternalId()
+	})
+}
+
+// Exec executes the query.
+func (u *UnavailabilityUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UnavailabilityCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UnavailabilityUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UnavailabilityUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UnavailabilityUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // UnavailabilityCreateBulk is the builder for creating many Unavailability entities in bulk.
 type UnavailabilityCreateBulk struct {
 	config
 	builders []*UnavailabilityCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Unavailability entities in the database.
@@ -300,6 +617,7 @@ func (ucb *UnavailabilityCreateBulk) Save(ctx context.Context) ([]*Unavailabilit
 					_, err = mutators[i+1].Mutate(root, ucb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ucb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ucb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -353,3 +671,195 @@ func (ucb *UnavailabilityCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Unavailability.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UnavailabilityUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ucb *UnavailabilityCreateBulk) OnConflict(opts ...sql.ConflictOption) *UnavailabilityUpsertBulk {
+	ucb.conflict = opts
+	return &UnavailabilityUpsertBulk{
+		create: ucb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Unavailability.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ucb *UnavailabilityCreateBulk) OnConflictColumns(columns ...string) *UnavailabilityUpsertBulk {
+	ucb.conflict = append(ucb.conflict, sql.ConflictColumns(columns...))
+	return &UnavailabilityUpsertBulk{
+		create: ucb,
+	}
+}
+
+// UnavailabilityUpsertBulk is the builder for "upsert"-ing
+// a bulk of Unavailability nodes.
+type UnavailabilityUpsertBulk struct {
+	create *UnavailabilityCreateBulk
+}
+
+// UpdateNewValues updates the fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Unavailability.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UnavailabilityUpsertBulk) UpdateNewValues() *UnavailabilityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Unavailability.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UnavailabilityUpsertBulk) Ignore() *UnavailabilityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UnavailabilityUpsertBulk) DoNothing() *UnavailabilityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UnavailabilityCreateBulk.OnConflict
+// documentation for more info.
+func (u *UnavailabilityUpsertBulk) Update(set func(*UnavailabilityUpsert)) *UnavailabilityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UnavailabilityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *UnavailabilityUpsertBulk) SetCreatedAt(v time.Time) *UnavailabilityUpsertBulk {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *UnavailabilityUpsertBulk) UpdateCreatedAt() *UnavailabilityUpsertBulk {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *UnavailabilityUpsertBulk) SetUpdatedAt(v time.Time) *UnavailabilityUpsertBulk {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *UnavailabilityUpsertBulk) UpdateUpdatedAt() *UnavailabilityUpsertBulk {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStartTime sets the "startTime" field.
+func (u *UnavailabilityUpsertBulk) SetStartTime(v time.Time) *UnavailabilityUpsertBulk {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.SetStartTime(v)
+	})
+}
+
+// UpdateStartTime sets the "startTime" field to the value that was provided on create.
+func (u *UnavailabilityUpsertBulk) UpdateStartTime() *UnavailabilityUpsertBulk {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.UpdateStartTime()
+	})
+}
+
+// SetEndTime sets the "endTime" field.
+func (u *UnavailabilityUpsertBulk) SetEndTime(v time.Time) *UnavailabilityUpsertBulk {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.SetEndTime(v)
+	})
+}
+
+// UpdateEndTime sets the "endTime" field to the value that was provided on create.
+func (u *UnavailabilityUpsertBulk) UpdateEndTime() *UnavailabilityUpsertBulk {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.UpdateEndTime()
+	})
+}
+
+// SetResourceId sets the "resourceId" field.
+func (u *UnavailabilityUpsertBulk) SetResourceId(v int) *UnavailabilityUpsertBulk {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.SetResourceId(v)
+	})
+}
+
+// UpdateResourceId sets the "resourceId" field to the value that was provided on create.
+func (u *UnavailabilityUpsertBulk) UpdateResourceId() *UnavailabilityUpsertBulk {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.UpdateResourceId()
+	})
+}
+
+// SetExternalId sets the "externalId" field.
+func (u *UnavailabilityUpsertBulk) SetExternalId(v string) *UnavailabilityUpsertBulk {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.SetExternalId(v)
+	})
+}
+
+// UpdateExternalId sets the "externalId" field to the value that was provided on create.
+func (u *UnavailabilityUpsertBulk) UpdateExternalId() *UnavailabilityUpsertBulk {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.UpdateExternalId()
+	})
+}
+
+// ClearExternalId clears the value of the "externalId" field.
+func (u *UnavailabilityUpsertBulk) ClearExternalId() *UnavailabilityUpsertBulk {
+	return u.Update(func(s *UnavailabilityUpsert) {
+		s.ClearExternalId()
+	})
+}
+
+// Exec executes the query.
+func (u *UnavailabilityUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UnavailabilityCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UnavailabilityCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UnavailabilityUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
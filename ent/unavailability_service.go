@@ -230,6 +230,7 @@ func (u *Unavailability) toModel() *booking.Unavailability {
 		ResourceID: u.ResourceId,
 		StartTime:  u.StartTime,
 		EndTime:    u.EndTime,
+		ExternalID: u.ExternalId,
 	}
 	if u.Edges.Resource != nil {
 		result.Resource = u.Edges.Resource.toModel()
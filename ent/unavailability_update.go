@@ -53,6 +53,26 @@ func (uu *UnavailabilityUpdate) SetResourceId(i int) *UnavailabilityUpdate {
 	return uu
 }
 
+// SetExternalId sets the "externalId" field.
+func (uu *UnavailabilityUpdate) SetExternalId(s string) *UnavailabilityUpdate {
+	uu.mutation.SetExternalId(s)
+	return uu
+}
+
+// SetNillableExternalId sets the "externalId" field if the given value is not nil.
+func (uu *UnavailabilityUpdate) SetNillableExternalId(s *string) *UnavailabilityUpdate {
+	if s != nil {
+		uu.SetExternalId(*s)
+	}
+	return uu
+}
+
+// ClearExternalId clears the value of the "externalId" field.
+func (uu *UnavailabilityUpdate) ClearExternalId() *UnavailabilityUpdate {
+	uu.mutation.ClearExternalId()
+	return uu
+}
+
 // SetResourceID sets the "resource" edge to the Resource entity by ID.
 func (uu *UnavailabilityUpdate) SetResourceID(id int) *UnavailabilityUpdate {
 	uu.mutation.SetResourceID(id)
@@ -197,6 +217,19 @@ func (uu *UnavailabilityUpdate) sqlSave(ctx context.Context) (n int, err error)
 			Column: unavailability.FieldEndTime,
 		})
 	}
+	if value, ok := uu.mutation.ExternalId(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: unavailability.FieldExternalId,
+		})
+	}
+	if uu.mutation.ExternalIdCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: unavailability.FieldExternalId,
+		})
+	}
 	if uu.mutation.ResourceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -275,6 +308,26 @@ func (uuo *UnavailabilityUpdateOne) SetResourceId(i int) *UnavailabilityUpdateOn
 	return uuo
 }
 
+// SetExternalId sets the "externalId" field.
+func (uuo *UnavailabilityUpdateOne) SetExternalId(s string) *UnavailabilityUpdateOne {
+	uuo.mutation.SetExternalId(s)
+	return uuo
+}
+
+// SetNillableExternalId sets the "externalId" field if the given value is not nil.
+func (uuo *UnavailabilityUpdateOne) SetNillableExternalId(s *string) *UnavailabilityUpdateOne {
+	if s != nil {
+		uuo.SetExternalId(*s)
+	}
+	return uuo
+}
+
+// ClearExternalId clears the value of the "externalId" field.
+func (uuo *UnavailabilityUpdateOne) ClearExternalId() *UnavailabilityUpdateOne {
+	uuo.mutation.ClearExternalId()
+	return uuo
+}
+
 // SetResourceID sets the "resource" edge to the Resource entity by ID.
 func (uuo *UnavailabilityUpdateOne) SetResourceID(id int) *UnavailabilityUpdateOne {
 	uuo.mutation.SetResourceID(id)
@@ -443,6 +496,19 @@ func (uuo *UnavailabilityUpdateOne) sqlSave(ctx context.Context) (_node *Unavail
 			Column: unavailability.FieldEndTime,
 		})
 	}
+	if value, ok := uuo.mutation.ExternalId(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: unavailability.FieldExternalId,
+		})
+	}
+	if uuo.mutation.ExternalIdCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: unavailability.FieldExternalId,
+		})
+	}
 	if uuo.mutation.ResourceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -38,11 +38,13 @@ type UserEdges struct {
 	Auths []*Auth `json:"auths,omitempty"`
 	// Tokens holds the value of the tokens edge.
 	Tokens []*Token `json:"tokens,omitempty"`
+	// Bookings holds the value of the bookings edge.
+	Bookings []*Booking `json:"bookings,omitempty"`
 	// Organization holds the value of the organization edge.
 	Organization *Organization `json:"organization,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // AuthsOrErr returns the Auths value or an error if the edge
@@ -63,10 +65,19 @@ func (e UserEdges) TokensOrErr() ([]*Token, error) {
 	return nil, &NotLoadedError{edge: "tokens"}
 }
 
+// BookingsOrErr returns the Bookings value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BookingsOrErr() ([]*Booking, error) {
+	if e.loadedTypes[2] {
+		return e.Bookings, nil
+	}
+	return nil, &NotLoadedError{edge: "bookings"}
+}
+
 // OrganizationOrErr returns the Organization value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e UserEdges) OrganizationOrErr() (*Organization, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		if e.Organization == nil {
 			// The edge organization was loaded in eager-loading,
 			// but was not found.
@@ -155,6 +166,11 @@ func (u *User) QueryTokens() *TokenQuery {
 	return (&UserClient{config: u.config}).QueryTokens(u)
 }
 
+// QueryBookings queries the "bookings" edge of the User entity.
+func (u *User) QueryBookings() *BookingQuery {
+	return (&UserClient{config: u.config}).QueryBookings(u)
+}
+
 // QueryOrganization queries the "organization" edge of the User entity.
 func (u *User) QueryOrganization() *OrganizationQuery {
 	return (&UserClient{config: u.config}).QueryOrganization(u)
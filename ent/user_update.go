@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/openmesh/booking/ent/auth"
+	"github.com/openmesh/booking/ent/booking"
 	"github.com/openmesh/booking/ent/organization"
 	"github.com/openmesh/booking/ent/predicate"
 	"github.com/openmesh/booking/ent/token"
@@ -98,6 +99,21 @@ func (uu *UserUpdate) AddTokens(t ...*Token) *UserUpdate {
 	return uu.AddTokenIDs(ids...)
 }
 
+// AddBookingIDs adds the "bookings" edge to the Booking entity by IDs.
+func (uu *UserUpdate) AddBookingIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddBookingIDs(ids...)
+	return uu
+}
+
+// AddBookings adds the "bookings" edges to the Booking entity.
+func (uu *UserUpdate) AddBookings(b ...*Booking) *UserUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uu.AddBookingIDs(ids...)
+}
+
 // SetOrganizationID sets the "organization" edge to the Organization entity by ID.
 func (uu *UserUpdate) SetOrganizationID(id int) *UserUpdate {
 	uu.mutation.SetOrganizationID(id)
@@ -164,6 +180,27 @@ func (uu *UserUpdate) RemoveTokens(t ...*Token) *UserUpdate {
 	return uu.RemoveTokenIDs(ids...)
 }
 
+// ClearBookings clears all "bookings" edges to the Booking entity.
+func (uu *UserUpdate) ClearBookings() *UserUpdate {
+	uu.mutation.ClearBookings()
+	return uu
+}
+
+// RemoveBookingIDs removes the "bookings" edge to Booking entities by IDs.
+func (uu *UserUpdate) RemoveBookingIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveBookingIDs(ids...)
+	return uu
+}
+
+// RemoveBookings removes "bookings" edges to Booking entities.
+func (uu *UserUpdate) RemoveBookings(b ...*Booking) *UserUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uu.RemoveBookingIDs(ids...)
+}
+
 // ClearOrganization clears the "organization" edge to the Organization entity.
 func (uu *UserUpdate) ClearOrganization() *UserUpdate {
 	uu.mutation.ClearOrganization()
@@ -380,6 +417,60 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uu.mutation.BookingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BookingsTable,
+			Columns: []string{user.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedBookingsIDs(); len(nodes) > 0 && !uu.mutation.BookingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BookingsTable,
+			Columns: []string{user.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.BookingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BookingsTable,
+			Columns: []string{user.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if uu.mutation.OrganizationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -502,6 +593,21 @@ func (uuo *UserUpdateOne) AddTokens(t ...*Token) *UserUpdateOne {
 	return uuo.AddTokenIDs(ids...)
 }
 
+// AddBookingIDs adds the "bookings" edge to the Booking entity by IDs.
+func (uuo *UserUpdateOne) AddBookingIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddBookingIDs(ids...)
+	return uuo
+}
+
+// AddBookings adds the "bookings" edges to the Booking entity.
+func (uuo *UserUpdateOne) AddBookings(b ...*Booking) *UserUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.AddBookingIDs(ids...)
+}
+
 // SetOrganizationID sets the "organization" edge to the Organization entity by ID.
 func (uuo *UserUpdateOne) SetOrganizationID(id int) *UserUpdateOne {
 	uuo.mutation.SetOrganizationID(id)
@@ -568,6 +674,27 @@ func (uuo *UserUpdateOne) RemoveTokens(t ...*Token) *UserUpdateOne {
 	return uuo.RemoveTokenIDs(ids...)
 }
 
+// ClearBookings clears all "bookings" edges to the Booking entity.
+func (uuo *UserUpdateOne) ClearBookings() *UserUpdateOne {
+	uuo.mutation.ClearBookings()
+	return uuo
+}
+
+// RemoveBookingIDs removes the "bookings" edge to Booking entities by IDs.
+func (uuo *UserUpdateOne) RemoveBookingIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveBookingIDs(ids...)
+	return uuo
+}
+
+// RemoveBookings removes "bookings" edges to Booking entities.
+func (uuo *UserUpdateOne) RemoveBookings(b ...*Booking) *UserUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.RemoveBookingIDs(ids...)
+}
+
 // ClearOrganization clears the "organization" edge to the Organization entity.
 func (uuo *UserUpdateOne) ClearOrganization() *UserUpdateOne {
 	uuo.mutation.ClearOrganization()
@@ -808,6 +935,60 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.BookingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BookingsTable,
+			Columns: []string{user.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedBookingsIDs(); len(nodes) > 0 && !uuo.mutation.BookingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BookingsTable,
+			Columns: []string{user.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.BookingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BookingsTable,
+			Columns: []string{user.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if uuo.mutation.OrganizationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
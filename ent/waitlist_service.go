@@ -0,0 +1,390 @@
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openmesh/booking"
+	entbooking "github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/waitlistentry"
+)
+
+type waitlistService struct {
+	client *Client
+}
+
+// NewWaitlistService constructs a new instance of a booking.WaitlistService
+// using ent as its persistence layer.
+func NewWaitlistService(client *Client) *waitlistService {
+	return &waitlistService{client}
+}
+
+// FindWaitlistEntries retrieves a list of waitlist entries in the order they
+// will be offered a spot.
+func (s *waitlistService) FindWaitlistEntries(
+	ctx context.Context,
+	req booking.FindWaitlistEntriesRequest,
+) booking.FindWaitlistEntriesResponse {
+	q := s.client.WaitlistEntry.Query()
+	if req.ResourceID != nil {
+		q.Where(waitlistentry.ResourceId(*req.ResourceID))
+	}
+	if req.Status != nil {
+		q.Where(waitlistentry.Status(*req.Status))
+	}
+
+	c, err := q.Clone().Count(ctx)
+	if err != nil {
+		return booking.FindWaitlistEntriesResponse{
+			Err: fmt.Errorf("failed to count waitlist entries: %w", err),
+		}
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = 10
+	}
+	w, err := q.
+		Order(waitlistOrder()...).
+		Offset(req.Offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return booking.FindWaitlistEntriesResponse{
+			Err: fmt.Errorf("failed to query waitlist entries: %w", err),
+		}
+	}
+
+	return booking.FindWaitlistEntriesResponse{
+		Entries:    WaitlistEntries(w).toModels(),
+		TotalItems: c,
+	}
+}
+
+// JoinWaitlist adds a customer to the waitlist of a resource. The time has to
+// fit the slots of the resource like a booking would, so that the entry can
+// be offered a spot. Spots that are already free are offered straight away.
+func (s *waitlistService) JoinWaitlist(
+	ctx context.Context,
+	req booking.JoinWaitlistRequest,
+) booking.JoinWaitlistResponse {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return booking.JoinWaitlistResponse{
+			Err: fmt.Errorf("failed to start transaction: %w", err),
+		}
+	}
+
+	err = checkBookingTimes(ctx, tx, req.ResourceID, req.StartTime, req.EndTime)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.JoinWaitlistResponse{
+			Err: fmt.Errorf("booking time check failed: %w", err),
+		}
+	}
+
+	w, err := tx.WaitlistEntry.
+		Create().
+		SetResourceId(req.ResourceID).
+		SetStartTime(req.StartTime).
+		SetEndTime(req.EndTime).
+		SetPriority(req.Priority).
+		SetMetadata(req.Metadata).
+		SetStatus(booking.WaitlistStatusWaiting).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.JoinWaitlistResponse{
+			Err: fmt.Errorf("failed to create waitlist entry: %w", err),
+		}
+	}
+
+	offers, err := offerWaitlistSpots(ctx, tx, req.ResourceID, req.StartTime, req.EndTime)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.JoinWaitlistResponse{
+			Err: fmt.Errorf("failed to offer waitlist spots: %w", err),
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return booking.JoinWaitlistResponse{
+			Err: fmt.Errorf("failed to commit transaction: %w", err),
+		}
+	}
+
+	entry := w.toModel()
+	for _, o := range offers {
+		if o.Entry.ID == w.ID {
+			entry = o.Entry
+		}
+	}
+	return booking.JoinWaitlistResponse{
+		WaitlistEntry: entry,
+		Offers:        offers,
+	}
+}
+
+// LeaveWaitlist deletes a waitlist entry. A spot that was offered to the entry
+// and is still held is cancelled and offered to the next entry.
+func (s *waitlistService) LeaveWaitlist(
+	ctx context.Context,
+	req booking.LeaveWaitlistRequest,
+) booking.LeaveWaitlistResponse {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return booking.LeaveWaitlistResponse{
+			Err: fmt.Errorf("failed to start transaction: %w", err),
+		}
+	}
+
+	w, err := tx.WaitlistEntry.Get(ctx, req.ID)
+	var nfe *NotFoundError
+	if errors.As(err, &nfe) {
+		_ = tx.Rollback()
+		return booking.LeaveWaitlistResponse{
+			Err: booking.Errorf(booking.EWAITLISTENTRYNOTFOUND, "Could not find waitlist entry with ID %d", req.ID),
+		}
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.LeaveWaitlistResponse{
+			Err: fmt.Errorf("failed to find waitlist entry: %w", err),
+		}
+	}
+
+	err = tx.WaitlistEntry.DeleteOneID(w.ID).Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return booking.LeaveWaitlistResponse{
+			Err: fmt.Errorf("failed to delete waitlist entry: %w", err),
+		}
+	}
+
+	var res booking.LeaveWaitlistResponse
+	if w.Status == booking.WaitlistStatusOffered && w.BookingId != nil {
+		// Nothing is given up if the booking was confirmed or released in the
+		// meantime.
+		a, err := tx.Booking.
+			Update().
+			Where(
+				entbooking.ID(*w.BookingId),
+				entbooking.Status(booking.BookingStatusHeld),
+			).
+			SetStatus(booking.BookingStatusCancelled).
+			SetCancelledAt(time.Now()).
+			ClearExpiresAt().
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return booking.LeaveWaitlistResponse{
+				Err: fmt.Errorf("failed to cancel offered booking: %w", err),
+			}
+		}
+		if a == 1 {
+			b, err := tx.Booking.
+				Query().
+				Where(entbooking.ID(*w.BookingId)).
+				WithResource().
+				WithMetadata().
+				Only(ctx)
+			if err != nil {
+				_ = tx.Rollback()
+				return booking.LeaveWaitlistResponse{
+					Err: fmt.Errorf("failed to query cancelled booking: %w", err),
+				}
+			}
+			res.CancelledBooking = b.toModel()
+			res.Offers, err = offerWaitlistSpots(ctx, tx, b.ResourceId, b.StartTime, b.EndTime)
+			if err != nil {
+				_ = tx.Rollback()
+				return booking.LeaveWaitlistResponse{
+					Err: fmt.Errorf("failed to offer waitlist spots: %w", err),
+				}
+			}
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return booking.LeaveWaitlistResponse{
+			Err: fmt.Errorf("failed to commit transaction: %w", err),
+		}
+	}
+	return res
+}
+
+// waitlistOrder orders waitlist entries by the position in which they are
+// offered a spot.
+func waitlistOrder() []OrderFunc {
+	return []OrderFunc{
+		Desc(waitlistentry.FieldPriority),
+		Asc(waitlistentry.FieldCreatedAt),
+		Asc(waitlistentry.FieldID),
+	}
+}
+
+// offerWaitlistSpots offers a held booking to each waiting entry of the
+// resource that overlaps [st, et) and can now be booked, in waitlist order.
+// Entries that still don't fit, e.g. because they want a longer time than was
+// freed up, keep waiting without holding up the entries behind them.
+func offerWaitlistSpots(ctx context.Context, tx *Tx, rid int, st, et time.Time) ([]booking.WaitlistOffer, error) {
+	now := time.Now()
+	entries, err := tx.WaitlistEntry.
+		Query().
+		Where(
+			waitlistentry.ResourceId(rid),
+			waitlistentry.Status(booking.WaitlistStatusWaiting),
+			waitlistentry.StartTimeLT(et),
+			waitlistentry.EndTimeGT(st),
+			waitlistentry.StartTimeGT(now),
+		).
+		Order(waitlistOrder()...).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query waitlist: %w", err)
+	}
+
+	var offers []booking.WaitlistOffer
+	for _, w := range entries {
+		err = checkBookingTimes(ctx, tx, rid, w.StartTime, w.EndTime)
+		if err == nil {
+			err = checkForBookingTimeConflict(ctx, tx, rid, w.StartTime, w.EndTime)
+		}
+		if _, ok := bookingTimeErrorParams(err); ok {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("booking time check failed: %w", err)
+		}
+
+		// The booking is made for the customer on the waitlist rather than the
+		// user whose change freed up the time.
+		expiresAt := now.Add(booking.WaitlistOfferDuration)
+		b, err := createBooking(booking.NewContextWithUser(ctx, nil), tx, booking.CreateBookingRequest{
+			ResourceID: rid,
+			Metadata:   w.Metadata,
+			Status:     booking.BookingStatusHeld,
+			StartTime:  w.StartTime,
+			EndTime:    w.EndTime,
+		}, &expiresAt, func(b *Booking) (*Booking, error) {
+			b.Edges.Metadata, err = b.QueryMetadata().All(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to query metadata")
+			}
+			b.Edges.Resource, err = b.QueryResource().First(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to query resource")
+			}
+			return b, nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create offered booking: %w", err)
+		}
+
+		w, err = w.Update().
+			SetStatus(booking.WaitlistStatusOffered).
+			SetBookingId(b.ID).
+			SetOfferedAt(now).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update waitlist entry: %w", err)
+		}
+		offers = append(offers, booking.WaitlistOffer{
+			Entry:   w.toModel(),
+			Booking: b.toModel(),
+		})
+	}
+	return offers, nil
+}
+
+// settleWaitlistOffer records the outcome of a spot that was offered to a
+// waitlist entry once its held booking is confirmed or released. It does
+// nothing if the booking was not offered to an entry.
+func settleWaitlistOffer(ctx context.Context, tx *Tx, bookingID int, status string) error {
+	_, err := tx.WaitlistEntry.
+		Update().
+		Where(
+			waitlistentry.BookingId(bookingID),
+			waitlistentry.Status(booking.WaitlistStatusOffered),
+		).
+		SetStatus(status).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update waitlist entry: %w", err)
+	}
+	return nil
+}
+
+func (w *WaitlistEntry) toModel() *booking.WaitlistEntry {
+	return &booking.WaitlistEntry{
+		ID:         w.ID,
+		ResourceID: w.ResourceId,
+		StartTime:  w.StartTime,
+		EndTime:    w.EndTime,
+		Priority:   w.Priority,
+		Metadata:   w.Metadata,
+		Status:     w.Status,
+		BookingID:  w.BookingId,
+		OfferedAt:  w.OfferedAt,
+		CreatedAt:  w.CreatedAt,
+		UpdatedAt:  w.UpdatedAt,
+	}
+}
+
+func (w WaitlistEntries) toModels() []*booking.WaitlistEntry {
+	var entries []*booking.WaitlistEntry
+	for _, v := range w {
+		entries = append(entries, v.toModel())
+	}
+	return entries
+}
+
+// freedTime is the time of a resource that a booking stopped occupying because
+// it was cancelled, moved or deleted.
+type freedTime struct {
+	resourceID int
+	period
+}
+
+// freedBy returns the time that booking b stops occupying when it is given the
+// status, resource and times of an update. Returns false if b didn't occupy
+// its resource or still occupies the same time.
+func freedBy(b *Booking, status string, rid int, st, et time.Time) (freedTime, bool) {
+	if !booking.ActiveBookingStatus(b.Status) {
+		return freedTime{}, false
+	}
+	if booking.ActiveBookingStatus(status) && rid == b.ResourceId && st.Equal(b.StartTime) && et.Equal(b.EndTime) {
+		return freedTime{}, false
+	}
+	return freedTime{b.ResourceId, period{b.StartTime, b.EndTime}}, true
+}
+
+// offerFreedTimes offers each of the freed times to the waitlist of its
+// resource.
+func offerFreedTimes(ctx context.Context, tx *Tx, freed []freedTime) ([]booking.WaitlistOffer, error) {
+	var offers []booking.WaitlistOffer
+	for _, f := range freed {
+		o, err := offerWaitlistSpots(ctx, tx, f.resourceID, f.start, f.end)
+		if err != nil {
+			return nil, err
+		}
+		offers = append(offers, o...)
+	}
+	return offers, nil
+}
+
+// waitlistOfferOutcome returns the status of a waitlist entry whose offered
+// booking moved from held to status.
+func waitlistOfferOutcome(status string) string {
+	switch {
+	case booking.ActiveBookingStatus(status):
+		return booking.WaitlistStatusBooked
+	case status == booking.BookingStatusExpired:
+		return booking.WaitlistStatusExpired
+	}
+	return booking.WaitlistStatusDeclined
+}
@@ -0,0 +1,257 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/resource"
+	"github.com/openmesh/booking/ent/waitlistentry"
+)
+
+// WaitlistEntry is the model entity for the WaitlistEntry schema.
+type WaitlistEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "createdAt" field.
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+	// UpdatedAt holds the value of the "updatedAt" field.
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// StartTime holds the value of the "startTime" field.
+	StartTime time.Time `json:"startTime,omitempty"`
+	// EndTime holds the value of the "endTime" field.
+	EndTime time.Time `json:"endTime,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority int `json:"priority,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// ResourceId holds the value of the "resourceId" field.
+	ResourceId int `json:"resourceId,omitempty"`
+	// BookingId holds the value of the "bookingId" field.
+	BookingId *int `json:"bookingId,omitempty"`
+	// OfferedAt holds the value of the "offeredAt" field.
+	OfferedAt *time.Time `json:"offeredAt,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WaitlistEntryQuery when eager-loading is set.
+	Edges WaitlistEntryEdges `json:"edges"`
+}
+
+// WaitlistEntryEdges holds the relations/edges for other nodes in the graph.
+type WaitlistEntryEdges struct {
+	// Resource holds the value of the resource edge.
+	Resource *Resource `json:"resource,omitempty"`
+	// Booking holds the value of the booking edge.
+	Booking *Booking `json:"booking,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ResourceOrErr returns the Resource value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WaitlistEntryEdges) ResourceOrErr() (*Resource, error) {
+	if e.loadedTypes[0] {
+		if e.Resource == nil {
+			// The edge resource was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: resource.Label}
+		}
+		return e.Resource, nil
+	}
+	return nil, &NotLoadedError{edge: "resource"}
+}
+
+// BookingOrErr returns the Booking value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WaitlistEntryEdges) BookingOrErr() (*Booking, error) {
+	if e.loadedTypes[1] {
+		if e.Booking == nil {
+			// The edge booking was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: booking.Label}
+		}
+		return e.Booking, nil
+	}
+	return nil, &NotLoadedError{edge: "booking"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WaitlistEntry) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case waitlistentry.FieldMetadata:
+			values[i] = new([]byte)
+		case waitlistentry.FieldID, waitlistentry.FieldPriority, waitlistentry.FieldResourceId, waitlistentry.FieldBookingId:
+			values[i] = new(sql.NullInt64)
+		case waitlistentry.FieldStatus:
+			values[i] = new(sql.NullString)
+		case waitlistentry.FieldCreatedAt, waitlistentry.FieldUpdatedAt, waitlistentry.FieldStartTime, waitlistentry.FieldEndTime, waitlistentry.FieldOfferedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type WaitlistEntry", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WaitlistEntry fields.
+func (we *WaitlistEntry) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case waitlistentry.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			we.ID = int(value.Int64)
+		case waitlistentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field createdAt", values[i])
+			} else if value.Valid {
+				we.CreatedAt = value.Time
+			}
+		case waitlistentry.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updatedAt", values[i])
+			} else if value.Valid {
+				we.UpdatedAt = value.Time
+			}
+		case waitlistentry.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				we.Status = value.String
+			}
+		case waitlistentry.FieldStartTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field startTime", values[i])
+			} else if value.Valid {
+				we.StartTime = value.Time
+			}
+		case waitlistentry.FieldEndTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field endTime", values[i])
+			} else if value.Valid {
+				we.EndTime = value.Time
+			}
+		case waitlistentry.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				we.Priority = int(value.Int64)
+			}
+		case waitlistentry.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &we.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case waitlistentry.FieldResourceId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field resourceId", values[i])
+			} else if value.Valid {
+				we.ResourceId = int(value.Int64)
+			}
+		case waitlistentry.FieldBookingId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bookingId", values[i])
+			} else if value.Valid {
+				we.BookingId = new(int)
+				*we.BookingId = int(value.Int64)
+			}
+		case waitlistentry.FieldOfferedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field offeredAt", values[i])
+			} else if value.Valid {
+				we.OfferedAt = new(time.Time)
+				*we.OfferedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryResource queries the "resource" edge of the WaitlistEntry entity.
+func (we *WaitlistEntry) QueryResource() *ResourceQuery {
+	return (&WaitlistEntryClient{config: we.config}).QueryResource(we)
+}
+
+// QueryBooking queries the "booking" edge of the WaitlistEntry entity.
+func (we *WaitlistEntry) QueryBooking() *BookingQuery {
+	return (&WaitlistEntryClient{config: we.config}).QueryBooking(we)
+}
+
+// Update returns a builder for updating this WaitlistEntry.
+// Note that you need to call WaitlistEntry.Unwrap() before calling this method if this WaitlistEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (we *WaitlistEntry) Update() *WaitlistEntryUpdateOne {
+	return (&WaitlistEntryClient{config: we.config}).UpdateOne(we)
+}
+
+// Unwrap unwraps the WaitlistEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (we *WaitlistEntry) Unwrap() *WaitlistEntry {
+	tx, ok := we.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WaitlistEntry is not a transactional entity")
+	}
+	we.config.driver = tx.drv
+	return we
+}
+
+// String implements the fmt.Stringer.
+func (we *WaitlistEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("WaitlistEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v", we.ID))
+	builder.WriteString(", createdAt=")
+	builder.WriteString(we.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", updatedAt=")
+	builder.WriteString(we.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", status=")
+	builder.WriteString(we.Status)
+	builder.WriteString(", startTime=")
+	builder.WriteString(we.StartTime.Format(time.ANSIC))
+	builder.WriteString(", endTime=")
+	builder.WriteString(we.EndTime.Format(time.ANSIC))
+	builder.WriteString(", priority=")
+	builder.WriteString(fmt.Sprintf("%v", we.Priority))
+	builder.WriteString(", metadata=")
+	builder.WriteString(fmt.Sprintf("%v", we.Metadata))
+	builder.WriteString(", resourceId=")
+	builder.WriteString(fmt.Sprintf("%v", we.ResourceId))
+	if v := we.BookingId; v != nil {
+		builder.WriteString(", bookingId=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	if v := we.OfferedAt; v != nil {
+		builder.WriteString(", offeredAt=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WaitlistEntries is a parsable slice of WaitlistEntry.
+type WaitlistEntries []*WaitlistEntry
+
+func (we WaitlistEntries) config(cfg config) {
+	for _i := range we {
+		we[_i].config = cfg
+	}
+}
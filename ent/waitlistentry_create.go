@@ -0,0 +1,1179 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/resource"
+	"github.com/openmesh/booking/ent/waitlistentry"
+)
+
+// WaitlistEntryCreate is the builder for creating a WaitlistEntry entity.
+type WaitlistEntryCreate struct {
+	config
+	mutation *WaitlistEntryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (wec *WaitlistEntryCreate) SetCreatedAt(t time.Time) *WaitlistEntryCreate {
+	wec.mutation.SetCreatedAt(t)
+	return wec
+}
+
+// SetNillableCreatedAt sets the "createdAt" field if the given value is not nil.
+func (wec *WaitlistEntryCreate) SetNillableCreatedAt(t *time.Time) *WaitlistEntryCreate {
+	if t != nil {
+		wec.SetCreatedAt(*t)
+	}
+	return wec
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (wec *WaitlistEntryCreate) SetUpdatedAt(t time.Time) *WaitlistEntryCreate {
+	wec.mutation.SetUpdatedAt(t)
+	return wec
+}
+
+// SetNillableUpdatedAt sets the "updatedAt" field if the given value is not nil.
+func (wec *WaitlistEntryCreate) SetNillableUpdatedAt(t *time.Time) *WaitlistEntryCreate {
+	if t != nil {
+		wec.SetUpdatedAt(*t)
+	}
+	return wec
+}
+
+// SetStatus sets the "status" field.
+func (wec *WaitlistEntryCreate) SetStatus(s string) *WaitlistEntryCreate {
+	wec.mutation.SetStatus(s)
+	return wec
+}
+
+// SetStartTime sets the "startTime" field.
+func (wec *WaitlistEntryCreate) SetStartTime(t time.Time) *WaitlistEntryCreate {
+	wec.mutation.SetStartTime(t)
+	return wec
+}
+
+// SetEndTime sets the "endTime" field.
+func (wec *WaitlistEntryCreate) SetEndTime(t time.Time) *WaitlistEntryCreate {
+	wec.mutation.SetEndTime(t)
+	return wec
+}
+
+// SetPriority sets the "priority" field.
+func (wec *WaitlistEntryCreate) SetPriority(i int) *WaitlistEntryCreate {
+	wec.mutation.SetPriority(i)
+	return wec
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (wec *WaitlistEntryCreate) SetNillablePriority(i *int) *WaitlistEntryCreate {
+	if i != nil {
+		wec.SetPriority(*i)
+	}
+	return wec
+}
+
+// SetMetadata sets the "metadata" field.
+func (wec *WaitlistEntryCreate) SetMetadata(m map[string]string) *WaitlistEntryCreate {
+	wec.mutation.SetMetadata(m)
+	return wec
+}
+
+// SetResourceId sets the "resourceId" field.
+func (wec *WaitlistEntryCreate) SetResourceId(i int) *WaitlistEntryCreate {
+	wec.mutation.SetResourceId(i)
+	return wec
+}
+
+// SetBookingId sets the "bookingId" field.
+func (wec *WaitlistEntryCreate) SetBookingId(i int) *WaitlistEntryCreate {
+	wec.mutation.SetBookingId(i)
+	return wec
+}
+
+// SetNillableBookingId sets the "bookingId" field if the given value is not nil.
+func (wec *WaitlistEntryCreate) SetNillableBookingId(i *int) *WaitlistEntryCreate {
+	if i != nil {
+		wec.SetBookingId(*i)
+	}
+	return wec
+}
+
+// SetOfferedAt sets the "offeredAt" field.
+func (wec *WaitlistEntryCreate) SetOfferedAt(t time.Time) *WaitlistEntryCreate {
+	wec.mutation.SetOfferedAt(t)
+	return wec
+}
+
+// SetNillableOfferedAt sets the "offeredAt" field if the given value is not nil.
+func (wec *WaitlistEntryCreate) SetNillableOfferedAt(t *time.Time) *WaitlistEntryCreate {
+	if t != nil {
+		wec.SetOfferedAt(*t)
+	}
+	return wec
+}
+
+// SetResourceID sets the "resource" edge to the Resource entity by ID.
+func (wec *WaitlistEntryCreate) SetResourceID(id int) *WaitlistEntryCreate {
+	wec.mutation.SetResourceID(id)
+	return wec
+}
+
+// SetResource sets the "resource" edge to the Resource entity.
+func (wec *WaitlistEntryCreate) SetResource(r *Resource) *WaitlistEntryCreate {
+	return wec.SetResourceID(r.ID)
+}
+
+// SetBookingID sets the "booking" edge to the Booking entity by ID.
+func (wec *WaitlistEntryCreate) SetBookingID(id int) *WaitlistEntryCreate {
+	wec.mutation.SetBookingID(id)
+	return wec
+}
+
+// SetNillableBookingID sets the "booking" edge to the Booking entity by ID if the given value is not nil.
+func (wec *WaitlistEntryCreate) SetNillableBookingID(id *int) *WaitlistEntryCreate {
+	if id != nil {
+		wec = wec.SetBookingID(*id)
+	}
+	return wec
+}
+
+// SetBooking sets the "booking" edge to the Booking entity.
+func (wec *WaitlistEntryCreate) SetBooking(b *Booking) *WaitlistEntryCreate {
+	return wec.SetBookingID(b.ID)
+}
+
+// Mutation returns the WaitlistEntryMutation object of the builder.
+func (wec *WaitlistEntryCreate) Mutation() *WaitlistEntryMutation {
+	return wec.mutation
+}
+
+// Save creates the WaitlistEntry in the database.
+func (wec *WaitlistEntryCreate) Save(ctx context.Context) (*WaitlistEntry, error) {
+	var (
+		err  error
+		node *WaitlistEntry
+	)
+	if err := wec.defaults(); err != nil {
+		return nil, err
+	}
+	if len(wec.hooks) == 0 {
+		if err = wec.check(); err != nil {
+			return nil, err
+		}
+		node, err = wec.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WaitlistEntryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wec.check(); err != nil {
+				return nil, err
+			}
+			wec.mutation = mutation
+			if node, err = wec.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wec.hooks) - 1; i >= 0; i-- {
+			if wec.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wec.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wec.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wec *WaitlistEntryCreate) SaveX(ctx context.Context) *WaitlistEntry {
+	v, err := wec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wec *WaitlistEntryCreate) Exec(ctx context.Context) error {
+	_, err := wec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wec *WaitlistEntryCreate) ExecX(ctx context.Context) {
+	if err := wec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wec *WaitlistEntryCreate) defaults() error {
+	if _, ok := wec.mutation.CreatedAt(); !ok {
+		if waitlistentry.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized waitlistentry.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := waitlistentry.DefaultCreatedAt()
+		wec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wec.mutation.UpdatedAt(); !ok {
+		if waitlistentry.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized waitlistentry.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := waitlistentry.DefaultUpdatedAt()
+		wec.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := wec.mutation.Priority(); !ok {
+		v := waitlistentry.DefaultPriority
+		wec.mutation.SetPriority(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wec *WaitlistEntryCreate) check() error {
+	if _, ok := wec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "createdAt", err: errors.New(`ent: missing required field "createdAt"`)}
+	}
+	if _, ok := wec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updatedAt", err: errors.New(`ent: missing required field "updatedAt"`)}
+	}
+	if _, ok := wec.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "status"`)}
+	}
+	if _, ok := wec.mutation.StartTime(); !ok {
+		return &ValidationError{Name: "startTime", err: errors.New(`ent: missing required field "startTime"`)}
+	}
+	if _, ok := wec.mutation.EndTime(); !ok {
+		return &ValidationError{Name: "endTime", err: errors.New(`ent: missing required field "endTime"`)}
+	}
+	if _, ok := wec.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "priority"`)}
+	}
+	if _, ok := wec.mutation.ResourceId(); !ok {
+		return &ValidationError{Name: "resourceId", err: errors.New(`ent: missing required field "resourceId"`)}
+	}
+	if _, ok := wec.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource", err: errors.New("ent: missing required edge \"resource\"")}
+	}
+	return nil
+}
+
+func (wec *WaitlistEntryCreate) sqlSave(ctx context.Context) (*WaitlistEntry, error) {
+	_node, _spec := wec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (wec *WaitlistEntryCreate) createSpec() (*WaitlistEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WaitlistEntry{config: wec.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: waitlistentry.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: waitlistentry.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = wec.conflict
+	if value, ok := wec.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: waitlistentry.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := wec.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: waitlistentry.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if value, ok := wec.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: waitlistentry.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := wec.mutation.StartTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: waitlistentry.FieldStartTime,
+		})
+		_node.StartTime = value
+	}
+	if value, ok := wec.mutation.EndTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: waitlistentry.FieldEndTime,
+		})
+		_node.EndTime = value
+	}
+	if value, ok := wec.mutation.Priority(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: waitlistentry.FieldPriority,
+		})
+		_node.Priority = value
+	}
+	if value, ok := wec.mutation.Metadata(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: waitlistentry.FieldMetadata,
+		})
+		_node.Metadata = value
+	}
+	if value, ok := wec.mutation.OfferedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: waitlistentry.FieldOfferedAt,
+		})
+		_node.OfferedAt = &value
+	}
+	if nodes := wec.mutation.ResourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.ResourceTable,
+			Columns: []string{waitlistentry.ResourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: resource.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ResourceId = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wec.mutation.BookingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.BookingTable,
+			Columns: []string{waitlistentry.BookingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BookingId = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WaitlistEntry.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WaitlistEntryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (wec *WaitlistEntryCreate) OnConflict(opts ...sql.ConflictOption) *WaitlistEntryUpsertOne {
+	wec.conflict = opts
+	return &WaitlistEntryUpsertOne{
+		create: wec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WaitlistEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wec *WaitlistEntryCreate) OnConflictColumns(columns ...string) *WaitlistEntryUpsertOne {
+	wec.conflict = append(wec.conflict, sql.ConflictColumns(columns...))
+	return &WaitlistEntryUpsertOne{
+		create: wec,
+	}
+}
+
+type (
+	// WaitlistEntryUpsertOne is the builder for "upsert"-ing
+	//  one WaitlistEntry node.
+	WaitlistEntryUpsertOne struct {
+		create *WaitlistEntryCreate
+	}
+
+	// WaitlistEntryUpsert is the "OnConflict" setter.
+	WaitlistEntryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *WaitlistEntryUpsert) SetCreatedAt(v time.Time) *WaitlistEntryUpsert {
+	u.Set(waitlistentry.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *WaitlistEntryUpsert) UpdateCreatedAt() *WaitlistEntryUpsert {
+	u.SetExcluded(waitlistentry.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *WaitlistEntryUpsert) SetUpdatedAt(v time.Time) *WaitlistEntryUpsert {
+	u.Set(waitlistentry.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *WaitlistEntryUpsert) UpdateUpdatedAt() *WaitlistEntryUpsert {
+	u.SetExcluded(waitlistentry.FieldUpdatedAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *WaitlistEntryUpsert) SetStatus(v string) *WaitlistEntryUpsert {
+	u.Set(waitlistentry.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WaitlistEntryUpsert) UpdateStatus() *WaitlistEntryUpsert {
+	u.SetExcluded(waitlistentry.FieldStatus)
+	return u
+}
+
+// SetStartTime sets the "startTime" field.
+func (u *WaitlistEntryUpsert) SetStartTime(v time.Time) *WaitlistEntryUpsert {
+	u.Set(waitlistentry.FieldStartTime, v)
+	return u
+}
+
+// UpdateStartTime sets the "startTime" field to the value that was provided on create.
+func (u *WaitlistEntryUpsert) UpdateStartTime() *WaitlistEntryUpsert {
+	u.SetExcluded(waitlistentry.FieldStartTime)
+	return u
+}
+
+// SetEndTime sets the "endTime" field.
+func (u *WaitlistEntryUpsert) SetEndTime(v time.Time) *WaitlistEntryUpsert {
+	u.Set(waitlistentry.FieldEndTime, v)
+	return u
+}
+
+// UpdateEndTime sets the "endTime" field to the value that was provided on create.
+func (u *WaitlistEntryUpsert) UpdateEndTime() *WaitlistEntryUpsert {
+	u.SetExcluded(waitlistentry.FieldEndTime)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *WaitlistEntryUpsert) SetPriority(v int) *WaitlistEntryUpsert {
+	u.Set(waitlistentry.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *WaitlistEntryUpsert) UpdatePriority() *WaitlistEntryUpsert {
+	u.SetExcluded(waitlistentry.FieldPriority)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *WaitlistEntryUpsert) SetMetadata(v map[string]string) *WaitlistEntryUpsert {
+	u.Set(waitlistentry.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *WaitlistEntryUpsert) UpdateMetadata() *WaitlistEntryUpsert {
+	u.SetExcluded(waitlistentry.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *WaitlistEntryUpsert) ClearMetadata() *WaitlistEntryUpsert {
+	u.SetNull(waitlistentry.FieldMetadata)
+	return u
+}
+
+// SetResourceId sets the "resourceId" field.
+func (u *WaitlistEntryUpsert) SetResourceId(v int) *WaitlistEntryUpsert {
+	u.Set(waitlistentry.FieldResourceId, v)
+	return u
+}
+
+// UpdateResourceId sets the "resourceId" field to the value that was provided on create.
+func (u *WaitlistEntryUpsert) UpdateResourceId() *WaitlistEntryUpsert {
+	u.SetExcluded(waitlistentry.FieldResourceId)
+	return u
+}
+
+// SetBookingId sets the "bookingId" field.
+func (u *WaitlistEntryUpsert) SetBookingId(v int) *WaitlistEntryUpsert {
+	u.Set(waitlistentry.FieldBookingId, v)
+	return u
+}
+
+// UpdateBookingId sets the "bookingId" field to the value that was provided on create.
+func (u *WaitlistEntryUpsert) UpdateBookingId() *WaitlistEntryUpsert {
+	u.SetExcluded(waitlistentry.FieldBookingId)
+	return u
+}
+
+// ClearBookingId clears the value of the "bookingId" field.
+func (u *WaitlistEntryUpsert) ClearBookingId() *WaitlistEntryUpsert {
+	u.SetNull(waitlistentry.FieldBookingId)
+	return u
+}
+
+// SetOfferedAt sets the "offeredAt" field.
+func (u *WaitlistEntryUpsert) SetOfferedAt(v time.Time) *WaitlistEntryUpsert {
+	u.Set(waitlistentry.FieldOfferedAt, v)
+	return u
+}
+
+// UpdateOfferedAt sets the "offeredAt" field to the value that was provided on create.
+func (u *WaitlistEntryUpsert) UpdateOfferedAt() *WaitlistEntryUpsert {
+	u.SetExcluded(waitlistentry.FieldOfferedAt)
+	return u
+}
+
+// ClearOfferedAt clears the value of the "offeredAt" field.
+func (u *WaitlistEntryUpsert) ClearOfferedAt() *WaitlistEntryUpsert {
+	u.SetNull(waitlistentry.FieldOfferedAt)
+	return u
+}
+
+// UpdateNewValues updates the fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.WaitlistEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WaitlistEntryUpsertOne) UpdateNewValues() *WaitlistEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WaitlistEntry.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WaitlistEntryUpsertOne) Ignore() *WaitlistEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WaitlistEntryUpsertOne) DoNothing() *WaitlistEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WaitlistEntryCreate.OnConflict
+// documentation for more info.
+func (u *WaitlistEntryUpsertOne) Update(set func(*WaitlistEntryUpsert)) *WaitlistEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WaitlistEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *WaitlistEntryUpsertOne) SetCreatedAt(v time.Time) *WaitlistEntryUpsertOne {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *WaitlistEntryUpsertOne) UpdateCreatedAt() *WaitlistEntryUpsertOne {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *WaitlistEntryUpsertOne) SetUpdatedAt(v time.Time) *WaitlistEntryUpsertOne {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *WaitlistEntryUpsertOne) UpdateUpdatedAt() *WaitlistEntryUpsertOne {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *WaitlistEntryUpsertOne) SetStatus(v string) *WaitlistEntryUpsertOne {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WaitlistEntryUpsertOne) UpdateStatus() *WaitlistEntryUpsertOne {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetStartTime sets the "startTime" field.
+func (u *WaitlistEntryUpsertOne) SetStartTime(v time.Time) *WaitlistEntryUpsertOne {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.SetStartTime(v)
+	})
+}
+
+// UpdateStartTime sets the "startTime" field to the value that was provided on create.
+func (u *WaitlistEntryUpsertOne) UpdateStartTime() *WaitlistEntryUpsertOne {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.UpdateStartTime()
+	})
+}
+
+// SetEndTime sets the "endTime" field.
+func (u *WaitlistEntryUpsertOne) SetEndTime(v time.Time) *WaitlistEntryUpsertOne {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.SetEndTime(v)
+	})
+}
+
+// UpdateEndTime sets the "endTime" field to the value that was provided on create.
+func (u *WaitlistEntryUpsertOne) UpdateEndTime() *WaitlistEntryUpsertOne {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.UpdateEndTime()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *WaitlistEntryUpsertOne) SetPriority(v int) *WaitlistEntryUpsertOne {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *WaitlistEntryUpsertOne) UpdatePriority() *WaitlistEntryUpsertOne {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *WaitlistEntryUpsertOne) SetMetadata(v map[string]string) *WaitlistEntryUpsertOne {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *WaitlistEntryUpsertOne) UpdateMetadata() *WaitlistEntryUpsertOne {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *WaitlistEntryUpsertOne) ClearMetadata() *WaitlistEntryUpsertOne {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetResourceId sets the "resourceId" field.
+func (u *WaitlistEntryUpsertOne) SetResourceId(v int) *WaitlistEntryUpsertOne {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.SetResourceId(v)
+	})
+}
+
+// UpdateResourceId sets the "resourceId" field to the value that was provided on create.
+func (u *WaitlistEntryUpsertOne) UpdateResourceId() *WaitlistEntryUpsertOne {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.UpdateResourceId()
+	})
+}
+
+// SetBookingId sets the "bookingId" field.
+func (u *WaitlistEntryUpsertOne) SetBookingId(v int) *WaitlistEntryUpsertOne {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.SetBookingId(v)
+	})
+}
+
+// UpdateBookingId sets the "bookingId" field to the value that was provided on create.
+func (u *WaitlistEntryUpsertOne) UpdateBookingId() *WaitlistEntryUpsertOne {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.UpdateBookingId()
+	})
+}
+
+// ClearBookingId clears the value of the "bookingId" field.
+func (u *WaitlistEntryUpsertOne) ClearBookingId() *WaitlistEntryUpsertOne {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.ClearBookingId()
+	})
+}
+
+// SetOfferedAt sets the "offeredAt" field.
+func (u *WaitlistEntryUpsertOne) SetOfferedAt(v time.Time) *WaitlistEntryUpsertOne {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.SetOfferedAt(v)
+	})
+}
+
+// UpdateOfferedAt sets the "offeredAt" field to the value that was provided on create.
+func (u *WaitlistEntryUpsertOne) UpdateOfferedAt() *WaitlistEntryUpsertOne {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.UpdateOfferedAt()
+	})
+}
+
+// ClearOfferedAt clears the value of the "offeredAt" field.
+func (u *WaitlistEntryUpsertOne) ClearOfferedAt() *WaitlistEntryUpsertOne {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.ClearOfferedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WaitlistEntryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WaitlistEntryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WaitlistEntryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WaitlistEntryUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WaitlistEntryUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WaitlistEntryCreateBulk is the builder for creating many WaitlistEntry entities in bulk.
+type WaitlistEntryCreateBulk struct {
+	config
+	builders []*WaitlistEntryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WaitlistEntry entities in the database.
+func (wecb *WaitlistEntryCreateBulk) Save(ctx context.Context) ([]*WaitlistEntry, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(wecb.builders))
+	nodes := make([]*WaitlistEntry, len(wecb.builders))
+	mutators := make([]Mutator, len(wecb.builders))
+	for i := range wecb.builders {
+		func(i int, root context.Context) {
+			builder := wecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WaitlistEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = wecb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wecb *WaitlistEntryCreateBulk) SaveX(ctx context.Context) []*WaitlistEntry {
+	v, err := wecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wecb *WaitlistEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := wecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wecb *WaitlistEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := wecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WaitlistEntry.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WaitlistEntryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (wecb *WaitlistEntryCreateBulk) OnConflict(opts ...sql.ConflictOption) *WaitlistEntryUpsertBulk {
+	wecb.conflict = opts
+	return &WaitlistEntryUpsertBulk{
+		create: wecb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WaitlistEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wecb *WaitlistEntryCreateBulk) OnConflictColumns(columns ...string) *WaitlistEntryUpsertBulk {
+	wecb.conflict = append(wecb.conflict, sql.ConflictColumns(columns...))
+	return &WaitlistEntryUpsertBulk{
+		create: wecb,
+	}
+}
+
+// WaitlistEntryUpsertBulk is the builder for "upsert"-ing
+// a bulk of WaitlistEntry nodes.
+type WaitlistEntryUpsertBulk struct {
+	create *WaitlistEntryCreateBulk
+}
+
+// UpdateNewValues updates the fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WaitlistEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WaitlistEntryUpsertBulk) UpdateNewValues() *WaitlistEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WaitlistEntry.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WaitlistEntryUpsertBulk) Ignore() *WaitlistEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WaitlistEntryUpsertBulk) DoNothing() *WaitlistEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WaitlistEntryCreateBulk.OnConflict
+// documentation for more info.
+func (u *WaitlistEntryUpsertBulk) Update(set func(*WaitlistEntryUpsert)) *WaitlistEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WaitlistEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (u *WaitlistEntryUpsertBulk) SetCreatedAt(v time.Time) *WaitlistEntryUpsertBulk {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "createdAt" field to the value that was provided on create.
+func (u *WaitlistEntryUpsertBulk) UpdateCreatedAt() *WaitlistEntryUpsertBulk {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *WaitlistEntryUpsertBulk) SetUpdatedAt(v time.Time) *WaitlistEntryUpsertBulk {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *WaitlistEntryUpsertBulk) UpdateUpdatedAt() *WaitlistEntryUpsertBulk {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *WaitlistEntryUpsertBulk) SetStatus(v string) *WaitlistEntryUpsertBulk {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WaitlistEntryUpsertBulk) UpdateStatus() *WaitlistEntryUpsertBulk {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetStartTime sets the "startTime" field.
+func (u *WaitlistEntryUpsertBulk) SetStartTime(v time.Time) *WaitlistEntryUpsertBulk {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.SetStartTime(v)
+	})
+}
+
+// UpdateStartTime sets the "startTime" field to the value that was provided on create.
+func (u *WaitlistEntryUpsertBulk) UpdateStartTime() *WaitlistEntryUpsertBulk {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.UpdateStartTime()
+	})
+}
+
+// SetEndTime sets the "endTime" field.
+func (u *WaitlistEntryUpsertBulk) SetEndTime(v time.Time) *WaitlistEntryUpsertBulk {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.SetEndTime(v)
+	})
+}
+
+// UpdateEndTime sets the "endTime" field to the value that was provided on create.
+func (u *WaitlistEntryUpsertBulk) UpdateEndTime() *WaitlistEntryUpsertBulk {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.UpdateEndTime()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *WaitlistEntryUpsertBulk) SetPriority(v int) *WaitlistEntryUpsertBulk {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *WaitlistEntryUpsertBulk) UpdatePriority() *WaitlistEntryUpsertBulk {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *WaitlistEntryUpsertBulk) SetMetadata(v map[string]string) *WaitlistEntryUpsertBulk {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *WaitlistEntryUpsertBulk) UpdateMetadata() *WaitlistEntryUpsertBulk {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *WaitlistEntryUpsertBulk) ClearMetadata() *WaitlistEntryUpsertBulk {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetResourceId sets the "resourceId" field.
+func (u *WaitlistEntryUpsertBulk) SetResourceId(v int) *WaitlistEntryUpsertBulk {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.SetResourceId(v)
+	})
+}
+
+// UpdateResourceId sets the "resourceId" field to the value that was provided on create.
+func (u *WaitlistEntryUpsertBulk) UpdateResourceId() *WaitlistEntryUpsertBulk {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.UpdateResourceId()
+	})
+}
+
+// SetBookingId sets the "bookingId" field.
+func (u *WaitlistEntryUpsertBulk) SetBookingId(v int) *WaitlistEntryUpsertBulk {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.SetBookingId(v)
+	})
+}
+
+// UpdateBookingId sets the "bookingId" field to the value that was provided on create.
+func (u *WaitlistEntryUpsertBulk) UpdateBookingId() *WaitlistEntryUpsertBulk {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.UpdateBookingId()
+	})
+}
+
+// ClearBookingId clears the value of the "bookingId" field.
+func (u *WaitlistEntryUpsertBulk) ClearBookingId() *WaitlistEntryUpsertBulk {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.ClearBookingId()
+	})
+}
+
+// SetOfferedAt sets the "offeredAt" field.
+func (u *WaitlistEntryUpsertBulk) SetOfferedAt(v time.Time) *WaitlistEntryUpsertBulk {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.SetOfferedAt(v)
+	})
+}
+
+// UpdateOfferedAt sets the "offeredAt" field to the value that was provided on create.
+func (u *WaitlistEntryUpsertBulk) UpdateOfferedAt() *WaitlistEntryUpsertBulk {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.UpdateOfferedAt()
+	})
+}
+
+// ClearOfferedAt clears the value of the "offeredAt" field.
+func (u *WaitlistEntryUpsertBulk) ClearOfferedAt() *WaitlistEntryUpsertBulk {
+	return u.Update(func(s *WaitlistEntryUpsert) {
+		s.ClearOfferedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WaitlistEntryUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WaitlistEntryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WaitlistEntryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WaitlistEntryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1092 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/predicate"
+	"github.com/openmesh/booking/ent/resource"
+	"github.com/openmesh/booking/ent/waitlistentry"
+)
+
+// WaitlistEntryQuery is the builder for querying WaitlistEntry entities.
+type WaitlistEntryQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.WaitlistEntry
+	// eager-loading edges.
+	withResource *ResourceQuery
+	withBooking  *BookingQuery
+	modifiers    []func(s *sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WaitlistEntryQuery builder.
+func (weq *WaitlistEntryQuery) Where(ps ...predicate.WaitlistEntry) *WaitlistEntryQuery {
+	weq.predicates = append(weq.predicates, ps...)
+	return weq
+}
+
+// Limit adds a limit step to the query.
+func (weq *WaitlistEntryQuery) Limit(limit int) *WaitlistEntryQuery {
+	weq.limit = &limit
+	return weq
+}
+
+// Offset adds an offset step to the query.
+func (weq *WaitlistEntryQuery) Offset(offset int) *WaitlistEntryQuery {
+	weq.offset = &offset
+	return weq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (weq *WaitlistEntryQuery) Unique(unique bool) *WaitlistEntryQuery {
+	weq.unique = &unique
+	return weq
+}
+
+// Order adds an order step to the query.
+func (weq *WaitlistEntryQuery) Order(o ...OrderFunc) *WaitlistEntryQuery {
+	weq.order = append(weq.order, o...)
+	return weq
+}
+
+// QueryResource chains the current query on the "resource" edge.
+func (weq *WaitlistEntryQuery) QueryResource() *ResourceQuery {
+	query := &ResourceQuery{config: weq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := weq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := weq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(waitlistentry.Table, waitlistentry.FieldID, selector),
+			sqlgraph.To(resource.Table, resource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, waitlistentry.ResourceTable, waitlistentry.ResourceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(weq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBooking chains the current query on the "booking" edge.
+func (weq *WaitlistEntryQuery) QueryBooking() *BookingQuery {
+	query := &BookingQuery{config: weq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := weq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := weq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(waitlistentry.Table, waitlistentry.FieldID, selector),
+			sqlgraph.To(booking.Table, booking.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, waitlistentry.BookingTable, waitlistentry.BookingColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(weq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first WaitlistEntry entity from the query.
+// Returns a *NotFoundError when no WaitlistEntry was found.
+func (weq *WaitlistEntryQuery) First(ctx context.Context) (*WaitlistEntry, error) {
+	nodes, err := weq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{waitlistentry.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (weq *WaitlistEntryQuery) FirstX(ctx context.Context) *WaitlistEntry {
+	node, err := weq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WaitlistEntry ID from the query.
+// Returns a *NotFoundError when no WaitlistEntry ID was found.
+func (weq *WaitlistEntryQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = weq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{waitlistentry.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (weq *WaitlistEntryQuery) FirstIDX(ctx context.Context) int {
+	id, err := weq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WaitlistEntry entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one WaitlistEntry entity is not found.
+// Returns a *NotFoundError when no WaitlistEntry entities are found.
+func (weq *WaitlistEntryQuery) Only(ctx context.Context) (*WaitlistEntry, error) {
+	nodes, err := weq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{waitlistentry.Label}
+	default:
+		return nil, &NotSingularError{waitlistentry.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (weq *WaitlistEntryQuery) OnlyX(ctx context.Context) *WaitlistEntry {
+	node, err := weq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WaitlistEntry ID in the query.
+// Returns a *NotSingularError when exactly one WaitlistEntry ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (weq *WaitlistEntryQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = weq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{waitlistentry.Label}
+	default:
+		err = &NotSingularError{waitlistentry.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (weq *WaitlistEntryQuery) OnlyIDX(ctx context.Context) int {
+	id, err := weq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WaitlistEntries.
+func (weq *WaitlistEntryQuery) All(ctx context.Context) ([]*WaitlistEntry, error) {
+	if err := weq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return weq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (weq *WaitlistEntryQuery) AllX(ctx context.Context) []*WaitlistEntry {
+	nodes, err := weq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WaitlistEntry IDs.
+func (weq *WaitlistEntryQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := weq.Select(waitlistentry.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (weq *WaitlistEntryQuery) IDsX(ctx context.Context) []int {
+	ids, err := weq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (weq *WaitlistEntryQuery) Count(ctx context.Context) (int, error) {
+	if err := weq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return weq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (weq *WaitlistEntryQuery) CountX(ctx context.Context) int {
+	count, err := weq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (weq *WaitlistEntryQuery) Exist(ctx context.Context) (bool, error) {
+	if err := weq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return weq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (weq *WaitlistEntryQuery) ExistX(ctx context.Context) bool {
+	exist, err := weq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WaitlistEntryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (weq *WaitlistEntryQuery) Clone() *WaitlistEntryQuery {
+	if weq == nil {
+		return nil
+	}
+	return &WaitlistEntryQuery{
+		config:       weq.config,
+		limit:        weq.limit,
+		offset:       weq.offset,
+		order:        append([]OrderFunc{}, weq.order...),
+		predicates:   append([]predicate.WaitlistEntry{}, weq.predicates...),
+		withResource: weq.withResource.Clone(),
+		withBooking:  weq.withBooking.Clone(),
+		// clone intermediate query.
+		sql:  weq.sql.Clone(),
+		path: weq.path,
+	}
+}
+
+// WithResource tells the query-builder to eager-load the nodes that are connected to
+// the "resource" edge. The optional arguments are used to configure the query builder of the edge.
+func (weq *WaitlistEntryQuery) WithResource(opts ...func(*ResourceQuery)) *WaitlistEntryQuery {
+	query := &ResourceQuery{config: weq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	weq.withResource = query
+	return weq
+}
+
+// WithBooking tells the query-builder to eager-load the nodes that are connected to
+// the "booking" edge. The optional arguments are used to configure the query builder of the edge.
+func (weq *WaitlistEntryQuery) WithBooking(opts ...func(*BookingQuery)) *WaitlistEntryQuery {
+	query := &BookingQuery{config: weq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	weq.withBooking = query
+	return weq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"createdAt,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WaitlistEntry.Query().
+//		GroupBy(waitlistentry.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (weq *WaitlistEntryQuery) GroupBy(field string, fields ...string) *WaitlistEntryGroupBy {
+	group := &WaitlistEntryGroupBy{config: weq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := weq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return weq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"createdAt,omitempty"`
+//	}
+//
+//	client.WaitlistEntry.Query().
+//		Select(waitlistentry.FieldCreatedAt).
+//		Scan(ctx, &v)
+//
+func (weq *WaitlistEntryQuery) Select(fields ...string) *WaitlistEntrySelect {
+	weq.fields = append(weq.fields, fields...)
+	return &WaitlistEntrySelect{WaitlistEntryQuery: weq}
+}
+
+func (weq *WaitlistEntryQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range weq.fields {
+		if !waitlistentry.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if weq.path != nil {
+		prev, err := weq.path(ctx)
+		if err != nil {
+			return err
+		}
+		weq.sql = prev
+	}
+	if waitlistentry.Policy == nil {
+		return errors.New("ent: uninitialized waitlistentry.Policy (forgotten import ent/runtime?)")
+	}
+	if err := waitlistentry.Policy.EvalQuery(ctx, weq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (weq *WaitlistEntryQuery) sqlAll(ctx context.Context) ([]*WaitlistEntry, error) {
+	var (
+		nodes       = []*WaitlistEntry{}
+		_spec       = weq.querySpec()
+		loadedTypes = [2]bool{
+			weq.withResource != nil,
+			weq.withBooking != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &WaitlistEntry{config: weq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(weq.modifiers) > 0 {
+		_spec.Modifiers = weq.modifiers
+	}
+	if err := sqlgraph.QueryNodes(ctx, weq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := weq.withResource; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*WaitlistEntry)
+		for i := range nodes {
+			fk := nodes[i].ResourceId
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(resource.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "resourceId" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Resource = n
+			}
+		}
+	}
+
+	if query := weq.withBooking; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*WaitlistEntry)
+		for i := range nodes {
+			if nodes[i].BookingId == nil {
+				continue
+			}
+			fk := *nodes[i].BookingId
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(booking.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "bookingId" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Booking = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (weq *WaitlistEntryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := weq.querySpec()
+	if len(weq.modifiers) > 0 {
+		_spec.Modifiers = weq.modifiers
+	}
+	return sqlgraph.CountNodes(ctx, weq.driver, _spec)
+}
+
+func (weq *WaitlistEntryQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := weq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (weq *WaitlistEntryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   waitlistentry.Table,
+			Columns: waitlistentry.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: waitlistentry.FieldID,
+			},
+		},
+		From:   weq.sql,
+		Unique: true,
+	}
+	if unique := weq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := weq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, waitlistentry.FieldID)
+		for i := range fields {
+			if fields[i] != waitlistentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := weq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := weq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := weq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := weq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (weq *WaitlistEntryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(weq.driver.Dialect())
+	t1 := builder.Table(waitlistentry.Table)
+	columns := weq.fields
+	if len(columns) == 0 {
+		columns = waitlistentry.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if weq.sql != nil {
+		selector = weq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	for _, m := range weq.modifiers {
+		m(selector)
+	}
+	for _, p := range weq.predicates {
+		p(selector)
+	}
+	for _, p := range weq.order {
+		p(selector)
+	}
+	if offset := weq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := weq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (weq *WaitlistEntryQuery) ForUpdate(opts ...sql.LockOption) *WaitlistEntryQuery {
+	if weq.driver.Dialect() == dialect.Postgres {
+		weq.Unique(false)
+	}
+	weq.modifiers = append(weq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return weq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (weq *WaitlistEntryQuery) ForShare(opts ...sql.LockOption) *WaitlistEntryQuery {
+	if weq.driver.Dialect() == dialect.Postgres {
+		weq.Unique(false)
+	}
+	weq.modifiers = append(weq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return weq
+}
+
+// WaitlistEntryGroupBy is the group-by builder for WaitlistEntry entities.
+type WaitlistEntryGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wegb *WaitlistEntryGroupBy) Aggregate(fns ...AggregateFunc) *WaitlistEntryGroupBy {
+	wegb.fns = append(wegb.fns, fns...)
+	return wegb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (wegb *WaitlistEntryGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := wegb.path(ctx)
+	if err != nil {
+		return err
+	}
+	wegb.sql = query
+	return wegb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (wegb *WaitlistEntryGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := wegb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wegb *WaitlistEntryGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(wegb.fields) > 1 {
+		return nil, errors.New("ent: WaitlistEntryGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := wegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (wegb *WaitlistEntryGroupBy) StringsX(ctx context.Context) []string {
+	v, err := wegb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wegb *WaitlistEntryGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = wegb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{waitlistentry.Label}
+	default:
+		err = fmt.Errorf("ent: WaitlistEntryGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (wegb *WaitlistEntryGroupBy) StringX(ctx context.Context) string {
+	v, err := wegb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wegb *WaitlistEntryGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(wegb.fields) > 1 {
+		return nil, errors.New("ent: WaitlistEntryGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := wegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (wegb *WaitlistEntryGroupBy) IntsX(ctx context.Context) []int {
+	v, err := wegb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wegb *WaitlistEntryGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = wegb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{waitlistentry.Label}
+	default:
+		err = fmt.Errorf("ent: WaitlistEntryGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (wegb *WaitlistEntryGroupBy) IntX(ctx context.Context) int {
+	v, err := wegb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wegb *WaitlistEntryGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(wegb.fields) > 1 {
+		return nil, errors.New("ent: WaitlistEntryGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := wegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (wegb *WaitlistEntryGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := wegb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wegb *WaitlistEntryGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = wegb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{waitlistentry.Label}
+	default:
+		err = fmt.Errorf("ent: WaitlistEntryGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (wegb *WaitlistEntryGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := wegb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wegb *WaitlistEntryGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(wegb.fields) > 1 {
+		return nil, errors.New("ent: WaitlistEntryGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := wegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (wegb *WaitlistEntryGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := wegb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wegb *WaitlistEntryGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = wegb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{waitlistentry.Label}
+	default:
+		err = fmt.Errorf("ent: WaitlistEntryGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (wegb *WaitlistEntryGroupBy) BoolX(ctx context.Context) bool {
+	v, err := wegb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (wegb *WaitlistEntryGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range wegb.fields {
+		if !waitlistentry.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := wegb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wegb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wegb *WaitlistEntryGroupBy) sqlQuery() *sql.Selector {
+	selector := wegb.sql.Select()
+	aggregation := make([]string, 0, len(wegb.fns))
+	for _, fn := range wegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(wegb.fields)+len(wegb.fns))
+		for _, f := range wegb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		for _, c := range aggregation {
+			columns = append(columns, c)
+		}
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(wegb.fields...)...)
+}
+
+// WaitlistEntrySelect is the builder for selecting fields of WaitlistEntry entities.
+type WaitlistEntrySelect struct {
+	*WaitlistEntryQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wes *WaitlistEntrySelect) Scan(ctx context.Context, v interface{}) error {
+	if err := wes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	wes.sql = wes.WaitlistEntryQuery.sqlQuery(ctx)
+	return wes.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (wes *WaitlistEntrySelect) ScanX(ctx context.Context, v interface{}) {
+	if err := wes.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (wes *WaitlistEntrySelect) Strings(ctx context.Context) ([]string, error) {
+	if len(wes.fields) > 1 {
+		return nil, errors.New("ent: WaitlistEntrySelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := wes.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (wes *WaitlistEntrySelect) StringsX(ctx context.Context) []string {
+	v, err := wes.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (wes *WaitlistEntrySelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = wes.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{waitlistentry.Label}
+	default:
+		err = fmt.Errorf("ent: WaitlistEntrySelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (wes *WaitlistEntrySelect) StringX(ctx context.Context) string {
+	v, err := wes.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (wes *WaitlistEntrySelect) Ints(ctx context.Context) ([]int, error) {
+	if len(wes.fields) > 1 {
+		return nil, errors.New("ent: WaitlistEntrySelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := wes.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (wes *WaitlistEntrySelect) IntsX(ctx context.Context) []int {
+	v, err := wes.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (wes *WaitlistEntrySelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = wes.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{waitlistentry.Label}
+	default:
+		err = fmt.Errorf("ent: WaitlistEntrySelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (wes *WaitlistEntrySelect) IntX(ctx context.Context) int {
+	v, err := wes.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (wes *WaitlistEntrySelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(wes.fields) > 1 {
+		return nil, errors.New("ent: WaitlistEntrySelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := wes.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (wes *WaitlistEntrySelect) Float64sX(ctx context.Context) []float64 {
+	v, err := wes.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (wes *WaitlistEntrySelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = wes.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{waitlistentry.Label}
+	default:
+		err = fmt.Errorf("ent: WaitlistEntrySelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (wes *WaitlistEntrySelect) Float64X(ctx context.Context) float64 {
+	v, err := wes.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (wes *WaitlistEntrySelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(wes.fields) > 1 {
+		return nil, errors.New("ent: WaitlistEntrySelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := wes.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (wes *WaitlistEntrySelect) BoolsX(ctx context.Context) []bool {
+	v, err := wes.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (wes *WaitlistEntrySelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = wes.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{waitlistentry.Label}
+	default:
+		err = fmt.Errorf("ent: WaitlistEntrySelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (wes *WaitlistEntrySelect) BoolX(ctx context.Context) bool {
+	v, err := wes.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (wes *WaitlistEntrySelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := wes.sql.Query()
+	if err := wes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,866 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmesh/booking/ent/booking"
+	"github.com/openmesh/booking/ent/predicate"
+	"github.com/openmesh/booking/ent/resource"
+	"github.com/openmesh/booking/ent/waitlistentry"
+)
+
+// WaitlistEntryUpdate is the builder for updating WaitlistEntry entities.
+type WaitlistEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WaitlistEntryMutation
+}
+
+// Where appends a list predicates to the WaitlistEntryUpdate builder.
+func (weu *WaitlistEntryUpdate) Where(ps ...predicate.WaitlistEntry) *WaitlistEntryUpdate {
+	weu.mutation.Where(ps...)
+	return weu
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (weu *WaitlistEntryUpdate) SetUpdatedAt(t time.Time) *WaitlistEntryUpdate {
+	weu.mutation.SetUpdatedAt(t)
+	return weu
+}
+
+// SetStatus sets the "status" field.
+func (weu *WaitlistEntryUpdate) SetStatus(s string) *WaitlistEntryUpdate {
+	weu.mutation.SetStatus(s)
+	return weu
+}
+
+// SetStartTime sets the "startTime" field.
+func (weu *WaitlistEntryUpdate) SetStartTime(t time.Time) *WaitlistEntryUpdate {
+	weu.mutation.SetStartTime(t)
+	return weu
+}
+
+// SetEndTime sets the "endTime" field.
+func (weu *WaitlistEntryUpdate) SetEndTime(t time.Time) *WaitlistEntryUpdate {
+	weu.mutation.SetEndTime(t)
+	return weu
+}
+
+// SetPriority sets the "priority" field.
+func (weu *WaitlistEntryUpdate) SetPriority(i int) *WaitlistEntryUpdate {
+	weu.mutation.ResetPriority()
+	weu.mutation.SetPriority(i)
+	return weu
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (weu *WaitlistEntryUpdate) SetNillablePriority(i *int) *WaitlistEntryUpdate {
+	if i != nil {
+		weu.SetPriority(*i)
+	}
+	return weu
+}
+
+// AddPriority adds i to the "priority" field.
+func (weu *WaitlistEntryUpdate) AddPriority(i int) *WaitlistEntryUpdate {
+	weu.mutation.AddPriority(i)
+	return weu
+}
+
+// SetMetadata sets the "metadata" field.
+func (weu *WaitlistEntryUpdate) SetMetadata(m map[string]string) *WaitlistEntryUpdate {
+	weu.mutation.SetMetadata(m)
+	return weu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (weu *WaitlistEntryUpdate) ClearMetadata() *WaitlistEntryUpdate {
+	weu.mutation.ClearMetadata()
+	return weu
+}
+
+// SetResourceId sets the "resourceId" field.
+func (weu *WaitlistEntryUpdate) SetResourceId(i int) *WaitlistEntryUpdate {
+	weu.mutation.SetResourceId(i)
+	return weu
+}
+
+// SetBookingId sets the "bookingId" field.
+func (weu *WaitlistEntryUpdate) SetBookingId(i int) *WaitlistEntryUpdate {
+	weu.mutation.SetBookingId(i)
+	return weu
+}
+
+// SetNillableBookingId sets the "bookingId" field if the given value is not nil.
+func (weu *WaitlistEntryUpdate) SetNillableBookingId(i *int) *WaitlistEntryUpdate {
+	if i != nil {
+		weu.SetBookingId(*i)
+	}
+	return weu
+}
+
+// ClearBookingId clears the value of the "bookingId" field.
+func (weu *WaitlistEntryUpdate) ClearBookingId() *WaitlistEntryUpdate {
+	weu.mutation.ClearBookingId()
+	return weu
+}
+
+// SetOfferedAt sets the "offeredAt" field.
+func (weu *WaitlistEntryUpdate) SetOfferedAt(t time.Time) *WaitlistEntryUpdate {
+	weu.mutation.SetOfferedAt(t)
+	return weu
+}
+
+// SetNillableOfferedAt sets the "offeredAt" field if the given value is not nil.
+func (weu *WaitlistEntryUpdate) SetNillableOfferedAt(t *time.Time) *WaitlistEntryUpdate {
+	if t != nil {
+		weu.SetOfferedAt(*t)
+	}
+	return weu
+}
+
+// ClearOfferedAt clears the value of the "offeredAt" field.
+func (weu *WaitlistEntryUpdate) ClearOfferedAt() *WaitlistEntryUpdate {
+	weu.mutation.ClearOfferedAt()
+	return weu
+}
+
+// SetResourceID sets the "resource" edge to the Resource entity by ID.
+func (weu *WaitlistEntryUpdate) SetResourceID(id int) *WaitlistEntryUpdate {
+	weu.mutation.SetResourceID(id)
+	return weu
+}
+
+// SetResource sets the "resource" edge to the Resource entity.
+func (weu *WaitlistEntryUpdate) SetResource(r *Resource) *WaitlistEntryUpdate {
+	return weu.SetResourceID(r.ID)
+}
+
+// SetBookingID sets the "booking" edge to the Booking entity by ID.
+func (weu *WaitlistEntryUpdate) SetBookingID(id int) *WaitlistEntryUpdate {
+	weu.mutation.SetBookingID(id)
+	return weu
+}
+
+// SetNillableBookingID sets the "booking" edge to the Booking entity by ID if the given value is not nil.
+func (weu *WaitlistEntryUpdate) SetNillableBookingID(id *int) *WaitlistEntryUpdate {
+	if id != nil {
+		weu = weu.SetBookingID(*id)
+	}
+	return weu
+}
+
+// SetBooking sets the "booking" edge to the Booking entity.
+func (weu *WaitlistEntryUpdate) SetBooking(b *Booking) *WaitlistEntryUpdate {
+	return weu.SetBookingID(b.ID)
+}
+
+// Mutation returns the WaitlistEntryMutation object of the builder.
+func (weu *WaitlistEntryUpdate) Mutation() *WaitlistEntryMutation {
+	return weu.mutation
+}
+
+// ClearResource clears the "resource" edge to the Resource entity.
+func (weu *WaitlistEntryUpdate) ClearResource() *WaitlistEntryUpdate {
+	weu.mutation.ClearResource()
+	return weu
+}
+
+// ClearBooking clears the "booking" edge to the Booking entity.
+func (weu *WaitlistEntryUpdate) ClearBooking() *WaitlistEntryUpdate {
+	weu.mutation.ClearBooking()
+	return weu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (weu *WaitlistEntryUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if err := weu.defaults(); err != nil {
+		return 0, err
+	}
+	if len(weu.hooks) == 0 {
+		if err = weu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = weu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WaitlistEntryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = weu.check(); err != nil {
+				return 0, err
+			}
+			weu.mutation = mutation
+			affected, err = weu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(weu.hooks) - 1; i >= 0; i-- {
+			if weu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = weu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, weu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (weu *WaitlistEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := weu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (weu *WaitlistEntryUpdate) Exec(ctx context.Context) error {
+	_, err := weu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (weu *WaitlistEntryUpdate) ExecX(ctx context.Context) {
+	if err := weu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (weu *WaitlistEntryUpdate) defaults() error {
+	if _, ok := weu.mutation.UpdatedAt(); !ok {
+		if waitlistentry.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized waitlistentry.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := waitlistentry.UpdateDefaultUpdatedAt()
+		weu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (weu *WaitlistEntryUpdate) check() error {
+	if _, ok := weu.mutation.ResourceID(); weu.mutation.ResourceCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"resource\"")
+	}
+	return nil
+}
+
+func (weu *WaitlistEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   waitlistentry.Table,
+			Columns: waitlistentry.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: waitlistentry.FieldID,
+			},
+		},
+	}
+	if ps := weu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := weu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: waitlistentry.FieldUpdatedAt,
+		})
+	}
+	if value, ok := weu.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: waitlistentry.FieldStatus,
+		})
+	}
+	if value, ok := weu.mutation.StartTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: waitlistentry.FieldStartTime,
+		})
+	}
+	if value, ok := weu.mutation.EndTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: waitlistentry.FieldEndTime,
+		})
+	}
+	if value, ok := weu.mutation.Priority(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: waitlistentry.FieldPriority,
+		})
+	}
+	if value, ok := weu.mutation.AddedPriority(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: waitlistentry.FieldPriority,
+		})
+	}
+	if value, ok := weu.mutation.Metadata(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: waitlistentry.FieldMetadata,
+		})
+	}
+	if weu.mutation.MetadataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: waitlistentry.FieldMetadata,
+		})
+	}
+	if value, ok := weu.mutation.OfferedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: waitlistentry.FieldOfferedAt,
+		})
+	}
+	if weu.mutation.OfferedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: waitlistentry.FieldOfferedAt,
+		})
+	}
+	if weu.mutation.ResourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.ResourceTable,
+			Columns: []string{waitlistentry.ResourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: resource.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weu.mutation.ResourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.ResourceTable,
+			Columns: []string{waitlistentry.ResourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: resource.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if weu.mutation.BookingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.BookingTable,
+			Columns: []string{waitlistentry.BookingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weu.mutation.BookingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.BookingTable,
+			Columns: []string{waitlistentry.BookingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, weu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{waitlistentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WaitlistEntryUpdateOne is the builder for updating a single WaitlistEntry entity.
+type WaitlistEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WaitlistEntryMutation
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (weuo *WaitlistEntryUpdateOne) SetUpdatedAt(t time.Time) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetUpdatedAt(t)
+	return weuo
+}
+
+// SetStatus sets the "status" field.
+func (weuo *WaitlistEntryUpdateOne) SetStatus(s string) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetStatus(s)
+	return weuo
+}
+
+// SetStartTime sets the "startTime" field.
+func (weuo *WaitlistEntryUpdateOne) SetStartTime(t time.Time) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetStartTime(t)
+	return weuo
+}
+
+// SetEndTime sets the "endTime" field.
+func (weuo *WaitlistEntryUpdateOne) SetEndTime(t time.Time) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetEndTime(t)
+	return weuo
+}
+
+// SetPriority sets the "priority" field.
+func (weuo *WaitlistEntryUpdateOne) SetPriority(i int) *WaitlistEntryUpdateOne {
+	weuo.mutation.ResetPriority()
+	weuo.mutation.SetPriority(i)
+	return weuo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (weuo *WaitlistEntryUpdateOne) SetNillablePriority(i *int) *WaitlistEntryUpdateOne {
+	if i != nil {
+		weuo.SetPriority(*i)
+	}
+	return weuo
+}
+
+// AddPriority adds i to the "priority" field.
+func (weuo *WaitlistEntryUpdateOne) AddPriority(i int) *WaitlistEntryUpdateOne {
+	weuo.mutation.AddPriority(i)
+	return weuo
+}
+
+// SetMetadata sets the "metadata" field.
+func (weuo *WaitlistEntryUpdateOne) SetMetadata(m map[string]string) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetMetadata(m)
+	return weuo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (weuo *WaitlistEntryUpdateOne) ClearMetadata() *WaitlistEntryUpdateOne {
+	weuo.mutation.ClearMetadata()
+	return weuo
+}
+
+// SetResourceId sets the "resourceId" field.
+func (weuo *WaitlistEntryUpdateOne) SetResourceId(i int) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetResourceId(i)
+	return weuo
+}
+
+// SetBookingId sets the "bookingId" field.
+func (weuo *WaitlistEntryUpdateOne) SetBookingId(i int) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetBookingId(i)
+	return weuo
+}
+
+// SetNillableBookingId sets the "bookingId" field if the given value is not nil.
+func (weuo *WaitlistEntryUpdateOne) SetNillableBookingId(i *int) *WaitlistEntryUpdateOne {
+	if i != nil {
+		weuo.SetBookingId(*i)
+	}
+	return weuo
+}
+
+// ClearBookingId clears the value of the "bookingId" field.
+func (weuo *WaitlistEntryUpdateOne) ClearBookingId() *WaitlistEntryUpdateOne {
+	weuo.mutation.ClearBookingId()
+	return weuo
+}
+
+// SetOfferedAt sets the "offeredAt" field.
+func (weuo *WaitlistEntryUpdateOne) SetOfferedAt(t time.Time) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetOfferedAt(t)
+	return weuo
+}
+
+// SetNillableOfferedAt sets the "offeredAt" field if the given value is not nil.
+func (weuo *WaitlistEntryUpdateOne) SetNillableOfferedAt(t *time.Time) *WaitlistEntryUpdateOne {
+	if t != nil {
+		weuo.SetOfferedAt(*t)
+	}
+	return weuo
+}
+
+// ClearOfferedAt clears the value of the "offeredAt" field.
+func (weuo *WaitlistEntryUpdateOne) ClearOfferedAt() *WaitlistEntryUpdateOne {
+	weuo.mutation.ClearOfferedAt()
+	return weuo
+}
+
+// SetResourceID sets the "resource" edge to the Resource entity by ID.
+func (weuo *WaitlistEntryUpdateOne) SetResourceID(id int) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetResourceID(id)
+	return weuo
+}
+
+// SetResource sets the "resource" edge to the Resource entity.
+func (weuo *WaitlistEntryUpdateOne) SetResource(r *Resource) *WaitlistEntryUpdateOne {
+	return weuo.SetResourceID(r.ID)
+}
+
+// SetBookingID sets the "booking" edge to the Booking entity by ID.
+func (weuo *WaitlistEntryUpdateOne) SetBookingID(id int) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetBookingID(id)
+	return weuo
+}
+
+// SetNillableBookingID sets the "booking" edge to the Booking entity by ID if the given value is not nil.
+func (weuo *WaitlistEntryUpdateOne) SetNillableBookingID(id *int) *WaitlistEntryUpdateOne {
+	if id != nil {
+		weuo = weuo.SetBookingID(*id)
+	}
+	return weuo
+}
+
+// SetBooking sets the "booking" edge to the Booking entity.
+func (weuo *WaitlistEntryUpdateOne) SetBooking(b *Booking) *WaitlistEntryUpdateOne {
+	return weuo.SetBookingID(b.ID)
+}
+
+// Mutation returns the WaitlistEntryMutation object of the builder.
+func (weuo *WaitlistEntryUpdateOne) Mutation() *WaitlistEntryMutation {
+	return weuo.mutation
+}
+
+// ClearResource clears the "resource" edge to the Resource entity.
+func (weuo *WaitlistEntryUpdateOne) ClearResource() *WaitlistEntryUpdateOne {
+	weuo.mutation.ClearResource()
+	return weuo
+}
+
+// ClearBooking clears the "booking" edge to the Booking entity.
+func (weuo *WaitlistEntryUpdateOne) ClearBooking() *WaitlistEntryUpdateOne {
+	weuo.mutation.ClearBooking()
+	return weuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (weuo *WaitlistEntryUpdateOne) Select(field string, fields ...string) *WaitlistEntryUpdateOne {
+	weuo.fields = append([]string{field}, fields...)
+	return weuo
+}
+
+// Save executes the query and returns the updated WaitlistEntry entity.
+func (weuo *WaitlistEntryUpdateOne) Save(ctx context.Context) (*WaitlistEntry, error) {
+	var (
+		err  error
+		node *WaitlistEntry
+	)
+	if err := weuo.defaults(); err != nil {
+		return nil, err
+	}
+	if len(weuo.hooks) == 0 {
+		if err = weuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = weuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WaitlistEntryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = weuo.check(); err != nil {
+				return nil, err
+			}
+			weuo.mutation = mutation
+			node, err = weuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(weuo.hooks) - 1; i >= 0; i-- {
+			if weuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = weuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, weuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (weuo *WaitlistEntryUpdateOne) SaveX(ctx context.Context) *WaitlistEntry {
+	node, err := weuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (weuo *WaitlistEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := weuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (weuo *WaitlistEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := weuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (weuo *WaitlistEntryUpdateOne) defaults() error {
+	if _, ok := weuo.mutation.UpdatedAt(); !ok {
+		if waitlistentry.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized waitlistentry.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := waitlistentry.UpdateDefaultUpdatedAt()
+		weuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (weuo *WaitlistEntryUpdateOne) check() error {
+	if _, ok := weuo.mutation.ResourceID(); weuo.mutation.ResourceCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"resource\"")
+	}
+	return nil
+}
+
+func (weuo *WaitlistEntryUpdateOne) sqlSave(ctx context.Context) (_node *WaitlistEntry, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   waitlistentry.Table,
+			Columns: waitlistentry.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: waitlistentry.FieldID,
+			},
+		},
+	}
+	id, ok := weuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing WaitlistEntry.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := weuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, waitlistentry.FieldID)
+		for _, f := range fields {
+			if !waitlistentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != waitlistentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := weuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := weuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: waitlistentry.FieldUpdatedAt,
+		})
+	}
+	if value, ok := weuo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: waitlistentry.FieldStatus,
+		})
+	}
+	if value, ok := weuo.mutation.StartTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: waitlistentry.FieldStartTime,
+		})
+	}
+	if value, ok := weuo.mutation.EndTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: waitlistentry.FieldEndTime,
+		})
+	}
+	if value, ok := weuo.mutation.Priority(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: waitlistentry.FieldPriority,
+		})
+	}
+	if value, ok := weuo.mutation.AddedPriority(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: waitlistentry.FieldPriority,
+		})
+	}
+	if value, ok := weuo.mutation.Metadata(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: waitlistentry.FieldMetadata,
+		})
+	}
+	if weuo.mutation.MetadataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: waitlistentry.FieldMetadata,
+		})
+	}
+	if value, ok := weuo.mutation.OfferedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: waitlistentry.FieldOfferedAt,
+		})
+	}
+	if weuo.mutation.OfferedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: waitlistentry.FieldOfferedAt,
+		})
+	}
+	if weuo.mutation.ResourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.ResourceTable,
+			Columns: []string{waitlistentry.ResourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: resource.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weuo.mutation.ResourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.ResourceTable,
+			Columns: []string{waitlistentry.ResourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: resource.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if weuo.mutation.BookingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.BookingTable,
+			Columns: []string{waitlistentry.BookingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weuo.mutation.BookingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.BookingTable,
+			Columns: []string{waitlistentry.BookingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: booking.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WaitlistEntry{config: weuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, weuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{waitlistentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
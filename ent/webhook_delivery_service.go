@@ -0,0 +1,164 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/privacy"
+	"github.com/openmesh/booking"
+	"github.com/openmesh/booking/ent/webhook"
+	"github.com/openmesh/booking/ent/webhookdelivery"
+)
+
+type webhookDeliveryService struct {
+	client *Client
+}
+
+// NewWebhookDeliveryService constructs a new instance of a
+// booking.WebhookDeliveryService using ent as its persistence layer. The
+// delivery worker acts on behalf of every organization so privacy rules are
+// bypassed by all of its methods.
+func NewWebhookDeliveryService(client *Client) *webhookDeliveryService {
+	return &webhookDeliveryService{client}
+}
+
+// CreateWebhookDeliveries creates a pending delivery of event for each of the
+// organization's enabled webhooks that are subscribed to its type.
+func (s *webhookDeliveryService) CreateWebhookDeliveries(
+	ctx context.Context,
+	organizationID int,
+	event booking.Event,
+) ([]*booking.WebhookDelivery, error) {
+	if !booking.WebhookEventType(event.Type) {
+		return nil, nil
+	}
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	w, err := s.client.Webhook.
+		Query().
+		Where(
+			webhook.OrganizationId(organizationID),
+			webhook.Enabled(true),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhooks: %w", err)
+	}
+
+	now := time.Now()
+	payload, err := newWebhookPayload(event, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+
+	var builders []*WebhookDeliveryCreate
+	for _, v := range w {
+		if !v.toModel().Subscribed(event.Type) {
+			continue
+		}
+		builders = append(builders, s.client.WebhookDelivery.
+			Create().
+			SetEventType(event.Type).
+			SetPayload(payload).
+			SetStatus(booking.WebhookDeliveryStatusPending).
+			SetNextAttemptAt(now).
+			SetWebhookID(v.ID).
+			SetOrganizationId(organizationID))
+	}
+	if len(builders) == 0 {
+		return nil, nil
+	}
+
+	d, err := s.client.WebhookDelivery.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook deliveries: %w", err)
+	}
+	return WebhookDeliveries(d).toModels(), nil
+}
+
+// ClaimWebhookDeliveries retrieves up to limit pending deliveries that are due.
+// Each delivery is claimed by pushing back its next attempt by lease, which
+// only succeeds if no other worker has claimed it in the meantime.
+func (s *webhookDeliveryService) ClaimWebhookDeliveries(
+	ctx context.Context,
+	limit int,
+	lease time.Duration,
+) ([]*booking.WebhookDelivery, error) {
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	now := time.Now()
+
+	due, err := s.client.WebhookDelivery.
+		Query().
+		Where(
+			webhookdelivery.Status(booking.WebhookDeliveryStatusPending),
+			webhookdelivery.NextAttemptAtLTE(now),
+		).
+		Order(Asc(webhookdelivery.FieldNextAttemptAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query due webhook deliveries: %w", err)
+	}
+
+	var ids []int
+	for _, d := range due {
+		a, err := s.client.WebhookDelivery.
+			Update().
+			Where(
+				webhookdelivery.ID(d.ID),
+				webhookdelivery.Status(booking.WebhookDeliveryStatusPending),
+				webhookdelivery.NextAttemptAt(d.NextAttemptAt),
+			).
+			SetNextAttemptAt(now.Add(lease)).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to claim webhook delivery: %w", err)
+		}
+		if a == 1 {
+			ids = append(ids, d.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	claimed, err := s.client.WebhookDelivery.
+		Query().
+		Where(webhookdelivery.IDIn(ids...)).
+		WithWebhook().
+		Order(Asc(webhookdelivery.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query claimed webhook deliveries: %w", err)
+	}
+	return WebhookDeliveries(claimed).toModels(), nil
+}
+
+// RecordWebhookDeliveryAttempt updates a delivery with the outcome of an
+// attempt to send it.
+func (s *webhookDeliveryService) RecordWebhookDeliveryAttempt(
+	ctx context.Context,
+	id int,
+	attempt booking.WebhookDeliveryAttempt,
+) error {
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	q := s.client.WebhookDelivery.
+		UpdateOneID(id).
+		SetStatus(attempt.Status).
+		SetNextAttemptAt(attempt.NextAttemptAt).
+		SetLastAttemptAt(attempt.AttemptedAt).
+		AddAttempts(1).
+		SetLastError(attempt.Error)
+	if attempt.ResponseStatus != nil {
+		q.SetResponseStatus(*attempt.ResponseStatus)
+	} else {
+		q.ClearResponseStatus()
+	}
+
+	if err := q.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record webhook delivery attempt: %w", err)
+	}
+	return nil
+}
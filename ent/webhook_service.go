@@ -0,0 +1,352 @@
+package ent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openmesh/booking"
+	"github.com/openmesh/booking/ent/webhook"
+	"github.com/openmesh/booking/ent/webhookdelivery"
+	"github.com/openmesh/booking/rand"
+)
+
+type webhookService struct {
+	client *Client
+}
+
+// NewWebhookService constructs a new instance of a booking.WebhookService
+// using ent as its persistence layer.
+func NewWebhookService(client *Client) *webhookService {
+	return &webhookService{client}
+}
+
+// FindWebhookByID retrieves a single webhook by ID. Returns EWEBHOOKNOTFOUND
+// if the webhook does not exist or user does not have permission to view it.
+func (s *webhookService) FindWebhookByID(
+	ctx context.Context,
+	req booking.FindWebhookByIDRequest,
+) booking.FindWebhookByIDResponse {
+	w, err := findWebhookByID(ctx, s.client, req.ID)
+	if err != nil {
+		return booking.FindWebhookByIDResponse{Err: err}
+	}
+	return booking.FindWebhookByIDResponse{Webhook: w.toModel()}
+}
+
+// FindWebhooks retrieves the webhooks of the current organization.
+func (s *webhookService) FindWebhooks(
+	ctx context.Context,
+	req booking.FindWebhooksRequest,
+) booking.FindWebhooksResponse {
+	q := s.client.Webhook.Query()
+
+	c, err := q.Clone().Count(ctx)
+	if err != nil {
+		return booking.FindWebhooksResponse{
+			Err: fmt.Errorf("failed to count webhooks: %w", err),
+		}
+	}
+
+	q = q.Offset(req.Offset)
+	if req.Limit == 0 {
+		q.Limit(10)
+	} else {
+		q.Limit(req.Limit)
+	}
+
+	w, err := q.Order(Asc(webhook.FieldID)).All(ctx)
+	if err != nil {
+		return booking.FindWebhooksResponse{
+			Err: fmt.Errorf("failed to query webhooks: %w", err),
+		}
+	}
+
+	return booking.FindWebhooksResponse{
+		Webhooks:   Webhooks(w).toModels(),
+		TotalItems: c,
+	}
+}
+
+// CreateWebhook registers a new webhook for the current organization along
+// with a newly generated signing secret.
+func (s *webhookService) CreateWebhook(
+	ctx context.Context,
+	req booking.CreateWebhookRequest,
+) booking.CreateWebhookResponse {
+	orgID := booking.OrganizationIDFromContext(ctx)
+	if orgID == 0 {
+		return booking.CreateWebhookResponse{
+			Err: booking.Errorf(booking.EUNAUTHORIZED, "You must be signed in to create a webhook."),
+		}
+	}
+
+	secret := booking.WebhookSecretPrefix + rand.Key()
+	w, err := s.client.Webhook.
+		Create().
+		SetURL(req.URL).
+		SetDescription(req.Description).
+		SetEventTypes(req.EventTypes).
+		SetSecret(secret).
+		SetOrganizationID(orgID).
+		Save(ctx)
+	if err != nil {
+		return booking.CreateWebhookResponse{
+			Err: fmt.Errorf("failed to create webhook: %w", err),
+		}
+	}
+
+	return booking.CreateWebhookResponse{
+		Webhook: w.toModel(),
+		Secret:  secret,
+	}
+}
+
+// UpdateWebhook updates an existing webhook by ID. A new signing secret is
+// generated if requested.
+func (s *webhookService) UpdateWebhook(
+	ctx context.Context,
+	req booking.UpdateWebhookRequest,
+) booking.UpdateWebhookResponse {
+	w, err := findWebhookByID(ctx, s.client, req.ID)
+	if err != nil {
+		return booking.UpdateWebhookResponse{Err: err}
+	}
+
+	q := w.Update().
+		SetURL(req.URL).
+		SetDescription(req.Description).
+		SetEventTypes(req.EventTypes).
+		SetEnabled(req.Enabled)
+	var secret string
+	if req.RotateSecret {
+		secret = booking.WebhookSecretPrefix + rand.Key()
+		q.SetSecret(secret)
+	}
+
+	w, err = q.Save(ctx)
+	if err != nil {
+		return booking.UpdateWebhookResponse{
+			Err: fmt.Errorf("failed to update webhook: %w", err),
+		}
+	}
+
+	return booking.UpdateWebhookResponse{
+		Webhook: w.toModel(),
+		Secret:  secret,
+	}
+}
+
+// DeleteWebhook permanently removes a webhook along with its deliveries.
+func (s *webhookService) DeleteWebhook(
+	ctx context.Context,
+	req booking.DeleteWebhookRequest,
+) booking.DeleteWebhookResponse {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return booking.DeleteWebhookResponse{
+			Err: fmt.Errorf("failed to start transaction: %w", err),
+		}
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.WebhookDelivery.
+		Delete().
+		Where(webhookdelivery.WebhookId(req.ID)).
+		Exec(ctx); err != nil {
+		return booking.DeleteWebhookResponse{
+			Err: fmt.Errorf("failed to delete webhook deliveries: %w", err),
+		}
+	}
+
+	a, err := tx.Webhook.
+		Delete().
+		Where(webhook.ID(req.ID)).
+		Exec(ctx)
+	if err != nil {
+		return booking.DeleteWebhookResponse{
+			Err: fmt.Errorf("failed to delete webhook: %w", err),
+		}
+	}
+	if a == 0 {
+		return booking.DeleteWebhookResponse{
+			Err: booking.Errorf(booking.EWEBHOOKNOTFOUND, "Could not find webhook with ID %d", req.ID),
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return booking.DeleteWebhookResponse{
+			Err: fmt.Errorf("failed to commit transaction: %w", err),
+		}
+	}
+	return booking.DeleteWebhookResponse{}
+}
+
+// FindWebhookDeliveries retrieves the delivery log of a webhook, most recent
+// first.
+func (s *webhookService) FindWebhookDeliveries(
+	ctx context.Context,
+	req booking.FindWebhookDeliveriesRequest,
+) booking.FindWebhookDeliveriesResponse {
+	// Make sure that the webhook exists so that a missing webhook isn't
+	// mistaken for one without any deliveries.
+	if _, err := findWebhookByID(ctx, s.client, req.WebhookID); err != nil {
+		return booking.FindWebhookDeliveriesResponse{Err: err}
+	}
+
+	q := s.client.WebhookDelivery.
+		Query().
+		Where(webhookdelivery.WebhookId(req.WebhookID))
+	if req.Status != nil {
+		q.Where(webhookdelivery.Status(*req.Status))
+	}
+	if req.EventType != nil {
+		q.Where(webhookdelivery.EventType(*req.EventType))
+	}
+
+	c, err := q.Clone().Count(ctx)
+	if err != nil {
+		return booking.FindWebhookDeliveriesResponse{
+			Err: fmt.Errorf("failed to count webhook deliveries: %w", err),
+		}
+	}
+
+	q = q.Offset(req.Offset)
+	if req.Limit == 0 {
+		q.Limit(10)
+	} else {
+		q.Limit(req.Limit)
+	}
+
+	d, err := q.Order(Desc(webhookdelivery.FieldCreatedAt), Desc(webhookdelivery.FieldID)).All(ctx)
+	if err != nil {
+		return booking.FindWebhookDeliveriesResponse{
+			Err: fmt.Errorf("failed to query webhook deliveries: %w", err),
+		}
+	}
+
+	return booking.FindWebhookDeliveriesResponse{
+		Deliveries: WebhookDeliveries(d).toModels(),
+		TotalItems: c,
+	}
+}
+
+// RetryWebhookDelivery moves a delivery back to pending so that it is sent by
+// the delivery worker as soon as possible. Previous attempts are kept so the
+// delivery log still reflects them.
+func (s *webhookService) RetryWebhookDelivery(
+	ctx context.Context,
+	req booking.RetryWebhookDeliveryRequest,
+) booking.RetryWebhookDeliveryResponse {
+	a, err := s.client.WebhookDelivery.
+		Update().
+		Where(
+			webhookdelivery.ID(req.ID),
+			webhookdelivery.WebhookId(req.WebhookID),
+		).
+		SetStatus(booking.WebhookDeliveryStatusPending).
+		SetNextAttemptAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return booking.RetryWebhookDeliveryResponse{
+			Err: fmt.Errorf("failed to retry webhook delivery: %w", err),
+		}
+	}
+	if a == 0 {
+		return booking.RetryWebhookDeliveryResponse{
+			Err: booking.Errorf(booking.EWEBHOOKDELIVERYNOTFOUND, "Could not find webhook delivery with ID %d", req.ID),
+		}
+	}
+
+	d, err := s.client.WebhookDelivery.Get(ctx, req.ID)
+	if err != nil {
+		return booking.RetryWebhookDeliveryResponse{
+			Err: fmt.Errorf("failed to find webhook delivery: %w", err),
+		}
+	}
+	return booking.RetryWebhookDeliveryResponse{WebhookDelivery: d.toModel()}
+}
+
+// findWebhookByID retrieves a webhook by ID. Returns EWEBHOOKNOTFOUND if the
+// webhook does not exist.
+func findWebhookByID(ctx context.Context, client *Client, id int) (*Webhook, error) {
+	w, err := client.Webhook.
+		Query().
+		Where(webhook.ID(id)).
+		Only(ctx)
+	var nfe *NotFoundError
+	if errors.As(err, &nfe) {
+		return nil, booking.Errorf(booking.EWEBHOOKNOTFOUND, "Could not find webhook with ID %d", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find webhook: %w", err)
+	}
+	return w, nil
+}
+
+// newWebhookPayload returns the JSON body that is sent to webhooks for event.
+func newWebhookPayload(event booking.Event, createdAt time.Time) (string, error) {
+	buf, err := json.Marshal(booking.WebhookPayload{
+		Type:      event.Type,
+		CreatedAt: createdAt,
+		Payload:   event.Payload,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
+
+func (w *Webhook) toModel() *booking.Webhook {
+	return &booking.Webhook{
+		ID:             w.ID,
+		URL:            w.URL,
+		Description:    w.Description,
+		EventTypes:     w.EventTypes,
+		Secret:         w.Secret,
+		Enabled:        w.Enabled,
+		OrganizationID: w.OrganizationId,
+		CreatedAt:      w.CreatedAt,
+		UpdatedAt:      w.UpdatedAt,
+	}
+}
+
+func (w Webhooks) toModels() []*booking.Webhook {
+	var webhooks []*booking.Webhook
+	for _, v := range w {
+		webhooks = append(webhooks, v.toModel())
+	}
+	return webhooks
+}
+
+func (d *WebhookDelivery) toModel() *booking.WebhookDelivery {
+	result := &booking.WebhookDelivery{
+		ID:             d.ID,
+		EventType:      d.EventType,
+		Payload:        json.RawMessage(d.Payload),
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		LastAttemptAt:  d.LastAttemptAt,
+		ResponseStatus: d.ResponseStatus,
+		LastError:      d.LastError,
+		WebhookID:      d.WebhookId,
+		OrganizationID: d.OrganizationId,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	}
+	if d.Edges.Webhook != nil {
+		result.Webhook = d.Edges.Webhook.toModel()
+	}
+	return result
+}
+
+func (d WebhookDeliveries) toModels() []*booking.WebhookDelivery {
+	var deliveries []*booking.WebhookDelivery
+	for _, v := range d {
+		deliveries = append(deliveries, v.toModel())
+	}
+	return deliveries
+}
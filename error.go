@@ -15,9 +15,30 @@ const (
 	// EBOOKINGCONFLICT indicates that a request was made to create a booking that
 	// would exceed the quantity available for a resource.
 	EBOOKINGCONFLICT = "booking_conflict"
-	EINTERNAL        = "internal"
-	EINVALID         = "invalid"
-	ENOTFOUND        = "not_found"
+	// EBOOKINGOUTSIDESLOTS indicates that a request was made to create or move a
+	// booking to a time that is not within any of the slots of the resource.
+	EBOOKINGOUTSIDESLOTS = "booking_outside_slots"
+	// EBOOKINGUNAVAILABLE indicates that a request was made to create or move a
+	// booking to a time that overlaps an unavailability of the resource.
+	EBOOKINGUNAVAILABLE = "booking_unavailable"
+	// EBOOKINGINPAST indicates that a request was made to create or move a
+	// booking to a time that has already passed.
+	EBOOKINGINPAST = "booking_in_past"
+	// ESLOTFULL indicates that a request was made to create or move a booking
+	// into a slot that has no quantity left.
+	ESLOTFULL = "slot_full"
+	// EBOOKINGNOTHELD indicates that a request was made to confirm a booking
+	// that is not held.
+	EBOOKINGNOTHELD = "booking_not_held"
+	// EHOLDEXPIRED indicates that a request was made to confirm a held booking
+	// after its hold expired.
+	EHOLDEXPIRED = "hold_expired"
+	// EINVALIDTRANSITION indicates that a request was made to move a booking
+	// into a status that cannot be reached from its current status.
+	EINVALIDTRANSITION = "invalid_transition"
+	EINTERNAL          = "internal"
+	EINVALID           = "invalid"
+	ENOTFOUND          = "not_found"
 	// ERESOURCENOTFOUND indicates that a request was made to retrieve a resource
 	// that does not exist or is not accessible by the requester.
 	ERESOURCENOTFOUND = "resource_not_found"
@@ -27,6 +48,25 @@ const (
 	// EBOOKINGNOTFOUND indicates that a request was made to retrieve a booking
 	// that does not exist or is not accessible by the requester.
 	EBOOKINGNOTFOUND = "booking_not_found"
+	// EBOOKINGSERIESNOTFOUND indicates that a request was made to retrieve a
+	// recurring booking that does not exist or is not accessible by the
+	// requester.
+	EBOOKINGSERIESNOTFOUND = "booking_series_not_found"
+	// EPAYMENTNOTFOUND indicates that a request was made to retrieve a payment
+	// that does not exist or is not accessible by the requester.
+	EPAYMENTNOTFOUND = "payment_not_found"
+	// EPAYMENTREQUIRED indicates that a booking could not be confirmed because
+	// its deposit has not been paid.
+	EPAYMENTREQUIRED = "payment_required"
+	// EPAYMENTPROVIDER indicates that the payment provider failed to carry out
+	// a request.
+	EPAYMENTPROVIDER = "payment_provider_error"
+	// EWAITLISTENTRYNOTFOUND indicates that a request was made to retrieve a
+	// waitlist entry that does not exist or is not accessible by the requester.
+	EWAITLISTENTRYNOTFOUND = "waitlist_entry_not_found"
+	// ENOTINSERIES indicates that a request was made to change other
+	// occurrences of a booking that is not part of a recurring series.
+	ENOTINSERIES = "not_in_series"
 	// EUNAVAILABILITYTIMECONFLICT indicates that a request was made to create
 	// update an unavailability which would have conflicted with an existing
 	// unavailability.
@@ -35,8 +75,48 @@ const (
 	// with a name already taken by an existing resource within the same
 	// organiztion.
 	ERESOURCENAMECONFLICT = "resource_name_conflict"
-	ENOTIMPLEMENTED       = "not_implemented"
-	EUNAUTHORIZED         = "unauthorized"
+	// EPROMOCODENOTFOUND indicates that a request was made to retrieve a promo
+	// code that does not exist or is not accessible by the requester.
+	EPROMOCODENOTFOUND = "promo_code_not_found"
+	// EPROMOCODECONFLICT indicates that a request was made to create or update
+	// a promo code with a code already used by another promo code within the
+	// same organization.
+	EPROMOCODECONFLICT = "promo_code_conflict"
+	// EINVOICENOTFOUND indicates that a request was made to retrieve an
+	// invoice that does not exist or is not accessible by the requester.
+	EINVOICENOTFOUND = "invoice_not_found"
+	// ETAXRATENOTFOUND indicates that a request was made to retrieve a tax
+	// rate that does not exist or is not accessible by the requester.
+	ETAXRATENOTFOUND = "tax_rate_not_found"
+	// ETAXRATECONFLICT indicates that a request was made to create or update a
+	// tax rate with a name already used by another tax rate within the same
+	// organization.
+	ETAXRATECONFLICT = "tax_rate_conflict"
+	// ECUSTOMERNOTFOUND indicates that a request was made to retrieve a
+	// customer that does not exist or is not accessible by the requester.
+	ECUSTOMERNOTFOUND = "customer_not_found"
+	// ECUSTOMERCONFLICT indicates that a request was made to create or update
+	// a customer with an email already used by another customer within the
+	// same organization.
+	ECUSTOMERCONFLICT = "customer_conflict"
+	// EWEBHOOKNOTFOUND indicates that a request was made to retrieve a webhook
+	// that does not exist or is not accessible by the requester.
+	EWEBHOOKNOTFOUND = "webhook_not_found"
+	// EWEBHOOKDELIVERYNOTFOUND indicates that a request was made to retrieve a
+	// webhook delivery that does not exist or is not accessible by the
+	// requester.
+	EWEBHOOKDELIVERYNOTFOUND = "webhook_delivery_not_found"
+	ENOTIMPLEMENTED          = "not_implemented"
+	EUNAUTHORIZED            = "unauthorized"
+	// EFORBIDDEN indicates that the requester is authenticated but has not been
+	// granted access to the requested operation, e.g. a token missing a scope.
+	EFORBIDDEN = "forbidden"
+	// ERATELIMITED indicates that the requester has made too many requests in
+	// a short period of time and should try again later.
+	ERATELIMITED = "rate_limited"
+	// ERESOURCEPASSWORDINVALID indicates that a request was made to book a
+	// password protected resource without its password or with the wrong one.
+	ERESOURCEPASSWORDINVALID = "resource_password_invalid"
 	// EAUTHSOURCENOTCONFIGURED indicates that an attempt was made to use an auth
 	// source that had not been set up.
 	EAUTHSOURCENOTCONFIGURED = "auth_source_not_configured"
@@ -126,7 +206,7 @@ type Errorer interface {
 // is wraps them in a domain Error. If not then it is expected that the error
 // should already be a domain Error and the value is returned as is.
 func WrapValidationErrors(errs []ValidationError) error {
-	return Error{
+	return &Error{
 		Code:   EINVALID,
 		Detail: "One or more validation errors occurred while processing your request.",
 		Title:  "Invalid request",
@@ -136,7 +216,7 @@ func WrapValidationErrors(errs []ValidationError) error {
 
 // WrapNotFoundError wraps a not
 func WrapNotFoundError(entity string) error {
-	return Error{
+	return &Error{
 		Code:   ENOTFOUND,
 		Detail: fmt.Sprintf("Specified %s could not be found", entity),
 		Title:  "Item not found",
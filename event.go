@@ -12,8 +12,18 @@ const (
 	EventTypeBookingCreated        = "booking:created"
 	EventTypeBookingUpdated        = "booking:updated"
 	EventTypeBookingDeleted        = "booking:deleted"
+	EventTypeBookingHeld           = "booking:held"
+	EventTypeBookingConfirmed      = "booking:confirmed"
+	EventTypeBookingCheckedIn      = "booking:checked_in"
+	EventTypeBookingCompleted      = "booking:completed"
+	EventTypeBookingCancelled      = "booking:cancelled"
+	EventTypeBookingNoShow         = "booking:no_show"
+	EventTypeBookingExpired        = "booking:expired"
 	EventTypeOrganizationCreated   = "organization:created"
 	EventTypeOrganizationUpdated   = "organization:updated"
+	EventTypePaymentSucceeded      = "payment:succeeded"
+	EventTypePaymentFailed         = "payment:failed"
+	EventTypePaymentRefunded       = "payment:refunded"
 	EventTypeResourceCreated       = "resource:created"
 	EventTypeResourceUpdated       = "resource:updated"
 	EventTypeResourceDeleted       = "resource:deleted"
@@ -23,11 +33,13 @@ const (
 	EventTypeUserCreated           = "user:created"
 	EventTypeUserUpdated           = "user:updated"
 	EventTypeUserDeleted           = "user:deleted"
+	EventTypeWaitlistJoined        = "waitlist:joined"
+	EventTypeWaitlistLeft          = "waitlist:left"
+	EventTypeWaitlistOffered       = "waitlist:offered"
 )
 
-// Event represents an event that occurs in the system. Currently there are only
-// events for changes to a dial value or membership value. These events are
-// eventually propagated out to connected users via WebSockets whenever changes
+// Event represents an event that occurs in the system. These events are
+// propagated out to connected users via Server-Sent Events whenever changes
 // occur so that the UI can update in real-time.
 type Event struct {
 	// Specifies the type of event that is occurring.
@@ -61,6 +73,76 @@ type BookingDeletedPayload struct {
 	ID int `json:"id"`
 }
 
+// BookingStatusChangedPayload is the payload of the events published when a
+// booking moves from one status to another, e.g. "booking:confirmed".
+type BookingStatusChangedPayload struct {
+	Booking        *Booking `json:"booking"`
+	PreviousStatus string   `json:"previousStatus"`
+}
+
+// bookingStatusEventTypes maps booking statuses to the event type published
+// when a booking enters that status.
+var bookingStatusEventTypes = map[string]string{
+	BookingStatusHeld:      EventTypeBookingHeld,
+	BookingStatusConfirmed: EventTypeBookingConfirmed,
+	BookingStatusCheckedIn: EventTypeBookingCheckedIn,
+	BookingStatusCompleted: EventTypeBookingCompleted,
+	BookingStatusCancelled: EventTypeBookingCancelled,
+	BookingStatusNoShow:    EventTypeBookingNoShow,
+	BookingStatusExpired:   EventTypeBookingExpired,
+}
+
+// BookingStatusEventType returns the event type for a booking entering the
+// given status. Returns false if no event is published for the status.
+func BookingStatusEventType(status string) (string, bool) {
+	t, ok := bookingStatusEventTypes[status]
+	return t, ok
+}
+
+// BookingCancelledPayload is the payload of the events published when a
+// booking is cancelled or its customer doesn't show up. RefundAmount and
+// FeeAmount are what the cancellation policy of the resource gave back and
+// charged.
+type BookingCancelledPayload struct {
+	Booking        *Booking `json:"booking"`
+	PreviousStatus string   `json:"previousStatus"`
+	RefundAmount   int      `json:"refundAmount"`
+	FeeAmount      int      `json:"feeAmount"`
+}
+
+// BookingStatusEvent returns the event published when booking b enters its
+// status from previousStatus. Returns false if no event is published for the
+// status.
+func BookingStatusEvent(b *Booking, previousStatus string) (Event, bool) {
+	t, ok := BookingStatusEventType(b.Status)
+	if !ok {
+		return Event{}, false
+	}
+	if b.Status == BookingStatusCancelled || b.Status == BookingStatusNoShow {
+		return Event{Type: t, Payload: BookingCancelledPayload{
+			Booking:        b,
+			PreviousStatus: previousStatus,
+			RefundAmount:   b.RefundAmount,
+			FeeAmount:      b.FeeAmount,
+		}}, true
+	}
+	return Event{Type: t, Payload: BookingStatusChangedPayload{
+		Booking:        b,
+		PreviousStatus: previousStatus,
+	}}, true
+}
+
+// WaitlistOfferEvents returns the events published when a spot is offered to
+// a waitlist entry: the held booking is created like any other hold, followed
+// by the offer itself.
+func WaitlistOfferEvents(offer WaitlistOffer) []Event {
+	return []Event{
+		{Type: EventTypeBookingCreated, Payload: BookingCreatedPayload{Booking: offer.Booking}},
+		{Type: EventTypeBookingHeld, Payload: BookingStatusChangedPayload{Booking: offer.Booking}},
+		{Type: EventTypeWaitlistOffered, Payload: WaitlistOfferedPayload{Entry: offer.Entry, Booking: offer.Booking}},
+	}
+}
+
 type OrganizationCreatedPayload struct {
 	Organization *Organization `json:"organization"`
 }
@@ -73,6 +155,29 @@ type OrganizationDeletedPayload struct {
 	ID int `json:"id"`
 }
 
+// PaymentPayload is the payload of the events published when the status of a
+// payment changes, e.g. "payment:succeeded".
+type PaymentPayload struct {
+	Payment        *Payment `json:"payment"`
+	PreviousStatus string   `json:"previousStatus"`
+}
+
+// paymentStatusEventTypes maps payment statuses to the event type published
+// when a payment enters that status.
+var paymentStatusEventTypes = map[string]string{
+	PaymentStatusSucceeded:         EventTypePaymentSucceeded,
+	PaymentStatusFailed:            EventTypePaymentFailed,
+	PaymentStatusPartiallyRefunded: EventTypePaymentRefunded,
+	PaymentStatusRefunded:          EventTypePaymentRefunded,
+}
+
+// PaymentStatusEventType returns the event type for a payment entering the
+// given status. Returns false if no event is published for the status.
+func PaymentStatusEventType(status string) (string, bool) {
+	t, ok := paymentStatusEventTypes[status]
+	return t, ok
+}
+
 type ResourceCreatedPayload struct {
 	Resource *Resource `json:"resource"`
 }
@@ -109,19 +214,33 @@ type UserDeletedPayload struct {
 	ID int `json:"id"`
 }
 
+type WaitlistJoinedPayload struct {
+	Entry *WaitlistEntry `json:"entry"`
+}
+
+type WaitlistLeftPayload struct {
+	ID int `json:"id"`
+}
+
+type WaitlistOfferedPayload struct {
+	Entry   *WaitlistEntry `json:"entry"`
+	Booking *Booking       `json:"booking"`
+}
+
 // EventService represents a service for managing event dispatch and event
 // listeners (aka subscriptions).
 //
-// Events are user-centric in this implementation although a more generic
-// implementation may use a topic-centic model (e.g. "booking_updated(id=1)").
-// The application has frequent reconnects so it's more efficient to subscribe
-// for a single user instead of resubscribing to all their related topics.
+// Events are organization-centric in this implementation although a more
+// generic implementation may use a topic-centic model (e.g.
+// "booking_updated(id=1)"). Every member of an organization is interested in
+// the same bookings and resources so it's more efficient to subscribe once for
+// the organization instead of resubscribing to all of its related topics.
 type EventService interface {
-	// Publishes an event to a user's event listeners.
-	// If the user is not currently subscribed then this is a no-op.
-	PublishEvent(userID int, event Event)
+	// Publishes an event to an organization's event listeners.
+	// If nobody in the organization is currently subscribed then this is a no-op.
+	PublishEvent(organizationID int, event Event)
 
-	// Creates a subscription for the current user's events.
+	// Creates a subscription for the current organization's events.
 	// Caller must call Subscription.Close() when done with the subscription.
 	Subscribe(ctx context.Context) (Subscription, error)
 }
@@ -131,15 +250,15 @@ func NopEventService() EventService { return &nopEventService{} }
 
 type nopEventService struct{}
 
-func (*nopEventService) PublishEvent(userID int, event Event) {}
+func (*nopEventService) PublishEvent(organizationID int, event Event) {}
 
 func (*nopEventService) Subscribe(ctx context.Context) (Subscription, error) {
-	panic("not implemented")
+	return nil, Errorf(ENOTIMPLEMENTED, "Event subscriptions are not supported.")
 }
 
-// Subscription represents a stream of events for a single user.
+// Subscription represents a stream of events for a single organization.
 type Subscription interface {
-	// Event stream for all user's event.
+	// Event stream for all of the organization's events.
 	C() <-chan Event
 
 	// Closes the event stream channel and disconnects from the event service.
@@ -44,8 +44,8 @@ func (mw authEventMiddleware) CreateAuth(ctx context.Context, req booking.Create
 			Type:    booking.EventTypeAuthCreated,
 			Payload: booking.AuthCreatedPayload{Auth: res.Auth},
 		}
-		userID := booking.UserIDFromContext(ctx)
-		mw.EventService.PublishEvent(userID, ev)
+		orgID := booking.OrganizationIDFromContext(ctx)
+		mw.EventService.PublishEvent(orgID, ev)
 	}()
 	res = mw.AuthService.CreateAuth(ctx, req)
 	return
@@ -61,8 +61,8 @@ func (mw authEventMiddleware) UpdateAuth(ctx context.Context, req booking.Update
 			Type:    booking.EventTypeAuthUpdated,
 			Payload: booking.AuthCreatedPayload{Auth: res.Auth},
 		}
-		userID := booking.UserIDFromContext(ctx)
-		mw.EventService.PublishEvent(userID, ev)
+		orgID := booking.OrganizationIDFromContext(ctx)
+		mw.EventService.PublishEvent(orgID, ev)
 	}()
 	res = mw.AuthService.UpdateAuth(ctx, req)
 	return
@@ -79,8 +79,8 @@ func (mw authEventMiddleware) DeleteAuth(ctx context.Context, req booking.Delete
 			Type:    booking.EventTypeAuthDeleted,
 			Payload: booking.AuthDeletedPayload{ID: req.ID},
 		}
-		userID := booking.UserIDFromContext(ctx)
-		mw.EventService.PublishEvent(userID, ev)
+		orgID := booking.OrganizationIDFromContext(ctx)
+		mw.EventService.PublishEvent(orgID, ev)
 	}()
 	res = mw.AuthService.DeleteAuth(ctx, req)
 	return
@@ -35,12 +35,15 @@ func (mw bookingEventMiddleware) FindBookings(ctx context.Context, req booking.F
 // Creates a new booking and assigns the current user as the owner.
 func (mw bookingEventMiddleware) CreateBooking(ctx context.Context, req booking.CreateBookingRequest) (res booking.CreateBookingResponse) {
 	defer func() {
+		if res.Err != nil {
+			return
+		}
 		ev := booking.Event{
 			Type:    booking.EventTypeBookingCreated,
 			Payload: booking.BookingCreatedPayload{Booking: res.Booking},
 		}
-		userID := booking.UserIDFromContext(ctx)
-		mw.EventService.PublishEvent(userID, ev)
+		orgID := booking.OrganizationIDFromContext(ctx)
+		mw.EventService.PublishEvent(orgID, ev)
 	}()
 	res = mw.BookingService.CreateBooking(ctx, req)
 	return
@@ -54,29 +57,136 @@ func (mw bookingEventMiddleware) CreateBooking(ctx context.Context, req booking.
 // permission to update it.
 func (mw bookingEventMiddleware) UpdateBooking(ctx context.Context, req booking.UpdateBookingRequest) (res booking.UpdateBookingResponse) {
 	defer func() {
-		ev := booking.Event{
-			Type:    booking.EventTypeBookingUpdated,
-			Payload: booking.BookingUpdatedPayload{Booking: res.Booking},
+		if res.Err != nil {
+			return
+		}
+		orgID := booking.OrganizationIDFromContext(ctx)
+		publishBookingUpdated(mw.EventService, orgID, res.Booking, res.PreviousStatus)
+		for _, o := range res.Occurrences {
+			publishBookingUpdated(mw.EventService, orgID, o.Booking, o.PreviousStatus)
 		}
-		userID := booking.UserIDFromContext(ctx)
-		mw.EventService.PublishEvent(userID, ev)
+		publishWaitlistOffers(mw.EventService, orgID, res.Offers)
 	}()
 	res = mw.BookingService.UpdateBooking(ctx, req)
 	return
 }
 
+// publishBookingUpdated publishes an event for an updated booking and an
+// additional event describing the transition if the status of the booking
+// changed.
+func publishBookingUpdated(eventService booking.EventService, orgID int, b *booking.Booking, previousStatus string) {
+	eventService.PublishEvent(orgID, booking.Event{
+		Type:    booking.EventTypeBookingUpdated,
+		Payload: booking.BookingUpdatedPayload{Booking: b},
+	})
+
+	if previousStatus == b.Status {
+		return
+	}
+	if e, ok := booking.BookingStatusEvent(b, previousStatus); ok {
+		eventService.PublishEvent(orgID, e)
+	}
+}
+
 // Permanently removes a booking by ID. Only the booking owner may delete a
 // booking. Returns ENOTFOUND if the booking does not exist or the user does
 // not have permission to delete it.
 func (mw bookingEventMiddleware) DeleteBooking(ctx context.Context, req booking.DeleteBookingRequest) (res booking.DeleteBookingResponse) {
 	defer func() {
-		ev := booking.Event{
-			Type:    booking.EventTypeBookingDeleted,
-			Payload: booking.BookingDeletedPayload{ID: req.ID},
+		if res.Err != nil {
+			return
+		}
+		orgID := booking.OrganizationIDFromContext(ctx)
+		for _, id := range res.DeletedIDs {
+			mw.EventService.PublishEvent(orgID, booking.Event{
+				Type:    booking.EventTypeBookingDeleted,
+				Payload: booking.BookingDeletedPayload{ID: id},
+			})
 		}
-		userID := booking.UserIDFromContext(ctx)
-		mw.EventService.PublishEvent(userID, ev)
+		publishWaitlistOffers(mw.EventService, orgID, res.Offers)
 	}()
 	res = mw.BookingService.DeleteBooking(ctx, req)
 	return
 }
+
+// Retrieves a recurring booking by ID along with its occurrences.
+func (mw bookingEventMiddleware) FindBookingSeriesByID(ctx context.Context, req booking.FindBookingSeriesByIDRequest) booking.FindBookingSeriesByIDResponse {
+	return mw.BookingService.FindBookingSeriesByID(ctx, req)
+}
+
+// Creates a recurring booking. An event is published for each occurrence that
+// was booked.
+func (mw bookingEventMiddleware) CreateBookingSeries(ctx context.Context, req booking.CreateBookingSeriesRequest) (res booking.CreateBookingSeriesResponse) {
+	defer func() {
+		if res.Err != nil {
+			return
+		}
+		orgID := booking.OrganizationIDFromContext(ctx)
+		for _, b := range res.Bookings {
+			mw.EventService.PublishEvent(orgID, booking.Event{
+				Type:    booking.EventTypeBookingCreated,
+				Payload: booking.BookingCreatedPayload{Booking: b},
+			})
+		}
+	}()
+	res = mw.BookingService.CreateBookingSeries(ctx, req)
+	return
+}
+
+// ImportBookings creates a booking for each row of an import. A booking
+// created event is published for every row that was imported.
+func (mw bookingEventMiddleware) ImportBookings(ctx context.Context, req booking.ImportBookingsRequest) (res booking.ImportBookingsResponse) {
+	defer func() {
+		if res.Err != nil {
+			return
+		}
+		orgID := booking.OrganizationIDFromContext(ctx)
+		for _, row := range res.Rows {
+			if row.Booking == nil {
+				continue
+			}
+			mw.EventService.PublishEvent(orgID, booking.Event{
+				Type:    booking.EventTypeBookingCreated,
+				Payload: booking.BookingCreatedPayload{Booking: row.Booking},
+			})
+		}
+	}()
+	res = mw.BookingService.ImportBookings(ctx, req)
+	return
+}
+
+// HoldBooking creates a held booking. Publishes a booking created event
+// followed by a booking held event so that subscribers which only track
+// created bookings still see the resource being occupied.
+func (mw bookingEventMiddleware) HoldBooking(ctx context.Context, req booking.HoldBookingRequest) (res booking.HoldBookingResponse) {
+	defer func() {
+		if res.Err != nil {
+			return
+		}
+		orgID := booking.OrganizationIDFromContext(ctx)
+		mw.EventService.PublishEvent(orgID, booking.Event{
+			Type:    booking.EventTypeBookingCreated,
+			Payload: booking.BookingCreatedPayload{Booking: res.Booking},
+		})
+		mw.EventService.PublishEvent(orgID, booking.Event{
+			Type:    booking.EventTypeBookingHeld,
+			Payload: booking.BookingStatusChangedPayload{Booking: res.Booking},
+		})
+	}()
+	res = mw.BookingService.HoldBooking(ctx, req)
+	return
+}
+
+// ConfirmBooking confirms a held booking and publishes the same events as an
+// update that confirms it.
+func (mw bookingEventMiddleware) ConfirmBooking(ctx context.Context, req booking.ConfirmBookingRequest) (res booking.ConfirmBookingResponse) {
+	defer func() {
+		if res.Err != nil {
+			return
+		}
+		orgID := booking.OrganizationIDFromContext(ctx)
+		publishBookingUpdated(mw.EventService, orgID, res.Booking, res.PreviousStatus)
+	}()
+	res = mw.BookingService.ConfirmBooking(ctx, req)
+	return
+}
@@ -0,0 +1,44 @@
+package event
+
+import (
+	"context"
+
+	"github.com/openmesh/booking"
+)
+
+func CalendarEventMiddleware(eventService booking.EventService) booking.CalendarServiceMiddleware {
+	return func(next booking.CalendarService) booking.CalendarService {
+		return calendarEventMiddleware{eventService, next}
+	}
+}
+
+type calendarEventMiddleware struct {
+	booking.EventService
+	booking.CalendarService
+}
+
+// ImportUnavailabilities creates an unavailability for each busy event of an
+// iCalendar file. An event is published for every unavailability that was
+// created or updated.
+func (mw calendarEventMiddleware) ImportUnavailabilities(ctx context.Context, req booking.ImportUnavailabilitiesRequest) (res booking.ImportUnavailabilitiesResponse) {
+	defer func() {
+		if res.Err != nil {
+			return
+		}
+		orgID := booking.OrganizationIDFromContext(ctx)
+		for _, u := range res.Created {
+			mw.EventService.PublishEvent(orgID, booking.Event{
+				Type:    booking.EventTypeUnavailabilityCreated,
+				Payload: booking.UnavailabilityCreatedPayload{Unavailability: u},
+			})
+		}
+		for _, u := range res.Updated {
+			mw.EventService.PublishEvent(orgID, booking.Event{
+				Type:    booking.EventTypeUnavailabilityUpdated,
+				Payload: booking.UnavailabilityUpdatedPayload{Unavailability: u},
+			})
+		}
+	}()
+	res = mw.CalendarService.ImportUnavailabilities(ctx, req)
+	return
+}
@@ -28,6 +28,12 @@ func (mw organizationEventMiddleware) FindOrganizationByPrivateKey(ctx context.C
 	return mw.OrganizationService.FindOrganizationByPrivateKey(ctx, key)
 }
 
+// FindOrganizationByPublicKey retrieves an organization by PublicKey. Returns
+// ENOTFOUND if organization does not exist.
+func (mw organizationEventMiddleware) FindOrganizationByPublicKey(ctx context.Context, key string) (*booking.Organization, error) {
+	return mw.OrganizationService.FindOrganizationByPublicKey(ctx, key)
+}
+
 // CreateOrganization creates a new organization.
 func (mw organizationEventMiddleware) CreateOrganization(ctx context.Context, org *booking.Organization) (err error) {
 	defer func() {
@@ -38,8 +44,9 @@ func (mw organizationEventMiddleware) CreateOrganization(ctx context.Context, or
 			Type:    booking.EventTypeOrganizationCreated,
 			Payload: booking.OrganizationCreatedPayload{Organization: org},
 		}
-		userID := booking.UserIDFromContext(ctx)
-		mw.EventService.PublishEvent(userID, ev)
+		// The organization isn't in the context yet as it has only just been
+		// created.
+		mw.EventService.PublishEvent(org.ID, ev)
 	}()
 	err = mw.OrganizationService.CreateOrganization(ctx, org)
 	return
@@ -53,10 +60,10 @@ func (mw organizationEventMiddleware) UpdateOrganization(ctx context.Context, up
 		}
 		ev := booking.Event{
 			Type:    booking.EventTypeOrganizationUpdated,
-			Payload: booking.OrganizationCreatedPayload{Organization: org},
+			Payload: booking.OrganizationUpdatedPayload{Organization: org},
 		}
-		userID := booking.UserIDFromContext(ctx)
-		mw.EventService.PublishEvent(userID, ev)
+		orgID := booking.OrganizationIDFromContext(ctx)
+		mw.EventService.PublishEvent(orgID, ev)
 	}()
 	org, err = mw.OrganizationService.UpdateOrganization(ctx, upd)
 	return
@@ -0,0 +1,88 @@
+package event
+
+import (
+	"context"
+
+	"github.com/openmesh/booking"
+)
+
+func PaymentEventMiddleware(eventService booking.EventService) booking.PaymentServiceMiddleware {
+	return func(next booking.PaymentService) booking.PaymentService {
+		return paymentEventMiddleware{eventService, next}
+	}
+}
+
+type paymentEventMiddleware struct {
+	booking.EventService
+	booking.PaymentService
+}
+
+// Retrieves a list of payments based on a filter.
+func (mw paymentEventMiddleware) FindPayments(ctx context.Context, req booking.FindPaymentsRequest) booking.FindPaymentsResponse {
+	return mw.PaymentService.FindPayments(ctx, req)
+}
+
+// Captures the funds of an authorized payment. The booking of the payment is
+// published as updated if the capture confirmed it.
+func (mw paymentEventMiddleware) CapturePayment(ctx context.Context, req booking.CapturePaymentRequest) (res booking.CapturePaymentResponse) {
+	defer func() {
+		if res.Err != nil {
+			return
+		}
+		orgID := booking.OrganizationIDFromContext(ctx)
+		publishPaymentChanged(mw.EventService, orgID, res.Payment, booking.PaymentStatusAuthorized)
+		if res.Booking != nil {
+			publishBookingUpdated(mw.EventService, orgID, res.Booking, res.PreviousBookingStatus)
+		}
+	}()
+	res = mw.PaymentService.CapturePayment(ctx, req)
+	return
+}
+
+// Refunds part or all of a payment that succeeded.
+func (mw paymentEventMiddleware) RefundPayment(ctx context.Context, req booking.RefundPaymentRequest) (res booking.RefundPaymentResponse) {
+	defer func() {
+		if res.Err != nil {
+			return
+		}
+		orgID := booking.OrganizationIDFromContext(ctx)
+		publishPaymentChanged(mw.EventService, orgID, res.Payment, res.PreviousStatus)
+	}()
+	res = mw.PaymentService.RefundPayment(ctx, req)
+	return
+}
+
+// Applies a notification sent by a payment provider. Notifications aren't
+// sent on behalf of an organization so events are published to the
+// organization of the booking that the payment is for. Repeated notifications
+// don't publish anything.
+func (mw paymentEventMiddleware) HandlePaymentWebhook(ctx context.Context, req booking.HandlePaymentWebhookRequest) (res booking.HandlePaymentWebhookResponse) {
+	defer func() {
+		if res.Err != nil || res.Payment == nil || res.Booking == nil || res.Booking.Resource == nil {
+			return
+		}
+		orgID := res.Booking.Resource.OrganizationID
+		if res.Payment.Status != res.PreviousStatus {
+			publishPaymentChanged(mw.EventService, orgID, res.Payment, res.PreviousStatus)
+		}
+		if res.PreviousBookingStatus != "" {
+			publishBookingUpdated(mw.EventService, orgID, res.Booking, res.PreviousBookingStatus)
+		}
+	}()
+	res = mw.PaymentService.HandlePaymentWebhook(ctx, req)
+	return
+}
+
+// publishPaymentChanged publishes an event for a payment that entered a new
+// status if there is one for the status.
+func publishPaymentChanged(eventService booking.EventService, orgID int, p *booking.Payment, previousStatus string) {
+	if t, ok := booking.PaymentStatusEventType(p.Status); ok {
+		eventService.PublishEvent(orgID, booking.Event{
+			Type: t,
+			Payload: booking.PaymentPayload{
+				Payment:        p,
+				PreviousStatus: previousStatus,
+			},
+		})
+	}
+}
@@ -42,8 +42,8 @@ func (mw resourceEventMiddleware) CreateResource(ctx context.Context, req bookin
 			Type:    booking.EventTypeResourceCreated,
 			Payload: booking.ResourceCreatedPayload{Resource: res.Resource},
 		}
-		userID := booking.UserIDFromContext(ctx)
-		mw.EventService.PublishEvent(userID, ev)
+		orgID := booking.OrganizationIDFromContext(ctx)
+		mw.EventService.PublishEvent(orgID, ev)
 	}()
 	res = mw.ResourceService.CreateResource(ctx, req)
 	return
@@ -63,8 +63,8 @@ func (mw resourceEventMiddleware) UpdateResource(ctx context.Context, req bookin
 			Type:    booking.EventTypeResourceUpdated,
 			Payload: booking.ResourceUpdatedPayload{Resource: res.Resource},
 		}
-		userID := booking.UserIDFromContext(ctx)
-		mw.EventService.PublishEvent(userID, ev)
+		orgID := booking.OrganizationIDFromContext(ctx)
+		mw.EventService.PublishEvent(orgID, ev)
 	}()
 	res = mw.ResourceService.UpdateResource(ctx, req)
 	return
@@ -82,9 +82,31 @@ func (mw resourceEventMiddleware) DeleteResource(ctx context.Context, req bookin
 			Type:    booking.EventTypeResourceDeleted,
 			Payload: booking.ResourceDeletedPayload{ID: req.ID},
 		}
-		userID := booking.UserIDFromContext(ctx)
-		mw.EventService.PublishEvent(userID, ev)
+		orgID := booking.OrganizationIDFromContext(ctx)
+		mw.EventService.PublishEvent(orgID, ev)
 	}()
 	res = mw.ResourceService.DeleteResource(ctx, req)
 	return
 }
+
+// ImportResources creates a resource for each row of an import. A resource
+// created event is published for every row that was imported.
+func (mw resourceEventMiddleware) ImportResources(ctx context.Context, req booking.ImportResourcesRequest) (res booking.ImportResourcesResponse) {
+	defer func() {
+		if res.Err != nil {
+			return
+		}
+		orgID := booking.OrganizationIDFromContext(ctx)
+		for _, row := range res.Rows {
+			if row.Resource == nil {
+				continue
+			}
+			mw.EventService.PublishEvent(orgID, booking.Event{
+				Type:    booking.EventTypeResourceCreated,
+				Payload: booking.ResourceCreatedPayload{Resource: row.Resource},
+			})
+		}
+	}()
+	res = mw.ResourceService.ImportResources(ctx, req)
+	return
+}
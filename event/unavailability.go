@@ -42,8 +42,8 @@ func (mw unavailabilityEventMiddleware) CreateUnavailability(ctx context.Context
 			Type:    booking.EventTypeUnavailabilityCreated,
 			Payload: booking.UnavailabilityCreatedPayload{Unavailability: res.Unavailability},
 		}
-		userID := booking.UserIDFromContext(ctx)
-		mw.EventService.PublishEvent(userID, ev)
+		orgID := booking.OrganizationIDFromContext(ctx)
+		mw.EventService.PublishEvent(orgID, ev)
 	}()
 	res = mw.UnavailabilityService.CreateUnavailability(ctx, req)
 	return
@@ -64,8 +64,8 @@ func (mw unavailabilityEventMiddleware) UpdateUnavailability(ctx context.Context
 			Type:    booking.EventTypeUnavailabilityUpdated,
 			Payload: booking.UnavailabilityUpdatedPayload{Unavailability: res.Unavailability},
 		}
-		userID := booking.UserIDFromContext(ctx)
-		mw.EventService.PublishEvent(userID, ev)
+		orgID := booking.OrganizationIDFromContext(ctx)
+		mw.EventService.PublishEvent(orgID, ev)
 	}()
 	res = mw.UnavailabilityService.UpdateUnavailability(ctx, req)
 	return
@@ -83,8 +83,8 @@ func (mw unavailabilityEventMiddleware) DeleteUnavailability(ctx context.Context
 			Type:    booking.EventTypeUnavailabilityDeleted,
 			Payload: booking.UnavailabilityDeletedPayload{ID: req.ID},
 		}
-		userID := booking.UserIDFromContext(ctx)
-		mw.EventService.PublishEvent(userID, ev)
+		orgID := booking.OrganizationIDFromContext(ctx)
+		mw.EventService.PublishEvent(orgID, ev)
 	}()
 	res = mw.UnavailabilityService.DeleteUnavailability(ctx, req)
 	return
@@ -0,0 +1,72 @@
+package event
+
+import (
+	"context"
+
+	"github.com/openmesh/booking"
+)
+
+func WaitlistEventMiddleware(eventService booking.EventService) booking.WaitlistServiceMiddleware {
+	return func(next booking.WaitlistService) booking.WaitlistService {
+		return waitlistEventMiddleware{eventService, next}
+	}
+}
+
+type waitlistEventMiddleware struct {
+	booking.EventService
+	booking.WaitlistService
+}
+
+// Retrieves a list of waitlist entries based on a filter.
+func (mw waitlistEventMiddleware) FindWaitlistEntries(ctx context.Context, req booking.FindWaitlistEntriesRequest) booking.FindWaitlistEntriesResponse {
+	return mw.WaitlistService.FindWaitlistEntries(ctx, req)
+}
+
+// Adds a customer to the waitlist of a resource. The entry is published before
+// any spot that it was offered straight away.
+func (mw waitlistEventMiddleware) JoinWaitlist(ctx context.Context, req booking.JoinWaitlistRequest) (res booking.JoinWaitlistResponse) {
+	defer func() {
+		if res.Err != nil {
+			return
+		}
+		orgID := booking.OrganizationIDFromContext(ctx)
+		mw.EventService.PublishEvent(orgID, booking.Event{
+			Type:    booking.EventTypeWaitlistJoined,
+			Payload: booking.WaitlistJoinedPayload{Entry: res.WaitlistEntry},
+		})
+		publishWaitlistOffers(mw.EventService, orgID, res.Offers)
+	}()
+	res = mw.WaitlistService.JoinWaitlist(ctx, req)
+	return
+}
+
+// Removes an entry from a waitlist. The cancellation of a spot that the entry
+// was holding and the offers made in its place are published as well.
+func (mw waitlistEventMiddleware) LeaveWaitlist(ctx context.Context, req booking.LeaveWaitlistRequest) (res booking.LeaveWaitlistResponse) {
+	defer func() {
+		if res.Err != nil {
+			return
+		}
+		orgID := booking.OrganizationIDFromContext(ctx)
+		mw.EventService.PublishEvent(orgID, booking.Event{
+			Type:    booking.EventTypeWaitlistLeft,
+			Payload: booking.WaitlistLeftPayload{ID: req.ID},
+		})
+		if b := res.CancelledBooking; b != nil {
+			publishBookingUpdated(mw.EventService, orgID, b, booking.BookingStatusHeld)
+		}
+		publishWaitlistOffers(mw.EventService, orgID, res.Offers)
+	}()
+	res = mw.WaitlistService.LeaveWaitlist(ctx, req)
+	return
+}
+
+// publishWaitlistOffers publishes the events of each spot that was offered to
+// a waitlist entry.
+func publishWaitlistOffers(eventService booking.EventService, orgID int, offers []booking.WaitlistOffer) {
+	for _, o := range offers {
+		for _, ev := range booking.WaitlistOfferEvents(o) {
+			eventService.PublishEvent(orgID, ev)
+		}
+	}
+}
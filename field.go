@@ -0,0 +1,180 @@
+package booking
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Types of the custom fields that are filled in when a resource is booked.
+const (
+	BookingFieldText   = "text"
+	BookingFieldNumber = "number"
+	BookingFieldBool   = "bool"
+	BookingFieldSelect = "select"
+	BookingFieldDate   = "date"
+)
+
+// BookingFieldTypes contains every valid booking field type.
+var BookingFieldTypes = []string{
+	BookingFieldText,
+	BookingFieldNumber,
+	BookingFieldBool,
+	BookingFieldSelect,
+	BookingFieldDate,
+}
+
+// BookingFieldDateLayout is the layout of the values of date fields.
+const BookingFieldDateLayout = "2006-01-02"
+
+// maxBookingFields is the largest number of fields that a resource can
+// define.
+const maxBookingFields = 50
+
+// bookingFieldKeyPattern matches the keys that booking fields can have, e.g.
+// "partySize".
+var bookingFieldKeyPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{0,63}$`)
+
+// BookingField defines a custom field that customers fill in when they book a
+// resource. The values of fields are stored in the metadata of bookings under
+// the key of the field.
+type BookingField struct {
+	// The metadata key that the value is stored under, e.g. "partySize".
+	Key string `json:"key"`
+
+	// The name of the field shown to customers, e.g. "Party size".
+	Label string `json:"label"`
+
+	// One of BookingFieldTypes.
+	Type string `json:"type"`
+
+	// Whether bookings have to be made with a value for the field.
+	Required bool `json:"required"`
+
+	// The values that can be chosen for select fields.
+	Options []string `json:"options,omitempty"`
+
+	// A regular expression that the values of text fields have to match.
+	Regex string `json:"regex,omitempty"`
+}
+
+// BookingFields are the custom fields of a resource, in the order that they
+// are shown to customers.
+type BookingFields []*BookingField
+
+// Validate the field definitions. Returns a ValidationError for each
+// requirement that fails, named after name, e.g. "bookingFields[0].key".
+func (f BookingFields) Validate(name string) []ValidationError {
+	var errs []ValidationError
+	if len(f) > maxBookingFields {
+		errs = append(errs, ValidationError{Name: name, Reason: fmt.Sprintf("Must contain no more than %d fields", maxBookingFields)})
+	}
+	keys := make(map[string]bool, len(f))
+	for i, v := range f {
+		field := fmt.Sprintf("%s[%d]", name, i)
+		if v == nil {
+			errs = append(errs, ValidationError{Name: field, Reason: "Field is required"})
+			continue
+		}
+		if !bookingFieldKeyPattern.MatchString(v.Key) {
+			errs = append(errs, ValidationError{Name: field + ".key", Reason: "Must start with a letter and contain only letters, digits and underscores, up to 64 characters"})
+		} else if keys[v.Key] {
+			errs = append(errs, ValidationError{Name: field + ".key", Reason: "Must be unique"})
+		}
+		keys[v.Key] = true
+		if strings.TrimSpace(v.Label) == "" {
+			errs = append(errs, ValidationError{Name: field + ".label", Reason: "Label is required"})
+		}
+		if !Strings(BookingFieldTypes).contains(v.Type) {
+			errs = append(errs, ValidationError{Name: field + ".type", Reason: "Must be one of 'text', 'number', 'bool', 'select' or 'date'"})
+		}
+		if v.Type == BookingFieldSelect {
+			if len(v.Options) == 0 {
+				errs = append(errs, ValidationError{Name: field + ".options", Reason: "Must contain at least one option"})
+			}
+			for j, o := range v.Options {
+				if o == "" || Strings(v.Options[:j]).contains(o) {
+					errs = append(errs, ValidationError{Name: fmt.Sprintf("%s.options[%d]", field, j), Reason: "Must be a unique, non-empty option"})
+				}
+			}
+		} else if len(v.Options) > 0 {
+			errs = append(errs, ValidationError{Name: field + ".options", Reason: "Can only be given for select fields"})
+		}
+		if v.Regex != "" {
+			if v.Type != BookingFieldText {
+				errs = append(errs, ValidationError{Name: field + ".regex", Reason: "Can only be given for text fields"})
+			} else if _, err := regexp.Compile(v.Regex); err != nil {
+				errs = append(errs, ValidationError{Name: field + ".regex", Reason: "Must be a valid regular expression"})
+			}
+		}
+	}
+	return errs
+}
+
+// ValidateMetadata checks the metadata of a booking against the fields.
+// Returns a ValidationError named after the key of each field whose value is
+// missing or invalid, e.g. "metadata.partySize". Keys that aren't defined by
+// a field are left as they are.
+func (f BookingFields) ValidateMetadata(metadata map[string]string) []ValidationError {
+	return f.validateMetadata(metadata, true)
+}
+
+// ValidatePartialMetadata checks the values of the metadata of a booking
+// against the fields without requiring any of them, e.g. for a held booking
+// whose details are filled in when it is confirmed.
+func (f BookingFields) ValidatePartialMetadata(metadata map[string]string) []ValidationError {
+	return f.validateMetadata(metadata, false)
+}
+
+func (f BookingFields) validateMetadata(metadata map[string]string, required bool) []ValidationError {
+	var errs []ValidationError
+	for _, v := range f {
+		value, ok := metadata[v.Key]
+		if !ok || strings.TrimSpace(value) == "" {
+			if required && v.Required {
+				errs = append(errs, ValidationError{Name: "metadata." + v.Key, Reason: v.Label + " is required"})
+			}
+			continue
+		}
+		if reason := v.check(value); reason != "" {
+			errs = append(errs, ValidationError{Name: "metadata." + v.Key, Reason: reason})
+		}
+	}
+	return errs
+}
+
+// check returns the reason that value isn't a valid value of the field, or an
+// empty string if it is.
+func (f *BookingField) check(value string) string {
+	switch f.Type {
+	case BookingFieldNumber:
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+			return "Must be a number"
+		}
+	case BookingFieldBool:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return "Must be true or false"
+		}
+	case BookingFieldSelect:
+		if !Strings(f.Options).contains(value) {
+			return "Must be one of the options of the field"
+		}
+	case BookingFieldDate:
+		if _, err := time.Parse(BookingFieldDateLayout, value); err != nil {
+			return "Must be a valid date in the format YYYY-MM-DD"
+		}
+	case BookingFieldText:
+		// Patterns are validated when the field is defined so they always
+		// compile.
+		if f.Regex != "" {
+			if re, err := regexp.Compile(f.Regex); err == nil && !re.MatchString(value) {
+				return "Must match the pattern " + f.Regex
+			}
+		}
+	}
+	return ""
+}
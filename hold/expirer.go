@@ -0,0 +1,108 @@
+package hold
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/openmesh/booking"
+)
+
+// Default settings of an Expirer.
+const (
+	DefaultPollInterval = 15 * time.Second
+	DefaultBatchSize    = 100
+)
+
+// Expirer releases held bookings that were not confirmed before they expired,
+// and pending bookings whose deposit was not paid before they expired, and
+// publishes a booking expired event for each of them. The released time is
+// offered to the waitlist of the resource.
+type Expirer struct {
+	// Service used to find and expire held bookings.
+	Holds booking.BookingHoldService
+
+	// Service that expired events are published to.
+	Events booking.EventService
+
+	// How often to check for expired holds.
+	PollInterval time.Duration
+
+	// Maximum number of holds expired at once.
+	BatchSize int
+
+	Logger log.Logger
+
+	// Returns the current time. Can be replaced in tests.
+	Now func() time.Time
+}
+
+// NewExpirer returns a new instance of Expirer with default settings.
+func NewExpirer(holds booking.BookingHoldService, events booking.EventService) *Expirer {
+	return &Expirer{
+		Holds:        holds,
+		Events:       events,
+		PollInterval: DefaultPollInterval,
+		BatchSize:    DefaultBatchSize,
+		Logger:       log.NewNopLogger(),
+		Now:          time.Now,
+	}
+}
+
+// Run expires held bookings until ctx is cancelled.
+func (e *Expirer) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Keep going while full batches are returned as there are likely to
+		// be more holds waiting.
+		for {
+			n, err := e.ExpireDue(ctx)
+			if err != nil {
+				e.Logger.Log("msg", "failed to expire booking holds", "err", err)
+				break
+			}
+			if n < e.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ExpireDue expires a batch of held bookings whose hold has run out and
+// publishes an event for each of them, along with the events of any spots that
+// were offered to waitlists in their place. Returns the number of bookings that
+// were expired.
+func (e *Expirer) ExpireDue(ctx context.Context) (int, error) {
+	// Bookings expired before an error are still published as their changes
+	// have been committed.
+	expired, offers, err := e.Holds.ExpireBookingHolds(ctx, e.Now(), e.BatchSize)
+
+	for _, p := range expired {
+		if p.Booking.Resource == nil {
+			e.Logger.Log("msg", "expired booking has no resource", "booking", p.Booking.ID)
+			continue
+		}
+		e.Events.PublishEvent(p.Booking.Resource.OrganizationID, booking.Event{
+			Type:    booking.EventTypeBookingExpired,
+			Payload: p,
+		})
+	}
+	for _, o := range offers {
+		if o.Booking.Resource == nil {
+			e.Logger.Log("msg", "offered booking has no resource", "booking", o.Booking.ID)
+			continue
+		}
+		for _, ev := range booking.WaitlistOfferEvents(o) {
+			e.Events.PublishEvent(o.Booking.Resource.OrganizationID, ev)
+		}
+	}
+	return len(expired), err
+}
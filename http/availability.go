@@ -0,0 +1,36 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/openmesh/booking"
+	"github.com/openmesh/booking/endpoint"
+)
+
+func (s *Server) registerAvailabilityRoutes(r *mux.Router) {
+	e := endpoint.MakeAvailabilityEndpoints(s.AvailabilityService)
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(s.logger)),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+
+	r.Methods("GET").Path("/resources/{resourceId}/availabilities").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeResourcesRead)(e.FindAvailabilitiesEndpoint),
+		decodeFindAvailabilitiesRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeFindAvailabilitiesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.FindAvailabilitiesRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
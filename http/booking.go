@@ -2,7 +2,9 @@ package http
 
 import (
 	"context"
+	"io/ioutil"
 	"net/http"
+	"strings"
 
 	"github.com/openmesh/booking"
 
@@ -18,42 +20,79 @@ func (s *Server) registerBookingRoutes(r *mux.Router) {
 	options := []httptransport.ServerOption{
 		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(s.logger)),
 		httptransport.ServerErrorEncoder(encodeError),
+		// Lists are encoded as CSV if the client accepts it.
+		httptransport.ServerBefore(httptransport.PopulateRequestContext),
 	}
 
 	r.Methods("GET").Path("/bookings/{id}").Handler(httptransport.NewServer(
-		e.FindBookingByIDEndpoint,
+		endpoint.RequireScope(booking.ScopeBookingsRead)(e.FindBookingByIDEndpoint),
 		decodeFindBookingByIDRequest,
 		encodeResponse,
 		options...,
 	))
 
 	r.Methods("GET").Path("/bookings").Handler(httptransport.NewServer(
-		e.FindBookingsEndpoint,
+		endpoint.RequireScope(booking.ScopeBookingsRead)(e.FindBookingsEndpoint),
 		decodeFindBookingsRequest,
 		encodeResponse,
 		options...,
 	))
 
 	r.Methods("POST").Path("/bookings").Handler(httptransport.NewServer(
-		e.CreateBookingEndpoint,
+		endpoint.RequireScope(booking.ScopeBookingsWrite)(e.CreateBookingEndpoint),
 		decodeCreateBookingRequest,
 		encodeResponse,
 		options...,
 	))
 
+	r.Methods("POST").Path("/bookings/import").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeBookingsWrite)(e.ImportBookingsEndpoint),
+		decodeImportBookingsRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/bookings/holds").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeBookingsWrite)(e.HoldBookingEndpoint),
+		decodeHoldBookingRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/bookings/{id}/confirm").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeBookingsWrite)(e.ConfirmBookingEndpoint),
+		decodeConfirmBookingRequest,
+		encodeResponse,
+		options...,
+	))
+
 	r.Methods("PUT").Path("/bookings/{id}").Handler(httptransport.NewServer(
-		e.UpdateBookingEndpoint,
+		endpoint.RequireScope(booking.ScopeBookingsWrite)(e.UpdateBookingEndpoint),
 		decodeUpdateBookingRequest,
 		encodeResponse,
 		options...,
 	))
 
 	r.Methods("DELETE").Path("/bookings/{id}").Handler(httptransport.NewServer(
-		e.DeleteBookingEndpoint,
+		endpoint.RequireScope(booking.ScopeBookingsWrite)(e.DeleteBookingEndpoint),
 		decodeDeleteBookingRequest,
 		encodeResponse,
 		options...,
 	))
+
+	r.Methods("GET").Path("/booking-series/{id}").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeBookingsRead)(e.FindBookingSeriesByIDEndpoint),
+		decodeFindBookingSeriesByIDRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/booking-series").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeBookingsWrite)(e.CreateBookingSeriesEndpoint),
+		decodeCreateBookingSeriesRequest,
+		encodeResponse,
+		options...,
+	))
 }
 
 func decodeFindBookingByIDRequest(_ context.Context, r *http.Request) (interface{}, error) {
@@ -64,11 +103,12 @@ func decodeFindBookingByIDRequest(_ context.Context, r *http.Request) (interface
 	return req, nil
 }
 
-func decodeFindBookingsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+func decodeFindBookingsRequest(ctx context.Context, r *http.Request) (interface{}, error) {
 	var req booking.FindBookingsRequest
 	if err := decodeHTTPRequest(r, &req); err != nil {
 		return nil, err
 	}
+	req.Limit = csvLimit(ctx, req.Limit)
 	return req, nil
 }
 
@@ -95,3 +135,71 @@ func decodeDeleteBookingRequest(_ context.Context, r *http.Request) (interface{}
 	}
 	return req, nil
 }
+
+func decodeFindBookingSeriesByIDRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.FindBookingSeriesByIDRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeCreateBookingSeriesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.CreateBookingSeriesRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// decodeImportBookingsRequest accepts the rows of an import either as a CSV
+// file or as JSON. CSV files can be sent as the raw request body or as the
+// "file" field of a multipart form and use the same columns as an export.
+func decodeImportBookingsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	buf, ok, err := readUpload(r, csvContentType, "A CSV file is required")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		var req booking.ImportBookingsRequest
+		if err := decodeHTTPRequest(r, &req); err != nil {
+			return nil, err
+		}
+		return req, nil
+	}
+
+	known := []string{"resourceId", "status", "startTime", "endTime", "segment", "promoCode", "customer", "customerId"}
+	records, err := csvRecords(buf, known, bookingCSVIgnoredColumns, csvMetadataPrefix)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := decodeCSVBookings(records)
+	if err != nil {
+		return nil, err
+	}
+	allOrNothing, err := parseAllOrNothing(r)
+	if err != nil {
+		return nil, err
+	}
+	return booking.ImportBookingsRequest{Rows: rows, AllOrNothing: allOrNothing}, nil
+}
+
+func decodeHoldBookingRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.HoldBookingRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeConfirmBookingRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	// Metadata is optional when confirming so an empty body is accepted.
+	if r.ContentLength == 0 {
+		r.Body = ioutil.NopCloser(strings.NewReader("{}"))
+	}
+	var req booking.ConfirmBookingRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
@@ -0,0 +1,114 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/openmesh/booking"
+	"github.com/openmesh/booking/ical"
+
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/openmesh/booking/endpoint"
+)
+
+func (s *Server) registerCalendarRoutes(r *mux.Router) {
+	e := endpoint.MakeCalendarEndpoints(s.CalendarService)
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(s.logger)),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+
+	r.Methods("POST").Path("/resources/{id}/feed-token").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeResourcesWrite)(e.CreateResourceFeedTokenEndpoint),
+		decodeCreateResourceFeedTokenRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/resources/{resourceId}/unavailabilities/import").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeUnavailabilitiesWrite)(e.ImportUnavailabilitiesEndpoint),
+		decodeImportUnavailabilitiesRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// registerCalendarFeedRoutes registers the routes that calendar applications
+// subscribe to. They are authenticated by the feed token in the URL rather
+// than a session or API key.
+func (s *Server) registerCalendarFeedRoutes(r *mux.Router) {
+	e := endpoint.MakeCalendarEndpoints(s.CalendarService)
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(s.logger)),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+
+	r.Methods("GET").Path("/resources/{id}/bookings").Handler(httptransport.NewServer(
+		e.FindResourceCalendarEndpoint,
+		decodeFindResourceCalendarRequest,
+		encodeCalendarResponse,
+		options...,
+	))
+}
+
+func decodeFindResourceCalendarRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.FindResourceCalendarRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeCreateResourceFeedTokenRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.CreateResourceFeedTokenRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// decodeImportUnavailabilitiesRequest accepts an iCalendar file either as the
+// raw request body, as the "file" field of a multipart form or as JSON.
+func decodeImportUnavailabilitiesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	buf, ok, err := readUpload(r, ical.ContentType, "An iCalendar file is required")
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		// decodeHTTPRequest expects a JSON body so the URL parameter is read
+		// here instead.
+		id, err := strconv.Atoi(mux.Vars(r)["resourceId"])
+		if err != nil {
+			return nil, booking.ValidationErrorf("", booking.ValidationError{
+				Name:   "resourceId",
+				Reason: "Must be a valid integer",
+			})
+		}
+		return booking.ImportUnavailabilitiesRequest{
+			ResourceID: id,
+			Calendar:   string(buf),
+		}, nil
+	}
+
+	var req booking.ImportUnavailabilitiesRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// encodeCalendarResponse writes a calendar as an iCalendar stream.
+func encodeCalendarResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if e, ok := response.(booking.Errorer); ok && e.Error() != nil {
+		encodeError(ctx, e.Error(), w)
+		return nil
+	}
+	res := response.(booking.FindResourceCalendarResponse)
+	w.Header().Set("Content-Type", ical.ContentType+"; charset=utf-8")
+	return ical.Encode(w, res.Calendar)
+}
@@ -0,0 +1,461 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openmesh/booking"
+)
+
+// csvContentType is the media type of comma separated values.
+const csvContentType = "text/csv"
+
+// csvMetadataPrefix prefixes the columns that booking metadata is flattened
+// into, e.g. "metadata.email".
+const csvMetadataPrefix = "metadata."
+
+// Columns written when exporting bookings, resources and unavailabilities.
+// Bookings have an additional column for each metadata key.
+var (
+	bookingCSVColumns        = []string{"id", "resourceId", "userId", "seriesId", "customerId", "status", "startTime", "endTime", "price", "tax", "currency", "createdAt", "updatedAt"}
+	resourceCSVColumns       = []string{"id", "name", "description", "timezone", "price", "bookingPrice", "currency", "quantityAvailable", "slots", "createdAt", "updatedAt"}
+	unavailabilityCSVColumns = []string{"id", "resourceId", "startTime", "endTime", "externalId"}
+)
+
+// Columns of an export that are generated by the server and so are ignored
+// when the export is imported again.
+var (
+	bookingCSVIgnoredColumns  = []string{"id", "userId", "seriesId", "price", "tax", "currency", "createdAt", "updatedAt"}
+	resourceCSVIgnoredColumns = []string{"id", "createdAt", "updatedAt"}
+)
+
+// acceptsCSV reports whether the client asked for CSV in the Accept header
+// of the request.
+func acceptsCSV(ctx context.Context) bool {
+	return accepts(ctx, csvContentType)
+}
+
+// csvExportLimit is the number of rows exported when a list is requested as
+// CSV without a limit. Lists otherwise default to a page of 10 items, which
+// would silently cut exports short. Larger exports are paged through with
+// offset and limit, using the X-Total-Count header to tell when every row has
+// been fetched.
+const csvExportLimit = 10000
+
+// csvLimit returns the limit of a list request, raised to csvExportLimit if
+// the list is being exported as CSV without a limit.
+func csvLimit(ctx context.Context, limit int) int {
+	if limit == 0 && acceptsCSV(ctx) {
+		return csvExportLimit
+	}
+	return limit
+}
+
+// encodeCSVResponse writes the list of a find response as CSV with a header
+// row. The total number of matching items is returned in the X-Total-Count
+// header since there is nowhere else to put it. Returns false if the response
+// can't be represented as CSV.
+func encodeCSVResponse(w http.ResponseWriter, response interface{}) (bool, error) {
+	var header []string
+	var rows [][]string
+	var total int
+	switch res := response.(type) {
+	case booking.FindBookingsResponse:
+		header, rows = bookingCSVRows(res.Bookings)
+		total = res.TotalItems
+	case booking.FindResourcesResponse:
+		header, rows = resourceCSVRows(res.Resources)
+		total = res.TotalItems
+	case booking.FindUnavailabilitiesResponse:
+		header, rows = unavailabilityCSVRows(res.Unavailabilities)
+		total = res.TotalItems
+	default:
+		return false, nil
+	}
+
+	w.Header().Set("Content-Type", csvContentType+"; charset=utf-8")
+	w.Header().Set("X-Total-Count", strconv.Itoa(total))
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return true, err
+	}
+	for _, row := range rows {
+		if err := cw.Write(row); err != nil {
+			return true, err
+		}
+	}
+	cw.Flush()
+	return true, cw.Error()
+}
+
+func bookingCSVRows(bookings []*booking.Booking) ([]string, [][]string) {
+	keys := make(map[string]bool)
+	for _, b := range bookings {
+		for k := range b.Metadata {
+			keys[k] = true
+		}
+	}
+	var metadata []string
+	for k := range keys {
+		metadata = append(metadata, k)
+	}
+	sort.Strings(metadata)
+
+	header := append([]string{}, bookingCSVColumns...)
+	for _, k := range metadata {
+		header = append(header, csvMetadataPrefix+k)
+	}
+
+	rows := make([][]string, 0, len(bookings))
+	for _, b := range bookings {
+		row := []string{
+			strconv.Itoa(b.ID),
+			strconv.Itoa(b.ResourceID),
+			formatCSVIntPtr(b.UserID),
+			formatCSVIntPtr(b.SeriesID),
+			formatCSVIntPtr(b.CustomerID),
+			b.Status,
+			formatCSVTime(b.StartTime),
+			formatCSVTime(b.EndTime),
+			strconv.Itoa(b.Price.Amount),
+			strconv.Itoa(b.Tax),
+			b.Price.Currency,
+			formatCSVTime(b.CreatedAt),
+			formatCSVTime(b.UpdatedAt),
+		}
+		for _, k := range metadata {
+			row = append(row, b.Metadata[k])
+		}
+		rows = append(rows, row)
+	}
+	return header, rows
+}
+
+func resourceCSVRows(resources []*booking.Resource) ([]string, [][]string) {
+	rows := make([][]string, 0, len(resources))
+	for _, r := range resources {
+		rows = append(rows, []string{
+			strconv.Itoa(r.ID),
+			r.Name,
+			r.Description,
+			r.Timezone,
+			strconv.Itoa(r.Price.Amount),
+			strconv.Itoa(r.BookingPrice.Amount),
+			r.Price.Currency,
+			formatCSVIntPtr(r.QuantityAvailable),
+			formatCSVSlots(r.Slots),
+			formatCSVTime(r.CreatedAt),
+			formatCSVTime(r.UpdatedAt),
+		})
+	}
+	return resourceCSVColumns, rows
+}
+
+func unavailabilityCSVRows(unavailabilities []*booking.Unavailability) ([]string, [][]string) {
+	rows := make([][]string, 0, len(unavailabilities))
+	for _, u := range unavailabilities {
+		rows = append(rows, []string{
+			strconv.Itoa(u.ID),
+			strconv.Itoa(u.ResourceID),
+			formatCSVTime(u.StartTime),
+			formatCSVTime(u.EndTime),
+			u.ExternalID,
+		})
+	}
+	return unavailabilityCSVColumns, rows
+}
+
+func formatCSVTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func formatCSVIntPtr(i *int) string {
+	if i == nil {
+		return ""
+	}
+	return strconv.Itoa(*i)
+}
+
+// formatCSVSlots writes slots in a single cell, e.g.
+// "monday 09:00-17:00; tuesday 09:00-12:00 x2". The quantity is only written
+// for slots that limit the number of bookings.
+func formatCSVSlots(slots []*booking.Slot) string {
+	s := make([]string, 0, len(slots))
+	for _, v := range slots {
+		slot := fmt.Sprintf("%s %s-%s", v.Day, v.StartTime, v.EndTime)
+		if v.Quantity != nil {
+			slot += fmt.Sprintf(" x%d", *v.Quantity)
+		}
+		s = append(s, slot)
+	}
+	return strings.Join(s, "; ")
+}
+
+// parseCSVSlots reverses formatCSVSlots. The times of each slot are checked
+// by the validation of the resource.
+func parseCSVSlots(s string) ([]*booking.Slot, bool) {
+	var slots []*booking.Slot
+	for _, v := range strings.Split(s, ";") {
+		fields := strings.Fields(v)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 2 || len(fields) > 3 {
+			return nil, false
+		}
+		times := strings.SplitN(fields[1], "-", 2)
+		if len(times) != 2 {
+			return nil, false
+		}
+		slot := &booking.Slot{
+			Day:       strings.ToLower(fields[0]),
+			StartTime: times[0],
+			EndTime:   times[1],
+		}
+		if len(fields) == 3 {
+			if !strings.HasPrefix(fields[2], "x") {
+				return nil, false
+			}
+			q, err := strconv.Atoi(fields[2][1:])
+			if err != nil {
+				return nil, false
+			}
+			slot.Quantity = &q
+		}
+		slots = append(slots, slot)
+	}
+	return slots, true
+}
+
+// csvRecords reads a CSV file with a header row. Each record is returned as a
+// map of column names to values. Returns EINVALID if the file can't be read or
+// contains a column that isn't in known, ignored or, if prefix isn't empty,
+// starts with prefix. Ignored columns are left out of the records.
+func csvRecords(b []byte, known, ignored []string, prefix string) ([]map[string]string, error) {
+	cr := csv.NewReader(bytes.NewReader(b))
+	cr.TrimLeadingSpace = true
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, booking.ValidationErrorf("", booking.ValidationError{
+			Name:   "file",
+			Reason: "Must contain a header row",
+		})
+	}
+	if err != nil {
+		return nil, booking.ValidationErrorf("", booking.ValidationError{
+			Name:   "file",
+			Reason: "Must be a valid CSV file: " + err.Error(),
+		})
+	}
+
+	var errs []booking.ValidationError
+	skip := make([]bool, len(header))
+	for i, h := range header {
+		h = strings.TrimSpace(strings.TrimPrefix(h, "\ufeff"))
+		header[i] = h
+		switch {
+		case containsString(ignored, h):
+			skip[i] = true
+		case containsString(known, h):
+		case prefix != "" && strings.HasPrefix(h, prefix) && len(h) > len(prefix):
+		default:
+			errs = append(errs, booking.ValidationError{
+				Name:   fmt.Sprintf("columns[%d]", i),
+				Reason: fmt.Sprintf("Unknown column '%s'", h),
+			})
+		}
+	}
+	if len(errs) > 0 {
+		return nil, booking.ValidationErrorf("", errs...)
+	}
+
+	var records []map[string]string
+	for {
+		row, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, booking.ValidationErrorf("", booking.ValidationError{
+				Name:   "file",
+				Reason: "Must be a valid CSV file: " + err.Error(),
+			})
+		}
+		record := make(map[string]string)
+		for i, v := range row {
+			if !skip[i] && v != "" {
+				record[header[i]] = v
+			}
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// decodeCSVBookings converts the records of a CSV file into requests to
+// create bookings. Returns EINVALID with an error for each cell that can't be
+// parsed.
+func decodeCSVBookings(records []map[string]string) ([]booking.CreateBookingRequest, error) {
+	var errs []booking.ValidationError
+	rows := make([]booking.CreateBookingRequest, len(records))
+	for i, record := range records {
+		row := &rows[i]
+		for _, k := range sortedCSVColumns(record) {
+			v := record[k]
+			var ok bool
+			switch k {
+			case "resourceId":
+				row.ResourceID, ok = parseCSVInt(v)
+			case "status":
+				row.Status, ok = v, true
+			case "startTime":
+				row.StartTime, ok = parseCSVTime(v)
+			case "endTime":
+				row.EndTime, ok = parseCSVTime(v)
+			case "segment":
+				row.Segment, ok = v, true
+			case "promoCode":
+				row.PromoCode, ok = v, true
+			case "customer":
+				row.Customer, ok = v, true
+			case "customerId":
+				var id int
+				id, ok = parseCSVInt(v)
+				row.CustomerID = &id
+			default:
+				if row.Metadata == nil {
+					row.Metadata = make(map[string]string)
+				}
+				row.Metadata[strings.TrimPrefix(k, csvMetadataPrefix)], ok = v, true
+			}
+			if !ok {
+				errs = append(errs, csvCellError(i, k))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return nil, booking.ValidationErrorf("", errs...)
+	}
+	return rows, nil
+}
+
+// decodeCSVResources converts the records of a CSV file into requests to
+// create resources. Returns EINVALID with an error for each cell that can't be
+// parsed.
+func decodeCSVResources(records []map[string]string) ([]booking.CreateResourceRequest, error) {
+	var errs []booking.ValidationError
+	rows := make([]booking.CreateResourceRequest, len(records))
+	for i, record := range records {
+		row := &rows[i]
+		for _, k := range sortedCSVColumns(record) {
+			v := record[k]
+			var ok bool
+			switch k {
+			case "name":
+				row.Name, ok = v, true
+			case "description":
+				row.Description, ok = v, true
+			case "timezone":
+				row.Timezone, ok = v, true
+			case "password":
+				row.Password, ok = v, true
+			case "price":
+				row.Price.Amount, ok = parseCSVInt(v)
+			case "bookingPrice":
+				row.BookingPrice.Amount, ok = parseCSVInt(v)
+			case "currency":
+				row.Price.Currency, ok = v, true
+				row.BookingPrice.Currency = v
+			case "quantityAvailable":
+				var q int
+				q, ok = parseCSVInt(v)
+				row.QuantityAvailable = &q
+			case "slots":
+				row.Slots, ok = parseCSVSlots(v)
+			}
+			if !ok {
+				errs = append(errs, csvCellError(i, k))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return nil, booking.ValidationErrorf("", errs...)
+	}
+	return rows, nil
+}
+
+func parseCSVInt(s string) (int, bool) {
+	i, err := strconv.Atoi(strings.TrimSpace(s))
+	return i, err == nil
+}
+
+func parseCSVTime(s string) (time.Time, bool) {
+	t, err := time.Parse(time.RFC3339, strings.TrimSpace(s))
+	return t, err == nil
+}
+
+// csvCellError describes a cell that could not be parsed. Rows are numbered
+// from 0 not counting the header row, the same as the rows of an import.
+func csvCellError(row int, column string) booking.ValidationError {
+	reason := "Must be a valid value"
+	switch column {
+	case "resourceId", "customerId", "price", "bookingPrice", "quantityAvailable":
+		reason = "Must be a valid integer"
+	case "startTime", "endTime":
+		reason = "Must be a valid RFC 3339 time"
+	case "slots":
+		reason = "Must be a list of slots in the format 'monday 09:00-17:00 x2; tuesday 09:00-12:00'"
+	}
+	return booking.ValidationError{
+		Name:   fmt.Sprintf("rows[%d].%s", row, column),
+		Reason: reason,
+	}
+}
+
+// sortedCSVColumns returns the columns of a record in order so that errors
+// are reported consistently.
+func sortedCSVColumns(record map[string]string) []string {
+	columns := make([]string, 0, len(record))
+	for k := range record {
+		columns = append(columns, k)
+	}
+	sort.Strings(columns)
+	return columns
+}
+
+func containsString(s []string, v string) bool {
+	for _, x := range s {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+// parseAllOrNothing reads the allOrNothing query parameter of an import that
+// was uploaded as a file, since decodeHTTPRequest can't be used to decode it.
+func parseAllOrNothing(r *http.Request) (bool, error) {
+	v := r.URL.Query().Get("allOrNothing")
+	if v == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, booking.ValidationErrorf("", booking.ValidationError{
+			Name:   "allOrNothing",
+			Reason: "Must be a valid boolean",
+		})
+	}
+	return b, nil
+}
@@ -0,0 +1,127 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/openmesh/booking"
+
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/openmesh/booking/endpoint"
+)
+
+func (s *Server) registerCustomerRoutes(r *mux.Router) {
+	e := endpoint.MakeCustomerEndpoints(s.CustomerService)
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(s.logger)),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+
+	r.Methods("GET").Path("/customers/{id}").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeCustomersRead)(e.FindCustomerByIDEndpoint),
+		decodeFindCustomerByIDRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("GET").Path("/customers").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeCustomersRead)(e.FindCustomersEndpoint),
+		decodeFindCustomersRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/customers").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeCustomersWrite)(e.CreateCustomerEndpoint),
+		decodeCreateCustomerRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("PUT").Path("/customers/{id}").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeCustomersWrite)(e.UpdateCustomerEndpoint),
+		decodeUpdateCustomerRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("DELETE").Path("/customers/{id}").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeCustomersWrite)(e.DeleteCustomerEndpoint),
+		decodeDeleteCustomerRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("GET").Path("/customers/{id}/bookings").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeCustomersRead)(e.FindCustomerBookingsEndpoint),
+		decodeFindCustomerBookingsRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("GET").Path("/customers/{id}/lifetime-value").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeCustomersRead)(e.GetCustomerLifetimeValueEndpoint),
+		decodeGetCustomerLifetimeValueRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeFindCustomerByIDRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.FindCustomerByIDRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeFindCustomersRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.FindCustomersRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeCreateCustomerRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.CreateCustomerRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeUpdateCustomerRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.UpdateCustomerRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeDeleteCustomerRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.DeleteCustomerRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeFindCustomerBookingsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.FindCustomerBookingsRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeGetCustomerLifetimeValueRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.GetCustomerLifetimeValueRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
@@ -0,0 +1,79 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/openmesh/booking"
+)
+
+// eventHeartbeatInterval is how often a comment is sent to idle event streams
+// so that proxies don't close the connection.
+const eventHeartbeatInterval = 30 * time.Second
+
+func (s *Server) registerEventRoutes(r *mux.Router) {
+	r.Methods("GET").Path("/events").HandlerFunc(s.handleEvents)
+}
+
+// handleEvents streams the events of the current organization to the client
+// using Server-Sent Events. The stream stays open until the client disconnects.
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if !booking.HasScope(ctx, booking.ScopeEventsRead) {
+		Error(w, r, booking.Errorf(booking.EFORBIDDEN, "This API token is missing the '%s' scope.", booking.ScopeEventsRead))
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		Error(w, r, booking.Errorf(booking.ENOTIMPLEMENTED, "Streaming is not supported."))
+		return
+	}
+
+	sub, err := s.EventService.Subscribe(ctx)
+	if err != nil {
+		Error(w, r, err)
+		return
+	}
+	defer sub.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// Disable response buffering in nginx.
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(eventHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		case ev, ok := <-sub.C():
+			// The subscription was closed by the event service, most likely
+			// because the client couldn't keep up. The client will reconnect.
+			if !ok {
+				return
+			}
+			buf, err := json.Marshal(ev)
+			if err != nil {
+				s.logger.Log("msg", "cannot marshal event", "type", ev.Type, "err", err)
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", ev.Type, buf); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
@@ -7,12 +7,14 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"mime"
 	"net/http"
 	"reflect"
 	"strconv"
 	"strings"
 	"time"
 
+	httptransport "github.com/go-kit/kit/transport/http"
 	"github.com/gorilla/mux"
 	"github.com/openmesh/booking"
 )
@@ -118,13 +120,47 @@ func parseResponseError(resp *http.Response) error {
 
 // lookup of application error codes to HTTP status codes.
 var codes = map[string]int{
-	booking.ECONFLICT:             http.StatusConflict,
-	booking.EINVALID:              http.StatusBadRequest,
-	booking.ENOTFOUND:             http.StatusNotFound,
-	booking.ENOTIMPLEMENTED:       http.StatusNotImplemented,
-	booking.EUNAUTHORIZED:         http.StatusUnauthorized,
-	booking.EINTERNAL:             http.StatusInternalServerError,
-	booking.ERESOURCENAMECONFLICT: http.StatusConflict,
+	booking.ECONFLICT:                   http.StatusConflict,
+	booking.EINVALIDTRANSITION:          http.StatusConflict,
+	booking.EINVALID:                    http.StatusBadRequest,
+	booking.ENOTFOUND:                   http.StatusNotFound,
+	booking.ENOTIMPLEMENTED:             http.StatusNotImplemented,
+	booking.EUNAUTHORIZED:               http.StatusUnauthorized,
+	booking.EFORBIDDEN:                  http.StatusForbidden,
+	booking.ERATELIMITED:                http.StatusTooManyRequests,
+	booking.ERESOURCEPASSWORDINVALID:    http.StatusForbidden,
+	booking.EINTERNAL:                   http.StatusInternalServerError,
+	booking.ERESOURCENAMECONFLICT:       http.StatusConflict,
+	booking.EWEBHOOKNOTFOUND:            http.StatusNotFound,
+	booking.EPROMOCODENOTFOUND:          http.StatusNotFound,
+	booking.EPROMOCODECONFLICT:          http.StatusConflict,
+	booking.EINVOICENOTFOUND:            http.StatusNotFound,
+	booking.ETAXRATENOTFOUND:            http.StatusNotFound,
+	booking.ETAXRATECONFLICT:            http.StatusConflict,
+	booking.ECUSTOMERNOTFOUND:           http.StatusNotFound,
+	booking.ECUSTOMERCONFLICT:           http.StatusConflict,
+	booking.EWEBHOOKDELIVERYNOTFOUND:    http.StatusNotFound,
+	booking.EBOOKINGSERIESNOTFOUND:      http.StatusNotFound,
+	booking.ENOTINSERIES:                http.StatusBadRequest,
+	booking.EBOOKINGCONFLICT:            http.StatusConflict,
+	booking.EBOOKINGOUTSIDESLOTS:        http.StatusUnprocessableEntity,
+	booking.EBOOKINGUNAVAILABLE:         http.StatusConflict,
+	booking.EBOOKINGINPAST:              http.StatusUnprocessableEntity,
+	booking.ESLOTFULL:                   http.StatusConflict,
+	booking.EBOOKINGNOTHELD:             http.StatusConflict,
+	booking.EHOLDEXPIRED:                http.StatusConflict,
+	booking.EBOOKINGNOTFOUND:            http.StatusNotFound,
+	booking.EWAITLISTENTRYNOTFOUND:      http.StatusNotFound,
+	booking.EPAYMENTNOTFOUND:            http.StatusNotFound,
+	booking.EPAYMENTREQUIRED:            http.StatusPaymentRequired,
+	booking.EPAYMENTPROVIDER:            http.StatusBadGateway,
+	booking.ERESOURCENOTFOUND:           http.StatusNotFound,
+	booking.EUNAVAILABILITYNOTFOUND:     http.StatusNotFound,
+	booking.EUNAVAILABILITYTIMECONFLICT: http.StatusConflict,
+	booking.EAUTHSOURCENOTCONFIGURED:    http.StatusNotImplemented,
+	booking.EAUTHSOURCEUNSUPPORTED:      http.StatusBadRequest,
+	booking.EAUTHNOTFOUND:               http.StatusNotFound,
+	booking.EUSERNOTFOUND:               http.StatusNotFound,
 }
 
 // ErrorStatusCode returns the associated HTTP status code for a booking error code.
@@ -156,10 +192,29 @@ func encodeResponse(ctx context.Context, w http.ResponseWriter, response interfa
 		encodeError(ctx, e.Error(), w)
 		return nil
 	}
+	if acceptsCSV(ctx) {
+		if ok, err := encodeCSVResponse(w, response); ok {
+			return err
+		}
+	}
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	return json.NewEncoder(w).Encode(response)
 }
 
+// accepts reports whether the client asked for mediaType in the Accept header
+// of the request. The header is only available in the context if the server
+// was created with httptransport.PopulateRequestContext.
+func accepts(ctx context.Context, mediaType string) bool {
+	accept, _ := ctx.Value(httptransport.ContextKeyRequestAccept).(string)
+	for _, v := range strings.Split(accept, ",") {
+		t, _, _ := mime.ParseMediaType(strings.TrimSpace(v))
+		if t == mediaType {
+			return true
+		}
+	}
+	return false
+}
+
 func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 	if err == nil {
 		panic("encodeError with nil error")
@@ -184,6 +239,41 @@ const (
 	sourceJSON  = "json"
 )
 
+// maxUploadSize limits the size of files uploaded to import endpoints.
+const maxUploadSize = 5 << 20
+
+// readUpload reads a file uploaded either as the raw request body with the
+// given media type or as the "file" field of a multipart form. Returns false
+// if the request contains neither so that the body can be decoded as JSON
+// instead. The reason is returned as a validation error if the form has no
+// file.
+func readUpload(r *http.Request, mediaType string, reason string) ([]byte, bool, error) {
+	r.Body = http.MaxBytesReader(nil, r.Body, maxUploadSize)
+
+	var body io.Reader = r.Body
+	switch t, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); t {
+	case mediaType:
+	case "multipart/form-data":
+		f, _, err := r.FormFile("file")
+		if err != nil {
+			return nil, false, booking.ValidationErrorf("", booking.ValidationError{
+				Name:   "file",
+				Reason: reason,
+			})
+		}
+		defer f.Close()
+		body = f
+	default:
+		return nil, false, nil
+	}
+
+	buf, err := io.ReadAll(body)
+	if err != nil {
+		return nil, false, err
+	}
+	return buf, true, nil
+}
+
 func decodeHTTPRequest(r *http.Request, v interface{}) error {
 	rv := reflect.ValueOf(v)
 	if rv.Kind() != reflect.Ptr || rv.IsNil() {
@@ -313,7 +403,7 @@ func decodeHTTPRequest(r *http.Request, v interface{}) error {
 					Reason: "Unrecognized time format. Prefer RFC3339 formatting when submitting date times. https://datatracker.ietf.org/doc/html/rfc3339",
 				})
 			}
-			f.Set(reflect.ValueOf(val))
+			f.Set(reflect.ValueOf(&val))
 		}
 	}
 
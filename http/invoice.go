@@ -0,0 +1,116 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/openmesh/booking"
+	"github.com/openmesh/booking/invoice"
+
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/openmesh/booking/endpoint"
+)
+
+func (s *Server) registerInvoiceRoutes(r *mux.Router) {
+	e := endpoint.MakeInvoiceEndpoints(s.InvoiceService)
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerBefore(httptransport.PopulateRequestContext),
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(s.logger)),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+
+	r.Methods("GET").Path("/invoices/{id}").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeInvoicesRead)(e.FindInvoiceByIDEndpoint),
+		decodeFindInvoiceByIDRequest,
+		encodeInvoiceResponse,
+		options...,
+	))
+
+	r.Methods("GET").Path("/invoices").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeInvoicesRead)(e.FindInvoicesEndpoint),
+		decodeFindInvoicesRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("GET").Path("/bookings/{bookingId}/invoice").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeInvoicesRead)(e.FindBookingInvoiceEndpoint),
+		decodeFindBookingInvoiceRequest,
+		encodeInvoiceResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/bookings/{bookingId}/invoice").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeInvoicesWrite)(e.IssueBookingInvoiceEndpoint),
+		decodeIssueBookingInvoiceRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeFindInvoiceByIDRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.FindInvoiceByIDRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeFindInvoicesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.FindInvoicesRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeFindBookingInvoiceRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.FindBookingInvoiceRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeIssueBookingInvoiceRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.IssueBookingInvoiceRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// encodeInvoiceResponse writes an invoice as a PDF document or an HTML page if
+// the client asked for one, e.g. with a ".pdf" or ".html" extension, and as
+// JSON otherwise.
+func encodeInvoiceResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if e, ok := response.(booking.Errorer); ok && e.Error() != nil {
+		encodeError(ctx, e.Error(), w)
+		return nil
+	}
+
+	var i *booking.Invoice
+	switch res := response.(type) {
+	case booking.FindInvoiceByIDResponse:
+		i = res.Invoice
+	case booking.FindBookingInvoiceResponse:
+		i = res.Invoice
+	}
+
+	switch {
+	case accepts(ctx, invoice.PDFContentType):
+		w.Header().Set("Content-Type", invoice.PDFContentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", i.Number+".pdf"))
+		return invoice.EncodePDF(w, i)
+	case accepts(ctx, invoice.HTMLContentType):
+		w.Header().Set("Content-Type", invoice.HTMLContentType+"; charset=utf-8")
+		return invoice.EncodeHTML(w, i)
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	return json.NewEncoder(w).Encode(response)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/go-kit/kit/transport"
 	httptransport "github.com/go-kit/kit/transport/http"
 	"github.com/gorilla/mux"
+	"github.com/openmesh/booking"
 	"github.com/openmesh/booking/endpoint"
 )
 
@@ -24,21 +25,21 @@ func (s *Server) registerOrganizationRoutes(r *mux.Router) {
 	// POST /organizations/ creates a new organization
 
 	r.Methods("GET").Path("/organization").Handler(httptransport.NewServer(
-		e.FindCurrentOrganizationEndpoint,
+		endpoint.RequireScope(booking.ScopeOrganizationRead)(e.FindCurrentOrganizationEndpoint),
 		decodeFindCurrentOrganizationRequest,
 		encodeResponse,
 		options...,
 	))
 
 	r.Methods("PUT").Path("/organization").Handler(httptransport.NewServer(
-		e.UpdateOrganizationEndpoint,
+		endpoint.RequireScope(booking.ScopeOrganizationWrite)(e.UpdateOrganizationEndpoint),
 		decodeUpdateOrganizationRequest,
 		encodeResponse,
 		options...,
 	))
 
 	r.Methods("POST").Path("organizations").Handler(httptransport.NewServer(
-		e.CreateOrganizationEndpoint,
+		endpoint.RequireScope(booking.ScopeOrganizationWrite)(e.CreateOrganizationEndpoint),
 		decodeCreateOrganizationRequest,
 		encodeResponse,
 		options...,
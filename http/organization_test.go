@@ -0,0 +1,71 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	"github.com/gorilla/mux"
+	"github.com/openmesh/booking"
+)
+
+// organizationService is a booking.OrganizationService that records whether
+// it was called.
+type organizationService struct {
+	booking.OrganizationService
+	called bool
+}
+
+func (s *organizationService) FindCurrentOrganization(ctx context.Context) (*booking.Organization, error) {
+	s.called = true
+	return &booking.Organization{}, nil
+}
+
+func (s *organizationService) UpdateOrganization(ctx context.Context, upd booking.OrganizationUpdate) (*booking.Organization, error) {
+	s.called = true
+	return &booking.Organization{}, nil
+}
+
+func TestOrganizationRoutes_Scopes(t *testing.T) {
+	tests := []struct {
+		method string
+		scope  string
+	}{
+		{method: "GET", scope: booking.ScopeOrganizationRead},
+		{method: "PUT", scope: booking.ScopeOrganizationWrite},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			// Tokens that only have the other scopes of the organization
+			// are rejected.
+			var others []string
+			for _, scope := range booking.Scopes {
+				if scope != tt.scope {
+					others = append(others, scope)
+				}
+			}
+			for _, scopes := range [][]string{others, {tt.scope}} {
+				svc := &organizationService{}
+				s := &Server{OrganizationService: svc, logger: log.NewNopLogger()}
+				router := mux.NewRouter()
+				s.registerOrganizationRoutes(router)
+
+				r := httptest.NewRequest(tt.method, "/organization", strings.NewReader("{}"))
+				r = r.WithContext(booking.NewContextWithScopes(r.Context(), scopes))
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, r)
+
+				granted := len(scopes) == 1
+				if granted && (w.Code != http.StatusOK || !svc.called) {
+					t.Errorf("token with %s returned %d", tt.scope, w.Code)
+				}
+				if !granted && (w.Code != http.StatusForbidden || svc.called) {
+					t.Errorf("token without %s returned %d", tt.scope, w.Code)
+				}
+			}
+		})
+	}
+}
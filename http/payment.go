@@ -0,0 +1,114 @@
+package http
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/openmesh/booking"
+
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/openmesh/booking/endpoint"
+)
+
+// maxPaymentWebhookSize limits the size of notifications sent by payment
+// providers.
+const maxPaymentWebhookSize = 1 << 20
+
+func (s *Server) registerPaymentRoutes(r *mux.Router) {
+	e := endpoint.MakePaymentEndpoints(s.PaymentService)
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(s.logger)),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+
+	r.Methods("GET").Path("/payments").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopePaymentsRead)(e.FindPaymentsEndpoint),
+		decodeFindPaymentsRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/payments/{id}/capture").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopePaymentsWrite)(e.CapturePaymentEndpoint),
+		decodeCapturePaymentRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/payments/{id}/refund").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopePaymentsWrite)(e.RefundPaymentEndpoint),
+		decodeRefundPaymentRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// registerPaymentWebhookRoutes registers the routes that payment providers
+// send notifications to. They are authenticated by the signature of the
+// notification rather than a session or API key.
+func (s *Server) registerPaymentWebhookRoutes(r *mux.Router) {
+	e := endpoint.MakePaymentEndpoints(s.PaymentService)
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(s.logger)),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+
+	r.Methods("POST").Path("/payments/webhooks/{provider}").Handler(httptransport.NewServer(
+		e.HandlePaymentWebhookEndpoint,
+		decodeHandlePaymentWebhookRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeFindPaymentsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.FindPaymentsRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeCapturePaymentRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	// The amount is optional so an empty body is accepted.
+	if r.ContentLength == 0 {
+		r.Body = ioutil.NopCloser(strings.NewReader("{}"))
+	}
+	var req booking.CapturePaymentRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeRefundPaymentRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	// The amount is optional so an empty body is accepted.
+	if r.ContentLength == 0 {
+		r.Body = ioutil.NopCloser(strings.NewReader("{}"))
+	}
+	var req booking.RefundPaymentRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// decodeHandlePaymentWebhookRequest keeps the body of a notification as it was
+// sent as its signature has to be checked against the exact bytes.
+func decodeHandlePaymentWebhookRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	payload, err := ioutil.ReadAll(http.MaxBytesReader(nil, r.Body, maxPaymentWebhookSize))
+	if err != nil {
+		return nil, booking.Errorf(booking.EINVALID, "Failed to read notification.")
+	}
+	return booking.HandlePaymentWebhookRequest{
+		Provider: mux.Vars(r)["provider"],
+		Payload:  payload,
+		Headers:  r.Header,
+	}, nil
+}
@@ -0,0 +1,36 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/openmesh/booking"
+	"github.com/openmesh/booking/endpoint"
+)
+
+func (s *Server) registerPricingRoutes(r *mux.Router) {
+	e := endpoint.MakePricingEndpoints(s.PricingService)
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(s.logger)),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+
+	r.Methods("GET").Path("/resources/{resourceId}/quote").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeResourcesRead)(e.QuoteBookingEndpoint),
+		decodeQuoteBookingRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeQuoteBookingRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.QuoteBookingRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
@@ -0,0 +1,112 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/openmesh/booking"
+
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/openmesh/booking/endpoint"
+)
+
+func (s *Server) registerPromoCodeRoutes(r *mux.Router) {
+	e := endpoint.MakePromoCodeEndpoints(s.PromoCodeService)
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(s.logger)),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+
+	r.Methods("GET").Path("/promo-codes/{id}").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopePromoCodesRead)(e.FindPromoCodeByIDEndpoint),
+		decodeFindPromoCodeByIDRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("GET").Path("/promo-codes").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopePromoCodesRead)(e.FindPromoCodesEndpoint),
+		decodeFindPromoCodesRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/promo-codes").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopePromoCodesWrite)(e.CreatePromoCodeEndpoint),
+		decodeCreatePromoCodeRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("PUT").Path("/promo-codes/{id}").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopePromoCodesWrite)(e.UpdatePromoCodeEndpoint),
+		decodeUpdatePromoCodeRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("DELETE").Path("/promo-codes/{id}").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopePromoCodesWrite)(e.DeletePromoCodeEndpoint),
+		decodeDeletePromoCodeRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("GET").Path("/promo-codes/{promoCodeId}/redemptions").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopePromoCodesRead)(e.FindPromoRedemptionsEndpoint),
+		decodeFindPromoRedemptionsRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeFindPromoCodeByIDRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.FindPromoCodeByIDRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeFindPromoCodesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.FindPromoCodesRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeCreatePromoCodeRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.CreatePromoCodeRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeUpdatePromoCodeRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.UpdatePromoCodeRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeDeletePromoCodeRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.DeletePromoCodeRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeFindPromoRedemptionsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.FindPromoRedemptionsRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
@@ -0,0 +1,232 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	kitendpoint "github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/openmesh/booking"
+	"github.com/openmesh/booking/endpoint"
+)
+
+// Default limits on the number of requests that each client can make to the
+// public API of an organization per minute. Bookings are limited separately
+// so that a client can't fill up a resource with bookings that it never pays
+// for.
+const (
+	DefaultPublicRateLimit        = 300
+	DefaultPublicBookingRateLimit = 10
+)
+
+// The number of times that each client can give the wrong password for a
+// resource before it has to wait for the window to end, and the number of
+// times that the wrong password can be given for a resource by every client
+// together before the resource is locked for the rest of the window. Keeps
+// passwords from being guessed through the public API, including by clients
+// that keep changing their address.
+const (
+	passwordFailureLimit  = 5
+	passwordFailureWindow = 15 * time.Minute
+
+	resourcePasswordFailureLimit  = 50
+	resourcePasswordFailureWindow = time.Hour
+)
+
+// registerPublicRoutes registers the routes of the public API under
+// /public/{publicKey}. They can be called from the browser on any origin and
+// act on behalf of the organization that the public key belongs to.
+func (s *Server) registerPublicRoutes(router *mux.Router) {
+	r := router.PathPrefix("/public/{publicKey}").Subrouter()
+	r.Use(allowCrossOrigin)
+	r.Use(newRateLimiter(s.PublicRateLimit, time.Minute, publicRateLimitKey).middleware)
+	r.Use(s.authenticatePublicKey)
+
+	// Preflight requests are answered by allowCrossOrigin but they still need
+	// a route for the middleware to run.
+	r.Methods("OPTIONS").PathPrefix("/").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	resources := endpoint.MakeResourceEndpoints(s.ResourceService)
+	availabilities := endpoint.MakeAvailabilityEndpoints(s.AvailabilityService)
+	bookings := endpoint.MakeBookingEndpoints(s.BookingService)
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(s.logger)),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+
+	r.Methods("GET").Path("/resources/{id}").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeResourcesRead)(resources.FindResourceByIDEndpoint),
+		decodeFindResourceByIDRequest,
+		encodePublicResponse,
+		options...,
+	))
+
+	r.Methods("GET").Path("/resources").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeResourcesRead)(resources.FindResourcesEndpoint),
+		decodeFindPublicResourcesRequest,
+		encodePublicResponse,
+		options...,
+	))
+
+	r.Methods("GET").Path("/resources/{resourceId}/availabilities").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeResourcesRead)(availabilities.FindAvailabilitiesEndpoint),
+		decodeFindAvailabilitiesRequest,
+		encodePublicResponse,
+		options...,
+	))
+
+	limitBookings := newRateLimiter(s.PublicBookingRateLimit, time.Minute, publicRateLimitKey).middleware
+	limitPasswords := limitPasswordFailures(
+		newRateLimiter(passwordFailureLimit, passwordFailureWindow, nil),
+		newRateLimiter(resourcePasswordFailureLimit, resourcePasswordFailureWindow, nil),
+	)
+	r.Methods("POST").Path("/bookings").Handler(limitBookings(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeBookingsWrite)(limitPasswords(bookings.CreateBookingEndpoint)),
+		decodeCreatePublicBookingRequest,
+		encodePublicResponse,
+		append(options, httptransport.ServerBefore(addRequestToContext))...,
+	)))
+}
+
+// authenticatePublicKey is middleware that identifies the organization of a
+// public API request by the public key in its URL. Any user signed in with a
+// session is ignored and the request is restricted to booking.PublicScopes.
+func (s *Server) authenticatePublicKey(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := mux.Vars(r)["publicKey"]
+		org, err := s.OrganizationService.FindOrganizationByPublicKey(r.Context(), key)
+		if booking.ErrorCode(err) == booking.ENOTFOUND {
+			encodeError(r.Context(), booking.Errorf(booking.EUNAUTHORIZED, "Invalid public key."), w)
+			return
+		} else if err != nil {
+			Error(w, r, err)
+			return
+		}
+
+		ctx := booking.NewContextWithUser(r.Context(), nil)
+		ctx = booking.NewContextWithOrganization(ctx, org)
+		ctx = booking.NewContextWithScopes(ctx, booking.PublicScopes)
+		ctx = booking.NewContextWithPublic(ctx, true)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// allowCrossOrigin is middleware that lets browsers call the public API from
+// any origin. Requests are identified by the public key in the URL rather
+// than by cookies so credentials are never allowed. Preflight requests are
+// answered without calling the next handler.
+func allowCrossOrigin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h := w.Header()
+		h.Set("Access-Control-Allow-Origin", "*")
+		h.Set("Access-Control-Expose-Headers", "Retry-After")
+		if r.Method == http.MethodOptions {
+			h.Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+			h.Set("Access-Control-Allow-Headers", "Accept, Content-Type")
+			h.Set("Access-Control-Max-Age", "600")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// publicRateLimitKey returns the key that public API requests are counted
+// against: the client that made the request within an organization.
+func publicRateLimitKey(r *http.Request) string {
+	return mux.Vars(r)["publicKey"] + "/" + clientIP(r)
+}
+
+// limitPasswordFailures is endpoint middleware that counts the bookings that
+// fail because of a wrong resource password against clients, per client and
+// resource, and against resources, per resource no matter which client made
+// them. Once either limit is reached bookings of the resource are rejected
+// with ERATELIMITED without the password being checked. Each attempt is
+// counted before the password is checked so that parallel guesses can't get
+// past the limits, and given back if it didn't fail because of the password.
+func limitPasswordFailures(clients, resources *rateLimiter) kitendpoint.Middleware {
+	return func(next kitendpoint.Endpoint) kitendpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			req, ok := request.(booking.CreateBookingRequest)
+			r := requestFromContext(ctx)
+			if !ok || r == nil {
+				return next(ctx, request)
+			}
+			resourceKey := mux.Vars(r)["publicKey"] + "/" + strconv.Itoa(req.ResourceID)
+			clientKey := publicRateLimitKey(r) + "/" + strconv.Itoa(req.ResourceID)
+			if ok, wait := clients.allow(clientKey); !ok {
+				return nil, booking.Errorf(booking.ERATELIMITED, "Too many incorrect passwords. Try again in %d seconds.", retryAfterSeconds(wait))
+			}
+			if ok, wait := resources.allow(resourceKey); !ok {
+				clients.release(clientKey)
+				return nil, booking.Errorf(booking.ERATELIMITED, "Too many incorrect passwords for this resource. Try again in %d seconds.", retryAfterSeconds(wait))
+			}
+			response, err := next(ctx, request)
+			if e, ok := response.(booking.Errorer); !ok || booking.ErrorCode(e.Error()) != booking.ERESOURCEPASSWORDINVALID {
+				clients.release(clientKey)
+				resources.release(resourceKey)
+			}
+			return response, err
+		}
+	}
+}
+
+func decodeFindPublicResourcesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.FindPublicResourcesRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	if errs := req.Validate(); len(errs) > 0 {
+		return nil, booking.WrapValidationErrors(errs)
+	}
+	return req.FindResourcesRequest(), nil
+}
+
+func decodeCreatePublicBookingRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.CreatePublicBookingRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req.CreateBookingRequest(), nil
+}
+
+// encodePublicResponse writes the public view of a response so that fields
+// such as resource passwords are never sent to public API clients.
+func encodePublicResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	res, err := publicResponse(response)
+	if err != nil {
+		return err
+	}
+	return encodeResponse(ctx, w, res)
+}
+
+// publicResponse returns the public view of the response of a service. Returns
+// an error rather than the response itself if there is no public view of it so
+// that private fields are never sent by mistake.
+func publicResponse(response interface{}) (interface{}, error) {
+	switch res := response.(type) {
+	case booking.FindResourcesResponse:
+		resources := make([]*booking.PublicResource, 0, len(res.Resources))
+		for _, v := range res.Resources {
+			resources = append(resources, v.Public())
+		}
+		return booking.FindPublicResourcesResponse{Resources: resources, TotalItems: res.TotalItems, Err: res.Err}, nil
+	case booking.FindResourceByIDResponse:
+		return booking.FindPublicResourceByIDResponse{PublicResource: res.Resource.Public(), Err: res.Err}, nil
+	case booking.FindAvailabilitiesResponse:
+		availabilities := make([]*booking.PublicAvailability, 0, len(res.Availabilities))
+		for _, v := range res.Availabilities {
+			availabilities = append(availabilities, v.Public())
+		}
+		return booking.FindPublicAvailabilitiesResponse{Availabilities: availabilities, TotalItems: res.TotalItems, Err: res.Err}, nil
+	case booking.CreateBookingResponse:
+		return booking.CreatePublicBookingResponse{PublicBooking: res.Booking.Public(), Payment: res.Payment.Public(), Err: res.Err}, nil
+	}
+	return nil, fmt.Errorf("no public view of %T", response)
+}
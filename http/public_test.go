@@ -0,0 +1,109 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/openmesh/booking"
+)
+
+func TestLimitPasswordFailures_Concurrent(t *testing.T) {
+	const limit = 3
+	l := newRateLimiter(limit, time.Minute, nil)
+	resources := newRateLimiter(100, time.Minute, nil)
+
+	// Every guess is wrong and holds up the endpoint until all of them have
+	// been made.
+	var checked int32
+	start := make(chan struct{})
+	wrongPassword := limitPasswordFailures(l, resources)(func(ctx context.Context, request interface{}) (interface{}, error) {
+		atomic.AddInt32(&checked, 1)
+		<-start
+		return booking.CreateBookingResponse{Err: booking.Errorf(booking.ERESOURCEPASSWORDINVALID, "Invalid password.")}, nil
+	})
+	ctx := newContextWithRequest(context.Background(), httptest.NewRequest("POST", "/bookings", nil))
+	req := booking.CreateBookingRequest{ResourceID: 1}
+
+	var wg sync.WaitGroup
+	var limited int32
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := wrongPassword(ctx, req); booking.ErrorCode(err) == booking.ERATELIMITED {
+				atomic.AddInt32(&limited, 1)
+			}
+		}()
+	}
+	for atomic.LoadInt32(&checked)+atomic.LoadInt32(&limited) < 10 {
+		time.Sleep(time.Millisecond)
+	}
+	close(start)
+	wg.Wait()
+
+	if checked != limit {
+		t.Errorf("checked %d passwords, want %d", checked, limit)
+	}
+	if limited != 10-limit {
+		t.Errorf("rate limited %d guesses, want %d", limited, 10-limit)
+	}
+}
+
+func TestLimitPasswordFailures_Success(t *testing.T) {
+	ok := limitPasswordFailures(newRateLimiter(1, time.Minute, nil), newRateLimiter(1, time.Minute, nil))(func(ctx context.Context, request interface{}) (interface{}, error) {
+		return booking.CreateBookingResponse{}, nil
+	})
+	ctx := newContextWithRequest(context.Background(), httptest.NewRequest("POST", "/bookings", nil))
+	req := booking.CreateBookingRequest{ResourceID: 1}
+
+	// Bookings that don't fail because of the password don't count towards
+	// the limit.
+	for i := 0; i < 3; i++ {
+		if _, err := ok(ctx, req); err != nil {
+			t.Fatalf("booking %d returned %v", i+1, err)
+		}
+	}
+}
+
+func TestLimitPasswordFailures_ManyClients(t *testing.T) {
+	const limit = 4
+	clients := newRateLimiter(2, time.Minute, nil)
+	resources := newRateLimiter(limit, time.Minute, nil)
+
+	var checked int
+	wrongPassword := limitPasswordFailures(clients, resources)(func(ctx context.Context, request interface{}) (interface{}, error) {
+		checked++
+		return booking.CreateBookingResponse{Err: booking.Errorf(booking.ERESOURCEPASSWORDINVALID, "Invalid password.")}, nil
+	})
+
+	// Each client guesses once, which keeps every one of them under its own
+	// limit, but the guesses are counted against the resource together.
+	guess := func(ip string, resourceID int) error {
+		r := httptest.NewRequest("POST", "/bookings", nil)
+		r.RemoteAddr = ip + ":1234"
+		_, err := wrongPassword(newContextWithRequest(context.Background(), r), booking.CreateBookingRequest{ResourceID: resourceID})
+		return err
+	}
+	for i := 0; i < 10; i++ {
+		err := guess(fmt.Sprintf("192.0.2.%d", i+1), 1)
+		if i < limit && err != nil {
+			t.Fatalf("guess %d returned %v", i+1, err)
+		}
+		if i >= limit && booking.ErrorCode(err) != booking.ERATELIMITED {
+			t.Fatalf("guess %d returned %v, want %s", i+1, err, booking.ERATELIMITED)
+		}
+	}
+	if checked != limit {
+		t.Errorf("checked %d passwords, want %d", checked, limit)
+	}
+
+	// Other resources aren't locked.
+	if err := guess("192.0.2.10", 2); err != nil {
+		t.Errorf("guess for another resource returned %v", err)
+	}
+}
@@ -0,0 +1,129 @@
+package http
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/openmesh/booking"
+)
+
+// rateLimiter limits the number of requests made with each key in a fixed
+// window of time.
+type rateLimiter struct {
+	limit  int
+	window time.Duration
+	key    func(r *http.Request) string
+
+	mu      sync.Mutex
+	windows map[string]*rateWindow
+	swept   time.Time
+
+	// Returns the current time. Can be replaced in tests.
+	now func() time.Time
+}
+
+// rateWindow counts the requests made with a key since the window started.
+type rateWindow struct {
+	start time.Time
+	count int
+}
+
+// newRateLimiter returns a rateLimiter that allows limit requests with the
+// same key in each window.
+func newRateLimiter(limit int, window time.Duration, key func(r *http.Request) string) *rateLimiter {
+	return &rateLimiter{
+		limit:   limit,
+		window:  window,
+		key:     key,
+		windows: make(map[string]*rateWindow),
+		now:     time.Now,
+	}
+}
+
+// allow counts a request made with key. Returns false and the time until the
+// window ends if the limit has already been reached.
+func (l *rateLimiter) allow(key string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	w := l.current(key, now)
+	if w.count >= l.limit {
+		return false, w.start.Add(l.window).Sub(now)
+	}
+	w.count++
+	return true, 0
+}
+
+// release gives back a request counted with key by allow, e.g. because it
+// turned out not to count towards the limit.
+func (l *rateLimiter) release(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if w, ok := l.windows[key]; ok && w.count > 0 {
+		w.count--
+	}
+}
+
+// current returns the window of key at now, starting a new one if the last
+// window has ended. Must be called with mu held.
+func (l *rateLimiter) current(key string, now time.Time) *rateWindow {
+	// Forget windows that have ended once per window so that the map doesn't
+	// grow with every client that has ever made a request.
+	if now.Sub(l.swept) >= l.window {
+		for k, w := range l.windows {
+			if now.Sub(w.start) >= l.window {
+				delete(l.windows, k)
+			}
+		}
+		l.swept = now
+	}
+
+	w, ok := l.windows[key]
+	if !ok || now.Sub(w.start) >= l.window {
+		w = &rateWindow{start: now}
+		l.windows[key] = w
+	}
+	return w
+}
+
+// retryAfterSeconds rounds the time until a window ends to whole seconds as
+// sent in the Retry-After header.
+func retryAfterSeconds(wait time.Duration) int {
+	seconds := int(wait.Round(time.Second) / time.Second)
+	if seconds < 1 {
+		seconds = 1
+	}
+	return seconds
+}
+
+// middleware rejects requests with ERATELIMITED once the limit has been
+// reached. A limit of zero or less disables the limiter.
+func (l *rateLimiter) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if l.limit <= 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if ok, wait := l.allow(l.key(r)); !ok {
+			seconds := retryAfterSeconds(wait)
+			w.Header().Set("Retry-After", strconv.Itoa(seconds))
+			encodeError(r.Context(), booking.Errorf(booking.ERATELIMITED, "Too many requests. Try again in %d seconds.", seconds), w)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// clientIP returns the IP address that r was sent from.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
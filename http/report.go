@@ -20,43 +20,43 @@ func (s *Server) registerReportRoutes(r *mux.Router) {
 		httptransport.ServerErrorEncoder(encodeError),
 	}
 
-	r.Methods("insert_method_here").Path("insert_path_here").Handler(httptransport.NewServer(
-		e.GetRecentSalesReportEndpoint,
+	r.Methods("GET").Path("/reports/recent-sales").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeReportsRead)(e.GetRecentSalesReportEndpoint),
 		decodeGetRecentSalesReportRequest,
 		encodeResponse,
 		options...,
 	))
 
-	r.Methods("insert_method_here").Path("insert_path_here").Handler(httptransport.NewServer(
-		e.GetUpcomingBookingsReportEndpoint,
+	r.Methods("GET").Path("/reports/upcoming-bookings").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeReportsRead)(e.GetUpcomingBookingsReportEndpoint),
 		decodeGetUpcomingBookingsReportRequest,
 		encodeResponse,
 		options...,
 	))
 
-	r.Methods("insert_method_here").Path("insert_path_here").Handler(httptransport.NewServer(
-		e.GetBookingsActivityReportEndpoint,
+	r.Methods("GET").Path("/reports/bookings-activity").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeReportsRead)(e.GetBookingsActivityReportEndpoint),
 		decodeGetBookingsActivityReportRequest,
 		encodeResponse,
 		options...,
 	))
 
-	r.Methods("insert_method_here").Path("insert_path_here").Handler(httptransport.NewServer(
-		e.GetTodaysBookingsReportEndpoint,
+	r.Methods("GET").Path("/reports/todays-bookings").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeReportsRead)(e.GetTodaysBookingsReportEndpoint),
 		decodeGetTodaysBookingsReportRequest,
 		encodeResponse,
 		options...,
 	))
 
-	r.Methods("insert_method_here").Path("insert_path_here").Handler(httptransport.NewServer(
-		e.GetTopResourcesReportEndpoint,
+	r.Methods("GET").Path("/reports/top-resources").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeReportsRead)(e.GetTopResourcesReportEndpoint),
 		decodeGetTopResourcesReportRequest,
 		encodeResponse,
 		options...,
 	))
 
-	r.Methods("insert_method_here").Path("insert_path_here").Handler(httptransport.NewServer(
-		e.GetTopEmployeesReportEndpoint,
+	r.Methods("GET").Path("/reports/top-employees").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeReportsRead)(e.GetTopEmployeesReportEndpoint),
 		decodeGetTopEmployeesReportRequest,
 		encodeResponse,
 		options...,
@@ -64,25 +64,49 @@ func (s *Server) registerReportRoutes(r *mux.Router) {
 }
 
 func decodeGetRecentSalesReportRequest(_ context.Context, r *http.Request) (interface{}, error) {
-	return booking.GetRecentSalesReportRequest{}, nil
+	var req booking.GetRecentSalesReportRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
 }
 
 func decodeGetUpcomingBookingsReportRequest(_ context.Context, r *http.Request) (interface{}, error) {
-	return booking.GetUpcomingBookingsReportRequest{}, nil
+	var req booking.GetUpcomingBookingsReportRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
 }
 
 func decodeGetBookingsActivityReportRequest(_ context.Context, r *http.Request) (interface{}, error) {
-	return booking.GetBookingsActivityReportRequest{}, nil
+	var req booking.GetBookingsActivityReportRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
 }
 
 func decodeGetTodaysBookingsReportRequest(_ context.Context, r *http.Request) (interface{}, error) {
-	return booking.GetTodaysBookingsReportRequest{}, nil
+	var req booking.GetTodaysBookingsReportRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
 }
 
 func decodeGetTopResourcesReportRequest(_ context.Context, r *http.Request) (interface{}, error) {
-	return booking.GetTopResourcesReportRequest{}, nil
+	var req booking.GetTopResourcesReportRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
 }
 
 func decodeGetTopEmployeesReportRequest(_ context.Context, r *http.Request) (interface{}, error) {
-	return booking.GetTopEmployeesReportRequest{}, nil
+	var req booking.GetTopEmployeesReportRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
 }
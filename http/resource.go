@@ -18,38 +18,47 @@ func (s *Server) registerResourceRoutes(r *mux.Router) {
 	options := []httptransport.ServerOption{
 		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(s.logger)),
 		httptransport.ServerErrorEncoder(encodeError),
+		// Lists are encoded as CSV if the client accepts it.
+		httptransport.ServerBefore(httptransport.PopulateRequestContext),
 	}
 
 	r.Methods("GET").Path("/resources/{id}").Handler(httptransport.NewServer(
-		e.FindResourceByIDEndpoint,
+		endpoint.RequireScope(booking.ScopeResourcesRead)(e.FindResourceByIDEndpoint),
 		decodeFindResourceByIDRequest,
 		encodeResponse,
 		options...,
 	))
 
 	r.Methods("GET").Path("/resources").Handler(httptransport.NewServer(
-		e.FindResourcesEndpoint,
+		endpoint.RequireScope(booking.ScopeResourcesRead)(e.FindResourcesEndpoint),
 		decodeFindResourcesRequest,
 		encodeResponse,
 		options...,
 	))
 
 	r.Methods("POST").Path("/resources").Handler(httptransport.NewServer(
-		e.CreateResourceEndpoint,
+		endpoint.RequireScope(booking.ScopeResourcesWrite)(e.CreateResourceEndpoint),
 		decodeCreateResourceRequest,
 		encodeResponse,
 		options...,
 	))
 
+	r.Methods("POST").Path("/resources/import").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeResourcesWrite)(e.ImportResourcesEndpoint),
+		decodeImportResourcesRequest,
+		encodeResponse,
+		options...,
+	))
+
 	r.Methods("PUT").Path("/resources/{id}").Handler(httptransport.NewServer(
-		e.UpdateResourceEndpoint,
+		endpoint.RequireScope(booking.ScopeResourcesWrite)(e.UpdateResourceEndpoint),
 		decodeUpdateResourceRequest,
 		encodeResponse,
 		options...,
 	))
 
 	r.Methods("DELETE").Path("/resources/{id}").Handler(httptransport.NewServer(
-		e.DeleteResourceEndpoint,
+		endpoint.RequireScope(booking.ScopeResourcesWrite)(e.DeleteResourceEndpoint),
 		decodeDeleteResourceRequest,
 		encodeResponse,
 		options...,
@@ -64,11 +73,12 @@ func decodeFindResourceByIDRequest(_ context.Context, r *http.Request) (interfac
 	return req, nil
 }
 
-func decodeFindResourcesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+func decodeFindResourcesRequest(ctx context.Context, r *http.Request) (interface{}, error) {
 	var req booking.FindResourcesRequest
 	if err := decodeHTTPRequest(r, &req); err != nil {
 		return nil, err
 	}
+	req.Limit = csvLimit(ctx, req.Limit)
 	return req, nil
 }
 
@@ -95,3 +105,36 @@ func decodeDeleteResourceRequest(_ context.Context, r *http.Request) (interface{
 	}
 	return req, nil
 }
+
+// decodeImportResourcesRequest accepts the rows of an import either as a CSV
+// file or as JSON. CSV files can be sent as the raw request body or as the
+// "file" field of a multipart form. Passwords can be imported from a password
+// column even though they are not exported.
+func decodeImportResourcesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	buf, ok, err := readUpload(r, csvContentType, "A CSV file is required")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		var req booking.ImportResourcesRequest
+		if err := decodeHTTPRequest(r, &req); err != nil {
+			return nil, err
+		}
+		return req, nil
+	}
+
+	known := []string{"name", "description", "timezone", "password", "price", "bookingPrice", "currency", "quantityAvailable", "slots"}
+	records, err := csvRecords(buf, known, resourceCSVIgnoredColumns, "")
+	if err != nil {
+		return nil, err
+	}
+	rows, err := decodeCSVResources(records)
+	if err != nil {
+		return nil, err
+	}
+	allOrNothing, err := parseAllOrNothing(r)
+	if err != nil {
+		return nil, err
+	}
+	return booking.ImportResourcesRequest{Rows: rows, AllOrNothing: allOrNothing}, nil
+}
@@ -46,18 +46,32 @@ type Server struct {
 	GitHubClientID     string
 	GitHubClientSecret string
 
+	// The number of requests and of bookings that each client can make to the
+	// public API of an organization per minute. Zero disables the limit.
+	PublicRateLimit        int
+	PublicBookingRateLimit int
+
 	// Services used by the various HTTP routes.
 	AuthService           booking.AuthService
 	AvailabilityService   booking.AvailabilityService
 	BookingService        booking.BookingService
+	CalendarService       booking.CalendarService
 	EventService          booking.EventService
+	InvoiceService        booking.InvoiceService
 	OAuthService          booking.OAuthService
 	OrganizationService   booking.OrganizationService
+	PaymentService        booking.PaymentService
+	PricingService        booking.PricingService
+	PromoCodeService      booking.PromoCodeService
 	ReportService         booking.ReportService
 	ResourceService       booking.ResourceService
+	TaxRateService        booking.TaxRateService
+	CustomerService       booking.CustomerService
 	TokenService          booking.TokenService
 	UnavailabilityService booking.UnavailabilityService
 	UserService           booking.UserService
+	WaitlistService       booking.WaitlistService
+	WebhookService        booking.WebhookService
 }
 
 // NewServer returns a new instance of Server.
@@ -66,6 +80,9 @@ func NewServer() *Server {
 	s := &Server{
 		server: &http.Server{},
 		router: mux.NewRouter(),
+
+		PublicRateLimit:        DefaultPublicRateLimit,
+		PublicBookingRateLimit: DefaultPublicBookingRateLimit,
 	}
 
 	// Report panics to external service.
@@ -110,6 +127,9 @@ func (s *Server) RegisterRoutes() {
 		"/",
 	}
 	s.router.Use(s.authenticate)
+	// Register the public API, which identifies organizations by their public
+	// key instead of authenticating requests.
+	s.registerPublicRoutes(s.router)
 	// Register unauthenticated routes.
 	{
 		r := s.router.PathPrefix("/").Subrouter()
@@ -120,6 +140,13 @@ func (s *Server) RegisterRoutes() {
 			r.HandleFunc(route, s.handleSpaRoute).Methods("GET")
 		}
 	}
+	// Register routes that authenticate requests themselves, such as calendar
+	// feeds which are authenticated by a token in the URL.
+	{
+		r := s.router.PathPrefix("/").Subrouter()
+		s.registerCalendarFeedRoutes(r)
+		s.registerPaymentWebhookRoutes(r)
+	}
 	// Register authenticated routes.
 	{
 		r := s.router.PathPrefix("/").Subrouter()
@@ -127,7 +154,19 @@ func (s *Server) RegisterRoutes() {
 		s.registerResourceRoutes(r)
 		s.registerBookingRoutes(r)
 		s.registerUnavailabilityRoutes(r)
+		s.registerAvailabilityRoutes(r)
+		s.registerPricingRoutes(r)
+		s.registerPromoCodeRoutes(r)
+		s.registerTaxRateRoutes(r)
+		s.registerCustomerRoutes(r)
+		s.registerInvoiceRoutes(r)
+		s.registerReportRoutes(r)
 		s.registerTokenRoutes(r)
+		s.registerEventRoutes(r)
+		s.registerWebhookRoutes(r)
+		s.registerWaitlistRoutes(r)
+		s.registerPaymentRoutes(r)
+		s.registerCalendarRoutes(r)
 		for _, route := range authSpaRoutes {
 			r.HandleFunc(route, s.handleSpaRoute).Methods("GET")
 		}
@@ -262,8 +301,9 @@ func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Override content-type for certain extensions.
-	// This allows us to easily cURL API endpoints with a ".json" or ".csv"
-	// extension instead of having to explicitly set Content-type & Accept headers.
+	// This allows us to easily cURL API endpoints with a ".json", ".csv", ".ics",
+	// ".pdf" or ".html" extension instead of having to explicitly set
+	// Content-type & Accept headers.
 	// The extensions are removed so they don't appear in the routes.
 	switch ext := path.Ext(r.URL.Path); ext {
 	case ".json":
@@ -273,6 +313,15 @@ func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
 	case ".csv":
 		r.Header.Set("Accept", "text/csv")
 		r.URL.Path = strings.TrimSuffix(r.URL.Path, ext)
+	case ".ics":
+		r.Header.Set("Accept", "text/calendar")
+		r.URL.Path = strings.TrimSuffix(r.URL.Path, ext)
+	case ".pdf":
+		r.Header.Set("Accept", "application/pdf")
+		r.URL.Path = strings.TrimSuffix(r.URL.Path, ext)
+	case ".html":
+		r.Header.Set("Accept", "text/html")
+		r.URL.Path = strings.TrimSuffix(r.URL.Path, ext)
 	}
 
 	// Delegate remaining HTTP handling to the gorilla router.
@@ -286,6 +335,22 @@ func (s *Server) authenticate(next http.Handler) http.Handler {
 		if v := r.Header.Get("Authorization"); strings.HasPrefix(v, "Bearer ") {
 			apiKey := strings.TrimPrefix(v, "Bearer ")
 
+			// Named API tokens are restricted to the scopes that they were
+			// created with and act on behalf of the user that created them.
+			if strings.HasPrefix(apiKey, booking.TokenSecretPrefix) {
+				res := s.TokenService.AuthenticateToken(r.Context(), booking.AuthenticateTokenRequest{Secret: apiKey})
+				if res.Err != nil {
+					Error(w, r, res.Err)
+					return
+				}
+
+				ctx := booking.NewContextWithUser(r.Context(), res.Token.User)
+				ctx = booking.NewContextWithOrganization(ctx, res.Token.Organization)
+				ctx = booking.NewContextWithScopes(ctx, res.Token.Scopes)
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+
 			// Lookup organization by API key. Display error if not found.
 			org, err := s.OrganizationService.FindOrganizationByPrivateKey(r.Context(), apiKey)
 			if err != nil && booking.ErrorCode(err) == booking.ENOTFOUND {
@@ -0,0 +1,97 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/openmesh/booking"
+
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/openmesh/booking/endpoint"
+)
+
+func (s *Server) registerTaxRateRoutes(r *mux.Router) {
+	e := endpoint.MakeTaxRateEndpoints(s.TaxRateService)
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(s.logger)),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+
+	r.Methods("GET").Path("/tax-rates/{id}").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeTaxRatesRead)(e.FindTaxRateByIDEndpoint),
+		decodeFindTaxRateByIDRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("GET").Path("/tax-rates").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeTaxRatesRead)(e.FindTaxRatesEndpoint),
+		decodeFindTaxRatesRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/tax-rates").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeTaxRatesWrite)(e.CreateTaxRateEndpoint),
+		decodeCreateTaxRateRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("PUT").Path("/tax-rates/{id}").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeTaxRatesWrite)(e.UpdateTaxRateEndpoint),
+		decodeUpdateTaxRateRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("DELETE").Path("/tax-rates/{id}").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeTaxRatesWrite)(e.DeleteTaxRateEndpoint),
+		decodeDeleteTaxRateRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeFindTaxRateByIDRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.FindTaxRateByIDRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeFindTaxRatesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.FindTaxRatesRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeCreateTaxRateRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.CreateTaxRateRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeUpdateTaxRateRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.UpdateTaxRateRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeDeleteTaxRateRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.DeleteTaxRateRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
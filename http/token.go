@@ -20,25 +20,48 @@ func (s *Server) registerTokenRoutes(r *mux.Router) {
 		httptransport.ServerErrorEncoder(encodeError),
 	}
 
-	r.Methods("insert_method_here").Path("insert_path_here").Handler(httptransport.NewServer(
-		e.CreateTokenEndpoint,
+	r.Methods("POST").Path("/tokens").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeTokensWrite)(e.CreateTokenEndpoint),
 		decodeCreateTokenRequest,
 		encodeResponse,
 		options...,
 	))
 
-	r.Methods("insert_method_here").Path("insert_path_here").Handler(httptransport.NewServer(
-		e.FindTokensEndpoint,
+	r.Methods("GET").Path("/tokens").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeTokensRead)(e.FindTokensEndpoint),
 		decodeFindTokensRequest,
 		encodeResponse,
 		options...,
 	))
+
+	r.Methods("DELETE").Path("/tokens/{id}").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeTokensWrite)(e.RevokeTokenEndpoint),
+		decodeRevokeTokenRequest,
+		encodeResponse,
+		options...,
+	))
 }
 
 func decodeCreateTokenRequest(_ context.Context, r *http.Request) (interface{}, error) {
-	return booking.CreateTokenRequest{}, nil
+	var req booking.CreateTokenRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
 }
 
 func decodeFindTokensRequest(_ context.Context, r *http.Request) (interface{}, error) {
-	return booking.FindTokensRequest{}, nil
+	var req booking.FindTokensRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeRevokeTokenRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.RevokeTokenRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
 }
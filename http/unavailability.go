@@ -18,38 +18,40 @@ func (s *Server) registerUnavailabilityRoutes(r *mux.Router) {
 	options := []httptransport.ServerOption{
 		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(s.logger)),
 		httptransport.ServerErrorEncoder(encodeError),
+		// Lists are encoded as CSV if the client accepts it.
+		httptransport.ServerBefore(httptransport.PopulateRequestContext),
 	}
 
 	r.Methods("GET").Path("/resources/{resourceId}/unavailabilities/{id}").Handler(httptransport.NewServer(
-		e.FindUnavailabilityByIDEndpoint,
+		endpoint.RequireScope(booking.ScopeUnavailabilitiesRead)(e.FindUnavailabilityByIDEndpoint),
 		decodeFindUnavailabilityByIDRequest,
 		encodeResponse,
 		options...,
 	))
 
 	r.Methods("GET").Path("/resources/{resourceId}/unavailabilities").Handler(httptransport.NewServer(
-		e.FindUnavailabilitiesEndpoint,
+		endpoint.RequireScope(booking.ScopeUnavailabilitiesRead)(e.FindUnavailabilitiesEndpoint),
 		decodeFindUnavailabilitiesRequest,
 		encodeResponse,
 		options...,
 	))
 
 	r.Methods("POST").Path("/resources/{resourceId}/unavailabilities").Handler(httptransport.NewServer(
-		e.CreateUnavailabilityEndpoint,
+		endpoint.RequireScope(booking.ScopeUnavailabilitiesWrite)(e.CreateUnavailabilityEndpoint),
 		decodeCreateUnavailabilityRequest,
 		encodeResponse,
 		options...,
 	))
 
 	r.Methods("PUT").Path("/resources/{resourceId}/unavailabilities").Handler(httptransport.NewServer(
-		e.UpdateUnavailabilityEndpoint,
+		endpoint.RequireScope(booking.ScopeUnavailabilitiesWrite)(e.UpdateUnavailabilityEndpoint),
 		decodeUpdateUnavailabilityRequest,
 		encodeResponse,
 		options...,
 	))
 
 	r.Methods("DELETE").Path("/resources/{resourceId}/unavailabilities/{id}").Handler(httptransport.NewServer(
-		e.DeleteUnavailabilityEndpoint,
+		endpoint.RequireScope(booking.ScopeUnavailabilitiesWrite)(e.DeleteUnavailabilityEndpoint),
 		decodeDeleteUnavailabilityRequest,
 		encodeResponse,
 		options...,
@@ -64,11 +66,12 @@ func decodeFindUnavailabilityByIDRequest(_ context.Context, r *http.Request) (in
 	return req, nil
 }
 
-func decodeFindUnavailabilitiesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+func decodeFindUnavailabilitiesRequest(ctx context.Context, r *http.Request) (interface{}, error) {
 	var req booking.FindUnavailabilitiesRequest
 	if err := decodeHTTPRequest(r, &req); err != nil {
 		return nil, err
 	}
+	req.Limit = csvLimit(ctx, req.Limit)
 	return req, nil
 }
 
@@ -0,0 +1,67 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/openmesh/booking"
+
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/openmesh/booking/endpoint"
+)
+
+func (s *Server) registerWaitlistRoutes(r *mux.Router) {
+	e := endpoint.MakeWaitlistEndpoints(s.WaitlistService)
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(s.logger)),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+
+	r.Methods("GET").Path("/waitlist").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeBookingsRead)(e.FindWaitlistEntriesEndpoint),
+		decodeFindWaitlistEntriesRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/waitlist").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeBookingsWrite)(e.JoinWaitlistEndpoint),
+		decodeJoinWaitlistRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("DELETE").Path("/waitlist/{id}").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeBookingsWrite)(e.LeaveWaitlistEndpoint),
+		decodeLeaveWaitlistRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeFindWaitlistEntriesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.FindWaitlistEntriesRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeJoinWaitlistRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.JoinWaitlistRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeLeaveWaitlistRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.LeaveWaitlistRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
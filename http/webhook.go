@@ -0,0 +1,127 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/openmesh/booking"
+
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/openmesh/booking/endpoint"
+)
+
+func (s *Server) registerWebhookRoutes(r *mux.Router) {
+	e := endpoint.MakeWebhookEndpoints(s.WebhookService)
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(s.logger)),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+
+	r.Methods("GET").Path("/webhooks/{id}").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeWebhooksRead)(e.FindWebhookByIDEndpoint),
+		decodeFindWebhookByIDRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("GET").Path("/webhooks").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeWebhooksRead)(e.FindWebhooksEndpoint),
+		decodeFindWebhooksRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/webhooks").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeWebhooksWrite)(e.CreateWebhookEndpoint),
+		decodeCreateWebhookRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("PUT").Path("/webhooks/{id}").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeWebhooksWrite)(e.UpdateWebhookEndpoint),
+		decodeUpdateWebhookRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("DELETE").Path("/webhooks/{id}").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeWebhooksWrite)(e.DeleteWebhookEndpoint),
+		decodeDeleteWebhookRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("GET").Path("/webhooks/{webhookId}/deliveries").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeWebhooksRead)(e.FindWebhookDeliveriesEndpoint),
+		decodeFindWebhookDeliveriesRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/webhooks/{webhookId}/deliveries/{id}/retry").Handler(httptransport.NewServer(
+		endpoint.RequireScope(booking.ScopeWebhooksWrite)(e.RetryWebhookDeliveryEndpoint),
+		decodeRetryWebhookDeliveryRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeFindWebhookByIDRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.FindWebhookByIDRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeFindWebhooksRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.FindWebhooksRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeCreateWebhookRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.CreateWebhookRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeUpdateWebhookRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.UpdateWebhookRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeDeleteWebhookRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.DeleteWebhookRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeFindWebhookDeliveriesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.FindWebhookDeliveriesRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeRetryWebhookDeliveryRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req booking.RetryWebhookDeliveryRequest
+	if err := decodeHTTPRequest(r, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
@@ -0,0 +1,399 @@
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openmesh/booking"
+)
+
+// maxLineLength limits the length of an unfolded content line.
+const maxLineLength = 1 << 20
+
+// defaultEventSummary is the summary of events that don't have one.
+const defaultEventSummary = "Busy"
+
+// property is a single content line of a component.
+type property struct {
+	Name   string
+	Params map[string]string
+	Value  string
+}
+
+// component holds the properties of a VEVENT.
+type component struct {
+	index int
+	props map[string][]property
+}
+
+func (c *component) get(name string) (property, bool) {
+	p, ok := c.props[name]
+	if !ok || len(p) == 0 {
+		return property{}, false
+	}
+	return p[0], true
+}
+
+// Decode reads the events of an iCalendar stream. Floating times and dates are
+// interpreted in loc, as are times with a TZID that isn't a known IANA
+// timezone. Recurring events are expanded into an event per occurrence up to
+// until, each with a UID made from the UID of the event and the start of the
+// occurrence. Modified occurrences replace the occurrence that they modify.
+//
+// Cancelled events and events that don't block time are left out. Events that
+// can't be decoded are left out too and an EventError is returned for each of
+// them. An error is only returned if r is not an iCalendar stream.
+func Decode(r io.Reader, loc *time.Location, until time.Time) ([]*booking.CalendarEvent, []*EventError, error) {
+	components, err := readEvents(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Modified occurrences are identified by the UID of the recurring event
+	// and the original start time of the occurrence.
+	overridden := make(map[string][]time.Time)
+	for _, c := range components {
+		p, ok := c.get("RECURRENCE-ID")
+		if !ok {
+			continue
+		}
+		t, _, err := parseTime(p, loc)
+		if err != nil {
+			continue
+		}
+		uid, _ := c.get("UID")
+		overridden[uid.Value] = append(overridden[uid.Value], t)
+	}
+
+	var events []*booking.CalendarEvent
+	var errs []*EventError
+	for _, c := range components {
+		ev, err := decodeEvent(c, loc, until, overridden)
+		if err != nil {
+			uid, _ := c.get("UID")
+			errs = append(errs, &EventError{Index: c.index, UID: uid.Value, Err: err})
+			continue
+		}
+		events = append(events, ev...)
+	}
+	return events, errs, nil
+}
+
+// readEvents reads the VEVENT components of an iCalendar stream. Properties of
+// components nested within events, such as alarms, are ignored.
+func readEvents(r io.Reader) ([]*component, error) {
+	lines, err := readLines(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 || !strings.EqualFold(lines[0], "BEGIN:VCALENDAR") {
+		return nil, errors.New("not an iCalendar file")
+	}
+
+	var components []*component
+	var stack []string
+	var current *component
+	for _, l := range lines {
+		p, err := parseProperty(l)
+		if err != nil {
+			return nil, err
+		}
+		switch p.Name {
+		case "BEGIN":
+			name := strings.ToUpper(p.Value)
+			if name == "VEVENT" && len(stack) == 1 {
+				current = &component{index: len(components), props: make(map[string][]property)}
+			}
+			stack = append(stack, name)
+			continue
+		case "END":
+			name := strings.ToUpper(p.Value)
+			if len(stack) == 0 || stack[len(stack)-1] != name {
+				return nil, fmt.Errorf("unexpected END:%s", p.Value)
+			}
+			stack = stack[:len(stack)-1]
+			if name == "VEVENT" && current != nil && len(stack) == 1 {
+				components = append(components, current)
+				current = nil
+			}
+			continue
+		}
+		if current != nil && len(stack) == 2 {
+			current.props[p.Name] = append(current.props[p.Name], p)
+		}
+	}
+	if len(stack) != 0 {
+		return nil, errors.New("unexpected end of calendar")
+	}
+	return components, nil
+}
+
+// readLines reads the content lines of r, joining lines that have been folded.
+func readLines(r io.Reader) ([]string, error) {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0, 64*1024), maxLineLength)
+	var lines []string
+	for s.Scan() {
+		l := strings.TrimRight(s.Text(), "\r")
+		if l == "" {
+			continue
+		}
+		if (l[0] == ' ' || l[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += l[1:]
+			continue
+		}
+		lines = append(lines, l)
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read calendar: %w", err)
+	}
+	return lines, nil
+}
+
+// parseProperty parses a content line of the form NAME;PARAM=VALUE:VALUE.
+// Parameter values may be quoted to include colons and semicolons.
+func parseProperty(l string) (property, error) {
+	p := property{Params: make(map[string]string)}
+	quoted := false
+	start := 0
+	var name string
+	var param string
+	for i := 0; i < len(l); i++ {
+		switch c := l[i]; {
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+		case c == ';' || c == ':':
+			if name == "" {
+				name = l[:i]
+			} else if param != "" {
+				p.Params[param] = strings.Trim(l[start:i], `"`)
+				param = ""
+			}
+			start = i + 1
+			if c == ':' {
+				p.Name = strings.ToUpper(name)
+				p.Value = l[i+1:]
+				return p, nil
+			}
+		case c == '=' && param == "" && name != "":
+			param = strings.ToUpper(l[start:i])
+			start = i + 1
+		}
+	}
+	return property{}, fmt.Errorf("invalid content line '%s'", l)
+}
+
+// decodeEvent returns the occurrences of an event that block time.
+func decodeEvent(
+	c *component,
+	loc *time.Location,
+	until time.Time,
+	overridden map[string][]time.Time,
+) ([]*booking.CalendarEvent, error) {
+	if p, ok := c.get("STATUS"); ok && strings.EqualFold(p.Value, booking.CalendarEventStatusCancelled) {
+		return nil, nil
+	}
+	if p, ok := c.get("TRANSP"); ok && strings.EqualFold(p.Value, "TRANSPARENT") {
+		return nil, nil
+	}
+
+	uid, ok := c.get("UID")
+	if !ok || uid.Value == "" {
+		return nil, errors.New("UID is required")
+	}
+	p, ok := c.get("DTSTART")
+	if !ok {
+		return nil, errors.New("DTSTART is required")
+	}
+	start, allDay, err := parseTime(p, loc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DTSTART: %w", err)
+	}
+	end, err := eventEnd(c, start, allDay, loc)
+	if err != nil {
+		return nil, err
+	}
+	if !end.After(start) {
+		return nil, errors.New("event must end after it starts")
+	}
+
+	ev := &booking.CalendarEvent{
+		UID:       unescapeText(uid.Value),
+		Summary:   defaultEventSummary,
+		Status:    booking.CalendarEventStatusConfirmed,
+		StartTime: start,
+		EndTime:   end,
+		AllDay:    allDay,
+	}
+	if p, ok := c.get("SUMMARY"); ok {
+		ev.Summary = unescapeText(p.Value)
+	}
+	if p, ok := c.get("DESCRIPTION"); ok {
+		ev.Description = unescapeText(p.Value)
+	}
+	if p, ok := c.get("STATUS"); ok {
+		ev.Status = strings.ToUpper(p.Value)
+	}
+	if p, ok := c.get("CREATED"); ok {
+		ev.CreatedAt, _, _ = parseTime(p, loc)
+	}
+	if p, ok := c.get("LAST-MODIFIED"); ok {
+		ev.UpdatedAt, _, _ = parseTime(p, loc)
+	}
+
+	// A modified occurrence takes the UID of the occurrence that it replaces.
+	if p, ok := c.get("RECURRENCE-ID"); ok {
+		t, _, err := parseTime(p, loc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid RECURRENCE-ID: %w", err)
+		}
+		ev.UID = occurrenceUID(ev.UID, t)
+		return []*booking.CalendarEvent{ev}, nil
+	}
+
+	p, ok = c.get("RRULE")
+	if !ok {
+		return []*booking.CalendarEvent{ev}, nil
+	}
+	rule, err := booking.ParseRRule(p.Value)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported RRULE: %w", err)
+	}
+	if u := rule.UntilIn(start.Location()); !rule.Bounded() || (u != nil && u.After(until)) {
+		rule.Count = 0
+		rule.Until = &until
+	}
+	starts, _ := rule.Occurrences(start, booking.MaxBookingSeriesOccurrences)
+
+	excluded := overridden[uid.Value]
+	for _, p := range c.props["EXDATE"] {
+		for _, v := range strings.Split(p.Value, ",") {
+			t, _, err := parseTime(property{Params: p.Params, Value: v}, loc)
+			if err != nil {
+				return nil, fmt.Errorf("invalid EXDATE: %w", err)
+			}
+			excluded = append(excluded, t)
+		}
+	}
+
+	duration := end.Sub(start)
+	var events []*booking.CalendarEvent
+	for _, st := range starts {
+		if containsTime(excluded, st) || st.After(until) {
+			continue
+		}
+		o := *ev
+		o.UID = occurrenceUID(ev.UID, st)
+		o.StartTime = st
+		o.EndTime = st.Add(duration)
+		if allDay {
+			// Keep all day events on date boundaries across DST changes.
+			o.EndTime = st.AddDate(0, 0, int(duration.Hours()/24+0.5))
+		}
+		events = append(events, &o)
+	}
+	return events, nil
+}
+
+// eventEnd returns the end of an event from its DTEND or DURATION. Events with
+// neither end at the end of the day if they're all day events and are
+// otherwise instantaneous, as described by RFC 5545.
+func eventEnd(c *component, start time.Time, allDay bool, loc *time.Location) (time.Time, error) {
+	if p, ok := c.get("DTEND"); ok {
+		end, _, err := parseTime(p, loc)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid DTEND: %w", err)
+		}
+		return end, nil
+	}
+	if p, ok := c.get("DURATION"); ok {
+		d, err := parseDuration(p.Value)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid DURATION: %w", err)
+		}
+		return start.Add(d), nil
+	}
+	if allDay {
+		return start.AddDate(0, 0, 1), nil
+	}
+	return start, nil
+}
+
+// parseTime parses a DATE or DATE-TIME property value. Returns true if the
+// value is a date.
+func parseTime(p property, loc *time.Location) (time.Time, bool, error) {
+	v := strings.TrimSpace(p.Value)
+	if strings.EqualFold(p.Params["VALUE"], "DATE") || len(v) == len(dateLayout) {
+		t, err := time.Parse(dateLayout, v)
+		if err != nil {
+			return time.Time{}, true, err
+		}
+		return booking.LocalTime(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc), true, nil
+	}
+	if strings.HasSuffix(v, "Z") {
+		t, err := time.Parse(utcDateTimeLayout, v)
+		return t, false, err
+	}
+	tz := loc
+	if tzid := p.Params["TZID"]; tzid != "" {
+		// Calendars exported from Outlook use Windows timezone names which
+		// aren't recognised. Those fall back to loc.
+		if l, err := time.LoadLocation(strings.TrimPrefix(tzid, "/")); err == nil {
+			tz = l
+		}
+	}
+	t, err := time.Parse(dateTimeLayout, v)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	h, m, s := t.Clock()
+	return booking.LocalTime(t.Year(), t.Month(), t.Day(), h, m, s, 0, tz), false, nil
+}
+
+var durationPattern = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// parseDuration parses a DURATION value such as "PT1H30M" or "P1D".
+func parseDuration(s string) (time.Duration, error) {
+	m := durationPattern.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(s)))
+	if m == nil || s == "P" || strings.HasSuffix(s, "T") {
+		return 0, fmt.Errorf("'%s' is not a valid duration", s)
+	}
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var d time.Duration
+	for i, unit := range units {
+		if m[i+2] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(m[i+2])
+		if err != nil {
+			return 0, fmt.Errorf("'%s' is not a valid duration", s)
+		}
+		d += time.Duration(n) * unit
+	}
+	if m[1] == "-" {
+		d = -d
+	}
+	return d, nil
+}
+
+// occurrenceUID returns the UID of the occurrence of a recurring event that
+// starts at t.
+func occurrenceUID(uid string, t time.Time) string {
+	return uid + "/" + t.UTC().Format(utcDateTimeLayout)
+}
+
+func containsTime(times []time.Time, t time.Time) bool {
+	for _, v := range times {
+		if v.Equal(t) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,146 @@
+// Package ical reads and writes calendars in the iCalendar format defined by
+// RFC 5545. Only the parts of the format needed to exchange events with
+// common calendar applications are supported.
+package ical
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/openmesh/booking"
+)
+
+// ContentType is the media type of iCalendar data.
+const ContentType = "text/calendar"
+
+// ProductID identifies the application that produced a calendar.
+const ProductID = "-//OpenMesh//Booking//EN"
+
+// Layouts of DATE and DATE-TIME values.
+const (
+	dateLayout        = "20060102"
+	dateTimeLayout    = "20060102T150405"
+	utcDateTimeLayout = "20060102T150405Z"
+)
+
+// Content lines longer than maxLineOctets are folded onto continuation lines
+// that begin with a space.
+const maxLineOctets = 75
+
+// Encode writes cal to w as an iCalendar stream. Times are written in UTC.
+func Encode(w io.Writer, cal *booking.Calendar) error {
+	e := &encoder{w: w}
+	e.line("BEGIN", "VCALENDAR")
+	e.line("VERSION", "2.0")
+	e.line("PRODID", ProductID)
+	e.line("CALSCALE", "GREGORIAN")
+	e.line("METHOD", "PUBLISH")
+	if cal.Name != "" {
+		e.line("X-WR-CALNAME", escapeText(cal.Name))
+	}
+
+	stamp := time.Now().UTC().Format(utcDateTimeLayout)
+	for _, ev := range cal.Events {
+		e.line("BEGIN", "VEVENT")
+		e.line("UID", escapeText(ev.UID))
+		e.line("DTSTAMP", stamp)
+		if ev.AllDay {
+			e.line("DTSTART;VALUE=DATE", ev.StartTime.Format(dateLayout))
+			e.line("DTEND;VALUE=DATE", ev.EndTime.Format(dateLayout))
+		} else {
+			e.line("DTSTART", ev.StartTime.UTC().Format(utcDateTimeLayout))
+			e.line("DTEND", ev.EndTime.UTC().Format(utcDateTimeLayout))
+		}
+		e.line("SUMMARY", escapeText(ev.Summary))
+		if ev.Description != "" {
+			e.line("DESCRIPTION", escapeText(ev.Description))
+		}
+		if ev.Status != "" {
+			e.line("STATUS", ev.Status)
+		}
+		if !ev.CreatedAt.IsZero() {
+			e.line("CREATED", ev.CreatedAt.UTC().Format(utcDateTimeLayout))
+		}
+		if !ev.UpdatedAt.IsZero() {
+			e.line("LAST-MODIFIED", ev.UpdatedAt.UTC().Format(utcDateTimeLayout))
+		}
+		e.line("END", "VEVENT")
+	}
+
+	e.line("END", "VCALENDAR")
+	return e.err
+}
+
+// encoder writes content lines, folding them so that no line is longer than
+// 75 octets. The first error is kept and later writes are skipped.
+type encoder struct {
+	w   io.Writer
+	err error
+}
+
+func (e *encoder) line(name, value string) {
+	if e.err != nil {
+		return
+	}
+	s := name + ":" + value
+	var b strings.Builder
+	n := 0
+	for _, r := range s {
+		size := utf8.RuneLen(r)
+		if n+size > maxLineOctets {
+			b.WriteString("\r\n ")
+			n = 1
+		}
+		b.WriteRune(r)
+		n += size
+	}
+	b.WriteString("\r\n")
+	_, e.err = io.WriteString(e.w, b.String())
+}
+
+// escapeText escapes a TEXT value.
+func escapeText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
+
+// unescapeText reverses escapeText.
+func unescapeText(s string) string {
+	return strings.NewReplacer(
+		`\\`, `\`,
+		`\;`, ";",
+		`\,`, ",",
+		`\n`, "\n",
+		`\N`, "\n",
+	).Replace(s)
+}
+
+// EventError describes why an event of a calendar could not be decoded.
+type EventError struct {
+	// The position of the event within the calendar, starting from 0.
+	Index int
+
+	// The UID of the event, if it has one.
+	UID string
+
+	Err error
+}
+
+// Error implements the error interface.
+func (e *EventError) Error() string {
+	if e.UID == "" {
+		return fmt.Sprintf("event %d: %s", e.Index, e.Err)
+	}
+	return fmt.Sprintf("event %d (%s): %s", e.Index, e.UID, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *EventError) Unwrap() error { return e.Err }
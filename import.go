@@ -0,0 +1,31 @@
+package booking
+
+import "fmt"
+
+// MaxImportRows is the maximum number of rows that can be imported at once.
+const MaxImportRows = 1000
+
+// ImportRowErrors prefixes the names of the validation errors of a row of an
+// import with the position of the row, e.g. "rows[2].startTime".
+func ImportRowErrors(row int, errs []ValidationError) []ValidationError {
+	prefixed := make([]ValidationError, len(errs))
+	for i, e := range errs {
+		prefixed[i] = ValidationError{
+			Name:   fmt.Sprintf("rows[%d].%s", row, e.Name),
+			Reason: e.Reason,
+		}
+	}
+	return prefixed
+}
+
+// validateImportRows returns a ValidationError if an import contains no rows
+// or too many of them.
+func validateImportRows(n int) []ValidationError {
+	if n == 0 {
+		return []ValidationError{{Name: "rows", Reason: "Must contain at least one row"}}
+	}
+	if n > MaxImportRows {
+		return []ValidationError{{Name: "rows", Reason: fmt.Sprintf("Must not contain more than %d rows", MaxImportRows)}}
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package inmem
+
+import (
+	"context"
+	"sync"
+
+	"github.com/openmesh/booking"
+)
+
+// EventBufferSize is the buffer size of the channel for each subscription.
+const EventBufferSize = 16
+
+// Ensure type implements interface.
+var _ booking.EventService = (*EventService)(nil)
+
+// EventService represents a service for managing events in the system. Events
+// are only delivered to subscribers within the same process so it is only
+// suitable for a single node or for testing.
+type EventService struct {
+	mu sync.Mutex
+	m  map[int]map[*Subscription]struct{} // subscriptions by organization ID
+}
+
+// NewEventService returns a new instance of EventService.
+func NewEventService() *EventService {
+	return &EventService{
+		m: make(map[int]map[*Subscription]struct{}),
+	}
+}
+
+// PublishEvent publishes event to all of an organization's subscriptions.
+//
+// If an organization has no subscriptions then this is a no-op. Subscriptions
+// that are too slow to keep up with their buffer are closed.
+func (s *EventService) PublishEvent(organizationID int, event booking.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for sub := range s.m[organizationID] {
+		select {
+		case sub.c <- event:
+		default:
+			s.unsubscribe(sub)
+		}
+	}
+}
+
+// Subscribe creates a new subscription for the current organization.
+func (s *EventService) Subscribe(ctx context.Context) (booking.Subscription, error) {
+	orgID := booking.OrganizationIDFromContext(ctx)
+	if orgID == 0 {
+		return nil, booking.Errorf(booking.EUNAUTHORIZED, "You must be signed in to subscribe to events.")
+	}
+
+	sub := &Subscription{
+		service:        s,
+		organizationID: orgID,
+		c:              make(chan booking.Event, EventBufferSize),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	subs, ok := s.m[orgID]
+	if !ok {
+		subs = make(map[*Subscription]struct{})
+		s.m[orgID] = subs
+	}
+	subs[sub] = struct{}{}
+
+	return sub, nil
+}
+
+// Unsubscribe disconnects sub from the service.
+func (s *EventService) Unsubscribe(sub *Subscription) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.unsubscribe(sub)
+}
+
+func (s *EventService) unsubscribe(sub *Subscription) {
+	sub.once.Do(func() { close(sub.c) })
+
+	subs, ok := s.m[sub.organizationID]
+	if !ok {
+		return
+	}
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(s.m, sub.organizationID)
+	}
+}
+
+// Ensure type implements interface.
+var _ booking.Subscription = (*Subscription)(nil)
+
+// Subscription represents a stream of organization-related events.
+type Subscription struct {
+	service        *EventService
+	organizationID int
+	c              chan booking.Event
+	once           sync.Once
+}
+
+// Close disconnects the subscription from the service it was created from.
+func (s *Subscription) Close() error {
+	s.service.Unsubscribe(s)
+	return nil
+}
+
+// C returns the event stream for all of the organization's events.
+func (s *Subscription) C() <-chan booking.Event {
+	return s.c
+}
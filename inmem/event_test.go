@@ -0,0 +1,77 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openmesh/booking"
+	"github.com/openmesh/booking/inmem"
+)
+
+func TestEventService_Subscribe(t *testing.T) {
+	s := inmem.NewEventService()
+	ctx := booking.NewContextWithOrganization(context.Background(), &booking.Organization{ID: 1})
+	sub, err := s.Subscribe(ctx)
+	if err != nil {
+		t.Fatalf("failed to subscribe: %v", err)
+	}
+
+	// Only events of the organization of the subscription are delivered.
+	s.PublishEvent(2, booking.Event{Type: booking.EventTypeResourceCreated})
+	s.PublishEvent(1, booking.Event{Type: booking.EventTypeBookingCreated})
+	select {
+	case e := <-sub.C():
+		if e.Type != booking.EventTypeBookingCreated {
+			t.Errorf("received %s event, want %s", e.Type, booking.EventTypeBookingCreated)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+	select {
+	case e := <-sub.C():
+		t.Errorf("received unexpected %s event", e.Type)
+	default:
+	}
+
+	if err := sub.Close(); err != nil {
+		t.Fatalf("failed to close subscription: %v", err)
+	}
+	if _, ok := <-sub.C(); ok {
+		t.Error("channel is still open after closing the subscription")
+	}
+	// Publishing after the last subscription has closed is a no-op.
+	s.PublishEvent(1, booking.Event{Type: booking.EventTypeBookingCreated})
+}
+
+func TestEventService_Subscribe_Unauthorized(t *testing.T) {
+	s := inmem.NewEventService()
+	if _, err := s.Subscribe(context.Background()); booking.ErrorCode(err) != booking.EUNAUTHORIZED {
+		t.Errorf("returned %v, want %s", err, booking.EUNAUTHORIZED)
+	}
+}
+
+func TestEventService_PublishEvent_SlowSubscriber(t *testing.T) {
+	s := inmem.NewEventService()
+	ctx := booking.NewContextWithOrganization(context.Background(), &booking.Organization{ID: 1})
+	sub, err := s.Subscribe(ctx)
+	if err != nil {
+		t.Fatalf("failed to subscribe: %v", err)
+	}
+
+	// A subscription is closed once its buffer is full rather than blocking
+	// the publisher.
+	for i := 0; i <= inmem.EventBufferSize; i++ {
+		s.PublishEvent(1, booking.Event{Type: booking.EventTypeBookingCreated})
+	}
+	var n int
+	for range sub.C() {
+		n++
+	}
+	if n != inmem.EventBufferSize {
+		t.Errorf("received %d events, want %d", n, inmem.EventBufferSize)
+	}
+	if err := sub.Close(); err != nil {
+		t.Errorf("failed to close subscription: %v", err)
+	}
+}
@@ -0,0 +1,270 @@
+package inmem
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/textproto"
+	"sync"
+
+	"github.com/openmesh/booking"
+)
+
+// PaymentProviderName is the name that payments made with a PaymentProvider
+// are stored under.
+const PaymentProviderName = "inmem"
+
+// PaymentSignatureHeader contains the signature of a notification sent by a
+// PaymentProvider.
+const PaymentSignatureHeader = "Inmem-Signature"
+
+// Ensure type implements interface.
+var _ booking.PaymentProvider = (*PaymentProvider)(nil)
+
+// PaymentProvider represents a payment provider that keeps payments in memory.
+// No money changes hands and payments are lost on restart so it is only
+// suitable for development and testing. Payments are completed by calling Pay
+// or Decline, and refunded outside of the app by calling Refund, which return
+// the notification that a real provider would send.
+type PaymentProvider struct {
+	// Secret that notifications are signed with.
+	WebhookSecret string
+
+	// Completes payments as soon as they are created instead of waiting for
+	// Pay to be called.
+	AutoSucceed bool
+
+	mu      sync.Mutex
+	seq     int
+	intents map[string]*paymentIntent
+	keys    map[string]string                 // intent IDs by idempotency key
+	refunds map[string]*booking.PaymentRefund // refunds by idempotency key
+}
+
+type paymentIntent struct {
+	booking.PaymentIntent
+	refunded int
+	manual   bool
+}
+
+// paymentNotification is the body of a notification sent by a PaymentProvider.
+type paymentNotification struct {
+	ID             string `json:"id"`
+	Status         string `json:"status"`
+	AmountRefunded int    `json:"amountRefunded,omitempty"`
+	FailureReason  string `json:"failureReason,omitempty"`
+}
+
+// NewPaymentProvider returns a new instance of PaymentProvider that signs its
+// notifications with webhookSecret.
+func NewPaymentProvider(webhookSecret string) *PaymentProvider {
+	return &PaymentProvider{
+		WebhookSecret: webhookSecret,
+		intents:       make(map[string]*paymentIntent),
+		keys:          make(map[string]string),
+		refunds:       make(map[string]*booking.PaymentRefund),
+	}
+}
+
+// Name returns PaymentProviderName.
+func (p *PaymentProvider) Name() string { return PaymentProviderName }
+
+// CreatePaymentIntent creates a payment that waits for Pay to be called, unless
+// AutoSucceed is set.
+func (p *PaymentProvider) CreatePaymentIntent(_ context.Context, params booking.PaymentIntentParams) (*booking.PaymentIntent, error) {
+	if params.Amount <= 0 {
+		return nil, fmt.Errorf("amount must be greater than 0")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if id, ok := p.keys[params.IdempotencyKey]; ok && params.IdempotencyKey != "" {
+		intent := p.intents[id].PaymentIntent
+		return &intent, nil
+	}
+
+	p.seq++
+	id := fmt.Sprintf("pi_%d", p.seq)
+	intent := &paymentIntent{
+		PaymentIntent: booking.PaymentIntent{
+			ID:           id,
+			Status:       booking.PaymentStatusPending,
+			Amount:       params.Amount,
+			ClientSecret: id + "_secret",
+		},
+		manual: params.CaptureManually,
+	}
+	if p.AutoSucceed {
+		intent.Status = paidStatus(intent)
+	}
+	p.intents[id] = intent
+	if params.IdempotencyKey != "" {
+		p.keys[params.IdempotencyKey] = id
+	}
+
+	ret := intent.PaymentIntent
+	return &ret, nil
+}
+
+// CapturePaymentIntent captures amount of an authorized payment.
+func (p *PaymentProvider) CapturePaymentIntent(_ context.Context, id string, amount int) (*booking.PaymentIntent, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	intent, ok := p.intents[id]
+	if !ok {
+		return nil, fmt.Errorf("payment intent %s not found", id)
+	}
+	if intent.Status != booking.PaymentStatusAuthorized {
+		return nil, fmt.Errorf("payment intent %s is %s and can't be captured", id, intent.Status)
+	}
+	if amount > intent.Amount {
+		return nil, fmt.Errorf("cannot capture more than %d", intent.Amount)
+	}
+	intent.Amount = amount
+	intent.Status = booking.PaymentStatusSucceeded
+
+	ret := intent.PaymentIntent
+	return &ret, nil
+}
+
+// RefundPayment refunds amount of a payment that succeeded.
+func (p *PaymentProvider) RefundPayment(_ context.Context, id string, amount int, idempotencyKey string) (*booking.PaymentRefund, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if r, ok := p.refunds[idempotencyKey]; ok && idempotencyKey != "" {
+		ret := *r
+		return &ret, nil
+	}
+	if _, err := p.refund(id, amount); err != nil {
+		return nil, err
+	}
+
+	p.seq++
+	r := &booking.PaymentRefund{
+		ID:     fmt.Sprintf("re_%d", p.seq),
+		Amount: amount,
+	}
+	if idempotencyKey != "" {
+		p.refunds[idempotencyKey] = r
+	}
+	ret := *r
+	return &ret, nil
+}
+
+// refund records a refund of amount of a payment that succeeded. Must be
+// called with mu held.
+func (p *PaymentProvider) refund(id string, amount int) (*paymentIntent, error) {
+	intent, ok := p.intents[id]
+	if !ok {
+		return nil, fmt.Errorf("payment intent %s not found", id)
+	}
+	if !booking.PaymentPaid(intent.Status) {
+		return nil, fmt.Errorf("payment intent %s is %s and can't be refunded", id, intent.Status)
+	}
+	if amount > intent.Amount-intent.refunded {
+		return nil, fmt.Errorf("cannot refund more than %d", intent.Amount-intent.refunded)
+	}
+	intent.refunded += amount
+	intent.Status = booking.PaymentStatusPartiallyRefunded
+	if intent.refunded == intent.Amount {
+		intent.Status = booking.PaymentStatusRefunded
+	}
+	return intent, nil
+}
+
+// Pay completes a payment as if the customer had paid. Payments that are
+// captured manually are authorized instead. Returns the notification to send
+// to the webhook endpoint.
+func (p *PaymentProvider) Pay(id string) ([]byte, map[string][]string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	intent, ok := p.intents[id]
+	if !ok {
+		return nil, nil, fmt.Errorf("payment intent %s not found", id)
+	}
+	intent.Status = paidStatus(intent)
+	return p.notification(paymentNotification{ID: id, Status: intent.Status})
+}
+
+// Decline fails a payment as if the customer's payment method had been
+// declined. Returns the notification to send to the webhook endpoint.
+func (p *PaymentProvider) Decline(id, reason string) ([]byte, map[string][]string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	intent, ok := p.intents[id]
+	if !ok {
+		return nil, nil, fmt.Errorf("payment intent %s not found", id)
+	}
+	intent.Status = booking.PaymentStatusFailed
+	return p.notification(paymentNotification{ID: id, Status: intent.Status, FailureReason: reason})
+}
+
+// Refund refunds amount of a payment as if it had been refunded through the
+// provider's dashboard. Returns the notification to send to the webhook
+// endpoint.
+func (p *PaymentProvider) Refund(id string, amount int) ([]byte, map[string][]string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	intent, err := p.refund(id, amount)
+	if err != nil {
+		return nil, nil, err
+	}
+	return p.notification(paymentNotification{ID: id, Status: intent.Status, AmountRefunded: intent.refunded})
+}
+
+// notification returns the signed body and headers of a notification.
+func (p *PaymentProvider) notification(n paymentNotification) ([]byte, map[string][]string, error) {
+	payload, err := json.Marshal(n)
+	if err != nil {
+		return nil, nil, err
+	}
+	headers := map[string][]string{
+		PaymentSignatureHeader: {p.sign(payload)},
+	}
+	return payload, headers, nil
+}
+
+// VerifyPaymentWebhook checks that a notification was signed with the webhook
+// secret and returns the change that it describes.
+func (p *PaymentProvider) VerifyPaymentWebhook(payload []byte, headers map[string][]string) (*booking.PaymentWebhookEvent, error) {
+	sig := textproto.MIMEHeader(headers).Get(PaymentSignatureHeader)
+	if !hmac.Equal([]byte(sig), []byte(p.sign(payload))) {
+		return nil, booking.Errorf(booking.EUNAUTHORIZED, "Invalid payment notification signature.")
+	}
+
+	var n paymentNotification
+	if err := json.Unmarshal(payload, &n); err != nil {
+		return nil, booking.Errorf(booking.EINVALID, "Invalid payment notification.")
+	}
+	return &booking.PaymentWebhookEvent{
+		ProviderPaymentID: n.ID,
+		Status:            n.Status,
+		AmountRefunded:    n.AmountRefunded,
+		FailureReason:     n.FailureReason,
+	}, nil
+}
+
+// sign returns the hex encoded HMAC-SHA256 of payload keyed with the webhook
+// secret.
+func (p *PaymentProvider) sign(payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(p.WebhookSecret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// paidStatus returns the status of intent once the customer has paid.
+func paidStatus(intent *paymentIntent) string {
+	if intent.manual {
+		return booking.PaymentStatusAuthorized
+	}
+	return booking.PaymentStatusSucceeded
+}
@@ -0,0 +1,339 @@
+package booking
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Kinds of invoice.
+const (
+	// Bills a customer for a booking.
+	InvoiceKindInvoice = "invoice"
+
+	// Credits part or all of an invoice back to the customer. Credit notes
+	// are issued when a payment towards a booking is refunded.
+	InvoiceKindCreditNote = "credit_note"
+)
+
+// InvoiceKinds contains every valid kind of invoice.
+var InvoiceKinds = []string{
+	InvoiceKindInvoice,
+	InvoiceKindCreditNote,
+}
+
+// Kinds of invoice line.
+const (
+	// The price of the booking before discounts.
+	InvoiceLineBooking = "booking"
+
+	// A discount given on the booking, e.g. by a promo code.
+	InvoiceLineDiscount = "discount"
+
+	// A deposit that has already been paid towards the booking. Deposits
+	// reduce the amount due but don't count towards the subtotal.
+	InvoiceLineDeposit = "deposit"
+
+	// An amount refunded to the customer on a credit note.
+	InvoiceLineRefund = "refund"
+)
+
+// invoiceNumberPrefixes maps each kind of invoice to the prefix of its
+// numbers.
+var invoiceNumberPrefixes = map[string]string{
+	InvoiceKindInvoice:    "INV",
+	InvoiceKindCreditNote: "CN",
+}
+
+// InvoiceNumber formats the nth invoice of the given kind, e.g. "INV-000042".
+// Each organization numbers its invoices and its credit notes in separate
+// sequences starting from 1.
+func InvoiceNumber(kind string, n int) string {
+	return fmt.Sprintf("%s-%06d", invoiceNumberPrefixes[kind], n)
+}
+
+// Invoice is a numbered bill for a booking, or a credit note for a refund of
+// one. Invoices are issued when the deposit of a booking is paid or on
+// request and don't change once issued.
+type Invoice struct {
+	ID int `json:"id"`
+
+	// The number of the invoice within its organization, e.g. "INV-000042"
+	// or "CN-000007".
+	Number string `json:"number"`
+	Kind   string `json:"kind"`
+
+	BookingID int `json:"bookingId"`
+
+	// The invoice that a credit note credits. Only set for credit notes.
+	CreditedInvoiceID *int `json:"creditedInvoiceId,omitempty"`
+
+	// The name of the organization at the time the invoice was issued.
+	IssuerName string `json:"issuerName"`
+
+	// The currency of the booking. Every amount of the invoice is in its
+	// minor unit.
+	Currency string `json:"currency"`
+
+	Lines    []*InvoiceLine    `json:"lines"`
+	TaxLines []*InvoiceTaxLine `json:"taxLines"`
+
+	// The sum of the lines other than deposits, the sum of the tax lines and
+	// the amount charged. The total is the sum of the subtotal and the tax
+	// unless prices include tax, in which case it equals the subtotal.
+	Subtotal     int  `json:"subtotal"`
+	Tax          int  `json:"tax"`
+	TaxInclusive bool `json:"taxInclusive"`
+	Total        int  `json:"total"`
+
+	// The amount already settled: the deposits paid towards an invoice, or
+	// the amount refunded by a credit note.
+	AmountPaid int `json:"amountPaid"`
+
+	// The amount that remains to be paid.
+	AmountDue int `json:"amountDue"`
+
+	IssuedAt time.Time `json:"issuedAt"`
+}
+
+// InvoiceLine is a line of an Invoice.
+type InvoiceLine struct {
+	// One of the InvoiceLine kinds.
+	Kind string `json:"kind"`
+
+	// What is being charged for, e.g. the resource and time of the booking.
+	Description string `json:"description"`
+
+	// Negative for discounts and deposits.
+	Amount int `json:"amount"`
+}
+
+// InvoiceTaxLine is the tax charged at one rate on an Invoice.
+type InvoiceTaxLine struct {
+	// The name of the tax, e.g. "VAT".
+	Name string `json:"name"`
+
+	// The rate in hundredths of a percent, e.g. 2000 for 20%.
+	Rate int `json:"rate"`
+
+	// The amount that the tax is charged on and the tax charged.
+	Net    int `json:"net"`
+	Amount int `json:"amount"`
+}
+
+// CreditNote returns the lines and tax lines of a credit note refunding amount
+// of invoice i. Tax is credited in proportion to the share of the total of i
+// that is refunded. The refund line excludes the tax credited unless i
+// includes tax in its lines.
+func (i *Invoice) CreditNote(amount int) ([]*InvoiceLine, []*InvoiceTaxLine) {
+	taxLines := make([]*InvoiceTaxLine, 0, len(i.TaxLines))
+	net := amount
+	if i.Total > 0 {
+		for _, t := range i.TaxLines {
+			credited := &InvoiceTaxLine{
+				Name:   t.Name,
+				Rate:   t.Rate,
+				Net:    t.Net * amount / i.Total,
+				Amount: t.Amount * amount / i.Total,
+			}
+			if !i.TaxInclusive {
+				net -= credited.Amount
+			}
+			taxLines = append(taxLines, credited)
+		}
+	}
+	lines := []*InvoiceLine{{
+		Kind:        InvoiceLineRefund,
+		Description: fmt.Sprintf("Refund for invoice %s", i.Number),
+		Amount:      net,
+	}}
+	return lines, taxLines
+}
+
+// InvoiceService represents a service for issuing and retrieving invoices.
+type InvoiceService interface {
+	// FindInvoiceByID retrieves a single invoice or credit note by ID.
+	// Returns EINVOICENOTFOUND if the invoice does not exist or user does not
+	// have permission to view it.
+	FindInvoiceByID(ctx context.Context, req FindInvoiceByIDRequest) FindInvoiceByIDResponse
+
+	// FindInvoices retrieves the invoices and credit notes of the current
+	// organization, most recent first. Also returns a count of total matching
+	// invoices which may be different from the number of returned invoices if
+	// the "Limit" field is set.
+	FindInvoices(ctx context.Context, req FindInvoicesRequest) FindInvoicesResponse
+
+	// FindBookingInvoice retrieves the invoice of a booking. Returns
+	// EINVOICENOTFOUND if the booking hasn't been invoiced yet.
+	FindBookingInvoice(ctx context.Context, req FindBookingInvoiceRequest) FindBookingInvoiceResponse
+
+	// IssueBookingInvoice issues the invoice of a booking that was paid for
+	// outside of the payment provider. Bookings are only invoiced once so the
+	// existing invoice is returned if there is one. Returns
+	// EINVALIDTRANSITION if the booking is held or waiting for its deposit.
+	IssueBookingInvoice(ctx context.Context, req IssueBookingInvoiceRequest) IssueBookingInvoiceResponse
+}
+
+// FindInvoiceByIDRequest represents a payload used by the FindInvoiceByID method of an InvoiceService
+type FindInvoiceByIDRequest struct {
+	ID int `json:"id" source:"url"`
+}
+
+// Validate a FindInvoiceByIDRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r FindInvoiceByIDRequest) Validate() []ValidationError {
+	if r.ID < 1 {
+		return []ValidationError{{Name: "id", Reason: "Must be at least 1"}}
+	}
+	return nil
+}
+
+// FindInvoiceByIDResponse represents a response returned by the FindInvoiceByID method of an InvoiceService.
+type FindInvoiceByIDResponse struct {
+	*Invoice
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r FindInvoiceByIDResponse) Error() error { return r.Err }
+
+// FindInvoicesRequest represents a payload used by the FindInvoices method of an InvoiceService
+type FindInvoicesRequest struct {
+	// Filtering fields.
+	BookingID *int       `json:"bookingId" source:"query"`
+	Kind      *string    `json:"kind" source:"query"`
+	From      *time.Time `json:"from" source:"query"`
+	To        *time.Time `json:"to" source:"query"`
+
+	// Restrict to subset of range.
+	Offset int `json:"offset" source:"query"`
+	Limit  int `json:"limit" source:"query"`
+}
+
+// Validate a FindInvoicesRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r FindInvoicesRequest) Validate() []ValidationError {
+	errs := make([]ValidationError, 0)
+	if r.BookingID != nil && *r.BookingID < 1 {
+		errs = append(errs, ValidationError{Name: "bookingId", Reason: "Must be at least 1"})
+	}
+	if r.Kind != nil && !Strings(InvoiceKinds).contains(*r.Kind) {
+		errs = append(errs, ValidationError{Name: "kind", Reason: "Must be one of 'invoice' or 'credit_note'"})
+	}
+	if r.From != nil && r.To != nil && !r.To.After(*r.From) {
+		errs = append(errs, ValidationError{Name: "to", Reason: "Must be later than 'from'"})
+	}
+	if r.Offset < 0 {
+		errs = append(errs, ValidationError{Name: "offset", Reason: "Must be greater than or equal to 0"})
+	}
+	if r.Limit < 0 {
+		errs = append(errs, ValidationError{Name: "limit", Reason: "Must be greater than or equal to 0"})
+	}
+	return errs
+}
+
+// FindInvoicesResponse represents a response returned by the FindInvoices method of an InvoiceService.
+type FindInvoicesResponse struct {
+	Invoices   []*Invoice `json:"invoices,omitempty"`
+	TotalItems int        `json:"totalItems"`
+	Err        error      `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r FindInvoicesResponse) Error() error { return r.Err }
+
+// FindBookingInvoiceRequest represents a payload used by the FindBookingInvoice method of an InvoiceService
+type FindBookingInvoiceRequest struct {
+	BookingID int `json:"bookingId" source:"url"`
+}
+
+// Validate a FindBookingInvoiceRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r FindBookingInvoiceRequest) Validate() []ValidationError {
+	if r.BookingID < 1 {
+		return []ValidationError{{Name: "bookingId", Reason: "Must be at least 1"}}
+	}
+	return nil
+}
+
+// FindBookingInvoiceResponse represents a response returned by the FindBookingInvoice method of an InvoiceService.
+type FindBookingInvoiceResponse struct {
+	*Invoice
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r FindBookingInvoiceResponse) Error() error { return r.Err }
+
+// IssueBookingInvoiceRequest represents a payload used by the IssueBookingInvoice method of an InvoiceService
+type IssueBookingInvoiceRequest struct {
+	BookingID int `json:"bookingId" source:"url"`
+}
+
+// Validate an IssueBookingInvoiceRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r IssueBookingInvoiceRequest) Validate() []ValidationError {
+	if r.BookingID < 1 {
+		return []ValidationError{{Name: "bookingId", Reason: "Must be at least 1"}}
+	}
+	return nil
+}
+
+// IssueBookingInvoiceResponse represents a response returned by the IssueBookingInvoice method of an InvoiceService.
+type IssueBookingInvoiceResponse struct {
+	*Invoice
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r IssueBookingInvoiceResponse) Error() error { return r.Err }
+
+// InvoiceServiceMiddleware defines a middleware for InvoiceService
+type InvoiceServiceMiddleware func(service InvoiceService) InvoiceService
+
+// InvoiceValidationMiddleware returns a middleware for validating requests made to an InvoiceService
+func InvoiceValidationMiddleware() InvoiceServiceMiddleware {
+	return func(next InvoiceService) InvoiceService {
+		return invoiceValidationMiddleware{next}
+	}
+}
+
+type invoiceValidationMiddleware struct {
+	InvoiceService
+}
+
+// FindInvoiceByID validates a FindInvoiceByIDRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw invoiceValidationMiddleware) FindInvoiceByID(ctx context.Context, req FindInvoiceByIDRequest) FindInvoiceByIDResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return FindInvoiceByIDResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.InvoiceService.FindInvoiceByID(ctx, req)
+}
+
+// FindInvoices validates a FindInvoicesRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw invoiceValidationMiddleware) FindInvoices(ctx context.Context, req FindInvoicesRequest) FindInvoicesResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return FindInvoicesResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.InvoiceService.FindInvoices(ctx, req)
+}
+
+// FindBookingInvoice validates a FindBookingInvoiceRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw invoiceValidationMiddleware) FindBookingInvoice(ctx context.Context, req FindBookingInvoiceRequest) FindBookingInvoiceResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return FindBookingInvoiceResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.InvoiceService.FindBookingInvoice(ctx, req)
+}
+
+// IssueBookingInvoice validates an IssueBookingInvoiceRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw invoiceValidationMiddleware) IssueBookingInvoice(ctx context.Context, req IssueBookingInvoiceRequest) IssueBookingInvoiceResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return IssueBookingInvoiceResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.InvoiceService.IssueBookingInvoice(ctx, req)
+}
@@ -0,0 +1,80 @@
+package invoice
+
+import (
+	"html/template"
+	"io"
+
+	"github.com/openmesh/booking"
+)
+
+var htmlTemplate = template.Must(template.New("invoice").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: Helvetica, Arial, sans-serif; color: #222; max-width: 48em; margin: 2em auto; padding: 0 1em; }
+h1 { font-size: 1.6em; margin-bottom: 0.2em; }
+dl { display: grid; grid-template-columns: max-content auto; gap: 0.2em 1em; color: #555; }
+dd { margin: 0; }
+table { width: 100%; border-collapse: collapse; margin-top: 2em; }
+th, td { padding: 0.4em 0; text-align: left; }
+th:last-child, td:last-child { text-align: right; white-space: nowrap; }
+thead th { border-bottom: 1px solid #222; }
+tfoot tr:first-child td { border-top: 1px solid #222; }
+.strong td { font-weight: bold; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<p>{{.Invoice.IssuerName}}</p>
+<dl>
+<dt>Issued</dt><dd>{{.IssuedAt}}</dd>
+<dt>Booking</dt><dd>#{{.Invoice.BookingID}}</dd>
+</dl>
+<table>
+<thead><tr><th>Description</th><th>Amount</th></tr></thead>
+<tbody>
+{{- range .Lines}}
+<tr><td>{{.Description}}</td><td>{{.Amount}}</td></tr>
+{{- end}}
+</tbody>
+<tfoot>
+{{- range .Summaries}}
+<tr{{if .Strong}} class="strong"{{end}}><td>{{.Label}}</td><td>{{.Amount}}</td></tr>
+{{- end}}
+</tfoot>
+</table>
+</body>
+</html>
+`))
+
+// htmlLine is a line of an invoice with its amount formatted.
+type htmlLine struct {
+	Description string
+	Amount      string
+}
+
+// EncodeHTML writes invoice i to w as an HTML page.
+func EncodeHTML(w io.Writer, i *booking.Invoice) error {
+	var lines []htmlLine
+	for _, l := range charges(i) {
+		lines = append(lines, htmlLine{
+			Description: l.Description,
+			Amount:      formatAmount(i, l.Amount),
+		})
+	}
+	return htmlTemplate.Execute(w, struct {
+		Title     string
+		Invoice   *booking.Invoice
+		IssuedAt  string
+		Lines     []htmlLine
+		Summaries []summary
+	}{
+		Title:     title(i),
+		Invoice:   i,
+		IssuedAt:  formatDate(i.IssuedAt),
+		Lines:     lines,
+		Summaries: summaries(i),
+	})
+}
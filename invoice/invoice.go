@@ -0,0 +1,96 @@
+// Package invoice renders invoices and credit notes as documents that can be
+// sent to customers. Invoices are rendered as PDF, a format that can be
+// printed and archived, or as HTML for display in a browser.
+package invoice
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openmesh/booking"
+)
+
+// Media types of rendered invoices.
+const (
+	PDFContentType  = "application/pdf"
+	HTMLContentType = "text/html"
+)
+
+// dateLayout is the layout of the dates shown on invoices.
+const dateLayout = "2 January 2006"
+
+// title returns the heading of invoice i, e.g. "Invoice INV-000042".
+func title(i *booking.Invoice) string {
+	if i.Kind == booking.InvoiceKindCreditNote {
+		return "Credit note " + i.Number
+	}
+	return "Invoice " + i.Number
+}
+
+// formatAmount formats an amount of the currency of invoice i.
+func formatAmount(i *booking.Invoice, amount int) string {
+	return booking.NewMoney(amount, i.Currency).String()
+}
+
+// formatDate formats the date of t in UTC.
+func formatDate(t time.Time) string {
+	return t.UTC().Format(dateLayout)
+}
+
+// formatTaxName describes a tax line, e.g. "VAT 20%".
+func formatTaxName(t *booking.InvoiceTaxLine) string {
+	rate := fmt.Sprintf("%d", t.Rate/100)
+	if t.Rate%100 != 0 {
+		rate = fmt.Sprintf("%d.%02d", t.Rate/100, t.Rate%100)
+	}
+	if t.Name == "" {
+		return "Tax " + rate + "%"
+	}
+	return t.Name + " " + rate + "%"
+}
+
+// summary is a labelled amount shown below the lines of an invoice.
+type summary struct {
+	Label  string
+	Amount string
+	Strong bool
+}
+
+// summaries returns the totals shown below the lines of invoice i. Tax that
+// is included in the subtotal is shown after the total rather than added to
+// it.
+func summaries(i *booking.Invoice) []summary {
+	s := []summary{{Label: "Subtotal", Amount: formatAmount(i, i.Subtotal)}}
+	if !i.TaxInclusive {
+		for _, t := range i.TaxLines {
+			s = append(s, summary{Label: formatTaxName(t), Amount: formatAmount(i, t.Amount)})
+		}
+	}
+	s = append(s, summary{Label: "Total", Amount: formatAmount(i, i.Total), Strong: true})
+	if i.TaxInclusive {
+		for _, t := range i.TaxLines {
+			s = append(s, summary{Label: "Includes " + formatTaxName(t), Amount: formatAmount(i, t.Amount)})
+		}
+	}
+	if i.Kind == booking.InvoiceKindCreditNote {
+		return append(s, summary{Label: "Refunded", Amount: formatAmount(i, i.AmountPaid)})
+	}
+	for _, l := range i.Lines {
+		if l.Kind == booking.InvoiceLineDeposit {
+			s = append(s, summary{Label: l.Description, Amount: formatAmount(i, l.Amount)})
+		}
+	}
+	return append(s, summary{Label: "Amount due", Amount: formatAmount(i, i.AmountDue), Strong: true})
+}
+
+// charges returns the lines of invoice i that make up its subtotal. Deposits
+// are shown with the totals instead since they have already been paid.
+func charges(i *booking.Invoice) []*booking.InvoiceLine {
+	var lines []*booking.InvoiceLine
+	for _, l := range i.Lines {
+		if l.Kind != booking.InvoiceLineDeposit {
+			lines = append(lines, l)
+		}
+	}
+	return lines
+}
@@ -0,0 +1,247 @@
+package invoice
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/openmesh/booking"
+)
+
+// Dimensions of an A4 page and its margins in points.
+const (
+	pageWidth    = 595
+	pageHeight   = 842
+	marginLeft   = 50
+	marginRight  = pageWidth - 50
+	marginTop    = pageHeight - 60
+	marginBottom = 60
+)
+
+// Font sizes and the height of a line of body text in points.
+const (
+	titleSize  = 20
+	bodySize   = 10
+	lineHeight = 16
+)
+
+// maxDescriptionWidth is the width in points that descriptions are cut to so
+// that they don't run into the amounts.
+const maxDescriptionWidth = 380
+
+// Names of the fonts in the resources of each page. Both are standard Type 1
+// fonts that every PDF reader has so nothing needs to be embedded.
+const (
+	fontRegular = "F1"
+	fontBold    = "F2"
+)
+
+// EncodePDF writes invoice i to w as a PDF document of one or more A4 pages.
+func EncodePDF(w io.Writer, i *booking.Invoice) error {
+	d := newPDFDocument()
+	d.text(marginLeft, d.y, fontBold, titleSize, title(i))
+	d.y -= titleSize + lineHeight/2
+	d.text(marginLeft, d.y, fontRegular, bodySize, i.IssuerName)
+	d.advance()
+	d.text(marginLeft, d.y, fontRegular, bodySize, "Issued "+formatDate(i.IssuedAt))
+	d.advance()
+	d.text(marginLeft, d.y, fontRegular, bodySize, fmt.Sprintf("Booking #%d", i.BookingID))
+	d.y -= 2 * lineHeight
+
+	d.text(marginLeft, d.y, fontBold, bodySize, "Description")
+	d.textRight(marginRight, d.y, fontBold, bodySize, "Amount")
+	d.y -= lineHeight / 2
+	d.rule(d.y + bodySize/2)
+	d.advance()
+	for _, l := range charges(i) {
+		d.text(marginLeft, d.y, fontRegular, bodySize, truncate(l.Description, bodySize, maxDescriptionWidth))
+		d.textRight(marginRight, d.y, fontRegular, bodySize, formatAmount(i, l.Amount))
+		d.advance()
+	}
+
+	d.rule(d.y + bodySize)
+	d.y -= lineHeight / 2
+	for _, s := range summaries(i) {
+		font := fontRegular
+		if s.Strong {
+			font = fontBold
+		}
+		d.text(marginLeft, d.y, font, bodySize, truncate(s.Label, bodySize, maxDescriptionWidth))
+		d.textRight(marginRight, d.y, font, bodySize, s.Amount)
+		d.advance()
+	}
+	return d.encode(w)
+}
+
+// pdfDocument lays out text on the pages of a PDF document from the top of
+// each page down.
+type pdfDocument struct {
+	pages []*bytes.Buffer
+	page  *bytes.Buffer
+
+	// The baseline of the next line of text.
+	y int
+}
+
+func newPDFDocument() *pdfDocument {
+	d := &pdfDocument{}
+	d.newPage()
+	return d
+}
+
+func (d *pdfDocument) newPage() {
+	d.page = &bytes.Buffer{}
+	d.pages = append(d.pages, d.page)
+	d.y = marginTop
+}
+
+// advance moves to the next line, starting a new page when the current one is
+// full.
+func (d *pdfDocument) advance() {
+	d.y -= lineHeight
+	if d.y < marginBottom {
+		d.newPage()
+	}
+}
+
+// text draws s with its baseline starting at x, y.
+func (d *pdfDocument) text(x, y int, font string, size int, s string) {
+	fmt.Fprintf(d.page, "BT /%s %d Tf %d %d Td (%s) Tj ET\n", font, size, x, y, escapePDFString(s))
+}
+
+// textRight draws s with its baseline ending at x, y.
+func (d *pdfDocument) textRight(x, y int, font string, size int, s string) {
+	d.text(x-textWidth(s, size), y, font, size, s)
+}
+
+// rule draws a horizontal line across the page at y.
+func (d *pdfDocument) rule(y int) {
+	fmt.Fprintf(d.page, "0.5 w %d %d m %d %d l S\n", marginLeft, y, marginRight, y)
+}
+
+// encode writes the document to w. Objects are numbered in the order they
+// are written: the catalog, the page tree, the two fonts and then each page
+// followed by its content stream.
+func (d *pdfDocument) encode(w io.Writer) error {
+	e := &pdfEncoder{w: bufio.NewWriter(w)}
+	e.write("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	const firstPage = 5
+	kids := make([]string, len(d.pages))
+	for n := range d.pages {
+		kids[n] = fmt.Sprintf("%d 0 R", firstPage+2*n)
+	}
+	e.object("<< /Type /Catalog /Pages 2 0 R >>")
+	e.object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	e.object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	e.object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	for n, p := range d.pages {
+		e.object(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] "+
+				"/Resources << /Font << /%s 3 0 R /%s 4 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, fontRegular, fontBold, firstPage+2*n+1,
+		))
+		e.object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", p.Len(), p.Bytes()))
+	}
+
+	xref := e.n
+	e.write(fmt.Sprintf("xref\n0 %d\n0000000000 65535 f \n", len(e.offsets)+1))
+	for _, off := range e.offsets {
+		e.write(fmt.Sprintf("%010d 00000 n \n", off))
+	}
+	e.write(fmt.Sprintf(
+		"trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n",
+		len(e.offsets)+1,
+		xref,
+	))
+	if e.err != nil {
+		return e.err
+	}
+	return e.w.Flush()
+}
+
+// pdfEncoder writes the objects of a PDF document and remembers where each
+// one starts for the cross-reference table.
+type pdfEncoder struct {
+	w       *bufio.Writer
+	n       int
+	offsets []int
+	err     error
+}
+
+func (e *pdfEncoder) write(s string) {
+	if e.err != nil {
+		return
+	}
+	n, err := e.w.WriteString(s)
+	e.n += n
+	e.err = err
+}
+
+func (e *pdfEncoder) object(body string) {
+	e.offsets = append(e.offsets, e.n)
+	e.write(fmt.Sprintf("%d 0 obj\n%s\nendobj\n", len(e.offsets), body))
+}
+
+// escapePDFString encodes s as the contents of a PDF literal string in
+// WinAnsiEncoding. Characters that the encoding lacks are replaced with "?".
+func escapePDFString(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '€':
+			b.WriteString(`\200`)
+		case r >= ' ' && r <= '~':
+			b.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
+
+// helveticaWidths holds the widths of the printable ASCII characters of
+// Helvetica in thousandths of the font size, starting with the space.
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+// textWidth returns the width in points of s set in Helvetica at size. Bold
+// text is slightly wider, but digits are as wide in either weight so amounts
+// still line up.
+func textWidth(s string, size int) int {
+	w := 0
+	for _, r := range s {
+		if r >= ' ' && r <= '~' {
+			w += helveticaWidths[r-' ']
+		} else {
+			w += 556
+		}
+	}
+	return w * size / 1000
+}
+
+// truncate shortens s with an ellipsis so that it is no wider than width
+// points at size.
+func truncate(s string, size int, width int) string {
+	if textWidth(s, size) <= width {
+		return s
+	}
+	runes := []rune(s)
+	for len(runes) > 0 && textWidth(string(runes)+"...", size) > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "..."
+}
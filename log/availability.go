@@ -19,18 +19,15 @@ type availabilityLoggingMiddleware struct {
 	booking.AvailabilityService
 }
 
-func (mw availabilityLoggingMiddleware) FindAvailabilities(ctx context.Context, filter booking.AvailabilityFilter) (availabilities []*booking.Availability, totalItems int, err error) {
+func (mw availabilityLoggingMiddleware) FindAvailabilities(ctx context.Context, req booking.FindAvailabilitiesRequest) (res booking.FindAvailabilitiesResponse) {
 	defer func(begin time.Time) {
 		_ = mw.logger.Log(
 			"method", "find_availabilities",
-			"filter", filter,
-			"availabilities", availabilities,
-			"total_items", totalItems,
-			"err", err,
+			"request", req,
+			"response", res,
 			"took", time.Since(begin),
 		)
 	}(time.Now())
-
-	availabilities, totalItems, err = mw.AvailabilityService.FindAvailabilties(ctx, filter)
+	res = mw.AvailabilityService.FindAvailabilities(ctx, req)
 	return
 }
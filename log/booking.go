@@ -83,3 +83,72 @@ func (mw bookingLoggingMiddleware) DeleteBooking(ctx context.Context, req bookin
 	res = mw.BookingService.DeleteBooking(ctx, req)
 	return
 }
+
+func (mw bookingLoggingMiddleware) FindBookingSeriesByID(ctx context.Context, req booking.FindBookingSeriesByIDRequest) (res booking.FindBookingSeriesByIDResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "find_booking_series_by_id",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.BookingService.FindBookingSeriesByID(ctx, req)
+	return
+}
+
+func (mw bookingLoggingMiddleware) CreateBookingSeries(ctx context.Context, req booking.CreateBookingSeriesRequest) (res booking.CreateBookingSeriesResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "create_booking_series",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.BookingService.CreateBookingSeries(ctx, req)
+	return
+}
+
+func (mw bookingLoggingMiddleware) ImportBookings(ctx context.Context, req booking.ImportBookingsRequest) (res booking.ImportBookingsResponse) {
+	defer func(begin time.Time) {
+		// Imports can contain many rows so only the counts are logged.
+		_ = mw.logger.Log(
+			"method", "import_bookings",
+			"rows", len(req.Rows),
+			"all_or_nothing", req.AllOrNothing,
+			"created", res.Created,
+			"failed", res.Failed,
+			"err", res.Err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.BookingService.ImportBookings(ctx, req)
+	return
+}
+
+func (mw bookingLoggingMiddleware) HoldBooking(ctx context.Context, req booking.HoldBookingRequest) (res booking.HoldBookingResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "hold_booking",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.BookingService.HoldBooking(ctx, req)
+	return
+}
+
+func (mw bookingLoggingMiddleware) ConfirmBooking(ctx context.Context, req booking.ConfirmBookingRequest) (res booking.ConfirmBookingResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "confirm_booking",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.BookingService.ConfirmBooking(ctx, req)
+	return
+}
@@ -0,0 +1,74 @@
+package log
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/openmesh/booking"
+)
+
+func CalendarLoggingMiddleware(logger log.Logger) booking.CalendarServiceMiddleware {
+	return func(next booking.CalendarService) booking.CalendarService {
+		return calendarLoggingMiddleware{logger, next}
+	}
+}
+
+type calendarLoggingMiddleware struct {
+	logger log.Logger
+	booking.CalendarService
+}
+
+func (mw calendarLoggingMiddleware) FindResourceCalendar(ctx context.Context, req booking.FindResourceCalendarRequest) (res booking.FindResourceCalendarResponse) {
+	defer func(begin time.Time) {
+		// The feed token grants access to the calendar so it must not be
+		// logged. Feeds can be large so only the number of events is logged.
+		logged := req
+		logged.Token = "[redacted]"
+		events := 0
+		if res.Calendar != nil {
+			events = len(res.Events)
+		}
+		_ = mw.logger.Log(
+			"method", "find_resource_calendar",
+			"request", logged,
+			"events", events,
+			"err", res.Err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.CalendarService.FindResourceCalendar(ctx, req)
+	return
+}
+
+func (mw calendarLoggingMiddleware) CreateResourceFeedToken(ctx context.Context, req booking.CreateResourceFeedTokenRequest) (res booking.CreateResourceFeedTokenResponse) {
+	defer func(begin time.Time) {
+		logged := res
+		if logged.Token != "" {
+			logged.Token = "[redacted]"
+		}
+		_ = mw.logger.Log(
+			"method", "create_resource_feed_token",
+			"request", req,
+			"response", logged,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.CalendarService.CreateResourceFeedToken(ctx, req)
+	return
+}
+
+func (mw calendarLoggingMiddleware) ImportUnavailabilities(ctx context.Context, req booking.ImportUnavailabilitiesRequest) (res booking.ImportUnavailabilitiesResponse) {
+	defer func(begin time.Time) {
+		// Log the size of the uploaded calendar rather than its contents.
+		_ = mw.logger.Log(
+			"method", "import_unavailabilities",
+			"resource_id", req.ResourceID,
+			"calendar_bytes", len(req.Calendar),
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.CalendarService.ImportUnavailabilities(ctx, req)
+	return
+}
@@ -0,0 +1,111 @@
+package log
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/openmesh/booking"
+)
+
+func CustomerLoggingMiddleware(logger log.Logger) booking.CustomerServiceMiddleware {
+	return func(next booking.CustomerService) booking.CustomerService {
+		return customerLoggingMiddleware{logger, next}
+	}
+}
+
+type customerLoggingMiddleware struct {
+	logger log.Logger
+	booking.CustomerService
+}
+
+func (mw customerLoggingMiddleware) FindCustomerByID(ctx context.Context, req booking.FindCustomerByIDRequest) (res booking.FindCustomerByIDResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "find_customer_by_id",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.CustomerService.FindCustomerByID(ctx, req)
+	return
+}
+
+func (mw customerLoggingMiddleware) FindCustomers(ctx context.Context, req booking.FindCustomersRequest) (res booking.FindCustomersResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "find_customers",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.CustomerService.FindCustomers(ctx, req)
+	return
+}
+
+func (mw customerLoggingMiddleware) CreateCustomer(ctx context.Context, req booking.CreateCustomerRequest) (res booking.CreateCustomerResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "create_customer",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.CustomerService.CreateCustomer(ctx, req)
+	return
+}
+
+func (mw customerLoggingMiddleware) UpdateCustomer(ctx context.Context, req booking.UpdateCustomerRequest) (res booking.UpdateCustomerResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "update_customer",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.CustomerService.UpdateCustomer(ctx, req)
+	return
+}
+
+func (mw customerLoggingMiddleware) DeleteCustomer(ctx context.Context, req booking.DeleteCustomerRequest) (res booking.DeleteCustomerResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "delete_customer",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.CustomerService.DeleteCustomer(ctx, req)
+	return
+}
+
+func (mw customerLoggingMiddleware) FindCustomerBookings(ctx context.Context, req booking.FindCustomerBookingsRequest) (res booking.FindCustomerBookingsResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "find_customer_bookings",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.CustomerService.FindCustomerBookings(ctx, req)
+	return
+}
+
+func (mw customerLoggingMiddleware) GetCustomerLifetimeValue(ctx context.Context, req booking.GetCustomerLifetimeValueRequest) (res booking.GetCustomerLifetimeValueResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "get_customer_lifetime_value",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.CustomerService.GetCustomerLifetimeValue(ctx, req)
+	return
+}
@@ -0,0 +1,72 @@
+package log
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/openmesh/booking"
+)
+
+func InvoiceLoggingMiddleware(logger log.Logger) booking.InvoiceServiceMiddleware {
+	return func(next booking.InvoiceService) booking.InvoiceService {
+		return invoiceLoggingMiddleware{logger, next}
+	}
+}
+
+type invoiceLoggingMiddleware struct {
+	logger log.Logger
+	booking.InvoiceService
+}
+
+func (mw invoiceLoggingMiddleware) FindInvoiceByID(ctx context.Context, req booking.FindInvoiceByIDRequest) (res booking.FindInvoiceByIDResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "find_invoice_by_id",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.InvoiceService.FindInvoiceByID(ctx, req)
+	return
+}
+
+func (mw invoiceLoggingMiddleware) FindInvoices(ctx context.Context, req booking.FindInvoicesRequest) (res booking.FindInvoicesResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "find_invoices",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.InvoiceService.FindInvoices(ctx, req)
+	return
+}
+
+func (mw invoiceLoggingMiddleware) FindBookingInvoice(ctx context.Context, req booking.FindBookingInvoiceRequest) (res booking.FindBookingInvoiceResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "find_booking_invoice",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.InvoiceService.FindBookingInvoice(ctx, req)
+	return
+}
+
+func (mw invoiceLoggingMiddleware) IssueBookingInvoice(ctx context.Context, req booking.IssueBookingInvoiceRequest) (res booking.IssueBookingInvoiceResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "issue_booking_invoice",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.InvoiceService.IssueBookingInvoice(ctx, req)
+	return
+}
@@ -48,6 +48,21 @@ func (mw organizationLoggingMiddleware) FindOrganizationByPrivateKey(ctx context
 	return
 }
 
+func (mw organizationLoggingMiddleware) FindOrganizationByPublicKey(ctx context.Context, key string) (organization *booking.Organization, err error) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "find_organization_by_public_key",
+			"key", key,
+			"organization", organization,
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	organization, err = mw.OrganizationService.FindOrganizationByPublicKey(ctx, key)
+	return
+}
+
 func (mw organizationLoggingMiddleware) CreateOrganization(ctx context.Context, organization *booking.Organization) (err error) {
 	defer func(begin time.Time) {
 		_ = mw.logger.Log(
@@ -0,0 +1,75 @@
+package log
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/openmesh/booking"
+)
+
+func PaymentLoggingMiddleware(logger log.Logger) booking.PaymentServiceMiddleware {
+	return func(next booking.PaymentService) booking.PaymentService {
+		return paymentLoggingMiddleware{logger, next}
+	}
+}
+
+type paymentLoggingMiddleware struct {
+	logger log.Logger
+	booking.PaymentService
+}
+
+func (mw paymentLoggingMiddleware) FindPayments(ctx context.Context, req booking.FindPaymentsRequest) (res booking.FindPaymentsResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "find_payments",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.PaymentService.FindPayments(ctx, req)
+	return
+}
+
+func (mw paymentLoggingMiddleware) CapturePayment(ctx context.Context, req booking.CapturePaymentRequest) (res booking.CapturePaymentResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "capture_payment",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.PaymentService.CapturePayment(ctx, req)
+	return
+}
+
+func (mw paymentLoggingMiddleware) RefundPayment(ctx context.Context, req booking.RefundPaymentRequest) (res booking.RefundPaymentResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "refund_payment",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.PaymentService.RefundPayment(ctx, req)
+	return
+}
+
+func (mw paymentLoggingMiddleware) HandlePaymentWebhook(ctx context.Context, req booking.HandlePaymentWebhookRequest) (res booking.HandlePaymentWebhookResponse) {
+	defer func(begin time.Time) {
+		// Notifications are logged by size as their body and signature aren't
+		// useful in logs.
+		_ = mw.logger.Log(
+			"method", "handle_payment_webhook",
+			"provider", req.Provider,
+			"size", len(req.Payload),
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.PaymentService.HandlePaymentWebhook(ctx, req)
+	return
+}
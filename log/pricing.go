@@ -0,0 +1,33 @@
+package log
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/openmesh/booking"
+)
+
+func PricingLoggingMiddleware(logger log.Logger) booking.PricingServiceMiddleware {
+	return func(next booking.PricingService) booking.PricingService {
+		return pricingLoggingMiddleware{logger, next}
+	}
+}
+
+type pricingLoggingMiddleware struct {
+	logger log.Logger
+	booking.PricingService
+}
+
+func (mw pricingLoggingMiddleware) QuoteBooking(ctx context.Context, req booking.QuoteBookingRequest) (res booking.QuoteBookingResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "quote_booking",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.PricingService.QuoteBooking(ctx, req)
+	return
+}
@@ -0,0 +1,98 @@
+package log
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/openmesh/booking"
+)
+
+func PromoCodeLoggingMiddleware(logger log.Logger) booking.PromoCodeServiceMiddleware {
+	return func(next booking.PromoCodeService) booking.PromoCodeService {
+		return promoCodeLoggingMiddleware{logger, next}
+	}
+}
+
+type promoCodeLoggingMiddleware struct {
+	logger log.Logger
+	booking.PromoCodeService
+}
+
+func (mw promoCodeLoggingMiddleware) FindPromoCodeByID(ctx context.Context, req booking.FindPromoCodeByIDRequest) (res booking.FindPromoCodeByIDResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "find_promo_code_by_id",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.PromoCodeService.FindPromoCodeByID(ctx, req)
+	return
+}
+
+func (mw promoCodeLoggingMiddleware) FindPromoCodes(ctx context.Context, req booking.FindPromoCodesRequest) (res booking.FindPromoCodesResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "find_promo_codes",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.PromoCodeService.FindPromoCodes(ctx, req)
+	return
+}
+
+func (mw promoCodeLoggingMiddleware) CreatePromoCode(ctx context.Context, req booking.CreatePromoCodeRequest) (res booking.CreatePromoCodeResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "create_promo_code",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.PromoCodeService.CreatePromoCode(ctx, req)
+	return
+}
+
+func (mw promoCodeLoggingMiddleware) UpdatePromoCode(ctx context.Context, req booking.UpdatePromoCodeRequest) (res booking.UpdatePromoCodeResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "update_promo_code",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.PromoCodeService.UpdatePromoCode(ctx, req)
+	return
+}
+
+func (mw promoCodeLoggingMiddleware) DeletePromoCode(ctx context.Context, req booking.DeletePromoCodeRequest) (res booking.DeletePromoCodeResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "delete_promo_code",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.PromoCodeService.DeletePromoCode(ctx, req)
+	return
+}
+
+func (mw promoCodeLoggingMiddleware) FindPromoRedemptions(ctx context.Context, req booking.FindPromoRedemptionsRequest) (res booking.FindPromoRedemptionsResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "find_promo_redemptions",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.PromoCodeService.FindPromoRedemptions(ctx, req)
+	return
+}
@@ -84,3 +84,20 @@ func (mw resourceLoggingMiddleware) DeleteResource(ctx context.Context, req book
 	res = mw.ResourceService.DeleteResource(ctx, req)
 	return
 }
+
+func (mw resourceLoggingMiddleware) ImportResources(ctx context.Context, req booking.ImportResourcesRequest) (res booking.ImportResourcesResponse) {
+	defer func(begin time.Time) {
+		// Imports can contain many rows so only the counts are logged.
+		_ = mw.logger.Log(
+			"method", "import_resources",
+			"rows", len(req.Rows),
+			"all_or_nothing", req.AllOrNothing,
+			"created", res.Created,
+			"failed", res.Failed,
+			"err", res.Err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.ResourceService.ImportResources(ctx, req)
+	return
+}
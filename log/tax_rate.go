@@ -0,0 +1,85 @@
+package log
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/openmesh/booking"
+)
+
+func TaxRateLoggingMiddleware(logger log.Logger) booking.TaxRateServiceMiddleware {
+	return func(next booking.TaxRateService) booking.TaxRateService {
+		return taxRateLoggingMiddleware{logger, next}
+	}
+}
+
+type taxRateLoggingMiddleware struct {
+	logger log.Logger
+	booking.TaxRateService
+}
+
+func (mw taxRateLoggingMiddleware) FindTaxRateByID(ctx context.Context, req booking.FindTaxRateByIDRequest) (res booking.FindTaxRateByIDResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "find_tax_rate_by_id",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.TaxRateService.FindTaxRateByID(ctx, req)
+	return
+}
+
+func (mw taxRateLoggingMiddleware) FindTaxRates(ctx context.Context, req booking.FindTaxRatesRequest) (res booking.FindTaxRatesResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "find_tax_rates",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.TaxRateService.FindTaxRates(ctx, req)
+	return
+}
+
+func (mw taxRateLoggingMiddleware) CreateTaxRate(ctx context.Context, req booking.CreateTaxRateRequest) (res booking.CreateTaxRateResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "create_tax_rate",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.TaxRateService.CreateTaxRate(ctx, req)
+	return
+}
+
+func (mw taxRateLoggingMiddleware) UpdateTaxRate(ctx context.Context, req booking.UpdateTaxRateRequest) (res booking.UpdateTaxRateResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "update_tax_rate",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.TaxRateService.UpdateTaxRate(ctx, req)
+	return
+}
+
+func (mw taxRateLoggingMiddleware) DeleteTaxRate(ctx context.Context, req booking.DeleteTaxRateRequest) (res booking.DeleteTaxRateResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "delete_tax_rate",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.TaxRateService.DeleteTaxRate(ctx, req)
+	return
+}
@@ -21,10 +21,15 @@ type tokenLoggingMiddleware struct {
 
 func (mw tokenLoggingMiddleware) CreateToken(ctx context.Context, req booking.CreateTokenRequest) (res booking.CreateTokenResponse) {
 	defer func(begin time.Time) {
+		// Never write token secrets to the logs.
+		logged := res
+		if logged.Secret != "" {
+			logged.Secret = "[redacted]"
+		}
 		_ = mw.logger.Log(
 			"method", "create_token",
 			"request", req,
-			"response", res,
+			"response", logged,
 			"took", time.Since(begin),
 		)
 	}(time.Now())
@@ -44,3 +49,29 @@ func (mw tokenLoggingMiddleware) FindTokens(ctx context.Context, req booking.Fin
 	res = mw.TokenService.FindTokens(ctx, req)
 	return
 }
+
+func (mw tokenLoggingMiddleware) RevokeToken(ctx context.Context, req booking.RevokeTokenRequest) (res booking.RevokeTokenResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "revoke_token",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.TokenService.RevokeToken(ctx, req)
+	return
+}
+
+func (mw tokenLoggingMiddleware) AuthenticateToken(ctx context.Context, req booking.AuthenticateTokenRequest) (res booking.AuthenticateTokenResponse) {
+	defer func(begin time.Time) {
+		// The request only contains the secret so it is left out.
+		_ = mw.logger.Log(
+			"method", "authenticate_token",
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.TokenService.AuthenticateToken(ctx, req)
+	return
+}
@@ -0,0 +1,59 @@
+package log
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/openmesh/booking"
+)
+
+func WaitlistLoggingMiddleware(logger log.Logger) booking.WaitlistServiceMiddleware {
+	return func(next booking.WaitlistService) booking.WaitlistService {
+		return waitlistLoggingMiddleware{logger, next}
+	}
+}
+
+type waitlistLoggingMiddleware struct {
+	logger log.Logger
+	booking.WaitlistService
+}
+
+func (mw waitlistLoggingMiddleware) FindWaitlistEntries(ctx context.Context, req booking.FindWaitlistEntriesRequest) (res booking.FindWaitlistEntriesResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "find_waitlist_entries",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.WaitlistService.FindWaitlistEntries(ctx, req)
+	return
+}
+
+func (mw waitlistLoggingMiddleware) JoinWaitlist(ctx context.Context, req booking.JoinWaitlistRequest) (res booking.JoinWaitlistResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "join_waitlist",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.WaitlistService.JoinWaitlist(ctx, req)
+	return
+}
+
+func (mw waitlistLoggingMiddleware) LeaveWaitlist(ctx context.Context, req booking.LeaveWaitlistRequest) (res booking.LeaveWaitlistResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "leave_waitlist",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.WaitlistService.LeaveWaitlist(ctx, req)
+	return
+}
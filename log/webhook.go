@@ -0,0 +1,121 @@
+package log
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/openmesh/booking"
+)
+
+func WebhookLoggingMiddleware(logger log.Logger) booking.WebhookServiceMiddleware {
+	return func(next booking.WebhookService) booking.WebhookService {
+		return webhookLoggingMiddleware{logger, next}
+	}
+}
+
+type webhookLoggingMiddleware struct {
+	logger log.Logger
+	booking.WebhookService
+}
+
+func (mw webhookLoggingMiddleware) FindWebhookByID(ctx context.Context, req booking.FindWebhookByIDRequest) (res booking.FindWebhookByIDResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "find_webhook_by_id",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.WebhookService.FindWebhookByID(ctx, req)
+	return
+}
+
+func (mw webhookLoggingMiddleware) FindWebhooks(ctx context.Context, req booking.FindWebhooksRequest) (res booking.FindWebhooksResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "find_webhooks",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.WebhookService.FindWebhooks(ctx, req)
+	return
+}
+
+func (mw webhookLoggingMiddleware) CreateWebhook(ctx context.Context, req booking.CreateWebhookRequest) (res booking.CreateWebhookResponse) {
+	defer func(begin time.Time) {
+		// Never write signing secrets to the logs.
+		logged := res
+		if logged.Secret != "" {
+			logged.Secret = "[redacted]"
+		}
+		_ = mw.logger.Log(
+			"method", "create_webhook",
+			"request", req,
+			"response", logged,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.WebhookService.CreateWebhook(ctx, req)
+	return
+}
+
+func (mw webhookLoggingMiddleware) UpdateWebhook(ctx context.Context, req booking.UpdateWebhookRequest) (res booking.UpdateWebhookResponse) {
+	defer func(begin time.Time) {
+		// Never write signing secrets to the logs.
+		logged := res
+		if logged.Secret != "" {
+			logged.Secret = "[redacted]"
+		}
+		_ = mw.logger.Log(
+			"method", "update_webhook",
+			"request", req,
+			"response", logged,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.WebhookService.UpdateWebhook(ctx, req)
+	return
+}
+
+func (mw webhookLoggingMiddleware) DeleteWebhook(ctx context.Context, req booking.DeleteWebhookRequest) (res booking.DeleteWebhookResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "delete_webhook",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.WebhookService.DeleteWebhook(ctx, req)
+	return
+}
+
+func (mw webhookLoggingMiddleware) FindWebhookDeliveries(ctx context.Context, req booking.FindWebhookDeliveriesRequest) (res booking.FindWebhookDeliveriesResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "find_webhook_deliveries",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.WebhookService.FindWebhookDeliveries(ctx, req)
+	return
+}
+
+func (mw webhookLoggingMiddleware) RetryWebhookDelivery(ctx context.Context, req booking.RetryWebhookDeliveryRequest) (res booking.RetryWebhookDeliveryResponse) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "retry_webhook_delivery",
+			"request", req,
+			"response", res,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	res = mw.WebhookService.RetryWebhookDelivery(ctx, req)
+	return
+}
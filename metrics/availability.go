@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/openmesh/booking"
+)
+
+func AvailabilityMetricsMiddleware(
+	requestCount metrics.Counter,
+	errorCount metrics.Counter,
+	requestDuration metrics.Histogram,
+) booking.AvailabilityServiceMiddleware {
+	return func(next booking.AvailabilityService) booking.AvailabilityService {
+		return availabilityMetricsMiddleware{requestCount, errorCount, requestDuration, next}
+	}
+}
+
+type availabilityMetricsMiddleware struct {
+	requestCount    metrics.Counter
+	errorCount      metrics.Counter
+	requestDuration metrics.Histogram
+	booking.AvailabilityService
+}
+
+func (mw availabilityMetricsMiddleware) FindAvailabilities(ctx context.Context, req booking.FindAvailabilitiesRequest) (res booking.FindAvailabilitiesResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "find_availabilities"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.AvailabilityService.FindAvailabilities(ctx, req)
+	return
+}
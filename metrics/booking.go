@@ -90,3 +90,68 @@ func (mw bookingMetricsMiddleware) DeleteBooking(ctx context.Context, req bookin
 	res = mw.BookingService.DeleteBooking(ctx, req)
 	return
 }
+
+func (mw bookingMetricsMiddleware) FindBookingSeriesByID(ctx context.Context, req booking.FindBookingSeriesByIDRequest) (res booking.FindBookingSeriesByIDResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "find_booking_series_by_id"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.BookingService.FindBookingSeriesByID(ctx, req)
+	return
+}
+
+func (mw bookingMetricsMiddleware) CreateBookingSeries(ctx context.Context, req booking.CreateBookingSeriesRequest) (res booking.CreateBookingSeriesResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "create_booking_series"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.BookingService.CreateBookingSeries(ctx, req)
+	return
+}
+
+func (mw bookingMetricsMiddleware) ImportBookings(ctx context.Context, req booking.ImportBookingsRequest) (res booking.ImportBookingsResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "import_bookings"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.BookingService.ImportBookings(ctx, req)
+	return
+}
+
+func (mw bookingMetricsMiddleware) HoldBooking(ctx context.Context, req booking.HoldBookingRequest) (res booking.HoldBookingResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "hold_booking"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.BookingService.HoldBooking(ctx, req)
+	return
+}
+
+func (mw bookingMetricsMiddleware) ConfirmBooking(ctx context.Context, req booking.ConfirmBookingRequest) (res booking.ConfirmBookingResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "confirm_booking"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.BookingService.ConfirmBooking(ctx, req)
+	return
+}
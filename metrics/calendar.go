@@ -0,0 +1,65 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/openmesh/booking"
+)
+
+func CalendarMetricsMiddleware(
+	requestCount metrics.Counter,
+	errorCount metrics.Counter,
+	requestDuration metrics.Histogram,
+) booking.CalendarServiceMiddleware {
+	return func(next booking.CalendarService) booking.CalendarService {
+		return calendarMetricsMiddleware{requestCount, errorCount, requestDuration, next}
+	}
+}
+
+type calendarMetricsMiddleware struct {
+	requestCount    metrics.Counter
+	errorCount      metrics.Counter
+	requestDuration metrics.Histogram
+	booking.CalendarService
+}
+
+func (mw calendarMetricsMiddleware) FindResourceCalendar(ctx context.Context, req booking.FindResourceCalendarRequest) (res booking.FindResourceCalendarResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "find_resource_calendar"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.CalendarService.FindResourceCalendar(ctx, req)
+	return
+}
+
+func (mw calendarMetricsMiddleware) CreateResourceFeedToken(ctx context.Context, req booking.CreateResourceFeedTokenRequest) (res booking.CreateResourceFeedTokenResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "create_resource_feed_token"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.CalendarService.CreateResourceFeedToken(ctx, req)
+	return
+}
+
+func (mw calendarMetricsMiddleware) ImportUnavailabilities(ctx context.Context, req booking.ImportUnavailabilitiesRequest) (res booking.ImportUnavailabilitiesResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "import_unavailabilities"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.CalendarService.ImportUnavailabilities(ctx, req)
+	return
+}
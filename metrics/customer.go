@@ -0,0 +1,117 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/openmesh/booking"
+)
+
+func CustomerMetricsMiddleware(
+	requestCount metrics.Counter,
+	errorCount metrics.Counter,
+	requestDuration metrics.Histogram,
+) booking.CustomerServiceMiddleware {
+	return func(next booking.CustomerService) booking.CustomerService {
+		return customerMetricsMiddleware{requestCount, errorCount, requestDuration, next}
+	}
+}
+
+type customerMetricsMiddleware struct {
+	requestCount    metrics.Counter
+	errorCount      metrics.Counter
+	requestDuration metrics.Histogram
+	booking.CustomerService
+}
+
+func (mw customerMetricsMiddleware) FindCustomerByID(ctx context.Context, req booking.FindCustomerByIDRequest) (res booking.FindCustomerByIDResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "find_customer_by_id"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.CustomerService.FindCustomerByID(ctx, req)
+	return
+}
+
+func (mw customerMetricsMiddleware) FindCustomers(ctx context.Context, req booking.FindCustomersRequest) (res booking.FindCustomersResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "find_customers"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.CustomerService.FindCustomers(ctx, req)
+	return
+}
+
+func (mw customerMetricsMiddleware) CreateCustomer(ctx context.Context, req booking.CreateCustomerRequest) (res booking.CreateCustomerResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "create_customer"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.CustomerService.CreateCustomer(ctx, req)
+	return
+}
+
+func (mw customerMetricsMiddleware) UpdateCustomer(ctx context.Context, req booking.UpdateCustomerRequest) (res booking.UpdateCustomerResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "update_customer"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.CustomerService.UpdateCustomer(ctx, req)
+	return
+}
+
+func (mw customerMetricsMiddleware) DeleteCustomer(ctx context.Context, req booking.DeleteCustomerRequest) (res booking.DeleteCustomerResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "delete_customer"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.CustomerService.DeleteCustomer(ctx, req)
+	return
+}
+
+func (mw customerMetricsMiddleware) FindCustomerBookings(ctx context.Context, req booking.FindCustomerBookingsRequest) (res booking.FindCustomerBookingsResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "find_customer_bookings"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.CustomerService.FindCustomerBookings(ctx, req)
+	return
+}
+
+func (mw customerMetricsMiddleware) GetCustomerLifetimeValue(ctx context.Context, req booking.GetCustomerLifetimeValueRequest) (res booking.GetCustomerLifetimeValueResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "get_customer_lifetime_value"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.CustomerService.GetCustomerLifetimeValue(ctx, req)
+	return
+}
@@ -0,0 +1,78 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/openmesh/booking"
+)
+
+func InvoiceMetricsMiddleware(
+	requestCount metrics.Counter,
+	errorCount metrics.Counter,
+	requestDuration metrics.Histogram,
+) booking.InvoiceServiceMiddleware {
+	return func(next booking.InvoiceService) booking.InvoiceService {
+		return invoiceMetricsMiddleware{requestCount, errorCount, requestDuration, next}
+	}
+}
+
+type invoiceMetricsMiddleware struct {
+	requestCount    metrics.Counter
+	errorCount      metrics.Counter
+	requestDuration metrics.Histogram
+	booking.InvoiceService
+}
+
+func (mw invoiceMetricsMiddleware) FindInvoiceByID(ctx context.Context, req booking.FindInvoiceByIDRequest) (res booking.FindInvoiceByIDResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "find_invoice_by_id"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.InvoiceService.FindInvoiceByID(ctx, req)
+	return
+}
+
+func (mw invoiceMetricsMiddleware) FindInvoices(ctx context.Context, req booking.FindInvoicesRequest) (res booking.FindInvoicesResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "find_invoices"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.InvoiceService.FindInvoices(ctx, req)
+	return
+}
+
+func (mw invoiceMetricsMiddleware) FindBookingInvoice(ctx context.Context, req booking.FindBookingInvoiceRequest) (res booking.FindBookingInvoiceResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "find_booking_invoice"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.InvoiceService.FindBookingInvoice(ctx, req)
+	return
+}
+
+func (mw invoiceMetricsMiddleware) IssueBookingInvoice(ctx context.Context, req booking.IssueBookingInvoiceRequest) (res booking.IssueBookingInvoiceResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "issue_booking_invoice"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.InvoiceService.IssueBookingInvoice(ctx, req)
+	return
+}
@@ -0,0 +1,78 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/openmesh/booking"
+)
+
+func PaymentMetricsMiddleware(
+	requestCount metrics.Counter,
+	errorCount metrics.Counter,
+	requestDuration metrics.Histogram,
+) booking.PaymentServiceMiddleware {
+	return func(next booking.PaymentService) booking.PaymentService {
+		return paymentMetricsMiddleware{requestCount, errorCount, requestDuration, next}
+	}
+}
+
+type paymentMetricsMiddleware struct {
+	requestCount    metrics.Counter
+	errorCount      metrics.Counter
+	requestDuration metrics.Histogram
+	booking.PaymentService
+}
+
+func (mw paymentMetricsMiddleware) FindPayments(ctx context.Context, req booking.FindPaymentsRequest) (res booking.FindPaymentsResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "find_payments"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.PaymentService.FindPayments(ctx, req)
+	return
+}
+
+func (mw paymentMetricsMiddleware) CapturePayment(ctx context.Context, req booking.CapturePaymentRequest) (res booking.CapturePaymentResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "capture_payment"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.PaymentService.CapturePayment(ctx, req)
+	return
+}
+
+func (mw paymentMetricsMiddleware) RefundPayment(ctx context.Context, req booking.RefundPaymentRequest) (res booking.RefundPaymentResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "refund_payment"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.PaymentService.RefundPayment(ctx, req)
+	return
+}
+
+func (mw paymentMetricsMiddleware) HandlePaymentWebhook(ctx context.Context, req booking.HandlePaymentWebhookRequest) (res booking.HandlePaymentWebhookResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "handle_payment_webhook"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.PaymentService.HandlePaymentWebhook(ctx, req)
+	return
+}
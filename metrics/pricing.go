@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/openmesh/booking"
+)
+
+func PricingMetricsMiddleware(
+	requestCount metrics.Counter,
+	errorCount metrics.Counter,
+	requestDuration metrics.Histogram,
+) booking.PricingServiceMiddleware {
+	return func(next booking.PricingService) booking.PricingService {
+		return pricingMetricsMiddleware{requestCount, errorCount, requestDuration, next}
+	}
+}
+
+type pricingMetricsMiddleware struct {
+	requestCount    metrics.Counter
+	errorCount      metrics.Counter
+	requestDuration metrics.Histogram
+	booking.PricingService
+}
+
+func (mw pricingMetricsMiddleware) QuoteBooking(ctx context.Context, req booking.QuoteBookingRequest) (res booking.QuoteBookingResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "quote_booking"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.PricingService.QuoteBooking(ctx, req)
+	return
+}
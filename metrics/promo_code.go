@@ -0,0 +1,104 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/openmesh/booking"
+)
+
+func PromoCodeMetricsMiddleware(
+	requestCount metrics.Counter,
+	errorCount metrics.Counter,
+	requestDuration metrics.Histogram,
+) booking.PromoCodeServiceMiddleware {
+	return func(next booking.PromoCodeService) booking.PromoCodeService {
+		return promoCodeMetricsMiddleware{requestCount, errorCount, requestDuration, next}
+	}
+}
+
+type promoCodeMetricsMiddleware struct {
+	requestCount    metrics.Counter
+	errorCount      metrics.Counter
+	requestDuration metrics.Histogram
+	booking.PromoCodeService
+}
+
+func (mw promoCodeMetricsMiddleware) FindPromoCodeByID(ctx context.Context, req booking.FindPromoCodeByIDRequest) (res booking.FindPromoCodeByIDResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "find_promo_code_by_id"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.PromoCodeService.FindPromoCodeByID(ctx, req)
+	return
+}
+
+func (mw promoCodeMetricsMiddleware) FindPromoCodes(ctx context.Context, req booking.FindPromoCodesRequest) (res booking.FindPromoCodesResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "find_promo_codes"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.PromoCodeService.FindPromoCodes(ctx, req)
+	return
+}
+
+func (mw promoCodeMetricsMiddleware) CreatePromoCode(ctx context.Context, req booking.CreatePromoCodeRequest) (res booking.CreatePromoCodeResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "create_promo_code"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.PromoCodeService.CreatePromoCode(ctx, req)
+	return
+}
+
+func (mw promoCodeMetricsMiddleware) UpdatePromoCode(ctx context.Context, req booking.UpdatePromoCodeRequest) (res booking.UpdatePromoCodeResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "update_promo_code"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.PromoCodeService.UpdatePromoCode(ctx, req)
+	return
+}
+
+func (mw promoCodeMetricsMiddleware) DeletePromoCode(ctx context.Context, req booking.DeletePromoCodeRequest) (res booking.DeletePromoCodeResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "delete_promo_code"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.PromoCodeService.DeletePromoCode(ctx, req)
+	return
+}
+
+func (mw promoCodeMetricsMiddleware) FindPromoRedemptions(ctx context.Context, req booking.FindPromoRedemptionsRequest) (res booking.FindPromoRedemptionsResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "find_promo_redemptions"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.PromoCodeService.FindPromoRedemptions(ctx, req)
+	return
+}
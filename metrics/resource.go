@@ -34,3 +34,16 @@ func (mw resourceMetricsMiddleware) FindResourceByID(ctx context.Context, req bo
 	res = mw.ResourceService.FindResourceByID(ctx, req)
 	return
 }
+
+func (mw resourceMetricsMiddleware) ImportResources(ctx context.Context, req booking.ImportResourcesRequest) (res booking.ImportResourcesResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "import_resources"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.ResourceService.ImportResources(ctx, req)
+	return
+}
@@ -0,0 +1,91 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/openmesh/booking"
+)
+
+func TaxRateMetricsMiddleware(
+	requestCount metrics.Counter,
+	errorCount metrics.Counter,
+	requestDuration metrics.Histogram,
+) booking.TaxRateServiceMiddleware {
+	return func(next booking.TaxRateService) booking.TaxRateService {
+		return taxRateMetricsMiddleware{requestCount, errorCount, requestDuration, next}
+	}
+}
+
+type taxRateMetricsMiddleware struct {
+	requestCount    metrics.Counter
+	errorCount      metrics.Counter
+	requestDuration metrics.Histogram
+	booking.TaxRateService
+}
+
+func (mw taxRateMetricsMiddleware) FindTaxRateByID(ctx context.Context, req booking.FindTaxRateByIDRequest) (res booking.FindTaxRateByIDResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "find_tax_rate_by_id"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.TaxRateService.FindTaxRateByID(ctx, req)
+	return
+}
+
+func (mw taxRateMetricsMiddleware) FindTaxRates(ctx context.Context, req booking.FindTaxRatesRequest) (res booking.FindTaxRatesResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "find_tax_rates"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.TaxRateService.FindTaxRates(ctx, req)
+	return
+}
+
+func (mw taxRateMetricsMiddleware) CreateTaxRate(ctx context.Context, req booking.CreateTaxRateRequest) (res booking.CreateTaxRateResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "create_tax_rate"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.TaxRateService.CreateTaxRate(ctx, req)
+	return
+}
+
+func (mw taxRateMetricsMiddleware) UpdateTaxRate(ctx context.Context, req booking.UpdateTaxRateRequest) (res booking.UpdateTaxRateResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "update_tax_rate"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.TaxRateService.UpdateTaxRate(ctx, req)
+	return
+}
+
+func (mw taxRateMetricsMiddleware) DeleteTaxRate(ctx context.Context, req booking.DeleteTaxRateRequest) (res booking.DeleteTaxRateResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "delete_tax_rate"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.TaxRateService.DeleteTaxRate(ctx, req)
+	return
+}
@@ -50,3 +50,29 @@ func (mw tokenMetricsMiddleware) FindTokens(ctx context.Context, req booking.Fin
 	res = mw.TokenService.FindTokens(ctx, req)
 	return
 }
+
+func (mw tokenMetricsMiddleware) RevokeToken(ctx context.Context, req booking.RevokeTokenRequest) (res booking.RevokeTokenResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "revoke_token"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.TokenService.RevokeToken(ctx, req)
+	return
+}
+
+func (mw tokenMetricsMiddleware) AuthenticateToken(ctx context.Context, req booking.AuthenticateTokenRequest) (res booking.AuthenticateTokenResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "authenticate_token"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.TokenService.AuthenticateToken(ctx, req)
+	return
+}
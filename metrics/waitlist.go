@@ -0,0 +1,65 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/openmesh/booking"
+)
+
+func WaitlistMetricsMiddleware(
+	requestCount metrics.Counter,
+	errorCount metrics.Counter,
+	requestDuration metrics.Histogram,
+) booking.WaitlistServiceMiddleware {
+	return func(next booking.WaitlistService) booking.WaitlistService {
+		return waitlistMetricsMiddleware{requestCount, errorCount, requestDuration, next}
+	}
+}
+
+type waitlistMetricsMiddleware struct {
+	requestCount    metrics.Counter
+	errorCount      metrics.Counter
+	requestDuration metrics.Histogram
+	booking.WaitlistService
+}
+
+func (mw waitlistMetricsMiddleware) FindWaitlistEntries(ctx context.Context, req booking.FindWaitlistEntriesRequest) (res booking.FindWaitlistEntriesResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "find_waitlist_entries"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.WaitlistService.FindWaitlistEntries(ctx, req)
+	return
+}
+
+func (mw waitlistMetricsMiddleware) JoinWaitlist(ctx context.Context, req booking.JoinWaitlistRequest) (res booking.JoinWaitlistResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "join_waitlist"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.WaitlistService.JoinWaitlist(ctx, req)
+	return
+}
+
+func (mw waitlistMetricsMiddleware) LeaveWaitlist(ctx context.Context, req booking.LeaveWaitlistRequest) (res booking.LeaveWaitlistResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "leave_waitlist"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.WaitlistService.LeaveWaitlist(ctx, req)
+	return
+}
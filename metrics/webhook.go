@@ -0,0 +1,117 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/openmesh/booking"
+)
+
+func WebhookMetricsMiddleware(
+	requestCount metrics.Counter,
+	errorCount metrics.Counter,
+	requestDuration metrics.Histogram,
+) booking.WebhookServiceMiddleware {
+	return func(next booking.WebhookService) booking.WebhookService {
+		return webhookMetricsMiddleware{requestCount, errorCount, requestDuration, next}
+	}
+}
+
+type webhookMetricsMiddleware struct {
+	requestCount    metrics.Counter
+	errorCount      metrics.Counter
+	requestDuration metrics.Histogram
+	booking.WebhookService
+}
+
+func (mw webhookMetricsMiddleware) FindWebhookByID(ctx context.Context, req booking.FindWebhookByIDRequest) (res booking.FindWebhookByIDResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "find_webhook_by_id"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.WebhookService.FindWebhookByID(ctx, req)
+	return
+}
+
+func (mw webhookMetricsMiddleware) FindWebhooks(ctx context.Context, req booking.FindWebhooksRequest) (res booking.FindWebhooksResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "find_webhooks"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.WebhookService.FindWebhooks(ctx, req)
+	return
+}
+
+func (mw webhookMetricsMiddleware) CreateWebhook(ctx context.Context, req booking.CreateWebhookRequest) (res booking.CreateWebhookResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "create_webhook"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.WebhookService.CreateWebhook(ctx, req)
+	return
+}
+
+func (mw webhookMetricsMiddleware) UpdateWebhook(ctx context.Context, req booking.UpdateWebhookRequest) (res booking.UpdateWebhookResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "update_webhook"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.WebhookService.UpdateWebhook(ctx, req)
+	return
+}
+
+func (mw webhookMetricsMiddleware) DeleteWebhook(ctx context.Context, req booking.DeleteWebhookRequest) (res booking.DeleteWebhookResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "delete_webhook"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.WebhookService.DeleteWebhook(ctx, req)
+	return
+}
+
+func (mw webhookMetricsMiddleware) FindWebhookDeliveries(ctx context.Context, req booking.FindWebhookDeliveriesRequest) (res booking.FindWebhookDeliveriesResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "find_webhook_deliveries"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.WebhookService.FindWebhookDeliveries(ctx, req)
+	return
+}
+
+func (mw webhookMetricsMiddleware) RetryWebhookDelivery(ctx context.Context, req booking.RetryWebhookDeliveryRequest) (res booking.RetryWebhookDeliveryResponse) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "retry_webhook_delivery"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+		if res.Err != nil {
+			mw.errorCount.With(lvs...).Add(1)
+		}
+	}(time.Now())
+	res = mw.WebhookService.RetryWebhookDelivery(ctx, req)
+	return
+}
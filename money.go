@@ -0,0 +1,121 @@
+package booking
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultCurrency is the ISO 4217 code of the currency that organizations use
+// unless they choose another one.
+const DefaultCurrency = "USD"
+
+// currencyExponents maps the ISO 4217 code of each supported currency to the
+// number of digits after the decimal separator of its minor unit.
+var currencyExponents = map[string]int{
+	"AED": 2, "AFN": 2, "ALL": 2, "AMD": 2, "ANG": 2, "AOA": 2, "ARS": 2,
+	"AUD": 2, "AWG": 2, "AZN": 2, "BAM": 2, "BBD": 2, "BDT": 2, "BGN": 2,
+	"BHD": 3, "BIF": 0, "BMD": 2, "BND": 2, "BOB": 2, "BRL": 2, "BSD": 2,
+	"BTN": 2, "BWP": 2, "BYN": 2, "BZD": 2, "CAD": 2, "CDF": 2, "CHF": 2,
+	"CLP": 0, "CNY": 2, "COP": 2, "CRC": 2, "CUP": 2, "CVE": 2, "CZK": 2,
+	"DJF": 0, "DKK": 2, "DOP": 2, "DZD": 2, "EGP": 2, "ERN": 2, "ETB": 2,
+	"EUR": 2, "FJD": 2, "FKP": 2, "GBP": 2, "GEL": 2, "GHS": 2, "GIP": 2,
+	"GMD": 2, "GNF": 0, "GTQ": 2, "GYD": 2, "HKD": 2, "HNL": 2, "HTG": 2,
+	"HUF": 2, "IDR": 2, "ILS": 2, "INR": 2, "IQD": 3, "IRR": 2, "ISK": 0,
+	"JMD": 2, "JOD": 3, "JPY": 0, "KES": 2, "KGS": 2, "KHR": 2, "KMF": 0,
+	"KRW": 0, "KWD": 3, "KYD": 2, "KZT": 2, "LAK": 2, "LBP": 2, "LKR": 2,
+	"LRD": 2, "LSL": 2, "LYD": 3, "MAD": 2, "MDL": 2, "MGA": 2, "MKD": 2,
+	"MMK": 2, "MNT": 2, "MOP": 2, "MRU": 2, "MUR": 2, "MVR": 2, "MWK": 2,
+	"MXN": 2, "MYR": 2, "MZN": 2, "NAD": 2, "NGN": 2, "NIO": 2, "NOK": 2,
+	"NPR": 2, "NZD": 2, "OMR": 3, "PAB": 2, "PEN": 2, "PGK": 2, "PHP": 2,
+	"PKR": 2, "PLN": 2, "PYG": 0, "QAR": 2, "RON": 2, "RSD": 2, "RUB": 2,
+	"RWF": 0, "SAR": 2, "SBD": 2, "SCR": 2, "SDG": 2, "SEK": 2, "SGD": 2,
+	"SHP": 2, "SLE": 2, "SOS": 2, "SRD": 2, "SSP": 2, "STN": 2, "SYP": 2,
+	"SZL": 2, "THB": 2, "TJS": 2, "TMT": 2, "TND": 3, "TOP": 2, "TRY": 2,
+	"TTD": 2, "TWD": 2, "TZS": 2, "UAH": 2, "UGX": 0, "USD": 2, "UYU": 2,
+	"UZS": 2, "VES": 2, "VND": 0, "VUV": 0, "WST": 2, "XAF": 0, "XCD": 2,
+	"XOF": 0, "XPF": 0, "YER": 2, "ZAR": 2, "ZMW": 2,
+}
+
+// ValidCurrency returns true if code is the ISO 4217 code of a supported
+// currency. Codes are upper case.
+func ValidCurrency(code string) bool {
+	_, ok := currencyExponents[code]
+	return ok
+}
+
+// Money is an amount of a currency. Amounts are in the minor unit of the
+// currency, e.g. cents for USD and yen for JPY, so that they can be added up
+// without rounding errors.
+type Money struct {
+	Amount int `json:"amount"`
+
+	// The ISO 4217 code of the currency, e.g. EUR.
+	Currency string `json:"currency"`
+}
+
+// NewMoney returns amount minor units of currency.
+func NewMoney(amount int, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// String formats m in major units followed by its currency, e.g. "12.50 EUR".
+func (m Money) String() string {
+	exp, ok := currencyExponents[m.Currency]
+	if !ok || exp == 0 {
+		return strings.TrimSpace(strconv.Itoa(m.Amount) + " " + m.Currency)
+	}
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	unit := 1
+	for i := 0; i < exp; i++ {
+		unit *= 10
+	}
+	return fmt.Sprintf("%s%d.%0*d %s", sign, amount/unit, exp, amount%unit, m.Currency)
+}
+
+// Validate a Money. Returns a ValidationError for each requirement that fails.
+// name is the name of the field holding the amount. The currency may be left
+// empty for the organization's default currency to be used.
+func (m Money) Validate(name string) []ValidationError {
+	var errs []ValidationError
+	if m.Amount < 0 {
+		errs = append(errs, ValidationError{Name: name + ".amount", Reason: "Cannot be less than 0"})
+	}
+	if m.Currency != "" && !ValidCurrency(m.Currency) {
+		errs = append(errs, ValidationError{Name: name + ".currency", Reason: "Must be a supported ISO 4217 currency code"})
+	}
+	return errs
+}
+
+// MoneyTotals holds a total for each currency, ordered by currency code.
+// Amounts of different currencies are never added together.
+type MoneyTotals []Money
+
+// Add returns t with m added to the total of its currency.
+func (t MoneyTotals) Add(m Money) MoneyTotals {
+	for i := range t {
+		if t[i].Currency == m.Currency {
+			t[i].Amount += m.Amount
+			return t
+		}
+	}
+	t = append(t, m)
+	sort.Slice(t, func(i, j int) bool { return t[i].Currency < t[j].Currency })
+	return t
+}
+
+// Amount returns the total of currency.
+func (t MoneyTotals) Amount(currency string) int {
+	for _, m := range t {
+		if m.Currency == currency {
+			return m.Amount
+		}
+	}
+	return 0
+}
@@ -34,7 +34,7 @@ func (r GetRedirectURLResponse) Error() error { return r.Err }
 type HandleCallbackRequest struct {
 	Source      string `json:"source"`
 	Code        string `json:"code"`
-	RedirectURL string `json:"redirectUrl`
+	RedirectURL string `json:"redirectUrl"`
 }
 
 // Validate a HandleCallback. Returns a ValidationError for each requirement that fails.
@@ -46,8 +46,8 @@ func (r HandleCallbackRequest) Validate() []ValidationError {
 // HandleCallbackResponse represents a response returned by the HandleCallback method of a OAuthService.
 type HandleCallbackResponse struct {
 	UserID      int    `json:"userId"`
-	RedirectURL string `json:"redirectUrl`
-	Err         error  `json:"error,omitempty`
+	RedirectURL string `json:"redirectUrl"`
+	Err         error  `json:"error,omitempty"`
 }
 
 // Error implements the errorer interface. Returns property Err from the response.
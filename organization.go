@@ -21,6 +21,10 @@ type Organization struct {
 	OwnerID int   `json:"ownerId"`
 	Owner   *User `json:"owner"`
 
+	// The ISO 4217 code of the currency that resources are priced in unless
+	// they are given another currency.
+	Currency string `json:"currency"`
+
 	// Timestamps for user creation & last update.
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
@@ -35,6 +39,10 @@ type OrganizationService interface {
 	// organization does not exist.
 	FindOrganizationByPrivateKey(ctx context.Context, key string) (*Organization, error)
 
+	// FindOrganizationByPublicKey retrieves an organization by PublicKey.
+	// Returns ENOTFOUND if organization does not exist.
+	FindOrganizationByPublicKey(ctx context.Context, key string) (*Organization, error)
+
 	// CreateOrganization creates a new organization.
 	CreateOrganization(ctx context.Context, organization *Organization) error
 
@@ -44,8 +52,19 @@ type OrganizationService interface {
 
 // OrganizationUpdate represents a set of fields to update on an organization.
 type OrganizationUpdate struct {
-	Name    *string `json:"name"`
-	OwnerID *int    `json:"ownerId"`
+	Name     *string `json:"name"`
+	OwnerID  *int    `json:"ownerId"`
+	Currency *string `json:"currency"`
+}
+
+// Validate an OrganizationUpdate. Returns a ValidationError for each
+// requirement that fails.
+func (u OrganizationUpdate) Validate() []ValidationError {
+	var errs []ValidationError
+	if u.Currency != nil && !ValidCurrency(*u.Currency) {
+		errs = append(errs, ValidationError{Name: "currency", Reason: "Must be a supported ISO 4217 currency code"})
+	}
+	return errs
 }
 
 // OrganizationServiceMiddleware defines a middleware for an organization service.
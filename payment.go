@@ -0,0 +1,379 @@
+package booking
+
+import (
+	"context"
+	"time"
+)
+
+// Payment represents money taken from a customer for a booking through a
+// payment provider. Bookings of resources with a booking price are only
+// confirmed once their deposit payment has succeeded.
+type Payment struct {
+	ID int `json:"id"`
+
+	// The booking that the payment is for.
+	BookingID int `json:"bookingId"`
+
+	// The name of the provider that processes the payment, e.g. "stripe", and
+	// the ID of the payment within the provider.
+	Provider          string `json:"provider"`
+	ProviderPaymentID string `json:"providerPaymentId"`
+
+	// Amounts are in the smallest unit of the currency, e.g. cents.
+	Amount         int    `json:"amount"`
+	AmountRefunded int    `json:"amountRefunded"`
+	Currency       string `json:"currency"`
+
+	Status string `json:"status"`
+
+	// Secret that the customer's client uses to complete the payment with the
+	// provider. Only returned when the payment is created.
+	ClientSecret string `json:"clientSecret,omitempty"`
+
+	// The reason given by the provider for the last failed attempt to pay.
+	FailureReason string `json:"failureReason,omitempty"`
+
+	// Timestamps for payment creation and last update.
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Payment statuses.
+const (
+	// Waiting for the customer to pay.
+	PaymentStatusPending = "pending"
+	// The customer has paid but the funds have not been captured yet.
+	PaymentStatusAuthorized = "authorized"
+	PaymentStatusSucceeded  = "succeeded"
+	// The last attempt to pay failed. The customer may try again.
+	PaymentStatusFailed    = "failed"
+	PaymentStatusCancelled = "cancelled"
+	// Part of the amount has been refunded.
+	PaymentStatusPartiallyRefunded = "partially_refunded"
+	PaymentStatusRefunded          = "refunded"
+)
+
+// PaymentStatuses contains every valid payment status.
+var PaymentStatuses = []string{
+	PaymentStatusPending,
+	PaymentStatusAuthorized,
+	PaymentStatusSucceeded,
+	PaymentStatusFailed,
+	PaymentStatusCancelled,
+	PaymentStatusPartiallyRefunded,
+	PaymentStatusRefunded,
+}
+
+// PaymentPaid returns true if a payment with the given status has been paid,
+// including payments that have since been refunded.
+func PaymentPaid(status string) bool {
+	return status == PaymentStatusSucceeded ||
+		status == PaymentStatusPartiallyRefunded ||
+		status == PaymentStatusRefunded
+}
+
+// PaymentProvider represents an external service that takes payments from
+// customers. Implementations translate the state of their payments into
+// payment statuses.
+type PaymentProvider interface {
+	PaymentWebhookVerifier
+
+	// Name identifies the provider. It is stored with each payment so that
+	// notifications can be matched to the provider that sent them.
+	Name() string
+
+	// Creates a payment for the customer to complete. Creating an intent with
+	// the same idempotency key twice returns the existing intent.
+	CreatePaymentIntent(ctx context.Context, params PaymentIntentParams) (*PaymentIntent, error)
+
+	// Captures the funds of an authorized payment. amount may be less than the
+	// authorized amount, in which case the remainder is released.
+	CapturePaymentIntent(ctx context.Context, id string, amount int) (*PaymentIntent, error)
+
+	// Refunds amount of a payment that succeeded. Refunding with the same
+	// idempotency key twice returns the existing refund.
+	RefundPayment(ctx context.Context, id string, amount int, idempotencyKey string) (*PaymentRefund, error)
+}
+
+// PaymentWebhookVerifier verifies the notifications that a payment provider
+// sends when the state of a payment changes.
+type PaymentWebhookVerifier interface {
+	// Checks the signature of a notification and returns the change that it
+	// describes. headers are the HTTP headers that the notification was sent
+	// with. Returns a nil event for notifications that don't concern
+	// payments. Returns EUNAUTHORIZED if the signature is not valid.
+	VerifyPaymentWebhook(payload []byte, headers map[string][]string) (*PaymentWebhookEvent, error)
+}
+
+// PaymentIntentParams describes a payment to create with a provider.
+type PaymentIntentParams struct {
+	Amount   int
+	Currency string
+
+	// Shown to the customer and in the provider's dashboard.
+	Description string
+
+	// Stored with the payment by the provider.
+	Metadata map[string]string
+
+	// Prevents the same payment from being created twice when a request is
+	// retried.
+	IdempotencyKey string
+
+	// Only authorize the payment so that it can be captured later.
+	CaptureManually bool
+}
+
+// PaymentIntent is a payment as seen by a provider.
+type PaymentIntent struct {
+	ID           string
+	Status       string
+	Amount       int
+	ClientSecret string
+}
+
+// PaymentRefund is a refund as seen by a provider.
+type PaymentRefund struct {
+	ID     string
+	Amount int
+}
+
+// PaymentWebhookEvent describes a change to a payment that a provider notified
+// us about.
+type PaymentWebhookEvent struct {
+	// The ID of the payment within the provider.
+	ProviderPaymentID string
+
+	// The new status of the payment.
+	Status string
+
+	// The total amount refunded so far. Only set for refunds.
+	AmountRefunded int
+
+	FailureReason string
+}
+
+// PaymentService represents a service for managing payments.
+type PaymentService interface {
+	// Retrieves a list of payments based on a filter, most recent first. Also
+	// returns a count of total matching payments which may be different from
+	// the number of returned payments if the "Limit" field is set.
+	FindPayments(ctx context.Context, req FindPaymentsRequest) FindPaymentsResponse
+
+	// Captures the funds of an authorized payment. Returns EINVALIDTRANSITION
+	// if the payment is not authorized.
+	CapturePayment(ctx context.Context, req CapturePaymentRequest) CapturePaymentResponse
+
+	// Refunds part or all of a payment that succeeded. Returns
+	// EINVALIDTRANSITION if the payment has not been paid.
+	RefundPayment(ctx context.Context, req RefundPaymentRequest) RefundPaymentResponse
+
+	// Applies a notification sent by a payment provider. Confirms the booking
+	// of a deposit that succeeded. Notifications for unknown payments are
+	// ignored so that the provider stops resending them.
+	HandlePaymentWebhook(ctx context.Context, req HandlePaymentWebhookRequest) HandlePaymentWebhookResponse
+}
+
+// FindPaymentsRequest represents a payload used by the FindPayments method of a PaymentService
+type FindPaymentsRequest struct {
+	// Filtering fields.
+	BookingID *int    `json:"bookingId" source:"query"`
+	Status    *string `json:"status" source:"query"`
+
+	// Restrict to subset of range.
+	Offset int `json:"offset" source:"query"`
+	Limit  int `json:"limit" source:"query"`
+}
+
+// Validate a FindPaymentsRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r FindPaymentsRequest) Validate() []ValidationError {
+	errs := make([]ValidationError, 0)
+	if r.Status != nil && !Strings(PaymentStatuses).contains(*r.Status) {
+		errs = append(errs, ValidationError{Name: "status", Reason: "Must be a valid payment status"})
+	}
+	if r.Offset < 0 {
+		errs = append(errs, ValidationError{Name: "offset", Reason: "Must be greater than or equal to 0"})
+	}
+	if r.Limit < 0 {
+		errs = append(errs, ValidationError{Name: "limit", Reason: "Must be greater than or equal to 0"})
+	}
+	return errs
+}
+
+// FindPaymentsResponse represents a response returned by the FindPayments method of a PaymentService.
+type FindPaymentsResponse struct {
+	Payments   []*Payment `json:"payments,omitempty"`
+	TotalItems int        `json:"totalItems"`
+	Err        error      `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r FindPaymentsResponse) Error() error { return r.Err }
+
+// CapturePaymentRequest represents a payload used by the CapturePayment method of a PaymentService
+type CapturePaymentRequest struct {
+	ID int `json:"id" source:"url"`
+
+	// The amount to capture. Zero captures the full amount.
+	Amount int `json:"amount" source:"json"`
+}
+
+// Validate a CapturePaymentRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r CapturePaymentRequest) Validate() []ValidationError {
+	errs := make([]ValidationError, 0)
+	if r.ID < 1 {
+		errs = append(errs, ValidationError{Name: "id", Reason: "Must be at least 1"})
+	}
+	if r.Amount < 0 {
+		errs = append(errs, ValidationError{Name: "amount", Reason: "Must be greater than or equal to 0"})
+	}
+	return errs
+}
+
+// CapturePaymentResponse represents a response returned by the CapturePayment method of a PaymentService.
+type CapturePaymentResponse struct {
+	*Payment
+
+	// The booking of the payment if it was confirmed by the capture.
+	Booking *Booking `json:"booking,omitempty"`
+
+	// The status of the booking before it was confirmed.
+	PreviousBookingStatus string `json:"previousBookingStatus,omitempty"`
+
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r CapturePaymentResponse) Error() error { return r.Err }
+
+// RefundPaymentRequest represents a payload used by the RefundPayment method of a PaymentService
+type RefundPaymentRequest struct {
+	ID int `json:"id" source:"url"`
+
+	// The amount to refund. Zero refunds everything that hasn't been refunded
+	// yet.
+	Amount int `json:"amount" source:"json"`
+}
+
+// Validate a RefundPaymentRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r RefundPaymentRequest) Validate() []ValidationError {
+	errs := make([]ValidationError, 0)
+	if r.ID < 1 {
+		errs = append(errs, ValidationError{Name: "id", Reason: "Must be at least 1"})
+	}
+	if r.Amount < 0 {
+		errs = append(errs, ValidationError{Name: "amount", Reason: "Must be greater than or equal to 0"})
+	}
+	return errs
+}
+
+// RefundPaymentResponse represents a response returned by the RefundPayment method of a PaymentService.
+type RefundPaymentResponse struct {
+	*Payment
+
+	// The status of the payment before it was refunded.
+	PreviousStatus string `json:"previousStatus,omitempty"`
+
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r RefundPaymentResponse) Error() error { return r.Err }
+
+// HandlePaymentWebhookRequest represents a payload used by the HandlePaymentWebhook method of a PaymentService
+type HandlePaymentWebhookRequest struct {
+	// The name of the provider that sent the notification.
+	Provider string `json:"provider" source:"url"`
+
+	// The unparsed body and headers of the notification. The signature covers
+	// the exact bytes that were sent.
+	Payload []byte              `json:"-"`
+	Headers map[string][]string `json:"-"`
+}
+
+// Validate a HandlePaymentWebhookRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r HandlePaymentWebhookRequest) Validate() []ValidationError {
+	errs := make([]ValidationError, 0)
+	if r.Provider == "" {
+		errs = append(errs, ValidationError{Name: "provider", Reason: "Cannot be blank"})
+	}
+	if len(r.Payload) == 0 {
+		errs = append(errs, ValidationError{Name: "payload", Reason: "Cannot be blank"})
+	}
+	return errs
+}
+
+// HandlePaymentWebhookResponse represents a response returned by the HandlePaymentWebhook method of a PaymentService.
+type HandlePaymentWebhookResponse struct {
+	// The payment that was changed. Nil if the notification was ignored.
+	Payment *Payment `json:"payment,omitempty"`
+
+	// The status of the payment before the notification.
+	PreviousStatus string `json:"previousStatus,omitempty"`
+
+	// The booking of the payment along with its resource.
+	Booking *Booking `json:"booking,omitempty"`
+
+	// The status of the booking before it was confirmed by the notification.
+	// Empty if the booking was not changed.
+	PreviousBookingStatus string `json:"previousBookingStatus,omitempty"`
+
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r HandlePaymentWebhookResponse) Error() error { return r.Err }
+
+// PaymentServiceMiddleware defines a middleware for PaymentService
+type PaymentServiceMiddleware func(service PaymentService) PaymentService
+
+// PaymentValidationMiddleware returns a middleware for validating requests made to a PaymentService
+func PaymentValidationMiddleware() PaymentServiceMiddleware {
+	return func(next PaymentService) PaymentService {
+		return paymentValidationMiddleware{next}
+	}
+}
+
+type paymentValidationMiddleware struct {
+	PaymentService
+}
+
+// FindPayments validates a FindPaymentsRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw paymentValidationMiddleware) FindPayments(ctx context.Context, req FindPaymentsRequest) FindPaymentsResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return FindPaymentsResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.PaymentService.FindPayments(ctx, req)
+}
+
+// CapturePayment validates a CapturePaymentRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw paymentValidationMiddleware) CapturePayment(ctx context.Context, req CapturePaymentRequest) CapturePaymentResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return CapturePaymentResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.PaymentService.CapturePayment(ctx, req)
+}
+
+// RefundPayment validates a RefundPaymentRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw paymentValidationMiddleware) RefundPayment(ctx context.Context, req RefundPaymentRequest) RefundPaymentResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return RefundPaymentResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.PaymentService.RefundPayment(ctx, req)
+}
+
+// HandlePaymentWebhook validates a HandlePaymentWebhookRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw paymentValidationMiddleware) HandlePaymentWebhook(ctx context.Context, req HandlePaymentWebhookRequest) HandlePaymentWebhookResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return HandlePaymentWebhookResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.PaymentService.HandlePaymentWebhook(ctx, req)
+}
@@ -0,0 +1,505 @@
+package booking
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Units that the price of a resource is charged in.
+const (
+	// The price is charged once for each booking.
+	PriceUnitFlat = "flat"
+
+	// The price is charged for each hour of a booking. Parts of an hour are
+	// charged pro rata.
+	PriceUnitHour = "hour"
+)
+
+// PricingRules describe how the price of a booking is worked out from the price
+// of its resource. A resource without rules charges its price once for each
+// booking.
+type PricingRules struct {
+	// The unit that the price is charged in, PriceUnitFlat or PriceUnitHour.
+	// Defaults to PriceUnitFlat.
+	Unit string `json:"unit"`
+
+	// Multipliers for times of the day and days of the week, e.g. weekday
+	// evenings. Where rules overlap the first one applies. Flat prices use the
+	// rule that applies when the booking starts, hourly prices charge each
+	// part of the booking at the rule that applies to it.
+	TimeRules []PriceTimeRule `json:"timeRules"`
+
+	// Discounts or surcharges for long bookings, ordered by minimum duration.
+	// The last tier that the booking is long enough for applies.
+	DurationTiers []PriceDurationTier `json:"durationTiers"`
+
+	// Prices that replace the price of the resource between two dates, e.g.
+	// over the summer. Where seasons overlap the first one applies.
+	Seasons []PriceSeason `json:"seasons"`
+
+	// Multipliers for customer segments, e.g. members or students.
+	Segments []PriceSegment `json:"segments"`
+}
+
+// PriceTimeRule multiplies the price of a resource during part of the day.
+type PriceTimeRule struct {
+	// Describes the rule on quotes, e.g. "Weekday evening".
+	Name string `json:"name"`
+
+	// The days of the week that the rule applies on, e.g. "saturday". Applies
+	// every day if empty.
+	Days []string `json:"days"`
+
+	// The times of the day that the rule applies between in the format HH:MM.
+	// An end time of 00:00 is the end of the day.
+	StartTime string `json:"startTime"`
+	EndTime   string `json:"endTime"`
+
+	// The percentage of the price charged, e.g. 150 charges one and a half
+	// times the price.
+	Percent int `json:"percent"`
+}
+
+// PriceDurationTier adjusts the price of bookings that last at least
+// MinDuration.
+type PriceDurationTier struct {
+	// Describes the tier on quotes, e.g. "Over four hours".
+	Name string `json:"name"`
+
+	// The number of seconds that a booking must last for the tier to apply.
+	MinDuration int `json:"minDuration"`
+
+	// The percentage of the price charged, e.g. 90 gives a discount of 10%.
+	Percent int `json:"percent"`
+}
+
+// PriceSeason replaces the price of a resource between two dates. Flat prices
+// use the season that the booking starts in.
+type PriceSeason struct {
+	// Describes the season on quotes, e.g. "Summer".
+	Name string `json:"name"`
+
+	// The first and last day of the season in the format YYYY-MM-DD in the
+	// timezone of the resource.
+	StartDate string `json:"startDate"`
+	EndDate   string `json:"endDate"`
+
+	// The price charged during the season in place of the price of the
+	// resource, in the currency of the resource.
+	Price int `json:"price"`
+}
+
+// PriceSegment multiplies the price for a segment of customers.
+type PriceSegment struct {
+	// The name of the segment that bookings and quotes are made for.
+	Segment string `json:"segment"`
+
+	// The percentage of the price charged, e.g. 80 gives a discount of 20%.
+	Percent int `json:"percent"`
+}
+
+// Quote is the itemised price of a prospective booking.
+type Quote struct {
+	ResourceID int       `json:"resourceId"`
+	StartTime  time.Time `json:"startTime"`
+	EndTime    time.Time `json:"endTime"`
+	Segment    string    `json:"segment,omitempty"`
+	PromoCode  string    `json:"promoCode,omitempty"`
+
+	// What the price is made up of. Items charged at a rate come first,
+	// followed by adjustments for the duration of the booking and the segment
+	// and finally the discount of the promo code.
+	Items []*QuoteItem `json:"items"`
+
+	// The sum of the amounts of the items. Items include tax if the tax rate
+	// is inclusive and exclude it otherwise.
+	Total int `json:"total"`
+
+	// The tax rate of the resource, if it has one, and Total split into the
+	// amount before tax, the tax and the amount that the booking costs.
+	TaxRate *AppliedTax `json:"taxRate,omitempty"`
+	Taxed
+
+	// The currency of the resource. Every amount of the quote is in its minor
+	// unit.
+	Currency string `json:"currency"`
+}
+
+// ApplyTax charges tax at t on the total of q. q isn't taxed if t is nil.
+func (q *Quote) ApplyTax(t *AppliedTax) {
+	q.TaxRate = t
+	q.Taxed = ApplyTax(q.Total, t)
+}
+
+// QuoteItem is a line of a Quote.
+type QuoteItem struct {
+	// What is being charged for, e.g. "Base price" or the name of a rule.
+	Description string `json:"description"`
+
+	// The number of minutes charged at UnitPrice per hour. Only set for
+	// resources priced by the hour.
+	Minutes   int `json:"minutes,omitempty"`
+	UnitPrice int `json:"unitPrice,omitempty"`
+
+	// The amount charged. Negative for discounts.
+	Amount int `json:"amount"`
+}
+
+// Quote prices a booking of r from st to et for the given customer segment,
+// which may be empty. Times of day and dates are those of the timezone of r.
+// The quote isn't taxed until ApplyTax is called with the tax rate of r.
+func (r *Resource) Quote(st, et time.Time, segment string) (*Quote, error) {
+	loc, err := r.Location()
+	if err != nil {
+		return nil, err
+	}
+	p := r.Pricing
+	if p == nil {
+		p = &PricingRules{}
+	}
+
+	q := &Quote{
+		ResourceID: r.ID,
+		StartTime:  st,
+		EndTime:    et,
+		Segment:    segment,
+		Currency:   r.Price.Currency,
+	}
+	if p.Unit == PriceUnitHour {
+		q.Items = p.hourlyItems(r.Price.Amount, st.In(loc), et.In(loc))
+	} else {
+		price, desc := p.rate(r.Price.Amount, st.In(loc))
+		q.Items = []*QuoteItem{{Description: desc, Amount: price}}
+	}
+	for _, item := range q.Items {
+		q.Total += item.Amount
+	}
+	if t := p.durationTier(et.Sub(st)); t != nil {
+		q.adjust(t.Name, t.Percent)
+	}
+	if s := p.segment(segment); s != nil {
+		q.adjust(s.Segment, s.Percent)
+	}
+	q.ApplyTax(nil)
+	return q, nil
+}
+
+// adjust adds an item that charges percent of the total of q so far.
+func (q *Quote) adjust(desc string, percent int) {
+	amount := q.Total*percent/100 - q.Total
+	q.Items = append(q.Items, &QuoteItem{Description: desc, Amount: amount})
+	q.Total += amount
+}
+
+// hourlyItems charges each part of the time from st to et at the rate that
+// applies to it. Parts charged at the same rate are combined into one item.
+func (p *PricingRules) hourlyItems(base int, st, et time.Time) []*QuoteItem {
+	// The rate can only change at midnight or where a time rule starts or
+	// ends.
+	cuts := []time.Time{st, et}
+	loc := st.Location()
+	for d := time.Date(st.Year(), st.Month(), st.Day(), 0, 0, 0, 0, loc); d.Before(et); d = d.AddDate(0, 0, 1) {
+		cuts = append(cuts, d)
+		for _, tr := range p.TimeRules {
+			if !tr.appliesOn(d.Weekday()) {
+				continue
+			}
+			cuts = append(cuts, clockOn(d, tr.StartTime), clockOn(d, tr.EndTime))
+		}
+	}
+	sort.Slice(cuts, func(i, j int) bool { return cuts[i].Before(cuts[j]) })
+
+	items := []*QuoteItem{}
+	seconds := make(map[*QuoteItem]int64)
+	for i := 0; i+1 < len(cuts); i++ {
+		from, to := cuts[i], cuts[i+1]
+		if from.Before(st) || !to.After(from) || to.After(et) {
+			continue
+		}
+		price, desc := p.rate(base, from)
+		var item *QuoteItem
+		for _, v := range items {
+			if v.Description == desc && v.UnitPrice == price {
+				item = v
+			}
+		}
+		if item == nil {
+			item = &QuoteItem{Description: desc, UnitPrice: price}
+			items = append(items, item)
+		}
+		seconds[item] += int64(to.Sub(from) / time.Second)
+	}
+	for _, item := range items {
+		s := seconds[item]
+		item.Minutes = int((s + 30) / 60)
+		item.Amount = int((int64(item.UnitPrice)*s + 1800) / 3600)
+	}
+	return items
+}
+
+// rate returns the price of base at local time t and a description of the
+// rules that it is made up of.
+func (p *PricingRules) rate(base int, t time.Time) (int, string) {
+	var names []string
+	price := base
+	if s := p.season(t); s != nil {
+		price = s.Price
+		names = append(names, s.Name)
+	}
+	if tr := p.timeRule(t); tr != nil {
+		price = price * tr.Percent / 100
+		names = append(names, tr.Name)
+	}
+	if len(names) == 0 {
+		return price, "Base price"
+	}
+	return price, strings.Join(names, ", ")
+}
+
+// season returns the first season that local time t falls in. Returns nil if t
+// is not in a season.
+func (p *PricingRules) season(t time.Time) *PriceSeason {
+	date := t.Format("2006-01-02")
+	for i, s := range p.Seasons {
+		if date >= s.StartDate && date <= s.EndDate {
+			return &p.Seasons[i]
+		}
+	}
+	return nil
+}
+
+// timeRule returns the first time rule that applies at local time t. Returns
+// nil if no rule applies.
+func (p *PricingRules) timeRule(t time.Time) *PriceTimeRule {
+	clock := t.Format("15:04")
+	for i, tr := range p.TimeRules {
+		if !tr.appliesOn(t.Weekday()) {
+			continue
+		}
+		if clock >= tr.StartTime && (tr.EndTime == "00:00" || clock < tr.EndTime) {
+			return &p.TimeRules[i]
+		}
+	}
+	return nil
+}
+
+// durationTier returns the tier that applies to a booking lasting d. Returns
+// nil if the booking is shorter than every tier.
+func (p *PricingRules) durationTier(d time.Duration) *PriceDurationTier {
+	var tier *PriceDurationTier
+	for i, t := range p.DurationTiers {
+		if d >= time.Duration(t.MinDuration)*time.Second {
+			tier = &p.DurationTiers[i]
+		}
+	}
+	return tier
+}
+
+// segment returns the rule for the named segment. Returns nil if name is empty
+// or there is no rule for it.
+func (p *PricingRules) segment(name string) *PriceSegment {
+	if name == "" {
+		return nil
+	}
+	for i, s := range p.Segments {
+		if s.Segment == name {
+			return &p.Segments[i]
+		}
+	}
+	return nil
+}
+
+// appliesOn returns true if the rule applies on day d.
+func (tr PriceTimeRule) appliesOn(d time.Weekday) bool {
+	if len(tr.Days) == 0 {
+		return true
+	}
+	for _, day := range tr.Days {
+		if wd, ok := parseWeekday(day); ok && wd == d {
+			return true
+		}
+	}
+	return false
+}
+
+// clockOn returns the time of day hhmm on day d. 00:00 is the end of the day
+// so that it can close a rule.
+func clockOn(d time.Time, hhmm string) time.Time {
+	c, err := time.Parse("15:04", hhmm)
+	if err != nil || hhmm == "00:00" {
+		return d.AddDate(0, 0, 1)
+	}
+	return time.Date(d.Year(), d.Month(), d.Day(), c.Hour(), c.Minute(), 0, 0, d.Location())
+}
+
+// Validate PricingRules. Returns a ValidationError for each requirement that
+// fails. name is the name of the field holding the rules.
+func (p *PricingRules) Validate(name string) []ValidationError {
+	if p == nil {
+		return nil
+	}
+	var errs []ValidationError
+	if p.Unit != "" && p.Unit != PriceUnitFlat && p.Unit != PriceUnitHour {
+		errs = append(errs, ValidationError{Name: name + ".unit", Reason: fmt.Sprintf("Must be '%s' or '%s'", PriceUnitFlat, PriceUnitHour)})
+	}
+	for i, tr := range p.TimeRules {
+		field := fmt.Sprintf("%s.timeRules[%d]", name, i)
+		if tr.Name == "" {
+			errs = append(errs, ValidationError{Name: field + ".name", Reason: "Name is required"})
+		}
+		for j, day := range tr.Days {
+			if _, ok := parseWeekday(day); !ok {
+				errs = append(errs, ValidationError{Name: fmt.Sprintf("%s.days[%d]", field, j), Reason: "Must be a valid day of the week"})
+			}
+		}
+		startValid := validateSlotTime(tr.StartTime) == nil
+		endValid := validateSlotTime(tr.EndTime) == nil
+		if !startValid {
+			errs = append(errs, ValidationError{Name: field + ".startTime", Reason: "Must be a valid time in the format HH:MM"})
+		}
+		if !endValid {
+			errs = append(errs, ValidationError{Name: field + ".endTime", Reason: "Must be a valid time in the format HH:MM"})
+		}
+		if startValid && endValid && tr.EndTime != "00:00" {
+			if ok, _ := timePrecedes(tr.StartTime, tr.EndTime); !ok {
+				errs = append(errs, ValidationError{Name: field, Reason: "Start time must be earlier than end time"})
+			}
+		}
+		if tr.Percent < 0 {
+			errs = append(errs, ValidationError{Name: field + ".percent", Reason: "Cannot be less than 0"})
+		}
+	}
+	for i, t := range p.DurationTiers {
+		field := fmt.Sprintf("%s.durationTiers[%d]", name, i)
+		if t.Name == "" {
+			errs = append(errs, ValidationError{Name: field + ".name", Reason: "Name is required"})
+		}
+		if t.MinDuration < 1 {
+			errs = append(errs, ValidationError{Name: field + ".minDuration", Reason: "Must be at least 1"})
+		}
+		if i > 0 && t.MinDuration <= p.DurationTiers[i-1].MinDuration {
+			errs = append(errs, ValidationError{Name: field + ".minDuration", Reason: "Must be greater than the minimum duration of the previous tier"})
+		}
+		if t.Percent < 0 {
+			errs = append(errs, ValidationError{Name: field + ".percent", Reason: "Cannot be less than 0"})
+		}
+	}
+	for i, s := range p.Seasons {
+		field := fmt.Sprintf("%s.seasons[%d]", name, i)
+		if s.Name == "" {
+			errs = append(errs, ValidationError{Name: field + ".name", Reason: "Name is required"})
+		}
+		_, startErr := time.Parse("2006-01-02", s.StartDate)
+		_, endErr := time.Parse("2006-01-02", s.EndDate)
+		if startErr != nil {
+			errs = append(errs, ValidationError{Name: field + ".startDate", Reason: "Must be a valid date in the format YYYY-MM-DD"})
+		}
+		if endErr != nil {
+			errs = append(errs, ValidationError{Name: field + ".endDate", Reason: "Must be a valid date in the format YYYY-MM-DD"})
+		}
+		if startErr == nil && endErr == nil && s.EndDate < s.StartDate {
+			errs = append(errs, ValidationError{Name: field + ".endDate", Reason: "Cannot be earlier than 'startDate'"})
+		}
+		if s.Price < 0 {
+			errs = append(errs, ValidationError{Name: field + ".price", Reason: "Cannot be less than 0"})
+		}
+	}
+	segments := make(map[string]bool)
+	for i, s := range p.Segments {
+		field := fmt.Sprintf("%s.segments[%d]", name, i)
+		if s.Segment == "" {
+			errs = append(errs, ValidationError{Name: field + ".segment", Reason: "Segment is required"})
+		} else if segments[s.Segment] {
+			errs = append(errs, ValidationError{Name: field + ".segment", Reason: "Must be unique"})
+		}
+		segments[s.Segment] = true
+		if s.Percent < 0 {
+			errs = append(errs, ValidationError{Name: field + ".percent", Reason: "Cannot be less than 0"})
+		}
+	}
+	return errs
+}
+
+// PricingService represents a service for pricing bookings.
+type PricingService interface {
+	// QuoteBooking returns the itemised price of a prospective booking of a
+	// resource. The booking is not checked against the slots or bookings of
+	// the resource. Returns ERESOURCENOTFOUND if the resource does not exist or
+	// the user does not have permission to view it, and a validation error
+	// for "promoCode" if the promo code can't be redeemed.
+	QuoteBooking(ctx context.Context, req QuoteBookingRequest) QuoteBookingResponse
+}
+
+// QuoteBookingRequest represents a payload used by the QuoteBooking method of
+// a PricingService.
+type QuoteBookingRequest struct {
+	ResourceID int       `json:"resourceId" source:"url"`
+	StartTime  time.Time `json:"startTime" source:"query"`
+	EndTime    time.Time `json:"endTime" source:"query"`
+	Segment    string    `json:"segment" source:"query"`
+
+	// A promo code to apply to the quote. The code is checked as it would be
+	// for a booking by Customer but isn't redeemed.
+	PromoCode string `json:"promoCode" source:"query"`
+	Customer  string `json:"customer" source:"query"`
+}
+
+// Validate a QuoteBookingRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r QuoteBookingRequest) Validate() []ValidationError {
+	var errs []ValidationError
+	if r.ResourceID < 1 {
+		errs = append(errs, ValidationError{Name: "resourceId", Reason: "Must be at least 1"})
+	}
+	if r.StartTime.IsZero() {
+		errs = append(errs, ValidationError{Name: "startTime", Reason: "Start time is required"})
+	}
+	if r.EndTime.IsZero() {
+		errs = append(errs, ValidationError{Name: "endTime", Reason: "End time is required"})
+	}
+	if !r.StartTime.IsZero() && !r.EndTime.IsZero() {
+		if !r.EndTime.After(r.StartTime) {
+			errs = append(errs, ValidationError{Name: "endTime", Reason: "Must be later than 'startTime'"})
+		} else if r.EndTime.Sub(r.StartTime) > MaxAvailabilityWindow {
+			errs = append(errs, ValidationError{Name: "endTime", Reason: "Must be no more than 366 days after 'startTime'"})
+		}
+	}
+	return errs
+}
+
+// QuoteBookingResponse represents a response returned by the QuoteBooking
+// method of a PricingService.
+type QuoteBookingResponse struct {
+	*Quote
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r QuoteBookingResponse) Error() error { return r.Err }
+
+// PricingServiceMiddleware defines a middleware for a pricing service.
+type PricingServiceMiddleware func(PricingService) PricingService
+
+// PricingValidationMiddleware returns a middleware for validating requests
+// made to a PricingService.
+func PricingValidationMiddleware() PricingServiceMiddleware {
+	return func(next PricingService) PricingService {
+		return pricingValidationMiddleware{next}
+	}
+}
+
+type pricingValidationMiddleware struct {
+	PricingService
+}
+
+// QuoteBooking validates a QuoteBookingRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw pricingValidationMiddleware) QuoteBooking(ctx context.Context, req QuoteBookingRequest) QuoteBookingResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return QuoteBookingResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.PricingService.QuoteBooking(ctx, req)
+}
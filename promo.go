@@ -0,0 +1,537 @@
+package booking
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Promo code discount types. Percent discounts take DiscountValue percent off
+// the price of a booking and fixed discounts take off DiscountValue.
+const (
+	PromoDiscountPercent = "percent"
+	PromoDiscountFixed   = "fixed"
+)
+
+// PromoDiscountTypes contains every valid promo code discount type.
+var PromoDiscountTypes = []string{
+	PromoDiscountPercent,
+	PromoDiscountFixed,
+}
+
+// VoidPromoRedemptionStatuses contains the statuses of bookings whose promo
+// code redemptions don't count towards the limits or totals of the code.
+var VoidPromoRedemptionStatuses = []string{
+	BookingStatusCancelled,
+	BookingStatusExpired,
+}
+
+// PromoCode represents a code that customers can enter to get a discount on
+// bookings of an organization's resources.
+type PromoCode struct {
+	ID int `json:"id"`
+
+	// The code that customers enter. Codes are matched regardless of case and
+	// are stored in upper case.
+	Code        string `json:"code"`
+	Description string `json:"description"`
+
+	DiscountType  string `json:"discountType"`
+	DiscountValue int    `json:"discountValue"`
+
+	// The currency of fixed discounts, which only apply to bookings priced
+	// in the same currency. Empty for percent discounts.
+	Currency string `json:"currency,omitempty"`
+
+	// When the code can be redeemed. Either end may be nil to leave the window
+	// open on that side.
+	ValidFrom  *time.Time `json:"validFrom"`
+	ValidUntil *time.Time `json:"validUntil"`
+
+	// How many times the code can be redeemed in total and by each customer.
+	// Nil for no limit.
+	MaxRedemptions            *int `json:"maxRedemptions"`
+	MaxRedemptionsPerCustomer *int `json:"maxRedemptionsPerCustomer"`
+
+	// The resources that the code can be used for. The code can be used for
+	// every resource if empty.
+	ResourceIDs []int `json:"resourceIds"`
+
+	// How many times the code has been redeemed by bookings that haven't been
+	// cancelled or expired, and the discount given by those redemptions in
+	// each currency.
+	Redemptions   int         `json:"redemptions"`
+	TotalDiscount MoneyTotals `json:"totalDiscount"`
+
+	OrganizationID int `json:"organizationId"`
+
+	// Timestamps for promo code creation and last update.
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// NormalizePromoCode returns code in the form that it is stored in.
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// NormalizePromoCustomer returns the form of a customer identifier that
+// redemptions are counted under so that "Jane@Example.com" and
+// "jane@example.com" count as the same customer.
+func NormalizePromoCustomer(customer string) string {
+	return strings.ToLower(strings.TrimSpace(customer))
+}
+
+// Check returns a ValidationError named "promoCode" if c can't be redeemed at
+// now for the booking priced by q. redemptions and customerRedemptions are the
+// number of times that the code has already been redeemed in total and by
+// customer.
+func (c *PromoCode) Check(now time.Time, q *Quote, customer string, redemptions, customerRedemptions int) []ValidationError {
+	invalid := func(reason string) []ValidationError {
+		return []ValidationError{{Name: "promoCode", Reason: reason}}
+	}
+	if c.ValidFrom != nil && now.Before(*c.ValidFrom) {
+		return invalid("Promo code is not valid yet")
+	}
+	if c.ValidUntil != nil && !now.Before(*c.ValidUntil) {
+		return invalid("Promo code has expired")
+	}
+	if len(c.ResourceIDs) > 0 && !containsInt(c.ResourceIDs, q.ResourceID) {
+		return invalid("Promo code is not valid for this resource")
+	}
+	if c.DiscountType == PromoDiscountFixed && c.Currency != q.Currency {
+		return invalid("Promo code is not valid for bookings in " + q.Currency)
+	}
+	if c.MaxRedemptions != nil && redemptions >= *c.MaxRedemptions {
+		return invalid("Promo code has been fully redeemed")
+	}
+	if c.MaxRedemptionsPerCustomer != nil {
+		if customer == "" {
+			return []ValidationError{{Name: "customer", Reason: "Customer is required to redeem this promo code"}}
+		}
+		if customerRedemptions >= *c.MaxRedemptionsPerCustomer {
+			return invalid("Promo code has already been redeemed the maximum number of times by this customer")
+		}
+	}
+	return nil
+}
+
+// Discount returns the amount that c takes off a booking costing total. The
+// discount never exceeds total.
+func (c *PromoCode) Discount(total int) int {
+	var d int
+	switch c.DiscountType {
+	case PromoDiscountPercent:
+		d = total * c.DiscountValue / 100
+	case PromoDiscountFixed:
+		d = c.DiscountValue
+	}
+	if d > total {
+		d = total
+	}
+	if d < 0 {
+		d = 0
+	}
+	return d
+}
+
+// ApplyPromoCode adds an item to q that takes the discount of c off its
+// total. The discount is taken off before tax is worked out. Returns the
+// discount.
+func (q *Quote) ApplyPromoCode(c *PromoCode) int {
+	d := c.Discount(q.Total)
+	q.PromoCode = c.Code
+	q.Items = append(q.Items, &QuoteItem{Description: "Promo code " + c.Code, Amount: -d})
+	q.Total -= d
+	q.ApplyTax(q.TaxRate)
+	return d
+}
+
+func containsInt(s []int, v int) bool {
+	for _, i := range s {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// PromoRedemption records a promo code being used for a booking.
+type PromoRedemption struct {
+	ID          int    `json:"id"`
+	PromoCodeID int    `json:"promoCodeId"`
+	Code        string `json:"code"`
+	BookingID   int    `json:"bookingId"`
+
+	// The status of the booking. Redemptions of bookings that have been
+	// cancelled or expired don't count towards the limits of the code.
+	BookingStatus string `json:"bookingStatus"`
+
+	Customer string `json:"customer,omitempty"`
+	Discount Money  `json:"discount"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// PromoCodeService represents a service for managing promo codes and
+// reporting on their redemptions.
+type PromoCodeService interface {
+	// FindPromoCodeByID retrieves a single promo code by ID along with its
+	// redemption totals. Returns EPROMOCODENOTFOUND if the promo code does not
+	// exist or user does not have permission to view it.
+	FindPromoCodeByID(ctx context.Context, req FindPromoCodeByIDRequest) FindPromoCodeByIDResponse
+
+	// FindPromoCodes retrieves the promo codes of the current organization.
+	// Also returns a count of total matching promo codes which may be
+	// different from the number of returned promo codes if the "Limit" field
+	// is set.
+	FindPromoCodes(ctx context.Context, req FindPromoCodesRequest) FindPromoCodesResponse
+
+	// CreatePromoCode creates a new promo code for the current organization.
+	// Returns EPROMOCODECONFLICT if the organization already has the code.
+	CreatePromoCode(ctx context.Context, req CreatePromoCodeRequest) CreatePromoCodeResponse
+
+	// UpdatePromoCode updates an existing promo code by ID. Existing
+	// redemptions are unaffected.
+	UpdatePromoCode(ctx context.Context, req UpdatePromoCodeRequest) UpdatePromoCodeResponse
+
+	// DeletePromoCode permanently removes a promo code along with its
+	// redemptions. The prices of bookings that redeemed it are unaffected.
+	DeletePromoCode(ctx context.Context, req DeletePromoCodeRequest) DeletePromoCodeResponse
+
+	// FindPromoRedemptions retrieves the redemptions of a promo code, most
+	// recent first, along with their total discount.
+	FindPromoRedemptions(ctx context.Context, req FindPromoRedemptionsRequest) FindPromoRedemptionsResponse
+}
+
+// FindPromoCodeByIDRequest represents a payload used by the FindPromoCodeByID method of a PromoCodeService
+type FindPromoCodeByIDRequest struct {
+	ID int `json:"id" source:"url"`
+}
+
+// Validate a FindPromoCodeByIDRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r FindPromoCodeByIDRequest) Validate() []ValidationError {
+	if r.ID < 1 {
+		return []ValidationError{{Name: "id", Reason: "Must be at least 1"}}
+	}
+	return nil
+}
+
+// FindPromoCodeByIDResponse represents a response returned by the FindPromoCodeByID method of a PromoCodeService.
+type FindPromoCodeByIDResponse struct {
+	*PromoCode
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r FindPromoCodeByIDResponse) Error() error { return r.Err }
+
+// FindPromoCodesRequest represents a payload used by the FindPromoCodes method of a PromoCodeService
+type FindPromoCodesRequest struct {
+	// Filtering fields. Matches codes containing Code regardless of case.
+	Code *string `json:"code" source:"query"`
+
+	// Restrict to subset of range.
+	Offset int `json:"offset" source:"query"`
+	Limit  int `json:"limit" source:"query"`
+}
+
+// Validate a FindPromoCodesRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r FindPromoCodesRequest) Validate() []ValidationError {
+	errs := make([]ValidationError, 0)
+	if r.Offset < 0 {
+		errs = append(errs, ValidationError{Name: "offset", Reason: "Must be greater than or equal to 0"})
+	}
+	if r.Limit < 0 {
+		errs = append(errs, ValidationError{Name: "limit", Reason: "Must be greater than or equal to 0"})
+	}
+	return errs
+}
+
+// FindPromoCodesResponse represents a response returned by the FindPromoCodes method of a PromoCodeService.
+type FindPromoCodesResponse struct {
+	PromoCodes []*PromoCode `json:"promoCodes,omitempty"`
+	TotalItems int          `json:"totalItems"`
+	Err        error        `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r FindPromoCodesResponse) Error() error { return r.Err }
+
+// CreatePromoCodeRequest represents a payload used by the CreatePromoCode method of a PromoCodeService
+type CreatePromoCodeRequest struct {
+	Code                      string     `json:"code" source:"json"`
+	Description               string     `json:"description" source:"json"`
+	DiscountType              string     `json:"discountType" source:"json"`
+	DiscountValue             int        `json:"discountValue" source:"json"`
+	Currency                  string     `json:"currency" source:"json"`
+	ValidFrom                 *time.Time `json:"validFrom" source:"json"`
+	ValidUntil                *time.Time `json:"validUntil" source:"json"`
+	MaxRedemptions            *int       `json:"maxRedemptions" source:"json"`
+	MaxRedemptionsPerCustomer *int       `json:"maxRedemptionsPerCustomer" source:"json"`
+	ResourceIDs               []int      `json:"resourceIds" source:"json"`
+}
+
+// Validate a CreatePromoCodeRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r CreatePromoCodeRequest) Validate() []ValidationError {
+	return validatePromoCode(
+		r.Code,
+		r.DiscountType,
+		r.DiscountValue,
+		r.Currency,
+		r.ValidFrom,
+		r.ValidUntil,
+		r.MaxRedemptions,
+		r.MaxRedemptionsPerCustomer,
+		r.ResourceIDs,
+	)
+}
+
+// CreatePromoCodeResponse represents a response returned by the CreatePromoCode method of a PromoCodeService.
+type CreatePromoCodeResponse struct {
+	*PromoCode
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r CreatePromoCodeResponse) Error() error { return r.Err }
+
+// UpdatePromoCodeRequest represents a payload used by the UpdatePromoCode method of a PromoCodeService
+type UpdatePromoCodeRequest struct {
+	ID                        int        `json:"id" source:"url"`
+	Code                      string     `json:"code" source:"json"`
+	Description               string     `json:"description" source:"json"`
+	DiscountType              string     `json:"discountType" source:"json"`
+	DiscountValue             int        `json:"discountValue" source:"json"`
+	Currency                  string     `json:"currency" source:"json"`
+	ValidFrom                 *time.Time `json:"validFrom" source:"json"`
+	ValidUntil                *time.Time `json:"validUntil" source:"json"`
+	MaxRedemptions            *int       `json:"maxRedemptions" source:"json"`
+	MaxRedemptionsPerCustomer *int       `json:"maxRedemptionsPerCustomer" source:"json"`
+	ResourceIDs               []int      `json:"resourceIds" source:"json"`
+}
+
+// Validate an UpdatePromoCodeRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r UpdatePromoCodeRequest) Validate() []ValidationError {
+	errs := make([]ValidationError, 0)
+	if r.ID < 1 {
+		errs = append(errs, ValidationError{Name: "id", Reason: "Must be at least 1"})
+	}
+	errs = append(errs, validatePromoCode(
+		r.Code,
+		r.DiscountType,
+		r.DiscountValue,
+		r.Currency,
+		r.ValidFrom,
+		r.ValidUntil,
+		r.MaxRedemptions,
+		r.MaxRedemptionsPerCustomer,
+		r.ResourceIDs,
+	)...)
+	return errs
+}
+
+// UpdatePromoCodeResponse represents a response returned by the UpdatePromoCode method of a PromoCodeService.
+type UpdatePromoCodeResponse struct {
+	*PromoCode
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r UpdatePromoCodeResponse) Error() error { return r.Err }
+
+// DeletePromoCodeRequest represents a payload used by the DeletePromoCode method of a PromoCodeService
+type DeletePromoCodeRequest struct {
+	ID int `json:"id" source:"url"`
+}
+
+// Validate a DeletePromoCodeRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r DeletePromoCodeRequest) Validate() []ValidationError {
+	if r.ID < 1 {
+		return []ValidationError{{Name: "id", Reason: "Must be at least 1"}}
+	}
+	return nil
+}
+
+// DeletePromoCodeResponse represents a response returned by the DeletePromoCode method of a PromoCodeService.
+type DeletePromoCodeResponse struct {
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r DeletePromoCodeResponse) Error() error { return r.Err }
+
+// FindPromoRedemptionsRequest represents a payload used by the FindPromoRedemptions method of a PromoCodeService
+type FindPromoRedemptionsRequest struct {
+	PromoCodeID int `json:"promoCodeId" source:"url"`
+
+	// Filtering fields. Restricts redemptions to those made in the window.
+	Customer *string    `json:"customer" source:"query"`
+	From     *time.Time `json:"from" source:"query"`
+	To       *time.Time `json:"to" source:"query"`
+
+	// Restrict to subset of range.
+	Offset int `json:"offset" source:"query"`
+	Limit  int `json:"limit" source:"query"`
+}
+
+// Validate a FindPromoRedemptionsRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r FindPromoRedemptionsRequest) Validate() []ValidationError {
+	errs := make([]ValidationError, 0)
+	if r.PromoCodeID < 1 {
+		errs = append(errs, ValidationError{Name: "promoCodeId", Reason: "Must be at least 1"})
+	}
+	if r.From != nil && r.To != nil && !r.To.After(*r.From) {
+		errs = append(errs, ValidationError{Name: "to", Reason: "Must be later than 'from'"})
+	}
+	if r.Offset < 0 {
+		errs = append(errs, ValidationError{Name: "offset", Reason: "Must be greater than or equal to 0"})
+	}
+	if r.Limit < 0 {
+		errs = append(errs, ValidationError{Name: "limit", Reason: "Must be greater than or equal to 0"})
+	}
+	return errs
+}
+
+// FindPromoRedemptionsResponse represents a response returned by the FindPromoRedemptions method of a PromoCodeService.
+type FindPromoRedemptionsResponse struct {
+	Redemptions []*PromoRedemption `json:"redemptions,omitempty"`
+	TotalItems  int                `json:"totalItems"`
+
+	// The sum of the discounts of every matching redemption whose booking
+	// hasn't been cancelled or expired in each currency, regardless of
+	// "Offset" and "Limit".
+	TotalDiscount MoneyTotals `json:"totalDiscount"`
+
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r FindPromoRedemptionsResponse) Error() error { return r.Err }
+
+func validatePromoCode(
+	code string,
+	discountType string,
+	discountValue int,
+	currency string,
+	validFrom *time.Time,
+	validUntil *time.Time,
+	maxRedemptions *int,
+	maxRedemptionsPerCustomer *int,
+	resourceIDs []int,
+) []ValidationError {
+	errs := make([]ValidationError, 0)
+	code = strings.TrimSpace(code)
+	if code == "" {
+		errs = append(errs, ValidationError{Name: "code", Reason: "Code is required"})
+	} else if len(code) > 64 || strings.ContainsAny(code, " \t\r\n") {
+		errs = append(errs, ValidationError{Name: "code", Reason: "Must be no more than 64 characters without spaces"})
+	}
+	switch discountType {
+	case PromoDiscountPercent:
+		if discountValue < 1 || discountValue > 100 {
+			errs = append(errs, ValidationError{Name: "discountValue", Reason: "Must be between 1 and 100"})
+		}
+	case PromoDiscountFixed:
+		if discountValue < 1 {
+			errs = append(errs, ValidationError{Name: "discountValue", Reason: "Must be at least 1"})
+		}
+	default:
+		errs = append(errs, ValidationError{Name: "discountType", Reason: "Must be one of 'percent' or 'fixed'"})
+	}
+	// Fixed discounts without a currency are in the organization's default
+	// currency.
+	if currency != "" && !ValidCurrency(currency) {
+		errs = append(errs, ValidationError{Name: "currency", Reason: "Must be a supported ISO 4217 currency code"})
+	}
+	if validFrom != nil && validUntil != nil && !validUntil.After(*validFrom) {
+		errs = append(errs, ValidationError{Name: "validUntil", Reason: "Must be later than 'validFrom'"})
+	}
+	if maxRedemptions != nil && *maxRedemptions < 1 {
+		errs = append(errs, ValidationError{Name: "maxRedemptions", Reason: "Must be at least 1"})
+	}
+	if maxRedemptionsPerCustomer != nil && *maxRedemptionsPerCustomer < 1 {
+		errs = append(errs, ValidationError{Name: "maxRedemptionsPerCustomer", Reason: "Must be at least 1"})
+	}
+	for i, id := range resourceIDs {
+		if id < 1 {
+			errs = append(errs, ValidationError{Name: fmt.Sprintf("resourceIds[%d]", i), Reason: "Must be at least 1"})
+		}
+	}
+	return errs
+}
+
+// PromoCodeServiceMiddleware defines a middleware for PromoCodeService
+type PromoCodeServiceMiddleware func(service PromoCodeService) PromoCodeService
+
+// PromoCodeValidationMiddleware returns a middleware for validating requests made to a PromoCodeService
+func PromoCodeValidationMiddleware() PromoCodeServiceMiddleware {
+	return func(next PromoCodeService) PromoCodeService {
+		return promoCodeValidationMiddleware{next}
+	}
+}
+
+type promoCodeValidationMiddleware struct {
+	PromoCodeService
+}
+
+// FindPromoCodeByID validates a FindPromoCodeByIDRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw promoCodeValidationMiddleware) FindPromoCodeByID(ctx context.Context, req FindPromoCodeByIDRequest) FindPromoCodeByIDResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return FindPromoCodeByIDResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.PromoCodeService.FindPromoCodeByID(ctx, req)
+}
+
+// FindPromoCodes validates a FindPromoCodesRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw promoCodeValidationMiddleware) FindPromoCodes(ctx context.Context, req FindPromoCodesRequest) FindPromoCodesResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return FindPromoCodesResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.PromoCodeService.FindPromoCodes(ctx, req)
+}
+
+// CreatePromoCode validates a CreatePromoCodeRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw promoCodeValidationMiddleware) CreatePromoCode(ctx context.Context, req CreatePromoCodeRequest) CreatePromoCodeResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return CreatePromoCodeResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.PromoCodeService.CreatePromoCode(ctx, req)
+}
+
+// UpdatePromoCode validates an UpdatePromoCodeRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw promoCodeValidationMiddleware) UpdatePromoCode(ctx context.Context, req UpdatePromoCodeRequest) UpdatePromoCodeResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return UpdatePromoCodeResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.PromoCodeService.UpdatePromoCode(ctx, req)
+}
+
+// DeletePromoCode validates a DeletePromoCodeRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw promoCodeValidationMiddleware) DeletePromoCode(ctx context.Context, req DeletePromoCodeRequest) DeletePromoCodeResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return DeletePromoCodeResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.PromoCodeService.DeletePromoCode(ctx, req)
+}
+
+// FindPromoRedemptions validates a FindPromoRedemptionsRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw promoCodeValidationMiddleware) FindPromoRedemptions(ctx context.Context, req FindPromoRedemptionsRequest) FindPromoRedemptionsResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return FindPromoRedemptionsResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.PromoCodeService.FindPromoRedemptions(ctx, req)
+}
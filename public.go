@@ -0,0 +1,259 @@
+package booking
+
+import (
+	"time"
+)
+
+// PublicScopes contains the scopes granted to requests made with the public
+// key of an organization. The public API lets client facing applications, such
+// as the website of an organization, list resources and their availability
+// and make bookings on behalf of customers. Since anyone can make requests
+// with a public key, responses only contain fields that are safe to show to
+// anyone.
+var PublicScopes = []string{
+	ScopeResourcesRead,
+	ScopeBookingsWrite,
+}
+
+// PublicResource is the view of a Resource that is returned by the public API.
+type PublicResource struct {
+	ID                 int                 `json:"id"`
+	Name               string              `json:"name"`
+	Description        string              `json:"description"`
+	Slots              []*Slot             `json:"slots"`
+	Timezone           string              `json:"timezone"`
+	Price              Money               `json:"price"`
+	BookingPrice       Money               `json:"bookingPrice"`
+	QuantityAvailable  *int                `json:"quantityAvailable"`
+	CancellationPolicy *CancellationPolicy `json:"cancellationPolicy"`
+	Pricing            *PricingRules       `json:"pricing"`
+
+	// Whether a password has to be given to book the resource.
+	PasswordProtected bool `json:"passwordProtected"`
+
+	// The custom fields that have to be filled in to book the resource.
+	BookingFields BookingFields `json:"bookingFields"`
+}
+
+// Public returns the fields of r that may be shown to customers.
+func (r *Resource) Public() *PublicResource {
+	if r == nil {
+		return nil
+	}
+	slots := r.Slots
+	if slots == nil {
+		slots = make([]*Slot, 0)
+	}
+	return &PublicResource{
+		ID:                 r.ID,
+		Name:               r.Name,
+		Description:        r.Description,
+		Slots:              slots,
+		Timezone:           r.Timezone,
+		Price:              r.Price,
+		BookingPrice:       r.BookingPrice,
+		QuantityAvailable:  r.QuantityAvailable,
+		CancellationPolicy: r.CancellationPolicy,
+		Pricing:            r.Pricing,
+		PasswordProtected:  r.PasswordProtected,
+		BookingFields:      r.BookingFields,
+	}
+}
+
+// PublicAvailability is the view of an Availability that is returned by the
+// public API.
+type PublicAvailability struct {
+	ResourceID int       `json:"resourceId"`
+	StartTime  time.Time `json:"startTime"`
+	EndTime    time.Time `json:"endTime"`
+}
+
+// Public returns the fields of a that may be shown to customers.
+func (a *Availability) Public() *PublicAvailability {
+	return &PublicAvailability{
+		ResourceID: a.ResourceID,
+		StartTime:  a.StartTime,
+		EndTime:    a.EndTime,
+	}
+}
+
+// PublicBooking is the view of a Booking that is returned to the customer
+// that made it through the public API.
+type PublicBooking struct {
+	ID         int               `json:"id"`
+	ResourceID int               `json:"resourceId"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+	Status     string            `json:"status"`
+	ExpiresAt  *time.Time        `json:"expiresAt,omitempty"`
+	Price      Money             `json:"price"`
+	TaxRate    *AppliedTax       `json:"taxRate,omitempty"`
+	Taxed
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Public returns the fields of b that may be shown to the customer that made
+// it.
+func (b *Booking) Public() *PublicBooking {
+	if b == nil {
+		return nil
+	}
+	return &PublicBooking{
+		ID:         b.ID,
+		ResourceID: b.ResourceID,
+		Metadata:   b.Metadata,
+		Status:     b.Status,
+		ExpiresAt:  b.ExpiresAt,
+		Price:      b.Price,
+		TaxRate:    b.TaxRate,
+		Taxed:      b.Taxed,
+		StartTime:  b.StartTime,
+		EndTime:    b.EndTime,
+		CreatedAt:  b.CreatedAt,
+	}
+}
+
+// PublicPayment is the view of a Payment that is returned to the customer
+// that has to make it through the public API.
+type PublicPayment struct {
+	Amount   int    `json:"amount"`
+	Currency string `json:"currency"`
+	Status   string `json:"status"`
+
+	// Secret that the customer's client uses to complete the payment with the
+	// provider.
+	ClientSecret string `json:"clientSecret,omitempty"`
+}
+
+// Public returns the fields of p that the customer needs to pay it.
+func (p *Payment) Public() *PublicPayment {
+	if p == nil {
+		return nil
+	}
+	return &PublicPayment{
+		Amount:       p.Amount,
+		Currency:     p.Currency,
+		Status:       p.Status,
+		ClientSecret: p.ClientSecret,
+	}
+}
+
+// FindPublicResourcesRequest represents a payload used to list the resources
+// of an organization through the public API.
+type FindPublicResourcesRequest struct {
+	// Filtering fields.
+	Name *string `json:"name" source:"query"`
+
+	// Restrict to subset of range.
+	Offset int `json:"offset" source:"query"`
+	Limit  int `json:"limit" source:"query"`
+
+	// Resource property to order by.
+	OrderBy *string `json:"orderBy" source:"query"`
+}
+
+// Validate a FindPublicResourcesRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r FindPublicResourcesRequest) Validate() []ValidationError {
+	errs := make([]ValidationError, 0)
+	if r.Offset < 0 {
+		errs = append(errs, ValidationError{Name: "offset", Reason: "Must be greater than or equal to 0"})
+	}
+	if r.Limit < 0 {
+		errs = append(errs, ValidationError{Name: "limit", Reason: "Must be greater than or equal to 0"})
+	}
+	validOrderByValues := []string{"name", "price", "createdAt"}
+	if r.OrderBy != nil && !Strings(validOrderByValues).contains(*r.OrderBy) {
+		errs = append(errs, ValidationError{Name: "orderBy", Reason: "Must be a valid property name"})
+	}
+	return errs
+}
+
+// FindResourcesRequest returns the request made to a ResourceService to
+// list resources matching r.
+func (r FindPublicResourcesRequest) FindResourcesRequest() FindResourcesRequest {
+	return FindResourcesRequest{
+		Name:    r.Name,
+		Offset:  r.Offset,
+		Limit:   r.Limit,
+		OrderBy: r.OrderBy,
+	}
+}
+
+// FindPublicResourcesResponse represents a response returned when listing
+// the resources of an organization through the public API.
+type FindPublicResourcesResponse struct {
+	Resources  []*PublicResource `json:"resources"`
+	TotalItems int               `json:"totalItems"`
+	Err        error             `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r FindPublicResourcesResponse) Error() error { return r.Err }
+
+// FindPublicResourceByIDResponse represents a response returned when
+// retrieving a single resource through the public API.
+type FindPublicResourceByIDResponse struct {
+	*PublicResource
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r FindPublicResourceByIDResponse) Error() error { return r.Err }
+
+// FindPublicAvailabilitiesResponse represents a response returned when
+// listing the availabilities of a resource through the public API.
+type FindPublicAvailabilitiesResponse struct {
+	Availabilities []*PublicAvailability `json:"availabilities"`
+	TotalItems     int                   `json:"totalItems"`
+	Err            error                 `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r FindPublicAvailabilitiesResponse) Error() error { return r.Err }
+
+// CreatePublicBookingRequest represents a payload used by customers to make a
+// booking through the public API. Bookings are always created pending and
+// priced for the default customer segment.
+type CreatePublicBookingRequest struct {
+	ResourceID int               `json:"resourceId" source:"json"`
+	Metadata   map[string]string `json:"metadata" source:"json"`
+	StartTime  time.Time         `json:"startTime" source:"json"`
+	EndTime    time.Time         `json:"endTime" source:"json"`
+	PromoCode  string            `json:"promoCode" source:"json"`
+	Customer   string            `json:"customer" source:"json"`
+
+	// Required if the resource is password protected.
+	Password string `json:"password" source:"json"`
+}
+
+// CreateBookingRequest returns the request made to a BookingService to
+// create the booking requested by r.
+func (r CreatePublicBookingRequest) CreateBookingRequest() CreateBookingRequest {
+	return CreateBookingRequest{
+		ResourceID: r.ResourceID,
+		Metadata:   r.Metadata,
+		Status:     BookingStatusPending,
+		StartTime:  r.StartTime,
+		EndTime:    r.EndTime,
+		PromoCode:  r.PromoCode,
+		Customer:   r.Customer,
+		Password:   r.Password,
+	}
+}
+
+// CreatePublicBookingResponse represents a response returned to a customer
+// that made a booking through the public API.
+type CreatePublicBookingResponse struct {
+	*PublicBooking
+
+	// The deposit that the customer has to pay before the booking is
+	// confirmed. Nil if the resource has no booking price.
+	Payment *PublicPayment `json:"payment,omitempty"`
+
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r CreatePublicBookingResponse) Error() error { return r.Err }
@@ -0,0 +1,115 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/openmesh/booking"
+)
+
+// EventBufferSize is the buffer size of the channel for each subscription.
+const EventBufferSize = 16
+
+// NewEventService returns an event service that uses Redis pub/sub to deliver
+// events. Each organization has its own channel so events can be published
+// and received by any number of nodes.
+func NewEventService(opts *redis.Options) booking.EventService {
+	return &eventService{client: redis.NewClient(opts)}
+}
+
+type eventService struct {
+	client *redis.Client
+}
+
+// eventMessage is the representation of an event sent over a channel. The
+// payload is kept as raw JSON so it can be passed on to subscribers without
+// knowing its type.
+type eventMessage struct {
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// eventChannel returns the name of the channel used for an organization's
+// events.
+func eventChannel(organizationID int) string {
+	return fmt.Sprintf("events:organization:%d", organizationID)
+}
+
+// PublishEvent publishes event to the organization's channel. Publishing is
+// best effort so failures are reported rather than returned.
+func (s *eventService) PublishEvent(organizationID int, event booking.Event) {
+	ctx := context.Background()
+	buf, err := json.Marshal(event)
+	if err != nil {
+		booking.ReportError(ctx, fmt.Errorf("failed to marshal event: %w", err))
+		return
+	}
+	if err := s.client.Publish(ctx, eventChannel(organizationID), buf).Err(); err != nil {
+		booking.ReportError(ctx, fmt.Errorf("failed to publish event: %w", err))
+	}
+}
+
+// Subscribe creates a new subscription to the current organization's channel.
+func (s *eventService) Subscribe(ctx context.Context) (booking.Subscription, error) {
+	orgID := booking.OrganizationIDFromContext(ctx)
+	if orgID == 0 {
+		return nil, booking.Errorf(booking.EUNAUTHORIZED, "You must be signed in to subscribe to events.")
+	}
+
+	ps := s.client.Subscribe(ctx, eventChannel(orgID))
+	// Wait for the subscription to be confirmed so that no events published
+	// after Subscribe returns are missed.
+	if _, err := ps.Receive(ctx); err != nil {
+		ps.Close()
+		return nil, fmt.Errorf("failed to subscribe to events: %w", err)
+	}
+
+	sub := &subscription{
+		pubsub: ps,
+		c:      make(chan booking.Event, EventBufferSize),
+		done:   make(chan struct{}),
+	}
+	go sub.run()
+	return sub, nil
+}
+
+type subscription struct {
+	pubsub *redis.PubSub
+	c      chan booking.Event
+	done   chan struct{}
+	once   sync.Once
+}
+
+// run decodes messages from the channel until the subscription is closed.
+func (s *subscription) run() {
+	defer close(s.c)
+	for msg := range s.pubsub.Channel() {
+		var m eventMessage
+		if err := json.Unmarshal([]byte(msg.Payload), &m); err != nil {
+			booking.ReportError(context.Background(), fmt.Errorf("failed to unmarshal event: %w", err))
+			continue
+		}
+		select {
+		case s.c <- booking.Event{Type: m.Type, Payload: m.Payload}:
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// Close unsubscribes from the channel and closes the event stream.
+func (s *subscription) Close() (err error) {
+	s.once.Do(func() {
+		close(s.done)
+		err = s.pubsub.Close()
+	})
+	return err
+}
+
+// C returns the event stream for all of the organization's events.
+func (s *subscription) C() <-chan booking.Event {
+	return s.c
+}
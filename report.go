@@ -1,34 +1,144 @@
 package booking
 
-import "context"
-
-// Dashboard reports
+import (
+	"context"
+	"time"
+)
+
+// maxActivityReportWindow is the longest period that the bookings activity
+// report can be generated for. The report contains a row for every day.
+const maxActivityReportWindow = 366 * 24 * time.Hour
+
+// SalesBookingStatuses contains the statuses of bookings that count as a sale
+// in reports. Bookings that were cancelled or expired before they took place
+// are not included.
+var SalesBookingStatuses = []string{
+	BookingStatusConfirmed,
+	BookingStatusCheckedIn,
+	BookingStatusCompleted,
+	BookingStatusNoShow,
+}
 
-// - Recent sales
-// - Upcoming bookings
-// - Today's next bookings
-// - Top resources
+// SaleBookingStatus returns true if a booking with the given status counts as
+// a sale.
+func SaleBookingStatus(status string) bool {
+	return Strings(SalesBookingStatuses).contains(status)
+}
 
+// ReportService represents a service for generating the reports displayed on
+// the dashboard. Every report can be restricted to a window of time and to a
+// single resource. Revenue is calculated from the prices that bookings were
+// made for, split into the amount before tax, the tax and the amount including
+// tax, and is totalled separately for each currency.
 type ReportService interface {
+	// GetRecentSalesReport returns the most recently made bookings that count as
+	// sales along with the total value of all sales in the window, broken down
+	// by the tax rate that they were charged.
 	GetRecentSalesReport(ctx context.Context, req GetRecentSalesReportRequest) GetRecentSalesReportResponse
+
+	// GetUpcomingBookingsReport returns active bookings that have not started yet
+	// ordered by start time.
 	GetUpcomingBookingsReport(ctx context.Context, req GetUpcomingBookingsReportRequest) GetUpcomingBookingsReportResponse
+
+	// GetBookingsActivityReport returns the number of bookings and the revenue
+	// from them for each day in the window.
 	GetBookingsActivityReport(ctx context.Context, req GetBookingsActivityReportRequest) GetBookingsActivityReportResponse
+
+	// GetTodaysBookingsReport returns the bookings that start today.
 	GetTodaysBookingsReport(ctx context.Context, req GetTodaysBookingsReportRequest) GetTodaysBookingsReportResponse
+
+	// GetTopResourcesReport returns the resources that generated the most
+	// revenue in the organization's default currency.
 	GetTopResourcesReport(ctx context.Context, req GetTopResourcesReportRequest) GetTopResourcesReportResponse
+
+	// GetTopEmployeesReport returns the users that made the bookings which
+	// generated the most revenue in the organization's default currency.
 	GetTopEmployeesReport(ctx context.Context, req GetTopEmployeesReportRequest) GetTopEmployeesReportResponse
 }
 
+// BookingReportRow represents a single booking within a report.
+type BookingReportRow struct {
+	BookingID    int       `json:"bookingId"`
+	ResourceID   int       `json:"resourceId"`
+	ResourceName string    `json:"resourceName"`
+	Status       string    `json:"status"`
+	StartTime    time.Time `json:"startTime"`
+	EndTime      time.Time `json:"endTime"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// SalesReportRow represents a single sale within a report.
+type SalesReportRow struct {
+	BookingReportRow
+
+	// The price of the booking and the upfront amount paid to make it.
+	Price        TaxedMoney `json:"price"`
+	BookingPrice Money      `json:"bookingPrice"`
+
+	// The tax rate charged on the booking. Nil if it was made without tax.
+	TaxRate *AppliedTax `json:"taxRate,omitempty"`
+}
+
+// TaxReportRow represents the sales charged at a single tax rate in a single
+// currency.
+type TaxReportRow struct {
+	// The tax rate charged. Nil for sales made without tax.
+	TaxRate  *AppliedTax `json:"taxRate"`
+	Bookings int         `json:"bookings"`
+	Revenue  TaxedMoney  `json:"revenue"`
+}
+
+// ActivityReportRow represents the bookings made for a single day.
+type ActivityReportRow struct {
+	// The day in the format YYYY-MM-DD.
+	Date     string      `json:"date"`
+	Bookings int         `json:"bookings"`
+	Revenue  TaxedTotals `json:"revenue"`
+}
+
+// TopResourceReportRow represents the bookings made for a single resource.
+type TopResourceReportRow struct {
+	ResourceID   int         `json:"resourceId"`
+	ResourceName string      `json:"resourceName"`
+	Bookings     int         `json:"bookings"`
+	Revenue      TaxedTotals `json:"revenue"`
+}
+
+// TopEmployeeReportRow represents the bookings made by a single user.
+type TopEmployeeReportRow struct {
+	UserID   int         `json:"userId"`
+	Name     string      `json:"name"`
+	Bookings int         `json:"bookings"`
+	Revenue  TaxedTotals `json:"revenue"`
+}
+
 // GetRecentSalesReportRequest represents a payload used by the GetRecentSalesReport method of a ReportService
-type GetRecentSalesReportRequest struct{}
+type GetRecentSalesReportRequest struct {
+	// Filtering fields. Defaults to the last 30 days.
+	From       *time.Time `json:"from" source:"query"`
+	To         *time.Time `json:"to" source:"query"`
+	ResourceID *int       `json:"resourceId" source:"query"`
+
+	// The maximum number of sales to return.
+	Limit int `json:"limit" source:"query"`
+}
 
 // Validate a GetRecentSalesReport. Returns a ValidationError for each requirement that fails.
 func (r GetRecentSalesReportRequest) Validate() []ValidationError {
-	// insert validation logic here
-	return nil
+	return validateReportRequest(r.From, r.To, r.ResourceID, r.Limit)
 }
 
 // GetRecentSalesReportResponse represents a response returned by the GetRecentSalesReport method of a ReportService.
 type GetRecentSalesReportResponse struct {
+	Sales         []*SalesReportRow `json:"sales"`
+	TotalSales    int               `json:"totalSales"`
+	TotalRevenue  TaxedTotals       `json:"totalRevenue"`
+	TotalDeposits MoneyTotals       `json:"totalDeposits"`
+
+	// The revenue of every sale in the window for each tax rate and currency,
+	// ordered by currency and then by tax rate.
+	TaxBreakdown []*TaxReportRow `json:"taxBreakdown"`
+
 	Err error `json:"err,omitempty"`
 }
 
@@ -36,93 +146,144 @@ type GetRecentSalesReportResponse struct {
 func (r GetRecentSalesReportResponse) Error() error { return r.Err }
 
 // GetUpcomingBookingsReportRequest represents a payload used by the GetUpcomingBookingsReport method of a ReportService
-type GetUpcomingBookingsReportRequest struct { // insert request properties here
+type GetUpcomingBookingsReportRequest struct {
+	// Filtering fields. Defaults to the next 7 days.
+	From       *time.Time `json:"from" source:"query"`
+	To         *time.Time `json:"to" source:"query"`
+	ResourceID *int       `json:"resourceId" source:"query"`
+
+	// The maximum number of bookings to return.
+	Limit int `json:"limit" source:"query"`
 }
 
 // Validate a GetUpcomingBookingsReport. Returns a ValidationError for each requirement that fails.
 func (r GetUpcomingBookingsReportRequest) Validate() []ValidationError {
-	// insert validation logic here
-	return nil
+	return validateReportRequest(r.From, r.To, r.ResourceID, r.Limit)
 }
 
 // GetUpcomingBookingsReportResponse represents a response returned by the GetUpcomingBookingsReport method of a ReportService.
 type GetUpcomingBookingsReportResponse struct {
-	Err error `json:"err,omitempty"`
+	Bookings   []*BookingReportRow `json:"bookings"`
+	TotalItems int                 `json:"totalItems"`
+	Err        error               `json:"err,omitempty"`
 }
 
 // Error implements the errorer interface. Returns property Err from the response.
 func (r GetUpcomingBookingsReportResponse) Error() error { return r.Err }
 
 // GetBookingsActivityReportRequest represents a payload used by the GetBookingsActivityReport method of a ReportService
-type GetBookingsActivityReportRequest struct{}
+type GetBookingsActivityReportRequest struct {
+	// Filtering fields. Defaults to the last 30 days.
+	From       *time.Time `json:"from" source:"query"`
+	To         *time.Time `json:"to" source:"query"`
+	ResourceID *int       `json:"resourceId" source:"query"`
+}
 
 // Validate a GetBookingsActivityReport. Returns a ValidationError for each requirement that fails.
 func (r GetBookingsActivityReportRequest) Validate() []ValidationError {
-	// insert validation logic here
-	return nil
+	errs := validateReportRequest(r.From, r.To, r.ResourceID, 0)
+	if r.From != nil && r.To != nil && r.To.Sub(*r.From) > maxActivityReportWindow {
+		errs = append(errs, ValidationError{Name: "to", Reason: "Must be no more than 366 days after 'from'"})
+	}
+	return errs
 }
 
 // GetBookingsActivityReportResponse represents a response returned by the GetBookingsActivityReport method of a ReportService.
 type GetBookingsActivityReportResponse struct {
-	Err error `json:"err,omitempty"`
+	Days []*ActivityReportRow `json:"days"`
+	Err  error                `json:"err,omitempty"`
 }
 
 // Error implements the errorer interface. Returns property Err from the response.
 func (r GetBookingsActivityReportResponse) Error() error { return r.Err }
 
 // GetTodaysBookingsReportRequest represents a payload used by the GetTodaysBookingsReport method of a ReportService
-type GetTodaysBookingsReportRequest struct{}
+type GetTodaysBookingsReportRequest struct {
+	// Filtering fields. Defaults to the start and end of the current day in UTC.
+	From       *time.Time `json:"from" source:"query"`
+	To         *time.Time `json:"to" source:"query"`
+	ResourceID *int       `json:"resourceId" source:"query"`
+}
 
 // Validate a GetTodaysBookingsReport. Returns a ValidationError for each requirement that fails.
 func (r GetTodaysBookingsReportRequest) Validate() []ValidationError {
-	// insert validation logic here
-	return nil
+	return validateReportRequest(r.From, r.To, r.ResourceID, 0)
 }
 
 // GetTodaysBookingsReportResponse represents a response returned by the GetTodaysBookingsReport method of a ReportService.
 type GetTodaysBookingsReportResponse struct {
-	Err error `json:"err,omitempty"`
+	Bookings []*BookingReportRow `json:"bookings"`
+	Err      error               `json:"err,omitempty"`
 }
 
 // Error implements the errorer interface. Returns property Err from the response.
 func (r GetTodaysBookingsReportResponse) Error() error { return r.Err }
 
 // GetTopResourcesReportRequest represents a payload used by the GetTopResourcesReport method of a ReportService
-type GetTopResourcesReportRequest struct { // insert request properties here
+type GetTopResourcesReportRequest struct {
+	// Filtering fields. Defaults to the last 30 days.
+	From       *time.Time `json:"from" source:"query"`
+	To         *time.Time `json:"to" source:"query"`
+	ResourceID *int       `json:"resourceId" source:"query"`
+
+	// The maximum number of resources to return.
+	Limit int `json:"limit" source:"query"`
 }
 
 // Validate a GetTopResourcesReport. Returns a ValidationError for each requirement that fails.
 func (r GetTopResourcesReportRequest) Validate() []ValidationError {
-	// insert validation logic here
-	return nil
+	return validateReportRequest(r.From, r.To, r.ResourceID, r.Limit)
 }
 
 // GetTopResourcesReportResponse represents a response returned by the GetTopResourcesReport method of a ReportService.
 type GetTopResourcesReportResponse struct {
-	Err error `json:"err,omitempty"`
+	Resources []*TopResourceReportRow `json:"resources"`
+	Err       error                   `json:"err,omitempty"`
 }
 
 // Error implements the errorer interface. Returns property Err from the response.
 func (r GetTopResourcesReportResponse) Error() error { return r.Err }
 
 // GetTopEmployeesReportRequest represents a payload used by the GetTopEmployeesReport method of a ReportService
-type GetTopEmployeesReportRequest struct { // insert request properties here
+type GetTopEmployeesReportRequest struct {
+	// Filtering fields. Defaults to the last 30 days.
+	From       *time.Time `json:"from" source:"query"`
+	To         *time.Time `json:"to" source:"query"`
+	ResourceID *int       `json:"resourceId" source:"query"`
+
+	// The maximum number of employees to return.
+	Limit int `json:"limit" source:"query"`
 }
 
 // Validate a GetTopEmployeesReport. Returns a ValidationError for each requirement that fails.
 func (r GetTopEmployeesReportRequest) Validate() []ValidationError {
-	// insert validation logic here
-	return nil
+	return validateReportRequest(r.From, r.To, r.ResourceID, r.Limit)
 }
 
 // GetTopEmployeesReportResponse represents a response returned by the GetTopEmployeesReport method of a ReportService.
 type GetTopEmployeesReportResponse struct {
-	Err error `json:"err,omitempty"`
+	Employees []*TopEmployeeReportRow `json:"employees"`
+	Err       error                   `json:"err,omitempty"`
 }
 
 // Error implements the errorer interface. Returns property Err from the response.
 func (r GetTopEmployeesReportResponse) Error() error { return r.Err }
 
+// validateReportRequest validates the filtering fields shared by every report.
+func validateReportRequest(from, to *time.Time, resourceID *int, limit int) []ValidationError {
+	errs := make([]ValidationError, 0)
+	if from != nil && to != nil && !to.After(*from) {
+		errs = append(errs, ValidationError{Name: "to", Reason: "Must be later than 'from'"})
+	}
+	if resourceID != nil && *resourceID < 1 {
+		errs = append(errs, ValidationError{Name: "resourceId", Reason: "Must be at least 1"})
+	}
+	if limit < 0 {
+		errs = append(errs, ValidationError{Name: "limit", Reason: "Must be greater than or equal to 0"})
+	}
+	return errs
+}
+
 // ReportServiceMiddleware defines a middleware for ReportService
 type ReportServiceMiddleware func(service ReportService) ReportService
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 )
 
@@ -25,18 +26,43 @@ type Resource struct {
 	// indicate when a resource can be booked.
 	Slots []*Slot `json:"slots,omitempty"`
 
-	// The timezone of the resource as a UTC offset. e.g. UTC+00:00
+	// The IANA name of the timezone of the resource, e.g. Europe/London.
+	// Resources created before IANA names were supported may still use a fixed
+	// UTC offset such as UTC+01:00.
 	Timezone string `json:"timezone"`
 
-	// A password to protect the resource. Used to prevent bookings from being
-	// made by unauthorized users.
-	Password string `json:"password"`
+	// Whether customers need a password to book the resource through the
+	// public API. The password itself is only stored as a hash and is never
+	// returned.
+	PasswordProtected bool `json:"passwordProtected"`
 
 	// The price of the resource to the customer
-	Price int `json:"price"`
+	Price Money `json:"price"`
 
 	// The upfront price that needs to be paid by the customer in order to make a booking.
-	BookingPrice int `json:"bookingPrice"`
+	// Always in the same currency as Price.
+	BookingPrice Money `json:"bookingPrice"`
+
+	// The number of bookings that can be in progress for the resource at the
+	// same time. Nil if there is no limit.
+	QuantityAvailable *int `json:"quantityAvailable"`
+
+	// The refunds and fees that apply when bookings of the resource are
+	// cancelled or their customer doesn't show up. Nil if bookings can be
+	// cancelled without charge and nothing is refunded.
+	CancellationPolicy *CancellationPolicy `json:"cancellationPolicy"`
+
+	// How the price of a booking is worked out from Price. Nil if every
+	// booking is charged Price.
+	Pricing *PricingRules `json:"pricing"`
+
+	// The custom fields that customers fill in when they book the resource.
+	// Their values are stored in the metadata of bookings.
+	BookingFields BookingFields `json:"bookingFields"`
+
+	// The tax rate charged on bookings of the resource. Nil if the default
+	// tax rate of the organization applies.
+	TaxRateID *int `json:"taxRateId"`
 
 	// Timestamps for booking creation and last update.
 	CreatedAt time.Time `json:"createdAt"`
@@ -60,6 +86,32 @@ type Slot struct {
 	Quantity *int `json:"quantity"`
 }
 
+// Location returns the time.Location described by the resource's timezone.
+// Returns an error if the timezone is not a valid IANA timezone or a legacy
+// offset in the format UTC±HH:MM.
+func (r *Resource) Location() (*time.Location, error) {
+	return LoadTimezone(r.Timezone)
+}
+
+// Weekday returns the day of the week that the slot is for. The day is matched
+// case-insensitively against the English day names, e.g. "monday". Returns
+// false if the day is not recognised.
+func (s *Slot) Weekday() (time.Weekday, bool) {
+	return parseWeekday(s.Day)
+}
+
+// parseWeekday returns the day of the week named by day, matched
+// case-insensitively against the English day names. Returns false if the day
+// is not recognised.
+func parseWeekday(day string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(day, d.String()) {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
 // ResourceService represents a service for managing resources.
 type ResourceService interface {
 	// FindResourceByID retrieves a single resource by ID along with associated availabilities.
@@ -87,6 +139,13 @@ type ResourceService interface {
 	// resource. Returns ENOTFOUND if the resource does not exist or the user does not have
 	// permission to delete it.
 	DeleteResource(ctx context.Context, req DeleteResourceRequest) DeleteResourceResponse
+
+	// ImportResources creates a resource for each row of an import. Every row
+	// is validated like a single resource would be. Rows that fail are
+	// reported in the response unless the import is all or nothing, in which
+	// case nothing is created and EINVALID is returned with the errors of
+	// every row.
+	ImportResources(ctx context.Context, req ImportResourcesRequest) ImportResourcesResponse
 }
 
 // FindResourceByIDRequest represents a request used by ResourceService.FindResourceByID.
@@ -144,7 +203,7 @@ func (r FindResourcesRequest) Validate() []ValidationError {
 	if r.Limit < 0 {
 		errs = append(errs, ValidationError{Name: "limit", Reason: "Must be greater than or equal to 0"})
 	}
-	validOrderByValues := []string{"name", "description", "timezone", "password", "price", "bookingPrice", "createdAt", "updatedAt"}
+	validOrderByValues := []string{"name", "description", "timezone", "price", "bookingPrice", "createdAt", "updatedAt"}
 	if r.OrderBy != nil && !Strings(validOrderByValues).contains(*r.OrderBy) {
 		errs = append(errs, ValidationError{Name: "orderBy", Reason: "Must be a valid property name"})
 	}
@@ -168,9 +227,29 @@ type CreateResourceRequest struct {
 	Description  string  `json:"description" source:"json"`
 	Slots        []*Slot `json:"slots" source:"json"`
 	Timezone     string  `json:"timezone" source:"json"`
-	Password     string  `json:"password" source:"json"`
-	Price        int     `json:"price" source:"json"`
-	BookingPrice int     `json:"bookingPrice" source:"json"`
+	Price        Money   `json:"price" source:"json"`
+	BookingPrice Money   `json:"bookingPrice" source:"json"`
+
+	// The password that customers need to book the resource through the
+	// public API. Empty if the resource isn't protected.
+	Password string `json:"password" source:"json"`
+
+	// The number of bookings that can be in progress at the same time. Nil if
+	// there is no limit.
+	QuantityAvailable *int `json:"quantityAvailable" source:"json"`
+
+	// The refunds and fees that apply when bookings are cancelled.
+	CancellationPolicy *CancellationPolicy `json:"cancellationPolicy" source:"json"`
+
+	// The rules that the price of a booking is worked out with.
+	Pricing *PricingRules `json:"pricing" source:"json"`
+
+	// The custom fields that customers fill in when they book the resource.
+	BookingFields BookingFields `json:"bookingFields" source:"json"`
+
+	// The tax rate charged on bookings in place of the default tax rate of the
+	// organization.
+	TaxRateID *int `json:"taxRateId" source:"json"`
 }
 
 // Validate a CreateResourceRequest. Returns a ValidationError for each
@@ -183,17 +262,22 @@ func (r CreateResourceRequest) Validate() []ValidationError {
 	if len(r.Slots) == 0 {
 		errs = append(errs, ValidationError{Name: "slots", Reason: "Must contain at least one slot"})
 	}
-	if r.Price < 0 {
-		errs = append(errs, ValidationError{Name: "price", Reason: "Cannot be less than 0"})
+	errs = append(errs, validateResourcePrices(r.Price, r.BookingPrice)...)
+	errs = append(errs, validateResourcePassword(r.Password)...)
+	if r.BookingPrice.Amount > r.Price.Amount {
+		errs = append(errs, ValidationError{Name: "bookingPrice.amount", Reason: "Cannot be greater than price"})
 	}
-	if r.BookingPrice < 0 {
-		errs = append(errs, ValidationError{Name: "bookingPrice", Reason: "Cannot be less than 0"})
+	if !validTimezone(r.Timezone) {
+		errs = append(errs, ValidationError{Name: "timezone", Reason: "Must be a valid IANA timezone, e.g. Europe/London"})
 	}
-	if r.BookingPrice > r.Price {
-		errs = append(errs, ValidationError{Name: "bookingPrice", Reason: "Cannot be greater than price"})
+	if r.QuantityAvailable != nil && *r.QuantityAvailable < 1 {
+		errs = append(errs, ValidationError{Name: "quantityAvailable", Reason: "Must be at least 1"})
 	}
-	if !validTimezone(r.Timezone) {
-		errs = append(errs, ValidationError{Name: "timezone", Reason: "Must be valid timezone in the format UTC±HH:MM"})
+	errs = append(errs, r.CancellationPolicy.Validate("cancellationPolicy")...)
+	errs = append(errs, r.Pricing.Validate("pricing")...)
+	errs = append(errs, r.BookingFields.Validate("bookingFields")...)
+	if r.TaxRateID != nil && *r.TaxRateID < 1 {
+		errs = append(errs, ValidationError{Name: "taxRateId", Reason: "Must be at least 1"})
 	}
 	errs = append(errs, validateSlots(r.Slots)...)
 	return errs
@@ -215,10 +299,31 @@ type UpdateResourceRequest struct {
 	Name         string  `json:"name" source:"json"`
 	Description  string  `json:"description" source:"json"`
 	Timezone     string  `json:"timezone" source:"json"`
-	Password     string  `json:"password" source:"json"`
-	Price        int     `json:"price" source:"json"`
-	BookingPrice int     `json:"bookingPrice" source:"json"`
+	Price        Money   `json:"price" source:"json"`
+	BookingPrice Money   `json:"bookingPrice" source:"json"`
 	Slots        []*Slot `json:"slots" source:"json"`
+
+	// The new password that customers need to book the resource through the
+	// public API. Nil keeps the current password and an empty string removes
+	// it.
+	Password *string `json:"password" source:"json"`
+
+	// The number of bookings that can be in progress at the same time. Nil if
+	// there is no limit.
+	QuantityAvailable *int `json:"quantityAvailable" source:"json"`
+
+	// The refunds and fees that apply when bookings are cancelled.
+	CancellationPolicy *CancellationPolicy `json:"cancellationPolicy" source:"json"`
+
+	// The rules that the price of a booking is worked out with.
+	Pricing *PricingRules `json:"pricing" source:"json"`
+
+	// The custom fields that customers fill in when they book the resource.
+	BookingFields BookingFields `json:"bookingFields" source:"json"`
+
+	// The tax rate charged on bookings in place of the default tax rate of the
+	// organization.
+	TaxRateID *int `json:"taxRateId" source:"json"`
 }
 
 // Validate an UpdateResourceRequest. Returns a ValidationError for each
@@ -234,19 +339,50 @@ func (r UpdateResourceRequest) Validate() []ValidationError {
 	if len(r.Slots) == 0 {
 		errs = append(errs, ValidationError{Name: "slots", Reason: "Must contain at least one slot"})
 	}
-	if r.Price < 0 {
-		errs = append(errs, ValidationError{Name: "price", Reason: "Cannot be less than 0"})
-	}
-	if r.BookingPrice < 0 {
-		errs = append(errs, ValidationError{Name: "bookingPrice", Reason: "Cannot be less than 0"})
+	errs = append(errs, validateResourcePrices(r.Price, r.BookingPrice)...)
+	if r.Password != nil {
+		errs = append(errs, validateResourcePassword(*r.Password)...)
 	}
 	if !validTimezone(r.Timezone) {
-		errs = append(errs, ValidationError{Name: "timezone", Reason: "Must be valid timezone in the format UTC±HH:MM"})
+		errs = append(errs, ValidationError{Name: "timezone", Reason: "Must be a valid IANA timezone, e.g. Europe/London"})
+	}
+	if r.QuantityAvailable != nil && *r.QuantityAvailable < 1 {
+		errs = append(errs, ValidationError{Name: "quantityAvailable", Reason: "Must be at least 1"})
+	}
+	errs = append(errs, r.CancellationPolicy.Validate("cancellationPolicy")...)
+	errs = append(errs, r.Pricing.Validate("pricing")...)
+	errs = append(errs, r.BookingFields.Validate("bookingFields")...)
+	if r.TaxRateID != nil && *r.TaxRateID < 1 {
+		errs = append(errs, ValidationError{Name: "taxRateId", Reason: "Must be at least 1"})
 	}
 	errs = append(errs, validateSlots(r.Slots)...)
 	return errs
 }
 
+// validateResourcePrices validates the price and booking price of a resource.
+// The currencies may be left empty but must match if both are given as a
+// resource only has one currency.
+func validateResourcePrices(price, bookingPrice Money) []ValidationError {
+	errs := price.Validate("price")
+	errs = append(errs, bookingPrice.Validate("bookingPrice")...)
+	if price.Currency != "" && bookingPrice.Currency != "" && price.Currency != bookingPrice.Currency {
+		errs = append(errs, ValidationError{Name: "bookingPrice.currency", Reason: "Must be the same as the currency of price"})
+	}
+	return errs
+}
+
+// maxResourcePasswordLength is the length in bytes beyond which passwords
+// would be truncated when they are hashed.
+const maxResourcePasswordLength = 72
+
+// validateResourcePassword validates the password of a resource.
+func validateResourcePassword(password string) []ValidationError {
+	if len(password) > maxResourcePasswordLength {
+		return []ValidationError{{Name: "password", Reason: fmt.Sprintf("Must be at most %d bytes", maxResourcePasswordLength)}}
+	}
+	return nil
+}
+
 // UpdateResourcesResponse represents a response returned by the UpdateResource
 // method of a ResourceService.
 type UpdateResourceResponse struct {
@@ -280,6 +416,44 @@ type DeleteResourceResponse struct {
 // Error implements the errorer interface. Returns property Err from the response.
 func (r DeleteResourceResponse) Error() error { return r.Err }
 
+// ImportResourcesRequest represents a request used by ResourceService.ImportResources.
+type ImportResourcesRequest struct {
+	Rows []CreateResourceRequest `json:"rows" source:"json"`
+
+	// Roll back the whole import if any row fails.
+	AllOrNothing bool `json:"allOrNothing" source:"query"`
+}
+
+// Validate an ImportResourcesRequest. Returns a ValidationError for each
+// requirement that fails. Rows are validated individually as they are
+// imported.
+func (r ImportResourcesRequest) Validate() []ValidationError {
+	return validateImportRows(len(r.Rows))
+}
+
+// ResourceImportResult describes the outcome of importing a single row.
+type ResourceImportResult struct {
+	// The position of the row within the import, starting from 0.
+	Row int `json:"row"`
+
+	// The created resource. Nil if the row failed.
+	Resource *Resource `json:"resource,omitempty"`
+
+	Errors []ValidationError `json:"errors,omitempty"`
+}
+
+// ImportResourcesResponse represents a response returned by the
+// ImportResources method of a ResourceService.
+type ImportResourcesResponse struct {
+	Rows    []ResourceImportResult `json:"rows"`
+	Created int                    `json:"created"`
+	Failed  int                    `json:"failed"`
+	Err     error                  `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r ImportResourcesResponse) Error() error { return r.Err }
+
 // ResourceServiceMiddleware defines a middleware for a resource request handler.
 type ResourceServiceMiddleware func(service ResourceService) ResourceService
 
@@ -346,6 +520,17 @@ func (mw resourceValidationMiddleware) DeleteResource(ctx context.Context, req D
 	return mw.ResourceService.DeleteResource(ctx, req)
 }
 
+// ImportResources validates an ImportResourcesRequest. Forwards the request to
+// the next middleware or service if the request is valid and returns an error
+// otherwise.
+func (mw resourceValidationMiddleware) ImportResources(ctx context.Context, req ImportResourcesRequest) ImportResourcesResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return ImportResourcesResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.ResourceService.ImportResources(ctx, req)
+}
+
 // validateSlots checks the validity of a []*Slot. Returns a []ValidationError
 // containing a ValidationError for each issue found. Checks that startTime and
 // endTimes are in the correct format, that startTime is earlier than endTime,
@@ -355,6 +540,12 @@ func validateSlots(slots []*Slot) []ValidationError {
 	// Validate slots
 	// Check that they all have correct time format
 	for i, s := range slots {
+		if _, ok := s.Weekday(); !ok {
+			errParams = append(errParams, ValidationError{
+				Name:   fmt.Sprintf("slots[%d].day", i),
+				Reason: "Must be a valid day of the week",
+			})
+		}
 		timesAreValid := true
 		err := validateSlotTime(s.StartTime)
 		if err != nil {
@@ -0,0 +1,471 @@
+package booking
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Recurrence rule frequencies.
+const (
+	RRuleFreqDaily   = "DAILY"
+	RRuleFreqWeekly  = "WEEKLY"
+	RRuleFreqMonthly = "MONTHLY"
+	RRuleFreqYearly  = "YEARLY"
+)
+
+// rruleUntilLayout is the UTC form of an RFC 5545 DATE-TIME value.
+const rruleUntilLayout = "20060102T150405Z"
+
+// maxRRulePeriods limits how many periods are searched for occurrences so that
+// rules which rarely or never match, e.g. the 31st of February, terminate.
+const maxRRulePeriods = 10000
+
+var rruleWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// RRule represents a recurrence rule as defined by RFC 5545 section 3.3.10.
+// The FREQ, INTERVAL, COUNT, UNTIL, BYDAY, BYMONTHDAY and BYMONTH parts are
+// supported which covers the rules produced by common calendar clients.
+// Weeks always start on Monday.
+type RRule struct {
+	Freq     string
+	Interval int
+
+	// Only one of Count and Until may be set.
+	Count int
+	Until *time.Time
+
+	// An UNTIL value without a UTC time, which is in the timezone of the
+	// start of the rule and is only resolved once it is known. See UntilIn.
+	floatingUntil string
+
+	ByDay      []RRuleWeekday
+	ByMonthDay []int
+	ByMonth    []time.Month
+}
+
+// RRuleWeekday is a day of the week used in the BYDAY part of a rule. N
+// selects a specific occurrence of the weekday within the month, e.g. 2 for
+// the second Tuesday or -1 for the last Friday. Zero selects every occurrence.
+type RRuleWeekday struct {
+	N       int
+	Weekday time.Weekday
+}
+
+// ParseRRule parses the value of an RRULE property. The "RRULE:" prefix is
+// optional.
+func ParseRRule(s string) (*RRule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	if s == "" {
+		return nil, fmt.Errorf("rule is empty")
+	}
+
+	r := &RRule{Interval: 1}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(s, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid rule part '%s'", part)
+		}
+		name, value := strings.ToUpper(kv[0]), kv[1]
+		if seen[name] {
+			return nil, fmt.Errorf("%s must only be specified once", name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			r.Freq = strings.ToUpper(value)
+			switch r.Freq {
+			case RRuleFreqDaily, RRuleFreqWeekly, RRuleFreqMonthly, RRuleFreqYearly:
+			default:
+				err = fmt.Errorf("unsupported frequency '%s'", value)
+			}
+		case "INTERVAL":
+			r.Interval, err = parseRRuleInt(value, 1, 0)
+		case "COUNT":
+			r.Count, err = parseRRuleInt(value, 1, 0)
+		case "UNTIL":
+			var until time.Time
+			until, err = parseRRuleUntil(value, time.UTC)
+			if strings.HasSuffix(value, "Z") {
+				r.Until = &until
+			} else {
+				r.floatingUntil = value
+			}
+		case "BYDAY":
+			for _, v := range strings.Split(value, ",") {
+				var d RRuleWeekday
+				d, err = parseRRuleWeekday(v)
+				if err != nil {
+					break
+				}
+				r.ByDay = append(r.ByDay, d)
+			}
+		case "BYMONTHDAY":
+			for _, v := range strings.Split(value, ",") {
+				var d int
+				d, err = parseRRuleInt(v, -31, 31)
+				if err != nil {
+					break
+				}
+				if d == 0 {
+					err = fmt.Errorf("BYMONTHDAY must not be 0")
+					break
+				}
+				r.ByMonthDay = append(r.ByMonthDay, d)
+			}
+		case "BYMONTH":
+			for _, v := range strings.Split(value, ",") {
+				var m int
+				m, err = parseRRuleInt(v, 1, 12)
+				if err != nil {
+					break
+				}
+				r.ByMonth = append(r.ByMonth, time.Month(m))
+			}
+		case "WKST":
+			if strings.ToUpper(value) != "MO" {
+				err = fmt.Errorf("only WKST=MO is supported")
+			}
+		default:
+			err = fmt.Errorf("unsupported rule part '%s'", name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", name, err)
+		}
+	}
+
+	if r.Freq == "" {
+		return nil, fmt.Errorf("FREQ is required")
+	}
+	if r.Count != 0 && (r.Until != nil || r.floatingUntil != "") {
+		return nil, fmt.Errorf("COUNT and UNTIL must not both be specified")
+	}
+	for _, d := range r.ByDay {
+		if d.N == 0 {
+			continue
+		}
+		if r.Freq != RRuleFreqMonthly && (r.Freq != RRuleFreqYearly || len(r.ByMonth) == 0) {
+			return nil, fmt.Errorf("BYDAY offsets are only supported by MONTHLY rules and YEARLY rules with BYMONTH")
+		}
+	}
+	if len(r.ByMonthDay) > 0 && r.Freq == RRuleFreqWeekly {
+		return nil, fmt.Errorf("BYMONTHDAY is not supported by WEEKLY rules")
+	}
+	return r, nil
+}
+
+func parseRRuleInt(s string, min, max int) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("'%s' is not an integer", s)
+	}
+	if v < min || (max != 0 && v > max) {
+		return 0, fmt.Errorf("%d is out of range", v)
+	}
+	return v, nil
+}
+
+// parseRRuleUntil parses an UNTIL value. Values without a UTC time are in loc
+// and date only values include the whole day.
+func parseRRuleUntil(s string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse(rruleUntilLayout, s); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("20060102T150405", s); err == nil {
+		h, m, sec := t.Clock()
+		return LocalTime(t.Year(), t.Month(), t.Day(), h, m, sec, 0, loc), nil
+	}
+	if t, err := time.Parse("20060102", s); err == nil {
+		return LocalTime(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc).Add(-time.Second), nil
+	}
+	return time.Time{}, fmt.Errorf("'%s' is not a valid date or date-time", s)
+}
+
+func parseRRuleWeekday(s string) (RRuleWeekday, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if len(s) < 2 {
+		return RRuleWeekday{}, fmt.Errorf("'%s' is not a valid weekday", s)
+	}
+	wd, ok := rruleWeekdays[s[len(s)-2:]]
+	if !ok {
+		return RRuleWeekday{}, fmt.Errorf("'%s' is not a valid weekday", s)
+	}
+	d := RRuleWeekday{Weekday: wd}
+	if n := s[:len(s)-2]; n != "" {
+		v, err := parseRRuleInt(strings.TrimPrefix(n, "+"), -5, 5)
+		if err != nil || v == 0 {
+			return RRuleWeekday{}, fmt.Errorf("'%s' has an invalid offset", s)
+		}
+		d.N = v
+	}
+	return d, nil
+}
+
+// String returns the rule in RFC 5545 format without the "RRULE:" prefix.
+func (r *RRule) String() string {
+	parts := []string{"FREQ=" + r.Freq}
+	if r.Interval > 1 {
+		parts = append(parts, fmt.Sprintf("INTERVAL=%d", r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, fmt.Sprintf("COUNT=%d", r.Count))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(rruleUntilLayout))
+	} else if r.floatingUntil != "" {
+		parts = append(parts, "UNTIL="+r.floatingUntil)
+	}
+	if len(r.ByDay) > 0 {
+		var days []string
+		for _, d := range r.ByDay {
+			days = append(days, d.String())
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		var days []string
+		for _, d := range r.ByMonthDay {
+			days = append(days, strconv.Itoa(d))
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByMonth) > 0 {
+		var months []string
+		for _, m := range r.ByMonth {
+			months = append(months, strconv.Itoa(int(m)))
+		}
+		parts = append(parts, "BYMONTH="+strings.Join(months, ","))
+	}
+	return strings.Join(parts, ";")
+}
+
+// String returns the weekday in RFC 5545 format, e.g. "TU" or "-1FR".
+func (d RRuleWeekday) String() string {
+	for k, v := range rruleWeekdays {
+		if v == d.Weekday {
+			if d.N != 0 {
+				return strconv.Itoa(d.N) + k
+			}
+			return k
+		}
+	}
+	return ""
+}
+
+// Bounded returns true if the rule produces a finite number of occurrences.
+func (r *RRule) Bounded() bool {
+	return r.Count > 0 || r.Until != nil || r.floatingUntil != ""
+}
+
+// UntilIn returns the end of the rule for a rule that starts in loc, or nil if
+// it has no UNTIL part. UNTIL values without a UTC time are in loc.
+func (r *RRule) UntilIn(loc *time.Location) *time.Time {
+	if r.Until != nil || r.floatingUntil == "" {
+		return r.Until
+	}
+	until, err := parseRRuleUntil(r.floatingUntil, loc)
+	if err != nil {
+		return nil
+	}
+	return &until
+}
+
+// Occurrences returns the start times of the occurrences of the rule starting
+// from dtstart. As in RFC 5545 dtstart is always the first occurrence, even if
+// it doesn't match the rule, and counts towards COUNT. The occurrences have
+// the same wall clock time as dtstart in its location. At most max
+// occurrences are returned, the second return value is false if there were
+// more.
+func (r *RRule) Occurrences(dtstart time.Time, max int) ([]time.Time, bool) {
+	until := r.UntilIn(dtstart.Location())
+	if until != nil && dtstart.After(*until) {
+		return nil, true
+	}
+	if max < 1 {
+		return nil, false
+	}
+	result := []time.Time{dtstart}
+	if r.Count == 1 {
+		return result, true
+	}
+	for period := 0; period < maxRRulePeriods; period++ {
+		candidates := r.candidates(dtstart, period)
+		for _, t := range candidates {
+			if !t.After(dtstart) {
+				continue
+			}
+			if until != nil && t.After(*until) {
+				return result, true
+			}
+			if len(result) == max {
+				return result, false
+			}
+			result = append(result, t)
+			if r.Count > 0 && len(result) == r.Count {
+				return result, true
+			}
+		}
+	}
+	return result, !r.Bounded()
+}
+
+// candidates returns the sorted occurrences of the rule within the nth period
+// after the one containing dtstart, ignoring COUNT and UNTIL.
+func (r *RRule) candidates(dtstart time.Time, n int) []time.Time {
+	loc := dtstart.Location()
+	h, m, s := dtstart.Clock()
+	at := func(y int, mo time.Month, d int) time.Time {
+		return LocalTime(y, mo, d, h, m, s, dtstart.Nanosecond(), loc)
+	}
+	step := n * r.interval()
+
+	var days []time.Time
+	switch r.Freq {
+	case RRuleFreqDaily:
+		days = []time.Time{at(dtstart.Year(), dtstart.Month(), dtstart.Day()+step)}
+	case RRuleFreqWeekly:
+		// Weeks start on Monday.
+		offset := (int(dtstart.Weekday()) + 6) % 7
+		monday := at(dtstart.Year(), dtstart.Month(), dtstart.Day()-offset+7*step)
+		weekdays := []RRuleWeekday{{Weekday: dtstart.Weekday()}}
+		if len(r.ByDay) > 0 {
+			weekdays = r.ByDay
+		}
+		for _, wd := range weekdays {
+			days = append(days, at(monday.Year(), monday.Month(), monday.Day()+(int(wd.Weekday)+6)%7))
+		}
+	case RRuleFreqMonthly:
+		first := time.Date(dtstart.Year(), dtstart.Month()+time.Month(step), 1, 0, 0, 0, 0, loc)
+		days = r.monthDays(first.Year(), first.Month(), dtstart, at)
+	case RRuleFreqYearly:
+		months := []time.Month{dtstart.Month()}
+		if len(r.ByMonth) > 0 {
+			months = r.ByMonth
+		}
+		for _, mo := range months {
+			days = append(days, r.monthDays(dtstart.Year()+step, mo, dtstart, at)...)
+		}
+	}
+
+	var result []time.Time
+	seen := make(map[int64]bool)
+	for _, d := range days {
+		if !r.matches(d) || seen[d.UnixNano()] {
+			continue
+		}
+		seen[d.UnixNano()] = true
+		result = append(result, d)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Before(result[j]) })
+	return result
+}
+
+// monthDays returns the days within a month selected by the BYMONTHDAY and
+// BYDAY parts. If neither is set the day of the month of dtstart is used,
+// which is skipped in months that are too short.
+func (r *RRule) monthDays(year int, month time.Month, dtstart time.Time, at func(int, time.Month, int) time.Time) []time.Time {
+	daysIn := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	var days []time.Time
+	switch {
+	case len(r.ByMonthDay) > 0:
+		for _, d := range r.ByMonthDay {
+			if d < 0 {
+				d = daysIn + d + 1
+			}
+			if d >= 1 && d <= daysIn {
+				days = append(days, at(year, month, d))
+			}
+		}
+	case len(r.ByDay) > 0:
+		for _, wd := range r.ByDay {
+			var matching []int
+			for d := 1; d <= daysIn; d++ {
+				if time.Date(year, month, d, 0, 0, 0, 0, time.UTC).Weekday() == wd.Weekday {
+					matching = append(matching, d)
+				}
+			}
+			switch {
+			case wd.N == 0:
+				for _, d := range matching {
+					days = append(days, at(year, month, d))
+				}
+			case wd.N > 0 && wd.N <= len(matching):
+				days = append(days, at(year, month, matching[wd.N-1]))
+			case wd.N < 0 && -wd.N <= len(matching):
+				days = append(days, at(year, month, matching[len(matching)+wd.N]))
+			}
+		}
+	default:
+		if dtstart.Day() <= daysIn {
+			days = append(days, at(year, month, dtstart.Day()))
+		}
+	}
+	return days
+}
+
+// matches returns true if t satisfies the filtering parts of the rule.
+func (r *RRule) matches(t time.Time) bool {
+	if len(r.ByMonth) > 0 && !containsMonth(r.ByMonth, t.Month()) {
+		return false
+	}
+	if len(r.ByDay) > 0 && r.Freq == RRuleFreqDaily {
+		ok := false
+		for _, wd := range r.ByDay {
+			ok = ok || wd.Weekday == t.Weekday()
+		}
+		if !ok {
+			return false
+		}
+	}
+	if len(r.ByMonthDay) > 0 && (r.Freq == RRuleFreqDaily) {
+		daysIn := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+		ok := false
+		for _, d := range r.ByMonthDay {
+			ok = ok || d == t.Day() || daysIn+d+1 == t.Day()
+		}
+		if !ok {
+			return false
+		}
+	}
+	// BYDAY limits the days selected by BYMONTHDAY in monthly and yearly rules.
+	if len(r.ByDay) > 0 && len(r.ByMonthDay) > 0 && r.Freq != RRuleFreqDaily {
+		ok := false
+		for _, wd := range r.ByDay {
+			ok = ok || wd.Weekday == t.Weekday()
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *RRule) interval() int {
+	if r.Interval < 1 {
+		return 1
+	}
+	return r.Interval
+}
+
+func containsMonth(months []time.Month, m time.Month) bool {
+	for _, v := range months {
+		if v == m {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,301 @@
+package booking
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("failed to load location %s: %v", name, err)
+	}
+	return loc
+}
+
+// formatTimes formats times in their own location so that tests can compare
+// both the wall clock time and the offset.
+func formatTimes(times []time.Time) string {
+	var s []string
+	for _, t := range times {
+		s = append(s, t.Format(time.RFC3339))
+	}
+	return strings.Join(s, " ")
+}
+
+func TestParseRRule(t *testing.T) {
+	tests := []struct {
+		rule string
+		want string
+		err  string
+	}{
+		{rule: "RRULE:FREQ=WEEKLY;BYDAY=TU,TH;COUNT=10", want: "FREQ=WEEKLY;COUNT=10;BYDAY=TU,TH"},
+		{rule: "FREQ=MONTHLY;BYDAY=2TU,-1FR", want: "FREQ=MONTHLY;BYDAY=2TU,-1FR"},
+		{rule: "FREQ=MONTHLY;BYDAY=+1MO", want: "FREQ=MONTHLY;BYDAY=1MO"},
+		{rule: "FREQ=MONTHLY;BYMONTHDAY=-1;INTERVAL=2", want: "FREQ=MONTHLY;INTERVAL=2;BYMONTHDAY=-1"},
+		{rule: "FREQ=YEARLY;BYMONTH=3;BYDAY=-1SU", want: "FREQ=YEARLY;BYDAY=-1SU;BYMONTH=3"},
+		{rule: "FREQ=DAILY;UNTIL=20210301T120000Z", want: "FREQ=DAILY;UNTIL=20210301T120000Z"},
+		{rule: "FREQ=DAILY;UNTIL=20210301T120000", want: "FREQ=DAILY;UNTIL=20210301T120000"},
+		{rule: "FREQ=DAILY;UNTIL=20210301", want: "FREQ=DAILY;UNTIL=20210301"},
+		{rule: "freq=daily;wkst=MO", want: "FREQ=DAILY"},
+		{rule: "", err: "rule is empty"},
+		{rule: "COUNT=3", err: "FREQ is required"},
+		{rule: "FREQ=HOURLY", err: "unsupported frequency"},
+		{rule: "FREQ=DAILY;COUNT=0", err: "invalid COUNT"},
+		{rule: "FREQ=DAILY;COUNT=2;COUNT=3", err: "must only be specified once"},
+		{rule: "FREQ=DAILY;COUNT=2;UNTIL=20210301", err: "must not both be specified"},
+		{rule: "FREQ=DAILY;UNTIL=2021-03-01", err: "invalid UNTIL"},
+		{rule: "FREQ=WEEKLY;BYDAY=1TU", err: "BYDAY offsets"},
+		{rule: "FREQ=MONTHLY;BYDAY=6TU", err: "invalid BYDAY"},
+		{rule: "FREQ=MONTHLY;BYDAY=XX", err: "invalid BYDAY"},
+		{rule: "FREQ=MONTHLY;BYMONTHDAY=0", err: "invalid BYMONTHDAY"},
+		{rule: "FREQ=WEEKLY;BYMONTHDAY=1", err: "not supported by WEEKLY"},
+		{rule: "FREQ=DAILY;WKST=SU", err: "only WKST=MO"},
+		{rule: "FREQ=DAILY;BYSETPOS=1", err: "unsupported rule part"},
+	}
+	for _, tt := range tests {
+		r, err := ParseRRule(tt.rule)
+		if tt.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("ParseRRule(%q) returned %v, want error containing %q", tt.rule, err, tt.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseRRule(%q) returned %v", tt.rule, err)
+			continue
+		}
+		if got := r.String(); got != tt.want {
+			t.Errorf("ParseRRule(%q) is %q, want %q", tt.rule, got, tt.want)
+		}
+	}
+}
+
+func TestRRule_Occurrences(t *testing.T) {
+	nz := mustLoadLocation(t, "Pacific/Auckland")
+	ny := mustLoadLocation(t, "America/New_York")
+
+	tests := []struct {
+		name    string
+		rule    string
+		dtstart time.Time
+		max     int
+		want    string
+		// False if the occurrences should have been cut off by max.
+		complete bool
+	}{
+		{
+			name:     "BYDAY with ordinals",
+			rule:     "FREQ=MONTHLY;BYDAY=2TU,-1FR;COUNT=5",
+			dtstart:  time.Date(2021, 1, 12, 10, 0, 0, 0, time.UTC),
+			max:      10,
+			want:     "2021-01-12T10:00:00Z 2021-01-29T10:00:00Z 2021-02-09T10:00:00Z 2021-02-26T10:00:00Z 2021-03-09T10:00:00Z",
+			complete: true,
+		},
+		{
+			name:     "last day of the month",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=4",
+			dtstart:  time.Date(2020, 1, 31, 9, 0, 0, 0, time.UTC),
+			max:      10,
+			want:     "2020-01-31T09:00:00Z 2020-02-29T09:00:00Z 2020-03-31T09:00:00Z 2020-04-30T09:00:00Z",
+			complete: true,
+		},
+		{
+			name:     "days missing from short months are skipped",
+			rule:     "FREQ=MONTHLY;COUNT=3",
+			dtstart:  time.Date(2021, 1, 31, 9, 0, 0, 0, time.UTC),
+			max:      10,
+			want:     "2021-01-31T09:00:00Z 2021-03-31T09:00:00Z 2021-05-31T09:00:00Z",
+			complete: true,
+		},
+		{
+			name:     "UTC UNTIL is inclusive",
+			rule:     "FREQ=DAILY;UNTIL=20210303T090000Z",
+			dtstart:  time.Date(2021, 3, 1, 9, 0, 0, 0, time.UTC),
+			max:      10,
+			want:     "2021-03-01T09:00:00Z 2021-03-02T09:00:00Z 2021-03-03T09:00:00Z",
+			complete: true,
+		},
+		{
+			// 09:00 in Auckland is the previous evening in UTC, so an UNTIL
+			// parsed as UTC would include another day.
+			name:     "floating UNTIL is in the location of dtstart",
+			rule:     "FREQ=DAILY;UNTIL=20210303T080000",
+			dtstart:  time.Date(2021, 3, 1, 9, 0, 0, 0, nz),
+			max:      10,
+			want:     "2021-03-01T09:00:00+13:00 2021-03-02T09:00:00+13:00",
+			complete: true,
+		},
+		{
+			name:     "date UNTIL includes the day in the location of dtstart",
+			rule:     "FREQ=DAILY;UNTIL=20210302",
+			dtstart:  time.Date(2021, 3, 1, 23, 0, 0, 0, ny),
+			max:      10,
+			want:     "2021-03-01T23:00:00-05:00 2021-03-02T23:00:00-05:00",
+			complete: true,
+		},
+		{
+			name:     "UNTIL before dtstart",
+			rule:     "FREQ=DAILY;UNTIL=20210228T090000Z",
+			dtstart:  time.Date(2021, 3, 1, 9, 0, 0, 0, time.UTC),
+			max:      10,
+			complete: true,
+		},
+		{
+			name:     "dtstart that doesn't match the rule is the first occurrence",
+			rule:     "FREQ=WEEKLY;BYDAY=TU;COUNT=3",
+			dtstart:  time.Date(2021, 3, 1, 9, 0, 0, 0, time.UTC),
+			max:      10,
+			want:     "2021-03-01T09:00:00Z 2021-03-02T09:00:00Z 2021-03-09T09:00:00Z",
+			complete: true,
+		},
+		{
+			name:     "COUNT=1 is only dtstart",
+			rule:     "FREQ=WEEKLY;BYDAY=TU;COUNT=1",
+			dtstart:  time.Date(2021, 3, 1, 9, 0, 0, 0, time.UTC),
+			max:      10,
+			want:     "2021-03-01T09:00:00Z",
+			complete: true,
+		},
+		{
+			name:     "week with a DST change keeps the wall clock time",
+			rule:     "FREQ=WEEKLY;BYDAY=SA,SU,MO;COUNT=6",
+			dtstart:  time.Date(2021, 3, 13, 9, 0, 0, 0, ny),
+			max:      10,
+			want:     "2021-03-13T09:00:00-05:00 2021-03-14T09:00:00-04:00 2021-03-15T09:00:00-04:00 2021-03-20T09:00:00-04:00 2021-03-21T09:00:00-04:00 2021-03-22T09:00:00-04:00",
+			complete: true,
+		},
+		{
+			name:     "daily across the end of DST",
+			rule:     "FREQ=DAILY;INTERVAL=2;COUNT=3",
+			dtstart:  time.Date(2021, 11, 5, 18, 30, 0, 0, ny),
+			max:      10,
+			want:     "2021-11-05T18:30:00-04:00 2021-11-07T18:30:00-05:00 2021-11-09T18:30:00-05:00",
+			complete: true,
+		},
+		{
+			name:     "yearly with BYMONTH and ordinal",
+			rule:     "FREQ=YEARLY;BYMONTH=3;BYDAY=-1SU;COUNT=3",
+			dtstart:  time.Date(2021, 3, 28, 10, 0, 0, 0, time.UTC),
+			max:      10,
+			want:     "2021-03-28T10:00:00Z 2022-03-27T10:00:00Z 2023-03-26T10:00:00Z",
+			complete: true,
+		},
+		{
+			name:     "cut off by max",
+			rule:     "FREQ=DAILY;COUNT=5",
+			dtstart:  time.Date(2021, 3, 1, 9, 0, 0, 0, time.UTC),
+			max:      2,
+			want:     "2021-03-01T09:00:00Z 2021-03-02T09:00:00Z",
+			complete: false,
+		},
+		{
+			name:     "unbounded",
+			rule:     "FREQ=DAILY",
+			dtstart:  time.Date(2021, 3, 1, 9, 0, 0, 0, time.UTC),
+			max:      2,
+			want:     "2021-03-01T09:00:00Z 2021-03-02T09:00:00Z",
+			complete: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := ParseRRule(tt.rule)
+			if err != nil {
+				t.Fatalf("failed to parse rule: %v", err)
+			}
+			got, complete := r.Occurrences(tt.dtstart, tt.max)
+			if s := formatTimes(got); s != tt.want {
+				t.Errorf("occurrences are %q, want %q", s, tt.want)
+			}
+			if complete != tt.complete {
+				t.Errorf("complete is %t, want %t", complete, tt.complete)
+			}
+		})
+	}
+}
+
+func TestRRule_candidates(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+
+	tests := []struct {
+		name    string
+		rule    string
+		dtstart time.Time
+		period  int
+		want    string
+	}{
+		{
+			// Weeks start on Monday so the Sunday before dtstart is in the
+			// same period.
+			name:    "weekly BYDAY",
+			rule:    "FREQ=WEEKLY;BYDAY=SU,WE",
+			dtstart: time.Date(2021, 3, 3, 9, 0, 0, 0, time.UTC),
+			want:    "2021-03-03T09:00:00Z 2021-03-07T09:00:00Z",
+		},
+		{
+			name:    "weekly with an interval",
+			rule:    "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO",
+			dtstart: time.Date(2021, 3, 3, 9, 0, 0, 0, time.UTC),
+			period:  1,
+			want:    "2021-03-15T09:00:00Z",
+		},
+		{
+			name:    "week with a DST change",
+			rule:    "FREQ=WEEKLY;BYDAY=SA,SU",
+			dtstart: time.Date(2021, 3, 1, 9, 0, 0, 0, ny),
+			want:    "2021-03-06T09:00:00-05:00 2021-03-07T09:00:00-05:00",
+		},
+		{
+			name:    "week after a DST change",
+			rule:    "FREQ=WEEKLY;BYDAY=SA,SU",
+			dtstart: time.Date(2021, 3, 8, 9, 0, 0, 0, ny),
+			want:    "2021-03-13T09:00:00-05:00 2021-03-14T09:00:00-04:00",
+		},
+		{
+			name:    "monthly BYDAY with ordinals",
+			rule:    "FREQ=MONTHLY;BYDAY=-1FR,1MO",
+			dtstart: time.Date(2021, 1, 1, 9, 0, 0, 0, time.UTC),
+			period:  1,
+			want:    "2021-02-01T09:00:00Z 2021-02-26T09:00:00Z",
+		},
+		{
+			name:    "monthly fifth weekday missing",
+			rule:    "FREQ=MONTHLY;BYDAY=5MO",
+			dtstart: time.Date(2021, 2, 1, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "monthly BYMONTHDAY limited by BYDAY",
+			rule:    "FREQ=MONTHLY;BYMONTHDAY=13;BYDAY=FR",
+			dtstart: time.Date(2021, 1, 1, 9, 0, 0, 0, time.UTC),
+			period:  7,
+			want:    "2021-08-13T09:00:00Z",
+		},
+		{
+			name:    "monthly last day",
+			rule:    "FREQ=MONTHLY;BYMONTHDAY=-1,1",
+			dtstart: time.Date(2021, 1, 1, 9, 0, 0, 0, time.UTC),
+			period:  1,
+			want:    "2021-02-01T09:00:00Z 2021-02-28T09:00:00Z",
+		},
+		{
+			name:    "daily filtered by BYDAY",
+			rule:    "FREQ=DAILY;BYDAY=MO",
+			dtstart: time.Date(2021, 3, 1, 9, 0, 0, 0, time.UTC),
+			period:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := ParseRRule(tt.rule)
+			if err != nil {
+				t.Fatalf("failed to parse rule: %v", err)
+			}
+			if got := formatTimes(r.candidates(tt.dtstart, tt.period)); got != tt.want {
+				t.Errorf("candidates are %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,324 @@
+// Package stripe takes payments through the Stripe API. Payments are modelled
+// as Stripe payment intents and their outcome is reported by Stripe's webhook
+// events, which are verified with the endpoint's signing secret.
+package stripe
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openmesh/booking"
+)
+
+// ProviderName is the name that payments made through Stripe are stored under.
+const ProviderName = "stripe"
+
+// Default settings of a PaymentProvider.
+const (
+	DefaultURL       = "https://api.stripe.com"
+	DefaultTimeout   = 30 * time.Second
+	DefaultTolerance = 5 * time.Minute
+)
+
+// SignatureHeader contains the signature of a webhook event.
+const SignatureHeader = "Stripe-Signature"
+
+// maxResponseBodySize limits the size of API responses that are read.
+const maxResponseBodySize = 1 << 20
+
+// Ensure type implements interface.
+var _ booking.PaymentProvider = (*PaymentProvider)(nil)
+
+// PaymentProvider takes payments through the Stripe API.
+type PaymentProvider struct {
+	// Secret API key of the Stripe account.
+	SecretKey string
+
+	// Signing secret of the webhook endpoint that events are sent to.
+	WebhookSecret string
+
+	// Base URL of the Stripe API.
+	URL string
+
+	// Client used to call the API.
+	Client *http.Client
+
+	// How old a webhook event can be before it is rejected so that captured
+	// events can't be replayed.
+	Tolerance time.Duration
+
+	// Returns the current time. Can be replaced in tests.
+	Now func() time.Time
+}
+
+// NewPaymentProvider returns a new instance of PaymentProvider with default
+// settings.
+func NewPaymentProvider(secretKey, webhookSecret string) *PaymentProvider {
+	return &PaymentProvider{
+		SecretKey:     secretKey,
+		WebhookSecret: webhookSecret,
+		URL:           DefaultURL,
+		Client:        &http.Client{Timeout: DefaultTimeout},
+		Tolerance:     DefaultTolerance,
+		Now:           time.Now,
+	}
+}
+
+// Name returns ProviderName.
+func (p *PaymentProvider) Name() string { return ProviderName }
+
+// paymentIntent is the subset of a Stripe payment intent that is used.
+type paymentIntent struct {
+	ID               string `json:"id"`
+	Status           string `json:"status"`
+	Amount           int    `json:"amount"`
+	ClientSecret     string `json:"client_secret"`
+	LastPaymentError *struct {
+		Message string `json:"message"`
+	} `json:"last_payment_error"`
+}
+
+func (pi *paymentIntent) toModel() *booking.PaymentIntent {
+	return &booking.PaymentIntent{
+		ID:           pi.ID,
+		Status:       paymentStatus(pi.Status),
+		Amount:       pi.Amount,
+		ClientSecret: pi.ClientSecret,
+	}
+}
+
+// paymentStatus translates the status of a Stripe payment intent into a
+// payment status.
+func paymentStatus(status string) string {
+	switch status {
+	case "requires_capture":
+		return booking.PaymentStatusAuthorized
+	case "succeeded":
+		return booking.PaymentStatusSucceeded
+	case "canceled":
+		return booking.PaymentStatusCancelled
+	default:
+		// The customer still has to act or the payment is processing.
+		return booking.PaymentStatusPending
+	}
+}
+
+// CreatePaymentIntent creates a payment intent that the customer completes
+// with the client secret.
+func (p *PaymentProvider) CreatePaymentIntent(ctx context.Context, params booking.PaymentIntentParams) (*booking.PaymentIntent, error) {
+	form := url.Values{}
+	form.Set("amount", strconv.Itoa(params.Amount))
+	form.Set("currency", strings.ToLower(params.Currency))
+	form.Set("automatic_payment_methods[enabled]", "true")
+	if params.Description != "" {
+		form.Set("description", params.Description)
+	}
+	for k, v := range params.Metadata {
+		form.Set("metadata["+k+"]", v)
+	}
+	if params.CaptureManually {
+		form.Set("capture_method", "manual")
+	}
+
+	var pi paymentIntent
+	if err := p.post(ctx, "/v1/payment_intents", form, params.IdempotencyKey, &pi); err != nil {
+		return nil, err
+	}
+	return pi.toModel(), nil
+}
+
+// CapturePaymentIntent captures amount of an authorized payment intent.
+func (p *PaymentProvider) CapturePaymentIntent(ctx context.Context, id string, amount int) (*booking.PaymentIntent, error) {
+	form := url.Values{}
+	form.Set("amount_to_capture", strconv.Itoa(amount))
+
+	var pi paymentIntent
+	if err := p.post(ctx, "/v1/payment_intents/"+url.PathEscape(id)+"/capture", form, "", &pi); err != nil {
+		return nil, err
+	}
+	return pi.toModel(), nil
+}
+
+// RefundPayment refunds amount of a payment intent that succeeded.
+func (p *PaymentProvider) RefundPayment(ctx context.Context, id string, amount int, idempotencyKey string) (*booking.PaymentRefund, error) {
+	form := url.Values{}
+	form.Set("payment_intent", id)
+	form.Set("amount", strconv.Itoa(amount))
+
+	var r struct {
+		ID     string `json:"id"`
+		Amount int    `json:"amount"`
+	}
+	if err := p.post(ctx, "/v1/refunds", form, idempotencyKey, &r); err != nil {
+		return nil, err
+	}
+	return &booking.PaymentRefund{ID: r.ID, Amount: r.Amount}, nil
+}
+
+// apiError is the body of an unsuccessful API response.
+type apiError struct {
+	Error struct {
+		Type    string `json:"type"`
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// post sends a form to an API endpoint and decodes the response into v.
+func (p *PaymentProvider) post(ctx context.Context, path string, form url.Values, idempotencyKey string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.URL+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(p.SecretKey, "")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("stripe: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize))
+	if err != nil {
+		return fmt.Errorf("stripe: failed to read response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var e apiError
+		if err := json.Unmarshal(body, &e); err != nil || e.Error.Message == "" {
+			return fmt.Errorf("stripe: unexpected status %d", resp.StatusCode)
+		}
+		return fmt.Errorf("stripe: %s", e.Error.Message)
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("stripe: failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// event is the subset of a Stripe webhook event that is used.
+type event struct {
+	Type string `json:"type"`
+	Data struct {
+		Object json.RawMessage `json:"object"`
+	} `json:"data"`
+}
+
+// charge is the subset of a Stripe charge that is used.
+type charge struct {
+	PaymentIntent  string `json:"payment_intent"`
+	AmountRefunded int    `json:"amount_refunded"`
+	Refunded       bool   `json:"refunded"`
+}
+
+// VerifyPaymentWebhook checks the signature of a webhook event and returns
+// the change to a payment intent that it describes. Events that aren't about
+// payment intents or refunds are ignored.
+func (p *PaymentProvider) VerifyPaymentWebhook(payload []byte, headers map[string][]string) (*booking.PaymentWebhookEvent, error) {
+	if err := p.verify(payload, http.Header(headers).Get(SignatureHeader)); err != nil {
+		return nil, booking.Errorf(booking.EUNAUTHORIZED, "Invalid Stripe signature: %s.", err)
+	}
+
+	var e event
+	if err := json.Unmarshal(payload, &e); err != nil {
+		return nil, booking.Errorf(booking.EINVALID, "Invalid Stripe event.")
+	}
+
+	switch e.Type {
+	case "payment_intent.succeeded",
+		"payment_intent.amount_capturable_updated",
+		"payment_intent.payment_failed",
+		"payment_intent.canceled":
+		var pi paymentIntent
+		if err := json.Unmarshal(e.Data.Object, &pi); err != nil {
+			return nil, booking.Errorf(booking.EINVALID, "Invalid Stripe payment intent.")
+		}
+		ev := &booking.PaymentWebhookEvent{
+			ProviderPaymentID: pi.ID,
+			Status:            paymentStatus(pi.Status),
+		}
+		// Failed attempts leave the intent waiting for another payment
+		// method so the status alone doesn't show that it failed.
+		if e.Type == "payment_intent.payment_failed" {
+			ev.Status = booking.PaymentStatusFailed
+			if pi.LastPaymentError != nil {
+				ev.FailureReason = pi.LastPaymentError.Message
+			}
+		}
+		return ev, nil
+	case "charge.refunded":
+		var c charge
+		if err := json.Unmarshal(e.Data.Object, &c); err != nil {
+			return nil, booking.Errorf(booking.EINVALID, "Invalid Stripe charge.")
+		}
+		status := booking.PaymentStatusPartiallyRefunded
+		if c.Refunded {
+			status = booking.PaymentStatusRefunded
+		}
+		return &booking.PaymentWebhookEvent{
+			ProviderPaymentID: c.PaymentIntent,
+			Status:            status,
+			AmountRefunded:    c.AmountRefunded,
+		}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// verify checks a Stripe-Signature header, which has the form
+// "t=<timestamp>,v1=<signature>[,v1=<signature>...]". Each v1 signature is the
+// hex encoded HMAC-SHA256 of "<timestamp>.<payload>" keyed with the webhook
+// secret. More than one is sent while a secret is being rolled.
+func (p *PaymentProvider) verify(payload []byte, header string) error {
+	var ts int64
+	var sigs []string
+	for _, part := range strings.Split(header, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "t":
+			t, err := strconv.ParseInt(kv[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid timestamp")
+			}
+			ts = t
+		case "v1":
+			sigs = append(sigs, kv[1])
+		}
+	}
+	if ts == 0 || len(sigs) == 0 {
+		return fmt.Errorf("missing timestamp or signature")
+	}
+	if p.Tolerance > 0 {
+		if d := p.Now().Sub(time.Unix(ts, 0)); d > p.Tolerance || d < -p.Tolerance {
+			return fmt.Errorf("timestamp is outside of tolerance")
+		}
+	}
+
+	mac := hmac.New(sha256.New, []byte(p.WebhookSecret))
+	fmt.Fprintf(mac, "%d.", ts)
+	mac.Write(payload)
+	expected := hex.EncodeToString(mac.Sum(nil))
+	for _, sig := range sigs {
+		if hmac.Equal([]byte(sig), []byte(expected)) {
+			return nil
+		}
+	}
+	return fmt.Errorf("signature mismatch")
+}
@@ -0,0 +1,325 @@
+package booking
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxTaxRate is the highest tax rate that can be configured, 100%.
+const maxTaxRate = 10000
+
+// TaxRate is a rate of tax, such as VAT, GST or sales tax, charged on the
+// bookings of an organization. The default rate of the organization applies to
+// every resource that doesn't have a rate of its own.
+type TaxRate struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+
+	// The rate in hundredths of a percent, e.g. 2000 for 20%.
+	Rate int `json:"rate"`
+
+	// Whether the prices of resources already include the tax. Otherwise the
+	// tax is added on top of them.
+	Inclusive bool `json:"inclusive"`
+
+	// Whether the rate applies to resources without a rate of their own.
+	Default bool `json:"default"`
+
+	// Timestamps for tax rate creation and last update.
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Applied returns the details of t that are recorded with a booking.
+func (t *TaxRate) Applied() *AppliedTax {
+	if t == nil {
+		return nil
+	}
+	return &AppliedTax{Name: t.Name, Rate: t.Rate, Inclusive: t.Inclusive}
+}
+
+// AppliedTax is the tax rate that was charged on a quote or a booking. Rates
+// are copied when bookings are made so that changing a rate doesn't change the
+// tax of existing bookings.
+type AppliedTax struct {
+	Name      string `json:"name"`
+	Rate      int    `json:"rate"`
+	Inclusive bool   `json:"inclusive"`
+}
+
+// Taxed is an amount split into the amount before tax, the tax charged on it
+// and the amount including tax.
+type Taxed struct {
+	Net   int `json:"net"`
+	Tax   int `json:"tax"`
+	Gross int `json:"gross"`
+}
+
+// ApplyTax charges tax at t on price. price includes the tax if t is
+// inclusive and excludes it otherwise. Tax is rounded to the nearest minor unit
+// of the currency. Prices aren't taxed if t is nil.
+func ApplyTax(price int, t *AppliedTax) Taxed {
+	if t == nil || t.Rate == 0 {
+		return Taxed{Net: price, Gross: price}
+	}
+	if t.Inclusive {
+		net := divRound(price*maxTaxRate, maxTaxRate+t.Rate)
+		return Taxed{Net: net, Tax: price - net, Gross: price}
+	}
+	tax := divRound(price*t.Rate, maxTaxRate)
+	return Taxed{Net: price, Tax: tax, Gross: price + tax}
+}
+
+// divRound divides a by a positive b, rounding halves away from zero.
+func divRound(a, b int) int {
+	if a < 0 {
+		return -divRound(-a, b)
+	}
+	return (2*a + b) / (2 * b)
+}
+
+// TaxedMoney is an amount of a currency split into the amount before tax, the
+// tax charged on it and the amount including tax.
+type TaxedMoney struct {
+	Taxed
+
+	// The ISO 4217 code of the currency, e.g. EUR.
+	Currency string `json:"currency"`
+}
+
+// NewTaxedMoney returns a gross amount of currency that includes tax.
+func NewTaxedMoney(gross, tax int, currency string) TaxedMoney {
+	return TaxedMoney{
+		Taxed:    Taxed{Net: gross - tax, Tax: tax, Gross: gross},
+		Currency: currency,
+	}
+}
+
+// TaxedTotals holds a total for each currency, ordered by currency code.
+// Amounts of different currencies are never added together.
+type TaxedTotals []TaxedMoney
+
+// Add returns t with m added to the total of its currency.
+func (t TaxedTotals) Add(m TaxedMoney) TaxedTotals {
+	for i := range t {
+		if t[i].Currency == m.Currency {
+			t[i].Net += m.Net
+			t[i].Tax += m.Tax
+			t[i].Gross += m.Gross
+			return t
+		}
+	}
+	t = append(t, m)
+	sort.Slice(t, func(i, j int) bool { return t[i].Currency < t[j].Currency })
+	return t
+}
+
+// Gross returns the gross total of currency.
+func (t TaxedTotals) Gross(currency string) int {
+	for _, m := range t {
+		if m.Currency == currency {
+			return m.Gross
+		}
+	}
+	return 0
+}
+
+// TaxRateService represents a service for managing the tax rates of an
+// organization.
+type TaxRateService interface {
+	// FindTaxRateByID retrieves a single tax rate by ID. Returns
+	// ETAXRATENOTFOUND if the tax rate does not exist or user does not have
+	// permission to view it.
+	FindTaxRateByID(ctx context.Context, req FindTaxRateByIDRequest) FindTaxRateByIDResponse
+
+	// FindTaxRates retrieves every tax rate of the current organization
+	// ordered by name.
+	FindTaxRates(ctx context.Context, req FindTaxRatesRequest) FindTaxRatesResponse
+
+	// CreateTaxRate creates a new tax rate for the current organization. A
+	// new default rate replaces the existing default. Returns
+	// ETAXRATECONFLICT if the organization already has a rate with the name.
+	CreateTaxRate(ctx context.Context, req CreateTaxRateRequest) CreateTaxRateResponse
+
+	// UpdateTaxRate updates an existing tax rate by ID. Bookings that have
+	// already been made keep the rate that they were made with.
+	UpdateTaxRate(ctx context.Context, req UpdateTaxRateRequest) UpdateTaxRateResponse
+
+	// DeleteTaxRate permanently removes a tax rate. Resources that used it
+	// fall back to the default rate of the organization.
+	DeleteTaxRate(ctx context.Context, req DeleteTaxRateRequest) DeleteTaxRateResponse
+}
+
+// FindTaxRateByIDRequest represents a payload used by the FindTaxRateByID method of a TaxRateService
+type FindTaxRateByIDRequest struct {
+	ID int `json:"id" source:"url"`
+}
+
+// Validate a FindTaxRateByIDRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r FindTaxRateByIDRequest) Validate() []ValidationError {
+	if r.ID < 1 {
+		return []ValidationError{{Name: "id", Reason: "Must be at least 1"}}
+	}
+	return nil
+}
+
+// FindTaxRateByIDResponse represents a response returned by the FindTaxRateByID method of a TaxRateService.
+type FindTaxRateByIDResponse struct {
+	*TaxRate
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r FindTaxRateByIDResponse) Error() error { return r.Err }
+
+// FindTaxRatesRequest represents a payload used by the FindTaxRates method of a TaxRateService
+type FindTaxRatesRequest struct{}
+
+// FindTaxRatesResponse represents a response returned by the FindTaxRates method of a TaxRateService.
+type FindTaxRatesResponse struct {
+	TaxRates []*TaxRate `json:"taxRates"`
+	Err      error      `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r FindTaxRatesResponse) Error() error { return r.Err }
+
+// CreateTaxRateRequest represents a payload used by the CreateTaxRate method of a TaxRateService
+type CreateTaxRateRequest struct {
+	Name      string `json:"name" source:"json"`
+	Rate      int    `json:"rate" source:"json"`
+	Inclusive bool   `json:"inclusive" source:"json"`
+	Default   bool   `json:"default" source:"json"`
+}
+
+// Validate a CreateTaxRateRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r CreateTaxRateRequest) Validate() []ValidationError {
+	return validateTaxRate(r.Name, r.Rate)
+}
+
+// CreateTaxRateResponse represents a response returned by the CreateTaxRate method of a TaxRateService.
+type CreateTaxRateResponse struct {
+	*TaxRate
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r CreateTaxRateResponse) Error() error { return r.Err }
+
+// UpdateTaxRateRequest represents a payload used by the UpdateTaxRate method of a TaxRateService
+type UpdateTaxRateRequest struct {
+	ID        int    `json:"id" source:"url"`
+	Name      string `json:"name" source:"json"`
+	Rate      int    `json:"rate" source:"json"`
+	Inclusive bool   `json:"inclusive" source:"json"`
+	Default   bool   `json:"default" source:"json"`
+}
+
+// Validate an UpdateTaxRateRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r UpdateTaxRateRequest) Validate() []ValidationError {
+	errs := make([]ValidationError, 0)
+	if r.ID < 1 {
+		errs = append(errs, ValidationError{Name: "id", Reason: "Must be at least 1"})
+	}
+	return append(errs, validateTaxRate(r.Name, r.Rate)...)
+}
+
+// UpdateTaxRateResponse represents a response returned by the UpdateTaxRate method of a TaxRateService.
+type UpdateTaxRateResponse struct {
+	*TaxRate
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r UpdateTaxRateResponse) Error() error { return r.Err }
+
+// DeleteTaxRateRequest represents a payload used by the DeleteTaxRate method of a TaxRateService
+type DeleteTaxRateRequest struct {
+	ID int `json:"id" source:"url"`
+}
+
+// Validate a DeleteTaxRateRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r DeleteTaxRateRequest) Validate() []ValidationError {
+	if r.ID < 1 {
+		return []ValidationError{{Name: "id", Reason: "Must be at least 1"}}
+	}
+	return nil
+}
+
+// DeleteTaxRateResponse represents a response returned by the DeleteTaxRate method of a TaxRateService.
+type DeleteTaxRateResponse struct {
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r DeleteTaxRateResponse) Error() error { return r.Err }
+
+func validateTaxRate(name string, rate int) []ValidationError {
+	errs := make([]ValidationError, 0)
+	name = strings.TrimSpace(name)
+	if name == "" {
+		errs = append(errs, ValidationError{Name: "name", Reason: "Name is required"})
+	} else if len(name) > 64 {
+		errs = append(errs, ValidationError{Name: "name", Reason: "Must be no more than 64 characters"})
+	}
+	if rate < 0 || rate > maxTaxRate {
+		errs = append(errs, ValidationError{Name: "rate", Reason: "Must be between 0 and 10000"})
+	}
+	return errs
+}
+
+// TaxRateServiceMiddleware defines a middleware for TaxRateService
+type TaxRateServiceMiddleware func(service TaxRateService) TaxRateService
+
+// TaxRateValidationMiddleware returns a middleware for validating requests made to a TaxRateService
+func TaxRateValidationMiddleware() TaxRateServiceMiddleware {
+	return func(next TaxRateService) TaxRateService {
+		return taxRateValidationMiddleware{next}
+	}
+}
+
+type taxRateValidationMiddleware struct {
+	TaxRateService
+}
+
+// FindTaxRateByID validates a FindTaxRateByIDRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw taxRateValidationMiddleware) FindTaxRateByID(ctx context.Context, req FindTaxRateByIDRequest) FindTaxRateByIDResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return FindTaxRateByIDResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.TaxRateService.FindTaxRateByID(ctx, req)
+}
+
+// CreateTaxRate validates a CreateTaxRateRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw taxRateValidationMiddleware) CreateTaxRate(ctx context.Context, req CreateTaxRateRequest) CreateTaxRateResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return CreateTaxRateResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.TaxRateService.CreateTaxRate(ctx, req)
+}
+
+// UpdateTaxRate validates an UpdateTaxRateRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw taxRateValidationMiddleware) UpdateTaxRate(ctx context.Context, req UpdateTaxRateRequest) UpdateTaxRateResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return UpdateTaxRateResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.TaxRateService.UpdateTaxRate(ctx, req)
+}
+
+// DeleteTaxRate validates a DeleteTaxRateRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw taxRateValidationMiddleware) DeleteTaxRate(ctx context.Context, req DeleteTaxRateRequest) DeleteTaxRateResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return DeleteTaxRateResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.TaxRateService.DeleteTaxRate(ctx, req)
+}
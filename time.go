@@ -2,6 +2,8 @@ package booking
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 )
 
@@ -28,3 +30,118 @@ func ParseTime(input string) (time.Time, error) {
 	}
 	return time.Time{}, errors.New("unrecognized time format")
 }
+
+// legacyTimezones maps the fixed UTC offsets that resources used before IANA
+// timezones were supported to a timezone with the same offset all year round.
+// Offsets that no such timezone exists for are left out and continue to be
+// loaded as fixed zones.
+var legacyTimezones = map[string]string{
+	"UTC-12:00": "Etc/GMT+12",
+	"UTC-11:00": "Etc/GMT+11",
+	"UTC-10:00": "Etc/GMT+10",
+	"UTC-09:30": "Pacific/Marquesas",
+	"UTC-09:00": "Etc/GMT+9",
+	"UTC-08:00": "Etc/GMT+8",
+	"UTC-07:00": "Etc/GMT+7",
+	"UTC-06:00": "Etc/GMT+6",
+	"UTC-05:00": "Etc/GMT+5",
+	"UTC-04:00": "Etc/GMT+4",
+	"UTC-03:00": "Etc/GMT+3",
+	"UTC-02:00": "Etc/GMT+2",
+	"UTC-01:00": "Etc/GMT+1",
+	"UTC+00:00": "UTC",
+	"UTC±00:00": "UTC",
+	"UTC+01:00": "Etc/GMT-1",
+	"UTC+02:00": "Etc/GMT-2",
+	"UTC+03:00": "Etc/GMT-3",
+	"UTC+04:00": "Etc/GMT-4",
+	"UTC+04:30": "Asia/Kabul",
+	"UTC+05:00": "Etc/GMT-5",
+	"UTC+05:30": "Asia/Kolkata",
+	"UTC+05:45": "Asia/Kathmandu",
+	"UTC+06:00": "Etc/GMT-6",
+	"UTC+06:30": "Asia/Yangon",
+	"UTC+07:00": "Etc/GMT-7",
+	"UTC+08:00": "Etc/GMT-8",
+	"UTC+08:45": "Australia/Eucla",
+	"UTC+09:00": "Etc/GMT-9",
+	"UTC+09:30": "Australia/Darwin",
+	"UTC+10:00": "Etc/GMT-10",
+	"UTC+11:00": "Etc/GMT-11",
+	"UTC+12:00": "Etc/GMT-12",
+	"UTC+13:00": "Etc/GMT-13",
+	"UTC+14:00": "Etc/GMT-14",
+}
+
+// LoadTimezone returns the location of an IANA timezone name such as
+// "Europe/London". Fixed offsets in the legacy format UTC±HH:MM are still
+// accepted so that resources created before IANA names were supported keep
+// working.
+func LoadTimezone(name string) (*time.Location, error) {
+	if offset, ok := parseLegacyTimezone(name); ok {
+		return time.FixedZone(name, offset), nil
+	}
+	// LoadLocation treats "" as UTC and "Local" as the timezone of the server,
+	// neither of which make sense for a resource.
+	if name == "" || name == "Local" {
+		return nil, fmt.Errorf("invalid timezone %q", name)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %w", name, err)
+	}
+	return loc, nil
+}
+
+// NormalizeTimezone converts a legacy UTC±HH:MM offset to the IANA timezone
+// with the same offset. Returns false if name is not a legacy offset or there
+// is no equivalent IANA timezone.
+func NormalizeTimezone(name string) (string, bool) {
+	tz, ok := legacyTimezones[name]
+	return tz, ok
+}
+
+// parseLegacyTimezone returns the offset in seconds east of UTC of a timezone
+// in the format UTC±HH:MM.
+func parseLegacyTimezone(name string) (int, bool) {
+	if !legacyTimezone(name) {
+		return 0, false
+	}
+	sign, hhmm := 1, strings.TrimPrefix(name, "UTC")
+	switch {
+	case strings.HasPrefix(hhmm, "+"):
+		hhmm = hhmm[1:]
+	case strings.HasPrefix(hhmm, "-"):
+		sign, hhmm = -1, hhmm[1:]
+	case strings.HasPrefix(hhmm, "±"):
+		hhmm = strings.TrimPrefix(hhmm, "±")
+	}
+	offset, err := time.Parse("15:04", hhmm)
+	if err != nil {
+		return 0, false
+	}
+	return sign * (offset.Hour()*60*60 + offset.Minute()*60), true
+}
+
+// LocalTime returns the instant at which the wall clock in loc shows the given
+// date and time. Unlike time.Date, the result is well defined at daylight
+// saving transitions. Following RFC 5545, times that are skipped when clocks go
+// forward are interpreted using the offset from before the transition, which
+// moves them forward by the length of the gap, and times that are repeated
+// when clocks go back resolve to their first occurrence.
+func LocalTime(year int, month time.Month, day, hour, min, sec, nsec int, loc *time.Location) time.Time {
+	wall := time.Date(year, month, day, hour, min, sec, nsec, time.UTC)
+	// Timezones don't change offset more than once in two days so the offsets
+	// a day either side are the only ones that can apply.
+	_, before := wall.Add(-24 * time.Hour).In(loc).Zone()
+	_, after := wall.Add(24 * time.Hour).In(loc).Zone()
+	for _, offset := range []int{before, after} {
+		t := wall.Add(-time.Duration(offset) * time.Second).In(loc)
+		y, mo, d := t.Date()
+		h, m, s := t.Clock()
+		if time.Date(y, mo, d, h, m, s, t.Nanosecond(), time.UTC).Equal(wall) {
+			return t
+		}
+	}
+	return wall.Add(-time.Duration(before) * time.Second).In(loc)
+}
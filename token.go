@@ -2,66 +2,252 @@ package booking
 
 import (
 	"context"
+	"strings"
 	"time"
 )
 
+// TokenSecretPrefix is prepended to the secret of every API token. It allows
+// tokens to be told apart from organization private keys and makes leaked
+// tokens easier to spot.
+const TokenSecretPrefix = "omb_"
+
+// Token scopes. A scope grants a token access to a group of operations.
+const (
+	ScopeBookingsRead          = "bookings:read"
+	ScopeBookingsWrite         = "bookings:write"
+	ScopePaymentsRead          = "payments:read"
+	ScopePaymentsWrite         = "payments:write"
+	ScopeResourcesRead         = "resources:read"
+	ScopeResourcesWrite        = "resources:write"
+	ScopeUnavailabilitiesRead  = "unavailabilities:read"
+	ScopeUnavailabilitiesWrite = "unavailabilities:write"
+	ScopeReportsRead           = "reports:read"
+	ScopeTokensRead            = "tokens:read"
+	ScopeTokensWrite           = "tokens:write"
+	ScopeEventsRead            = "events:read"
+	ScopeWebhooksRead          = "webhooks:read"
+	ScopeWebhooksWrite         = "webhooks:write"
+	ScopePromoCodesRead        = "promocodes:read"
+	ScopePromoCodesWrite       = "promocodes:write"
+	ScopeInvoicesRead          = "invoices:read"
+	ScopeInvoicesWrite         = "invoices:write"
+	ScopeTaxRatesRead          = "taxrates:read"
+	ScopeTaxRatesWrite         = "taxrates:write"
+	ScopeCustomersRead         = "customers:read"
+	ScopeCustomersWrite        = "customers:write"
+	ScopeOrganizationRead      = "organization:read"
+	ScopeOrganizationWrite     = "organization:write"
+)
+
+// Scopes contains every valid token scope.
+var Scopes = []string{
+	ScopeBookingsRead,
+	ScopeBookingsWrite,
+	ScopePaymentsRead,
+	ScopePaymentsWrite,
+	ScopeResourcesRead,
+	ScopeResourcesWrite,
+	ScopeUnavailabilitiesRead,
+	ScopeUnavailabilitiesWrite,
+	ScopeReportsRead,
+	ScopeTokensRead,
+	ScopeTokensWrite,
+	ScopeEventsRead,
+	ScopeWebhooksRead,
+	ScopeWebhooksWrite,
+	ScopePromoCodesRead,
+	ScopePromoCodesWrite,
+	ScopeInvoicesRead,
+	ScopeInvoicesWrite,
+	ScopeTaxRatesRead,
+	ScopeTaxRatesWrite,
+	ScopeCustomersRead,
+	ScopeCustomersWrite,
+	ScopeOrganizationRead,
+	ScopeOrganizationWrite,
+}
+
+// Token represents a named API token that a user has created to access the API
+// on behalf of their organization.
 type Token struct {
-	ID             string     `json:"id"`
-	Name           string     `json:"name"`
-	Expiry         *time.Time `json:"expiry"`
-	UserID         int        `json:"userId"`
-	OrganizationID int        `json:"organizationId"`
+	ID   string `json:"id"`
+	Name string `json:"name"`
+
+	// The first few characters of the secret. Used to help users recognise a
+	// token without exposing the secret.
+	SecretPrefix string `json:"secretPrefix"`
+
+	// The operations that the token is allowed to perform.
+	Scopes []string `json:"scopes"`
+
+	// The time after which the token can no longer be used. Nil if the token
+	// does not expire.
+	Expiry *time.Time `json:"expiry"`
+
+	// The last time that the token was used to authenticate a request.
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+
+	// The time that the token was revoked. Nil if the token has not been revoked.
+	RevokedAt *time.Time `json:"revokedAt"`
+
+	// The user that created the token and the organization it grants access to.
+	UserID         int           `json:"userId"`
+	User           *User         `json:"user,omitempty"`
+	OrganizationID int           `json:"organizationId"`
+	Organization   *Organization `json:"organization,omitempty"`
+
+	// Timestamps for token creation and last update.
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// HasScope returns true if the token has been granted scope.
+func (t *Token) HasScope(scope string) bool {
+	return Strings(t.Scopes).contains(scope)
 }
 
+// TokenService represents a service for managing API tokens.
 type TokenService interface {
+	// CreateToken creates a new token for the current user and organization.
+	// The secret used to authenticate with the token is only returned here.
 	CreateToken(ctx context.Context, req CreateTokenRequest) CreateTokenResponse
+
+	// FindTokens retrieves the tokens of the current user. Also returns a count
+	// of total matching tokens which may be different from the number of
+	// returned tokens if the "Limit" field is set.
 	FindTokens(ctx context.Context, req FindTokensRequest) FindTokensResponse
+
+	// RevokeToken prevents a token from being used again. Returns ENOTFOUND if
+	// the token does not exist or does not belong to the current user.
+	RevokeToken(ctx context.Context, req RevokeTokenRequest) RevokeTokenResponse
+
+	// AuthenticateToken looks up the token that a secret belongs to along with
+	// its user and organization and records that it has been used. Returns
+	// EUNAUTHORIZED if the token does not exist, has expired or has been
+	// revoked.
+	AuthenticateToken(ctx context.Context, req AuthenticateTokenRequest) AuthenticateTokenResponse
 }
 
 // CreateTokenRequest represents a payload used by the CreateToken method of a TokenService
 type CreateTokenRequest struct {
-	Name   string     `json:"name"`
-	Expiry *time.Time `json:"expiry"`
+	Name   string     `json:"name" source:"json"`
+	Scopes []string   `json:"scopes" source:"json"`
+	Expiry *time.Time `json:"expiry" source:"json"`
 }
 
 // Validate a CreateToken. Returns a ValidationError for each requirement that fails.
 func (r CreateTokenRequest) Validate() []ValidationError {
-	// insert validation logic here
-	return nil
+	errs := make([]ValidationError, 0)
+	if strings.TrimSpace(r.Name) == "" {
+		errs = append(errs, ValidationError{Name: "name", Reason: "Name is required"})
+	}
+	if len(r.Scopes) == 0 {
+		errs = append(errs, ValidationError{Name: "scopes", Reason: "At least one scope is required"})
+	}
+	for _, s := range r.Scopes {
+		if !Strings(Scopes).contains(s) {
+			errs = append(errs, ValidationError{Name: "scopes", Reason: "Unknown scope '" + s + "'"})
+		}
+	}
+	if r.Expiry != nil && !r.Expiry.After(time.Now()) {
+		errs = append(errs, ValidationError{Name: "expiry", Reason: "Must be in the future"})
+	}
+	return errs
 }
 
 // CreateTokenResponse represents a response returned by the CreateToken method of a TokenService.
 type CreateTokenResponse struct {
-	ID             string     `json:"id"`
-	Name           string     `json:"name"`
-	Expiry         *time.Time `json:"expiry"`
-	UserID         int        `json:"userId"`
-	OrganizationId int        `json:"organizationId"`
-	Err            error      `json:"err"`
+	*Token
+
+	// The secret used to authenticate with the token. It is not stored and
+	// cannot be retrieved again.
+	Secret string `json:"secret,omitempty"`
+
+	Err error `json:"err,omitempty"`
 }
 
 // Error implements the errorer interface. Returns property Err from the response.
 func (r CreateTokenResponse) Error() error { return r.Err }
 
 // FindTokensRequest represents a payload used by the FindTokens method of a TokenService
-type FindTokensRequest struct{}
+type FindTokensRequest struct {
+	// Include tokens that have been revoked.
+	IncludeRevoked bool `json:"includeRevoked" source:"query"`
+
+	// Restrict to subset of range.
+	Offset int `json:"offset" source:"query"`
+	Limit  int `json:"limit" source:"query"`
+}
 
 // Validate a FindTokens. Returns a ValidationError for each requirement that fails.
 func (r FindTokensRequest) Validate() []ValidationError {
-	// insert validation logic here
-	return nil
+	errs := make([]ValidationError, 0)
+	if r.Offset < 0 {
+		errs = append(errs, ValidationError{Name: "offset", Reason: "Must be greater than or equal to 0"})
+	}
+	if r.Limit < 0 {
+		errs = append(errs, ValidationError{Name: "limit", Reason: "Must be greater than or equal to 0"})
+	}
+	return errs
 }
 
 // FindTokensResponse represents a response returned by the FindTokens method of a TokenService.
 type FindTokensResponse struct {
-	Tokens     []Token `json:"tokens,omitempty"`
-	TotalItems int     `json:"totalItems"`
-	Err        error   `json:"err,omitempty"`
+	Tokens     []*Token `json:"tokens,omitempty"`
+	TotalItems int      `json:"totalItems"`
+	Err        error    `json:"err,omitempty"`
 }
 
 // Error implements the errorer interface. Returns property Err from the response.
 func (r FindTokensResponse) Error() error { return r.Err }
 
+// RevokeTokenRequest represents a payload used by the RevokeToken method of a TokenService
+type RevokeTokenRequest struct {
+	ID string `json:"id" source:"url"`
+}
+
+// Validate a RevokeToken. Returns a ValidationError for each requirement that fails.
+func (r RevokeTokenRequest) Validate() []ValidationError {
+	if r.ID == "" {
+		return []ValidationError{
+			{Name: "id", Reason: "ID is required"},
+		}
+	}
+	return nil
+}
+
+// RevokeTokenResponse represents a response returned by the RevokeToken method of a TokenService.
+type RevokeTokenResponse struct {
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r RevokeTokenResponse) Error() error { return r.Err }
+
+// AuthenticateTokenRequest represents a payload used by the AuthenticateToken method of a TokenService
+type AuthenticateTokenRequest struct {
+	Secret string `json:"-"`
+}
+
+// Validate a AuthenticateToken. Returns a ValidationError for each requirement that fails.
+func (r AuthenticateTokenRequest) Validate() []ValidationError {
+	if !strings.HasPrefix(r.Secret, TokenSecretPrefix) {
+		return []ValidationError{
+			{Name: "secret", Reason: "Must be a valid token secret"},
+		}
+	}
+	return nil
+}
+
+// AuthenticateTokenResponse represents a response returned by the AuthenticateToken method of a TokenService.
+type AuthenticateTokenResponse struct {
+	*Token
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r AuthenticateTokenResponse) Error() error { return r.Err }
+
 // TokenServiceMiddleware defines a middleware for TokenService
 type TokenServiceMiddleware func(service TokenService) TokenService
 
@@ -93,3 +279,21 @@ func (mw tokenValidationMiddleware) FindTokens(ctx context.Context, req FindToke
 	}
 	return mw.TokenService.FindTokens(ctx, req)
 }
+
+// RevokeToken validates a RevokeTokenRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw tokenValidationMiddleware) RevokeToken(ctx context.Context, req RevokeTokenRequest) RevokeTokenResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return RevokeTokenResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.TokenService.RevokeToken(ctx, req)
+}
+
+// AuthenticateToken validates a AuthenticateTokenRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw tokenValidationMiddleware) AuthenticateToken(ctx context.Context, req AuthenticateTokenRequest) AuthenticateTokenResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return AuthenticateTokenResponse{Err: Errorf(EUNAUTHORIZED, "Invalid API token.")}
+	}
+	return mw.TokenService.AuthenticateToken(ctx, req)
+}
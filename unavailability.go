@@ -19,6 +19,10 @@ type Unavailability struct {
 
 	// The time that the unavavailability finishes at.
 	EndTime time.Time `json:"endTime"`
+
+	// The UID of the calendar event that the unavailability was imported from.
+	// Empty if the unavailability was not imported.
+	ExternalID string `json:"externalId,omitempty"`
 }
 
 // UnavailabilityService represents a service for managing unavailabilities.
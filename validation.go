@@ -51,7 +51,7 @@ func timeEqual(time1, time2 string) (bool, error) {
 // returns nil otherwise.
 func processValidationErrors(errs []ValidationError) error {
 	if len(errs) > 0 {
-		return Error{
+		return &Error{
 			Code:   EINVALID,
 			Detail: "One or more validation errors occurred while processing your request.",
 			Title:  "Invalid request",
@@ -61,7 +61,16 @@ func processValidationErrors(errs []ValidationError) error {
 	return nil
 }
 
+// validTimezone returns true if tz is an IANA timezone name or a fixed offset
+// in the legacy format UTC±HH:MM.
 func validTimezone(tz string) bool {
+	_, err := LoadTimezone(tz)
+	return err == nil
+}
+
+// legacyTimezone returns true if tz is one of the fixed offsets that resources
+// were limited to before IANA timezones were supported.
+func legacyTimezone(tz string) bool {
 	validTimezones := []string{
 		"UTC-12:00",
 		"UTC-11:00",
@@ -0,0 +1,236 @@
+package booking
+
+import (
+	"context"
+	"time"
+)
+
+// WaitlistEntry represents a customer waiting for a resource to become
+// available at a time that is fully booked. When a booking that overlaps the
+// entry is cancelled or deleted the entry is offered a held booking for its
+// time, which it has to confirm before the hold expires.
+type WaitlistEntry struct {
+	ID int `json:"id"`
+
+	// The resource that the customer is waiting for.
+	ResourceID int `json:"resourceId"`
+
+	// The time that the customer wants to book.
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+
+	// Entries with a higher priority are offered a spot first. Entries with the
+	// same priority are offered a spot in the order they joined the waitlist.
+	Priority int `json:"priority"`
+
+	// Generic information about the customer. Copied to the booking that the
+	// entry is offered.
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	Status string `json:"status"`
+
+	// The held booking that the entry was offered. Nil until a spot is
+	// offered.
+	BookingID *int       `json:"bookingId,omitempty"`
+	OfferedAt *time.Time `json:"offeredAt,omitempty"`
+
+	// Timestamps for entry creation and last update.
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// WaitlistOffer describes a spot that was offered to a waitlist entry.
+type WaitlistOffer struct {
+	Entry *WaitlistEntry `json:"entry"`
+
+	// The held booking that was created for the entry.
+	Booking *Booking `json:"booking"`
+}
+
+// Waitlist entry statuses.
+const (
+	// Waiting for a spot to become available.
+	WaitlistStatusWaiting = "waiting"
+	// A held booking was offered and has not been confirmed yet.
+	WaitlistStatusOffered = "offered"
+	// The offered booking was confirmed.
+	WaitlistStatusBooked = "booked"
+	// The offered booking was not confirmed before its hold expired.
+	WaitlistStatusExpired = "expired"
+	// The offered booking was cancelled or deleted.
+	WaitlistStatusDeclined = "declined"
+)
+
+// WaitlistStatuses contains every valid waitlist entry status.
+var WaitlistStatuses = []string{
+	WaitlistStatusWaiting,
+	WaitlistStatusOffered,
+	WaitlistStatusBooked,
+	WaitlistStatusExpired,
+	WaitlistStatusDeclined,
+}
+
+// WaitlistOfferDuration is how long the booking offered to a waitlist entry is
+// held for.
+const WaitlistOfferDuration = 30 * time.Minute
+
+// WaitlistService represents a service for managing waitlists of resources.
+type WaitlistService interface {
+	// Retrieves a list of waitlist entries based on a filter, ordered by the
+	// position in which they will be offered a spot. Also returns a count of
+	// total matching entries which may be different from the number of
+	// returned entries if the "Limit" field is set.
+	FindWaitlistEntries(ctx context.Context, req FindWaitlistEntriesRequest) FindWaitlistEntriesResponse
+
+	// Adds a customer to the waitlist of a resource. The time must be one that
+	// could be booked if the resource wasn't full. If there already is space
+	// for the customer a spot is offered straight away.
+	JoinWaitlist(ctx context.Context, req JoinWaitlistRequest) JoinWaitlistResponse
+
+	// Removes an entry from a waitlist. If the entry had been offered a spot
+	// that it hasn't confirmed yet the held booking is cancelled and offered
+	// to the next entry. Returns EWAITLISTENTRYNOTFOUND if the entry does not
+	// exist or the user does not have permission to delete it.
+	LeaveWaitlist(ctx context.Context, req LeaveWaitlistRequest) LeaveWaitlistResponse
+}
+
+// FindWaitlistEntriesRequest represents a payload used by the FindWaitlistEntries method of a WaitlistService
+type FindWaitlistEntriesRequest struct {
+	// Filtering fields.
+	ResourceID *int    `json:"resourceId" source:"query"`
+	Status     *string `json:"status" source:"query"`
+
+	// Restrict to subset of range.
+	Offset int `json:"offset" source:"query"`
+	Limit  int `json:"limit" source:"query"`
+}
+
+// Validate a FindWaitlistEntriesRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r FindWaitlistEntriesRequest) Validate() []ValidationError {
+	errs := make([]ValidationError, 0)
+	if r.Status != nil && !Strings(WaitlistStatuses).contains(*r.Status) {
+		errs = append(errs, ValidationError{Name: "status", Reason: "Must be a valid waitlist status"})
+	}
+	if r.Offset < 0 {
+		errs = append(errs, ValidationError{Name: "offset", Reason: "Must be greater than or equal to 0"})
+	}
+	if r.Limit < 0 {
+		errs = append(errs, ValidationError{Name: "limit", Reason: "Must be greater than or equal to 0"})
+	}
+	return errs
+}
+
+// FindWaitlistEntriesResponse represents a response returned by the FindWaitlistEntries method of a WaitlistService.
+type FindWaitlistEntriesResponse struct {
+	Entries    []*WaitlistEntry `json:"entries,omitempty"`
+	TotalItems int              `json:"totalItems"`
+	Err        error            `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r FindWaitlistEntriesResponse) Error() error { return r.Err }
+
+// JoinWaitlistRequest represents a payload used by the JoinWaitlist method of a WaitlistService
+type JoinWaitlistRequest struct {
+	ResourceID int               `json:"resourceId" source:"json"`
+	StartTime  time.Time         `json:"startTime" source:"json"`
+	EndTime    time.Time         `json:"endTime" source:"json"`
+	Priority   int               `json:"priority" source:"json"`
+	Metadata   map[string]string `json:"metadata" source:"json"`
+}
+
+// Validate a JoinWaitlistRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r JoinWaitlistRequest) Validate() []ValidationError {
+	errs := make([]ValidationError, 0)
+	if r.ResourceID < 1 {
+		errs = append(errs, ValidationError{Name: "resourceId", Reason: "Must be at least 1"})
+	}
+	errs = append(errs, validateBookingTimes(r.StartTime, r.EndTime)...)
+	return errs
+}
+
+// JoinWaitlistResponse represents a response returned by the JoinWaitlist method of a WaitlistService.
+type JoinWaitlistResponse struct {
+	*WaitlistEntry
+
+	// Spots that were offered straight away. Only the new entry can be
+	// offered a spot unless entries ahead of it could not be offered one
+	// before.
+	Offers []WaitlistOffer `json:"offers,omitempty"`
+
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r JoinWaitlistResponse) Error() error { return r.Err }
+
+// LeaveWaitlistRequest represents a payload used by the LeaveWaitlist method of a WaitlistService
+type LeaveWaitlistRequest struct {
+	ID int `json:"id" source:"url"`
+}
+
+// Validate a LeaveWaitlistRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r LeaveWaitlistRequest) Validate() []ValidationError {
+	if r.ID < 1 {
+		return []ValidationError{{Name: "id", Reason: "Must be at least 1"}}
+	}
+	return nil
+}
+
+// LeaveWaitlistResponse represents a response returned by the LeaveWaitlist method of a WaitlistService.
+type LeaveWaitlistResponse struct {
+	// The held booking of the entry that was cancelled, if any.
+	CancelledBooking *Booking `json:"cancelledBooking,omitempty"`
+
+	// Spots that were offered to other entries in place of the entry.
+	Offers []WaitlistOffer `json:"offers,omitempty"`
+
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r LeaveWaitlistResponse) Error() error { return r.Err }
+
+// WaitlistServiceMiddleware defines a middleware for WaitlistService
+type WaitlistServiceMiddleware func(service WaitlistService) WaitlistService
+
+// WaitlistValidationMiddleware returns a middleware for validating requests made to a WaitlistService
+func WaitlistValidationMiddleware() WaitlistServiceMiddleware {
+	return func(next WaitlistService) WaitlistService {
+		return waitlistValidationMiddleware{next}
+	}
+}
+
+type waitlistValidationMiddleware struct {
+	WaitlistService
+}
+
+// FindWaitlistEntries validates a FindWaitlistEntriesRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw waitlistValidationMiddleware) FindWaitlistEntries(ctx context.Context, req FindWaitlistEntriesRequest) FindWaitlistEntriesResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return FindWaitlistEntriesResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.WaitlistService.FindWaitlistEntries(ctx, req)
+}
+
+// JoinWaitlist validates a JoinWaitlistRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw waitlistValidationMiddleware) JoinWaitlist(ctx context.Context, req JoinWaitlistRequest) JoinWaitlistResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return JoinWaitlistResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.WaitlistService.JoinWaitlist(ctx, req)
+}
+
+// LeaveWaitlist validates a LeaveWaitlistRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw waitlistValidationMiddleware) LeaveWaitlist(ctx context.Context, req LeaveWaitlistRequest) LeaveWaitlistResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return LeaveWaitlistResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.WaitlistService.LeaveWaitlist(ctx, req)
+}
@@ -0,0 +1,552 @@
+package booking
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// WebhookSecretPrefix is prepended to the signing secret of every webhook.
+const WebhookSecretPrefix = "whsec_"
+
+// Webhook delivery statuses. Failed deliveries remain pending while they are
+// being retried and are moved to dead once they run out of attempts.
+const (
+	WebhookDeliveryStatusPending   = "pending"
+	WebhookDeliveryStatusSucceeded = "succeeded"
+	WebhookDeliveryStatusDead      = "dead"
+)
+
+// WebhookDeliveryStatuses contains every valid webhook delivery status.
+var WebhookDeliveryStatuses = []string{
+	WebhookDeliveryStatusPending,
+	WebhookDeliveryStatusSucceeded,
+	WebhookDeliveryStatusDead,
+}
+
+// WebhookEventTypes contains the event types that can be delivered to a
+// webhook.
+var WebhookEventTypes = []string{
+	EventTypeBookingCreated,
+	EventTypeBookingUpdated,
+	EventTypeBookingDeleted,
+	EventTypeBookingHeld,
+	EventTypeBookingConfirmed,
+	EventTypeBookingCheckedIn,
+	EventTypeBookingCompleted,
+	EventTypeBookingCancelled,
+	EventTypeBookingNoShow,
+	EventTypeBookingExpired,
+	EventTypePaymentSucceeded,
+	EventTypePaymentFailed,
+	EventTypePaymentRefunded,
+	EventTypeResourceCreated,
+	EventTypeResourceUpdated,
+	EventTypeResourceDeleted,
+	EventTypeUnavailabilityCreated,
+	EventTypeUnavailabilityUpdated,
+	EventTypeUnavailabilityDeleted,
+	EventTypeWaitlistJoined,
+	EventTypeWaitlistLeft,
+	EventTypeWaitlistOffered,
+}
+
+// WebhookEventType returns true if events of type t can be delivered to a
+// webhook.
+func WebhookEventType(t string) bool {
+	return Strings(WebhookEventTypes).contains(t)
+}
+
+// Webhook represents an endpoint that an organization has registered to be
+// notified of events.
+type Webhook struct {
+	ID          int    `json:"id"`
+	URL         string `json:"url"`
+	Description string `json:"description"`
+
+	// The event types that are delivered to the webhook. All event types are
+	// delivered if empty.
+	EventTypes []string `json:"eventTypes"`
+
+	// The secret used to sign payloads. Only returned when the webhook is
+	// created or its secret is rotated.
+	Secret string `json:"-"`
+
+	// Disabled webhooks don't receive new deliveries.
+	Enabled bool `json:"enabled"`
+
+	OrganizationID int `json:"organizationId"`
+
+	// Timestamps for webhook creation and last update.
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Subscribed returns true if events of type t should be delivered to the
+// webhook.
+func (w *Webhook) Subscribed(t string) bool {
+	if !w.Enabled || !WebhookEventType(t) {
+		return false
+	}
+	return len(w.EventTypes) == 0 || Strings(w.EventTypes).contains(t)
+}
+
+// WebhookDelivery represents a single event being sent to a webhook along
+// with the outcome of the most recent attempt.
+type WebhookDelivery struct {
+	ID        int    `json:"id"`
+	EventType string `json:"eventType"`
+
+	// The JSON body sent to the webhook, see WebhookPayload.
+	Payload json.RawMessage `json:"payload"`
+
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	NextAttemptAt  time.Time  `json:"nextAttemptAt"`
+	LastAttemptAt  *time.Time `json:"lastAttemptAt"`
+	ResponseStatus *int       `json:"responseStatus"`
+	LastError      string     `json:"lastError,omitempty"`
+
+	WebhookID      int      `json:"webhookId"`
+	Webhook        *Webhook `json:"webhook,omitempty"`
+	OrganizationID int      `json:"organizationId"`
+
+	// Timestamps for delivery creation and last update.
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// WebhookPayload is the body of the request sent to a webhook.
+type WebhookPayload struct {
+	Type      string      `json:"type"`
+	CreatedAt time.Time   `json:"createdAt"`
+	Payload   interface{} `json:"payload"`
+}
+
+// WebhookDeliveryAttempt describes the outcome of sending a delivery.
+type WebhookDeliveryAttempt struct {
+	// The status of the delivery after the attempt.
+	Status string
+
+	// When the delivery should next be attempted if it is still pending.
+	NextAttemptAt time.Time
+
+	AttemptedAt time.Time
+
+	// The status code returned by the webhook. Nil if no response was received.
+	ResponseStatus *int
+
+	// Describes why the attempt failed. Empty if it succeeded.
+	Error string
+}
+
+// WebhookService represents a service for managing webhooks and inspecting
+// their deliveries.
+type WebhookService interface {
+	// FindWebhookByID retrieves a single webhook by ID. Returns
+	// EWEBHOOKNOTFOUND if the webhook does not exist or user does not have
+	// permission to view it.
+	FindWebhookByID(ctx context.Context, req FindWebhookByIDRequest) FindWebhookByIDResponse
+
+	// FindWebhooks retrieves the webhooks of the current organization. Also
+	// returns a count of total matching webhooks which may be different from
+	// the number of returned webhooks if the "Limit" field is set.
+	FindWebhooks(ctx context.Context, req FindWebhooksRequest) FindWebhooksResponse
+
+	// CreateWebhook registers a new webhook for the current organization. The
+	// signing secret is generated and only returned here.
+	CreateWebhook(ctx context.Context, req CreateWebhookRequest) CreateWebhookResponse
+
+	// UpdateWebhook updates an existing webhook by ID.
+	UpdateWebhook(ctx context.Context, req UpdateWebhookRequest) UpdateWebhookResponse
+
+	// DeleteWebhook permanently removes a webhook along with its deliveries.
+	DeleteWebhook(ctx context.Context, req DeleteWebhookRequest) DeleteWebhookResponse
+
+	// FindWebhookDeliveries retrieves the delivery log of a webhook, most
+	// recent first.
+	FindWebhookDeliveries(ctx context.Context, req FindWebhookDeliveriesRequest) FindWebhookDeliveriesResponse
+
+	// RetryWebhookDelivery schedules a delivery to be sent again immediately.
+	// Typically used to redrive deliveries that are dead.
+	RetryWebhookDelivery(ctx context.Context, req RetryWebhookDeliveryRequest) RetryWebhookDeliveryResponse
+}
+
+// WebhookDeliveryService represents a service used by the delivery worker to
+// store deliveries and record their outcome. Implementations aren't
+// restricted to the current organization as the worker delivers events for
+// every organization.
+type WebhookDeliveryService interface {
+	// CreateWebhookDeliveries creates a pending delivery of event for each of
+	// the organization's webhooks that are subscribed to it.
+	CreateWebhookDeliveries(ctx context.Context, organizationID int, event Event) ([]*WebhookDelivery, error)
+
+	// ClaimWebhookDeliveries retrieves up to limit pending deliveries that are
+	// due along with their webhook. Claimed deliveries won't be returned again
+	// until lease has passed so that multiple workers don't send the same
+	// delivery.
+	ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*WebhookDelivery, error)
+
+	// RecordWebhookDeliveryAttempt updates a delivery with the outcome of an
+	// attempt to send it.
+	RecordWebhookDeliveryAttempt(ctx context.Context, id int, attempt WebhookDeliveryAttempt) error
+}
+
+// FindWebhookByIDRequest represents a payload used by the FindWebhookByID method of a WebhookService
+type FindWebhookByIDRequest struct {
+	ID int `json:"id" source:"url"`
+}
+
+// Validate a FindWebhookByIDRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r FindWebhookByIDRequest) Validate() []ValidationError {
+	if r.ID < 1 {
+		return []ValidationError{{Name: "id", Reason: "Must be at least 1"}}
+	}
+	return nil
+}
+
+// FindWebhookByIDResponse represents a response returned by the FindWebhookByID method of a WebhookService.
+type FindWebhookByIDResponse struct {
+	*Webhook
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r FindWebhookByIDResponse) Error() error { return r.Err }
+
+// FindWebhooksRequest represents a payload used by the FindWebhooks method of a WebhookService
+type FindWebhooksRequest struct {
+	// Restrict to subset of range.
+	Offset int `json:"offset" source:"query"`
+	Limit  int `json:"limit" source:"query"`
+}
+
+// Validate a FindWebhooksRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r FindWebhooksRequest) Validate() []ValidationError {
+	errs := make([]ValidationError, 0)
+	if r.Offset < 0 {
+		errs = append(errs, ValidationError{Name: "offset", Reason: "Must be greater than or equal to 0"})
+	}
+	if r.Limit < 0 {
+		errs = append(errs, ValidationError{Name: "limit", Reason: "Must be greater than or equal to 0"})
+	}
+	return errs
+}
+
+// FindWebhooksResponse represents a response returned by the FindWebhooks method of a WebhookService.
+type FindWebhooksResponse struct {
+	Webhooks   []*Webhook `json:"webhooks,omitempty"`
+	TotalItems int        `json:"totalItems"`
+	Err        error      `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r FindWebhooksResponse) Error() error { return r.Err }
+
+// CreateWebhookRequest represents a payload used by the CreateWebhook method of a WebhookService
+type CreateWebhookRequest struct {
+	URL         string   `json:"url" source:"json"`
+	Description string   `json:"description" source:"json"`
+	EventTypes  []string `json:"eventTypes" source:"json"`
+}
+
+// Validate a CreateWebhookRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r CreateWebhookRequest) Validate() []ValidationError {
+	errs := make([]ValidationError, 0)
+	errs = append(errs, validateWebhookURL(r.URL)...)
+	errs = append(errs, validateWebhookEventTypes(r.EventTypes)...)
+	return errs
+}
+
+// CreateWebhookResponse represents a response returned by the CreateWebhook method of a WebhookService.
+type CreateWebhookResponse struct {
+	*Webhook
+
+	// The secret used to sign payloads sent to the webhook.
+	Secret string `json:"secret,omitempty"`
+
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r CreateWebhookResponse) Error() error { return r.Err }
+
+// UpdateWebhookRequest represents a payload used by the UpdateWebhook method of a WebhookService
+type UpdateWebhookRequest struct {
+	ID          int      `json:"id" source:"url"`
+	URL         string   `json:"url" source:"json"`
+	Description string   `json:"description" source:"json"`
+	EventTypes  []string `json:"eventTypes" source:"json"`
+	Enabled     bool     `json:"enabled" source:"json"`
+
+	// Generate a new signing secret. The new secret is returned in the
+	// response.
+	RotateSecret bool `json:"rotateSecret" source:"json"`
+}
+
+// Validate an UpdateWebhookRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r UpdateWebhookRequest) Validate() []ValidationError {
+	errs := make([]ValidationError, 0)
+	if r.ID < 1 {
+		errs = append(errs, ValidationError{Name: "id", Reason: "Must be at least 1"})
+	}
+	errs = append(errs, validateWebhookURL(r.URL)...)
+	errs = append(errs, validateWebhookEventTypes(r.EventTypes)...)
+	return errs
+}
+
+// UpdateWebhookResponse represents a response returned by the UpdateWebhook method of a WebhookService.
+type UpdateWebhookResponse struct {
+	*Webhook
+
+	// The new signing secret if it was rotated.
+	Secret string `json:"secret,omitempty"`
+
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r UpdateWebhookResponse) Error() error { return r.Err }
+
+// DeleteWebhookRequest represents a payload used by the DeleteWebhook method of a WebhookService
+type DeleteWebhookRequest struct {
+	ID int `json:"id" source:"url"`
+}
+
+// Validate a DeleteWebhookRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r DeleteWebhookRequest) Validate() []ValidationError {
+	if r.ID < 1 {
+		return []ValidationError{{Name: "id", Reason: "Must be at least 1"}}
+	}
+	return nil
+}
+
+// DeleteWebhookResponse represents a response returned by the DeleteWebhook method of a WebhookService.
+type DeleteWebhookResponse struct {
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r DeleteWebhookResponse) Error() error { return r.Err }
+
+// FindWebhookDeliveriesRequest represents a payload used by the FindWebhookDeliveries method of a WebhookService
+type FindWebhookDeliveriesRequest struct {
+	WebhookID int `json:"webhookId" source:"url"`
+
+	// Filtering fields.
+	Status    *string `json:"status" source:"query"`
+	EventType *string `json:"eventType" source:"query"`
+
+	// Restrict to subset of range.
+	Offset int `json:"offset" source:"query"`
+	Limit  int `json:"limit" source:"query"`
+}
+
+// Validate a FindWebhookDeliveriesRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r FindWebhookDeliveriesRequest) Validate() []ValidationError {
+	errs := make([]ValidationError, 0)
+	if r.WebhookID < 1 {
+		errs = append(errs, ValidationError{Name: "webhookId", Reason: "Must be at least 1"})
+	}
+	if r.Status != nil && !Strings(WebhookDeliveryStatuses).contains(*r.Status) {
+		errs = append(errs, ValidationError{Name: "status", Reason: "Must be a valid webhook delivery status"})
+	}
+	if r.Offset < 0 {
+		errs = append(errs, ValidationError{Name: "offset", Reason: "Must be greater than or equal to 0"})
+	}
+	if r.Limit < 0 {
+		errs = append(errs, ValidationError{Name: "limit", Reason: "Must be greater than or equal to 0"})
+	}
+	return errs
+}
+
+// FindWebhookDeliveriesResponse represents a response returned by the FindWebhookDeliveries method of a WebhookService.
+type FindWebhookDeliveriesResponse struct {
+	Deliveries []*WebhookDelivery `json:"deliveries,omitempty"`
+	TotalItems int                `json:"totalItems"`
+	Err        error              `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r FindWebhookDeliveriesResponse) Error() error { return r.Err }
+
+// RetryWebhookDeliveryRequest represents a payload used by the RetryWebhookDelivery method of a WebhookService
+type RetryWebhookDeliveryRequest struct {
+	WebhookID int `json:"webhookId" source:"url"`
+	ID        int `json:"id" source:"url"`
+}
+
+// Validate a RetryWebhookDeliveryRequest. Returns a ValidationError for each
+// requirement that fails.
+func (r RetryWebhookDeliveryRequest) Validate() []ValidationError {
+	errs := make([]ValidationError, 0)
+	if r.WebhookID < 1 {
+		errs = append(errs, ValidationError{Name: "webhookId", Reason: "Must be at least 1"})
+	}
+	if r.ID < 1 {
+		errs = append(errs, ValidationError{Name: "id", Reason: "Must be at least 1"})
+	}
+	return errs
+}
+
+// RetryWebhookDeliveryResponse represents a response returned by the RetryWebhookDelivery method of a WebhookService.
+type RetryWebhookDeliveryResponse struct {
+	*WebhookDelivery
+	Err error `json:"err,omitempty"`
+}
+
+// Error implements the errorer interface. Returns property Err from the response.
+func (r RetryWebhookDeliveryResponse) Error() error { return r.Err }
+
+func validateWebhookURL(rawURL string) []ValidationError {
+	if rawURL == "" {
+		return []ValidationError{{Name: "url", Reason: "URL is required"}}
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return []ValidationError{{Name: "url", Reason: "Must be an absolute http or https URL"}}
+	}
+	if !webhookHostAllowed(u.Hostname()) {
+		return []ValidationError{{Name: "url", Reason: "Must not be a loopback, private or link-local address"}}
+	}
+	return nil
+}
+
+// internalHostnames are names that resolve to the server itself or to cloud
+// metadata services.
+var internalHostnames = []string{"localhost", "metadata.google.internal"}
+
+// webhookHostAllowed returns false if host is an address or name that
+// webhooks must not be delivered to. Other names are checked once they have
+// been resolved, when deliveries are sent.
+func webhookHostAllowed(host string) bool {
+	if ip := net.ParseIP(host); ip != nil {
+		return WebhookAddressAllowed(ip)
+	}
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	for _, name := range internalHostnames {
+		if host == name || strings.HasSuffix(host, "."+name) {
+			return false
+		}
+	}
+	return true
+}
+
+// sharedAddressSpace is the range of addresses used by carrier-grade NAT,
+// which are private to the network of the provider.
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// WebhookAddressAllowed returns true if webhooks may be delivered to ip.
+// Loopback, private, link-local, unspecified and multicast addresses are
+// refused so that webhooks can't be used to reach the network of the server,
+// including the metadata services of cloud providers.
+func WebhookAddressAllowed(ip net.IP) bool {
+	return !(ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() ||
+		ip.IsUnspecified() ||
+		sharedAddressSpace.Contains(ip))
+}
+
+func validateWebhookEventTypes(eventTypes []string) []ValidationError {
+	errs := make([]ValidationError, 0)
+	for i, t := range eventTypes {
+		if !WebhookEventType(t) {
+			errs = append(errs, ValidationError{
+				Name:   fmt.Sprintf("eventTypes[%d]", i),
+				Reason: "Must be an event type that can be delivered to webhooks",
+			})
+		}
+	}
+	return errs
+}
+
+// WebhookServiceMiddleware defines a middleware for WebhookService
+type WebhookServiceMiddleware func(service WebhookService) WebhookService
+
+// WebhookValidationMiddleware returns a middleware for validating requests made to a WebhookService
+func WebhookValidationMiddleware() WebhookServiceMiddleware {
+	return func(next WebhookService) WebhookService {
+		return webhookValidationMiddleware{next}
+	}
+}
+
+type webhookValidationMiddleware struct {
+	WebhookService
+}
+
+// FindWebhookByID validates a FindWebhookByIDRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw webhookValidationMiddleware) FindWebhookByID(ctx context.Context, req FindWebhookByIDRequest) FindWebhookByIDResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return FindWebhookByIDResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.WebhookService.FindWebhookByID(ctx, req)
+}
+
+// FindWebhooks validates a FindWebhooksRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw webhookValidationMiddleware) FindWebhooks(ctx context.Context, req FindWebhooksRequest) FindWebhooksResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return FindWebhooksResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.WebhookService.FindWebhooks(ctx, req)
+}
+
+// CreateWebhook validates a CreateWebhookRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw webhookValidationMiddleware) CreateWebhook(ctx context.Context, req CreateWebhookRequest) CreateWebhookResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return CreateWebhookResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.WebhookService.CreateWebhook(ctx, req)
+}
+
+// UpdateWebhook validates an UpdateWebhookRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw webhookValidationMiddleware) UpdateWebhook(ctx context.Context, req UpdateWebhookRequest) UpdateWebhookResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return UpdateWebhookResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.WebhookService.UpdateWebhook(ctx, req)
+}
+
+// DeleteWebhook validates a DeleteWebhookRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw webhookValidationMiddleware) DeleteWebhook(ctx context.Context, req DeleteWebhookRequest) DeleteWebhookResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return DeleteWebhookResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.WebhookService.DeleteWebhook(ctx, req)
+}
+
+// FindWebhookDeliveries validates a FindWebhookDeliveriesRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw webhookValidationMiddleware) FindWebhookDeliveries(ctx context.Context, req FindWebhookDeliveriesRequest) FindWebhookDeliveriesResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return FindWebhookDeliveriesResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.WebhookService.FindWebhookDeliveries(ctx, req)
+}
+
+// RetryWebhookDelivery validates a RetryWebhookDeliveryRequest. Returns a domain error if any requirements fail and invokes the next middleware otherwise.
+func (mw webhookValidationMiddleware) RetryWebhookDelivery(ctx context.Context, req RetryWebhookDeliveryRequest) RetryWebhookDeliveryResponse {
+	errs := req.Validate()
+	if len(errs) > 0 {
+		return RetryWebhookDeliveryResponse{Err: WrapValidationErrors(errs)}
+	}
+	return mw.WebhookService.RetryWebhookDelivery(ctx, req)
+}
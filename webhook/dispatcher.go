@@ -0,0 +1,264 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/openmesh/booking"
+)
+
+// Default settings of a Dispatcher.
+const (
+	DefaultMaxAttempts  = 10
+	DefaultMinBackoff   = 30 * time.Second
+	DefaultMaxBackoff   = 6 * time.Hour
+	DefaultPollInterval = 5 * time.Second
+	DefaultBatchSize    = 20
+	DefaultLease        = time.Minute
+	DefaultTimeout      = 10 * time.Second
+)
+
+// maxResponseBodySize is the number of bytes of a response body that are read
+// so that connections can be reused. The body itself isn't stored.
+const maxResponseBodySize = 64 << 10
+
+// Dispatcher sends pending webhook deliveries and records their outcome.
+// Failed deliveries are retried with exponential backoff until they have been
+// attempted MaxAttempts times, after which they are moved to the dead state.
+type Dispatcher struct {
+	// Service used to store deliveries and record their outcome.
+	Deliveries booking.WebhookDeliveryService
+
+	// Client used to send deliveries. See NewClient.
+	Client *http.Client
+
+	// Number of attempts made before a delivery is moved to the dead state.
+	MaxAttempts int
+
+	// Delay before the first retry. It doubles with every failed attempt up
+	// to MaxBackoff.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	// How often to check for due deliveries when not woken up by new events.
+	PollInterval time.Duration
+
+	// Maximum number of deliveries sent at once.
+	BatchSize int
+
+	// How long a delivery is claimed for while it is being sent. Should be
+	// longer than the client timeout.
+	Lease time.Duration
+
+	Logger log.Logger
+
+	// Returns the current time. Can be replaced in tests.
+	Now func() time.Time
+
+	wake chan struct{}
+}
+
+// NewDispatcher returns a new instance of Dispatcher with default settings.
+func NewDispatcher(deliveries booking.WebhookDeliveryService) *Dispatcher {
+	return &Dispatcher{
+		Deliveries:   deliveries,
+		Client:       NewClient(DefaultTimeout),
+		MaxAttempts:  DefaultMaxAttempts,
+		MinBackoff:   DefaultMinBackoff,
+		MaxBackoff:   DefaultMaxBackoff,
+		PollInterval: DefaultPollInterval,
+		BatchSize:    DefaultBatchSize,
+		Lease:        DefaultLease,
+		Logger:       log.NewNopLogger(),
+		Now:          time.Now,
+		wake:         make(chan struct{}, 1),
+	}
+}
+
+// NewClient returns a client that sends deliveries directly to the address of
+// a webhook, refusing addresses that aren't allowed by
+// booking.WebhookAddressAllowed once the name of the webhook's host has been
+// resolved. Redirects aren't followed so that they can't be used to reach a
+// refused address, and are reported as failed deliveries.
+func NewClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   controlWebhookDial,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Transport: transport,
+		Timeout:   timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// controlWebhookDial refuses connections to addresses that webhooks must not
+// be delivered to. It is called with the resolved address of every
+// connection.
+func controlWebhookDial(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !booking.WebhookAddressAllowed(ip) {
+		return fmt.Errorf("address %s is not allowed", host)
+	}
+	return nil
+}
+
+// Enqueue creates deliveries of event for the organization's webhooks and
+// wakes up the dispatcher to send them.
+func (d *Dispatcher) Enqueue(ctx context.Context, organizationID int, event booking.Event) error {
+	deliveries, err := d.Deliveries.CreateWebhookDeliveries(ctx, organizationID, event)
+	if err != nil {
+		return err
+	}
+	if len(deliveries) > 0 {
+		select {
+		case d.wake <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// Run sends due deliveries until ctx is cancelled.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Keep going while full batches are returned as there are likely to
+		// be more deliveries waiting.
+		for {
+			n, err := d.DeliverDue(ctx)
+			if err != nil {
+				d.Logger.Log("msg", "failed to deliver webhooks", "err", err)
+				break
+			}
+			if n < d.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.wake:
+		}
+	}
+}
+
+// DeliverDue claims a batch of due deliveries and sends them concurrently.
+// Returns the number of deliveries that were sent.
+func (d *Dispatcher) DeliverDue(ctx context.Context) (int, error) {
+	deliveries, err := d.Deliveries.ClaimWebhookDeliveries(ctx, d.BatchSize, d.Lease)
+	if err != nil {
+		return 0, err
+	}
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func(delivery *booking.WebhookDelivery) {
+			defer wg.Done()
+			attempt := d.Deliver(ctx, delivery)
+			if err := d.Deliveries.RecordWebhookDeliveryAttempt(ctx, delivery.ID, attempt); err != nil {
+				d.Logger.Log("msg", "failed to record webhook delivery attempt", "delivery", delivery.ID, "err", err)
+			}
+		}(delivery)
+	}
+	wg.Wait()
+
+	return len(deliveries), nil
+}
+
+// Deliver sends a delivery to its webhook and returns the outcome. The
+// delivery must have its webhook attached.
+func (d *Dispatcher) Deliver(ctx context.Context, delivery *booking.WebhookDelivery) booking.WebhookDeliveryAttempt {
+	now := d.Now()
+	attempt := booking.WebhookDeliveryAttempt{AttemptedAt: now}
+
+	status, err := d.send(ctx, delivery, now)
+	if status != 0 {
+		attempt.ResponseStatus = &status
+	}
+	if err == nil {
+		attempt.Status = booking.WebhookDeliveryStatusSucceeded
+		attempt.NextAttemptAt = now
+		return attempt
+	}
+
+	attempt.Error = err.Error()
+	attempts := delivery.Attempts + 1
+	if attempts >= d.MaxAttempts {
+		attempt.Status = booking.WebhookDeliveryStatusDead
+		attempt.NextAttemptAt = now
+		return attempt
+	}
+	attempt.Status = booking.WebhookDeliveryStatusPending
+	attempt.NextAttemptAt = now.Add(d.Backoff(attempts))
+	return attempt
+}
+
+// Backoff returns the delay before retrying a delivery that has failed
+// attempts times.
+func (d *Dispatcher) Backoff(attempts int) time.Duration {
+	backoff := d.MinBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= d.MaxBackoff {
+			return d.MaxBackoff
+		}
+	}
+	return backoff
+}
+
+// send POSTs the payload of a delivery to its webhook. Returns the response
+// status code, if any, and an error unless the webhook responded with 2xx.
+func (d *Dispatcher) send(ctx context.Context, delivery *booking.WebhookDelivery, now time.Time) (int, error) {
+	if delivery.Webhook == nil {
+		return 0, fmt.Errorf("webhook %d not found", delivery.WebhookID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Webhook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	ts := now.Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "OpenMesh-Booking-Webhooks/"+booking.Version)
+	req.Header.Set(EventHeader, delivery.EventType)
+	req.Header.Set(DeliveryHeader, strconv.Itoa(delivery.ID))
+	req.Header.Set(TimestampHeader, strconv.FormatInt(ts, 10))
+	req.Header.Set(SignatureHeader, Sign(delivery.Webhook.Secret, ts, delivery.Payload))
+
+	resp, err := d.Client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, maxResponseBodySize))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
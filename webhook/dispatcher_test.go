@@ -0,0 +1,297 @@
+package webhook_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openmesh/booking"
+	"github.com/openmesh/booking/webhook"
+)
+
+const testSecret = "whsec_test"
+
+// receiver is a webhook endpoint that responds with a fixed status and
+// records the requests that it receives.
+type receiver struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	status   int
+	requests []*receivedRequest
+}
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+func newReceiver(t *testing.T) *receiver {
+	r := &receiver{status: http.StatusOK}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			t.Errorf("failed to read body: %v", err)
+		}
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.requests = append(r.requests, &receivedRequest{header: req.Header, body: body})
+		w.WriteHeader(r.status)
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *receiver) respondWith(status int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status = status
+}
+
+func (r *receiver) received() []*receivedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.requests
+}
+
+// newTestDispatcher returns a dispatcher whose clock is stopped at now. It
+// can deliver to receivers, which listen on loopback addresses.
+func newTestDispatcher(deliveries booking.WebhookDeliveryService, now time.Time) *webhook.Dispatcher {
+	d := webhook.NewDispatcher(deliveries)
+	d.Client.Transport = http.DefaultTransport
+	d.MaxAttempts = 3
+	d.MinBackoff = time.Minute
+	d.MaxBackoff = time.Hour
+	d.Now = func() time.Time { return now }
+	return d
+}
+
+func newTestDelivery(url string, attempts int) *booking.WebhookDelivery {
+	return &booking.WebhookDelivery{
+		ID:        7,
+		EventType: booking.EventTypeBookingCreated,
+		Payload:   []byte(`{"type":"booking:created","payload":{}}`),
+		Status:    booking.WebhookDeliveryStatusPending,
+		Attempts:  attempts,
+		WebhookID: 1,
+		Webhook:   &booking.Webhook{ID: 1, URL: url, Secret: testSecret, Enabled: true},
+	}
+}
+
+func TestDispatcher_Deliver(t *testing.T) {
+	r := newReceiver(t)
+	now := time.Now().Truncate(time.Second)
+	d := newTestDispatcher(nil, now)
+	delivery := newTestDelivery(r.URL, 0)
+
+	attempt := d.Deliver(context.Background(), delivery)
+	if attempt.Status != booking.WebhookDeliveryStatusSucceeded {
+		t.Errorf("status is %s, want %s", attempt.Status, booking.WebhookDeliveryStatusSucceeded)
+	}
+	if attempt.ResponseStatus == nil || *attempt.ResponseStatus != http.StatusOK {
+		t.Errorf("response status is %v, want %d", attempt.ResponseStatus, http.StatusOK)
+	}
+	if attempt.Error != "" {
+		t.Errorf("unexpected error %q", attempt.Error)
+	}
+	if !attempt.AttemptedAt.Equal(now) {
+		t.Errorf("attempted at %s, want %s", attempt.AttemptedAt, now)
+	}
+
+	requests := r.received()
+	if len(requests) != 1 {
+		t.Fatalf("received %d requests, want 1", len(requests))
+	}
+	req := requests[0]
+	if err := webhook.Verify(testSecret, req.header, req.body, 5*time.Minute); err != nil {
+		t.Errorf("signature does not verify: %v", err)
+	}
+	if err := webhook.Verify("wrong secret", req.header, req.body, 5*time.Minute); err == nil {
+		t.Error("signature verifies with the wrong secret")
+	}
+	if got, want := string(req.body), string(delivery.Payload); got != want {
+		t.Errorf("body is %s, want %s", got, want)
+	}
+	if got := req.header.Get(webhook.EventHeader); got != delivery.EventType {
+		t.Errorf("%s is %q, want %q", webhook.EventHeader, got, delivery.EventType)
+	}
+	if got := req.header.Get(webhook.DeliveryHeader); got != strconv.Itoa(delivery.ID) {
+		t.Errorf("%s is %q, want %d", webhook.DeliveryHeader, got, delivery.ID)
+	}
+	if got := req.header.Get(webhook.TimestampHeader); got != strconv.FormatInt(now.Unix(), 10) {
+		t.Errorf("%s is %q, want %d", webhook.TimestampHeader, got, now.Unix())
+	}
+}
+
+func TestDispatcher_Deliver_Retry(t *testing.T) {
+	r := newReceiver(t)
+	r.respondWith(http.StatusServiceUnavailable)
+	now := time.Now().Truncate(time.Second)
+	d := newTestDispatcher(nil, now)
+
+	// Failed attempts are retried with backoff until MaxAttempts is reached.
+	for attempts := 0; attempts < d.MaxAttempts-1; attempts++ {
+		attempt := d.Deliver(context.Background(), newTestDelivery(r.URL, attempts))
+		if attempt.Status != booking.WebhookDeliveryStatusPending {
+			t.Errorf("after %d attempts status is %s, want %s", attempts+1, attempt.Status, booking.WebhookDeliveryStatusPending)
+		}
+		if want := now.Add(d.Backoff(attempts + 1)); !attempt.NextAttemptAt.Equal(want) {
+			t.Errorf("after %d attempts next attempt is at %s, want %s", attempts+1, attempt.NextAttemptAt, want)
+		}
+		if attempt.ResponseStatus == nil || *attempt.ResponseStatus != http.StatusServiceUnavailable {
+			t.Errorf("response status is %v, want %d", attempt.ResponseStatus, http.StatusServiceUnavailable)
+		}
+		if attempt.Error == "" {
+			t.Error("error is empty")
+		}
+	}
+
+	attempt := d.Deliver(context.Background(), newTestDelivery(r.URL, d.MaxAttempts-1))
+	if attempt.Status != booking.WebhookDeliveryStatusDead {
+		t.Errorf("after %d attempts status is %s, want %s", d.MaxAttempts, attempt.Status, booking.WebhookDeliveryStatusDead)
+	}
+
+	// A delivery succeeds as soon as the receiver recovers.
+	r.respondWith(http.StatusNoContent)
+	attempt = d.Deliver(context.Background(), newTestDelivery(r.URL, 1))
+	if attempt.Status != booking.WebhookDeliveryStatusSucceeded {
+		t.Errorf("status is %s, want %s", attempt.Status, booking.WebhookDeliveryStatusSucceeded)
+	}
+}
+
+func TestDispatcher_Deliver_Unreachable(t *testing.T) {
+	r := newReceiver(t)
+	r.Close()
+	now := time.Now().Truncate(time.Second)
+	d := newTestDispatcher(nil, now)
+
+	attempt := d.Deliver(context.Background(), newTestDelivery(r.URL, 0))
+	if attempt.Status != booking.WebhookDeliveryStatusPending {
+		t.Errorf("status is %s, want %s", attempt.Status, booking.WebhookDeliveryStatusPending)
+	}
+	if attempt.ResponseStatus != nil {
+		t.Errorf("response status is %d, want nil", *attempt.ResponseStatus)
+	}
+	if want := now.Add(d.MinBackoff); !attempt.NextAttemptAt.Equal(want) {
+		t.Errorf("next attempt is at %s, want %s", attempt.NextAttemptAt, want)
+	}
+}
+
+func TestDispatcher_Deliver_PrivateAddress(t *testing.T) {
+	r := newReceiver(t)
+	d := webhook.NewDispatcher(nil)
+
+	attempt := d.Deliver(context.Background(), newTestDelivery(r.URL, 0))
+	if attempt.Status != booking.WebhookDeliveryStatusPending {
+		t.Errorf("status is %s, want %s", attempt.Status, booking.WebhookDeliveryStatusPending)
+	}
+	if !strings.Contains(attempt.Error, "not allowed") {
+		t.Errorf("error is %q, want the address to be refused", attempt.Error)
+	}
+	if n := len(r.received()); n != 0 {
+		t.Errorf("received %d requests, want 0", n)
+	}
+}
+
+func TestDispatcher_Deliver_Redirect(t *testing.T) {
+	target := newReceiver(t)
+	redirect := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusFound))
+	t.Cleanup(redirect.Close)
+	d := newTestDispatcher(nil, time.Now())
+
+	// Redirects aren't followed, so they can't lead to addresses that
+	// deliveries would be refused.
+	attempt := d.Deliver(context.Background(), newTestDelivery(redirect.URL, 0))
+	if attempt.Status != booking.WebhookDeliveryStatusPending {
+		t.Errorf("status is %s, want %s", attempt.Status, booking.WebhookDeliveryStatusPending)
+	}
+	if attempt.ResponseStatus == nil || *attempt.ResponseStatus != http.StatusFound {
+		t.Errorf("response status is %v, want %d", attempt.ResponseStatus, http.StatusFound)
+	}
+	if n := len(target.received()); n != 0 {
+		t.Errorf("redirect target received %d requests, want 0", n)
+	}
+}
+
+func TestDispatcher_Backoff(t *testing.T) {
+	d := newTestDispatcher(nil, time.Now())
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{3, 4 * time.Minute},
+		{6, 32 * time.Minute},
+		{7, time.Hour},
+		{100, time.Hour},
+	}
+	for _, tt := range tests {
+		if got := d.Backoff(tt.attempts); got != tt.want {
+			t.Errorf("Backoff(%d) = %s, want %s", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestDispatcher_DeliverDue(t *testing.T) {
+	r := newReceiver(t)
+	deliveries := &deliveryService{
+		due: []*booking.WebhookDelivery{newTestDelivery(r.URL, 0)},
+	}
+	now := time.Now().Truncate(time.Second)
+	d := newTestDispatcher(deliveries, now)
+
+	n, err := d.DeliverDue(context.Background())
+	if err != nil {
+		t.Fatalf("failed to deliver: %v", err)
+	}
+	if n != 1 {
+		t.Errorf("delivered %d deliveries, want 1", n)
+	}
+	attempt, ok := deliveries.recorded[7]
+	if !ok {
+		t.Fatal("attempt was not recorded")
+	}
+	if attempt.Status != booking.WebhookDeliveryStatusSucceeded {
+		t.Errorf("status is %s, want %s", attempt.Status, booking.WebhookDeliveryStatusSucceeded)
+	}
+}
+
+// deliveryService is a booking.WebhookDeliveryService that returns a fixed
+// set of due deliveries and records the attempts made to send them.
+type deliveryService struct {
+	mu       sync.Mutex
+	due      []*booking.WebhookDelivery
+	recorded map[int]booking.WebhookDeliveryAttempt
+}
+
+func (s *deliveryService) CreateWebhookDeliveries(ctx context.Context, organizationID int, event booking.Event) ([]*booking.WebhookDelivery, error) {
+	return nil, nil
+}
+
+func (s *deliveryService) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*booking.WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if limit > len(s.due) {
+		limit = len(s.due)
+	}
+	claimed := s.due[:limit]
+	s.due = s.due[limit:]
+	return claimed, nil
+}
+
+func (s *deliveryService) RecordWebhookDeliveryAttempt(ctx context.Context, id int, attempt booking.WebhookDeliveryAttempt) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.recorded == nil {
+		s.recorded = make(map[int]booking.WebhookDeliveryAttempt)
+	}
+	s.recorded[id] = attempt
+	return nil
+}
@@ -0,0 +1,35 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/openmesh/booking"
+)
+
+// enqueueTimeout limits how long publishing an event can be held up by
+// storing its webhook deliveries.
+const enqueueTimeout = 5 * time.Second
+
+// NewEventService returns an event service that publishes events to next and
+// also enqueues them for delivery to the organization's webhooks.
+func NewEventService(next booking.EventService, dispatcher *Dispatcher) booking.EventService {
+	return &eventService{next, dispatcher}
+}
+
+type eventService struct {
+	booking.EventService
+	dispatcher *Dispatcher
+}
+
+// PublishEvent publishes event to the next event service and enqueues it for
+// delivery. Enqueueing is best effort as publishing can't fail.
+func (s *eventService) PublishEvent(organizationID int, event booking.Event) {
+	s.EventService.PublishEvent(organizationID, event)
+
+	ctx, cancel := context.WithTimeout(context.Background(), enqueueTimeout)
+	defer cancel()
+	if err := s.dispatcher.Enqueue(ctx, organizationID, event); err != nil {
+		s.dispatcher.Logger.Log("msg", "failed to enqueue webhook deliveries", "type", event.Type, "err", err)
+	}
+}
@@ -0,0 +1,70 @@
+// Package webhook delivers events to the webhooks that organizations have
+// registered. Deliveries are stored before they are sent so that they survive
+// restarts and can be retried with exponential backoff.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers sent with every delivery.
+const (
+	// EventHeader contains the type of the event being delivered.
+	EventHeader = "X-Booking-Event"
+
+	// DeliveryHeader contains the ID of the delivery. It stays the same
+	// between retries so receivers can use it to ignore duplicates.
+	DeliveryHeader = "X-Booking-Delivery"
+
+	// TimestampHeader contains the Unix time at which the request was signed.
+	TimestampHeader = "X-Booking-Timestamp"
+
+	// SignatureHeader contains the signature of the request, see Sign.
+	SignatureHeader = "X-Booking-Signature"
+)
+
+// signatureVersion prefixes signatures so that the scheme can be changed
+// without breaking existing receivers.
+const signatureVersion = "v1"
+
+// Sign returns the signature of a request body sent at timestamp. It is the
+// hex encoded HMAC-SHA256 of "<timestamp>.<body>" keyed with the webhook
+// secret. The timestamp is included so that captured requests can't be
+// replayed later.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(body)
+	return signatureVersion + "=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks that the signature headers of a delivery match its body and
+// that it was signed within tolerance of now. A tolerance of zero disables
+// the timestamp check. It is intended for receivers written in Go and tests.
+func Verify(secret string, header http.Header, body []byte, tolerance time.Duration) error {
+	ts, err := strconv.ParseInt(header.Get(TimestampHeader), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid %s header", TimestampHeader)
+	}
+	if tolerance > 0 {
+		if d := time.Since(time.Unix(ts, 0)); d > tolerance || d < -tolerance {
+			return fmt.Errorf("timestamp is outside of tolerance")
+		}
+	}
+
+	sig := header.Get(SignatureHeader)
+	if !strings.HasPrefix(sig, signatureVersion+"=") {
+		return fmt.Errorf("invalid %s header", SignatureHeader)
+	}
+	if !hmac.Equal([]byte(sig), []byte(Sign(secret, ts, body))) {
+		return fmt.Errorf("signature mismatch")
+	}
+	return nil
+}
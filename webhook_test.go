@@ -0,0 +1,38 @@
+package booking
+
+import "testing"
+
+func TestCreateWebhookRequest_Validate_URL(t *testing.T) {
+	tests := []struct {
+		url   string
+		valid bool
+	}{
+		{url: "https://example.com/webhooks", valid: true},
+		{url: "http://93.184.216.34:8080/hook", valid: true},
+		{url: "https://[2606:2800:220:1:248:1893:25c8:1946]/hook", valid: true},
+		{url: "", valid: false},
+		{url: "ftp://example.com", valid: false},
+		{url: "/webhooks", valid: false},
+		{url: "http://localhost:8080/hook", valid: false},
+		{url: "http://LOCALHOST./hook", valid: false},
+		{url: "http://api.localhost/hook", valid: false},
+		{url: "http://metadata.google.internal/computeMetadata/v1/", valid: false},
+		{url: "http://127.0.0.1/hook", valid: false},
+		{url: "http://[::1]/hook", valid: false},
+		{url: "http://0.0.0.0/hook", valid: false},
+		{url: "http://10.0.0.5/hook", valid: false},
+		{url: "http://172.16.0.1/hook", valid: false},
+		{url: "http://192.168.1.1/hook", valid: false},
+		{url: "http://100.64.0.1/hook", valid: false},
+		{url: "http://169.254.169.254/latest/meta-data/", valid: false},
+		{url: "http://[fe80::1]/hook", valid: false},
+		{url: "http://[fd00:ec2::254]/hook", valid: false},
+		{url: "http://[::ffff:127.0.0.1]/hook", valid: false},
+	}
+	for _, tt := range tests {
+		errs := CreateWebhookRequest{URL: tt.url}.Validate()
+		if valid := len(errs) == 0; valid != tt.valid {
+			t.Errorf("URL %q is valid %t, want %t: %v", tt.url, valid, tt.valid, errs)
+		}
+	}
+}